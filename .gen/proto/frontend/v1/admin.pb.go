@@ -0,0 +1,6920 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/frontend/v1/admin.proto
+
+package frontendv1
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	v1 "github.com/uber/cadence-idl/go/proto/api/v1"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicConfigFilter, DynamicConfigValue and DynamicConfigEntry have the shape of their admin.v1 counterparts,
+// which are declared next to the public AdminAPI.
+type DynamicConfigFilter struct {
+	Name                 string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                *v1.DataBlob `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *DynamicConfigFilter) Reset()         { *m = DynamicConfigFilter{} }
+func (m *DynamicConfigFilter) String() string { return proto.CompactTextString(m) }
+func (*DynamicConfigFilter) ProtoMessage()    {}
+func (*DynamicConfigFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{0}
+}
+func (m *DynamicConfigFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigFilter.Merge(m, src)
+}
+func (m *DynamicConfigFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigFilter proto.InternalMessageInfo
+
+func (m *DynamicConfigFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DynamicConfigFilter) GetValue() *v1.DataBlob {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type DynamicConfigValue struct {
+	Value                *v1.DataBlob           `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Filters              []*DynamicConfigFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DynamicConfigValue) Reset()         { *m = DynamicConfigValue{} }
+func (m *DynamicConfigValue) String() string { return proto.CompactTextString(m) }
+func (*DynamicConfigValue) ProtoMessage()    {}
+func (*DynamicConfigValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{1}
+}
+func (m *DynamicConfigValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigValue.Merge(m, src)
+}
+func (m *DynamicConfigValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigValue proto.InternalMessageInfo
+
+func (m *DynamicConfigValue) GetValue() *v1.DataBlob {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *DynamicConfigValue) GetFilters() []*DynamicConfigFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+type DynamicConfigEntry struct {
+	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values               []*DynamicConfigValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DynamicConfigEntry) Reset()         { *m = DynamicConfigEntry{} }
+func (m *DynamicConfigEntry) String() string { return proto.CompactTextString(m) }
+func (*DynamicConfigEntry) ProtoMessage()    {}
+func (*DynamicConfigEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{2}
+}
+func (m *DynamicConfigEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigEntry.Merge(m, src)
+}
+func (m *DynamicConfigEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigEntry proto.InternalMessageInfo
+
+func (m *DynamicConfigEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DynamicConfigEntry) GetValues() []*DynamicConfigValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// DynamicConfigVersion is a snapshot of the dynamic config kept by the config store.
+type DynamicConfigVersion struct {
+	Version              int64                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Timestamp            *types.Timestamp      `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Author               string                `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Reason               string                `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Entries              []*DynamicConfigEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DynamicConfigVersion) Reset()         { *m = DynamicConfigVersion{} }
+func (m *DynamicConfigVersion) String() string { return proto.CompactTextString(m) }
+func (*DynamicConfigVersion) ProtoMessage()    {}
+func (*DynamicConfigVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{3}
+}
+func (m *DynamicConfigVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigVersion.Merge(m, src)
+}
+func (m *DynamicConfigVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigVersion proto.InternalMessageInfo
+
+func (m *DynamicConfigVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *DynamicConfigVersion) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *DynamicConfigVersion) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *DynamicConfigVersion) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DynamicConfigVersion) GetEntries() []*DynamicConfigEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// DynamicConfigEntryDiff is the change of a dynamic config key between two versions.
+// from_values is empty for an added key and to_values is empty for a removed key.
+type DynamicConfigEntryDiff struct {
+	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FromValues           []*DynamicConfigValue `protobuf:"bytes,2,rep,name=from_values,json=fromValues,proto3" json:"from_values,omitempty"`
+	ToValues             []*DynamicConfigValue `protobuf:"bytes,3,rep,name=to_values,json=toValues,proto3" json:"to_values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DynamicConfigEntryDiff) Reset()         { *m = DynamicConfigEntryDiff{} }
+func (m *DynamicConfigEntryDiff) String() string { return proto.CompactTextString(m) }
+func (*DynamicConfigEntryDiff) ProtoMessage()    {}
+func (*DynamicConfigEntryDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{4}
+}
+func (m *DynamicConfigEntryDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigEntryDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigEntryDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigEntryDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigEntryDiff.Merge(m, src)
+}
+func (m *DynamicConfigEntryDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigEntryDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigEntryDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigEntryDiff proto.InternalMessageInfo
+
+func (m *DynamicConfigEntryDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DynamicConfigEntryDiff) GetFromValues() []*DynamicConfigValue {
+	if m != nil {
+		return m.FromValues
+	}
+	return nil
+}
+
+func (m *DynamicConfigEntryDiff) GetToValues() []*DynamicConfigValue {
+	if m != nil {
+		return m.ToValues
+	}
+	return nil
+}
+
+type ListDynamicConfigVersionsRequest struct {
+	// max_version is the latest version to return, 0 starts from the latest version.
+	MaxVersion           int64    `protobuf:"varint,1,opt,name=max_version,json=maxVersion,proto3" json:"max_version,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDynamicConfigVersionsRequest) Reset()         { *m = ListDynamicConfigVersionsRequest{} }
+func (m *ListDynamicConfigVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDynamicConfigVersionsRequest) ProtoMessage()    {}
+func (*ListDynamicConfigVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{5}
+}
+func (m *ListDynamicConfigVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDynamicConfigVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDynamicConfigVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListDynamicConfigVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDynamicConfigVersionsRequest.Merge(m, src)
+}
+func (m *ListDynamicConfigVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDynamicConfigVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDynamicConfigVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDynamicConfigVersionsRequest proto.InternalMessageInfo
+
+func (m *ListDynamicConfigVersionsRequest) GetMaxVersion() int64 {
+	if m != nil {
+		return m.MaxVersion
+	}
+	return 0
+}
+
+func (m *ListDynamicConfigVersionsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type ListDynamicConfigVersionsResponse struct {
+	Versions []*DynamicConfigVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	// next_max_version is the max_version of the next page, 0 when there are no more versions.
+	NextMaxVersion       int64    `protobuf:"varint,2,opt,name=next_max_version,json=nextMaxVersion,proto3" json:"next_max_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDynamicConfigVersionsResponse) Reset()         { *m = ListDynamicConfigVersionsResponse{} }
+func (m *ListDynamicConfigVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDynamicConfigVersionsResponse) ProtoMessage()    {}
+func (*ListDynamicConfigVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{6}
+}
+func (m *ListDynamicConfigVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDynamicConfigVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDynamicConfigVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListDynamicConfigVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDynamicConfigVersionsResponse.Merge(m, src)
+}
+func (m *ListDynamicConfigVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDynamicConfigVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDynamicConfigVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDynamicConfigVersionsResponse proto.InternalMessageInfo
+
+func (m *ListDynamicConfigVersionsResponse) GetVersions() []*DynamicConfigVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *ListDynamicConfigVersionsResponse) GetNextMaxVersion() int64 {
+	if m != nil {
+		return m.NextMaxVersion
+	}
+	return 0
+}
+
+type DiffDynamicConfigVersionsRequest struct {
+	FromVersion int64 `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// to_version 0 compares against the latest version.
+	ToVersion            int64    `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffDynamicConfigVersionsRequest) Reset()         { *m = DiffDynamicConfigVersionsRequest{} }
+func (m *DiffDynamicConfigVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffDynamicConfigVersionsRequest) ProtoMessage()    {}
+func (*DiffDynamicConfigVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{7}
+}
+func (m *DiffDynamicConfigVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiffDynamicConfigVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiffDynamicConfigVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DiffDynamicConfigVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffDynamicConfigVersionsRequest.Merge(m, src)
+}
+func (m *DiffDynamicConfigVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiffDynamicConfigVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffDynamicConfigVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffDynamicConfigVersionsRequest proto.InternalMessageInfo
+
+func (m *DiffDynamicConfigVersionsRequest) GetFromVersion() int64 {
+	if m != nil {
+		return m.FromVersion
+	}
+	return 0
+}
+
+func (m *DiffDynamicConfigVersionsRequest) GetToVersion() int64 {
+	if m != nil {
+		return m.ToVersion
+	}
+	return 0
+}
+
+type DiffDynamicConfigVersionsResponse struct {
+	From                 *DynamicConfigVersion     `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *DynamicConfigVersion     `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Diffs                []*DynamicConfigEntryDiff `protobuf:"bytes,3,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *DiffDynamicConfigVersionsResponse) Reset()         { *m = DiffDynamicConfigVersionsResponse{} }
+func (m *DiffDynamicConfigVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffDynamicConfigVersionsResponse) ProtoMessage()    {}
+func (*DiffDynamicConfigVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{8}
+}
+func (m *DiffDynamicConfigVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiffDynamicConfigVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiffDynamicConfigVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DiffDynamicConfigVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffDynamicConfigVersionsResponse.Merge(m, src)
+}
+func (m *DiffDynamicConfigVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiffDynamicConfigVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffDynamicConfigVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffDynamicConfigVersionsResponse proto.InternalMessageInfo
+
+func (m *DiffDynamicConfigVersionsResponse) GetFrom() *DynamicConfigVersion {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *DiffDynamicConfigVersionsResponse) GetTo() *DynamicConfigVersion {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *DiffDynamicConfigVersionsResponse) GetDiffs() []*DynamicConfigEntryDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+type RollbackDynamicConfigRequest struct {
+	Version              int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackDynamicConfigRequest) Reset()         { *m = RollbackDynamicConfigRequest{} }
+func (m *RollbackDynamicConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackDynamicConfigRequest) ProtoMessage()    {}
+func (*RollbackDynamicConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{9}
+}
+func (m *RollbackDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackDynamicConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackDynamicConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackDynamicConfigRequest.Merge(m, src)
+}
+func (m *RollbackDynamicConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackDynamicConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackDynamicConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackDynamicConfigRequest proto.InternalMessageInfo
+
+func (m *RollbackDynamicConfigRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RollbackDynamicConfigRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type RollbackDynamicConfigResponse struct {
+	// new_version is the version created by the rollback.
+	NewVersion           int64    `protobuf:"varint,1,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackDynamicConfigResponse) Reset()         { *m = RollbackDynamicConfigResponse{} }
+func (m *RollbackDynamicConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackDynamicConfigResponse) ProtoMessage()    {}
+func (*RollbackDynamicConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{10}
+}
+func (m *RollbackDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackDynamicConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackDynamicConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackDynamicConfigResponse.Merge(m, src)
+}
+func (m *RollbackDynamicConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackDynamicConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackDynamicConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackDynamicConfigResponse proto.InternalMessageInfo
+
+func (m *RollbackDynamicConfigResponse) GetNewVersion() int64 {
+	if m != nil {
+		return m.NewVersion
+	}
+	return 0
+}
+
+type ResolveDynamicConfigRequest struct {
+	ConfigName           string                 `protobuf:"bytes,1,opt,name=config_name,json=configName,proto3" json:"config_name,omitempty"`
+	Filters              []*DynamicConfigFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ResolveDynamicConfigRequest) Reset()         { *m = ResolveDynamicConfigRequest{} }
+func (m *ResolveDynamicConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveDynamicConfigRequest) ProtoMessage()    {}
+func (*ResolveDynamicConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{11}
+}
+func (m *ResolveDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveDynamicConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResolveDynamicConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveDynamicConfigRequest.Merge(m, src)
+}
+func (m *ResolveDynamicConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveDynamicConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveDynamicConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveDynamicConfigRequest proto.InternalMessageInfo
+
+func (m *ResolveDynamicConfigRequest) GetConfigName() string {
+	if m != nil {
+		return m.ConfigName
+	}
+	return ""
+}
+
+func (m *ResolveDynamicConfigRequest) GetFilters() []*DynamicConfigFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+type ResolveDynamicConfigResponse struct {
+	Value *v1.DataBlob `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// source is the dynamic config client which supplied the value.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// matched_filters are the constraints of the winning value, empty when an unconstrained value won.
+	MatchedFilters []*DynamicConfigFilter `protobuf:"bytes,3,rep,name=matched_filters,json=matchedFilters,proto3" json:"matched_filters,omitempty"`
+	// is_default is set when nothing is configured for the key and its default value is returned.
+	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	// candidates are the other configured values of the key, in evaluation order.
+	Candidates []*DynamicConfigCandidate `protobuf:"bytes,5,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// detail is a client specific description of the evaluation.
+	Detail               string   `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResolveDynamicConfigResponse) Reset()         { *m = ResolveDynamicConfigResponse{} }
+func (m *ResolveDynamicConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*ResolveDynamicConfigResponse) ProtoMessage()    {}
+func (*ResolveDynamicConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{12}
+}
+func (m *ResolveDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveDynamicConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResolveDynamicConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveDynamicConfigResponse.Merge(m, src)
+}
+func (m *ResolveDynamicConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveDynamicConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveDynamicConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveDynamicConfigResponse proto.InternalMessageInfo
+
+func (m *ResolveDynamicConfigResponse) GetValue() *v1.DataBlob {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ResolveDynamicConfigResponse) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ResolveDynamicConfigResponse) GetMatchedFilters() []*DynamicConfigFilter {
+	if m != nil {
+		return m.MatchedFilters
+	}
+	return nil
+}
+
+func (m *ResolveDynamicConfigResponse) GetIsDefault() bool {
+	if m != nil {
+		return m.IsDefault
+	}
+	return false
+}
+
+func (m *ResolveDynamicConfigResponse) GetCandidates() []*DynamicConfigCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *ResolveDynamicConfigResponse) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+// DynamicConfigCandidate is a configured value of a key considered by ResolveDynamicConfig.
+type DynamicConfigCandidate struct {
+	Value   *v1.DataBlob           `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Filters []*DynamicConfigFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	// matched is set when the filters of the candidate match the request, whether or not it won.
+	Matched              bool     `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DynamicConfigCandidate) Reset()         { *m = DynamicConfigCandidate{} }
+func (m *DynamicConfigCandidate) String() string { return proto.CompactTextString(m) }
+func (*DynamicConfigCandidate) ProtoMessage()    {}
+func (*DynamicConfigCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{13}
+}
+func (m *DynamicConfigCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigCandidate.Merge(m, src)
+}
+func (m *DynamicConfigCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigCandidate proto.InternalMessageInfo
+
+func (m *DynamicConfigCandidate) GetValue() *v1.DataBlob {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *DynamicConfigCandidate) GetFilters() []*DynamicConfigFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *DynamicConfigCandidate) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
+}
+
+type DescribeReplicationStatusRequest struct {
+	TargetCluster string `protobuf:"bytes,1,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	// shard_ids limits the status to the given shards, all shards are described when it is empty.
+	ShardIds []int32 `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	// domain limits the pending tasks to the ones of a single domain when set.
+	Domain               string   `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeReplicationStatusRequest) Reset()         { *m = DescribeReplicationStatusRequest{} }
+func (m *DescribeReplicationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeReplicationStatusRequest) ProtoMessage()    {}
+func (*DescribeReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{14}
+}
+func (m *DescribeReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeReplicationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeReplicationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeReplicationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeReplicationStatusRequest.Merge(m, src)
+}
+func (m *DescribeReplicationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeReplicationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeReplicationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeReplicationStatusRequest proto.InternalMessageInfo
+
+func (m *DescribeReplicationStatusRequest) GetTargetCluster() string {
+	if m != nil {
+		return m.TargetCluster
+	}
+	return ""
+}
+
+func (m *DescribeReplicationStatusRequest) GetShardIds() []int32 {
+	if m != nil {
+		return m.ShardIds
+	}
+	return nil
+}
+
+func (m *DescribeReplicationStatusRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type DescribeReplicationStatusResponse struct {
+	SourceCluster        string                    `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	TargetCluster        string                    `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	Summary              *ReplicationStatus        `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Shards               []*ShardReplicationStatus `protobuf:"bytes,4,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *DescribeReplicationStatusResponse) Reset()         { *m = DescribeReplicationStatusResponse{} }
+func (m *DescribeReplicationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeReplicationStatusResponse) ProtoMessage()    {}
+func (*DescribeReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{15}
+}
+func (m *DescribeReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeReplicationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeReplicationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeReplicationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeReplicationStatusResponse.Merge(m, src)
+}
+func (m *DescribeReplicationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeReplicationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeReplicationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeReplicationStatusResponse proto.InternalMessageInfo
+
+func (m *DescribeReplicationStatusResponse) GetSourceCluster() string {
+	if m != nil {
+		return m.SourceCluster
+	}
+	return ""
+}
+
+func (m *DescribeReplicationStatusResponse) GetTargetCluster() string {
+	if m != nil {
+		return m.TargetCluster
+	}
+	return ""
+}
+
+func (m *DescribeReplicationStatusResponse) GetSummary() *ReplicationStatus {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *DescribeReplicationStatusResponse) GetShards() []*ShardReplicationStatus {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+// ShardReplicationStatus, ReplicationStatus and ReplicationFetcherStatus have the shape of their history.v1
+// counterparts.
+type ShardReplicationStatus struct {
+	ShardId              int32              `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Status               *ReplicationStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ShardReplicationStatus) Reset()         { *m = ShardReplicationStatus{} }
+func (m *ShardReplicationStatus) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationStatus) ProtoMessage()    {}
+func (*ShardReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{16}
+}
+func (m *ShardReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardReplicationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationStatus.Merge(m, src)
+}
+func (m *ShardReplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationStatus proto.InternalMessageInfo
+
+func (m *ShardReplicationStatus) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ShardReplicationStatus) GetStatus() *ReplicationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type ReplicationStatus struct {
+	AckedTaskId           int64                     `protobuf:"varint,1,opt,name=acked_task_id,json=ackedTaskId,proto3" json:"acked_task_id,omitempty"`
+	MaxTaskId             int64                     `protobuf:"varint,2,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
+	TaskLag               int64                     `protobuf:"varint,3,opt,name=task_lag,json=taskLag,proto3" json:"task_lag,omitempty"`
+	TaskLagTruncated      bool                      `protobuf:"varint,4,opt,name=task_lag_truncated,json=taskLagTruncated,proto3" json:"task_lag_truncated,omitempty"`
+	OldestPendingTaskTime *types.Timestamp          `protobuf:"bytes,5,opt,name=oldest_pending_task_time,json=oldestPendingTaskTime,proto3" json:"oldest_pending_task_time,omitempty"`
+	LagSeconds            int64                     `protobuf:"varint,6,opt,name=lag_seconds,json=lagSeconds,proto3" json:"lag_seconds,omitempty"`
+	DlqSize               int64                     `protobuf:"varint,7,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+	Fetcher               *ReplicationFetcherStatus `protobuf:"bytes,8,opt,name=fetcher,proto3" json:"fetcher,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                  `json:"-"`
+	XXX_unrecognized      []byte                    `json:"-"`
+	XXX_sizecache         int32                     `json:"-"`
+}
+
+func (m *ReplicationStatus) Reset()         { *m = ReplicationStatus{} }
+func (m *ReplicationStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationStatus) ProtoMessage()    {}
+func (*ReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{17}
+}
+func (m *ReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationStatus.Merge(m, src)
+}
+func (m *ReplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationStatus proto.InternalMessageInfo
+
+func (m *ReplicationStatus) GetAckedTaskId() int64 {
+	if m != nil {
+		return m.AckedTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationStatus) GetMaxTaskId() int64 {
+	if m != nil {
+		return m.MaxTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationStatus) GetTaskLag() int64 {
+	if m != nil {
+		return m.TaskLag
+	}
+	return 0
+}
+
+func (m *ReplicationStatus) GetTaskLagTruncated() bool {
+	if m != nil {
+		return m.TaskLagTruncated
+	}
+	return false
+}
+
+func (m *ReplicationStatus) GetOldestPendingTaskTime() *types.Timestamp {
+	if m != nil {
+		return m.OldestPendingTaskTime
+	}
+	return nil
+}
+
+func (m *ReplicationStatus) GetLagSeconds() int64 {
+	if m != nil {
+		return m.LagSeconds
+	}
+	return 0
+}
+
+func (m *ReplicationStatus) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
+	}
+	return 0
+}
+
+func (m *ReplicationStatus) GetFetcher() *ReplicationFetcherStatus {
+	if m != nil {
+		return m.Fetcher
+	}
+	return nil
+}
+
+type ReplicationFetcherStatus struct {
+	LastFetchTime        *types.Timestamp `protobuf:"bytes,1,opt,name=last_fetch_time,json=lastFetchTime,proto3" json:"last_fetch_time,omitempty"`
+	ErrorCount           int64            `protobuf:"varint,2,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	LastError            string           `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime        *types.Timestamp `protobuf:"bytes,4,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplicationFetcherStatus) Reset()         { *m = ReplicationFetcherStatus{} }
+func (m *ReplicationFetcherStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationFetcherStatus) ProtoMessage()    {}
+func (*ReplicationFetcherStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{18}
+}
+func (m *ReplicationFetcherStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationFetcherStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationFetcherStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationFetcherStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationFetcherStatus.Merge(m, src)
+}
+func (m *ReplicationFetcherStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationFetcherStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationFetcherStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationFetcherStatus proto.InternalMessageInfo
+
+func (m *ReplicationFetcherStatus) GetLastFetchTime() *types.Timestamp {
+	if m != nil {
+		return m.LastFetchTime
+	}
+	return nil
+}
+
+func (m *ReplicationFetcherStatus) GetErrorCount() int64 {
+	if m != nil {
+		return m.ErrorCount
+	}
+	return 0
+}
+
+func (m *ReplicationFetcherStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ReplicationFetcherStatus) GetLastErrorTime() *types.Timestamp {
+	if m != nil {
+		return m.LastErrorTime
+	}
+	return nil
+}
+
+type MoveTaskListBacklogRequest struct {
+	Domain              string          `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList            *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType        v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	DestinationTaskList *v1.TaskList    `protobuf:"bytes,4,opt,name=destination_task_list,json=destinationTaskList,proto3" json:"destination_task_list,omitempty"`
+	MinTaskId           int64           `protobuf:"varint,5,opt,name=min_task_id,json=minTaskId,proto3" json:"min_task_id,omitempty"`
+	// max_task_id 0 moves every task from min_task_id on.
+	MaxTaskId            int64    `protobuf:"varint,6,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveTaskListBacklogRequest) Reset()         { *m = MoveTaskListBacklogRequest{} }
+func (m *MoveTaskListBacklogRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveTaskListBacklogRequest) ProtoMessage()    {}
+func (*MoveTaskListBacklogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{19}
+}
+func (m *MoveTaskListBacklogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveTaskListBacklogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveTaskListBacklogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MoveTaskListBacklogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTaskListBacklogRequest.Merge(m, src)
+}
+func (m *MoveTaskListBacklogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveTaskListBacklogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTaskListBacklogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTaskListBacklogRequest proto.InternalMessageInfo
+
+func (m *MoveTaskListBacklogRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *MoveTaskListBacklogRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *MoveTaskListBacklogRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+func (m *MoveTaskListBacklogRequest) GetDestinationTaskList() *v1.TaskList {
+	if m != nil {
+		return m.DestinationTaskList
+	}
+	return nil
+}
+
+func (m *MoveTaskListBacklogRequest) GetMinTaskId() int64 {
+	if m != nil {
+		return m.MinTaskId
+	}
+	return 0
+}
+
+func (m *MoveTaskListBacklogRequest) GetMaxTaskId() int64 {
+	if m != nil {
+		return m.MaxTaskId
+	}
+	return 0
+}
+
+type MoveTaskListBacklogResponse struct {
+	MovedTasks           int64    `protobuf:"varint,1,opt,name=moved_tasks,json=movedTasks,proto3" json:"moved_tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveTaskListBacklogResponse) Reset()         { *m = MoveTaskListBacklogResponse{} }
+func (m *MoveTaskListBacklogResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveTaskListBacklogResponse) ProtoMessage()    {}
+func (*MoveTaskListBacklogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{20}
+}
+func (m *MoveTaskListBacklogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveTaskListBacklogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveTaskListBacklogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MoveTaskListBacklogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTaskListBacklogResponse.Merge(m, src)
+}
+func (m *MoveTaskListBacklogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveTaskListBacklogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTaskListBacklogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTaskListBacklogResponse proto.InternalMessageInfo
+
+func (m *MoveTaskListBacklogResponse) GetMovedTasks() int64 {
+	if m != nil {
+		return m.MovedTasks
+	}
+	return 0
+}
+
+type DeleteTaskListBacklogTasksRequest struct {
+	Domain               string          `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	TaskIds              []int64         `protobuf:"varint,4,rep,packed,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) Reset()         { *m = DeleteTaskListBacklogTasksRequest{} }
+func (m *DeleteTaskListBacklogTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTaskListBacklogTasksRequest) ProtoMessage()    {}
+func (*DeleteTaskListBacklogTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{21}
+}
+func (m *DeleteTaskListBacklogTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTaskListBacklogTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTaskListBacklogTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteTaskListBacklogTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTaskListBacklogTasksRequest.Merge(m, src)
+}
+func (m *DeleteTaskListBacklogTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTaskListBacklogTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTaskListBacklogTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTaskListBacklogTasksRequest proto.InternalMessageInfo
+
+func (m *DeleteTaskListBacklogTasksRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) GetTaskIds() []int64 {
+	if m != nil {
+		return m.TaskIds
+	}
+	return nil
+}
+
+type DeleteTaskListBacklogTasksResponse struct {
+	// deleted_task_ids excludes the tasks which were already dispatched to a poller or completed.
+	DeletedTaskIds       []int64  `protobuf:"varint,1,rep,packed,name=deleted_task_ids,json=deletedTaskIds,proto3" json:"deleted_task_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTaskListBacklogTasksResponse) Reset()         { *m = DeleteTaskListBacklogTasksResponse{} }
+func (m *DeleteTaskListBacklogTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTaskListBacklogTasksResponse) ProtoMessage()    {}
+func (*DeleteTaskListBacklogTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33be5c6332dbd43a, []int{22}
+}
+func (m *DeleteTaskListBacklogTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTaskListBacklogTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTaskListBacklogTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteTaskListBacklogTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTaskListBacklogTasksResponse.Merge(m, src)
+}
+func (m *DeleteTaskListBacklogTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTaskListBacklogTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTaskListBacklogTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTaskListBacklogTasksResponse proto.InternalMessageInfo
+
+func (m *DeleteTaskListBacklogTasksResponse) GetDeletedTaskIds() []int64 {
+	if m != nil {
+		return m.DeletedTaskIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DynamicConfigFilter)(nil), "uber.cadence.frontend.v1.DynamicConfigFilter")
+	proto.RegisterType((*DynamicConfigValue)(nil), "uber.cadence.frontend.v1.DynamicConfigValue")
+	proto.RegisterType((*DynamicConfigEntry)(nil), "uber.cadence.frontend.v1.DynamicConfigEntry")
+	proto.RegisterType((*DynamicConfigVersion)(nil), "uber.cadence.frontend.v1.DynamicConfigVersion")
+	proto.RegisterType((*DynamicConfigEntryDiff)(nil), "uber.cadence.frontend.v1.DynamicConfigEntryDiff")
+	proto.RegisterType((*ListDynamicConfigVersionsRequest)(nil), "uber.cadence.frontend.v1.ListDynamicConfigVersionsRequest")
+	proto.RegisterType((*ListDynamicConfigVersionsResponse)(nil), "uber.cadence.frontend.v1.ListDynamicConfigVersionsResponse")
+	proto.RegisterType((*DiffDynamicConfigVersionsRequest)(nil), "uber.cadence.frontend.v1.DiffDynamicConfigVersionsRequest")
+	proto.RegisterType((*DiffDynamicConfigVersionsResponse)(nil), "uber.cadence.frontend.v1.DiffDynamicConfigVersionsResponse")
+	proto.RegisterType((*RollbackDynamicConfigRequest)(nil), "uber.cadence.frontend.v1.RollbackDynamicConfigRequest")
+	proto.RegisterType((*RollbackDynamicConfigResponse)(nil), "uber.cadence.frontend.v1.RollbackDynamicConfigResponse")
+	proto.RegisterType((*ResolveDynamicConfigRequest)(nil), "uber.cadence.frontend.v1.ResolveDynamicConfigRequest")
+	proto.RegisterType((*ResolveDynamicConfigResponse)(nil), "uber.cadence.frontend.v1.ResolveDynamicConfigResponse")
+	proto.RegisterType((*DynamicConfigCandidate)(nil), "uber.cadence.frontend.v1.DynamicConfigCandidate")
+	proto.RegisterType((*DescribeReplicationStatusRequest)(nil), "uber.cadence.frontend.v1.DescribeReplicationStatusRequest")
+	proto.RegisterType((*DescribeReplicationStatusResponse)(nil), "uber.cadence.frontend.v1.DescribeReplicationStatusResponse")
+	proto.RegisterType((*ShardReplicationStatus)(nil), "uber.cadence.frontend.v1.ShardReplicationStatus")
+	proto.RegisterType((*ReplicationStatus)(nil), "uber.cadence.frontend.v1.ReplicationStatus")
+	proto.RegisterType((*ReplicationFetcherStatus)(nil), "uber.cadence.frontend.v1.ReplicationFetcherStatus")
+	proto.RegisterType((*MoveTaskListBacklogRequest)(nil), "uber.cadence.frontend.v1.MoveTaskListBacklogRequest")
+	proto.RegisterType((*MoveTaskListBacklogResponse)(nil), "uber.cadence.frontend.v1.MoveTaskListBacklogResponse")
+	proto.RegisterType((*DeleteTaskListBacklogTasksRequest)(nil), "uber.cadence.frontend.v1.DeleteTaskListBacklogTasksRequest")
+	proto.RegisterType((*DeleteTaskListBacklogTasksResponse)(nil), "uber.cadence.frontend.v1.DeleteTaskListBacklogTasksResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/frontend/v1/admin.proto", fileDescriptor_33be5c6332dbd43a)
+}
+
+var fileDescriptor_33be5c6332dbd43a = []byte{
+	// 1530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6e, 0x1b, 0x47,
+	0x12, 0xc6, 0x90, 0xe2, 0x5f, 0xd1, 0x96, 0xed, 0xb6, 0x2d, 0x8c, 0x69, 0x5b, 0x3f, 0x83, 0x35,
+	0x20, 0x60, 0xbd, 0xd4, 0x4a, 0x5e, 0x7b, 0x77, 0xad, 0x85, 0xb1, 0xd6, 0x9f, 0xa3, 0xc0, 0x36,
+	0x94, 0x91, 0xe0, 0x43, 0x0e, 0x99, 0xb4, 0x66, 0x9a, 0x54, 0x43, 0x33, 0xd3, 0xf4, 0x74, 0x93,
+	0x96, 0x7c, 0xc8, 0xc1, 0x40, 0x90, 0x20, 0xc7, 0x20, 0x40, 0x90, 0xb7, 0xc8, 0x53, 0x04, 0x39,
+	0xe4, 0x90, 0x7b, 0x2e, 0x81, 0x5e, 0x20, 0x8f, 0x90, 0xa0, 0xff, 0x28, 0x52, 0x22, 0x29, 0x89,
+	0x09, 0x10, 0xe4, 0x36, 0x5d, 0x5d, 0x5f, 0xd5, 0x57, 0xd5, 0x5d, 0xd5, 0x45, 0xc2, 0xdf, 0xda,
+	0xbb, 0x24, 0x5b, 0x08, 0x71, 0x44, 0xd2, 0x90, 0x2c, 0x34, 0x32, 0x96, 0x0a, 0x92, 0x46, 0x0b,
+	0x9d, 0xc5, 0x05, 0x1c, 0x25, 0x34, 0xad, 0xb7, 0x32, 0x26, 0x18, 0x72, 0xa5, 0x56, 0xdd, 0x68,
+	0xd5, 0xad, 0x56, 0xbd, 0xb3, 0x58, 0x9b, 0x69, 0x32, 0xd6, 0x8c, 0xc9, 0x82, 0xd2, 0xdb, 0x6d,
+	0x37, 0x16, 0x04, 0x4d, 0x08, 0x17, 0x38, 0x69, 0x69, 0x68, 0x6d, 0xb6, 0xcf, 0x01, 0x6e, 0x51,
+	0x69, 0x3b, 0x64, 0x49, 0xc2, 0x8c, 0xf1, 0x9a, 0x37, 0x48, 0x43, 0x60, 0xbe, 0x1f, 0x53, 0x2e,
+	0xb4, 0x8e, 0xf7, 0x11, 0x5c, 0x5f, 0x3b, 0x4c, 0x71, 0x42, 0xc3, 0x55, 0x96, 0x36, 0x68, 0x73,
+	0x83, 0xc6, 0x82, 0x64, 0x08, 0xc1, 0x44, 0x8a, 0x13, 0xe2, 0x3a, 0xb3, 0xce, 0x7c, 0xc5, 0x57,
+	0xdf, 0xe8, 0x01, 0x14, 0x3a, 0x38, 0x6e, 0x13, 0x37, 0x37, 0xeb, 0xcc, 0x57, 0x97, 0xee, 0xd6,
+	0xfb, 0xb8, 0xe3, 0x16, 0xad, 0x77, 0x16, 0xeb, 0x6b, 0x58, 0xe0, 0x95, 0x98, 0xed, 0xfa, 0x5a,
+	0xd7, 0xfb, 0xd2, 0x01, 0xd4, 0xe7, 0xe0, 0x95, 0x14, 0x1f, 0xdb, 0x72, 0xce, 0x6f, 0x0b, 0x3d,
+	0x83, 0x52, 0x43, 0xd1, 0xe3, 0x6e, 0x6e, 0x36, 0x3f, 0x5f, 0x5d, 0xfa, 0x47, 0x7d, 0x58, 0xfa,
+	0xea, 0x03, 0x82, 0xf2, 0x2d, 0xda, 0x4b, 0x4f, 0x70, 0x5a, 0x4f, 0x45, 0x76, 0x38, 0x30, 0xe6,
+	0x35, 0x28, 0x2a, 0xdf, 0xd6, 0xe3, 0xfd, 0x73, 0x7a, 0x54, 0x51, 0xfa, 0x06, 0xeb, 0x1d, 0x39,
+	0x70, 0xa3, 0x7f, 0x9b, 0x64, 0x9c, 0xb2, 0x14, 0xb9, 0x50, 0xea, 0xe8, 0x4f, 0xe5, 0x35, 0xef,
+	0xdb, 0x25, 0xfa, 0x0f, 0x54, 0xba, 0x07, 0x6e, 0x12, 0x5e, 0xab, 0xeb, 0x2b, 0x51, 0xb7, 0x57,
+	0xa2, 0xbe, 0x63, 0x35, 0xfc, 0x63, 0x65, 0x34, 0x05, 0x45, 0xdc, 0x16, 0x7b, 0x2c, 0x73, 0xf3,
+	0x2a, 0x10, 0xb3, 0x92, 0xf2, 0x8c, 0x60, 0xce, 0x52, 0x77, 0x42, 0xcb, 0xf5, 0x0a, 0x6d, 0x40,
+	0x89, 0xa4, 0x22, 0xa3, 0x84, 0xbb, 0x85, 0x0b, 0xc5, 0xa8, 0xb2, 0xe6, 0x5b, 0xb0, 0xf7, 0x9d,
+	0x03, 0x53, 0xa7, 0xf7, 0xd7, 0x68, 0xa3, 0x31, 0x30, 0xb3, 0x2f, 0xa0, 0xda, 0xc8, 0x58, 0x12,
+	0xfc, 0x8e, 0xf4, 0x82, 0x34, 0xa0, 0x3e, 0x39, 0xda, 0x84, 0x8a, 0x60, 0xd6, 0x58, 0x7e, 0x0c,
+	0x63, 0x65, 0xc1, 0xb4, 0x29, 0xef, 0x63, 0x98, 0x7d, 0x4e, 0xb9, 0x18, 0x74, 0x60, 0xdc, 0x27,
+	0xaf, 0xdb, 0x84, 0x0b, 0x34, 0x03, 0xd5, 0x04, 0x1f, 0x04, 0xfd, 0x87, 0x07, 0x09, 0x3e, 0xb0,
+	0x27, 0x7b, 0x1b, 0x2a, 0x2d, 0xdc, 0x24, 0x01, 0xa7, 0x6f, 0x75, 0xc1, 0x14, 0xfc, 0xb2, 0x14,
+	0x6c, 0xd3, 0xb7, 0xc4, 0xfb, 0xc6, 0x81, 0xb9, 0x11, 0x2e, 0x78, 0x8b, 0xa5, 0x9c, 0xa0, 0xf7,
+	0xa1, 0x6c, 0xec, 0x73, 0xd7, 0x51, 0x11, 0xd5, 0xcf, 0x1b, 0x91, 0x86, 0xf9, 0x5d, 0x3c, 0x9a,
+	0x87, 0xab, 0x29, 0x39, 0x10, 0x41, 0x2f, 0xe9, 0x9c, 0x22, 0x3d, 0x29, 0xe5, 0x2f, 0xba, 0xc4,
+	0xbd, 0x08, 0x66, 0xe5, 0x99, 0x8d, 0x8c, 0x7e, 0x0e, 0x2e, 0xe9, 0xb3, 0xeb, 0x0b, 0x5f, 0x9d,
+	0xa7, 0x8d, 0xff, 0x2e, 0x80, 0x3c, 0x8f, 0x3e, 0x57, 0x15, 0xc1, 0xac, 0x97, 0x5f, 0x1c, 0x98,
+	0x1b, 0xe1, 0xc6, 0x64, 0x60, 0x05, 0x26, 0xa4, 0x4d, 0xd3, 0x24, 0x2e, 0x1a, 0xbd, 0xc2, 0xa2,
+	0x27, 0x90, 0x13, 0xcc, 0xcd, 0x8d, 0x65, 0x21, 0x27, 0x18, 0xda, 0x80, 0x42, 0x44, 0x1b, 0x0d,
+	0x7b, 0xa9, 0xfe, 0x79, 0x91, 0xe2, 0x90, 0x11, 0xfa, 0x1a, 0xee, 0x6d, 0xc1, 0x1d, 0x9f, 0xc5,
+	0xf1, 0x2e, 0x0e, 0xf7, 0xfb, 0x14, 0x6d, 0x4e, 0x87, 0xb7, 0x82, 0xe3, 0xc2, 0xcd, 0xf5, 0x16,
+	0xae, 0xf7, 0x7f, 0xb8, 0x3b, 0xc4, 0xa2, 0x49, 0xdf, 0x0c, 0x54, 0x53, 0xf2, 0xe6, 0xe4, 0x25,
+	0x4d, 0xc9, 0x1b, 0x7b, 0x0a, 0x9f, 0x39, 0x70, 0xdb, 0x27, 0x9c, 0xc5, 0x1d, 0x32, 0x90, 0xd3,
+	0x0c, 0x54, 0x43, 0x25, 0x08, 0x7a, 0xca, 0x17, 0xb4, 0xe8, 0x25, 0x4e, 0xfe, 0xc0, 0x8e, 0xfc,
+	0x43, 0x0e, 0xee, 0x0c, 0x66, 0x62, 0x62, 0x19, 0xeb, 0xc1, 0x98, 0x82, 0x22, 0x67, 0xed, 0x2c,
+	0x24, 0x36, 0x73, 0x7a, 0x85, 0x5e, 0xc1, 0x95, 0x04, 0x8b, 0x70, 0x8f, 0x44, 0x81, 0xa5, 0x9f,
+	0x1f, 0x87, 0xfe, 0xa4, 0xb1, 0xa2, 0x97, 0x5c, 0x5e, 0x7a, 0xca, 0x83, 0x88, 0x34, 0x70, 0x3b,
+	0x16, 0xaa, 0xcd, 0x96, 0xfd, 0x0a, 0xe5, 0x6b, 0x5a, 0x80, 0xb6, 0x00, 0x42, 0x9c, 0x46, 0x34,
+	0xc2, 0xa2, 0xdb, 0x6c, 0xcf, 0x7b, 0x9f, 0x56, 0x2d, 0xd0, 0xef, 0xb1, 0x21, 0x03, 0x8c, 0x88,
+	0xc0, 0x34, 0x76, 0x8b, 0x3a, 0x40, 0xbd, 0xf2, 0xbe, 0x3d, 0xd9, 0x8b, 0xbb, 0xf0, 0x3f, 0xf7,
+	0xe5, 0x95, 0xb7, 0xdc, 0xe4, 0x4c, 0xbd, 0x4e, 0x65, 0xdf, 0x2e, 0xbd, 0x4f, 0x60, 0x76, 0x8d,
+	0xf0, 0x30, 0xa3, 0xbb, 0xc4, 0x27, 0xad, 0x98, 0x86, 0x58, 0x50, 0x96, 0x6e, 0x0b, 0x2c, 0xda,
+	0xdd, 0xbe, 0x73, 0x0f, 0x26, 0x05, 0xce, 0x9a, 0x44, 0x04, 0x61, 0xdc, 0xe6, 0x82, 0x64, 0xe6,
+	0x4a, 0x5e, 0xd6, 0xd2, 0x55, 0x2d, 0x94, 0xbd, 0x97, 0xef, 0xe1, 0x2c, 0x0a, 0x68, 0xa4, 0xf9,
+	0x16, 0xfc, 0xb2, 0x12, 0x6c, 0x46, 0x3a, 0x65, 0x2c, 0xc1, 0x34, 0xb5, 0xcf, 0xa3, 0x5e, 0x79,
+	0xef, 0x72, 0x30, 0x37, 0x82, 0x80, 0xb9, 0x86, 0xf7, 0x60, 0x52, 0xdf, 0xa1, 0x93, 0x0c, 0xb4,
+	0xd4, 0x32, 0x38, 0x4d, 0x34, 0x37, 0x88, 0xe8, 0x3a, 0x94, 0x78, 0x3b, 0x49, 0x70, 0x76, 0xa8,
+	0xc8, 0x54, 0x97, 0xfe, 0x3e, 0x3c, 0xad, 0xa7, 0x39, 0x59, 0x2c, 0x7a, 0x0f, 0x8a, 0x2a, 0x3c,
+	0xee, 0x4e, 0x9c, 0x75, 0xa7, 0xb6, 0xa5, 0xde, 0x69, 0x53, 0x06, 0xef, 0x1d, 0xc0, 0xd4, 0x60,
+	0x0d, 0x74, 0x0b, 0xca, 0x36, 0xa7, 0x2a, 0xe4, 0x82, 0x5f, 0x32, 0x29, 0x45, 0xab, 0x50, 0xe4,
+	0x4a, 0xc9, 0xcd, 0x5d, 0x3c, 0x08, 0x03, 0xf5, 0x7e, 0xcd, 0xc1, 0xb5, 0xd3, 0x5e, 0x3d, 0xb8,
+	0x8c, 0xc3, 0x7d, 0x12, 0x05, 0x72, 0x6a, 0xb5, 0xae, 0xf3, 0x7e, 0x55, 0x09, 0x77, 0x30, 0xdf,
+	0xdf, 0x8c, 0xd0, 0xb4, 0x7e, 0x8a, 0xad, 0x86, 0x79, 0x6a, 0x12, 0x7c, 0x60, 0xf6, 0x6f, 0x41,
+	0x59, 0xed, 0xc5, 0xb8, 0xa9, 0xb2, 0x9c, 0xf7, 0x4b, 0x72, 0xfd, 0x1c, 0x37, 0xd1, 0x7d, 0x40,
+	0x76, 0x2b, 0x10, 0x59, 0x3b, 0x0d, 0xb1, 0x20, 0x91, 0xa9, 0xdb, 0xab, 0x46, 0x69, 0xc7, 0xca,
+	0xd1, 0x36, 0xb8, 0x2c, 0x8e, 0x08, 0x17, 0x41, 0x8b, 0xa4, 0x11, 0x4d, 0x9b, 0xda, 0xa7, 0x9c,
+	0xbc, 0xdc, 0xc2, 0x99, 0x13, 0xda, 0x4d, 0x8d, 0xdd, 0xd2, 0x50, 0xc9, 0x4d, 0xee, 0xc9, 0x16,
+	0x2b, 0xbd, 0x73, 0x12, 0xb2, 0x34, 0xe2, 0xaa, 0x8c, 0xf3, 0x3e, 0xc4, 0xb8, 0xb9, 0xad, 0x25,
+	0x92, 0x7e, 0x14, 0xbf, 0xd6, 0x73, 0x44, 0x49, 0xd3, 0x8f, 0xe2, 0xd7, 0x72, 0x8c, 0x40, 0xcf,
+	0xa1, 0xd4, 0x20, 0xb2, 0x7a, 0x32, 0xb7, 0xac, 0xfc, 0x2f, 0x9d, 0x2b, 0xf3, 0x1b, 0x1a, 0x63,
+	0x6f, 0x91, 0x31, 0x21, 0x87, 0x54, 0x77, 0x98, 0x16, 0x5a, 0x81, 0x2b, 0x31, 0xe6, 0x22, 0x50,
+	0xca, 0x3a, 0x64, 0xe7, 0xcc, 0x90, 0x2f, 0x4b, 0x88, 0xb2, 0x63, 0x43, 0x25, 0x59, 0xc6, 0xb2,
+	0x20, 0x64, 0xed, 0x54, 0x98, 0x83, 0x02, 0x25, 0x5a, 0x95, 0x12, 0xd9, 0x3e, 0x95, 0x13, 0x25,
+	0x32, 0xe5, 0x59, 0x91, 0x92, 0x75, 0x29, 0xe8, 0x72, 0xd0, 0x46, 0x14, 0x87, 0x89, 0xf3, 0x71,
+	0x50, 0x78, 0x29, 0x93, 0xef, 0x4c, 0xed, 0x05, 0xeb, 0x10, 0x99, 0x7f, 0x39, 0x81, 0xad, 0xe0,
+	0x70, 0x3f, 0x66, 0xdd, 0x07, 0xef, 0xb8, 0x39, 0x38, 0xbd, 0xcd, 0x01, 0x3d, 0x86, 0x8a, 0xbe,
+	0x28, 0x94, 0x8b, 0x91, 0x3f, 0x7f, 0xac, 0x5d, 0xbf, 0x2c, 0xcc, 0x17, 0x7a, 0x06, 0x93, 0x5d,
+	0x6c, 0x20, 0x0e, 0x5b, 0x44, 0x45, 0x36, 0xb9, 0x34, 0x37, 0xd2, 0xc0, 0xce, 0x61, 0x8b, 0xf8,
+	0x97, 0x44, 0xcf, 0x0a, 0x7d, 0x00, 0x37, 0xe5, 0x0d, 0xa2, 0xa9, 0x3a, 0x9f, 0xe0, 0x98, 0xd0,
+	0xc4, 0x79, 0x08, 0x5d, 0xef, 0xc1, 0x5a, 0xa1, 0xaa, 0x1d, 0x9a, 0x76, 0x6b, 0xa7, 0x60, 0x6a,
+	0x87, 0xa6, 0x83, 0x6b, 0xab, 0x78, 0xa2, 0xb6, 0xbc, 0x27, 0x70, 0x7b, 0x60, 0x36, 0x8f, 0x07,
+	0x90, 0x84, 0x75, 0x4c, 0xf9, 0xf2, 0xee, 0x94, 0x2c, 0x45, 0x12, 0xc2, 0xbd, 0x9f, 0xe4, 0x18,
+	0x48, 0x62, 0x22, 0x4e, 0x9a, 0x50, 0xdb, 0x7f, 0x89, 0x53, 0xb1, 0xed, 0x85, 0x9a, 0xf6, 0x6b,
+	0xda, 0xcb, 0x66, 0xc4, 0xbd, 0x97, 0xe0, 0x8d, 0x0a, 0xce, 0x24, 0x69, 0x1e, 0xae, 0x46, 0x4a,
+	0xab, 0xdb, 0xe5, 0xf4, 0xb8, 0x9f, 0xf7, 0x27, 0x8d, 0x5c, 0x27, 0x9b, 0x2f, 0x7d, 0x51, 0x86,
+	0x6b, 0x4f, 0xe5, 0x9f, 0x07, 0xeb, 0x07, 0x82, 0xa4, 0x9c, 0xb2, 0xf4, 0xe9, 0xd6, 0x26, 0xfa,
+	0xca, 0x81, 0x5b, 0x43, 0x7f, 0x4c, 0xa0, 0xc7, 0xc3, 0x5b, 0xc2, 0x59, 0x3f, 0x72, 0x6a, 0xcb,
+	0x63, 0x61, 0x4d, 0x58, 0x92, 0xd6, 0xd0, 0x09, 0x7f, 0x14, 0xad, 0xb3, 0x7e, 0x7d, 0xd4, 0x96,
+	0xc7, 0xc2, 0x1a, 0x5a, 0x9f, 0x3b, 0x70, 0x73, 0xe0, 0xd4, 0x8c, 0x1e, 0x8d, 0x68, 0x9e, 0x23,
+	0x06, 0xf7, 0xda, 0xbf, 0x2f, 0x8c, 0x33, 0x54, 0x3e, 0x75, 0xe0, 0xc6, 0xa0, 0x99, 0x17, 0x3d,
+	0x1c, 0x61, 0x71, 0xf8, 0xb4, 0x5e, 0x7b, 0x74, 0x51, 0x58, 0xef, 0x49, 0x0d, 0x9b, 0x7c, 0x46,
+	0x9e, 0xd4, 0x19, 0xf3, 0x5a, 0x6d, 0x79, 0x2c, 0xac, 0xa1, 0xf5, 0xce, 0x81, 0xeb, 0x03, 0x9a,
+	0x0b, 0xfa, 0xd7, 0x70, 0xa3, 0xc3, 0x3b, 0x7b, 0xed, 0xe1, 0x05, 0x51, 0x86, 0xc4, 0xd7, 0x0e,
+	0xd4, 0x86, 0xd7, 0x30, 0x1a, 0x19, 0xe0, 0x19, 0x6d, 0xad, 0xf6, 0xbf, 0xf1, 0xc0, 0x9a, 0xd9,
+	0xca, 0xb3, 0xef, 0x8f, 0xa6, 0x9d, 0x1f, 0x8f, 0xa6, 0x9d, 0x9f, 0x8f, 0xa6, 0x9d, 0x0f, 0xff,
+	0xdb, 0xa4, 0x62, 0xaf, 0xbd, 0x5b, 0x0f, 0x59, 0xb2, 0xd0, 0xf7, 0xaf, 0x5f, 0xbd, 0x49, 0x52,
+	0xfd, 0x1f, 0x62, 0xef, 0x7f, 0x90, 0xcb, 0xf6, 0xbb, 0xb3, 0xb8, 0x5b, 0x54, 0xbb, 0x0f, 0x7e,
+	0x1b, 0x00, 0xb5, 0x12, 0xd7, 0xf1, 0xb1, 0x14, 0x00, 0x00,
+}
+
+func (m *DynamicConfigFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigEntryDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigEntryDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigEntryDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ToValues) > 0 {
+		for iNdEx := len(m.ToValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FromValues) > 0 {
+		for iNdEx := len(m.FromValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FromValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDynamicConfigVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDynamicConfigVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDynamicConfigVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxVersion != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MaxVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDynamicConfigVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDynamicConfigVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDynamicConfigVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NextMaxVersion != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NextMaxVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiffDynamicConfigVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffDynamicConfigVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffDynamicConfigVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ToVersion != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ToVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromVersion != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.FromVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiffDynamicConfigVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffDynamicConfigVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffDynamicConfigVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Diffs) > 0 {
+		for iNdEx := len(m.Diffs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diffs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NewVersion != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NewVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResolveDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResolveDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConfigName) > 0 {
+		i -= len(m.ConfigName)
+		copy(dAtA[i:], m.ConfigName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ConfigName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResolveDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResolveDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.IsDefault {
+		i--
+		if m.IsDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MatchedFilters) > 0 {
+		for iNdEx := len(m.MatchedFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MatchedFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Matched {
+		i--
+		if m.Matched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeReplicationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeReplicationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeReplicationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ShardIds) > 0 {
+		dAtA9 := make([]byte, len(m.ShardIds)*10)
+		var j8 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintAdmin(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeReplicationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeReplicationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeReplicationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Summary != nil {
+		{
+			size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardReplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Fetcher != nil {
+		{
+			size, err := m.Fetcher.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DlqSize != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LagSeconds != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LagSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OldestPendingTaskTime != nil {
+		{
+			size, err := m.OldestPendingTaskTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskLagTruncated {
+		i--
+		if m.TaskLagTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskLag != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TaskLag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTaskId != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MaxTaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AckedTaskId != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.AckedTaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationFetcherStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationFetcherStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationFetcherStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastErrorTime != nil {
+		{
+			size, err := m.LastErrorTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ErrorCount != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ErrorCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastFetchTime != nil {
+		{
+			size, err := m.LastFetchTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveTaskListBacklogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveTaskListBacklogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTaskListBacklogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxTaskId != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MaxTaskId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinTaskId != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MinTaskId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DestinationTaskList != nil {
+		{
+			size, err := m.DestinationTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveTaskListBacklogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveTaskListBacklogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTaskListBacklogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MovedTasks != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MovedTasks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskIds) > 0 {
+		dAtA19 := make([]byte, len(m.TaskIds)*10)
+		var j18 int
+		for _, num1 := range m.TaskIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintAdmin(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteTaskListBacklogTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTaskListBacklogTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteTaskListBacklogTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeletedTaskIds) > 0 {
+		dAtA22 := make([]byte, len(m.DeletedTaskIds)*10)
+		var j21 int
+		for _, num1 := range m.DeletedTaskIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintAdmin(dAtA, i, uint64(j21))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicConfigFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DynamicConfigValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DynamicConfigEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DynamicConfigVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovAdmin(uint64(m.Version))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DynamicConfigEntryDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.FromValues) > 0 {
+		for _, e := range m.FromValues {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.ToValues) > 0 {
+		for _, e := range m.ToValues {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDynamicConfigVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxVersion != 0 {
+		n += 1 + sovAdmin(uint64(m.MaxVersion))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovAdmin(uint64(m.PageSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDynamicConfigVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.NextMaxVersion != 0 {
+		n += 1 + sovAdmin(uint64(m.NextMaxVersion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffDynamicConfigVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromVersion != 0 {
+		n += 1 + sovAdmin(uint64(m.FromVersion))
+	}
+	if m.ToVersion != 0 {
+		n += 1 + sovAdmin(uint64(m.ToVersion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffDynamicConfigVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Diffs) > 0 {
+		for _, e := range m.Diffs {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovAdmin(uint64(m.Version))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewVersion != 0 {
+		n += 1 + sovAdmin(uint64(m.NewVersion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResolveDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResolveDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.MatchedFilters) > 0 {
+		for _, e := range m.MatchedFilters {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.IsDefault {
+		n += 2
+	}
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DynamicConfigCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Matched {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeReplicationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetCluster)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeReplicationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.TargetCluster)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Summary != nil {
+		l = m.Summary.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovAdmin(uint64(m.ShardId))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AckedTaskId != 0 {
+		n += 1 + sovAdmin(uint64(m.AckedTaskId))
+	}
+	if m.MaxTaskId != 0 {
+		n += 1 + sovAdmin(uint64(m.MaxTaskId))
+	}
+	if m.TaskLag != 0 {
+		n += 1 + sovAdmin(uint64(m.TaskLag))
+	}
+	if m.TaskLagTruncated {
+		n += 2
+	}
+	if m.OldestPendingTaskTime != nil {
+		l = m.OldestPendingTaskTime.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.LagSeconds != 0 {
+		n += 1 + sovAdmin(uint64(m.LagSeconds))
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovAdmin(uint64(m.DlqSize))
+	}
+	if m.Fetcher != nil {
+		l = m.Fetcher.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationFetcherStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastFetchTime != nil {
+		l = m.LastFetchTime.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.ErrorCount != 0 {
+		n += 1 + sovAdmin(uint64(m.ErrorCount))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.LastErrorTime != nil {
+		l = m.LastErrorTime.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MoveTaskListBacklogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovAdmin(uint64(m.TaskListType))
+	}
+	if m.DestinationTaskList != nil {
+		l = m.DestinationTaskList.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.MinTaskId != 0 {
+		n += 1 + sovAdmin(uint64(m.MinTaskId))
+	}
+	if m.MaxTaskId != 0 {
+		n += 1 + sovAdmin(uint64(m.MaxTaskId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MoveTaskListBacklogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MovedTasks != 0 {
+		n += 1 + sovAdmin(uint64(m.MovedTasks))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovAdmin(uint64(m.TaskListType))
+	}
+	if len(m.TaskIds) > 0 {
+		l = 0
+		for _, e := range m.TaskIds {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTaskListBacklogTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeletedTaskIds) > 0 {
+		l = 0
+		for _, e := range m.DeletedTaskIds {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicConfigFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &v1.DataBlob{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicConfigValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &v1.DataBlob{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, &DynamicConfigFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicConfigEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &DynamicConfigValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicConfigVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &DynamicConfigEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicConfigEntryDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigEntryDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigEntryDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromValues = append(m.FromValues, &DynamicConfigValue{})
+			if err := m.FromValues[len(m.FromValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToValues = append(m.ToValues, &DynamicConfigValue{})
+			if err := m.ToValues[len(m.ToValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDynamicConfigVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDynamicConfigVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDynamicConfigVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVersion", wireType)
+			}
+			m.MaxVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDynamicConfigVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDynamicConfigVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDynamicConfigVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &DynamicConfigVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMaxVersion", wireType)
+			}
+			m.NextMaxVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextMaxVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiffDynamicConfigVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiffDynamicConfigVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiffDynamicConfigVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			}
+			m.FromVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			}
+			m.ToVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiffDynamicConfigVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiffDynamicConfigVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiffDynamicConfigVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &DynamicConfigVersion{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &DynamicConfigVersion{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diffs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diffs = append(m.Diffs, &DynamicConfigEntryDiff{})
+			if err := m.Diffs[len(m.Diffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackDynamicConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackDynamicConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackDynamicConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackDynamicConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewVersion", wireType)
+			}
+			m.NewVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolveDynamicConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolveDynamicConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolveDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, &DynamicConfigFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolveDynamicConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolveDynamicConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolveDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &v1.DataBlob{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatchedFilters = append(m.MatchedFilters, &DynamicConfigFilter{})
+			if err := m.MatchedFilters[len(m.MatchedFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDefault = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, &DynamicConfigCandidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicConfigCandidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicConfigCandidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicConfigCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &v1.DataBlob{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, &DynamicConfigFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Matched = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeReplicationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeReplicationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeReplicationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeReplicationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Summary == nil {
+				m.Summary = &ReplicationStatus{}
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, &ShardReplicationStatus{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardReplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &ReplicationStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedTaskId", wireType)
+			}
+			m.AckedTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckedTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskId", wireType)
+			}
+			m.MaxTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskLag", wireType)
+			}
+			m.TaskLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskLagTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TaskLagTruncated = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestPendingTaskTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestPendingTaskTime == nil {
+				m.OldestPendingTaskTime = &types.Timestamp{}
+			}
+			if err := m.OldestPendingTaskTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LagSeconds", wireType)
+			}
+			m.LagSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LagSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
+			}
+			m.DlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetcher", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fetcher == nil {
+				m.Fetcher = &ReplicationFetcherStatus{}
+			}
+			if err := m.Fetcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationFetcherStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationFetcherStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationFetcherStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFetchTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFetchTime == nil {
+				m.LastFetchTime = &types.Timestamp{}
+			}
+			if err := m.LastFetchTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
+			}
+			m.ErrorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastErrorTime == nil {
+				m.LastErrorTime = &types.Timestamp{}
+			}
+			if err := m.LastErrorTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoveTaskListBacklogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveTaskListBacklogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveTaskListBacklogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DestinationTaskList == nil {
+				m.DestinationTaskList = &v1.TaskList{}
+			}
+			if err := m.DestinationTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTaskId", wireType)
+			}
+			m.MinTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskId", wireType)
+			}
+			m.MaxTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoveTaskListBacklogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveTaskListBacklogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveTaskListBacklogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedTasks", wireType)
+			}
+			m.MovedTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MovedTasks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTaskListBacklogTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTaskListBacklogTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTaskListBacklogTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TaskIds = append(m.TaskIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TaskIds) == 0 {
+					m.TaskIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TaskIds = append(m.TaskIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTaskListBacklogTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTaskListBacklogTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTaskListBacklogTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DeletedTaskIds = append(m.DeletedTaskIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DeletedTaskIds) == 0 {
+					m.DeletedTaskIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DeletedTaskIds = append(m.DeletedTaskIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedTaskIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAdmin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAdmin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAdmin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAdmin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAdmin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAdmin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAdmin = fmt.Errorf("proto: unexpected end of group")
+)
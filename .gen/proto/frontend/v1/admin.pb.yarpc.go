@@ -0,0 +1,848 @@
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/frontend/v1/admin.proto
+
+package frontendv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// AdminExtensionAPIYARPCClient is the YARPC client-side interface for the AdminExtensionAPI service.
+type AdminExtensionAPIYARPCClient interface {
+	ListDynamicConfigVersions(context.Context, *ListDynamicConfigVersionsRequest, ...yarpc.CallOption) (*ListDynamicConfigVersionsResponse, error)
+	DiffDynamicConfigVersions(context.Context, *DiffDynamicConfigVersionsRequest, ...yarpc.CallOption) (*DiffDynamicConfigVersionsResponse, error)
+	RollbackDynamicConfig(context.Context, *RollbackDynamicConfigRequest, ...yarpc.CallOption) (*RollbackDynamicConfigResponse, error)
+	ResolveDynamicConfig(context.Context, *ResolveDynamicConfigRequest, ...yarpc.CallOption) (*ResolveDynamicConfigResponse, error)
+	DescribeReplicationStatus(context.Context, *DescribeReplicationStatusRequest, ...yarpc.CallOption) (*DescribeReplicationStatusResponse, error)
+	MoveTaskListBacklog(context.Context, *MoveTaskListBacklogRequest, ...yarpc.CallOption) (*MoveTaskListBacklogResponse, error)
+	DeleteTaskListBacklogTasks(context.Context, *DeleteTaskListBacklogTasksRequest, ...yarpc.CallOption) (*DeleteTaskListBacklogTasksResponse, error)
+}
+
+func newAdminExtensionAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) AdminExtensionAPIYARPCClient {
+	return &_AdminExtensionAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.frontend.v1.AdminExtensionAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewAdminExtensionAPIYARPCClient builds a new YARPC client for the AdminExtensionAPI service.
+func NewAdminExtensionAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) AdminExtensionAPIYARPCClient {
+	return newAdminExtensionAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// AdminExtensionAPIYARPCServer is the YARPC server-side interface for the AdminExtensionAPI service.
+type AdminExtensionAPIYARPCServer interface {
+	ListDynamicConfigVersions(context.Context, *ListDynamicConfigVersionsRequest) (*ListDynamicConfigVersionsResponse, error)
+	DiffDynamicConfigVersions(context.Context, *DiffDynamicConfigVersionsRequest) (*DiffDynamicConfigVersionsResponse, error)
+	RollbackDynamicConfig(context.Context, *RollbackDynamicConfigRequest) (*RollbackDynamicConfigResponse, error)
+	ResolveDynamicConfig(context.Context, *ResolveDynamicConfigRequest) (*ResolveDynamicConfigResponse, error)
+	DescribeReplicationStatus(context.Context, *DescribeReplicationStatusRequest) (*DescribeReplicationStatusResponse, error)
+	MoveTaskListBacklog(context.Context, *MoveTaskListBacklogRequest) (*MoveTaskListBacklogResponse, error)
+	DeleteTaskListBacklogTasks(context.Context, *DeleteTaskListBacklogTasksRequest) (*DeleteTaskListBacklogTasksResponse, error)
+}
+
+type buildAdminExtensionAPIYARPCProceduresParams struct {
+	Server      AdminExtensionAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildAdminExtensionAPIYARPCProcedures(params buildAdminExtensionAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_AdminExtensionAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.frontend.v1.AdminExtensionAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "ListDynamicConfigVersions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListDynamicConfigVersions,
+							NewRequest:  newAdminExtensionAPIServiceListDynamicConfigVersionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DiffDynamicConfigVersions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DiffDynamicConfigVersions,
+							NewRequest:  newAdminExtensionAPIServiceDiffDynamicConfigVersionsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RollbackDynamicConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RollbackDynamicConfig,
+							NewRequest:  newAdminExtensionAPIServiceRollbackDynamicConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResolveDynamicConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResolveDynamicConfig,
+							NewRequest:  newAdminExtensionAPIServiceResolveDynamicConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeReplicationStatus",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeReplicationStatus,
+							NewRequest:  newAdminExtensionAPIServiceDescribeReplicationStatusYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MoveTaskListBacklog",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MoveTaskListBacklog,
+							NewRequest:  newAdminExtensionAPIServiceMoveTaskListBacklogYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeleteTaskListBacklogTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeleteTaskListBacklogTasks,
+							NewRequest:  newAdminExtensionAPIServiceDeleteTaskListBacklogTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildAdminExtensionAPIYARPCProcedures prepares an implementation of the AdminExtensionAPI service for YARPC registration.
+func BuildAdminExtensionAPIYARPCProcedures(server AdminExtensionAPIYARPCServer) []transport.Procedure {
+	return buildAdminExtensionAPIYARPCProcedures(buildAdminExtensionAPIYARPCProceduresParams{Server: server})
+}
+
+// FxAdminExtensionAPIYARPCClientParams defines the input
+// for NewFxAdminExtensionAPIYARPCClient. It provides the
+// paramaters to get a AdminExtensionAPIYARPCClient in an
+// Fx application.
+type FxAdminExtensionAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxAdminExtensionAPIYARPCClientResult defines the output
+// of NewFxAdminExtensionAPIYARPCClient. It provides a
+// AdminExtensionAPIYARPCClient to an Fx application.
+type FxAdminExtensionAPIYARPCClientResult struct {
+	fx.Out
+
+	Client AdminExtensionAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxAdminExtensionAPIYARPCClient provides a AdminExtensionAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  frontendv1.NewFxAdminExtensionAPIYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxAdminExtensionAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxAdminExtensionAPIYARPCClientParams) FxAdminExtensionAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxAdminExtensionAPIYARPCClientResult{
+			Client: newAdminExtensionAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxAdminExtensionAPIYARPCProceduresParams defines the input
+// for NewFxAdminExtensionAPIYARPCProcedures. It provides the
+// paramaters to get AdminExtensionAPIYARPCServer procedures in an
+// Fx application.
+type FxAdminExtensionAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      AdminExtensionAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxAdminExtensionAPIYARPCProceduresResult defines the output
+// of NewFxAdminExtensionAPIYARPCProcedures. It provides
+// AdminExtensionAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxAdminExtensionAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxAdminExtensionAPIYARPCProcedures provides AdminExtensionAPIYARPCServer procedures to an Fx application.
+// It expects a AdminExtensionAPIYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  frontendv1.NewFxAdminExtensionAPIYARPCProcedures(),
+//	  ...
+//	)
+func NewFxAdminExtensionAPIYARPCProcedures() interface{} {
+	return func(params FxAdminExtensionAPIYARPCProceduresParams) FxAdminExtensionAPIYARPCProceduresResult {
+		return FxAdminExtensionAPIYARPCProceduresResult{
+			Procedures: buildAdminExtensionAPIYARPCProcedures(buildAdminExtensionAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: AdminExtensionAPIReflectionMeta,
+		}
+	}
+}
+
+// AdminExtensionAPIReflectionMeta is the reflection server metadata
+// required for using the gRPC reflection protocol with YARPC.
+//
+// See https://github.com/grpc/grpc/blob/master/doc/server-reflection.md.
+var AdminExtensionAPIReflectionMeta = reflection.ServerMeta{
+	ServiceName:     "uber.cadence.frontend.v1.AdminExtensionAPI",
+	FileDescriptors: yarpcFileDescriptorClosure33be5c6332dbd43a,
+}
+
+type _AdminExtensionAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_AdminExtensionAPIYARPCCaller) ListDynamicConfigVersions(ctx context.Context, request *ListDynamicConfigVersionsRequest, options ...yarpc.CallOption) (*ListDynamicConfigVersionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListDynamicConfigVersions", request, newAdminExtensionAPIServiceListDynamicConfigVersionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListDynamicConfigVersionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtensionAPIServiceListDynamicConfigVersionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtensionAPIYARPCCaller) DiffDynamicConfigVersions(ctx context.Context, request *DiffDynamicConfigVersionsRequest, options ...yarpc.CallOption) (*DiffDynamicConfigVersionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DiffDynamicConfigVersions", request, newAdminExtensionAPIServiceDiffDynamicConfigVersionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DiffDynamicConfigVersionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtensionAPIServiceDiffDynamicConfigVersionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtensionAPIYARPCCaller) RollbackDynamicConfig(ctx context.Context, request *RollbackDynamicConfigRequest, options ...yarpc.CallOption) (*RollbackDynamicConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RollbackDynamicConfig", request, newAdminExtensionAPIServiceRollbackDynamicConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RollbackDynamicConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtensionAPIServiceRollbackDynamicConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtensionAPIYARPCCaller) ResolveDynamicConfig(ctx context.Context, request *ResolveDynamicConfigRequest, options ...yarpc.CallOption) (*ResolveDynamicConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResolveDynamicConfig", request, newAdminExtensionAPIServiceResolveDynamicConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResolveDynamicConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtensionAPIServiceResolveDynamicConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtensionAPIYARPCCaller) DescribeReplicationStatus(ctx context.Context, request *DescribeReplicationStatusRequest, options ...yarpc.CallOption) (*DescribeReplicationStatusResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeReplicationStatus", request, newAdminExtensionAPIServiceDescribeReplicationStatusYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeReplicationStatusResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtensionAPIServiceDescribeReplicationStatusYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtensionAPIYARPCCaller) MoveTaskListBacklog(ctx context.Context, request *MoveTaskListBacklogRequest, options ...yarpc.CallOption) (*MoveTaskListBacklogResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MoveTaskListBacklog", request, newAdminExtensionAPIServiceMoveTaskListBacklogYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MoveTaskListBacklogResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtensionAPIServiceMoveTaskListBacklogYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminExtensionAPIYARPCCaller) DeleteTaskListBacklogTasks(ctx context.Context, request *DeleteTaskListBacklogTasksRequest, options ...yarpc.CallOption) (*DeleteTaskListBacklogTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeleteTaskListBacklogTasks", request, newAdminExtensionAPIServiceDeleteTaskListBacklogTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeleteTaskListBacklogTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminExtensionAPIServiceDeleteTaskListBacklogTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _AdminExtensionAPIYARPCHandler struct {
+	server AdminExtensionAPIYARPCServer
+}
+
+func (h *_AdminExtensionAPIYARPCHandler) ListDynamicConfigVersions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListDynamicConfigVersionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListDynamicConfigVersionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtensionAPIServiceListDynamicConfigVersionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListDynamicConfigVersions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtensionAPIYARPCHandler) DiffDynamicConfigVersions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DiffDynamicConfigVersionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DiffDynamicConfigVersionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtensionAPIServiceDiffDynamicConfigVersionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DiffDynamicConfigVersions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtensionAPIYARPCHandler) RollbackDynamicConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RollbackDynamicConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RollbackDynamicConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtensionAPIServiceRollbackDynamicConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RollbackDynamicConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtensionAPIYARPCHandler) ResolveDynamicConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResolveDynamicConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResolveDynamicConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtensionAPIServiceResolveDynamicConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResolveDynamicConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtensionAPIYARPCHandler) DescribeReplicationStatus(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeReplicationStatusRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeReplicationStatusRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtensionAPIServiceDescribeReplicationStatusYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeReplicationStatus(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtensionAPIYARPCHandler) MoveTaskListBacklog(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MoveTaskListBacklogRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MoveTaskListBacklogRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtensionAPIServiceMoveTaskListBacklogYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MoveTaskListBacklog(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminExtensionAPIYARPCHandler) DeleteTaskListBacklogTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeleteTaskListBacklogTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeleteTaskListBacklogTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminExtensionAPIServiceDeleteTaskListBacklogTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeleteTaskListBacklogTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newAdminExtensionAPIServiceListDynamicConfigVersionsYARPCRequest() proto.Message {
+	return &ListDynamicConfigVersionsRequest{}
+}
+
+func newAdminExtensionAPIServiceListDynamicConfigVersionsYARPCResponse() proto.Message {
+	return &ListDynamicConfigVersionsResponse{}
+}
+
+func newAdminExtensionAPIServiceDiffDynamicConfigVersionsYARPCRequest() proto.Message {
+	return &DiffDynamicConfigVersionsRequest{}
+}
+
+func newAdminExtensionAPIServiceDiffDynamicConfigVersionsYARPCResponse() proto.Message {
+	return &DiffDynamicConfigVersionsResponse{}
+}
+
+func newAdminExtensionAPIServiceRollbackDynamicConfigYARPCRequest() proto.Message {
+	return &RollbackDynamicConfigRequest{}
+}
+
+func newAdminExtensionAPIServiceRollbackDynamicConfigYARPCResponse() proto.Message {
+	return &RollbackDynamicConfigResponse{}
+}
+
+func newAdminExtensionAPIServiceResolveDynamicConfigYARPCRequest() proto.Message {
+	return &ResolveDynamicConfigRequest{}
+}
+
+func newAdminExtensionAPIServiceResolveDynamicConfigYARPCResponse() proto.Message {
+	return &ResolveDynamicConfigResponse{}
+}
+
+func newAdminExtensionAPIServiceDescribeReplicationStatusYARPCRequest() proto.Message {
+	return &DescribeReplicationStatusRequest{}
+}
+
+func newAdminExtensionAPIServiceDescribeReplicationStatusYARPCResponse() proto.Message {
+	return &DescribeReplicationStatusResponse{}
+}
+
+func newAdminExtensionAPIServiceMoveTaskListBacklogYARPCRequest() proto.Message {
+	return &MoveTaskListBacklogRequest{}
+}
+
+func newAdminExtensionAPIServiceMoveTaskListBacklogYARPCResponse() proto.Message {
+	return &MoveTaskListBacklogResponse{}
+}
+
+func newAdminExtensionAPIServiceDeleteTaskListBacklogTasksYARPCRequest() proto.Message {
+	return &DeleteTaskListBacklogTasksRequest{}
+}
+
+func newAdminExtensionAPIServiceDeleteTaskListBacklogTasksYARPCResponse() proto.Message {
+	return &DeleteTaskListBacklogTasksResponse{}
+}
+
+var (
+	emptyAdminExtensionAPIServiceListDynamicConfigVersionsYARPCRequest   = &ListDynamicConfigVersionsRequest{}
+	emptyAdminExtensionAPIServiceListDynamicConfigVersionsYARPCResponse  = &ListDynamicConfigVersionsResponse{}
+	emptyAdminExtensionAPIServiceDiffDynamicConfigVersionsYARPCRequest   = &DiffDynamicConfigVersionsRequest{}
+	emptyAdminExtensionAPIServiceDiffDynamicConfigVersionsYARPCResponse  = &DiffDynamicConfigVersionsResponse{}
+	emptyAdminExtensionAPIServiceRollbackDynamicConfigYARPCRequest       = &RollbackDynamicConfigRequest{}
+	emptyAdminExtensionAPIServiceRollbackDynamicConfigYARPCResponse      = &RollbackDynamicConfigResponse{}
+	emptyAdminExtensionAPIServiceResolveDynamicConfigYARPCRequest        = &ResolveDynamicConfigRequest{}
+	emptyAdminExtensionAPIServiceResolveDynamicConfigYARPCResponse       = &ResolveDynamicConfigResponse{}
+	emptyAdminExtensionAPIServiceDescribeReplicationStatusYARPCRequest   = &DescribeReplicationStatusRequest{}
+	emptyAdminExtensionAPIServiceDescribeReplicationStatusYARPCResponse  = &DescribeReplicationStatusResponse{}
+	emptyAdminExtensionAPIServiceMoveTaskListBacklogYARPCRequest         = &MoveTaskListBacklogRequest{}
+	emptyAdminExtensionAPIServiceMoveTaskListBacklogYARPCResponse        = &MoveTaskListBacklogResponse{}
+	emptyAdminExtensionAPIServiceDeleteTaskListBacklogTasksYARPCRequest  = &DeleteTaskListBacklogTasksRequest{}
+	emptyAdminExtensionAPIServiceDeleteTaskListBacklogTasksYARPCResponse = &DeleteTaskListBacklogTasksResponse{}
+)
+
+var yarpcFileDescriptorClosure33be5c6332dbd43a = [][]byte{
+	// uber/cadence/frontend/v1/admin.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6e, 0x1b, 0x47,
+		0x12, 0xc6, 0x90, 0xe2, 0x5f, 0xd1, 0x96, 0xed, 0xb6, 0x2d, 0x8c, 0x69, 0x7b, 0x25, 0x0d, 0xd6,
+		0x80, 0x80, 0xf5, 0x8e, 0x56, 0xf2, 0xda, 0xbb, 0xb1, 0x02, 0x23, 0xd6, 0x9f, 0xa3, 0xc0, 0x36,
+		0x94, 0x91, 0xe0, 0x43, 0x0e, 0x99, 0xb4, 0x66, 0x9a, 0x54, 0x43, 0x33, 0xd3, 0xf4, 0x74, 0x93,
+		0x96, 0x7c, 0xc8, 0xc1, 0x40, 0x90, 0x20, 0xc7, 0x20, 0x40, 0x90, 0xb7, 0xc8, 0x53, 0xe4, 0x94,
+		0x37, 0xc8, 0x2d, 0x2f, 0x90, 0x47, 0x48, 0xd0, 0x7f, 0x14, 0x29, 0x91, 0x94, 0xc4, 0x04, 0x08,
+		0x72, 0x9b, 0xae, 0xae, 0xaf, 0xea, 0xab, 0xea, 0xae, 0xea, 0x22, 0xe1, 0x9f, 0x9d, 0x3d, 0x92,
+		0x2f, 0x46, 0x38, 0x26, 0x59, 0x44, 0x16, 0x9b, 0x39, 0xcb, 0x04, 0xc9, 0xe2, 0xc5, 0xee, 0xd2,
+		0x22, 0x8e, 0x53, 0x9a, 0xf9, 0xed, 0x9c, 0x09, 0x86, 0x5c, 0xa9, 0xe5, 0x1b, 0x2d, 0xdf, 0x6a,
+		0xf9, 0xdd, 0xa5, 0xc6, 0x6c, 0x8b, 0xb1, 0x56, 0x42, 0x16, 0x95, 0xde, 0x5e, 0xa7, 0xb9, 0x28,
+		0x68, 0x4a, 0xb8, 0xc0, 0x69, 0x5b, 0x43, 0x1b, 0x73, 0x03, 0x0e, 0x70, 0x9b, 0x4a, 0xdb, 0x11,
+		0x4b, 0x53, 0x66, 0x8c, 0x37, 0xbc, 0x61, 0x1a, 0x02, 0xf3, 0x83, 0x84, 0x72, 0xa1, 0x75, 0xbc,
+		0x4f, 0xe1, 0xfa, 0xfa, 0x51, 0x86, 0x53, 0x1a, 0xad, 0xb1, 0xac, 0x49, 0x5b, 0x9b, 0x34, 0x11,
+		0x24, 0x47, 0x08, 0xa6, 0x32, 0x9c, 0x12, 0xd7, 0x99, 0x73, 0x16, 0x6a, 0x81, 0xfa, 0x46, 0x0f,
+		0xa0, 0xd4, 0xc5, 0x49, 0x87, 0xb8, 0x85, 0x39, 0x67, 0xa1, 0xbe, 0x7c, 0xd7, 0x1f, 0xe0, 0x8e,
+		0xdb, 0xd4, 0xef, 0x2e, 0xf9, 0xeb, 0x58, 0xe0, 0xd5, 0x84, 0xed, 0x05, 0x5a, 0xd7, 0xfb, 0xc6,
+		0x01, 0x34, 0xe0, 0xe0, 0x95, 0x14, 0x1f, 0xdb, 0x72, 0xce, 0x6f, 0x0b, 0x3d, 0x83, 0x4a, 0x53,
+		0xd1, 0xe3, 0x6e, 0x61, 0xae, 0xb8, 0x50, 0x5f, 0xfe, 0xb7, 0x3f, 0x2a, 0x7d, 0xfe, 0x90, 0xa0,
+		0x02, 0x8b, 0xf6, 0xb2, 0x13, 0x9c, 0x36, 0x32, 0x91, 0x1f, 0x0d, 0x8d, 0x79, 0x1d, 0xca, 0xca,
+		0xb7, 0xf5, 0x78, 0xff, 0x9c, 0x1e, 0x55, 0x94, 0x81, 0xc1, 0x7a, 0xbf, 0x38, 0x70, 0x63, 0x70,
+		0x9b, 0xe4, 0x9c, 0xb2, 0x0c, 0xb9, 0x50, 0xe9, 0xea, 0x4f, 0xe5, 0xb5, 0x18, 0xd8, 0x25, 0xfa,
+		0x3f, 0xd4, 0x7a, 0x07, 0x6e, 0x12, 0xde, 0xf0, 0xf5, 0x95, 0xf0, 0xed, 0x95, 0xf0, 0x77, 0xad,
+		0x46, 0x70, 0xac, 0x8c, 0x66, 0xa0, 0x8c, 0x3b, 0x62, 0x9f, 0xe5, 0x6e, 0x51, 0x05, 0x62, 0x56,
+		0x52, 0x9e, 0x13, 0xcc, 0x59, 0xe6, 0x4e, 0x69, 0xb9, 0x5e, 0xa1, 0x4d, 0xa8, 0x90, 0x4c, 0xe4,
+		0x94, 0x70, 0xb7, 0x74, 0xa1, 0x18, 0x55, 0xd6, 0x02, 0x0b, 0xf6, 0x7e, 0x74, 0x60, 0xe6, 0xf4,
+		0xfe, 0x3a, 0x6d, 0x36, 0x87, 0x66, 0xf6, 0x05, 0xd4, 0x9b, 0x39, 0x4b, 0xc3, 0x3f, 0x90, 0x5e,
+		0x90, 0x06, 0xd4, 0x27, 0x47, 0x5b, 0x50, 0x13, 0xcc, 0x1a, 0x2b, 0x4e, 0x60, 0xac, 0x2a, 0x98,
+		0x36, 0xe5, 0x7d, 0x06, 0x73, 0xcf, 0x29, 0x17, 0xc3, 0x0e, 0x8c, 0x07, 0xe4, 0x75, 0x87, 0x70,
+		0x81, 0x66, 0xa1, 0x9e, 0xe2, 0xc3, 0x70, 0xf0, 0xf0, 0x20, 0xc5, 0x87, 0xf6, 0x64, 0x6f, 0x43,
+		0xad, 0x8d, 0x5b, 0x24, 0xe4, 0xf4, 0xad, 0x2e, 0x98, 0x52, 0x50, 0x95, 0x82, 0x1d, 0xfa, 0x96,
+		0x78, 0xdf, 0x3b, 0x30, 0x3f, 0xc6, 0x05, 0x6f, 0xb3, 0x8c, 0x13, 0xf4, 0x11, 0x54, 0x8d, 0x7d,
+		0xee, 0x3a, 0x2a, 0x22, 0xff, 0xbc, 0x11, 0x69, 0x58, 0xd0, 0xc3, 0xa3, 0x05, 0xb8, 0x9a, 0x91,
+		0x43, 0x11, 0xf6, 0x93, 0x2e, 0x28, 0xd2, 0xd3, 0x52, 0xfe, 0xa2, 0x47, 0xdc, 0x8b, 0x61, 0x4e,
+		0x9e, 0xd9, 0xd8, 0xe8, 0xe7, 0xe1, 0x92, 0x3e, 0xbb, 0x81, 0xf0, 0xd5, 0x79, 0xda, 0xf8, 0xef,
+		0x02, 0xc8, 0xf3, 0x18, 0x70, 0x55, 0x13, 0xcc, 0x7a, 0xf9, 0xd5, 0x81, 0xf9, 0x31, 0x6e, 0x4c,
+		0x06, 0x56, 0x61, 0x4a, 0xda, 0x34, 0x4d, 0xe2, 0xa2, 0xd1, 0x2b, 0x2c, 0x7a, 0x02, 0x05, 0xc1,
+		0xdc, 0xc2, 0x44, 0x16, 0x0a, 0x82, 0xa1, 0x4d, 0x28, 0xc5, 0xb4, 0xd9, 0xb4, 0x97, 0xea, 0x3f,
+		0x17, 0x29, 0x0e, 0x19, 0x61, 0xa0, 0xe1, 0xde, 0x36, 0xdc, 0x09, 0x58, 0x92, 0xec, 0xe1, 0xe8,
+		0x60, 0x40, 0xd1, 0xe6, 0x74, 0x74, 0x2b, 0x38, 0x2e, 0xdc, 0x42, 0x7f, 0xe1, 0x7a, 0x1f, 0xc0,
+		0xdd, 0x11, 0x16, 0x4d, 0xfa, 0x66, 0xa1, 0x9e, 0x91, 0x37, 0x27, 0x2f, 0x69, 0x46, 0xde, 0xd8,
+		0x53, 0xf8, 0xd2, 0x81, 0xdb, 0x01, 0xe1, 0x2c, 0xe9, 0x92, 0xa1, 0x9c, 0x66, 0xa1, 0x1e, 0x29,
+		0x41, 0xd8, 0x57, 0xbe, 0xa0, 0x45, 0x2f, 0x71, 0xfa, 0x27, 0x76, 0xe4, 0x9f, 0x0a, 0x70, 0x67,
+		0x38, 0x13, 0x13, 0xcb, 0x44, 0x0f, 0xc6, 0x0c, 0x94, 0x39, 0xeb, 0xe4, 0x11, 0xb1, 0x99, 0xd3,
+		0x2b, 0xf4, 0x0a, 0xae, 0xa4, 0x58, 0x44, 0xfb, 0x24, 0x0e, 0x2d, 0xfd, 0xe2, 0x24, 0xf4, 0xa7,
+		0x8d, 0x15, 0xbd, 0xe4, 0xf2, 0xd2, 0x53, 0x1e, 0xc6, 0xa4, 0x89, 0x3b, 0x89, 0x50, 0x6d, 0xb6,
+		0x1a, 0xd4, 0x28, 0x5f, 0xd7, 0x02, 0xb4, 0x0d, 0x10, 0xe1, 0x2c, 0xa6, 0x31, 0x16, 0xbd, 0x66,
+		0x7b, 0xde, 0xfb, 0xb4, 0x66, 0x81, 0x41, 0x9f, 0x0d, 0x19, 0x60, 0x4c, 0x04, 0xa6, 0x89, 0x5b,
+		0xd6, 0x01, 0xea, 0x95, 0xf7, 0xc3, 0xc9, 0x5e, 0xdc, 0x83, 0xff, 0xb5, 0x2f, 0xaf, 0xbc, 0xe5,
+		0x26, 0x67, 0xea, 0x75, 0xaa, 0x06, 0x76, 0xe9, 0x7d, 0x0e, 0x73, 0xeb, 0x84, 0x47, 0x39, 0xdd,
+		0x23, 0x01, 0x69, 0x27, 0x34, 0xc2, 0x82, 0xb2, 0x6c, 0x47, 0x60, 0xd1, 0xe9, 0xf5, 0x9d, 0x7b,
+		0x30, 0x2d, 0x70, 0xde, 0x22, 0x22, 0x8c, 0x92, 0x0e, 0x17, 0x24, 0x37, 0x57, 0xf2, 0xb2, 0x96,
+		0xae, 0x69, 0xa1, 0xec, 0xbd, 0x7c, 0x1f, 0xe7, 0x71, 0x48, 0x63, 0xcd, 0xb7, 0x14, 0x54, 0x95,
+		0x60, 0x2b, 0xd6, 0x29, 0x63, 0x29, 0xa6, 0x99, 0x7d, 0x1e, 0xf5, 0xca, 0x7b, 0x57, 0x80, 0xf9,
+		0x31, 0x04, 0xcc, 0x35, 0xbc, 0x07, 0xd3, 0xfa, 0x0e, 0x9d, 0x64, 0xa0, 0xa5, 0x96, 0xc1, 0x69,
+		0xa2, 0x85, 0x61, 0x44, 0x37, 0xa0, 0xc2, 0x3b, 0x69, 0x8a, 0xf3, 0x23, 0x45, 0xa6, 0xbe, 0xfc,
+		0xaf, 0xd1, 0x69, 0x3d, 0xcd, 0xc9, 0x62, 0xd1, 0x87, 0x50, 0x56, 0xe1, 0x71, 0x77, 0xea, 0xac,
+		0x3b, 0xb5, 0x23, 0xf5, 0x4e, 0x9b, 0x32, 0x78, 0xef, 0x10, 0x66, 0x86, 0x6b, 0xa0, 0x5b, 0x50,
+		0xb5, 0x39, 0x55, 0x21, 0x97, 0x82, 0x8a, 0x49, 0x29, 0x5a, 0x83, 0x32, 0x57, 0x4a, 0x6e, 0xe1,
+		0xe2, 0x41, 0x18, 0xa8, 0xf7, 0x5b, 0x01, 0xae, 0x9d, 0xf6, 0xea, 0xc1, 0x65, 0x1c, 0x1d, 0x90,
+		0x38, 0x94, 0x53, 0xab, 0x75, 0x5d, 0x0c, 0xea, 0x4a, 0xb8, 0x8b, 0xf9, 0xc1, 0x56, 0x8c, 0xfe,
+		0xa1, 0x9f, 0x62, 0xab, 0x61, 0x9e, 0x9a, 0x14, 0x1f, 0x9a, 0xfd, 0x5b, 0x50, 0x55, 0x7b, 0x09,
+		0x6e, 0xa9, 0x2c, 0x17, 0x83, 0x8a, 0x5c, 0x3f, 0xc7, 0x2d, 0x74, 0x1f, 0x90, 0xdd, 0x0a, 0x45,
+		0xde, 0xc9, 0x22, 0x2c, 0x48, 0x6c, 0xea, 0xf6, 0xaa, 0x51, 0xda, 0xb5, 0x72, 0xb4, 0x03, 0x2e,
+		0x4b, 0x62, 0xc2, 0x45, 0xd8, 0x26, 0x59, 0x4c, 0xb3, 0x96, 0xf6, 0x29, 0x27, 0x2f, 0xb7, 0x74,
+		0xe6, 0x84, 0x76, 0x53, 0x63, 0xb7, 0x35, 0x54, 0x72, 0x93, 0x7b, 0xb2, 0xc5, 0x4a, 0xef, 0x9c,
+		0x44, 0x2c, 0x8b, 0xb9, 0x2a, 0xe3, 0x62, 0x00, 0x09, 0x6e, 0xed, 0x68, 0x89, 0xa4, 0x1f, 0x27,
+		0xaf, 0xf5, 0x1c, 0x51, 0xd1, 0xf4, 0xe3, 0xe4, 0xb5, 0x1c, 0x23, 0xd0, 0x73, 0xa8, 0x34, 0x89,
+		0xac, 0x9e, 0xdc, 0xad, 0x2a, 0xff, 0xcb, 0xe7, 0xca, 0xfc, 0xa6, 0xc6, 0xd8, 0x5b, 0x64, 0x4c,
+		0xc8, 0x21, 0xd5, 0x1d, 0xa5, 0x85, 0x56, 0xe1, 0x4a, 0x82, 0xb9, 0x08, 0x95, 0xb2, 0x0e, 0xd9,
+		0x39, 0x33, 0xe4, 0xcb, 0x12, 0xa2, 0xec, 0xd8, 0x50, 0x49, 0x9e, 0xb3, 0x3c, 0x8c, 0x58, 0x27,
+		0x13, 0xe6, 0xa0, 0x40, 0x89, 0xd6, 0xa4, 0x44, 0xb6, 0x4f, 0xe5, 0x44, 0x89, 0x4c, 0x79, 0xd6,
+		0xa4, 0x64, 0x43, 0x0a, 0x7a, 0x1c, 0xb4, 0x11, 0xc5, 0x61, 0xea, 0x7c, 0x1c, 0x14, 0x5e, 0xca,
+		0xe4, 0x3b, 0xd3, 0x78, 0xc1, 0xba, 0x44, 0xe6, 0x5f, 0x4e, 0x60, 0xab, 0x38, 0x3a, 0x48, 0x58,
+		0xef, 0xc1, 0x3b, 0x6e, 0x0e, 0x4e, 0x7f, 0x73, 0x40, 0x8f, 0xa1, 0xa6, 0x2f, 0x0a, 0xe5, 0x62,
+		0xec, 0xcf, 0x1f, 0x6b, 0x37, 0xa8, 0x0a, 0xf3, 0x85, 0x9e, 0xc1, 0x74, 0x0f, 0x1b, 0x8a, 0xa3,
+		0x36, 0x51, 0x91, 0x4d, 0x2f, 0xcf, 0x8f, 0x35, 0xb0, 0x7b, 0xd4, 0x26, 0xc1, 0x25, 0xd1, 0xb7,
+		0x42, 0x1f, 0xc3, 0x4d, 0x79, 0x83, 0x68, 0xa6, 0xce, 0x27, 0x3c, 0x26, 0x34, 0x75, 0x1e, 0x42,
+		0xd7, 0xfb, 0xb0, 0x56, 0xa8, 0x6a, 0x87, 0x66, 0xbd, 0xda, 0x29, 0x99, 0xda, 0xa1, 0xd9, 0xf0,
+		0xda, 0x2a, 0x9f, 0xa8, 0x2d, 0xef, 0x09, 0xdc, 0x1e, 0x9a, 0xcd, 0xe3, 0x01, 0x24, 0x65, 0x5d,
+		0x53, 0xbe, 0xbc, 0x37, 0x25, 0x4b, 0x91, 0x84, 0x70, 0xef, 0x67, 0x39, 0x06, 0x92, 0x84, 0x88,
+		0x93, 0x26, 0xd4, 0xf6, 0xdf, 0xe2, 0x54, 0x6c, 0x7b, 0xa1, 0xa6, 0xfd, 0x9a, 0xf6, 0xb2, 0x15,
+		0x73, 0xef, 0x25, 0x78, 0xe3, 0x82, 0x33, 0x49, 0x5a, 0x80, 0xab, 0xb1, 0xd2, 0xea, 0x75, 0x39,
+		0x3d, 0xee, 0x17, 0x83, 0x69, 0x23, 0xd7, 0xc9, 0xe6, 0xcb, 0x5f, 0x57, 0xe1, 0xda, 0x53, 0xf9,
+		0xe7, 0xc1, 0xc6, 0xa1, 0x20, 0x19, 0xa7, 0x2c, 0x7b, 0xba, 0xbd, 0x85, 0xbe, 0x75, 0xe0, 0xd6,
+		0xc8, 0x1f, 0x13, 0xe8, 0xf1, 0xe8, 0x96, 0x70, 0xd6, 0x8f, 0x9c, 0xc6, 0xca, 0x44, 0x58, 0x13,
+		0x96, 0xa4, 0x35, 0x72, 0xc2, 0x1f, 0x47, 0xeb, 0xac, 0x5f, 0x1f, 0x8d, 0x95, 0x89, 0xb0, 0x86,
+		0xd6, 0x57, 0x0e, 0xdc, 0x1c, 0x3a, 0x35, 0xa3, 0x47, 0x63, 0x9a, 0xe7, 0x98, 0xc1, 0xbd, 0xf1,
+		0xbf, 0x0b, 0xe3, 0x0c, 0x95, 0x2f, 0x1c, 0xb8, 0x31, 0x6c, 0xe6, 0x45, 0x0f, 0xc7, 0x58, 0x1c,
+		0x3d, 0xad, 0x37, 0x1e, 0x5d, 0x14, 0xd6, 0x7f, 0x52, 0xa3, 0x26, 0x9f, 0xb1, 0x27, 0x75, 0xc6,
+		0xbc, 0xd6, 0x58, 0x99, 0x08, 0x6b, 0x68, 0xbd, 0x73, 0xe0, 0xfa, 0x90, 0xe6, 0x82, 0xfe, 0x3b,
+		0xda, 0xe8, 0xe8, 0xce, 0xde, 0x78, 0x78, 0x41, 0x94, 0x21, 0xf1, 0x9d, 0x03, 0x8d, 0xd1, 0x35,
+		0x8c, 0xc6, 0x06, 0x78, 0x46, 0x5b, 0x6b, 0xbc, 0x3f, 0x19, 0x58, 0x33, 0x5b, 0x5d, 0xf9, 0xe4,
+		0xbd, 0x16, 0x15, 0xfb, 0x9d, 0x3d, 0x3f, 0x62, 0xe9, 0xe2, 0xc0, 0x3f, 0x7d, 0x7e, 0x8b, 0x64,
+		0xfa, 0x7f, 0xc3, 0xfe, 0xff, 0x1d, 0x57, 0xec, 0x77, 0x77, 0x69, 0xaf, 0xac, 0x76, 0x1f, 0xfc,
+		0x3e, 0x00, 0x10, 0x82, 0x50, 0xaf, 0xa5, 0x14, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/common.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5d, 0x73, 0xdb, 0x44,
+		0x17, 0x7e, 0x65, 0xd7, 0xf9, 0x38, 0x4e, 0x13, 0x65, 0xd3, 0x26, 0x4e, 0xda, 0xbc, 0x6f, 0xea,
+		0x17, 0x68, 0xc8, 0x80, 0x3d, 0x71, 0x6f, 0x3a, 0x40, 0x01, 0xd5, 0x1f, 0x89, 0x5a, 0x63, 0x9b,
+		0xb5, 0xdb, 0x50, 0x98, 0xa9, 0x66, 0x2d, 0xad, 0xdd, 0x25, 0xb2, 0x56, 0x48, 0x2b, 0x37, 0xee,
+		0x05, 0xc3, 0x0d, 0x7f, 0x83, 0x0b, 0xae, 0xf9, 0x27, 0x5c, 0xf2, 0x87, 0x98, 0x95, 0x56, 0xb1,
+		0x9d, 0xb8, 0x94, 0x0b, 0x86, 0x3b, 0xed, 0x79, 0x9e, 0xf3, 0xb1, 0x47, 0xe7, 0x63, 0xe1, 0x20,
+		0xea, 0xd3, 0xa0, 0x6c, 0x13, 0x87, 0x7a, 0x36, 0x2d, 0x13, 0x9f, 0x95, 0xc7, 0xc7, 0x65, 0x9b,
+		0x8f, 0x46, 0xdc, 0x2b, 0xf9, 0x01, 0x17, 0x1c, 0x6d, 0x49, 0x46, 0x49, 0x31, 0x4a, 0xc4, 0x67,
+		0xa5, 0xf1, 0xf1, 0xde, 0x7f, 0x87, 0x9c, 0x0f, 0x5d, 0x5a, 0x8e, 0x29, 0xfd, 0x68, 0x50, 0x76,
+		0xa2, 0x80, 0x08, 0x96, 0x2a, 0x15, 0x9f, 0xc2, 0xe6, 0x19, 0x0f, 0xce, 0x07, 0x2e, 0x7f, 0x5d,
+		0xbf, 0xa0, 0x76, 0x24, 0x21, 0xf4, 0x3f, 0xc8, 0xbf, 0x56, 0x42, 0x8b, 0x39, 0x05, 0xed, 0x40,
+		0x3b, 0x5c, 0xc5, 0x90, 0x8a, 0x4c, 0x07, 0xdd, 0x86, 0xa5, 0x20, 0xf2, 0x24, 0x96, 0x89, 0xb1,
+		0x5c, 0x10, 0x79, 0xa6, 0x53, 0x2c, 0xc2, 0x5a, 0x6a, 0xac, 0x37, 0xf1, 0x29, 0x42, 0x70, 0xc3,
+		0x23, 0x23, 0xaa, 0x0c, 0xc4, 0xdf, 0x92, 0x63, 0xd8, 0x82, 0x8d, 0x99, 0x98, 0xbc, 0x95, 0xb3,
+		0x0f, 0xcb, 0x1d, 0x32, 0x71, 0x39, 0x71, 0x24, 0xec, 0x10, 0x41, 0x62, 0x78, 0x0d, 0xc7, 0xdf,
+		0xc5, 0x37, 0xb0, 0xdc, 0x20, 0xcc, 0x8d, 0x02, 0x8a, 0xb6, 0x61, 0x29, 0xa0, 0x24, 0xe4, 0x9e,
+		0xd2, 0x57, 0x27, 0x54, 0x80, 0x65, 0x87, 0x0a, 0xc2, 0xdc, 0x30, 0x8e, 0x70, 0x0d, 0xa7, 0x47,
+		0xf4, 0x08, 0x96, 0xb9, 0x2f, 0x6f, 0x19, 0x16, 0xb2, 0x07, 0xda, 0x61, 0xbe, 0xf2, 0xff, 0xd2,
+		0x82, 0xbc, 0x95, 0x94, 0x83, 0x76, 0x42, 0xc5, 0xa9, 0x4e, 0xf1, 0x37, 0x0d, 0xd6, 0xe7, 0x31,
+		0xd4, 0x06, 0x7d, 0x90, 0x48, 0x2c, 0x9b, 0x08, 0x3a, 0xe4, 0xc1, 0x24, 0x8e, 0x66, 0xbd, 0xf2,
+		0xde, 0x5f, 0x99, 0xae, 0x2a, 0x2e, 0xde, 0x18, 0xcc, 0x0b, 0x90, 0x09, 0x5b, 0x1e, 0xbd, 0x10,
+		0x56, 0x40, 0x45, 0x30, 0xb1, 0x98, 0x27, 0x68, 0x30, 0x26, 0x6e, 0x7c, 0x91, 0x7c, 0x65, 0xb7,
+		0x94, 0xfc, 0xd1, 0x52, 0xfa, 0x47, 0x4b, 0x35, 0xf5, 0x47, 0xf1, 0xa6, 0xd4, 0xc2, 0x52, 0xc9,
+		0x54, 0x3a, 0xc5, 0x5f, 0x34, 0xb8, 0xf1, 0x15, 0x1d, 0x71, 0xf4, 0x08, 0x96, 0x06, 0x8c, 0xba,
+		0x4e, 0x58, 0xd0, 0x0e, 0xb2, 0x87, 0xf9, 0xca, 0xfb, 0x0b, 0x43, 0x93, 0xd4, 0x52, 0x23, 0xe6,
+		0xd5, 0x3d, 0x11, 0x4c, 0xb0, 0x52, 0xda, 0x3b, 0x83, 0xfc, 0x8c, 0x18, 0xe9, 0x90, 0x3d, 0xa7,
+		0x13, 0x95, 0x73, 0xf9, 0x89, 0x2a, 0x90, 0x1b, 0x13, 0x37, 0xa2, 0x2a, 0xca, 0xbb, 0x0b, 0xcd,
+		0xab, 0x9f, 0x8a, 0x13, 0xea, 0x27, 0x99, 0x87, 0x5a, 0xf1, 0x57, 0x0d, 0x96, 0x4e, 0x29, 0x71,
+		0x68, 0x80, 0xbe, 0xb8, 0x12, 0xe2, 0xfd, 0x85, 0x36, 0x12, 0xf2, 0xbf, 0x1b, 0xe4, 0x1f, 0x1a,
+		0xe8, 0x5d, 0x4a, 0x02, 0xfb, 0x95, 0x21, 0x44, 0xc0, 0xfa, 0x91, 0xa0, 0x21, 0xb2, 0x60, 0x9d,
+		0x79, 0x0e, 0xbd, 0xa0, 0x8e, 0x35, 0x17, 0xf6, 0xc3, 0x85, 0x56, 0xaf, 0xaa, 0x97, 0xcc, 0x44,
+		0x77, 0xf6, 0x1e, 0x37, 0xd9, 0xac, 0x6c, 0xef, 0x25, 0xa0, 0xeb, 0xa4, 0x7f, 0xf0, 0x56, 0x03,
+		0x58, 0xa9, 0x11, 0x41, 0x1e, 0xbb, 0xbc, 0x8f, 0x1a, 0x70, 0x93, 0x7a, 0x36, 0x77, 0x98, 0x37,
+		0xb4, 0xc4, 0xc4, 0xa7, 0xaa, 0x80, 0xef, 0x2d, 0xb4, 0x55, 0x57, 0x4c, 0xd9, 0xbf, 0x78, 0x8d,
+		0xce, 0x9c, 0x2e, 0xdb, 0x35, 0x33, 0xd3, 0xae, 0x9d, 0x64, 0xc4, 0xd0, 0xe0, 0x39, 0x0d, 0x42,
+		0xc6, 0x3d, 0xd3, 0x1b, 0x70, 0x49, 0x64, 0x23, 0xdf, 0x4d, 0xdb, 0x5e, 0x7e, 0xa3, 0xfb, 0xb0,
+		0x31, 0xa0, 0x44, 0xc8, 0x46, 0x1a, 0x27, 0x54, 0x35, 0x5e, 0xd6, 0x95, 0x58, 0x19, 0x28, 0x3e,
+		0x85, 0x9d, 0x6e, 0xe4, 0xfb, 0x3c, 0x10, 0xd4, 0xa9, 0xba, 0x8c, 0x7a, 0x42, 0x21, 0xa1, 0x9c,
+		0x4c, 0x43, 0x6e, 0x85, 0xce, 0xb9, 0xb2, 0x9c, 0x1b, 0xf2, 0xae, 0x73, 0x8e, 0x76, 0x61, 0xe5,
+		0x7b, 0x32, 0x26, 0x31, 0x90, 0xd8, 0x5c, 0x96, 0xe7, 0xae, 0x73, 0x5e, 0xfc, 0x29, 0x0b, 0xf9,
+		0xb8, 0x69, 0x3a, 0xdc, 0x65, 0xf6, 0x04, 0xd5, 0x40, 0x67, 0x1e, 0x13, 0x8c, 0xb8, 0xd3, 0xd6,
+		0xd3, 0xde, 0xd5, 0x7a, 0x1b, 0x4a, 0x25, 0x6d, 0x3c, 0x54, 0x86, 0xad, 0x3e, 0xb1, 0xcf, 0xf9,
+		0x60, 0x60, 0xd9, 0x9c, 0x0e, 0x06, 0xcc, 0x96, 0x61, 0xc6, 0xbe, 0x35, 0x8c, 0x14, 0x54, 0x9d,
+		0x22, 0xd2, 0xed, 0x88, 0x5c, 0xb0, 0x51, 0x34, 0x9a, 0xba, 0xcd, 0xbe, 0xd3, 0xad, 0x52, 0xb9,
+		0x74, 0xfb, 0xe1, 0xd4, 0x0a, 0x11, 0x82, 0x8e, 0x7c, 0x11, 0x16, 0x6e, 0x1c, 0x68, 0x87, 0xb9,
+		0x4b, 0xaa, 0xa1, 0xc4, 0xe8, 0x11, 0xdc, 0xf1, 0xb8, 0x97, 0x0c, 0x19, 0xd2, 0x77, 0xa9, 0x45,
+		0x83, 0x80, 0x07, 0x56, 0x32, 0x40, 0xc3, 0x42, 0xee, 0x20, 0x7b, 0xb8, 0x8a, 0x0b, 0x1e, 0xf7,
+		0x70, 0xca, 0xa8, 0x4b, 0x02, 0x4e, 0x70, 0xf4, 0x04, 0xb6, 0xe8, 0x85, 0xcf, 0x92, 0x40, 0xa6,
+		0x21, 0x2f, 0xbd, 0x2b, 0x64, 0x34, 0xd5, 0xba, 0x9c, 0x52, 0x23, 0xd8, 0x31, 0x43, 0xee, 0xc6,
+		0xc2, 0x93, 0x80, 0x47, 0x7e, 0x87, 0x04, 0x82, 0xc5, 0xab, 0x68, 0xc1, 0x7a, 0x40, 0x9f, 0x43,
+		0x2e, 0x14, 0x44, 0x24, 0x05, 0xbf, 0x5e, 0x39, 0x5c, 0x58, 0xa4, 0xf3, 0x06, 0xbb, 0x92, 0x8f,
+		0x13, 0xb5, 0xe2, 0x18, 0xee, 0xcc, 0xa3, 0x55, 0xee, 0x0d, 0xd8, 0x50, 0x45, 0x88, 0xce, 0x40,
+		0x67, 0x29, 0x6c, 0x0d, 0x25, 0x9e, 0xb6, 0xf6, 0x47, 0x7f, 0xc3, 0xd3, 0x65, 0xe8, 0x78, 0x83,
+		0xcd, 0x01, 0x61, 0xf1, 0x77, 0x0d, 0xf6, 0x8c, 0x70, 0xe2, 0xd9, 0xe9, 0x92, 0x9c, 0xf7, 0x5b,
+		0x80, 0x65, 0xea, 0xc9, 0x3c, 0x27, 0x1b, 0x77, 0x05, 0xa7, 0x47, 0x54, 0x81, 0xdb, 0x7e, 0x40,
+		0x1d, 0x3a, 0x60, 0x1e, 0x75, 0xac, 0x1f, 0x22, 0x1a, 0x51, 0x2b, 0xce, 0x4a, 0x52, 0xca, 0x5b,
+		0x53, 0xf0, 0x6b, 0x89, 0xb5, 0x64, 0x92, 0xf6, 0x01, 0x12, 0x62, 0xdc, 0xce, 0xd9, 0x98, 0xb8,
+		0x1a, 0x4b, 0xe2, 0x46, 0xfd, 0x12, 0xd6, 0x12, 0xd8, 0x8e, 0x63, 0x88, 0x8b, 0x24, 0x5f, 0xd9,
+		0x5f, 0x78, 0xc1, 0x74, 0x4a, 0xe0, 0x7c, 0xac, 0x92, 0x44, 0x5d, 0x0c, 0xe0, 0x6e, 0xbc, 0xc8,
+		0x69, 0xd5, 0x8d, 0x42, 0x41, 0x83, 0x2e, 0x75, 0xa9, 0x2d, 0x2f, 0xa2, 0xfa, 0x08, 0xc3, 0xa6,
+		0x9d, 0x20, 0xb2, 0x14, 0x93, 0xb1, 0xa7, 0xdc, 0x2c, 0x5e, 0x3e, 0xca, 0xce, 0xe5, 0x8c, 0xc4,
+		0xba, 0x7d, 0x45, 0x52, 0xfc, 0x0c, 0xf4, 0xab, 0x2c, 0x74, 0x0b, 0x72, 0xa1, 0xcd, 0xfd, 0xb4,
+		0x44, 0x92, 0xc3, 0x65, 0xdd, 0x64, 0x66, 0x9e, 0x15, 0xdf, 0xc0, 0x66, 0x87, 0x0c, 0x99, 0x17,
+		0xa7, 0x3b, 0xdd, 0xde, 0x77, 0x60, 0xd5, 0x27, 0x43, 0x6a, 0x85, 0xec, 0x4d, 0x62, 0x22, 0x87,
+		0x57, 0xa4, 0xa0, 0xcb, 0xde, 0x50, 0xf4, 0x01, 0x6c, 0xc4, 0x9b, 0x38, 0x66, 0x08, 0x7e, 0x4e,
+		0x3d, 0x35, 0xd9, 0x6e, 0x4a, 0x71, 0x87, 0x0c, 0x69, 0x4f, 0x0a, 0x8f, 0x7e, 0xd6, 0x60, 0xe3,
+		0xca, 0x5a, 0x47, 0x77, 0xa1, 0xd0, 0x30, 0xcc, 0xe6, 0x33, 0x5c, 0xb7, 0xaa, 0x46, 0xaf, 0x7e,
+		0xd2, 0xc6, 0x2f, 0x2c, 0xb3, 0xf5, 0xdc, 0x68, 0x9a, 0x35, 0xfd, 0x3f, 0x68, 0x17, 0x6e, 0x5f,
+		0x43, 0x3b, 0xed, 0x66, 0x53, 0xd7, 0xd0, 0x3e, 0xec, 0x5e, 0x83, 0xba, 0x3d, 0xa3, 0x55, 0x33,
+		0x70, 0x4d, 0xcf, 0xa0, 0x3d, 0xd8, 0xbe, 0x06, 0x37, 0x8c, 0x9e, 0xd1, 0xd4, 0xb3, 0x47, 0xaf,
+		0x61, 0x6d, 0x76, 0x38, 0x4b, 0x2f, 0xf5, 0x56, 0xb5, 0x5d, 0x33, 0x5b, 0x27, 0x56, 0xef, 0x45,
+		0xa7, 0x3e, 0x13, 0xc0, 0x1e, 0x6c, 0xcf, 0x43, 0xbd, 0x53, 0x6c, 0x36, 0x7a, 0xf8, 0x4c, 0xd7,
+		0xd0, 0x36, 0xa0, 0x79, 0xec, 0x49, 0xb7, 0xdd, 0xd2, 0x33, 0xa8, 0x00, 0xb7, 0xe6, 0xe5, 0x1d,
+		0xdc, 0xee, 0xb5, 0x1f, 0xe8, 0xd9, 0xa3, 0x1f, 0x61, 0x6b, 0x41, 0xc3, 0xa1, 0x7b, 0xb0, 0x6f,
+		0x76, 0xdb, 0x4d, 0xa3, 0x67, 0xb6, 0x5b, 0xd6, 0x09, 0x6e, 0x3f, 0xeb, 0xc8, 0x9b, 0xf4, 0x66,
+		0xe3, 0x78, 0x2b, 0xe5, 0xb4, 0x6e, 0x34, 0x7b, 0xa7, 0x2f, 0x74, 0xed, 0xed, 0x94, 0x1a, 0x36,
+		0xcc, 0x56, 0xbd, 0xa6, 0x67, 0x8e, 0x5e, 0xc2, 0x9a, 0xcc, 0x3f, 0x1f, 0xd3, 0x20, 0xbd, 0xb8,
+		0x4c, 0x52, 0xfb, 0x79, 0x1d, 0x5f, 0xbd, 0xf8, 0x0e, 0x6c, 0xcd, 0x43, 0x8d, 0x36, 0xae, 0xd6,
+		0x75, 0x2d, 0x4d, 0xec, 0x14, 0x38, 0xc1, 0x46, 0xb5, 0xde, 0x78, 0xd6, 0xd4, 0x33, 0x8f, 0xbf,
+		0x83, 0x1d, 0x9b, 0x8f, 0x16, 0x95, 0xed, 0xe3, 0x7c, 0x35, 0x7e, 0x84, 0x77, 0xe4, 0xa4, 0xeb,
+		0x68, 0xdf, 0x1e, 0x0f, 0x99, 0x78, 0x15, 0xf5, 0x4b, 0x36, 0x1f, 0x95, 0x67, 0x9f, 0xec, 0x1f,
+		0x33, 0xc7, 0x2d, 0x0f, 0x79, 0xf2, 0x10, 0x57, 0xef, 0xf7, 0x4f, 0x89, 0xcf, 0xc6, 0xc7, 0xfd,
+		0xa5, 0x58, 0xf6, 0xe0, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0x45, 0xc2, 0x08, 0xe3, 0x0b,
+		0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/tasklist.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xdb, 0x36,
+		0x1b, 0xfe, 0xe4, 0x9f, 0xd6, 0x79, 0xdd, 0x24, 0x2a, 0x9b, 0x34, 0xb6, 0xdb, 0x7e, 0x73, 0x7d,
+		0x50, 0x64, 0xc5, 0x26, 0x23, 0xd9, 0x06, 0x0c, 0xdb, 0xd0, 0xd5, 0x89, 0x8d, 0x56, 0x88, 0x93,
+		0x1a, 0xb2, 0xd6, 0xa1, 0x03, 0x06, 0x81, 0x96, 0x58, 0x87, 0xb3, 0x24, 0x0a, 0x22, 0x65, 0xd7,
+		0x27, 0xbb, 0x8c, 0x9d, 0xed, 0x46, 0x76, 0x0f, 0xbb, 0xa7, 0x81, 0x94, 0xec, 0xfa, 0x47, 0x09,
+		0xd6, 0x83, 0x9d, 0x99, 0xef, 0xc3, 0xe7, 0x7d, 0xde, 0x5f, 0x5a, 0xd0, 0x4a, 0x46, 0x24, 0x6e,
+		0xbb, 0xd8, 0x23, 0xa1, 0x4b, 0xda, 0x38, 0xa2, 0xed, 0xe9, 0x49, 0x5b, 0x60, 0x3e, 0xf1, 0x29,
+		0x17, 0x46, 0x14, 0x33, 0xc1, 0xd0, 0x03, 0x79, 0xc7, 0xc8, 0xee, 0x18, 0x38, 0xa2, 0xc6, 0xf4,
+		0xa4, 0xf1, 0xff, 0x31, 0x63, 0x63, 0x9f, 0xb4, 0xd5, 0x95, 0x51, 0xf2, 0xbe, 0xed, 0x25, 0x31,
+		0x16, 0x94, 0x85, 0x29, 0xa9, 0xf1, 0xd9, 0x26, 0x2e, 0x68, 0x40, 0xb8, 0xc0, 0x41, 0x94, 0x5d,
+		0xd8, 0x72, 0x30, 0x8b, 0x71, 0x14, 0x91, 0x98, 0xa7, 0x78, 0x2b, 0x86, 0x8a, 0x8d, 0xf9, 0xa4,
+		0x4f, 0xb9, 0x40, 0x08, 0x4a, 0x21, 0x0e, 0x48, 0x4d, 0x6b, 0x6a, 0xc7, 0x3b, 0x96, 0xfa, 0x8d,
+		0xbe, 0x81, 0xd2, 0x84, 0x86, 0x5e, 0xad, 0xd0, 0xd4, 0x8e, 0xf7, 0x4e, 0x9f, 0x1a, 0x39, 0x41,
+		0x1a, 0x0b, 0x07, 0x17, 0x34, 0xf4, 0x2c, 0x75, 0x1d, 0x3d, 0x82, 0x9d, 0x11, 0xe6, 0xc4, 0x51,
+		0xfe, 0x8a, 0xca, 0x5f, 0x45, 0x1a, 0xae, 0x70, 0x40, 0x5a, 0x18, 0xf4, 0x05, 0xe5, 0x92, 0x08,
+		0xec, 0x61, 0x81, 0xd1, 0x25, 0x1c, 0x04, 0xf8, 0x83, 0x23, 0x6b, 0xc2, 0x9d, 0x88, 0xc4, 0x0e,
+		0x27, 0x2e, 0x0b, 0x3d, 0x15, 0x4b, 0xf5, 0xf4, 0xb1, 0x91, 0xa6, 0x61, 0x2c, 0xd2, 0x30, 0xba,
+		0x2c, 0x19, 0xf9, 0xe4, 0x2d, 0xf6, 0x13, 0x62, 0xdd, 0x0f, 0xf0, 0x07, 0xe9, 0x90, 0x0f, 0x48,
+		0x3c, 0x54, 0xb4, 0xd6, 0x4f, 0x50, 0x5f, 0x48, 0x0c, 0x70, 0x2c, 0xa8, 0x2c, 0xd9, 0x52, 0x4b,
+		0x87, 0xe2, 0x84, 0xcc, 0xb3, 0x34, 0xe5, 0x4f, 0xf4, 0x0c, 0xf6, 0xd9, 0x2c, 0x24, 0xb1, 0x73,
+		0xcd, 0xb8, 0x48, 0x83, 0x2e, 0x28, 0x74, 0x57, 0x99, 0x5f, 0x33, 0x2e, 0x54, 0xe4, 0x13, 0x38,
+		0x34, 0x39, 0xf3, 0x55, 0x07, 0x5e, 0xc5, 0x2c, 0x89, 0x2e, 0x89, 0x88, 0xa9, 0xcb, 0x51, 0x1b,
+		0x0e, 0x42, 0x32, 0xcb, 0x0f, 0x5f, 0xb3, 0xee, 0x87, 0x64, 0xb6, 0x1e, 0x20, 0x7a, 0x0a, 0xf7,
+		0x22, 0xe6, 0xfb, 0x24, 0x76, 0x5c, 0x96, 0x84, 0x42, 0xc9, 0x15, 0xad, 0x6a, 0x6a, 0x3b, 0x97,
+		0xa6, 0xd6, 0x9f, 0x25, 0xd8, 0x5b, 0x24, 0x31, 0x14, 0x58, 0x24, 0x1c, 0x7d, 0x01, 0x68, 0x84,
+		0xdd, 0x89, 0xcf, 0xc6, 0x29, 0xcd, 0xb9, 0xa6, 0xa1, 0x50, 0x22, 0x45, 0x4b, 0xcf, 0x10, 0x45,
+		0x7e, 0x4d, 0x43, 0x81, 0x9e, 0x00, 0xc4, 0x04, 0x7b, 0x8e, 0x4f, 0xa6, 0xc4, 0xcf, 0x14, 0x76,
+		0xa4, 0xa5, 0x2f, 0x0d, 0xb2, 0x47, 0xd8, 0x9d, 0x64, 0x68, 0x51, 0xa1, 0x15, 0xec, 0x4e, 0x52,
+		0xf0, 0x19, 0xec, 0xc7, 0x58, 0x90, 0xd5, 0x5c, 0x4a, 0x2a, 0x97, 0x5d, 0x69, 0xfe, 0x98, 0x47,
+		0x17, 0x76, 0x65, 0xd2, 0x0e, 0xf5, 0x9c, 0x91, 0xcf, 0xdc, 0x49, 0xad, 0xac, 0x1a, 0xd6, 0xbc,
+		0x71, 0x50, 0xcc, 0xee, 0x99, 0xbc, 0x67, 0x55, 0x25, 0xcd, 0xf4, 0xd4, 0x01, 0x4d, 0xe1, 0x88,
+		0x2e, 0xea, 0xea, 0x8c, 0x65, 0x61, 0x9d, 0x20, 0xad, 0x6c, 0xed, 0x4e, 0xb3, 0x78, 0x5c, 0x3d,
+		0x7d, 0x71, 0xeb, 0xe0, 0xa5, 0xd5, 0x31, 0x72, 0x5b, 0xd3, 0x0b, 0x45, 0x3c, 0xb7, 0x0e, 0xe9,
+		0x27, 0xb5, 0xed, 0xee, 0x4d, 0x6d, 0x3b, 0x80, 0x32, 0x09, 0x22, 0x31, 0xaf, 0x55, 0x9a, 0xda,
+		0x71, 0xc5, 0x4a, 0x0f, 0x0d, 0x01, 0x8d, 0x9b, 0xb5, 0x73, 0xc6, 0xed, 0x25, 0x94, 0xa7, 0x72,
+		0x72, 0x55, 0x4f, 0xaa, 0xa7, 0xcf, 0x73, 0x93, 0xcb, 0xf5, 0x68, 0xa5, 0xc4, 0xef, 0x0a, 0xdf,
+		0x6a, 0xad, 0x1f, 0xa1, 0xba, 0x52, 0x50, 0x54, 0x87, 0x0a, 0x17, 0x38, 0x16, 0x0e, 0xf5, 0xb2,
+		0x89, 0xb8, 0xab, 0xce, 0xa6, 0x87, 0x0e, 0xe1, 0x0e, 0x09, 0x3d, 0x09, 0xa4, 0x43, 0x50, 0x26,
+		0xa1, 0x67, 0x7a, 0xad, 0x3f, 0x34, 0x80, 0x81, 0x1a, 0x38, 0x33, 0x7c, 0xcf, 0x50, 0x17, 0x74,
+		0x1f, 0x73, 0xe1, 0x60, 0xd7, 0x25, 0x9c, 0x3b, 0xf2, 0x25, 0xc9, 0xd6, 0xaf, 0xb1, 0xb5, 0x7e,
+		0xf6, 0xe2, 0x99, 0xb1, 0xf6, 0x24, 0xa7, 0xa3, 0x28, 0xd2, 0x88, 0x1a, 0x50, 0xa1, 0x1e, 0x09,
+		0x05, 0x15, 0xf3, 0x6c, 0x87, 0x96, 0xe7, 0xbc, 0xa1, 0x2a, 0xe6, 0x0c, 0x55, 0xeb, 0x2f, 0x0d,
+		0xea, 0x43, 0x41, 0xdd, 0xc9, 0xbc, 0xf7, 0x81, 0xb8, 0x89, 0x2c, 0x42, 0x47, 0x88, 0x98, 0x8e,
+		0x12, 0x41, 0x38, 0x7a, 0x05, 0xfa, 0x8c, 0xc5, 0x13, 0x12, 0xab, 0xbe, 0x39, 0xf2, 0x09, 0xcd,
+		0xe2, 0x7c, 0x72, 0xeb, 0x94, 0x58, 0x7b, 0x29, 0x6d, 0xf9, 0xde, 0xd9, 0x50, 0xe7, 0xee, 0x35,
+		0xf1, 0x12, 0x9f, 0x38, 0x82, 0x39, 0x69, 0xf5, 0x64, 0xda, 0x2c, 0x11, 0x59, 0x6b, 0xea, 0xdb,
+		0x0f, 0x4f, 0xf6, 0x00, 0x5b, 0x0f, 0x17, 0x5c, 0x9b, 0x0d, 0x25, 0xd3, 0x4e, 0x89, 0xad, 0x17,
+		0x70, 0x7f, 0xeb, 0xe9, 0x41, 0x9f, 0x83, 0xbe, 0x31, 0xe0, 0xbc, 0xa6, 0x35, 0x8b, 0xc7, 0x3b,
+		0xd6, 0xfe, 0xfa, 0x64, 0xf2, 0xd6, 0xdf, 0x25, 0x38, 0xda, 0x72, 0x70, 0xce, 0xc2, 0xf7, 0x74,
+		0x8c, 0x6a, 0x70, 0x77, 0x4a, 0x62, 0x4e, 0x59, 0xb8, 0x68, 0x71, 0x76, 0x44, 0xa7, 0xf0, 0x20,
+		0x4c, 0x02, 0x47, 0xed, 0x7b, 0xb4, 0x60, 0x71, 0x95, 0x45, 0xf9, 0xac, 0x50, 0x93, 0xc3, 0x9c,
+		0x04, 0x16, 0xc1, 0xde, 0xd2, 0x25, 0x47, 0x5f, 0xc3, 0x81, 0xe4, 0xcc, 0x62, 0x2a, 0x7b, 0xf2,
+		0x91, 0x54, 0x5c, 0x92, 0x50, 0x98, 0x04, 0x3f, 0x4b, 0x78, 0x85, 0x45, 0x61, 0x7f, 0x53, 0xa5,
+		0xa4, 0x76, 0xf4, 0xe5, 0xad, 0xd5, 0xdf, 0x48, 0xc5, 0x58, 0x8f, 0x25, 0xdd, 0xd2, 0xbd, 0x78,
+		0x3d, 0x40, 0x1f, 0xf4, 0xad, 0xe0, 0xca, 0x4a, 0xab, 0xf3, 0x49, 0x5a, 0x1b, 0x29, 0xa4, 0x62,
+		0xfb, 0xb3, 0x75, 0x6b, 0x83, 0xc2, 0x83, 0x9c, 0xa0, 0x56, 0xd7, 0xb7, 0x9c, 0xae, 0xef, 0x0f,
+		0xeb, 0xeb, 0xfb, 0xec, 0xdf, 0xc5, 0xb2, 0xb2, 0xba, 0x8d, 0xdf, 0xe0, 0x20, 0x2f, 0xa6, 0xff,
+		0x42, 0xeb, 0xf9, 0xef, 0x70, 0x6f, 0xf5, 0x0f, 0x1a, 0x35, 0xe0, 0xa1, 0xdd, 0x19, 0x5e, 0x38,
+		0x7d, 0x73, 0x68, 0x3b, 0x17, 0xe6, 0x55, 0xd7, 0x31, 0xaf, 0xde, 0x76, 0xfa, 0x66, 0x57, 0xff,
+		0x1f, 0xaa, 0xc3, 0xe1, 0x06, 0x76, 0xf5, 0xc6, 0xba, 0xec, 0xf4, 0x75, 0x2d, 0x07, 0x1a, 0xda,
+		0xe6, 0xf9, 0xc5, 0x3b, 0xbd, 0x80, 0x1e, 0x43, 0x6d, 0x03, 0xea, 0x0d, 0x5e, 0xf7, 0x2e, 0x7b,
+		0x56, 0xa7, 0xaf, 0x17, 0x9f, 0x7b, 0x1f, 0xf5, 0xed, 0x79, 0x44, 0xd6, 0xf5, 0xed, 0x77, 0x83,
+		0xde, 0x8a, 0xfe, 0x23, 0x38, 0xda, 0xc0, 0xba, 0xbd, 0x73, 0x73, 0x68, 0xbe, 0xb9, 0xd2, 0xb5,
+		0x1c, 0xb0, 0x73, 0x6e, 0x9b, 0x6f, 0x4d, 0xfb, 0x9d, 0x5e, 0x38, 0xfb, 0x15, 0x8e, 0x5c, 0x16,
+		0xe4, 0x55, 0xe7, 0x6c, 0x77, 0x59, 0x1e, 0xb9, 0xc3, 0x03, 0xed, 0x97, 0x93, 0x31, 0x15, 0xd7,
+		0xc9, 0xc8, 0x70, 0x59, 0xd0, 0x5e, 0xfd, 0x30, 0xfb, 0x92, 0x7a, 0x7e, 0x7b, 0xcc, 0xd2, 0x6f,
+		0xa5, 0xec, 0x2b, 0xed, 0x7b, 0x1c, 0xd1, 0xe9, 0xc9, 0xe8, 0x8e, 0xb2, 0x7d, 0xf5, 0x4f, 0x00,
+		0x00, 0x00, 0xff, 0xff, 0xd9, 0x65, 0x10, 0xfe, 0xc9, 0x09, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
+		0x02, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) AdminExtensionAPIYARPCClient {
+			return NewAdminExtensionAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
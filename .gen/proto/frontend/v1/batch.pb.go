@@ -0,0 +1,4270 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/frontend/v1/batch.proto
+
+package frontendv1
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type BatchOperationState int32
+
+const (
+	BatchOperationState_BATCH_OPERATION_STATE_INVALID   BatchOperationState = 0
+	BatchOperationState_BATCH_OPERATION_STATE_RUNNING   BatchOperationState = 1
+	BatchOperationState_BATCH_OPERATION_STATE_COMPLETED BatchOperationState = 2
+	BatchOperationState_BATCH_OPERATION_STATE_FAILED    BatchOperationState = 3
+	BatchOperationState_BATCH_OPERATION_STATE_STOPPED   BatchOperationState = 4
+)
+
+var BatchOperationState_name = map[int32]string{
+	0: "BATCH_OPERATION_STATE_INVALID",
+	1: "BATCH_OPERATION_STATE_RUNNING",
+	2: "BATCH_OPERATION_STATE_COMPLETED",
+	3: "BATCH_OPERATION_STATE_FAILED",
+	4: "BATCH_OPERATION_STATE_STOPPED",
+}
+
+var BatchOperationState_value = map[string]int32{
+	"BATCH_OPERATION_STATE_INVALID":   0,
+	"BATCH_OPERATION_STATE_RUNNING":   1,
+	"BATCH_OPERATION_STATE_COMPLETED": 2,
+	"BATCH_OPERATION_STATE_FAILED":    3,
+	"BATCH_OPERATION_STATE_STOPPED":   4,
+}
+
+func (x BatchOperationState) String() string {
+	return proto.EnumName(BatchOperationState_name, int32(x))
+}
+
+func (BatchOperationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{0}
+}
+
+type BatchOperationSignalParams struct {
+	SignalName           string   `protobuf:"bytes,1,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	Input                []byte   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchOperationSignalParams) Reset()         { *m = BatchOperationSignalParams{} }
+func (m *BatchOperationSignalParams) String() string { return proto.CompactTextString(m) }
+func (*BatchOperationSignalParams) ProtoMessage()    {}
+func (*BatchOperationSignalParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{0}
+}
+func (m *BatchOperationSignalParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOperationSignalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOperationSignalParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOperationSignalParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOperationSignalParams.Merge(m, src)
+}
+func (m *BatchOperationSignalParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOperationSignalParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOperationSignalParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOperationSignalParams proto.InternalMessageInfo
+
+func (m *BatchOperationSignalParams) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
+	}
+	return ""
+}
+
+func (m *BatchOperationSignalParams) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+type BatchOperationReplicateParams struct {
+	SourceCluster        string   `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
+	TargetCluster        string   `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchOperationReplicateParams) Reset()         { *m = BatchOperationReplicateParams{} }
+func (m *BatchOperationReplicateParams) String() string { return proto.CompactTextString(m) }
+func (*BatchOperationReplicateParams) ProtoMessage()    {}
+func (*BatchOperationReplicateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{1}
+}
+func (m *BatchOperationReplicateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOperationReplicateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOperationReplicateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOperationReplicateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOperationReplicateParams.Merge(m, src)
+}
+func (m *BatchOperationReplicateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOperationReplicateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOperationReplicateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOperationReplicateParams proto.InternalMessageInfo
+
+func (m *BatchOperationReplicateParams) GetSourceCluster() string {
+	if m != nil {
+		return m.SourceCluster
+	}
+	return ""
+}
+
+func (m *BatchOperationReplicateParams) GetTargetCluster() string {
+	if m != nil {
+		return m.TargetCluster
+	}
+	return ""
+}
+
+type BatchOperationFailure struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchOperationFailure) Reset()         { *m = BatchOperationFailure{} }
+func (m *BatchOperationFailure) String() string { return proto.CompactTextString(m) }
+func (*BatchOperationFailure) ProtoMessage()    {}
+func (*BatchOperationFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{2}
+}
+func (m *BatchOperationFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOperationFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOperationFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOperationFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOperationFailure.Merge(m, src)
+}
+func (m *BatchOperationFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOperationFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOperationFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOperationFailure proto.InternalMessageInfo
+
+func (m *BatchOperationFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BatchOperationFailure) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type BatchOperationInfo struct {
+	JobId                string                   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Domain               string                   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	BatchType            string                   `protobuf:"bytes,3,opt,name=batch_type,json=batchType,proto3" json:"batch_type,omitempty"`
+	Query                string                   `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Reason               string                   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string                   `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	State                BatchOperationState      `protobuf:"varint,7,opt,name=state,proto3,enum=uber.cadence.frontend.v1.BatchOperationState" json:"state,omitempty"`
+	StartTime            *types.Timestamp         `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CloseTime            *types.Timestamp         `protobuf:"bytes,9,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	TotalEstimate        int64                    `protobuf:"varint,10,opt,name=total_estimate,json=totalEstimate,proto3" json:"total_estimate,omitempty"`
+	SucceededCount       int64                    `protobuf:"varint,11,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	FailedCount          int64                    `protobuf:"varint,12,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	SkippedCount         int64                    `protobuf:"varint,13,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	FailureReasons       []*BatchOperationFailure `protobuf:"bytes,14,rep,name=failure_reasons,json=failureReasons,proto3" json:"failure_reasons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *BatchOperationInfo) Reset()         { *m = BatchOperationInfo{} }
+func (m *BatchOperationInfo) String() string { return proto.CompactTextString(m) }
+func (*BatchOperationInfo) ProtoMessage()    {}
+func (*BatchOperationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{3}
+}
+func (m *BatchOperationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOperationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOperationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOperationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOperationInfo.Merge(m, src)
+}
+func (m *BatchOperationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOperationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOperationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOperationInfo proto.InternalMessageInfo
+
+func (m *BatchOperationInfo) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *BatchOperationInfo) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *BatchOperationInfo) GetBatchType() string {
+	if m != nil {
+		return m.BatchType
+	}
+	return ""
+}
+
+func (m *BatchOperationInfo) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *BatchOperationInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BatchOperationInfo) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *BatchOperationInfo) GetState() BatchOperationState {
+	if m != nil {
+		return m.State
+	}
+	return BatchOperationState_BATCH_OPERATION_STATE_INVALID
+}
+
+func (m *BatchOperationInfo) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *BatchOperationInfo) GetCloseTime() *types.Timestamp {
+	if m != nil {
+		return m.CloseTime
+	}
+	return nil
+}
+
+func (m *BatchOperationInfo) GetTotalEstimate() int64 {
+	if m != nil {
+		return m.TotalEstimate
+	}
+	return 0
+}
+
+func (m *BatchOperationInfo) GetSucceededCount() int64 {
+	if m != nil {
+		return m.SucceededCount
+	}
+	return 0
+}
+
+func (m *BatchOperationInfo) GetFailedCount() int64 {
+	if m != nil {
+		return m.FailedCount
+	}
+	return 0
+}
+
+func (m *BatchOperationInfo) GetSkippedCount() int64 {
+	if m != nil {
+		return m.SkippedCount
+	}
+	return 0
+}
+
+func (m *BatchOperationInfo) GetFailureReasons() []*BatchOperationFailure {
+	if m != nil {
+		return m.FailureReasons
+	}
+	return nil
+}
+
+type StartBatchOperationRequest struct {
+	Domain                   string                         `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	JobId                    string                         `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	BatchType                string                         `protobuf:"bytes,3,opt,name=batch_type,json=batchType,proto3" json:"batch_type,omitempty"`
+	Query                    string                         `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Reason                   string                         `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity                 string                         `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId                string                         `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SignalParams             *BatchOperationSignalParams    `protobuf:"bytes,8,opt,name=signal_params,json=signalParams,proto3" json:"signal_params,omitempty"`
+	ReplicateParams          *BatchOperationReplicateParams `protobuf:"bytes,9,opt,name=replicate_params,json=replicateParams,proto3" json:"replicate_params,omitempty"`
+	TerminateChildren        *types.BoolValue               `protobuf:"bytes,10,opt,name=terminate_children,json=terminateChildren,proto3" json:"terminate_children,omitempty"`
+	CancelChildren           *types.BoolValue               `protobuf:"bytes,11,opt,name=cancel_children,json=cancelChildren,proto3" json:"cancel_children,omitempty"`
+	Rps                      int32                          `protobuf:"varint,12,opt,name=rps,proto3" json:"rps,omitempty"`
+	Concurrency              int32                          `protobuf:"varint,13,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	PageSize                 int32                          `protobuf:"varint,14,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	AttemptsOnRetryableError int32                          `protobuf:"varint,15,opt,name=attempts_on_retryable_error,json=attemptsOnRetryableError,proto3" json:"attempts_on_retryable_error,omitempty"`
+	ActivityHeartbeatTimeout *types.Duration                `protobuf:"bytes,16,opt,name=activity_heartbeat_timeout,json=activityHeartbeatTimeout,proto3" json:"activity_heartbeat_timeout,omitempty"`
+	MaxActivityRetries       int32                          `protobuf:"varint,17,opt,name=max_activity_retries,json=maxActivityRetries,proto3" json:"max_activity_retries,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                       `json:"-"`
+	XXX_unrecognized         []byte                         `json:"-"`
+	XXX_sizecache            int32                          `json:"-"`
+}
+
+func (m *StartBatchOperationRequest) Reset()         { *m = StartBatchOperationRequest{} }
+func (m *StartBatchOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*StartBatchOperationRequest) ProtoMessage()    {}
+func (*StartBatchOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{4}
+}
+func (m *StartBatchOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartBatchOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartBatchOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartBatchOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartBatchOperationRequest.Merge(m, src)
+}
+func (m *StartBatchOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartBatchOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartBatchOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartBatchOperationRequest proto.InternalMessageInfo
+
+func (m *StartBatchOperationRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *StartBatchOperationRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *StartBatchOperationRequest) GetBatchType() string {
+	if m != nil {
+		return m.BatchType
+	}
+	return ""
+}
+
+func (m *StartBatchOperationRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *StartBatchOperationRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *StartBatchOperationRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *StartBatchOperationRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *StartBatchOperationRequest) GetSignalParams() *BatchOperationSignalParams {
+	if m != nil {
+		return m.SignalParams
+	}
+	return nil
+}
+
+func (m *StartBatchOperationRequest) GetReplicateParams() *BatchOperationReplicateParams {
+	if m != nil {
+		return m.ReplicateParams
+	}
+	return nil
+}
+
+func (m *StartBatchOperationRequest) GetTerminateChildren() *types.BoolValue {
+	if m != nil {
+		return m.TerminateChildren
+	}
+	return nil
+}
+
+func (m *StartBatchOperationRequest) GetCancelChildren() *types.BoolValue {
+	if m != nil {
+		return m.CancelChildren
+	}
+	return nil
+}
+
+func (m *StartBatchOperationRequest) GetRps() int32 {
+	if m != nil {
+		return m.Rps
+	}
+	return 0
+}
+
+func (m *StartBatchOperationRequest) GetConcurrency() int32 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+func (m *StartBatchOperationRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *StartBatchOperationRequest) GetAttemptsOnRetryableError() int32 {
+	if m != nil {
+		return m.AttemptsOnRetryableError
+	}
+	return 0
+}
+
+func (m *StartBatchOperationRequest) GetActivityHeartbeatTimeout() *types.Duration {
+	if m != nil {
+		return m.ActivityHeartbeatTimeout
+	}
+	return nil
+}
+
+func (m *StartBatchOperationRequest) GetMaxActivityRetries() int32 {
+	if m != nil {
+		return m.MaxActivityRetries
+	}
+	return 0
+}
+
+type StartBatchOperationResponse struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartBatchOperationResponse) Reset()         { *m = StartBatchOperationResponse{} }
+func (m *StartBatchOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*StartBatchOperationResponse) ProtoMessage()    {}
+func (*StartBatchOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{5}
+}
+func (m *StartBatchOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartBatchOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartBatchOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartBatchOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartBatchOperationResponse.Merge(m, src)
+}
+func (m *StartBatchOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartBatchOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartBatchOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartBatchOperationResponse proto.InternalMessageInfo
+
+func (m *StartBatchOperationResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+type DescribeBatchOperationRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	JobId                string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeBatchOperationRequest) Reset()         { *m = DescribeBatchOperationRequest{} }
+func (m *DescribeBatchOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeBatchOperationRequest) ProtoMessage()    {}
+func (*DescribeBatchOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{6}
+}
+func (m *DescribeBatchOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeBatchOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeBatchOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeBatchOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeBatchOperationRequest.Merge(m, src)
+}
+func (m *DescribeBatchOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeBatchOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeBatchOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeBatchOperationRequest proto.InternalMessageInfo
+
+func (m *DescribeBatchOperationRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DescribeBatchOperationRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+type DescribeBatchOperationResponse struct {
+	Info                 *BatchOperationInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DescribeBatchOperationResponse) Reset()         { *m = DescribeBatchOperationResponse{} }
+func (m *DescribeBatchOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeBatchOperationResponse) ProtoMessage()    {}
+func (*DescribeBatchOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{7}
+}
+func (m *DescribeBatchOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeBatchOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeBatchOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeBatchOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeBatchOperationResponse.Merge(m, src)
+}
+func (m *DescribeBatchOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeBatchOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeBatchOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeBatchOperationResponse proto.InternalMessageInfo
+
+func (m *DescribeBatchOperationResponse) GetInfo() *BatchOperationInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ListBatchOperationsRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken        []byte   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBatchOperationsRequest) Reset()         { *m = ListBatchOperationsRequest{} }
+func (m *ListBatchOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBatchOperationsRequest) ProtoMessage()    {}
+func (*ListBatchOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{8}
+}
+func (m *ListBatchOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBatchOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListBatchOperationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListBatchOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBatchOperationsRequest.Merge(m, src)
+}
+func (m *ListBatchOperationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBatchOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBatchOperationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBatchOperationsRequest proto.InternalMessageInfo
+
+func (m *ListBatchOperationsRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ListBatchOperationsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListBatchOperationsRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type ListBatchOperationsResponse struct {
+	Operations           []*BatchOperationInfo `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	NextPageToken        []byte                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListBatchOperationsResponse) Reset()         { *m = ListBatchOperationsResponse{} }
+func (m *ListBatchOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBatchOperationsResponse) ProtoMessage()    {}
+func (*ListBatchOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{9}
+}
+func (m *ListBatchOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBatchOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListBatchOperationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListBatchOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBatchOperationsResponse.Merge(m, src)
+}
+func (m *ListBatchOperationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBatchOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBatchOperationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBatchOperationsResponse proto.InternalMessageInfo
+
+func (m *ListBatchOperationsResponse) GetOperations() []*BatchOperationInfo {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *ListBatchOperationsResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type StopBatchOperationRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	JobId                string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity             string   `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopBatchOperationRequest) Reset()         { *m = StopBatchOperationRequest{} }
+func (m *StopBatchOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*StopBatchOperationRequest) ProtoMessage()    {}
+func (*StopBatchOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{10}
+}
+func (m *StopBatchOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopBatchOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopBatchOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopBatchOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopBatchOperationRequest.Merge(m, src)
+}
+func (m *StopBatchOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopBatchOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopBatchOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopBatchOperationRequest proto.InternalMessageInfo
+
+func (m *StopBatchOperationRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *StopBatchOperationRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *StopBatchOperationRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *StopBatchOperationRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type StopBatchOperationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopBatchOperationResponse) Reset()         { *m = StopBatchOperationResponse{} }
+func (m *StopBatchOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*StopBatchOperationResponse) ProtoMessage()    {}
+func (*StopBatchOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30ac851af015ed72, []int{11}
+}
+func (m *StopBatchOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopBatchOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopBatchOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StopBatchOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopBatchOperationResponse.Merge(m, src)
+}
+func (m *StopBatchOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopBatchOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopBatchOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopBatchOperationResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("uber.cadence.frontend.v1.BatchOperationState", BatchOperationState_name, BatchOperationState_value)
+	proto.RegisterType((*BatchOperationSignalParams)(nil), "uber.cadence.frontend.v1.BatchOperationSignalParams")
+	proto.RegisterType((*BatchOperationReplicateParams)(nil), "uber.cadence.frontend.v1.BatchOperationReplicateParams")
+	proto.RegisterType((*BatchOperationFailure)(nil), "uber.cadence.frontend.v1.BatchOperationFailure")
+	proto.RegisterType((*BatchOperationInfo)(nil), "uber.cadence.frontend.v1.BatchOperationInfo")
+	proto.RegisterType((*StartBatchOperationRequest)(nil), "uber.cadence.frontend.v1.StartBatchOperationRequest")
+	proto.RegisterType((*StartBatchOperationResponse)(nil), "uber.cadence.frontend.v1.StartBatchOperationResponse")
+	proto.RegisterType((*DescribeBatchOperationRequest)(nil), "uber.cadence.frontend.v1.DescribeBatchOperationRequest")
+	proto.RegisterType((*DescribeBatchOperationResponse)(nil), "uber.cadence.frontend.v1.DescribeBatchOperationResponse")
+	proto.RegisterType((*ListBatchOperationsRequest)(nil), "uber.cadence.frontend.v1.ListBatchOperationsRequest")
+	proto.RegisterType((*ListBatchOperationsResponse)(nil), "uber.cadence.frontend.v1.ListBatchOperationsResponse")
+	proto.RegisterType((*StopBatchOperationRequest)(nil), "uber.cadence.frontend.v1.StopBatchOperationRequest")
+	proto.RegisterType((*StopBatchOperationResponse)(nil), "uber.cadence.frontend.v1.StopBatchOperationResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/frontend/v1/batch.proto", fileDescriptor_30ac851af015ed72)
+}
+
+var fileDescriptor_30ac851af015ed72 = []byte{
+	// 1197 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6e, 0x1b, 0x37,
+	0x17, 0xfd, 0xc6, 0xb2, 0x1d, 0xfb, 0xca, 0x96, 0x15, 0xe6, 0x07, 0x93, 0x71, 0xec, 0x28, 0xca,
+	0xf7, 0x63, 0x7c, 0x68, 0xa5, 0xc6, 0x49, 0xd0, 0x06, 0x45, 0x81, 0xca, 0xb2, 0x92, 0x08, 0x70,
+	0x65, 0x61, 0xa4, 0xa6, 0x3f, 0x9b, 0x01, 0x35, 0xba, 0x96, 0x27, 0x99, 0x19, 0x4e, 0x48, 0x8e,
+	0x1b, 0x65, 0xd1, 0x02, 0xdd, 0x76, 0xd9, 0x47, 0xe8, 0x73, 0x74, 0x59, 0xa0, 0xcb, 0x6e, 0xbb,
+	0x2b, 0xf2, 0x24, 0xc5, 0x90, 0x94, 0x22, 0x39, 0x92, 0x63, 0x03, 0x41, 0x77, 0xc3, 0xcb, 0x73,
+	0x0e, 0x2f, 0xc9, 0x73, 0x2f, 0x31, 0xf0, 0xef, 0xb4, 0x87, 0xbc, 0xea, 0xd3, 0x3e, 0xc6, 0x3e,
+	0x56, 0x8f, 0x38, 0x8b, 0x25, 0xc6, 0xfd, 0xea, 0xc9, 0xdd, 0x6a, 0x8f, 0x4a, 0xff, 0xb8, 0x92,
+	0x70, 0x26, 0x19, 0xb1, 0x33, 0x54, 0xc5, 0xa0, 0x2a, 0x23, 0x54, 0xe5, 0xe4, 0xae, 0xb3, 0x3d,
+	0x60, 0x6c, 0x10, 0x62, 0x55, 0xe1, 0x7a, 0xe9, 0x51, 0xb5, 0x9f, 0x72, 0x2a, 0x03, 0x16, 0x6b,
+	0xa6, 0x73, 0xeb, 0xf4, 0xbc, 0x0c, 0x22, 0x14, 0x92, 0x46, 0x89, 0x01, 0xbc, 0x25, 0xf0, 0x1d,
+	0xa7, 0x49, 0x82, 0x5c, 0xe8, 0xf9, 0x72, 0x07, 0x9c, 0xbd, 0x2c, 0x93, 0xc3, 0x04, 0xb5, 0x70,
+	0x27, 0x18, 0xc4, 0x34, 0x6c, 0x53, 0x4e, 0x23, 0x41, 0x6e, 0x41, 0x5e, 0xa8, 0xb1, 0x17, 0xd3,
+	0x08, 0x6d, 0xab, 0x64, 0xed, 0xac, 0xba, 0xa0, 0x43, 0x2d, 0x1a, 0x21, 0xb9, 0x0a, 0x4b, 0x41,
+	0x9c, 0xa4, 0xd2, 0x5e, 0x28, 0x59, 0x3b, 0x6b, 0xae, 0x1e, 0x94, 0x23, 0xd8, 0x9a, 0x16, 0x75,
+	0x31, 0x09, 0x03, 0x9f, 0x4a, 0x34, 0xba, 0xff, 0x81, 0x82, 0x60, 0x29, 0xf7, 0xd1, 0xf3, 0xc3,
+	0x54, 0x48, 0xe4, 0x46, 0x7a, 0x5d, 0x47, 0xeb, 0x3a, 0x98, 0xc1, 0x24, 0xe5, 0x03, 0x94, 0x63,
+	0xd8, 0x82, 0x86, 0xe9, 0xa8, 0x81, 0x95, 0x1b, 0x70, 0x6d, 0x7a, 0xb9, 0x47, 0x34, 0x08, 0x53,
+	0x8e, 0xe4, 0x3a, 0x2c, 0x73, 0xa4, 0x82, 0xc5, 0x46, 0xde, 0x8c, 0xb2, 0xac, 0x7d, 0x96, 0xc6,
+	0x3a, 0xeb, 0x9c, 0xab, 0x07, 0xe5, 0x3f, 0x17, 0x81, 0x4c, 0xeb, 0x34, 0xe3, 0x23, 0x46, 0xae,
+	0xc1, 0xf2, 0x33, 0xd6, 0xf3, 0x82, 0xbe, 0x11, 0x59, 0x7a, 0xc6, 0x7a, 0xcd, 0x7e, 0xa6, 0xdd,
+	0x67, 0x11, 0x0d, 0x62, 0x93, 0x93, 0x19, 0x91, 0x2d, 0x00, 0x75, 0xb5, 0x9e, 0x1c, 0x26, 0x68,
+	0xe7, 0xd4, 0xdc, 0xaa, 0x8a, 0x74, 0x87, 0x89, 0x3a, 0xb0, 0x17, 0x29, 0xf2, 0xa1, 0xbd, 0xa8,
+	0xc5, 0xd4, 0x60, 0x22, 0xd1, 0xa5, 0xa9, 0x44, 0x1d, 0x58, 0x09, 0xfa, 0x18, 0xcb, 0x40, 0x0e,
+	0xed, 0x65, 0x35, 0x33, 0x1e, 0x93, 0x3a, 0x2c, 0x09, 0x49, 0x25, 0xda, 0x97, 0x4a, 0xd6, 0x4e,
+	0x61, 0xf7, 0xc3, 0xca, 0x3c, 0x13, 0x55, 0x4e, 0x5d, 0x70, 0x46, 0x72, 0x35, 0x97, 0x3c, 0x04,
+	0x10, 0x92, 0x72, 0xe9, 0x65, 0xbe, 0xb1, 0x57, 0x4a, 0xd6, 0x4e, 0x7e, 0xd7, 0xa9, 0x68, 0xcf,
+	0x54, 0x46, 0x9e, 0xa9, 0x74, 0x47, 0xa6, 0x72, 0x57, 0x15, 0x3a, 0x1b, 0x67, 0x54, 0x3f, 0x64,
+	0x02, 0x35, 0x75, 0xf5, 0xdd, 0x54, 0x85, 0x56, 0xd4, 0xec, 0x5e, 0x99, 0xa4, 0xa1, 0x87, 0x42,
+	0x06, 0x51, 0xb6, 0x07, 0x50, 0x17, 0xb1, 0xae, 0xa2, 0x0d, 0x13, 0x24, 0xff, 0x83, 0x0d, 0x91,
+	0xfa, 0x3e, 0x62, 0x1f, 0xfb, 0x9e, 0xbe, 0xb0, 0xbc, 0xc2, 0x15, 0xc6, 0xe1, 0x7a, 0x16, 0x25,
+	0xb7, 0x61, 0xed, 0x88, 0x06, 0xe1, 0x18, 0xb5, 0xa6, 0x50, 0x79, 0x1d, 0xd3, 0x90, 0x3b, 0xb0,
+	0x2e, 0x9e, 0x07, 0x49, 0x32, 0xc6, 0xac, 0x2b, 0xcc, 0x9a, 0x09, 0x6a, 0xd0, 0xd7, 0xb0, 0x71,
+	0xa4, 0xad, 0xe3, 0xe9, 0x0b, 0x10, 0x76, 0xa1, 0x94, 0xdb, 0xc9, 0xef, 0x56, 0xcf, 0x7b, 0xb8,
+	0xc6, 0x79, 0x6e, 0xc1, 0xe8, 0xb8, 0x5a, 0xa6, 0xfc, 0xeb, 0x32, 0x38, 0x9d, 0xec, 0xe8, 0x4e,
+	0xd7, 0xc5, 0x8b, 0x14, 0x85, 0x9c, 0x30, 0x93, 0x35, 0x65, 0xa6, 0x37, 0xde, 0x5b, 0x98, 0xf4,
+	0xde, 0x3f, 0xe6, 0xb1, 0x2d, 0x00, 0xae, 0x53, 0xcc, 0x72, 0xb8, 0xa4, 0x17, 0x32, 0x91, 0x66,
+	0x9f, 0x7c, 0x03, 0xeb, 0xa6, 0x3d, 0x24, 0xaa, 0xae, 0x8d, 0x81, 0xee, 0x9f, 0xdb, 0x8a, 0x13,
+	0xbd, 0xc6, 0x5d, 0x13, 0x13, 0x23, 0xd2, 0x83, 0x22, 0x1f, 0x35, 0x8d, 0x91, 0xba, 0xf6, 0xd8,
+	0xc7, 0xe7, 0x55, 0x3f, 0xd5, 0x74, 0xdc, 0x0d, 0x3e, 0x1d, 0x20, 0x4d, 0x20, 0x12, 0x79, 0x14,
+	0xc4, 0xd9, 0x1a, 0xfe, 0x71, 0x10, 0xf6, 0x39, 0xc6, 0x36, 0xcc, 0x71, 0xf2, 0x1e, 0x63, 0xe1,
+	0x53, 0x1a, 0xa6, 0xe8, 0x5e, 0x1e, 0xb3, 0xea, 0x86, 0x44, 0xea, 0xb0, 0xe1, 0xd3, 0xd8, 0xc7,
+	0xf0, 0x8d, 0x4e, 0xfe, 0x9d, 0x3a, 0x05, 0x4d, 0x19, 0x8b, 0x14, 0x21, 0xc7, 0x13, 0xa1, 0xdc,
+	0xbb, 0xe4, 0x66, 0x9f, 0xa4, 0x04, 0x79, 0x9f, 0xc5, 0x7e, 0xca, 0x39, 0xc6, 0xfe, 0x50, 0x79,
+	0x76, 0xc9, 0x9d, 0x0c, 0x91, 0x4d, 0x58, 0x4d, 0xe8, 0x00, 0x3d, 0x11, 0xbc, 0x42, 0xbb, 0xa0,
+	0xe6, 0x57, 0xb2, 0x40, 0x27, 0x78, 0x85, 0xe4, 0x33, 0xd8, 0xa4, 0x52, 0x62, 0x94, 0x48, 0xe1,
+	0xb1, 0xd8, 0xe3, 0x28, 0xf9, 0x90, 0xf6, 0x42, 0xf4, 0x90, 0x73, 0xc6, 0xed, 0x0d, 0x05, 0xb7,
+	0x47, 0x90, 0xc3, 0xd8, 0x1d, 0x01, 0x1a, 0xd9, 0x3c, 0xf9, 0x0a, 0x1c, 0xea, 0xcb, 0xe0, 0x24,
+	0x90, 0x43, 0xef, 0x18, 0x29, 0x97, 0x3d, 0xa4, 0xba, 0x53, 0xb0, 0x54, 0xda, 0x45, 0xb5, 0xbf,
+	0x1b, 0x6f, 0xed, 0x6f, 0xdf, 0xbc, 0x50, 0xae, 0x3d, 0x22, 0x3f, 0x19, 0x71, 0xbb, 0x9a, 0x4a,
+	0x3e, 0x82, 0xab, 0x11, 0x7d, 0xe9, 0x8d, 0xc5, 0xb3, 0xc4, 0x02, 0x14, 0xf6, 0x65, 0x95, 0x10,
+	0x89, 0xe8, 0xcb, 0x9a, 0x99, 0x72, 0xf5, 0x4c, 0xf9, 0x3e, 0x6c, 0xce, 0x2c, 0x1f, 0x91, 0xb0,
+	0x58, 0xe0, 0x9c, 0x1e, 0x5d, 0x6e, 0xc1, 0xd6, 0x3e, 0x0a, 0x9f, 0x07, 0x3d, 0x7c, 0x1f, 0x75,
+	0x57, 0xee, 0xc1, 0xf6, 0x3c, 0x3d, 0x93, 0xc8, 0xe7, 0xb0, 0x18, 0xc4, 0x47, 0x4c, 0xc9, 0xe5,
+	0x77, 0x3f, 0x38, 0xaf, 0x55, 0xb3, 0x87, 0xc6, 0x55, 0xcc, 0xf2, 0x10, 0x9c, 0x83, 0x40, 0x9c,
+	0xda, 0xa8, 0x78, 0x57, 0xc2, 0x53, 0x36, 0x58, 0x38, 0x65, 0x83, 0xff, 0xc2, 0x46, 0x8c, 0x2f,
+	0xa5, 0xa7, 0x10, 0x92, 0x3d, 0xc7, 0x58, 0xf5, 0x8c, 0x35, 0x77, 0x3d, 0x0b, 0xb7, 0xe9, 0x00,
+	0xbb, 0x59, 0xb0, 0xfc, 0xb3, 0x05, 0x9b, 0x33, 0xd7, 0x36, 0x9b, 0x3b, 0x00, 0x60, 0xe3, 0xa8,
+	0x6d, 0x95, 0x72, 0x17, 0xde, 0xe2, 0x04, 0x7f, 0x56, 0x56, 0x0b, 0xb3, 0xb2, 0xfa, 0x1e, 0x6e,
+	0x74, 0x24, 0x4b, 0xde, 0x4b, 0xe3, 0x7c, 0xd3, 0x03, 0x73, 0x73, 0x7b, 0xe0, 0xe2, 0x74, 0x0f,
+	0x2c, 0xdf, 0x04, 0x67, 0xd6, 0xfa, 0xfa, 0x4c, 0xfe, 0xff, 0x9b, 0x05, 0x57, 0x66, 0xbc, 0xaf,
+	0xe4, 0x36, 0x6c, 0xed, 0xd5, 0xba, 0xf5, 0x27, 0xde, 0x61, 0xbb, 0xe1, 0xd6, 0xba, 0xcd, 0xc3,
+	0x96, 0xd7, 0xe9, 0xd6, 0xba, 0x0d, 0xaf, 0xd9, 0x7a, 0x5a, 0x3b, 0x68, 0xee, 0x17, 0xff, 0x35,
+	0x1f, 0xe2, 0x7e, 0xd9, 0x6a, 0x35, 0x5b, 0x8f, 0x8b, 0x16, 0xb9, 0x03, 0xb7, 0x66, 0x43, 0xea,
+	0x87, 0x5f, 0xb4, 0x0f, 0x1a, 0xdd, 0xc6, 0x7e, 0x71, 0x81, 0x94, 0xe0, 0xe6, 0x6c, 0xd0, 0xa3,
+	0x5a, 0xf3, 0xa0, 0xb1, 0x5f, 0xcc, 0xcd, 0x5f, 0xa9, 0xd3, 0x3d, 0x6c, 0xb7, 0x1b, 0xfb, 0xc5,
+	0xc5, 0xdd, 0x5f, 0x16, 0x61, 0x45, 0xed, 0xa3, 0xd6, 0x6e, 0x92, 0x1f, 0x2d, 0xb8, 0x32, 0xa3,
+	0xdc, 0xc8, 0x19, 0x8d, 0x7d, 0xfe, 0xe3, 0xe6, 0x3c, 0xb8, 0x20, 0xcb, 0xb8, 0xed, 0x27, 0x0b,
+	0xae, 0xcf, 0xae, 0x36, 0x72, 0xc6, 0x13, 0x70, 0x66, 0xbd, 0x3b, 0x9f, 0x5c, 0x9c, 0x68, 0xb2,
+	0xc9, 0x8e, 0x64, 0x46, 0x6d, 0x9c, 0x75, 0x24, 0xf3, 0xcb, 0xd8, 0x79, 0x70, 0x41, 0x96, 0x49,
+	0xe2, 0x07, 0x20, 0x6f, 0x5b, 0x91, 0xdc, 0x3b, 0xeb, 0x7c, 0xe7, 0x14, 0x8e, 0x73, 0xff, 0x62,
+	0x24, 0x9d, 0xc0, 0xde, 0xe3, 0xdf, 0x5f, 0x6f, 0x5b, 0x7f, 0xbc, 0xde, 0xb6, 0xfe, 0x7a, 0xbd,
+	0x6d, 0x7d, 0xfb, 0x70, 0x10, 0xc8, 0xe3, 0xb4, 0x57, 0xf1, 0x59, 0x54, 0x9d, 0xfa, 0xcf, 0xa9,
+	0x0c, 0x30, 0xd6, 0x7f, 0x1c, 0x93, 0xbf, 0x3c, 0x9f, 0x8e, 0xbe, 0x4f, 0xee, 0xf6, 0x96, 0xd5,
+	0xec, 0xbd, 0xbf, 0x07, 0x00, 0x6f, 0x6e, 0xb3, 0x6c, 0x20, 0x0d, 0x00, 0x00,
+}
+
+func (m *BatchOperationSignalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOperationSignalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOperationSignalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOperationReplicateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOperationReplicateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOperationReplicateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOperationFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOperationFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOperationFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOperationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOperationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOperationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FailureReasons) > 0 {
+		for iNdEx := len(m.FailureReasons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailureReasons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.SkippedCount != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.SkippedCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.FailedCount != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.FailedCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SucceededCount != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.SucceededCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TotalEstimate != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.TotalEstimate))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CloseTime != nil {
+		{
+			size, err := m.CloseTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.State != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchType) > 0 {
+		i -= len(m.BatchType)
+		copy(dAtA[i:], m.BatchType)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.BatchType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartBatchOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartBatchOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartBatchOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxActivityRetries != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.MaxActivityRetries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.ActivityHeartbeatTimeout != nil {
+		{
+			size, err := m.ActivityHeartbeatTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.AttemptsOnRetryableError != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.AttemptsOnRetryableError))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Concurrency != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Concurrency))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Rps != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Rps))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CancelChildren != nil {
+		{
+			size, err := m.CancelChildren.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.TerminateChildren != nil {
+		{
+			size, err := m.TerminateChildren.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ReplicateParams != nil {
+		{
+			size, err := m.ReplicateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SignalParams != nil {
+		{
+			size, err := m.SignalParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchType) > 0 {
+		i -= len(m.BatchType)
+		copy(dAtA[i:], m.BatchType)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.BatchType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartBatchOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartBatchOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartBatchOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeBatchOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeBatchOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeBatchOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeBatchOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeBatchOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeBatchOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListBatchOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBatchOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListBatchOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListBatchOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBatchOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListBatchOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopBatchOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopBatchOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopBatchOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopBatchOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopBatchOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopBatchOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BatchOperationSignalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchOperationReplicateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.TargetCluster)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchOperationFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBatch(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchOperationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.BatchType)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovBatch(uint64(m.State))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.CloseTime != nil {
+		l = m.CloseTime.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.TotalEstimate != 0 {
+		n += 1 + sovBatch(uint64(m.TotalEstimate))
+	}
+	if m.SucceededCount != 0 {
+		n += 1 + sovBatch(uint64(m.SucceededCount))
+	}
+	if m.FailedCount != 0 {
+		n += 1 + sovBatch(uint64(m.FailedCount))
+	}
+	if m.SkippedCount != 0 {
+		n += 1 + sovBatch(uint64(m.SkippedCount))
+	}
+	if len(m.FailureReasons) > 0 {
+		for _, e := range m.FailureReasons {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartBatchOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.BatchType)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.SignalParams != nil {
+		l = m.SignalParams.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.ReplicateParams != nil {
+		l = m.ReplicateParams.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.TerminateChildren != nil {
+		l = m.TerminateChildren.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.CancelChildren != nil {
+		l = m.CancelChildren.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.Rps != 0 {
+		n += 1 + sovBatch(uint64(m.Rps))
+	}
+	if m.Concurrency != 0 {
+		n += 1 + sovBatch(uint64(m.Concurrency))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBatch(uint64(m.PageSize))
+	}
+	if m.AttemptsOnRetryableError != 0 {
+		n += 1 + sovBatch(uint64(m.AttemptsOnRetryableError))
+	}
+	if m.ActivityHeartbeatTimeout != nil {
+		l = m.ActivityHeartbeatTimeout.Size()
+		n += 2 + l + sovBatch(uint64(l))
+	}
+	if m.MaxActivityRetries != 0 {
+		n += 2 + sovBatch(uint64(m.MaxActivityRetries))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartBatchOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeBatchOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeBatchOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBatchOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBatch(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBatchOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopBatchOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopBatchOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBatch(x uint64) (n int) {
+	return sovBatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BatchOperationSignalParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOperationSignalParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOperationSignalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOperationReplicateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOperationReplicateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOperationReplicateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOperationFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOperationFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOperationFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOperationInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOperationInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOperationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= BatchOperationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CloseTime == nil {
+				m.CloseTime = &types.Timestamp{}
+			}
+			if err := m.CloseTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEstimate", wireType)
+			}
+			m.TotalEstimate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalEstimate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SucceededCount", wireType)
+			}
+			m.SucceededCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SucceededCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCount", wireType)
+			}
+			m.FailedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedCount", wireType)
+			}
+			m.SkippedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReasons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReasons = append(m.FailureReasons, &BatchOperationFailure{})
+			if err := m.FailureReasons[len(m.FailureReasons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartBatchOperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartBatchOperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartBatchOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignalParams == nil {
+				m.SignalParams = &BatchOperationSignalParams{}
+			}
+			if err := m.SignalParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicateParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReplicateParams == nil {
+				m.ReplicateParams = &BatchOperationReplicateParams{}
+			}
+			if err := m.ReplicateParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminateChildren", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TerminateChildren == nil {
+				m.TerminateChildren = &types.BoolValue{}
+			}
+			if err := m.TerminateChildren.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelChildren", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CancelChildren == nil {
+				m.CancelChildren = &types.BoolValue{}
+			}
+			if err := m.CancelChildren.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rps", wireType)
+			}
+			m.Rps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rps |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptsOnRetryableError", wireType)
+			}
+			m.AttemptsOnRetryableError = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttemptsOnRetryableError |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityHeartbeatTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivityHeartbeatTimeout == nil {
+				m.ActivityHeartbeatTimeout = &types.Duration{}
+			}
+			if err := m.ActivityHeartbeatTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActivityRetries", wireType)
+			}
+			m.MaxActivityRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActivityRetries |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartBatchOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartBatchOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartBatchOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeBatchOperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeBatchOperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeBatchOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeBatchOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeBatchOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeBatchOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &BatchOperationInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBatchOperationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBatchOperationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBatchOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBatchOperationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBatchOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBatchOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &BatchOperationInfo{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopBatchOperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopBatchOperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopBatchOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopBatchOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopBatchOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopBatchOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBatch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBatch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBatch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBatch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBatch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBatch = fmt.Errorf("proto: unexpected end of group")
+)
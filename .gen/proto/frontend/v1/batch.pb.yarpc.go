@@ -0,0 +1,518 @@
+// Code generated by protoc-gen-yarpc-go. DO NOT EDIT.
+// source: uber/cadence/frontend/v1/batch.proto
+
+package frontendv1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/api/x/restriction"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// BatchAPIYARPCClient is the YARPC client-side interface for the BatchAPI service.
+type BatchAPIYARPCClient interface {
+	StartBatchOperation(context.Context, *StartBatchOperationRequest, ...yarpc.CallOption) (*StartBatchOperationResponse, error)
+	DescribeBatchOperation(context.Context, *DescribeBatchOperationRequest, ...yarpc.CallOption) (*DescribeBatchOperationResponse, error)
+	ListBatchOperations(context.Context, *ListBatchOperationsRequest, ...yarpc.CallOption) (*ListBatchOperationsResponse, error)
+	StopBatchOperation(context.Context, *StopBatchOperationRequest, ...yarpc.CallOption) (*StopBatchOperationResponse, error)
+}
+
+func newBatchAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) BatchAPIYARPCClient {
+	return &_BatchAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.frontend.v1.BatchAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewBatchAPIYARPCClient builds a new YARPC client for the BatchAPI service.
+func NewBatchAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) BatchAPIYARPCClient {
+	return newBatchAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// BatchAPIYARPCServer is the YARPC server-side interface for the BatchAPI service.
+type BatchAPIYARPCServer interface {
+	StartBatchOperation(context.Context, *StartBatchOperationRequest) (*StartBatchOperationResponse, error)
+	DescribeBatchOperation(context.Context, *DescribeBatchOperationRequest) (*DescribeBatchOperationResponse, error)
+	ListBatchOperations(context.Context, *ListBatchOperationsRequest) (*ListBatchOperationsResponse, error)
+	StopBatchOperation(context.Context, *StopBatchOperationRequest) (*StopBatchOperationResponse, error)
+}
+
+type buildBatchAPIYARPCProceduresParams struct {
+	Server      BatchAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildBatchAPIYARPCProcedures(params buildBatchAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_BatchAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.frontend.v1.BatchAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "StartBatchOperation",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.StartBatchOperation,
+							NewRequest:  newBatchAPIServiceStartBatchOperationYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeBatchOperation",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeBatchOperation,
+							NewRequest:  newBatchAPIServiceDescribeBatchOperationYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListBatchOperations",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListBatchOperations,
+							NewRequest:  newBatchAPIServiceListBatchOperationsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "StopBatchOperation",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.StopBatchOperation,
+							NewRequest:  newBatchAPIServiceStopBatchOperationYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildBatchAPIYARPCProcedures prepares an implementation of the BatchAPI service for YARPC registration.
+func BuildBatchAPIYARPCProcedures(server BatchAPIYARPCServer) []transport.Procedure {
+	return buildBatchAPIYARPCProcedures(buildBatchAPIYARPCProceduresParams{Server: server})
+}
+
+// FxBatchAPIYARPCClientParams defines the input
+// for NewFxBatchAPIYARPCClient. It provides the
+// paramaters to get a BatchAPIYARPCClient in an
+// Fx application.
+type FxBatchAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxBatchAPIYARPCClientResult defines the output
+// of NewFxBatchAPIYARPCClient. It provides a
+// BatchAPIYARPCClient to an Fx application.
+type FxBatchAPIYARPCClientResult struct {
+	fx.Out
+
+	Client BatchAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxBatchAPIYARPCClient provides a BatchAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  frontendv1.NewFxBatchAPIYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxBatchAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxBatchAPIYARPCClientParams) FxBatchAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxBatchAPIYARPCClientResult{
+			Client: newBatchAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxBatchAPIYARPCProceduresParams defines the input
+// for NewFxBatchAPIYARPCProcedures. It provides the
+// paramaters to get BatchAPIYARPCServer procedures in an
+// Fx application.
+type FxBatchAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      BatchAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxBatchAPIYARPCProceduresResult defines the output
+// of NewFxBatchAPIYARPCProcedures. It provides
+// BatchAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxBatchAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxBatchAPIYARPCProcedures provides BatchAPIYARPCServer procedures to an Fx application.
+// It expects a BatchAPIYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  frontendv1.NewFxBatchAPIYARPCProcedures(),
+//	  ...
+//	)
+func NewFxBatchAPIYARPCProcedures() interface{} {
+	return func(params FxBatchAPIYARPCProceduresParams) FxBatchAPIYARPCProceduresResult {
+		return FxBatchAPIYARPCProceduresResult{
+			Procedures: buildBatchAPIYARPCProcedures(buildBatchAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: BatchAPIReflectionMeta,
+		}
+	}
+}
+
+// BatchAPIReflectionMeta is the reflection server metadata
+// required for using the gRPC reflection protocol with YARPC.
+//
+// See https://github.com/grpc/grpc/blob/master/doc/server-reflection.md.
+var BatchAPIReflectionMeta = reflection.ServerMeta{
+	ServiceName:     "uber.cadence.frontend.v1.BatchAPI",
+	FileDescriptors: yarpcFileDescriptorClosure30ac851af015ed72,
+}
+
+type _BatchAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_BatchAPIYARPCCaller) StartBatchOperation(ctx context.Context, request *StartBatchOperationRequest, options ...yarpc.CallOption) (*StartBatchOperationResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "StartBatchOperation", request, newBatchAPIServiceStartBatchOperationYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartBatchOperationResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyBatchAPIServiceStartBatchOperationYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_BatchAPIYARPCCaller) DescribeBatchOperation(ctx context.Context, request *DescribeBatchOperationRequest, options ...yarpc.CallOption) (*DescribeBatchOperationResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeBatchOperation", request, newBatchAPIServiceDescribeBatchOperationYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeBatchOperationResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyBatchAPIServiceDescribeBatchOperationYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_BatchAPIYARPCCaller) ListBatchOperations(ctx context.Context, request *ListBatchOperationsRequest, options ...yarpc.CallOption) (*ListBatchOperationsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListBatchOperations", request, newBatchAPIServiceListBatchOperationsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListBatchOperationsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyBatchAPIServiceListBatchOperationsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_BatchAPIYARPCCaller) StopBatchOperation(ctx context.Context, request *StopBatchOperationRequest, options ...yarpc.CallOption) (*StopBatchOperationResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "StopBatchOperation", request, newBatchAPIServiceStopBatchOperationYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StopBatchOperationResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyBatchAPIServiceStopBatchOperationYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _BatchAPIYARPCHandler struct {
+	server BatchAPIYARPCServer
+}
+
+func (h *_BatchAPIYARPCHandler) StartBatchOperation(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *StartBatchOperationRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*StartBatchOperationRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyBatchAPIServiceStartBatchOperationYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.StartBatchOperation(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_BatchAPIYARPCHandler) DescribeBatchOperation(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeBatchOperationRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeBatchOperationRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyBatchAPIServiceDescribeBatchOperationYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeBatchOperation(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_BatchAPIYARPCHandler) ListBatchOperations(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListBatchOperationsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListBatchOperationsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyBatchAPIServiceListBatchOperationsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListBatchOperations(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_BatchAPIYARPCHandler) StopBatchOperation(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *StopBatchOperationRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*StopBatchOperationRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyBatchAPIServiceStopBatchOperationYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.StopBatchOperation(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newBatchAPIServiceStartBatchOperationYARPCRequest() proto.Message {
+	return &StartBatchOperationRequest{}
+}
+
+func newBatchAPIServiceStartBatchOperationYARPCResponse() proto.Message {
+	return &StartBatchOperationResponse{}
+}
+
+func newBatchAPIServiceDescribeBatchOperationYARPCRequest() proto.Message {
+	return &DescribeBatchOperationRequest{}
+}
+
+func newBatchAPIServiceDescribeBatchOperationYARPCResponse() proto.Message {
+	return &DescribeBatchOperationResponse{}
+}
+
+func newBatchAPIServiceListBatchOperationsYARPCRequest() proto.Message {
+	return &ListBatchOperationsRequest{}
+}
+
+func newBatchAPIServiceListBatchOperationsYARPCResponse() proto.Message {
+	return &ListBatchOperationsResponse{}
+}
+
+func newBatchAPIServiceStopBatchOperationYARPCRequest() proto.Message {
+	return &StopBatchOperationRequest{}
+}
+
+func newBatchAPIServiceStopBatchOperationYARPCResponse() proto.Message {
+	return &StopBatchOperationResponse{}
+}
+
+var (
+	emptyBatchAPIServiceStartBatchOperationYARPCRequest     = &StartBatchOperationRequest{}
+	emptyBatchAPIServiceStartBatchOperationYARPCResponse    = &StartBatchOperationResponse{}
+	emptyBatchAPIServiceDescribeBatchOperationYARPCRequest  = &DescribeBatchOperationRequest{}
+	emptyBatchAPIServiceDescribeBatchOperationYARPCResponse = &DescribeBatchOperationResponse{}
+	emptyBatchAPIServiceListBatchOperationsYARPCRequest     = &ListBatchOperationsRequest{}
+	emptyBatchAPIServiceListBatchOperationsYARPCResponse    = &ListBatchOperationsResponse{}
+	emptyBatchAPIServiceStopBatchOperationYARPCRequest      = &StopBatchOperationRequest{}
+	emptyBatchAPIServiceStopBatchOperationYARPCResponse     = &StopBatchOperationResponse{}
+)
+
+var yarpcFileDescriptorClosure30ac851af015ed72 = [][]byte{
+	// uber/cadence/frontend/v1/batch.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdd, 0x6e, 0xdb, 0x36,
+		0x14, 0x9e, 0xe3, 0x24, 0x4d, 0x8e, 0x13, 0xc7, 0x65, 0x7f, 0xa0, 0x2a, 0x4d, 0xeb, 0xba, 0xfb,
+		0x09, 0x86, 0xcd, 0x5e, 0xd3, 0x16, 0x5b, 0x51, 0x0c, 0x98, 0xe3, 0xb8, 0xab, 0x81, 0xcc, 0x31,
+		0x64, 0xaf, 0xfb, 0xb9, 0x11, 0x28, 0xf9, 0xc4, 0x51, 0x2b, 0x89, 0x2a, 0x49, 0x65, 0x75, 0x2f,
+		0x36, 0x60, 0xb7, 0xbb, 0xdc, 0x23, 0xec, 0x39, 0x76, 0xb9, 0x87, 0xd8, 0xdb, 0x0c, 0x22, 0x69,
+		0xd7, 0x76, 0xed, 0x34, 0x01, 0x8a, 0xdd, 0x89, 0x87, 0xdf, 0xf7, 0xf1, 0x90, 0xfc, 0xce, 0x21,
+		0x04, 0x1f, 0xa6, 0x1e, 0xf2, 0x9a, 0x4f, 0xfb, 0x18, 0xfb, 0x58, 0x3b, 0xe6, 0x2c, 0x96, 0x18,
+		0xf7, 0x6b, 0xa7, 0xf7, 0x6a, 0x1e, 0x95, 0xfe, 0x49, 0x35, 0xe1, 0x4c, 0x32, 0x62, 0x65, 0xa8,
+		0xaa, 0x41, 0x55, 0x47, 0xa8, 0xea, 0xe9, 0x3d, 0xfb, 0xd6, 0x80, 0xb1, 0x41, 0x88, 0x35, 0x85,
+		0xf3, 0xd2, 0xe3, 0x5a, 0x3f, 0xe5, 0x54, 0x06, 0x2c, 0xd6, 0x4c, 0xfb, 0xf6, 0xec, 0xbc, 0x0c,
+		0x22, 0x14, 0x92, 0x46, 0x89, 0x01, 0xbc, 0x25, 0xf0, 0x0b, 0xa7, 0x49, 0x82, 0x5c, 0xe8, 0xf9,
+		0x4a, 0x17, 0xec, 0xfd, 0x2c, 0x93, 0xa3, 0x04, 0xb5, 0x70, 0x37, 0x18, 0xc4, 0x34, 0xec, 0x50,
+		0x4e, 0x23, 0x41, 0x6e, 0x43, 0x41, 0xa8, 0xb1, 0x1b, 0xd3, 0x08, 0xad, 0x5c, 0x39, 0xb7, 0xbb,
+		0xee, 0x80, 0x0e, 0xb5, 0x69, 0x84, 0xe4, 0x2a, 0xac, 0x04, 0x71, 0x92, 0x4a, 0x6b, 0xa9, 0x9c,
+		0xdb, 0xdd, 0x70, 0xf4, 0xa0, 0x12, 0xc1, 0xce, 0xb4, 0xa8, 0x83, 0x49, 0x18, 0xf8, 0x54, 0xa2,
+		0xd1, 0xfd, 0x08, 0x8a, 0x82, 0xa5, 0xdc, 0x47, 0xd7, 0x0f, 0x53, 0x21, 0x91, 0x1b, 0xe9, 0x4d,
+		0x1d, 0x6d, 0xe8, 0x60, 0x06, 0x93, 0x94, 0x0f, 0x50, 0x8e, 0x61, 0x4b, 0x1a, 0xa6, 0xa3, 0x06,
+		0x56, 0x69, 0xc2, 0xb5, 0xe9, 0xe5, 0x9e, 0xd0, 0x20, 0x4c, 0x39, 0x92, 0xeb, 0xb0, 0xca, 0x91,
+		0x0a, 0x16, 0x1b, 0x79, 0x33, 0xca, 0xb2, 0xf6, 0x59, 0x1a, 0xeb, 0xac, 0xf3, 0x8e, 0x1e, 0x54,
+		0xfe, 0x5d, 0x06, 0x32, 0xad, 0xd3, 0x8a, 0x8f, 0x19, 0xb9, 0x06, 0xab, 0xcf, 0x99, 0xe7, 0x06,
+		0x7d, 0x23, 0xb2, 0xf2, 0x9c, 0x79, 0xad, 0x7e, 0xa6, 0xdd, 0x67, 0x11, 0x0d, 0x62, 0x93, 0x93,
+		0x19, 0x91, 0x1d, 0x00, 0x75, 0xb5, 0xae, 0x1c, 0x26, 0x68, 0xe5, 0xd5, 0xdc, 0xba, 0x8a, 0xf4,
+		0x86, 0x89, 0x3a, 0xb0, 0x97, 0x29, 0xf2, 0xa1, 0xb5, 0xac, 0xc5, 0xd4, 0x60, 0x22, 0xd1, 0x95,
+		0xa9, 0x44, 0x6d, 0x58, 0x0b, 0xfa, 0x18, 0xcb, 0x40, 0x0e, 0xad, 0x55, 0x35, 0x33, 0x1e, 0x93,
+		0x06, 0xac, 0x08, 0x49, 0x25, 0x5a, 0x97, 0xca, 0xb9, 0xdd, 0xe2, 0xde, 0xe7, 0xd5, 0x45, 0x26,
+		0xaa, 0xce, 0x5c, 0x70, 0x46, 0x72, 0x34, 0x97, 0x3c, 0x02, 0x10, 0x92, 0x72, 0xe9, 0x66, 0xbe,
+		0xb1, 0xd6, 0xca, 0xb9, 0xdd, 0xc2, 0x9e, 0x5d, 0xd5, 0x9e, 0xa9, 0x8e, 0x3c, 0x53, 0xed, 0x8d,
+		0x4c, 0xe5, 0xac, 0x2b, 0x74, 0x36, 0xce, 0xa8, 0x7e, 0xc8, 0x04, 0x6a, 0xea, 0xfa, 0xbb, 0xa9,
+		0x0a, 0xad, 0xa8, 0xd9, 0xbd, 0x32, 0x49, 0x43, 0x17, 0x85, 0x0c, 0xa2, 0x6c, 0x0f, 0xa0, 0x2e,
+		0x62, 0x53, 0x45, 0x9b, 0x26, 0x48, 0x3e, 0x81, 0x2d, 0x91, 0xfa, 0x3e, 0x62, 0x1f, 0xfb, 0xae,
+		0xbe, 0xb0, 0x82, 0xc2, 0x15, 0xc7, 0xe1, 0x46, 0x16, 0x25, 0x77, 0x60, 0xe3, 0x98, 0x06, 0xe1,
+		0x18, 0xb5, 0xa1, 0x50, 0x05, 0x1d, 0xd3, 0x90, 0xbb, 0xb0, 0x29, 0x5e, 0x04, 0x49, 0x32, 0xc6,
+		0x6c, 0x2a, 0xcc, 0x86, 0x09, 0x6a, 0xd0, 0x8f, 0xb0, 0x75, 0xac, 0xad, 0xe3, 0xea, 0x0b, 0x10,
+		0x56, 0xb1, 0x9c, 0xdf, 0x2d, 0xec, 0xd5, 0xce, 0x7b, 0xb8, 0xc6, 0x79, 0x4e, 0xd1, 0xe8, 0x38,
+		0x5a, 0xa6, 0xf2, 0xf7, 0x2a, 0xd8, 0xdd, 0xec, 0xe8, 0x66, 0xeb, 0xe2, 0x65, 0x8a, 0x42, 0x4e,
+		0x98, 0x29, 0x37, 0x65, 0xa6, 0x37, 0xde, 0x5b, 0x9a, 0xf4, 0xde, 0xff, 0xe6, 0xb1, 0x1d, 0x00,
+		0xae, 0x53, 0xcc, 0x72, 0xb8, 0xa4, 0x17, 0x32, 0x91, 0x56, 0x9f, 0xfc, 0x04, 0x9b, 0xa6, 0x3d,
+		0x24, 0xaa, 0xae, 0x8d, 0x81, 0x1e, 0x9c, 0xdb, 0x8a, 0x13, 0xbd, 0xc6, 0xd9, 0x10, 0x13, 0x23,
+		0xe2, 0x41, 0x89, 0x8f, 0x9a, 0xc6, 0x48, 0x5d, 0x7b, 0xec, 0xcb, 0xf3, 0xaa, 0xcf, 0x34, 0x1d,
+		0x67, 0x8b, 0x4f, 0x07, 0x48, 0x0b, 0x88, 0x44, 0x1e, 0x05, 0x71, 0xb6, 0x86, 0x7f, 0x12, 0x84,
+		0x7d, 0x8e, 0xb1, 0x05, 0x0b, 0x9c, 0xbc, 0xcf, 0x58, 0xf8, 0x8c, 0x86, 0x29, 0x3a, 0x97, 0xc7,
+		0xac, 0x86, 0x21, 0x91, 0x06, 0x6c, 0xf9, 0x34, 0xf6, 0x31, 0x7c, 0xa3, 0x53, 0x78, 0xa7, 0x4e,
+		0x51, 0x53, 0xc6, 0x22, 0x25, 0xc8, 0xf3, 0x44, 0x28, 0xf7, 0xae, 0x38, 0xd9, 0x27, 0x29, 0x43,
+		0xc1, 0x67, 0xb1, 0x9f, 0x72, 0x8e, 0xb1, 0x3f, 0x54, 0x9e, 0x5d, 0x71, 0x26, 0x43, 0x64, 0x1b,
+		0xd6, 0x13, 0x3a, 0x40, 0x57, 0x04, 0xaf, 0xd1, 0x2a, 0xaa, 0xf9, 0xb5, 0x2c, 0xd0, 0x0d, 0x5e,
+		0x23, 0xf9, 0x1a, 0xb6, 0xa9, 0x94, 0x18, 0x25, 0x52, 0xb8, 0x2c, 0x76, 0x39, 0x4a, 0x3e, 0xa4,
+		0x5e, 0x88, 0x2e, 0x72, 0xce, 0xb8, 0xb5, 0xa5, 0xe0, 0xd6, 0x08, 0x72, 0x14, 0x3b, 0x23, 0x40,
+		0x33, 0x9b, 0x27, 0x3f, 0x80, 0x4d, 0x7d, 0x19, 0x9c, 0x06, 0x72, 0xe8, 0x9e, 0x20, 0xe5, 0xd2,
+		0x43, 0xaa, 0x3b, 0x05, 0x4b, 0xa5, 0x55, 0x52, 0xfb, 0xbb, 0xf1, 0xd6, 0xfe, 0x0e, 0xcc, 0x0b,
+		0xe5, 0x58, 0x23, 0xf2, 0xd3, 0x11, 0xb7, 0xa7, 0xa9, 0xe4, 0x0b, 0xb8, 0x1a, 0xd1, 0x57, 0xee,
+		0x58, 0x3c, 0x4b, 0x2c, 0x40, 0x61, 0x5d, 0x56, 0x09, 0x91, 0x88, 0xbe, 0xaa, 0x9b, 0x29, 0x47,
+		0xcf, 0x54, 0x1e, 0xc0, 0xf6, 0xdc, 0xf2, 0x11, 0x09, 0x8b, 0x05, 0x2e, 0xe8, 0xd1, 0x95, 0x36,
+		0xec, 0x1c, 0xa0, 0xf0, 0x79, 0xe0, 0xe1, 0xfb, 0xa8, 0xbb, 0x8a, 0x07, 0xb7, 0x16, 0xe9, 0x99,
+		0x44, 0xbe, 0x81, 0xe5, 0x20, 0x3e, 0x66, 0x4a, 0xae, 0xb0, 0xf7, 0xd9, 0x79, 0xad, 0x9a, 0x3d,
+		0x34, 0x8e, 0x62, 0x56, 0x86, 0x60, 0x1f, 0x06, 0x62, 0x66, 0xa3, 0xe2, 0x5d, 0x09, 0x4f, 0xd9,
+		0x60, 0x69, 0xc6, 0x06, 0x1f, 0xc3, 0x56, 0x8c, 0xaf, 0xa4, 0xab, 0x10, 0x92, 0xbd, 0xc0, 0x58,
+		0xf5, 0x8c, 0x0d, 0x67, 0x33, 0x0b, 0x77, 0xe8, 0x00, 0x7b, 0x59, 0xb0, 0xf2, 0x67, 0x0e, 0xb6,
+		0xe7, 0xae, 0x6d, 0x36, 0x77, 0x08, 0xc0, 0xc6, 0x51, 0x2b, 0x57, 0xce, 0x5f, 0x78, 0x8b, 0x13,
+		0xfc, 0x79, 0x59, 0x2d, 0xcd, 0xcb, 0xea, 0x57, 0xb8, 0xd1, 0x95, 0x2c, 0x79, 0x2f, 0x8d, 0xf3,
+		0x4d, 0x0f, 0xcc, 0x2f, 0xec, 0x81, 0xcb, 0xd3, 0x3d, 0xb0, 0x72, 0x13, 0xec, 0x79, 0xeb, 0xeb,
+		0x33, 0xf9, 0xf4, 0x9f, 0x1c, 0x5c, 0x99, 0xf3, 0xbe, 0x92, 0x3b, 0xb0, 0xb3, 0x5f, 0xef, 0x35,
+		0x9e, 0xba, 0x47, 0x9d, 0xa6, 0x53, 0xef, 0xb5, 0x8e, 0xda, 0x6e, 0xb7, 0x57, 0xef, 0x35, 0xdd,
+		0x56, 0xfb, 0x59, 0xfd, 0xb0, 0x75, 0x50, 0xfa, 0x60, 0x31, 0xc4, 0xf9, 0xbe, 0xdd, 0x6e, 0xb5,
+		0xbf, 0x2d, 0xe5, 0xc8, 0x5d, 0xb8, 0x3d, 0x1f, 0xd2, 0x38, 0xfa, 0xae, 0x73, 0xd8, 0xec, 0x35,
+		0x0f, 0x4a, 0x4b, 0xa4, 0x0c, 0x37, 0xe7, 0x83, 0x9e, 0xd4, 0x5b, 0x87, 0xcd, 0x83, 0x52, 0x7e,
+		0xf1, 0x4a, 0xdd, 0xde, 0x51, 0xa7, 0xd3, 0x3c, 0x28, 0x2d, 0xef, 0xfd, 0xb5, 0x0c, 0x6b, 0x6a,
+		0x1f, 0xf5, 0x4e, 0x8b, 0xfc, 0x9e, 0x83, 0x2b, 0x73, 0xca, 0x8d, 0x9c, 0xd1, 0xd8, 0x17, 0x3f,
+		0x6e, 0xf6, 0xc3, 0x0b, 0xb2, 0x8c, 0xdb, 0xfe, 0xc8, 0xc1, 0xf5, 0xf9, 0xd5, 0x46, 0xce, 0x78,
+		0x02, 0xce, 0xac, 0x77, 0xfb, 0xab, 0x8b, 0x13, 0x4d, 0x36, 0xd9, 0x91, 0xcc, 0xa9, 0x8d, 0xb3,
+		0x8e, 0x64, 0x71, 0x19, 0xdb, 0x0f, 0x2f, 0xc8, 0x32, 0x49, 0xfc, 0x06, 0xe4, 0x6d, 0x2b, 0x92,
+		0xfb, 0x67, 0x9d, 0xef, 0x82, 0xc2, 0xb1, 0x1f, 0x5c, 0x8c, 0xa4, 0x13, 0xd8, 0x7f, 0xfc, 0xf3,
+		0xa3, 0x41, 0x20, 0x4f, 0x52, 0xaf, 0xea, 0xb3, 0xa8, 0x36, 0xf5, 0x6f, 0x53, 0x1d, 0x60, 0xac,
+		0xff, 0x32, 0x26, 0x7f, 0x73, 0x1e, 0x8f, 0xbe, 0x4f, 0xef, 0x79, 0xab, 0x6a, 0xf6, 0xfe, 0x7f,
+		0x03, 0x00, 0x2b, 0xa8, 0x8b, 0x00, 0x14, 0x0d, 0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) BatchAPIYARPCClient {
+			return NewBatchAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
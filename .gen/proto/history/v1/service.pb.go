@@ -11,10 +11,10 @@ import (
 
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
-	v11 "github.com/uber/cadence-idl/go/proto/admin/v1"
+	v12 "github.com/uber/cadence-idl/go/proto/admin/v1"
 	v1 "github.com/uber/cadence-idl/go/proto/api/v1"
 
-	v12 "github.com/uber/cadence/.gen/proto/shared/v1"
+	v11 "github.com/uber/cadence/.gen/proto/shared/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -39,9 +39,14 @@ type StartWorkflowExecutionRequest struct {
 	LastCompletionResult     *v1.Payload                       `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
 	FirstDecisionTaskBackoff *types.Duration                   `protobuf:"bytes,9,opt,name=first_decision_task_backoff,json=firstDecisionTaskBackoff,proto3" json:"first_decision_task_backoff,omitempty"`
 	PartitionConfig          map[string]string                 `protobuf:"bytes,10,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral     struct{}                          `json:"-"`
-	XXX_unrecognized         []byte                            `json:"-"`
-	XXX_sizecache            int32                             `json:"-"`
+	CompletionCallbacks      []*v11.CompletionCallback         `protobuf:"bytes,11,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
+	// the active cluster selection policy of the request has no workflow ID hash in the public IDL yet
+	WorkflowIdHash *v11.WorkflowIdHashPolicy `protobuf:"bytes,12,opt,name=workflow_id_hash,json=workflowIdHash,proto3" json:"workflow_id_hash,omitempty"`
+	// the public IDL has no workflow ID conflict policy yet
+	WorkflowIdConflictPolicy v11.WorkflowIdConflictPolicy `protobuf:"varint,13,opt,name=workflow_id_conflict_policy,json=workflowIdConflictPolicy,proto3,enum=uber.cadence.shared.v1.WorkflowIdConflictPolicy" json:"workflow_id_conflict_policy,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                     `json:"-"`
+	XXX_unrecognized         []byte                       `json:"-"`
+	XXX_sizecache            int32                        `json:"-"`
 }
 
 func (m *StartWorkflowExecutionRequest) Reset()         { *m = StartWorkflowExecutionRequest{} }
@@ -147,6 +152,27 @@ func (m *StartWorkflowExecutionRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetCompletionCallbacks() []*v11.CompletionCallback {
+	if m != nil {
+		return m.CompletionCallbacks
+	}
+	return nil
+}
+
+func (m *StartWorkflowExecutionRequest) GetWorkflowIdHash() *v11.WorkflowIdHashPolicy {
+	if m != nil {
+		return m.WorkflowIdHash
+	}
+	return nil
+}
+
+func (m *StartWorkflowExecutionRequest) GetWorkflowIdConflictPolicy() v11.WorkflowIdConflictPolicy {
+	if m != nil {
+		return m.WorkflowIdConflictPolicy
+	}
+	return v11.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -308,12 +334,16 @@ func (m *SignalWorkflowExecutionResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_SignalWorkflowExecutionResponse proto.InternalMessageInfo
 
 type SignalWithStartWorkflowExecutionRequest struct {
-	Request              *v1.SignalWithStartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                                      `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	PartitionConfig      map[string]string                           `protobuf:"bytes,3,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
-	XXX_unrecognized     []byte                                      `json:"-"`
-	XXX_sizecache        int32                                       `json:"-"`
+	Request         *v1.SignalWithStartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId        string                                      `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	PartitionConfig map[string]string                           `protobuf:"bytes,3,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// the active cluster selection policy of the request has no workflow ID hash in the public IDL yet
+	WorkflowIdHash *v11.WorkflowIdHashPolicy `protobuf:"bytes,4,opt,name=workflow_id_hash,json=workflowIdHash,proto3" json:"workflow_id_hash,omitempty"`
+	// the public IDL has no workflow ID conflict policy yet
+	WorkflowIdConflictPolicy v11.WorkflowIdConflictPolicy `protobuf:"varint,5,opt,name=workflow_id_conflict_policy,json=workflowIdConflictPolicy,proto3,enum=uber.cadence.shared.v1.WorkflowIdConflictPolicy" json:"workflow_id_conflict_policy,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                     `json:"-"`
+	XXX_unrecognized         []byte                       `json:"-"`
+	XXX_sizecache            int32                        `json:"-"`
 }
 
 func (m *SignalWithStartWorkflowExecutionRequest) Reset() {
@@ -372,6 +402,20 @@ func (m *SignalWithStartWorkflowExecutionRequest) GetPartitionConfig() map[strin
 	return nil
 }
 
+func (m *SignalWithStartWorkflowExecutionRequest) GetWorkflowIdHash() *v11.WorkflowIdHashPolicy {
+	if m != nil {
+		return m.WorkflowIdHash
+	}
+	return nil
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) GetWorkflowIdConflictPolicy() v11.WorkflowIdConflictPolicy {
+	if m != nil {
+		return m.WorkflowIdConflictPolicy
+	}
+	return v11.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID
+}
+
 type SignalWithStartWorkflowExecutionResponse struct {
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -697,6 +741,8 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*v1.PendingActivityInfo          `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
 	PendingChildren        []*v1.PendingChildExecutionInfo    `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
 	PendingDecision        *v1.PendingDecisionInfo            `protobuf:"bytes,5,opt,name=pending_decision,json=pendingDecision,proto3" json:"pending_decision,omitempty"`
+	ReplicationExcluded    bool                               `protobuf:"varint,6,opt,name=replication_excluded,json=replicationExcluded,proto3" json:"replication_excluded,omitempty"`
+	CompletionCallbacks    []*v11.CompletionCallbackInfo      `protobuf:"bytes,7,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}                           `json:"-"`
 	XXX_unrecognized       []byte                             `json:"-"`
 	XXX_sizecache          int32                              `json:"-"`
@@ -770,6 +816,20 @@ func (m *DescribeWorkflowExecutionResponse) GetPendingDecision() *v1.PendingDeci
 	return nil
 }
 
+func (m *DescribeWorkflowExecutionResponse) GetReplicationExcluded() bool {
+	if m != nil {
+		return m.ReplicationExcluded
+	}
+	return false
+}
+
+func (m *DescribeWorkflowExecutionResponse) GetCompletionCallbacks() []*v11.CompletionCallbackInfo {
+	if m != nil {
+		return m.CompletionCallbacks
+	}
+	return nil
+}
+
 type QueryWorkflowRequest struct {
 	Request              *v1.QueryWorkflowRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -979,7 +1039,7 @@ type GetMutableStateRequest struct {
 	WorkflowExecution    *v1.WorkflowExecution   `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
 	ExpectedNextEventId  int64                   `protobuf:"varint,3,opt,name=expected_next_event_id,json=expectedNextEventId,proto3" json:"expected_next_event_id,omitempty"`
 	CurrentBranchToken   []byte                  `protobuf:"bytes,4,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
-	VersionHistoryItem   *v11.VersionHistoryItem `protobuf:"bytes,5,opt,name=version_history_item,json=versionHistoryItem,proto3" json:"version_history_item,omitempty"`
+	VersionHistoryItem   *v12.VersionHistoryItem `protobuf:"bytes,5,opt,name=version_history_item,json=versionHistoryItem,proto3" json:"version_history_item,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1046,7 +1106,7 @@ func (m *GetMutableStateRequest) GetCurrentBranchToken() []byte {
 	return nil
 }
 
-func (m *GetMutableStateRequest) GetVersionHistoryItem() *v11.VersionHistoryItem {
+func (m *GetMutableStateRequest) GetVersionHistoryItem() *v12.VersionHistoryItem {
 	if m != nil {
 		return m.VersionHistoryItem
 	}
@@ -1067,9 +1127,9 @@ type GetMutableStateResponse struct {
 	StickyTaskListScheduleToStartTimeout *types.Duration                 `protobuf:"bytes,11,opt,name=sticky_task_list_schedule_to_start_timeout,json=stickyTaskListScheduleToStartTimeout,proto3" json:"sticky_task_list_schedule_to_start_timeout,omitempty"`
 	EventStoreVersion                    int32                           `protobuf:"varint,12,opt,name=event_store_version,json=eventStoreVersion,proto3" json:"event_store_version,omitempty"`
 	CurrentBranchToken                   []byte                          `protobuf:"bytes,13,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
-	WorkflowState                        v12.WorkflowState               `protobuf:"varint,14,opt,name=workflow_state,json=workflowState,proto3,enum=uber.cadence.shared.v1.WorkflowState" json:"workflow_state,omitempty"`
+	WorkflowState                        v11.WorkflowState               `protobuf:"varint,14,opt,name=workflow_state,json=workflowState,proto3,enum=uber.cadence.shared.v1.WorkflowState" json:"workflow_state,omitempty"`
 	WorkflowCloseState                   v1.WorkflowExecutionCloseStatus `protobuf:"varint,15,opt,name=workflow_close_state,json=workflowCloseState,proto3,enum=uber.cadence.api.v1.WorkflowExecutionCloseStatus" json:"workflow_close_state,omitempty"`
-	VersionHistories                     *v12.VersionHistories           `protobuf:"bytes,16,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	VersionHistories                     *v11.VersionHistories           `protobuf:"bytes,16,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
 	IsStickyTaskListEnabled              bool                            `protobuf:"varint,17,opt,name=is_sticky_task_list_enabled,json=isStickyTaskListEnabled,proto3" json:"is_sticky_task_list_enabled,omitempty"`
 	HistorySize                          int64                           `protobuf:"varint,18,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 	XXX_NoUnkeyedLiteral                 struct{}                        `json:"-"`
@@ -1201,11 +1261,11 @@ func (m *GetMutableStateResponse) GetCurrentBranchToken() []byte {
 	return nil
 }
 
-func (m *GetMutableStateResponse) GetWorkflowState() v12.WorkflowState {
+func (m *GetMutableStateResponse) GetWorkflowState() v11.WorkflowState {
 	if m != nil {
 		return m.WorkflowState
 	}
-	return v12.WorkflowState_WORKFLOW_STATE_INVALID
+	return v11.WorkflowState_WORKFLOW_STATE_INVALID
 }
 
 func (m *GetMutableStateResponse) GetWorkflowCloseState() v1.WorkflowExecutionCloseStatus {
@@ -1215,7 +1275,7 @@ func (m *GetMutableStateResponse) GetWorkflowCloseState() v1.WorkflowExecutionCl
 	return v1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
 }
 
-func (m *GetMutableStateResponse) GetVersionHistories() *v12.VersionHistories {
+func (m *GetMutableStateResponse) GetVersionHistories() *v11.VersionHistories {
 	if m != nil {
 		return m.VersionHistories
 	}
@@ -1320,8 +1380,8 @@ type PollMutableStateResponse struct {
 	ClientImpl                           string                          `protobuf:"bytes,10,opt,name=client_impl,json=clientImpl,proto3" json:"client_impl,omitempty"`
 	StickyTaskListScheduleToStartTimeout *types.Duration                 `protobuf:"bytes,11,opt,name=sticky_task_list_schedule_to_start_timeout,json=stickyTaskListScheduleToStartTimeout,proto3" json:"sticky_task_list_schedule_to_start_timeout,omitempty"`
 	CurrentBranchToken                   []byte                          `protobuf:"bytes,12,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
-	VersionHistories                     *v12.VersionHistories           `protobuf:"bytes,13,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
-	WorkflowState                        v12.WorkflowState               `protobuf:"varint,14,opt,name=workflow_state,json=workflowState,proto3,enum=uber.cadence.shared.v1.WorkflowState" json:"workflow_state,omitempty"`
+	VersionHistories                     *v11.VersionHistories           `protobuf:"bytes,13,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	WorkflowState                        v11.WorkflowState               `protobuf:"varint,14,opt,name=workflow_state,json=workflowState,proto3,enum=uber.cadence.shared.v1.WorkflowState" json:"workflow_state,omitempty"`
 	WorkflowCloseState                   v1.WorkflowExecutionCloseStatus `protobuf:"varint,15,opt,name=workflow_close_state,json=workflowCloseState,proto3,enum=uber.cadence.api.v1.WorkflowExecutionCloseStatus" json:"workflow_close_state,omitempty"`
 	XXX_NoUnkeyedLiteral                 struct{}                        `json:"-"`
 	XXX_unrecognized                     []byte                          `json:"-"`
@@ -1445,18 +1505,18 @@ func (m *PollMutableStateResponse) GetCurrentBranchToken() []byte {
 	return nil
 }
 
-func (m *PollMutableStateResponse) GetVersionHistories() *v12.VersionHistories {
+func (m *PollMutableStateResponse) GetVersionHistories() *v11.VersionHistories {
 	if m != nil {
 		return m.VersionHistories
 	}
 	return nil
 }
 
-func (m *PollMutableStateResponse) GetWorkflowState() v12.WorkflowState {
+func (m *PollMutableStateResponse) GetWorkflowState() v11.WorkflowState {
 	if m != nil {
 		return m.WorkflowState
 	}
-	return v12.WorkflowState_WORKFLOW_STATE_INVALID
+	return v11.WorkflowState_WORKFLOW_STATE_INVALID
 }
 
 func (m *PollMutableStateResponse) GetWorkflowCloseState() v1.WorkflowExecutionCloseStatus {
@@ -1562,7 +1622,7 @@ type RecordDecisionTaskStartedResponse struct {
 	NextEventId               int64                        `protobuf:"varint,5,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
 	Attempt                   int32                        `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
 	StickyExecutionEnabled    bool                         `protobuf:"varint,7,opt,name=sticky_execution_enabled,json=stickyExecutionEnabled,proto3" json:"sticky_execution_enabled,omitempty"`
-	DecisionInfo              *v12.TransientDecisionInfo   `protobuf:"bytes,8,opt,name=decision_info,json=decisionInfo,proto3" json:"decision_info,omitempty"`
+	DecisionInfo              *v11.TransientDecisionInfo   `protobuf:"bytes,8,opt,name=decision_info,json=decisionInfo,proto3" json:"decision_info,omitempty"`
 	WorkflowExecutionTaskList *v1.TaskList                 `protobuf:"bytes,9,opt,name=workflow_execution_task_list,json=workflowExecutionTaskList,proto3" json:"workflow_execution_task_list,omitempty"`
 	EventStoreVersion         int32                        `protobuf:"varint,10,opt,name=event_store_version,json=eventStoreVersion,proto3" json:"event_store_version,omitempty"`
 	BranchToken               []byte                       `protobuf:"bytes,11,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
@@ -1570,6 +1630,8 @@ type RecordDecisionTaskStartedResponse struct {
 	StartedTime               *types.Timestamp             `protobuf:"bytes,13,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
 	Queries                   map[string]*v1.WorkflowQuery `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	HistorySize               int64                        `protobuf:"varint,15,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	HistoryCount              int64                        `protobuf:"varint,16,opt,name=history_count,json=historyCount,proto3" json:"history_count,omitempty"`
+	ContinueAsNewSuggested    bool                         `protobuf:"varint,17,opt,name=continue_as_new_suggested,json=continueAsNewSuggested,proto3" json:"continue_as_new_suggested,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}                     `json:"-"`
 	XXX_unrecognized          []byte                       `json:"-"`
 	XXX_sizecache             int32                        `json:"-"`
@@ -1657,7 +1719,7 @@ func (m *RecordDecisionTaskStartedResponse) GetStickyExecutionEnabled() bool {
 	return false
 }
 
-func (m *RecordDecisionTaskStartedResponse) GetDecisionInfo() *v12.TransientDecisionInfo {
+func (m *RecordDecisionTaskStartedResponse) GetDecisionInfo() *v11.TransientDecisionInfo {
 	if m != nil {
 		return m.DecisionInfo
 	}
@@ -1713,6 +1775,20 @@ func (m *RecordDecisionTaskStartedResponse) GetHistorySize() int64 {
 	return 0
 }
 
+func (m *RecordDecisionTaskStartedResponse) GetHistoryCount() int64 {
+	if m != nil {
+		return m.HistoryCount
+	}
+	return 0
+}
+
+func (m *RecordDecisionTaskStartedResponse) GetContinueAsNewSuggested() bool {
+	if m != nil {
+		return m.ContinueAsNewSuggested
+	}
+	return false
+}
+
 type RecordActivityTaskStartedRequest struct {
 	DomainId          string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -2937,7 +3013,7 @@ var xxx_messageInfo_RecordChildExecutionCompletedResponse proto.InternalMessageI
 type ReplicateEventsV2Request struct {
 	DomainId            string                    `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	WorkflowExecution   *v1.WorkflowExecution     `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	VersionHistoryItems []*v11.VersionHistoryItem `protobuf:"bytes,3,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
+	VersionHistoryItems []*v12.VersionHistoryItem `protobuf:"bytes,3,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
 	Events              *v1.DataBlob              `protobuf:"bytes,4,opt,name=events,proto3" json:"events,omitempty"`
 	// New run events does not need version history since there is no prior events.
 	NewRunEvents         *v1.DataBlob `protobuf:"bytes,5,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
@@ -2993,7 +3069,7 @@ func (m *ReplicateEventsV2Request) GetWorkflowExecution() *v1.WorkflowExecution
 	return nil
 }
 
-func (m *ReplicateEventsV2Request) GetVersionHistoryItems() []*v11.VersionHistoryItem {
+func (m *ReplicateEventsV2Request) GetVersionHistoryItems() []*v12.VersionHistoryItem {
 	if m != nil {
 		return m.VersionHistoryItems
 	}
@@ -3168,7 +3244,7 @@ type SyncActivityRequest struct {
 	Attempt              int32                 `protobuf:"varint,10,opt,name=attempt,proto3" json:"attempt,omitempty"`
 	LastFailure          *v1.Failure           `protobuf:"bytes,11,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
 	LastWorkerIdentity   string                `protobuf:"bytes,12,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
-	VersionHistory       *v11.VersionHistory   `protobuf:"bytes,13,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
+	VersionHistory       *v12.VersionHistory   `protobuf:"bytes,13,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -3291,7 +3367,7 @@ func (m *SyncActivityRequest) GetLastWorkerIdentity() string {
 	return ""
 }
 
-func (m *SyncActivityRequest) GetVersionHistory() *v11.VersionHistory {
+func (m *SyncActivityRequest) GetVersionHistory() *v12.VersionHistory {
 	if m != nil {
 		return m.VersionHistory
 	}
@@ -3489,7 +3565,7 @@ var xxx_messageInfo_DescribeHistoryHostRequest proto.InternalMessageInfo
 type DescribeHistoryHostResponse struct {
 	NumberOfShards        int32                `protobuf:"varint,1,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
 	ShardIds              []int32              `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
-	DomainCache           *v11.DomainCacheInfo `protobuf:"bytes,3,opt,name=domain_cache,json=domainCache,proto3" json:"domain_cache,omitempty"`
+	DomainCache           *v12.DomainCacheInfo `protobuf:"bytes,3,opt,name=domain_cache,json=domainCache,proto3" json:"domain_cache,omitempty"`
 	ShardControllerStatus string               `protobuf:"bytes,4,opt,name=shard_controller_status,json=shardControllerStatus,proto3" json:"shard_controller_status,omitempty"`
 	Address               string               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
@@ -3544,7 +3620,7 @@ func (m *DescribeHistoryHostResponse) GetShardIds() []int32 {
 	return nil
 }
 
-func (m *DescribeHistoryHostResponse) GetDomainCache() *v11.DomainCacheInfo {
+func (m *DescribeHistoryHostResponse) GetDomainCache() *v12.DomainCacheInfo {
 	if m != nil {
 		return m.DomainCache
 	}
@@ -3653,7 +3729,7 @@ var xxx_messageInfo_CloseShardResponse proto.InternalMessageInfo
 
 type RemoveTaskRequest struct {
 	ShardId              int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	TaskType             v11.TaskType     `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
+	TaskType             v12.TaskType     `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
 	TaskId               int64            `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	VisibilityTime       *types.Timestamp `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3" json:"visibility_time,omitempty"`
 	ClusterName          string           `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
@@ -3702,11 +3778,11 @@ func (m *RemoveTaskRequest) GetShardId() int32 {
 	return 0
 }
 
-func (m *RemoveTaskRequest) GetTaskType() v11.TaskType {
+func (m *RemoveTaskRequest) GetTaskType() v12.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v11.TaskType_TASK_TYPE_INVALID
+	return v12.TaskType_TASK_TYPE_INVALID
 }
 
 func (m *RemoveTaskRequest) GetTaskId() int64 {
@@ -3772,7 +3848,7 @@ var xxx_messageInfo_RemoveTaskResponse proto.InternalMessageInfo
 type ResetQueueRequest struct {
 	ShardId              int32        `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	ClusterName          string       `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	TaskType             v11.TaskType `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
+	TaskType             v12.TaskType `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -3825,11 +3901,11 @@ func (m *ResetQueueRequest) GetClusterName() string {
 	return ""
 }
 
-func (m *ResetQueueRequest) GetTaskType() v11.TaskType {
+func (m *ResetQueueRequest) GetTaskType() v12.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v11.TaskType_TASK_TYPE_INVALID
+	return v12.TaskType_TASK_TYPE_INVALID
 }
 
 type ResetQueueResponse struct {
@@ -3874,7 +3950,7 @@ var xxx_messageInfo_ResetQueueResponse proto.InternalMessageInfo
 type DescribeQueueRequest struct {
 	ShardId              int32        `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	ClusterName          string       `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	TaskType             v11.TaskType `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
+	TaskType             v12.TaskType `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3,enum=uber.cadence.admin.v1.TaskType" json:"task_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -3927,11 +4003,11 @@ func (m *DescribeQueueRequest) GetClusterName() string {
 	return ""
 }
 
-func (m *DescribeQueueRequest) GetTaskType() v11.TaskType {
+func (m *DescribeQueueRequest) GetTaskType() v12.TaskType {
 	if m != nil {
 		return m.TaskType
 	}
-	return v11.TaskType_TASK_TYPE_INVALID
+	return v12.TaskType_TASK_TYPE_INVALID
 }
 
 type DescribeQueueResponse struct {
@@ -3982,7 +4058,7 @@ func (m *DescribeQueueResponse) GetProcessingQueueStates() []string {
 }
 
 type GetReplicationMessagesRequest struct {
-	Tokens               []*v11.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Tokens               []*v12.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	ClusterName          string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
@@ -4022,7 +4098,7 @@ func (m *GetReplicationMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetReplicationMessagesRequest proto.InternalMessageInfo
 
-func (m *GetReplicationMessagesRequest) GetTokens() []*v11.ReplicationToken {
+func (m *GetReplicationMessagesRequest) GetTokens() []*v12.ReplicationToken {
 	if m != nil {
 		return m.Tokens
 	}
@@ -4037,7 +4113,7 @@ func (m *GetReplicationMessagesRequest) GetClusterName() string {
 }
 
 type GetReplicationMessagesResponse struct {
-	ShardMessages        map[int32]*v11.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ShardMessages        map[int32]*v12.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
 	XXX_unrecognized     []byte                             `json:"-"`
 	XXX_sizecache        int32                              `json:"-"`
@@ -4076,7 +4152,7 @@ func (m *GetReplicationMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetReplicationMessagesResponse proto.InternalMessageInfo
 
-func (m *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v11.ReplicationMessages {
+func (m *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v12.ReplicationMessages {
 	if m != nil {
 		return m.ShardMessages
 	}
@@ -4084,7 +4160,7 @@ func (m *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v11.Repli
 }
 
 type GetDLQReplicationMessagesRequest struct {
-	TaskInfos            []*v11.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
+	TaskInfos            []*v12.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -4123,7 +4199,7 @@ func (m *GetDLQReplicationMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDLQReplicationMessagesRequest proto.InternalMessageInfo
 
-func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v11.ReplicationTaskInfo {
+func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v12.ReplicationTaskInfo {
 	if m != nil {
 		return m.TaskInfos
 	}
@@ -4131,7 +4207,7 @@ func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v11.ReplicationTask
 }
 
 type GetDLQReplicationMessagesResponse struct {
-	ReplicationTasks     []*v11.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	ReplicationTasks     []*v12.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -4170,7 +4246,7 @@ func (m *GetDLQReplicationMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDLQReplicationMessagesResponse proto.InternalMessageInfo
 
-func (m *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v11.ReplicationTask {
+func (m *GetDLQReplicationMessagesResponse) GetReplicationTasks() []*v12.ReplicationTask {
 	if m != nil {
 		return m.ReplicationTasks
 	}
@@ -4437,7 +4513,7 @@ func (m *CountDLQMessagesRequest) GetForceFetch() bool {
 }
 
 type CountDLQMessagesResponse struct {
-	Entries              []*v11.HistoryDLQCountEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Entries              []*v12.HistoryDLQCountEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
 	XXX_sizecache        int32                       `json:"-"`
@@ -4476,7 +4552,7 @@ func (m *CountDLQMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CountDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *CountDLQMessagesResponse) GetEntries() []*v11.HistoryDLQCountEntry {
+func (m *CountDLQMessagesResponse) GetEntries() []*v12.HistoryDLQCountEntry {
 	if m != nil {
 		return m.Entries
 	}
@@ -4484,7 +4560,7 @@ func (m *CountDLQMessagesResponse) GetEntries() []*v11.HistoryDLQCountEntry {
 }
 
 type ReadDLQMessagesRequest struct {
-	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	Type                  v12.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
 	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -4528,11 +4604,11 @@ func (m *ReadDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReadDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *ReadDLQMessagesRequest) GetType() v11.DLQType {
+func (m *ReadDLQMessagesRequest) GetType() v12.DLQType {
 	if m != nil {
 		return m.Type
 	}
-	return v11.DLQType_DLQ_TYPE_INVALID
+	return v12.DLQType_DLQ_TYPE_INVALID
 }
 
 func (m *ReadDLQMessagesRequest) GetShardId() int32 {
@@ -4571,9 +4647,9 @@ func (m *ReadDLQMessagesRequest) GetNextPageToken() []byte {
 }
 
 type ReadDLQMessagesResponse struct {
-	Type                 v11.DLQType                `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
-	ReplicationTasks     []*v11.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
-	ReplicationTasksInfo []*v11.ReplicationTaskInfo `protobuf:"bytes,3,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
+	Type                 v12.DLQType                `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	ReplicationTasks     []*v12.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
+	ReplicationTasksInfo []*v12.ReplicationTaskInfo `protobuf:"bytes,3,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
 	NextPageToken        []byte                     `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -4613,21 +4689,21 @@ func (m *ReadDLQMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReadDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *ReadDLQMessagesResponse) GetType() v11.DLQType {
+func (m *ReadDLQMessagesResponse) GetType() v12.DLQType {
 	if m != nil {
 		return m.Type
 	}
-	return v11.DLQType_DLQ_TYPE_INVALID
+	return v12.DLQType_DLQ_TYPE_INVALID
 }
 
-func (m *ReadDLQMessagesResponse) GetReplicationTasks() []*v11.ReplicationTask {
+func (m *ReadDLQMessagesResponse) GetReplicationTasks() []*v12.ReplicationTask {
 	if m != nil {
 		return m.ReplicationTasks
 	}
 	return nil
 }
 
-func (m *ReadDLQMessagesResponse) GetReplicationTasksInfo() []*v11.ReplicationTaskInfo {
+func (m *ReadDLQMessagesResponse) GetReplicationTasksInfo() []*v12.ReplicationTaskInfo {
 	if m != nil {
 		return m.ReplicationTasksInfo
 	}
@@ -4642,7 +4718,7 @@ func (m *ReadDLQMessagesResponse) GetNextPageToken() []byte {
 }
 
 type PurgeDLQMessagesRequest struct {
-	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	Type                  v12.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
 	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -4684,11 +4760,11 @@ func (m *PurgeDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PurgeDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *PurgeDLQMessagesRequest) GetType() v11.DLQType {
+func (m *PurgeDLQMessagesRequest) GetType() v12.DLQType {
 	if m != nil {
 		return m.Type
 	}
-	return v11.DLQType_DLQ_TYPE_INVALID
+	return v12.DLQType_DLQ_TYPE_INVALID
 }
 
 func (m *PurgeDLQMessagesRequest) GetShardId() int32 {
@@ -4752,7 +4828,7 @@ func (m *PurgeDLQMessagesResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_PurgeDLQMessagesResponse proto.InternalMessageInfo
 
 type MergeDLQMessagesRequest struct {
-	Type                  v11.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
+	Type                  v12.DLQType       `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.admin.v1.DLQType" json:"type,omitempty"`
 	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -4796,11 +4872,11 @@ func (m *MergeDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MergeDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *MergeDLQMessagesRequest) GetType() v11.DLQType {
+func (m *MergeDLQMessagesRequest) GetType() v12.DLQType {
 	if m != nil {
 		return m.Type
 	}
-	return v11.DLQType_DLQ_TYPE_INVALID
+	return v12.DLQType_DLQ_TYPE_INVALID
 }
 
 func (m *MergeDLQMessagesRequest) GetShardId() int32 {
@@ -4886,7 +4962,7 @@ func (m *MergeDLQMessagesResponse) GetNextPageToken() []byte {
 }
 
 type NotifyFailoverMarkersRequest struct {
-	FailoverMarkerTokens []*v11.FailoverMarkerToken `protobuf:"bytes,1,rep,name=failover_marker_tokens,json=failoverMarkerTokens,proto3" json:"failover_marker_tokens,omitempty"`
+	FailoverMarkerTokens []*v12.FailoverMarkerToken `protobuf:"bytes,1,rep,name=failover_marker_tokens,json=failoverMarkerTokens,proto3" json:"failover_marker_tokens,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -4925,7 +5001,7 @@ func (m *NotifyFailoverMarkersRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NotifyFailoverMarkersRequest proto.InternalMessageInfo
 
-func (m *NotifyFailoverMarkersRequest) GetFailoverMarkerTokens() []*v11.FailoverMarkerToken {
+func (m *NotifyFailoverMarkersRequest) GetFailoverMarkerTokens() []*v12.FailoverMarkerToken {
 	if m != nil {
 		return m.FailoverMarkerTokens
 	}
@@ -5027,8 +5103,8 @@ func (m *GetCrossClusterTasksRequest) GetTargetCluster() string {
 }
 
 type GetCrossClusterTasksResponse struct {
-	TasksByShard         map[int32]*v11.CrossClusterTaskRequests `protobuf:"bytes,1,rep,name=tasks_by_shard,json=tasksByShard,proto3" json:"tasks_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	FailedCauseByShard   map[int32]v11.GetTaskFailedCause        `protobuf:"bytes,2,rep,name=failed_cause_by_shard,json=failedCauseByShard,proto3" json:"failed_cause_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=uber.cadence.admin.v1.GetTaskFailedCause"`
+	TasksByShard         map[int32]*v12.CrossClusterTaskRequests `protobuf:"bytes,1,rep,name=tasks_by_shard,json=tasksByShard,proto3" json:"tasks_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FailedCauseByShard   map[int32]v12.GetTaskFailedCause        `protobuf:"bytes,2,rep,name=failed_cause_by_shard,json=failedCauseByShard,proto3" json:"failed_cause_by_shard,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=uber.cadence.admin.v1.GetTaskFailedCause"`
 	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
 	XXX_unrecognized     []byte                                  `json:"-"`
 	XXX_sizecache        int32                                   `json:"-"`
@@ -5067,14 +5143,14 @@ func (m *GetCrossClusterTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetCrossClusterTasksResponse proto.InternalMessageInfo
 
-func (m *GetCrossClusterTasksResponse) GetTasksByShard() map[int32]*v11.CrossClusterTaskRequests {
+func (m *GetCrossClusterTasksResponse) GetTasksByShard() map[int32]*v12.CrossClusterTaskRequests {
 	if m != nil {
 		return m.TasksByShard
 	}
 	return nil
 }
 
-func (m *GetCrossClusterTasksResponse) GetFailedCauseByShard() map[int32]v11.GetTaskFailedCause {
+func (m *GetCrossClusterTasksResponse) GetFailedCauseByShard() map[int32]v12.GetTaskFailedCause {
 	if m != nil {
 		return m.FailedCauseByShard
 	}
@@ -5084,7 +5160,7 @@ func (m *GetCrossClusterTasksResponse) GetFailedCauseByShard() map[int32]v11.Get
 type RespondCrossClusterTasksCompletedRequest struct {
 	ShardId              int32                           `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	TargetCluster        string                          `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
-	TaskResponses        []*v11.CrossClusterTaskResponse `protobuf:"bytes,3,rep,name=task_responses,json=taskResponses,proto3" json:"task_responses,omitempty"`
+	TaskResponses        []*v12.CrossClusterTaskResponse `protobuf:"bytes,3,rep,name=task_responses,json=taskResponses,proto3" json:"task_responses,omitempty"`
 	FetchNewTasks        bool                            `protobuf:"varint,4,opt,name=fetchNewTasks,proto3" json:"fetchNewTasks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
 	XXX_unrecognized     []byte                          `json:"-"`
@@ -5140,7 +5216,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) GetTargetCluster() string {
 	return ""
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) GetTaskResponses() []*v11.CrossClusterTaskResponse {
+func (m *RespondCrossClusterTasksCompletedRequest) GetTaskResponses() []*v12.CrossClusterTaskResponse {
 	if m != nil {
 		return m.TaskResponses
 	}
@@ -5155,7 +5231,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) GetFetchNewTasks() bool {
 }
 
 type RespondCrossClusterTasksCompletedResponse struct {
-	Tasks                *v11.CrossClusterTaskRequests `protobuf:"bytes,1,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Tasks                *v12.CrossClusterTaskRequests `protobuf:"bytes,1,opt,name=tasks,proto3" json:"tasks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
 	XXX_unrecognized     []byte                        `json:"-"`
 	XXX_sizecache        int32                         `json:"-"`
@@ -5198,7 +5274,7 @@ func (m *RespondCrossClusterTasksCompletedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RespondCrossClusterTasksCompletedResponse proto.InternalMessageInfo
 
-func (m *RespondCrossClusterTasksCompletedResponse) GetTasks() *v11.CrossClusterTaskRequests {
+func (m *RespondCrossClusterTasksCompletedResponse) GetTasks() *v12.CrossClusterTaskRequests {
 	if m != nil {
 		return m.Tasks
 	}
@@ -5316,7 +5392,7 @@ type RatelimitUpdateRequest struct {
 	// this is a single blob rather than a collection to save on
 	// repeated serialization of the type name, and to allow impls
 	// to choose whatever structures are most-convenient for them.
-	Data                 *v12.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data                 *v11.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5355,7 +5431,7 @@ func (m *RatelimitUpdateRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RatelimitUpdateRequest proto.InternalMessageInfo
 
-func (m *RatelimitUpdateRequest) GetData() *v12.Any {
+func (m *RatelimitUpdateRequest) GetData() *v11.Any {
 	if m != nil {
 		return m.Data
 	}
@@ -5372,7 +5448,7 @@ type RatelimitUpdateResponse struct {
 	// this is a single blob rather than a collection to save on
 	// repeated serialization of the type name, and to allow impls
 	// to choose whatever structures are most-convenient for them.
-	Data                 *v12.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data                 *v11.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5411,13 +5487,352 @@ func (m *RatelimitUpdateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RatelimitUpdateResponse proto.InternalMessageInfo
 
-func (m *RatelimitUpdateResponse) GetData() *v12.Any {
+func (m *RatelimitUpdateResponse) GetData() *v11.Any {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
+type GetReplicationStatusRequest struct {
+	ClusterName          string   `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	ShardIds             []int32  `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	DomainId             string   `protobuf:"bytes,3,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReplicationStatusRequest) Reset()         { *m = GetReplicationStatusRequest{} }
+func (m *GetReplicationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationStatusRequest) ProtoMessage()    {}
+func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{88}
+}
+func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusRequest.Merge(m, src)
+}
+func (m *GetReplicationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationStatusRequest proto.InternalMessageInfo
+
+func (m *GetReplicationStatusRequest) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *GetReplicationStatusRequest) GetShardIds() []int32 {
+	if m != nil {
+		return m.ShardIds
+	}
+	return nil
+}
+
+func (m *GetReplicationStatusRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+type GetReplicationStatusResponse struct {
+	Shards               []*ShardReplicationStatus `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetReplicationStatusResponse) Reset()         { *m = GetReplicationStatusResponse{} }
+func (m *GetReplicationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationStatusResponse) ProtoMessage()    {}
+func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{89}
+}
+func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationStatusResponse.Merge(m, src)
+}
+func (m *GetReplicationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationStatusResponse proto.InternalMessageInfo
+
+func (m *GetReplicationStatusResponse) GetShards() []*ShardReplicationStatus {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+type ShardReplicationStatus struct {
+	ShardId              int32              `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Status               *ReplicationStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ShardReplicationStatus) Reset()         { *m = ShardReplicationStatus{} }
+func (m *ShardReplicationStatus) String() string { return proto.CompactTextString(m) }
+func (*ShardReplicationStatus) ProtoMessage()    {}
+func (*ShardReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{90}
+}
+func (m *ShardReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardReplicationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardReplicationStatus.Merge(m, src)
+}
+func (m *ShardReplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardReplicationStatus proto.InternalMessageInfo
+
+func (m *ShardReplicationStatus) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ShardReplicationStatus) GetStatus() *ReplicationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type ReplicationStatus struct {
+	AckedTaskId           int64                     `protobuf:"varint,1,opt,name=acked_task_id,json=ackedTaskId,proto3" json:"acked_task_id,omitempty"`
+	MaxTaskId             int64                     `protobuf:"varint,2,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
+	TaskLag               int64                     `protobuf:"varint,3,opt,name=task_lag,json=taskLag,proto3" json:"task_lag,omitempty"`
+	TaskLagTruncated      bool                      `protobuf:"varint,4,opt,name=task_lag_truncated,json=taskLagTruncated,proto3" json:"task_lag_truncated,omitempty"`
+	OldestPendingTaskTime *types.Timestamp          `protobuf:"bytes,5,opt,name=oldest_pending_task_time,json=oldestPendingTaskTime,proto3" json:"oldest_pending_task_time,omitempty"`
+	LagSeconds            int64                     `protobuf:"varint,6,opt,name=lag_seconds,json=lagSeconds,proto3" json:"lag_seconds,omitempty"`
+	DlqSize               int64                     `protobuf:"varint,7,opt,name=dlq_size,json=dlqSize,proto3" json:"dlq_size,omitempty"`
+	Fetcher               *ReplicationFetcherStatus `protobuf:"bytes,8,opt,name=fetcher,proto3" json:"fetcher,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                  `json:"-"`
+	XXX_unrecognized      []byte                    `json:"-"`
+	XXX_sizecache         int32                     `json:"-"`
+}
+
+func (m *ReplicationStatus) Reset()         { *m = ReplicationStatus{} }
+func (m *ReplicationStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationStatus) ProtoMessage()    {}
+func (*ReplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{91}
+}
+func (m *ReplicationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationStatus.Merge(m, src)
+}
+func (m *ReplicationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationStatus proto.InternalMessageInfo
+
+func (m *ReplicationStatus) GetAckedTaskId() int64 {
+	if m != nil {
+		return m.AckedTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationStatus) GetMaxTaskId() int64 {
+	if m != nil {
+		return m.MaxTaskId
+	}
+	return 0
+}
+
+func (m *ReplicationStatus) GetTaskLag() int64 {
+	if m != nil {
+		return m.TaskLag
+	}
+	return 0
+}
+
+func (m *ReplicationStatus) GetTaskLagTruncated() bool {
+	if m != nil {
+		return m.TaskLagTruncated
+	}
+	return false
+}
+
+func (m *ReplicationStatus) GetOldestPendingTaskTime() *types.Timestamp {
+	if m != nil {
+		return m.OldestPendingTaskTime
+	}
+	return nil
+}
+
+func (m *ReplicationStatus) GetLagSeconds() int64 {
+	if m != nil {
+		return m.LagSeconds
+	}
+	return 0
+}
+
+func (m *ReplicationStatus) GetDlqSize() int64 {
+	if m != nil {
+		return m.DlqSize
+	}
+	return 0
+}
+
+func (m *ReplicationStatus) GetFetcher() *ReplicationFetcherStatus {
+	if m != nil {
+		return m.Fetcher
+	}
+	return nil
+}
+
+type ReplicationFetcherStatus struct {
+	LastFetchTime        *types.Timestamp `protobuf:"bytes,1,opt,name=last_fetch_time,json=lastFetchTime,proto3" json:"last_fetch_time,omitempty"`
+	ErrorCount           int64            `protobuf:"varint,2,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	LastError            string           `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime        *types.Timestamp `protobuf:"bytes,4,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplicationFetcherStatus) Reset()         { *m = ReplicationFetcherStatus{} }
+func (m *ReplicationFetcherStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplicationFetcherStatus) ProtoMessage()    {}
+func (*ReplicationFetcherStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{92}
+}
+func (m *ReplicationFetcherStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationFetcherStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationFetcherStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationFetcherStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationFetcherStatus.Merge(m, src)
+}
+func (m *ReplicationFetcherStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationFetcherStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationFetcherStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationFetcherStatus proto.InternalMessageInfo
+
+func (m *ReplicationFetcherStatus) GetLastFetchTime() *types.Timestamp {
+	if m != nil {
+		return m.LastFetchTime
+	}
+	return nil
+}
+
+func (m *ReplicationFetcherStatus) GetErrorCount() int64 {
+	if m != nil {
+		return m.ErrorCount
+	}
+	return 0
+}
+
+func (m *ReplicationFetcherStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ReplicationFetcherStatus) GetLastErrorTime() *types.Timestamp {
+	if m != nil {
+		return m.LastErrorTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
 	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest.PartitionConfigEntry")
@@ -5487,7 +5902,7 @@ func init() {
 	proto.RegisterType((*DescribeQueueResponse)(nil), "uber.cadence.history.v1.DescribeQueueResponse")
 	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetReplicationMessagesRequest")
 	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v11.ReplicationMessages)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterMapType((map[int32]*v12.ReplicationMessages)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
 	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesRequest")
 	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesResponse")
 	proto.RegisterType((*ReapplyEventsRequest)(nil), "uber.cadence.history.v1.ReapplyEventsRequest")
@@ -5506,14 +5921,19 @@ func init() {
 	proto.RegisterType((*NotifyFailoverMarkersResponse)(nil), "uber.cadence.history.v1.NotifyFailoverMarkersResponse")
 	proto.RegisterType((*GetCrossClusterTasksRequest)(nil), "uber.cadence.history.v1.GetCrossClusterTasksRequest")
 	proto.RegisterType((*GetCrossClusterTasksResponse)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse")
-	proto.RegisterMapType((map[int32]v11.GetTaskFailedCause)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.FailedCauseByShardEntry")
-	proto.RegisterMapType((map[int32]*v11.CrossClusterTaskRequests)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.TasksByShardEntry")
+	proto.RegisterMapType((map[int32]v12.GetTaskFailedCause)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.FailedCauseByShardEntry")
+	proto.RegisterMapType((map[int32]*v12.CrossClusterTaskRequests)(nil), "uber.cadence.history.v1.GetCrossClusterTasksResponse.TasksByShardEntry")
 	proto.RegisterType((*RespondCrossClusterTasksCompletedRequest)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedRequest")
 	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedResponse")
 	proto.RegisterType((*GetFailoverInfoRequest)(nil), "uber.cadence.history.v1.GetFailoverInfoRequest")
 	proto.RegisterType((*GetFailoverInfoResponse)(nil), "uber.cadence.history.v1.GetFailoverInfoResponse")
 	proto.RegisterType((*RatelimitUpdateRequest)(nil), "uber.cadence.history.v1.RatelimitUpdateRequest")
 	proto.RegisterType((*RatelimitUpdateResponse)(nil), "uber.cadence.history.v1.RatelimitUpdateResponse")
+	proto.RegisterType((*GetReplicationStatusRequest)(nil), "uber.cadence.history.v1.GetReplicationStatusRequest")
+	proto.RegisterType((*GetReplicationStatusResponse)(nil), "uber.cadence.history.v1.GetReplicationStatusResponse")
+	proto.RegisterType((*ShardReplicationStatus)(nil), "uber.cadence.history.v1.ShardReplicationStatus")
+	proto.RegisterType((*ReplicationStatus)(nil), "uber.cadence.history.v1.ReplicationStatus")
+	proto.RegisterType((*ReplicationFetcherStatus)(nil), "uber.cadence.history.v1.ReplicationFetcherStatus")
 }
 
 func init() {
@@ -5521,319 +5941,347 @@ func init() {
 }
 
 var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4981 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4d, 0x6c, 0x1c, 0x47,
-	0x76, 0x30, 0x7a, 0x46, 0xfc, 0x7b, 0x24, 0x87, 0x64, 0x89, 0x3f, 0xc3, 0xa1, 0x44, 0x91, 0x6d,
-	0xcb, 0xa2, 0xe5, 0xf5, 0xd0, 0xa2, 0x6c, 0x49, 0x96, 0xe5, 0xd5, 0x4a, 0xa4, 0x24, 0x8f, 0x3f,
-	0xfd, 0x36, 0x69, 0xf9, 0xcb, 0x9f, 0x7b, 0x9b, 0xd3, 0x35, 0x64, 0x47, 0x3d, 0xdd, 0xa3, 0xee,
-	0x1e, 0x52, 0xf4, 0x21, 0x70, 0xe2, 0x20, 0x40, 0x16, 0x41, 0x76, 0xb3, 0x48, 0x82, 0x00, 0x01,
-	0x02, 0x04, 0x1b, 0x60, 0xb1, 0x46, 0x6e, 0x09, 0x90, 0x43, 0x92, 0x53, 0x2e, 0x7b, 0xdc, 0x6b,
-	0x6e, 0x81, 0xb1, 0x7b, 0x48, 0x80, 0xdc, 0xf6, 0x1c, 0x04, 0xf5, 0xd3, 0x3d, 0xfd, 0x53, 0x5d,
-	0x3d, 0x43, 0x06, 0x91, 0xd7, 0xf1, 0x6d, 0xba, 0xaa, 0xde, 0xab, 0x57, 0xaf, 0xde, 0x7b, 0xfd,
-	0xfe, 0x7a, 0xe0, 0x7c, 0x77, 0x17, 0x7b, 0xeb, 0x4d, 0xc3, 0xc4, 0x4e, 0x13, 0xaf, 0xef, 0x5b,
-	0x7e, 0xe0, 0x7a, 0x47, 0xeb, 0x07, 0x97, 0xd6, 0x7d, 0xec, 0x1d, 0x58, 0x4d, 0x5c, 0xef, 0x78,
-	0x6e, 0xe0, 0xa2, 0x05, 0xb2, 0xac, 0xce, 0x97, 0xd5, 0xf9, 0xb2, 0xfa, 0xc1, 0xa5, 0xda, 0xf2,
-	0x9e, 0xeb, 0xee, 0xd9, 0x78, 0x9d, 0x2e, 0xdb, 0xed, 0xb6, 0xd6, 0xcd, 0xae, 0x67, 0x04, 0x96,
-	0xeb, 0x30, 0xc0, 0xda, 0xb9, 0xf4, 0x7c, 0x60, 0xb5, 0xb1, 0x1f, 0x18, 0xed, 0x0e, 0x5f, 0x90,
-	0x41, 0x70, 0xe8, 0x19, 0x9d, 0x0e, 0xf6, 0x7c, 0x3e, 0xbf, 0x92, 0x20, 0xd0, 0xe8, 0x58, 0x84,
-	0xb8, 0xa6, 0xdb, 0x6e, 0x47, 0x5b, 0xac, 0x8a, 0x56, 0x84, 0x24, 0x72, 0x2a, 0x44, 0x4b, 0x9e,
-	0x77, 0x71, 0xb4, 0x40, 0x15, 0x2d, 0x08, 0x0c, 0xff, 0x99, 0x6d, 0xf9, 0x81, 0x6c, 0xcd, 0xa1,
-	0xeb, 0x3d, 0x6b, 0xd9, 0xee, 0x21, 0x5f, 0x73, 0x51, 0xb4, 0x86, 0xb3, 0x52, 0x4f, 0xad, 0x5d,
-	0x2b, 0x5a, 0x8b, 0x3d, 0xbe, 0xf2, 0x95, 0xe4, 0x4a, 0xb3, 0x6d, 0x39, 0x94, 0x0b, 0x76, 0xd7,
-	0x0f, 0x8a, 0x16, 0x25, 0x19, 0xb1, 0x2a, 0x5e, 0xf4, 0xbc, 0x8b, 0xbb, 0xfc, 0xaa, 0x6b, 0x17,
-	0xc4, 0x4b, 0x3c, 0xdc, 0xb1, 0xad, 0x66, 0xfc, 0x6a, 0x93, 0x37, 0xe3, 0xef, 0x1b, 0x1e, 0x36,
-	0xc9, 0x4a, 0xc3, 0x09, 0x77, 0x7b, 0x35, 0x67, 0x45, 0x92, 0xa6, 0xf3, 0x39, 0xab, 0x92, 0xec,
-	0x52, 0x7f, 0x3e, 0x0c, 0x67, 0xb7, 0x03, 0xc3, 0x0b, 0x3e, 0xe6, 0xe3, 0x77, 0x5e, 0xe0, 0x66,
-	0x97, 0xd0, 0xa3, 0xe1, 0xe7, 0x5d, 0xec, 0x07, 0xe8, 0x3e, 0x8c, 0x78, 0xec, 0x67, 0x55, 0x59,
-	0x51, 0xd6, 0xc6, 0x37, 0x36, 0xea, 0x09, 0xb1, 0x35, 0x3a, 0x56, 0xfd, 0xe0, 0x52, 0x5d, 0x8a,
-	0x44, 0x0b, 0x51, 0xa0, 0x25, 0x18, 0x33, 0xdd, 0xb6, 0x61, 0x39, 0xba, 0x65, 0x56, 0x4b, 0x2b,
-	0xca, 0xda, 0x98, 0x36, 0xca, 0x06, 0x1a, 0x26, 0xfa, 0x4d, 0x98, 0xeb, 0x18, 0x1e, 0x76, 0x02,
-	0x1d, 0x87, 0x08, 0x74, 0xcb, 0x69, 0xb9, 0xd5, 0x32, 0xdd, 0x78, 0x4d, 0xb8, 0xf1, 0x63, 0x0a,
-	0x11, 0xed, 0xd8, 0x70, 0x5a, 0xae, 0x76, 0xba, 0x93, 0x1d, 0x44, 0x55, 0x18, 0x31, 0x82, 0x00,
-	0xb7, 0x3b, 0x41, 0xf5, 0xd4, 0x8a, 0xb2, 0x36, 0xa4, 0x85, 0x8f, 0x68, 0x13, 0xa6, 0xf0, 0x8b,
-	0x8e, 0xc5, 0x54, 0x4c, 0x27, 0xba, 0x54, 0x1d, 0xa2, 0x3b, 0xd6, 0xea, 0x4c, 0x8f, 0xea, 0xa1,
-	0x1e, 0xd5, 0x77, 0x42, 0x45, 0xd3, 0x2a, 0x3d, 0x10, 0x32, 0x88, 0x5a, 0xb0, 0xd8, 0x74, 0x9d,
-	0xc0, 0x72, 0xba, 0x58, 0x37, 0x7c, 0xdd, 0xc1, 0x87, 0xba, 0xe5, 0x58, 0x81, 0x65, 0x04, 0xae,
-	0x57, 0x1d, 0x5e, 0x51, 0xd6, 0x2a, 0x1b, 0x6f, 0x08, 0x0f, 0xb0, 0xc9, 0xa1, 0x6e, 0xf9, 0x0f,
-	0xf1, 0x61, 0x23, 0x04, 0xd1, 0xe6, 0x9b, 0xc2, 0x71, 0xd4, 0x80, 0x99, 0x70, 0xc6, 0xd4, 0x5b,
-	0x86, 0x65, 0x77, 0x3d, 0x5c, 0x1d, 0xa1, 0xe4, 0x9e, 0x11, 0xe2, 0xbf, 0xcb, 0xd6, 0x68, 0xd3,
-	0x11, 0x18, 0x1f, 0x41, 0x1a, 0xcc, 0xdb, 0x86, 0x1f, 0xe8, 0x4d, 0xb7, 0xdd, 0xb1, 0x31, 0x3d,
-	0xbc, 0x87, 0xfd, 0xae, 0x1d, 0x54, 0x47, 0x25, 0xf8, 0x1e, 0x1b, 0x47, 0xb6, 0x6b, 0x98, 0xda,
-	0x2c, 0x81, 0xdd, 0x8c, 0x40, 0x35, 0x0a, 0x89, 0xfe, 0x3f, 0x2c, 0xb5, 0x2c, 0xcf, 0x0f, 0x74,
-	0x13, 0x37, 0x2d, 0x9f, 0xf2, 0xd3, 0xf0, 0x9f, 0xe9, 0xbb, 0x46, 0xf3, 0x99, 0xdb, 0x6a, 0x55,
-	0xc7, 0x28, 0xe2, 0xc5, 0x0c, 0x5f, 0xb7, 0xb8, 0x81, 0xd3, 0xaa, 0x14, 0x7a, 0x8b, 0x03, 0xef,
-	0x18, 0xfe, 0xb3, 0xdb, 0x0c, 0x14, 0x1d, 0xc0, 0x74, 0xc7, 0xf0, 0x02, 0x8b, 0xd2, 0xd9, 0x74,
-	0x9d, 0x96, 0xb5, 0x57, 0x85, 0x95, 0xf2, 0xda, 0xf8, 0xc6, 0xff, 0xab, 0xe7, 0x18, 0x52, 0xb9,
-	0x54, 0x12, 0xd1, 0x61, 0xe8, 0x36, 0x29, 0xb6, 0x3b, 0x4e, 0xe0, 0x1d, 0x69, 0x53, 0x9d, 0xe4,
-	0x68, 0xed, 0x36, 0xcc, 0x8a, 0x16, 0xa2, 0x69, 0x28, 0x3f, 0xc3, 0x47, 0x54, 0x29, 0xc6, 0x34,
-	0xf2, 0x13, 0xcd, 0xc2, 0xd0, 0x81, 0x61, 0x77, 0x31, 0x17, 0x6c, 0xf6, 0x70, 0xbd, 0x74, 0x4d,
-	0x51, 0xaf, 0xc2, 0x72, 0x1e, 0x29, 0x7e, 0xc7, 0x75, 0x7c, 0x8c, 0xe6, 0x60, 0xd8, 0xeb, 0x52,
-	0xad, 0x60, 0x08, 0x87, 0xbc, 0xae, 0xd3, 0x30, 0xd5, 0xbf, 0x29, 0xc1, 0xf2, 0xb6, 0xb5, 0xe7,
-	0x18, 0x76, 0xae, 0x82, 0x3e, 0x48, 0x2b, 0xe8, 0x65, 0xb1, 0x82, 0x4a, 0xb1, 0xf4, 0xa9, 0xa1,
-	0x2d, 0x58, 0xc2, 0x2f, 0x02, 0xec, 0x39, 0x86, 0x1d, 0x19, 0xde, 0x9e, 0xb2, 0x72, 0x3d, 0x7d,
-	0x4d, 0xb8, 0x7f, 0x76, 0xe7, 0xc5, 0x10, 0x55, 0x66, 0x0a, 0xd5, 0xe1, 0x74, 0x73, 0xdf, 0xb2,
-	0xcd, 0xde, 0x26, 0xae, 0x63, 0x1f, 0x51, 0xbd, 0x1d, 0xd5, 0x66, 0xe8, 0x54, 0x08, 0xf4, 0xc8,
-	0xb1, 0x8f, 0xd4, 0x55, 0x38, 0x97, 0x7b, 0x3e, 0xc6, 0x60, 0xf5, 0x17, 0x25, 0xb8, 0xc0, 0xd7,
-	0x58, 0xc1, 0xbe, 0xdc, 0xe6, 0x3d, 0x4d, 0xb3, 0xf4, 0x86, 0x8c, 0xa5, 0x45, 0xe8, 0xfa, 0xe4,
-	0xed, 0x67, 0x8a, 0x40, 0xc0, 0xcb, 0x54, 0xc0, 0x3f, 0xca, 0x17, 0xf0, 0xfe, 0x48, 0xf8, 0x5f,
-	0x14, 0xf5, 0x5b, 0xb0, 0x56, 0x4c, 0x94, 0x5c, 0xe8, 0xbf, 0xa7, 0xc0, 0x59, 0x0d, 0xfb, 0xf8,
-	0xc4, 0x2f, 0x25, 0x29, 0x92, 0xfe, 0xae, 0x85, 0xa8, 0x6e, 0x1e, 0x1a, 0xf9, 0x29, 0xbe, 0x28,
-	0xc1, 0xea, 0x0e, 0xf6, 0xda, 0x96, 0x63, 0x04, 0x38, 0xf7, 0x24, 0x8f, 0xd3, 0x27, 0xb9, 0x22,
-	0x3c, 0x49, 0x21, 0xa2, 0x5f, 0x71, 0x05, 0x7e, 0x15, 0x54, 0xd9, 0x11, 0xb9, 0x0e, 0xff, 0x40,
-	0x81, 0x95, 0x2d, 0xec, 0x37, 0x3d, 0x6b, 0x37, 0x9f, 0xa3, 0x8f, 0xd2, 0x1c, 0x7d, 0x47, 0x78,
-	0x9c, 0x22, 0x3c, 0x7d, 0x8a, 0xc7, 0x7f, 0x95, 0x61, 0x55, 0x82, 0x8a, 0x8b, 0x88, 0x0d, 0x0b,
-	0x3d, 0x97, 0x86, 0xa9, 0x36, 0x7f, 0xe1, 0x49, 0x6d, 0x76, 0x06, 0xe1, 0x66, 0x1c, 0x54, 0x9b,
-	0xc7, 0xc2, 0x71, 0xb4, 0x0b, 0x0b, 0xd9, 0xbb, 0x65, 0x9e, 0x54, 0x89, 0xee, 0x76, 0xb1, 0xbf,
-	0xdd, 0xa8, 0x2f, 0x35, 0x77, 0x28, 0x1a, 0x46, 0x1f, 0x03, 0xea, 0x60, 0xc7, 0xb4, 0x9c, 0x3d,
-	0xdd, 0x68, 0x06, 0xd6, 0x81, 0x15, 0x58, 0xd8, 0xe7, 0xe6, 0x2a, 0xc7, 0x51, 0x63, 0xcb, 0x6f,
-	0xb1, 0xd5, 0x47, 0x14, 0xf9, 0x4c, 0x27, 0x31, 0x68, 0x61, 0x1f, 0xfd, 0x1a, 0x4c, 0x87, 0x88,
-	0xa9, 0x98, 0x78, 0xd8, 0xa9, 0x9e, 0xa2, 0x68, 0xeb, 0x32, 0xb4, 0x9b, 0x64, 0x6d, 0x92, 0xf2,
-	0xa9, 0x4e, 0x6c, 0xca, 0xc3, 0x0e, 0xda, 0xee, 0xa1, 0x0e, 0xbd, 0x13, 0xee, 0xe8, 0x49, 0x29,
-	0x0e, 0x9d, 0x91, 0x04, 0xd2, 0x70, 0x50, 0x7d, 0x01, 0xb3, 0x4f, 0x48, 0xcc, 0x13, 0x72, 0x2f,
-	0x14, 0xc3, 0xcd, 0xb4, 0x18, 0xbe, 0x2e, 0xdc, 0x43, 0x04, 0xdb, 0xa7, 0xe8, 0xfd, 0x48, 0x81,
-	0xb9, 0x14, 0x38, 0x17, 0xb7, 0x9b, 0x30, 0x41, 0xe3, 0xb0, 0xd0, 0x9d, 0x53, 0xfa, 0x70, 0xe7,
-	0xc6, 0x29, 0x04, 0xf7, 0xe2, 0x1a, 0x50, 0x09, 0x11, 0xfc, 0x36, 0x6e, 0x06, 0xd8, 0xe4, 0x82,
-	0xa3, 0xe6, 0x9f, 0x41, 0xe3, 0x2b, 0xb5, 0xc9, 0xe7, 0xf1, 0x47, 0xf5, 0xf7, 0x15, 0xa8, 0x51,
-	0x03, 0xba, 0x1d, 0x58, 0xcd, 0x67, 0x47, 0xc4, 0xa3, 0xbb, 0x6f, 0xf9, 0x41, 0xc8, 0xa6, 0x46,
-	0x9a, 0x4d, 0xeb, 0xf9, 0x96, 0x5c, 0x88, 0xa1, 0x4f, 0x66, 0x9d, 0x85, 0x25, 0x21, 0x0e, 0x6e,
-	0x59, 0x7e, 0x56, 0x82, 0xf9, 0x7b, 0x38, 0x78, 0xd0, 0x0d, 0x8c, 0x5d, 0x1b, 0x6f, 0x07, 0x46,
-	0x80, 0x35, 0x11, 0x5a, 0x25, 0x65, 0x4f, 0x3f, 0x02, 0x24, 0x30, 0xa3, 0xa5, 0x81, 0xcc, 0xe8,
-	0x4c, 0x46, 0xc3, 0xd0, 0x65, 0x98, 0xc7, 0x2f, 0x3a, 0x94, 0x81, 0xba, 0x83, 0x5f, 0x04, 0x3a,
-	0x3e, 0x20, 0x61, 0x91, 0x65, 0x52, 0x0b, 0x5d, 0xd6, 0x4e, 0x87, 0xb3, 0x0f, 0xf1, 0x8b, 0xe0,
-	0x0e, 0x99, 0x6b, 0x98, 0xe8, 0x2d, 0x98, 0x6d, 0x76, 0x3d, 0x1a, 0x3f, 0xed, 0x7a, 0x86, 0xd3,
-	0xdc, 0xd7, 0x03, 0xf7, 0x19, 0xd5, 0x1e, 0x65, 0x6d, 0x42, 0x43, 0x7c, 0xee, 0x36, 0x9d, 0xda,
-	0x21, 0x33, 0xe8, 0x37, 0x60, 0xf6, 0x00, 0x7b, 0xd4, 0x4b, 0xe7, 0x3e, 0x85, 0x6e, 0x05, 0xb8,
-	0xcd, 0x95, 0x22, 0x2d, 0xb0, 0x24, 0x68, 0x25, 0x27, 0x78, 0xca, 0x40, 0x3e, 0x60, 0x10, 0x8d,
-	0x00, 0xb7, 0x35, 0x74, 0x90, 0x19, 0x53, 0xff, 0x61, 0x0c, 0x16, 0x32, 0x2c, 0xe5, 0x02, 0x2a,
-	0x66, 0x9b, 0x72, 0x52, 0xb6, 0xdd, 0x85, 0xc9, 0x08, 0x6d, 0x70, 0xd4, 0xc1, 0xfc, 0x22, 0x56,
-	0xa5, 0x18, 0x77, 0x8e, 0x3a, 0x58, 0x9b, 0x38, 0x8c, 0x3d, 0x21, 0x15, 0x26, 0x45, 0x5c, 0x1f,
-	0x77, 0x62, 0xdc, 0x7e, 0x0a, 0x8b, 0x1d, 0x0f, 0x1f, 0x58, 0x6e, 0xd7, 0xd7, 0x7d, 0xe2, 0xe6,
-	0x60, 0xb3, 0xb7, 0xfe, 0x14, 0xdd, 0x77, 0x29, 0x13, 0xe6, 0x34, 0x9c, 0xe0, 0xca, 0xdb, 0x4f,
-	0x89, 0xaf, 0xa4, 0xcd, 0x87, 0xd0, 0xdb, 0x0c, 0x38, 0xc4, 0xfb, 0x26, 0x9c, 0xa6, 0x41, 0x19,
-	0x8b, 0xa2, 0x22, 0x8c, 0x43, 0x94, 0x82, 0x69, 0x32, 0x75, 0x97, 0xcc, 0x84, 0xcb, 0xaf, 0xc3,
-	0x18, 0x0d, 0xb0, 0x6c, 0xcb, 0x0f, 0x68, 0x98, 0x39, 0xbe, 0x71, 0x56, 0xec, 0x41, 0x84, 0x22,
-	0x3f, 0x1a, 0xf0, 0x5f, 0xe8, 0x1e, 0x4c, 0xfb, 0x54, 0x1d, 0xf4, 0x1e, 0x8a, 0x91, 0x7e, 0x50,
-	0x54, 0xfc, 0x84, 0x16, 0xa1, 0xb7, 0x61, 0xbe, 0x69, 0x5b, 0x84, 0x52, 0xdb, 0xda, 0xf5, 0x0c,
-	0xef, 0x48, 0xe7, 0xf2, 0x40, 0x03, 0xc9, 0x31, 0x6d, 0x96, 0xcd, 0xde, 0x67, 0x93, 0x5c, 0x7e,
-	0x62, 0x50, 0x2d, 0x6c, 0x04, 0x5d, 0x0f, 0x47, 0x50, 0x63, 0x71, 0xa8, 0xbb, 0x6c, 0x32, 0x84,
-	0x3a, 0x07, 0xe3, 0x1c, 0xca, 0x6a, 0x77, 0xec, 0x2a, 0xd0, 0xa5, 0xc0, 0x86, 0x1a, 0xed, 0x8e,
-	0x8d, 0x7c, 0xb8, 0x98, 0x3e, 0x95, 0xee, 0x37, 0xf7, 0xb1, 0xd9, 0xb5, 0xb1, 0x1e, 0xb8, 0xec,
-	0xb2, 0x68, 0x94, 0xef, 0x76, 0x83, 0xea, 0x78, 0x51, 0x40, 0xfa, 0x6a, 0xf2, 0xac, 0xdb, 0x1c,
-	0xd3, 0x8e, 0x4b, 0xef, 0x6d, 0x87, 0xa1, 0x21, 0xfe, 0x0e, 0xbb, 0x2a, 0x22, 0xff, 0xbd, 0x83,
-	0x4c, 0xd0, 0x44, 0xc3, 0x0c, 0x9d, 0xda, 0x26, 0x33, 0xe1, 0x29, 0xf2, 0x74, 0x75, 0x32, 0x57,
-	0x57, 0xef, 0x43, 0x25, 0x92, 0x6d, 0x9f, 0x28, 0x53, 0xb5, 0x42, 0x93, 0x0a, 0xe7, 0x93, 0x57,
-	0xc5, 0x32, 0x3d, 0x71, 0xf9, 0x66, 0x9a, 0x17, 0x29, 0x06, 0x7d, 0x44, 0x4d, 0x98, 0x8d, 0xb0,
-	0x35, 0x6d, 0xd7, 0xc7, 0x1c, 0xe7, 0x14, 0xc5, 0x79, 0xa9, 0x4f, 0x6f, 0x84, 0x00, 0x12, 0x7c,
-	0x5d, 0x5f, 0x8b, 0xf4, 0x39, 0x1a, 0x24, 0x5a, 0x3e, 0x93, 0x34, 0x2f, 0xc4, 0x45, 0x98, 0x16,
-	0xbd, 0x70, 0x7b, 0x54, 0x27, 0x8c, 0x8b, 0x85, 0x7d, 0x6d, 0xfa, 0x20, 0x35, 0x82, 0x6e, 0xc0,
-	0x92, 0x45, 0x74, 0x2e, 0x75, 0xc7, 0xd8, 0x21, 0x76, 0xc6, 0xac, 0xce, 0x50, 0x1f, 0x73, 0xc1,
-	0xf2, 0x93, 0xa6, 0xfe, 0x0e, 0x9b, 0x46, 0xab, 0x30, 0x11, 0xda, 0x3a, 0xdf, 0xfa, 0x14, 0x57,
-	0x11, 0x53, 0x6d, 0x3e, 0xb6, 0x6d, 0x7d, 0x8a, 0xd5, 0x5f, 0x2a, 0xb0, 0xf0, 0xd8, 0xb5, 0xed,
-	0xff, 0x5b, 0x6f, 0x03, 0xf5, 0xc7, 0xa3, 0x50, 0xcd, 0x1e, 0xfb, 0x1b, 0x8b, 0xfd, 0x8d, 0xc5,
-	0xfe, 0x3a, 0x5a, 0xec, 0x3c, 0xfd, 0x98, 0xc8, 0xb5, 0xc0, 0x42, 0x73, 0x36, 0x79, 0x62, 0x73,
-	0xf6, 0xab, 0x67, 0xd8, 0xd5, 0x7f, 0x29, 0xc1, 0x8a, 0x86, 0x9b, 0xae, 0x67, 0xc6, 0x13, 0xb5,
-	0x5c, 0x2d, 0x5e, 0xa6, 0xa5, 0x3c, 0x07, 0xe3, 0x91, 0xe0, 0x44, 0x46, 0x00, 0xc2, 0xa1, 0x86,
-	0x89, 0x16, 0x60, 0x84, 0xca, 0x18, 0xd7, 0xf8, 0xb2, 0x36, 0x4c, 0x1e, 0x1b, 0x26, 0x3a, 0x0b,
-	0xc0, 0xe3, 0x88, 0x50, 0x77, 0xc7, 0xb4, 0x31, 0x3e, 0xd2, 0x30, 0x91, 0x06, 0x13, 0x1d, 0xd7,
-	0xb6, 0xf5, 0x30, 0x56, 0x19, 0x96, 0xc4, 0x2a, 0xc4, 0x86, 0xde, 0x75, 0xbd, 0x38, 0x6b, 0xc2,
-	0x58, 0x65, 0x9c, 0x20, 0xe1, 0x0f, 0xea, 0xef, 0x8d, 0xc2, 0xaa, 0x84, 0x8b, 0xdc, 0xf0, 0x66,
-	0x2c, 0xa4, 0x72, 0x3c, 0x0b, 0x29, 0xb5, 0x7e, 0xa5, 0xe3, 0x5b, 0xbf, 0x6f, 0x01, 0x0a, 0xf9,
-	0x6b, 0xa6, 0xcd, 0xef, 0x74, 0x34, 0x13, 0xae, 0x5e, 0x23, 0x06, 0x4c, 0x60, 0x7a, 0xcb, 0xc4,
-	0x42, 0x25, 0xf0, 0x66, 0x2c, 0xfa, 0x50, 0xd6, 0xa2, 0xc7, 0x4a, 0x3a, 0xc3, 0xc9, 0x92, 0xce,
-	0x35, 0xa8, 0x72, 0x93, 0xd2, 0x4b, 0x80, 0x84, 0x0e, 0xc2, 0x08, 0x75, 0x10, 0xe6, 0xd9, 0x7c,
-	0x24, 0x3b, 0xa1, 0x7f, 0xa0, 0xc1, 0x64, 0x54, 0xba, 0xa0, 0x29, 0x13, 0x56, 0x0b, 0x79, 0x33,
-	0x4f, 0x1b, 0x77, 0x3c, 0xc3, 0xf1, 0x89, 0x29, 0x4b, 0xa4, 0x09, 0x26, 0xcc, 0xd8, 0x13, 0xfa,
-	0x04, 0xce, 0x08, 0x12, 0x32, 0x3d, 0x13, 0x3e, 0xd6, 0x8f, 0x09, 0x5f, 0xcc, 0x88, 0x7b, 0x64,
-	0xcd, 0x73, 0xbc, 0x4f, 0xc8, 0xf3, 0x3e, 0x57, 0x61, 0x22, 0x61, 0xf3, 0xc6, 0xa9, 0xcd, 0x1b,
-	0xdf, 0x8d, 0x19, 0xbb, 0x5b, 0x50, 0xe9, 0x5d, 0x2b, 0x2d, 0x89, 0x4d, 0x14, 0x96, 0xc4, 0x26,
-	0x23, 0x08, 0x5a, 0x11, 0x7b, 0x1f, 0x26, 0xc2, 0xbb, 0xa6, 0x08, 0x26, 0x0b, 0x11, 0x8c, 0xf3,
-	0xf5, 0x14, 0xdc, 0x80, 0x91, 0xe7, 0x5d, 0x4c, 0x8d, 0x6c, 0x85, 0xe6, 0x7f, 0xee, 0xe5, 0x66,
-	0xc1, 0x0b, 0xb5, 0x88, 0xa6, 0x28, 0x2c, 0xec, 0xb3, 0xbc, 0x77, 0x88, 0x37, 0xe3, 0x0b, 0x4e,
-	0x65, 0x7c, 0xc1, 0xda, 0x27, 0x30, 0x11, 0x87, 0x15, 0xa4, 0xc2, 0xaf, 0xc5, 0x53, 0xe1, 0x79,
-	0x29, 0x92, 0x50, 0x31, 0x59, 0xaa, 0x24, 0x96, 0x2e, 0xef, 0x99, 0xd2, 0x30, 0x31, 0xf6, 0x8d,
-	0x29, 0xcd, 0x98, 0xd2, 0x38, 0x6b, 0x84, 0xa6, 0xf4, 0xe7, 0xe5, 0xd0, 0x94, 0x0a, 0xb9, 0xc8,
-	0x4d, 0xe9, 0x87, 0x30, 0x95, 0x32, 0x55, 0x52, 0x63, 0xca, 0x93, 0x19, 0xd4, 0xd8, 0x68, 0x95,
-	0xa4, 0x29, 0xcb, 0x08, 0x77, 0x69, 0x30, 0xe1, 0x8e, 0x59, 0xae, 0x72, 0xd2, 0x72, 0x7d, 0x02,
-	0xcb, 0x49, 0xc5, 0xd3, 0xdd, 0x96, 0x1e, 0xec, 0x5b, 0xbe, 0x1e, 0xaf, 0x5e, 0xcb, 0xb7, 0xaa,
-	0x25, 0x14, 0xf1, 0x51, 0x6b, 0x67, 0xdf, 0xf2, 0x6f, 0x71, 0xfc, 0x0d, 0x98, 0xd9, 0xc7, 0x86,
-	0x17, 0xec, 0x62, 0x23, 0xd0, 0x4d, 0x1c, 0x18, 0x96, 0xed, 0xf3, 0x84, 0x8f, 0x3c, 0x41, 0x38,
-	0x1d, 0x81, 0x6d, 0x31, 0xa8, 0xec, 0xab, 0x69, 0xf8, 0x78, 0xaf, 0xa6, 0x0b, 0x30, 0x15, 0xe1,
-	0x61, 0x62, 0x4d, 0x6d, 0xf4, 0x98, 0x16, 0x39, 0x46, 0x5b, 0x74, 0x54, 0xfd, 0x73, 0x05, 0x5e,
-	0x61, 0xb7, 0x99, 0x50, 0x76, 0x5e, 0x84, 0xee, 0xe9, 0x8b, 0x96, 0x4e, 0x2a, 0x5e, 0xcb, 0x4b,
-	0x2a, 0x16, 0xa1, 0xea, 0x33, 0xbb, 0xf8, 0x77, 0x65, 0x78, 0x55, 0x8e, 0x8d, 0x8b, 0x20, 0xee,
-	0xbd, 0xff, 0x3c, 0x3e, 0xc6, 0x49, 0xbc, 0x7e, 0x7c, 0xeb, 0xa6, 0x4d, 0xf9, 0x29, 0x49, 0xff,
-	0x91, 0x02, 0xcb, 0xbd, 0xb4, 0x3c, 0xf1, 0xa1, 0x4d, 0xcb, 0xef, 0x18, 0x41, 0x73, 0x5f, 0xb7,
-	0xdd, 0xa6, 0x61, 0xdb, 0x47, 0xd5, 0x12, 0xb5, 0xa9, 0x9f, 0x48, 0x76, 0x2d, 0x3e, 0x4e, 0xbd,
-	0x97, 0xb7, 0xdf, 0x71, 0xb7, 0xf8, 0x0e, 0xf7, 0xd9, 0x06, 0xcc, 0xd4, 0x2e, 0x19, 0xf9, 0x2b,
-	0x6a, 0xbf, 0x03, 0x2b, 0x45, 0x08, 0x04, 0xf6, 0x76, 0x2b, 0x69, 0x6f, 0xc5, 0x55, 0x81, 0xd0,
-	0x0c, 0x50, 0x5c, 0x21, 0x62, 0xfa, 0x66, 0x8e, 0xd9, 0xde, 0x1f, 0x28, 0xc4, 0xf6, 0x66, 0x8e,
-	0x79, 0xd7, 0xb0, 0xec, 0x9e, 0x2c, 0xf5, 0x59, 0x4e, 0x2a, 0xc2, 0xd3, 0xa7, 0x20, 0xbd, 0x42,
-	0xec, 0x58, 0x2e, 0x26, 0x9e, 0xac, 0xfe, 0x53, 0x05, 0xd4, 0xac, 0xb5, 0xfb, 0x20, 0x54, 0xcf,
-	0x90, 0xf2, 0x27, 0x69, 0xca, 0xaf, 0xe6, 0x50, 0x5e, 0x84, 0xa9, 0x4f, 0xda, 0x1f, 0x13, 0xe5,
-	0x94, 0xe0, 0xe2, 0xb2, 0xf9, 0x3a, 0x4c, 0x37, 0x0d, 0xa7, 0x89, 0xa3, 0x37, 0x00, 0x66, 0xef,
-	0xb4, 0x51, 0x6d, 0x8a, 0x8d, 0x6b, 0xe1, 0x70, 0x5c, 0xdf, 0xe3, 0x38, 0x4f, 0xa8, 0xef, 0x32,
-	0x54, 0x7d, 0x1e, 0xf5, 0xb5, 0x48, 0xdd, 0x73, 0x90, 0xc5, 0x0a, 0x96, 0x82, 0x85, 0x27, 0x91,
-	0xb0, 0x5c, 0x3c, 0x03, 0x4b, 0x98, 0x08, 0x53, 0x42, 0xc2, 0xb2, 0x07, 0xa4, 0xf7, 0xd3, 0xa3,
-	0xbc, 0x6f, 0x09, 0x2b, 0xc2, 0xd4, 0x27, 0xed, 0xe7, 0xc5, 0xe2, 0x10, 0xe1, 0xe2, 0xd4, 0xff,
-	0xbd, 0x02, 0xe7, 0x34, 0xdc, 0x76, 0x0f, 0x30, 0xeb, 0x44, 0xf8, 0xaa, 0xe4, 0xf1, 0x92, 0x8e,
-	0x51, 0x39, 0xe5, 0x18, 0xa9, 0x2a, 0x91, 0x95, 0x3c, 0xaa, 0xf9, 0xd1, 0xfe, 0xb1, 0x04, 0xe7,
-	0xf9, 0x11, 0xd8, 0xb1, 0x73, 0xcb, 0xe0, 0xd2, 0x03, 0x1a, 0x50, 0x49, 0xea, 0x20, 0x3f, 0xdc,
-	0xf5, 0x9c, 0xfb, 0xeb, 0x63, 0x43, 0x6d, 0x32, 0xa1, 0xbd, 0x68, 0x17, 0x16, 0xa2, 0x4e, 0x03,
-	0x61, 0x3b, 0x9f, 0xb8, 0x08, 0x7d, 0x87, 0xc3, 0xa4, 0x8a, 0xd0, 0x58, 0x34, 0x3c, 0x70, 0x97,
-	0xc1, 0x1a, 0xbc, 0x56, 0x74, 0x16, 0xce, 0xe7, 0x7f, 0x56, 0x60, 0x29, 0x4c, 0x1c, 0x09, 0x02,
-	0xf9, 0x97, 0x22, 0x3e, 0x17, 0x61, 0xc6, 0xf2, 0xf5, 0x64, 0x77, 0x1d, 0xe5, 0xe5, 0xa8, 0x36,
-	0x65, 0xf9, 0x77, 0xe3, 0x7d, 0x73, 0xea, 0x32, 0x9c, 0x11, 0x93, 0xcf, 0xcf, 0xf7, 0x39, 0x75,
-	0x58, 0x88, 0xb1, 0x4e, 0x16, 0xce, 0x33, 0xa6, 0xf5, 0x65, 0x1c, 0x74, 0x15, 0x26, 0x78, 0xeb,
-	0x24, 0x36, 0x63, 0xb9, 0xdc, 0x68, 0xac, 0x61, 0xa2, 0x8f, 0xe1, 0x74, 0x33, 0x24, 0x35, 0xb6,
-	0xf5, 0xa9, 0x81, 0xb6, 0x46, 0x11, 0x8a, 0xde, 0xde, 0xf7, 0x61, 0x3a, 0xd6, 0x0e, 0xc9, 0x82,
-	0x84, 0xa1, 0x7e, 0x83, 0x84, 0xa9, 0x1e, 0x28, 0x8b, 0x12, 0xce, 0x02, 0x84, 0xee, 0x9e, 0x65,
-	0x52, 0xf7, 0xb8, 0xac, 0x8d, 0xf1, 0x91, 0x86, 0xa9, 0x5e, 0x20, 0xca, 0x2c, 0xbd, 0x04, 0x7e,
-	0x5d, 0xff, 0x5e, 0x82, 0xaa, 0xc6, 0x7b, 0x85, 0x31, 0x45, 0xed, 0x3f, 0xdd, 0x78, 0x99, 0x57,
-	0xf4, 0x5b, 0x30, 0x27, 0xaa, 0x1c, 0x87, 0x1d, 0x20, 0x03, 0x94, 0x8e, 0x4f, 0x67, 0x4b, 0xc7,
-	0x3e, 0x7a, 0x07, 0x86, 0x29, 0xeb, 0x7d, 0x7e, 0xa3, 0xe2, 0xd4, 0xc8, 0x96, 0x11, 0x18, 0xb7,
-	0x6d, 0x77, 0x57, 0xe3, 0x8b, 0xd1, 0x26, 0x54, 0x1c, 0x7c, 0xa8, 0x7b, 0x5d, 0x7e, 0x73, 0x61,
-	0x60, 0x53, 0x00, 0x3e, 0xe1, 0xe0, 0x43, 0xad, 0xcb, 0xae, 0xcc, 0x57, 0x97, 0x60, 0x51, 0xc0,
-	0x6a, 0x7e, 0x11, 0xdf, 0x53, 0x60, 0x7e, 0xfb, 0xc8, 0x69, 0x6e, 0xef, 0x1b, 0x9e, 0xc9, 0x33,
-	0xa4, 0xfc, 0x1a, 0xce, 0x43, 0xc5, 0x77, 0xbb, 0x5e, 0x13, 0xeb, 0xbc, 0x85, 0x9c, 0xdf, 0xc5,
-	0x24, 0x1b, 0xdd, 0x64, 0x83, 0x68, 0x11, 0x46, 0x7d, 0x02, 0x1c, 0xbe, 0xdf, 0x86, 0xb4, 0x11,
-	0xfa, 0xdc, 0x30, 0x51, 0x1d, 0x4e, 0xd1, 0x58, 0xb2, 0x5c, 0x18, 0xe0, 0xd1, 0x75, 0xea, 0x22,
-	0x2c, 0x64, 0x68, 0xe1, 0x74, 0xfe, 0x74, 0x08, 0x4e, 0x93, 0xb9, 0xf0, 0x3d, 0xf9, 0x32, 0x65,
-	0xa5, 0x0a, 0x23, 0x61, 0x46, 0x8a, 0x69, 0x72, 0xf8, 0x48, 0x14, 0xbd, 0x17, 0xeb, 0x46, 0x79,
-	0x84, 0x28, 0xef, 0x40, 0x78, 0x92, 0xcd, 0x43, 0x0d, 0x0d, 0x9a, 0x87, 0x92, 0x2b, 0x61, 0x26,
-	0x92, 0x1f, 0x19, 0x2c, 0x92, 0xff, 0x90, 0x57, 0x7f, 0x7a, 0x41, 0x35, 0xc5, 0x32, 0x5a, 0x88,
-	0x65, 0x86, 0x80, 0x45, 0xee, 0x31, 0xc5, 0x75, 0x05, 0x46, 0xc2, 0x88, 0x7c, 0xac, 0x8f, 0x88,
-	0x3c, 0x5c, 0x1c, 0xcf, 0x26, 0x40, 0x32, 0x9b, 0x70, 0x13, 0x26, 0x58, 0x6d, 0x8a, 0x37, 0x8a,
-	0x8f, 0xf7, 0xd1, 0x28, 0x3e, 0x4e, 0x4b, 0x56, 0xbc, 0x47, 0xfc, 0x2d, 0xa0, 0x7d, 0xde, 0xfc,
-	0xd3, 0x09, 0xdd, 0x32, 0xb1, 0x13, 0x58, 0xc1, 0x11, 0xcd, 0x06, 0x8e, 0x69, 0x88, 0xcc, 0x7d,
-	0x4c, 0xa7, 0x1a, 0x7c, 0x06, 0x3d, 0x84, 0xa9, 0x94, 0x69, 0xe0, 0x99, 0xbf, 0xf3, 0x7d, 0x19,
-	0x05, 0xad, 0x92, 0x34, 0x08, 0xea, 0x3c, 0xcc, 0x26, 0x25, 0x99, 0x8b, 0xf8, 0x9f, 0x28, 0xb0,
-	0x14, 0x76, 0xde, 0x7d, 0x45, 0x3c, 0x3c, 0xf5, 0x8f, 0x15, 0x38, 0x23, 0xa6, 0x89, 0x07, 0x3f,
-	0x97, 0x61, 0xbe, 0xcd, 0xc6, 0x59, 0x5d, 0x46, 0xb7, 0x1c, 0xbd, 0x69, 0x34, 0xf7, 0x31, 0xa7,
-	0xf0, 0x74, 0x3b, 0x06, 0xd5, 0x70, 0x36, 0xc9, 0x14, 0x7a, 0x17, 0x16, 0x33, 0x40, 0xa6, 0x11,
-	0x18, 0xbb, 0x86, 0x1f, 0x36, 0xe0, 0xce, 0x27, 0xe1, 0xb6, 0xf8, 0xac, 0x7a, 0x06, 0x6a, 0x21,
-	0x3d, 0x9c, 0x9f, 0x1f, 0xb8, 0x51, 0xeb, 0x94, 0xfa, 0xbb, 0xa5, 0x1e, 0x0b, 0x13, 0xd3, 0x9c,
-	0xda, 0x35, 0x98, 0x76, 0xba, 0xed, 0x5d, 0xec, 0xe9, 0x6e, 0x4b, 0xa7, 0x56, 0xca, 0xa7, 0x74,
-	0x0e, 0x69, 0x15, 0x36, 0xfe, 0xa8, 0x45, 0x8d, 0x8f, 0x4f, 0x98, 0x1d, 0x5a, 0x35, 0x9f, 0xa6,
-	0x16, 0x86, 0xb4, 0x51, 0x6e, 0xd6, 0x7c, 0xd4, 0x80, 0x09, 0x7e, 0x13, 0xec, 0xa8, 0xe2, 0x2e,
-	0xd3, 0x50, 0x1c, 0x58, 0xae, 0x87, 0x9e, 0x9c, 0xfa, 0x7e, 0xe3, 0x66, 0x6f, 0x00, 0x5d, 0x81,
-	0x05, 0xb6, 0x4f, 0xd3, 0x75, 0x02, 0xcf, 0xb5, 0x6d, 0xec, 0x51, 0x9e, 0x74, 0xd9, 0x9b, 0x62,
-	0x4c, 0x9b, 0xa3, 0xd3, 0x9b, 0xd1, 0x2c, 0xb3, 0x8b, 0x54, 0x43, 0x4c, 0xd3, 0xc3, 0xbe, 0xcf,
-	0x13, 0x92, 0xe1, 0xa3, 0x5a, 0x87, 0x19, 0x56, 0xd9, 0x22, 0x70, 0xa1, 0xec, 0xc4, 0x8d, 0xb4,
-	0x92, 0x30, 0xd2, 0xea, 0x2c, 0xa0, 0xf8, 0x7a, 0x2e, 0x8c, 0xff, 0xa9, 0xc0, 0x0c, 0x73, 0xde,
-	0xe3, 0x5e, 0x62, 0x3e, 0x1a, 0x74, 0x83, 0x57, 0x81, 0xa3, 0xa2, 0x77, 0x65, 0xe3, 0x5c, 0x0e,
-	0x43, 0x08, 0x46, 0x9a, 0x35, 0xa3, 0x75, 0x60, 0x9a, 0x31, 0x8b, 0xe5, 0x5e, 0xcb, 0x89, 0xdc,
-	0xeb, 0x26, 0x4c, 0x1d, 0x58, 0xbe, 0xb5, 0x6b, 0xd9, 0x56, 0x70, 0xc4, 0x2c, 0x51, 0x71, 0xba,
-	0xb0, 0xd2, 0x03, 0xa1, 0x66, 0x68, 0x15, 0x26, 0xf8, 0x2b, 0x4c, 0x77, 0x0c, 0x6e, 0x71, 0xc7,
-	0xb4, 0x71, 0x3e, 0xf6, 0xd0, 0x68, 0x63, 0xc2, 0x85, 0xf8, 0x71, 0x39, 0x17, 0xbe, 0x4f, 0xb9,
-	0xe0, 0xe3, 0xe0, 0x49, 0x17, 0x77, 0x71, 0x1f, 0x5c, 0x48, 0xef, 0x54, 0xca, 0xec, 0x94, 0x64,
-	0x54, 0x79, 0x40, 0x46, 0x31, 0x3a, 0x7b, 0x04, 0x71, 0x3a, 0x7f, 0xa8, 0xc0, 0x6c, 0x28, 0xf7,
-	0x5f, 0x19, 0x52, 0x1f, 0xc1, 0x5c, 0x8a, 0x26, 0xae, 0x85, 0x57, 0x60, 0xa1, 0xe3, 0xb9, 0x4d,
-	0xec, 0xfb, 0x96, 0xb3, 0xa7, 0xd3, 0xaf, 0xca, 0x98, 0x1d, 0x20, 0xca, 0x58, 0x26, 0x32, 0xdf,
-	0x9b, 0xa6, 0x90, 0xd4, 0x08, 0xf8, 0xea, 0xe7, 0x0a, 0x9c, 0xbd, 0x87, 0x03, 0xad, 0xf7, 0x8d,
-	0xd9, 0x03, 0xec, 0xfb, 0xc6, 0x1e, 0x8e, 0x5c, 0x96, 0x9b, 0x30, 0x4c, 0x0b, 0x40, 0x0c, 0xd1,
-	0xf8, 0xc6, 0x85, 0x1c, 0x6a, 0x63, 0x28, 0x68, 0x75, 0x48, 0xe3, 0x60, 0x7d, 0x30, 0x85, 0xd8,
-	0x98, 0xe5, 0x3c, 0x2a, 0xf8, 0x01, 0x9f, 0x43, 0x85, 0x71, 0xbd, 0xcd, 0x67, 0x38, 0x39, 0x1f,
-	0xe6, 0x26, 0x27, 0xe5, 0x08, 0xeb, 0x54, 0x37, 0xc3, 0x51, 0x96, 0x88, 0x9c, 0xf4, 0xe3, 0x63,
-	0x35, 0x1b, 0x50, 0x76, 0x51, 0x3c, 0xd9, 0x38, 0xc4, 0x92, 0x8d, 0xdf, 0x49, 0x26, 0x1b, 0x2f,
-	0x16, 0x33, 0x28, 0x22, 0x26, 0x96, 0x68, 0x6c, 0xc3, 0xca, 0x3d, 0x1c, 0x6c, 0xdd, 0x7f, 0x22,
-	0xb9, 0x8b, 0x06, 0x00, 0x53, 0x69, 0xa7, 0xe5, 0x86, 0x0c, 0xe8, 0x63, 0x3b, 0x22, 0x48, 0xd4,
-	0x4c, 0x52, 0xd1, 0x23, 0xbf, 0x7c, 0xf5, 0x05, 0xac, 0x4a, 0xb6, 0xe3, 0x4c, 0xdf, 0x86, 0x99,
-	0xd8, 0xd7, 0x87, 0xb4, 0x18, 0x19, 0x6e, 0xfb, 0x5a, 0x7f, 0xdb, 0x6a, 0xd3, 0x5e, 0x72, 0xc0,
-	0x57, 0xff, 0x55, 0x81, 0x59, 0x0d, 0x1b, 0x9d, 0x8e, 0xcd, 0x22, 0xa2, 0xe8, 0x74, 0xf3, 0x30,
-	0xcc, 0x33, 0xfb, 0xec, 0x3d, 0xc7, 0x9f, 0xe4, 0x1f, 0x2b, 0x88, 0x5f, 0xd2, 0xe5, 0x93, 0xfa,
-	0xa3, 0xc7, 0x0b, 0x2e, 0xd4, 0x05, 0x98, 0x4b, 0x1d, 0x8d, 0x5b, 0x93, 0x9f, 0x28, 0xb0, 0xa4,
-	0xe1, 0x96, 0x87, 0xfd, 0xfd, 0xa8, 0xc8, 0x41, 0xb8, 0xf1, 0x15, 0x3c, 0xbb, 0xba, 0x0c, 0x67,
-	0xc4, 0xa4, 0xf2, 0xb3, 0xbc, 0x0b, 0x0b, 0x9b, 0x6e, 0xd7, 0x21, 0xc2, 0x93, 0x16, 0xd0, 0x65,
-	0x80, 0x96, 0xeb, 0x35, 0xf1, 0x5d, 0x1c, 0x34, 0xf7, 0x79, 0xc6, 0x36, 0x36, 0xa2, 0x1a, 0x50,
-	0xcd, 0x82, 0x72, 0x61, 0xbb, 0x03, 0x23, 0xd8, 0x09, 0x68, 0x2d, 0x97, 0x89, 0xd8, 0x1b, 0x39,
-	0x22, 0xc6, 0xbd, 0x90, 0xad, 0xfb, 0x4f, 0x28, 0x2e, 0x5e, 0xaf, 0xe5, 0xb0, 0xea, 0x4f, 0x4a,
-	0x30, 0xaf, 0x61, 0xc3, 0x14, 0x50, 0xb7, 0x01, 0xa7, 0xa2, 0xee, 0x88, 0xca, 0xc6, 0x72, 0x9e,
-	0x6f, 0x71, 0xff, 0x09, 0xb5, 0xba, 0x74, 0xad, 0x2c, 0x14, 0xcb, 0x06, 0x73, 0x65, 0x51, 0x30,
-	0xb7, 0x03, 0x55, 0xcb, 0x21, 0x2b, 0xac, 0x03, 0xac, 0x63, 0x27, 0xb2, 0x60, 0x7d, 0x76, 0x94,
-	0xcd, 0x45, 0xc0, 0x77, 0x9c, 0xd0, 0x14, 0x35, 0x4c, 0x22, 0x18, 0x1d, 0x82, 0x84, 0xd6, 0xa4,
-	0x87, 0x28, 0x61, 0xa3, 0x64, 0x60, 0xdb, 0xfa, 0x14, 0xa3, 0xd7, 0x60, 0x8a, 0xf6, 0x45, 0xd0,
-	0x15, 0xac, 0x7c, 0x3f, 0x4c, 0xcb, 0xf7, 0xb4, 0x5d, 0xe2, 0xb1, 0xb1, 0x87, 0x59, 0x37, 0xdf,
-	0xdf, 0x96, 0x60, 0x21, 0xc3, 0x2b, 0x7e, 0x1d, 0xc7, 0x61, 0x96, 0xd0, 0x5e, 0x94, 0x4e, 0x66,
-	0x2f, 0xd0, 0x77, 0x61, 0x3e, 0x83, 0x34, 0xcc, 0x11, 0x0e, 0x6a, 0x00, 0x67, 0xd3, 0xd8, 0x69,
-	0x8a, 0x50, 0xc0, 0xae, 0x53, 0x22, 0x76, 0xfd, 0x42, 0x81, 0x85, 0xc7, 0x5d, 0x6f, 0x0f, 0x7f,
-	0xbd, 0x65, 0x4b, 0xad, 0x41, 0x35, 0x7b, 0x4c, 0xae, 0xfc, 0x5f, 0x94, 0x60, 0xe1, 0x01, 0xfe,
-	0xda, 0xf3, 0xe0, 0x7f, 0x46, 0xbf, 0x6e, 0x43, 0x35, 0xcb, 0x2b, 0xae, 0x5f, 0x02, 0x1c, 0x8a,
-	0x08, 0xc7, 0x67, 0x0a, 0x9c, 0x79, 0xe8, 0x06, 0x56, 0xeb, 0x88, 0x84, 0xdb, 0xee, 0x01, 0xf6,
-	0x1e, 0x18, 0x24, 0x96, 0x8e, 0xb8, 0xfe, 0x5d, 0x98, 0x6f, 0xf1, 0x19, 0xbd, 0x4d, 0xa7, 0xf4,
-	0x84, 0xc3, 0x96, 0xa7, 0x1f, 0x49, 0x74, 0xcc, 0x67, 0x9b, 0x6d, 0x65, 0x07, 0x7d, 0xf5, 0x1c,
-	0x9c, 0xcd, 0xa1, 0x80, 0x0b, 0x85, 0x01, 0x4b, 0xf7, 0x70, 0xb0, 0xe9, 0xb9, 0xbe, 0xcf, 0x6f,
-	0x25, 0xf1, 0x72, 0x4b, 0x04, 0x7e, 0x4a, 0x2a, 0xf0, 0x3b, 0x0f, 0x95, 0xc0, 0xf0, 0xf6, 0x70,
-	0x10, 0xdd, 0x32, 0x7b, 0xcd, 0x4d, 0xb2, 0x51, 0x8e, 0x4f, 0xfd, 0x65, 0x19, 0xce, 0x88, 0xf7,
-	0xe0, 0xfc, 0x6c, 0x13, 0x3c, 0xc4, 0x34, 0xec, 0x1e, 0xb1, 0x30, 0x94, 0x1f, 0xff, 0x9e, 0xcc,
-	0x41, 0xcc, 0x45, 0x47, 0x9d, 0x6f, 0xff, 0xf6, 0x11, 0x75, 0x00, 0xd9, 0x1b, 0x66, 0x22, 0x88,
-	0x0d, 0xa1, 0xcf, 0x14, 0x98, 0x6b, 0xd1, 0x82, 0x98, 0xde, 0x34, 0xba, 0x3e, 0xee, 0x6d, 0xcb,
-	0xec, 0xdd, 0x83, 0xe3, 0x6d, 0xcb, 0x6a, 0x6c, 0x9b, 0x04, 0x63, 0x62, 0x73, 0xd4, 0xca, 0x4c,
-	0xd4, 0x3a, 0x30, 0x93, 0xa1, 0x52, 0xe0, 0x9e, 0xde, 0x49, 0xba, 0xa7, 0xeb, 0x39, 0xe2, 0x90,
-	0xa6, 0x89, 0x5f, 0x5e, 0xdc, 0x47, 0xad, 0x75, 0x60, 0x21, 0x87, 0x40, 0xc1, 0xbe, 0x37, 0xe3,
-	0xfb, 0x56, 0x72, 0xd3, 0xbd, 0xf7, 0x70, 0xd0, 0x2b, 0x2e, 0x52, 0xbc, 0x71, 0xaf, 0xf8, 0x3f,
-	0x14, 0x58, 0xe3, 0xe5, 0xbc, 0x0c, 0xd3, 0x32, 0x75, 0x08, 0x49, 0x64, 0xd6, 0x9f, 0x94, 0xa1,
-	0xa7, 0x4c, 0x88, 0xa2, 0xbe, 0x8b, 0x30, 0x57, 0xdd, 0x3f, 0xd3, 0x78, 0xb7, 0xc5, 0x64, 0x10,
-	0x7b, 0xf2, 0xd1, 0xab, 0x30, 0xd9, 0x22, 0x0e, 0xd0, 0x43, 0xcc, 0x7c, 0x29, 0x5e, 0x7e, 0x4a,
-	0x0e, 0xaa, 0x1e, 0xbc, 0xde, 0xc7, 0x59, 0x23, 0x77, 0x69, 0x28, 0xf4, 0xc7, 0x8f, 0x77, 0xad,
-	0x14, 0x5a, 0x7d, 0x87, 0x7e, 0xd3, 0x16, 0x2a, 0x36, 0x7d, 0x49, 0xf6, 0x91, 0x1b, 0x53, 0x03,
-	0xfa, 0xdd, 0x56, 0x12, 0x2c, 0x72, 0x1c, 0xe6, 0x7a, 0x65, 0x97, 0x30, 0x11, 0xd3, 0xe5, 0x7d,
-	0x54, 0x43, 0x5a, 0xaf, 0x26, 0xb3, 0xcd, 0xb2, 0x30, 0x5d, 0x87, 0xe6, 0xc5, 0xc3, 0xaf, 0x2e,
-	0x79, 0x0a, 0x89, 0xe5, 0x87, 0x26, 0xf9, 0x28, 0xcb, 0x20, 0xa9, 0x0d, 0x98, 0xd7, 0x8c, 0x00,
-	0xdb, 0x56, 0xdb, 0x0a, 0x3e, 0xea, 0x98, 0xb1, 0x44, 0xde, 0x3a, 0x9c, 0x32, 0x8d, 0xc0, 0xe0,
-	0xcc, 0x58, 0xca, 0x6b, 0xc4, 0xbc, 0xe5, 0x1c, 0x69, 0x74, 0xa1, 0xfa, 0x21, 0x2c, 0x64, 0x50,
-	0xf1, 0x03, 0x0c, 0x8a, 0x6b, 0xe3, 0x9f, 0xea, 0x00, 0xdc, 0x29, 0xbd, 0xf5, 0xb8, 0x81, 0xfe,
-	0x50, 0x81, 0x79, 0xf1, 0x47, 0xed, 0xe8, 0xca, 0xf1, 0xfe, 0x85, 0xa2, 0x76, 0x75, 0x60, 0x38,
-	0x7e, 0x96, 0x3f, 0x52, 0x60, 0x21, 0xe7, 0x5f, 0x0f, 0xd0, 0xd5, 0xa2, 0x7f, 0x0c, 0xc8, 0xa3,
-	0xe6, 0xda, 0xe0, 0x80, 0x9c, 0x9c, 0x1f, 0x2b, 0xb0, 0x52, 0xf4, 0xe5, 0x3f, 0xfa, 0xce, 0x49,
-	0xff, 0xc9, 0xa0, 0x76, 0xeb, 0x04, 0x18, 0x38, 0xa5, 0xe4, 0x12, 0xc5, 0xdf, 0xf4, 0x4b, 0x2e,
-	0x51, 0xfa, 0x5f, 0x02, 0x92, 0x4b, 0x2c, 0xf8, 0xf3, 0x80, 0x3f, 0x53, 0xa0, 0x96, 0xff, 0xe5,
-	0x3b, 0xca, 0xef, 0x0a, 0x2b, 0xfc, 0x47, 0x80, 0xda, 0x7b, 0xc7, 0x82, 0xe5, 0x74, 0xfd, 0x50,
-	0x81, 0xc5, 0xdc, 0xef, 0xda, 0xd1, 0xbb, 0xb9, 0xa8, 0x8b, 0x3e, 0xab, 0xaf, 0x5d, 0x3f, 0x0e,
-	0x28, 0x27, 0xca, 0x81, 0xc9, 0xc4, 0x07, 0xcf, 0xe8, 0xcd, 0x5c, 0x64, 0xa2, 0xef, 0xaa, 0x6b,
-	0xf5, 0x7e, 0x97, 0xf3, 0xfd, 0x3e, 0x53, 0xe0, 0xb4, 0xe0, 0xab, 0x61, 0x74, 0x59, 0x7e, 0xdb,
-	0xc2, 0xef, 0x94, 0x6b, 0x6f, 0x0f, 0x06, 0xc4, 0x49, 0x08, 0x60, 0x2a, 0xf5, 0x11, 0x2d, 0x5a,
-	0x97, 0xb9, 0x1f, 0x82, 0x4a, 0x48, 0xed, 0xad, 0xfe, 0x01, 0xf8, 0xae, 0x87, 0x30, 0x9d, 0xfe,
-	0x12, 0x0c, 0xe5, 0x63, 0xc9, 0xf9, 0x56, 0xae, 0x76, 0x69, 0x00, 0x88, 0x98, 0xd8, 0xe5, 0xf6,
-	0x3b, 0x4a, 0xc4, 0xae, 0xe8, 0x6b, 0x94, 0xda, 0x09, 0xda, 0x2b, 0xd1, 0x5f, 0x2a, 0x70, 0x46,
-	0xd6, 0x0e, 0x89, 0x6e, 0x1c, 0xb3, 0x8b, 0x92, 0x91, 0xf6, 0xfe, 0x89, 0x7a, 0x30, 0x39, 0xcb,
-	0x72, 0x7a, 0x06, 0xa5, 0x2c, 0x93, 0x77, 0x2c, 0x4a, 0x59, 0x56, 0xd0, 0xa2, 0x18, 0xbb, 0x47,
-	0x41, 0x43, 0x76, 0xe1, 0x3d, 0xe6, 0xb7, 0xc2, 0x17, 0xde, 0xa3, 0xac, 0xff, 0x3b, 0x76, 0x8f,
-	0xc2, 0xb6, 0xbd, 0xe2, 0x7b, 0x94, 0xb5, 0x0e, 0x16, 0xdf, 0xa3, 0xb4, 0x57, 0x30, 0x7e, 0x8f,
-	0xd9, 0xce, 0xbc, 0xe2, 0x7b, 0xcc, 0xed, 0x0b, 0x2c, 0xbe, 0xc7, 0xfc, 0x46, 0x40, 0xf4, 0x17,
-	0x34, 0xb7, 0x99, 0xdb, 0x72, 0x87, 0xde, 0x1b, 0xe8, 0xcc, 0xc9, 0xa6, 0xbf, 0xda, 0x8d, 0xe3,
-	0x01, 0x27, 0x48, 0xcb, 0xed, 0x37, 0x95, 0x92, 0x56, 0xd4, 0xf1, 0x2a, 0x25, 0xad, 0xb8, 0xc5,
-	0xf5, 0xaf, 0x15, 0x58, 0x96, 0x37, 0x9a, 0xa1, 0x6f, 0x4b, 0x36, 0xe8, 0xa3, 0xdb, 0xae, 0x76,
-	0xf3, 0xd8, 0xf0, 0x9c, 0xc6, 0xef, 0x2b, 0x50, 0xcd, 0x6b, 0x37, 0x44, 0xd7, 0x24, 0xd8, 0xa5,
-	0x7d, 0x95, 0xb5, 0x77, 0x8f, 0x01, 0xc9, 0x29, 0xfa, 0x5c, 0x81, 0x59, 0x51, 0xd3, 0x1a, 0xca,
-	0x7f, 0x73, 0x4a, 0x5a, 0xf4, 0x6a, 0xef, 0x0c, 0x08, 0xc5, 0xa9, 0xf8, 0x2b, 0xfa, 0xe7, 0x53,
-	0x92, 0xa6, 0x2c, 0xf4, 0x7e, 0x81, 0x6c, 0xc8, 0x3b, 0xea, 0x6a, 0xdf, 0x3e, 0x2e, 0x38, 0x27,
-	0xf0, 0x53, 0x98, 0xc9, 0xf4, 0x27, 0xa1, 0x4b, 0x12, 0xa4, 0xe2, 0xb6, 0xb1, 0xda, 0xc6, 0x20,
-	0x20, 0x3d, 0x6f, 0x24, 0xd5, 0x71, 0x24, 0xf1, 0x46, 0xc4, 0x7d, 0x52, 0x12, 0x6f, 0x24, 0xa7,
-	0x99, 0x09, 0x3d, 0x83, 0x89, 0x78, 0x07, 0x08, 0xfa, 0x96, 0x14, 0x43, 0xaa, 0xe5, 0xa9, 0xf6,
-	0x66, 0x9f, 0xab, 0x63, 0x52, 0x28, 0x6a, 0xe1, 0x90, 0x48, 0xa1, 0xa4, 0x0b, 0x45, 0x22, 0x85,
-	0xd2, 0x3e, 0x11, 0xe2, 0x79, 0x0a, 0x3a, 0x33, 0x24, 0x9e, 0x67, 0x7e, 0x9b, 0x47, 0xed, 0xed,
-	0xc1, 0x80, 0xa2, 0x4f, 0x55, 0xa0, 0xd7, 0xe8, 0x80, 0x2e, 0xe6, 0xe2, 0xc8, 0x74, 0x4f, 0xd4,
-	0xde, 0xe8, 0x6b, 0x6d, 0x6f, 0x9b, 0x5e, 0x27, 0x81, 0x64, 0x9b, 0x4c, 0x77, 0x85, 0x64, 0x9b,
-	0x6c, 0x6b, 0x02, 0xdb, 0x26, 0x6c, 0x04, 0x90, 0x6e, 0x93, 0x6a, 0x5f, 0x90, 0x6e, 0x93, 0xee,
-	0x2c, 0x20, 0x11, 0x4a, 0xa2, 0x88, 0x2f, 0x89, 0x50, 0x44, 0x0d, 0x08, 0x92, 0x08, 0x45, 0xdc,
-	0x1b, 0x40, 0x42, 0x59, 0x71, 0x31, 0x5c, 0x12, 0xca, 0x4a, 0x9b, 0x02, 0x24, 0xa1, 0x6c, 0x41,
-	0x19, 0x9f, 0x38, 0x30, 0xb9, 0x75, 0x67, 0x89, 0x03, 0x53, 0x54, 0x1a, 0x97, 0x38, 0x30, 0xc5,
-	0x65, 0x6e, 0x07, 0x26, 0x13, 0x55, 0x5b, 0xc9, 0x85, 0x88, 0x0a, 0xd7, 0x92, 0x0b, 0x11, 0x16,
-	0x83, 0xa9, 0xf9, 0x10, 0x55, 0x58, 0x91, 0x2c, 0xfc, 0xcb, 0xad, 0x1d, 0x4b, 0xcc, 0x87, 0xac,
-	0x8c, 0x4b, 0xe2, 0xb7, 0x74, 0x2d, 0x56, 0x12, 0xbf, 0xe5, 0x54, 0x7c, 0x25, 0xf1, 0x5b, 0x6e,
-	0xa1, 0x37, 0x80, 0xa9, 0x54, 0xd1, 0x51, 0xf2, 0x82, 0x10, 0x97, 0x72, 0x25, 0x2f, 0x88, 0xbc,
-	0x7a, 0x26, 0x09, 0x57, 0x53, 0x45, 0x2d, 0x59, 0xb8, 0x2a, 0x2e, 0xf3, 0xc9, 0xc2, 0xd5, 0x9c,
-	0x8a, 0x19, 0xd9, 0x38, 0x5d, 0x04, 0x92, 0x6c, 0x9c, 0x53, 0x5b, 0x93, 0x6c, 0x9c, 0x5b, 0x61,
-	0xfa, 0x03, 0x05, 0xe6, 0x84, 0x75, 0x1b, 0x94, 0x2f, 0x31, 0xb2, 0x4a, 0x53, 0xed, 0xca, 0xa0,
-	0x60, 0x31, 0x79, 0x17, 0x55, 0x3d, 0x24, 0xf2, 0x2e, 0x29, 0x27, 0x49, 0xe4, 0x5d, 0x5a, 0x20,
-	0xfa, 0x42, 0x89, 0xbe, 0x6a, 0xca, 0x4f, 0xaf, 0xa3, 0x5b, 0x45, 0xf1, 0x46, 0x61, 0x19, 0xa2,
-	0x76, 0xfb, 0x24, 0x28, 0x12, 0x29, 0x9d, 0x78, 0x7e, 0x5d, 0x9e, 0xd2, 0x11, 0x24, 0xf0, 0xe5,
-	0x29, 0x1d, 0x61, 0xea, 0x9e, 0x68, 0x66, 0x32, 0x29, 0x2e, 0xd3, 0x4c, 0x61, 0x26, 0x5e, 0xa6,
-	0x99, 0xe2, 0x7c, 0xfb, 0xed, 0x3b, 0x3f, 0xfd, 0x72, 0x59, 0xf9, 0xd9, 0x97, 0xcb, 0xca, 0xbf,
-	0x7d, 0xb9, 0xac, 0xfc, 0xfa, 0xd5, 0x3d, 0x2b, 0xd8, 0xef, 0xee, 0xd6, 0x9b, 0x6e, 0x7b, 0x3d,
-	0xf1, 0xff, 0xe4, 0xf5, 0x3d, 0xec, 0xb0, 0x3f, 0xab, 0x8f, 0xfd, 0x5b, 0xfe, 0x7b, 0xfc, 0xe7,
-	0xc1, 0xa5, 0xdd, 0x61, 0x3a, 0x77, 0xf9, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x7a, 0xca,
-	0x50, 0x59, 0x5f, 0x00, 0x00,
+	// 5430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x5b, 0x6c, 0xdc, 0x56,
+	0x76, 0xa0, 0x64, 0xbd, 0x8e, 0xa4, 0x91, 0x74, 0xad, 0xc7, 0x98, 0xb2, 0x65, 0x89, 0xb6, 0x13,
+	0xc5, 0x49, 0x46, 0xb6, 0x12, 0x3f, 0xe2, 0x38, 0x9b, 0xb5, 0x25, 0xdb, 0x99, 0xac, 0xec, 0xd8,
+	0x94, 0xe2, 0xf4, 0xb5, 0xe1, 0x52, 0xe4, 0x1d, 0x89, 0x35, 0x87, 0x1c, 0x93, 0x1c, 0xc9, 0xca,
+	0x47, 0x91, 0x22, 0x45, 0x81, 0x2e, 0x16, 0xdd, 0x76, 0xb1, 0x2d, 0x0a, 0x14, 0x28, 0x50, 0x6c,
+	0x81, 0xc5, 0x06, 0x45, 0x7f, 0x5a, 0xa0, 0x1f, 0x45, 0x7f, 0xda, 0x9f, 0xfd, 0xdc, 0xdf, 0xfe,
+	0x15, 0x41, 0xfb, 0xd1, 0x02, 0xfd, 0xdb, 0xef, 0xa2, 0xb8, 0x2f, 0x0e, 0x39, 0xbc, 0xe4, 0x70,
+	0xa4, 0x16, 0xc9, 0xa6, 0xf9, 0xb2, 0xe6, 0xdc, 0x73, 0xce, 0x3d, 0xf7, 0xdc, 0x7b, 0x0e, 0xcf,
+	0x3d, 0xe7, 0x90, 0x86, 0x4b, 0xed, 0x5d, 0x1c, 0xac, 0x59, 0xa6, 0x8d, 0x3d, 0x0b, 0xaf, 0xed,
+	0x3b, 0x61, 0xe4, 0x07, 0x47, 0x6b, 0x07, 0x57, 0xd7, 0x42, 0x1c, 0x1c, 0x38, 0x16, 0xae, 0xb5,
+	0x02, 0x3f, 0xf2, 0xd1, 0x02, 0x41, 0xab, 0x71, 0xb4, 0x1a, 0x47, 0xab, 0x1d, 0x5c, 0x55, 0x97,
+	0xf6, 0x7c, 0x7f, 0xcf, 0xc5, 0x6b, 0x14, 0x6d, 0xb7, 0xdd, 0x58, 0xb3, 0xdb, 0x81, 0x19, 0x39,
+	0xbe, 0xc7, 0x08, 0xd5, 0xf3, 0xdd, 0xe3, 0x91, 0xd3, 0xc4, 0x61, 0x64, 0x36, 0x5b, 0x1c, 0x21,
+	0xc3, 0xe0, 0x30, 0x30, 0x5b, 0x2d, 0x1c, 0x84, 0x7c, 0x7c, 0x39, 0x25, 0xa0, 0xd9, 0x72, 0x88,
+	0x70, 0x96, 0xdf, 0x6c, 0xc6, 0x53, 0xac, 0xc8, 0x30, 0x84, 0x88, 0x5c, 0x0a, 0x19, 0xca, 0xf3,
+	0x36, 0x8e, 0x11, 0x34, 0x19, 0x42, 0x64, 0x86, 0xcf, 0x5c, 0x27, 0x8c, 0x8a, 0x70, 0x0e, 0xfd,
+	0xe0, 0x59, 0xc3, 0xf5, 0x0f, 0x39, 0xce, 0x65, 0x19, 0x0e, 0x57, 0xa5, 0xd1, 0x85, 0xbb, 0xda,
+	0x0b, 0x17, 0x07, 0x1c, 0xf3, 0x42, 0x1a, 0xd3, 0x6e, 0x3a, 0x1e, 0xd5, 0x82, 0xdb, 0x0e, 0xa3,
+	0x5e, 0x48, 0x69, 0x45, 0xac, 0xc8, 0x91, 0x9e, 0xb7, 0x71, 0x9b, 0x6f, 0xb5, 0xfa, 0xb2, 0x1c,
+	0x25, 0xc0, 0x2d, 0xd7, 0xb1, 0x92, 0x5b, 0x9b, 0xde, 0x99, 0x70, 0xdf, 0x0c, 0xb0, 0x4d, 0x30,
+	0x4d, 0x4f, 0xcc, 0x76, 0x31, 0x07, 0x23, 0x2d, 0xd3, 0xa5, 0x1c, 0xac, 0xb4, 0xba, 0xb4, 0x1f,
+	0x8c, 0xc1, 0xb9, 0xed, 0xc8, 0x0c, 0xa2, 0x8f, 0x38, 0xfc, 0xde, 0x0b, 0x6c, 0xb5, 0x89, 0x3c,
+	0x3a, 0x7e, 0xde, 0xc6, 0x61, 0x84, 0xb6, 0x60, 0x24, 0x60, 0x7f, 0x56, 0x95, 0x65, 0x65, 0x75,
+	0x7c, 0x7d, 0xbd, 0x96, 0x3a, 0xb6, 0x66, 0xcb, 0xa9, 0x1d, 0x5c, 0xad, 0x15, 0x32, 0xd1, 0x05,
+	0x0b, 0xb4, 0x08, 0x63, 0xb6, 0xdf, 0x34, 0x1d, 0xcf, 0x70, 0xec, 0xea, 0xc0, 0xb2, 0xb2, 0x3a,
+	0xa6, 0x8f, 0x32, 0x40, 0xdd, 0x46, 0xbf, 0x05, 0x73, 0x2d, 0x33, 0xc0, 0x5e, 0x64, 0x60, 0xc1,
+	0xc0, 0x70, 0xbc, 0x86, 0x5f, 0x1d, 0xa4, 0x13, 0xaf, 0x4a, 0x27, 0x7e, 0x4c, 0x29, 0xe2, 0x19,
+	0xeb, 0x5e, 0xc3, 0xd7, 0x4f, 0xb7, 0xb2, 0x40, 0x54, 0x85, 0x11, 0x33, 0x8a, 0x70, 0xb3, 0x15,
+	0x55, 0x4f, 0x2d, 0x2b, 0xab, 0x43, 0xba, 0xf8, 0x89, 0x36, 0x60, 0x0a, 0xbf, 0x68, 0x39, 0xcc,
+	0xc4, 0x0c, 0x62, 0x4b, 0xd5, 0x21, 0x3a, 0xa3, 0x5a, 0x63, 0x76, 0x54, 0x13, 0x76, 0x54, 0xdb,
+	0x11, 0x86, 0xa6, 0x57, 0x3a, 0x24, 0x04, 0x88, 0x1a, 0x70, 0xc6, 0xf2, 0xbd, 0xc8, 0xf1, 0xda,
+	0xd8, 0x30, 0x43, 0xc3, 0xc3, 0x87, 0x86, 0xe3, 0x39, 0x91, 0x63, 0x46, 0x7e, 0x50, 0x1d, 0x5e,
+	0x56, 0x56, 0x2b, 0xeb, 0xaf, 0x4a, 0x17, 0xb0, 0xc1, 0xa9, 0xee, 0x84, 0x8f, 0xf0, 0x61, 0x5d,
+	0x90, 0xe8, 0xf3, 0x96, 0x14, 0x8e, 0xea, 0x30, 0x23, 0x46, 0x6c, 0xa3, 0x61, 0x3a, 0x6e, 0x3b,
+	0xc0, 0xd5, 0x11, 0x2a, 0xee, 0x59, 0x29, 0xff, 0xfb, 0x0c, 0x47, 0x9f, 0x8e, 0xc9, 0x38, 0x04,
+	0xe9, 0x30, 0xef, 0x9a, 0x61, 0x64, 0x58, 0x7e, 0xb3, 0xe5, 0x62, 0xba, 0xf8, 0x00, 0x87, 0x6d,
+	0x37, 0xaa, 0x8e, 0x16, 0xf0, 0x7b, 0x6c, 0x1e, 0xb9, 0xbe, 0x69, 0xeb, 0xb3, 0x84, 0x76, 0x23,
+	0x26, 0xd5, 0x29, 0x25, 0xfa, 0x35, 0x58, 0x6c, 0x38, 0x41, 0x18, 0x19, 0x36, 0xb6, 0x9c, 0x90,
+	0xea, 0xd3, 0x0c, 0x9f, 0x19, 0xbb, 0xa6, 0xf5, 0xcc, 0x6f, 0x34, 0xaa, 0x63, 0x94, 0xf1, 0x99,
+	0x8c, 0x5e, 0x37, 0xb9, 0x83, 0xd3, 0xab, 0x94, 0x7a, 0x93, 0x13, 0xef, 0x98, 0xe1, 0xb3, 0xbb,
+	0x8c, 0x14, 0x1d, 0xc0, 0x74, 0xcb, 0x0c, 0x22, 0x87, 0xca, 0x69, 0xf9, 0x5e, 0xc3, 0xd9, 0xab,
+	0xc2, 0xf2, 0xe0, 0xea, 0xf8, 0xfa, 0x77, 0x6a, 0x39, 0x8e, 0xb4, 0xf8, 0x54, 0xd6, 0x1e, 0x0b,
+	0x76, 0x1b, 0x94, 0xdb, 0x3d, 0x2f, 0x0a, 0x8e, 0xf4, 0xa9, 0x56, 0x1a, 0x8a, 0xbe, 0x0b, 0xb3,
+	0x09, 0x05, 0x59, 0xa6, 0xeb, 0x92, 0xc5, 0x84, 0xd5, 0x71, 0x3a, 0xf7, 0xe5, 0xf4, 0xdc, 0xcc,
+	0xd0, 0xd8, 0xb6, 0x0a, 0x9a, 0x0d, 0x4e, 0xa2, 0x9f, 0xb6, 0x32, 0xb0, 0x10, 0x3d, 0x85, 0x69,
+	0x61, 0x93, 0x86, 0x63, 0x1b, 0xfb, 0x66, 0xb8, 0x5f, 0x9d, 0xa0, 0x5a, 0x7a, 0x2d, 0x8f, 0xb5,
+	0x58, 0x50, 0xdd, 0x7e, 0xcf, 0x0c, 0xf7, 0x1f, 0xfb, 0xae, 0x63, 0x1d, 0xe9, 0x95, 0xc3, 0x14,
+	0x14, 0xf9, 0xb0, 0x98, 0xe4, 0x4b, 0x14, 0xe6, 0x3a, 0x56, 0x64, 0xb4, 0x28, 0x7a, 0x75, 0x92,
+	0x9e, 0xc8, 0x2b, 0xbd, 0xa7, 0xd8, 0xe0, 0x84, 0x7c, 0x9a, 0xea, 0x61, 0xce, 0x88, 0x7a, 0x17,
+	0x66, 0x65, 0x0a, 0x45, 0xd3, 0x30, 0xf8, 0x0c, 0x1f, 0x51, 0xe7, 0x31, 0xa6, 0x93, 0x3f, 0xd1,
+	0x2c, 0x0c, 0x1d, 0x98, 0x6e, 0x1b, 0x73, 0x07, 0xc0, 0x7e, 0xdc, 0x1a, 0xb8, 0xa9, 0x68, 0x37,
+	0x60, 0x29, 0x6f, 0xcb, 0xc2, 0x96, 0xef, 0x85, 0x18, 0xcd, 0xc1, 0x70, 0xd0, 0xa6, 0xde, 0x83,
+	0x31, 0x1c, 0x0a, 0xda, 0x5e, 0xdd, 0xd6, 0xfe, 0x6a, 0x00, 0x96, 0xb6, 0x9d, 0x3d, 0xcf, 0x74,
+	0x73, 0x1d, 0xd9, 0xc3, 0x6e, 0x47, 0xf6, 0x86, 0xdc, 0x91, 0x15, 0x72, 0x29, 0xe9, 0xc9, 0x1a,
+	0xb0, 0x88, 0x5f, 0x44, 0x38, 0xf0, 0x4c, 0x37, 0x7e, 0x40, 0x75, 0x9c, 0x1a, 0xf7, 0x67, 0x2f,
+	0x49, 0xe7, 0xcf, 0xce, 0x7c, 0x46, 0xb0, 0xca, 0x0c, 0xa1, 0x1a, 0x9c, 0xb6, 0xf6, 0x1d, 0xd7,
+	0xee, 0x4c, 0xe2, 0x7b, 0xee, 0x11, 0xf5, 0x6f, 0xa3, 0xfa, 0x0c, 0x1d, 0x12, 0x44, 0x1f, 0x78,
+	0xee, 0x91, 0xb6, 0x02, 0xe7, 0x73, 0xd7, 0xc7, 0x14, 0xac, 0xfd, 0xcd, 0x29, 0x78, 0x99, 0xe3,
+	0x38, 0xd1, 0x7e, 0xf1, 0xb3, 0xe1, 0x69, 0xb7, 0x4a, 0x6f, 0x17, 0xa9, 0xb4, 0x17, 0xbb, 0x92,
+	0xba, 0xfd, 0x54, 0x91, 0x38, 0x82, 0x41, 0x6a, 0x8c, 0x1f, 0xe6, 0x3b, 0x82, 0x72, 0x22, 0x94,
+	0x74, 0x09, 0x32, 0x9b, 0x3d, 0xf5, 0x7f, 0x6f, 0xb3, 0x43, 0x5f, 0x49, 0x9b, 0xbd, 0x03, 0xab,
+	0xbd, 0xb5, 0x5b, 0x6c, 0xbd, 0xdf, 0x57, 0xe0, 0x9c, 0x8e, 0x43, 0x7c, 0xe2, 0x28, 0xa4, 0x90,
+	0x49, 0xb9, 0xf3, 0x45, 0x7c, 0x50, 0x1e, 0x9b, 0xe2, 0x55, 0x7c, 0x3e, 0x00, 0x2b, 0x3b, 0x38,
+	0x68, 0x3a, 0x9e, 0x19, 0xe1, 0xdc, 0x95, 0x3c, 0xee, 0x5e, 0xc9, 0x75, 0xe9, 0x4a, 0x7a, 0x32,
+	0xfa, 0x15, 0xf7, 0x44, 0x17, 0x41, 0x2b, 0x5a, 0x22, 0x77, 0x46, 0x7f, 0xa4, 0xc0, 0xf2, 0x26,
+	0x0e, 0xad, 0xc0, 0xd9, 0xcd, 0xd7, 0xe8, 0x07, 0xdd, 0x1a, 0xbd, 0x26, 0x5d, 0x4e, 0x2f, 0x3e,
+	0x25, 0x8f, 0xc7, 0x8f, 0x87, 0x60, 0xa5, 0x80, 0x15, 0x3f, 0x22, 0x2e, 0x2c, 0x74, 0x62, 0x58,
+	0xe6, 0xa3, 0x78, 0x84, 0x53, 0xf8, 0xf0, 0xc9, 0x30, 0xdc, 0x48, 0x92, 0xea, 0xf3, 0x58, 0x0a,
+	0x47, 0xbb, 0xb0, 0x90, 0xdd, 0x5b, 0x16, 0x3a, 0x0f, 0x2c, 0x2b, 0xd9, 0x28, 0x25, 0x6f, 0x36,
+	0x1a, 0x3c, 0xcf, 0x1d, 0xca, 0xc0, 0xe8, 0x23, 0x40, 0x2d, 0xec, 0xd9, 0x8e, 0xb7, 0x67, 0x98,
+	0x56, 0xe4, 0x1c, 0x38, 0x91, 0x83, 0x43, 0xee, 0x77, 0x73, 0x22, 0x73, 0x86, 0x7e, 0x87, 0x61,
+	0x1f, 0x51, 0xe6, 0x33, 0xad, 0x14, 0xd0, 0xc1, 0x21, 0xfa, 0x75, 0x98, 0x16, 0x8c, 0xe9, 0x31,
+	0x09, 0xb0, 0x57, 0x3d, 0x45, 0xd9, 0xd6, 0x8a, 0xd8, 0x6e, 0x10, 0xdc, 0xb4, 0xe4, 0x53, 0xad,
+	0xc4, 0x50, 0x80, 0x3d, 0xb4, 0xdd, 0x61, 0x2d, 0xc2, 0x51, 0x1e, 0xd9, 0x17, 0x4a, 0x2c, 0xa2,
+	0xcf, 0x14, 0x53, 0x01, 0x44, 0x57, 0x61, 0x36, 0x71, 0x6d, 0x33, 0xf0, 0x0b, 0xcb, 0x6d, 0xdb,
+	0xd8, 0xa6, 0x31, 0xfe, 0xa8, 0x7e, 0x3a, 0x31, 0x76, 0x8f, 0x0f, 0x21, 0x33, 0x27, 0x84, 0x1c,
+	0x91, 0x2d, 0xb3, 0x28, 0x84, 0x64, 0xb7, 0x1b, 0x49, 0x18, 0xa9, 0xbd, 0x80, 0xd9, 0x27, 0xe4,
+	0xea, 0x2d, 0xf6, 0x54, 0x18, 0xc7, 0x46, 0xb7, 0x71, 0xbc, 0x22, 0x5d, 0xb9, 0x8c, 0xb6, 0xa4,
+	0x41, 0xfc, 0x44, 0x81, 0xb9, 0x2e, 0x72, 0x6e, 0x04, 0xef, 0xc2, 0x04, 0x4d, 0x07, 0x88, 0x5b,
+	0x85, 0x52, 0xe2, 0x56, 0x31, 0x4e, 0x29, 0xf8, 0x65, 0xa2, 0x0e, 0x15, 0xc1, 0xe0, 0xb7, 0xb1,
+	0x15, 0x61, 0x9b, 0x1f, 0x67, 0x2d, 0x7f, 0x0d, 0x3a, 0xc7, 0xd4, 0x27, 0x9f, 0x27, 0x7f, 0x6a,
+	0xbf, 0xa7, 0x80, 0x4a, 0xdd, 0xfa, 0x76, 0xe4, 0x58, 0xcf, 0x8e, 0xc8, 0xc5, 0x62, 0xcb, 0x09,
+	0x23, 0xa1, 0xa6, 0x7a, 0xb7, 0x9a, 0xd6, 0xf2, 0x9f, 0x2f, 0x52, 0x0e, 0x25, 0x95, 0x75, 0x0e,
+	0x16, 0xa5, 0x3c, 0xb8, 0xbf, 0xfb, 0xc5, 0x00, 0xcc, 0x3f, 0xc0, 0xd1, 0xc3, 0x76, 0x64, 0xee,
+	0xba, 0x78, 0x3b, 0x32, 0x23, 0xac, 0xcb, 0xd8, 0x2a, 0x5d, 0x5e, 0xfe, 0x43, 0x40, 0x12, 0xe7,
+	0x3e, 0xd0, 0x97, 0x73, 0x9f, 0xc9, 0xd8, 0x3d, 0x7a, 0x03, 0xe6, 0xf1, 0x8b, 0x16, 0x55, 0xa0,
+	0xe1, 0xe1, 0x17, 0x91, 0x81, 0x0f, 0xc8, 0xed, 0xdc, 0xb1, 0xe9, 0x73, 0x63, 0x50, 0x3f, 0x2d,
+	0x46, 0x1f, 0xe1, 0x17, 0xd1, 0x3d, 0x32, 0x56, 0xb7, 0xd1, 0x15, 0x98, 0xb5, 0xda, 0x01, 0xbd,
+	0xc6, 0xef, 0x06, 0xa6, 0x67, 0xed, 0x1b, 0x91, 0xff, 0x8c, 0xda, 0xb4, 0xb2, 0x3a, 0xa1, 0x23,
+	0x3e, 0x76, 0x97, 0x0e, 0xed, 0x90, 0x11, 0xf4, 0x9b, 0x30, 0x7b, 0x80, 0x03, 0x7a, 0x59, 0xe4,
+	0x21, 0x9b, 0xe1, 0x44, 0xb8, 0x59, 0x1d, 0x92, 0x1e, 0x58, 0x92, 0x3b, 0x21, 0x2b, 0x78, 0xca,
+	0x48, 0xde, 0x63, 0x14, 0xf5, 0x08, 0x37, 0x75, 0x74, 0x90, 0x81, 0x69, 0x7f, 0x3f, 0x06, 0x0b,
+	0x19, 0x95, 0xf2, 0x03, 0x2a, 0x57, 0x9b, 0x72, 0x52, 0xb5, 0xdd, 0x87, 0xc9, 0x98, 0x6d, 0x74,
+	0xd4, 0xc2, 0x7c, 0x23, 0x56, 0x0a, 0x39, 0xee, 0x1c, 0xb5, 0xb0, 0x3e, 0x71, 0x98, 0xf8, 0x85,
+	0x34, 0x98, 0x94, 0x69, 0x7d, 0xdc, 0x4b, 0x68, 0xfb, 0x29, 0x9c, 0x69, 0x05, 0xf8, 0xc0, 0xf1,
+	0xdb, 0xa1, 0x11, 0x92, 0xe0, 0x0b, 0xdb, 0x1d, 0x7c, 0x16, 0x93, 0x2e, 0x66, 0x6e, 0xdb, 0x75,
+	0x2f, 0xba, 0xfe, 0xe6, 0x53, 0x12, 0xc1, 0xe9, 0xf3, 0x82, 0x7a, 0x9b, 0x11, 0x0b, 0xbe, 0xaf,
+	0xc3, 0x69, 0x9a, 0x1b, 0x60, 0x97, 0xf9, 0x98, 0xe3, 0x10, 0x95, 0x60, 0x9a, 0x0c, 0xdd, 0x27,
+	0x23, 0x02, 0xfd, 0x16, 0x8c, 0xd1, 0x7b, 0xbe, 0xeb, 0x84, 0x11, 0xf5, 0x84, 0xe3, 0xeb, 0xe7,
+	0xe4, 0x71, 0x8d, 0x38, 0xf2, 0xa3, 0x11, 0xff, 0x0b, 0x3d, 0x80, 0xe9, 0x90, 0x9a, 0x83, 0xd1,
+	0x61, 0x31, 0x52, 0x86, 0x45, 0x25, 0x4c, 0x59, 0x11, 0x7a, 0x13, 0xe6, 0x2d, 0xd7, 0x21, 0x92,
+	0xba, 0xce, 0x6e, 0x60, 0x06, 0x47, 0x06, 0x3f, 0x0f, 0x34, 0x9f, 0x31, 0xa6, 0xcf, 0xb2, 0xd1,
+	0x2d, 0x36, 0xc8, 0xcf, 0x4f, 0x82, 0xaa, 0x81, 0xcd, 0xa8, 0x1d, 0xe0, 0x98, 0x6a, 0x2c, 0x49,
+	0x75, 0x9f, 0x0d, 0x0a, 0xaa, 0xf3, 0x30, 0xce, 0xa9, 0x9c, 0x66, 0xcb, 0xad, 0x02, 0x45, 0x05,
+	0x06, 0xaa, 0x37, 0x5b, 0x2e, 0x0a, 0xe1, 0x72, 0xf7, 0xaa, 0x8c, 0xd0, 0xda, 0xc7, 0x76, 0xdb,
+	0xc5, 0x46, 0xe4, 0xb3, 0xcd, 0xa2, 0xc9, 0x26, 0xbf, 0x1d, 0x55, 0xc7, 0x7b, 0xe5, 0x45, 0x2e,
+	0xa6, 0xd7, 0xba, 0xcd, 0x39, 0xed, 0xf8, 0x74, 0xdf, 0x76, 0x18, 0x1b, 0x12, 0x85, 0xb1, 0xad,
+	0x22, 0xe7, 0xbf, 0xb3, 0x90, 0x09, 0x9a, 0xef, 0x9a, 0xa1, 0x43, 0xdb, 0x91, 0xdf, 0x59, 0x45,
+	0x9e, 0xad, 0x4e, 0xe6, 0xda, 0xea, 0x16, 0xc4, 0x97, 0x16, 0xb2, 0x84, 0x08, 0x57, 0x2b, 0xf4,
+	0x56, 0x72, 0xa9, 0xd7, 0xad, 0x84, 0x59, 0xde, 0xe4, 0x61, 0xf2, 0x27, 0xb2, 0x60, 0x36, 0xe6,
+	0x66, 0xb9, 0x7e, 0x88, 0x39, 0xcf, 0x29, 0xca, 0xf3, 0x6a, 0xc9, 0x18, 0x89, 0x10, 0x12, 0x7e,
+	0xed, 0x50, 0x8f, 0xed, 0x39, 0x06, 0x12, 0x2b, 0x9f, 0x49, 0xbb, 0x17, 0x12, 0xb8, 0x4c, 0xcb,
+	0xc2, 0x80, 0x8e, 0xd4, 0x29, 0xe7, 0xe2, 0xe0, 0x50, 0x9f, 0x3e, 0xe8, 0x82, 0xa0, 0xdb, 0xb0,
+	0xe8, 0x84, 0x06, 0xdb, 0x96, 0xc4, 0x1e, 0x63, 0x8f, 0xf8, 0x19, 0xbb, 0x3a, 0x43, 0xc3, 0x81,
+	0x05, 0x27, 0x4c, 0xbb, 0xfa, 0x7b, 0x6c, 0x18, 0xad, 0xc0, 0x84, 0xf0, 0x75, 0xa1, 0xf3, 0x09,
+	0xae, 0x22, 0x66, 0xda, 0x1c, 0xb6, 0xed, 0x7c, 0x82, 0xb5, 0x5f, 0x2a, 0xb0, 0xf0, 0xd8, 0x77,
+	0xdd, 0xff, 0x5f, 0x4f, 0x03, 0xed, 0xa7, 0xa3, 0x50, 0xcd, 0x2e, 0xfb, 0x1b, 0x8f, 0xfd, 0x8d,
+	0xc7, 0xfe, 0x3a, 0x7a, 0xec, 0x3c, 0xfb, 0x98, 0xc8, 0xf5, 0xc0, 0x52, 0x77, 0x36, 0x79, 0x62,
+	0x77, 0xf6, 0xab, 0xe7, 0xd8, 0xb5, 0x7f, 0x1e, 0x80, 0x65, 0x1d, 0x5b, 0x7e, 0x60, 0x27, 0xeb,
+	0x05, 0xdc, 0x2c, 0xbe, 0x4c, 0x4f, 0x79, 0x1e, 0xc6, 0xe3, 0x83, 0x13, 0x3b, 0x01, 0x10, 0xa0,
+	0xba, 0x8d, 0x16, 0x60, 0x84, 0x9e, 0x31, 0x6e, 0xf1, 0x83, 0xfa, 0x30, 0xf9, 0x59, 0xb7, 0xd1,
+	0x39, 0x00, 0x7e, 0x8f, 0x10, 0xb6, 0x3b, 0xa6, 0x8f, 0x71, 0x48, 0xdd, 0x46, 0x3a, 0x4c, 0xb4,
+	0x7c, 0xd7, 0x35, 0x38, 0xa4, 0x3a, 0x5c, 0x70, 0x57, 0x21, 0x3e, 0xf4, 0xbe, 0x1f, 0x24, 0x55,
+	0x23, 0xee, 0x2a, 0xe3, 0x84, 0x09, 0xff, 0xa1, 0x7d, 0x31, 0x0a, 0x2b, 0x05, 0x5a, 0xe4, 0x8e,
+	0x37, 0xe3, 0x21, 0x95, 0xe3, 0x79, 0xc8, 0x42, 0xef, 0x37, 0x70, 0x7c, 0xef, 0xf7, 0x1a, 0x20,
+	0xa1, 0x5f, 0xbb, 0xdb, 0xfd, 0x4e, 0xc7, 0x23, 0x02, 0x7b, 0x95, 0x38, 0x30, 0x89, 0xeb, 0x1d,
+	0xd4, 0x2b, 0x1c, 0x2e, 0x30, 0x33, 0x1e, 0x7d, 0x28, 0xeb, 0xd1, 0x13, 0x95, 0xc5, 0xe1, 0x74,
+	0x65, 0xf1, 0x26, 0x54, 0xb9, 0x4b, 0xe9, 0xa4, 0x65, 0x44, 0x80, 0x30, 0x42, 0x03, 0x84, 0x79,
+	0x36, 0x1e, 0x9f, 0x1d, 0x11, 0x1f, 0xe8, 0x30, 0x19, 0x57, 0xd0, 0x68, 0x22, 0x87, 0x95, 0xe4,
+	0x5e, 0xcf, 0xb3, 0xc6, 0x9d, 0xc0, 0xf4, 0x42, 0xe2, 0xca, 0x52, 0xc9, 0x8b, 0x09, 0x3b, 0xf1,
+	0x0b, 0x7d, 0x0c, 0x67, 0x25, 0x69, 0xa2, 0x8e, 0x0b, 0x1f, 0x2b, 0xe3, 0xc2, 0xcf, 0x64, 0x8e,
+	0xbb, 0x18, 0xca, 0x8b, 0x3e, 0x21, 0x2f, 0xfa, 0x5c, 0x81, 0x89, 0x94, 0xcf, 0x1b, 0xa7, 0x3e,
+	0x6f, 0x7c, 0x37, 0xe1, 0xec, 0xee, 0x40, 0xa5, 0xb3, 0xad, 0xb4, 0x32, 0x3b, 0xd1, 0xb3, 0x32,
+	0x3b, 0x19, 0x53, 0x10, 0x18, 0x7a, 0x07, 0x26, 0xc4, 0x5e, 0x53, 0x06, 0x93, 0x3d, 0x19, 0x8c,
+	0x73, 0x7c, 0x4a, 0x6e, 0xc2, 0x08, 0xc9, 0x24, 0x10, 0x27, 0x5b, 0xa1, 0xe9, 0x9a, 0x07, 0xb9,
+	0x45, 0x86, 0x9e, 0x56, 0x44, 0x53, 0x14, 0x0e, 0x0e, 0x59, 0x59, 0x41, 0xf0, 0xcd, 0xc4, 0x82,
+	0x53, 0x99, 0x58, 0x10, 0x5d, 0x80, 0x49, 0x81, 0x62, 0xf9, 0x6d, 0x2f, 0xa2, 0xf1, 0xeb, 0xa0,
+	0x2e, 0xe8, 0x36, 0x08, 0x0c, 0xbd, 0x95, 0x2d, 0x41, 0x87, 0xed, 0xbd, 0x3d, 0x1c, 0x46, 0x71,
+	0x3c, 0x9a, 0xae, 0x2a, 0x6f, 0x8b, 0x51, 0xf5, 0x63, 0x98, 0x48, 0xca, 0x26, 0x29, 0x00, 0xdc,
+	0x4c, 0x16, 0x00, 0xf2, 0x52, 0x30, 0xc2, 0xf0, 0x59, 0x2a, 0x26, 0x51, 0x24, 0xe8, 0xb8, 0x6a,
+	0x91, 0x0e, 0xfc, 0xc6, 0x55, 0x67, 0x5c, 0x75, 0x52, 0x35, 0x52, 0x57, 0xfd, 0x6f, 0x83, 0xc2,
+	0x55, 0x4b, 0xb5, 0xc8, 0x5d, 0xf5, 0xfb, 0x30, 0xd5, 0xe5, 0x0a, 0x0b, 0x9d, 0x35, 0x4f, 0x96,
+	0x50, 0x67, 0xa6, 0x57, 0xd2, 0xae, 0x32, 0x63, 0x3c, 0x03, 0xfd, 0x19, 0x4f, 0xc2, 0x33, 0x0e,
+	0xa6, 0x3d, 0xe3, 0xc7, 0xb0, 0x94, 0x36, 0x6c, 0xc3, 0x6f, 0x18, 0xd1, 0xbe, 0x13, 0x1a, 0xc9,
+	0x26, 0x8d, 0xe2, 0xa9, 0xd4, 0x94, 0xa1, 0x7f, 0xd0, 0xd8, 0xd9, 0x77, 0xc2, 0x3b, 0x9c, 0x7f,
+	0x1d, 0x66, 0xf6, 0xb1, 0x19, 0x44, 0xbb, 0xd8, 0x8c, 0x0c, 0x1b, 0x47, 0xa6, 0xe3, 0x86, 0xd5,
+	0xa1, 0x12, 0x09, 0xc8, 0xe9, 0x98, 0x6c, 0x93, 0x51, 0x65, 0x1f, 0x7d, 0xc3, 0xc7, 0x7b, 0xf4,
+	0xbd, 0x0c, 0x53, 0x31, 0x1f, 0x76, 0xac, 0xe9, 0x33, 0x60, 0xac, 0x53, 0x06, 0xdc, 0xa4, 0x50,
+	0xed, 0x4f, 0x15, 0xb8, 0xc0, 0x76, 0x33, 0xe5, 0x4c, 0x78, 0x3a, 0xb8, 0x63, 0x2f, 0x7a, 0x77,
+	0xd2, 0xf2, 0x66, 0x5e, 0xd2, 0xb2, 0x17, 0xab, 0x92, 0xd9, 0xcb, 0xbf, 0x1d, 0x84, 0x8b, 0xc5,
+	0xdc, 0xf8, 0x11, 0xc4, 0x9d, 0xe7, 0x6b, 0xc0, 0x61, 0x5c, 0xc4, 0x5b, 0xc7, 0xf7, 0x9e, 0xfa,
+	0x54, 0xd8, 0x75, 0xd2, 0x7f, 0xa2, 0xc0, 0x52, 0xa7, 0x18, 0x41, 0x62, 0x74, 0xdb, 0x09, 0x5b,
+	0x66, 0x64, 0xed, 0x1b, 0xae, 0x4f, 0xd2, 0xec, 0x47, 0xd5, 0x01, 0xea, 0xb3, 0x3f, 0x2e, 0x98,
+	0xb5, 0xf7, 0x72, 0x6a, 0x9d, 0x6a, 0xc5, 0x8e, 0xbf, 0xc9, 0x67, 0xd8, 0x62, 0x13, 0x30, 0x57,
+	0xbe, 0x68, 0xe6, 0x63, 0xa8, 0xbf, 0x03, 0xcb, 0xbd, 0x18, 0x48, 0xfc, 0xed, 0x66, 0xda, 0xdf,
+	0xca, 0x6b, 0x21, 0xc2, 0x0d, 0x50, 0x5e, 0x82, 0x31, 0x7d, 0xf2, 0x27, 0x7c, 0x2f, 0x29, 0xa2,
+	0x49, 0x96, 0x49, 0xba, 0x80, 0xb0, 0xdd, 0x67, 0x11, 0xad, 0x17, 0x9f, 0x92, 0x07, 0xe9, 0x02,
+	0xac, 0x14, 0x70, 0xe2, 0xc9, 0xf0, 0x1f, 0x2b, 0xa0, 0x65, 0xbd, 0xdd, 0x7b, 0xc2, 0x3c, 0x85,
+	0xe4, 0x4f, 0xba, 0x25, 0xbf, 0x91, 0x23, 0x79, 0x2f, 0x4e, 0x25, 0x65, 0x7f, 0x0c, 0x17, 0x0a,
+	0x79, 0xf1, 0xb3, 0xf9, 0x0a, 0x4c, 0x5b, 0xa6, 0x67, 0xe1, 0xf8, 0x09, 0x80, 0xd9, 0x33, 0x6d,
+	0x54, 0x9f, 0x62, 0x70, 0x5d, 0x80, 0x93, 0xf6, 0x9e, 0xe4, 0x79, 0x42, 0x7b, 0x2f, 0x62, 0x55,
+	0x72, 0xa9, 0x2f, 0xc1, 0xc5, 0x62, 0x66, 0x89, 0x32, 0xad, 0x04, 0xf1, 0x24, 0x27, 0x2c, 0x97,
+	0x4f, 0xdf, 0x27, 0x4c, 0xc6, 0x29, 0x75, 0xc2, 0xb2, 0x0b, 0xa4, 0xfb, 0x83, 0xed, 0xbe, 0x4f,
+	0x58, 0x2f, 0x4e, 0x25, 0x65, 0xbf, 0x04, 0x17, 0x0a, 0x79, 0x71, 0xe9, 0xff, 0x4e, 0x81, 0xf3,
+	0x3a, 0x6e, 0xfa, 0x07, 0x98, 0xf5, 0x5f, 0x7c, 0x55, 0xf2, 0x84, 0xe9, 0xc0, 0x68, 0xb0, 0x2b,
+	0x30, 0xd2, 0x34, 0x58, 0xce, 0x97, 0x9a, 0x2f, 0xed, 0x1f, 0x06, 0xe0, 0x12, 0x5f, 0x02, 0x5b,
+	0x76, 0x6e, 0xf1, 0xbf, 0x70, 0x81, 0x26, 0x54, 0xd2, 0x36, 0x58, 0x1d, 0x90, 0x3d, 0x84, 0xe2,
+	0xfd, 0x2b, 0x31, 0xa1, 0x3e, 0x99, 0xb2, 0x5e, 0x52, 0x7a, 0x8f, 0xfb, 0x2b, 0xa4, 0x5d, 0xab,
+	0xf2, 0xd2, 0xfb, 0x3d, 0x4e, 0xd3, 0x55, 0x7a, 0xc7, 0x32, 0x70, 0xdf, 0xbd, 0x15, 0xab, 0xf0,
+	0x52, 0xaf, 0xb5, 0x70, 0x3d, 0xff, 0xa3, 0x02, 0x8b, 0x22, 0x31, 0x25, 0x49, 0x14, 0x7c, 0x29,
+	0xc7, 0xe7, 0x32, 0xcc, 0x38, 0xa1, 0x91, 0x6e, 0x22, 0xa5, 0xba, 0x1c, 0xd5, 0xa7, 0x9c, 0xf0,
+	0x7e, 0xb2, 0x3d, 0x54, 0x5b, 0x82, 0xb3, 0x72, 0xf1, 0xf9, 0xfa, 0x3e, 0xa3, 0x01, 0x0b, 0x71,
+	0xd6, 0xe9, 0x76, 0x81, 0x8c, 0x6b, 0xfd, 0x32, 0x16, 0xba, 0x02, 0x13, 0xbc, 0x43, 0x18, 0xdb,
+	0x89, 0x5c, 0x71, 0x0c, 0xab, 0xdb, 0xe8, 0x23, 0x10, 0xc5, 0x7e, 0x6c, 0x27, 0xa6, 0x3e, 0xd5,
+	0xd7, 0xd4, 0x28, 0x66, 0xd1, 0x99, 0x7b, 0x0b, 0xa6, 0x13, 0x1d, 0x09, 0xec, 0x92, 0x30, 0x54,
+	0xf6, 0x92, 0x30, 0xd5, 0x21, 0xa5, 0x00, 0x62, 0xf1, 0x22, 0xdc, 0x73, 0x58, 0x23, 0xc4, 0xa0,
+	0x3e, 0xc6, 0x21, 0x75, 0x5b, 0x7b, 0x19, 0x2e, 0xf5, 0xd8, 0x04, 0xbe, 0x5d, 0xff, 0x31, 0x00,
+	0x55, 0x9d, 0xf7, 0x4f, 0x60, 0xca, 0x3a, 0x7c, 0xba, 0xfe, 0x65, 0x6e, 0xd1, 0x77, 0x61, 0x4e,
+	0x56, 0x99, 0x16, 0x7d, 0x2f, 0x7d, 0x94, 0xa6, 0x4f, 0x67, 0x4b, 0xd3, 0x21, 0xba, 0x06, 0xc3,
+	0x54, 0xf5, 0x61, 0xf5, 0x54, 0x41, 0xea, 0x65, 0xd3, 0x8c, 0xcc, 0xbb, 0xae, 0xbf, 0xab, 0x73,
+	0x64, 0xb4, 0x01, 0x15, 0x72, 0xb7, 0x27, 0x3d, 0x68, 0x9c, 0x7c, 0xa8, 0x0c, 0xf9, 0x84, 0x87,
+	0x0f, 0xf5, 0x36, 0xdb, 0xb2, 0x50, 0x5b, 0x84, 0x33, 0x12, 0x55, 0xf3, 0x8d, 0xf8, 0xbe, 0x02,
+	0xf3, 0xdb, 0x47, 0x9e, 0xb5, 0xbd, 0x6f, 0x06, 0x36, 0xcf, 0xc0, 0xf2, 0x6d, 0xb8, 0x04, 0x95,
+	0xd0, 0x6f, 0x07, 0x16, 0x36, 0xf8, 0x9b, 0x12, 0x7c, 0x2f, 0x26, 0x19, 0x74, 0x83, 0x01, 0xd1,
+	0x19, 0x18, 0x25, 0xc9, 0x29, 0x5b, 0x3c, 0xdf, 0x86, 0xf4, 0x11, 0xfa, 0xbb, 0x6e, 0xa3, 0x1a,
+	0x9c, 0xa2, 0x77, 0xc9, 0xc1, 0x9e, 0x17, 0x3c, 0x8a, 0xa7, 0x9d, 0x81, 0x85, 0x8c, 0x2c, 0x5c,
+	0xce, 0x9f, 0x0f, 0xc1, 0x69, 0x32, 0x26, 0x9e, 0x93, 0x5f, 0xe6, 0x59, 0xa9, 0xc2, 0x88, 0xc8,
+	0x78, 0x31, 0x4b, 0x16, 0x3f, 0x89, 0xa1, 0x77, 0xee, 0xba, 0x71, 0x1e, 0x21, 0xce, 0x3b, 0x10,
+	0x9d, 0x64, 0xf3, 0x5c, 0x43, 0xfd, 0xe6, 0xb9, 0x8a, 0x8d, 0x30, 0x73, 0x93, 0x1f, 0xe9, 0xef,
+	0x26, 0xff, 0x3e, 0xaf, 0x2e, 0x75, 0x2e, 0xd5, 0x94, 0xcb, 0x68, 0x4f, 0x2e, 0x33, 0x84, 0x2c,
+	0x0e, 0x8f, 0x29, 0xaf, 0xeb, 0x30, 0x22, 0x6e, 0xe4, 0x63, 0x25, 0x6e, 0xe4, 0x02, 0x39, 0x99,
+	0x4d, 0x80, 0x74, 0x36, 0xe1, 0x5d, 0x98, 0x60, 0xb5, 0x2f, 0xfe, 0x3e, 0xc4, 0x78, 0x89, 0xf7,
+	0x21, 0xc6, 0x69, 0x49, 0x8c, 0xfd, 0x20, 0x65, 0x18, 0xca, 0x80, 0xbd, 0x21, 0x64, 0x38, 0x36,
+	0xf6, 0x22, 0x27, 0x3a, 0xa2, 0xd9, 0xc6, 0x31, 0x1d, 0x91, 0xb1, 0x8f, 0xe8, 0x50, 0x9d, 0x8f,
+	0xa0, 0x47, 0x30, 0xd5, 0xe5, 0x1a, 0x78, 0x66, 0xf1, 0x52, 0x29, 0xa7, 0xa0, 0x57, 0xd2, 0x0e,
+	0x41, 0x9b, 0x87, 0xd9, 0xf4, 0x49, 0xe6, 0x47, 0xfc, 0x8f, 0x15, 0x58, 0x14, 0xfd, 0x86, 0x5f,
+	0x91, 0x08, 0x4f, 0xfb, 0x43, 0x05, 0xce, 0xca, 0x65, 0xe2, 0x97, 0x9f, 0x37, 0x60, 0xbe, 0xc9,
+	0xe0, 0xac, 0xee, 0x63, 0x38, 0xa4, 0xed, 0xcd, 0xda, 0xc7, 0x5c, 0xc2, 0xd3, 0xcd, 0x04, 0x55,
+	0xdd, 0xdb, 0x20, 0x43, 0x24, 0x7d, 0x99, 0x21, 0xb2, 0xcd, 0xc8, 0xdc, 0x35, 0x43, 0xd1, 0x76,
+	0x3c, 0x9f, 0xa6, 0xdb, 0xe4, 0xa3, 0xda, 0x59, 0x50, 0x85, 0x3c, 0x5c, 0x9f, 0xef, 0xf9, 0x71,
+	0x6b, 0x96, 0xf6, 0xbb, 0x03, 0xb0, 0x28, 0x1d, 0xe6, 0xd2, 0xae, 0xc2, 0xb4, 0xd7, 0x6e, 0xee,
+	0xe2, 0x80, 0xe4, 0xa0, 0xa8, 0x97, 0x0a, 0xa9, 0x9c, 0x43, 0x7a, 0x85, 0xc1, 0x3f, 0x68, 0x50,
+	0xe7, 0x13, 0x12, 0x65, 0x0b, 0xaf, 0x16, 0xd2, 0xd4, 0xc2, 0x90, 0x3e, 0xca, 0xdd, 0x5a, 0x88,
+	0xea, 0x30, 0xc1, 0x77, 0x82, 0x2d, 0x55, 0xde, 0x5b, 0x2b, 0x8e, 0x03, 0xcb, 0xf5, 0xd0, 0x95,
+	0xd3, 0xd8, 0x6f, 0xdc, 0xee, 0x00, 0xd0, 0x75, 0x58, 0x60, 0xf3, 0x58, 0xbe, 0x17, 0x05, 0xbe,
+	0xeb, 0xe2, 0x80, 0xea, 0xa4, 0xcd, 0x9e, 0x14, 0x63, 0xfa, 0x1c, 0x1d, 0xde, 0x88, 0x47, 0x99,
+	0x5f, 0xa4, 0x16, 0x62, 0xdb, 0x01, 0x0e, 0x43, 0x9e, 0x90, 0x14, 0x3f, 0xb5, 0x1a, 0xcc, 0xb0,
+	0xca, 0x19, 0xa1, 0x13, 0x67, 0x27, 0xe9, 0xa4, 0x95, 0x94, 0x93, 0xd6, 0x66, 0x01, 0x25, 0xf1,
+	0xf9, 0x61, 0xfc, 0x2f, 0x05, 0x66, 0x58, 0xf0, 0x9e, 0x8c, 0x12, 0xf3, 0xd9, 0xa0, 0xdb, 0xbc,
+	0xca, 0x1c, 0x17, 0xd5, 0x2b, 0xeb, 0xe7, 0x73, 0x14, 0x42, 0x38, 0xd2, 0xac, 0xd9, 0x68, 0xc4,
+	0xff, 0x4a, 0xe6, 0x5e, 0x07, 0x53, 0xb9, 0xd7, 0x0d, 0x98, 0x3a, 0x70, 0x42, 0x67, 0xd7, 0x71,
+	0x9d, 0xe8, 0x88, 0x79, 0xa2, 0xde, 0xe9, 0xc2, 0x4a, 0x87, 0x84, 0x00, 0x89, 0x5b, 0xe6, 0x8f,
+	0x30, 0xc3, 0x33, 0xb9, 0xc7, 0x1d, 0xd3, 0xc7, 0x39, 0xec, 0x91, 0xd9, 0xc4, 0x44, 0x0b, 0xc9,
+	0xe5, 0x72, 0x2d, 0xfc, 0x90, 0x6a, 0x21, 0xc4, 0xd1, 0x93, 0x36, 0x6e, 0xe3, 0x12, 0x5a, 0xe8,
+	0x9e, 0x69, 0x20, 0x33, 0x53, 0x5a, 0x51, 0x83, 0x7d, 0x2a, 0x8a, 0xc9, 0xd9, 0x11, 0x88, 0xcb,
+	0xf9, 0x23, 0x05, 0x66, 0xc5, 0xb9, 0xff, 0xca, 0x88, 0xfa, 0x01, 0xcc, 0x75, 0xc9, 0xc4, 0xad,
+	0xf0, 0x3a, 0x2c, 0xb4, 0x02, 0xdf, 0xc2, 0x61, 0x48, 0xfa, 0x75, 0xe9, 0xcb, 0x93, 0xcc, 0x0f,
+	0x10, 0x63, 0x1c, 0x24, 0x67, 0xbe, 0x33, 0x4c, 0x29, 0xa9, 0x13, 0x08, 0xb5, 0xcf, 0x14, 0x38,
+	0xf7, 0x00, 0x47, 0x7a, 0xa7, 0xef, 0xf6, 0x21, 0x0e, 0x43, 0x73, 0x0f, 0xc7, 0x21, 0xcb, 0xbb,
+	0x30, 0x4c, 0x0b, 0x4c, 0x8c, 0xd1, 0xf8, 0xfa, 0xcb, 0x39, 0xd2, 0x26, 0x58, 0xd0, 0xea, 0x93,
+	0xce, 0xc9, 0x4a, 0x28, 0x85, 0xf8, 0x98, 0xa5, 0x3c, 0x29, 0xf8, 0x02, 0x9f, 0x43, 0x85, 0x69,
+	0xbd, 0xc9, 0x47, 0xb8, 0x38, 0xef, 0xe7, 0x26, 0x27, 0x8b, 0x19, 0xd6, 0xa8, 0x6d, 0x0a, 0x28,
+	0x4b, 0x44, 0x4e, 0x86, 0x49, 0x98, 0xea, 0x02, 0xca, 0x22, 0x25, 0x93, 0x8d, 0x43, 0x2c, 0xd9,
+	0xf8, 0xed, 0x74, 0xb2, 0xf1, 0x72, 0x6f, 0x05, 0xc5, 0xc2, 0x24, 0x12, 0x8d, 0x4d, 0x58, 0x7e,
+	0x80, 0xa3, 0xcd, 0xad, 0x27, 0x05, 0x7b, 0x51, 0x07, 0x60, 0x26, 0xed, 0x35, 0x7c, 0xa1, 0x80,
+	0x12, 0xd3, 0x91, 0x83, 0x44, 0xdd, 0xe4, 0x58, 0xc4, 0xff, 0x22, 0x2d, 0xcf, 0x2b, 0x05, 0xd3,
+	0x71, 0xa5, 0x6f, 0xc3, 0x4c, 0xb2, 0x5b, 0x9b, 0x50, 0x8b, 0x69, 0x5f, 0x2a, 0x37, 0xad, 0x3e,
+	0x1d, 0xa4, 0x01, 0xa1, 0xf6, 0x2f, 0x0a, 0xcc, 0xea, 0xd8, 0x6c, 0xb5, 0x5c, 0x76, 0x23, 0x8a,
+	0x57, 0x37, 0x0f, 0xc3, 0x3c, 0xb3, 0xcf, 0x9e, 0x73, 0xfc, 0x57, 0xf1, 0x2b, 0x1a, 0xf2, 0x87,
+	0xf4, 0xe0, 0x49, 0xe3, 0xd1, 0xe3, 0x5d, 0x2e, 0xb4, 0x05, 0x98, 0xeb, 0x5a, 0x1a, 0xf7, 0x26,
+	0x3f, 0x53, 0x48, 0xef, 0x72, 0x23, 0xc0, 0xe1, 0x7e, 0x5c, 0xe4, 0x20, 0xda, 0xf8, 0x0a, 0xae,
+	0x9d, 0xe4, 0x05, 0xe4, 0xa2, 0xf2, 0xb5, 0xbc, 0x05, 0x0b, 0xb4, 0x64, 0xba, 0xb9, 0xf5, 0xa4,
+	0xfb, 0x80, 0x2e, 0x01, 0x34, 0xfc, 0xc0, 0xc2, 0xf7, 0x71, 0x64, 0xed, 0xf3, 0x8c, 0x6d, 0x02,
+	0xa2, 0x99, 0x50, 0xcd, 0x92, 0xf2, 0xc3, 0x76, 0x0f, 0x46, 0xb0, 0x17, 0xd1, 0x5a, 0x31, 0x3b,
+	0x62, 0xaf, 0xe6, 0x1c, 0x31, 0x1e, 0x85, 0x6c, 0x6e, 0x3d, 0xa1, 0xbc, 0x78, 0x3d, 0x98, 0xd3,
+	0x6a, 0x3f, 0x1b, 0x80, 0x79, 0x1d, 0x9b, 0xb6, 0x44, 0xba, 0x75, 0x38, 0x15, 0x77, 0x5f, 0x54,
+	0xd6, 0x97, 0xf2, 0x62, 0x8b, 0xad, 0x27, 0xd4, 0xeb, 0x52, 0xdc, 0xa2, 0xab, 0x58, 0xf6, 0x32,
+	0x37, 0x28, 0xbb, 0xcc, 0xed, 0x40, 0xd5, 0xf1, 0x08, 0x86, 0x73, 0x80, 0x0d, 0xec, 0xc5, 0x1e,
+	0xac, 0x64, 0xc7, 0xda, 0x5c, 0x4c, 0x7c, 0xcf, 0x13, 0xae, 0xa8, 0x6e, 0x93, 0x83, 0xd1, 0x22,
+	0x4c, 0x68, 0xcd, 0x7b, 0x88, 0x0a, 0x36, 0x4a, 0x00, 0xb4, 0xe0, 0xfd, 0x12, 0x4c, 0xd1, 0xbe,
+	0x0b, 0x8a, 0xc1, 0xda, 0x03, 0x86, 0x69, 0x7b, 0x00, 0x6d, 0xc7, 0x78, 0x6c, 0xee, 0x61, 0xd6,
+	0x2d, 0xf8, 0xd7, 0x03, 0xb0, 0x90, 0xd1, 0x15, 0xdf, 0x8e, 0xe3, 0x28, 0x4b, 0xea, 0x2f, 0x06,
+	0x4e, 0xe6, 0x2f, 0xd0, 0xf7, 0x60, 0x3e, 0xc3, 0x54, 0xe4, 0x08, 0xfb, 0x75, 0x80, 0xb3, 0xdd,
+	0xdc, 0x09, 0x54, 0xa6, 0xae, 0x53, 0x32, 0x75, 0xfd, 0x3b, 0xe9, 0x29, 0x6d, 0x07, 0x7b, 0xf8,
+	0xeb, 0x7d, 0xb6, 0x34, 0x15, 0xaa, 0xd9, 0x65, 0x72, 0xe3, 0xff, 0x7c, 0x00, 0x16, 0x1e, 0xe2,
+	0xaf, 0xbd, 0x0e, 0xfe, 0x77, 0xec, 0xeb, 0x2e, 0x54, 0x1f, 0x62, 0xb9, 0x22, 0x65, 0x3c, 0x14,
+	0x19, 0x8f, 0x4f, 0x15, 0x38, 0xfb, 0xc8, 0x8f, 0x9c, 0xc6, 0x11, 0xb9, 0x6e, 0xfb, 0x07, 0x38,
+	0x78, 0x68, 0x92, 0xbb, 0x74, 0xac, 0xf5, 0xef, 0xc1, 0x7c, 0x83, 0x8f, 0x18, 0x4d, 0x3a, 0x64,
+	0xa4, 0x02, 0xb6, 0x3c, 0xfb, 0x48, 0xb3, 0xa3, 0x93, 0xe9, 0xb3, 0x8d, 0x2c, 0x30, 0xd4, 0xce,
+	0xc3, 0xb9, 0x1c, 0x09, 0xf8, 0xa1, 0x30, 0x61, 0xf1, 0x01, 0x8e, 0x36, 0x02, 0x3f, 0x0c, 0xf9,
+	0xae, 0xa4, 0x1e, 0x6e, 0xa9, 0x8b, 0x9f, 0xd2, 0x75, 0xf1, 0xbb, 0x04, 0x95, 0xc8, 0x0c, 0xf6,
+	0x70, 0x14, 0xef, 0x32, 0x7b, 0xcc, 0x4d, 0x32, 0x28, 0xe7, 0xa7, 0xfd, 0x72, 0x10, 0xce, 0xca,
+	0xe7, 0xe0, 0xfa, 0x6c, 0x42, 0x85, 0xb9, 0x86, 0xdd, 0x23, 0x76, 0x0d, 0xad, 0x2a, 0x3d, 0x3a,
+	0x8e, 0x8a, 0xd8, 0xd1, 0xe0, 0x3b, 0xbc, 0x7b, 0x44, 0x03, 0x40, 0xf6, 0x84, 0x99, 0x88, 0x12,
+	0x20, 0xf2, 0x22, 0xf5, 0x5c, 0x83, 0x16, 0xc4, 0x0c, 0xcb, 0x6c, 0x87, 0xb8, 0x33, 0x2d, 0xf3,
+	0x77, 0x0f, 0x8f, 0x37, 0x2d, 0xab, 0xb1, 0x6d, 0x10, 0x8e, 0xa9, 0xc9, 0x51, 0x23, 0x33, 0xa0,
+	0xb6, 0x60, 0x26, 0x23, 0xa5, 0x24, 0x3c, 0xbd, 0x97, 0x0e, 0x4f, 0xd7, 0x72, 0x8e, 0x43, 0xb7,
+	0x4c, 0x7c, 0xf3, 0x92, 0x31, 0xaa, 0xda, 0x82, 0x85, 0x1c, 0x01, 0x25, 0xf3, 0xbe, 0x9b, 0x9c,
+	0xb7, 0x92, 0x9b, 0xee, 0x7d, 0x80, 0xa3, 0x4e, 0x71, 0x91, 0xf2, 0x4d, 0x46, 0xc5, 0xff, 0xa9,
+	0xc0, 0x2a, 0x2f, 0xe7, 0x65, 0x94, 0x96, 0xa9, 0x43, 0x14, 0xdc, 0xcc, 0xca, 0x9d, 0x32, 0xf4,
+	0x94, 0x1d, 0xa2, 0xb8, 0xef, 0x42, 0xe4, 0xaa, 0xcb, 0x2b, 0x8d, 0xd1, 0x11, 0xbe, 0x9d, 0x5f,
+	0x21, 0xba, 0x08, 0x93, 0x0d, 0x12, 0x00, 0x3d, 0xc2, 0x2c, 0x96, 0xe2, 0xe5, 0xa7, 0x34, 0x50,
+	0x0b, 0xe0, 0x95, 0x12, 0x6b, 0x8d, 0xc3, 0xa5, 0x21, 0x11, 0x8f, 0x1f, 0x6f, 0x5b, 0x29, 0xb5,
+	0x76, 0x8d, 0xbe, 0x33, 0x27, 0x0c, 0x9b, 0x3e, 0x24, 0x4b, 0xe4, 0xc6, 0xb4, 0x08, 0x16, 0x32,
+	0x64, 0x71, 0xe0, 0x30, 0xd7, 0x29, 0xbb, 0x88, 0x44, 0x4c, 0x9b, 0xf7, 0x51, 0x0d, 0xe9, 0x9d,
+	0x9a, 0xcc, 0x36, 0xcb, 0xc2, 0x90, 0xe6, 0xbb, 0x4b, 0x50, 0x11, 0xef, 0x9a, 0xf2, 0x14, 0x12,
+	0xcb, 0x0f, 0x4d, 0x72, 0x28, 0x45, 0x0d, 0xb5, 0x3a, 0xcc, 0xeb, 0x66, 0x84, 0x5d, 0xa7, 0xe9,
+	0x44, 0x1f, 0xb6, 0xec, 0x44, 0x22, 0x6f, 0x0d, 0x4e, 0x91, 0x6c, 0x17, 0x57, 0xc6, 0x62, 0x5e,
+	0xa3, 0xe7, 0x1d, 0xef, 0x48, 0xa7, 0x88, 0xda, 0xfb, 0xb0, 0x90, 0x61, 0xc5, 0x17, 0xd0, 0x37,
+	0xaf, 0x4f, 0xa8, 0xfb, 0x4b, 0xc4, 0x1b, 0xe9, 0xa4, 0x7f, 0xf7, 0x05, 0x58, 0xc9, 0x66, 0x05,
+	0x0a, 0x53, 0x63, 0xa9, 0x8d, 0x18, 0xec, 0xda, 0x88, 0x3d, 0x38, 0x2b, 0x9f, 0x9b, 0x2f, 0xe6,
+	0x01, 0x0c, 0xc7, 0x49, 0x39, 0xc9, 0x49, 0x4e, 0x7e, 0xe5, 0x81, 0x25, 0xab, 0xba, 0x19, 0x71,
+	0x72, 0xed, 0x10, 0xe6, 0xe5, 0x18, 0x45, 0x66, 0x77, 0x17, 0x86, 0x79, 0xe6, 0x4d, 0x7a, 0x37,
+	0x4e, 0xb5, 0x12, 0x65, 0x27, 0xa6, 0xff, 0x6a, 0xff, 0x3d, 0x00, 0x33, 0x99, 0x51, 0xd2, 0x5a,
+	0x6c, 0x5a, 0xcf, 0xb0, 0x6d, 0x88, 0x1c, 0x97, 0xc2, 0xea, 0x02, 0x14, 0xb8, 0xc3, 0x12, 0x5d,
+	0x4b, 0x30, 0xde, 0x34, 0x5f, 0xc4, 0x18, 0x03, 0x2c, 0xab, 0xdf, 0x34, 0x5f, 0xf0, 0xf1, 0x33,
+	0x40, 0x33, 0x2b, 0x86, 0x6b, 0xee, 0x89, 0xaa, 0x03, 0xf9, 0xbd, 0x65, 0xee, 0x91, 0x8e, 0x68,
+	0x31, 0x64, 0x44, 0x41, 0xdb, 0xb3, 0xcc, 0x08, 0xdb, 0xdc, 0x6a, 0xa7, 0x39, 0xd2, 0x8e, 0x80,
+	0xa3, 0x6d, 0xa8, 0xfa, 0xae, 0x8d, 0xc3, 0xc8, 0x10, 0xa7, 0x98, 0x12, 0x97, 0x2c, 0x45, 0xcc,
+	0x31, 0x5a, 0xfe, 0xfe, 0x34, 0xcd, 0xfa, 0x90, 0x0c, 0xdb, 0x79, 0x18, 0x27, 0xb3, 0x87, 0xd8,
+	0xf2, 0x3d, 0x3b, 0xe4, 0x35, 0x09, 0x70, 0xcd, 0xbd, 0x6d, 0x06, 0x21, 0xe2, 0xdb, 0xee, 0x73,
+	0x16, 0xa1, 0x8c, 0x30, 0xf1, 0x6d, 0xf7, 0x39, 0x0d, 0x50, 0xbe, 0x03, 0x23, 0xd4, 0xb5, 0xe0,
+	0x80, 0x17, 0x19, 0xae, 0x96, 0x51, 0xfc, 0x7d, 0x46, 0xc2, 0xf5, 0x2f, 0x38, 0x68, 0x5f, 0x28,
+	0x50, 0xcd, 0xc3, 0x42, 0x77, 0x61, 0x8a, 0x15, 0x0f, 0x08, 0x94, 0xad, 0x58, 0xe9, 0x5d, 0x7c,
+	0xa1, 0xd5, 0x03, 0x42, 0x21, 0x56, 0x8a, 0x83, 0xc0, 0x0f, 0xb8, 0x9f, 0x60, 0xfb, 0x04, 0x14,
+	0xc4, 0xdc, 0xc3, 0x39, 0x00, 0x3a, 0x09, 0x05, 0x89, 0xa6, 0x08, 0x02, 0xb9, 0x47, 0x00, 0xb1,
+	0x0c, 0x8c, 0x49, 0xc9, 0x84, 0xe6, 0x64, 0x4c, 0x4f, 0x60, 0xeb, 0xff, 0xb4, 0x06, 0xc0, 0x2f,
+	0x96, 0x77, 0x1e, 0xd7, 0xd1, 0x1f, 0x90, 0x1a, 0x9e, 0xf4, 0x73, 0x1c, 0xe8, 0xfa, 0xf1, 0x3e,
+	0x98, 0xa4, 0xde, 0xe8, 0x9b, 0x8e, 0x9b, 0xf0, 0x0f, 0x14, 0x58, 0xc8, 0xf9, 0xf0, 0x0c, 0xba,
+	0xd1, 0xeb, 0xa3, 0x2d, 0x79, 0xd2, 0xdc, 0xec, 0x9f, 0x90, 0x8b, 0xf3, 0x53, 0x05, 0x96, 0x7b,
+	0x7d, 0xb3, 0x04, 0x7d, 0xfb, 0xa4, 0x1f, 0x93, 0x51, 0xef, 0x9c, 0x80, 0x03, 0x97, 0x94, 0x6c,
+	0xa2, 0xfc, 0x6b, 0x24, 0x05, 0x9b, 0x58, 0xf8, 0x15, 0x14, 0xf5, 0x46, 0xdf, 0x74, 0x5c, 0x96,
+	0x3f, 0x51, 0x40, 0xcd, 0xff, 0x66, 0x07, 0xca, 0xef, 0xec, 0xec, 0xf9, 0x2d, 0x13, 0xf5, 0xed,
+	0x63, 0xd1, 0x72, 0xb9, 0x7e, 0xa4, 0xc0, 0x99, 0xdc, 0x2f, 0x72, 0xa0, 0xb7, 0x72, 0x59, 0xf7,
+	0xfa, 0x20, 0x88, 0x7a, 0xeb, 0x38, 0xa4, 0x5c, 0x28, 0x0f, 0x26, 0x53, 0x1f, 0x45, 0x40, 0xaf,
+	0xe7, 0x32, 0x93, 0x7d, 0x7b, 0x41, 0xad, 0x95, 0x45, 0xe7, 0xf3, 0x7d, 0xaa, 0xc0, 0x69, 0xc9,
+	0x97, 0x05, 0xd0, 0x1b, 0xc5, 0xbb, 0x2d, 0xfd, 0x96, 0x81, 0xfa, 0x66, 0x7f, 0x44, 0x5c, 0x84,
+	0x08, 0xa6, 0xba, 0x5e, 0xb4, 0x47, 0x6b, 0x45, 0x57, 0x08, 0x49, 0x35, 0x53, 0xbd, 0x52, 0x9e,
+	0x80, 0xcf, 0x7a, 0x08, 0xd3, 0xdd, 0x6f, 0x8b, 0xa2, 0x7c, 0x2e, 0x39, 0xef, 0xd3, 0xaa, 0x57,
+	0xfb, 0xa0, 0x48, 0x1c, 0xbb, 0xdc, 0x9e, 0xe5, 0x82, 0x63, 0xd7, 0xeb, 0x8d, 0x35, 0xf5, 0x04,
+	0x2d, 0xd2, 0xe8, 0xcf, 0x15, 0x38, 0xcb, 0x7e, 0xc8, 0x5b, 0x9a, 0xd1, 0xed, 0x63, 0x76, 0x42,
+	0x33, 0xd1, 0xde, 0x39, 0x51, 0x1f, 0x35, 0x57, 0x59, 0x4e, 0xdf, 0x6f, 0xa1, 0xca, 0x8a, 0xbb,
+	0x8e, 0xd5, 0x5b, 0xc7, 0x21, 0xcd, 0xec, 0xa3, 0xe4, 0xa5, 0x8a, 0x9e, 0xfb, 0x98, 0xff, 0x3a,
+	0x8b, 0x7a, 0xeb, 0x38, 0xa4, 0xd9, 0x7d, 0x94, 0xb6, 0xde, 0xf6, 0xde, 0xc7, 0xa2, 0xf6, 0x5f,
+	0xf5, 0x9d, 0x63, 0x52, 0x67, 0xf7, 0x31, 0xdb, 0x5d, 0xdb, 0x7b, 0x1f, 0x73, 0x7b, 0x7b, 0xd5,
+	0x5b, 0xc7, 0x21, 0xe5, 0x42, 0xfd, 0x19, 0xad, 0x4f, 0xe4, 0xb6, 0xcd, 0xa2, 0xb7, 0xfb, 0x5a,
+	0x73, 0xba, 0x71, 0x57, 0xbd, 0x7d, 0x3c, 0xe2, 0x94, 0x68, 0xb9, 0x3d, 0xe3, 0x85, 0xa2, 0xf5,
+	0xea, 0x5a, 0x57, 0x6f, 0x1f, 0x8f, 0x98, 0x8b, 0xf6, 0x97, 0x0a, 0x2c, 0x71, 0x4e, 0x39, 0xcd,
+	0xa2, 0xe8, 0x5b, 0x05, 0x13, 0x94, 0xe8, 0x98, 0x55, 0xdf, 0x3d, 0x36, 0x3d, 0x97, 0xf1, 0x87,
+	0x34, 0x7a, 0x97, 0xb7, 0x0c, 0xa3, 0x9b, 0x05, 0xdc, 0x0b, 0x7b, 0xa3, 0xd5, 0xb7, 0x8e, 0x41,
+	0xc9, 0x25, 0xfa, 0x4c, 0x81, 0x59, 0x59, 0xe3, 0x29, 0xca, 0x7f, 0x72, 0x16, 0xb4, 0xd9, 0xaa,
+	0xd7, 0xfa, 0xa4, 0xe2, 0x52, 0xfc, 0x05, 0xfd, 0x6c, 0x5e, 0x41, 0x63, 0x25, 0x7a, 0xa7, 0xc7,
+	0xd9, 0x28, 0xee, 0x8a, 0x55, 0xbf, 0x75, 0x5c, 0x72, 0x2e, 0xe0, 0x27, 0x30, 0x93, 0xe9, 0x31,
+	0x44, 0xbd, 0xef, 0x71, 0xdd, 0xad, 0x9f, 0xea, 0x7a, 0x3f, 0x24, 0x9d, 0x68, 0xa4, 0xab, 0x6b,
+	0xb0, 0x20, 0x1a, 0x91, 0xf7, 0x3a, 0xaa, 0x57, 0xca, 0x13, 0xf0, 0x59, 0x9f, 0xc1, 0x44, 0xb2,
+	0x8b, 0x0b, 0xbd, 0x56, 0xc8, 0xa1, 0xab, 0x6d, 0x51, 0x7d, 0xbd, 0x24, 0x76, 0xe2, 0x14, 0xca,
+	0xda, 0xb0, 0x0a, 0x4e, 0x61, 0x41, 0x27, 0x99, 0x7a, 0xad, 0x4f, 0xaa, 0x44, 0xe4, 0x29, 0xe9,
+	0xae, 0x2a, 0x88, 0x3c, 0xf3, 0x5b, 0xb5, 0xd4, 0x37, 0xfb, 0x23, 0x8a, 0x5f, 0x37, 0x83, 0x4e,
+	0xb3, 0x12, 0xca, 0xcf, 0xd0, 0x64, 0x3a, 0xa0, 0xd4, 0x57, 0x4b, 0xe1, 0x76, 0xa6, 0xe9, 0x74,
+	0x03, 0xa1, 0xcb, 0x3d, 0xdc, 0x47, 0xd2, 0xc0, 0x5f, 0x2d, 0x85, 0x9b, 0x9c, 0x46, 0x34, 0xf3,
+	0x14, 0x4e, 0xd3, 0xd5, 0x82, 0xa4, 0xbe, 0x5a, 0x0a, 0xb7, 0x73, 0x43, 0x49, 0x35, 0xe2, 0x14,
+	0xdc, 0x50, 0x64, 0x4d, 0x44, 0x6a, 0xad, 0x2c, 0x7a, 0xe2, 0x2a, 0x2b, 0x6f, 0x68, 0x29, 0xb8,
+	0xca, 0x16, 0x36, 0xf6, 0xa8, 0x37, 0xfa, 0xa6, 0x4b, 0x04, 0x30, 0xb9, 0xbd, 0x23, 0x05, 0x01,
+	0x4c, 0xaf, 0xf6, 0x16, 0xf5, 0xd6, 0x71, 0x48, 0x3b, 0x1b, 0x92, 0xea, 0xbc, 0x28, 0xd8, 0x10,
+	0x59, 0xf3, 0x89, 0x5a, 0x2b, 0x8b, 0x9e, 0x70, 0x1f, 0xb2, 0x2e, 0x09, 0x54, 0x74, 0xfd, 0xcb,
+	0xed, 0xff, 0x50, 0xaf, 0xf5, 0x49, 0xd5, 0xb9, 0xbf, 0x75, 0xf7, 0x53, 0x14, 0xdc, 0xdf, 0x72,
+	0xba, 0x36, 0xd4, 0xab, 0x7d, 0x50, 0x74, 0x1e, 0x10, 0x5d, 0x8d, 0x03, 0x05, 0x0f, 0x08, 0x79,
+	0x3b, 0x86, 0x7a, 0xa5, 0x3c, 0x41, 0xe2, 0xba, 0xda, 0x55, 0x98, 0x2e, 0xba, 0xae, 0xca, 0x4b,
+	0xf5, 0xea, 0xd5, 0x3e, 0x28, 0x3a, 0x13, 0x3f, 0xc4, 0xa5, 0x27, 0x7e, 0x88, 0xfb, 0x9d, 0x38,
+	0xb7, 0x4a, 0xfc, 0xfb, 0x0a, 0xcc, 0x49, 0x6b, 0xaf, 0x28, 0xff, 0xc4, 0x14, 0x55, 0x8b, 0xd5,
+	0xeb, 0xfd, 0x92, 0x25, 0xce, 0xbb, 0xac, 0x72, 0x59, 0x70, 0xde, 0x0b, 0x4a, 0xc2, 0xea, 0xb5,
+	0x3e, 0xa9, 0xb8, 0x14, 0x9f, 0x2b, 0xf1, 0x9b, 0x89, 0xf9, 0x25, 0x32, 0x74, 0xa7, 0xd7, 0x7d,
+	0xa3, 0x67, 0x29, 0x51, 0xbd, 0x7b, 0x12, 0x16, 0xa9, 0x94, 0x4e, 0xb2, 0x46, 0x56, 0x9c, 0xd2,
+	0x91, 0x14, 0xe1, 0xd4, 0x2b, 0xe5, 0x09, 0x12, 0x96, 0x99, 0x2e, 0x6c, 0x15, 0x59, 0xa6, 0xb4,
+	0x9a, 0xa6, 0x5e, 0x29, 0x4f, 0x90, 0x3e, 0x1e, 0xd9, 0x3a, 0xcd, 0x9b, 0x25, 0x9f, 0x32, 0xe9,
+	0xd8, 0xf1, 0x5a, 0x9f, 0x54, 0x4c, 0x8a, 0xbb, 0xf7, 0x7e, 0xfe, 0xc5, 0x92, 0xf2, 0x8b, 0x2f,
+	0x96, 0x94, 0x7f, 0xfd, 0x62, 0x49, 0xf9, 0x8d, 0x1b, 0x7b, 0x4e, 0xb4, 0xdf, 0xde, 0xad, 0x59,
+	0x7e, 0x73, 0x2d, 0xf5, 0x1f, 0x7a, 0xd4, 0xf6, 0xb0, 0xc7, 0xfe, 0x77, 0x97, 0xc4, 0x7f, 0x2f,
+	0xf3, 0x36, 0xff, 0xf3, 0xe0, 0xea, 0xee, 0x30, 0x1d, 0x7b, 0xe3, 0x7f, 0x06, 0x00, 0x96, 0xa7,
+	0xc9, 0xc0, 0x8a, 0x66, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -5860,11 +6308,42 @@ func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PartitionConfig) > 0 {
-		for k := range m.PartitionConfig {
-			v := m.PartitionConfig[k]
-			baseI := i
-			i -= len(v)
+	if m.WorkflowIdConflictPolicy != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.WorkflowIdConflictPolicy))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.WorkflowIdHash != nil {
+		{
+			size, err := m.WorkflowIdHash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CompletionCallbacks) > 0 {
+		for iNdEx := len(m.CompletionCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PartitionConfig) > 0 {
+		for k := range m.PartitionConfig {
+			v := m.PartitionConfig[k]
+			baseI := i
+			i -= len(v)
 			copy(dAtA[i:], v)
 			i = encodeVarintService(dAtA, i, uint64(len(v)))
 			i--
@@ -6124,6 +6603,23 @@ func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []by
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WorkflowIdConflictPolicy != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.WorkflowIdConflictPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WorkflowIdHash != nil {
+		{
+			size, err := m.WorkflowIdHash.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -6444,6 +6940,30 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CompletionCallbacks) > 0 {
+		for iNdEx := len(m.CompletionCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ReplicationExcluded {
+		i--
+		if m.ReplicationExcluded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.PendingDecision != nil {
 		{
 			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
@@ -7252,6 +7772,25 @@ func (m *RecordDecisionTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ContinueAsNewSuggested {
+		i--
+		if m.ContinueAsNewSuggested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.HistoryCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistoryCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.HistorySize != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.HistorySize))
 		i--
@@ -8951,21 +9490,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA83 := make([]byte, len(m.ShardIds)*10)
-		var j82 int
+		dAtA85 := make([]byte, len(m.ShardIds)*10)
+		var j84 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA83[j82] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA85[j84] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j82++
+				j84++
 			}
-			dAtA83[j82] = uint8(num)
-			j82++
+			dAtA85[j84] = uint8(num)
+			j84++
 		}
-		i -= j82
-		copy(dAtA[i:], dAtA83[:j82])
-		i = encodeVarintService(dAtA, i, uint64(j82))
+		i -= j84
+		copy(dAtA[i:], dAtA85[:j84])
+		i = encodeVarintService(dAtA, i, uint64(j84))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10126,21 +10665,21 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x12
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA93 := make([]byte, len(m.ShardIds)*10)
-		var j92 int
+		dAtA95 := make([]byte, len(m.ShardIds)*10)
+		var j94 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA95[j94] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j92++
+				j94++
 			}
-			dAtA93[j92] = uint8(num)
-			j92++
+			dAtA95[j94] = uint8(num)
+			j94++
 		}
-		i -= j92
-		copy(dAtA[i:], dAtA93[:j92])
-		i = encodeVarintService(dAtA, i, uint64(j92))
+		i -= j94
+		copy(dAtA[i:], dAtA95[:j94])
+		i = encodeVarintService(dAtA, i, uint64(j94))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -10374,21 +10913,21 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.PendingShards) > 0 {
-		dAtA97 := make([]byte, len(m.PendingShards)*10)
-		var j96 int
+		dAtA99 := make([]byte, len(m.PendingShards)*10)
+		var j98 int
 		for _, num1 := range m.PendingShards {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA97[j96] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA99[j98] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j96++
+				j98++
 			}
-			dAtA97[j96] = uint8(num)
-			j96++
+			dAtA99[j98] = uint8(num)
+			j98++
 		}
-		i -= j96
-		copy(dAtA[i:], dAtA97[:j96])
-		i = encodeVarintService(dAtA, i, uint64(j96))
+		i -= j98
+		copy(dAtA[i:], dAtA99[:j98])
+		i = encodeVarintService(dAtA, i, uint64(j98))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10478,171 +11017,312 @@ func (m *RatelimitUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetReplicationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetReplicationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ParentExecutionInfo != nil {
-		l = m.ParentExecutionInfo.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
-	}
-	if m.ExpirationTime != nil {
-		l = m.ExpirationTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ContinueAsNewInitiator != 0 {
-		n += 1 + sovService(uint64(m.ContinueAsNewInitiator))
-	}
-	if m.ContinuedFailure != nil {
-		l = m.ContinuedFailure.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.LastCompletionResult != nil {
-		l = m.LastCompletionResult.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FirstDecisionTaskBackoff != nil {
-		l = m.FirstDecisionTaskBackoff.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.PartitionConfig) > 0 {
-		for k, v := range m.PartitionConfig {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+	if len(m.ShardIds) > 0 {
+		dAtA103 := make([]byte, len(m.ShardIds)*10)
+		var j102 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA103[j102] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j102++
+			}
+			dAtA103[j102] = uint8(num)
+			j102++
 		}
+		i -= j102
+		copy(dAtA[i:], dAtA103[:j102])
+		i = encodeVarintService(dAtA, i, uint64(j102))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetReplicationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignalWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ExternalWorkflowExecution != nil {
-		l = m.ExternalWorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ChildWorkflowOnly {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *GetReplicationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignalWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetReplicationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShardReplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if len(m.PartitionConfig) > 0 {
-		for k, v := range m.PartitionConfig {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReplicationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Fetcher != nil {
+		{
+			size, err := m.Fetcher.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DlqSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.DlqSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LagSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.LagSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OldestPendingTaskTime != nil {
+		{
+			size, err := m.OldestPendingTaskTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskLagTruncated {
+		i--
+		if m.TaskLagTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskLag != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskLag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxTaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AckedTaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.AckedTaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationFetcherStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationFetcherStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationFetcherStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.LastErrorTime != nil {
+		{
+			size, err := m.LastErrorTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintService(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ErrorCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ErrorCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastFetchTime != nil {
+		{
+			size, err := m.LastFetchTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ResetWorkflowExecutionRequest) Size() (n int) {
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10656,13 +11336,60 @@ func (m *ResetWorkflowExecutionRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.ParentExecutionInfo != nil {
+		l = m.ParentExecutionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.ExpirationTime != nil {
+		l = m.ExpirationTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ContinueAsNewInitiator != 0 {
+		n += 1 + sovService(uint64(m.ContinueAsNewInitiator))
+	}
+	if m.ContinuedFailure != nil {
+		l = m.ContinuedFailure.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.LastCompletionResult != nil {
+		l = m.LastCompletionResult.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.FirstDecisionTaskBackoff != nil {
+		l = m.FirstDecisionTaskBackoff.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.PartitionConfig) > 0 {
+		for k, v := range m.PartitionConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if len(m.CompletionCallbacks) > 0 {
+		for _, e := range m.CompletionCallbacks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.WorkflowIdHash != nil {
+		l = m.WorkflowIdHash.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowIdConflictPolicy != 0 {
+		n += 1 + sovService(uint64(m.WorkflowIdConflictPolicy))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ResetWorkflowExecutionResponse) Size() (n int) {
+func (m *StartWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10678,7 +11405,7 @@ func (m *ResetWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
-func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
+func (m *SignalWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10705,7 +11432,7 @@ func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
 	return n
 }
 
-func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
+func (m *SignalWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10717,7 +11444,7 @@ func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
-func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
+func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10731,40 +11458,35 @@ func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.PartitionConfig) > 0 {
+		for k, v := range m.PartitionConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.WorkflowIdHash != nil {
+		l = m.WorkflowIdHash.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowIdConflictPolicy != 0 {
+		n += 1 + sovService(uint64(m.WorkflowIdConflictPolicy))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
+func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ExecutionConfiguration != nil {
-		l = m.ExecutionConfiguration.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecutionInfo != nil {
-		l = m.WorkflowExecutionInfo.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if len(m.PendingActivities) > 0 {
-		for _, e := range m.PendingActivities {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
-	if len(m.PendingChildren) > 0 {
-		for _, e := range m.PendingChildren {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
-	if m.PendingDecision != nil {
-		l = m.PendingDecision.Size()
+	l = len(m.RunId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -10773,7 +11495,7 @@ func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryWorkflowRequest) Size() (n int) {
+func (m *ResetWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10793,18 +11515,14 @@ func (m *QueryWorkflowRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryWorkflowResponse) Size() (n int) {
+func (m *ResetWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.QueryResult != nil {
-		l = m.QueryResult.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.QueryRejected != nil {
-		l = m.QueryRejected.Size()
+	l = len(m.RunId)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -10813,7 +11531,7 @@ func (m *QueryWorkflowResponse) Size() (n int) {
 	return n
 }
 
-func (m *ResetStickyTaskListRequest) Size() (n int) {
+func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10827,8 +11545,152 @@ func (m *ResetStickyTaskListRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ExternalWorkflowExecution != nil {
+		l = m.ExternalWorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ChildWorkflowOnly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecutionConfiguration != nil {
+		l = m.ExecutionConfiguration.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecutionInfo != nil {
+		l = m.WorkflowExecutionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.PendingActivities) > 0 {
+		for _, e := range m.PendingActivities {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.PendingChildren) > 0 {
+		for _, e := range m.PendingChildren {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.PendingDecision != nil {
+		l = m.PendingDecision.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ReplicationExcluded {
+		n += 2
+	}
+	if len(m.CompletionCallbacks) > 0 {
+		for _, e := range m.CompletionCallbacks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueryWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueryWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryResult != nil {
+		l = m.QueryResult.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.QueryRejected != nil {
+		l = m.QueryRejected.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetStickyTaskListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
@@ -11150,6 +12012,12 @@ func (m *RecordDecisionTaskStartedResponse) Size() (n int) {
 	if m.HistorySize != 0 {
 		n += 1 + sovService(uint64(m.HistorySize))
 	}
+	if m.HistoryCount != 0 {
+		n += 2 + sovService(uint64(m.HistoryCount))
+	}
+	if m.ContinueAsNewSuggested {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12521,6 +13389,135 @@ func (m *RatelimitUpdateResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetReplicationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShardReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AckedTaskId != 0 {
+		n += 1 + sovService(uint64(m.AckedTaskId))
+	}
+	if m.MaxTaskId != 0 {
+		n += 1 + sovService(uint64(m.MaxTaskId))
+	}
+	if m.TaskLag != 0 {
+		n += 1 + sovService(uint64(m.TaskLag))
+	}
+	if m.TaskLagTruncated {
+		n += 2
+	}
+	if m.OldestPendingTaskTime != nil {
+		l = m.OldestPendingTaskTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.LagSeconds != 0 {
+		n += 1 + sovService(uint64(m.LagSeconds))
+	}
+	if m.DlqSize != 0 {
+		n += 1 + sovService(uint64(m.DlqSize))
+	}
+	if m.Fetcher != nil {
+		l = m.Fetcher.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationFetcherStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastFetchTime != nil {
+		l = m.LastFetchTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ErrorCount != 0 {
+		n += 1 + sovService(uint64(m.ErrorCount))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.LastErrorTime != nil {
+		l = m.LastErrorTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -12969,17 +13966,106 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbacks", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionCallbacks = append(m.CompletionCallbacks, &v11.CompletionCallback{})
+			if err := m.CompletionCallbacks[len(m.CompletionCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowIdHash == nil {
+				m.WorkflowIdHash = &v11.WorkflowIdHashPolicy{}
+			}
+			if err := m.WorkflowIdHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdConflictPolicy", wireType)
+			}
+			m.WorkflowIdConflictPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowIdConflictPolicy |= v11.WorkflowIdConflictPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -13524,6 +14610,61 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowIdHash == nil {
+				m.WorkflowIdHash = &v11.WorkflowIdHashPolicy{}
+			}
+			if err := m.WorkflowIdHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdConflictPolicy", wireType)
+			}
+			m.WorkflowIdConflictPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowIdConflictPolicy |= v11.WorkflowIdConflictPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -14381,6 +15522,60 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationExcluded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReplicationExcluded = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionCallbacks = append(m.CompletionCallbacks, &v11.CompletionCallbackInfo{})
+			if err := m.CompletionCallbacks[len(m.CompletionCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -14995,7 +16190,7 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.VersionHistoryItem == nil {
-				m.VersionHistoryItem = &v11.VersionHistoryItem{}
+				m.VersionHistoryItem = &v12.VersionHistoryItem{}
 			}
 			if err := m.VersionHistoryItem.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -15469,7 +16664,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowState |= v12.WorkflowState(b&0x7F) << shift
+				m.WorkflowState |= v11.WorkflowState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15523,7 +16718,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.VersionHistories == nil {
-				m.VersionHistories = &v12.VersionHistories{}
+				m.VersionHistories = &v11.VersionHistories{}
 			}
 			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -16205,7 +17400,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.VersionHistories == nil {
-				m.VersionHistories = &v12.VersionHistories{}
+				m.VersionHistories = &v11.VersionHistories{}
 			}
 			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -16225,7 +17420,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowState |= v12.WorkflowState(b&0x7F) << shift
+				m.WorkflowState |= v11.WorkflowState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16723,7 +17918,7 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.DecisionInfo == nil {
-				m.DecisionInfo = &v12.TransientDecisionInfo{}
+				m.DecisionInfo = &v11.TransientDecisionInfo{}
 			}
 			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -17038,6 +18233,45 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryCount", wireType)
+			}
+			m.HistoryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewSuggested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContinueAsNewSuggested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -19796,7 +21030,7 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v11.VersionHistoryItem{})
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v12.VersionHistoryItem{})
 			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -20550,7 +21784,7 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.VersionHistory == nil {
-				m.VersionHistory = &v11.VersionHistory{}
+				m.VersionHistory = &v12.VersionHistory{}
 			}
 			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -21068,7 +22302,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.DomainCache == nil {
-				m.DomainCache = &v11.DomainCacheInfo{}
+				m.DomainCache = &v12.DomainCacheInfo{}
 			}
 			if err := m.DomainCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -21343,7 +22577,7 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				m.TaskType |= v12.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21602,7 +22836,7 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				m.TaskType |= v12.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21774,7 +23008,7 @@ func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				m.TaskType |= v12.TaskType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21942,7 +23176,7 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &v11.ReplicationToken{})
+			m.Tokens = append(m.Tokens, &v12.ReplicationToken{})
 			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -22060,10 +23294,10 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ShardMessages == nil {
-				m.ShardMessages = make(map[int32]*v11.ReplicationMessages)
+				m.ShardMessages = make(map[int32]*v12.ReplicationMessages)
 			}
 			var mapkey int32
-			var mapvalue *v11.ReplicationMessages
+			var mapvalue *v12.ReplicationMessages
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -22123,7 +23357,7 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &v11.ReplicationMessages{}
+					mapvalue = &v12.ReplicationMessages{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -22225,7 +23459,7 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfos = append(m.TaskInfos, &v11.ReplicationTaskInfo{})
+			m.TaskInfos = append(m.TaskInfos, &v12.ReplicationTaskInfo{})
 			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -22310,7 +23544,7 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
+			m.ReplicationTasks = append(m.ReplicationTasks, &v12.ReplicationTask{})
 			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -22906,7 +24140,7 @@ func (m *CountDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, &v11.HistoryDLQCountEntry{})
+			m.Entries = append(m.Entries, &v12.HistoryDLQCountEntry{})
 			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -22976,7 +24210,7 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
+				m.Type |= v12.DLQType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -23186,7 +24420,7 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
+				m.Type |= v12.DLQType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -23220,7 +24454,7 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
+			m.ReplicationTasks = append(m.ReplicationTasks, &v12.ReplicationTask{})
 			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -23254,7 +24488,7 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasksInfo = append(m.ReplicationTasksInfo, &v11.ReplicationTaskInfo{})
+			m.ReplicationTasksInfo = append(m.ReplicationTasksInfo, &v12.ReplicationTaskInfo{})
 			if err := m.ReplicationTasksInfo[len(m.ReplicationTasksInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -23358,7 +24592,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
+				m.Type |= v12.DLQType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -23566,7 +24800,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
+				m.Type |= v12.DLQType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -23876,7 +25110,7 @@ func (m *NotifyFailoverMarkersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailoverMarkerTokens = append(m.FailoverMarkerTokens, &v11.FailoverMarkerToken{})
+			m.FailoverMarkerTokens = append(m.FailoverMarkerTokens, &v12.FailoverMarkerToken{})
 			if err := m.FailoverMarkerTokens[len(m.FailoverMarkerTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -24172,10 +25406,10 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.TasksByShard == nil {
-				m.TasksByShard = make(map[int32]*v11.CrossClusterTaskRequests)
+				m.TasksByShard = make(map[int32]*v12.CrossClusterTaskRequests)
 			}
 			var mapkey int32
-			var mapvalue *v11.CrossClusterTaskRequests
+			var mapvalue *v12.CrossClusterTaskRequests
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -24235,7 +25469,7 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &v11.CrossClusterTaskRequests{}
+					mapvalue = &v12.CrossClusterTaskRequests{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -24287,10 +25521,10 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.FailedCauseByShard == nil {
-				m.FailedCauseByShard = make(map[int32]v11.GetTaskFailedCause)
+				m.FailedCauseByShard = make(map[int32]v12.GetTaskFailedCause)
 			}
 			var mapkey int32
-			var mapvalue v11.GetTaskFailedCause
+			var mapvalue v12.GetTaskFailedCause
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -24334,7 +25568,7 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= v11.GetTaskFailedCause(b&0x7F) << shift
+						mapvalue |= v12.GetTaskFailedCause(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
@@ -24487,7 +25721,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskResponses = append(m.TaskResponses, &v11.CrossClusterTaskResponse{})
+			m.TaskResponses = append(m.TaskResponses, &v12.CrossClusterTaskResponse{})
 			if err := m.TaskResponses[len(m.TaskResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -24593,7 +25827,7 @@ func (m *RespondCrossClusterTasksCompletedResponse) Unmarshal(dAtA []byte) error
 				return io.ErrUnexpectedEOF
 			}
 			if m.Tasks == nil {
-				m.Tasks = &v11.CrossClusterTaskRequests{}
+				m.Tasks = &v12.CrossClusterTaskRequests{}
 			}
 			if err := m.Tasks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24909,7 +26143,7 @@ func (m *RatelimitUpdateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Data == nil {
-				m.Data = &v12.Any{}
+				m.Data = &v11.Any{}
 			}
 			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24996,7 +26230,7 @@ func (m *RatelimitUpdateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Data == nil {
-				m.Data = &v12.Any{}
+				m.Data = &v11.Any{}
 			}
 			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -25024,6 +26258,800 @@ func (m *RatelimitUpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, &ShardReplicationStatus{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardReplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &ReplicationStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedTaskId", wireType)
+			}
+			m.AckedTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckedTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskId", wireType)
+			}
+			m.MaxTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskLag", wireType)
+			}
+			m.TaskLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskLagTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TaskLagTruncated = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestPendingTaskTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestPendingTaskTime == nil {
+				m.OldestPendingTaskTime = &types.Timestamp{}
+			}
+			if err := m.OldestPendingTaskTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LagSeconds", wireType)
+			}
+			m.LagSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LagSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DlqSize", wireType)
+			}
+			m.DlqSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DlqSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetcher", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fetcher == nil {
+				m.Fetcher = &ReplicationFetcherStatus{}
+			}
+			if err := m.Fetcher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationFetcherStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationFetcherStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationFetcherStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFetchTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFetchTime == nil {
+				m.LastFetchTime = &types.Timestamp{}
+			}
+			if err := m.LastFetchTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
+			}
+			m.ErrorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastErrorTime == nil {
+				m.LastErrorTime = &types.Timestamp{}
+			}
+			if err := m.LastErrorTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
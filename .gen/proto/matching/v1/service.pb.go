@@ -312,6 +312,9 @@ type PollForDecisionTaskResponse struct {
 	PartitionConfig           *TaskListPartitionConfig     `protobuf:"bytes,19,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 	LoadBalancerHints         *LoadBalancerHints           `protobuf:"bytes,20,opt,name=load_balancer_hints,json=loadBalancerHints,proto3" json:"load_balancer_hints,omitempty"`
 	AutoConfigHint            *v1.AutoConfigHint           `protobuf:"bytes,21,opt,name=auto_config_hint,json=autoConfigHint,proto3" json:"auto_config_hint,omitempty"`
+	HistoryCount              int64                        `protobuf:"varint,22,opt,name=history_count,json=historyCount,proto3" json:"history_count,omitempty"`
+	ContinueAsNewSuggested    bool                         `protobuf:"varint,23,opt,name=continue_as_new_suggested,json=continueAsNewSuggested,proto3" json:"continue_as_new_suggested,omitempty"`
+	RecommendedPollerCount    int32                        `protobuf:"varint,24,opt,name=recommended_poller_count,json=recommendedPollerCount,proto3" json:"recommended_poller_count,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}                     `json:"-"`
 	XXX_unrecognized          []byte                       `json:"-"`
 	XXX_sizecache             int32                        `json:"-"`
@@ -497,6 +500,27 @@ func (m *PollForDecisionTaskResponse) GetAutoConfigHint() *v1.AutoConfigHint {
 	return nil
 }
 
+func (m *PollForDecisionTaskResponse) GetHistoryCount() int64 {
+	if m != nil {
+		return m.HistoryCount
+	}
+	return 0
+}
+
+func (m *PollForDecisionTaskResponse) GetContinueAsNewSuggested() bool {
+	if m != nil {
+		return m.ContinueAsNewSuggested
+	}
+	return false
+}
+
+func (m *PollForDecisionTaskResponse) GetRecommendedPollerCount() int32 {
+	if m != nil {
+		return m.RecommendedPollerCount
+	}
+	return 0
+}
+
 type PollForActivityTaskRequest struct {
 	Request              *v1.PollForActivityTaskRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -596,6 +620,7 @@ type PollForActivityTaskResponse struct {
 	LoadBalancerHints          *LoadBalancerHints       `protobuf:"bytes,17,opt,name=load_balancer_hints,json=loadBalancerHints,proto3" json:"load_balancer_hints,omitempty"`
 	PartitionConfig            *TaskListPartitionConfig `protobuf:"bytes,19,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 	AutoConfigHint             *v1.AutoConfigHint       `protobuf:"bytes,20,opt,name=auto_config_hint,json=autoConfigHint,proto3" json:"auto_config_hint,omitempty"`
+	RecommendedPollerCount     int32                    `protobuf:"varint,21,opt,name=recommended_poller_count,json=recommendedPollerCount,proto3" json:"recommended_poller_count,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                 `json:"-"`
 	XXX_unrecognized           []byte                   `json:"-"`
 	XXX_sizecache              int32                    `json:"-"`
@@ -767,6 +792,13 @@ func (m *PollForActivityTaskResponse) GetAutoConfigHint() *v1.AutoConfigHint {
 	return nil
 }
 
+func (m *PollForActivityTaskResponse) GetRecommendedPollerCount() int32 {
+	if m != nil {
+		return m.RecommendedPollerCount
+	}
+	return 0
+}
+
 type AddDecisionTaskRequest struct {
 	DomainId               string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	WorkflowExecution      *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -1588,13 +1620,14 @@ func (m *DescribeTaskListRequest) GetDomainId() string {
 }
 
 type DescribeTaskListResponse struct {
-	Pollers              []*v1.PollerInfo            `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
-	TaskListStatus       *v1.TaskListStatus          `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
-	PartitionConfig      *v1.TaskListPartitionConfig `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
-	TaskList             *v1.TaskList                `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Pollers                []*v1.PollerInfo            `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	TaskListStatus         *v1.TaskListStatus          `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
+	PartitionConfig        *v1.TaskListPartitionConfig `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	TaskList               *v1.TaskList                `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	RecommendedPollerCount int32                       `protobuf:"varint,5,opt,name=recommended_poller_count,json=recommendedPollerCount,proto3" json:"recommended_poller_count,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                    `json:"-"`
+	XXX_unrecognized       []byte                      `json:"-"`
+	XXX_sizecache          int32                       `json:"-"`
 }
 
 func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListResponse{} }
@@ -1658,6 +1691,13 @@ func (m *DescribeTaskListResponse) GetTaskList() *v1.TaskList {
 	return nil
 }
 
+func (m *DescribeTaskListResponse) GetRecommendedPollerCount() int32 {
+	if m != nil {
+		return m.RecommendedPollerCount
+	}
+	return 0
+}
+
 type ListTaskListPartitionsRequest struct {
 	Domain               string       `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	TaskList             *v1.TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
@@ -2092,6 +2132,259 @@ func (m *RefreshTaskListPartitionConfigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshTaskListPartitionConfigResponse proto.InternalMessageInfo
 
+type MoveTaskListBacklogRequest struct {
+	DomainId             string          `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	DestinationTaskList  *v1.TaskList    `protobuf:"bytes,4,opt,name=destination_task_list,json=destinationTaskList,proto3" json:"destination_task_list,omitempty"`
+	MinTaskId            int64           `protobuf:"varint,5,opt,name=min_task_id,json=minTaskId,proto3" json:"min_task_id,omitempty"`
+	MaxTaskId            int64           `protobuf:"varint,6,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MoveTaskListBacklogRequest) Reset()         { *m = MoveTaskListBacklogRequest{} }
+func (m *MoveTaskListBacklogRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveTaskListBacklogRequest) ProtoMessage()    {}
+func (*MoveTaskListBacklogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{28}
+}
+func (m *MoveTaskListBacklogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveTaskListBacklogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveTaskListBacklogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MoveTaskListBacklogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTaskListBacklogRequest.Merge(m, src)
+}
+func (m *MoveTaskListBacklogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveTaskListBacklogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTaskListBacklogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTaskListBacklogRequest proto.InternalMessageInfo
+
+func (m *MoveTaskListBacklogRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *MoveTaskListBacklogRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *MoveTaskListBacklogRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+func (m *MoveTaskListBacklogRequest) GetDestinationTaskList() *v1.TaskList {
+	if m != nil {
+		return m.DestinationTaskList
+	}
+	return nil
+}
+
+func (m *MoveTaskListBacklogRequest) GetMinTaskId() int64 {
+	if m != nil {
+		return m.MinTaskId
+	}
+	return 0
+}
+
+func (m *MoveTaskListBacklogRequest) GetMaxTaskId() int64 {
+	if m != nil {
+		return m.MaxTaskId
+	}
+	return 0
+}
+
+type MoveTaskListBacklogResponse struct {
+	MovedTasks           int64    `protobuf:"varint,1,opt,name=moved_tasks,json=movedTasks,proto3" json:"moved_tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveTaskListBacklogResponse) Reset()         { *m = MoveTaskListBacklogResponse{} }
+func (m *MoveTaskListBacklogResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveTaskListBacklogResponse) ProtoMessage()    {}
+func (*MoveTaskListBacklogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{29}
+}
+func (m *MoveTaskListBacklogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveTaskListBacklogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveTaskListBacklogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MoveTaskListBacklogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTaskListBacklogResponse.Merge(m, src)
+}
+func (m *MoveTaskListBacklogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveTaskListBacklogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTaskListBacklogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTaskListBacklogResponse proto.InternalMessageInfo
+
+func (m *MoveTaskListBacklogResponse) GetMovedTasks() int64 {
+	if m != nil {
+		return m.MovedTasks
+	}
+	return 0
+}
+
+type DeleteTaskListBacklogTasksRequest struct {
+	DomainId             string          `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	TaskIds              []int64         `protobuf:"varint,4,rep,packed,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) Reset()         { *m = DeleteTaskListBacklogTasksRequest{} }
+func (m *DeleteTaskListBacklogTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTaskListBacklogTasksRequest) ProtoMessage()    {}
+func (*DeleteTaskListBacklogTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{30}
+}
+func (m *DeleteTaskListBacklogTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTaskListBacklogTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTaskListBacklogTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteTaskListBacklogTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTaskListBacklogTasksRequest.Merge(m, src)
+}
+func (m *DeleteTaskListBacklogTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTaskListBacklogTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTaskListBacklogTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTaskListBacklogTasksRequest proto.InternalMessageInfo
+
+func (m *DeleteTaskListBacklogTasksRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) GetTaskIds() []int64 {
+	if m != nil {
+		return m.TaskIds
+	}
+	return nil
+}
+
+type DeleteTaskListBacklogTasksResponse struct {
+	// deleted_task_ids excludes the tasks which were already dispatched to a poller or completed.
+	DeletedTaskIds       []int64  `protobuf:"varint,1,rep,packed,name=deleted_task_ids,json=deletedTaskIds,proto3" json:"deleted_task_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTaskListBacklogTasksResponse) Reset()         { *m = DeleteTaskListBacklogTasksResponse{} }
+func (m *DeleteTaskListBacklogTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTaskListBacklogTasksResponse) ProtoMessage()    {}
+func (*DeleteTaskListBacklogTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{31}
+}
+func (m *DeleteTaskListBacklogTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTaskListBacklogTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTaskListBacklogTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteTaskListBacklogTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTaskListBacklogTasksResponse.Merge(m, src)
+}
+func (m *DeleteTaskListBacklogTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTaskListBacklogTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTaskListBacklogTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTaskListBacklogTasksResponse proto.InternalMessageInfo
+
+func (m *DeleteTaskListBacklogTasksResponse) GetDeletedTaskIds() []int64 {
+	if m != nil {
+		return m.DeletedTaskIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TaskListPartition)(nil), "uber.cadence.matching.v1.TaskListPartition")
 	proto.RegisterType((*TaskListPartitionConfig)(nil), "uber.cadence.matching.v1.TaskListPartitionConfig")
@@ -2128,6 +2421,10 @@ func init() {
 	proto.RegisterType((*UpdateTaskListPartitionConfigResponse)(nil), "uber.cadence.matching.v1.UpdateTaskListPartitionConfigResponse")
 	proto.RegisterType((*RefreshTaskListPartitionConfigRequest)(nil), "uber.cadence.matching.v1.RefreshTaskListPartitionConfigRequest")
 	proto.RegisterType((*RefreshTaskListPartitionConfigResponse)(nil), "uber.cadence.matching.v1.RefreshTaskListPartitionConfigResponse")
+	proto.RegisterType((*MoveTaskListBacklogRequest)(nil), "uber.cadence.matching.v1.MoveTaskListBacklogRequest")
+	proto.RegisterType((*MoveTaskListBacklogResponse)(nil), "uber.cadence.matching.v1.MoveTaskListBacklogResponse")
+	proto.RegisterType((*DeleteTaskListBacklogTasksRequest)(nil), "uber.cadence.matching.v1.DeleteTaskListBacklogTasksRequest")
+	proto.RegisterType((*DeleteTaskListBacklogTasksResponse)(nil), "uber.cadence.matching.v1.DeleteTaskListBacklogTasksResponse")
 }
 
 func init() {
@@ -2135,165 +2432,182 @@ func init() {
 }
 
 var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 2528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
-	0x19, 0x57, 0x8f, 0x3d, 0x7e, 0x7c, 0x63, 0x8f, 0xed, 0xb2, 0xd7, 0xe9, 0x4c, 0x62, 0xc7, 0x99,
-	0x6c, 0xb2, 0x5e, 0x58, 0xc6, 0x6b, 0xef, 0x26, 0x64, 0xb3, 0x62, 0x83, 0x1f, 0x71, 0x32, 0x68,
-	0x43, 0xb2, 0x1d, 0x6f, 0x22, 0xc1, 0x2a, 0x4d, 0x79, 0xba, 0xec, 0x69, 0xdc, 0xd3, 0xdd, 0xe9,
-	0xae, 0xb6, 0x77, 0xf6, 0xc0, 0x01, 0x01, 0x42, 0xe2, 0x0a, 0x77, 0x5e, 0xff, 0x04, 0x17, 0xce,
-	0x1c, 0x39, 0x22, 0xad, 0x90, 0x20, 0x12, 0x7f, 0x00, 0x48, 0xdc, 0x38, 0xa0, 0x7a, 0xf4, 0x4c,
-	0xf7, 0x4c, 0xf5, 0x3c, 0x6c, 0x27, 0xcb, 0x81, 0x9b, 0xbb, 0xea, 0x7b, 0xd5, 0xf7, 0xfa, 0x7d,
-	0x55, 0x63, 0xb8, 0x11, 0xed, 0x93, 0x60, 0xad, 0x86, 0x2d, 0xe2, 0xd6, 0xc8, 0x5a, 0x03, 0xd3,
-	0x5a, 0xdd, 0x76, 0x0f, 0xd7, 0x8e, 0xd7, 0xd7, 0x42, 0x12, 0x1c, 0xdb, 0x35, 0x52, 0xf1, 0x03,
-	0x8f, 0x7a, 0x48, 0x67, 0x74, 0x15, 0x49, 0x57, 0x89, 0xe9, 0x2a, 0xc7, 0xeb, 0xa5, 0xe5, 0x43,
-	0xcf, 0x3b, 0x74, 0xc8, 0x1a, 0xa7, 0xdb, 0x8f, 0x0e, 0xd6, 0xac, 0x28, 0xc0, 0xd4, 0xf6, 0x5c,
-	0xc1, 0x59, 0xba, 0xd2, 0xb9, 0x4f, 0xed, 0x06, 0x09, 0x29, 0x6e, 0xf8, 0x92, 0xa0, 0x4b, 0xc0,
-	0x49, 0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x49, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0x57, 0xf3,
-	0x1a, 0x8d, 0xb6, 0x0a, 0x15, 0xc5, 0x8b, 0x88, 0x04, 0x4d, 0x49, 0x50, 0x56, 0x11, 0x50, 0x1c,
-	0x1e, 0x39, 0x76, 0x48, 0x25, 0xcd, 0xaa, 0x8a, 0x46, 0x3a, 0xc1, 0x3c, 0xf1, 0x82, 0x23, 0x12,
-	0x48, 0xca, 0xaf, 0xf5, 0xa3, 0x3c, 0x70, 0xbc, 0x13, 0x49, 0x7b, 0x55, 0x45, 0x5b, 0xb7, 0x43,
-	0xea, 0xb5, 0x8c, 0x7b, 0x33, 0x45, 0x12, 0xd6, 0x71, 0x40, 0xac, 0x6e, 0xaa, 0xeb, 0x19, 0x54,
-	0xe9, 0x53, 0x94, 0x3f, 0x82, 0xb9, 0x3d, 0x1c, 0x1e, 0x7d, 0x6c, 0x87, 0xf4, 0x31, 0x0e, 0xa8,
-	0xcd, 0x02, 0x81, 0xde, 0x86, 0x59, 0x3b, 0xf4, 0x1c, 0x1e, 0x15, 0xf3, 0x30, 0xf0, 0x22, 0x3f,
-	0xd4, 0xb5, 0x95, 0x91, 0xd5, 0x49, 0x63, 0xa6, 0xb5, 0x7e, 0x9f, 0x2f, 0x97, 0xff, 0x3e, 0x0a,
-	0x17, 0xba, 0x04, 0x6c, 0x7b, 0xee, 0x81, 0x7d, 0x88, 0x74, 0x18, 0x3f, 0x26, 0x41, 0x68, 0x7b,
-	0xae, 0xae, 0xad, 0x68, 0xab, 0x23, 0x46, 0xfc, 0x89, 0x36, 0x60, 0xde, 0x8d, 0x1a, 0x66, 0x40,
-	0xb0, 0x65, 0xfa, 0x31, 0x57, 0xa8, 0xe7, 0x56, 0xb4, 0xd5, 0xfc, 0x56, 0x4e, 0xd7, 0x8c, 0x39,
-	0x37, 0x6a, 0x18, 0x04, 0x5b, 0x2d, 0x91, 0x21, 0x7a, 0x1f, 0x16, 0x18, 0xcf, 0x49, 0x60, 0x53,
-	0x92, 0x64, 0x1a, 0x69, 0x31, 0x21, 0x37, 0x6a, 0x3c, 0x63, 0xdb, 0x09, 0x2e, 0x17, 0x66, 0x3a,
-	0xb5, 0x8c, 0xae, 0x8c, 0xac, 0x16, 0x36, 0xee, 0x55, 0xb2, 0x32, 0xb4, 0x92, 0x71, 0x9e, 0x4a,
-	0xda, 0xa0, 0x7b, 0x2e, 0x0d, 0x9a, 0x46, 0x31, 0x48, 0x5b, 0xf9, 0x02, 0x66, 0xbb, 0x2c, 0xcc,
-	0x73, 0x85, 0xbb, 0xc3, 0x2b, 0xec, 0x38, 0x8c, 0xd0, 0x38, 0x73, 0x92, 0x5e, 0x2d, 0xb9, 0x30,
-	0xaf, 0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x11, 0x69, 0x72, 0xcf, 0xe7, 0x0d, 0xf6, 0x27, 0xda, 0x84,
-	0xfc, 0x31, 0x76, 0x22, 0xc2, 0xfd, 0x5c, 0xd8, 0xf8, 0xfa, 0x10, 0x06, 0x19, 0x82, 0xf3, 0x4e,
-	0xee, 0xb6, 0x56, 0xf2, 0x60, 0x41, 0x65, 0xd8, 0x2b, 0x53, 0x58, 0xfe, 0x01, 0xcc, 0x7d, 0xec,
-	0x61, 0x6b, 0x0b, 0x3b, 0xd8, 0xad, 0x91, 0xe0, 0x81, 0xed, 0xd2, 0x10, 0x5d, 0x83, 0xe9, 0x7d,
-	0x5c, 0x3b, 0x72, 0xbc, 0x43, 0xb3, 0xe6, 0x45, 0x2e, 0x95, 0x29, 0x36, 0x25, 0x17, 0xb7, 0xd9,
-	0x1a, 0xba, 0x01, 0x33, 0x01, 0x66, 0xc1, 0x20, 0x81, 0x19, 0x92, 0x9a, 0xe7, 0x5a, 0xdc, 0x14,
-	0xcd, 0x98, 0x66, 0xcb, 0x8f, 0x49, 0xf0, 0x84, 0x2f, 0x96, 0xff, 0xa9, 0x41, 0xe9, 0xb1, 0xe7,
-	0x38, 0xbb, 0x5e, 0xb0, 0x43, 0x6a, 0x36, 0xcb, 0x51, 0x66, 0x91, 0x41, 0x5e, 0x44, 0x24, 0xa4,
-	0xa8, 0x0a, 0xe3, 0x81, 0xf8, 0x93, 0x6b, 0x29, 0x6c, 0xac, 0xa5, 0x4f, 0x82, 0x7d, 0x9b, 0x1d,
-	0x22, 0x5b, 0x82, 0x11, 0xf3, 0xa3, 0x4b, 0x30, 0x69, 0x79, 0x0d, 0x6c, 0xbb, 0xa6, 0x2d, 0x6c,
-	0x99, 0x34, 0x26, 0xc4, 0x42, 0xd5, 0x62, 0x9b, 0xbe, 0xe7, 0x38, 0x24, 0x60, 0x9b, 0x23, 0x62,
-	0x53, 0x2c, 0x54, 0x2d, 0x74, 0x1d, 0x8a, 0x07, 0x5e, 0x70, 0x82, 0x03, 0x8b, 0x58, 0xe6, 0x41,
-	0xe0, 0x35, 0xf4, 0x51, 0x4e, 0x31, 0xdd, 0x5a, 0xdd, 0x0d, 0xbc, 0x06, 0x7a, 0x0b, 0x66, 0x3a,
-	0x6a, 0x57, 0xcf, 0x73, 0xba, 0x62, 0xba, 0x74, 0xcb, 0x7f, 0x2c, 0xc0, 0x25, 0xa5, 0xc5, 0xa1,
-	0xef, 0xb9, 0x21, 0x41, 0x4b, 0x00, 0xac, 0x57, 0x98, 0xd4, 0x3b, 0x22, 0xa2, 0x80, 0xa7, 0x8c,
-	0x49, 0xb6, 0xb2, 0xc7, 0x16, 0xd0, 0xa7, 0x80, 0xe2, 0xd6, 0x65, 0x92, 0xcf, 0x49, 0x2d, 0x62,
-	0x92, 0x65, 0xa0, 0x6f, 0x28, 0xdd, 0xf3, 0x4c, 0x92, 0xdf, 0x8b, 0xa9, 0x8d, 0xb9, 0x93, 0xce,
-	0x25, 0xb4, 0x0b, 0xd3, 0x2d, 0xb1, 0xb4, 0xe9, 0x13, 0xee, 0x86, 0xc2, 0xc6, 0xd5, 0x9e, 0x12,
-	0xf7, 0x9a, 0x3e, 0x31, 0xa6, 0x4e, 0x12, 0x5f, 0xe8, 0x29, 0x5c, 0xf4, 0x03, 0x72, 0x6c, 0x7b,
-	0x51, 0x68, 0x86, 0x14, 0x07, 0x94, 0x58, 0x26, 0x39, 0x26, 0x2e, 0x65, 0xae, 0x1d, 0xe5, 0x32,
-	0x2f, 0x55, 0x04, 0x90, 0x54, 0x62, 0x20, 0xa9, 0x54, 0x5d, 0x7a, 0xeb, 0xfd, 0xa7, 0x2c, 0xef,
-	0x8c, 0xc5, 0x98, 0xfb, 0x89, 0x60, 0xbe, 0xc7, 0x78, 0xab, 0x16, 0x5a, 0x85, 0xd9, 0x2e, 0x71,
-	0x79, 0x9e, 0x79, 0xc5, 0x30, 0x4d, 0xa9, 0xc3, 0x38, 0xa6, 0x94, 0x34, 0x7c, 0xaa, 0x8f, 0xf1,
-	0x92, 0x88, 0x3f, 0x51, 0x19, 0xa6, 0x5d, 0xf2, 0x39, 0x6d, 0x0b, 0x18, 0xe7, 0x02, 0x0a, 0x6c,
-	0x31, 0xe6, 0x7e, 0x07, 0x50, 0x2a, 0xbd, 0xcd, 0xba, 0xed, 0x52, 0x7d, 0x82, 0x13, 0xce, 0x26,
-	0x73, 0x9c, 0x55, 0x03, 0xba, 0x0d, 0x7a, 0x48, 0xed, 0xda, 0x51, 0xb3, 0x1d, 0x0a, 0x93, 0xb8,
-	0x78, 0xdf, 0x21, 0x96, 0x3e, 0xb9, 0xa2, 0xad, 0x4e, 0x18, 0x8b, 0x62, 0xbf, 0xe5, 0xe8, 0x7b,
-	0x62, 0x17, 0xdd, 0x86, 0x3c, 0x07, 0x3e, 0x1d, 0xb8, 0x4f, 0xca, 0x3d, 0xfd, 0xfc, 0x09, 0xa3,
-	0x34, 0x04, 0x03, 0x32, 0x60, 0xda, 0x92, 0x79, 0x63, 0xda, 0xee, 0x81, 0xa7, 0x17, 0xb8, 0x84,
-	0x6f, 0xa4, 0x25, 0x08, 0xe0, 0xe1, 0x25, 0x1e, 0x60, 0x37, 0xb4, 0x89, 0x4b, 0xe3, 0x6c, 0xab,
-	0xba, 0x07, 0x9e, 0x31, 0x65, 0x25, 0xbe, 0xd0, 0x73, 0xb8, 0xdc, 0x9d, 0x54, 0x26, 0x4f, 0x43,
-	0x86, 0x59, 0xfa, 0x14, 0x57, 0xb1, 0xa4, 0x34, 0x32, 0x6e, 0x21, 0xc6, 0xc5, 0xae, 0xac, 0x8a,
-	0xb7, 0x50, 0x05, 0xe6, 0x85, 0xd3, 0x19, 0x52, 0x12, 0x33, 0x46, 0xa7, 0x69, 0x1e, 0x9f, 0x39,
-	0xbe, 0xf5, 0x84, 0xed, 0x3c, 0x95, 0x38, 0x75, 0x15, 0xa6, 0xf6, 0x03, 0xec, 0xd6, 0xea, 0xb2,
-	0x0a, 0x8a, 0xbc, 0x0a, 0x0a, 0x62, 0x4d, 0xd4, 0xc1, 0x26, 0x14, 0xc3, 0x5a, 0x9d, 0x58, 0x91,
-	0x43, 0x2c, 0x93, 0x8d, 0x2a, 0xfa, 0x0c, 0x37, 0xb2, 0xd4, 0x95, 0x5d, 0x7b, 0xf1, 0x1c, 0x63,
-	0x4c, 0xb7, 0x38, 0xd8, 0x1a, 0xfa, 0x16, 0x4c, 0xc5, 0x39, 0xc5, 0x05, 0xcc, 0xf6, 0x15, 0x50,
-	0x90, 0xf4, 0x9c, 0xfd, 0x33, 0x18, 0x67, 0x11, 0xb1, 0x49, 0xa8, 0xcf, 0x71, 0xa4, 0xd9, 0xca,
-	0xee, 0xb3, 0x3d, 0x0a, 0xbe, 0xf2, 0x89, 0x10, 0x22, 0x50, 0x26, 0x16, 0xc9, 0x5c, 0x46, 0x3d,
-	0x8a, 0x1d, 0x53, 0x8e, 0x17, 0xe6, 0x7e, 0x93, 0x92, 0x50, 0x47, 0x3c, 0x13, 0xe7, 0xf8, 0xd6,
-	0x03, 0xb1, 0xb3, 0xc5, 0x36, 0xd0, 0x67, 0x30, 0xdb, 0x82, 0x3e, 0xb3, 0xc6, 0x71, 0x4c, 0x9f,
-	0xe7, 0x07, 0x5a, 0x1f, 0x1a, 0x00, 0x8d, 0x19, 0xbf, 0x63, 0xa4, 0xf8, 0x3e, 0xcc, 0x3b, 0x1e,
-	0xb6, 0xcc, 0x7d, 0x89, 0x05, 0xbc, 0x2c, 0x42, 0x7d, 0xa1, 0x1f, 0xbe, 0x74, 0xe1, 0x87, 0x31,
-	0xe7, 0x74, 0x41, 0xca, 0x43, 0x98, 0xc5, 0x11, 0xf5, 0xa4, 0xd5, 0xa2, 0xe2, 0xde, 0xe0, 0x92,
-	0xaf, 0x29, 0x33, 0x6e, 0x33, 0xa2, 0x9e, 0xb0, 0x8b, 0xf1, 0x1b, 0x45, 0x9c, 0xfa, 0x2e, 0x3d,
-	0x87, 0xa9, 0xa4, 0x4b, 0x93, 0xf8, 0x38, 0x29, 0xf0, 0xf1, 0x76, 0x1a, 0x1f, 0x07, 0x2a, 0xbe,
-	0x36, 0x2c, 0x26, 0x40, 0x6b, 0xb3, 0x46, 0xed, 0x63, 0x9b, 0x36, 0x4f, 0x0f, 0x5a, 0x0a, 0x09,
-	0xff, 0x8b, 0xa0, 0xf5, 0x2b, 0x68, 0x81, 0x56, 0xda, 0xe2, 0xaf, 0x14, 0xb4, 0xae, 0x40, 0x01,
-	0x4b, 0x6b, 0xda, 0x4e, 0x80, 0x78, 0xa9, 0x6a, 0x31, 0x54, 0x6b, 0x11, 0x70, 0x54, 0x1b, 0xed,
-	0x81, 0x6a, 0xad, 0x83, 0x71, 0x54, 0xc3, 0x89, 0x2f, 0xb4, 0x01, 0x79, 0xdb, 0xf5, 0x23, 0xca,
-	0xbd, 0x53, 0xd8, 0xb8, 0xac, 0x8e, 0x28, 0x6e, 0xb2, 0xdc, 0x36, 0x04, 0xa9, 0xa2, 0x41, 0x8d,
-	0x9d, 0xb5, 0x41, 0x8d, 0x0f, 0xd7, 0xa0, 0xf6, 0xe0, 0x62, 0x2c, 0xcf, 0x64, 0xe5, 0xe5, 0x78,
-	0x21, 0xe1, 0x82, 0xbc, 0x48, 0x40, 0x5a, 0x61, 0xe3, 0x62, 0x97, 0xac, 0x1d, 0x79, 0x2b, 0x34,
-	0x16, 0x63, 0xde, 0x3d, 0x6f, 0x9b, 0x71, 0xee, 0x09, 0x46, 0xf4, 0x5d, 0x58, 0xe4, 0x4a, 0xba,
-	0x45, 0x4e, 0xf6, 0x13, 0x39, 0xcf, 0x19, 0x3b, 0xe4, 0xed, 0xc2, 0x5c, 0x9d, 0xe0, 0x80, 0xee,
-	0x13, 0x4c, 0x5b, 0xa2, 0xa0, 0x9f, 0xa8, 0xd9, 0x16, 0x4f, 0x2c, 0x27, 0x81, 0xfb, 0x85, 0x34,
-	0xee, 0x3f, 0x87, 0xe5, 0x74, 0x24, 0x4c, 0xef, 0xc0, 0xa4, 0x75, 0x3b, 0x34, 0x63, 0x86, 0xa9,
-	0xbe, 0x8e, 0x2d, 0xa5, 0x22, 0xf3, 0xe8, 0x60, 0xaf, 0x6e, 0x87, 0x9b, 0x52, 0x7e, 0x35, 0x79,
-	0x02, 0x8b, 0x50, 0x6c, 0x3b, 0x21, 0xc7, 0xb6, 0x7e, 0x99, 0xd2, 0x3e, 0xc4, 0x8e, 0xe0, 0xea,
-	0x1e, 0xc3, 0x8a, 0xa7, 0x1b, 0xc3, 0xde, 0x82, 0x99, 0x96, 0x1c, 0xd1, 0x31, 0x38, 0x3c, 0x4e,
-	0x1a, 0xc5, 0x78, 0x79, 0x87, 0xaf, 0xa2, 0xf7, 0x60, 0xac, 0x4e, 0xb0, 0x45, 0x02, 0x89, 0x7e,
-	0x97, 0x94, 0x9a, 0x1e, 0x70, 0x12, 0x43, 0x92, 0x66, 0xa1, 0xc1, 0xdc, 0xb9, 0xa0, 0xc1, 0xab,
-	0x05, 0x32, 0x15, 0xd6, 0x2c, 0x9c, 0x1a, 0x6b, 0xca, 0x7f, 0x19, 0x85, 0xc5, 0x4d, 0xcb, 0x52,
-	0x5d, 0x5e, 0x52, 0xcd, 0x5b, 0xeb, 0x68, 0xde, 0xaf, 0xa8, 0x21, 0xde, 0x81, 0xc9, 0xf6, 0xd0,
-	0x36, 0x32, 0xc8, 0xd0, 0x36, 0x41, 0xe3, 0x19, 0xed, 0x0a, 0x14, 0x5a, 0xdd, 0x42, 0xce, 0xea,
-	0x23, 0x06, 0xc4, 0x4b, 0x55, 0xab, 0xb3, 0x9d, 0xc8, 0x26, 0x20, 0x0b, 0x36, 0x3f, 0x44, 0x3b,
-	0xe1, 0xa3, 0x7d, 0x5c, 0xb6, 0x77, 0x60, 0x2c, 0xf4, 0xa2, 0xa0, 0x26, 0xda, 0x63, 0xb1, 0x13,
-	0x8c, 0x13, 0x73, 0x2c, 0x0e, 0x8f, 0x9e, 0x70, 0x4a, 0x43, 0x72, 0x28, 0x50, 0x6e, 0x5c, 0x85,
-	0x72, 0xbe, 0x22, 0xa3, 0x26, 0xfa, 0x3d, 0x46, 0xa8, 0xa3, 0x5a, 0xe9, 0x48, 0x30, 0xf9, 0x34,
-	0xd0, 0x91, 0x65, 0xa5, 0x2d, 0x58, 0x50, 0x11, 0x2a, 0x46, 0x91, 0x85, 0xe4, 0x28, 0x32, 0x99,
-	0x1c, 0x33, 0x4e, 0xe0, 0x42, 0x97, 0x0d, 0x12, 0x6d, 0x55, 0x25, 0xa2, 0x9d, 0x57, 0x89, 0x94,
-	0xff, 0x95, 0xe7, 0x39, 0xad, 0x9a, 0x6d, 0xbe, 0x8a, 0x9c, 0x66, 0x37, 0x3f, 0x1e, 0x6e, 0xb3,
-	0xad, 0x5a, 0x20, 0x7d, 0x51, 0xac, 0xef, 0xc4, 0x06, 0xa4, 0xb2, 0x7f, 0xf4, 0x4c, 0xd9, 0x9f,
-	0x1f, 0x2e, 0xfb, 0xc7, 0xce, 0x9e, 0xfd, 0xe3, 0xe7, 0x90, 0xfd, 0x13, 0xaa, 0xec, 0x77, 0x41,
-	0xc7, 0x89, 0x50, 0xee, 0xd8, 0xa1, 0xcf, 0xb2, 0x82, 0xdd, 0xfb, 0x24, 0x62, 0x6f, 0xf4, 0xa8,
-	0x82, 0x0c, 0x4e, 0x23, 0x53, 0xa6, 0xb2, 0xda, 0x60, 0x80, 0x6a, 0x53, 0xe4, 0xdb, 0x6b, 0xac,
-	0xb6, 0x2f, 0x47, 0x40, 0xcf, 0x3a, 0x2c, 0xfa, 0x0e, 0xcc, 0xb4, 0x07, 0x08, 0x7e, 0x5b, 0x95,
-	0xe5, 0xa6, 0xc6, 0x65, 0x79, 0x2f, 0xe3, 0x4f, 0x0a, 0x46, 0x7b, 0x08, 0xe4, 0xdf, 0x5d, 0x33,
-	0x5d, 0x6e, 0xb8, 0x99, 0x2e, 0x31, 0xe5, 0x8c, 0x0c, 0x3b, 0xe5, 0x8c, 0x9e, 0xff, 0x94, 0x93,
-	0x3f, 0x9f, 0x29, 0x67, 0xec, 0xdc, 0xa6, 0x9c, 0x71, 0xd5, 0x94, 0x23, 0x7b, 0xa9, 0xf2, 0xe6,
-	0xf2, 0x6a, 0x7b, 0xe9, 0x97, 0x1a, 0x2c, 0xf0, 0x0b, 0x64, 0x7c, 0x8a, 0xb8, 0x93, 0x6e, 0x77,
-	0xde, 0x12, 0xdf, 0x56, 0x1e, 0x5e, 0xc5, 0x3b, 0xe0, 0xfd, 0xf0, 0x2c, 0xb3, 0xc0, 0x60, 0xd7,
-	0xc7, 0xf2, 0x7f, 0x34, 0x78, 0xa3, 0xc3, 0x42, 0xe9, 0xd5, 0xbb, 0x30, 0xc5, 0x5f, 0xab, 0xcc,
-	0x80, 0x84, 0x91, 0x13, 0x9f, 0xb1, 0x77, 0x9e, 0x14, 0x38, 0x87, 0xc1, 0x19, 0x50, 0x15, 0x8a,
-	0xb1, 0x80, 0x1f, 0x92, 0x1a, 0x25, 0x56, 0xcf, 0xbb, 0xba, 0xb8, 0xa3, 0x4b, 0x4a, 0x63, 0xfa,
-	0x45, 0xf2, 0x13, 0x3d, 0x53, 0x44, 0x58, 0xf8, 0xe3, 0x9d, 0x9e, 0xfe, 0xe8, 0x1b, 0xdc, 0x7f,
-	0x68, 0xb0, 0x22, 0x4e, 0x6c, 0x71, 0x03, 0x18, 0xe3, 0xb6, 0xd7, 0xf0, 0x1d, 0xc2, 0xac, 0x90,
-	0x31, 0x7a, 0xd4, 0x19, 0xe8, 0x9b, 0x4a, 0xa5, 0xfd, 0xe4, 0xbc, 0x86, 0xa0, 0x5f, 0x80, 0x71,
-	0xce, 0x2b, 0x87, 0xbf, 0x49, 0x63, 0x8c, 0x7d, 0x56, 0xad, 0xf2, 0x35, 0xb8, 0xda, 0xc3, 0x3c,
-	0x11, 0xf1, 0xf2, 0x5f, 0x35, 0xb8, 0xbc, 0xcd, 0xc6, 0x78, 0xe7, 0x51, 0x44, 0x43, 0x8a, 0x5d,
-	0xcb, 0x76, 0x0f, 0x1f, 0x7b, 0x8e, 0x33, 0xd0, 0xec, 0x90, 0x7a, 0xcc, 0xc8, 0x75, 0x3c, 0x66,
-	0xdc, 0x87, 0x62, 0xeb, 0x50, 0xed, 0xc7, 0xe9, 0x62, 0x46, 0xbf, 0x88, 0x4f, 0x26, 0xfa, 0x05,
-	0x4d, 0x7c, 0x9d, 0x65, 0x40, 0x28, 0x5f, 0x81, 0xa5, 0x8c, 0xe3, 0x49, 0x07, 0xfc, 0x08, 0x2e,
-	0xec, 0x90, 0xb0, 0x16, 0xd8, 0xfb, 0xa4, 0xc5, 0x2e, 0x8f, 0xbe, 0xdb, 0x99, 0x03, 0xea, 0xc4,
-	0xcb, 0x60, 0x1f, 0x2c, 0xf4, 0xe5, 0x3f, 0xe4, 0x40, 0xef, 0x96, 0x20, 0xeb, 0xf1, 0x03, 0x18,
-	0x17, 0xee, 0x14, 0x3f, 0x28, 0x16, 0x36, 0xae, 0x64, 0x3e, 0x4a, 0x91, 0x80, 0x03, 0x7c, 0x4c,
-	0xcf, 0x6e, 0x4c, 0x6d, 0xef, 0x87, 0x14, 0xd3, 0x28, 0x94, 0xb5, 0x78, 0xad, 0xa7, 0xef, 0x9e,
-	0x70, 0x52, 0xa3, 0x48, 0x53, 0xdf, 0xaf, 0xac, 0x1a, 0xcf, 0x14, 0xdc, 0x10, 0x96, 0x78, 0x92,
-	0x74, 0xea, 0x0a, 0xe3, 0x08, 0x2e, 0xc2, 0x98, 0x04, 0x18, 0x91, 0xb9, 0xf2, 0x2b, 0xad, 0x34,
-	0x37, 0x9c, 0xd2, 0x9f, 0xe5, 0x60, 0x39, 0x4b, 0xab, 0x0c, 0xdb, 0x0b, 0x58, 0x6a, 0xbf, 0x5f,
-	0xb5, 0x82, 0x90, 0xf8, 0x89, 0x53, 0x04, 0xb3, 0x32, 0x98, 0xe7, 0x1e, 0x12, 0x8a, 0x2d, 0x4c,
-	0xb1, 0x51, 0x4a, 0x0e, 0x6f, 0x69, 0xd5, 0x4c, 0x65, 0xeb, 0xe7, 0x05, 0xa5, 0xca, 0xdc, 0xe9,
-	0x54, 0x5a, 0x89, 0x8b, 0x4c, 0x5a, 0x65, 0xf9, 0x26, 0x5c, 0xba, 0x4f, 0x5a, 0x6e, 0x08, 0xb7,
-	0x9a, 0x02, 0xb5, 0xfb, 0xf8, 0xbe, 0xfc, 0xfb, 0x51, 0xb8, 0xac, 0xe6, 0x93, 0xde, 0xfb, 0x89,
-	0x06, 0x8b, 0x8a, 0xb3, 0x34, 0xb0, 0x2f, 0xfd, 0xf6, 0x28, 0x1b, 0xe1, 0x7b, 0x09, 0xae, 0xec,
-	0x74, 0x9c, 0xe5, 0x21, 0xf6, 0xc5, 0x68, 0x3a, 0x6f, 0x75, 0xef, 0x70, 0x33, 0x14, 0x51, 0x64,
-	0x66, 0xe4, 0xce, 0x64, 0xc6, 0x66, 0x47, 0x14, 0xdb, 0x66, 0xe0, 0xee, 0x9d, 0xd2, 0x17, 0xac,
-	0x3d, 0xa8, 0xed, 0x56, 0x4c, 0xca, 0x0f, 0xd2, 0x4f, 0xe4, 0x3d, 0xae, 0x08, 0x59, 0x3d, 0x27,
-	0xf9, 0xd3, 0xf5, 0x17, 0xe9, 0xe1, 0xfa, 0x75, 0xea, 0x2e, 0xff, 0x26, 0x07, 0x6f, 0x7e, 0xea,
-	0x5b, 0x98, 0x92, 0xac, 0x56, 0x32, 0x08, 0x40, 0x9d, 0xa1, 0xd0, 0xcf, 0x0f, 0xbf, 0x54, 0xbd,
-	0x73, 0xf4, 0x3c, 0x26, 0x99, 0xb7, 0xe0, 0x7a, 0x1f, 0x17, 0x49, 0x90, 0xfb, 0x6d, 0x0e, 0xae,
-	0x1b, 0xe4, 0x20, 0x20, 0x61, 0xfd, 0xff, 0xde, 0xcc, 0xf2, 0xe6, 0x2a, 0xdc, 0xe8, 0xe7, 0x23,
-	0xe1, 0xce, 0x8d, 0x7f, 0x4f, 0x41, 0xe1, 0xa1, 0xcc, 0xe7, 0xcd, 0xc7, 0x55, 0xf4, 0x63, 0x0d,
-	0xe6, 0x15, 0x3f, 0x15, 0xa2, 0xf7, 0x87, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x74, 0xf3, 0x54, 0xbf,
-	0x47, 0x26, 0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96,
-	0x1d, 0xc3, 0x4c, 0xc7, 0xeb, 0x17, 0x7a, 0x77, 0xd8, 0xc7, 0xba, 0xd2, 0xfa, 0x10, 0x1c, 0x29,
-	0xbd, 0xa9, 0x73, 0xbf, 0x3b, 0xec, 0xb3, 0x45, 0x1f, 0xbd, 0xca, 0xf3, 0xfa, 0x30, 0x9d, 0xba,
-	0x49, 0xa1, 0x4a, 0xb6, 0x0c, 0xd5, 0xa5, 0xb0, 0xb4, 0x36, 0x30, 0xbd, 0xd4, 0xf8, 0x4b, 0x0d,
-	0x2e, 0x66, 0x8e, 0xf5, 0xe8, 0x4e, 0xb6, 0xb8, 0x7e, 0x57, 0x95, 0xd2, 0x87, 0xa7, 0xe2, 0x95,
-	0x66, 0xfd, 0x5c, 0x83, 0x37, 0x94, 0x83, 0x36, 0xba, 0x95, 0x2d, 0xb6, 0xd7, 0xc5, 0xa3, 0xf4,
-	0xcd, 0xa1, 0xf9, 0xa4, 0x29, 0x4d, 0x98, 0xed, 0x04, 0x18, 0xb4, 0x3e, 0x0c, 0x18, 0x09, 0xfd,
-	0xa7, 0xc0, 0x2f, 0xf4, 0x0b, 0x0d, 0x16, 0xd5, 0xb3, 0x21, 0xea, 0x71, 0x9c, 0x9e, 0x33, 0x6c,
-	0xe9, 0xf6, 0xf0, 0x8c, 0xd2, 0x9a, 0x9f, 0x6a, 0xb0, 0xa0, 0x9a, 0x44, 0xd0, 0xcd, 0x61, 0x27,
-	0x17, 0x61, 0xc9, 0xad, 0xd3, 0x0d, 0x3c, 0xe8, 0xd7, 0x1a, 0x2c, 0xf5, 0xc4, 0x29, 0xf4, 0x51,
-	0xb6, 0xe4, 0x41, 0x66, 0x80, 0xd2, 0xdd, 0x53, 0xf3, 0x4b, 0x13, 0x7f, 0xa7, 0xc1, 0x72, 0xef,
-	0xe6, 0x8f, 0xee, 0xf6, 0x2a, 0x8f, 0x01, 0xa0, 0xb5, 0xf4, 0xed, 0xd3, 0x0b, 0x10, 0x56, 0x6e,
-	0xdd, 0xff, 0xd3, 0xcb, 0x65, 0xed, 0xcf, 0x2f, 0x97, 0xb5, 0xbf, 0xbd, 0x5c, 0xd6, 0xbe, 0xf7,
-	0xc1, 0xa1, 0x4d, 0xeb, 0xd1, 0x7e, 0xa5, 0xe6, 0x35, 0xd6, 0x52, 0xff, 0xbd, 0x5a, 0x39, 0x24,
-	0xae, 0xf8, 0x77, 0xdf, 0xe4, 0x7f, 0x1c, 0x7f, 0x18, 0xff, 0x7d, 0xbc, 0xbe, 0x3f, 0xc6, 0x77,
-	0xdf, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x50, 0xe3, 0xd9, 0x9f, 0x2c, 0x00, 0x00,
+	// 2792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xf1, 0xc7, 0x2c, 0xdf, 0xb5, 0xe4, 0x92, 0x6c, 0x52, 0xd4, 0x70, 0x28, 0x51, 0xd4, 0xda, 0x92,
+	0xe9, 0xff, 0xdf, 0x59, 0x9a, 0xb4, 0xe5, 0xc8, 0x72, 0x62, 0x87, 0x14, 0xf5, 0xd8, 0xc0, 0xb2,
+	0xe4, 0x11, 0x6d, 0x03, 0x89, 0xe1, 0x49, 0x73, 0xa7, 0x49, 0x4e, 0xb8, 0x3b, 0xb3, 0x9a, 0xee,
+	0x21, 0x45, 0x1f, 0x72, 0x08, 0x92, 0x20, 0x40, 0xae, 0x06, 0x72, 0xcd, 0xeb, 0xc3, 0xe4, 0x96,
+	0xe4, 0x66, 0xc0, 0x08, 0xe0, 0x18, 0xc8, 0x07, 0x48, 0x72, 0xcd, 0x21, 0xe8, 0xc7, 0xec, 0xce,
+	0xec, 0xf6, 0xcc, 0xee, 0x92, 0x94, 0x1c, 0x03, 0xb9, 0xb1, 0xbb, 0xab, 0xaa, 0xab, 0xab, 0xab,
+	0xea, 0x57, 0xd5, 0xb3, 0x84, 0xeb, 0xd1, 0x2e, 0x09, 0xd7, 0x6a, 0xd8, 0x25, 0x7e, 0x8d, 0xac,
+	0x35, 0x30, 0xab, 0x1d, 0x78, 0xfe, 0xfe, 0xda, 0xd1, 0xfa, 0x1a, 0x25, 0xe1, 0x91, 0x57, 0x23,
+	0x95, 0x66, 0x18, 0xb0, 0x00, 0x99, 0x9c, 0xae, 0xa2, 0xe8, 0x2a, 0x31, 0x5d, 0xe5, 0x68, 0xdd,
+	0x5a, 0xde, 0x0f, 0x82, 0xfd, 0x3a, 0x59, 0x13, 0x74, 0xbb, 0xd1, 0xde, 0x9a, 0x1b, 0x85, 0x98,
+	0x79, 0x81, 0x2f, 0x39, 0xad, 0x2b, 0x9d, 0xeb, 0xcc, 0x6b, 0x10, 0xca, 0x70, 0xa3, 0xa9, 0x08,
+	0xba, 0x04, 0x1c, 0x87, 0xb8, 0xd9, 0x24, 0x21, 0x55, 0xeb, 0x2b, 0x29, 0x15, 0x71, 0xd3, 0xe3,
+	0xda, 0xd5, 0x82, 0x46, 0xa3, 0xbd, 0x85, 0x8e, 0xe2, 0x49, 0x44, 0xc2, 0x13, 0x45, 0x50, 0xd6,
+	0x11, 0x30, 0x4c, 0x0f, 0xeb, 0x1e, 0x65, 0x8a, 0x66, 0x55, 0x47, 0xa3, 0x8c, 0xe0, 0x1c, 0x07,
+	0xe1, 0x21, 0x09, 0x15, 0xe5, 0xff, 0xf5, 0xa2, 0xdc, 0xab, 0x07, 0xc7, 0x8a, 0xf6, 0xaa, 0x8e,
+	0xf6, 0xc0, 0xa3, 0x2c, 0x68, 0x29, 0xf7, 0x62, 0x8a, 0x84, 0x1e, 0xe0, 0x90, 0xb8, 0xdd, 0x54,
+	0xd7, 0x32, 0xa8, 0xd2, 0xa7, 0x28, 0xbf, 0x0d, 0xb3, 0x3b, 0x98, 0x1e, 0xbe, 0xeb, 0x51, 0xf6,
+	0x08, 0x87, 0xcc, 0xe3, 0x17, 0x81, 0x5e, 0x86, 0x19, 0x8f, 0x06, 0x75, 0x71, 0x2b, 0xce, 0x7e,
+	0x18, 0x44, 0x4d, 0x6a, 0x1a, 0x2b, 0x43, 0xab, 0x13, 0xf6, 0x74, 0x6b, 0xfe, 0x9e, 0x98, 0x2e,
+	0xff, 0x6d, 0x18, 0x2e, 0x76, 0x09, 0xb8, 0x1d, 0xf8, 0x7b, 0xde, 0x3e, 0x32, 0x61, 0xec, 0x88,
+	0x84, 0xd4, 0x0b, 0x7c, 0xd3, 0x58, 0x31, 0x56, 0x87, 0xec, 0x78, 0x88, 0x36, 0x60, 0xce, 0x8f,
+	0x1a, 0x4e, 0x48, 0xb0, 0xeb, 0x34, 0x63, 0x2e, 0x6a, 0x16, 0x56, 0x8c, 0xd5, 0x91, 0xad, 0x82,
+	0x69, 0xd8, 0xb3, 0x7e, 0xd4, 0xb0, 0x09, 0x76, 0x5b, 0x22, 0x29, 0x7a, 0x1d, 0xe6, 0x39, 0xcf,
+	0x71, 0xe8, 0x31, 0x92, 0x64, 0x1a, 0x6a, 0x31, 0x21, 0x3f, 0x6a, 0x7c, 0xc4, 0x97, 0x13, 0x5c,
+	0x3e, 0x4c, 0x77, 0xee, 0x32, 0xbc, 0x32, 0xb4, 0x5a, 0xdc, 0xb8, 0x53, 0xc9, 0xf2, 0xd0, 0x4a,
+	0xc6, 0x79, 0x2a, 0x69, 0x85, 0xee, 0xf8, 0x2c, 0x3c, 0xb1, 0x4b, 0x61, 0x5a, 0xcb, 0x27, 0x30,
+	0xd3, 0xa5, 0xe1, 0x88, 0xd8, 0xf0, 0xee, 0xe0, 0x1b, 0x76, 0x1c, 0x46, 0xee, 0x38, 0x7d, 0x9c,
+	0x9e, 0xb5, 0x7c, 0x98, 0xd3, 0x68, 0x86, 0x66, 0x60, 0xe8, 0x90, 0x9c, 0x08, 0xcb, 0x8f, 0xd8,
+	0xfc, 0x4f, 0xb4, 0x09, 0x23, 0x47, 0xb8, 0x1e, 0x11, 0x61, 0xe7, 0xe2, 0xc6, 0xff, 0x0f, 0xa0,
+	0x90, 0x2d, 0x39, 0x6f, 0x15, 0x6e, 0x1a, 0x56, 0x00, 0xf3, 0x3a, 0xc5, 0x9e, 0xd9, 0x86, 0xe5,
+	0x1f, 0xc1, 0xec, 0xbb, 0x01, 0x76, 0xb7, 0x70, 0x1d, 0xfb, 0x35, 0x12, 0xde, 0xf7, 0x7c, 0x46,
+	0xd1, 0x0b, 0x30, 0xb5, 0x8b, 0x6b, 0x87, 0xf5, 0x60, 0xdf, 0xa9, 0x05, 0x91, 0xcf, 0x94, 0x8b,
+	0x4d, 0xaa, 0xc9, 0xdb, 0x7c, 0x0e, 0x5d, 0x87, 0xe9, 0x10, 0xf3, 0xcb, 0x20, 0xa1, 0x43, 0x49,
+	0x2d, 0xf0, 0x5d, 0xa1, 0x8a, 0x61, 0x4f, 0xf1, 0xe9, 0x47, 0x24, 0x7c, 0x2c, 0x26, 0xcb, 0xff,
+	0x30, 0xc0, 0x7a, 0x14, 0xd4, 0xeb, 0x77, 0x83, 0x70, 0x9b, 0xd4, 0x3c, 0xee, 0xa3, 0x5c, 0x23,
+	0x9b, 0x3c, 0x89, 0x08, 0x65, 0xa8, 0x0a, 0x63, 0xa1, 0xfc, 0x53, 0xec, 0x52, 0xdc, 0x58, 0x4b,
+	0x9f, 0x04, 0x37, 0x3d, 0x7e, 0x88, 0x6c, 0x09, 0x76, 0xcc, 0x8f, 0x96, 0x60, 0xc2, 0x0d, 0x1a,
+	0xd8, 0xf3, 0x1d, 0x4f, 0xea, 0x32, 0x61, 0x8f, 0xcb, 0x89, 0xaa, 0xcb, 0x17, 0x9b, 0x41, 0xbd,
+	0x4e, 0x42, 0xbe, 0x38, 0x24, 0x17, 0xe5, 0x44, 0xd5, 0x45, 0xd7, 0xa0, 0xb4, 0x17, 0x84, 0xc7,
+	0x38, 0x74, 0x89, 0xeb, 0xec, 0x85, 0x41, 0xc3, 0x1c, 0x16, 0x14, 0x53, 0xad, 0xd9, 0xbb, 0x61,
+	0xd0, 0x40, 0x2f, 0xc1, 0x74, 0x47, 0xec, 0x9a, 0x23, 0x82, 0xae, 0x94, 0x0e, 0xdd, 0xf2, 0xe7,
+	0x93, 0xb0, 0xa4, 0xd5, 0x98, 0x36, 0x03, 0x9f, 0x12, 0x74, 0x19, 0x80, 0xe7, 0x0a, 0x87, 0x05,
+	0x87, 0x44, 0x06, 0xf0, 0xa4, 0x3d, 0xc1, 0x67, 0x76, 0xf8, 0x04, 0xfa, 0x00, 0x50, 0x9c, 0xba,
+	0x1c, 0xf2, 0x94, 0xd4, 0x22, 0x2e, 0x59, 0x5d, 0xf4, 0x75, 0xad, 0x79, 0x3e, 0x52, 0xe4, 0x77,
+	0x62, 0x6a, 0x7b, 0xf6, 0xb8, 0x73, 0x0a, 0xdd, 0x85, 0xa9, 0x96, 0x58, 0x76, 0xd2, 0x24, 0xc2,
+	0x0c, 0xc5, 0x8d, 0xab, 0xb9, 0x12, 0x77, 0x4e, 0x9a, 0xc4, 0x9e, 0x3c, 0x4e, 0x8c, 0xd0, 0x87,
+	0xb0, 0xd8, 0x0c, 0xc9, 0x91, 0x17, 0x44, 0xd4, 0xa1, 0x0c, 0x87, 0x8c, 0xb8, 0x0e, 0x39, 0x22,
+	0x3e, 0xe3, 0xa6, 0x1d, 0x16, 0x32, 0x97, 0x2a, 0x12, 0x48, 0x2a, 0x31, 0x90, 0x54, 0xaa, 0x3e,
+	0x7b, 0xe3, 0xf5, 0x0f, 0xb9, 0xdf, 0xd9, 0x0b, 0x31, 0xf7, 0x63, 0xc9, 0x7c, 0x87, 0xf3, 0x56,
+	0x5d, 0xb4, 0x0a, 0x33, 0x5d, 0xe2, 0x46, 0x84, 0xe7, 0x95, 0x68, 0x9a, 0xd2, 0x84, 0x31, 0xcc,
+	0x18, 0x69, 0x34, 0x99, 0x39, 0x2a, 0x42, 0x22, 0x1e, 0xa2, 0x32, 0x4c, 0xf9, 0xe4, 0x29, 0x6b,
+	0x0b, 0x18, 0x13, 0x02, 0x8a, 0x7c, 0x32, 0xe6, 0x7e, 0x05, 0x50, 0xca, 0xbd, 0x9d, 0x03, 0xcf,
+	0x67, 0xe6, 0xb8, 0x20, 0x9c, 0x49, 0xfa, 0x38, 0x8f, 0x06, 0x74, 0x13, 0x4c, 0xca, 0xbc, 0xda,
+	0xe1, 0x49, 0xfb, 0x2a, 0x1c, 0xe2, 0xe3, 0xdd, 0x3a, 0x71, 0xcd, 0x89, 0x15, 0x63, 0x75, 0xdc,
+	0x5e, 0x90, 0xeb, 0x2d, 0x43, 0xdf, 0x91, 0xab, 0xe8, 0x26, 0x8c, 0x08, 0xe0, 0x33, 0x41, 0xd8,
+	0xa4, 0x9c, 0x6b, 0xe7, 0xf7, 0x39, 0xa5, 0x2d, 0x19, 0x90, 0x0d, 0x53, 0xae, 0xf2, 0x1b, 0xc7,
+	0xf3, 0xf7, 0x02, 0xb3, 0x28, 0x24, 0x7c, 0x2b, 0x2d, 0x41, 0x02, 0x8f, 0x08, 0xf1, 0x10, 0xfb,
+	0xd4, 0x23, 0x3e, 0x8b, 0xbd, 0xad, 0xea, 0xef, 0x05, 0xf6, 0xa4, 0x9b, 0x18, 0xa1, 0x4f, 0xe0,
+	0x52, 0xb7, 0x53, 0x39, 0xc2, 0x0d, 0x39, 0x66, 0x99, 0x93, 0x62, 0x8b, 0xcb, 0x5a, 0x25, 0xe3,
+	0x14, 0x62, 0x2f, 0x76, 0x79, 0x55, 0xbc, 0x84, 0x2a, 0x30, 0x27, 0x8d, 0xce, 0x91, 0x92, 0x38,
+	0x31, 0x3a, 0x4d, 0x89, 0xfb, 0x99, 0x15, 0x4b, 0x8f, 0xf9, 0xca, 0x87, 0x72, 0x01, 0x5d, 0x85,
+	0xc9, 0xdd, 0x10, 0xfb, 0xb5, 0x03, 0x15, 0x05, 0x25, 0x11, 0x05, 0x45, 0x39, 0x27, 0xe3, 0x60,
+	0x13, 0x4a, 0xb4, 0x76, 0x40, 0xdc, 0xa8, 0x4e, 0x5c, 0x87, 0x97, 0x2a, 0xe6, 0xb4, 0x50, 0xd2,
+	0xea, 0xf2, 0xae, 0x9d, 0xb8, 0x8e, 0xb1, 0xa7, 0x5a, 0x1c, 0x7c, 0x0e, 0x7d, 0x17, 0x26, 0x63,
+	0x9f, 0x12, 0x02, 0x66, 0x7a, 0x0a, 0x28, 0x2a, 0x7a, 0xc1, 0xfe, 0x31, 0x8c, 0xf1, 0x1b, 0xf1,
+	0x08, 0x35, 0x67, 0x05, 0xd2, 0x6c, 0x65, 0xe7, 0xd9, 0x9c, 0x80, 0xaf, 0xbc, 0x2f, 0x85, 0x48,
+	0x94, 0x89, 0x45, 0x72, 0x93, 0xb1, 0x80, 0xe1, 0xba, 0xa3, 0xca, 0x0b, 0x67, 0xf7, 0x84, 0x11,
+	0x6a, 0x22, 0xe1, 0x89, 0xb3, 0x62, 0xe9, 0xbe, 0x5c, 0xd9, 0xe2, 0x0b, 0xe8, 0x63, 0x98, 0x69,
+	0x41, 0x9f, 0x53, 0x13, 0x38, 0x66, 0xce, 0x89, 0x03, 0xad, 0x0f, 0x0c, 0x80, 0xf6, 0x74, 0x33,
+	0x3d, 0x81, 0x7e, 0x08, 0x73, 0xf5, 0x00, 0xbb, 0xce, 0xae, 0xc2, 0x02, 0x11, 0x16, 0xd4, 0x9c,
+	0xef, 0x85, 0x2f, 0x5d, 0xf8, 0x61, 0xcf, 0xd6, 0x3b, 0xa7, 0xd0, 0x03, 0x98, 0xc1, 0x11, 0x0b,
+	0x94, 0xd6, 0x32, 0xe2, 0x2e, 0x08, 0xc9, 0x2f, 0x68, 0x3d, 0x6e, 0x33, 0x62, 0x81, 0xd4, 0x8b,
+	0xf3, 0xdb, 0x25, 0x9c, 0x1a, 0x73, 0x84, 0x8a, 0x6d, 0x26, 0x11, 0x6a, 0x41, 0x22, 0x94, 0x9a,
+	0x94, 0x08, 0xf5, 0x26, 0x2c, 0xd6, 0x02, 0x9f, 0x79, 0x7e, 0x44, 0x1c, 0x4c, 0x1d, 0x9f, 0x1c,
+	0x3b, 0x34, 0xda, 0xdf, 0x27, 0x94, 0x11, 0xd7, 0xbc, 0x28, 0x43, 0x37, 0x26, 0xd8, 0xa4, 0xef,
+	0x91, 0xe3, 0xc7, 0xf1, 0x2a, 0x0f, 0xfa, 0x90, 0xf0, 0xba, 0x96, 0xf8, 0x1c, 0x12, 0x14, 0x72,
+	0xc8, 0xad, 0x4c, 0xe1, 0xd1, 0x0b, 0x89, 0xf5, 0x47, 0x62, 0x59, 0x6c, 0x6a, 0x7d, 0x02, 0x93,
+	0xc9, 0xcb, 0x4e, 0x22, 0xf7, 0x84, 0x44, 0xee, 0x9b, 0x69, 0xe4, 0xee, 0x2b, 0x2d, 0xb4, 0x01,
+	0x3b, 0x01, 0xa7, 0x9b, 0x35, 0xe6, 0x1d, 0x79, 0xec, 0xe4, 0xf4, 0x70, 0xaa, 0x91, 0xf0, 0xdf,
+	0x08, 0xa7, 0x7f, 0x02, 0x58, 0xd2, 0x6a, 0xfc, 0xb5, 0xc2, 0xe9, 0x15, 0x28, 0x62, 0xa5, 0x4d,
+	0xdb, 0x08, 0x10, 0x4f, 0x55, 0x5d, 0x8e, 0xb7, 0x2d, 0x02, 0x81, 0xb7, 0xc3, 0x39, 0x78, 0xdb,
+	0x3a, 0x98, 0xc0, 0x5b, 0x9c, 0x18, 0xa1, 0x0d, 0x18, 0xf1, 0xfc, 0x66, 0xc4, 0x84, 0x75, 0x8a,
+	0x1b, 0x97, 0xf4, 0x37, 0x8a, 0x4f, 0x78, 0xd4, 0xd9, 0x92, 0x54, 0x93, 0x3a, 0x47, 0xcf, 0x9a,
+	0x3a, 0xc7, 0x06, 0x4b, 0x9d, 0x3b, 0xb0, 0x18, 0xcb, 0x73, 0x78, 0xe0, 0xd7, 0x03, 0x4a, 0x84,
+	0xa0, 0x20, 0x92, 0x60, 0x5b, 0xdc, 0x58, 0xec, 0x92, 0xb5, 0xad, 0xfa, 0x55, 0x7b, 0x21, 0xe6,
+	0xdd, 0x09, 0x6e, 0x73, 0xce, 0x1d, 0xc9, 0x88, 0xde, 0x83, 0x05, 0xb1, 0x49, 0xb7, 0xc8, 0x89,
+	0x5e, 0x22, 0xe7, 0x04, 0x63, 0x87, 0xbc, 0xbb, 0x30, 0x7b, 0x40, 0x70, 0xc8, 0x76, 0x09, 0x66,
+	0x2d, 0x51, 0xd0, 0x4b, 0xd4, 0x4c, 0x8b, 0x27, 0x96, 0x93, 0xa8, 0x48, 0x8a, 0xe9, 0x8a, 0xe4,
+	0x13, 0x58, 0x4e, 0xdf, 0x84, 0x13, 0xec, 0x39, 0xec, 0xc0, 0xa3, 0x4e, 0xcc, 0x30, 0xd9, 0xd3,
+	0xb0, 0x56, 0xea, 0x66, 0x1e, 0xee, 0xed, 0x1c, 0x78, 0x74, 0x53, 0xc9, 0xaf, 0x26, 0x4f, 0xe0,
+	0x12, 0x86, 0xbd, 0x3a, 0x35, 0xa7, 0xfa, 0xf0, 0x94, 0xf6, 0x21, 0xb6, 0x25, 0x57, 0x77, 0x81,
+	0x58, 0x3a, 0x5d, 0x81, 0xf8, 0x12, 0x4c, 0xb7, 0xe4, 0xc8, 0x8c, 0x21, 0x80, 0x7b, 0xc2, 0x2e,
+	0xc5, 0xd3, 0xdb, 0x62, 0x16, 0xbd, 0x06, 0xa3, 0x07, 0x04, 0xbb, 0x24, 0x54, 0xb8, 0xbc, 0xa4,
+	0xdd, 0xe9, 0xbe, 0x20, 0xb1, 0x15, 0x69, 0x16, 0x4e, 0xcd, 0x9e, 0x0b, 0x4e, 0x3d, 0x5b, 0x88,
+	0xd5, 0xa1, 0xe0, 0xfc, 0xe9, 0x51, 0x30, 0x0f, 0xa5, 0x2e, 0xe4, 0xa1, 0x54, 0xf9, 0xf3, 0x61,
+	0x58, 0xd8, 0x74, 0x5d, 0x5d, 0x43, 0x96, 0x4a, 0xfb, 0x46, 0x47, 0xda, 0x7f, 0x46, 0xa9, 0xf4,
+	0x16, 0x4c, 0xb4, 0x0b, 0xd1, 0xa1, 0x7e, 0x0a, 0xd1, 0x71, 0xa6, 0xfe, 0xe2, 0x69, 0xb8, 0x95,
+	0x67, 0x54, 0xff, 0x31, 0x64, 0x43, 0x3c, 0x55, 0x75, 0x3b, 0x13, 0x91, 0x4a, 0x1f, 0x2a, 0xd4,
+	0x47, 0x06, 0x48, 0x44, 0xa2, 0x5d, 0x89, 0x03, 0xfe, 0x16, 0x8c, 0xd2, 0x20, 0x0a, 0x6b, 0x32,
+	0xb1, 0x96, 0x36, 0xca, 0x99, 0xb5, 0x39, 0xa6, 0x87, 0x8f, 0x05, 0xa5, 0xad, 0x38, 0x34, 0xf8,
+	0x38, 0xa6, 0xc3, 0xc7, 0xa6, 0xc6, 0x17, 0xc7, 0x7b, 0x3d, 0xb0, 0xe8, 0x6f, 0xb5, 0xd2, 0xe1,
+	0x9a, 0xea, 0xb9, 0xa3, 0xc3, 0x3f, 0xad, 0x2d, 0x98, 0xd7, 0x11, 0x6a, 0x8a, 0x98, 0xf9, 0x64,
+	0x11, 0x33, 0x91, 0x2c, 0x50, 0x8e, 0xe1, 0x62, 0x97, 0x0e, 0x0a, 0xa7, 0x75, 0xc1, 0x65, 0x9c,
+	0x57, 0x70, 0x95, 0xff, 0x39, 0x22, 0x7c, 0x5a, 0x57, 0x15, 0x7d, 0x1d, 0x3e, 0xcd, 0xbb, 0x59,
+	0x71, 0xdd, 0x4e, 0x7b, 0x6b, 0x59, 0x23, 0x94, 0xe4, 0xfc, 0x76, 0xac, 0x40, 0xca, 0xfb, 0x87,
+	0xcf, 0xe4, 0xfd, 0x23, 0x83, 0x79, 0xff, 0xe8, 0xd9, 0xbd, 0x7f, 0xec, 0x1c, 0xbc, 0x7f, 0x5c,
+	0xe7, 0xfd, 0x3e, 0x98, 0x38, 0x71, 0x95, 0xdb, 0x1e, 0x6d, 0x72, 0xaf, 0xe0, 0xbd, 0xac, 0xc2,
+	0xfa, 0x8d, 0x9c, 0x28, 0xc8, 0xe0, 0xb4, 0x33, 0x65, 0x6a, 0xa3, 0x0d, 0xfa, 0x88, 0x36, 0x8d,
+	0xbf, 0x3d, 0xc7, 0x68, 0xfb, 0x62, 0x08, 0xcc, 0xac, 0xc3, 0xa2, 0xef, 0xc3, 0x74, 0xbb, 0xf4,
+	0x10, 0x1d, 0xb8, 0x69, 0xe4, 0x20, 0xba, 0xea, 0x35, 0xc5, 0x33, 0x89, 0xdd, 0x2e, 0x1f, 0xc5,
+	0xb8, 0xab, 0x1a, 0x2c, 0x0c, 0x56, 0x0d, 0x26, 0xea, 0xa3, 0xa1, 0x41, 0xeb, 0xa3, 0xe1, 0xf3,
+	0xaf, 0x8f, 0x46, 0xce, 0xa7, 0x3e, 0x1a, 0x3d, 0xb7, 0xfa, 0x68, 0x4c, 0x57, 0x1f, 0xa9, 0x5c,
+	0xaa, 0xed, 0x79, 0x9e, 0x6d, 0x2e, 0xfd, 0xc2, 0x80, 0x79, 0xd1, 0x7a, 0xc6, 0xa7, 0x88, 0x33,
+	0xe9, 0xed, 0xce, 0xfe, 0xf2, 0x65, 0xed, 0xe1, 0x75, 0xbc, 0x7d, 0x76, 0x96, 0x67, 0xa9, 0x05,
+	0xfa, 0x6b, 0x3c, 0xcb, 0xff, 0x36, 0xe0, 0x42, 0x87, 0x86, 0xca, 0xaa, 0xef, 0xc0, 0xa4, 0x78,
+	0x81, 0x73, 0x42, 0x42, 0xa3, 0x7a, 0x7c, 0xc6, 0x7c, 0x3f, 0x29, 0x0a, 0x0e, 0x5b, 0x30, 0xa0,
+	0x2a, 0x94, 0x62, 0x01, 0x3f, 0x26, 0x35, 0x46, 0xe4, 0xf9, 0xb2, 0xba, 0x7c, 0xd9, 0xdd, 0x2b,
+	0x4a, 0x7b, 0xea, 0x49, 0x72, 0x88, 0x3e, 0xd2, 0xdc, 0xb0, 0xb4, 0xc7, 0x2b, 0xb9, 0xf6, 0xe8,
+	0x79, 0xb9, 0x7f, 0x37, 0x60, 0x45, 0x9e, 0xd8, 0x15, 0x0a, 0x70, 0xc6, 0xdb, 0x41, 0xa3, 0x59,
+	0x27, 0x5c, 0x0b, 0x75, 0x47, 0x0f, 0x3b, 0x2f, 0xfa, 0x86, 0x76, 0xd3, 0x5e, 0x72, 0x9e, 0xc3,
+	0xa5, 0x5f, 0x84, 0x31, 0xc1, 0xab, 0x8a, 0xbf, 0x09, 0x7b, 0x94, 0x0f, 0xab, 0x6e, 0xf9, 0x05,
+	0xb8, 0x9a, 0xa3, 0x9e, 0xbc, 0xf1, 0xf2, 0x5f, 0x0d, 0xb8, 0x74, 0x9b, 0x37, 0x00, 0xf5, 0x87,
+	0x11, 0xa3, 0x0c, 0xfb, 0xae, 0xe7, 0xef, 0xf3, 0x52, 0xb9, 0xaf, 0xda, 0x21, 0xf5, 0x0c, 0x52,
+	0xe8, 0x78, 0x06, 0xb9, 0x07, 0xa5, 0xd6, 0xa1, 0xda, 0x0f, 0xee, 0xa5, 0x8c, 0x7c, 0x11, 0x9f,
+	0x4c, 0xe6, 0x0b, 0x96, 0x18, 0x9d, 0xa5, 0x40, 0x28, 0x5f, 0x81, 0xcb, 0x19, 0xc7, 0x53, 0x06,
+	0xf8, 0x09, 0x5c, 0xdc, 0x26, 0xb4, 0x16, 0x7a, 0xbb, 0xa4, 0xc5, 0xae, 0x8e, 0x7e, 0xb7, 0xd3,
+	0x07, 0xf4, 0x8e, 0x97, 0xc1, 0xde, 0xdf, 0xd5, 0x97, 0xff, 0x55, 0x00, 0xb3, 0x5b, 0x82, 0x8a,
+	0xc7, 0x37, 0x61, 0x4c, 0x9a, 0x53, 0x7e, 0x24, 0x2d, 0x6e, 0x5c, 0xc9, 0x7c, 0xce, 0x22, 0xa1,
+	0x00, 0xf8, 0x98, 0x9e, 0xf7, 0x5a, 0x6d, 0xeb, 0x53, 0x86, 0x59, 0x44, 0xcd, 0x42, 0x4e, 0xaf,
+	0x15, 0xef, 0xfd, 0x58, 0x90, 0xda, 0x25, 0x96, 0x1a, 0x3f, 0xb3, 0x68, 0x3c, 0x53, 0xf5, 0x97,
+	0xd7, 0x00, 0x8e, 0xe4, 0x36, 0x80, 0x14, 0x2e, 0x0b, 0xf7, 0xea, 0xd4, 0x92, 0xc6, 0x77, 0xbf,
+	0x00, 0xa3, 0x0a, 0x9a, 0xa4, 0xcf, 0xab, 0x51, 0x5a, 0xdd, 0xc2, 0x60, 0xbe, 0xf8, 0x8b, 0x02,
+	0x2c, 0x67, 0xed, 0xaa, 0x2e, 0xfc, 0x09, 0x5c, 0x6e, 0xbf, 0x99, 0xb5, 0xae, 0x2f, 0xf1, 0xc1,
+	0x57, 0xba, 0x41, 0xa5, 0x3f, 0x9b, 0x3f, 0x20, 0x0c, 0xbb, 0x98, 0x61, 0xdb, 0x4a, 0x96, 0x7d,
+	0xe9, 0xad, 0xf9, 0x96, 0xad, 0x8f, 0x2d, 0xda, 0x2d, 0x0b, 0xa7, 0xdb, 0xd2, 0x4d, 0xb4, 0x40,
+	0xe9, 0x2d, 0xcb, 0x37, 0x60, 0xe9, 0x1e, 0x69, 0x99, 0x81, 0x6e, 0x9d, 0x48, 0xbc, 0xef, 0x61,
+	0xfb, 0xf2, 0x1f, 0x86, 0xe1, 0x92, 0x9e, 0x4f, 0x59, 0xef, 0x67, 0x06, 0x2c, 0x68, 0xce, 0xd2,
+	0xc0, 0x4d, 0x65, 0xb7, 0x87, 0xd9, 0xb5, 0x41, 0x9e, 0xe0, 0xca, 0x76, 0xc7, 0x59, 0x1e, 0xe0,
+	0xa6, 0x2c, 0x6a, 0xe7, 0xdc, 0xee, 0x15, 0xa1, 0x86, 0xe6, 0x16, 0xb9, 0x1a, 0x85, 0x33, 0xa9,
+	0xb1, 0xd9, 0x71, 0x8b, 0x6d, 0x35, 0x70, 0xf7, 0x8a, 0xf5, 0x29, 0x4f, 0x2c, 0x7a, 0xbd, 0x35,
+	0x35, 0xf6, 0xfd, 0xf4, 0xb3, 0x7c, 0x4e, 0x73, 0x91, 0x95, 0xad, 0x92, 0x1f, 0xf2, 0x3f, 0x4d,
+	0x97, 0xe5, 0xcf, 0x73, 0xef, 0xf2, 0x6f, 0x0b, 0xf0, 0xe2, 0x07, 0x4d, 0x17, 0x33, 0x92, 0x95,
+	0x84, 0xfa, 0x81, 0xb6, 0x33, 0x04, 0xfa, 0xf9, 0x21, 0x9f, 0x2e, 0xeb, 0x0e, 0x9f, 0x47, 0x0d,
+	0xf4, 0x12, 0x5c, 0xeb, 0x61, 0x22, 0x05, 0x8f, 0xbf, 0x2b, 0xc0, 0x35, 0x9b, 0xec, 0x85, 0x84,
+	0x1e, 0xfc, 0xcf, 0x9a, 0x59, 0xd6, 0x5c, 0x85, 0xeb, 0xbd, 0x6c, 0xa4, 0xcc, 0xf9, 0x97, 0x02,
+	0x58, 0x0f, 0x82, 0xa3, 0x96, 0xd9, 0xb7, 0xe4, 0xe7, 0xf6, 0x6f, 0x8e, 0x0d, 0xdf, 0x87, 0x0b,
+	0x2e, 0xa1, 0xcc, 0xf3, 0x71, 0xc7, 0xf7, 0xf3, 0xbe, 0xa0, 0x7b, 0x2e, 0xc1, 0x1b, 0x4f, 0xa2,
+	0x65, 0x28, 0x36, 0x3c, 0x25, 0xaa, 0xf5, 0x86, 0x33, 0xd1, 0xf0, 0x04, 0x45, 0xd5, 0x15, 0xeb,
+	0xf8, 0x69, 0x6b, 0x7d, 0x54, 0xad, 0xe3, 0xa7, 0x72, 0xbd, 0xfc, 0x36, 0x2c, 0x69, 0x4d, 0xaa,
+	0x40, 0xe1, 0x0a, 0x14, 0x1b, 0xc1, 0x11, 0x6f, 0xae, 0x31, 0x3d, 0xa4, 0xea, 0xb7, 0x3c, 0x20,
+	0xa6, 0x38, 0x0b, 0x2d, 0x7f, 0x69, 0xc0, 0xd5, 0x6d, 0xc2, 0xcb, 0xe2, 0x0e, 0x11, 0x62, 0xf9,
+	0x9b, 0x73, 0x35, 0x8b, 0x30, 0xae, 0x6c, 0x24, 0x7f, 0x88, 0x36, 0x64, 0x8f, 0xc9, 0x4e, 0x80,
+	0x96, 0xdf, 0x83, 0x72, 0xde, 0x09, 0x95, 0xa5, 0x56, 0x61, 0xc6, 0x15, 0x54, 0xae, 0xd3, 0x12,
+	0x64, 0x08, 0x41, 0x25, 0x35, 0x2f, 0x2d, 0x4e, 0x37, 0x3e, 0x9b, 0x86, 0xe2, 0x03, 0x95, 0x96,
+	0x37, 0x1f, 0x55, 0xd1, 0x4f, 0x0d, 0x98, 0xd3, 0x7c, 0xff, 0x47, 0xaf, 0x0f, 0xf8, 0x73, 0x01,
+	0x61, 0x6a, 0xeb, 0xc6, 0xa9, 0x7e, 0x64, 0x90, 0x54, 0x22, 0x89, 0x3d, 0x7d, 0x28, 0xa1, 0x79,
+	0xc3, 0xb2, 0x6e, 0x0c, 0xc8, 0xa5, 0x94, 0x38, 0x82, 0xe9, 0x8e, 0xe7, 0x5f, 0xf4, 0xea, 0xa0,
+	0xaf, 0xd5, 0xd6, 0xfa, 0x00, 0x1c, 0xa9, 0x7d, 0x53, 0xe7, 0x7e, 0x75, 0xd0, 0x77, 0x3b, 0x6b,
+	0x7d, 0x00, 0x0e, 0xb5, 0x6f, 0x13, 0xa6, 0x52, 0x4f, 0x09, 0xa8, 0x92, 0x2d, 0x43, 0xf7, 0x2a,
+	0x62, 0xad, 0xf5, 0x4d, 0xaf, 0x76, 0xfc, 0xcc, 0x80, 0xc5, 0xcc, 0xbe, 0x16, 0xdd, 0xca, 0x16,
+	0xd7, 0xab, 0x57, 0xb7, 0xde, 0x3a, 0x15, 0xaf, 0x52, 0xeb, 0x97, 0x06, 0x5c, 0xd0, 0x76, 0x9a,
+	0xe8, 0x8d, 0x6c, 0xb1, 0x79, 0x9d, 0xb7, 0xf5, 0xed, 0x81, 0xf9, 0x94, 0x2a, 0x27, 0x30, 0xd3,
+	0x59, 0x27, 0xa1, 0xf5, 0x41, 0x6a, 0x2a, 0xb9, 0xff, 0x29, 0xca, 0x30, 0xf4, 0x2b, 0x03, 0x16,
+	0xf4, 0x2d, 0x0e, 0xca, 0x39, 0x4e, 0x6e, 0x2b, 0x66, 0xdd, 0x1c, 0x9c, 0x51, 0x69, 0xf3, 0x73,
+	0x03, 0xe6, 0x75, 0x05, 0x35, 0xba, 0x31, 0x68, 0x01, 0x2e, 0x35, 0x79, 0xe3, 0x74, 0x75, 0x3b,
+	0xfa, 0x8d, 0x01, 0x97, 0x73, 0xcb, 0x2d, 0xf4, 0x76, 0xb6, 0xe4, 0x7e, 0x4a, 0x59, 0xeb, 0x9d,
+	0x53, 0xf3, 0x2b, 0x15, 0x7f, 0x6f, 0xc0, 0x72, 0x7e, 0x0d, 0x83, 0xde, 0xc9, 0x0b, 0x8f, 0x3e,
+	0x2a, 0x44, 0xeb, 0x7b, 0xa7, 0x17, 0x90, 0x48, 0xf1, 0x1a, 0xac, 0xcf, 0x4b, 0xf1, 0xd9, 0xd5,
+	0x96, 0x75, 0x63, 0x40, 0x2e, 0xa5, 0xc4, 0xaf, 0x0d, 0xb0, 0xb2, 0xd1, 0x14, 0xbd, 0x95, 0x17,
+	0x36, 0x3d, 0xaa, 0x0c, 0xeb, 0x3b, 0xa7, 0x63, 0x96, 0x9a, 0x6d, 0xdd, 0xfb, 0xe3, 0x57, 0xcb,
+	0xc6, 0x9f, 0xbf, 0x5a, 0x36, 0xbe, 0xfc, 0x6a, 0xd9, 0xf8, 0xc1, 0x9b, 0xfb, 0x1e, 0x3b, 0x88,
+	0x76, 0x2b, 0xb5, 0xa0, 0xb1, 0x96, 0xfa, 0xc5, 0x7e, 0x65, 0x9f, 0xf8, 0xf2, 0x5f, 0x1c, 0x92,
+	0xff, 0x65, 0xf1, 0x56, 0xfc, 0xf7, 0xd1, 0xfa, 0xee, 0xa8, 0x58, 0x7d, 0xed, 0x3f, 0x03, 0x00,
+	0x6d, 0x22, 0xe8, 0x7d, 0x93, 0x31, 0x00, 0x00,
 }
 
 func (m *TaskListPartition) Marshal() (dAtA []byte, err error) {
@@ -2551,6 +2865,32 @@ func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RecommendedPollerCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.RecommendedPollerCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ContinueAsNewSuggested {
+		i--
+		if m.ContinueAsNewSuggested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.HistoryCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistoryCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	if m.AutoConfigHint != nil {
 		{
 			size, err := m.AutoConfigHint.MarshalToSizedBuffer(dAtA[:i])
@@ -2869,6 +3209,13 @@ func (m *PollForActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RecommendedPollerCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.RecommendedPollerCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.AutoConfigHint != nil {
 		{
 			size, err := m.AutoConfigHint.MarshalToSizedBuffer(dAtA[:i])
@@ -3869,6 +4216,11 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RecommendedPollerCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.RecommendedPollerCount))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.TaskList != nil {
 		{
 			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
@@ -4316,34 +4668,255 @@ func (m *RefreshTaskListPartitionConfigResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MoveTaskListBacklogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TaskListPartition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MoveTaskListBacklogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTaskListBacklogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IsolationGroups) > 0 {
-		for _, s := range m.IsolationGroups {
-			l = len(s)
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
+	if m.MaxTaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxTaskId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinTaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MinTaskId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DestinationTaskList != nil {
+		{
+			size, err := m.DestinationTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveTaskListBacklogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveTaskListBacklogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTaskListBacklogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MovedTasks != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MovedTasks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskIds) > 0 {
+		dAtA68 := make([]byte, len(m.TaskIds)*10)
+		var j67 int
+		for _, num1 := range m.TaskIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA68[j67] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j67++
+			}
+			dAtA68[j67] = uint8(num)
+			j67++
+		}
+		i -= j67
+		copy(dAtA[i:], dAtA68[:j67])
+		i = encodeVarintService(dAtA, i, uint64(j67))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteTaskListBacklogTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTaskListBacklogTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteTaskListBacklogTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeletedTaskIds) > 0 {
+		dAtA71 := make([]byte, len(m.DeletedTaskIds)*10)
+		var j70 int
+		for _, num1 := range m.DeletedTaskIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA71[j70] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j70++
+			}
+			dAtA71[j70] = uint8(num)
+			j70++
+		}
+		i -= j70
+		copy(dAtA[i:], dAtA71[:j70])
+		i = encodeVarintService(dAtA, i, uint64(j70))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaskListPartition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IsolationGroups) > 0 {
+		for _, s := range m.IsolationGroups {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
 
 func (m *TaskListPartitionConfig) Size() (n int) {
 	if m == nil {
@@ -4534,6 +5107,15 @@ func (m *PollForDecisionTaskResponse) Size() (n int) {
 		l = m.AutoConfigHint.Size()
 		n += 2 + l + sovService(uint64(l))
 	}
+	if m.HistoryCount != 0 {
+		n += 2 + sovService(uint64(m.HistoryCount))
+	}
+	if m.ContinueAsNewSuggested {
+		n += 3
+	}
+	if m.RecommendedPollerCount != 0 {
+		n += 2 + sovService(uint64(m.RecommendedPollerCount))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4653,6 +5235,9 @@ func (m *PollForActivityTaskResponse) Size() (n int) {
 		l = m.AutoConfigHint.Size()
 		n += 2 + l + sovService(uint64(l))
 	}
+	if m.RecommendedPollerCount != 0 {
+		n += 2 + sovService(uint64(m.RecommendedPollerCount))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5005,6 +5590,9 @@ func (m *DescribeTaskListResponse) Size() (n int) {
 		l = m.TaskList.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.RecommendedPollerCount != 0 {
+		n += 1 + sovService(uint64(m.RecommendedPollerCount))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5187,6 +5775,103 @@ func (m *RefreshTaskListPartitionConfigResponse) Size() (n int) {
 	return n
 }
 
+func (m *MoveTaskListBacklogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.DestinationTaskList != nil {
+		l = m.DestinationTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.MinTaskId != 0 {
+		n += 1 + sovService(uint64(m.MinTaskId))
+	}
+	if m.MaxTaskId != 0 {
+		n += 1 + sovService(uint64(m.MaxTaskId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MoveTaskListBacklogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MovedTasks != 0 {
+		n += 1 + sovService(uint64(m.MovedTasks))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTaskListBacklogTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if len(m.TaskIds) > 0 {
+		l = 0
+		for _, e := range m.TaskIds {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTaskListBacklogTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeletedTaskIds) > 0 {
+		l = 0
+		for _, e := range m.DeletedTaskIds {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6666,6 +7351,64 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryCount", wireType)
+			}
+			m.HistoryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewSuggested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContinueAsNewSuggested = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedPollerCount", wireType)
+			}
+			m.RecommendedPollerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecommendedPollerCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -7589,6 +8332,25 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedPollerCount", wireType)
+			}
+			m.RecommendedPollerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecommendedPollerCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10006,6 +10768,25 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedPollerCount", wireType)
+			}
+			m.RecommendedPollerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecommendedPollerCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -11108,6 +11889,629 @@ func (m *RefreshTaskListPartitionConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MoveTaskListBacklogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveTaskListBacklogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveTaskListBacklogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DestinationTaskList == nil {
+				m.DestinationTaskList = &v1.TaskList{}
+			}
+			if err := m.DestinationTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTaskId", wireType)
+			}
+			m.MinTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskId", wireType)
+			}
+			m.MaxTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoveTaskListBacklogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveTaskListBacklogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveTaskListBacklogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedTasks", wireType)
+			}
+			m.MovedTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MovedTasks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTaskListBacklogTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTaskListBacklogTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTaskListBacklogTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TaskIds = append(m.TaskIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TaskIds) == 0 {
+					m.TaskIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TaskIds = append(m.TaskIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTaskListBacklogTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTaskListBacklogTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTaskListBacklogTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DeletedTaskIds = append(m.DeletedTaskIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DeletedTaskIds) == 0 {
+					m.DeletedTaskIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DeletedTaskIds = append(m.DeletedTaskIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedTaskIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34,6 +34,8 @@ type MatchingAPIYARPCClient interface {
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest, ...yarpc.CallOption) (*GetTaskListsByDomainResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *UpdateTaskListPartitionConfigRequest, ...yarpc.CallOption) (*UpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *RefreshTaskListPartitionConfigRequest, ...yarpc.CallOption) (*RefreshTaskListPartitionConfigResponse, error)
+	MoveTaskListBacklog(context.Context, *MoveTaskListBacklogRequest, ...yarpc.CallOption) (*MoveTaskListBacklogResponse, error)
+	DeleteTaskListBacklogTasks(context.Context, *DeleteTaskListBacklogTasksRequest, ...yarpc.CallOption) (*DeleteTaskListBacklogTasksResponse, error)
 }
 
 func newMatchingAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) MatchingAPIYARPCClient {
@@ -66,6 +68,8 @@ type MatchingAPIYARPCServer interface {
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest) (*GetTaskListsByDomainResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *UpdateTaskListPartitionConfigRequest) (*UpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *RefreshTaskListPartitionConfigRequest) (*RefreshTaskListPartitionConfigResponse, error)
+	MoveTaskListBacklog(context.Context, *MoveTaskListBacklogRequest) (*MoveTaskListBacklogResponse, error)
+	DeleteTaskListBacklogTasks(context.Context, *DeleteTaskListBacklogTasksRequest) (*DeleteTaskListBacklogTasksResponse, error)
 }
 
 type buildMatchingAPIYARPCProceduresParams struct {
@@ -199,6 +203,26 @@ func buildMatchingAPIYARPCProcedures(params buildMatchingAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "MoveTaskListBacklog",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MoveTaskListBacklog,
+							NewRequest:  newMatchingAPIServiceMoveTaskListBacklogYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DeleteTaskListBacklogTasks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DeleteTaskListBacklogTasks,
+							NewRequest:  newMatchingAPIServiceDeleteTaskListBacklogTasksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -461,6 +485,30 @@ func (c *_MatchingAPIYARPCCaller) RefreshTaskListPartitionConfig(ctx context.Con
 	return response, err
 }
 
+func (c *_MatchingAPIYARPCCaller) MoveTaskListBacklog(ctx context.Context, request *MoveTaskListBacklogRequest, options ...yarpc.CallOption) (*MoveTaskListBacklogResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MoveTaskListBacklog", request, newMatchingAPIServiceMoveTaskListBacklogYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MoveTaskListBacklogResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceMoveTaskListBacklogYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) DeleteTaskListBacklogTasks(ctx context.Context, request *DeleteTaskListBacklogTasksRequest, options ...yarpc.CallOption) (*DeleteTaskListBacklogTasksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeleteTaskListBacklogTasks", request, newMatchingAPIServiceDeleteTaskListBacklogTasksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeleteTaskListBacklogTasksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceDeleteTaskListBacklogTasksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _MatchingAPIYARPCHandler struct {
 	server MatchingAPIYARPCServer
 }
@@ -657,6 +705,38 @@ func (h *_MatchingAPIYARPCHandler) RefreshTaskListPartitionConfig(ctx context.Co
 	return response, err
 }
 
+func (h *_MatchingAPIYARPCHandler) MoveTaskListBacklog(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MoveTaskListBacklogRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MoveTaskListBacklogRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceMoveTaskListBacklogYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MoveTaskListBacklog(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) DeleteTaskListBacklogTasks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeleteTaskListBacklogTasksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeleteTaskListBacklogTasksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceDeleteTaskListBacklogTasksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeleteTaskListBacklogTasks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newMatchingAPIServicePollForDecisionTaskYARPCRequest() proto.Message {
 	return &PollForDecisionTaskRequest{}
 }
@@ -753,6 +833,22 @@ func newMatchingAPIServiceRefreshTaskListPartitionConfigYARPCResponse() proto.Me
 	return &RefreshTaskListPartitionConfigResponse{}
 }
 
+func newMatchingAPIServiceMoveTaskListBacklogYARPCRequest() proto.Message {
+	return &MoveTaskListBacklogRequest{}
+}
+
+func newMatchingAPIServiceMoveTaskListBacklogYARPCResponse() proto.Message {
+	return &MoveTaskListBacklogResponse{}
+}
+
+func newMatchingAPIServiceDeleteTaskListBacklogTasksYARPCRequest() proto.Message {
+	return &DeleteTaskListBacklogTasksRequest{}
+}
+
+func newMatchingAPIServiceDeleteTaskListBacklogTasksYARPCResponse() proto.Message {
+	return &DeleteTaskListBacklogTasksResponse{}
+}
+
 var (
 	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest             = &PollForDecisionTaskRequest{}
 	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse            = &PollForDecisionTaskResponse{}
@@ -778,169 +874,189 @@ var (
 	emptyMatchingAPIServiceUpdateTaskListPartitionConfigYARPCResponse  = &UpdateTaskListPartitionConfigResponse{}
 	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCRequest  = &RefreshTaskListPartitionConfigRequest{}
 	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCResponse = &RefreshTaskListPartitionConfigResponse{}
+	emptyMatchingAPIServiceMoveTaskListBacklogYARPCRequest             = &MoveTaskListBacklogRequest{}
+	emptyMatchingAPIServiceMoveTaskListBacklogYARPCResponse            = &MoveTaskListBacklogResponse{}
+	emptyMatchingAPIServiceDeleteTaskListBacklogTasksYARPCRequest      = &DeleteTaskListBacklogTasksRequest{}
+	emptyMatchingAPIServiceDeleteTaskListBacklogTasksYARPCResponse     = &DeleteTaskListBacklogTasksResponse{}
 )
 
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x49, 0x73, 0xdc, 0xc6,
-		0xf5, 0x2f, 0x0c, 0x39, 0x5c, 0xde, 0x90, 0x43, 0xb2, 0x49, 0x53, 0xd0, 0x48, 0x94, 0xa8, 0x91,
-		0x25, 0xd3, 0xff, 0xbf, 0x33, 0x34, 0x69, 0x49, 0x91, 0xa5, 0x8a, 0x15, 0x2e, 0xa2, 0x34, 0x29,
-		0x2b, 0x92, 0x21, 0x5a, 0xaa, 0x4a, 0x5c, 0x42, 0x9a, 0x83, 0x26, 0x07, 0x21, 0x06, 0x80, 0x80,
-		0x06, 0x69, 0xfa, 0x90, 0x43, 0x2a, 0x49, 0xa5, 0x2a, 0xd7, 0xe4, 0x9e, 0xed, 0x4b, 0xe4, 0x92,
-		0xcf, 0x91, 0x2a, 0x57, 0x0e, 0x39, 0xe4, 0x03, 0x24, 0x55, 0xb9, 0xe5, 0x90, 0xea, 0x05, 0x33,
-		0x00, 0xa6, 0x31, 0x0b, 0x49, 0xc9, 0x39, 0xe4, 0x46, 0x74, 0xbf, 0xad, 0xdf, 0xf6, 0x7b, 0xdd,
-		0x43, 0xb8, 0x19, 0xed, 0x91, 0x60, 0xb5, 0x81, 0x2d, 0xe2, 0x36, 0xc8, 0x6a, 0x0b, 0xd3, 0x46,
-		0xd3, 0x76, 0x0f, 0x56, 0x8f, 0xd6, 0x56, 0x43, 0x12, 0x1c, 0xd9, 0x0d, 0x52, 0xf3, 0x03, 0x8f,
-		0x7a, 0x48, 0x67, 0x74, 0x35, 0x49, 0x57, 0x8b, 0xe9, 0x6a, 0x47, 0x6b, 0x95, 0x2b, 0x07, 0x9e,
-		0x77, 0xe0, 0x90, 0x55, 0x4e, 0xb7, 0x17, 0xed, 0xaf, 0x5a, 0x51, 0x80, 0xa9, 0xed, 0xb9, 0x82,
-		0xb3, 0x72, 0x35, 0xbb, 0x4f, 0xed, 0x16, 0x09, 0x29, 0x6e, 0xf9, 0x92, 0xa0, 0x4b, 0xc0, 0x71,
-		0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x4e, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0xd7, 0xf0, 0x5a,
-		0xad, 0x8e, 0x0a, 0x15, 0xc5, 0xeb, 0x88, 0x04, 0x27, 0x92, 0xa0, 0xaa, 0x22, 0xa0, 0x38, 0x3c,
-		0x74, 0xec, 0x90, 0x4a, 0x9a, 0x15, 0x15, 0x8d, 0x74, 0x82, 0x79, 0xec, 0x05, 0x87, 0x24, 0x90,
-		0x94, 0xff, 0xd7, 0x8f, 0x72, 0xdf, 0xf1, 0x8e, 0x25, 0xed, 0x35, 0x15, 0x6d, 0xd3, 0x0e, 0xa9,
-		0xd7, 0x36, 0xee, 0xdd, 0x14, 0x49, 0xd8, 0xc4, 0x01, 0xb1, 0xba, 0xa9, 0x6e, 0xe4, 0x50, 0xa5,
-		0x4f, 0x51, 0xfd, 0x04, 0xe6, 0x76, 0x71, 0x78, 0xf8, 0xa9, 0x1d, 0xd2, 0x67, 0x38, 0xa0, 0x36,
-		0x0b, 0x04, 0x7a, 0x1f, 0x66, 0xed, 0xd0, 0x73, 0x78, 0x54, 0xcc, 0x83, 0xc0, 0x8b, 0xfc, 0x50,
-		0xd7, 0x96, 0x47, 0x56, 0x26, 0x8d, 0x99, 0xf6, 0xfa, 0x23, 0xbe, 0x5c, 0xfd, 0xdb, 0x28, 0x5c,
-		0xe8, 0x12, 0xb0, 0xe5, 0xb9, 0xfb, 0xf6, 0x01, 0xd2, 0x61, 0xfc, 0x88, 0x04, 0xa1, 0xed, 0xb9,
-		0xba, 0xb6, 0xac, 0xad, 0x8c, 0x18, 0xf1, 0x27, 0x5a, 0x87, 0x79, 0x37, 0x6a, 0x99, 0x01, 0xc1,
-		0x96, 0xe9, 0xc7, 0x5c, 0xa1, 0x5e, 0x58, 0xd6, 0x56, 0x8a, 0x9b, 0x05, 0x5d, 0x33, 0xe6, 0xdc,
-		0xa8, 0x65, 0x10, 0x6c, 0xb5, 0x45, 0x86, 0xe8, 0x16, 0x2c, 0x30, 0x9e, 0xe3, 0xc0, 0xa6, 0x24,
-		0xc9, 0x34, 0xd2, 0x66, 0x42, 0x6e, 0xd4, 0x7a, 0xc9, 0xb6, 0x13, 0x5c, 0x2e, 0xcc, 0x64, 0xb5,
-		0x8c, 0x2e, 0x8f, 0xac, 0x94, 0xd6, 0x1f, 0xd6, 0xf2, 0x32, 0xb4, 0x96, 0x73, 0x9e, 0x5a, 0xda,
-		0xa0, 0x87, 0x2e, 0x0d, 0x4e, 0x8c, 0x72, 0x90, 0xb6, 0xf2, 0x35, 0xcc, 0x76, 0x59, 0x58, 0xe4,
-		0x0a, 0x77, 0x86, 0x57, 0x98, 0x39, 0x8c, 0xd0, 0x38, 0x73, 0x9c, 0x5e, 0xad, 0xb8, 0x30, 0xaf,
-		0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x21, 0x39, 0xe1, 0x9e, 0x2f, 0x1a, 0xec, 0x4f, 0xb4, 0x01, 0xc5,
-		0x23, 0xec, 0x44, 0x84, 0xfb, 0xb9, 0xb4, 0xfe, 0xff, 0x43, 0x18, 0x64, 0x08, 0xce, 0x7b, 0x85,
-		0xbb, 0x5a, 0xc5, 0x83, 0x05, 0x95, 0x61, 0x6f, 0x4c, 0x61, 0xf5, 0x47, 0x30, 0xf7, 0xa9, 0x87,
-		0xad, 0x4d, 0xec, 0x60, 0xb7, 0x41, 0x82, 0xc7, 0xb6, 0x4b, 0x43, 0x74, 0x1d, 0xa6, 0xf7, 0x70,
-		0xe3, 0xd0, 0xf1, 0x0e, 0xcc, 0x86, 0x17, 0xb9, 0x54, 0xa6, 0xd8, 0x94, 0x5c, 0xdc, 0x62, 0x6b,
-		0xe8, 0x26, 0xcc, 0x04, 0x98, 0x05, 0x83, 0x04, 0x66, 0x48, 0x1a, 0x9e, 0x6b, 0x71, 0x53, 0x34,
-		0x63, 0x9a, 0x2d, 0x3f, 0x23, 0xc1, 0x73, 0xbe, 0x58, 0xfd, 0x87, 0x06, 0x95, 0x67, 0x9e, 0xe3,
-		0xec, 0x78, 0xc1, 0x36, 0x69, 0xd8, 0x2c, 0x47, 0x99, 0x45, 0x06, 0x79, 0x1d, 0x91, 0x90, 0xa2,
-		0x3a, 0x8c, 0x07, 0xe2, 0x4f, 0xae, 0xa5, 0xb4, 0xbe, 0x9a, 0x3e, 0x09, 0xf6, 0x6d, 0x76, 0x88,
-		0x7c, 0x09, 0x46, 0xcc, 0x8f, 0x2e, 0xc1, 0xa4, 0xe5, 0xb5, 0xb0, 0xed, 0x9a, 0xb6, 0xb0, 0x65,
-		0xd2, 0x98, 0x10, 0x0b, 0x75, 0x8b, 0x6d, 0xfa, 0x9e, 0xe3, 0x90, 0x80, 0x6d, 0x8e, 0x88, 0x4d,
-		0xb1, 0x50, 0xb7, 0xd0, 0x0d, 0x28, 0xef, 0x7b, 0xc1, 0x31, 0x0e, 0x2c, 0x62, 0x99, 0xfb, 0x81,
-		0xd7, 0xd2, 0x47, 0x39, 0xc5, 0x74, 0x7b, 0x75, 0x27, 0xf0, 0x5a, 0xe8, 0x3d, 0x98, 0xc9, 0xd4,
-		0xae, 0x5e, 0xe4, 0x74, 0xe5, 0x74, 0xe9, 0x56, 0xff, 0x5c, 0x82, 0x4b, 0x4a, 0x8b, 0x43, 0xdf,
-		0x73, 0x43, 0x82, 0x96, 0x00, 0x58, 0xaf, 0x30, 0xa9, 0x77, 0x48, 0x44, 0x01, 0x4f, 0x19, 0x93,
-		0x6c, 0x65, 0x97, 0x2d, 0xa0, 0xcf, 0x01, 0xc5, 0xad, 0xcb, 0x24, 0x5f, 0x92, 0x46, 0xc4, 0x24,
-		0xcb, 0x40, 0xdf, 0x54, 0xba, 0xe7, 0xa5, 0x24, 0x7f, 0x18, 0x53, 0x1b, 0x73, 0xc7, 0xd9, 0x25,
-		0xb4, 0x03, 0xd3, 0x6d, 0xb1, 0xf4, 0xc4, 0x27, 0xdc, 0x0d, 0xa5, 0xf5, 0x6b, 0x3d, 0x25, 0xee,
-		0x9e, 0xf8, 0xc4, 0x98, 0x3a, 0x4e, 0x7c, 0xa1, 0x17, 0x70, 0xd1, 0x0f, 0xc8, 0x91, 0xed, 0x45,
-		0xa1, 0x19, 0x52, 0x1c, 0x50, 0x62, 0x99, 0xe4, 0x88, 0xb8, 0x94, 0xb9, 0x76, 0x94, 0xcb, 0xbc,
-		0x54, 0x13, 0x40, 0x52, 0x8b, 0x81, 0xa4, 0x56, 0x77, 0xe9, 0x9d, 0x5b, 0x2f, 0x58, 0xde, 0x19,
-		0x8b, 0x31, 0xf7, 0x73, 0xc1, 0xfc, 0x90, 0xf1, 0xd6, 0x2d, 0xb4, 0x02, 0xb3, 0x5d, 0xe2, 0x8a,
-		0x3c, 0xf3, 0xca, 0x61, 0x9a, 0x52, 0x87, 0x71, 0x4c, 0x29, 0x69, 0xf9, 0x54, 0x1f, 0xe3, 0x25,
-		0x11, 0x7f, 0xa2, 0x2a, 0x4c, 0xbb, 0xe4, 0x4b, 0xda, 0x11, 0x30, 0xce, 0x05, 0x94, 0xd8, 0x62,
-		0xcc, 0xfd, 0x01, 0xa0, 0x54, 0x7a, 0x9b, 0x4d, 0xdb, 0xa5, 0xfa, 0x04, 0x27, 0x9c, 0x4d, 0xe6,
-		0x38, 0xab, 0x06, 0x74, 0x17, 0xf4, 0x90, 0xda, 0x8d, 0xc3, 0x93, 0x4e, 0x28, 0x4c, 0xe2, 0xe2,
-		0x3d, 0x87, 0x58, 0xfa, 0xe4, 0xb2, 0xb6, 0x32, 0x61, 0x2c, 0x8a, 0xfd, 0xb6, 0xa3, 0x1f, 0x8a,
-		0x5d, 0x74, 0x17, 0x8a, 0x1c, 0xf8, 0x74, 0xe0, 0x3e, 0xa9, 0xf6, 0xf4, 0xf3, 0x67, 0x8c, 0xd2,
-		0x10, 0x0c, 0xc8, 0x80, 0x69, 0x4b, 0xe6, 0x8d, 0x69, 0xbb, 0xfb, 0x9e, 0x5e, 0xe2, 0x12, 0xbe,
-		0x95, 0x96, 0x20, 0x80, 0x87, 0x97, 0x78, 0x80, 0xdd, 0xd0, 0x26, 0x2e, 0x8d, 0xb3, 0xad, 0xee,
-		0xee, 0x7b, 0xc6, 0x94, 0x95, 0xf8, 0x42, 0xaf, 0xe0, 0x72, 0x77, 0x52, 0x99, 0x3c, 0x0d, 0x19,
-		0x66, 0xe9, 0x53, 0x5c, 0xc5, 0x92, 0xd2, 0xc8, 0xb8, 0x85, 0x18, 0x17, 0xbb, 0xb2, 0x2a, 0xde,
-		0x42, 0x35, 0x98, 0x17, 0x4e, 0x67, 0x48, 0x49, 0xcc, 0x18, 0x9d, 0xa6, 0x79, 0x7c, 0xe6, 0xf8,
-		0xd6, 0x73, 0xb6, 0xf3, 0x42, 0xe2, 0xd4, 0x35, 0x98, 0xda, 0x0b, 0xb0, 0xdb, 0x68, 0xca, 0x2a,
-		0x28, 0xf3, 0x2a, 0x28, 0x89, 0x35, 0x51, 0x07, 0x1b, 0x50, 0x0e, 0x1b, 0x4d, 0x62, 0x45, 0x0e,
-		0xb1, 0x4c, 0x36, 0xaa, 0xe8, 0x33, 0xdc, 0xc8, 0x4a, 0x57, 0x76, 0xed, 0xc6, 0x73, 0x8c, 0x31,
-		0xdd, 0xe6, 0x60, 0x6b, 0xe8, 0x3b, 0x30, 0x15, 0xe7, 0x14, 0x17, 0x30, 0xdb, 0x57, 0x40, 0x49,
-		0xd2, 0x73, 0xf6, 0x2f, 0x60, 0x9c, 0x45, 0xc4, 0x26, 0xa1, 0x3e, 0xc7, 0x91, 0x66, 0x33, 0xbf,
-		0xcf, 0xf6, 0x28, 0xf8, 0xda, 0x67, 0x42, 0x88, 0x40, 0x99, 0x58, 0x24, 0x73, 0x19, 0xf5, 0x28,
-		0x76, 0x4c, 0x39, 0x5e, 0x98, 0x7b, 0x27, 0x94, 0x84, 0x3a, 0xe2, 0x99, 0x38, 0xc7, 0xb7, 0x1e,
-		0x8b, 0x9d, 0x4d, 0xb6, 0x81, 0xbe, 0x80, 0xd9, 0x36, 0xf4, 0x99, 0x0d, 0x8e, 0x63, 0xfa, 0x3c,
-		0x3f, 0xd0, 0xda, 0xd0, 0x00, 0x68, 0xcc, 0xf8, 0x99, 0x91, 0xe2, 0x87, 0x30, 0xef, 0x78, 0xd8,
-		0x32, 0xf7, 0x24, 0x16, 0xf0, 0xb2, 0x08, 0xf5, 0x85, 0x7e, 0xf8, 0xd2, 0x85, 0x1f, 0xc6, 0x9c,
-		0xd3, 0x05, 0x29, 0x4f, 0x60, 0x16, 0x47, 0xd4, 0x93, 0x56, 0x8b, 0x8a, 0x7b, 0x87, 0x4b, 0xbe,
-		0xae, 0xcc, 0xb8, 0x8d, 0x88, 0x7a, 0xc2, 0x2e, 0xc6, 0x6f, 0x94, 0x71, 0xea, 0xbb, 0xf2, 0x0a,
-		0xa6, 0x92, 0x2e, 0x4d, 0xe2, 0xe3, 0xa4, 0xc0, 0xc7, 0xbb, 0x69, 0x7c, 0x1c, 0xa8, 0xf8, 0x3a,
-		0xb0, 0x98, 0x00, 0xad, 0x8d, 0x06, 0xb5, 0x8f, 0x6c, 0x7a, 0x72, 0x7a, 0xd0, 0x52, 0x48, 0xf8,
-		0x6f, 0x04, 0xad, 0xdf, 0x40, 0x1b, 0xb4, 0xd2, 0x16, 0x7f, 0xa3, 0xa0, 0x75, 0x15, 0x4a, 0x58,
-		0x5a, 0xd3, 0x71, 0x02, 0xc4, 0x4b, 0x75, 0x8b, 0xa1, 0x5a, 0x9b, 0x80, 0xa3, 0xda, 0x68, 0x0f,
-		0x54, 0x6b, 0x1f, 0x8c, 0xa3, 0x1a, 0x4e, 0x7c, 0xa1, 0x75, 0x28, 0xda, 0xae, 0x1f, 0x51, 0xee,
-		0x9d, 0xd2, 0xfa, 0x65, 0x75, 0x44, 0xf1, 0x09, 0xcb, 0x6d, 0x43, 0x90, 0x2a, 0x1a, 0xd4, 0xd8,
-		0x59, 0x1b, 0xd4, 0xf8, 0x70, 0x0d, 0x6a, 0x17, 0x2e, 0xc6, 0xf2, 0x4c, 0x56, 0x5e, 0x8e, 0x17,
-		0x12, 0x2e, 0xc8, 0x8b, 0x04, 0xa4, 0x95, 0xd6, 0x2f, 0x76, 0xc9, 0xda, 0x96, 0xb7, 0x42, 0x63,
-		0x31, 0xe6, 0xdd, 0xf5, 0xb6, 0x18, 0xe7, 0xae, 0x60, 0x44, 0xdf, 0x87, 0x45, 0xae, 0xa4, 0x5b,
-		0xe4, 0x64, 0x3f, 0x91, 0xf3, 0x9c, 0x31, 0x23, 0x6f, 0x07, 0xe6, 0x9a, 0x04, 0x07, 0x74, 0x8f,
-		0x60, 0xda, 0x16, 0x05, 0xfd, 0x44, 0xcd, 0xb6, 0x79, 0x62, 0x39, 0x09, 0xdc, 0x2f, 0xa5, 0x71,
-		0xff, 0x15, 0x5c, 0x49, 0x47, 0xc2, 0xf4, 0xf6, 0x4d, 0xda, 0xb4, 0x43, 0x33, 0x66, 0x98, 0xea,
-		0xeb, 0xd8, 0x4a, 0x2a, 0x32, 0x4f, 0xf7, 0x77, 0x9b, 0x76, 0xb8, 0x21, 0xe5, 0xd7, 0x93, 0x27,
-		0xb0, 0x08, 0xc5, 0xb6, 0x13, 0x72, 0x6c, 0xeb, 0x97, 0x29, 0x9d, 0x43, 0x6c, 0x0b, 0xae, 0xee,
-		0x31, 0xac, 0x7c, 0xba, 0x31, 0xec, 0x3d, 0x98, 0x69, 0xcb, 0x11, 0x1d, 0x83, 0xc3, 0xe3, 0xa4,
-		0x51, 0x8e, 0x97, 0xb7, 0xf9, 0x2a, 0xfa, 0x08, 0xc6, 0x9a, 0x04, 0x5b, 0x24, 0x90, 0xe8, 0x77,
-		0x49, 0xa9, 0xe9, 0x31, 0x27, 0x31, 0x24, 0x69, 0x1e, 0x1a, 0xcc, 0x9d, 0x0b, 0x1a, 0xbc, 0x59,
-		0x20, 0x53, 0x61, 0xcd, 0xc2, 0xa9, 0xb1, 0xa6, 0xfa, 0x97, 0x51, 0x58, 0xdc, 0xb0, 0x2c, 0xd5,
-		0xe5, 0x25, 0xd5, 0xbc, 0xb5, 0x4c, 0xf3, 0x7e, 0x43, 0x0d, 0xf1, 0x1e, 0x4c, 0x76, 0x86, 0xb6,
-		0x91, 0x41, 0x86, 0xb6, 0x09, 0x1a, 0xcf, 0x68, 0x57, 0xa1, 0xd4, 0xee, 0x16, 0x72, 0x56, 0x1f,
-		0x31, 0x20, 0x5e, 0xaa, 0x5b, 0xd9, 0x76, 0x22, 0x9b, 0x80, 0x2c, 0xd8, 0xe2, 0x10, 0xed, 0x84,
-		0x8f, 0xf6, 0x71, 0xd9, 0xde, 0x83, 0xb1, 0xd0, 0x8b, 0x82, 0x86, 0x68, 0x8f, 0xe5, 0x2c, 0x18,
-		0x27, 0xe6, 0x58, 0x1c, 0x1e, 0x3e, 0xe7, 0x94, 0x86, 0xe4, 0x50, 0xa0, 0xdc, 0xb8, 0x0a, 0xe5,
-		0x7c, 0x45, 0x46, 0x4d, 0xf4, 0x7b, 0x8c, 0x50, 0x47, 0xb5, 0x96, 0x49, 0x30, 0xf9, 0x34, 0x90,
-		0xc9, 0xb2, 0xca, 0x26, 0x2c, 0xa8, 0x08, 0x15, 0xa3, 0xc8, 0x42, 0x72, 0x14, 0x99, 0x4c, 0x8e,
-		0x19, 0xc7, 0x70, 0xa1, 0xcb, 0x06, 0x89, 0xb6, 0xaa, 0x12, 0xd1, 0xce, 0xab, 0x44, 0xaa, 0xff,
-		0x2c, 0xf2, 0x9c, 0x56, 0xcd, 0x36, 0xdf, 0x44, 0x4e, 0xb3, 0x9b, 0x1f, 0x0f, 0xb7, 0xd9, 0x51,
-		0x2d, 0x90, 0xbe, 0x2c, 0xd6, 0xb7, 0x63, 0x03, 0x52, 0xd9, 0x3f, 0x7a, 0xa6, 0xec, 0x2f, 0x0e,
-		0x97, 0xfd, 0x63, 0x67, 0xcf, 0xfe, 0xf1, 0x73, 0xc8, 0xfe, 0x09, 0x55, 0xf6, 0xbb, 0xa0, 0xe3,
-		0x44, 0x28, 0xb7, 0xed, 0xd0, 0x67, 0x59, 0xc1, 0xee, 0x7d, 0x12, 0xb1, 0xd7, 0x7b, 0x54, 0x41,
-		0x0e, 0xa7, 0x91, 0x2b, 0x53, 0x59, 0x6d, 0x30, 0x40, 0xb5, 0x29, 0xf2, 0xed, 0x2d, 0x56, 0xdb,
-		0xd7, 0x23, 0xa0, 0xe7, 0x1d, 0x16, 0x7d, 0x0f, 0x66, 0x3a, 0x03, 0x04, 0xbf, 0xad, 0xca, 0x72,
-		0x53, 0xe3, 0xb2, 0xbc, 0x97, 0xf1, 0x27, 0x05, 0xa3, 0x33, 0x04, 0xf2, 0xef, 0xae, 0x99, 0xae,
-		0x30, 0xdc, 0x4c, 0x97, 0x98, 0x72, 0x46, 0x86, 0x9d, 0x72, 0x46, 0xcf, 0x7f, 0xca, 0x29, 0x9e,
-		0xcf, 0x94, 0x33, 0x76, 0x6e, 0x53, 0xce, 0xb8, 0x6a, 0xca, 0x91, 0xbd, 0x54, 0x79, 0x73, 0x79,
-		0xb3, 0xbd, 0xf4, 0x6b, 0x0d, 0x16, 0xf8, 0x05, 0x32, 0x3e, 0x45, 0xdc, 0x49, 0xb7, 0xb2, 0xb7,
-		0xc4, 0xf7, 0x95, 0x87, 0x57, 0xf1, 0x0e, 0x78, 0x3f, 0x3c, 0xcb, 0x2c, 0x30, 0xd8, 0xf5, 0xb1,
-		0xfa, 0x6f, 0x0d, 0xde, 0xc9, 0x58, 0x28, 0xbd, 0xfa, 0x00, 0xa6, 0xf8, 0x6b, 0x95, 0x19, 0x90,
-		0x30, 0x72, 0xe2, 0x33, 0xf6, 0xce, 0x93, 0x12, 0xe7, 0x30, 0x38, 0x03, 0xaa, 0x43, 0x39, 0x16,
-		0xf0, 0x63, 0xd2, 0xa0, 0xc4, 0xea, 0x79, 0x57, 0x17, 0x77, 0x74, 0x49, 0x69, 0x4c, 0xbf, 0x4e,
-		0x7e, 0xa2, 0x97, 0x8a, 0x08, 0x0b, 0x7f, 0x7c, 0xd0, 0xd3, 0x1f, 0x7d, 0x83, 0xfb, 0x77, 0x0d,
-		0x96, 0xc5, 0x89, 0x2d, 0x6e, 0x00, 0x63, 0xdc, 0xf2, 0x5a, 0xbe, 0x43, 0x98, 0x15, 0x32, 0x46,
-		0x4f, 0xb3, 0x81, 0xbe, 0xad, 0x54, 0xda, 0x4f, 0xce, 0x5b, 0x08, 0xfa, 0x05, 0x18, 0xe7, 0xbc,
-		0x72, 0xf8, 0x9b, 0x34, 0xc6, 0xd8, 0x67, 0xdd, 0xaa, 0x5e, 0x87, 0x6b, 0x3d, 0xcc, 0x13, 0x11,
-		0xaf, 0xfe, 0x55, 0x83, 0xcb, 0x5b, 0x6c, 0x8c, 0x77, 0x9e, 0x46, 0x34, 0xa4, 0xd8, 0xb5, 0x6c,
-		0xf7, 0xe0, 0x99, 0xe7, 0x38, 0x03, 0xcd, 0x0e, 0xa9, 0xc7, 0x8c, 0x42, 0xe6, 0x31, 0xe3, 0x11,
-		0x94, 0xdb, 0x87, 0xea, 0x3c, 0x4e, 0x97, 0x73, 0xfa, 0x45, 0x7c, 0x32, 0xd1, 0x2f, 0x68, 0xe2,
-		0xeb, 0x2c, 0x03, 0x42, 0xf5, 0x2a, 0x2c, 0xe5, 0x1c, 0x4f, 0x3a, 0xe0, 0x27, 0x70, 0x61, 0x9b,
-		0x84, 0x8d, 0xc0, 0xde, 0x23, 0x6d, 0x76, 0x79, 0xf4, 0x9d, 0x6c, 0x0e, 0xa8, 0x13, 0x2f, 0x87,
-		0x7d, 0xb0, 0xd0, 0x57, 0xff, 0x54, 0x00, 0xbd, 0x5b, 0x82, 0xac, 0xc7, 0x8f, 0x61, 0x5c, 0xb8,
-		0x53, 0xfc, 0xa0, 0x58, 0x5a, 0xbf, 0x9a, 0xfb, 0x28, 0x45, 0x02, 0x0e, 0xf0, 0x31, 0x3d, 0xbb,
-		0x31, 0x75, 0xbc, 0x1f, 0x52, 0x4c, 0xa3, 0x50, 0xd6, 0xe2, 0xf5, 0x9e, 0xbe, 0x7b, 0xce, 0x49,
-		0x8d, 0x32, 0x4d, 0x7d, 0xbf, 0xb1, 0x6a, 0x3c, 0x53, 0x70, 0x43, 0x58, 0xe2, 0x49, 0x92, 0xd5,
-		0x15, 0xc6, 0x11, 0x5c, 0x84, 0x31, 0x09, 0x30, 0x22, 0x73, 0xe5, 0x57, 0x5a, 0x69, 0x61, 0x38,
-		0xa5, 0xbf, 0x28, 0xc0, 0x95, 0x3c, 0xad, 0x32, 0x6c, 0xaf, 0x61, 0xa9, 0xf3, 0x7e, 0xd5, 0x0e,
-		0x42, 0xe2, 0x27, 0x4e, 0x11, 0xcc, 0xda, 0x60, 0x9e, 0x7b, 0x42, 0x28, 0xb6, 0x30, 0xc5, 0x46,
-		0x25, 0x39, 0xbc, 0xa5, 0x55, 0x33, 0x95, 0xed, 0x9f, 0x17, 0x94, 0x2a, 0x0b, 0xa7, 0x53, 0x69,
-		0x25, 0x2e, 0x32, 0x69, 0x95, 0xd5, 0xdb, 0x70, 0xe9, 0x11, 0x69, 0xbb, 0x21, 0xdc, 0x3c, 0x11,
-		0xa8, 0xdd, 0xc7, 0xf7, 0xd5, 0x3f, 0x8e, 0xc2, 0x65, 0x35, 0x9f, 0xf4, 0xde, 0xcf, 0x34, 0x58,
-		0x54, 0x9c, 0xa5, 0x85, 0x7d, 0xe9, 0xb7, 0xa7, 0xf9, 0x08, 0xdf, 0x4b, 0x70, 0x6d, 0x3b, 0x73,
-		0x96, 0x27, 0xd8, 0x17, 0xa3, 0xe9, 0xbc, 0xd5, 0xbd, 0xc3, 0xcd, 0x50, 0x44, 0x91, 0x99, 0x51,
-		0x38, 0x93, 0x19, 0x1b, 0x99, 0x28, 0x76, 0xcc, 0xc0, 0xdd, 0x3b, 0x95, 0xaf, 0x58, 0x7b, 0x50,
-		0xdb, 0xad, 0x98, 0x94, 0x1f, 0xa7, 0x9f, 0xc8, 0x7b, 0x5c, 0x11, 0xf2, 0x7a, 0x4e, 0xf2, 0xa7,
-		0xeb, 0xaf, 0xd2, 0xc3, 0xf5, 0xdb, 0xd4, 0x5d, 0xfd, 0x5d, 0x01, 0xde, 0xfd, 0xdc, 0xb7, 0x30,
-		0x25, 0x79, 0xad, 0x64, 0x10, 0x80, 0x3a, 0x43, 0xa1, 0x9f, 0x1f, 0x7e, 0xa9, 0x7a, 0xe7, 0xe8,
-		0x79, 0x4c, 0x32, 0xef, 0xc1, 0x8d, 0x3e, 0x2e, 0x92, 0x20, 0xf7, 0xfb, 0x02, 0xdc, 0x30, 0xc8,
-		0x7e, 0x40, 0xc2, 0xe6, 0xff, 0xbc, 0x99, 0xe7, 0xcd, 0x15, 0xb8, 0xd9, 0xcf, 0x47, 0xc2, 0x9d,
-		0xeb, 0xff, 0x9a, 0x82, 0xd2, 0x13, 0x99, 0xcf, 0x1b, 0xcf, 0xea, 0xe8, 0xa7, 0x1a, 0xcc, 0x2b,
-		0x7e, 0x2a, 0x44, 0xb7, 0x86, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x72, 0xfb, 0x54, 0xbf, 0x47, 0x26,
-		0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96, 0x1d, 0xc1,
-		0x4c, 0xe6, 0xf5, 0x0b, 0x7d, 0x38, 0xec, 0x63, 0x5d, 0x65, 0x6d, 0x08, 0x8e, 0x94, 0xde, 0xd4,
-		0xb9, 0x3f, 0x1c, 0xf6, 0xd9, 0xa2, 0x8f, 0x5e, 0xe5, 0x79, 0x7d, 0x98, 0x4e, 0xdd, 0xa4, 0x50,
-		0x2d, 0x5f, 0x86, 0xea, 0x52, 0x58, 0x59, 0x1d, 0x98, 0x5e, 0x6a, 0xfc, 0xb5, 0x06, 0x17, 0x73,
-		0xc7, 0x7a, 0x74, 0x2f, 0x5f, 0x5c, 0xbf, 0xab, 0x4a, 0xe5, 0xfe, 0xa9, 0x78, 0xa5, 0x59, 0xbf,
-		0xd4, 0xe0, 0x1d, 0xe5, 0xa0, 0x8d, 0xee, 0xe4, 0x8b, 0xed, 0x75, 0xf1, 0xa8, 0x7c, 0x7b, 0x68,
-		0x3e, 0x69, 0xca, 0x09, 0xcc, 0x66, 0x01, 0x06, 0xad, 0x0d, 0x03, 0x46, 0x42, 0xff, 0x29, 0xf0,
-		0x0b, 0xfd, 0x4a, 0x83, 0x45, 0xf5, 0x6c, 0x88, 0x7a, 0x1c, 0xa7, 0xe7, 0x0c, 0x5b, 0xb9, 0x3b,
-		0x3c, 0xa3, 0xb4, 0xe6, 0xe7, 0x1a, 0x2c, 0xa8, 0x26, 0x11, 0x74, 0x7b, 0xd8, 0xc9, 0x45, 0x58,
-		0x72, 0xe7, 0x74, 0x03, 0x0f, 0xfa, 0xad, 0x06, 0x4b, 0x3d, 0x71, 0x0a, 0x7d, 0x92, 0x2f, 0x79,
-		0x90, 0x19, 0xa0, 0xf2, 0xe0, 0xd4, 0xfc, 0xd2, 0xc4, 0x3f, 0x68, 0x70, 0xa5, 0x77, 0xf3, 0x47,
-		0x0f, 0x7a, 0x95, 0xc7, 0x00, 0xd0, 0x5a, 0xf9, 0xee, 0xe9, 0x05, 0x08, 0x2b, 0x37, 0xef, 0xff,
-		0xe0, 0xe3, 0x03, 0x9b, 0x36, 0xa3, 0xbd, 0x5a, 0xc3, 0x6b, 0xad, 0xa6, 0xfe, 0x63, 0xb5, 0x76,
-		0x40, 0x5c, 0xf1, 0x2f, 0xbe, 0xc9, 0xff, 0x32, 0xbe, 0x1f, 0xff, 0x7d, 0xb4, 0xb6, 0x37, 0xc6,
-		0x77, 0x3f, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xe2, 0xa5, 0xb6, 0x93, 0x2c, 0x00,
-		0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xdd, 0x6f, 0x1b, 0xc7,
+		0x11, 0xc7, 0x51, 0xa2, 0x3e, 0x86, 0x12, 0x25, 0xad, 0x64, 0xf9, 0x74, 0xf2, 0x87, 0xcc, 0xc4,
+		0x8e, 0xd2, 0xa6, 0x54, 0xa4, 0xc4, 0xa9, 0x63, 0xb7, 0x49, 0x25, 0xcb, 0x8e, 0x59, 0xc4, 0xb1,
+		0x73, 0x56, 0x12, 0xa0, 0x0d, 0x72, 0x5d, 0xf1, 0x56, 0xd2, 0x55, 0xe4, 0x1d, 0x7d, 0xbb, 0x27,
+		0x59, 0x79, 0xe8, 0x43, 0xd1, 0x16, 0x05, 0xfa, 0x1a, 0xa0, 0xaf, 0xfd, 0xfa, 0x7f, 0x8a, 0xbe,
+		0x05, 0x08, 0x0a, 0xb4, 0x0f, 0xfd, 0x03, 0xda, 0xbe, 0xf6, 0xa1, 0xd8, 0x8f, 0x23, 0xef, 0xc8,
+		0xbd, 0x23, 0x29, 0xc9, 0x4e, 0x03, 0xf4, 0x4d, 0xbb, 0x3b, 0x33, 0x3b, 0x3b, 0x3b, 0x33, 0xbf,
+		0x99, 0x3d, 0x0a, 0x6e, 0x44, 0xbb, 0x24, 0x5c, 0xab, 0x63, 0x97, 0xf8, 0x75, 0xb2, 0xd6, 0xc4,
+		0xac, 0x7e, 0xe0, 0xf9, 0xfb, 0x6b, 0x47, 0xeb, 0x6b, 0x94, 0x84, 0x47, 0x5e, 0x9d, 0x54, 0x5b,
+		0x61, 0xc0, 0x02, 0x64, 0x72, 0xba, 0xaa, 0xa2, 0xab, 0xc6, 0x74, 0xd5, 0xa3, 0x75, 0xeb, 0xca,
+		0x7e, 0x10, 0xec, 0x37, 0xc8, 0x9a, 0xa0, 0xdb, 0x8d, 0xf6, 0xd6, 0xdc, 0x28, 0xc4, 0xcc, 0x0b,
+		0x7c, 0xc9, 0x69, 0x5d, 0xed, 0x5e, 0x67, 0x5e, 0x93, 0x50, 0x86, 0x9b, 0x2d, 0x45, 0xd0, 0x23,
+		0xe0, 0x38, 0xc4, 0xad, 0x16, 0x09, 0xa9, 0x5a, 0x5f, 0x49, 0xa9, 0x88, 0x5b, 0x1e, 0xd7, 0xae,
+		0x1e, 0x34, 0x9b, 0x9d, 0x2d, 0x74, 0x14, 0x4f, 0x23, 0x12, 0x9e, 0x28, 0x82, 0x8a, 0x8e, 0x80,
+		0x61, 0x7a, 0xd8, 0xf0, 0x28, 0x53, 0x34, 0xab, 0x3a, 0x1a, 0x65, 0x04, 0xe7, 0x38, 0x08, 0x0f,
+		0x49, 0xa8, 0x28, 0xbf, 0xd5, 0x8f, 0x72, 0xaf, 0x11, 0x1c, 0x2b, 0xda, 0x6b, 0x3a, 0xda, 0x03,
+		0x8f, 0xb2, 0xa0, 0xad, 0xdc, 0xcb, 0x29, 0x12, 0x7a, 0x80, 0x43, 0xe2, 0xf6, 0x52, 0x5d, 0xcf,
+		0xa0, 0x4a, 0x9f, 0xa2, 0xf2, 0x0e, 0xcc, 0xed, 0x60, 0x7a, 0xf8, 0xbe, 0x47, 0xd9, 0x63, 0x1c,
+		0x32, 0x8f, 0x5f, 0x04, 0x7a, 0x15, 0x66, 0x3d, 0x1a, 0x34, 0xc4, 0xad, 0x38, 0xfb, 0x61, 0x10,
+		0xb5, 0xa8, 0x69, 0xac, 0x8c, 0xac, 0x4e, 0xda, 0x33, 0xed, 0xf9, 0xf7, 0xc4, 0x74, 0xe5, 0xef,
+		0xa3, 0x70, 0xb1, 0x47, 0xc0, 0xdd, 0xc0, 0xdf, 0xf3, 0xf6, 0x91, 0x09, 0xe3, 0x47, 0x24, 0xa4,
+		0x5e, 0xe0, 0x9b, 0xc6, 0x8a, 0xb1, 0x3a, 0x62, 0xc7, 0x43, 0xb4, 0x01, 0xf3, 0x7e, 0xd4, 0x74,
+		0x42, 0x82, 0x5d, 0xa7, 0x15, 0x73, 0x51, 0xb3, 0xb0, 0x62, 0xac, 0x16, 0xb7, 0x0a, 0xa6, 0x61,
+		0xcf, 0xf9, 0x51, 0xd3, 0x26, 0xd8, 0x6d, 0x8b, 0xa4, 0xe8, 0x4d, 0x58, 0xe0, 0x3c, 0xc7, 0xa1,
+		0xc7, 0x48, 0x92, 0x69, 0xa4, 0xcd, 0x84, 0xfc, 0xa8, 0xf9, 0x09, 0x5f, 0x4e, 0x70, 0xf9, 0x30,
+		0xd3, 0xbd, 0xcb, 0xe8, 0xca, 0xc8, 0x6a, 0x69, 0xe3, 0x5e, 0x35, 0xcb, 0x43, 0xab, 0x19, 0xe7,
+		0xa9, 0xa6, 0x15, 0xba, 0xe7, 0xb3, 0xf0, 0xc4, 0x2e, 0x87, 0x69, 0x2d, 0x9f, 0xc2, 0x6c, 0x8f,
+		0x86, 0x45, 0xb1, 0xe1, 0xfd, 0xe1, 0x37, 0xec, 0x3a, 0x8c, 0xdc, 0x71, 0xe6, 0x38, 0x3d, 0x6b,
+		0xf9, 0x30, 0xaf, 0xd1, 0x0c, 0xcd, 0xc2, 0xc8, 0x21, 0x39, 0x11, 0x96, 0x2f, 0xda, 0xfc, 0x4f,
+		0xb4, 0x09, 0xc5, 0x23, 0xdc, 0x88, 0x88, 0xb0, 0x73, 0x69, 0xe3, 0xdb, 0x43, 0x28, 0x64, 0x4b,
+		0xce, 0xdb, 0x85, 0x5b, 0x86, 0x15, 0xc0, 0x82, 0x4e, 0xb1, 0xe7, 0xb6, 0x61, 0xe5, 0x27, 0x30,
+		0xf7, 0x7e, 0x80, 0xdd, 0x2d, 0xdc, 0xc0, 0x7e, 0x9d, 0x84, 0x0f, 0x3c, 0x9f, 0x51, 0xf4, 0x12,
+		0x4c, 0xef, 0xe2, 0xfa, 0x61, 0x23, 0xd8, 0x77, 0xea, 0x41, 0xe4, 0x33, 0xe5, 0x62, 0x53, 0x6a,
+		0xf2, 0x2e, 0x9f, 0x43, 0x37, 0x60, 0x26, 0xc4, 0xfc, 0x32, 0x48, 0xe8, 0x50, 0x52, 0x0f, 0x7c,
+		0x57, 0xa8, 0x62, 0xd8, 0xd3, 0x7c, 0xfa, 0x31, 0x09, 0x9f, 0x88, 0xc9, 0xca, 0x3f, 0x0d, 0xb0,
+		0x1e, 0x07, 0x8d, 0xc6, 0xfd, 0x20, 0xdc, 0x26, 0x75, 0x8f, 0xfb, 0x28, 0xd7, 0xc8, 0x26, 0x4f,
+		0x23, 0x42, 0x19, 0xaa, 0xc1, 0x78, 0x28, 0xff, 0x14, 0xbb, 0x94, 0x36, 0xd6, 0xd2, 0x27, 0xc1,
+		0x2d, 0x8f, 0x1f, 0x22, 0x5b, 0x82, 0x1d, 0xf3, 0xa3, 0x65, 0x98, 0x74, 0x83, 0x26, 0xf6, 0x7c,
+		0xc7, 0x93, 0xba, 0x4c, 0xda, 0x13, 0x72, 0xa2, 0xe6, 0xf2, 0xc5, 0x56, 0xd0, 0x68, 0x90, 0x90,
+		0x2f, 0x8e, 0xc8, 0x45, 0x39, 0x51, 0x73, 0xd1, 0x75, 0x28, 0xef, 0x05, 0xe1, 0x31, 0x0e, 0x5d,
+		0xe2, 0x3a, 0x7b, 0x61, 0xd0, 0x34, 0x47, 0x05, 0xc5, 0x74, 0x7b, 0xf6, 0x7e, 0x18, 0x34, 0xd1,
+		0x2b, 0x30, 0xd3, 0x15, 0xbb, 0x66, 0x51, 0xd0, 0x95, 0xd3, 0xa1, 0x5b, 0xf9, 0x72, 0x0a, 0x96,
+		0xb5, 0x1a, 0xd3, 0x56, 0xe0, 0x53, 0x82, 0x2e, 0x03, 0xf0, 0x5c, 0xe1, 0xb0, 0xe0, 0x90, 0xc8,
+		0x00, 0x9e, 0xb2, 0x27, 0xf9, 0xcc, 0x0e, 0x9f, 0x40, 0x1f, 0x01, 0x8a, 0x53, 0x97, 0x43, 0x9e,
+		0x91, 0x7a, 0xc4, 0x25, 0xab, 0x8b, 0xbe, 0xa1, 0x35, 0xcf, 0x27, 0x8a, 0xfc, 0x5e, 0x4c, 0x6d,
+		0xcf, 0x1d, 0x77, 0x4f, 0xa1, 0xfb, 0x30, 0xdd, 0x16, 0xcb, 0x4e, 0x5a, 0x44, 0x98, 0xa1, 0xb4,
+		0x71, 0x2d, 0x57, 0xe2, 0xce, 0x49, 0x8b, 0xd8, 0x53, 0xc7, 0x89, 0x11, 0xfa, 0x18, 0x96, 0x5a,
+		0x21, 0x39, 0xf2, 0x82, 0x88, 0x3a, 0x94, 0xe1, 0x90, 0x11, 0xd7, 0x21, 0x47, 0xc4, 0x67, 0xdc,
+		0xb4, 0xa3, 0x42, 0xe6, 0x72, 0x55, 0x02, 0x49, 0x35, 0x06, 0x92, 0x6a, 0xcd, 0x67, 0x6f, 0xbd,
+		0xf9, 0x31, 0xf7, 0x3b, 0x7b, 0x31, 0xe6, 0x7e, 0x22, 0x99, 0xef, 0x71, 0xde, 0x9a, 0x8b, 0x56,
+		0x61, 0xb6, 0x47, 0x5c, 0x51, 0x78, 0x5e, 0x99, 0xa6, 0x29, 0x4d, 0x18, 0xc7, 0x8c, 0x91, 0x66,
+		0x8b, 0x99, 0x63, 0x22, 0x24, 0xe2, 0x21, 0xaa, 0xc0, 0xb4, 0x4f, 0x9e, 0xb1, 0x8e, 0x80, 0x71,
+		0x21, 0xa0, 0xc4, 0x27, 0x63, 0xee, 0xd7, 0x00, 0xa5, 0xdc, 0xdb, 0x39, 0xf0, 0x7c, 0x66, 0x4e,
+		0x08, 0xc2, 0xd9, 0xa4, 0x8f, 0xf3, 0x68, 0x40, 0xb7, 0xc0, 0xa4, 0xcc, 0xab, 0x1f, 0x9e, 0x74,
+		0xae, 0xc2, 0x21, 0x3e, 0xde, 0x6d, 0x10, 0xd7, 0x9c, 0x5c, 0x31, 0x56, 0x27, 0xec, 0x45, 0xb9,
+		0xde, 0x36, 0xf4, 0x3d, 0xb9, 0x8a, 0x6e, 0x41, 0x51, 0x00, 0x9f, 0x09, 0xc2, 0x26, 0x95, 0x5c,
+		0x3b, 0x7f, 0xc8, 0x29, 0x6d, 0xc9, 0x80, 0x6c, 0x98, 0x76, 0x95, 0xdf, 0x38, 0x9e, 0xbf, 0x17,
+		0x98, 0x25, 0x21, 0xe1, 0x3b, 0x69, 0x09, 0x12, 0x78, 0x44, 0x88, 0x87, 0xd8, 0xa7, 0x1e, 0xf1,
+		0x59, 0xec, 0x6d, 0x35, 0x7f, 0x2f, 0xb0, 0xa7, 0xdc, 0xc4, 0x08, 0x7d, 0x06, 0x97, 0x7a, 0x9d,
+		0xca, 0x11, 0x6e, 0xc8, 0x31, 0xcb, 0x9c, 0x12, 0x5b, 0x5c, 0xd6, 0x2a, 0x19, 0xa7, 0x10, 0x7b,
+		0xa9, 0xc7, 0xab, 0xe2, 0x25, 0x54, 0x85, 0x79, 0x69, 0x74, 0x8e, 0x94, 0xc4, 0x89, 0xd1, 0x69,
+		0x5a, 0xdc, 0xcf, 0x9c, 0x58, 0x7a, 0xc2, 0x57, 0x3e, 0x96, 0x0b, 0xe8, 0x1a, 0x4c, 0xed, 0x86,
+		0xd8, 0xaf, 0x1f, 0xa8, 0x28, 0x28, 0x8b, 0x28, 0x28, 0xc9, 0x39, 0x19, 0x07, 0x9b, 0x50, 0xa6,
+		0xf5, 0x03, 0xe2, 0x46, 0x0d, 0xe2, 0x3a, 0xbc, 0x54, 0x31, 0x67, 0x84, 0x92, 0x56, 0x8f, 0x77,
+		0xed, 0xc4, 0x75, 0x8c, 0x3d, 0xdd, 0xe6, 0xe0, 0x73, 0xe8, 0xfb, 0x30, 0x15, 0xfb, 0x94, 0x10,
+		0x30, 0xdb, 0x57, 0x40, 0x49, 0xd1, 0x0b, 0xf6, 0x4f, 0x61, 0x9c, 0xdf, 0x88, 0x47, 0xa8, 0x39,
+		0x27, 0x90, 0x66, 0x2b, 0x3b, 0xcf, 0xe6, 0x04, 0x7c, 0xf5, 0x43, 0x29, 0x44, 0xa2, 0x4c, 0x2c,
+		0x92, 0x9b, 0x8c, 0x05, 0x0c, 0x37, 0x1c, 0x55, 0x5e, 0x38, 0xbb, 0x27, 0x8c, 0x50, 0x13, 0x09,
+		0x4f, 0x9c, 0x13, 0x4b, 0x0f, 0xe4, 0xca, 0x16, 0x5f, 0x40, 0x9f, 0xc2, 0x6c, 0x1b, 0xfa, 0x9c,
+		0xba, 0xc0, 0x31, 0x73, 0x5e, 0x1c, 0x68, 0x7d, 0x68, 0x00, 0xb4, 0x67, 0x5a, 0xe9, 0x09, 0xf4,
+		0x63, 0x98, 0x6f, 0x04, 0xd8, 0x75, 0x76, 0x15, 0x16, 0x88, 0xb0, 0xa0, 0xe6, 0x42, 0x3f, 0x7c,
+		0xe9, 0xc1, 0x0f, 0x7b, 0xae, 0xd1, 0x3d, 0x85, 0x1e, 0xc2, 0x2c, 0x8e, 0x58, 0xa0, 0xb4, 0x96,
+		0x11, 0x77, 0x41, 0x48, 0x7e, 0x49, 0xeb, 0x71, 0x9b, 0x11, 0x0b, 0xa4, 0x5e, 0x9c, 0xdf, 0x2e,
+		0xe3, 0xd4, 0x98, 0x23, 0x54, 0x6c, 0x33, 0x89, 0x50, 0x8b, 0x12, 0xa1, 0xd4, 0xa4, 0x44, 0xa8,
+		0xb7, 0x61, 0xa9, 0x1e, 0xf8, 0xcc, 0xf3, 0x23, 0xe2, 0x60, 0xea, 0xf8, 0xe4, 0xd8, 0xa1, 0xd1,
+		0xfe, 0x3e, 0xa1, 0x8c, 0xb8, 0xe6, 0x45, 0x19, 0xba, 0x31, 0xc1, 0x26, 0xfd, 0x80, 0x1c, 0x3f,
+		0x89, 0x57, 0x79, 0xd0, 0x87, 0x84, 0xd7, 0xb5, 0xc4, 0xe7, 0x90, 0xa0, 0x90, 0x43, 0x6e, 0x65,
+		0x0a, 0x8f, 0x5e, 0x4c, 0xac, 0x3f, 0x16, 0xcb, 0x62, 0x53, 0xeb, 0x33, 0x98, 0x4a, 0x5e, 0x76,
+		0x12, 0xb9, 0x27, 0x25, 0x72, 0xdf, 0x4a, 0x23, 0xf7, 0x40, 0x69, 0xa1, 0x03, 0xd8, 0x09, 0x38,
+		0xdd, 0xac, 0x33, 0xef, 0xc8, 0x63, 0x27, 0xa7, 0x87, 0x53, 0x8d, 0x84, 0xff, 0x45, 0x38, 0xfd,
+		0x33, 0xc0, 0xb2, 0x56, 0xe3, 0xaf, 0x15, 0x4e, 0xaf, 0x42, 0x09, 0x2b, 0x6d, 0x3a, 0x46, 0x80,
+		0x78, 0xaa, 0xe6, 0x72, 0xbc, 0x6d, 0x13, 0x08, 0xbc, 0x1d, 0xcd, 0xc1, 0xdb, 0xf6, 0xc1, 0x04,
+		0xde, 0xe2, 0xc4, 0x08, 0x6d, 0x40, 0xd1, 0xf3, 0x5b, 0x11, 0x13, 0xd6, 0x29, 0x6d, 0x5c, 0xd2,
+		0xdf, 0x28, 0x3e, 0xe1, 0x51, 0x67, 0x4b, 0x52, 0x4d, 0xea, 0x1c, 0x3b, 0x6b, 0xea, 0x1c, 0x1f,
+		0x2e, 0x75, 0xee, 0xc0, 0x52, 0x2c, 0xcf, 0xe1, 0x81, 0xdf, 0x08, 0x28, 0x11, 0x82, 0x82, 0x48,
+		0x82, 0x6d, 0x69, 0x63, 0xa9, 0x47, 0xd6, 0xb6, 0xea, 0x57, 0xed, 0xc5, 0x98, 0x77, 0x27, 0xb8,
+		0xcb, 0x39, 0x77, 0x24, 0x23, 0xfa, 0x00, 0x16, 0xc5, 0x26, 0xbd, 0x22, 0x27, 0xfb, 0x89, 0x9c,
+		0x17, 0x8c, 0x5d, 0xf2, 0xee, 0xc3, 0xdc, 0x01, 0xc1, 0x21, 0xdb, 0x25, 0x98, 0xb5, 0x45, 0x41,
+		0x3f, 0x51, 0xb3, 0x6d, 0x9e, 0x58, 0x4e, 0xa2, 0x22, 0x29, 0xa5, 0x2b, 0x92, 0xcf, 0xe0, 0x4a,
+		0xfa, 0x26, 0x9c, 0x60, 0xcf, 0x61, 0x07, 0x1e, 0x75, 0x62, 0x86, 0xa9, 0xbe, 0x86, 0xb5, 0x52,
+		0x37, 0xf3, 0x68, 0x6f, 0xe7, 0xc0, 0xa3, 0x9b, 0x4a, 0x7e, 0x2d, 0x79, 0x02, 0x97, 0x30, 0xec,
+		0x35, 0xa8, 0x39, 0x3d, 0x80, 0xa7, 0x74, 0x0e, 0xb1, 0x2d, 0xb9, 0x7a, 0x0b, 0xc4, 0xf2, 0xe9,
+		0x0a, 0xc4, 0x57, 0x60, 0xa6, 0x2d, 0x47, 0x66, 0x0c, 0x01, 0xdc, 0x93, 0x76, 0x39, 0x9e, 0xde,
+		0x16, 0xb3, 0xe8, 0x0d, 0x18, 0x3b, 0x20, 0xd8, 0x25, 0xa1, 0xc2, 0xe5, 0x65, 0xed, 0x4e, 0x0f,
+		0x04, 0x89, 0xad, 0x48, 0xb3, 0x70, 0x6a, 0xee, 0x5c, 0x70, 0xea, 0xf9, 0x42, 0xac, 0x0e, 0x05,
+		0x17, 0x4e, 0x8f, 0x82, 0x79, 0x28, 0x75, 0x21, 0x0f, 0xa5, 0x2a, 0x5f, 0x8e, 0xc2, 0xe2, 0xa6,
+		0xeb, 0xea, 0x1a, 0xb2, 0x54, 0xda, 0x37, 0xba, 0xd2, 0xfe, 0x73, 0x4a, 0xa5, 0xb7, 0x61, 0xb2,
+		0x53, 0x88, 0x8e, 0x0c, 0x52, 0x88, 0x4e, 0x30, 0xf5, 0x17, 0x4f, 0xc3, 0xed, 0x3c, 0xa3, 0xfa,
+		0x8f, 0x11, 0x1b, 0xe2, 0xa9, 0x9a, 0xdb, 0x9d, 0x88, 0x54, 0xfa, 0x50, 0xa1, 0x5e, 0x1c, 0x22,
+		0x11, 0x89, 0x76, 0x25, 0x0e, 0xf8, 0xdb, 0x30, 0x46, 0x83, 0x28, 0xac, 0xcb, 0xc4, 0x5a, 0xde,
+		0xa8, 0x64, 0xd6, 0xe6, 0x98, 0x1e, 0x3e, 0x11, 0x94, 0xb6, 0xe2, 0xd0, 0xe0, 0xe3, 0xb8, 0x0e,
+		0x1f, 0x5b, 0x1a, 0x5f, 0x9c, 0xe8, 0xf7, 0xc0, 0xa2, 0xbf, 0xd5, 0x6a, 0x97, 0x6b, 0xaa, 0xe7,
+		0x8e, 0x2e, 0xff, 0xb4, 0xb6, 0x60, 0x41, 0x47, 0xa8, 0x29, 0x62, 0x16, 0x92, 0x45, 0xcc, 0x64,
+		0xb2, 0x40, 0x39, 0x86, 0x8b, 0x3d, 0x3a, 0x28, 0x9c, 0xd6, 0x05, 0x97, 0x71, 0x5e, 0xc1, 0x55,
+		0xf9, 0x57, 0x51, 0xf8, 0xb4, 0xae, 0x2a, 0xfa, 0x3a, 0x7c, 0x9a, 0x77, 0xb3, 0xe2, 0xba, 0x9d,
+		0xce, 0xd6, 0xb2, 0x46, 0x28, 0xcb, 0xf9, 0xed, 0x58, 0x81, 0x94, 0xf7, 0x8f, 0x9e, 0xc9, 0xfb,
+		0x8b, 0xc3, 0x79, 0xff, 0xd8, 0xd9, 0xbd, 0x7f, 0xfc, 0x1c, 0xbc, 0x7f, 0x42, 0xe7, 0xfd, 0x3e,
+		0x98, 0x38, 0x71, 0x95, 0xdb, 0x1e, 0x6d, 0x71, 0xaf, 0xe0, 0xbd, 0xac, 0xc2, 0xfa, 0x8d, 0x9c,
+		0x28, 0xc8, 0xe0, 0xb4, 0x33, 0x65, 0x6a, 0xa3, 0x0d, 0x06, 0x88, 0x36, 0x8d, 0xbf, 0xbd, 0xc0,
+		0x68, 0xfb, 0x6a, 0x04, 0xcc, 0xac, 0xc3, 0xa2, 0x1f, 0xc2, 0x4c, 0xa7, 0xf4, 0x10, 0x1d, 0xb8,
+		0x69, 0xe4, 0x20, 0xba, 0xea, 0x35, 0xc5, 0x33, 0x89, 0xdd, 0x29, 0x1f, 0xc5, 0xb8, 0xa7, 0x1a,
+		0x2c, 0x0c, 0x57, 0x0d, 0x26, 0xea, 0xa3, 0x91, 0x61, 0xeb, 0xa3, 0xd1, 0xf3, 0xaf, 0x8f, 0x8a,
+		0xe7, 0x53, 0x1f, 0x8d, 0x9d, 0x5b, 0x7d, 0x34, 0xae, 0xab, 0x8f, 0x54, 0x2e, 0xd5, 0xf6, 0x3c,
+		0xcf, 0x37, 0x97, 0x7e, 0x65, 0xc0, 0x82, 0x68, 0x3d, 0xe3, 0x53, 0xc4, 0x99, 0xf4, 0x6e, 0x77,
+		0x7f, 0xf9, 0xaa, 0xf6, 0xf0, 0x3a, 0xde, 0x01, 0x3b, 0xcb, 0xb3, 0xd4, 0x02, 0x83, 0x35, 0x9e,
+		0x95, 0xff, 0x18, 0x70, 0xa1, 0x4b, 0x43, 0x65, 0xd5, 0x77, 0x61, 0x4a, 0xbc, 0xc0, 0x39, 0x21,
+		0xa1, 0x51, 0x23, 0x3e, 0x63, 0xbe, 0x9f, 0x94, 0x04, 0x87, 0x2d, 0x18, 0x50, 0x0d, 0xca, 0xb1,
+		0x80, 0x9f, 0x92, 0x3a, 0x23, 0xf2, 0x7c, 0x59, 0x5d, 0xbe, 0xec, 0xee, 0x15, 0xa5, 0x3d, 0xfd,
+		0x34, 0x39, 0x44, 0x9f, 0x68, 0x6e, 0x58, 0xda, 0xe3, 0xb5, 0x5c, 0x7b, 0xf4, 0xbd, 0xdc, 0x7f,
+		0x18, 0xb0, 0x22, 0x4f, 0xec, 0x0a, 0x05, 0x38, 0xe3, 0xdd, 0xa0, 0xd9, 0x6a, 0x10, 0xae, 0x85,
+		0xba, 0xa3, 0x47, 0xdd, 0x17, 0x7d, 0x53, 0xbb, 0x69, 0x3f, 0x39, 0x2f, 0xe0, 0xd2, 0x2f, 0xc2,
+		0xb8, 0xe0, 0x55, 0xc5, 0xdf, 0xa4, 0x3d, 0xc6, 0x87, 0x35, 0xb7, 0xf2, 0x12, 0x5c, 0xcb, 0x51,
+		0x4f, 0xde, 0x78, 0xe5, 0xaf, 0x06, 0x5c, 0xba, 0xcb, 0x1b, 0x80, 0xc6, 0xa3, 0x88, 0x51, 0x86,
+		0x7d, 0xd7, 0xf3, 0xf7, 0x79, 0xa9, 0x3c, 0x50, 0xed, 0x90, 0x7a, 0x06, 0x29, 0x74, 0x3d, 0x83,
+		0xbc, 0x07, 0xe5, 0xf6, 0xa1, 0x3a, 0x0f, 0xee, 0xe5, 0x8c, 0x7c, 0x11, 0x9f, 0x4c, 0xe6, 0x0b,
+		0x96, 0x18, 0x9d, 0xa5, 0x40, 0xa8, 0x5c, 0x85, 0xcb, 0x19, 0xc7, 0x53, 0x06, 0xf8, 0x19, 0x5c,
+		0xdc, 0x26, 0xb4, 0x1e, 0x7a, 0xbb, 0xa4, 0xcd, 0xae, 0x8e, 0x7e, 0xbf, 0xdb, 0x07, 0xf4, 0x8e,
+		0x97, 0xc1, 0x3e, 0xd8, 0xd5, 0x57, 0xfe, 0x5d, 0x00, 0xb3, 0x57, 0x82, 0x8a, 0xc7, 0xb7, 0x61,
+		0x5c, 0x9a, 0x53, 0x7e, 0x24, 0x2d, 0x6d, 0x5c, 0xcd, 0x7c, 0xce, 0x22, 0xa1, 0x00, 0xf8, 0x98,
+		0x9e, 0xf7, 0x5a, 0x1d, 0xeb, 0x53, 0x86, 0x59, 0x44, 0xcd, 0x42, 0x4e, 0xaf, 0x15, 0xef, 0xfd,
+		0x44, 0x90, 0xda, 0x65, 0x96, 0x1a, 0x3f, 0xb7, 0x68, 0x3c, 0x53, 0xf5, 0x97, 0xd7, 0x00, 0x16,
+		0x73, 0x1b, 0x40, 0x0a, 0x97, 0x85, 0x7b, 0x75, 0x6b, 0x49, 0xe3, 0xbb, 0x5f, 0x84, 0x31, 0x05,
+		0x4d, 0xd2, 0xe7, 0xd5, 0x28, 0xad, 0x6e, 0x61, 0x38, 0x5f, 0xfc, 0x55, 0x01, 0xae, 0x64, 0xed,
+		0xaa, 0x2e, 0xfc, 0x29, 0x5c, 0xee, 0xbc, 0x99, 0xb5, 0xaf, 0x2f, 0xf1, 0xc1, 0x57, 0xba, 0x41,
+		0x75, 0x30, 0x9b, 0x3f, 0x24, 0x0c, 0xbb, 0x98, 0x61, 0xdb, 0x4a, 0x96, 0x7d, 0xe9, 0xad, 0xf9,
+		0x96, 0xed, 0x8f, 0x2d, 0xda, 0x2d, 0x0b, 0xa7, 0xdb, 0xd2, 0x4d, 0xb4, 0x40, 0xe9, 0x2d, 0x2b,
+		0x37, 0x61, 0xf9, 0x3d, 0xd2, 0x36, 0x03, 0xdd, 0x3a, 0x91, 0x78, 0xdf, 0xc7, 0xf6, 0x95, 0x3f,
+		0x8d, 0xc2, 0x25, 0x3d, 0x9f, 0xb2, 0xde, 0x2f, 0x0c, 0x58, 0xd4, 0x9c, 0xa5, 0x89, 0x5b, 0xca,
+		0x6e, 0x8f, 0xb2, 0x6b, 0x83, 0x3c, 0xc1, 0xd5, 0xed, 0xae, 0xb3, 0x3c, 0xc4, 0x2d, 0x59, 0xd4,
+		0xce, 0xbb, 0xbd, 0x2b, 0x42, 0x0d, 0xcd, 0x2d, 0x72, 0x35, 0x0a, 0x67, 0x52, 0x63, 0xb3, 0xeb,
+		0x16, 0x3b, 0x6a, 0xe0, 0xde, 0x15, 0xeb, 0x73, 0x9e, 0x58, 0xf4, 0x7a, 0x6b, 0x6a, 0xec, 0x07,
+		0xe9, 0x67, 0xf9, 0x9c, 0xe6, 0x22, 0x2b, 0x5b, 0x25, 0x3f, 0xe4, 0x7f, 0x9e, 0x2e, 0xcb, 0x5f,
+		0xe4, 0xde, 0x95, 0xdf, 0x17, 0xe0, 0xe5, 0x8f, 0x5a, 0x2e, 0x66, 0x24, 0x2b, 0x09, 0x0d, 0x02,
+		0x6d, 0x67, 0x08, 0xf4, 0xf3, 0x43, 0x3e, 0x5d, 0xd6, 0x1d, 0x3d, 0x8f, 0x1a, 0xe8, 0x15, 0xb8,
+		0xde, 0xc7, 0x44, 0x0a, 0x1e, 0xff, 0x50, 0x80, 0xeb, 0x36, 0xd9, 0x0b, 0x09, 0x3d, 0xf8, 0xbf,
+		0x35, 0xb3, 0xac, 0xb9, 0x0a, 0x37, 0xfa, 0xd9, 0x48, 0x99, 0xf3, 0x2f, 0x05, 0xb0, 0x1e, 0x06,
+		0x47, 0x6d, 0xb3, 0x6f, 0xc9, 0xcf, 0xed, 0xdf, 0x1c, 0x1b, 0x7e, 0x08, 0x17, 0x5c, 0x42, 0x99,
+		0xe7, 0xe3, 0xae, 0xef, 0xe7, 0x03, 0x41, 0xf7, 0x7c, 0x82, 0x37, 0x9e, 0x44, 0x57, 0xa0, 0xd4,
+		0xf4, 0x94, 0xa8, 0xf6, 0x1b, 0xce, 0x64, 0xd3, 0x13, 0x14, 0x35, 0x57, 0xac, 0xe3, 0x67, 0xed,
+		0xf5, 0x31, 0xb5, 0x8e, 0x9f, 0xc9, 0xf5, 0xca, 0x3b, 0xb0, 0xac, 0x35, 0xa9, 0x02, 0x85, 0xab,
+		0x50, 0x6a, 0x06, 0x47, 0xbc, 0xb9, 0xc6, 0xf4, 0x90, 0xaa, 0xdf, 0xf2, 0x80, 0x98, 0xe2, 0x2c,
+		0xb4, 0xf2, 0x37, 0x03, 0xae, 0x6d, 0x13, 0x5e, 0x16, 0x77, 0x89, 0x10, 0xcb, 0xdf, 0x9c, 0xab,
+		0x59, 0x82, 0x09, 0x65, 0x23, 0xf9, 0x43, 0xb4, 0x11, 0x7b, 0x5c, 0x76, 0x02, 0xb4, 0xf2, 0x01,
+		0x54, 0xf2, 0x4e, 0xa8, 0x2c, 0xb5, 0x0a, 0xb3, 0xae, 0xa0, 0x72, 0x9d, 0xb6, 0x20, 0x43, 0x08,
+		0x2a, 0xab, 0x79, 0x69, 0x71, 0xba, 0xf1, 0xc5, 0x0c, 0x94, 0x1e, 0xaa, 0xb4, 0xbc, 0xf9, 0xb8,
+		0x86, 0x7e, 0x6e, 0xc0, 0xbc, 0xe6, 0xfb, 0x3f, 0x7a, 0x73, 0xc8, 0x9f, 0x0b, 0x08, 0x53, 0x5b,
+		0x37, 0x4f, 0xf5, 0x23, 0x83, 0xa4, 0x12, 0x49, 0xec, 0x19, 0x40, 0x09, 0xcd, 0x1b, 0x96, 0x75,
+		0x73, 0x48, 0x2e, 0xa5, 0xc4, 0x11, 0xcc, 0x74, 0x3d, 0xff, 0xa2, 0xd7, 0x87, 0x7d, 0xad, 0xb6,
+		0xd6, 0x87, 0xe0, 0x48, 0xed, 0x9b, 0x3a, 0xf7, 0xeb, 0xc3, 0xbe, 0xdb, 0x59, 0xeb, 0x43, 0x70,
+		0xa8, 0x7d, 0x5b, 0x30, 0x9d, 0x7a, 0x4a, 0x40, 0xd5, 0x6c, 0x19, 0xba, 0x57, 0x11, 0x6b, 0x6d,
+		0x60, 0x7a, 0xb5, 0xe3, 0x17, 0x06, 0x2c, 0x65, 0xf6, 0xb5, 0xe8, 0x76, 0xb6, 0xb8, 0x7e, 0xbd,
+		0xba, 0x75, 0xe7, 0x54, 0xbc, 0x4a, 0xad, 0x5f, 0x1b, 0x70, 0x41, 0xdb, 0x69, 0xa2, 0xb7, 0xb2,
+		0xc5, 0xe6, 0x75, 0xde, 0xd6, 0x77, 0x87, 0xe6, 0x53, 0xaa, 0x9c, 0xc0, 0x6c, 0x77, 0x9d, 0x84,
+		0xd6, 0x87, 0xa9, 0xa9, 0xe4, 0xfe, 0xa7, 0x28, 0xc3, 0xd0, 0x6f, 0x0c, 0x58, 0xd4, 0xb7, 0x38,
+		0x28, 0xe7, 0x38, 0xb9, 0xad, 0x98, 0x75, 0x6b, 0x78, 0x46, 0xa5, 0xcd, 0x2f, 0x0d, 0x58, 0xd0,
+		0x15, 0xd4, 0xe8, 0xe6, 0xb0, 0x05, 0xb8, 0xd4, 0xe4, 0xad, 0xd3, 0xd5, 0xed, 0xe8, 0x77, 0x06,
+		0x5c, 0xce, 0x2d, 0xb7, 0xd0, 0x3b, 0xd9, 0x92, 0x07, 0x29, 0x65, 0xad, 0x77, 0x4f, 0xcd, 0xaf,
+		0x54, 0xfc, 0xa3, 0x01, 0x57, 0xf2, 0x6b, 0x18, 0xf4, 0x6e, 0x5e, 0x78, 0x0c, 0x50, 0x21, 0x5a,
+		0x3f, 0x38, 0xbd, 0x80, 0x44, 0x8a, 0xd7, 0x60, 0x7d, 0x5e, 0x8a, 0xcf, 0xae, 0xb6, 0xac, 0x9b,
+		0x43, 0x72, 0x29, 0x25, 0x7e, 0x6b, 0x80, 0x95, 0x8d, 0xa6, 0xe8, 0x4e, 0x5e, 0xd8, 0xf4, 0xa9,
+		0x32, 0xac, 0xef, 0x9d, 0x8e, 0x59, 0x6a, 0xb6, 0x75, 0xe7, 0x47, 0x6f, 0xef, 0x7b, 0xec, 0x20,
+		0xda, 0xad, 0xd6, 0x83, 0xe6, 0x5a, 0xea, 0x57, 0xfa, 0xd5, 0x7d, 0xe2, 0xcb, 0x7f, 0x6b, 0x48,
+		0xfe, 0x67, 0xc5, 0x9d, 0xf8, 0xef, 0xa3, 0xf5, 0xdd, 0x31, 0xb1, 0xfa, 0xc6, 0x7f, 0x07, 0x00,
+		0x00, 0x87, 0x4c, 0x23, 0x87, 0x31, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -2028,8 +2144,8 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0xb3, 0xa4, 0x83, 0x02, 0x7f, 0x39, 0xf3, 0x44, 0x7c, 0x8b, 0xb6, 0xcb, 0x9f, 0xbc, 0x41, 0x6f,
 		0xf8, 0x6f, 0xd2, 0x9f, 0x9e, 0xfd, 0xea, 0xe6, 0xcf, 0xe7, 0xdb, 0x85, 0x7a, 0xb6, 0xf6, 0x6e,
 		0x2e, 0x5f, 0x2f, 0xe6, 0xc2, 0x96, 0x75, 0x81, 0x99, 0x5a, 0x90, 0xe0, 0xf8, 0x78, 0x0e, 0x92,
-		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x07, 0x00,
-		0x00, 0xff, 0xff, 0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
+		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x0f, 0x00,
+		0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/history.proto
 	[]byte{
@@ -2061,8 +2177,8 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x14, 0x92, 0xe2, 0x12, 0x43, 0x96, 0x70, 0x71, 0x8a, 0x77, 0x72, 0x74, 0xf6, 0xf6, 0xf1, 0x77,
 		0x17, 0x60, 0x72, 0x32, 0x8f, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
 		0xd5, 0x47, 0x71, 0xa7, 0x5e, 0x7a, 0x6a, 0x9e, 0x3e, 0xd8, 0x65, 0x08, 0x27, 0x5b, 0x43, 0x58,
-		0x65, 0x86, 0x49, 0x6c, 0x60, 0x19, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x73, 0x06,
-		0x1c, 0xdc, 0x00, 0x00, 0x00,
+		0x65, 0x86, 0x49, 0x6c, 0x60, 0x19, 0x63, 0xc0, 0x00, 0x1f, 0x73, 0x06, 0x1c, 0xdc, 0x00, 0x00,
+		0x00,
 	},
 }
 
@@ -5,9 +5,12 @@ package sharedv1
 
 import (
 	fmt "fmt"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -61,8 +64,262 @@ func (WorkflowState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7ca73ea33aecbb95, []int{0}
 }
 
+// WorkflowIdConflictPolicy decides what a start request does when a run with the same workflow ID is still open.
+type WorkflowIdConflictPolicy int32
+
+const (
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID         WorkflowIdConflictPolicy = 0
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL            WorkflowIdConflictPolicy = 1
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING    WorkflowIdConflictPolicy = 2
+	WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_SIGNAL_EXISTING WorkflowIdConflictPolicy = 3
+)
+
+var WorkflowIdConflictPolicy_name = map[int32]string{
+	0: "WORKFLOW_ID_CONFLICT_POLICY_INVALID",
+	1: "WORKFLOW_ID_CONFLICT_POLICY_FAIL",
+	2: "WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING",
+	3: "WORKFLOW_ID_CONFLICT_POLICY_SIGNAL_EXISTING",
+}
+
+var WorkflowIdConflictPolicy_value = map[string]int32{
+	"WORKFLOW_ID_CONFLICT_POLICY_INVALID":         0,
+	"WORKFLOW_ID_CONFLICT_POLICY_FAIL":            1,
+	"WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING":    2,
+	"WORKFLOW_ID_CONFLICT_POLICY_SIGNAL_EXISTING": 3,
+}
+
+func (x WorkflowIdConflictPolicy) String() string {
+	return proto.EnumName(WorkflowIdConflictPolicy_name, int32(x))
+}
+
+func (WorkflowIdConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7ca73ea33aecbb95, []int{1}
+}
+
+type CompletionCallbackState int32
+
+const (
+	CompletionCallbackState_COMPLETION_CALLBACK_STATE_INVALID   CompletionCallbackState = 0
+	CompletionCallbackState_COMPLETION_CALLBACK_STATE_SCHEDULED CompletionCallbackState = 1
+	CompletionCallbackState_COMPLETION_CALLBACK_STATE_SUCCEEDED CompletionCallbackState = 2
+	CompletionCallbackState_COMPLETION_CALLBACK_STATE_FAILED    CompletionCallbackState = 3
+)
+
+var CompletionCallbackState_name = map[int32]string{
+	0: "COMPLETION_CALLBACK_STATE_INVALID",
+	1: "COMPLETION_CALLBACK_STATE_SCHEDULED",
+	2: "COMPLETION_CALLBACK_STATE_SUCCEEDED",
+	3: "COMPLETION_CALLBACK_STATE_FAILED",
+}
+
+var CompletionCallbackState_value = map[string]int32{
+	"COMPLETION_CALLBACK_STATE_INVALID":   0,
+	"COMPLETION_CALLBACK_STATE_SCHEDULED": 1,
+	"COMPLETION_CALLBACK_STATE_SUCCEEDED": 2,
+	"COMPLETION_CALLBACK_STATE_FAILED":    3,
+}
+
+func (x CompletionCallbackState) String() string {
+	return proto.EnumName(CompletionCallbackState_name, int32(x))
+}
+
+func (CompletionCallbackState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7ca73ea33aecbb95, []int{2}
+}
+
+// CompletionCallback is an HTTP endpoint which is sent the close event of a workflow execution.
+type CompletionCallback struct {
+	Url                  string            `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Header               map[string]string `protobuf:"bytes,2,rep,name=header,proto3" json:"header,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CompletionCallback) Reset()         { *m = CompletionCallback{} }
+func (m *CompletionCallback) String() string { return proto.CompactTextString(m) }
+func (*CompletionCallback) ProtoMessage()    {}
+func (*CompletionCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ca73ea33aecbb95, []int{0}
+}
+func (m *CompletionCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletionCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletionCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletionCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionCallback.Merge(m, src)
+}
+func (m *CompletionCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletionCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionCallback proto.InternalMessageInfo
+
+func (m *CompletionCallback) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CompletionCallback) GetHeader() map[string]string {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+// CompletionCallbackInfo is a completion callback together with its delivery state.
+type CompletionCallbackInfo struct {
+	Callback             *CompletionCallback     `protobuf:"bytes,1,opt,name=callback,proto3" json:"callback,omitempty"`
+	State                CompletionCallbackState `protobuf:"varint,2,opt,name=state,proto3,enum=uber.cadence.shared.v1.CompletionCallbackState" json:"state,omitempty"`
+	Attempt              int32                   `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastAttemptTime      *types.Timestamp        `protobuf:"bytes,4,opt,name=last_attempt_time,json=lastAttemptTime,proto3" json:"last_attempt_time,omitempty"`
+	LastFailure          string                  `protobuf:"bytes,5,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CompletionCallbackInfo) Reset()         { *m = CompletionCallbackInfo{} }
+func (m *CompletionCallbackInfo) String() string { return proto.CompactTextString(m) }
+func (*CompletionCallbackInfo) ProtoMessage()    {}
+func (*CompletionCallbackInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ca73ea33aecbb95, []int{1}
+}
+func (m *CompletionCallbackInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletionCallbackInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletionCallbackInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletionCallbackInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionCallbackInfo.Merge(m, src)
+}
+func (m *CompletionCallbackInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletionCallbackInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionCallbackInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionCallbackInfo proto.InternalMessageInfo
+
+func (m *CompletionCallbackInfo) GetCallback() *CompletionCallback {
+	if m != nil {
+		return m.Callback
+	}
+	return nil
+}
+
+func (m *CompletionCallbackInfo) GetState() CompletionCallbackState {
+	if m != nil {
+		return m.State
+	}
+	return CompletionCallbackState_COMPLETION_CALLBACK_STATE_INVALID
+}
+
+func (m *CompletionCallbackInfo) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *CompletionCallbackInfo) GetLastAttemptTime() *types.Timestamp {
+	if m != nil {
+		return m.LastAttemptTime
+	}
+	return nil
+}
+
+func (m *CompletionCallbackInfo) GetLastFailure() string {
+	if m != nil {
+		return m.LastFailure
+	}
+	return ""
+}
+
+// WorkflowIdHashPolicy selects the cluster attribute of a workflow by hashing its workflow ID into the weighted
+// cluster attributes of scope.
+type WorkflowIdHashPolicy struct {
+	Scope                string   `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowIdHashPolicy) Reset()         { *m = WorkflowIdHashPolicy{} }
+func (m *WorkflowIdHashPolicy) String() string { return proto.CompactTextString(m) }
+func (*WorkflowIdHashPolicy) ProtoMessage()    {}
+func (*WorkflowIdHashPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ca73ea33aecbb95, []int{2}
+}
+func (m *WorkflowIdHashPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowIdHashPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowIdHashPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowIdHashPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowIdHashPolicy.Merge(m, src)
+}
+func (m *WorkflowIdHashPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowIdHashPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowIdHashPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowIdHashPolicy proto.InternalMessageInfo
+
+func (m *WorkflowIdHashPolicy) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("uber.cadence.shared.v1.WorkflowState", WorkflowState_name, WorkflowState_value)
+	proto.RegisterEnum("uber.cadence.shared.v1.WorkflowIdConflictPolicy", WorkflowIdConflictPolicy_name, WorkflowIdConflictPolicy_value)
+	proto.RegisterEnum("uber.cadence.shared.v1.CompletionCallbackState", CompletionCallbackState_name, CompletionCallbackState_value)
+	proto.RegisterType((*CompletionCallback)(nil), "uber.cadence.shared.v1.CompletionCallback")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.shared.v1.CompletionCallback.HeaderEntry")
+	proto.RegisterType((*CompletionCallbackInfo)(nil), "uber.cadence.shared.v1.CompletionCallbackInfo")
+	proto.RegisterType((*WorkflowIdHashPolicy)(nil), "uber.cadence.shared.v1.WorkflowIdHashPolicy")
 }
 
 func init() {
@@ -70,20 +327,859 @@ func init() {
 }
 
 var fileDescriptor_7ca73ea33aecbb95 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4d, 0x4a, 0x2d,
-	0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x2f, 0xcf, 0x2f, 0xca, 0x4e, 0xcb, 0xc9, 0x2f, 0xd7, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x03, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0xd4, 0xba,
-	0xcc, 0xc8, 0xc5, 0x1b, 0x0e, 0x55, 0x1a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x24, 0xc5, 0x25, 0x16,
-	0xee, 0x1f, 0xe4, 0xed, 0xe6, 0xe3, 0x1f, 0x1e, 0x1f, 0x1c, 0xe2, 0x18, 0xe2, 0x1a, 0xef, 0xe9,
-	0x17, 0xe6, 0xe8, 0xe3, 0xe9, 0x22, 0xc0, 0x80, 0x45, 0xce, 0x39, 0xc8, 0xd5, 0x31, 0xc4, 0xd5,
-	0x45, 0x80, 0x11, 0x8b, 0x5c, 0x50, 0xa8, 0x9f, 0x9f, 0xa7, 0x9f, 0xbb, 0x00, 0x93, 0x90, 0x0c,
-	0x97, 0x04, 0xba, 0x3e, 0x7f, 0xdf, 0x00, 0x1f, 0x57, 0x90, 0x4e, 0x66, 0x21, 0x49, 0x2e, 0x51,
-	0x34, 0xd9, 0x28, 0x7f, 0x5f, 0x27, 0x4f, 0x57, 0x01, 0x16, 0x21, 0x71, 0x2e, 0x61, 0x34, 0xa9,
-	0x30, 0x7f, 0x4f, 0x17, 0x01, 0x56, 0xac, 0x26, 0x06, 0x05, 0x85, 0x06, 0x80, 0x4c, 0x64, 0x73,
-	0x72, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0xa3, 0x4c,
-	0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x51, 0x42, 0x4b, 0x2f, 0x3d,
-	0x35, 0x4f, 0x1f, 0x1c, 0x3e, 0x88, 0x80, 0xb3, 0x86, 0xb0, 0xca, 0x0c, 0x93, 0xd8, 0xc0, 0x32,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x33, 0x81, 0xb3, 0x62, 0x01, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0xfd, 0x9c, 0x10, 0xbe, 0xf6, 0xa6, 0x3f, 0xee, 0x94, 0x82, 0x1b, 0x55, 0x34, 0x40, 0x11,
+	0x51, 0x8a, 0x6c, 0x41, 0xd5, 0xaa, 0x3f, 0x2b, 0x63, 0x3b, 0x30, 0xc2, 0xd8, 0x91, 0x93, 0x40,
+	0xcb, 0xc6, 0x72, 0x9c, 0xc9, 0x8f, 0xe2, 0x64, 0x22, 0x67, 0x12, 0xc4, 0x3b, 0x75, 0xd3, 0x5d,
+	0x1f, 0x81, 0x65, 0xa5, 0xbe, 0x40, 0xc5, 0x93, 0x54, 0x63, 0x3b, 0x40, 0x21, 0x89, 0xd8, 0xf9,
+	0xde, 0x7b, 0xce, 0xb9, 0x9e, 0x73, 0x46, 0x03, 0x9b, 0xa3, 0x3a, 0x09, 0x15, 0xdf, 0x6b, 0x90,
+	0xbe, 0x4f, 0x94, 0x61, 0xdb, 0x0b, 0x49, 0x43, 0x19, 0xef, 0x28, 0x67, 0x34, 0xec, 0x36, 0x03,
+	0x7a, 0x26, 0x0f, 0x42, 0xca, 0x28, 0x5a, 0xe6, 0x30, 0x39, 0x81, 0xc9, 0x31, 0x4c, 0x1e, 0xef,
+	0xe4, 0x5e, 0xb7, 0x28, 0x6d, 0x05, 0x44, 0x89, 0x50, 0xf5, 0x51, 0x53, 0x61, 0x9d, 0x1e, 0x19,
+	0x32, 0xaf, 0x37, 0x88, 0x89, 0xeb, 0x3f, 0x04, 0x40, 0x1a, 0xed, 0x0d, 0x02, 0xc2, 0x3a, 0xb4,
+	0xaf, 0x79, 0x41, 0x50, 0xf7, 0xfc, 0x2e, 0x12, 0x21, 0x3d, 0x0a, 0x03, 0x49, 0xc8, 0x0b, 0x85,
+	0x87, 0x0e, 0xff, 0x44, 0x16, 0x2c, 0xb6, 0x89, 0xd7, 0x20, 0xa1, 0x94, 0xca, 0xa7, 0x0b, 0xd9,
+	0xdd, 0x0f, 0xf2, 0xf4, 0x95, 0xf2, 0x5d, 0x35, 0xf9, 0x20, 0x22, 0x1a, 0x7d, 0x16, 0x9e, 0x3b,
+	0x89, 0x4a, 0xee, 0x13, 0x64, 0x6f, 0xb4, 0xf9, 0xc2, 0x2e, 0x39, 0x9f, 0x2c, 0xec, 0x92, 0x73,
+	0xb4, 0x04, 0x99, 0xb1, 0x17, 0x8c, 0x88, 0x94, 0x8a, 0x7a, 0x71, 0xf1, 0x39, 0xf5, 0x51, 0x58,
+	0xff, 0x9e, 0x82, 0xe5, 0xbb, 0x5b, 0x70, 0xbf, 0x49, 0x51, 0x09, 0x1e, 0xf8, 0x49, 0x1d, 0x69,
+	0x65, 0x77, 0x8b, 0xf7, 0xff, 0x4f, 0xe7, 0x8a, 0x8b, 0x0c, 0xc8, 0x0c, 0x99, 0xc7, 0xe2, 0xe5,
+	0x4f, 0x76, 0x95, 0xfb, 0x8b, 0x54, 0x38, 0xcd, 0x89, 0xd9, 0x48, 0x82, 0xff, 0x3d, 0xc6, 0x48,
+	0x6f, 0xc0, 0xa4, 0x74, 0x5e, 0x28, 0x64, 0x9c, 0x49, 0x89, 0x4a, 0xf0, 0x2c, 0xf0, 0x86, 0xcc,
+	0x4d, 0x6a, 0x97, 0xe7, 0x22, 0x2d, 0x44, 0x7f, 0x9c, 0x93, 0xe3, 0xd0, 0xe4, 0x49, 0x68, 0x72,
+	0x75, 0x12, 0x9a, 0xf3, 0x94, 0x93, 0xd4, 0x98, 0xc3, 0xbb, 0x68, 0x0d, 0x1e, 0x45, 0x3a, 0x4d,
+	0xaf, 0x13, 0x8c, 0x42, 0x22, 0x65, 0x22, 0xb3, 0xb2, 0xbc, 0x57, 0x8a, 0x5b, 0xeb, 0xdb, 0xb0,
+	0x74, 0x92, 0xdc, 0x16, 0xdc, 0x38, 0xf0, 0x86, 0xed, 0x32, 0x0d, 0x3a, 0x7e, 0x64, 0xf0, 0xd0,
+	0xa7, 0x03, 0x92, 0x98, 0x1e, 0x17, 0xc5, 0xdf, 0x02, 0x3c, 0x9e, 0xc0, 0xa3, 0xb3, 0xa0, 0x1c,
+	0x2c, 0x9f, 0xd8, 0xce, 0x61, 0xc9, 0xb4, 0x4f, 0xdc, 0x4a, 0x55, 0xad, 0x1a, 0x2e, 0xb6, 0x8e,
+	0x55, 0x13, 0xeb, 0xe2, 0x7f, 0x53, 0x66, 0x9a, 0x63, 0xa8, 0x55, 0x43, 0x17, 0x85, 0x29, 0x33,
+	0xa7, 0x66, 0x59, 0xd8, 0xda, 0x17, 0x53, 0xe8, 0x15, 0x48, 0xb7, 0x79, 0xf6, 0x51, 0xd9, 0x34,
+	0x38, 0x33, 0x8d, 0x5e, 0xc2, 0x8b, 0x5b, 0xd3, 0x53, 0xfb, 0x68, 0x0f, 0x1b, 0xe2, 0x02, 0x5a,
+	0x81, 0xe7, 0xb7, 0x46, 0xc7, 0x36, 0xd6, 0xc5, 0xcc, 0x54, 0x45, 0xc7, 0xa9, 0x95, 0xb9, 0xe2,
+	0x62, 0xf1, 0x42, 0x00, 0xe9, 0xda, 0x04, 0x8d, 0xf6, 0x9b, 0x41, 0xc7, 0x67, 0x89, 0x11, 0x5b,
+	0xb0, 0x71, 0x45, 0xc5, 0xba, 0xab, 0xd9, 0x56, 0xc9, 0xc4, 0x5a, 0xd5, 0x2d, 0xdb, 0x26, 0xd6,
+	0xbe, 0xdd, 0x38, 0xed, 0x1b, 0xc8, 0xcf, 0x03, 0x96, 0x54, 0x6c, 0x8a, 0x02, 0xda, 0x86, 0xc2,
+	0x3c, 0x54, 0xad, 0x62, 0xb8, 0xc6, 0x57, 0x5c, 0xa9, 0xc6, 0x4e, 0x28, 0xf0, 0x76, 0x1e, 0xba,
+	0x82, 0xf7, 0x2d, 0xd5, 0xbc, 0x26, 0xa4, 0x8b, 0x3f, 0x05, 0x58, 0x99, 0x71, 0xed, 0xd0, 0x26,
+	0xac, 0x25, 0x3e, 0x62, 0xdb, 0x72, 0x35, 0xd5, 0x34, 0xf7, 0x54, 0xed, 0xf0, 0x4e, 0x6a, 0x5b,
+	0xb0, 0x31, 0x1b, 0x56, 0xd1, 0x0e, 0x0c, 0xbd, 0x66, 0x46, 0x11, 0xce, 0x07, 0xd6, 0x34, 0xcd,
+	0x30, 0x74, 0x43, 0x17, 0x53, 0xdc, 0x99, 0xd9, 0x40, 0xee, 0x0b, 0xcf, 0x75, 0x4f, 0xbb, 0xb8,
+	0x5c, 0x15, 0x7e, 0x5d, 0xae, 0x0a, 0x7f, 0x2e, 0x57, 0x85, 0xd3, 0xf7, 0xad, 0x0e, 0x6b, 0x8f,
+	0xea, 0xb2, 0x4f, 0x7b, 0xca, 0x3f, 0xaf, 0x9c, 0xdc, 0x22, 0xfd, 0xf8, 0xc5, 0xba, 0x7e, 0xf0,
+	0xbe, 0xc4, 0x5f, 0xe3, 0x9d, 0xfa, 0x62, 0x34, 0x79, 0xf7, 0x77, 0x00, 0x34, 0xa4, 0x98, 0x9c,
+	0x1a, 0x05, 0x00, 0x00,
+}
+
+func (m *CompletionCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletionCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletionCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Header) > 0 {
+		for k := range m.Header {
+			v := m.Header[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintWorkflow(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompletionCallbackInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletionCallbackInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
+
+func (m *CompletionCallbackInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LastFailure) > 0 {
+		i -= len(m.LastFailure)
+		copy(dAtA[i:], m.LastFailure)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.LastFailure)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastAttemptTime != nil {
+		{
+			size, err := m.LastAttemptTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintWorkflow(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintWorkflow(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Callback != nil {
+		{
+			size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowIdHashPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowIdHashPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowIdHashPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CompletionCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.Header) > 0 {
+		for k, v := range m.Header {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovWorkflow(uint64(len(k))) + 1 + len(v) + sovWorkflow(uint64(len(v)))
+			n += mapEntrySize + 1 + sovWorkflow(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompletionCallbackInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Callback != nil {
+		l = m.Callback.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovWorkflow(uint64(m.State))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovWorkflow(uint64(m.Attempt))
+	}
+	if m.LastAttemptTime != nil {
+		l = m.LastAttemptTime.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.LastFailure)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowIdHashPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWorkflow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWorkflow(x uint64) (n int) {
+	return sovWorkflow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CompletionCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletionCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletionCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthWorkflow
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthWorkflow
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthWorkflow
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthWorkflow
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipWorkflow(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthWorkflow
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Header[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompletionCallbackInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletionCallbackInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletionCallbackInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Callback == nil {
+				m.Callback = &CompletionCallback{}
+			}
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= CompletionCallbackState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastAttemptTime == nil {
+				m.LastAttemptTime = &types.Timestamp{}
+			}
+			if err := m.LastAttemptTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastFailure = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowIdHashPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowIdHashPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowIdHashPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWorkflow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWorkflow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWorkflow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWorkflow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWorkflow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWorkflow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWorkflow = fmt.Errorf("proto: unexpected end of group")
+)
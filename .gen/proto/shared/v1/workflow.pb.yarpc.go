@@ -6,20 +6,45 @@ package sharedv1
 var yarpcFileDescriptorClosure7ca73ea33aecbb95 = [][]byte{
 	// uber/cadence/shared/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4d, 0x4a, 0x2d,
-		0xd2, 0x4f, 0x4e, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0xd1,
-		0x2f, 0x33, 0xd4, 0x2f, 0xcf, 0x2f, 0xca, 0x4e, 0xcb, 0xc9, 0x2f, 0xd7, 0x2b, 0x28, 0xca, 0x2f,
-		0xc9, 0x17, 0x12, 0x03, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0xd4, 0xba,
-		0xcc, 0xc8, 0xc5, 0x1b, 0x0e, 0x55, 0x1a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x24, 0xc5, 0x25, 0x16,
-		0xee, 0x1f, 0xe4, 0xed, 0xe6, 0xe3, 0x1f, 0x1e, 0x1f, 0x1c, 0xe2, 0x18, 0xe2, 0x1a, 0xef, 0xe9,
-		0x17, 0xe6, 0xe8, 0xe3, 0xe9, 0x22, 0xc0, 0x80, 0x45, 0xce, 0x39, 0xc8, 0xd5, 0x31, 0xc4, 0xd5,
-		0x45, 0x80, 0x11, 0x8b, 0x5c, 0x50, 0xa8, 0x9f, 0x9f, 0xa7, 0x9f, 0xbb, 0x00, 0x93, 0x90, 0x0c,
-		0x97, 0x04, 0xba, 0x3e, 0x7f, 0xdf, 0x00, 0x1f, 0x57, 0x90, 0x4e, 0x66, 0x21, 0x49, 0x2e, 0x51,
-		0x34, 0xd9, 0x28, 0x7f, 0x5f, 0x27, 0x4f, 0x57, 0x01, 0x16, 0x21, 0x71, 0x2e, 0x61, 0x34, 0xa9,
-		0x30, 0x7f, 0x4f, 0x17, 0x01, 0x56, 0xac, 0x26, 0x06, 0x05, 0x85, 0x06, 0x80, 0x4c, 0x64, 0x73,
-		0x32, 0x8f, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x09,
-		0x21, 0xbd, 0xf4, 0xd4, 0x3c, 0x7d, 0x70, 0x98, 0x20, 0x02, 0xcb, 0x1a, 0xc2, 0x2a, 0x33, 0x4c,
-		0x62, 0x03, 0xcb, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xff, 0xc3, 0x37, 0x89, 0x56, 0x01,
-		0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+		0x14, 0xfd, 0x9c, 0x10, 0xbe, 0xf6, 0xa6, 0x3f, 0xee, 0x94, 0x82, 0x1b, 0x55, 0x6a, 0x80, 0x22,
+		0xa2, 0x14, 0xd9, 0x82, 0xaa, 0xff, 0x2b, 0x63, 0x4f, 0x60, 0x84, 0xb1, 0x23, 0x27, 0x81, 0x96,
+		0x8d, 0xe5, 0x38, 0x93, 0x1f, 0xc5, 0xc9, 0x44, 0xce, 0x24, 0x88, 0x77, 0xea, 0xa6, 0xbb, 0x3e,
+		0x42, 0xf7, 0x7d, 0xa1, 0x6a, 0x6c, 0x07, 0x28, 0x84, 0x88, 0x9d, 0xef, 0xbd, 0xe7, 0x9c, 0xeb,
+		0x39, 0x67, 0x34, 0xb0, 0x35, 0x69, 0xd2, 0x48, 0x0b, 0xfc, 0x16, 0x1d, 0x06, 0x54, 0x1b, 0x77,
+		0xfd, 0x88, 0xb6, 0xb4, 0xe9, 0xae, 0x76, 0xce, 0xa2, 0x7e, 0x3b, 0x64, 0xe7, 0xea, 0x28, 0x62,
+		0x9c, 0xa1, 0x55, 0x01, 0x53, 0x53, 0x98, 0x9a, 0xc0, 0xd4, 0xe9, 0x6e, 0xe1, 0x75, 0x87, 0xb1,
+		0x4e, 0x48, 0xb5, 0x18, 0xd5, 0x9c, 0xb4, 0x35, 0xde, 0x1b, 0xd0, 0x31, 0xf7, 0x07, 0xa3, 0x84,
+		0xb8, 0xf1, 0x53, 0x02, 0x64, 0xb0, 0xc1, 0x28, 0xa4, 0xbc, 0xc7, 0x86, 0x86, 0x1f, 0x86, 0x4d,
+		0x3f, 0xe8, 0x23, 0x19, 0xb2, 0x93, 0x28, 0x54, 0xa4, 0xa2, 0x54, 0x7a, 0xe8, 0x8a, 0x4f, 0x64,
+		0xc3, 0x72, 0x97, 0xfa, 0x2d, 0x1a, 0x29, 0x99, 0x62, 0xb6, 0x94, 0xdf, 0xfb, 0xa0, 0xce, 0x5f,
+		0xa9, 0xde, 0x56, 0x53, 0x0f, 0x63, 0x22, 0x1e, 0xf2, 0xe8, 0xc2, 0x4d, 0x55, 0x0a, 0x9f, 0x21,
+		0x7f, 0xad, 0x2d, 0x16, 0xf6, 0xe9, 0xc5, 0x6c, 0x61, 0x9f, 0x5e, 0xa0, 0x15, 0xc8, 0x4d, 0xfd,
+		0x70, 0x42, 0x95, 0x4c, 0xdc, 0x4b, 0x8a, 0x2f, 0x99, 0x4f, 0xd2, 0xc6, 0x8f, 0x0c, 0xac, 0xde,
+		0xde, 0x42, 0x86, 0x6d, 0x86, 0x2a, 0xf0, 0x20, 0x48, 0xeb, 0x58, 0x2b, 0xbf, 0x57, 0xbe, 0xff,
+		0x7f, 0xba, 0x97, 0x5c, 0x84, 0x21, 0x37, 0xe6, 0x3e, 0x4f, 0x96, 0x3f, 0xd9, 0xd3, 0xee, 0x2f,
+		0x52, 0x13, 0x34, 0x37, 0x61, 0x23, 0x05, 0xfe, 0xf7, 0x39, 0xa7, 0x83, 0x11, 0x57, 0xb2, 0x45,
+		0xa9, 0x94, 0x73, 0x67, 0x25, 0xaa, 0xc0, 0xb3, 0xd0, 0x1f, 0x73, 0x2f, 0xad, 0x3d, 0x91, 0x8b,
+		0xb2, 0x14, 0xff, 0x71, 0x41, 0x4d, 0x42, 0x53, 0x67, 0xa1, 0xa9, 0xf5, 0x59, 0x68, 0xee, 0x53,
+		0x41, 0xd2, 0x13, 0x8e, 0xe8, 0xa2, 0x75, 0x78, 0x14, 0xeb, 0xb4, 0xfd, 0x5e, 0x38, 0x89, 0xa8,
+		0x92, 0x8b, 0xcd, 0xca, 0x8b, 0x5e, 0x25, 0x69, 0x6d, 0xec, 0xc0, 0xca, 0x69, 0x7a, 0x5b, 0x48,
+		0xeb, 0xd0, 0x1f, 0x77, 0xab, 0x2c, 0xec, 0x05, 0xb1, 0xc1, 0xe3, 0x80, 0x8d, 0x68, 0x6a, 0x7a,
+		0x52, 0x94, 0xff, 0x48, 0xf0, 0x78, 0x06, 0x8f, 0xcf, 0x82, 0x0a, 0xb0, 0x7a, 0xea, 0xb8, 0x47,
+		0x15, 0xcb, 0x39, 0xf5, 0x6a, 0x75, 0xbd, 0x8e, 0x3d, 0x62, 0x9f, 0xe8, 0x16, 0x31, 0xe5, 0xff,
+		0xe6, 0xcc, 0x0c, 0x17, 0xeb, 0x75, 0x6c, 0xca, 0xd2, 0x9c, 0x99, 0xdb, 0xb0, 0x6d, 0x62, 0x1f,
+		0xc8, 0x19, 0xf4, 0x0a, 0x94, 0x9b, 0x3c, 0xe7, 0xb8, 0x6a, 0x61, 0xc1, 0xcc, 0xa2, 0x97, 0xf0,
+		0xe2, 0xc6, 0xf4, 0xcc, 0x39, 0xde, 0x27, 0x58, 0x5e, 0x42, 0x6b, 0xf0, 0xfc, 0xc6, 0xe8, 0xc4,
+		0x21, 0xa6, 0x9c, 0x9b, 0xab, 0xe8, 0xba, 0x8d, 0xaa, 0x50, 0x5c, 0x2e, 0xff, 0x96, 0x40, 0xb9,
+		0x32, 0xc1, 0x60, 0xc3, 0x76, 0xd8, 0x0b, 0x78, 0x6a, 0xc4, 0x36, 0x6c, 0x5e, 0x52, 0x89, 0xe9,
+		0x19, 0x8e, 0x5d, 0xb1, 0x88, 0x51, 0xf7, 0xaa, 0x8e, 0x45, 0x8c, 0xef, 0xd7, 0x4e, 0xfb, 0x06,
+		0x8a, 0x8b, 0x80, 0x15, 0x9d, 0x58, 0xb2, 0x84, 0x76, 0xa0, 0xb4, 0x08, 0xd5, 0xa8, 0x61, 0x0f,
+		0x7f, 0x23, 0xb5, 0x7a, 0xe2, 0x84, 0x06, 0x6f, 0x17, 0xa1, 0x6b, 0xe4, 0xc0, 0xd6, 0xad, 0x2b,
+		0x42, 0xb6, 0xfc, 0x4b, 0x82, 0xb5, 0x3b, 0xae, 0x1d, 0xda, 0x82, 0xf5, 0xd4, 0x47, 0xe2, 0xd8,
+		0x9e, 0xa1, 0x5b, 0xd6, 0xbe, 0x6e, 0x1c, 0xdd, 0x4a, 0x6d, 0x1b, 0x36, 0xef, 0x86, 0xd5, 0x8c,
+		0x43, 0x6c, 0x36, 0xac, 0x38, 0xc2, 0xc5, 0xc0, 0x86, 0x61, 0x60, 0x6c, 0x62, 0x53, 0xce, 0x08,
+		0x67, 0xee, 0x06, 0x0a, 0x5f, 0x44, 0xae, 0xfb, 0x1f, 0xcf, 0xde, 0x77, 0x7a, 0xbc, 0x3b, 0x69,
+		0xaa, 0x01, 0x1b, 0x68, 0xff, 0xbc, 0x6c, 0x6a, 0x87, 0x0e, 0x93, 0x57, 0xea, 0xea, 0x91, 0xfb,
+		0x9a, 0x7c, 0x4d, 0x77, 0x9b, 0xcb, 0xf1, 0xe4, 0xdd, 0xdf, 0x01, 0x00, 0xce, 0x58, 0xae, 0x5f,
+		0x0e, 0x05, 0x00, 0x00,
 	},
 }
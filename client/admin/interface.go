@@ -44,6 +44,7 @@ type Client interface {
 	DescribeShardDistribution(context.Context, *types.DescribeShardDistributionRequest, ...yarpc.CallOption) (*types.DescribeShardDistributionResponse, error)
 	DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest, ...yarpc.CallOption) (*types.DescribeHistoryHostResponse, error)
 	DescribeQueue(context.Context, *types.DescribeQueueRequest, ...yarpc.CallOption) (*types.DescribeQueueResponse, error)
+	DescribeReplicationStatus(context.Context, *types.DescribeReplicationStatusRequest, ...yarpc.CallOption) (*types.DescribeReplicationStatusResponse, error)
 	DescribeWorkflowExecution(context.Context, *types.AdminDescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error)
 	GetDomainReplicationMessages(context.Context, *types.GetDomainReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDomainReplicationMessagesResponse, error)
@@ -51,6 +52,8 @@ type Client interface {
 	GetWorkflowExecutionRawHistoryV2(context.Context, *types.GetWorkflowExecutionRawHistoryV2Request, ...yarpc.CallOption) (*types.GetWorkflowExecutionRawHistoryV2Response, error)
 	CountDLQMessages(context.Context, *types.CountDLQMessagesRequest, ...yarpc.CallOption) (*types.CountDLQMessagesResponse, error)
 	MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest, ...yarpc.CallOption) (*types.MergeDLQMessagesResponse, error)
+	MoveTaskListBacklog(context.Context, *types.MoveTaskListBacklogRequest, ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error)
+	DeleteTaskListBacklogTasks(context.Context, *types.DeleteTaskListBacklogTasksRequest, ...yarpc.CallOption) (*types.DeleteTaskListBacklogTasksResponse, error)
 	PurgeDLQMessages(context.Context, *types.PurgeDLQMessagesRequest, ...yarpc.CallOption) error
 	ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest, ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error)
 	ReapplyEvents(context.Context, *types.ReapplyEventsRequest, ...yarpc.CallOption) error
@@ -66,6 +69,10 @@ type Client interface {
 	UpdateOperationalDynamicConfig(context.Context, *types.UpdateOperationalDynamicConfigRequest, ...yarpc.CallOption) error
 	RestoreOperationalDynamicConfig(context.Context, *types.RestoreOperationalDynamicConfigRequest, ...yarpc.CallOption) error
 	ListOperationalDynamicConfig(context.Context, *types.ListOperationalDynamicConfigRequest, ...yarpc.CallOption) (*types.ListOperationalDynamicConfigResponse, error)
+	ListDynamicConfigVersions(context.Context, *types.ListDynamicConfigVersionsRequest, ...yarpc.CallOption) (*types.ListDynamicConfigVersionsResponse, error)
+	DiffDynamicConfigVersions(context.Context, *types.DiffDynamicConfigVersionsRequest, ...yarpc.CallOption) (*types.DiffDynamicConfigVersionsResponse, error)
+	RollbackDynamicConfig(context.Context, *types.RollbackDynamicConfigRequest, ...yarpc.CallOption) (*types.RollbackDynamicConfigResponse, error)
+	ResolveDynamicConfig(context.Context, *types.ResolveDynamicConfigRequest, ...yarpc.CallOption) (*types.ResolveDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest, ...yarpc.CallOption) (*types.AdminDeleteWorkflowResponse, error)
 	MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest, ...yarpc.CallOption) (*types.AdminMaintainWorkflowResponse, error)
 	GetGlobalIsolationGroups(ctx context.Context, request *types.GetGlobalIsolationGroupsRequest, opts ...yarpc.CallOption) (*types.GetGlobalIsolationGroupsResponse, error)
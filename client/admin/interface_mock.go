@@ -101,6 +101,26 @@ func (mr *MockClientMockRecorder) CountDLQMessages(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDLQMessages", reflect.TypeOf((*MockClient)(nil).CountDLQMessages), varargs...)
 }
 
+// DeleteTaskListBacklogTasks mocks base method.
+func (m *MockClient) DeleteTaskListBacklogTasks(arg0 context.Context, arg1 *types.DeleteTaskListBacklogTasksRequest, arg2 ...yarpc.CallOption) (*types.DeleteTaskListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTaskListBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*types.DeleteTaskListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskListBacklogTasks indicates an expected call of DeleteTaskListBacklogTasks.
+func (mr *MockClientMockRecorder) DeleteTaskListBacklogTasks(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskListBacklogTasks", reflect.TypeOf((*MockClient)(nil).DeleteTaskListBacklogTasks), varargs...)
+}
+
 // DeleteWorkflow mocks base method.
 func (m *MockClient) DeleteWorkflow(arg0 context.Context, arg1 *types.AdminDeleteWorkflowRequest, arg2 ...yarpc.CallOption) (*types.AdminDeleteWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +201,26 @@ func (mr *MockClientMockRecorder) DescribeQueue(arg0, arg1 any, arg2 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockClient)(nil).DescribeQueue), varargs...)
 }
 
+// DescribeReplicationStatus mocks base method.
+func (m *MockClient) DescribeReplicationStatus(arg0 context.Context, arg1 *types.DescribeReplicationStatusRequest, arg2 ...yarpc.CallOption) (*types.DescribeReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*types.DescribeReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationStatus indicates an expected call of DescribeReplicationStatus.
+func (mr *MockClientMockRecorder) DescribeReplicationStatus(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationStatus", reflect.TypeOf((*MockClient)(nil).DescribeReplicationStatus), varargs...)
+}
+
 // DescribeShardDistribution mocks base method.
 func (m *MockClient) DescribeShardDistribution(arg0 context.Context, arg1 *types.DescribeShardDistributionRequest, arg2 ...yarpc.CallOption) (*types.DescribeShardDistributionResponse, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +261,26 @@ func (mr *MockClientMockRecorder) DescribeWorkflowExecution(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// DiffDynamicConfigVersions mocks base method.
+func (m *MockClient) DiffDynamicConfigVersions(arg0 context.Context, arg1 *types.DiffDynamicConfigVersionsRequest, arg2 ...yarpc.CallOption) (*types.DiffDynamicConfigVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiffDynamicConfigVersions", varargs...)
+	ret0, _ := ret[0].(*types.DiffDynamicConfigVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffDynamicConfigVersions indicates an expected call of DiffDynamicConfigVersions.
+func (mr *MockClientMockRecorder) DiffDynamicConfigVersions(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffDynamicConfigVersions", reflect.TypeOf((*MockClient)(nil).DiffDynamicConfigVersions), varargs...)
+}
+
 // GetDLQReplicationMessages mocks base method.
 func (m *MockClient) GetDLQReplicationMessages(arg0 context.Context, arg1 *types.GetDLQReplicationMessagesRequest, arg2 ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -421,6 +481,26 @@ func (mr *MockClientMockRecorder) ListDynamicConfig(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockClient)(nil).ListDynamicConfig), varargs...)
 }
 
+// ListDynamicConfigVersions mocks base method.
+func (m *MockClient) ListDynamicConfigVersions(arg0 context.Context, arg1 *types.ListDynamicConfigVersionsRequest, arg2 ...yarpc.CallOption) (*types.ListDynamicConfigVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfigVersions", varargs...)
+	ret0, _ := ret[0].(*types.ListDynamicConfigVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigVersions indicates an expected call of ListDynamicConfigVersions.
+func (mr *MockClientMockRecorder) ListDynamicConfigVersions(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigVersions", reflect.TypeOf((*MockClient)(nil).ListDynamicConfigVersions), varargs...)
+}
+
 // ListOperationalDynamicConfig mocks base method.
 func (m *MockClient) ListOperationalDynamicConfig(arg0 context.Context, arg1 *types.ListOperationalDynamicConfigRequest, arg2 ...yarpc.CallOption) (*types.ListOperationalDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -481,6 +561,26 @@ func (mr *MockClientMockRecorder) MergeDLQMessages(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MoveTaskListBacklog mocks base method.
+func (m *MockClient) MoveTaskListBacklog(arg0 context.Context, arg1 *types.MoveTaskListBacklogRequest, arg2 ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskListBacklog", varargs...)
+	ret0, _ := ret[0].(*types.MoveTaskListBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskListBacklog indicates an expected call of MoveTaskListBacklog.
+func (mr *MockClientMockRecorder) MoveTaskListBacklog(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskListBacklog", reflect.TypeOf((*MockClient)(nil).MoveTaskListBacklog), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockClient) PurgeDLQMessages(arg0 context.Context, arg1 *types.PurgeDLQMessagesRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -615,6 +715,26 @@ func (mr *MockClientMockRecorder) ResetQueue(arg0, arg1 any, arg2 ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetQueue", reflect.TypeOf((*MockClient)(nil).ResetQueue), varargs...)
 }
 
+// ResolveDynamicConfig mocks base method.
+func (m *MockClient) ResolveDynamicConfig(arg0 context.Context, arg1 *types.ResolveDynamicConfigRequest, arg2 ...yarpc.CallOption) (*types.ResolveDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*types.ResolveDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveDynamicConfig indicates an expected call of ResolveDynamicConfig.
+func (mr *MockClientMockRecorder) ResolveDynamicConfig(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDynamicConfig", reflect.TypeOf((*MockClient)(nil).ResolveDynamicConfig), varargs...)
+}
+
 // RestoreDynamicConfig mocks base method.
 func (m *MockClient) RestoreDynamicConfig(arg0 context.Context, arg1 *types.RestoreDynamicConfigRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -653,6 +773,26 @@ func (mr *MockClientMockRecorder) RestoreOperationalDynamicConfig(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreOperationalDynamicConfig", reflect.TypeOf((*MockClient)(nil).RestoreOperationalDynamicConfig), varargs...)
 }
 
+// RollbackDynamicConfig mocks base method.
+func (m *MockClient) RollbackDynamicConfig(arg0 context.Context, arg1 *types.RollbackDynamicConfigRequest, arg2 ...yarpc.CallOption) (*types.RollbackDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*types.RollbackDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackDynamicConfig indicates an expected call of RollbackDynamicConfig.
+func (mr *MockClientMockRecorder) RollbackDynamicConfig(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDynamicConfig", reflect.TypeOf((*MockClient)(nil).RollbackDynamicConfig), varargs...)
+}
+
 // UpdateDomainAsyncWorkflowConfiguraton mocks base method.
 func (m *MockClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (*types.UpdateDomainAsyncWorkflowConfiguratonResponse, error) {
 	m.ctrl.T.Helper()
@@ -94,4 +94,9 @@ type Client interface {
 	UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest, ...yarpc.CallOption) (*types.UnpauseScheduleResponse, error)
 	BackfillSchedule(context.Context, *types.BackfillScheduleRequest, ...yarpc.CallOption) (*types.BackfillScheduleResponse, error)
 	ListSchedules(context.Context, *types.ListSchedulesRequest, ...yarpc.CallOption) (*types.ListSchedulesResponse, error)
+
+	StartBatchOperation(context.Context, *types.StartBatchOperationRequest, ...yarpc.CallOption) (*types.StartBatchOperationResponse, error)
+	DescribeBatchOperation(context.Context, *types.DescribeBatchOperationRequest, ...yarpc.CallOption) (*types.DescribeBatchOperationResponse, error)
+	ListBatchOperations(context.Context, *types.ListBatchOperationsRequest, ...yarpc.CallOption) (*types.ListBatchOperationsResponse, error)
+	StopBatchOperation(context.Context, *types.StopBatchOperationRequest, ...yarpc.CallOption) (*types.StopBatchOperationResponse, error)
 }
@@ -0,0 +1,100 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// The public IDL has no workflow ID hash on the active cluster selection policy yet. The transport clients send
+// it in a request header and frontend copies it back to the request.
+
+// WithStartWorkflowExecutionRequestHeaders adds the request headers carrying the values of the request which
+// are not in the IDL yet to the call options
+func WithStartWorkflowExecutionRequestHeaders(req *types.StartWorkflowExecutionRequest, opts []yarpc.CallOption) []yarpc.CallOption {
+	return withWorkflowIDHash(req.GetActiveClusterSelectionPolicy(), opts)
+}
+
+// ReadStartWorkflowExecutionRequestHeaders copies the values sent in the request headers to the request
+func ReadStartWorkflowExecutionRequestHeaders(ctx context.Context, req *types.StartWorkflowExecutionRequest) error {
+	if req == nil {
+		return nil
+	}
+	policy, err := readWorkflowIDHash(ctx, req.ActiveClusterSelectionPolicy)
+	if err != nil {
+		return err
+	}
+	req.ActiveClusterSelectionPolicy = policy
+	return nil
+}
+
+// WithSignalWithStartWorkflowExecutionRequestHeaders adds the request headers carrying the values of the request
+// which are not in the IDL yet to the call options
+func WithSignalWithStartWorkflowExecutionRequestHeaders(req *types.SignalWithStartWorkflowExecutionRequest, opts []yarpc.CallOption) []yarpc.CallOption {
+	return withWorkflowIDHash(req.GetActiveClusterSelectionPolicy(), opts)
+}
+
+// ReadSignalWithStartWorkflowExecutionRequestHeaders copies the values sent in the request headers to the request
+func ReadSignalWithStartWorkflowExecutionRequestHeaders(ctx context.Context, req *types.SignalWithStartWorkflowExecutionRequest) error {
+	if req == nil {
+		return nil
+	}
+	policy, err := readWorkflowIDHash(ctx, req.ActiveClusterSelectionPolicy)
+	if err != nil {
+		return err
+	}
+	req.ActiveClusterSelectionPolicy = policy
+	return nil
+}
+
+func withWorkflowIDHash(policy *types.ActiveClusterSelectionPolicy, opts []yarpc.CallOption) []yarpc.CallOption {
+	hash := policy.GetWorkflowIDHash()
+	if hash == nil {
+		return opts
+	}
+	// encoding a struct of strings cannot fail
+	encoded, _ := json.Marshal(hash)
+	return append([]yarpc.CallOption{yarpc.WithHeader(common.WorkflowIDHashHeaderName, string(encoded))}, opts...)
+}
+
+// readWorkflowIDHash keeps a hash which is already set on the policy, e.g. by the REST gateway
+func readWorkflowIDHash(ctx context.Context, policy *types.ActiveClusterSelectionPolicy) (*types.ActiveClusterSelectionPolicy, error) {
+	encoded := yarpc.CallFromContext(ctx).Header(common.WorkflowIDHashHeaderName)
+	if encoded == "" || policy.GetWorkflowIDHash() != nil {
+		return policy, nil
+	}
+	var hash types.WorkflowIDHashPolicy
+	if err := json.Unmarshal([]byte(encoded), &hash); err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("%s header is not a valid workflow ID hash policy: %v", common.WorkflowIDHashHeaderName, err)}
+	}
+	if policy == nil {
+		policy = &types.ActiveClusterSelectionPolicy{}
+	}
+	policy.WorkflowIDHash = &hash
+	return policy, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestStartWorkflowExecutionRequestHeaders(t *testing.T) {
+	hash := &types.WorkflowIDHashPolicy{Scope: "region"}
+	opts := WithStartWorkflowExecutionRequestHeaders(&types.StartWorkflowExecutionRequest{
+		ActiveClusterSelectionPolicy: &types.ActiveClusterSelectionPolicy{WorkflowIDHash: hash},
+	}, nil)
+	ctx := sendRequestHeaders(t, opts)
+
+	// the public IDL drops the hash, so the policy arrives empty
+	req := &types.StartWorkflowExecutionRequest{ActiveClusterSelectionPolicy: &types.ActiveClusterSelectionPolicy{}}
+	require.NoError(t, ReadStartWorkflowExecutionRequestHeaders(ctx, req))
+	assert.Equal(t, &types.ActiveClusterSelectionPolicy{WorkflowIDHash: hash}, req.ActiveClusterSelectionPolicy)
+
+	req = &types.StartWorkflowExecutionRequest{}
+	require.NoError(t, ReadStartWorkflowExecutionRequestHeaders(ctx, req))
+	assert.Equal(t, &types.ActiveClusterSelectionPolicy{WorkflowIDHash: hash}, req.ActiveClusterSelectionPolicy)
+
+	// a hash set on the request, e.g. by the REST gateway, is kept
+	req = &types.StartWorkflowExecutionRequest{ActiveClusterSelectionPolicy: &types.ActiveClusterSelectionPolicy{
+		WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "location"},
+	}}
+	require.NoError(t, ReadStartWorkflowExecutionRequestHeaders(ctx, req))
+	assert.Equal(t, "location", req.ActiveClusterSelectionPolicy.GetWorkflowIDHash().GetScope())
+
+	assert.Empty(t, WithStartWorkflowExecutionRequestHeaders(&types.StartWorkflowExecutionRequest{}, nil))
+	require.NoError(t, ReadStartWorkflowExecutionRequestHeaders(ctx, nil))
+
+	// an older client sends no header
+	req = &types.StartWorkflowExecutionRequest{}
+	require.NoError(t, ReadStartWorkflowExecutionRequestHeaders(context.Background(), req))
+	assert.Nil(t, req.ActiveClusterSelectionPolicy)
+}
+
+func TestSignalWithStartWorkflowExecutionRequestHeaders(t *testing.T) {
+	clusterAttribute := &types.ClusterAttribute{Scope: "region", Name: "lisbon"}
+	hash := &types.WorkflowIDHashPolicy{Scope: "region"}
+	opts := WithSignalWithStartWorkflowExecutionRequestHeaders(&types.SignalWithStartWorkflowExecutionRequest{
+		ActiveClusterSelectionPolicy: &types.ActiveClusterSelectionPolicy{ClusterAttribute: clusterAttribute, WorkflowIDHash: hash},
+	}, nil)
+	ctx := sendRequestHeaders(t, opts)
+
+	req := &types.SignalWithStartWorkflowExecutionRequest{ActiveClusterSelectionPolicy: &types.ActiveClusterSelectionPolicy{ClusterAttribute: clusterAttribute}}
+	require.NoError(t, ReadSignalWithStartWorkflowExecutionRequestHeaders(ctx, req))
+	assert.Equal(t, &types.ActiveClusterSelectionPolicy{ClusterAttribute: clusterAttribute, WorkflowIDHash: hash}, req.ActiveClusterSelectionPolicy)
+
+	assert.Empty(t, WithSignalWithStartWorkflowExecutionRequestHeaders(nil, nil))
+	require.NoError(t, ReadSignalWithStartWorkflowExecutionRequestHeaders(ctx, nil))
+}
+
+func TestReadWorkflowIDHashMalformedHeader(t *testing.T) {
+	ctx, call := encoding.NewInboundCall(context.Background())
+	require.NoError(t, call.ReadFromRequest(&transport.Request{
+		Headers: transport.NewHeaders().With(common.WorkflowIDHashHeaderName, "region"),
+	}))
+
+	err := ReadStartWorkflowExecutionRequestHeaders(ctx, &types.StartWorkflowExecutionRequest{})
+	var badRequest *types.BadRequestError
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+// sendRequestHeaders returns the context of a call receiving the headers added by opts
+func sendRequestHeaders(t *testing.T, opts []yarpc.CallOption) context.Context {
+	encodingOpts := make([]encoding.CallOption, 0, len(opts))
+	for _, opt := range opts {
+		encodingOpts = append(encodingOpts, encoding.CallOption(opt))
+	}
+	request := &transport.Request{}
+	_, err := encoding.NewOutboundCall(encodingOpts...).WriteToRequest(context.Background(), request)
+	require.NoError(t, err)
+	_, ok := request.Headers.Get(common.WorkflowIDHashHeaderName)
+	require.True(t, ok)
+
+	ctx, call := encoding.NewInboundCall(context.Background())
+	require.NoError(t, call.ReadFromRequest(request))
+	return ctx
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// The public IDL has no field for the recommended poller count, the history count, the continue-as-new
+// suggestion, the open workflow usage of a domain, the comment of a failover event and the replication exclusion
+// of a workflow yet. Frontend sends them in response headers and
+// the transport clients copy them back to the response.
+
+// PollForActivityTaskResponseHeaders returns the response headers carrying the values of the response which
+// are not in the IDL yet
+func PollForActivityTaskResponseHeaders(resp *types.PollForActivityTaskResponse) map[string]string {
+	headers := make(map[string]string)
+	if resp != nil {
+		writeRecommendedPollerCount(headers, resp.AutoConfigHint.GetRecommendedPollerCount())
+	}
+	return headers
+}
+
+// ReadPollForActivityTaskResponseHeaders copies the values sent in the response headers to the response
+func ReadPollForActivityTaskResponseHeaders(resp *types.PollForActivityTaskResponse, headers map[string]string) {
+	if resp != nil && resp.AutoConfigHint != nil {
+		resp.AutoConfigHint.RecommendedPollerCount = readRecommendedPollerCount(headers)
+	}
+}
+
+// PollForDecisionTaskResponseHeaders returns the response headers carrying the values of the response which
+// are not in the IDL yet
+func PollForDecisionTaskResponseHeaders(resp *types.PollForDecisionTaskResponse) map[string]string {
+	headers := make(map[string]string)
+	if resp == nil {
+		return headers
+	}
+	writeRecommendedPollerCount(headers, resp.AutoConfigHint.GetRecommendedPollerCount())
+	if resp.HistoryCount != 0 {
+		headers[common.HistoryCountHeaderName] = strconv.FormatInt(resp.HistoryCount, 10)
+	}
+	if resp.ContinueAsNewSuggested {
+		headers[common.ContinueAsNewSuggestedHeaderName] = strconv.FormatBool(resp.ContinueAsNewSuggested)
+	}
+	return headers
+}
+
+// ReadPollForDecisionTaskResponseHeaders copies the values sent in the response headers to the response
+func ReadPollForDecisionTaskResponseHeaders(resp *types.PollForDecisionTaskResponse, headers map[string]string) {
+	if resp == nil {
+		return
+	}
+	if resp.AutoConfigHint != nil {
+		resp.AutoConfigHint.RecommendedPollerCount = readRecommendedPollerCount(headers)
+	}
+	// a missing or malformed header leaves the zero value, e.g. when the server is older
+	resp.HistoryCount, _ = strconv.ParseInt(headers[common.HistoryCountHeaderName], 10, 64)
+	resp.ContinueAsNewSuggested, _ = strconv.ParseBool(headers[common.ContinueAsNewSuggestedHeaderName])
+}
+
+// DescribeTaskListResponseHeaders returns the response headers carrying the values of the response which
+// are not in the IDL yet
+func DescribeTaskListResponseHeaders(resp *types.DescribeTaskListResponse) map[string]string {
+	headers := make(map[string]string)
+	writeRecommendedPollerCount(headers, resp.GetTaskListStatus().GetRecommendedPollerCount())
+	return headers
+}
+
+// ReadDescribeTaskListResponseHeaders copies the values sent in the response headers to the response
+func ReadDescribeTaskListResponseHeaders(resp *types.DescribeTaskListResponse, headers map[string]string) {
+	if status := resp.GetTaskListStatus(); status != nil {
+		status.RecommendedPollerCount = readRecommendedPollerCount(headers)
+	}
+}
+
+// DescribeDomainResponseHeaders returns the response headers carrying the values of the response which
+// are not in the IDL yet
+func DescribeDomainResponseHeaders(resp *types.DescribeDomainResponse) map[string]string {
+	headers := make(map[string]string)
+	if usage := resp.GetOpenWorkflowUsage(); usage != nil {
+		// encoding a struct of numbers and strings cannot fail
+		encoded, _ := json.Marshal(usage)
+		headers[common.OpenWorkflowUsageHeaderName] = string(encoded)
+	}
+	return headers
+}
+
+// ReadDescribeDomainResponseHeaders copies the values sent in the response headers to the response
+func ReadDescribeDomainResponseHeaders(resp *types.DescribeDomainResponse, headers map[string]string) {
+	encoded, ok := headers[common.OpenWorkflowUsageHeaderName]
+	if resp == nil || !ok {
+		return
+	}
+	var usage types.OpenWorkflowUsage
+	if err := json.Unmarshal([]byte(encoded), &usage); err == nil {
+		resp.OpenWorkflowUsage = &usage
+	}
+}
+
+// ListFailoverHistoryResponseHeaders returns the response headers carrying the values of the response which
+// are not in the IDL yet
+func ListFailoverHistoryResponseHeaders(resp *types.ListFailoverHistoryResponse) map[string]string {
+	headers := make(map[string]string)
+	comments := make(map[string]string)
+	for _, event := range resp.GetFailoverEvents() {
+		if event.GetComment() != "" {
+			comments[event.GetID()] = event.GetComment()
+		}
+	}
+	if len(comments) > 0 {
+		// encoding a map of strings cannot fail
+		encoded, _ := json.Marshal(comments)
+		headers[common.FailoverEventCommentsHeaderName] = string(encoded)
+	}
+	return headers
+}
+
+// ReadListFailoverHistoryResponseHeaders copies the values sent in the response headers to the response
+func ReadListFailoverHistoryResponseHeaders(resp *types.ListFailoverHistoryResponse, headers map[string]string) {
+	encoded, ok := headers[common.FailoverEventCommentsHeaderName]
+	if resp == nil || !ok {
+		return
+	}
+	var comments map[string]string
+	if err := json.Unmarshal([]byte(encoded), &comments); err != nil {
+		return
+	}
+	for _, event := range resp.FailoverEvents {
+		if comment, ok := comments[event.GetID()]; ok {
+			event.Comment = common.StringPtr(comment)
+		}
+	}
+}
+
+// DescribeWorkflowExecutionResponseHeaders returns the response headers carrying the values of the response which
+// are not in the IDL yet
+func DescribeWorkflowExecutionResponseHeaders(resp *types.DescribeWorkflowExecutionResponse) map[string]string {
+	headers := make(map[string]string)
+	if resp.GetWorkflowExecutionInfo().GetReplicationExcluded() {
+		headers[common.ReplicationExcludedHeaderName] = strconv.FormatBool(true)
+	}
+	return headers
+}
+
+// ReadDescribeWorkflowExecutionResponseHeaders copies the values sent in the response headers to the response
+func ReadDescribeWorkflowExecutionResponseHeaders(resp *types.DescribeWorkflowExecutionResponse, headers map[string]string) {
+	if info := resp.GetWorkflowExecutionInfo(); info != nil {
+		// a missing or malformed header leaves the zero value, e.g. when the server is older
+		info.ReplicationExcluded, _ = strconv.ParseBool(headers[common.ReplicationExcludedHeaderName])
+	}
+}
+
+func writeRecommendedPollerCount(headers map[string]string, recommendedPollerCount int32) {
+	if recommendedPollerCount != 0 {
+		headers[common.RecommendedPollerCountHeaderName] = strconv.Itoa(int(recommendedPollerCount))
+	}
+}
+
+// readRecommendedPollerCount returns 0 when the header is missing, e.g. because the server is older
+func readRecommendedPollerCount(headers map[string]string) int32 {
+	count, err := strconv.ParseInt(headers[common.RecommendedPollerCountHeaderName], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(count)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestPollForActivityTaskResponseHeaders(t *testing.T) {
+	headers := PollForActivityTaskResponseHeaders(&types.PollForActivityTaskResponse{
+		AutoConfigHint: &types.AutoConfigHint{EnableAutoConfig: true, RecommendedPollerCount: 4},
+	})
+	assert.Equal(t, map[string]string{common.RecommendedPollerCountHeaderName: "4"}, headers)
+
+	resp := &types.PollForActivityTaskResponse{AutoConfigHint: &types.AutoConfigHint{EnableAutoConfig: true}}
+	ReadPollForActivityTaskResponseHeaders(resp, headers)
+	assert.Equal(t, int32(4), resp.AutoConfigHint.RecommendedPollerCount)
+
+	assert.Empty(t, PollForActivityTaskResponseHeaders(&types.PollForActivityTaskResponse{}))
+	ReadPollForActivityTaskResponseHeaders(nil, headers)
+}
+
+func TestPollForDecisionTaskResponseHeaders(t *testing.T) {
+	headers := PollForDecisionTaskResponseHeaders(&types.PollForDecisionTaskResponse{
+		AutoConfigHint:         &types.AutoConfigHint{EnableAutoConfig: true, RecommendedPollerCount: 2},
+		HistoryCount:           12000,
+		ContinueAsNewSuggested: true,
+	})
+	assert.Equal(t, map[string]string{
+		common.RecommendedPollerCountHeaderName: "2",
+		common.HistoryCountHeaderName:           "12000",
+		common.ContinueAsNewSuggestedHeaderName: "true",
+	}, headers)
+
+	resp := &types.PollForDecisionTaskResponse{AutoConfigHint: &types.AutoConfigHint{EnableAutoConfig: true}}
+	ReadPollForDecisionTaskResponseHeaders(resp, headers)
+	assert.Equal(t, &types.PollForDecisionTaskResponse{
+		AutoConfigHint:         &types.AutoConfigHint{EnableAutoConfig: true, RecommendedPollerCount: 2},
+		HistoryCount:           12000,
+		ContinueAsNewSuggested: true,
+	}, resp)
+
+	// the response has no hint to copy the count to
+	resp = &types.PollForDecisionTaskResponse{}
+	ReadPollForDecisionTaskResponseHeaders(resp, headers)
+	assert.Equal(t, &types.PollForDecisionTaskResponse{HistoryCount: 12000, ContinueAsNewSuggested: true}, resp)
+
+	// an older server sends none of the headers
+	resp = &types.PollForDecisionTaskResponse{}
+	ReadPollForDecisionTaskResponseHeaders(resp, nil)
+	assert.Equal(t, &types.PollForDecisionTaskResponse{}, resp)
+}
+
+func TestDescribeTaskListResponseHeaders(t *testing.T) {
+	headers := DescribeTaskListResponseHeaders(&types.DescribeTaskListResponse{
+		TaskListStatus: &types.TaskListStatus{RecommendedPollerCount: 3},
+	})
+	assert.Equal(t, map[string]string{common.RecommendedPollerCountHeaderName: "3"}, headers)
+
+	resp := &types.DescribeTaskListResponse{TaskListStatus: &types.TaskListStatus{}}
+	ReadDescribeTaskListResponseHeaders(resp, headers)
+	assert.Equal(t, int32(3), resp.TaskListStatus.RecommendedPollerCount)
+
+	// a malformed header from another server is ignored
+	ReadDescribeTaskListResponseHeaders(resp, map[string]string{common.RecommendedPollerCountHeaderName: "many"})
+	assert.Equal(t, int32(0), resp.TaskListStatus.RecommendedPollerCount)
+}
+
+func TestDescribeDomainResponseHeaders(t *testing.T) {
+	usage := &types.OpenWorkflowUsage{
+		OpenWorkflowCount: 42,
+		OpenWorkflowLimit: 100,
+		WorkflowTypes: []*types.WorkflowTypeOpenWorkflowUsage{
+			{WorkflowType: "wf-type", OpenWorkflowCount: 3, OpenWorkflowLimit: 10},
+		},
+	}
+	headers := DescribeDomainResponseHeaders(&types.DescribeDomainResponse{OpenWorkflowUsage: usage})
+	assert.Contains(t, headers, common.OpenWorkflowUsageHeaderName)
+
+	resp := &types.DescribeDomainResponse{}
+	ReadDescribeDomainResponseHeaders(resp, headers)
+	assert.Equal(t, usage, resp.OpenWorkflowUsage)
+
+	// no open workflow limit is configured for the domain
+	assert.Empty(t, DescribeDomainResponseHeaders(&types.DescribeDomainResponse{}))
+	resp = &types.DescribeDomainResponse{}
+	ReadDescribeDomainResponseHeaders(resp, nil)
+	assert.Nil(t, resp.OpenWorkflowUsage)
+
+	ReadDescribeDomainResponseHeaders(resp, map[string]string{common.OpenWorkflowUsageHeaderName: "malformed"})
+	assert.Nil(t, resp.OpenWorkflowUsage)
+	ReadDescribeDomainResponseHeaders(nil, headers)
+}
+
+func TestListFailoverHistoryResponseHeaders(t *testing.T) {
+	newResponse := func() *types.ListFailoverHistoryResponse {
+		return &types.ListFailoverHistoryResponse{
+			FailoverEvents: []*types.FailoverEvent{
+				{ID: common.StringPtr("event-1")},
+				{ID: common.StringPtr("event-2")},
+			},
+		}
+	}
+	sent := newResponse()
+	sent.FailoverEvents[1].Comment = common.StringPtr("scheduled failover succeeded (workflow wid)")
+	headers := ListFailoverHistoryResponseHeaders(sent)
+	assert.Contains(t, headers, common.FailoverEventCommentsHeaderName)
+
+	resp := newResponse()
+	ReadListFailoverHistoryResponseHeaders(resp, headers)
+	assert.Equal(t, sent, resp)
+
+	// no event has a comment
+	assert.Empty(t, ListFailoverHistoryResponseHeaders(newResponse()))
+	assert.Empty(t, ListFailoverHistoryResponseHeaders(nil))
+	resp = newResponse()
+	ReadListFailoverHistoryResponseHeaders(resp, nil)
+	assert.Equal(t, newResponse(), resp)
+
+	ReadListFailoverHistoryResponseHeaders(resp, map[string]string{common.FailoverEventCommentsHeaderName: "malformed"})
+	assert.Equal(t, newResponse(), resp)
+	ReadListFailoverHistoryResponseHeaders(nil, headers)
+}
+
+func TestDescribeWorkflowExecutionResponseHeaders(t *testing.T) {
+	newResponse := func(replicationExcluded bool) *types.DescribeWorkflowExecutionResponse {
+		return &types.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &types.WorkflowExecutionInfo{ReplicationExcluded: replicationExcluded},
+		}
+	}
+	headers := DescribeWorkflowExecutionResponseHeaders(newResponse(true))
+	assert.Equal(t, map[string]string{common.ReplicationExcludedHeaderName: "true"}, headers)
+
+	resp := newResponse(false)
+	ReadDescribeWorkflowExecutionResponseHeaders(resp, headers)
+	assert.Equal(t, newResponse(true), resp)
+
+	// the workflow is replicated
+	assert.Empty(t, DescribeWorkflowExecutionResponseHeaders(newResponse(false)))
+	assert.Empty(t, DescribeWorkflowExecutionResponseHeaders(nil))
+	resp = newResponse(false)
+	ReadDescribeWorkflowExecutionResponseHeaders(resp, nil)
+	assert.Equal(t, newResponse(false), resp)
+
+	ReadDescribeWorkflowExecutionResponseHeaders(resp, map[string]string{common.ReplicationExcludedHeaderName: "malformed"})
+	assert.Equal(t, newResponse(false), resp)
+	ReadDescribeWorkflowExecutionResponseHeaders(nil, headers)
+	ReadDescribeWorkflowExecutionResponseHeaders(&types.DescribeWorkflowExecutionResponse{}, headers)
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"sort"
 	"sync"
 
 	"go.uber.org/yarpc"
@@ -878,6 +879,61 @@ func cmpGetReplicationMessagesWithSize(a, b *getReplicationMessagesWithSize) int
 	return 0
 }
 
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *types.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationStatusResponse, error) {
+	requestsByPeer := make(map[string]*types.GetReplicationStatusRequest)
+
+	for _, shardID := range request.ShardIDs {
+		peer, err := c.peerResolver.FromShardID(int(shardID))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestsByPeer[peer]; !ok {
+			requestsByPeer[peer] = &types.GetReplicationStatusRequest{
+				ClusterName: request.ClusterName,
+				DomainID:    request.DomainID,
+			}
+		}
+
+		req := requestsByPeer[peer]
+		req.ShardIDs = append(req.ShardIDs, shardID)
+	}
+
+	g := &errgroup.Group{}
+	var responseMutex sync.Mutex
+	response := &types.GetReplicationStatusResponse{}
+
+	for peer, req := range requestsByPeer {
+		peer, req := peer, req
+		g.Go(func() (e error) {
+			defer func() { log.CapturePanic(recover(), c.logger, &e) }()
+
+			// unlike replication messages, a partial status would hide the lag of the missing shards
+			resp, err := c.client.GetReplicationStatus(ctx, req, append(opts, yarpc.WithShardKey(peer))...)
+			if err != nil {
+				return err
+			}
+			responseMutex.Lock()
+			response.Shards = append(response.Shards, resp.GetShards()...)
+			responseMutex.Unlock()
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(response.Shards, func(i, j int) bool {
+		return response.Shards[i].GetShardID() < response.Shards[j].GetShardID()
+	})
+	return response, nil
+}
+
 func (c *clientImpl) GetDLQReplicationMessages(
 	ctx context.Context,
 	request *types.GetDLQReplicationMessagesRequest,
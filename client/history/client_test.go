@@ -218,6 +218,40 @@ func TestClient_withResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "GetReplicationStatus",
+			op: func(c Client) (any, error) {
+				return c.GetReplicationStatus(context.Background(), &types.GetReplicationStatusRequest{
+					ClusterName: "standby",
+					ShardIDs:    []int32{100, 101, 102},
+					DomainID:    "domain-id",
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromShardID(100).Return("test-peer-0", nil).Times(1)
+				p.EXPECT().FromShardID(101).Return("test-peer-1", nil).Times(1)
+				p.EXPECT().FromShardID(102).Return("test-peer-0", nil).Times(1)
+				c.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{
+					ClusterName: "standby",
+					ShardIDs:    []int32{100, 102},
+					DomainID:    "domain-id",
+				}, []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.GetReplicationStatusResponse{
+						Shards: []*types.ShardReplicationStatus{{ShardID: 102}, {ShardID: 100}},
+					}, nil).Times(1)
+				c.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{
+					ClusterName: "standby",
+					ShardIDs:    []int32{101},
+					DomainID:    "domain-id",
+				}, []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					Return(&types.GetReplicationStatusResponse{
+						Shards: []*types.ShardReplicationStatus{{ShardID: 101}},
+					}, nil).Times(1)
+			},
+			want: &types.GetReplicationStatusResponse{
+				Shards: []*types.ShardReplicationStatus{{ShardID: 100}, {ShardID: 101}, {ShardID: 102}},
+			},
+		},
 		{
 			name: "GetDLQReplicationMessages",
 			op: func(c Client) (any, error) {
@@ -675,6 +709,26 @@ func TestClient_withResponse(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "GetReplicationStatus fails on any peer error",
+			op: func(c Client) (any, error) {
+				return c.GetReplicationStatus(context.Background(), &types.GetReplicationStatusRequest{
+					ClusterName: "standby",
+					ShardIDs:    []int32{100, 101},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromShardID(100).Return("test-peer-0", nil).Times(1)
+				p.EXPECT().FromShardID(101).Return("test-peer-1", nil).Times(1)
+				c.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-0")}).
+					Return(&types.GetReplicationStatusResponse{
+						Shards: []*types.ShardReplicationStatus{{ShardID: 100}},
+					}, nil).Times(1)
+				c.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer-1")}).
+					Return(nil, fmt.Errorf("GetReplicationStatus failed")).Times(1)
+			},
+			wantError: true,
+		},
 		{
 			name: "GetReplicationMessages fail open on unknow error",
 			op: func(c Client) (any, error) {
@@ -262,6 +262,26 @@ func (mr *MockClientMockRecorder) GetReplicationMessages(arg0, arg1 any, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockClient) GetReplicationStatus(arg0 context.Context, arg1 *types.GetReplicationStatusRequest, arg2 ...yarpc.CallOption) (*types.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*types.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockClientMockRecorder) GetReplicationStatus(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockClient)(nil).GetReplicationStatus), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockClient) MergeDLQMessages(arg0 context.Context, arg1 *types.MergeDLQMessagesRequest, arg2 ...yarpc.CallOption) (*types.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -387,3 +387,29 @@ func (c *clientImpl) RefreshTaskListPartitionConfig(
 	}
 	return resp, nil
 }
+
+// MoveTaskListBacklog is routed to the owner of the destination task list, which allocates the IDs of the moved tasks
+func (c *clientImpl) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListBacklogRequest,
+	opts ...yarpc.CallOption,
+) (*types.MoveTaskListBacklogResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.DestinationTaskList.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return c.client.MoveTaskListBacklog(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
+// DeleteTaskListBacklogTasks is routed to the owner of the task list partition, which drops the tasks it has already read
+func (c *clientImpl) DeleteTaskListBacklogTasks(
+	ctx context.Context,
+	request *types.MatchingDeleteTaskListBacklogTasksRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingDeleteTaskListBacklogTasksResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.TaskList.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return c.client.DeleteTaskListBacklogTasks(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
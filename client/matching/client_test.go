@@ -568,6 +568,50 @@ func TestClient_withResponse(t *testing.T) {
 			want:      nil,
 			wantError: true,
 		},
+		{
+			name: "MoveTaskListBacklog - routed to the destination",
+			op: func(c Client) (any, error) {
+				return c.MoveTaskListBacklog(context.Background(), testMatchingMoveTaskListBacklogRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList("destination").Return("peer1", nil)
+				c.EXPECT().MoveTaskListBacklog(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer1")}).Return(&types.MoveTaskListBacklogResponse{MovedTasks: 3}, nil)
+			},
+			want: &types.MoveTaskListBacklogResponse{MovedTasks: 3},
+		},
+		{
+			name: "MoveTaskListBacklog - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.MoveTaskListBacklog(context.Background(), testMatchingMoveTaskListBacklogRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList("destination").Return("", assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "DeleteTaskListBacklogTasks - routed to the partition",
+			op: func(c Client) (any, error) {
+				return c.DeleteTaskListBacklogTasks(context.Background(), testMatchingDeleteTaskListBacklogTasksRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer1", nil)
+				c.EXPECT().DeleteTaskListBacklogTasks(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer1")}).Return(&types.MatchingDeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{1}}, nil)
+			},
+			want: &types.MatchingDeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{1}},
+		},
+		{
+			name: "DeleteTaskListBacklogTasks - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.DeleteTaskListBacklogTasks(context.Background(), testMatchingDeleteTaskListBacklogTasksRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("", assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -712,3 +756,23 @@ func testMatchingRefreshTaskListPartitionConfigRequest() *types.MatchingRefreshT
 		},
 	}
 }
+
+func testMatchingMoveTaskListBacklogRequest() *types.MatchingMoveTaskListBacklogRequest {
+	return &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:          _testDomainUUID,
+		TaskList:            &types.TaskList{Name: _testTaskList},
+		TaskListType:        types.TaskListTypeActivity.Ptr(),
+		DestinationTaskList: &types.TaskList{Name: "destination"},
+		MinTaskID:           1,
+		MaxTaskID:           10,
+	}
+}
+
+func testMatchingDeleteTaskListBacklogTasksRequest() *types.MatchingDeleteTaskListBacklogTasksRequest {
+	return &types.MatchingDeleteTaskListBacklogTasksRequest{
+		DomainUUID:   _testDomainUUID,
+		TaskList:     &types.TaskList{Name: _testTaskList},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		TaskIDs:      []int64{1, 2},
+	}
+}
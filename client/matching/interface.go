@@ -50,4 +50,6 @@ type Client interface {
 	RespondQueryTaskCompleted(context.Context, *types.MatchingRespondQueryTaskCompletedRequest, ...yarpc.CallOption) error
 	UpdateTaskListPartitionConfig(context.Context, *types.MatchingUpdateTaskListPartitionConfigRequest, ...yarpc.CallOption) (*types.MatchingUpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *types.MatchingRefreshTaskListPartitionConfigRequest, ...yarpc.CallOption) (*types.MatchingRefreshTaskListPartitionConfigResponse, error)
+	MoveTaskListBacklog(context.Context, *types.MatchingMoveTaskListBacklogRequest, ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error)
+	DeleteTaskListBacklogTasks(context.Context, *types.MatchingDeleteTaskListBacklogTasksRequest, ...yarpc.CallOption) (*types.MatchingDeleteTaskListBacklogTasksResponse, error)
 }
@@ -102,6 +102,26 @@ func (mr *MockClientMockRecorder) CancelOutstandingPoll(arg0, arg1 any, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockClient)(nil).CancelOutstandingPoll), varargs...)
 }
 
+// DeleteTaskListBacklogTasks mocks base method.
+func (m *MockClient) DeleteTaskListBacklogTasks(arg0 context.Context, arg1 *types.MatchingDeleteTaskListBacklogTasksRequest, arg2 ...yarpc.CallOption) (*types.MatchingDeleteTaskListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTaskListBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*types.MatchingDeleteTaskListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskListBacklogTasks indicates an expected call of DeleteTaskListBacklogTasks.
+func (mr *MockClientMockRecorder) DeleteTaskListBacklogTasks(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskListBacklogTasks", reflect.TypeOf((*MockClient)(nil).DeleteTaskListBacklogTasks), varargs...)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockClient) DescribeTaskList(arg0 context.Context, arg1 *types.MatchingDescribeTaskListRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -162,6 +182,26 @@ func (mr *MockClientMockRecorder) ListTaskListPartitions(arg0, arg1 any, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockClient)(nil).ListTaskListPartitions), varargs...)
 }
 
+// MoveTaskListBacklog mocks base method.
+func (m *MockClient) MoveTaskListBacklog(arg0 context.Context, arg1 *types.MatchingMoveTaskListBacklogRequest, arg2 ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskListBacklog", varargs...)
+	ret0, _ := ret[0].(*types.MoveTaskListBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskListBacklog indicates an expected call of MoveTaskListBacklog.
+func (mr *MockClientMockRecorder) MoveTaskListBacklog(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskListBacklog", reflect.TypeOf((*MockClient)(nil).MoveTaskListBacklog), varargs...)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockClient) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest, arg2 ...yarpc.CallOption) (*types.MatchingPollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +100,26 @@ func (c *adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQM
 	return
 }
 
+func (c *adminClient) DeleteTaskListBacklogTasks(ctx context.Context, dp1 *types.DeleteTaskListBacklogTasksRequest, p1 ...yarpc.CallOption) (dp2 *types.DeleteTaskListBacklogTasksResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		dp2, err = c.client.DeleteTaskListBacklogTasks(ctx, dp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationDeleteTaskListBacklogTasks,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -180,6 +200,26 @@ func (c *adminClient) DescribeQueue(ctx context.Context, dp1 *types.DescribeQueu
 	return
 }
 
+func (c *adminClient) DescribeReplicationStatus(ctx context.Context, dp1 *types.DescribeReplicationStatusRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeReplicationStatusResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		dp2, err = c.client.DescribeReplicationStatus(ctx, dp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationDescribeReplicationStatus,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) DescribeShardDistribution(ctx context.Context, dp1 *types.DescribeShardDistributionRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeShardDistributionResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -220,6 +260,26 @@ func (c *adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.
 	return
 }
 
+func (c *adminClient) DiffDynamicConfigVersions(ctx context.Context, dp1 *types.DiffDynamicConfigVersionsRequest, p1 ...yarpc.CallOption) (dp2 *types.DiffDynamicConfigVersionsResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		dp2, err = c.client.DiffDynamicConfigVersions(ctx, dp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationDiffDynamicConfigVersions,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types.GetDLQReplicationMessagesRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDLQReplicationMessagesResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -420,6 +480,26 @@ func (c *adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDyna
 	return
 }
 
+func (c *adminClient) ListDynamicConfigVersions(ctx context.Context, lp1 *types.ListDynamicConfigVersionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigVersionsResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		lp2, err = c.client.ListDynamicConfigVersions(ctx, lp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationListDynamicConfigVersions,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) ListOperationalDynamicConfig(ctx context.Context, lp1 *types.ListOperationalDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListOperationalDynamicConfigResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -480,6 +560,26 @@ func (c *adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQM
 	return
 }
 
+func (c *adminClient) MoveTaskListBacklog(ctx context.Context, mp1 *types.MoveTaskListBacklogRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		mp2, err = c.client.MoveTaskListBacklog(ctx, mp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationMoveTaskListBacklog,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -620,6 +720,26 @@ func (c *adminClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueReque
 	return
 }
 
+func (c *adminClient) ResolveDynamicConfig(ctx context.Context, rp1 *types.ResolveDynamicConfigRequest, p1 ...yarpc.CallOption) (rp2 *types.ResolveDynamicConfigResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		rp2, err = c.client.ResolveDynamicConfig(ctx, rp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationResolveDynamicConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.RestoreDynamicConfigRequest, p1 ...yarpc.CallOption) (err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -660,6 +780,26 @@ func (c *adminClient) RestoreOperationalDynamicConfig(ctx context.Context, rp1 *
 	return
 }
 
+func (c *adminClient) RollbackDynamicConfig(ctx context.Context, rp1 *types.RollbackDynamicConfigRequest, p1 ...yarpc.CallOption) (rp2 *types.RollbackDynamicConfigResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		rp2, err = c.client.RollbackDynamicConfig(ctx, rp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationRollbackDynamicConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
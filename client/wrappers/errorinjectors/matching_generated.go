@@ -100,6 +100,26 @@ func (c *matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.C
 	return
 }
 
+func (c *matchingClient) DeleteTaskListBacklogTasks(ctx context.Context, mp1 *types.MatchingDeleteTaskListBacklogTasksRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDeleteTaskListBacklogTasksResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		mp2, err = c.client.DeleteTaskListBacklogTasks(ctx, mp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgMatchingInjectedFakeErr,
+			tag.MatchingClientOperationDeleteTaskListBacklogTasks,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -160,6 +180,26 @@ func (c *matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.
 	return
 }
 
+func (c *matchingClient) MoveTaskListBacklog(ctx context.Context, mp1 *types.MatchingMoveTaskListBacklogRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		mp2, err = c.client.MoveTaskListBacklog(ctx, mp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgMatchingInjectedFakeErr,
+			tag.MatchingClientOperationMoveTaskListBacklog,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
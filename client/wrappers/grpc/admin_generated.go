@@ -29,6 +29,11 @@ func (g adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQMe
 	return proto.ToAdminCountDLQMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) DeleteTaskListBacklogTasks(ctx context.Context, dp1 *types.DeleteTaskListBacklogTasksRequest, p1 ...yarpc.CallOption) (dp2 *types.DeleteTaskListBacklogTasksResponse, err error) {
+	response, err := g.c.DeleteTaskListBacklogTasks(ctx, proto.FromAdminDeleteTaskListBacklogTasksRequest(dp1), p1...)
+	return proto.ToAdminDeleteTaskListBacklogTasksResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	response, err := g.c.DeleteWorkflow(ctx, proto.FromAdminDeleteWorkflowRequest(ap1), p1...)
 	return proto.ToAdminDeleteWorkflowResponse(response), proto.ToError(err)
@@ -49,6 +54,11 @@ func (g adminClient) DescribeQueue(ctx context.Context, dp1 *types.DescribeQueue
 	return proto.ToAdminDescribeQueueResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) DescribeReplicationStatus(ctx context.Context, dp1 *types.DescribeReplicationStatusRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeReplicationStatusResponse, err error) {
+	response, err := g.c.DescribeReplicationStatus(ctx, proto.FromAdminDescribeReplicationStatusRequest(dp1), p1...)
+	return proto.ToAdminDescribeReplicationStatusResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) DescribeShardDistribution(ctx context.Context, dp1 *types.DescribeShardDistributionRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeShardDistributionResponse, err error) {
 	response, err := g.c.DescribeShardDistribution(ctx, proto.FromAdminDescribeShardDistributionRequest(dp1), p1...)
 	return proto.ToAdminDescribeShardDistributionResponse(response), proto.ToError(err)
@@ -59,6 +69,11 @@ func (g adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.A
 	return proto.ToAdminDescribeWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) DiffDynamicConfigVersions(ctx context.Context, dp1 *types.DiffDynamicConfigVersionsRequest, p1 ...yarpc.CallOption) (dp2 *types.DiffDynamicConfigVersionsResponse, err error) {
+	response, err := g.c.DiffDynamicConfigVersions(ctx, proto.FromAdminDiffDynamicConfigVersionsRequest(dp1), p1...)
+	return proto.ToAdminDiffDynamicConfigVersionsResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types.GetDLQReplicationMessagesRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDLQReplicationMessagesResponse, err error) {
 	response, err := g.c.GetDLQReplicationMessages(ctx, proto.FromAdminGetDLQReplicationMessagesRequest(gp1), p1...)
 	return proto.ToAdminGetDLQReplicationMessagesResponse(response), proto.ToError(err)
@@ -109,6 +124,11 @@ func (g adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynam
 	return proto.ToAdminListDynamicConfigResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) ListDynamicConfigVersions(ctx context.Context, lp1 *types.ListDynamicConfigVersionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigVersionsResponse, err error) {
+	response, err := g.c.ListDynamicConfigVersions(ctx, proto.FromAdminListDynamicConfigVersionsRequest(lp1), p1...)
+	return proto.ToAdminListDynamicConfigVersionsResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) ListOperationalDynamicConfig(ctx context.Context, lp1 *types.ListOperationalDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListOperationalDynamicConfigResponse, err error) {
 	response, err := g.c.ListOperationalDynamicConfig(ctx, proto.FromAdminListOperationalDynamicConfigRequest(lp1), p1...)
 	return proto.ToAdminListOperationalDynamicConfigResponse(response), proto.ToError(err)
@@ -124,6 +144,11 @@ func (g adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMe
 	return proto.ToAdminMergeDLQMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) MoveTaskListBacklog(ctx context.Context, mp1 *types.MoveTaskListBacklogRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	response, err := g.c.MoveTaskListBacklog(ctx, proto.FromAdminMoveTaskListBacklogRequest(mp1), p1...)
+	return proto.ToAdminMoveTaskListBacklogResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.PurgeDLQMessages(ctx, proto.FromAdminPurgeDLQMessagesRequest(pp1), p1...)
 	return proto.ToError(err)
@@ -159,9 +184,9 @@ func (g adminClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueReques
 	return proto.ToError(err)
 }
 
-func (g adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.RestoreDynamicConfigRequest, p1 ...yarpc.CallOption) (err error) {
-	_, err = g.c.RestoreDynamicConfig(ctx, proto.FromAdminRestoreDynamicConfigRequest(rp1), p1...)
-	return proto.ToError(err)
+func (g adminClient) ResolveDynamicConfig(ctx context.Context, rp1 *types.ResolveDynamicConfigRequest, p1 ...yarpc.CallOption) (rp2 *types.ResolveDynamicConfigResponse, err error) {
+	response, err := g.c.ResolveDynamicConfig(ctx, proto.FromAdminResolveDynamicConfigRequest(rp1), p1...)
+	return proto.ToAdminResolveDynamicConfigResponse(response), proto.ToError(err)
 }
 
 func (g adminClient) RestoreOperationalDynamicConfig(ctx context.Context, rp1 *types.RestoreOperationalDynamicConfigRequest, p1 ...yarpc.CallOption) (err error) {
@@ -169,6 +194,11 @@ func (g adminClient) RestoreOperationalDynamicConfig(ctx context.Context, rp1 *t
 	return proto.ToError(err)
 }
 
+func (g adminClient) RollbackDynamicConfig(ctx context.Context, rp1 *types.RollbackDynamicConfigRequest, p1 ...yarpc.CallOption) (rp2 *types.RollbackDynamicConfigResponse, err error) {
+	response, err := g.c.RollbackDynamicConfig(ctx, proto.FromAdminRollbackDynamicConfigRequest(rp1), p1...)
+	return proto.ToAdminRollbackDynamicConfigResponse(response), proto.ToError(err)
+}
+
 func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.c.UpdateDomainAsyncWorkflowConfiguraton(ctx, proto.FromAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request), opts...)
 	return proto.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), proto.ToError(err)
@@ -179,11 +209,6 @@ func (g adminClient) UpdateDomainIsolationGroups(ctx context.Context, request *t
 	return proto.ToAdminUpdateDomainIsolationGroupsResponse(response), proto.ToError(err)
 }
 
-func (g adminClient) UpdateDynamicConfig(ctx context.Context, up1 *types.UpdateDynamicConfigRequest, p1 ...yarpc.CallOption) (err error) {
-	_, err = g.c.UpdateDynamicConfig(ctx, proto.FromAdminUpdateDynamicConfigRequest(up1), p1...)
-	return proto.ToError(err)
-}
-
 func (g adminClient) UpdateGlobalIsolationGroups(ctx context.Context, request *types.UpdateGlobalIsolationGroupsRequest, opts ...yarpc.CallOption) (up1 *types.UpdateGlobalIsolationGroupsResponse, err error) {
 	response, err := g.c.UpdateGlobalIsolationGroups(ctx, proto.FromAdminUpdateGlobalIsolationGroupsRequest(request), opts...)
 	return proto.ToAdminUpdateGlobalIsolationGroupsResponse(response), proto.ToError(err)
@@ -26,6 +26,7 @@ import (
 	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	matchingv1 "github.com/uber/cadence/.gen/proto/matching/v1"
 	"github.com/uber/cadence/client/admin"
@@ -35,8 +36,12 @@ import (
 )
 
 type (
+	adminGRPCClientWrapper struct {
+		adminv1.AdminAPIYARPCClient
+		frontendv1.AdminExtensionAPIYARPCClient
+	}
 	adminClient struct {
-		c adminv1.AdminAPIYARPCClient
+		c *adminGRPCClientWrapper
 	}
 
 	frontendGRPCClientWrapper struct {
@@ -45,6 +50,7 @@ type (
 		apiv1.WorkerAPIYARPCClient
 		apiv1.VisibilityAPIYARPCClient
 		apiv1.ScheduleAPIYARPCClient
+		frontendv1.BatchAPIYARPCClient
 	}
 	frontendClient struct {
 		c *frontendGRPCClientWrapper
@@ -57,8 +63,11 @@ type (
 	}
 )
 
-func NewAdminClient(c adminv1.AdminAPIYARPCClient) admin.Client {
-	return adminClient{c}
+func NewAdminClient(
+	adminAPI adminv1.AdminAPIYARPCClient,
+	extensionAPI frontendv1.AdminExtensionAPIYARPCClient,
+) admin.Client {
+	return adminClient{&adminGRPCClientWrapper{adminAPI, extensionAPI}}
 }
 
 func NewFrontendClient(
@@ -67,8 +76,9 @@ func NewFrontendClient(
 	worker apiv1.WorkerAPIYARPCClient,
 	visibility apiv1.VisibilityAPIYARPCClient,
 	schedule apiv1.ScheduleAPIYARPCClient,
+	batch frontendv1.BatchAPIYARPCClient,
 ) frontend.Client {
-	return frontendClient{&frontendGRPCClientWrapper{domain, workflow, worker, visibility, schedule}}
+	return frontendClient{&frontendGRPCClientWrapper{domain, workflow, worker, visibility, schedule, batch}}
 }
 
 func NewHistoryClient(c historyv1.HistoryAPIYARPCClient) history.Client {
@@ -44,9 +44,9 @@ func (g frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.Deprecat
 	return proto.ToError(err)
 }
 
-func (g frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeDomainResponse, err error) {
-	response, err := g.c.DescribeDomain(ctx, proto.FromDescribeDomainRequest(dp1), p1...)
-	return proto.ToDescribeDomainResponse(response), proto.ToError(err)
+func (g frontendClient) DescribeBatchOperation(ctx context.Context, dp1 *types.DescribeBatchOperationRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeBatchOperationResponse, err error) {
+	response, err := g.c.DescribeBatchOperation(ctx, proto.FromDescribeBatchOperationRequest(dp1), p1...)
+	return proto.ToDescribeBatchOperationResponse(response), proto.ToError(err)
 }
 
 func (g frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.DescribeScheduleRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeScheduleResponse, err error) {
@@ -54,16 +54,6 @@ func (g frontendClient) DescribeSchedule(ctx context.Context, dp1 *types.Describ
 	return proto.ToDescribeScheduleResponse(response), proto.ToError(err)
 }
 
-func (g frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTaskListRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeTaskListResponse, err error) {
-	response, err := g.c.DescribeTaskList(ctx, proto.FromDescribeTaskListRequest(dp1), p1...)
-	return proto.ToDescribeTaskListResponse(response), proto.ToError(err)
-}
-
-func (g frontendClient) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
-	response, err := g.c.DescribeWorkflowExecution(ctx, proto.FromDescribeWorkflowExecutionRequest(dp1), p1...)
-	return proto.ToDescribeWorkflowExecutionResponse(response), proto.ToError(err)
-}
-
 func (g frontendClient) DiagnoseWorkflowExecution(ctx context.Context, dp1 *types.DiagnoseWorkflowExecutionRequest, p1 ...yarpc.CallOption) (dp2 *types.DiagnoseWorkflowExecutionResponse, err error) {
 	response, err := g.c.DiagnoseWorkflowExecution(ctx, proto.FromDiagnoseWorkflowExecutionRequest(dp1), p1...)
 	return proto.ToDiagnoseWorkflowExecutionResponse(response), proto.ToError(err)
@@ -99,6 +89,11 @@ func (g frontendClient) ListArchivedWorkflowExecutions(ctx context.Context, lp1
 	return proto.ToListArchivedWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) ListBatchOperations(ctx context.Context, lp1 *types.ListBatchOperationsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListBatchOperationsResponse, err error) {
+	response, err := g.c.ListBatchOperations(ctx, proto.FromListBatchOperationsRequest(lp1), p1...)
+	return proto.ToListBatchOperationsResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) ListClosedWorkflowExecutions(ctx context.Context, lp1 *types.ListClosedWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListClosedWorkflowExecutionsResponse, err error) {
 	response, err := g.c.ListClosedWorkflowExecutions(ctx, proto.FromListClosedWorkflowExecutionsRequest(lp1), p1...)
 	return proto.ToListClosedWorkflowExecutionsResponse(response), proto.ToError(err)
@@ -109,11 +104,6 @@ func (g frontendClient) ListDomains(ctx context.Context, lp1 *types.ListDomainsR
 	return proto.ToListDomainsResponse(response), proto.ToError(err)
 }
 
-func (g frontendClient) ListFailoverHistory(ctx context.Context, lp1 *types.ListFailoverHistoryRequest, p1 ...yarpc.CallOption) (lp2 *types.ListFailoverHistoryResponse, err error) {
-	response, err := g.c.ListFailoverHistory(ctx, proto.FromListFailoverHistoryRequest(lp1), p1...)
-	return proto.ToListFailoverHistoryResponse(response), proto.ToError(err)
-}
-
 func (g frontendClient) ListOpenWorkflowExecutions(ctx context.Context, lp1 *types.ListOpenWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListOpenWorkflowExecutionsResponse, err error) {
 	response, err := g.c.ListOpenWorkflowExecutions(ctx, proto.FromListOpenWorkflowExecutionsRequest(lp1), p1...)
 	return proto.ToListOpenWorkflowExecutionsResponse(response), proto.ToError(err)
@@ -139,16 +129,6 @@ func (g frontendClient) PauseSchedule(ctx context.Context, pp1 *types.PauseSched
 	return proto.ToPauseScheduleResponse(response), proto.ToError(err)
 }
 
-func (g frontendClient) PollForActivityTask(ctx context.Context, pp1 *types.PollForActivityTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForActivityTaskResponse, err error) {
-	response, err := g.c.PollForActivityTask(ctx, proto.FromPollForActivityTaskRequest(pp1), p1...)
-	return proto.ToPollForActivityTaskResponse(response), proto.ToError(err)
-}
-
-func (g frontendClient) PollForDecisionTask(ctx context.Context, pp1 *types.PollForDecisionTaskRequest, p1 ...yarpc.CallOption) (pp2 *types.PollForDecisionTaskResponse, err error) {
-	response, err := g.c.PollForDecisionTask(ctx, proto.FromPollForDecisionTaskRequest(pp1), p1...)
-	return proto.ToPollForDecisionTaskResponse(response), proto.ToError(err)
-}
-
 func (g frontendClient) QueryWorkflow(ctx context.Context, qp1 *types.QueryWorkflowRequest, p1 ...yarpc.CallOption) (qp2 *types.QueryWorkflowResponse, err error) {
 	response, err := g.c.QueryWorkflow(ctx, proto.FromQueryWorkflowRequest(qp1), p1...)
 	return proto.ToQueryWorkflowResponse(response), proto.ToError(err)
@@ -219,11 +199,6 @@ func (g frontendClient) RespondActivityTaskFailedByID(ctx context.Context, rp1 *
 	return proto.ToError(err)
 }
 
-func (g frontendClient) RespondDecisionTaskCompleted(ctx context.Context, rp1 *types.RespondDecisionTaskCompletedRequest, p1 ...yarpc.CallOption) (rp2 *types.RespondDecisionTaskCompletedResponse, err error) {
-	response, err := g.c.RespondDecisionTaskCompleted(ctx, proto.FromRespondDecisionTaskCompletedRequest(rp1), p1...)
-	return proto.ToRespondDecisionTaskCompletedResponse(response), proto.ToError(err)
-}
-
 func (g frontendClient) RespondDecisionTaskFailed(ctx context.Context, rp1 *types.RespondDecisionTaskFailedRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.RespondDecisionTaskFailed(ctx, proto.FromRespondDecisionTaskFailedRequest(rp1), p1...)
 	return proto.ToError(err)
@@ -244,11 +219,6 @@ func (g frontendClient) ScanWorkflowExecutions(ctx context.Context, lp1 *types.L
 	return proto.ToScanWorkflowExecutionsResponse(response), proto.ToError(err)
 }
 
-func (g frontendClient) SignalWithStartWorkflowExecution(ctx context.Context, sp1 *types.SignalWithStartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp2 *types.StartWorkflowExecutionResponse, err error) {
-	response, err := g.c.SignalWithStartWorkflowExecution(ctx, proto.FromSignalWithStartWorkflowExecutionRequest(sp1), p1...)
-	return proto.ToSignalWithStartWorkflowExecutionResponse(response), proto.ToError(err)
-}
-
 func (g frontendClient) SignalWithStartWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWithStartWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (sp2 *types.SignalWithStartWorkflowExecutionAsyncResponse, err error) {
 	response, err := g.c.SignalWithStartWorkflowExecutionAsync(ctx, proto.FromSignalWithStartWorkflowExecutionAsyncRequest(sp1), p1...)
 	return proto.ToSignalWithStartWorkflowExecutionAsyncResponse(response), proto.ToError(err)
@@ -259,9 +229,9 @@ func (g frontendClient) SignalWorkflowExecution(ctx context.Context, sp1 *types.
 	return proto.ToError(err)
 }
 
-func (g frontendClient) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp2 *types.StartWorkflowExecutionResponse, err error) {
-	response, err := g.c.StartWorkflowExecution(ctx, proto.FromStartWorkflowExecutionRequest(sp1), p1...)
-	return proto.ToStartWorkflowExecutionResponse(response), proto.ToError(err)
+func (g frontendClient) StartBatchOperation(ctx context.Context, sp1 *types.StartBatchOperationRequest, p1 ...yarpc.CallOption) (sp2 *types.StartBatchOperationResponse, err error) {
+	response, err := g.c.StartBatchOperation(ctx, proto.FromStartBatchOperationRequest(sp1), p1...)
+	return proto.ToStartBatchOperationResponse(response), proto.ToError(err)
 }
 
 func (g frontendClient) StartWorkflowExecutionAsync(ctx context.Context, sp1 *types.StartWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (sp2 *types.StartWorkflowExecutionAsyncResponse, err error) {
@@ -269,6 +239,11 @@ func (g frontendClient) StartWorkflowExecutionAsync(ctx context.Context, sp1 *ty
 	return proto.ToStartWorkflowExecutionAsyncResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) StopBatchOperation(ctx context.Context, sp1 *types.StopBatchOperationRequest, p1 ...yarpc.CallOption) (sp2 *types.StopBatchOperationResponse, err error) {
+	response, err := g.c.StopBatchOperation(ctx, proto.FromStopBatchOperationRequest(sp1), p1...)
+	return proto.ToStopBatchOperationResponse(response), proto.ToError(err)
+}
+
 func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *types.TerminateWorkflowExecutionRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.TerminateWorkflowExecution(ctx, proto.FromTerminateWorkflowExecutionRequest(tp1), p1...)
 	return proto.ToError(err)
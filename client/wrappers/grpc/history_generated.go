@@ -68,6 +68,11 @@ func (g historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.Ge
 	return proto.ToHistoryGetReplicationMessagesResponse(response), proto.ToError(err)
 }
 
+func (g historyClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetReplicationStatusResponse, err error) {
+	response, err := g.c.GetReplicationStatus(ctx, proto.FromHistoryGetReplicationStatusRequest(gp1), p1...)
+	return proto.ToHistoryGetReplicationStatusResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	response, err := g.c.MergeDLQMessages(ctx, proto.FromHistoryMergeDLQMessagesRequest(mp1), p1...)
 	return proto.ToHistoryMergeDLQMessagesResponse(response), proto.ToError(err)
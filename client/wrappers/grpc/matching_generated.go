@@ -28,6 +28,11 @@ func (g matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.Ca
 	return proto.ToError(err)
 }
 
+func (g matchingClient) DeleteTaskListBacklogTasks(ctx context.Context, mp1 *types.MatchingDeleteTaskListBacklogTasksRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDeleteTaskListBacklogTasksResponse, err error) {
+	response, err := g.c.DeleteTaskListBacklogTasks(ctx, proto.FromMatchingDeleteTaskListBacklogTasksRequest(mp1), p1...)
+	return proto.ToMatchingDeleteTaskListBacklogTasksResponse(response), proto.ToError(err)
+}
+
 func (g matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, proto.FromMatchingDescribeTaskListRequest(mp1), p1...)
 	return proto.ToMatchingDescribeTaskListResponse(response), proto.ToError(err)
@@ -43,6 +48,11 @@ func (g matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.M
 	return proto.ToMatchingListTaskListPartitionsResponse(response), proto.ToError(err)
 }
 
+func (g matchingClient) MoveTaskListBacklog(ctx context.Context, mp1 *types.MatchingMoveTaskListBacklogRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	response, err := g.c.MoveTaskListBacklog(ctx, proto.FromMatchingMoveTaskListBacklogRequest(mp1), p1...)
+	return proto.ToMatchingMoveTaskListBacklogResponse(response), proto.ToError(err)
+}
+
 func (g matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, proto.FromMatchingPollForActivityTaskRequest(mp1), p1...)
 	return proto.ToMatchingPollForActivityTaskResponse(response), proto.ToError(err)
@@ -101,6 +101,30 @@ func (c *adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQM
 	return cp2, err
 }
 
+func (c *adminClient) DeleteTaskListBacklogTasks(ctx context.Context, dp1 *types.DeleteTaskListBacklogTasksRequest, p1 ...yarpc.CallOption) (dp2 *types.DeleteTaskListBacklogTasksResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientDeleteTaskListBacklogTasksScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientDeleteTaskListBacklogTasksScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	dp2, err = c.client.DeleteTaskListBacklogTasks(ctx, dp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -197,6 +221,30 @@ func (c *adminClient) DescribeQueue(ctx context.Context, dp1 *types.DescribeQueu
 	return dp2, err
 }
 
+func (c *adminClient) DescribeReplicationStatus(ctx context.Context, dp1 *types.DescribeReplicationStatusRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeReplicationStatusResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientDescribeReplicationStatusScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientDescribeReplicationStatusScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	dp2, err = c.client.DescribeReplicationStatus(ctx, dp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *adminClient) DescribeShardDistribution(ctx context.Context, dp1 *types.DescribeShardDistributionRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeShardDistributionResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -245,6 +293,30 @@ func (c *adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.
 	return ap2, err
 }
 
+func (c *adminClient) DiffDynamicConfigVersions(ctx context.Context, dp1 *types.DiffDynamicConfigVersionsRequest, p1 ...yarpc.CallOption) (dp2 *types.DiffDynamicConfigVersionsResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientDiffDynamicConfigVersionsScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientDiffDynamicConfigVersionsScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	dp2, err = c.client.DiffDynamicConfigVersions(ctx, dp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *adminClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types.GetDLQReplicationMessagesRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDLQReplicationMessagesResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -485,6 +557,30 @@ func (c *adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDyna
 	return lp2, err
 }
 
+func (c *adminClient) ListDynamicConfigVersions(ctx context.Context, lp1 *types.ListDynamicConfigVersionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigVersionsResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientListDynamicConfigVersionsScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientListDynamicConfigVersionsScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	lp2, err = c.client.ListDynamicConfigVersions(ctx, lp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return lp2, err
+}
+
 func (c *adminClient) ListOperationalDynamicConfig(ctx context.Context, lp1 *types.ListOperationalDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListOperationalDynamicConfigResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -557,6 +653,30 @@ func (c *adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQM
 	return mp2, err
 }
 
+func (c *adminClient) MoveTaskListBacklog(ctx context.Context, mp1 *types.MoveTaskListBacklogRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientMoveTaskListBacklogScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientMoveTaskListBacklogScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	mp2, err = c.client.MoveTaskListBacklog(ctx, mp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return mp2, err
+}
+
 func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -725,6 +845,30 @@ func (c *adminClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueReque
 	return err
 }
 
+func (c *adminClient) ResolveDynamicConfig(ctx context.Context, rp1 *types.ResolveDynamicConfigRequest, p1 ...yarpc.CallOption) (rp2 *types.ResolveDynamicConfigResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientResolveDynamicConfigScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientResolveDynamicConfigScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	rp2, err = c.client.ResolveDynamicConfig(ctx, rp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return rp2, err
+}
+
 func (c *adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.RestoreDynamicConfigRequest, p1 ...yarpc.CallOption) (err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -773,6 +917,30 @@ func (c *adminClient) RestoreOperationalDynamicConfig(ctx context.Context, rp1 *
 	return err
 }
 
+func (c *adminClient) RollbackDynamicConfig(ctx context.Context, rp1 *types.RollbackDynamicConfigRequest, p1 ...yarpc.CallOption) (rp2 *types.RollbackDynamicConfigResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientRollbackDynamicConfigScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientRollbackDynamicConfigScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	rp2, err = c.client.RollbackDynamicConfig(ctx, rp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return rp2, err
+}
+
 func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
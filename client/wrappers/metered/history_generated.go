@@ -293,6 +293,30 @@ func (c *historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.G
 	return gp2, err
 }
 
+func (c *historyClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetReplicationStatusResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.HistoryClientGetReplicationStatusScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.HistoryClientGetReplicationStatusScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp2, err = c.client.GetReplicationStatus(ctx, gp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
 func (c *historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -106,6 +106,31 @@ func (c *matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.C
 	return err
 }
 
+func (c *matchingClient) DeleteTaskListBacklogTasks(ctx context.Context, mp1 *types.MatchingDeleteTaskListBacklogTasksRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDeleteTaskListBacklogTasksResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientDeleteTaskListBacklogTasksScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientDeleteTaskListBacklogTasksScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	mp2, err = c.client.DeleteTaskListBacklogTasks(ctx, mp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return mp2, err
+}
+
 func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -181,6 +206,31 @@ func (c *matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.
 	return lp1, err
 }
 
+func (c *matchingClient) MoveTaskListBacklog(ctx context.Context, mp1 *types.MatchingMoveTaskListBacklogRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientMoveTaskListBacklogScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientMoveTaskListBacklogScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	clientLatencyStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	mp2, err = c.client.MoveTaskListBacklog(ctx, mp1, p1...)
+	sw.Stop()
+	scope.ExponentialHistogram(metrics.CadenceClientLatencyHistogram, time.Since(clientLatencyStart))
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return mp2, err
+}
+
 func (c *matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -56,6 +56,17 @@ func (c *adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQM
 	return resp, err
 }
 
+func (c *adminClient) DeleteTaskListBacklogTasks(ctx context.Context, dp1 *types.DeleteTaskListBacklogTasksRequest, p1 ...yarpc.CallOption) (dp2 *types.DeleteTaskListBacklogTasksResponse, err error) {
+	var resp *types.DeleteTaskListBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteTaskListBacklogTasks(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	var resp *types.AdminDeleteWorkflowResponse
 	op := func(ctx context.Context) error {
@@ -100,6 +111,17 @@ func (c *adminClient) DescribeQueue(ctx context.Context, dp1 *types.DescribeQueu
 	return resp, err
 }
 
+func (c *adminClient) DescribeReplicationStatus(ctx context.Context, dp1 *types.DescribeReplicationStatusRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeReplicationStatusResponse, err error) {
+	var resp *types.DescribeReplicationStatusResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeReplicationStatus(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) DescribeShardDistribution(ctx context.Context, dp1 *types.DescribeShardDistributionRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeShardDistributionResponse, err error) {
 	var resp *types.DescribeShardDistributionResponse
 	op := func(ctx context.Context) error {
@@ -122,6 +144,17 @@ func (c *adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.
 	return resp, err
 }
 
+func (c *adminClient) DiffDynamicConfigVersions(ctx context.Context, dp1 *types.DiffDynamicConfigVersionsRequest, p1 ...yarpc.CallOption) (dp2 *types.DiffDynamicConfigVersionsResponse, err error) {
+	var resp *types.DiffDynamicConfigVersionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DiffDynamicConfigVersions(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types.GetDLQReplicationMessagesRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDLQReplicationMessagesResponse, err error) {
 	var resp *types.GetDLQReplicationMessagesResponse
 	op := func(ctx context.Context) error {
@@ -232,6 +265,17 @@ func (c *adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDyna
 	return resp, err
 }
 
+func (c *adminClient) ListDynamicConfigVersions(ctx context.Context, lp1 *types.ListDynamicConfigVersionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigVersionsResponse, err error) {
+	var resp *types.ListDynamicConfigVersionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDynamicConfigVersions(ctx, lp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) ListOperationalDynamicConfig(ctx context.Context, lp1 *types.ListOperationalDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListOperationalDynamicConfigResponse, err error) {
 	var resp *types.ListOperationalDynamicConfigResponse
 	op := func(ctx context.Context) error {
@@ -265,6 +309,17 @@ func (c *adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQM
 	return resp, err
 }
 
+func (c *adminClient) MoveTaskListBacklog(ctx context.Context, mp1 *types.MoveTaskListBacklogRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	var resp *types.MoveTaskListBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveTaskListBacklog(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.PurgeDLQMessages(ctx, pp1, p1...)
@@ -318,6 +373,17 @@ func (c *adminClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueReque
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) ResolveDynamicConfig(ctx context.Context, rp1 *types.ResolveDynamicConfigRequest, p1 ...yarpc.CallOption) (rp2 *types.ResolveDynamicConfigResponse, err error) {
+	var resp *types.ResolveDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResolveDynamicConfig(ctx, rp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.RestoreDynamicConfigRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.RestoreDynamicConfig(ctx, rp1, p1...)
@@ -332,6 +398,17 @@ func (c *adminClient) RestoreOperationalDynamicConfig(ctx context.Context, rp1 *
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) RollbackDynamicConfig(ctx context.Context, rp1 *types.RollbackDynamicConfigRequest, p1 ...yarpc.CallOption) (rp2 *types.RollbackDynamicConfigResponse, err error) {
+	var resp *types.RollbackDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RollbackDynamicConfig(ctx, rp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	var resp *types.UpdateDomainAsyncWorkflowConfiguratonResponse
 	op := func(ctx context.Context) error {
@@ -148,6 +148,17 @@ func (c *historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.G
 	return resp, err
 }
 
+func (c *historyClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetReplicationStatusResponse, err error) {
+	var resp *types.GetReplicationStatusResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetReplicationStatus(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	var resp *types.MergeDLQMessagesResponse
 	op := func(ctx context.Context) error {
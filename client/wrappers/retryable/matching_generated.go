@@ -60,6 +60,17 @@ func (c *matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.C
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *matchingClient) DeleteTaskListBacklogTasks(ctx context.Context, mp1 *types.MatchingDeleteTaskListBacklogTasksRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDeleteTaskListBacklogTasksResponse, err error) {
+	var resp *types.MatchingDeleteTaskListBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteTaskListBacklogTasks(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	var resp *types.DescribeTaskListResponse
 	op := func(ctx context.Context) error {
@@ -93,6 +104,17 @@ func (c *matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.
 	return resp, err
 }
 
+func (c *matchingClient) MoveTaskListBacklog(ctx context.Context, mp1 *types.MatchingMoveTaskListBacklogRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	var resp *types.MoveTaskListBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveTaskListBacklog(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	var resp *types.MatchingPollForActivityTaskResponse
 	op := func(ctx context.Context) error {
@@ -27,6 +27,10 @@ func (g adminClient) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQMe
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
+func (g adminClient) DeleteTaskListBacklogTasks(ctx context.Context, dp1 *types.DeleteTaskListBacklogTasksRequest, p1 ...yarpc.CallOption) (dp2 *types.DeleteTaskListBacklogTasksResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest, p1 ...yarpc.CallOption) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	response, err := g.c.DeleteWorkflow(ctx, thrift.FromAdminDeleteWorkflowRequest(ap1), p1...)
 	return thrift.ToAdminDeleteWorkflowResponse(response), thrift.ToError(err)
@@ -47,6 +51,10 @@ func (g adminClient) DescribeQueue(ctx context.Context, dp1 *types.DescribeQueue
 	return thrift.ToAdminDescribeQueueResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) DescribeReplicationStatus(ctx context.Context, dp1 *types.DescribeReplicationStatusRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeReplicationStatusResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g adminClient) DescribeShardDistribution(ctx context.Context, dp1 *types.DescribeShardDistributionRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeShardDistributionResponse, err error) {
 	response, err := g.c.DescribeShardDistribution(ctx, thrift.FromAdminDescribeShardDistributionRequest(dp1), p1...)
 	return thrift.ToAdminDescribeShardDistributionResponse(response), thrift.ToError(err)
@@ -57,6 +65,10 @@ func (g adminClient) DescribeWorkflowExecution(ctx context.Context, ap1 *types.A
 	return thrift.ToAdminDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) DiffDynamicConfigVersions(ctx context.Context, dp1 *types.DiffDynamicConfigVersionsRequest, p1 ...yarpc.CallOption) (dp2 *types.DiffDynamicConfigVersionsResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g adminClient) GetDLQReplicationMessages(ctx context.Context, gp1 *types.GetDLQReplicationMessagesRequest, p1 ...yarpc.CallOption) (gp2 *types.GetDLQReplicationMessagesResponse, err error) {
 	response, err := g.c.GetDLQReplicationMessages(ctx, thrift.FromAdminGetDLQReplicationMessagesRequest(gp1), p1...)
 	return thrift.ToAdminGetDLQReplicationMessagesResponse(response), thrift.ToError(err)
@@ -107,6 +119,10 @@ func (g adminClient) ListDynamicConfig(ctx context.Context, lp1 *types.ListDynam
 	return thrift.ToAdminListDynamicConfigResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) ListDynamicConfigVersions(ctx context.Context, lp1 *types.ListDynamicConfigVersionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListDynamicConfigVersionsResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g adminClient) ListOperationalDynamicConfig(ctx context.Context, lp1 *types.ListOperationalDynamicConfigRequest, p1 ...yarpc.CallOption) (lp2 *types.ListOperationalDynamicConfigResponse, err error) {
 	response, err := g.c.ListOperationalDynamicConfig(ctx, thrift.FromAdminListOperationalDynamicConfigRequest(lp1), p1...)
 	return thrift.ToAdminListOperationalDynamicConfigResponse(response), thrift.ToError(err)
@@ -122,6 +138,10 @@ func (g adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMe
 	return thrift.ToAdminMergeDLQMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) MoveTaskListBacklog(ctx context.Context, mp1 *types.MoveTaskListBacklogRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.PurgeDLQMessages(ctx, thrift.FromAdminPurgeDLQMessagesRequest(pp1), p1...)
 	return thrift.ToError(err)
@@ -157,9 +177,8 @@ func (g adminClient) ResetQueue(ctx context.Context, rp1 *types.ResetQueueReques
 	return thrift.ToError(err)
 }
 
-func (g adminClient) RestoreDynamicConfig(ctx context.Context, rp1 *types.RestoreDynamicConfigRequest, p1 ...yarpc.CallOption) (err error) {
-	err = g.c.RestoreDynamicConfig(ctx, thrift.FromAdminRestoreDynamicConfigRequest(rp1), p1...)
-	return thrift.ToError(err)
+func (g adminClient) ResolveDynamicConfig(ctx context.Context, rp1 *types.ResolveDynamicConfigRequest, p1 ...yarpc.CallOption) (rp2 *types.ResolveDynamicConfigResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
 
 func (g adminClient) RestoreOperationalDynamicConfig(ctx context.Context, rp1 *types.RestoreOperationalDynamicConfigRequest, p1 ...yarpc.CallOption) (err error) {
@@ -167,6 +186,10 @@ func (g adminClient) RestoreOperationalDynamicConfig(ctx context.Context, rp1 *t
 	return thrift.ToError(err)
 }
 
+func (g adminClient) RollbackDynamicConfig(ctx context.Context, rp1 *types.RollbackDynamicConfigRequest, p1 ...yarpc.CallOption) (rp2 *types.RollbackDynamicConfigResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g adminClient) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (up1 *types.UpdateDomainAsyncWorkflowConfiguratonResponse, err error) {
 	response, err := g.c.UpdateDomainAsyncWorkflowConfiguraton(ctx, thrift.FromAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request), opts...)
 	return thrift.ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), thrift.ToError(err)
@@ -177,11 +200,6 @@ func (g adminClient) UpdateDomainIsolationGroups(ctx context.Context, request *t
 	return thrift.ToAdminUpdateDomainIsolationGroupsResponse(response), thrift.ToError(err)
 }
 
-func (g adminClient) UpdateDynamicConfig(ctx context.Context, up1 *types.UpdateDynamicConfigRequest, p1 ...yarpc.CallOption) (err error) {
-	err = g.c.UpdateDynamicConfig(ctx, thrift.FromAdminUpdateDynamicConfigRequest(up1), p1...)
-	return thrift.ToError(err)
-}
-
 func (g adminClient) UpdateGlobalIsolationGroups(ctx context.Context, request *types.UpdateGlobalIsolationGroupsRequest, opts ...yarpc.CallOption) (up1 *types.UpdateGlobalIsolationGroupsResponse, err error) {
 	response, err := g.c.UpdateGlobalIsolationGroups(ctx, thrift.FromAdminUpdateGlobalIsolationGroupsRequest(request), opts...)
 	return thrift.ToAdminUpdateGlobalIsolationGroupsResponse(response), thrift.ToError(err)
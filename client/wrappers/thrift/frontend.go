@@ -0,0 +1,103 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package thrift
+
+import (
+	"context"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+// PollForActivityTask, PollForDecisionTask, DescribeTaskList, RespondDecisionTaskCompleted, DescribeDomain,
+// ListFailoverHistory and DescribeWorkflowExecution are written by hand, they copy the values which are not in the IDL yet from the response headers to the response.
+// StartWorkflowExecution and SignalWithStartWorkflowExecution send the values of the request which are not in the IDL yet in request headers.
+
+func (g frontendClient) PollForActivityTask(ctx context.Context, request *types.PollForActivityTaskRequest, opts ...yarpc.CallOption) (*types.PollForActivityTaskResponse, error) {
+	var headers map[string]string
+	response, err := g.c.PollForActivityTask(ctx, thrift.FromPollForActivityTaskRequest(request), append(opts, yarpc.ResponseHeaders(&headers))...)
+	resp := thrift.ToPollForActivityTaskResponse(response)
+	frontend.ReadPollForActivityTaskResponseHeaders(resp, headers)
+	return resp, thrift.ToError(err)
+}
+
+func (g frontendClient) PollForDecisionTask(ctx context.Context, request *types.PollForDecisionTaskRequest, opts ...yarpc.CallOption) (*types.PollForDecisionTaskResponse, error) {
+	var headers map[string]string
+	response, err := g.c.PollForDecisionTask(ctx, thrift.FromPollForDecisionTaskRequest(request), append(opts, yarpc.ResponseHeaders(&headers))...)
+	resp := thrift.ToPollForDecisionTaskResponse(response)
+	frontend.ReadPollForDecisionTaskResponseHeaders(resp, headers)
+	return resp, thrift.ToError(err)
+}
+
+func (g frontendClient) DescribeTaskList(ctx context.Context, request *types.DescribeTaskListRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
+	var headers map[string]string
+	response, err := g.c.DescribeTaskList(ctx, thrift.FromDescribeTaskListRequest(request), append(opts, yarpc.ResponseHeaders(&headers))...)
+	resp := thrift.ToDescribeTaskListResponse(response)
+	frontend.ReadDescribeTaskListResponseHeaders(resp, headers)
+	return resp, thrift.ToError(err)
+}
+
+func (g frontendClient) RespondDecisionTaskCompleted(ctx context.Context, request *types.RespondDecisionTaskCompletedRequest, opts ...yarpc.CallOption) (*types.RespondDecisionTaskCompletedResponse, error) {
+	var headers map[string]string
+	response, err := g.c.RespondDecisionTaskCompleted(ctx, thrift.FromRespondDecisionTaskCompletedRequest(request), append(opts, yarpc.ResponseHeaders(&headers))...)
+	resp := thrift.ToRespondDecisionTaskCompletedResponse(response)
+	frontend.ReadPollForDecisionTaskResponseHeaders(resp.GetDecisionTask(), headers)
+	return resp, thrift.ToError(err)
+}
+
+func (g frontendClient) DescribeDomain(ctx context.Context, request *types.DescribeDomainRequest, opts ...yarpc.CallOption) (*types.DescribeDomainResponse, error) {
+	var headers map[string]string
+	response, err := g.c.DescribeDomain(ctx, thrift.FromDescribeDomainRequest(request), append(opts, yarpc.ResponseHeaders(&headers))...)
+	resp := thrift.ToDescribeDomainResponse(response)
+	frontend.ReadDescribeDomainResponseHeaders(resp, headers)
+	return resp, thrift.ToError(err)
+}
+
+func (g frontendClient) ListFailoverHistory(ctx context.Context, request *types.ListFailoverHistoryRequest, opts ...yarpc.CallOption) (*types.ListFailoverHistoryResponse, error) {
+	var headers map[string]string
+	response, err := g.c.ListFailoverHistory(ctx, thrift.FromListFailoverHistoryRequest(request), append(opts, yarpc.ResponseHeaders(&headers))...)
+	resp := thrift.ToListFailoverHistoryResponse(response)
+	frontend.ReadListFailoverHistoryResponseHeaders(resp, headers)
+	return resp, thrift.ToError(err)
+}
+
+func (g frontendClient) DescribeWorkflowExecution(ctx context.Context, request *types.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
+	var headers map[string]string
+	response, err := g.c.DescribeWorkflowExecution(ctx, thrift.FromDescribeWorkflowExecutionRequest(request), append(opts, yarpc.ResponseHeaders(&headers))...)
+	resp := thrift.ToDescribeWorkflowExecutionResponse(response)
+	frontend.ReadDescribeWorkflowExecutionResponseHeaders(resp, headers)
+	return resp, thrift.ToError(err)
+}
+
+func (g frontendClient) StartWorkflowExecution(ctx context.Context, request *types.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+	response, err := g.c.StartWorkflowExecution(ctx, thrift.FromStartWorkflowExecutionRequest(request), frontend.WithStartWorkflowExecutionRequestHeaders(request, opts)...)
+	return thrift.ToStartWorkflowExecutionResponse(response), thrift.ToError(err)
+}
+
+func (g frontendClient) SignalWithStartWorkflowExecution(ctx context.Context, request *types.SignalWithStartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+	response, err := g.c.SignalWithStartWorkflowExecution(ctx, thrift.FromSignalWithStartWorkflowExecutionRequest(request), frontend.WithSignalWithStartWorkflowExecutionRequestHeaders(request, opts)...)
+	return thrift.ToSignalWithStartWorkflowExecutionResponse(response), thrift.ToError(err)
+}
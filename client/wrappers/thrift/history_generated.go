@@ -67,6 +67,10 @@ func (g historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.Ge
 	return thrift.ToHistoryGetReplicationMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g historyClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetReplicationStatusResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	response, err := g.c.MergeDLQMessages(ctx, thrift.FromHistoryMergeDLQMessagesRequest(mp1), p1...)
 	return thrift.ToHistoryMergeDLQMessagesResponse(response), thrift.ToError(err)
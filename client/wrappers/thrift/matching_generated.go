@@ -34,6 +34,10 @@ func (g matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.Ca
 	return thrift.ToError(err)
 }
 
+func (g matchingClient) DeleteTaskListBacklogTasks(ctx context.Context, mp1 *types.MatchingDeleteTaskListBacklogTasksRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDeleteTaskListBacklogTasksResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, thrift.FromMatchingDescribeTaskListRequest(mp1), p1...)
 	return thrift.ToMatchingDescribeTaskListResponse(response), thrift.ToError(err)
@@ -49,6 +53,10 @@ func (g matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.M
 	return thrift.ToMatchingListTaskListPartitionsResponse(response), thrift.ToError(err)
 }
 
+func (g matchingClient) MoveTaskListBacklog(ctx context.Context, mp1 *types.MatchingMoveTaskListBacklogRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, thrift.FromMatchingPollForActivityTaskRequest(mp1), p1...)
 	return thrift.ToMatchingPollForActivityTaskResponse(response), thrift.ToError(err)
@@ -89,6 +89,12 @@ func (c *historyClient) GetReplicationMessages(ctx context.Context, gp1 *types.G
 	return c.client.GetReplicationMessages(ctx, gp1, p1...)
 }
 
+func (c *historyClient) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest, p1 ...yarpc.CallOption) (gp2 *types.GetReplicationStatusResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.GetReplicationStatus(ctx, gp1, p1...)
+}
+
 func (c *historyClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMessagesRequest, p1 ...yarpc.CallOption) (mp2 *types.MergeDLQMessagesResponse, err error) {
 	return c.client.MergeDLQMessages(ctx, mp1, p1...)
 }
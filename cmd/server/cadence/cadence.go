@@ -172,6 +172,7 @@ func BuildCLI(releaseVersion string, gitRevision string) *cli.App {
 				return runUpdateSchema(c.Context, factory, logger, clock.NewRealTimeSource())
 			},
 		},
+		newConfigCommand(),
 	}
 
 	return app
@@ -0,0 +1,313 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	yamlv2 "gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/config"
+)
+
+const redactedValue = "<redacted>"
+
+// secretKeyPattern matches the config keys whose values are redacted by config dump
+var secretKeyPattern = regexp.MustCompile(`(?i)password|secret|token|privatekey|apikey|accesskey|credential`)
+
+func newConfigCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "config",
+		Usage: "validate or show the config of the server",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "validate",
+				Usage: "validate the config merged from the base, env and zone files, without starting the server",
+				Action: func(c *cli.Context) error {
+					var cfg config.Config
+					if err := config.Load(getEnvironment(c), getConfigDir(c), getZone(c), &cfg); err != nil {
+						return fmt.Errorf("load config: %w", err)
+					}
+					if err := validateConfig(&cfg); err != nil {
+						return fmt.Errorf("invalid config:\n%w", err)
+					}
+					fmt.Fprintln(c.App.Writer, "config is valid")
+					return nil
+				},
+			},
+			{
+				Name:  "dump",
+				Usage: "print the config merged from the base, env and zone files, with env variables expanded and secrets redacted",
+				Action: func(c *cli.Context) error {
+					merged, err := config.LoadMerged(getEnvironment(c), getConfigDir(c), getZone(c))
+					if err != nil {
+						return fmt.Errorf("load config: %w", err)
+					}
+					return dumpConfig(c.App.Writer, merged)
+				},
+			},
+		},
+	}
+}
+
+// validateConfig runs the validation done when the server starts, then checks the parts of the config which
+// are otherwise only found to be wrong once the server or one of its connections starts
+func validateConfig(cfg *config.Config) error {
+	if cfg.ClusterGroupMetadata == nil && cfg.ClusterMetadata == nil {
+		return errors.New("clusterGroupMetadata is empty")
+	}
+	var errs []error
+	if err := cfg.ValidateAndFillDefaults(); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, validateDatastores(cfg.Persistence)...)
+	errs = append(errs, validateRPC(cfg.Services)...)
+	errs = append(errs, validateTLSFiles(cfg)...)
+	errs = append(errs, validateArchivalURIs(cfg.DomainDefaults.Archival)...)
+	errs = append(errs, validateClusterGroup(cfg)...)
+	return errors.Join(errs...)
+}
+
+func validateDatastores(persistence config.Persistence) []error {
+	var errs []error
+	if persistence.NumHistoryShards <= 0 {
+		errs = append(errs, fmt.Errorf("persistence: numHistoryShards must be positive, got %d", persistence.NumHistoryShards))
+	}
+	for _, name := range sortedKeys(persistence.DataStores) {
+		ds := persistence.DataStores[name]
+		// unknown keys are ignored when the config is loaded, so a misspelled datastore type leaves it empty
+		if ds.NoSQL == nil && ds.Cassandra == nil && ds.ShardedNoSQL == nil && ds.SQL == nil && ds.ElasticSearch == nil && ds.Pinot == nil {
+			errs = append(errs, fmt.Errorf("persistence: datastore %s has no config", name))
+		}
+	}
+	if store := persistence.AdvancedVisibilityStore; store != "" {
+		ds, ok := persistence.DataStores[store]
+		if !ok {
+			errs = append(errs, fmt.Errorf("persistence: missing config for advanced visibility datastore %s", store))
+		} else if ds.ElasticSearch == nil && ds.Pinot == nil {
+			errs = append(errs, fmt.Errorf("persistence: advanced visibility datastore %s must be elasticsearch or pinot", store))
+		}
+	}
+	return errs
+}
+
+// validateRPC checks the addresses the services bind to. All services can run in a single process, so a port
+// can only be used once across services.
+func validateRPC(services map[string]config.Service) []error {
+	var errs []error
+	usedBy := make(map[int]string)
+	usePort := func(port int, name string) {
+		if port == 0 {
+			return
+		}
+		if other, ok := usedBy[port]; ok {
+			errs = append(errs, fmt.Errorf("services: port %d is used by both %s and %s", port, other, name))
+			return
+		}
+		usedBy[port] = name
+	}
+	for _, name := range sortedKeys(services) {
+		rpc := services[name].RPC
+		if rpc.Port == 0 {
+			errs = append(errs, fmt.Errorf("services: %s.rpc.port is not set", name))
+		}
+		if rpc.BindOnLocalHost && rpc.BindOnIP != "" {
+			errs = append(errs, fmt.Errorf("services: %s.rpc: bindOnLocalHost and bindOnIP are mutually exclusive", name))
+		}
+		if ip := net.ParseIP(rpc.BindOnIP); rpc.BindOnIP != "" && (ip == nil || ip.To4() == nil) {
+			errs = append(errs, fmt.Errorf("services: %s.rpc.bindOnIP %q is not an IPv4 address", name, rpc.BindOnIP))
+		}
+		usePort(int(rpc.Port), name+".rpc.port")
+		usePort(int(rpc.GRPCPort), name+".rpc.grpcPort")
+		if rpc.HTTP != nil {
+			usePort(int(rpc.HTTP.Port), name+".rpc.http.port")
+		}
+		usePort(services[name].PProf.Port, name+".pprof.port")
+	}
+	return errs
+}
+
+// validateTLSFiles loads the certificates and keys of every enabled TLS config
+func validateTLSFiles(cfg *config.Config) []error {
+	var errs []error
+	visitTLS(reflect.ValueOf(cfg), "", func(path string, tls config.TLS) {
+		if !tls.Enabled {
+			return
+		}
+		if (tls.CertFile == "") != (tls.KeyFile == "") {
+			errs = append(errs, fmt.Errorf("%s: certFile and keyFile must be set together", path))
+			return
+		}
+		if _, err := tls.ToTLSConfig(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+		}
+	})
+	return errs
+}
+
+// visitTLS walks the config and calls visit with the yaml path of each TLS config
+func visitTLS(v reflect.Value, path string, visit func(string, config.TLS)) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			visitTLS(v.Elem(), path, visit)
+		}
+	case reflect.Struct:
+		if tls, ok := v.Interface().(config.TLS); ok {
+			visit(path, tls)
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+			if name == "" {
+				name = field.Name
+			}
+			visitTLS(v.Field(i), joinPath(path, name), visit)
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			visitTLS(v.MapIndex(key), joinPath(path, fmt.Sprint(key.Interface())), visit)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			visitTLS(v.Index(i), fmt.Sprintf("%s[%d]", path, i), visit)
+		}
+	}
+}
+
+func validateArchivalURIs(defaults config.ArchivalDomainDefaults) []error {
+	var errs []error
+	for name, uri := range map[string]string{
+		"domainDefaults.archival.history.URI":    defaults.History.URI,
+		"domainDefaults.archival.visibility.URI": defaults.Visibility.URI,
+	} {
+		if uri == "" {
+			continue
+		}
+		if parsed, err := url.ParseRequestURI(uri); err != nil || parsed.Scheme == "" {
+			errs = append(errs, fmt.Errorf("%s: %q is not a valid URI", name, uri))
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errs
+}
+
+// validateClusterGroup checks the addresses of the cluster group, the rest is checked by ClusterGroupMetadata.Validate
+func validateClusterGroup(cfg *config.Config) []error {
+	var errs []error
+	metadata := cfg.ClusterGroupMetadata
+	if current, ok := metadata.ClusterGroup[metadata.CurrentClusterName]; ok && !current.Enabled {
+		errs = append(errs, fmt.Errorf("clusterGroupMetadata: current cluster %s is not enabled", metadata.CurrentClusterName))
+	}
+	for _, name := range sortedKeys(metadata.ClusterGroup) {
+		info := metadata.ClusterGroup[name]
+		if !info.Enabled || info.RPCAddress == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(info.RPCAddress); err != nil {
+			errs = append(errs, fmt.Errorf("clusterGroupMetadata: cluster %s: rpcAddress %q is not host:port", name, info.RPCAddress))
+		}
+	}
+	if _, _, err := net.SplitHostPort(cfg.PublicClient.HostPort); err != nil {
+		errs = append(errs, fmt.Errorf("publicClient: hostPort %q is not host:port", cfg.PublicClient.HostPort))
+	}
+	return errs
+}
+
+// dumpConfig prints the config as yaml, with the values of secret keys and the passwords of URLs redacted
+func dumpConfig(w io.Writer, merged yamlv2.MapSlice) error {
+	out, err := yamlv2.Marshal(redactSecrets(merged))
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+func redactSecrets(value interface{}) interface{} {
+	switch v := value.(type) {
+	case yamlv2.MapSlice:
+		result := make(yamlv2.MapSlice, 0, len(v))
+		for _, item := range v {
+			if key, ok := item.Key.(string); ok && secretKeyPattern.MatchString(key) && item.Value != nil && !isCollection(item.Value) {
+				result = append(result, yamlv2.MapItem{Key: item.Key, Value: redactedValue})
+				continue
+			}
+			result = append(result, yamlv2.MapItem{Key: item.Key, Value: redactSecrets(item.Value)})
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			result = append(result, redactSecrets(item))
+		}
+		return result
+	case string:
+		if parsed, err := url.Parse(v); err == nil && parsed.User != nil {
+			return parsed.Redacted()
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isCollection(value interface{}) bool {
+	switch value.(type) {
+	case yamlv2.MapSlice, []interface{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -61,6 +61,7 @@ import (
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging/kafka"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/peerprovider"
 	"github.com/uber/cadence/common/peerprovider/ringpopprovider"
 	pnt "github.com/uber/cadence/common/pinot"
 	"github.com/uber/cadence/common/resource"
@@ -71,7 +72,10 @@ import (
 	"github.com/uber/cadence/service/matching"
 	"github.com/uber/cadence/service/worker"
 	diagnosticsInvariant "github.com/uber/cadence/service/worker/diagnostics/invariant"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/blocked"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/decision"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/historylimit"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/retry"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
 )
@@ -177,19 +181,9 @@ func (s *server) startService() common.Daemon {
 
 	params.RPCFactory = s.rpcFactory
 
-	peerProvider, err := ringpopprovider.New(
-		params.Name,
-		&s.cfg.Ringpop,
-		s.rpcFactory.GetTChannel(),
-		membership.PortMap{
-			membership.PortGRPC:     svcCfg.RPC.GRPCPort,
-			membership.PortTchannel: svcCfg.RPC.Port,
-		},
-		params.Logger,
-	)
-
+	peerProvider, err := s.newPeerProvider(params.Name, svcCfg, params.Logger)
 	if err != nil {
-		s.logger.Fatal("ringpop provider failed", tag.Error(err))
+		s.logger.Fatal("peer provider failed", tag.Error(err))
 	}
 
 	shardDistributorClient := s.createShardDistributorClient(params)
@@ -308,7 +302,19 @@ func (s *server) startService() common.Daemon {
 	}
 
 	params.KafkaConfig = s.cfg.Kafka
-	params.DiagnosticsInvariants = []diagnosticsInvariant.Invariant{timeout.NewInvariant(timeout.Params{Client: params.PublicClient}), failure.NewInvariant(), retry.NewInvariant()}
+	params.DiagnosticsInvariants = []diagnosticsInvariant.Invariant{
+		timeout.NewInvariant(timeout.Params{Client: params.PublicClient}),
+		failure.NewInvariant(),
+		retry.NewInvariant(),
+		decision.NewInvariant(),
+		blocked.NewInvariant(),
+		historylimit.NewInvariant(historylimit.Params{
+			HistorySizeLimitWarn:   s.dynamicCollection.GetIntPropertyFilteredByDomain(dynamicproperties.HistorySizeLimitWarn),
+			HistorySizeLimitError:  s.dynamicCollection.GetIntPropertyFilteredByDomain(dynamicproperties.HistorySizeLimitError),
+			HistoryCountLimitWarn:  s.dynamicCollection.GetIntPropertyFilteredByDomain(dynamicproperties.HistoryCountLimitWarn),
+			HistoryCountLimitError: s.dynamicCollection.GetIntPropertyFilteredByDomain(dynamicproperties.HistoryCountLimitError),
+		}),
+	}
 	params.ShardDistributorMatchingConfig = s.cfg.ShardDistributorMatchingConfig
 
 	params.Logger.Info("Starting service " + s.name)
@@ -396,6 +402,23 @@ func execute(d common.Daemon, doneC chan struct{}) {
 	close(doneC)
 }
 
+// newPeerProvider creates the peer provider configured in membership.provider, or ringpop if none is configured
+func (s *server) newPeerProvider(serviceName string, svcCfg config.Service, logger log.Logger) (membership.PeerProvider, error) {
+	portMap := membership.PortMap{
+		membership.PortGRPC:     svcCfg.RPC.GRPCPort,
+		membership.PortTchannel: svcCfg.RPC.Port,
+	}
+	if len(s.cfg.Membership.Provider) > 0 {
+		return peerprovider.New(s.cfg.Membership.Provider, peerprovider.Container{
+			Service: serviceName,
+			Channel: s.rpcFactory.GetTChannel(),
+			Logger:  logger,
+			Portmap: portMap,
+		}).Provider()
+	}
+	return ringpopprovider.New(serviceName, &s.cfg.Ringpop, s.rpcFactory.GetTChannel(), portMap, logger)
+}
+
 // there are multiple circumstances:
 // 1. advanced visibility store == elasticsearch, use ESClient and visibilityDualManager
 // 2. advanced visibility store == pinot and in process of migration, use ESClient, PinotClient and and visibilityTripleManager
@@ -32,6 +32,8 @@ import (
 	_ "github.com/uber/cadence/common/archiver/gcloud"                                      // needed to load the optional gcloud archiver plugin
 	_ "github.com/uber/cadence/common/asyncworkflow/queue/kafka"                            // needed to load kafka asyncworkflow queue
 	_ "github.com/uber/cadence/common/dynamicconfig/openfeatureprovider/unleash"            // needed to load the optional unleash openfeature provider plugin
+	_ "github.com/uber/cadence/common/peerprovider/dnsprovider"                             // needed to load the optional dns-srv peer provider plugin
+	_ "github.com/uber/cadence/common/peerprovider/staticprovider"                          // needed to load the optional static peer provider plugin
 	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra"              // needed to load cassandra plugin
 	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql/public" // needed to load the default gocql client
 	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/cloudsql-mysql"             // needed to load cloudsql-mysql plugin
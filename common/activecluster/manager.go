@@ -46,6 +46,7 @@ const (
 	GetActiveClusterInfoByWorkflowOpName                    = "GetActiveClusterInfoByWorkflow"
 	GetActiveClusterSelectionPolicyForWorkflowOpName        = "GetActiveClusterSelectionPolicyForWorkflow"
 	GetActiveClusterSelectionPolicyForCurrentWorkflowOpName = "GetActiveClusterSelectionPolicyForCurrentWorkflow"
+	ResolveActiveClusterSelectionPolicyOpName               = "ResolveActiveClusterSelectionPolicy"
 	DomainIDToDomainFnErrorReason                           = "domain_id_to_name_fn_error"
 
 	workflowPolicyCacheTTL      = 10 * time.Second
@@ -314,3 +315,30 @@ func (m *managerImpl) GetActiveClusterSelectionPolicyForCurrentWorkflow(ctx cont
 	}
 	return nil, false, nil
 }
+
+func (m *managerImpl) ResolveActiveClusterSelectionPolicy(ctx context.Context, domainID, wfID string, policy *types.ActiveClusterSelectionPolicy) (res *types.ActiveClusterSelectionPolicy, e error) {
+	hashPolicy := policy.GetWorkflowIDHash()
+	if hashPolicy == nil {
+		return policy, nil
+	}
+
+	d, scope, err := m.getDomainAndScope(domainID, ResolveActiveClusterSelectionPolicyOpName)
+	if err != nil {
+		return nil, err
+	}
+	defer m.handleError(scope, &e, time.Now())
+
+	clusterAttribute, err := selectClusterAttributeByWorkflowID(domainID, d, hashPolicy.Scope, wfID)
+	if err != nil {
+		return nil, err
+	}
+	m.logger.Debug("ResolveActiveClusterSelectionPolicy: selected cluster attribute by workflow ID hash",
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(wfID),
+		tag.Dynamic("clusterAttribute", clusterAttribute),
+	)
+	return &types.ActiveClusterSelectionPolicy{
+		ClusterAttribute: clusterAttribute,
+		WorkflowIDHash:   hashPolicy,
+	}, nil
+}
@@ -102,3 +102,18 @@ func (mr *MockManagerMockRecorder) GetActiveClusterSelectionPolicyForWorkflow(ct
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveClusterSelectionPolicyForWorkflow", reflect.TypeOf((*MockManager)(nil).GetActiveClusterSelectionPolicyForWorkflow), ctx, domainID, wfID, rID)
 }
+
+// ResolveActiveClusterSelectionPolicy mocks base method.
+func (m *MockManager) ResolveActiveClusterSelectionPolicy(ctx context.Context, domainID, wfID string, policy *types.ActiveClusterSelectionPolicy) (*types.ActiveClusterSelectionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveActiveClusterSelectionPolicy", ctx, domainID, wfID, policy)
+	ret0, _ := ret[0].(*types.ActiveClusterSelectionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveActiveClusterSelectionPolicy indicates an expected call of ResolveActiveClusterSelectionPolicy.
+func (mr *MockManagerMockRecorder) ResolveActiveClusterSelectionPolicy(ctx, domainID, wfID, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveActiveClusterSelectionPolicy", reflect.TypeOf((*MockManager)(nil).ResolveActiveClusterSelectionPolicy), ctx, domainID, wfID, policy)
+}
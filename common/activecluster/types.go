@@ -50,6 +50,12 @@ type Manager interface {
 	// GetActiveClusterSelectionPolicyForCurrentWorkflow returns the active cluster selection policy for the current workflow
 	// if the workflow is NOT closed, returns policy and true, otherwise returns nil and false
 	GetActiveClusterSelectionPolicyForCurrentWorkflow(ctx context.Context, domainID, wfID string) (*types.ActiveClusterSelectionPolicy, bool, error)
+
+	// ResolveActiveClusterSelectionPolicy returns the policy a new run of the workflow is started with
+	// A policy with WorkflowIDHash gets the cluster attribute selected by hashing the workflow ID into the weighted cluster attributes of its scope,
+	// so new runs follow changes of the domain's cluster attribute weights
+	// Any other policy is returned as is
+	ResolveActiveClusterSelectionPolicy(ctx context.Context, domainID, wfID string, policy *types.ActiveClusterSelectionPolicy) (*types.ActiveClusterSelectionPolicy, error)
 }
 
 type ClusterAttributeNotFoundError struct {
@@ -0,0 +1,77 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activecluster
+
+import (
+	"math"
+	"sort"
+
+	"github.com/dgryski/go-farm"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/types"
+)
+
+// selectClusterAttributeByWorkflowID picks the cluster attribute of scope that workflow wfID is started in, using
+// weighted rendezvous hashing: every cluster attribute scores the workflow ID in proportion to its weight and the
+// highest score wins. Changing the weight of a cluster attribute only moves workflow IDs to or from that cluster attribute.
+func selectClusterAttributeByWorkflowID(domainID string, d *cache.DomainCacheEntry, scope, wfID string) (*types.ClusterAttribute, error) {
+	weights, err := d.GetClusterAttributeWeights()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	if activeClusters := d.GetReplicationConfig().ActiveClusters; activeClusters != nil {
+		for name := range activeClusters.AttributeScopes[scope].ClusterAttributes {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	selected := ""
+	maxScore := 0.0
+	for _, name := range names {
+		weight := weights.Weight(scope, name)
+		if weight <= 0 {
+			continue
+		}
+		score := float64(weight) / -math.Log(hashToUnitInterval(wfID, name))
+		if score > maxScore {
+			selected, maxScore = name, score
+		}
+	}
+	if selected == "" {
+		return nil, &ClusterAttributeNotFoundError{
+			DomainID:         domainID,
+			ClusterAttribute: &types.ClusterAttribute{Scope: scope},
+		}
+	}
+	return &types.ClusterAttribute{Scope: scope, Name: selected}, nil
+}
+
+// hashToUnitInterval maps the workflow ID and cluster attribute name to a uniformly distributed value in (0, 1)
+func hashToUnitInterval(wfID, name string) float64 {
+	hash := farm.Fingerprint64([]byte(wfID + "/" + name))
+	return (float64(hash>>11) + 0.5) / (1 << 53)
+}
@@ -0,0 +1,241 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package activecluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+var testRegionScope = &types.ActiveClusters{
+	AttributeScopes: map[string]types.ClusterAttributeScope{
+		"region": {
+			ClusterAttributes: map[string]types.ActiveClusterInfo{
+				"us-east": {ActiveClusterName: "cluster0", FailoverVersion: 0},
+				"us-west": {ActiveClusterName: "cluster1", FailoverVersion: 1},
+				"eu-west": {ActiveClusterName: "cluster2", FailoverVersion: 2},
+			},
+		},
+	},
+}
+
+func getDomainCacheEntryWithWeights(cfg *types.ActiveClusters, weights string) *cache.DomainCacheEntry {
+	data := map[string]string{}
+	if weights != "" {
+		data[constants.DomainDataKeyForClusterAttributeWeights] = weights
+	}
+	return cache.NewDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			Name: "test-domain-id",
+			Data: data,
+		},
+		nil,
+		true,
+		&persistence.DomainReplicationConfig{
+			ActiveClusters: cfg,
+		},
+		201,
+		nil,
+		1,
+		1,
+		1,
+	)
+}
+
+func countClusterAttributes(t *testing.T, d *cache.DomainCacheEntry, wfIDs []string) map[string]int {
+	counts := map[string]int{}
+	for _, wfID := range wfIDs {
+		attribute, err := selectClusterAttributeByWorkflowID("test-domain-id", d, "region", wfID)
+		require.NoError(t, err)
+		counts[attribute.Name]++
+	}
+	return counts
+}
+
+func testWorkflowIDs(n int) []string {
+	wfIDs := make([]string, n)
+	for i := range wfIDs {
+		wfIDs[i] = fmt.Sprintf("wf-%d", i)
+	}
+	return wfIDs
+}
+
+func TestSelectClusterAttributeByWorkflowID(t *testing.T) {
+	wfIDs := testWorkflowIDs(10000)
+
+	t.Run("without weights workflows are spread evenly", func(t *testing.T) {
+		counts := countClusterAttributes(t, getDomainCacheEntryWithWeights(testRegionScope, ""), wfIDs)
+		for _, name := range []string{"us-east", "us-west", "eu-west"} {
+			assert.InDelta(t, 3333, counts[name], 300, name)
+		}
+	})
+
+	t.Run("workflows are spread by weight", func(t *testing.T) {
+		counts := countClusterAttributes(t, getDomainCacheEntryWithWeights(testRegionScope, `{"region":{"us-east":3,"us-west":1}}`), wfIDs)
+		assert.InDelta(t, 7500, counts["us-east"], 300)
+		assert.InDelta(t, 2500, counts["us-west"], 300)
+		assert.Zero(t, counts["eu-west"])
+	})
+
+	t.Run("changing a weight only moves workflows to the changed cluster attribute", func(t *testing.T) {
+		before := getDomainCacheEntryWithWeights(testRegionScope, `{"region":{"us-east":1,"us-west":1,"eu-west":1}}`)
+		after := getDomainCacheEntryWithWeights(testRegionScope, `{"region":{"us-east":1,"us-west":1,"eu-west":2}}`)
+		moved := 0
+		for _, wfID := range wfIDs {
+			from, err := selectClusterAttributeByWorkflowID("test-domain-id", before, "region", wfID)
+			require.NoError(t, err)
+			to, err := selectClusterAttributeByWorkflowID("test-domain-id", after, "region", wfID)
+			require.NoError(t, err)
+			if from.Name != to.Name {
+				assert.Equal(t, "eu-west", to.Name)
+				moved++
+			}
+		}
+		// eu-west goes from a third to half of the workflows
+		assert.InDelta(t, 1667, moved, 300)
+	})
+
+	t.Run("selection is deterministic", func(t *testing.T) {
+		d := getDomainCacheEntryWithWeights(testRegionScope, "")
+		first, err := selectClusterAttributeByWorkflowID("test-domain-id", d, "region", "wf")
+		require.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			attribute, err := selectClusterAttributeByWorkflowID("test-domain-id", d, "region", "wf")
+			require.NoError(t, err)
+			assert.Equal(t, first, attribute)
+		}
+	})
+
+	t.Run("unknown scope", func(t *testing.T) {
+		_, err := selectClusterAttributeByWorkflowID("test-domain-id", getDomainCacheEntryWithWeights(testRegionScope, ""), "city", "wf")
+		var notFound *ClusterAttributeNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("not an active-active domain", func(t *testing.T) {
+		_, err := selectClusterAttributeByWorkflowID("test-domain-id", getDomainCacheEntryWithWeights(nil, ""), "region", "wf")
+		var notFound *ClusterAttributeNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("weights of another scope", func(t *testing.T) {
+		_, err := selectClusterAttributeByWorkflowID("test-domain-id", getDomainCacheEntryWithWeights(testRegionScope, `{"region":{"mars":1}}`), "region", "wf")
+		var notFound *ClusterAttributeNotFoundError
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("invalid weights", func(t *testing.T) {
+		_, err := selectClusterAttributeByWorkflowID("test-domain-id", getDomainCacheEntryWithWeights(testRegionScope, "{"), "region", "wf")
+		assert.ErrorContains(t, err, "invalid cluster attribute weights")
+	})
+}
+
+func TestResolveActiveClusterSelectionPolicy(t *testing.T) {
+	tests := []struct {
+		name              string
+		policy            *types.ActiveClusterSelectionPolicy
+		weights           string
+		domainIDToNameErr error
+		expectedResult    *types.ActiveClusterSelectionPolicy
+		expectedError     string
+	}{
+		{
+			name: "nil policy is returned as is",
+		},
+		{
+			name:           "cluster attribute policy is returned as is",
+			policy:         &types.ActiveClusterSelectionPolicy{ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "us-west"}},
+			expectedResult: &types.ActiveClusterSelectionPolicy{ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "us-west"}},
+		},
+		{
+			name:    "workflow ID hash policy is resolved to a cluster attribute",
+			policy:  &types.ActiveClusterSelectionPolicy{WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "region"}},
+			weights: `{"region":{"eu-west":1}}`,
+			expectedResult: &types.ActiveClusterSelectionPolicy{
+				ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "eu-west"},
+				WorkflowIDHash:   &types.WorkflowIDHashPolicy{Scope: "region"},
+			},
+		},
+		{
+			name: "previously resolved cluster attribute is replaced",
+			policy: &types.ActiveClusterSelectionPolicy{
+				ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "us-east"},
+				WorkflowIDHash:   &types.WorkflowIDHashPolicy{Scope: "region"},
+			},
+			weights: `{"region":{"us-west":1}}`,
+			expectedResult: &types.ActiveClusterSelectionPolicy{
+				ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "us-west"},
+				WorkflowIDHash:   &types.WorkflowIDHashPolicy{Scope: "region"},
+			},
+		},
+		{
+			name:          "unknown scope",
+			policy:        &types.ActiveClusterSelectionPolicy{WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "city"}},
+			expectedError: "could not find cluster attribute &{city } in the domain test-domain-id's active cluster config",
+		},
+		{
+			name:              "domain ID to domain function returns error",
+			policy:            &types.ActiveClusterSelectionPolicy{WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "region"}},
+			domainIDToNameErr: errors.New("failed to find domain by id"),
+			expectedError:     "failed to find domain by id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			domainIDToDomainFn := func(id string) (*cache.DomainCacheEntry, error) {
+				return getDomainCacheEntryWithWeights(testRegionScope, tc.weights), tc.domainIDToNameErr
+			}
+
+			mgr, err := NewManager(
+				domainIDToDomainFn,
+				metrics.NewNoopMetricsClient(),
+				log.NewNoop(),
+				nil,
+				numShards,
+			)
+			require.NoError(t, err)
+
+			result, err := mgr.ResolveActiveClusterSelectionPolicy(context.Background(), "test-domain-id", "wf", tc.policy)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
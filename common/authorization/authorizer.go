@@ -71,8 +71,14 @@ type (
 	// Result is result from authority.
 	Result struct {
 		Decision Decision
+		// Actor is the authenticated caller, empty when the authority doesn't authenticate callers
+		Actor string
+		// DryRunDenied is set when an authority in dry run mode allowed a request it would have denied
+		DryRunDenied bool
 	}
 
+	authenticatedActorKey struct{}
+
 	// Decision is enum type for auth decision
 	Decision int
 
@@ -108,6 +114,21 @@ type Authorizer interface {
 	Authorize(ctx context.Context, attributes *Attributes) (Result, error)
 }
 
+// WithAuthenticatedActor returns a copy of ctx carrying the caller authenticated by an Authorizer
+func WithAuthenticatedActor(ctx context.Context, actor string) context.Context {
+	if actor == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, authenticatedActorKey{}, actor)
+}
+
+// AuthenticatedActor returns the caller authenticated by an Authorizer, or "" when the request
+// wasn't authenticated
+func AuthenticatedActor(ctx context.Context) string {
+	actor, _ := ctx.Value(authenticatedActorKey{}).(string)
+	return actor
+}
+
 func GetAuthProviderClient(privateKey string) (clientworker.AuthorizationProvider, error) {
 	pk, err := os.ReadFile(privateKey)
 	if err != nil {
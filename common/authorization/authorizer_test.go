@@ -23,6 +23,7 @@
 package authorization
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -214,3 +215,10 @@ func createNewSignalWithStartWorkflowExecutionRequest() *types.SignalWithStartWo
 	}
 	return testReq
 }
+
+func TestAuthenticatedActor(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, AuthenticatedActor(ctx))
+	assert.Equal(t, ctx, WithAuthenticatedActor(ctx, ""))
+	assert.Equal(t, "alice", AuthenticatedActor(WithAuthenticatedActor(ctx, "alice")))
+}
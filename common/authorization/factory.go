@@ -30,6 +30,8 @@ func NewAuthorizer(authorization config.Authorization, logger log.Logger, domain
 	switch true {
 	case authorization.OAuthAuthorizer.Enable:
 		return NewOAuthAuthorizer(authorization.OAuthAuthorizer, logger, domainCache)
+	case authorization.PolicyAuthorizer.Enable:
+		return NewPolicyAuthorizer(authorization.PolicyAuthorizer, logger)
 	default:
 		return NewNopAuthorizer()
 	}
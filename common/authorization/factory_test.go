@@ -96,3 +96,22 @@ func (s *factorySuite) TestFactoryNoopAuthorizer() {
 		s.Equal(err, test.err)
 	}
 }
+
+func (s *factorySuite) TestFactoryPolicyAuthorizer() {
+	file := writePolicy(s.T(), s.T().TempDir(), "rules:\n  - effect: allow\n")
+	cfg := config.Authorization{
+		PolicyAuthorizer: config.PolicyAuthorizer{
+			Enable:     true,
+			PolicyFile: file,
+			MaxJwtTTL:  3600,
+			JwtCredentials: &config.JwtCredentials{
+				Algorithm: jwt.SigningMethodRS256.Name,
+				PublicKey: "../../config/credentials/keytest.pub",
+			},
+		},
+	}
+
+	authorizer, err := NewAuthorizer(cfg, s.logger, nil)
+	s.NoError(err)
+	s.IsType(&policyAuthority{}, authorizer)
+}
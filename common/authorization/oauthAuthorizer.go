@@ -69,6 +69,14 @@ func (j JWTClaims) GetGroups() []string {
 	return strings.Split(j.Groups, groupSeparator)
 }
 
+// actorName identifies the caller by the Name claim, falling back to the subject
+func (j JWTClaims) actorName() string {
+	if j.Name != "" {
+		return j.Name
+	}
+	return j.Subject
+}
+
 // NewOAuthAuthorizer creates an oauth Authorizer
 func NewOAuthAuthorizer(
 	oauthConfig config.OAuthAuthorizer,
@@ -148,7 +156,7 @@ func (a *oauthAuthority) Authorize(ctx context.Context, attributes *Attributes)
 	}
 
 	if claims.Admin {
-		return Result{Decision: DecisionAllow}, nil
+		return Result{Decision: DecisionAllow, Actor: claims.actorName()}, nil
 	}
 
 	domain, err := a.domainCache.GetDomain(attributes.DomainName)
@@ -161,7 +169,7 @@ func (a *oauthAuthority) Authorize(ctx context.Context, attributes *Attributes)
 		return Result{Decision: DecisionDeny}, nil
 	}
 
-	return Result{Decision: DecisionAllow}, nil
+	return Result{Decision: DecisionAllow, Actor: claims.actorName()}, nil
 }
 
 // keyFunc returns correct key to check signature
@@ -179,6 +187,10 @@ func (a *oauthAuthority) keyFunc(token *jwt.Token) (interface{}, error) {
 }
 
 func (a *oauthAuthority) validateTTL(claims *JWTClaims) error {
+	return validateTTL(claims, a.config.MaxJwtTTL)
+}
+
+func validateTTL(claims *JWTClaims, maxTTL int64) error {
 	// Fill ExpiresAt when TTL is passed
 	if claims.TTL > 0 {
 		claims.ExpiresAt = jwt.NewNumericDate(claims.IssuedAt.Time.Add(time.Second * time.Duration(claims.TTL)))
@@ -195,8 +207,8 @@ func (a *oauthAuthority) validateTTL(claims *JWTClaims) error {
 		return errors.New("token is expired")
 	}
 
-	if timeLeft > a.config.MaxJwtTTL {
-		return fmt.Errorf("token TTL: %d is larger than MaxTTL allowed: %d", timeLeft, a.config.MaxJwtTTL)
+	if timeLeft > maxTTL {
+		return fmt.Errorf("token TTL: %d is larger than MaxTTL allowed: %d", timeLeft, maxTTL)
 	}
 
 	return nil
@@ -127,6 +127,7 @@ func (s *oauthSuite) TestCorrectPayload() {
 	result, err := authorizer.Authorize(s.ctx, &s.att)
 	s.NoError(err)
 	s.Equal(result.Decision, DecisionAllow)
+	s.Equal("John Doe", result.Actor)
 }
 
 func (s *oauthSuite) TestItIsAdmin() {
@@ -0,0 +1,377 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/yarpc"
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	// EffectAllow allows the requests matched by a policy rule
+	EffectAllow = "allow"
+	// EffectDeny denies the requests matched by a policy rule, deny rules take precedence
+	EffectDeny = "deny"
+
+	defaultPolicyReloadInterval = 30 * time.Second
+	// dryRunLogRPS limits the logs of the requests a dry run would deny, all of them are counted by a metric
+	dryRunLogRPS = 10
+)
+
+type (
+	// Policy is the content of a policy file
+	Policy struct {
+		// Groups maps group names to the actors in the group
+		Groups map[string][]string `yaml:"groups"`
+		Rules  []PolicyRule        `yaml:"rules"`
+	}
+
+	// PolicyRule allows or denies the requests it matches. A request matches when every non-empty
+	// field of the rule has a matching pattern. In a pattern, "*" matches any sequence of characters,
+	// including "/", "?" matches any single character and "\" escapes the next character, e.g.
+	// "samples-*" or "github.com/org/*".
+	PolicyRule struct {
+		Effect string `yaml:"effect"`
+		// Actors and Groups match the caller, a rule with neither matches every caller
+		Actors        []string `yaml:"actors"`
+		Groups        []string `yaml:"groups"`
+		Domains       []string `yaml:"domains"`
+		APIs          []string `yaml:"apis"`
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		TaskLists     []string `yaml:"taskLists"`
+	}
+
+	policyAuthority struct {
+		config     config.PolicyAuthorizer
+		log        log.Logger
+		dryRunLog  log.Logger
+		timeSource clock.TimeSource
+		parser     *jwt.Parser
+		publicKey  interface{}
+
+		policy    atomic.Pointer[Policy]
+		modTime   time.Time
+		nextCheck atomic.Int64
+		reloadMu  sync.Mutex
+	}
+)
+
+// NewPolicyAuthorizer creates an Authorizer which evaluates requests against a policy file.
+// The file is checked for changes every ReloadInterval; a file which fails to load keeps the previous policy.
+// The actor of a request is read from its JWT, requests without a valid JWT are denied.
+func NewPolicyAuthorizer(policyConfig config.PolicyAuthorizer, logger log.Logger) (Authorizer, error) {
+	return newPolicyAuthorizer(policyConfig, logger, clock.NewRealTimeSource())
+}
+
+func newPolicyAuthorizer(policyConfig config.PolicyAuthorizer, logger log.Logger, timeSource clock.TimeSource) (*policyAuthority, error) {
+	if policyConfig.ReloadInterval <= 0 {
+		policyConfig.ReloadInterval = defaultPolicyReloadInterval
+	}
+	if policyConfig.JwtCredentials == nil {
+		return nil, errors.New("jwtCredentials are not set")
+	}
+	if policyConfig.JwtCredentials.Algorithm != jwt.SigningMethodRS256.Name {
+		return nil, fmt.Errorf("algorithm %q is not supported", policyConfig.JwtCredentials.Algorithm)
+	}
+	publicKey, err := common.LoadRSAPublicKey(policyConfig.JwtCredentials.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("loading RSA public key: %w", err)
+	}
+	a := &policyAuthority{
+		config:     policyConfig,
+		log:        logger,
+		dryRunLog:  log.NewThrottledLogger(logger, dynamicproperties.GetIntPropertyFn(dryRunLogRPS)),
+		timeSource: timeSource,
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Name}),
+			jwt.WithIssuedAt(),
+		),
+		publicKey: publicKey,
+	}
+	info, err := os.Stat(policyConfig.PolicyFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading policy file: %w", err)
+	}
+	if err := a.load(info.ModTime()); err != nil {
+		return nil, err
+	}
+	a.nextCheck.Store(timeSource.Now().Add(policyConfig.ReloadInterval).UnixNano())
+	return a, nil
+}
+
+// Authorize allows a request when an allow rule matches it and no deny rule does
+func (a *policyAuthority) Authorize(ctx context.Context, attributes *Attributes) (Result, error) {
+	a.reloadIfChanged()
+
+	var decision Decision
+	actor, admin, err := a.verifiedActor(ctx)
+	switch {
+	case err != nil:
+		a.log.Debug("request is not authorized", tag.Error(err))
+		decision = DecisionDeny
+	case admin:
+		// admin tokens are used by the cadence services and the cross cluster calls
+		decision = DecisionAllow
+	default:
+		decision = a.policy.Load().evaluate(actor, attributes)
+	}
+	if a.config.DryRun {
+		if decision == DecisionDeny {
+			a.dryRunLog.Info("policy authorizer dry run would deny request",
+				tag.ActorID(actor),
+				tag.OperationName(attributes.APIName),
+				tag.WorkflowDomainName(attributes.DomainName),
+			)
+		}
+		return Result{Decision: DecisionAllow, Actor: actor, DryRunDenied: decision == DecisionDeny}, nil
+	}
+	if decision == DecisionDeny {
+		a.log.Debug("request is not authorized by policy",
+			tag.ActorID(actor),
+			tag.OperationName(attributes.APIName),
+			tag.WorkflowDomainName(attributes.DomainName),
+		)
+	}
+	return Result{Decision: decision, Actor: actor}, nil
+}
+
+// verifiedActor returns the actor of the JWT in the request, after checking its signature and TTL
+func (a *policyAuthority) verifiedActor(ctx context.Context) (actor string, admin bool, err error) {
+	call := yarpc.CallFromContext(ctx)
+	if call == nil {
+		return "", false, errors.New("request is not a yarpc call")
+	}
+	token := call.Header(common.AuthorizationTokenHeaderName)
+	if token == "" {
+		return "", false, errors.New("token is not set in header")
+	}
+	var claims JWTClaims
+	if _, err := a.parser.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return a.publicKey, nil
+	}); err != nil {
+		return "", false, err
+	}
+	if err := validateTTL(&claims, a.config.MaxJwtTTL); err != nil {
+		return "", false, err
+	}
+	if claims.Admin {
+		return claims.Name, true, nil
+	}
+	actor = claims.actorName()
+	if actor == "" {
+		return "", false, errors.New("token has neither a Name claim nor a subject")
+	}
+	return actor, false, nil
+}
+
+// reloadIfChanged reloads the policy when the file changed since it was loaded. Only one caller
+// checks the file per ReloadInterval, the others keep using the current policy.
+func (a *policyAuthority) reloadIfChanged() {
+	now := a.timeSource.Now()
+	if now.UnixNano() < a.nextCheck.Load() || !a.reloadMu.TryLock() {
+		return
+	}
+	defer a.reloadMu.Unlock()
+	a.nextCheck.Store(now.Add(a.config.ReloadInterval).UnixNano())
+
+	info, err := os.Stat(a.config.PolicyFile)
+	if err != nil {
+		a.log.Error("failed to check policy file, keeping the current policy", tag.Error(err))
+		return
+	}
+	if info.ModTime().Equal(a.modTime) {
+		return
+	}
+	if err := a.load(info.ModTime()); err != nil {
+		a.log.Error("failed to reload policy file, keeping the current policy", tag.Error(err))
+		return
+	}
+	a.log.Info("reloaded authorization policy", tag.Value(a.config.PolicyFile))
+}
+
+func (a *policyAuthority) load(modTime time.Time) error {
+	content, err := os.ReadFile(a.config.PolicyFile)
+	if err != nil {
+		return fmt.Errorf("reading policy file: %w", err)
+	}
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict(content, policy); err != nil {
+		return fmt.Errorf("decoding policy file: %w", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	a.policy.Store(policy)
+	a.modTime = modTime
+	return nil
+}
+
+// Validate checks rule effects, groups and patterns
+func (p *Policy) Validate() error {
+	for group, members := range p.Groups {
+		for _, pattern := range members {
+			if err := validatePattern(pattern); err != nil {
+				return fmt.Errorf("group %q: invalid pattern %q: %w", group, pattern, err)
+			}
+		}
+	}
+	for i, rule := range p.Rules {
+		if rule.Effect != EffectAllow && rule.Effect != EffectDeny {
+			return fmt.Errorf("rule %d: effect must be %q or %q, got %q", i, EffectAllow, EffectDeny, rule.Effect)
+		}
+		for _, patterns := range [][]string{rule.Actors, rule.Domains, rule.APIs, rule.WorkflowTypes, rule.TaskLists} {
+			for _, pattern := range patterns {
+				if err := validatePattern(pattern); err != nil {
+					return fmt.Errorf("rule %d: invalid pattern %q: %w", i, pattern, err)
+				}
+			}
+		}
+		for _, group := range rule.Groups {
+			if _, ok := p.Groups[group]; !ok {
+				return fmt.Errorf("rule %d: unknown group %q", i, group)
+			}
+		}
+	}
+	return nil
+}
+
+func (p *Policy) evaluate(actor string, attributes *Attributes) Decision {
+	decision := DecisionDeny
+	for _, rule := range p.Rules {
+		if !p.matches(rule, actor, attributes) {
+			continue
+		}
+		if rule.Effect == EffectDeny {
+			return DecisionDeny
+		}
+		decision = DecisionAllow
+	}
+	return decision
+}
+
+func (p *Policy) matches(rule PolicyRule, actor string, attributes *Attributes) bool {
+	if len(rule.Actors) > 0 || len(rule.Groups) > 0 {
+		if !matchAny(rule.Actors, actor) && !p.inAnyGroup(rule.Groups, actor) {
+			return false
+		}
+	}
+	if len(rule.Domains) > 0 && !matchAny(rule.Domains, attributes.DomainName) {
+		return false
+	}
+	if len(rule.APIs) > 0 && !matchAny(rule.APIs, attributes.APIName) {
+		return false
+	}
+	if len(rule.WorkflowTypes) > 0 && (attributes.WorkflowType == nil || !matchAny(rule.WorkflowTypes, attributes.WorkflowType.GetName())) {
+		return false
+	}
+	if len(rule.TaskLists) > 0 && (attributes.TaskList == nil || !matchAny(rule.TaskLists, attributes.TaskList.GetName())) {
+		return false
+	}
+	return true
+}
+
+func (p *Policy) inAnyGroup(groups []string, actor string) bool {
+	for _, group := range groups {
+		if matchAny(p.Groups[group], actor) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matchPattern(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern reports whether value matches the whole pattern. Unlike path.Match, "*" also matches
+// "/", so that "github.com/org/*" matches the workflow types registered by the Go client.
+func matchPattern(pattern, value string) bool {
+	px, vx := 0, 0
+	// where to resume when a literal does not match: after the last "*" took one more character
+	starPx, starVx := -1, 0
+	for px < len(pattern) || vx < len(value) {
+		if px < len(pattern) {
+			switch c := pattern[px]; c {
+			case '*':
+				starPx, starVx = px, vx
+				px++
+				continue
+			case '?':
+				if vx < len(value) {
+					_, size := utf8.DecodeRuneInString(value[vx:])
+					px++
+					vx += size
+					continue
+				}
+			default:
+				if c == '\\' && px+1 < len(pattern) {
+					px++
+					c = pattern[px]
+				}
+				if vx < len(value) && value[vx] == c {
+					px++
+					vx++
+					continue
+				}
+			}
+		}
+		if starPx < 0 || starVx >= len(value) {
+			return false
+		}
+		_, size := utf8.DecodeRuneInString(value[starVx:])
+		starVx += size
+		px, vx = starPx+1, starVx
+	}
+	return true
+}
+
+func validatePattern(pattern string) error {
+	escaped := false
+	for i := 0; i < len(pattern); i++ {
+		escaped = !escaped && pattern[i] == '\\'
+	}
+	if escaped {
+		return errors.New("pattern ends with an unescaped backslash")
+	}
+	return nil
+}
@@ -0,0 +1,365 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/types"
+)
+
+const testPolicy = `
+groups:
+  oncall: [alice, "bot-*"]
+rules:
+  - effect: allow
+    groups: [oncall]
+  - effect: allow
+    actors: [bob]
+    domains: ["samples-*"]
+    apis: [StartWorkflowExecution, "Describe*"]
+  - effect: allow
+    actors: [carol]
+    workflowTypes: [payments]
+    taskLists: [payments-tl]
+  - effect: deny
+    domains: [restricted]
+    apis: [TerminateWorkflowExecution]
+`
+
+func newTestPolicyAuthorizer(t *testing.T, policyConfig config.PolicyAuthorizer) Authorizer {
+	policyConfig.Enable = true
+	policyConfig.MaxJwtTTL = 3600
+	policyConfig.JwtCredentials = &config.JwtCredentials{
+		Algorithm: jwt.SigningMethodRS256.Name,
+		PublicKey: "../../config/credentials/keytest.pub",
+	}
+	authorizer, err := NewPolicyAuthorizer(policyConfig, testlogger.New(t))
+	require.NoError(t, err)
+	return authorizer
+}
+
+// contextWithToken returns the context of a call with a JWT signed by the test key
+func contextWithToken(t *testing.T, claims JWTClaims) context.Context {
+	t.Helper()
+	key, err := common.LoadRSAPrivateKey("../../config/credentials/keytest")
+	require.NoError(t, err)
+	if claims.ExpiresAt == nil {
+		claims.IssuedAt = jwt.NewNumericDate(time.Now())
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Minute))
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+	require.NoError(t, err)
+
+	ctx, call := encoding.NewInboundCall(context.Background())
+	require.NoError(t, call.ReadFromRequest(&transport.Request{
+		Headers: transport.NewHeaders().With(common.AuthorizationTokenHeaderName, token),
+	}))
+	return ctx
+}
+
+func contextWithActor(t *testing.T, actor string) context.Context {
+	return contextWithToken(t, JWTClaims{Name: actor})
+}
+
+func writePolicy(t *testing.T, dir, content string) string {
+	t.Helper()
+	file := filepath.Join(dir, "policy.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+	return file
+}
+
+func TestPolicyAuthorizer_Authorize(t *testing.T) {
+	authorizer := newTestPolicyAuthorizer(t, config.PolicyAuthorizer{
+		PolicyFile: writePolicy(t, t.TempDir(), testPolicy),
+	})
+
+	tests := map[string]struct {
+		actor      string
+		attributes Attributes
+		expected   Decision
+	}{
+		"group member": {
+			actor:      "bot-1",
+			attributes: Attributes{APIName: "SignalWorkflowExecution", DomainName: "any"},
+			expected:   DecisionAllow,
+		},
+		"deny takes precedence": {
+			actor:      "alice",
+			attributes: Attributes{APIName: "TerminateWorkflowExecution", DomainName: "restricted"},
+			expected:   DecisionDeny,
+		},
+		"domain and api patterns": {
+			actor:      "bob",
+			attributes: Attributes{APIName: "DescribeWorkflowExecution", DomainName: "samples-domain"},
+			expected:   DecisionAllow,
+		},
+		"api not allowed": {
+			actor:      "bob",
+			attributes: Attributes{APIName: "TerminateWorkflowExecution", DomainName: "samples-domain"},
+			expected:   DecisionDeny,
+		},
+		"domain not allowed": {
+			actor:      "bob",
+			attributes: Attributes{APIName: "StartWorkflowExecution", DomainName: "other"},
+			expected:   DecisionDeny,
+		},
+		"workflow type and task list": {
+			actor: "carol",
+			attributes: Attributes{
+				APIName:      "StartWorkflowExecution",
+				WorkflowType: &types.WorkflowType{Name: "payments"},
+				TaskList:     &types.TaskList{Name: "payments-tl"},
+			},
+			expected: DecisionAllow,
+		},
+		"missing workflow type": {
+			actor:      "carol",
+			attributes: Attributes{APIName: "StartWorkflowExecution", TaskList: &types.TaskList{Name: "payments-tl"}},
+			expected:   DecisionDeny,
+		},
+		"unknown actor": {
+			actor:      "mallory",
+			attributes: Attributes{APIName: "StartWorkflowExecution", DomainName: "samples-domain"},
+			expected:   DecisionDeny,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := authorizer.Authorize(contextWithActor(t, tc.actor), &tc.attributes)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result.Decision)
+			assert.Equal(t, tc.actor, result.Actor)
+		})
+	}
+}
+
+func TestPolicyAuthorizer_Identity(t *testing.T) {
+	authorizer := newTestPolicyAuthorizer(t, config.PolicyAuthorizer{
+		PolicyFile: writePolicy(t, t.TempDir(), testPolicy),
+	})
+	callerOnly, call := encoding.NewInboundCall(context.Background())
+	require.NoError(t, call.ReadFromRequest(&transport.Request{Caller: "alice"}))
+	expired := time.Now().Add(-time.Minute)
+
+	tests := map[string]struct {
+		ctx      context.Context
+		expected Decision
+	}{
+		"subject as actor": {
+			ctx:      contextWithToken(t, JWTClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "alice"}}),
+			expected: DecisionAllow,
+		},
+		"admin token": {
+			ctx:      contextWithToken(t, JWTClaims{Name: "mallory", Admin: true}),
+			expected: DecisionAllow,
+		},
+		"caller header is not an identity": {
+			ctx:      callerOnly,
+			expected: DecisionDeny,
+		},
+		"no call": {
+			ctx:      context.Background(),
+			expected: DecisionDeny,
+		},
+		"token without actor": {
+			ctx:      contextWithToken(t, JWTClaims{}),
+			expected: DecisionDeny,
+		},
+		"expired token": {
+			ctx: contextWithToken(t, JWTClaims{Name: "alice", RegisteredClaims: jwt.RegisteredClaims{
+				IssuedAt:  jwt.NewNumericDate(expired.Add(-time.Minute)),
+				ExpiresAt: jwt.NewNumericDate(expired),
+			}}),
+			expected: DecisionDeny,
+		},
+		"token TTL above max": {
+			ctx: contextWithToken(t, JWTClaims{Name: "alice", RegisteredClaims: jwt.RegisteredClaims{
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(2 * time.Hour)),
+			}}),
+			expected: DecisionDeny,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := authorizer.Authorize(tc.ctx, &Attributes{APIName: "StartWorkflowExecution"})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result.Decision)
+		})
+	}
+}
+
+func TestPolicyAuthorizer_DryRun(t *testing.T) {
+	authorizer := newTestPolicyAuthorizer(t, config.PolicyAuthorizer{
+		PolicyFile: writePolicy(t, t.TempDir(), testPolicy),
+		DryRun:     true,
+	})
+
+	result, err := authorizer.Authorize(contextWithActor(t, "mallory"), &Attributes{APIName: "StartWorkflowExecution"})
+	require.NoError(t, err)
+	assert.Equal(t, DecisionAllow, result.Decision)
+	assert.True(t, result.DryRunDenied)
+
+	result, err = authorizer.Authorize(contextWithActor(t, "alice"), &Attributes{APIName: "StartWorkflowExecution"})
+	require.NoError(t, err)
+	assert.Equal(t, DecisionAllow, result.Decision)
+	assert.False(t, result.DryRunDenied)
+}
+
+func TestPolicyAuthorizer_Reload(t *testing.T) {
+	dir := t.TempDir()
+	file := writePolicy(t, dir, testPolicy)
+	timeSource := clock.NewMockedTimeSource()
+	authorizer, err := newPolicyAuthorizer(config.PolicyAuthorizer{
+		Enable:         true,
+		PolicyFile:     file,
+		ReloadInterval: time.Minute,
+		MaxJwtTTL:      3600,
+		JwtCredentials: &config.JwtCredentials{
+			Algorithm: jwt.SigningMethodRS256.Name,
+			PublicKey: "../../config/credentials/keytest.pub",
+		},
+	}, testlogger.New(t), timeSource)
+	require.NoError(t, err)
+
+	ctx := contextWithActor(t, "mallory")
+	attributes := &Attributes{APIName: "StartWorkflowExecution"}
+	authorize := func() Decision {
+		result, err := authorizer.Authorize(ctx, attributes)
+		require.NoError(t, err)
+		return result.Decision
+	}
+	require.Equal(t, DecisionDeny, authorize())
+
+	writePolicy(t, dir, "rules:\n  - effect: allow\n    actors: [mallory]\n")
+	require.NoError(t, os.Chtimes(file, time.Now(), time.Now().Add(time.Hour)))
+	assert.Equal(t, DecisionDeny, authorize(), "the file is only checked every ReloadInterval")
+
+	timeSource.Advance(time.Minute)
+	assert.Equal(t, DecisionAllow, authorize())
+
+	writePolicy(t, dir, "rules:\n  - effect: maybe\n")
+	require.NoError(t, os.Chtimes(file, time.Now(), time.Now().Add(2*time.Hour)))
+	timeSource.Advance(time.Minute)
+	assert.Equal(t, DecisionAllow, authorize(), "an invalid policy keeps the previous one")
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	tests := map[string]struct {
+		policy string
+		err    string
+	}{
+		"invalid effect": {
+			policy: "rules:\n  - effect: maybe\n",
+			err:    `rule 0: effect must be "allow" or "deny", got "maybe"`,
+		},
+		"invalid pattern": {
+			policy: "rules:\n  - effect: allow\n    domains: ['samples-\\']\n",
+			err:    `rule 0: invalid pattern "samples-\\"`,
+		},
+		"unknown group": {
+			policy: "rules:\n  - effect: allow\n    groups: [oncall]\n",
+			err:    `rule 0: unknown group "oncall"`,
+		},
+		"unknown field": {
+			policy: "rules:\n  - effect: allow\n    domain: [samples]\n",
+			err:    "decoding policy file",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewPolicyAuthorizer(config.PolicyAuthorizer{
+				Enable:     true,
+				PolicyFile: writePolicy(t, t.TempDir(), tc.policy),
+				JwtCredentials: &config.JwtCredentials{
+					Algorithm: jwt.SigningMethodRS256.Name,
+					PublicKey: "../../config/credentials/keytest.pub",
+				},
+			}, testlogger.New(t))
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		match   bool
+	}{
+		{"samples", "samples", true},
+		{"samples", "samples-1", false},
+		{"samples-*", "samples-", true},
+		{"samples-*", "samples-1", true},
+		{"samples-*", "other-1", false},
+		{"*", "", true},
+		{"github.com/org/*", "github.com/org/pkg.Workflow", true},
+		{"github.com/*.Workflow", "github.com/org/pkg.Workflow", true},
+		{"github.com/*.Workflow", "github.com/org/pkg.Activity", false},
+		{"*-tl", "payments-tl", true},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxbyy", false},
+		{"tl-?", "tl-1", true},
+		{"tl-?", "tl-é", true},
+		{"tl-?", "tl-12", false},
+		{"tl-?", "tl-", false},
+		{`samples-\*`, "samples-*", true},
+		{`samples-\*`, "samples-1", false},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.match, matchPattern(tc.pattern, tc.value), "pattern %q, value %q", tc.pattern, tc.value)
+	}
+}
+
+func TestPolicyAuthorizer_MissingFile(t *testing.T) {
+	_, err := NewPolicyAuthorizer(config.PolicyAuthorizer{
+		Enable:     true,
+		PolicyFile: filepath.Join(t.TempDir(), "missing.yaml"),
+		JwtCredentials: &config.JwtCredentials{
+			Algorithm: jwt.SigningMethodRS256.Name,
+			PublicKey: "../../config/credentials/keytest.pub",
+		},
+	}, testlogger.New(t))
+	assert.ErrorContains(t, err, "reading policy file")
+}
+
+func TestPolicyAuthorizer_MissingJwtCredentials(t *testing.T) {
+	_, err := NewPolicyAuthorizer(config.PolicyAuthorizer{
+		Enable:     true,
+		PolicyFile: writePolicy(t, t.TempDir(), testPolicy),
+	}, testlogger.New(t))
+	assert.ErrorContains(t, err, "jwtCredentials are not set")
+}
@@ -948,6 +948,37 @@ func (entry *DomainCacheEntry) GetReplicationPolicy() ReplicationPolicy {
 	return ReplicationPolicyOneCluster
 }
 
+// GetReplicationFilter returns the replication filter of the domain, nil if all its workflows are replicated
+func (entry *DomainCacheEntry) GetReplicationFilter() (*types.ReplicationFilter, error) {
+	if entry.info == nil {
+		return nil, nil
+	}
+	return types.ParseReplicationFilter(entry.info.Data[constants.DomainDataKeyForReplicationFilter])
+}
+
+// GetClusterAttributeWeights returns the weights of the cluster attributes workflows using a workflow ID hash
+// active cluster selection policy are spread over
+func (entry *DomainCacheEntry) GetClusterAttributeWeights() (types.ClusterAttributeWeights, error) {
+	if entry.info == nil {
+		return nil, nil
+	}
+	return types.ParseClusterAttributeWeights(entry.info.Data[constants.DomainDataKeyForClusterAttributeWeights])
+}
+
+// IsReplicationExcluded returns true if the domain is replicated but not its workflows of workflowType
+// started on taskList
+func (entry *DomainCacheEntry) IsReplicationExcluded(workflowType, taskList string) bool {
+	if entry.GetReplicationPolicy() != ReplicationPolicyMultiCluster {
+		return false
+	}
+	// the filter is validated when the domain is registered or updated
+	filter, err := entry.GetReplicationFilter()
+	if err != nil {
+		return false
+	}
+	return filter.Excludes(workflowType, taskList)
+}
+
 // HasReplicationCluster returns true if the domain has replication in the cluster
 func (entry *DomainCacheEntry) HasReplicationCluster(clusterName string) bool {
 	for _, cluster := range entry.GetReplicationConfig().Clusters {
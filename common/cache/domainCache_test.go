@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
@@ -1478,3 +1479,25 @@ func Test_GetActiveClusterInfoByClusterAttribute(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsReplicationExcluded(t *testing.T) {
+	data := map[string]string{constants.DomainDataKeyForReplicationFilter: `{"excludedWorkflowTypes":["ephemeral"]}`}
+	replicationConfig := &persistence.DomainReplicationConfig{
+		ActiveClusterName: cluster.TestCurrentClusterName,
+		Clusters: []*persistence.ClusterReplicationConfig{
+			{ClusterName: cluster.TestCurrentClusterName},
+			{ClusterName: cluster.TestAlternativeClusterName},
+		},
+	}
+
+	global := NewGlobalDomainCacheEntryForTest(&persistence.DomainInfo{Data: data}, nil, replicationConfig, 0)
+	assert.True(t, global.IsReplicationExcluded("ephemeral", "tl"))
+	assert.False(t, global.IsReplicationExcluded("wf", "tl"))
+
+	invalid := NewGlobalDomainCacheEntryForTest(&persistence.DomainInfo{Data: map[string]string{constants.DomainDataKeyForReplicationFilter: "{"}}, nil, replicationConfig, 0)
+	assert.False(t, invalid.IsReplicationExcluded("ephemeral", "tl"))
+
+	// local domains are not replicated, so nothing is excluded
+	local := NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{Data: data}, nil, cluster.TestCurrentClusterName)
+	assert.False(t, local.IsReplicationExcluded("ephemeral", "tl"))
+}
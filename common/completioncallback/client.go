@@ -0,0 +1,128 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package completioncallback guards the outgoing requests of workflow completion callbacks.
+// Callback URLs are chosen by the callers of StartWorkflowExecution, so the requests are only sent
+// to hosts allowed by the operator and never to loopback, private or link-local addresses.
+package completioncallback
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+)
+
+// ErrForbiddenAddress is returned when the host of a callback resolves to an address which can't be called
+var ErrForbiddenAddress = errors.New("completion callbacks can't be sent to loopback, private or link-local addresses")
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which net.IP.IsPrivate doesn't cover
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// HostAllowlist checks callback URLs against the hosts allowed in dynamic config
+type HostAllowlist struct {
+	allowedHosts dynamicproperties.ListPropertyFn
+}
+
+// NewHostAllowlist creates a HostAllowlist. Entries are host names, an entry starting with "*." matches
+// every subdomain of the rest of the entry. An empty list allows nothing.
+func NewHostAllowlist(allowedHosts dynamicproperties.ListPropertyFn) HostAllowlist {
+	return HostAllowlist{
+		allowedHosts: allowedHosts,
+	}
+}
+
+// Validate returns an error when the host of the URL is not allowed
+func (a HostAllowlist) Validate(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid completion callback URL %q: %w", rawURL, err)
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, entry := range a.allowedHosts() {
+		allowed, ok := entry.(string)
+		if !ok {
+			continue
+		}
+		allowed = strings.ToLower(allowed)
+		if host == allowed || (strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:])) {
+			return nil
+		}
+	}
+	return fmt.Errorf("completion callback host %q is not allowed", host)
+}
+
+// NewHTTPClient returns the client used to deliver completion callbacks. It checks every address it
+// connects to after DNS resolution, so a host can't be pointed at an internal address after it was
+// allowed. Redirects are not followed and proxies from the environment are not used.
+func NewHTTPClient() *http.Client {
+	return newHTTPClient(checkAddress)
+}
+
+func newHTTPClient(check func(address string) error) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return check(address)
+		},
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// checkAddress rejects the resolved addresses which point inside the network of the cluster
+func checkAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("%w: %v is not an IP address", ErrForbiddenAddress, host)
+	}
+	if ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("%w: %v", ErrForbiddenAddress, ip)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package completioncallback
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+)
+
+func TestHostAllowlist(t *testing.T) {
+	allowlist := NewHostAllowlist(func(...dynamicproperties.FilterOption) []interface{} {
+		return []interface{}{"hooks.example.com", "*.callbacks.example.org", 42}
+	})
+
+	tests := map[string]struct {
+		url     string
+		allowed bool
+	}{
+		"exact host":          {url: "https://hooks.example.com/done", allowed: true},
+		"host is lower cased": {url: "https://HOOKS.example.com/done", allowed: true},
+		"port is ignored":     {url: "http://hooks.example.com:8080/done", allowed: true},
+		"subdomain wildcard":  {url: "https://a.b.callbacks.example.org/done", allowed: true},
+		"wildcard parent":     {url: "https://callbacks.example.org/done", allowed: false},
+		"suffix of the host":  {url: "https://evilhooks.example.com/done", allowed: false},
+		"other host":          {url: "https://169.254.169.254/latest/meta-data", allowed: false},
+		"invalid url":         {url: "https://%zz", allowed: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := allowlist.Validate(tc.url)
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestHostAllowlist_Empty(t *testing.T) {
+	allowlist := NewHostAllowlist(func(...dynamicproperties.FilterOption) []interface{} { return nil })
+	assert.ErrorContains(t, allowlist.Validate("https://hooks.example.com/done"), `completion callback host "hooks.example.com" is not allowed`)
+}
+
+func TestCheckAddress(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.215.14:443":           true,
+		"[2606:2800:21f:cb07::1]:443": true,
+		"127.0.0.1:80":                false,
+		"10.1.2.3:80":                 false,
+		"172.16.0.1:80":               false,
+		"192.168.1.1:80":              false,
+		"169.254.169.254:80":          false,
+		"100.64.0.1:80":               false,
+		"0.0.0.0:80":                  false,
+		"224.0.0.1:80":                false,
+		"[::1]:80":                    false,
+		"[fd00::1]:80":                false,
+		"[fe80::1]:80":                false,
+		"[::ffff:127.0.0.1]:80":       false,
+	}
+	for address, allowed := range tests {
+		t.Run(address, func(t *testing.T) {
+			err := checkAddress(address)
+			if allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbiddenAddress)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_RejectsLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPClient().Post(server.URL, "application/json", nil)
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+}
+
+func TestHTTPClient_DoesNotFollowRedirects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			t.Error("redirect should not be followed")
+		}
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	client := newHTTPClient(func(string) error { return nil })
+	response, err := client.Post(server.URL, "application/json", nil)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusTemporaryRedirect, response.StatusCode)
+}
@@ -23,14 +23,16 @@ package config
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
 type (
 	Authorization struct {
-		OAuthAuthorizer OAuthAuthorizer `yaml:"oauthAuthorizer"`
-		NoopAuthorizer  NoopAuthorizer  `yaml:"noopAuthorizer"`
+		OAuthAuthorizer  OAuthAuthorizer  `yaml:"oauthAuthorizer"`
+		NoopAuthorizer   NoopAuthorizer   `yaml:"noopAuthorizer"`
+		PolicyAuthorizer PolicyAuthorizer `yaml:"policyAuthorizer"`
 	}
 
 	NoopAuthorizer struct {
@@ -60,11 +62,33 @@ type (
 		GroupsAttributePath string `yaml:"groupsAttributePath"`
 		AdminAttributePath  string `yaml:"adminAttributePath"`
 	}
+
+	// PolicyAuthorizer authorizes requests against the rules of a policy file
+	PolicyAuthorizer struct {
+		Enable bool `yaml:"enable"`
+		// PolicyFile is the path of the yaml policy file
+		PolicyFile string `yaml:"policyFile"`
+		// ReloadInterval is how often the policy file is checked for changes, defaults to 30s
+		ReloadInterval time.Duration `yaml:"reloadInterval"`
+		// DryRun allows every request, the requests the policy would deny are logged and counted
+		DryRun bool `yaml:"dryRun"`
+		// Max of TTL in the claim
+		MaxJwtTTL int64 `yaml:"maxJwtTTL"`
+		// JwtCredentials verify the JWT of the requests, the actor matched by the rules is the Name claim of the JWT,
+		// or its subject when Name is not set
+		JwtCredentials *JwtCredentials `yaml:"jwtCredentials"`
+	}
 )
 
 // Validate validates the persistence config
 func (a *Authorization) Validate() error {
-	if a.OAuthAuthorizer.Enable && a.NoopAuthorizer.Enable {
+	enabled := 0
+	for _, enable := range []bool{a.OAuthAuthorizer.Enable, a.NoopAuthorizer.Enable, a.PolicyAuthorizer.Enable} {
+		if enable {
+			enabled++
+		}
+	}
+	if enabled > 1 {
 		return fmt.Errorf("[AuthorizationConfig] More than one authorizer is enabled")
 	}
 
@@ -74,6 +98,24 @@ func (a *Authorization) Validate() error {
 		}
 	}
 
+	if a.PolicyAuthorizer.Enable {
+		if a.PolicyAuthorizer.PolicyFile == "" {
+			return fmt.Errorf("[PolicyAuthorizerConfig] PolicyFile can't be empty")
+		}
+		if a.PolicyAuthorizer.ReloadInterval < 0 {
+			return fmt.Errorf("[PolicyAuthorizerConfig] ReloadInterval can't be negative")
+		}
+		if a.PolicyAuthorizer.MaxJwtTTL <= 0 {
+			return fmt.Errorf("[PolicyAuthorizerConfig] MaxTTL must be greater than 0")
+		}
+		if a.PolicyAuthorizer.JwtCredentials == nil || a.PolicyAuthorizer.JwtCredentials.PublicKey == "" {
+			return fmt.Errorf("[PolicyAuthorizerConfig] PublicKey can't be empty")
+		}
+		if a.PolicyAuthorizer.JwtCredentials.Algorithm != jwt.SigningMethodRS256.Name {
+			return fmt.Errorf("[PolicyAuthorizerConfig] The only supported Algorithm is RS256")
+		}
+	}
+
 	return nil
 }
 
@@ -23,6 +23,7 @@ package config
 import (
 	"testing"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -112,3 +113,67 @@ func TestCorrectValidation(t *testing.T) {
 	err := cfg.Validate()
 	assert.NoError(t, err)
 }
+
+func TestPolicyAuthorizerValidation(t *testing.T) {
+	valid := func() PolicyAuthorizer {
+		return PolicyAuthorizer{
+			Enable:     true,
+			PolicyFile: "policy.yaml",
+			MaxJwtTTL:  3600,
+			JwtCredentials: &JwtCredentials{
+				Algorithm: jwt.SigningMethodRS256.Name,
+				PublicKey: "public.pem",
+			},
+		}
+	}
+	with := func(update func(*PolicyAuthorizer)) PolicyAuthorizer {
+		cfg := valid()
+		update(&cfg)
+		return cfg
+	}
+	tests := map[string]struct {
+		cfg Authorization
+		err string
+	}{
+		"valid": {
+			cfg: Authorization{PolicyAuthorizer: valid()},
+		},
+		"missing policy file": {
+			cfg: Authorization{PolicyAuthorizer: with(func(c *PolicyAuthorizer) { c.PolicyFile = "" })},
+			err: "[PolicyAuthorizerConfig] PolicyFile can't be empty",
+		},
+		"negative reload interval": {
+			cfg: Authorization{PolicyAuthorizer: with(func(c *PolicyAuthorizer) { c.ReloadInterval = -1 })},
+			err: "[PolicyAuthorizerConfig] ReloadInterval can't be negative",
+		},
+		"missing max TTL": {
+			cfg: Authorization{PolicyAuthorizer: with(func(c *PolicyAuthorizer) { c.MaxJwtTTL = 0 })},
+			err: "[PolicyAuthorizerConfig] MaxTTL must be greater than 0",
+		},
+		"missing jwt credentials": {
+			cfg: Authorization{PolicyAuthorizer: with(func(c *PolicyAuthorizer) { c.JwtCredentials = nil })},
+			err: "[PolicyAuthorizerConfig] PublicKey can't be empty",
+		},
+		"unsupported algorithm": {
+			cfg: Authorization{PolicyAuthorizer: with(func(c *PolicyAuthorizer) { c.JwtCredentials.Algorithm = "HS256" })},
+			err: "[PolicyAuthorizerConfig] The only supported Algorithm is RS256",
+		},
+		"enabled with noop authorizer": {
+			cfg: Authorization{
+				PolicyAuthorizer: valid(),
+				NoopAuthorizer:   NoopAuthorizer{Enable: true},
+			},
+			err: "[AuthorizationConfig] More than one authorizer is enabled",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
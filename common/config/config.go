@@ -107,6 +107,8 @@ type (
 
 	// Membership holds peer provider configuration.
 	Membership struct {
+		// Provider is the config of the peer provider used instead of ringpop, keyed by the provider
+		// name, e.g. static or dns-srv. Ringpop is used when it is empty.
 		Provider PeerProvider `yaml:"provider"`
 	}
 
@@ -178,6 +180,10 @@ type (
 		// Mode represents the TLS mode of the transport.
 		// Available modes: disabled, permissive, enforced
 		TLSMode yarpctls.Mode `yaml:"TLSMode"`
+		// REST enables the resource-oriented REST/JSON gateway of the service API under /api/v1.
+		// Only the frontend service provides one. A route is only served if the procedure it exposes
+		// is in Procedures, and it goes through the same inbound middleware and authorization.
+		REST bool `yaml:"rest"`
 	}
 
 	// Blobstore contains the config for blobstore
@@ -206,6 +212,9 @@ type (
 		HistoryMaxConns int `yaml:"historyMaxConns"`
 		// EnablePersistenceLatencyHistogramMetrics is to enable latency histogram metrics for persistence layer
 		EnablePersistenceLatencyHistogramMetrics bool `yaml:"enablePersistenceLatencyHistogramMetrics"`
+		// EnableFaultInjection installs the persistence fault injectors, so that the rules of the
+		// system.persistenceFaultInjectionRules dynamic config take effect. Only enable it in test clusters.
+		EnableFaultInjection bool `yaml:"enableFaultInjection"`
 		// NumHistoryShards is the desired number of history shards. It's for computing the historyShardID from workflowID into [0, NumHistoryShards)
 		// Therefore, the value cannot be changed once set.
 		// TODO This config doesn't belong here, needs refactoring
@@ -247,8 +256,9 @@ type (
 		Port int `yaml:"port"`
 		// User is the cassandra user used for authentication by gocql client
 		User string `yaml:"user"`
-		// Password is the cassandra password used for authentication by gocql client
-		Password string `yaml:"password"`
+		// Password is the cassandra password used for authentication by gocql client,
+		// it can be a secret reference such as file:///etc/cadence/password
+		Password string `yaml:"password" secret:"true"`
 		// AllowedAuthenticators informs the cassandra client to expect a custom authenticator
 		AllowedAuthenticators []string `yaml:"allowedAuthenticators"`
 		// Keyspace is the cassandra keyspace
@@ -329,7 +339,9 @@ type (
 		User string `yaml:"user"`
 		// Password is the password corresponding to the user name
 		// If useMultipleDatabases, must be empty and provide it via multipleDatabasesConfig instead
-		Password string `yaml:"password"`
+		// It can be a secret reference such as file:///etc/cadence/password, which is resolved again whenever
+		// a new connection is opened, so that a rotated password is used once MaxConnLifetime recycles the connections
+		Password string `yaml:"password" secret:"reload"`
 		// PluginName is the name of SQL plugin
 		PluginName string `yaml:"pluginName" validate:"nonzero"`
 		// DatabaseName is the name of SQL database to connect to
@@ -375,8 +387,8 @@ type (
 	MultipleDatabasesConfigEntry struct {
 		// User is the username to be used for the conn
 		User string `yaml:"user"`
-		// Password is the password corresponding to the user name
-		Password string `yaml:"password"`
+		// Password is the password corresponding to the user name, it can be a secret reference like SQL.Password
+		Password string `yaml:"password" secret:"reload"`
 		// DatabaseName is the name of SQL database to connect to
 		DatabaseName string `yaml:"databaseName" validate:"nonzero"`
 		// ConnectAddr is the remote addr of the database
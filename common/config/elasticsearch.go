@@ -43,8 +43,8 @@ type (
 		Version string `yaml:"version"` //nolint:govet
 		// optional username to communicate with ElasticSearch
 		Username string `yaml:"username"` //nolint:govet
-		// optional password to communicate with ElasticSearch
-		Password string `yaml:"password"` //nolint:govet
+		// optional password to communicate with ElasticSearch, can be a secret reference such as file:///etc/cadence/password
+		Password string `yaml:"password" secret:"true"` //nolint:govet
 		// optional to disable sniff, according to issues on Github,
 		// Sniff could cause issue like "no Elasticsearch node available"
 		DisableSniff bool `yaml:"disableSniff"`
@@ -78,8 +78,8 @@ type (
 	AWSStaticCredential struct {
 		AccessKey    string `yaml:"accessKey"`
 		Region       string `yaml:"region"`
-		SecretKey    string `yaml:"secretKey"`
-		SessionToken string `yaml:"sessionToken"`
+		SecretKey    string `yaml:"secretKey" secret:"true"`
+		SessionToken string `yaml:"sessionToken" secret:"true"`
 	}
 
 	// AWSEnvironmentCredential will make a new Session created from SDK defaults, config files,
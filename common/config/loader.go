@@ -24,9 +24,11 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 
 	uconfig "go.uber.org/config"
 	"gopkg.in/validator.v2"
+	yamlv2 "gopkg.in/yaml.v2"
 )
 
 const (
@@ -61,8 +63,51 @@ const (
 //	base.yaml
 //	    env.yaml   -- environment is one of the input params ex-development
 //	      env_az.yaml -- zone is another input param
+//
+// The fields tagged as secrets can be set to a reference to a secret, such as
+// file:///etc/cadence/password, which is resolved by the SecretProvider registered
+// for the scheme of the reference
 func Load(env string, configDir string, zone string, config interface{}) error {
+	yaml, err := newYAML(env, configDir, zone)
+	if err != nil {
+		return err
+	}
+
+	err = yaml.Get(uconfig.Root).Populate(config)
+	if err != nil {
+		return fmt.Errorf("unable to populate config: %w", err)
+	}
+
+	err = resolveSecrets(reflect.ValueOf(config), "")
+	if err != nil {
+		return fmt.Errorf("unable to resolve config secrets: %w", err)
+	}
+
+	err = validator.Validate(config)
+	if err != nil {
+		return fmt.Errorf("failed to validate config: %w", err)
+	}
+	return nil
+}
 
+// LoadMerged returns the configuration merged from the same config files as Load,
+// with the env variables expanded, without populating it into a config struct so that
+// the keys unknown to the config struct and the plugin configs are kept
+func LoadMerged(env string, configDir string, zone string) (yamlv2.MapSlice, error) {
+	yaml, err := newYAML(env, configDir, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	var merged yamlv2.MapSlice
+	err = yaml.Get(uconfig.Root).Populate(&merged)
+	if err != nil {
+		return nil, fmt.Errorf("unable to populate config: %w", err)
+	}
+	return merged, nil
+}
+
+func newYAML(env string, configDir string, zone string) (*uconfig.YAML, error) {
 	if len(env) == 0 {
 		env = envDevelopment
 	}
@@ -73,7 +118,7 @@ func Load(env string, configDir string, zone string, config interface{}) error {
 
 	files, err := getConfigFiles(env, configDir, zone)
 	if err != nil {
-		return fmt.Errorf("unable to get config files: %w", err)
+		return nil, fmt.Errorf("unable to get config files: %w", err)
 	}
 
 	log.Printf("Loading configFiles=%v\n", files)
@@ -88,19 +133,9 @@ func Load(env string, configDir string, zone string, config interface{}) error {
 
 	yaml, err := uconfig.NewYAML(options...)
 	if err != nil {
-		return fmt.Errorf("unable to create yaml parser: %w", err)
-	}
-
-	err = yaml.Get(uconfig.Root).Populate(config)
-	if err != nil {
-		return fmt.Errorf("unable to populate config: %w", err)
+		return nil, fmt.Errorf("unable to create yaml parser: %w", err)
 	}
-
-	err = validator.Validate(config)
-	if err != nil {
-		return fmt.Errorf("failed to validate config: %w", err)
-	}
-	return nil
+	return yaml, nil
 }
 
 // getConfigFiles returns the list of config files to
@@ -26,6 +26,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	yamlv2 "gopkg.in/yaml.v2"
 )
 
 type (
@@ -42,6 +43,11 @@ type (
 	testConfig struct {
 		Items itemsConfig `yaml:"items"`
 	}
+
+	secretsConfig struct {
+		DataStores map[string]DataStore `yaml:"datastores"`
+		Kafka      KafkaConfig          `yaml:"kafka"`
+	}
 )
 
 func TestLoaderSuite(t *testing.T) {
@@ -122,6 +128,70 @@ items:
 	}
 }
 
+func (s *LoaderSuite) TestLoadMerged() {
+
+	dir := s.T().TempDir()
+
+	s.createFile(dir, "base.yaml", `
+items:
+  item1: base1
+  item2: base2
+plugin:
+  key: value`)
+
+	s.createFile(dir, "prod.yaml", `
+items:
+  item1: ${TEST_LOAD_MERGED_ITEM:prod1}`)
+
+	s.T().Setenv("TEST_LOAD_MERGED_ITEM", "env1")
+	merged, err := LoadMerged("prod", dir, "")
+	s.NoError(err)
+	s.Equal(yamlv2.MapSlice{
+		{Key: "items", Value: yamlv2.MapSlice{
+			{Key: "item1", Value: "env1"},
+			{Key: "item2", Value: "base2"},
+		}},
+		{Key: "plugin", Value: yamlv2.MapSlice{
+			{Key: "key", Value: "value"},
+		}},
+	}, merged)
+
+	_, err = LoadMerged("prod", "", "")
+	s.Error(err)
+}
+
+func (s *LoaderSuite) TestSecrets() {
+
+	dir := s.T().TempDir()
+
+	s.createFile(dir, "password", "secret1\n")
+	s.createFile(dir, "base.yaml", `
+datastores:
+  default:
+    nosql:
+      hosts: 127.0.0.1
+      password: file://`+path(dir, "password")+`
+  sql:
+    sql:
+      pluginName: mysql
+      password: file://`+path(dir, "password")+`
+kafka:
+  sasl:
+    password: plain`)
+
+	var cfg secretsConfig
+	s.NoError(Load("prod", dir, "", &cfg))
+	s.Equal("secret1", cfg.DataStores["default"].NoSQL.Password)
+	s.Equal("file://"+path(dir, "password"), cfg.DataStores["sql"].SQL.Password)
+	s.Equal("plain", cfg.Kafka.SASL.Password)
+
+	s.createFile(dir, "prod.yaml", `
+kafka:
+  sasl:
+    password: file://`+path(dir, "missing"))
+	s.ErrorContains(Load("prod", dir, "", &cfg), "kafka.sasl.password")
+}
+
 func (s *LoaderSuite) TestInvalidPath() {
 	var cfg testConfig
 	err := Load("prod", "", "", &cfg)
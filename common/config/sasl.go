@@ -25,7 +25,7 @@ type (
 	SASL struct {
 		Enabled   bool   `yaml:"enabled"` // false as default
 		User      string `yaml:"user"`
-		Password  string `yaml:"password"`
-		Algorithm string `yaml:"algorithm"` // plain, sha512 or sha256
+		Password  string `yaml:"password" secret:"true"` // can be a secret reference such as file:///etc/cadence/password
+		Algorithm string `yaml:"algorithm"`              // plain, sha512 or sha256
 	}
 )
@@ -0,0 +1,168 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+const (
+	// secretTag is the struct tag of the config fields which can be set to a secret reference
+	secretTag = "secret"
+	// secretTagResolve marks fields whose secret reference is replaced with the secret value by Load
+	secretTagResolve = "true"
+	// secretTagReload marks fields whose secret reference is kept by Load, so that the client can resolve
+	// it again with ResolveSecret whenever it reconnects and pick up a rotated secret
+	secretTagReload = "reload"
+
+	fileSecretScheme = "file"
+)
+
+type (
+	// SecretProvider returns the current value of the secrets it is registered for.
+	// A secret reference in the config has the form <scheme>://<ref>, and the provider registered for
+	// the scheme is called with the ref part, e.g. "file:///etc/cadence/password" calls the file provider
+	// with "/etc/cadence/password".
+	SecretProvider interface {
+		GetSecret(ref string) (string, error)
+	}
+
+	// SecretProviderFunc is an adapter to use a function as a SecretProvider
+	SecretProviderFunc func(ref string) (string, error)
+
+	fileSecretProvider struct{}
+)
+
+var secretProviders = map[string]SecretProvider{
+	fileSecretScheme: fileSecretProvider{},
+}
+
+// RegisterSecretProvider registers the provider of the secret references with the given scheme.
+// It must be called before the config is loaded.
+func RegisterSecretProvider(scheme string, provider SecretProvider) {
+	if _, ok := secretProviders[scheme]; ok {
+		panic("secret provider " + scheme + " already registered")
+	}
+	secretProviders[scheme] = provider
+}
+
+// IsSecretReference returns true if value is a reference to a secret of a registered provider
+func IsSecretReference(value string) bool {
+	_, _, ok := parseSecretReference(value)
+	return ok
+}
+
+// ResolveSecret returns the current value of the secret if value is a reference to a secret
+// of a registered provider, otherwise value is returned as is
+func ResolveSecret(value string) (string, error) {
+	scheme, ref, ok := parseSecretReference(value)
+	if !ok {
+		return value, nil
+	}
+	secret, err := secretProviders[scheme].GetSecret(ref)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve %s secret: %w", scheme, err)
+	}
+	return secret, nil
+}
+
+func parseSecretReference(value string) (scheme string, ref string, ok bool) {
+	scheme, ref, ok = strings.Cut(value, "://")
+	if !ok {
+		return "", "", false
+	}
+	if _, ok := secretProviders[scheme]; !ok {
+		return "", "", false
+	}
+	return scheme, ref, true
+}
+
+// GetSecret implements SecretProvider
+func (f SecretProviderFunc) GetSecret(ref string) (string, error) {
+	return f(ref)
+}
+
+// GetSecret reads the secret from the file, without the trailing newline
+func (fileSecretProvider) GetSecret(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// resolveSecrets walks the config and resolves the secret references in the fields tagged as secrets
+func resolveSecrets(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return resolveSecrets(v.Elem(), path)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+			if name == "" || name == "-" {
+				name = field.Name
+			}
+			fieldPath := path + "." + name
+			tag := field.Tag.Get(secretTag)
+			if tag == "" || field.Type.Kind() != reflect.String {
+				if err := resolveSecrets(v.Field(i), fieldPath); err != nil {
+					return err
+				}
+				continue
+			}
+			secret, err := ResolveSecret(v.Field(i).String())
+			if err != nil {
+				return fmt.Errorf("%s: %w", strings.TrimPrefix(fieldPath, "."), err)
+			}
+			if tag == secretTagResolve {
+				v.Field(i).SetString(secret)
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			// map values are not addressable, so the secrets are resolved in a copy which replaces the value
+			value := reflect.New(v.Type().Elem()).Elem()
+			value.Set(iter.Value())
+			if err := resolveSecrets(value, fmt.Sprintf("%s.%v", path, iter.Key().Interface())); err != nil {
+				return err
+			}
+			v.SetMapIndex(iter.Key(), value)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := resolveSecrets(v.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSecret(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "password"), []byte("secret1\n"), fileMode))
+	RegisterSecretProvider("test-vault", SecretProviderFunc(func(ref string) (string, error) {
+		if ref == "cadence/password" {
+			return "secret2", nil
+		}
+		return "", errors.New("not found")
+	}))
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "plain value",
+			value: "password",
+			want:  "password",
+		},
+		{
+			name:  "unknown scheme",
+			value: "unknown://password",
+			want:  "unknown://password",
+		},
+		{
+			name:  "file",
+			value: "file://" + filepath.Join(dir, "password"),
+			want:  "secret1",
+		},
+		{
+			name:    "missing file",
+			value:   "file://" + filepath.Join(dir, "missing"),
+			wantErr: "unable to resolve file secret",
+		},
+		{
+			name:  "registered provider",
+			value: "test-vault://cadence/password",
+			want:  "secret2",
+		},
+		{
+			name:    "registered provider error",
+			value:   "test-vault://cadence/missing",
+			wantErr: "unable to resolve test-vault secret: not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveSecret(tt.value)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	assert.Panics(t, func() { RegisterSecretProvider(fileSecretScheme, fileSecretProvider{}) })
+}
+
+func TestResolveSecrets(t *testing.T) {
+	dir := t.TempDir()
+	ref := "file://" + filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "password"), []byte("secret"), fileMode))
+
+	cfg := Config{
+		Persistence: Persistence{
+			DataStores: map[string]DataStore{
+				"nosql": {NoSQL: &NoSQL{Password: ref}},
+				"sql": {SQL: &SQL{
+					Password:                ref,
+					MultipleDatabasesConfig: []MultipleDatabasesConfigEntry{{Password: ref}},
+				}},
+				"es": {ElasticSearch: &ElasticSearchConfig{
+					Password: ref,
+					AWSSigning: AWSSigning{
+						StaticCredential: &AWSStaticCredential{SecretKey: ref, SessionToken: ref},
+					},
+				}},
+			},
+		},
+		Kafka: KafkaConfig{SASL: SASL{Password: ref}},
+	}
+	require.NoError(t, resolveSecrets(reflect.ValueOf(&cfg), ""))
+
+	assert.Equal(t, "secret", cfg.Persistence.DataStores["nosql"].NoSQL.Password)
+	assert.Equal(t, ref, cfg.Persistence.DataStores["sql"].SQL.Password)
+	assert.Equal(t, ref, cfg.Persistence.DataStores["sql"].SQL.MultipleDatabasesConfig[0].Password)
+	assert.Equal(t, "secret", cfg.Persistence.DataStores["es"].ElasticSearch.Password)
+	assert.Equal(t, "secret", cfg.Persistence.DataStores["es"].ElasticSearch.AWSSigning.StaticCredential.SecretKey)
+	assert.Equal(t, "secret", cfg.Persistence.DataStores["es"].ElasticSearch.AWSSigning.StaticCredential.SessionToken)
+	assert.Equal(t, "secret", cfg.Kafka.SASL.Password)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "password")))
+	err := resolveSecrets(reflect.ValueOf(&cfg), "")
+	assert.ErrorContains(t, err, "persistence.datastores.sql.sql.password")
+}
@@ -186,6 +186,12 @@ const (
 	// When set to a value > 0, failover and rebalance workflows use graceful failover with this timeout.
 	// When empty or zero, force failover is used.
 	DomainDataKeyForFailoverTimeoutSeconds = "FailoverTimeoutInSeconds"
+	// DomainDataKeyForReplicationFilter is the key of DomainData for excluding workflows from replication.
+	// The value is a JSON-encoded types.ReplicationFilter.
+	DomainDataKeyForReplicationFilter = "ReplicationFilter"
+	// DomainDataKeyForClusterAttributeWeights is the key of DomainData for spreading workflows by workflow ID hash.
+	// The value is a JSON-encoded types.ClusterAttributeWeights.
+	DomainDataKeyForClusterAttributeWeights = "ClusterAttributeWeights"
 	// DomainDataKeyForReadGroups stores which groups have read permission of the domain API
 	DomainDataKeyForReadGroups = "READ_GROUPS"
 	// DomainDataKeyForWriteGroups stores which groups have write permission of the domain API
@@ -292,6 +298,8 @@ const (
 const (
 	// WorkflowIDRateLimitReason is the reason set in ServiceBusyError when workflow ID rate limit is exceeded
 	WorkflowIDRateLimitReason = "external-workflow-id-rate-limit"
+	// OpenWorkflowLimitReason is the reason set in ServiceBusyError when a domain or workflow type reached its open workflow limit
+	OpenWorkflowLimitReason = "open-workflow-limit"
 )
 
 type (
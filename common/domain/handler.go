@@ -115,6 +115,7 @@ type (
 		FailoverHistoryMaxSize      dynamicproperties.IntPropertyFnWithDomainFilter
 		MaxFailoverTimeoutInSeconds dynamicproperties.IntPropertyFnWithDomainFilter
 		EnableDomainAuditLogging    dynamicproperties.BoolPropertyFn
+		IsSQLDefaultStore           bool
 	}
 
 	// FailoverEvent is the failover information to be stored for each failover event in domain data
@@ -194,6 +195,12 @@ func (d *handlerImpl) RegisterDomain(
 	if !matchedRegex {
 		return errInvalidDomainName
 	}
+	if err := d.validateReplicationFilter(registerRequest.Data); err != nil {
+		return err
+	}
+	if err := validateClusterAttributeWeights(registerRequest.Data); err != nil {
+		return err
+	}
 
 	activeClusterName := d.clusterMetadata.GetCurrentClusterName()
 	// input validation on cluster names
@@ -435,6 +442,13 @@ func (d *handlerImpl) UpdateDomain(
 	ctx context.Context,
 	updateRequest *types.UpdateDomainRequest,
 ) (*types.UpdateDomainResponse, error) {
+	if err := d.validateReplicationFilter(updateRequest.Data); err != nil {
+		return nil, err
+	}
+	if err := validateClusterAttributeWeights(updateRequest.Data); err != nil {
+		return nil, err
+	}
+
 	// must get the metadata (notificationVersion) first
 	// this version can be regarded as the lock on the v2 domain table
 	// and since we do not know which table will return the domain afterwards
@@ -1979,3 +1993,24 @@ func (d *handlerImpl) buildActiveActiveClusterScopesFromUpdateRequest(updateRequ
 
 	return config.ActiveClusters, false
 }
+
+// validateReplicationFilter rejects a replication filter in the domain data which cannot be evaluated,
+// or which the execution store cannot apply because it has no room for the replication exclusion of a workflow
+func (d *handlerImpl) validateReplicationFilter(data map[string]string) error {
+	filter, err := types.ParseReplicationFilter(data[constants.DomainDataKeyForReplicationFilter])
+	if err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	if filter != nil && d.config.IsSQLDefaultStore {
+		return &types.BadRequestError{Message: "replication filters are not supported by the SQL persistence store"}
+	}
+	return nil
+}
+
+// validateClusterAttributeWeights rejects cluster attribute weights in the domain data which cannot be evaluated
+func validateClusterAttributeWeights(data map[string]string) error {
+	if _, err := types.ParseClusterAttributeWeights(data[constants.DomainDataKeyForClusterAttributeWeights]); err != nil {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	return nil
+}
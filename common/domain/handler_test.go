@@ -170,6 +170,32 @@ func TestRegisterDomain(t *testing.T) {
 			wantErr:     true,
 			expectedErr: errInvalidDomainName,
 		},
+		{
+			name: "invalid replication filter",
+			request: &types.RegisterDomainRequest{
+				Name:                                   "test-domain",
+				WorkflowExecutionRetentionPeriodInDays: 3,
+				Data:                                   map[string]string{commonconstants.DomainDataKeyForReplicationFilter: `{"excludedTaskLists":[""]}`},
+			},
+			mockSetup: func(mockDomainMgr *persistence.MockDomainManager, replicator *MockReplicator, request *types.RegisterDomainRequest) {
+				mockDomainMgr.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: request.Name}).Return(nil, &types.EntityNotExistsError{})
+			},
+			wantErr:     true,
+			expectedErr: &types.BadRequestError{},
+		},
+		{
+			name: "invalid cluster attribute weights",
+			request: &types.RegisterDomainRequest{
+				Name:                                   "test-domain",
+				WorkflowExecutionRetentionPeriodInDays: 3,
+				Data:                                   map[string]string{commonconstants.DomainDataKeyForClusterAttributeWeights: `{"region":{"us-east":-1}}`},
+			},
+			mockSetup: func(mockDomainMgr *persistence.MockDomainManager, replicator *MockReplicator, request *types.RegisterDomainRequest) {
+				mockDomainMgr.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: request.Name}).Return(nil, &types.EntityNotExistsError{})
+			},
+			wantErr:     true,
+			expectedErr: &types.BadRequestError{},
+		},
 		{
 			name: "specify active cluster name",
 			request: &types.RegisterDomainRequest{
@@ -2154,6 +2180,26 @@ func TestHandler_UpdateDomain(t *testing.T) {
 			},
 			err: errLocalDomainsCannotFailover,
 		},
+		{
+			name: "Error case - invalid replication filter",
+			setupMock: func(_ *persistence.MockDomainManager, _ *types.UpdateDomainRequest, _ *archiver.MockArchivalMetadata, _ clock.MockedTimeSource, _ *MockReplicator) {
+			},
+			request: &types.UpdateDomainRequest{
+				Name: constants.TestDomainName,
+				Data: map[string]string{commonconstants.DomainDataKeyForReplicationFilter: "not-json"},
+			},
+			err: &types.BadRequestError{Message: "invalid replication filter: invalid character 'o' in literal null (expecting 'u')"},
+		},
+		{
+			name: "Error case - invalid cluster attribute weights",
+			setupMock: func(_ *persistence.MockDomainManager, _ *types.UpdateDomainRequest, _ *archiver.MockArchivalMetadata, _ clock.MockedTimeSource, _ *MockReplicator) {
+			},
+			request: &types.UpdateDomainRequest{
+				Name: constants.TestDomainName,
+				Data: map[string]string{commonconstants.DomainDataKeyForClusterAttributeWeights: `{"region":{"us-east":0}}`},
+			},
+			err: &types.BadRequestError{Message: "invalid cluster attribute weights: no positive weight in scope region"},
+		},
 		{
 			name: "Error case - GetMetadata error",
 			setupMock: func(domainManager *persistence.MockDomainManager, _ *types.UpdateDomainRequest, _ *archiver.MockArchivalMetadata, _ clock.MockedTimeSource, _ *MockReplicator) {
@@ -4800,3 +4846,53 @@ func TestValidateDomainReplicationConfigForFailover(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateReplicationFilter(t *testing.T) {
+	tests := []struct {
+		name              string
+		data              map[string]string
+		isSQLDefaultStore bool
+		expectedErr       error
+	}{
+		{
+			name: "no filter",
+		},
+		{
+			name:              "no filter on SQL",
+			isSQLDefaultStore: true,
+		},
+		{
+			name: "valid filter",
+			data: map[string]string{commonconstants.DomainDataKeyForReplicationFilter: `{"excludedTaskLists":["tl"]}`},
+		},
+		{
+			name:        "invalid filter",
+			data:        map[string]string{commonconstants.DomainDataKeyForReplicationFilter: `{"excludedTaskLists":[""]}`},
+			expectedErr: &types.BadRequestError{},
+		},
+		{
+			name:              "valid filter on SQL",
+			data:              map[string]string{commonconstants.DomainDataKeyForReplicationFilter: `{"excludedTaskLists":["tl"]}`},
+			isSQLDefaultStore: true,
+			expectedErr:       &types.BadRequestError{Message: "replication filters are not supported by the SQL persistence store"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := &handlerImpl{
+				config: Config{IsSQLDefaultStore: tc.isSQLDefaultStore},
+			}
+
+			err := handler.validateReplicationFilter(tc.data)
+			if tc.expectedErr != nil {
+				assert.IsType(t, tc.expectedErr, err)
+				if message := tc.expectedErr.(*types.BadRequestError).Message; message != "" {
+					assert.Equal(t, tc.expectedErr, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -26,6 +26,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"reflect"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -47,7 +49,11 @@ import (
 
 //go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination configstore_mock.go -self_package github.com/uber/cadence/common/dynamicconfig/configstore
 
-var _ dc.Client = (*configStoreClient)(nil)
+var (
+	_ dc.Client       = (*configStoreClient)(nil)
+	_ dc.Explainer    = (*configStoreClient)(nil)
+	_ VersionedClient = (*configStoreClient)(nil)
+)
 
 // Client is a stateful config store
 type Client interface {
@@ -55,6 +61,27 @@ type Client interface {
 	dc.Client
 }
 
+// VersionedClient is a config store which keeps every snapshot of the dynamic config as a version
+type VersionedClient interface {
+	// UpdateValueWithChange is UpdateValue recording who made the change and why in the new version
+	UpdateValueWithChange(name dynamicproperties.Key, value interface{}, change ChangeInfo) error
+	// RestoreValueWithChange is RestoreValue recording who made the change and why in the new version
+	RestoreValueWithChange(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}, change ChangeInfo) error
+	// ListVersions returns up to pageSize versions not greater than maxVersion, latest first.
+	// A zero maxVersion starts from the latest version.
+	ListVersions(maxVersion int64, pageSize int) ([]*types.DynamicConfigVersion, error)
+	// GetVersion returns a version with its entries, a zero version returns the latest one
+	GetVersion(version int64) (*types.DynamicConfigVersion, error)
+	// Rollback writes the entries of version as a new version and returns the new version
+	Rollback(version int64, change ChangeInfo) (int64, error)
+}
+
+// ChangeInfo describes who made a dynamic config change and why
+type ChangeInfo struct {
+	Author string
+	Reason string
+}
+
 const (
 	configStoreMinPollInterval = time.Second * 2
 )
@@ -297,14 +324,22 @@ func (csc *configStoreClient) GetListValue(name dynamicproperties.ListKey, filte
 }
 
 func (csc *configStoreClient) UpdateValue(name dynamicproperties.Key, value interface{}) error {
+	return csc.UpdateValueWithChange(name, value, ChangeInfo{})
+}
+
+func (csc *configStoreClient) UpdateValueWithChange(name dynamicproperties.Key, value interface{}, change ChangeInfo) error {
 	dcValues, ok := value.([]*types.DynamicConfigValue)
 	if !ok && value != nil {
 		return errors.New("invalid value")
 	}
-	return csc.updateValue(name, dcValues, csc.config.UpdateRetryAttempts)
+	return csc.updateValue(name, dcValues, change, csc.config.UpdateRetryAttempts)
 }
 
 func (csc *configStoreClient) RestoreValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) error {
+	return csc.RestoreValueWithChange(name, filters, ChangeInfo{})
+}
+
+func (csc *configStoreClient) RestoreValueWithChange(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}, change ChangeInfo) error {
 	// if empty filter provided, update fallback value.
 	// if u want to remove entire entry, just do update value with empty
 	loaded := csc.values.Load()
@@ -337,7 +372,7 @@ func (csc *configStoreClient) RestoreValue(name dynamicproperties.Key, filters m
 		}
 	}
 
-	return csc.updateValue(name, newValues, csc.config.UpdateRetryAttempts)
+	return csc.updateValue(name, newValues, change, csc.config.UpdateRetryAttempts)
 }
 
 func (csc *configStoreClient) ListValue(name dynamicproperties.Key) ([]*types.DynamicConfigEntry, error) {
@@ -375,6 +410,59 @@ func (csc *configStoreClient) ListValue(name dynamicproperties.Key) ([]*types.Dy
 	return resList, nil
 }
 
+func (csc *configStoreClient) ListVersions(maxVersion int64, pageSize int) ([]*types.DynamicConfigVersion, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), csc.config.FetchTimeout)
+	defer cancel()
+
+	res, err := csc.configStoreManager.ListDynamicConfigVersions(
+		ctx,
+		&persistence.ListDynamicConfigVersionsRequest{
+			MaxVersion: maxVersion,
+			PageSize:   pageSize,
+		}, csc.configStoreType,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dynamic config versions %v", err)
+	}
+
+	versions := make([]*types.DynamicConfigVersion, 0, len(res.Snapshots))
+	for _, snapshot := range res.Snapshots {
+		versions = append(versions, toDynamicConfigVersion(snapshot))
+	}
+	return versions, nil
+}
+
+func (csc *configStoreClient) GetVersion(version int64) (*types.DynamicConfigVersion, error) {
+	if version < 0 {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("invalid dynamic config version %v", version)}
+	}
+	versions, err := csc.ListVersions(version, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 || (version != 0 && versions[0].Version != version) {
+		return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("dynamic config version %v not found", version)}
+	}
+	return versions[0], nil
+}
+
+func (csc *configStoreClient) Rollback(version int64, change ChangeInfo) (int64, error) {
+	if version <= 0 {
+		return 0, &types.BadRequestError{Message: fmt.Sprintf("invalid dynamic config version %v", version)}
+	}
+	target, err := csc.GetVersion(version)
+	if err != nil {
+		return 0, err
+	}
+	return csc.writeSnapshot(func(cacheEntry) []*types.DynamicConfigEntry {
+		entries := make([]*types.DynamicConfigEntry, 0, len(target.Entries))
+		for _, entry := range target.Entries {
+			entries = append(entries, entry.Copy())
+		}
+		return entries
+	}, change, csc.config.UpdateRetryAttempts)
+}
+
 func (csc *configStoreClient) Stop() {
 	if !atomic.CompareAndSwapInt32(&csc.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
 		return
@@ -394,7 +482,7 @@ func (csc *configStoreClient) Start() {
 	}
 }
 
-func (csc *configStoreClient) updateValue(name dynamicproperties.Key, dcValues []*types.DynamicConfigValue, retryAttempts int) error {
+func (csc *configStoreClient) updateValue(name dynamicproperties.Key, dcValues []*types.DynamicConfigValue, change ChangeInfo, retryAttempts int) error {
 	// since values are not unique, no way to know if you are trying to update a specific value
 	// or if you want to add another of the same value with different filters.
 	// UpdateValue will replace everything associated with dc key.
@@ -403,64 +491,79 @@ func (csc *configStoreClient) updateValue(name dynamicproperties.Key, dcValues [
 			return err
 		}
 	}
-	loaded := csc.values.Load()
-	var currentCached cacheEntry
-	if loaded == nil {
-		currentCached = cacheEntry{
-			cacheVersion:  0,
-			schemaVersion: 0,
-			dcEntries:     map[string]*types.DynamicConfigEntry{},
-		}
-	} else {
-		currentCached = loaded.(cacheEntry)
-	}
 
-	keyName := name.String()
-	var newEntries []*types.DynamicConfigEntry
+	_, err := csc.writeSnapshot(func(currentCached cacheEntry) []*types.DynamicConfigEntry {
+		keyName := name.String()
+		var newEntries []*types.DynamicConfigEntry
 
-	existingEntry, entryExists := currentCached.dcEntries[keyName]
+		existingEntry, entryExists := currentCached.dcEntries[keyName]
 
-	if len(dcValues) == 0 {
-		newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
+		if len(dcValues) == 0 {
+			newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
 
-		for _, entry := range currentCached.dcEntries {
-			if entryExists && entry == existingEntry {
-				continue
-			} else {
-				newEntries = append(newEntries, entry.Copy())
+			for _, entry := range currentCached.dcEntries {
+				if entryExists && entry == existingEntry {
+					continue
+				} else {
+					newEntries = append(newEntries, entry.Copy())
+				}
 			}
-		}
-	} else {
-		if entryExists {
-			newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
 		} else {
-			newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries)+1)
-			newEntries = append(newEntries,
-				&types.DynamicConfigEntry{
-					Name:   keyName,
-					Values: dcValues,
-				})
-		}
-
-		for _, entry := range currentCached.dcEntries {
-			if entryExists && entry.Name == keyName {
+			if entryExists {
+				newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries))
+			} else {
+				newEntries = make([]*types.DynamicConfigEntry, 0, len(currentCached.dcEntries)+1)
 				newEntries = append(newEntries,
 					&types.DynamicConfigEntry{
 						Name:   keyName,
 						Values: dcValues,
 					})
-			} else {
-				newEntries = append(newEntries, entry.Copy())
+			}
+
+			for _, entry := range currentCached.dcEntries {
+				if entryExists && entry.Name == keyName {
+					newEntries = append(newEntries,
+						&types.DynamicConfigEntry{
+							Name:   keyName,
+							Values: dcValues,
+						})
+				} else {
+					newEntries = append(newEntries, entry.Copy())
+				}
 			}
 		}
+		return newEntries
+	}, change, retryAttempts)
+	return err
+}
+
+// writeSnapshot writes the entries built from the cached snapshot as the next version and returns it.
+// On a version conflict, the cache is refreshed and the entries are built again.
+func (csc *configStoreClient) writeSnapshot(
+	buildEntries func(currentCached cacheEntry) []*types.DynamicConfigEntry,
+	change ChangeInfo,
+	retryAttempts int,
+) (int64, error) {
+	loaded := csc.values.Load()
+	var currentCached cacheEntry
+	if loaded == nil {
+		currentCached = cacheEntry{
+			cacheVersion:  0,
+			schemaVersion: 0,
+			dcEntries:     map[string]*types.DynamicConfigEntry{},
+		}
+	} else {
+		currentCached = loaded.(cacheEntry)
 	}
 
 	newSnapshot := &persistence.DynamicConfigSnapshot{
 		Version: currentCached.cacheVersion + 1,
 		Values: &types.DynamicConfigBlob{
 			SchemaVersion: currentCached.schemaVersion,
-			Entries:       newEntries,
+			Entries:       buildEntries(currentCached),
 		},
+		Author: change.Author,
+		Reason: change.Reason,
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), csc.config.UpdateTimeout)
@@ -476,24 +579,24 @@ func (csc *configStoreClient) updateValue(name dynamicproperties.Key, dcValues [
 	select {
 	case <-ctx.Done():
 		// potentially we can retry on timeout
-		return errors.New("timeout error on update")
+		return 0, errors.New("timeout error on update")
 	default:
 		if err != nil {
 			if _, ok := err.(*persistence.ConditionFailedError); ok && retryAttempts > 0 {
 				// fetch new config and retry
 				err := csc.update()
 				if err != nil {
-					return err
+					return 0, err
 				}
-				return csc.updateValue(name, dcValues, retryAttempts-1)
+				return csc.writeSnapshot(buildEntries, change, retryAttempts-1)
 			}
 
 			if retryAttempts == 0 {
-				return errors.New("ran out of retry attempts on update")
+				return 0, errors.New("ran out of retry attempts on update")
 			}
-			return err
+			return 0, err
 		}
-		return nil
+		return newSnapshot.Version, nil
 	}
 }
 
@@ -575,6 +678,75 @@ func (csc *configStoreClient) getValueWithFilters(key dynamicproperties.Key, fil
 	return defaultValue, dc.NotFoundError
 }
 
+func (csc *configStoreClient) ExplainValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) (*dc.Resolution, error) {
+	var candidates []dc.Candidate
+	if loaded := csc.values.Load(); loaded != nil {
+		if entry, ok := loaded.(cacheEntry).dcEntries[name.String()]; ok && entry != nil {
+			for _, dcValue := range entry.Values {
+				value, err := convertFromDataBlob(dcValue.Value)
+				if err != nil {
+					// values which fail to parse can never be served
+					continue
+				}
+				constraints := make(map[string]interface{}, len(dcValue.Filters))
+				for _, filter := range dcValue.Filters {
+					constraints[filter.Name], _ = convertFromDataBlob(filter.Value)
+				}
+				candidates = append(candidates, dc.Candidate{
+					Value:       value,
+					Constraints: constraints,
+					Matched:     matchFilters(dcValue, filters),
+				})
+			}
+		}
+	}
+	return dc.NewResolution(dc.ConfigStoreClient, name, candidates), nil
+}
+
+func toDynamicConfigVersion(snapshot *persistence.DynamicConfigSnapshot) *types.DynamicConfigVersion {
+	version := &types.DynamicConfigVersion{
+		Version: snapshot.Version,
+		Author:  snapshot.Author,
+		Reason:  snapshot.Reason,
+	}
+	if !snapshot.Timestamp.IsZero() {
+		version.Timestamp = common.Int64Ptr(snapshot.Timestamp.UnixNano())
+	}
+	if snapshot.Values != nil {
+		version.Entries = snapshot.Values.Entries
+	}
+	return version
+}
+
+// DiffEntries returns the entries which differ between two versions, sorted by name.
+// Values are compared in order, as the first matching value wins on lookup.
+func DiffEntries(from, to []*types.DynamicConfigEntry) []*types.DynamicConfigEntryDiff {
+	fromValues := make(map[string][]*types.DynamicConfigValue, len(from))
+	for _, entry := range from {
+		fromValues[entry.Name] = entry.Values
+	}
+	toValues := make(map[string][]*types.DynamicConfigValue, len(to))
+	for _, entry := range to {
+		toValues[entry.Name] = entry.Values
+	}
+
+	var diffs []*types.DynamicConfigEntryDiff
+	for name, values := range fromValues {
+		if other, ok := toValues[name]; !ok || !reflect.DeepEqual(values, other) {
+			diffs = append(diffs, &types.DynamicConfigEntryDiff{Name: name, FromValues: values, ToValues: other})
+		}
+	}
+	for name, values := range toValues {
+		if _, ok := fromValues[name]; !ok {
+			diffs = append(diffs, &types.DynamicConfigEntryDiff{Name: name, ToValues: values})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Name < diffs[j].Name
+	})
+	return diffs
+}
+
 func matchFilters(dcValue *types.DynamicConfigValue, filters map[dynamicproperties.Filter]interface{}) bool {
 	if len(dcValue.Filters) > len(filters) {
 		return false
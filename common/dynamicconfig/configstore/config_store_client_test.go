@@ -32,7 +32,9 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
+	dc "github.com/uber/cadence/common/dynamicconfig"
 	c "github.com/uber/cadence/common/dynamicconfig/configstore/config"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
@@ -366,6 +368,29 @@ func (s *configStoreClientSuite) TestGetValue_NonExistKey() {
 	s.Equal(dynamicproperties.RequiredDomainDataKeys.DefaultMap(), v)
 }
 
+func (s *configStoreClientSuite) TestExplainValue() {
+	defaultTestSetup(s)
+	filters := map[dynamicproperties.Filter]interface{}{
+		dynamicproperties.DomainName: "samples-domain",
+	}
+	res, err := s.client.ExplainValue(dynamicproperties.TestGetBoolPropertyKey, filters)
+	s.NoError(err)
+	s.Equal(&dc.Resolution{
+		Source:      dc.ConfigStoreClient,
+		Value:       true,
+		Constraints: map[string]interface{}{"domainName": "samples-domain"},
+		Candidates: []dc.Candidate{
+			{Value: false, Constraints: map[string]interface{}{}, Matched: true},
+			{Value: true, Constraints: map[string]interface{}{"domainName": "global-samples-domain"}},
+		},
+	}, res)
+
+	res, err = s.client.ExplainValue(dynamicproperties.MaxRetentionDays, filters)
+	s.NoError(err)
+	s.True(res.Default)
+	s.Equal(dynamicproperties.MaxRetentionDays.DefaultInt(), res.Value)
+}
+
 func (s *configStoreClientSuite) TestGetValueWithFilters() {
 	defaultTestSetup(s)
 
@@ -927,6 +952,165 @@ func (s *configStoreClientSuite) TestListValues_EmptyCache() {
 	s.Nil(val)
 }
 
+func (s *configStoreClientSuite) TestUpdateValueWithChange() {
+	defaultTestSetup(s)
+
+	s.mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(2), p.DynamicConfig).
+		DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest, cfgType p.ConfigType) error {
+			s.Equal("alice", request.Snapshot.Author)
+			s.Equal("incident", request.Snapshot.Reason)
+			return nil
+		}).Times(1)
+
+	err := s.client.UpdateValueWithChange(dynamicproperties.TestGetBoolPropertyKey, nil, ChangeInfo{Author: "alice", Reason: "incident"})
+	s.NoError(err)
+}
+
+func (s *configStoreClientSuite) TestListVersions() {
+	ts := time.Unix(0, 1000)
+	s.mockManager.EXPECT().
+		ListDynamicConfigVersions(gomock.Any(), &p.ListDynamicConfigVersionsRequest{MaxVersion: 5, PageSize: 2}, p.DynamicConfig).
+		Return(&p.ListDynamicConfigVersionsResponse{
+			Snapshots: []*p.DynamicConfigSnapshot{
+				{Version: 5, Timestamp: ts, Author: "alice", Reason: "incident", Values: snapshot1.Values},
+				{Version: 4, Values: &types.DynamicConfigBlob{}},
+			},
+		}, nil).Times(1)
+
+	versions, err := s.client.ListVersions(5, 2)
+	s.NoError(err)
+	s.Equal([]*types.DynamicConfigVersion{
+		{Version: 5, Timestamp: common.Int64Ptr(1000), Author: "alice", Reason: "incident", Entries: snapshot1.Values.Entries},
+		{Version: 4},
+	}, versions)
+}
+
+func (s *configStoreClientSuite) TestListVersions_Error() {
+	s.mockManager.EXPECT().
+		ListDynamicConfigVersions(gomock.Any(), gomock.Any(), p.DynamicConfig).
+		Return(nil, errors.New("db error")).Times(1)
+
+	_, err := s.client.ListVersions(0, 10)
+	s.Error(err)
+}
+
+func (s *configStoreClientSuite) TestGetVersion() {
+	tests := map[string]struct {
+		version   int64
+		snapshots []*p.DynamicConfigSnapshot
+		expected  int64
+		err       error
+	}{
+		"latest": {
+			version:   0,
+			snapshots: []*p.DynamicConfigSnapshot{{Version: 7}},
+			expected:  7,
+		},
+		"exact version": {
+			version:   3,
+			snapshots: []*p.DynamicConfigSnapshot{{Version: 3}},
+			expected:  3,
+		},
+		"missing version": {
+			version:   3,
+			snapshots: []*p.DynamicConfigSnapshot{{Version: 2}},
+			err:       &types.EntityNotExistsError{Message: "dynamic config version 3 not found"},
+		},
+		"empty store": {
+			version: 0,
+			err:     &types.EntityNotExistsError{Message: "dynamic config version 0 not found"},
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.mockManager.EXPECT().
+				ListDynamicConfigVersions(gomock.Any(), &p.ListDynamicConfigVersionsRequest{MaxVersion: tc.version, PageSize: 1}, p.DynamicConfig).
+				Return(&p.ListDynamicConfigVersionsResponse{Snapshots: tc.snapshots}, nil).Times(1)
+
+			version, err := s.client.GetVersion(tc.version)
+			if tc.err != nil {
+				s.Equal(tc.err, err)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tc.expected, version.Version)
+		})
+	}
+}
+
+func (s *configStoreClientSuite) TestRollback() {
+	defaultTestSetup(s)
+
+	old := &types.DynamicConfigBlob{
+		Entries: []*types.DynamicConfigEntry{
+			{
+				Name: dynamicproperties.TestGetIntPropertyKey.String(),
+				Values: []*types.DynamicConfigValue{
+					{
+						Value: &types.DataBlob{
+							EncodingType: types.EncodingTypeJSON.Ptr(),
+							Data:         jsonMarshalHelper(10),
+						},
+					},
+				},
+			},
+		},
+	}
+	s.mockManager.EXPECT().
+		ListDynamicConfigVersions(gomock.Any(), &p.ListDynamicConfigVersionsRequest{MaxVersion: 1, PageSize: 1}, p.DynamicConfig).
+		Return(&p.ListDynamicConfigVersionsResponse{
+			Snapshots: []*p.DynamicConfigSnapshot{{Version: 1, Values: old}},
+		}, nil).Times(1)
+	s.mockManager.EXPECT().
+		UpdateDynamicConfig(gomock.Any(), EqSnapshotVersion(2), p.DynamicConfig).
+		DoAndReturn(func(_ context.Context, request *p.UpdateDynamicConfigRequest, cfgType p.ConfigType) error {
+			s.Equal(old.Entries, request.Snapshot.Values.Entries)
+			s.Equal("bob", request.Snapshot.Author)
+			s.Equal("revert", request.Snapshot.Reason)
+			return nil
+		}).Times(1)
+
+	newVersion, err := s.client.Rollback(1, ChangeInfo{Author: "bob", Reason: "revert"})
+	s.NoError(err)
+	s.Equal(int64(2), newVersion)
+}
+
+func (s *configStoreClientSuite) TestRollback_InvalidVersion() {
+	_, err := s.client.Rollback(0, ChangeInfo{})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func TestDiffEntries(t *testing.T) {
+	value := func(v interface{}) []*types.DynamicConfigValue {
+		return []*types.DynamicConfigValue{
+			{
+				Value: &types.DataBlob{
+					EncodingType: types.EncodingTypeJSON.Ptr(),
+					Data:         jsonMarshalHelper(v),
+				},
+			},
+		}
+	}
+	from := []*types.DynamicConfigEntry{
+		{Name: "b", Values: value(1)},
+		{Name: "a", Values: value(true)},
+		{Name: "c", Values: value("same")},
+	}
+	to := []*types.DynamicConfigEntry{
+		{Name: "c", Values: value("same")},
+		{Name: "b", Values: value(2)},
+		{Name: "d", Values: value(3)},
+	}
+
+	require.Equal(t, []*types.DynamicConfigEntryDiff{
+		{Name: "a", FromValues: value(true)},
+		{Name: "b", FromValues: value(1), ToValues: value(2)},
+		{Name: "d", ToValues: value(3)},
+	}, DiffEntries(from, to))
+	require.Empty(t, DiffEntries(from, from))
+}
+
 func (s *configStoreClientSuite) TestValidateKeyDataBlobPair() {
 	tests := []struct {
 		name    string
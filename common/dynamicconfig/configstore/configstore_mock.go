@@ -244,3 +244,100 @@ func (mr *MockClientMockRecorder) UpdateValue(name, value any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValue", reflect.TypeOf((*MockClient)(nil).UpdateValue), name, value)
 }
+
+// MockVersionedClient is a mock of VersionedClient interface.
+type MockVersionedClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockVersionedClientMockRecorder
+	isgomock struct{}
+}
+
+// MockVersionedClientMockRecorder is the mock recorder for MockVersionedClient.
+type MockVersionedClientMockRecorder struct {
+	mock *MockVersionedClient
+}
+
+// NewMockVersionedClient creates a new mock instance.
+func NewMockVersionedClient(ctrl *gomock.Controller) *MockVersionedClient {
+	mock := &MockVersionedClient{ctrl: ctrl}
+	mock.recorder = &MockVersionedClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVersionedClient) EXPECT() *MockVersionedClientMockRecorder {
+	return m.recorder
+}
+
+// GetVersion mocks base method.
+func (m *MockVersionedClient) GetVersion(version int64) (*types.DynamicConfigVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", version)
+	ret0, _ := ret[0].(*types.DynamicConfigVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockVersionedClientMockRecorder) GetVersion(version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockVersionedClient)(nil).GetVersion), version)
+}
+
+// ListVersions mocks base method.
+func (m *MockVersionedClient) ListVersions(maxVersion int64, pageSize int) ([]*types.DynamicConfigVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", maxVersion, pageSize)
+	ret0, _ := ret[0].([]*types.DynamicConfigVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockVersionedClientMockRecorder) ListVersions(maxVersion, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockVersionedClient)(nil).ListVersions), maxVersion, pageSize)
+}
+
+// RestoreValueWithChange mocks base method.
+func (m *MockVersionedClient) RestoreValueWithChange(name dynamicproperties.Key, filters map[dynamicproperties.Filter]any, change ChangeInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreValueWithChange", name, filters, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreValueWithChange indicates an expected call of RestoreValueWithChange.
+func (mr *MockVersionedClientMockRecorder) RestoreValueWithChange(name, filters, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreValueWithChange", reflect.TypeOf((*MockVersionedClient)(nil).RestoreValueWithChange), name, filters, change)
+}
+
+// Rollback mocks base method.
+func (m *MockVersionedClient) Rollback(version int64, change ChangeInfo) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", version, change)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockVersionedClientMockRecorder) Rollback(version, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockVersionedClient)(nil).Rollback), version, change)
+}
+
+// UpdateValueWithChange mocks base method.
+func (m *MockVersionedClient) UpdateValueWithChange(name dynamicproperties.Key, value any, change ChangeInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateValueWithChange", name, value, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateValueWithChange indicates an expected call of UpdateValueWithChange.
+func (mr *MockVersionedClientMockRecorder) UpdateValueWithChange(name, value, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValueWithChange", reflect.TypeOf((*MockVersionedClient)(nil).UpdateValueWithChange), name, value, change)
+}
@@ -407,6 +407,18 @@ const (
 	// Default value: 51200 (50*1024)
 	// Allowed filters: DomainName
 	HistoryCountLimitWarn
+	// HistorySizeSuggestContinueAsNew is the per workflow execution history size above which decision tasks suggest continue-as-new, 0 disables the suggestion
+	// KeyName: limit.historySize.suggestContinueAsNew
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	HistorySizeSuggestContinueAsNew
+	// HistoryCountSuggestContinueAsNew is the per workflow execution history event count above which decision tasks suggest continue-as-new, 0 disables the suggestion
+	// KeyName: limit.historyCount.suggestContinueAsNew
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	HistoryCountSuggestContinueAsNew
 	// PendingActivitiesCountLimitError is the limit of how many pending activities a workflow can have at a point in time
 	// KeyName: limit.pendingActivityCount.error
 	// Value type: Int
@@ -734,6 +746,20 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	FrontendDecisionResultCountLimit
+	// FrontendOpenWorkflowLimit is max number of concurrently open workflows per domain, 0 means unlimited.
+	// It is only enforced on StartWorkflowExecution, workflows started by history (child, cron, continue-as-new, reset) are not limited
+	// KeyName: frontend.openWorkflowLimit
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	FrontendOpenWorkflowLimit
+	// FrontendOpenWorkflowLimitRunningCheckRPS is the rate at which starts rejected by open workflow limits are checked
+	// against history for an already running workflow ID, which history would return instead of opening another workflow
+	// KeyName: frontend.openWorkflowLimitRunningCheckRPS
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	FrontendOpenWorkflowLimitRunningCheckRPS
 	// FrontendThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	// KeyName: frontend.throttledLogRPS
 	// Value type: Int
@@ -905,6 +931,18 @@ const (
 	// Default value: 200
 	// Allowed filters: DomainName,TasklistName,TaskType
 	MatchingPartitionUpscaleRPS
+	// MatchingPollerHintMinPollerCount is the lowest poller count recommended to workers in the auto config hint
+	// KeyName: matching.pollerHintMinPollerCount
+	// Value type: Int
+	// Default value: 2
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingPollerHintMinPollerCount
+	// MatchingPollerHintMaxPollerCount is the highest poller count recommended to workers in the auto config hint
+	// KeyName: matching.pollerHintMaxPollerCount
+	// Value type: Int
+	// Default value: 64
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingPollerHintMaxPollerCount
 	// MatchingIsolationGroupsPerPartition is the target number of isolation groups to assign to each partition
 	// KeyName: matching.isolationGroupsPerPartition
 	// Value type: Int
@@ -1305,6 +1343,18 @@ const (
 	// Default value: 10
 	// Allowed filters: N/A
 	NumParentClosePolicySystemWorkflows
+	// CompletionCallbackMaxAttempts is the max number of attempts to deliver a workflow completion callback before giving up
+	// KeyName: history.completionCallbackMaxAttempts
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName
+	CompletionCallbackMaxAttempts
+	// CompletionCallbackMaxConcurrentDeliveries is the max number of workflows per shard whose completion callbacks are delivered concurrently
+	// KeyName: history.completionCallbackMaxConcurrentDeliveries
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: N/A
+	CompletionCallbackMaxConcurrentDeliveries
 	// HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	// KeyName: history.throttledLogRPS
 	// Value type: Int
@@ -2143,6 +2193,13 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	EnableFailoverManager
+	// EnableVisibilityRebuild indicates if the worker runs the visibility rebuild and consistency check workflows,
+	// which need the worker to read and write visibility records
+	// KeyName: worker.enableVisibilityRebuild
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableVisibilityRebuild
 	// ConcreteExecutionFixerDomainAllow is which domains are allowed to be fixed by concrete fixer workflow
 	// KeyName: worker.concreteExecutionFixerDomainAllow
 	// Value type: Bool
@@ -2377,6 +2434,13 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	EnableActiveClusterSelectionPolicyInStartWorkflow
+	// EnableCompletionCallbacks is to accept and deliver workflow completion callbacks for a domain.
+	// Callbacks can only be registered through the REST gateway, the thrift and gRPC APIs have no field for them yet.
+	// KeyName: system.enableCompletionCallbacks
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableCompletionCallbacks
 
 	// EnforceDecisionTaskAttempts is the key for enforcing decision retry attempts limit in case of timeouts.
 	// KeyName: history.enforceDecisionTaskAttempts
@@ -2605,6 +2669,14 @@ const (
 
 	MatchingPartitionDownscaleFactor
 
+	// MatchingPollerHintMinSyncMatchRate is the share of tasks which must be sync matched, below it more pollers are
+	// recommended to workers while the task list has a backlog
+	// KeyName: matching.pollerHintMinSyncMatchRate
+	// Value type: Float64
+	// Default value: 0.9
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingPollerHintMinSyncMatchRate
+
 	// MatchingOverrideTaskListRPS is the RPS override for a specific TaskList.
 	// When set to a non-zero value, this overrides the RPS value that pollers specify.
 	// KeyName: matching.overrideTaskListRps
@@ -2776,6 +2848,12 @@ const (
 	// Default value: 30s
 	// Allowed filters: N/A
 	FrontendWarmupDuration
+	// FrontendOpenWorkflowCountCacheTTL is how long open workflow counts used to enforce open workflow limits are cached
+	// KeyName: frontend.openWorkflowCountCacheTTL
+	// Value type: Duration
+	// Default value: 10s
+	// Allowed filters: N/A
+	FrontendOpenWorkflowCountCacheTTL
 	// FrontendFailoverCoolDown is duration between two domain failvoers
 	// KeyName: frontend.failoverCoolDown
 	// Value type: Duration
@@ -2864,6 +2942,19 @@ const (
 	// Default value: 10s
 	// Allowed filters: DomainName,TasklistName,TaskType
 	MatchingQPSTrackerInterval
+	// MatchingPollerHintScaleUpBacklogAge is the age of the backlog above which more pollers are recommended to workers
+	// KeyName: matching.pollerHintScaleUpBacklogAge
+	// Value type: Duration
+	// Default value: 1s
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingPollerHintScaleUpBacklogAge
+	// MatchingPollerHintScaleDownPollWaitTime is the average poll wait time above which fewer pollers are recommended
+	// to workers when the task list has no backlog
+	// KeyName: matching.pollerHintScaleDownPollWaitTime
+	// Value type: Duration
+	// Default value: 10s
+	// Allowed filters: DomainName,TasklistName,TaskType
+	MatchingPollerHintScaleDownPollWaitTime
 
 	// MatchingIsolationGroupUpscaleSustainedDuration is the sustained period to wait before upscaling the number of partitions an isolation group is assigned to
 	// KeyName: matching.isolationGroupUpscaleSustainedDuration
@@ -2946,6 +3037,12 @@ const (
 	// Default value: 15m (15*time.Minute)
 	// Allowed filters: N/A
 	StandbyTaskMissingEventsResendDelay
+	// CompletionCallbackRequestTimeout is the timeout of a single workflow completion callback delivery attempt
+	// KeyName: history.completionCallbackRequestTimeout
+	// Value type: Duration
+	// Default value: 10s (10*time.Second)
+	// Allowed filters: N/A
+	CompletionCallbackRequestTimeout
 	// StandbyTaskMissingEventsDiscardDelay is the amount of time standby cluster's will wait (if events are missing)before discarding the task
 	// KeyName: history.standbyTaskMissingEventsDiscardDelay
 	// Value type: Duration
@@ -3407,6 +3504,13 @@ const (
 	// Default value: the default attributes of this release version, see definition.GetDefaultIndexedKeys()
 	// Allowed filters: N/A
 	ValidSearchAttributes
+	// FrontendOpenWorkflowLimitPerWorkflowType is max number of concurrently open workflows per domain, keyed by workflow type name.
+	// It is only enforced on StartWorkflowExecution, workflows started by history (child, cron, continue-as-new, reset) are not limited
+	// KeyName: frontend.openWorkflowLimitPerWorkflowType
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: DomainName
+	FrontendOpenWorkflowLimitPerWorkflowType
 
 	// key for history
 
@@ -3449,6 +3553,30 @@ const (
 	// Allowed filters: N/A
 	SearchAttributesHiddenValueKeys
 
+	// key for worker
+
+	// ConcreteExecutionsScannerCustomInvariants enables registered invariants in the concrete executions scanner.
+	// A registered invariant runs only when its collection is enabled as well.
+	// KeyName: worker.executionsScannerCustomInvariants
+	// Value type: Map of invariant name to bool
+	// Default value: empty map
+	// Allowed filters: N/A
+	ConcreteExecutionsScannerCustomInvariants
+	// ConcreteExecutionsFixerCustomInvariants enables registered invariants in the concrete executions fixer.
+	// A registered invariant runs only when its collection is enabled as well.
+	// KeyName: worker.executionsFixerCustomInvariants
+	// Value type: Map of invariant name to bool
+	// Default value: empty map
+	// Allowed filters: N/A
+	ConcreteExecutionsFixerCustomInvariants
+	// CurrentExecutionsScannerCustomInvariants enables registered invariants in the current executions scanner.
+	// A registered invariant runs only when its collection is enabled as well.
+	// KeyName: worker.currentExecutionsScannerCustomInvariants
+	// Value type: Map of invariant name to bool
+	// Default value: empty map
+	// Allowed filters: N/A
+	CurrentExecutionsScannerCustomInvariants
+
 	// LastMapKey must be the last one in this const group
 	LastMapKey
 )
@@ -3471,6 +3599,14 @@ const (
 	// Allowed filters: N/A
 	RateLimiterBypassCallerTypes
 
+	// CompletionCallbackAllowedHosts is the list of hosts workflow completion callbacks can be sent to.
+	// An entry starting with "*." matches every subdomain. No host is allowed by default.
+	// KeyName: system.completionCallbackAllowedHosts
+	// Value type: []string
+	// Default value: empty list
+	// Allowed filters: N/A
+	CompletionCallbackAllowedHosts
+
 	// HeaderForwardingRules defines which headers are forwarded from inbound calls to outbound.
 	// This value is only loaded at startup.
 	//
@@ -3481,6 +3617,15 @@ const (
 	// Default value: forward all headers.  (this is a problematic value, and it will be changing as we reduce to a list of known values)
 	HeaderForwardingRules
 
+	// PersistenceFaultInjectionRules are the rules for injecting errors and latency into matching persistence calls.
+	// Rules are read every 10 seconds, so they can be added and removed without a restart. They only apply when
+	// persistence.enableFaultInjection is set in the static config.
+	// KeyName: system.persistenceFaultInjectionRules
+	// Value type: []errorinjectors.Rule or an []interface{} containing map[string]interface{} values, see errorinjectors.Rule for the fields
+	// Default value: empty list
+	// Allowed filters: N/A
+	PersistenceFaultInjectionRules
+
 	LastListKey
 )
 
@@ -3578,6 +3723,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "HistoryCountLimitWarn is the per workflow execution history event count limit for warning",
 		DefaultValue: 50 * 1024,
 	},
+	HistorySizeSuggestContinueAsNew: {
+		KeyName:      "limit.historySize.suggestContinueAsNew",
+		Filters:      []Filter{DomainName},
+		Description:  "HistorySizeSuggestContinueAsNew is the per workflow execution history size above which decision tasks suggest continue-as-new, 0 disables the suggestion",
+		DefaultValue: 0,
+	},
+	HistoryCountSuggestContinueAsNew: {
+		KeyName:      "limit.historyCount.suggestContinueAsNew",
+		Filters:      []Filter{DomainName},
+		Description:  "HistoryCountSuggestContinueAsNew is the per workflow execution history event count above which decision tasks suggest continue-as-new, 0 disables the suggestion",
+		DefaultValue: 0,
+	},
 	PendingActivitiesCountLimitError: {
 		KeyName:      "limit.pendingActivityCount.error",
 		Filters:      []Filter{DomainName},
@@ -3810,6 +3967,17 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "FrontendDecisionResultCountLimit is max number of decisions per RespondDecisionTaskCompleted request",
 		DefaultValue: 0,
 	},
+	FrontendOpenWorkflowLimit: {
+		KeyName:      "frontend.openWorkflowLimit",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendOpenWorkflowLimit is max number of concurrently open workflows per domain, 0 means unlimited. It is only enforced on StartWorkflowExecution, workflows started by history (child, cron, continue-as-new, reset) are not limited",
+		DefaultValue: 0,
+	},
+	FrontendOpenWorkflowLimitRunningCheckRPS: {
+		KeyName:      "frontend.openWorkflowLimitRunningCheckRPS",
+		Description:  "FrontendOpenWorkflowLimitRunningCheckRPS is the rate at which starts rejected by open workflow limits are checked against history for an already running workflow ID, which history would return instead of opening another workflow",
+		DefaultValue: 100,
+	},
 	FrontendThrottledLogRPS: {
 		KeyName:      "frontend.throttledLogRPS",
 		Description:  "FrontendThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger",
@@ -3968,6 +4136,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingPartitionUpscaleRPS is the threshold of adding tasks RPS per partition to trigger upscale",
 		DefaultValue: 200,
 	},
+	MatchingPollerHintMinPollerCount: {
+		KeyName:      "matching.pollerHintMinPollerCount",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPollerHintMinPollerCount is the lowest poller count recommended to workers in the auto config hint",
+		DefaultValue: 2,
+	},
+	MatchingPollerHintMaxPollerCount: {
+		KeyName:      "matching.pollerHintMaxPollerCount",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPollerHintMaxPollerCount is the highest poller count recommended to workers in the auto config hint",
+		DefaultValue: 64,
+	},
 	MatchingIsolationGroupsPerPartition: {
 		KeyName:      "matching.isolationGroupsPerPartition",
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
@@ -4306,6 +4486,17 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "NumParentClosePolicySystemWorkflows is key for number of parentClosePolicy system workflows running in total",
 		DefaultValue: 10,
 	},
+	CompletionCallbackMaxAttempts: {
+		KeyName:      "history.completionCallbackMaxAttempts",
+		Filters:      []Filter{DomainName},
+		Description:  "CompletionCallbackMaxAttempts is the max number of attempts to deliver a workflow completion callback before giving up",
+		DefaultValue: 10,
+	},
+	CompletionCallbackMaxConcurrentDeliveries: {
+		KeyName:      "history.completionCallbackMaxConcurrentDeliveries",
+		Description:  "CompletionCallbackMaxConcurrentDeliveries is the max number of workflows per shard whose completion callbacks are delivered concurrently",
+		DefaultValue: 10,
+	},
 	HistoryThrottledLogRPS: {
 		KeyName:      "history.throttledLogRPS",
 		Description:  "HistoryThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger",
@@ -5034,6 +5225,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableFailoverManager indicates if failover manager is enabled",
 		DefaultValue: true,
 	},
+	EnableVisibilityRebuild: {
+		KeyName:      "worker.enableVisibilityRebuild",
+		Description:  "EnableVisibilityRebuild indicates if the worker runs the visibility rebuild and consistency check workflows, which need the worker to read and write visibility records",
+		DefaultValue: false,
+	},
 	ConcreteExecutionFixerDomainAllow: {
 		KeyName:      "worker.concreteExecutionFixerDomainAllow",
 		Filters:      []Filter{DomainName},
@@ -5234,6 +5430,14 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		DefaultValue: false,
 		Filters:      []Filter{DomainName},
 	},
+	EnableCompletionCallbacks: {
+		KeyName: "system.enableCompletionCallbacks",
+		Description: "EnableCompletionCallbacks is to accept and deliver workflow completion callbacks for a domain. " +
+			"Callbacks are only persisted by Cassandra and are not replicated, they are not delivered after a failover. " +
+			"They can only be registered through the REST gateway, the thrift and gRPC APIs have no field for them yet",
+		DefaultValue: false,
+		Filters:      []Filter{DomainName},
+	},
 	EnforceDecisionTaskAttempts: {
 		KeyName:      "history.enforceDecisionTaskAttempts",
 		Filters:      []Filter{DomainName},
@@ -5418,6 +5622,12 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		Filters:      []Filter{DomainName, TaskListName, TaskType},
 		DefaultValue: 0.75,
 	},
+	MatchingPollerHintMinSyncMatchRate: {
+		KeyName:      "matching.pollerHintMinSyncMatchRate",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPollerHintMinSyncMatchRate is the share of tasks which must be sync matched, below it more pollers are recommended to workers while the task list has a backlog",
+		DefaultValue: 0.9,
+	},
 	MatchingOverrideTaskListRPS: {
 		KeyName:      "matching.overrideTaskListRps",
 		Description:  "MatchingOverrideTaskListRPS is the RPS override for a specific TaskList. When set to a non-zero value, this overrides the RPS value that pollers specify. By default (0), the pollers' specified RPS is respected.",
@@ -5580,6 +5790,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "FrontendWarmupDuration is the duration before a frontend host reports its status as healthy",
 		DefaultValue: 30 * time.Second,
 	},
+	FrontendOpenWorkflowCountCacheTTL: {
+		KeyName:      "frontend.openWorkflowCountCacheTTL",
+		Description:  "FrontendOpenWorkflowCountCacheTTL is how long open workflow counts used to enforce open workflow limits are cached",
+		DefaultValue: 10 * time.Second,
+	},
 	FrontendFailoverCoolDown: {
 		KeyName:      "frontend.failoverCoolDown",
 		Filters:      []Filter{DomainName},
@@ -5685,6 +5900,18 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "MatchingQPSTrackerInterval is the interval for qps tracker's loop. Changes are not reflected until service restart",
 		DefaultValue: time.Second * 10,
 	},
+	MatchingPollerHintScaleUpBacklogAge: {
+		KeyName:      "matching.pollerHintScaleUpBacklogAge",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPollerHintScaleUpBacklogAge is the age of the backlog above which more pollers are recommended to workers",
+		DefaultValue: time.Second,
+	},
+	MatchingPollerHintScaleDownPollWaitTime: {
+		KeyName:      "matching.pollerHintScaleDownPollWaitTime",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingPollerHintScaleDownPollWaitTime is the average poll wait time above which fewer pollers are recommended to workers when the task list has no backlog",
+		DefaultValue: time.Second * 10,
+	},
 	HistoryLongPollExpirationInterval: {
 		KeyName:      "history.longPollExpirationInterval",
 		Filters:      []Filter{DomainName},
@@ -5726,6 +5953,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing)before calling remote for missing events",
 		DefaultValue: time.Minute * 15,
 	},
+	CompletionCallbackRequestTimeout: {
+		KeyName:      "history.completionCallbackRequestTimeout",
+		Description:  "CompletionCallbackRequestTimeout is the timeout of a single workflow completion callback delivery attempt",
+		DefaultValue: time.Second * 10,
+	},
 	StandbyTaskMissingEventsDiscardDelay: {
 		KeyName:      "history.standbyTaskMissingEventsDiscardDelay",
 		Description:  "StandbyTaskMissingEventsDiscardDelay is the amount of time standby cluster's will wait (if events are missing)before discarding the task",
@@ -6113,6 +6345,12 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "ValidSearchAttributes is legal indexed keys that can be used in list APIs. When overriding, ensure to include the existing default attributes of the current release",
 		DefaultValue: definition.GetDefaultIndexedKeys(),
 	},
+	FrontendOpenWorkflowLimitPerWorkflowType: {
+		KeyName:      "frontend.openWorkflowLimitPerWorkflowType",
+		Description:  "FrontendOpenWorkflowLimitPerWorkflowType is max number of concurrently open workflows per domain, keyed by workflow type name. It is only enforced on StartWorkflowExecution, workflows started by history (child, cron, continue-as-new, reset) are not limited",
+		Filters:      []Filter{DomainName},
+		DefaultValue: map[string]interface{}{},
+	},
 	TaskSchedulerRoundRobinWeights: {
 		KeyName:      "history.taskSchedulerRoundRobinWeight",
 		Description:  "TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler",
@@ -6144,6 +6382,21 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "SearchAttributesHiddenValueKeys is the list of search attributes that values should be hidden",
 		DefaultValue: map[string]interface{}{},
 	},
+	ConcreteExecutionsScannerCustomInvariants: {
+		KeyName:      "worker.executionsScannerCustomInvariants",
+		Description:  "ConcreteExecutionsScannerCustomInvariants enables registered invariants in the concrete executions scanner, keyed by invariant name",
+		DefaultValue: map[string]interface{}{},
+	},
+	ConcreteExecutionsFixerCustomInvariants: {
+		KeyName:      "worker.executionsFixerCustomInvariants",
+		Description:  "ConcreteExecutionsFixerCustomInvariants enables registered invariants in the concrete executions fixer, keyed by invariant name",
+		DefaultValue: map[string]interface{}{},
+	},
+	CurrentExecutionsScannerCustomInvariants: {
+		KeyName:      "worker.currentExecutionsScannerCustomInvariants",
+		Description:  "CurrentExecutionsScannerCustomInvariants enables registered invariants in the current executions scanner, keyed by invariant name",
+		DefaultValue: map[string]interface{}{},
+	},
 }
 
 var ListKeys = map[ListKey]DynamicList{
@@ -6156,11 +6409,23 @@ var ListKeys = map[ListKey]DynamicList{
 		Description:  "List of caller types that bypass rate limiters (both frontend and persistence)",
 		DefaultValue: []interface{}{},
 	},
+	CompletionCallbackAllowedHosts: {
+		KeyName:      "system.completionCallbackAllowedHosts",
+		Description:  "List of hosts workflow completion callbacks can be sent to, an entry starting with *. matches every subdomain",
+		DefaultValue: []interface{}{},
+	},
 	DefaultIsolationGroupConfigStoreManagerGlobalMapping: {
 		KeyName: "system.defaultIsolationGroupConfigStoreManagerGlobalMapping",
 		Description: "A configuration store for global isolation groups - used in isolation-group config only, not normal dynamic config." +
 			"Not intended for use in normal dynamic config",
 	},
+	PersistenceFaultInjectionRules: {
+		KeyName: "system.persistenceFaultInjectionRules",
+		Description: "Rules for injecting errors and latency into persistence calls matching an operation, domain, shard range or task list. " +
+			"Rules are read every 10 seconds, so they can be added and removed without a restart. " +
+			"They only apply when persistence.enableFaultInjection is set in the static config.",
+		DefaultValue: []interface{}{},
+	},
 	HeaderForwardingRules: {
 		KeyName: "admin.HeaderForwardingRules", // make a new scope for global?
 		Description: "Only loaded at startup.  " +
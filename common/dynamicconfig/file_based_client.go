@@ -36,7 +36,10 @@ import (
 	"github.com/uber/cadence/common/types"
 )
 
-var _ Client = (*fileBasedClient)(nil)
+var (
+	_ Client    = (*fileBasedClient)(nil)
+	_ Explainer = (*fileBasedClient)(nil)
+)
 
 const (
 	minPollInterval = time.Second * 5
@@ -239,6 +242,19 @@ func (fc *fileBasedClient) ListValue(name dynamicproperties.Key) ([]*types.Dynam
 	return nil, errors.New("not supported for file based client")
 }
 
+func (fc *fileBasedClient) ExplainValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) (*Resolution, error) {
+	values := fc.values.Load().(map[string][]*constrainedValue)
+	var candidates []Candidate
+	for _, cv := range values[name.String()] {
+		candidates = append(candidates, Candidate{
+			Value:       cv.Value,
+			Constraints: cv.Constraints,
+			Matched:     match(cv, filters),
+		})
+	}
+	return NewResolution(FileBasedClient, name, candidates), nil
+}
+
 func (fc *fileBasedClient) update() error {
 	defer func() {
 		fc.lastUpdatedTime = time.Now()
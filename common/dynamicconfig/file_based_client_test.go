@@ -108,6 +108,31 @@ func (s *fileBasedClientSuite) TestGetValueWithFilters_UnknownFilter() {
 	s.Equal(false, v)
 }
 
+func (s *fileBasedClientSuite) TestExplainValue() {
+	filters := map[dynamicproperties.Filter]interface{}{
+		dynamicproperties.DomainName: "samples-domain",
+	}
+	res, err := s.client.(Explainer).ExplainValue(dynamicproperties.TestGetBoolPropertyKey, filters)
+	s.NoError(err)
+	s.Equal(&Resolution{
+		Source:      FileBasedClient,
+		Value:       true,
+		Constraints: map[string]interface{}{"domainName": "samples-domain"},
+		Candidates: []Candidate{
+			{Value: false, Constraints: map[string]interface{}{}, Matched: true},
+			{Value: true, Constraints: map[string]interface{}{"domainName": "global-samples-domain"}},
+		},
+	}, res)
+}
+
+func (s *fileBasedClientSuite) TestExplainValue_NonExistKey() {
+	res, err := s.client.(Explainer).ExplainValue(dynamicproperties.EnableVisibilitySampling, nil)
+	s.NoError(err)
+	s.True(res.Default)
+	s.Equal(dynamicproperties.EnableVisibilitySampling.DefaultBool(), res.Value)
+	s.Empty(res.Candidates)
+}
+
 func (s *fileBasedClientSuite) TestGetIntValue() {
 	v, err := s.client.GetIntValue(dynamicproperties.TestGetIntPropertyKey, nil)
 	s.NoError(err)
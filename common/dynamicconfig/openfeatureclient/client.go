@@ -48,7 +48,10 @@ import (
 	"github.com/uber/cadence/common/types"
 )
 
-var _ dynamicconfig.Client = (*openFeatureClient)(nil)
+var (
+	_ dynamicconfig.Client    = (*openFeatureClient)(nil)
+	_ dynamicconfig.Explainer = (*openFeatureClient)(nil)
+)
 
 type openFeatureClient struct {
 	client *openfeature.Client
@@ -215,6 +218,28 @@ func (c *openFeatureClient) GetValueWithFilters(name dynamicproperties.Key, filt
 	return details.Value, nil
 }
 
+// ExplainValue reports the variant and reason the provider resolved. Targeting rules live in the
+// provider, so there are no candidates or constraints to report.
+func (c *openFeatureClient) ExplainValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) (*dynamicconfig.Resolution, error) {
+	details, err := c.client.ObjectValueDetails(context.Background(), name.String(), name.DefaultValue(), toEvalContext(filters))
+	if err != nil {
+		if translateErr(err, details.ErrorCode) == dynamicconfig.NotFoundError {
+			return &dynamicconfig.Resolution{
+				Source:  dynamicconfig.OpenFeatureClient,
+				Value:   name.DefaultValue(),
+				Default: true,
+			}, nil
+		}
+		return nil, err
+	}
+	return &dynamicconfig.Resolution{
+		Source:  dynamicconfig.OpenFeatureClient,
+		Value:   details.Value,
+		Default: details.Reason == openfeature.DefaultReason,
+		Detail:  fmt.Sprintf("variant: %v, reason: %v", details.Variant, details.Reason),
+	}, nil
+}
+
 func (c *openFeatureClient) GetIntValue(name dynamicproperties.IntKey, filters map[dynamicproperties.Filter]interface{}) (int, error) {
 	defaultValue := name.DefaultInt()
 	details, err := c.client.IntValueDetails(context.Background(), name.String(), int64(defaultValue), toEvalContext(filters))
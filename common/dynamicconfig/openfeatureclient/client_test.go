@@ -245,6 +245,30 @@ func TestGetValue(t *testing.T) {
 	assert.EqualValues(t, 42, val)
 }
 
+func TestExplainValue(t *testing.T) {
+	key := dynamicproperties.TestGetIntPropertyKey
+	client := newTestClient(t, map[string]memprovider.InMemoryFlag{
+		key.String(): staticVariant(key.String(), "on", int64(42)),
+	})
+
+	res, err := client.(dynamicconfig.Explainer).ExplainValue(key, map[dynamicproperties.Filter]interface{}{dynamicproperties.DomainName: "some-domain"})
+	require.NoError(t, err)
+	assert.Equal(t, dynamicconfig.OpenFeatureClient, res.Source)
+	assert.EqualValues(t, 42, res.Value)
+	assert.False(t, res.Default)
+	assert.Contains(t, res.Detail, "variant: on")
+}
+
+func TestExplainValue_NotFound_ReturnsDefault(t *testing.T) {
+	key := dynamicproperties.TestGetIntPropertyKey
+	client := newTestClient(t, nil)
+
+	res, err := client.(dynamicconfig.Explainer).ExplainValue(key, nil)
+	require.NoError(t, err)
+	assert.True(t, res.Default)
+	assert.Equal(t, key.DefaultValue(), res.Value)
+}
+
 func TestGetValueWithFilters_NotFound_ReturnsDefault(t *testing.T) {
 	key := dynamicproperties.TestGetIntPropertyKey
 	client := newTestClient(t, nil)
@@ -0,0 +1,88 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+)
+
+type (
+	// Explainer is implemented by clients which can tell how a value was resolved for a set of filters
+	Explainer interface {
+		ExplainValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) (*Resolution, error)
+	}
+
+	// Resolution describes how a client resolved the value of a key
+	Resolution struct {
+		// Source is the client which supplied the value, e.g. FileBasedClient
+		Source string
+		Value  interface{}
+		// Constraints of the winning value, empty when an unconstrained value or the key default won
+		Constraints map[string]interface{}
+		// Default is set when nothing is configured for the key and its default value is returned
+		Default bool
+		// Candidates are the other configured values of the key, in evaluation order
+		Candidates []Candidate
+		// Detail is a client specific description of the evaluation
+		Detail string
+	}
+
+	// Candidate is a configured value of a key
+	Candidate struct {
+		Value       interface{}
+		Constraints map[string]interface{}
+		// Matched is set when the constraints match the filters, whether or not the value won
+		Matched bool
+	}
+)
+
+// NewResolution picks the winning candidate the way the clients evaluate values: the first constrained
+// value which matches the filters wins, otherwise the last unconstrained value, otherwise the key default.
+func NewResolution(source string, name dynamicproperties.Key, candidates []Candidate) *Resolution {
+	winner := -1
+	for i, c := range candidates {
+		if len(c.Constraints) == 0 {
+			if winner == -1 || len(candidates[winner].Constraints) == 0 {
+				winner = i
+			}
+			continue
+		}
+		if c.Matched && (winner == -1 || len(candidates[winner].Constraints) == 0) {
+			winner = i
+		}
+	}
+
+	res := &Resolution{Source: source}
+	if winner == -1 {
+		res.Value = name.DefaultValue()
+		res.Default = true
+		res.Candidates = candidates
+		return res
+	}
+	res.Value = candidates[winner].Value
+	res.Constraints = candidates[winner].Constraints
+	for i, c := range candidates {
+		if i != winner {
+			res.Candidates = append(res.Candidates, c)
+		}
+	}
+	return res
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+)
+
+func TestNewResolution(t *testing.T) {
+	unconstrained := Candidate{Value: 1, Matched: true}
+	lateUnconstrained := Candidate{Value: 2, Matched: true}
+	matched := Candidate{Value: 3, Constraints: map[string]interface{}{"domainName": "d"}, Matched: true}
+	lateMatched := Candidate{Value: 4, Constraints: map[string]interface{}{"taskListName": "tl"}, Matched: true}
+	unmatched := Candidate{Value: 5, Constraints: map[string]interface{}{"domainName": "other"}}
+
+	tests := map[string]struct {
+		candidates []Candidate
+		expected   *Resolution
+	}{
+		"nothing configured": {
+			expected: &Resolution{
+				Source:  FileBasedClient,
+				Value:   dynamicproperties.TestGetIntPropertyKey.DefaultValue(),
+				Default: true,
+			},
+		},
+		"nothing matched": {
+			candidates: []Candidate{unmatched},
+			expected: &Resolution{
+				Source:     FileBasedClient,
+				Value:      dynamicproperties.TestGetIntPropertyKey.DefaultValue(),
+				Default:    true,
+				Candidates: []Candidate{unmatched},
+			},
+		},
+		"last unconstrained value wins": {
+			candidates: []Candidate{unconstrained, unmatched, lateUnconstrained},
+			expected: &Resolution{
+				Source:     FileBasedClient,
+				Value:      2,
+				Candidates: []Candidate{unconstrained, unmatched},
+			},
+		},
+		"first matching constrained value wins": {
+			candidates: []Candidate{unconstrained, unmatched, matched, lateMatched},
+			expected: &Resolution{
+				Source:      FileBasedClient,
+				Value:       3,
+				Constraints: matched.Constraints,
+				Candidates:  []Candidate{unconstrained, unmatched, lateMatched},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NewResolution(FileBasedClient, dynamicproperties.TestGetIntPropertyKey, tc.candidates))
+		})
+	}
+}
@@ -59,4 +59,22 @@ const (
 
 	// CallerTypeHeaderName refers to the name of the header that contains the caller type (CLI, UI, SDK, internal, etc.)
 	CallerTypeHeaderName = types.CallerTypeHeaderName
+
+	// DynamicConfigChangeReasonHeaderName refers to the name of the header that contains the reason recorded with a dynamic config change
+	DynamicConfigChangeReasonHeaderName = "cadence-dynamic-config-change-reason"
+	// WorkflowIDHashHeaderName refers to the name of the header that contains the json encoded workflow ID hash policy of a start request
+	WorkflowIDHashHeaderName = "cadence-workflow-id-hash"
+
+	// RecommendedPollerCountHeaderName refers to the name of the response header that contains the recommended poller count of the polled task list
+	RecommendedPollerCountHeaderName = "cadence-recommended-poller-count"
+	// HistoryCountHeaderName refers to the name of the response header that contains the number of history events of the polled decision task
+	HistoryCountHeaderName = "cadence-history-count"
+	// ContinueAsNewSuggestedHeaderName refers to the name of the response header that is set when the polled decision task suggests continue-as-new
+	ContinueAsNewSuggestedHeaderName = "cadence-continue-as-new-suggested"
+	// OpenWorkflowUsageHeaderName refers to the name of the response header that contains the json encoded open workflow usage of the described domain
+	OpenWorkflowUsageHeaderName = "cadence-open-workflow-usage"
+	// FailoverEventCommentsHeaderName refers to the name of the response header that contains the json encoded comments of the listed failover events, keyed by event ID
+	FailoverEventCommentsHeaderName = "cadence-failover-event-comments"
+	// ReplicationExcludedHeaderName refers to the name of the response header that is set when the described workflow is excluded from replication
+	ReplicationExcludedHeaderName = "cadence-replication-excluded"
 )
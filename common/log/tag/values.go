@@ -303,8 +303,9 @@ var (
 	StoreOperationGetDLQSize                 = storeOperation("get-dlq-size")
 	StoreOperationDeleteMessageFromDLQ       = storeOperation("delete-message-from-dlq")
 
-	StoreOperationFetchDynamicConfig  = storeOperation("fetch-dynamic-config")
-	StoreOperationUpdateDynamicConfig = storeOperation("update-dynamic-config")
+	StoreOperationFetchDynamicConfig        = storeOperation("fetch-dynamic-config")
+	StoreOperationUpdateDynamicConfig       = storeOperation("update-dynamic-config")
+	StoreOperationListDynamicConfigVersions = storeOperation("list-dynamic-config-versions")
 )
 
 // Pre-defined values for TagSysClientOperation
@@ -320,6 +321,9 @@ var (
 	AdminClientOperationGetWorkflowExecutionRawHistoryV2      = clientOperation("admin-get-wf-execution-raw-history-v2")
 	AdminClientOperationDescribeCluster                       = clientOperation("admin-describe-cluster")
 	AdminClientOperationGetReplicationMessages                = clientOperation("admin-get-replication-messsages")
+	AdminClientOperationDescribeReplicationStatus             = clientOperation("admin-describe-replication-status")
+	AdminClientOperationMoveTaskListBacklog                   = clientOperation("admin-move-task-list-backlog")
+	AdminClientOperationDeleteTaskListBacklogTasks            = clientOperation("admin-delete-task-list-backlog-tasks")
 	AdminClientOperationGetDomainReplicationMessages          = clientOperation("admin-get-domain-replication-messsages")
 	AdminClientOperationGetDLQReplicationMessages             = clientOperation("admin-get-dlq-replication-messsages")
 	AdminClientOperationReapplyEvents                         = clientOperation("admin-reapply-events")
@@ -340,6 +344,10 @@ var (
 	AdminClientOperationUpdateOperationalDynamicConfig        = clientOperation("admin-update-operational-dynamic-config")
 	AdminClientOperationRestoreOperationalDynamicConfig       = clientOperation("admin-restore-operational-dynamic-config")
 	AdminClientOperationListOperationalDynamicConfig          = clientOperation("admin-list-operational-dynamic-config")
+	AdminClientOperationListDynamicConfigVersions             = clientOperation("admin-list-dynamic-config-versions")
+	AdminClientOperationDiffDynamicConfigVersions             = clientOperation("admin-diff-dynamic-config-versions")
+	AdminClientOperationRollbackDynamicConfig                 = clientOperation("admin-rollback-dynamic-config")
+	AdminClientOperationResolveDynamicConfig                  = clientOperation("admin-resolve-dynamic-config")
 	AdminClientOperationMaintainCorruptWorkflow               = clientOperation("admin-maintain-corrupt-workflow")
 	AdminClientOperationUpdateGlobalIsolationGroups           = clientOperation("admin-update-global-isolation-groups")
 	AdminClientOperationGetGlobalIsolationGroups              = clientOperation("admin-get-global-isolation-groups")
@@ -406,6 +414,10 @@ var (
 	FrontendClientOperationUnpauseSchedule                       = clientOperation("frontend-unpause-schedule")
 	FrontendClientOperationBackfillSchedule                      = clientOperation("frontend-backfill-schedule")
 	FrontendClientOperationListSchedules                         = clientOperation("frontend-list-schedules")
+	FrontendClientOperationStartBatchOperation                   = clientOperation("frontend-start-batch-operation")
+	FrontendClientOperationDescribeBatchOperation                = clientOperation("frontend-describe-batch-operation")
+	FrontendClientOperationListBatchOperations                   = clientOperation("frontend-list-batch-operations")
+	FrontendClientOperationStopBatchOperation                    = clientOperation("frontend-stop-batch-operation")
 
 	HistoryClientOperationStartWorkflowExecution            = clientOperation("history-start-wf-execution")
 	HistoryClientOperationDescribeHistoryHost               = clientOperation("history-describe-history-host")
@@ -438,6 +450,7 @@ var (
 	HistoryClientOperationSyncShardStatus                   = clientOperation("history-sync-shard-status")
 	HistoryClientOperationSyncActivity                      = clientOperation("history-sync-activity")
 	HistoryClientOperationGetReplicationMessages            = clientOperation("history-get-replication-messages")
+	HistoryClientOperationGetReplicationStatus              = clientOperation("history-get-replication-status")
 	HistoryClientOperationGetDLQReplicationMessages         = clientOperation("history-get-dlq-replication-messages")
 	HistoryClientOperationQueryWorkflow                     = clientOperation("history-query-wf")
 	HistoryClientOperationReapplyEvents                     = clientOperation("history-reapply-events")
@@ -470,6 +483,8 @@ var (
 	MatchingClientOperationRespondQueryTaskCompleted      = clientOperation("matching-respond-query-task-completed")
 	MatchingClientOperationUpdateTaskListPartitionConfig  = clientOperation("matching-update-task-list-partition-config")
 	MatchingClientOperationRefreshTaskListPartitionConfig = clientOperation("matching-refresh-task-list-partition-config")
+	MatchingClientOperationMoveTaskListBacklog            = clientOperation("matching-move-task-list-backlog")
+	MatchingClientOperationDeleteTaskListBacklogTasks     = clientOperation("matching-delete-task-list-backlog-tasks")
 )
 
 // Pre-defined values for TagIDType
@@ -327,6 +327,8 @@ const (
 	PersistenceFetchDynamicConfigScope
 	// PersistenceUpdateDynamicConfigScope tracks UpdateDynamicConfig calls made by service to persistence layer
 	PersistenceUpdateDynamicConfigScope
+	// PersistenceListDynamicConfigVersionsScope tracks ListDynamicConfigVersions calls made by service to persistence layer
+	PersistenceListDynamicConfigVersionsScope
 	// PersistenceShardRequestCountScope tracks number of persistence calls made to each shard
 	PersistenceShardRequestCountScope
 	// PersistencePerHostScope is a constant scope for per-host persistence latency metrics
@@ -436,6 +438,8 @@ const (
 	HistoryClientGetDLQReplicationMessagesScope
 	// HistoryClientGetReplicationMessagesScope tracks RPC calls to history service
 	HistoryClientGetReplicationMessagesScope
+	// HistoryClientGetReplicationStatusScope tracks RPC calls to history service
+	HistoryClientGetReplicationStatusScope
 	// HistoryClientWfIDCacheScope tracks workflow ID cache metrics
 	HistoryClientWfIDCacheScope
 	// HistoryClientRatelimitUpdateScope tracks global ratelimiter related calls to history service
@@ -465,6 +469,10 @@ const (
 	MatchingClientUpdateTaskListPartitionConfigScope
 	// MatchingClientRefreshTaskListPartitionConfigScope tracks RPC calls to matching service
 	MatchingClientRefreshTaskListPartitionConfigScope
+	// MatchingClientMoveTaskListBacklogScope tracks RPC calls to matching service
+	MatchingClientMoveTaskListBacklogScope
+	// MatchingClientDeleteTaskListBacklogTasksScope tracks RPC calls to matching service
+	MatchingClientDeleteTaskListBacklogTasksScope
 
 	// FrontendClientDeleteDomainScope tracks RPC calls to frontend service
 	FrontendClientDeleteDomainScope
@@ -566,6 +574,14 @@ const (
 	FrontendClientBackfillScheduleScope
 	// FrontendClientListSchedulesScope tracks RPC calls to frontend service
 	FrontendClientListSchedulesScope
+	// FrontendClientStartBatchOperationScope tracks RPC calls to frontend service
+	FrontendClientStartBatchOperationScope
+	// FrontendClientDescribeBatchOperationScope tracks RPC calls to frontend service
+	FrontendClientDescribeBatchOperationScope
+	// FrontendClientListBatchOperationsScope tracks RPC calls to frontend service
+	FrontendClientListBatchOperationsScope
+	// FrontendClientStopBatchOperationScope tracks RPC calls to frontend service
+	FrontendClientStopBatchOperationScope
 	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
 	FrontendClientListWorkflowExecutionsScope
 	// FrontendClientScanWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -641,6 +657,14 @@ const (
 	AdminClientRestoreOperationalDynamicConfigScope
 	// AdminClientListOperationalDynamicConfigScope tracks RPC calls to admin service
 	AdminClientListOperationalDynamicConfigScope
+	// AdminClientListDynamicConfigVersionsScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigVersionsScope
+	// AdminClientDiffDynamicConfigVersionsScope tracks RPC calls to admin service
+	AdminClientDiffDynamicConfigVersionsScope
+	// AdminClientRollbackDynamicConfigScope tracks RPC calls to admin service
+	AdminClientRollbackDynamicConfigScope
+	// AdminClientResolveDynamicConfigScope tracks RPC calls to admin service
+	AdminClientResolveDynamicConfigScope
 	// AdminClientGetGlobalIsolationGroupsScope is a request to get all the global isolation-groups
 	AdminClientGetGlobalIsolationGroupsScope
 	// AdminClientUpdateGlobalIsolationGroupsScope is a request to update the global isolation-groups
@@ -663,6 +687,12 @@ const (
 	AdminClientGetDomainReplicationMessagesScope
 	// AdminClientGetReplicationMessagesScope is the metric scope for admin.GetReplicationMessages
 	AdminClientGetReplicationMessagesScope
+	// AdminClientDescribeReplicationStatusScope is the metric scope for admin.DescribeReplicationStatus
+	AdminClientDescribeReplicationStatusScope
+	// AdminClientMoveTaskListBacklogScope is the metric scope for admin.MoveTaskListBacklog
+	AdminClientMoveTaskListBacklogScope
+	// AdminClientDeleteTaskListBacklogTasksScope is the metric scope for admin.DeleteTaskListBacklogTasks
+	AdminClientDeleteTaskListBacklogTasksScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope is the metric scope for admin.GetDomainAsyncWorkflow
 	AdminClientGetDomainAsyncWorkflowConfiguratonScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope is the metric scope for admin.UpdateDomainAsyncWorkflowConfiguration
@@ -1040,6 +1070,22 @@ const (
 	AdminRestoreOperationalDynamicConfigScope
 	// AdminListOperationalDynamicConfigScope is the metric scope for admin.ListOperationalDynamicConfig
 	AdminListOperationalDynamicConfigScope
+	// AdminListDynamicConfigVersionsScope is the metric scope for admin.ListDynamicConfigVersions
+	AdminListDynamicConfigVersionsScope
+	// AdminDiffDynamicConfigVersionsScope is the metric scope for admin.DiffDynamicConfigVersions
+	AdminDiffDynamicConfigVersionsScope
+	// AdminRollbackDynamicConfigScope is the metric scope for admin.RollbackDynamicConfig
+	AdminRollbackDynamicConfigScope
+	// AdminResolveDynamicConfigScope is the metric scope for admin.ResolveDynamicConfig
+	AdminResolveDynamicConfigScope
+	// AdminDescribeReplicationStatusScope is the metric scope for admin.DescribeReplicationStatus
+	AdminDescribeReplicationStatusScope
+	// AdminListTaskListBacklogScope is the metric scope for admin.ListTaskListBacklog
+	AdminListTaskListBacklogScope
+	// AdminDeleteTaskListBacklogTasksScope is the metric scope for admin.DeleteTaskListBacklogTasks
+	AdminDeleteTaskListBacklogTasksScope
+	// AdminMoveTaskListBacklogScope is the metric scope for admin.MoveTaskListBacklog
+	AdminMoveTaskListBacklogScope
 	// AdminDeleteWorkflowScope is the metric scope for admin.DeleteWorkflow
 	AdminDeleteWorkflowScope
 	// GetGlobalIsolationGroups is the scope for getting global isolation groups
@@ -1056,6 +1102,8 @@ const (
 	UpdateDomainAsyncWorkflowConfiguraton
 	// UpdateTaskListPartitionConfig is the scope for update task list partition config
 	UpdateTaskListPartitionConfig
+	// AdminAuthorizationScope is the metric scope for the authorization of admin requests
+	AdminAuthorizationScope
 
 	NumAdminScopes
 )
@@ -1179,6 +1227,14 @@ const (
 	FrontendBackfillScheduleScope
 	// FrontendListSchedulesScope is the metric scope for frontend.ListSchedules
 	FrontendListSchedulesScope
+	// FrontendStartBatchOperationScope is the metric scope for frontend.StartBatchOperation
+	FrontendStartBatchOperationScope
+	// FrontendDescribeBatchOperationScope is the metric scope for frontend.DescribeBatchOperation
+	FrontendDescribeBatchOperationScope
+	// FrontendListBatchOperationsScope is the metric scope for frontend.ListBatchOperations
+	FrontendListBatchOperationsScope
+	// FrontendStopBatchOperationScope is the metric scope for frontend.StopBatchOperation
+	FrontendStopBatchOperationScope
 
 	NumFrontendScopes
 )
@@ -1245,6 +1301,8 @@ const (
 	HistoryDescribeMutableStateScope
 	// GetReplicationMessages tracks GetReplicationMessages API calls received by service
 	HistoryGetReplicationMessagesScope
+	// HistoryGetReplicationStatusScope tracks GetReplicationStatus API calls received by service
+	HistoryGetReplicationStatusScope
 	// HistoryGetDLQReplicationMessagesScope tracks GetReplicationMessages API calls received by service
 	HistoryGetDLQReplicationMessagesScope
 	// HistoryCountDLQMessagesScope tracks CountDLQMessages API calls received by service
@@ -1305,6 +1363,8 @@ const (
 	TransferActiveTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferActiveTaskApplyParentClosePolicyScope
+	// TransferActiveTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferActiveTaskCompletionCallbackScope
 	// TransferStandbyTaskResetWorkflowScope is the scope used for record workflow started task processing by transfer queue processor
 	TransferStandbyTaskResetWorkflowScope
 	// TransferStandbyTaskActivityScope is the scope used for activity task processing by transfer queue processor
@@ -1329,6 +1389,8 @@ const (
 	TransferStandbyTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferStandbyTaskApplyParentClosePolicyScope
+	// TransferStandbyTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferStandbyTaskCompletionCallbackScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerQueueProcessorV2Scope is the scope used by all metric emitted by timer queue processor
@@ -1349,6 +1411,8 @@ const (
 	TimerActiveTaskActivityRetryTimerScope
 	// TimerActiveTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerActiveTaskWorkflowBackoffTimerScope
+	// TimerActiveTaskCompletionCallbackRetryTimerScope is the scope used by metric emitted by timer queue processor for retrying completion callbacks.
+	TimerActiveTaskCompletionCallbackRetryTimerScope
 	// TimerActiveTaskDeleteHistoryEventScope is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerActiveTaskDeleteHistoryEventScope
 	// TimerStandbyTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
@@ -1365,6 +1429,8 @@ const (
 	TimerStandbyTaskDeleteHistoryEventScope
 	// TimerStandbyTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerStandbyTaskWorkflowBackoffTimerScope
+	// TimerStandbyTaskCompletionCallbackRetryTimerScope is the scope used by metric emitted by timer queue processor for retrying completion callbacks.
+	TimerStandbyTaskCompletionCallbackRetryTimerScope
 	// CrossClusterQueueProcessorScope is the scope used by all metric emitted by cross cluster queue processor in the source cluster
 	CrossClusterQueueProcessorScope
 	// CrossClusterTaskProcessorScope is the scope used by all metric emitted by cross cluster task processor in the target cluster
@@ -1506,6 +1572,10 @@ const (
 	MatchingUpdateTaskListPartitionConfigScope
 	// MatchingRefreshTaskListPartitionConfigScope tracks RefreshTaskListPartitionConfig API calls received by service
 	MatchingRefreshTaskListPartitionConfigScope
+	// MatchingMoveTaskListBacklogScope tracks MoveTaskListBacklog API calls received by service
+	MatchingMoveTaskListBacklogScope
+	// MatchingDeleteTaskListBacklogTasksScope tracks DeleteTaskListBacklogTasks API calls received by service
+	MatchingDeleteTaskListBacklogTasksScope
 
 	NumMatchingScopes
 )
@@ -1659,6 +1729,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		PersistenceGetDLQSizeScope:                               {operation: "GetDLQSize"},
 		PersistenceFetchDynamicConfigScope:                       {operation: "FetchDynamicConfig"},
 		PersistenceUpdateDynamicConfigScope:                      {operation: "UpdateDynamicConfig"},
+		PersistenceListDynamicConfigVersionsScope:                {operation: "ListDynamicConfigVersions"},
 		PersistenceShardRequestCountScope:                        {operation: "ShardIdPersistenceRequest"},
 		PersistencePerHostScope:                                  {operation: "persistence_operations"},
 		PersistenceGetActiveClusterSelectionPolicyScope:          {operation: "GetActiveClusterSelectionPolicy"},
@@ -1713,6 +1784,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistoryClientGetFailoverInfoScope:                   {operation: "HistoryClientGetFailoverInfo", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetDLQReplicationMessagesScope:         {operation: "HistoryClientGetDLQReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetReplicationMessagesScope:            {operation: "HistoryClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetReplicationStatusScope:              {operation: "HistoryClientGetReplicationStatus", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientWfIDCacheScope:                         {operation: "HistoryClientWfIDCache", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRatelimitUpdateScope:                   {operation: "HistoryClientRatelimitUpdate", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 
@@ -1728,6 +1800,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		MatchingClientGetTaskListsByDomainScope:           {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientUpdateTaskListPartitionConfigScope:  {operation: "MatchingClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientRefreshTaskListPartitionConfigScope: {operation: "MatchingClientRefreshTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientMoveTaskListBacklogScope:            {operation: "MatchingClientMoveTaskListBacklog", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientDeleteTaskListBacklogTasksScope:     {operation: "MatchingClientDeleteTaskListBacklogTasks", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 
 		FrontendClientDeleteDomainScope:                          {operation: "FrontendClientDeleteDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                       {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1790,6 +1864,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendClientUnpauseScheduleScope:                       {operation: "FrontendClientUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientBackfillScheduleScope:                      {operation: "FrontendClientBackfillSchedule", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListSchedulesScope:                         {operation: "FrontendClientListSchedules", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientStartBatchOperationScope:                   {operation: "FrontendClientStartBatchOperation", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDescribeBatchOperationScope:                {operation: "FrontendClientDescribeBatchOperation", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientListBatchOperationsScope:                   {operation: "FrontendClientListBatchOperations", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientStopBatchOperationScope:                    {operation: "FrontendClientStopBatchOperation", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 
 		AdminClientGetReplicationTasksScope:                   {operation: "AdminClientGetReplicationTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientAddSearchAttributeScope:                    {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1818,6 +1896,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminClientUpdateOperationalDynamicConfigScope:        {operation: "AdminClientUpdateOperationalDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRestoreOperationalDynamicConfigScope:       {operation: "AdminClientRestoreOperationalDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientListOperationalDynamicConfigScope:          {operation: "AdminClientListOperationalDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientListDynamicConfigVersionsScope:             {operation: "AdminClientListDynamicConfigVersions", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDiffDynamicConfigVersionsScope:             {operation: "AdminClientDiffDynamicConfigVersions", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRollbackDynamicConfigScope:                 {operation: "AdminClientRollbackDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientResolveDynamicConfigScope:                  {operation: "AdminClientResolveDynamicConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetGlobalIsolationGroupsScope:              {operation: "AdminClientGetGlobalIsolationGroups", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateGlobalIsolationGroupsScope:           {operation: "AdminClientUpdateGlobalIsolationGroups", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetDomainIsolationGroupsScope:              {operation: "AdminClientGetDomainIsolationGroups", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1828,6 +1910,9 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminClientGetDLQReplicationMessagesScope:             {operation: "AdminClientGetDLQReplicationMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetDomainReplicationMessagesScope:          {operation: "AdminClientGetDomainReplicationMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetReplicationMessagesScope:                {operation: "AdminClientGetReplicationMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDescribeReplicationStatusScope:             {operation: "AdminClientDescribeReplicationStatus", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientMoveTaskListBacklogScope:                   {operation: "AdminClientMoveTaskListBacklog", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDeleteTaskListBacklogTasksScope:            {operation: "AdminClientDeleteTaskListBacklogTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetDomainAsyncWorkflowConfiguratonScope:    {operation: "AdminClientGetDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateDomainAsyncWorkflowConfiguratonScope: {operation: "AdminClientUpdateDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateTaskListPartitionConfigScope:         {operation: "AdminClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -2014,6 +2099,14 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		AdminUpdateOperationalDynamicConfigScope:    {operation: "AdminUpdateOperationalDynamicConfig"},
 		AdminRestoreOperationalDynamicConfigScope:   {operation: "AdminRestoreOperationalDynamicConfig"},
 		AdminListOperationalDynamicConfigScope:      {operation: "AdminListOperationalDynamicConfig"},
+		AdminListDynamicConfigVersionsScope:         {operation: "AdminListDynamicConfigVersions"},
+		AdminDiffDynamicConfigVersionsScope:         {operation: "AdminDiffDynamicConfigVersions"},
+		AdminRollbackDynamicConfigScope:             {operation: "AdminRollbackDynamicConfig"},
+		AdminResolveDynamicConfigScope:              {operation: "AdminResolveDynamicConfig"},
+		AdminDescribeReplicationStatusScope:         {operation: "AdminDescribeReplicationStatus"},
+		AdminListTaskListBacklogScope:               {operation: "AdminListTaskListBacklog"},
+		AdminDeleteTaskListBacklogTasksScope:        {operation: "AdminDeleteTaskListBacklogTasks"},
+		AdminMoveTaskListBacklogScope:               {operation: "AdminMoveTaskListBacklog"},
 		AdminDeleteWorkflowScope:                    {operation: "AdminDeleteWorkflow"},
 		GetGlobalIsolationGroups:                    {operation: "GetGlobalIsolationGroups"},
 		UpdateGlobalIsolationGroups:                 {operation: "UpdateGlobalIsolationGroups"},
@@ -2022,6 +2115,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		GetDomainAsyncWorkflowConfiguraton:          {operation: "GetDomainAsyncWorkflowConfiguraton"},
 		UpdateDomainAsyncWorkflowConfiguraton:       {operation: "UpdateDomainAsyncWorkflowConfiguraton"},
 		UpdateTaskListPartitionConfig:               {operation: "UpdateTaskListPartitionConfig"},
+		AdminAuthorizationScope:                     {operation: "AdminAuthorization"},
 
 		FrontendRestartWorkflowExecutionScope:              {operation: "RestartWorkflowExecution"},
 		FrontendStartWorkflowExecutionScope:                {operation: "StartWorkflowExecution"},
@@ -2079,6 +2173,10 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		FrontendUnpauseScheduleScope:                       {operation: "UnpauseSchedule"},
 		FrontendBackfillScheduleScope:                      {operation: "BackfillSchedule"},
 		FrontendListSchedulesScope:                         {operation: "ListSchedules"},
+		FrontendStartBatchOperationScope:                   {operation: "StartBatchOperation"},
+		FrontendDescribeBatchOperationScope:                {operation: "DescribeBatchOperation"},
+		FrontendListBatchOperationsScope:                   {operation: "ListBatchOperations"},
+		FrontendStopBatchOperationScope:                    {operation: "StopBatchOperation"},
 		FrontendGetSearchAttributesScope:                   {operation: "GetSearchAttributes"},
 		FrontendGetClusterInfoScope:                        {operation: "GetClusterInfo"},
 	},
@@ -2117,6 +2215,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		HistorySyncActivityScope:                                        {operation: "SyncActivity"},
 		HistoryDescribeMutableStateScope:                                {operation: "DescribeMutableState"},
 		HistoryGetReplicationMessagesScope:                              {operation: "GetReplicationMessages"},
+		HistoryGetReplicationStatusScope:                                {operation: "GetReplicationStatus"},
 		HistoryGetDLQReplicationMessagesScope:                           {operation: "GetDLQReplicationMessages"},
 		HistoryCountDLQMessagesScope:                                    {operation: "CountDLQMessages"},
 		HistoryReadDLQMessagesScope:                                     {operation: "ReadDLQMessages"},
@@ -2147,6 +2246,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TransferActiveTaskRecordWorkflowClosedScope:                     {operation: "TransferActiveTaskRecordWorkflowClosed"},
 		TransferActiveTaskRecordChildExecutionCompletedScope:            {operation: "TransferActiveTaskRecordChildExecutionCompleted"},
 		TransferActiveTaskApplyParentClosePolicyScope:                   {operation: "TransferActiveTaskApplyParentClosePolicy"},
+		TransferActiveTaskCompletionCallbackScope:                       {operation: "TransferActiveTaskCompletionCallback"},
 		TransferStandbyTaskActivityScope:                                {operation: "TransferStandbyTaskActivity"},
 		TransferStandbyTaskDecisionScope:                                {operation: "TransferStandbyTaskDecision"},
 		TransferStandbyTaskCloseExecutionScope:                          {operation: "TransferStandbyTaskCloseExecution"},
@@ -2159,6 +2259,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TransferStandbyTaskRecordWorkflowClosedScope:                    {operation: "TransferStandbyTaskRecordWorkflowClosed"},
 		TransferStandbyTaskRecordChildExecutionCompletedScope:           {operation: "TransferStandbyTaskRecordChildExecutionCompleted"},
 		TransferStandbyTaskApplyParentClosePolicyScope:                  {operation: "TransferStandbyTaskApplyParentClosePolicy"},
+		TransferStandbyTaskCompletionCallbackScope:                      {operation: "TransferStandbyTaskCompletionCallback"},
 		TimerQueueProcessorScope:                                        {operation: "TimerQueueProcessor"},
 		TimerQueueProcessorV2Scope:                                      {operation: "TimerQueueProcessorV2"},
 		TimerActiveQueueProcessorScope:                                  {operation: "TimerActiveQueueProcessor"},
@@ -2169,6 +2270,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TimerActiveTaskWorkflowTimeoutScope:                             {operation: "TimerActiveTaskWorkflowTimeout"},
 		TimerActiveTaskActivityRetryTimerScope:                          {operation: "TimerActiveTaskActivityRetryTimer"},
 		TimerActiveTaskWorkflowBackoffTimerScope:                        {operation: "TimerActiveTaskWorkflowBackoffTimer"},
+		TimerActiveTaskCompletionCallbackRetryTimerScope:                {operation: "TimerActiveTaskCompletionCallbackRetryTimer"},
 		TimerActiveTaskDeleteHistoryEventScope:                          {operation: "TimerActiveTaskDeleteHistoryEvent"},
 		TimerStandbyTaskActivityTimeoutScope:                            {operation: "TimerStandbyTaskActivityTimeout"},
 		TimerStandbyTaskDecisionTimeoutScope:                            {operation: "TimerStandbyTaskDecisionTimeout"},
@@ -2176,6 +2278,7 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		TimerStandbyTaskWorkflowTimeoutScope:                            {operation: "TimerStandbyTaskWorkflowTimeout"},
 		TimerStandbyTaskActivityRetryTimerScope:                         {operation: "TimerStandbyTaskActivityRetryTimer"},
 		TimerStandbyTaskWorkflowBackoffTimerScope:                       {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
+		TimerStandbyTaskCompletionCallbackRetryTimerScope:               {operation: "TimerStandbyTaskCompletionCallbackRetryTimer"},
 		TimerStandbyTaskDeleteHistoryEventScope:                         {operation: "TimerStandbyTaskDeleteHistoryEvent"},
 		CrossClusterQueueProcessorScope:                                 {operation: "CrossClusterQueueProcessor"},
 		CrossClusterTaskProcessorScope:                                  {operation: "CrossClusterTaskProcessor"},
@@ -2245,6 +2348,8 @@ var ScopeDefs = map[ServiceIdx]map[ScopeIdx]scopeDefinition{
 		MatchingGetTaskListsByDomainScope:           {operation: "GetTaskListsByDomain"},
 		MatchingUpdateTaskListPartitionConfigScope:  {operation: "UpdateTaskListPartitionConfig"},
 		MatchingRefreshTaskListPartitionConfigScope: {operation: "RefreshTaskListPartitionConfig"},
+		MatchingMoveTaskListBacklogScope:            {operation: "MoveTaskListBacklog"},
+		MatchingDeleteTaskListBacklogTasksScope:     {operation: "DeleteTaskListBacklogTasks"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -2376,6 +2481,7 @@ const (
 
 	CadenceAuthorizationLatency
 	CadenceAuthorizationLatencyHistogram
+	CadenceAuthorizationDryRunDeniedCounter
 
 	DomainCachePrepareCallbacksLatency
 	DomainCachePrepareCallbacksLatencyHistogram
@@ -2609,6 +2715,10 @@ const (
 
 	WeightedChannelPoolSizeGauge
 
+	// OpenWorkflowLimitSkippedCounter counts the starts let through without enforcing the open workflow limits
+	// because open workflows could not be counted
+	OpenWorkflowLimitSkippedCounter
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
@@ -3347,6 +3457,7 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		CadenceDcRedirectionClientLatencyHistogram:                   {metricName: "cadence_client_latency_redirection_ns", metricType: Histogram, exponentialBuckets: Low1ms100s},
 		CadenceAuthorizationLatency:                                  {metricName: "cadence_authorization_latency", metricType: Timer},
 		CadenceAuthorizationLatencyHistogram:                         {metricName: "cadence_authorization_latency_ns", metricType: Histogram, exponentialBuckets: Low1ms100s},
+		CadenceAuthorizationDryRunDeniedCounter:                      {metricName: "cadence_authorization_dry_run_denied", metricType: Counter},
 		DomainCachePrepareCallbacksLatency:                           {metricName: "domain_cache_prepare_callbacks_latency", metricType: Timer},
 		DomainCachePrepareCallbacksLatencyHistogram:                  {metricName: "domain_cache_prepare_callbacks_latency_ns", metricType: Histogram, exponentialBuckets: Low1ms100s},
 		DomainCacheCallbacksLatency:                                  {metricName: "domain_cache_callbacks_latency", metricType: Timer},
@@ -3598,6 +3709,8 @@ var MetricDefs = map[ServiceIdx]map[MetricIdx]metricDefinition{
 		BudgetManagerSoftCapExceeded:  {metricName: "budget_manager_soft_cap_exceeded", metricType: Counter},
 
 		WeightedChannelPoolSizeGauge: {metricName: "weighted_channel_pool_size", metricType: Gauge},
+
+		OpenWorkflowLimitSkippedCounter: {metricName: "open_workflow_limit_skipped", metricType: Counter},
 	},
 	History: {
 		TaskRequests:                                  {metricName: "task_requests", metricType: Counter},
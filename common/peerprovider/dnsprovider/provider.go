@@ -0,0 +1,177 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dnsprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config/yaml"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/peerprovider"
+)
+
+// ConfigKey is the key of the DNS SRV peer provider in the membership provider config
+const ConfigKey = "dns-srv"
+
+const defaultRefreshInterval = 10 * time.Second
+
+type (
+	// Config is the config of the DNS SRV peer provider
+	Config struct {
+		// Services are the SRV records of each service, keyed by service name such as cadence-frontend
+		Services map[string]ServiceRecords `yaml:"services"`
+		// RefreshInterval is how often the records are resolved, default is 10s
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// BroadcastAddress is the IP this host is listed with, if it is not the IP the service listens on,
+		// e.g. when the service listens on 0.0.0.0
+		BroadcastAddress string `yaml:"broadcastAddress"`
+	}
+
+	// ServiceRecords are the names of the SRV records of a service
+	ServiceRecords struct {
+		// TChannel is the SRV record of the tchannel port, e.g. _tchannel._tcp.cadence-frontend.cadence.svc.cluster.local
+		TChannel string `yaml:"tchannel"`
+		// GRPC is the optional SRV record of the gRPC port, e.g. _grpc._tcp.cadence-frontend.cadence.svc.cluster.local
+		GRPC string `yaml:"grpc"`
+	}
+
+	// Resolver resolves the DNS records, it is implemented by net.Resolver
+	Resolver interface {
+		LookupHost(ctx context.Context, host string) (addrs []string, err error)
+		LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
+	}
+
+	srvDiscovery struct {
+		services map[string]ServiceRecords
+		resolver Resolver
+	}
+)
+
+func init() {
+	if err := peerprovider.Register(ConfigKey, newProvider); err != nil {
+		panic(err)
+	}
+}
+
+func newProvider(cfg *yaml.Node, container peerprovider.Container) (membership.PeerProvider, error) {
+	var c Config
+	if err := cfg.Decode(&c); err != nil {
+		return nil, fmt.Errorf("failed to decode dns-srv peer provider config: %w", err)
+	}
+	return New(c, container, net.DefaultResolver, clock.NewRealTimeSource())
+}
+
+// New creates a peer provider which resolves the hosts of the services from SRV records
+func New(cfg Config, container peerprovider.Container, resolver Resolver, timeSource clock.TimeSource) (*peerprovider.PollingProvider, error) {
+	if len(cfg.Services) == 0 {
+		return nil, fmt.Errorf("dns-srv peer provider config requires services")
+	}
+	for service, records := range cfg.Services {
+		if records.TChannel == "" {
+			return nil, fmt.Errorf("dns-srv peer provider config requires the tchannel record of %s", service)
+		}
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultRefreshInterval
+	}
+
+	discovery := &srvDiscovery{
+		services: cfg.Services,
+		resolver: resolver,
+	}
+	return peerprovider.NewPollingProvider(ConfigKey, discovery.discover, cfg.RefreshInterval, cfg.BroadcastAddress, container, timeSource), nil
+}
+
+func (d *srvDiscovery) discover(ctx context.Context) (map[string][]membership.HostInfo, error) {
+	// the hosts of the services are usually the same for the tchannel and gRPC records
+	resolvedHosts := make(map[string][]string)
+	members := make(map[string][]membership.HostInfo, len(d.services))
+	for service, records := range d.services {
+		tchannelPorts, err := d.resolvePorts(ctx, records.TChannel, resolvedHosts)
+		if err != nil {
+			return nil, err
+		}
+		var grpcPorts map[string]uint16
+		if records.GRPC != "" {
+			if grpcPorts, err = d.resolvePorts(ctx, records.GRPC, resolvedHosts); err != nil {
+				return nil, err
+			}
+		}
+
+		for ip, port := range tchannelPorts {
+			address := net.JoinHostPort(ip, strconv.Itoa(int(port)))
+			portMap := membership.PortMap{membership.PortTchannel: port}
+			if grpcPort, ok := grpcPorts[ip]; ok {
+				portMap[membership.PortGRPC] = grpcPort
+			}
+			members[service] = append(members[service], membership.NewDetailedHostInfo(address, address, portMap))
+		}
+		sort.Slice(members[service], func(i, j int) bool {
+			return members[service][i].GetAddress() < members[service][j].GetAddress()
+		})
+	}
+	return members, nil
+}
+
+// resolvePorts returns the port of each IP of the targets of the SRV record
+func (d *srvDiscovery) resolvePorts(ctx context.Context, record string, resolvedHosts map[string][]string) (map[string]uint16, error) {
+	_, srvs, err := d.resolver.LookupSRV(ctx, "", "", record)
+	if isNotFound(err) {
+		// the record doesn't exist until the first host of the service is ready
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve SRV record %s: %w", record, err)
+	}
+
+	ports := make(map[string]uint16)
+	for _, srv := range srvs {
+		ips, ok := resolvedHosts[srv.Target]
+		if !ok {
+			ips, err = d.resolver.LookupHost(ctx, srv.Target)
+			if isNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("could not resolve host %s: %w", srv.Target, err)
+			}
+			resolvedHosts[srv.Target] = ips
+		}
+		for _, ip := range ips {
+			ports[ip] = srv.Port
+		}
+	}
+	return ports, nil
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
@@ -0,0 +1,162 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dnsprovider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/peerprovider"
+)
+
+type fakeResolver struct {
+	srvs  map[string][]*net.SRV
+	hosts map[string][]string
+}
+
+func (r *fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	srvs, ok := r.srvs[name]
+	if !ok {
+		return "", nil, &net.DNSError{Name: name, IsNotFound: true}
+	}
+	return name, srvs, nil
+}
+
+func (r *fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if host == "broken." {
+		return nil, errors.New("dns failure")
+	}
+	ips, ok := r.hosts[host]
+	if !ok {
+		return nil, &net.DNSError{Name: host, IsNotFound: true}
+	}
+	return ips, nil
+}
+
+func TestDiscover(t *testing.T) {
+	resolver := &fakeResolver{
+		srvs: map[string][]*net.SRV{
+			"_tchannel._tcp.frontend": {
+				{Target: "frontend-0.", Port: 7933},
+				{Target: "frontend-1.", Port: 7933},
+				{Target: "frontend-2.", Port: 7933},
+			},
+			"_grpc._tcp.frontend": {
+				{Target: "frontend-0.", Port: 7833},
+				{Target: "frontend-1.", Port: 7833},
+			},
+			"_tchannel._tcp.history": {
+				{Target: "history-0.", Port: 7934},
+			},
+			"_tchannel._tcp.broken": {
+				{Target: "broken.", Port: 7935},
+			},
+		},
+		hosts: map[string][]string{
+			"frontend-0.": {"10.0.0.2"},
+			"frontend-1.": {"10.0.0.1"},
+			"history-0.":  {"10.0.0.3"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		services map[string]ServiceRecords
+		want     map[string][]membership.HostInfo
+		wantErr  string
+	}{
+		{
+			name: "tchannel and grpc records",
+			services: map[string]ServiceRecords{
+				"cadence-frontend": {TChannel: "_tchannel._tcp.frontend", GRPC: "_grpc._tcp.frontend"},
+				"cadence-history":  {TChannel: "_tchannel._tcp.history"},
+			},
+			want: map[string][]membership.HostInfo{
+				"cadence-frontend": {
+					membership.NewDetailedHostInfo("10.0.0.1:7933", "10.0.0.1:7933", membership.PortMap{membership.PortTchannel: 7933, membership.PortGRPC: 7833}),
+					membership.NewDetailedHostInfo("10.0.0.2:7933", "10.0.0.2:7933", membership.PortMap{membership.PortTchannel: 7933, membership.PortGRPC: 7833}),
+				},
+				"cadence-history": {
+					membership.NewDetailedHostInfo("10.0.0.3:7934", "10.0.0.3:7934", membership.PortMap{membership.PortTchannel: 7934}),
+				},
+			},
+		},
+		{
+			name: "missing record",
+			services: map[string]ServiceRecords{
+				"cadence-matching": {TChannel: "_tchannel._tcp.matching"},
+			},
+			want: map[string][]membership.HostInfo{},
+		},
+		{
+			name: "resolve error",
+			services: map[string]ServiceRecords{
+				"cadence-worker": {TChannel: "_tchannel._tcp.broken"},
+			},
+			wantErr: "could not resolve host broken.: dns failure",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discovery := &srvDiscovery{services: tt.services, resolver: resolver}
+			members, err := discovery.discover(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, members)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	container := peerprovider.Container{Logger: testlogger.New(t)}
+	resolver := &fakeResolver{
+		srvs:  map[string][]*net.SRV{"_tchannel._tcp.frontend": {{Target: "frontend-0.", Port: 7933}}},
+		hosts: map[string][]string{"frontend-0.": {"10.0.0.1"}},
+	}
+
+	_, err := New(Config{}, container, resolver, clock.NewMockedTimeSource())
+	assert.EqualError(t, err, "dns-srv peer provider config requires services")
+	_, err = New(Config{Services: map[string]ServiceRecords{"cadence-frontend": {GRPC: "_grpc._tcp.frontend"}}}, container, resolver, clock.NewMockedTimeSource())
+	assert.EqualError(t, err, "dns-srv peer provider config requires the tchannel record of cadence-frontend")
+
+	provider, err := New(Config{Services: map[string]ServiceRecords{"cadence-frontend": {TChannel: "_tchannel._tcp.frontend"}}}, container, resolver, clock.NewMockedTimeSource())
+	require.NoError(t, err)
+	require.NoError(t, provider.Start(context.Background()))
+	defer provider.Stop(context.Background())
+	members, err := provider.GetMembers("cadence-frontend")
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{
+		membership.NewDetailedHostInfo("10.0.0.1:7933", "10.0.0.1:7933", membership.PortMap{membership.PortTchannel: 7933}),
+	}, members)
+}
@@ -24,6 +24,9 @@ package peerprovider
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"go.uber.org/yarpc/transport/tchannel"
 
@@ -34,12 +37,10 @@ import (
 	"github.com/uber/cadence/common/syncmap"
 )
 
-const key = "peerprovider"
-
 // Container is passed to peer provider plugin
 type Container struct {
 	Service string
-	// Channel is required by ringpop
+	// Channel is required by ringpop, the other providers use its address to identify this host
 	Channel tchannel.Channel
 	Logger  log.Logger
 	Portmap membership.PortMap
@@ -66,34 +67,49 @@ func New(config config.PeerProvider, container Container) *Provider {
 	}
 }
 
+// Register registers the constructor of the peer provider configured under configKey in the membership
+// provider config. Several providers can be registered, the one present in the config is used.
 func Register(configKey string, constructor constructorFn) error {
-
-	inserted := plugins.Put(key, plugin{
+	inserted := plugins.Put(configKey, plugin{
 		fn:        constructor,
 		configKey: configKey,
 	})
-
-	// only one plugin is allowed to be registered
 	if !inserted {
-		registeredPlugin, _ := plugins.Get(key)
-		return fmt.Errorf("cannot register %q provider, %q is already registered", configKey, registeredPlugin.configKey)
+		return fmt.Errorf("cannot register %q provider, it is already registered", configKey)
 	}
 
 	return nil
 }
 
 func (p *Provider) Provider() (membership.PeerProvider, error) {
-	registeredPlugin, found := plugins.Get(key)
-
-	if !found {
+	registered := plugins.Keys()
+	if len(registered) == 0 {
 		return nil, fmt.Errorf("no configured peer providers found")
 	}
 
-	for configKey, cfg := range p.config {
-		if configKey == registeredPlugin.configKey {
-			return registeredPlugin.fn(cfg, p.container)
+	var configured []string
+	for _, configKey := range registered {
+		if _, ok := p.config[configKey]; ok {
+			configured = append(configured, configKey)
 		}
 	}
 
-	return nil, fmt.Errorf("no configuration for %q peer provider found", registeredPlugin.configKey)
+	switch len(configured) {
+	case 0:
+		return nil, fmt.Errorf("no configuration for %s peer provider found", quoteKeys(registered))
+	case 1:
+		registeredPlugin, _ := plugins.Get(configured[0])
+		return registeredPlugin.fn(p.config[configured[0]], p.container)
+	default:
+		return nil, fmt.Errorf("only one peer provider can be configured, found %s", quoteKeys(configured))
+	}
+}
+
+func quoteKeys(keys []string) string {
+	sort.Strings(keys)
+	quoted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		quoted = append(quoted, strconv.Quote(key))
+	}
+	return strings.Join(quoted, ", ")
 }
@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/config/yaml"
@@ -52,10 +53,33 @@ func TestProviderRetrunsErrorWhenPluginAlreadyRegistered(t *testing.T) {
 		return nil, nil
 	})
 	assert.NoError(t, err)
-	err = Register("provider2", func(cfg *yaml.Node, container Container) (membership.PeerProvider, error) {
+	err = Register("provider1", func(cfg *yaml.Node, container Container) (membership.PeerProvider, error) {
 		return nil, nil
 	})
-	assert.Error(t, err)
+	assert.EqualError(t, err, "cannot register \"provider1\" provider, it is already registered")
+}
+
+func TestConfiguredProviderIsPicked(t *testing.T) {
+	// Reset plugins
+	plugins = syncmap.New[string, plugin]()
+	provider1 := membership.NewMockPeerProvider(gomock.NewController(t))
+	provider2 := membership.NewMockPeerProvider(gomock.NewController(t))
+	assert.NoError(t, Register("provider1", func(cfg *yaml.Node, container Container) (membership.PeerProvider, error) {
+		return provider1, nil
+	}))
+	assert.NoError(t, Register("provider2", func(cfg *yaml.Node, container Container) (membership.PeerProvider, error) {
+		return provider2, nil
+	}))
+
+	p, err := New(config.PeerProvider{"provider2": &yaml.Node{}}, Container{}).Provider()
+	assert.NoError(t, err)
+	assert.Equal(t, provider2, p)
+
+	_, err = New(config.PeerProvider{}, Container{}).Provider()
+	assert.EqualError(t, err, "no configuration for \"provider1\", \"provider2\" peer provider found")
+
+	_, err = New(config.PeerProvider{"provider1": &yaml.Node{}, "provider2": &yaml.Node{}}, Container{}).Provider()
+	assert.EqualError(t, err, "only one peer provider can be configured, found \"provider1\", \"provider2\"")
 }
 
 func TestConfigIsPickedUp(t *testing.T) {
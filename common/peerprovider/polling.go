@@ -0,0 +1,252 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package peerprovider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+)
+
+type (
+	// DiscoverFn returns the hosts of the services, keyed by service name
+	DiscoverFn func(ctx context.Context) (map[string][]membership.HostInfo, error)
+
+	// PollingProvider is a peer provider for membership sources which can't notify about changes,
+	// such as a static host list or DNS records. It calls discover periodically and notifies the
+	// subscribers when the hosts have changed.
+	PollingProvider struct {
+		status           int32
+		name             string
+		discover         DiscoverFn
+		refreshInterval  time.Duration
+		broadcastAddress string
+		container        Container
+		timeSource       clock.TimeSource
+		shutdownCh       chan struct{}
+		shutdownWG       sync.WaitGroup
+
+		mu          sync.RWMutex
+		members     map[string][]membership.HostInfo
+		subscribers map[string]func(membership.ChangedEvent)
+	}
+)
+
+var _ membership.PeerProvider = (*PollingProvider)(nil)
+
+// NewPollingProvider creates a peer provider which calls discover every refreshInterval.
+// This host is identified by the address of the container's channel, with the IP replaced by
+// broadcastAddress if it is set.
+func NewPollingProvider(
+	name string,
+	discover DiscoverFn,
+	refreshInterval time.Duration,
+	broadcastAddress string,
+	container Container,
+	timeSource clock.TimeSource,
+) *PollingProvider {
+	return &PollingProvider{
+		status:           common.DaemonStatusInitialized,
+		name:             name,
+		discover:         discover,
+		refreshInterval:  refreshInterval,
+		broadcastAddress: broadcastAddress,
+		container:        container,
+		timeSource:       timeSource,
+		shutdownCh:       make(chan struct{}),
+		members:          map[string][]membership.HostInfo{},
+		subscribers:      map[string]func(membership.ChangedEvent){},
+	}
+}
+
+// Start discovers the hosts and starts refreshing them in the background
+func (p *PollingProvider) Start(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(
+		&p.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return nil
+	}
+
+	if err := p.refresh(ctx); err != nil {
+		atomic.StoreInt32(&p.status, common.DaemonStatusInitialized)
+		return fmt.Errorf("discover %s peers: %w", p.name, err)
+	}
+
+	p.shutdownWG.Add(1)
+	go p.refreshLoop()
+	return nil
+}
+
+// Stop stops refreshing the hosts
+func (p *PollingProvider) Stop(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(
+		&p.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return nil
+	}
+
+	close(p.shutdownCh)
+	p.shutdownWG.Wait()
+	return nil
+}
+
+// GetMembers returns the hosts of the service found by the last discovery
+func (p *PollingProvider) GetMembers(service string) ([]membership.HostInfo, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return append([]membership.HostInfo(nil), p.members[service]...), nil
+}
+
+// WhoAmI returns the address of this host
+func (p *PollingProvider) WhoAmI() (membership.HostInfo, error) {
+	if p.container.Channel == nil {
+		return membership.HostInfo{}, fmt.Errorf("%s peer provider requires a channel to identify the host", p.name)
+	}
+	peerInfo := p.container.Channel.PeerInfo()
+	if peerInfo.IsEphemeralHostPort() {
+		return membership.HostInfo{}, fmt.Errorf("channel is not listening yet")
+	}
+
+	address := peerInfo.HostPort
+	if p.broadcastAddress != "" {
+		_, port, err := net.SplitHostPort(peerInfo.HostPort)
+		if err != nil {
+			return membership.HostInfo{}, fmt.Errorf("failed splitting channel's hostport %q: %w", peerInfo.HostPort, err)
+		}
+		address = net.JoinHostPort(p.broadcastAddress, port)
+	}
+	return membership.NewDetailedHostInfo(address, address, p.container.Portmap), nil
+}
+
+// SelfEvict is a no-op, the hosts are only removed from the membership by the source of the discovery
+func (p *PollingProvider) SelfEvict() error {
+	return nil
+}
+
+// Subscribe allows to be subscribed for host changes
+func (p *PollingProvider) Subscribe(name string, handler func(membership.ChangedEvent)) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.subscribers[name]; ok {
+		return fmt.Errorf("%q already subscribed to %s peer provider", name, p.name)
+	}
+	p.subscribers[name] = handler
+	return nil
+}
+
+func (p *PollingProvider) refreshLoop() {
+	defer p.shutdownWG.Done()
+
+	ticker := p.timeSource.NewTicker(p.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.shutdownCh:
+			return
+		case <-ticker.Chan():
+			ctx, cancel := p.timeSource.ContextWithTimeout(context.Background(), p.refreshInterval)
+			if err := p.refresh(ctx); err != nil {
+				p.container.Logger.Warn("Failed to refresh peers, keeping the previous peers", tag.Name(p.name), tag.Error(err))
+			}
+			cancel()
+		}
+	}
+}
+
+func (p *PollingProvider) refresh(ctx context.Context) error {
+	members, err := p.discover(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	change := diffMembers(p.members, members)
+	p.members = members
+	subscribers := make([]func(membership.ChangedEvent), 0, len(p.subscribers))
+	for _, handler := range p.subscribers {
+		subscribers = append(subscribers, handler)
+	}
+	p.mu.Unlock()
+
+	if len(change.HostsAdded) == 0 && len(change.HostsUpdated) == 0 && len(change.HostsRemoved) == 0 {
+		return nil
+	}
+	p.container.Logger.Info("Peers changed", tag.Name(p.name), tag.MembershipChangeEvent(change))
+	for _, handler := range subscribers {
+		handler(change)
+	}
+	return nil
+}
+
+// diffMembers returns the addresses of the hosts added, updated or removed between the two discoveries
+func diffMembers(previous, current map[string][]membership.HostInfo) membership.ChangedEvent {
+	previousHosts := hostsByAddress(previous)
+	currentHosts := hostsByAddress(current)
+
+	var change membership.ChangedEvent
+	for address, host := range currentHosts {
+		previousHost, ok := previousHosts[address]
+		switch {
+		case !ok:
+			change.HostsAdded = append(change.HostsAdded, address)
+		case previousHost != host:
+			change.HostsUpdated = append(change.HostsUpdated, address)
+		}
+	}
+	for address := range previousHosts {
+		if _, ok := currentHosts[address]; !ok {
+			change.HostsRemoved = append(change.HostsRemoved, address)
+		}
+	}
+	sort.Strings(change.HostsAdded)
+	sort.Strings(change.HostsUpdated)
+	sort.Strings(change.HostsRemoved)
+	return change
+}
+
+// hostsByAddress returns the service and ports of each host, which are compared to find updated hosts
+func hostsByAddress(members map[string][]membership.HostInfo) map[string]string {
+	hosts := make(map[string]string)
+	for service, serviceHosts := range members {
+		for _, host := range serviceHosts {
+			hosts[host.GetAddress()] = service + " " + host.String()
+		}
+	}
+	return hosts
+}
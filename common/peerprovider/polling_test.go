@@ -0,0 +1,132 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package peerprovider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+)
+
+type testDiscovery struct {
+	sync.Mutex
+	members map[string][]membership.HostInfo
+	err     error
+}
+
+func (d *testDiscovery) set(members map[string][]membership.HostInfo, err error) {
+	d.Lock()
+	defer d.Unlock()
+	d.members = members
+	d.err = err
+}
+
+func (d *testDiscovery) discover(ctx context.Context) (map[string][]membership.HostInfo, error) {
+	d.Lock()
+	defer d.Unlock()
+	return d.members, d.err
+}
+
+func TestPollingProvider(t *testing.T) {
+	host1 := membership.NewDetailedHostInfo("10.0.0.1:7933", "10.0.0.1:7933", membership.PortMap{membership.PortGRPC: 7833})
+	host2 := membership.NewDetailedHostInfo("10.0.0.2:7933", "10.0.0.2:7933", membership.PortMap{membership.PortGRPC: 7833})
+	host2Updated := membership.NewDetailedHostInfo("10.0.0.2:7933", "10.0.0.2:7933", membership.PortMap{membership.PortGRPC: 7834})
+	host3 := membership.NewDetailedHostInfo("10.0.0.3:7934", "10.0.0.3:7934", membership.PortMap{membership.PortGRPC: 7834})
+
+	discovery := &testDiscovery{}
+	discovery.set(nil, errors.New("discovery failed"))
+	timeSource := clock.NewMockedTimeSource()
+	provider := NewPollingProvider("test", discovery.discover, time.Second, "", Container{Logger: testlogger.New(t)}, timeSource)
+	assert.ErrorContains(t, provider.Start(context.Background()), "discovery failed")
+
+	discovery.set(map[string][]membership.HostInfo{
+		"cadence-frontend": {host1, host2},
+	}, nil)
+	require.NoError(t, provider.Start(context.Background()))
+	defer provider.Stop(context.Background())
+
+	members, err := provider.GetMembers("cadence-frontend")
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{host1, host2}, members)
+
+	changes := make(chan membership.ChangedEvent, 1)
+	require.NoError(t, provider.Subscribe("test", func(event membership.ChangedEvent) {
+		changes <- event
+	}))
+	assert.Error(t, provider.Subscribe("test", func(membership.ChangedEvent) {}))
+
+	discovery.set(map[string][]membership.HostInfo{
+		"cadence-frontend": {host2Updated},
+		"cadence-history":  {host3},
+	}, nil)
+	timeSource.BlockUntil(1)
+	timeSource.Advance(time.Second)
+	select {
+	case event := <-changes:
+		assert.Equal(t, membership.ChangedEvent{
+			HostsAdded:   []string{"10.0.0.3:7934"},
+			HostsUpdated: []string{"10.0.0.2:7933"},
+			HostsRemoved: []string{"10.0.0.1:7933"},
+		}, event)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no change event")
+	}
+
+	members, err = provider.GetMembers("cadence-history")
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{host3}, members)
+}
+
+func TestPollingProviderWhoAmI(t *testing.T) {
+	ch, err := tchannel.NewChannel("test-polling", nil)
+	require.NoError(t, err)
+	defer ch.Close()
+	portMap := membership.PortMap{membership.PortTchannel: 7933}
+
+	provider := NewPollingProvider("test", nil, time.Second, "", Container{Channel: ch, Portmap: portMap}, clock.NewRealTimeSource())
+	_, err = provider.WhoAmI()
+	assert.Error(t, err, "channel is not listening yet")
+
+	require.NoError(t, ch.ListenAndServe("127.0.0.1:0"))
+	self, err := provider.WhoAmI()
+	require.NoError(t, err)
+	assert.Equal(t, membership.NewDetailedHostInfo(ch.PeerInfo().HostPort, ch.PeerInfo().HostPort, portMap), self)
+
+	_, port, err := net.SplitHostPort(ch.PeerInfo().HostPort)
+	require.NoError(t, err)
+	provider = NewPollingProvider("test", nil, time.Second, "10.0.0.1", Container{Channel: ch, Portmap: portMap}, clock.NewRealTimeSource())
+	self, err = provider.WhoAmI()
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:"+port, self.GetAddress())
+}
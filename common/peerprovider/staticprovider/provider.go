@@ -0,0 +1,171 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package staticprovider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	yamlv2 "gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config/yaml"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/peerprovider"
+)
+
+// ConfigKey is the key of the static peer provider in the membership provider config
+const ConfigKey = "static"
+
+const defaultRefreshInterval = 10 * time.Second
+
+type (
+	// Config is the config of the static peer provider, the hosts of the services are listed either
+	// in Services or in File
+	Config struct {
+		// Services are the hosts of each service, keyed by service name such as cadence-frontend
+		Services map[string]ServiceHosts `yaml:"services"`
+		// File is a yaml file with the services, in the same format as Services under a services key.
+		// It is checked for changes every RefreshInterval.
+		File string `yaml:"file"`
+		// RefreshInterval is how often File is checked for changes, default is 10s
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// BroadcastAddress is the IP this host is listed with, if it is not the IP the service listens on,
+		// e.g. when the service listens on 0.0.0.0
+		BroadcastAddress string `yaml:"broadcastAddress"`
+	}
+
+	// ServiceHosts are the hosts of a service
+	ServiceHosts struct {
+		// Hosts are the tchannel addresses of the hosts, in host:port format
+		Hosts []string `yaml:"hosts"`
+		// GRPCPort is the gRPC port of the hosts
+		GRPCPort uint16 `yaml:"grpcPort"`
+	}
+
+	fileConfig struct {
+		Services map[string]ServiceHosts `yaml:"services"`
+	}
+
+	// fileDiscovery parses the file again only when it has been modified
+	fileDiscovery struct {
+		path string
+
+		mu      sync.Mutex
+		modTime time.Time
+		size    int64
+		members map[string][]membership.HostInfo
+	}
+)
+
+func init() {
+	if err := peerprovider.Register(ConfigKey, newProvider); err != nil {
+		panic(err)
+	}
+}
+
+func newProvider(cfg *yaml.Node, container peerprovider.Container) (membership.PeerProvider, error) {
+	var c Config
+	if err := cfg.Decode(&c); err != nil {
+		return nil, fmt.Errorf("failed to decode static peer provider config: %w", err)
+	}
+	return New(c, container, clock.NewRealTimeSource())
+}
+
+// New creates a peer provider which gets the hosts of the services from the config or from a file
+func New(cfg Config, container peerprovider.Container, timeSource clock.TimeSource) (*peerprovider.PollingProvider, error) {
+	if (len(cfg.Services) == 0) == (cfg.File == "") {
+		return nil, fmt.Errorf("static peer provider config requires either services or file")
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultRefreshInterval
+	}
+
+	discover := func(context.Context) (map[string][]membership.HostInfo, error) {
+		return toMembers(cfg.Services)
+	}
+	if cfg.File != "" {
+		discover = (&fileDiscovery{path: cfg.File}).discover
+	} else if _, err := discover(context.Background()); err != nil {
+		return nil, err
+	}
+	return peerprovider.NewPollingProvider(ConfigKey, discover, cfg.RefreshInterval, cfg.BroadcastAddress, container, timeSource), nil
+}
+
+func (d *fileDiscovery) discover(context.Context) (map[string][]membership.HostInfo, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	info, err := os.Stat(d.path)
+	if err != nil {
+		return nil, err
+	}
+	if d.members != nil && info.ModTime().Equal(d.modTime) && info.Size() == d.size {
+		return d.members, nil
+	}
+
+	content, err := os.ReadFile(d.path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg fileConfig
+	if err := yamlv2.UnmarshalStrict(content, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", d.path, err)
+	}
+	members, err := toMembers(cfg.Services)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", d.path, err)
+	}
+
+	d.modTime = info.ModTime()
+	d.size = info.Size()
+	d.members = members
+	return members, nil
+}
+
+func toMembers(services map[string]ServiceHosts) (map[string][]membership.HostInfo, error) {
+	members := make(map[string][]membership.HostInfo, len(services))
+	for service, hosts := range services {
+		for _, address := range hosts.Hosts {
+			_, port, err := net.SplitHostPort(address)
+			if err != nil {
+				return nil, fmt.Errorf("invalid host of %s: %w", service, err)
+			}
+			tchannelPort, err := strconv.ParseUint(port, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port of %s host %q: %w", service, address, err)
+			}
+			portMap := membership.PortMap{membership.PortTchannel: uint16(tchannelPort)}
+			if hosts.GRPCPort != 0 {
+				portMap[membership.PortGRPC] = hosts.GRPCPort
+			}
+			members[service] = append(members[service], membership.NewDetailedHostInfo(address, address, portMap))
+		}
+	}
+	return members, nil
+}
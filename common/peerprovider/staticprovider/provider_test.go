@@ -0,0 +1,167 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package staticprovider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config/yaml"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/peerprovider"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    map[string][]membership.HostInfo
+		wantErr string
+	}{
+		{
+			name: "services",
+			cfg: Config{
+				Services: map[string]ServiceHosts{
+					"cadence-frontend": {Hosts: []string{"10.0.0.1:7933", "10.0.0.2:7933"}, GRPCPort: 7833},
+					"cadence-history":  {Hosts: []string{"10.0.0.1:7934"}},
+				},
+			},
+			want: map[string][]membership.HostInfo{
+				"cadence-frontend": {
+					membership.NewDetailedHostInfo("10.0.0.1:7933", "10.0.0.1:7933", membership.PortMap{membership.PortTchannel: 7933, membership.PortGRPC: 7833}),
+					membership.NewDetailedHostInfo("10.0.0.2:7933", "10.0.0.2:7933", membership.PortMap{membership.PortTchannel: 7933, membership.PortGRPC: 7833}),
+				},
+				"cadence-history": {
+					membership.NewDetailedHostInfo("10.0.0.1:7934", "10.0.0.1:7934", membership.PortMap{membership.PortTchannel: 7934}),
+				},
+			},
+		},
+		{
+			name:    "no services or file",
+			cfg:     Config{},
+			wantErr: "static peer provider config requires either services or file",
+		},
+		{
+			name: "both services and file",
+			cfg: Config{
+				Services: map[string]ServiceHosts{"cadence-frontend": {Hosts: []string{"10.0.0.1:7933"}}},
+				File:     "peers.yaml",
+			},
+			wantErr: "static peer provider config requires either services or file",
+		},
+		{
+			name: "host without port",
+			cfg: Config{
+				Services: map[string]ServiceHosts{"cadence-frontend": {Hosts: []string{"10.0.0.1"}}},
+			},
+			wantErr: "invalid host of cadence-frontend",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := New(tt.cfg, peerprovider.Container{Logger: testlogger.New(t)}, clock.NewMockedTimeSource())
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, provider.Start(context.Background()))
+			defer provider.Stop(context.Background())
+			for service, want := range tt.want {
+				members, err := provider.GetMembers(service)
+				require.NoError(t, err)
+				assert.Equal(t, want, members)
+			}
+		})
+	}
+}
+
+func TestFileReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "peers.yaml")
+	writeFile := func(content string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	writeFile(`
+services:
+  cadence-frontend:
+    hosts: ["10.0.0.1:7933"]
+`, time.Unix(1, 0))
+
+	timeSource := clock.NewMockedTimeSource()
+	provider, err := New(Config{File: path, RefreshInterval: time.Second}, peerprovider.Container{Logger: testlogger.New(t)}, timeSource)
+	require.NoError(t, err)
+	require.NoError(t, provider.Start(context.Background()))
+	defer provider.Stop(context.Background())
+
+	changes := make(chan membership.ChangedEvent, 1)
+	require.NoError(t, provider.Subscribe("test", func(event membership.ChangedEvent) {
+		changes <- event
+	}))
+
+	writeFile(`
+services:
+  cadence-frontend:
+    hosts: ["10.0.0.2:7933"]
+`, time.Unix(2, 0))
+	timeSource.BlockUntil(1)
+	timeSource.Advance(time.Second)
+	select {
+	case event := <-changes:
+		assert.Equal(t, membership.ChangedEvent{
+			HostsAdded:   []string{"10.0.0.2:7933"},
+			HostsRemoved: []string{"10.0.0.1:7933"},
+		}, event)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no change event")
+	}
+
+	members, err := provider.GetMembers("cadence-frontend")
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{
+		membership.NewDetailedHostInfo("10.0.0.2:7933", "10.0.0.2:7933", membership.PortMap{membership.PortTchannel: 7933}),
+	}, members)
+}
+
+func TestNewProvider(t *testing.T) {
+	cfg, err := yaml.ToNode(map[string]interface{}{
+		"services": map[string]interface{}{
+			"cadence-frontend": map[string]interface{}{"hosts": []string{"10.0.0.1:7933"}},
+		},
+	})
+	require.NoError(t, err)
+	provider, err := newProvider(cfg, peerprovider.Container{Logger: testlogger.New(t)})
+	require.NoError(t, err)
+	assert.NotNil(t, provider)
+
+	_, err = newProvider(nil, peerprovider.Container{})
+	assert.Error(t, err)
+}
@@ -187,8 +187,8 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 		return nil, err
 	}
 	result := p.NewTaskManager(store)
-	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewTaskManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 || f.canInjectFaults() {
+		result = errorinjectors.NewTaskManager(result, errorRate, f.logger, time.Now(), errorinjectors.WithRules(f.dc.FaultInjectionRules))
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewTaskManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -207,8 +207,8 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 		return nil, err
 	}
 	result := p.NewShardManager(store, f.dc)
-	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewShardManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 || f.canInjectFaults() {
+		result = errorinjectors.NewShardManager(result, errorRate, f.logger, time.Now(), errorinjectors.WithRules(f.dc.FaultInjectionRules))
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewShardManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -227,8 +227,8 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 		return nil, err
 	}
 	result := p.NewHistoryV2ManagerImpl(store, f.logger, p.NewPayloadSerializer(), codec.NewThriftRWEncoder(), f.dc.TransactionSizeLimit)
-	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewHistoryManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 || f.canInjectFaults() {
+		result = errorinjectors.NewHistoryManager(result, errorRate, f.logger, time.Now(), errorinjectors.WithRules(f.dc.FaultInjectionRules))
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewHistoryManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -249,8 +249,8 @@ func (f *factoryImpl) NewDomainManager() (p.DomainManager, error) {
 		return nil, err
 	}
 	result := p.NewDomainManagerImpl(store, f.logger, p.NewPayloadSerializer(), f.dc)
-	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewDomainManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 || f.canInjectFaults() {
+		result = errorinjectors.NewDomainManager(result, errorRate, f.logger, time.Now(), errorinjectors.WithRules(f.dc.FaultInjectionRules))
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewDomainManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -322,8 +322,8 @@ func (f *factoryImpl) NewExecutionManager() (p.ExecutionManager, error) {
 		return nil, err
 	}
 	result := p.NewExecutionManagerImpl(store, f.logger, p.NewPayloadSerializer(), f.dc)
-	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewExecutionManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 || f.canInjectFaults() {
+		result = errorinjectors.NewExecutionManager(result, errorRate, f.logger, time.Now(), errorinjectors.WithRules(f.dc.FaultInjectionRules))
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewExecutionManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -517,8 +517,8 @@ func (f *factoryImpl) newDBVisibilityManager(
 		return nil, err
 	}
 	result := p.NewVisibilityManagerImpl(store, f.logger, f.dc)
-	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewVisibilityManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 || f.canInjectFaults() {
+		result = errorinjectors.NewVisibilityManager(result, errorRate, f.logger, time.Now(), errorinjectors.WithRules(f.dc.FaultInjectionRules))
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewVisibilityManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -550,8 +550,8 @@ func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error)
 		return nil, err
 	}
 	result := p.NewQueueManager(store)
-	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewQueueManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 || f.canInjectFaults() {
+		result = errorinjectors.NewQueueManager(result, errorRate, f.logger, time.Now(), errorinjectors.WithRules(f.dc.FaultInjectionRules))
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewQueueManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -570,8 +570,8 @@ func (f *factoryImpl) NewConfigStoreManager() (p.ConfigStoreManager, error) {
 		return nil, err
 	}
 	result := p.NewConfigStoreManagerImpl(store, f.logger)
-	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewConfigStoreManager(result, errorRate, f.logger, time.Now())
+	if errorRate := f.dc.ErrorInjectionRate(); errorRate != 0 || f.canInjectFaults() {
+		result = errorinjectors.NewConfigStoreManager(result, errorRate, f.logger, time.Now(), errorinjectors.WithRules(f.dc.FaultInjectionRules))
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewConfigStoreManager(result, ds.ratelimit, quotas.NewCallerBypass(f.dc.RateLimiterBypassCallerTypes))
@@ -696,6 +696,12 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	f.datastores[storeTypeVisibility] = visibilityDataStore
 }
 
+// canInjectFaults returns whether fault injection is enabled in the static config. The injectors read the
+// rules lazily so that rules added through dynamic config take effect without a restart.
+func (f *factoryImpl) canInjectFaults() bool {
+	return f.config.EnableFaultInjection && f.dc.FaultInjectionRules != nil
+}
+
 func (f *factoryImpl) getParser() serialization.Parser {
 	parser, err := serialization.NewParser(f.dc)
 	if err != nil {
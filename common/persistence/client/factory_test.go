@@ -252,6 +252,22 @@ func TestFactoryMethods(t *testing.T) {
 	})
 }
 
+func TestCanInjectFaults(t *testing.T) {
+	for name, tc := range map[string]struct {
+		enabled bool
+		want    bool
+	}{
+		"enabled in static config":     {enabled: true, want: true},
+		"not enabled in static config": {enabled: false, want: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			impl := makeFactory(t).(*factoryImpl)
+			impl.config.EnableFaultInjection = tc.enabled
+			assert.Equal(t, tc.want, impl.canInjectFaults())
+		})
+	}
+}
+
 func makeFactory(t *testing.T) Factory {
 	return makeFactoryWithMetrics(t, true)
 }
@@ -42,6 +42,7 @@ type (
 		RateLimiterBypassCallerTypes             dynamicproperties.ListPropertyFn
 		TransactionSizeLimit                     dynamicproperties.IntPropertyFn
 		ErrorInjectionRate                       dynamicproperties.FloatPropertyFn
+		FaultInjectionRules                      dynamicproperties.ListPropertyFn
 	}
 )
 
@@ -62,6 +63,7 @@ func NewDynamicConfiguration(dc *dynamicconfig.Collection) *DynamicConfiguration
 		RateLimiterBypassCallerTypes:             dc.GetListProperty(dynamicproperties.RateLimiterBypassCallerTypes),
 		TransactionSizeLimit:                     dc.GetIntProperty(dynamicproperties.TransactionSizeLimit),
 		ErrorInjectionRate:                       dc.GetFloat64Property(dynamicproperties.PersistenceErrorInjectionRate),
+		FaultInjectionRules:                      dc.GetListProperty(dynamicproperties.PersistenceFaultInjectionRules),
 	}
 }
 
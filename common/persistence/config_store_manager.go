@@ -61,15 +61,11 @@ func (m *configStoreManagerImpl) FetchDynamicConfig(ctx context.Context, cfgType
 		return nil, err
 	}
 
-	config, err := m.serializer.DeserializeDynamicConfigBlob(values.Values)
+	snapshot, err := m.toSnapshot(values)
 	if err != nil {
 		return nil, err
 	}
-
-	return &FetchDynamicConfigResponse{Snapshot: &DynamicConfigSnapshot{
-		Version: values.Version,
-		Values:  config,
-	}}, nil
+	return &FetchDynamicConfigResponse{Snapshot: snapshot}, nil
 }
 
 func (m *configStoreManagerImpl) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, cfgType ConfigType) error {
@@ -83,7 +79,41 @@ func (m *configStoreManagerImpl) UpdateDynamicConfig(ctx context.Context, reques
 		Version:   request.Snapshot.Version,
 		Timestamp: m.timeSrc.Now(),
 		Values:    blob,
+		Author:    request.Snapshot.Author,
+		Reason:    request.Snapshot.Reason,
 	}
 
 	return m.persistence.UpdateConfig(ctx, entry)
 }
+
+func (m *configStoreManagerImpl) ListDynamicConfigVersions(ctx context.Context, request *ListDynamicConfigVersionsRequest, cfgType ConfigType) (*ListDynamicConfigVersionsResponse, error) {
+	values, err := m.persistence.FetchConfigVersions(ctx, cfgType, request.MaxVersion, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*DynamicConfigSnapshot, 0, len(values))
+	for _, value := range values {
+		snapshot, err := m.toSnapshot(value)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return &ListDynamicConfigVersionsResponse{Snapshots: snapshots}, nil
+}
+
+func (m *configStoreManagerImpl) toSnapshot(entry *InternalConfigStoreEntry) (*DynamicConfigSnapshot, error) {
+	config, err := m.serializer.DeserializeDynamicConfigBlob(entry.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DynamicConfigSnapshot{
+		Version:   entry.Version,
+		Values:    config,
+		Author:    entry.Author,
+		Reason:    entry.Reason,
+		Timestamp: entry.Timestamp,
+	}, nil
+}
@@ -66,6 +66,7 @@ func TestNewConfigStoreManagerImpl(t *testing.T) {
 
 func TestFetchDynamicConfig(t *testing.T) {
 	encodingType := constants.EncodingTypeThriftRW
+	timestamp := time.Unix(1700000000, 0)
 	testCases := []struct {
 		name             string
 		setupMock        func(mockStore *MockConfigStore, mockSerializer *MockPayloadSerializer)
@@ -80,8 +81,10 @@ func TestFetchDynamicConfig(t *testing.T) {
 				// Mocking persistence DataBlob
 				mockStore.EXPECT().FetchConfig(gomock.Any(), DynamicConfig).Return(&InternalConfigStoreEntry{
 					Version:   1,
-					Timestamp: time.Now(),
+					Timestamp: timestamp,
 					Values:    &DataBlob{Encoding: encodingType, Data: []byte("serialized-values")},
+					Author:    "alice",
+					Reason:    "raise limits",
 				}, nil).Times(1)
 
 				// Mocking deserialization of persistence.DataBlob into types.DynamicConfigBlob
@@ -117,7 +120,10 @@ func TestFetchDynamicConfig(t *testing.T) {
 			expectError: false,
 			expectedResponse: &FetchDynamicConfigResponse{
 				Snapshot: &DynamicConfigSnapshot{
-					Version: 1,
+					Version:   1,
+					Author:    "alice",
+					Reason:    "raise limits",
+					Timestamp: timestamp,
 					Values: &types.DynamicConfigBlob{
 						SchemaVersion: 1,
 						Entries: []*types.DynamicConfigEntry{
@@ -234,12 +240,18 @@ func TestUpdateDynamicConfig(t *testing.T) {
 					}, constants.EncodingTypeThriftRW).
 					Return(&DataBlob{Encoding: encodingType, Data: []byte("serialized-values")}, nil).Times(1)
 
-				mockStore.EXPECT().UpdateConfig(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockStore.EXPECT().UpdateConfig(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry *InternalConfigStoreEntry) error {
+					assert.Equal(t, "alice", entry.Author)
+					assert.Equal(t, "raise limits", entry.Reason)
+					return nil
+				}).Times(1)
 			},
 			cfgType: DynamicConfig, // Updated to use DynamicConfig
 			request: &UpdateDynamicConfigRequest{
 				Snapshot: &DynamicConfigSnapshot{
 					Version: 1,
+					Author:  "alice",
+					Reason:  "raise limits",
 					Values: &types.DynamicConfigBlob{
 						SchemaVersion: 1,
 						Entries: []*types.DynamicConfigEntry{
@@ -432,3 +444,73 @@ func TestCloseConfigStoreManager(t *testing.T) {
 		configStoreManager.Close()
 	})
 }
+
+func TestListDynamicConfigVersions(t *testing.T) {
+	encodingType := constants.EncodingTypeThriftRW
+	timestamp := time.Unix(1700000000, 0)
+	blob := &types.DynamicConfigBlob{
+		SchemaVersion: 1,
+		Entries:       []*types.DynamicConfigEntry{{Name: "TestEntry"}},
+	}
+	testCases := []struct {
+		name             string
+		setupMock        func(mockStore *MockConfigStore, mockSerializer *MockPayloadSerializer)
+		expectedError    string
+		expectedResponse *ListDynamicConfigVersionsResponse
+	}{
+		{
+			name: "success",
+			setupMock: func(mockStore *MockConfigStore, mockSerializer *MockPayloadSerializer) {
+				mockStore.EXPECT().FetchConfigVersions(gomock.Any(), DynamicConfig, int64(3), 2).Return([]*InternalConfigStoreEntry{
+					{Version: 3, Timestamp: timestamp, Values: &DataBlob{Encoding: encodingType, Data: []byte("v3")}, Author: "alice", Reason: "rollback"},
+					{Version: 2, Timestamp: timestamp, Values: &DataBlob{Encoding: encodingType, Data: []byte("v2")}},
+				}, nil).Times(1)
+				mockSerializer.EXPECT().DeserializeDynamicConfigBlob(gomock.Any()).Return(blob, nil).Times(2)
+			},
+			expectedResponse: &ListDynamicConfigVersionsResponse{
+				Snapshots: []*DynamicConfigSnapshot{
+					{Version: 3, Values: blob, Author: "alice", Reason: "rollback", Timestamp: timestamp},
+					{Version: 2, Values: blob, Timestamp: timestamp},
+				},
+			},
+		},
+		{
+			name: "fetch error",
+			setupMock: func(mockStore *MockConfigStore, mockSerializer *MockPayloadSerializer) {
+				mockStore.EXPECT().FetchConfigVersions(gomock.Any(), DynamicConfig, int64(3), 2).Return(nil, errors.New("fetch error")).Times(1)
+			},
+			expectedError: "fetch error",
+		},
+		{
+			name: "deserialization error",
+			setupMock: func(mockStore *MockConfigStore, mockSerializer *MockPayloadSerializer) {
+				mockStore.EXPECT().FetchConfigVersions(gomock.Any(), DynamicConfig, int64(3), 2).Return([]*InternalConfigStoreEntry{
+					{Version: 3, Timestamp: timestamp, Values: &DataBlob{Encoding: encodingType, Data: []byte("v3")}},
+				}, nil).Times(1)
+				mockSerializer.EXPECT().DeserializeDynamicConfigBlob(gomock.Any()).Return(nil, errors.New("deserialization error")).Times(1)
+			},
+			expectedError: "deserialization error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configStoreManager, mockStore, mockSerializer := setUpMocksForConfigStoreManager(t)
+
+			tc.setupMock(mockStore, mockSerializer)
+
+			resp, err := configStoreManager.ListDynamicConfigVersions(
+				context.Background(),
+				&ListDynamicConfigVersionsRequest{MaxVersion: 3, PageSize: 2},
+				DynamicConfig,
+			)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResponse, resp)
+			}
+		})
+	}
+}
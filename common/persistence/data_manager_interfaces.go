@@ -260,6 +260,7 @@ const (
 	TransferTaskTypeRecordWorkflowClosed
 	TransferTaskTypeRecordChildExecutionCompleted
 	TransferTaskTypeApplyParentClosePolicy // Deprecated: this is related to cross-cluster tasks
+	TransferTaskTypeCompletionCallback
 )
 
 // Types of replication tasks
@@ -278,6 +279,7 @@ const (
 	TaskTypeDeleteHistoryEvent
 	TaskTypeActivityRetryTimer
 	TaskTypeWorkflowBackoffTimer
+	TaskTypeCompletionCallbackRetryTimer
 )
 
 // WorkflowRequestType is the type of workflow request
@@ -300,6 +302,12 @@ const (
 	DomainAuditOperationTypeFailover
 )
 
+const (
+	// DomainAuditIdentityTypeScheduledFailover marks audit log entries written by the scheduled failover
+	// workflow. They record the outcome of a scheduled failover and leave the domain state unchanged.
+	DomainAuditIdentityTypeScheduledFailover = "scheduled-failover"
+)
+
 func (d DomainAuditOperationType) String() string {
 	switch d {
 	case DomainAuditOperationTypeCreate:
@@ -455,6 +463,12 @@ type (
 		CronSchedule      string
 
 		ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
+
+		CompletionCallbacks []*types.CompletionCallbackInfo
+
+		// ReplicationExcluded is decided by the domain replication filter when the workflow starts,
+		// an excluded workflow never generates replication tasks
+		ReplicationExcluded bool
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -1709,9 +1723,26 @@ type (
 		Snapshot *DynamicConfigSnapshot
 	}
 
+	// ListDynamicConfigVersionsRequest is a request to list the stored dynamic config snapshots, latest first
+	ListDynamicConfigVersionsRequest struct {
+		// MaxVersion is the latest version to return, 0 starts from the latest snapshot
+		MaxVersion int64
+		PageSize   int
+	}
+
+	// ListDynamicConfigVersionsResponse is a response to ListDynamicConfigVersionsRequest
+	ListDynamicConfigVersionsResponse struct {
+		Snapshots []*DynamicConfigSnapshot
+	}
+
 	DynamicConfigSnapshot struct {
 		Version int64
 		Values  *types.DynamicConfigBlob
+		// Author and Reason describe the change which created the snapshot
+		Author string
+		Reason string
+		// Timestamp is set by the store when the snapshot is fetched
+		Timestamp time.Time
 	}
 
 	// Closeable is an interface for any entity that supports a close operation to release resources
@@ -2046,6 +2077,7 @@ type (
 		Closeable
 		FetchDynamicConfig(ctx context.Context, cfgType ConfigType) (*FetchDynamicConfigResponse, error)
 		UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, cfgType ConfigType) error
+		ListDynamicConfigVersions(ctx context.Context, request *ListDynamicConfigVersionsRequest, cfgType ConfigType) (*ListDynamicConfigVersionsResponse, error)
 		// can add functions for config types other than dynamic config
 	}
 )
@@ -2174,6 +2206,12 @@ func (t *TransferTaskInfo) ToTask() (Task, error) {
 			TaskData:           taskData,
 			TaskList:           t.TaskList,
 		}, nil
+	case TransferTaskTypeCompletionCallback:
+		return &CompletionCallbackTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           t.TaskList,
+		}, nil
 	case TransferTaskTypeRecordChildExecutionCompleted:
 		targetRunID := t.TargetRunID
 		if t.TargetRunID == TransferTaskTransferTargetRunID {
@@ -2391,6 +2429,12 @@ func (t *TimerTaskInfo) ToTask() (Task, error) {
 			TimeoutType:        t.TimeoutType,
 			TaskList:           t.TaskList,
 		}, nil
+	case TaskTypeCompletionCallbackRetryTimer:
+		return &CompletionCallbackRetryTimerTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           t.TaskList,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown task type: %d", t.TaskType)
 	}
@@ -1891,6 +1891,21 @@ func (mr *MockConfigStoreManagerMockRecorder) FetchDynamicConfig(ctx, cfgType an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDynamicConfig", reflect.TypeOf((*MockConfigStoreManager)(nil).FetchDynamicConfig), ctx, cfgType)
 }
 
+// ListDynamicConfigVersions mocks base method.
+func (m *MockConfigStoreManager) ListDynamicConfigVersions(ctx context.Context, request *ListDynamicConfigVersionsRequest, cfgType ConfigType) (*ListDynamicConfigVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigVersions", ctx, request, cfgType)
+	ret0, _ := ret[0].(*ListDynamicConfigVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigVersions indicates an expected call of ListDynamicConfigVersions.
+func (mr *MockConfigStoreManagerMockRecorder) ListDynamicConfigVersions(ctx, request, cfgType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigVersions", reflect.TypeOf((*MockConfigStoreManager)(nil).ListDynamicConfigVersions), ctx, request, cfgType)
+}
+
 // UpdateDynamicConfig mocks base method.
 func (m *MockConfigStoreManager) UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, cfgType ConfigType) error {
 	m.ctrl.T.Helper()
@@ -214,6 +214,8 @@ type (
 		Closeable
 		FetchConfig(ctx context.Context, configType ConfigType) (*InternalConfigStoreEntry, error)
 		UpdateConfig(ctx context.Context, value *InternalConfigStoreEntry) error
+		// FetchConfigVersions returns up to pageSize entries with a version not greater than maxVersion, latest first
+		FetchConfigVersions(ctx context.Context, configType ConfigType, maxVersion int64, pageSize int) ([]*InternalConfigStoreEntry, error)
 	}
 
 	InternalConfigStoreEntry struct {
@@ -221,6 +223,8 @@ type (
 		Version   int64
 		Timestamp time.Time
 		Values    *DataBlob
+		Author    string
+		Reason    string
 	}
 
 	InternalEnqueueMessageRequest struct {
@@ -446,6 +450,8 @@ type (
 		PartitionConfig    map[string]string
 
 		ActiveClusterSelectionPolicy *DataBlob
+		CompletionCallbacks          *DataBlob
+		ReplicationExcluded          bool
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -78,12 +78,16 @@ func (auditLog *DomainAuditLog) ToFailoverEvents() *types.FailoverEvent {
 		failoverType = types.FailoverTypeGraceful
 	}
 
-	return &types.FailoverEvent{
+	event := &types.FailoverEvent{
 		ID:               &auditLog.EventID,
 		CreatedTime:      common.Ptr(auditLog.CreatedTime.UnixNano()),
 		ClusterFailovers: clusterFailovers,
 		FailoverType:     &failoverType,
 	}
+	if auditLog.Comment != "" {
+		event.Comment = common.Ptr(auditLog.Comment)
+	}
+	return event
 }
 
 // for when there's only a new cluster attribute introduced
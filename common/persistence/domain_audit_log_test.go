@@ -564,6 +564,30 @@ func TestDomainAuditLog_ToFailoverEvents(t *testing.T) {
 				},
 			},
 		},
+		"scheduled failover outcome keeps the comment": {
+			auditLog: &DomainAuditLog{
+				EventID:     "event-10",
+				DomainID:    "domain-1",
+				CreatedTime: now,
+				StateBefore: &GetDomainResponse{
+					ReplicationConfig: &DomainReplicationConfig{ActiveClusterName: "cluster-us-east"},
+					FailoverVersion:   100,
+				},
+				StateAfter: &GetDomainResponse{
+					ReplicationConfig: &DomainReplicationConfig{ActiveClusterName: "cluster-us-east"},
+					FailoverVersion:   100,
+				},
+				OperationType: DomainAuditOperationTypeFailover,
+				IdentityType:  DomainAuditIdentityTypeScheduledFailover,
+				Comment:       "scheduled failover precondition failed: cluster cluster-us-west has 3 DLQ messages",
+			},
+			expected: &types.FailoverEvent{
+				ID:           stringPtr("event-10"),
+				CreatedTime:  int64Ptr(now.UnixNano()),
+				FailoverType: types.FailoverTypeForce.Ptr(),
+				Comment:      stringPtr("scheduled failover precondition failed: cluster cluster-us-west has 3 DLQ messages"),
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -148,6 +148,14 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		return nil, nil, err
 	}
 
+	var completionCallbacks []*types.CompletionCallbackInfo
+	if info.CompletionCallbacks != nil {
+		completionCallbacks, err = m.serializer.DeserializeCompletionCallbacks(info.CompletionCallbacks)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	newInfo := &WorkflowExecutionInfo{
 		CompletionEvent: completionEvent,
 
@@ -210,6 +218,8 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		Memo:                               info.Memo,
 		PartitionConfig:                    info.PartitionConfig,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		CompletionCallbacks:                completionCallbacks,
+		ReplicationExcluded:                info.ReplicationExcluded,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -495,6 +505,14 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		return nil, err
 	}
 
+	var completionCallbacks *DataBlob
+	if len(info.CompletionCallbacks) > 0 {
+		completionCallbacks, err = m.serializer.SerializeCompletionCallbacks(info.CompletionCallbacks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &InternalWorkflowExecutionInfo{
 		DomainID:                           info.DomainID,
 		WorkflowID:                         info.WorkflowID,
@@ -555,6 +573,8 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		PartitionConfig:                    info.PartitionConfig,
 		CronOverlapPolicy:                  info.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		CompletionCallbacks:                completionCallbacks,
+		ReplicationExcluded:                info.ReplicationExcluded,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
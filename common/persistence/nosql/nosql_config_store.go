@@ -71,3 +71,11 @@ func (m *nosqlConfigStore) UpdateConfig(ctx context.Context, value *persistence.
 	}
 	return nil
 }
+
+func (m *nosqlConfigStore) FetchConfigVersions(ctx context.Context, configType persistence.ConfigType, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	entries, err := m.db.SelectConfigVersions(ctx, int(configType), maxVersion, pageSize)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "FetchConfigVersions", err)
+	}
+	return entries, nil
+}
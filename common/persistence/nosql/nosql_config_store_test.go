@@ -198,3 +198,54 @@ func TestUpdateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchConfigVersions(t *testing.T) {
+	entries := []*persistence.InternalConfigStoreEntry{
+		{Version: 2, Values: &persistence.DataBlob{Encoding: constants.EncodingTypeThriftRW, Data: []byte("v2")}, Author: "alice"},
+		{Version: 1, Values: &persistence.DataBlob{Encoding: constants.EncodingTypeThriftRW, Data: []byte("v1")}},
+	}
+	testCases := []struct {
+		name           string
+		setupMock      func(mockDB *nosqlplugin.MockDB)
+		expectedError  string
+		expectedResult []*persistence.InternalConfigStoreEntry
+	}{
+		{
+			name: "success",
+			setupMock: func(mockDB *nosqlplugin.MockDB) {
+				mockDB.EXPECT().
+					SelectConfigVersions(gomock.Any(), int(persistence.DynamicConfig), int64(0), 5).
+					Return(entries, nil).Times(1)
+			},
+			expectedResult: entries,
+		},
+		{
+			name: "fetch error",
+			setupMock: func(mockDB *nosqlplugin.MockDB) {
+				mockDB.EXPECT().
+					SelectConfigVersions(gomock.Any(), int(persistence.DynamicConfig), int64(0), 5).
+					Return(nil, errors.New("fetch error")).
+					Times(1)
+				mockDB.EXPECT().IsNotFoundError(errors.New("fetch error")).Return(true).Times(1)
+			},
+			expectedError: "fetch error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configStore, mockDB := setUpMocksForNoSQLConfigStore(t)
+
+			tc.setupMock(mockDB)
+
+			result, err := configStore.FetchConfigVersions(context.Background(), persistence.DynamicConfig, 0, 5)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
@@ -22,15 +22,17 @@ package cassandra
 
 import (
 	"context"
+	"math"
 	"time"
 
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 func (db *CDB) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	query := db.session.Query(templateInsertConfig, row.RowType, row.Version, row.Timestamp, row.Values.Data, row.Values.Encoding).WithContext(ctx)
+	query := db.session.Query(templateInsertConfig, row.RowType, row.Version, row.Timestamp, row.Values.Data, row.Values.Encoding, row.Author, row.Reason).WithContext(ctx)
 	applied, err := query.MapScanCAS(make(map[string]interface{}))
 	if err != nil {
 		return err
@@ -46,9 +48,10 @@ func (db *CDB) SelectLatestConfig(ctx context.Context, rowType int) (*persistenc
 	var timestamp time.Time
 	var data []byte
 	var encoding constants.EncodingType
+	var author, reason string
 
 	query := db.session.Query(templateSelectLatestConfig, rowType).WithContext(ctx)
-	err := query.Scan(&rowType, &version, &timestamp, &data, &encoding)
+	err := query.Scan(&rowType, &version, &timestamp, &data, &encoding, &author, &reason)
 	if err != nil {
 		return nil, err
 	}
@@ -61,5 +64,32 @@ func (db *CDB) SelectLatestConfig(ctx context.Context, rowType int) (*persistenc
 			Data:     data,
 			Encoding: encoding,
 		},
+		Author: author,
+		Reason: reason,
 	}, err
 }
+
+func (db *CDB) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	// version is an int column
+	if maxVersion <= 0 || maxVersion > math.MaxInt32 {
+		maxVersion = math.MaxInt32
+	}
+	query := db.session.Query(templateSelectConfigVersions, rowType, maxVersion, pageSize).WithContext(ctx)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &types.InternalServiceError{
+			Message: "SelectConfigVersions operation failed. Not able to create query iterator.",
+		}
+	}
+
+	var entries []*persistence.InternalConfigStoreEntry
+	entry := &persistence.InternalConfigStoreEntry{Values: &persistence.DataBlob{}}
+	for iter.Scan(&entry.RowType, &entry.Version, &entry.Timestamp, &entry.Values.Data, &entry.Values.Encoding, &entry.Author, &entry.Reason) {
+		entries = append(entries, entry)
+		entry = &persistence.InternalConfigStoreEntry{Values: &persistence.DataBlob{}}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
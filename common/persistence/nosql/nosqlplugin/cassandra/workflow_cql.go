@@ -86,7 +86,10 @@ const (
 		`memo: ?, ` +
 		`partition_config: ?, ` +
 		`active_cluster_selection_policy: ?, ` +
-		`active_cluster_selection_policy_encoding: ?` +
+		`active_cluster_selection_policy_encoding: ?, ` +
+		`completion_callbacks: ?, ` +
+		`completion_callbacks_encoding: ?, ` +
+		`replication_excluded: ?` +
 		`}`
 
 	templateTransferTaskType = `{` +
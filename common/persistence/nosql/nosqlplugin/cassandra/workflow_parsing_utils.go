@@ -50,6 +50,8 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) (*persistence.Int
 	var autoResetPointsEncoding constants.EncodingType
 	var activeClusterSelectionPolicy []byte
 	var activeClusterSelectionPolicyEncoding constants.EncodingType
+	var completionCallbacks []byte
+	var completionCallbacksEncoding constants.EncodingType
 
 	for k, v := range executionBlob {
 		switch k {
@@ -187,6 +189,12 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) (*persistence.Int
 			activeClusterSelectionPolicy = v.([]byte)
 		case "active_cluster_selection_policy_encoding":
 			activeClusterSelectionPolicyEncoding = constants.EncodingType(v.(string))
+		case "completion_callbacks":
+			completionCallbacks = v.([]byte)
+		case "completion_callbacks_encoding":
+			completionCallbacksEncoding = constants.EncodingType(v.(string))
+		case "replication_excluded":
+			info.ReplicationExcluded = v.(bool)
 		case "cron_overlap_policy":
 			info.CronOverlapPolicy = types.CronOverlapPolicy(int32(v.(int)))
 		}
@@ -194,6 +202,7 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) (*persistence.Int
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
 	info.AutoResetPoints = persistence.NewDataBlob(autoResetPoints, autoResetPointsEncoding)
 	info.ActiveClusterSelectionPolicy = persistence.NewDataBlob(activeClusterSelectionPolicy, activeClusterSelectionPolicyEncoding)
+	info.CompletionCallbacks = persistence.NewDataBlob(completionCallbacks, completionCallbacksEncoding)
 
 	if nextEventID, ok := result["next_event_id"].(int64); ok {
 		info.NextEventID = nextEventID
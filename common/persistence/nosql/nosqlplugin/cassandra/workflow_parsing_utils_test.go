@@ -53,6 +53,7 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 	completionEventData := []byte("completion event data")
 	autoResetPointsData := []byte("auto reset points data")
 	activeClusterSelectionPolicyData := []byte("active cluster selection policy data")
+	completionCallbacksData := []byte(`[{"callback":{"url":"https://example.com/done"},"state":0}]`)
 	searchAttributes := map[string][]byte{"AttributeKey": []byte("AttributeValue")}
 	memo := map[string][]byte{"MemoKey": []byte("MemoValue")}
 	partitionConfig := map[string]string{"PartitionKey": "PartitionValue"}
@@ -125,6 +126,9 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 					"auto_reset_points_encoding":               "Proto3",
 					"active_cluster_selection_policy":          activeClusterSelectionPolicyData,
 					"active_cluster_selection_policy_encoding": "Proto3",
+					"completion_callbacks":                     completionCallbacksData,
+					"completion_callbacks_encoding":            "json",
+					"replication_excluded":                     true,
 				},
 				"next_event_id": int64(5),
 			},
@@ -180,6 +184,8 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				Memo:                               memo,
 				PartitionConfig:                    partitionConfig,
 				ActiveClusterSelectionPolicy:       persistence.NewDataBlob(activeClusterSelectionPolicyData, "Proto3"),
+				CompletionCallbacks:                persistence.NewDataBlob(completionCallbacksData, "json"),
+				ReplicationExcluded:                true,
 			},
 		},
 		{
@@ -284,6 +290,8 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 			assert.Equal(t, result.DecisionAttempt, tt.want.DecisionAttempt)
 			assert.Equal(t, result.ParentDomainID, tt.want.ParentDomainID)
 			assert.Equal(t, result.ActiveClusterSelectionPolicy, tt.want.ActiveClusterSelectionPolicy)
+			assert.Equal(t, result.CompletionCallbacks, tt.want.CompletionCallbacks)
+			assert.Equal(t, result.ReplicationExcluded, tt.want.ReplicationExcluded)
 		})
 	}
 }
@@ -1473,6 +1473,9 @@ func updateWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.CompletionCallbacks.GetData(),
+		execution.CompletionCallbacks.GetEncodingString(),
+		execution.ReplicationExcluded,
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1575,6 +1578,9 @@ func createWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.CompletionCallbacks.GetData(),
+		execution.CompletionCallbacks.GetEncodingString(),
+		execution.ReplicationExcluded,
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
@@ -23,6 +23,7 @@ package dynamodb
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/uber/cadence/common/persistence"
 )
@@ -34,3 +35,7 @@ func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfig
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	return nil, errors.New("TODO")
 }
+
+func (db *ddb) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	return nil, fmt.Errorf("SelectConfigVersions not implemented for DynamoDB")
+}
@@ -882,6 +882,21 @@ func (mr *MockDBMockRecorder) SelectAllWorkflowExecutions(ctx, shardID, pageToke
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAllWorkflowExecutions", reflect.TypeOf((*MockDB)(nil).SelectAllWorkflowExecutions), ctx, shardID, pageToken, pageSize)
 }
 
+// SelectConfigVersions mocks base method.
+func (m *MockDB) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConfigVersions", ctx, rowType, maxVersion, pageSize)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConfigVersions indicates an expected call of SelectConfigVersions.
+func (mr *MockDBMockRecorder) SelectConfigVersions(ctx, rowType, maxVersion, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConfigVersions", reflect.TypeOf((*MockDB)(nil).SelectConfigVersions), ctx, rowType, maxVersion, pageSize)
+}
+
 // SelectCurrentWorkflow mocks base method.
 func (m *MockDB) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*CurrentWorkflowRow, error) {
 	m.ctrl.T.Helper()
@@ -2118,6 +2133,21 @@ func (mr *MocktableCRUDMockRecorder) SelectAllWorkflowExecutions(ctx, shardID, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAllWorkflowExecutions", reflect.TypeOf((*MocktableCRUD)(nil).SelectAllWorkflowExecutions), ctx, shardID, pageToken, pageSize)
 }
 
+// SelectConfigVersions mocks base method.
+func (m *MocktableCRUD) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConfigVersions", ctx, rowType, maxVersion, pageSize)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConfigVersions indicates an expected call of SelectConfigVersions.
+func (mr *MocktableCRUDMockRecorder) SelectConfigVersions(ctx, rowType, maxVersion, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConfigVersions", reflect.TypeOf((*MocktableCRUD)(nil).SelectConfigVersions), ctx, rowType, maxVersion, pageSize)
+}
+
 // SelectCurrentWorkflow mocks base method.
 func (m *MocktableCRUD) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*CurrentWorkflowRow, error) {
 	m.ctrl.T.Helper()
@@ -3951,6 +3981,21 @@ func (mr *MockConfigStoreCRUDMockRecorder) InsertConfig(ctx, row any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertConfig", reflect.TypeOf((*MockConfigStoreCRUD)(nil).InsertConfig), ctx, row)
 }
 
+// SelectConfigVersions mocks base method.
+func (m *MockConfigStoreCRUD) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConfigVersions", ctx, rowType, maxVersion, pageSize)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConfigVersions indicates an expected call of SelectConfigVersions.
+func (mr *MockConfigStoreCRUDMockRecorder) SelectConfigVersions(ctx, rowType, maxVersion, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConfigVersions", reflect.TypeOf((*MockConfigStoreCRUD)(nil).SelectConfigVersions), ctx, rowType, maxVersion, pageSize)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockConfigStoreCRUD) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -42,6 +42,8 @@ func (db *mdb) InsertConfig(ctx context.Context, row *persistence.InternalConfig
 		UnixTimestampSeconds: row.Timestamp.Unix(),
 		Data:                 row.Values.Data,
 		DataEncoding:         row.Values.GetEncodingString(),
+		Author:               row.Author,
+		Reason:               row.Reason,
 	}
 	_, err := collection.InsertOne(ctx, doc)
 	if mongo.IsDuplicateKeyError(err) {
@@ -61,10 +63,41 @@ func (db *mdb) SelectLatestConfig(ctx context.Context, rowType int) (*persistenc
 	if err != nil {
 		return nil, err
 	}
+	return toConfigStoreEntry(&result), nil
+}
+
+func (db *mdb) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	filter := bson.D{{"rowtype", rowType}}
+	if maxVersion > 0 {
+		filter = append(filter, bson.E{"version", bson.D{{"$lte", maxVersion}}})
+	}
+	queryOptions := options.FindOptions{}
+	queryOptions.SetSort(bson.D{{"version", -1}})
+	queryOptions.SetLimit(int64(pageSize))
+
+	collection := db.dbConn.Collection(cadence.ClusterConfigCollectionName)
+	cursor, err := collection.Find(ctx, filter, &queryOptions)
+	if err != nil {
+		return nil, err
+	}
+	var results []cadence.ClusterConfigCollectionEntry
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	entries := make([]*persistence.InternalConfigStoreEntry, 0, len(results))
+	for i := range results {
+		entries = append(entries, toConfigStoreEntry(&results[i]))
+	}
+	return entries, nil
+}
+
+func toConfigStoreEntry(result *cadence.ClusterConfigCollectionEntry) *persistence.InternalConfigStoreEntry {
 	return &persistence.InternalConfigStoreEntry{
-		RowType:   rowType,
+		RowType:   result.RowType,
 		Version:   result.Version,
 		Timestamp: time.Unix(result.UnixTimestampSeconds, 0),
 		Values:    persistence.NewDataBlob(result.Data, constants.EncodingType(result.DataEncoding)),
-	}, nil
+		Author:    result.Author,
+		Reason:    result.Reason,
+	}
 }
@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"os"
 	"testing"
@@ -132,6 +133,33 @@ func (s *ConfigStorePersistenceSuite) TestFetchLatestVersionSuccess() {
 	s.Equal(int64(3), snapshot.Version)
 }
 
+func (s *ConfigStorePersistenceSuite) TestListVersionsSuccess() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	for version := int64(1); version <= 3; version++ {
+		snapshot := generateRandomSnapshot(version)
+		snapshot.Author = "author"
+		snapshot.Reason = fmt.Sprintf("reason %v", version)
+		err := s.UpdateDynamicConfig(ctx, snapshot, 5)
+		s.Nil(err)
+	}
+
+	response, err := s.ConfigStoreManager.ListDynamicConfigVersions(ctx, &p.ListDynamicConfigVersionsRequest{PageSize: 2}, p.ConfigType(5))
+	s.Nil(err)
+	s.Len(response.Snapshots, 2)
+	s.Equal(int64(3), response.Snapshots[0].Version)
+	s.Equal("author", response.Snapshots[0].Author)
+	s.Equal("reason 3", response.Snapshots[0].Reason)
+	s.Equal(int64(2), response.Snapshots[1].Version)
+
+	response, err = s.ConfigStoreManager.ListDynamicConfigVersions(ctx, &p.ListDynamicConfigVersionsRequest{MaxVersion: 1, PageSize: 2}, p.ConfigType(5))
+	s.Nil(err)
+	s.Len(response.Snapshots, 1)
+	s.Equal(int64(1), response.Snapshots[0].Version)
+	s.Equal("reason 1", response.Snapshots[0].Reason)
+}
+
 func generateRandomSnapshot(version int64) *p.DynamicConfigSnapshot {
 	data, _ := json.Marshal("test_value")
 
@@ -157,6 +157,11 @@ func (s *taskSerializerImpl) serializeTransferTask(task persistence.Task) (persi
 		info.WorkflowID = t.WorkflowID
 		info.RunID = MustParseUUID(t.RunID)
 		info.TaskList = t.TaskList
+	case *persistence.CompletionCallbackTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
+		info.TaskList = t.TaskList
 	case *persistence.ResetWorkflowTask:
 		info.DomainID = MustParseUUID(t.DomainID)
 		info.WorkflowID = t.WorkflowID
@@ -270,6 +275,12 @@ func (s *taskSerializerImpl) deserializeTransferTask(blob *persistence.DataBlob)
 			TaskData:           taskData,
 			TaskList:           info.GetTaskList(),
 		}
+	case persistence.TransferTaskTypeCompletionCallback:
+		task = &persistence.CompletionCallbackTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           info.GetTaskList(),
+		}
 	case persistence.TransferTaskTypeRecordChildExecutionCompleted:
 		task = &persistence.RecordChildExecutionCompletedTask{
 			WorkflowIdentifier: workflowIdentifier,
@@ -327,6 +338,11 @@ func (s *taskSerializerImpl) serializeTimerTask(task persistence.Task) (persiste
 		info.RunID = MustParseUUID(t.RunID)
 		info.TimeoutType = common.Int16Ptr(int16(t.TimeoutType))
 		info.TaskList = t.TaskList
+	case *persistence.CompletionCallbackRetryTimerTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
+		info.TaskList = t.TaskList
 	case *persistence.WorkflowTimeoutTask:
 		info.DomainID = MustParseUUID(t.DomainID)
 		info.WorkflowID = t.WorkflowID
@@ -412,6 +428,12 @@ func (s *taskSerializerImpl) deserializeTimerTask(blob *persistence.DataBlob) (p
 			TimeoutType:        int(info.GetTimeoutType()),
 			TaskList:           info.GetTaskList(),
 		}
+	case persistence.TaskTypeCompletionCallbackRetryTimer:
+		task = &persistence.CompletionCallbackRetryTimerTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+			TaskList:           info.GetTaskList(),
+		}
 	default:
 		return nil, fmt.Errorf("unknown timer task type: %v", info.GetTaskType())
 	}
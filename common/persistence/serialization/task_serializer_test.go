@@ -176,6 +176,18 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 				},
 			},
 		},
+		{
+			category: persistence.HistoryTaskCategoryTransfer,
+			task: &persistence.CompletionCallbackTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             9,
+					TaskID:              9,
+					VisibilityTimestamp: time.Unix(9, 9),
+				},
+				TaskList: "test-tl",
+			},
+		},
 		{
 			category: persistence.HistoryTaskCategoryTransfer,
 			task: &persistence.RecordChildExecutionCompletedTask{
@@ -277,6 +289,17 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 				TimeoutType: 17,
 			},
 		},
+		{
+			category: persistence.HistoryTaskCategoryTimer,
+			task: &persistence.CompletionCallbackRetryTimerTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             21,
+					TaskID:              21,
+					VisibilityTimestamp: time.Unix(21, 21),
+				},
+			},
+		},
 		{
 			category: persistence.HistoryTaskCategoryReplication,
 			task: &persistence.HistoryReplicationTask{
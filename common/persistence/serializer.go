@@ -99,6 +99,10 @@ type (
 		SerializeActiveClusterSelectionPolicy(policy *types.ActiveClusterSelectionPolicy, encodingType constants.EncodingType) (*DataBlob, error)
 		DeserializeActiveClusterSelectionPolicy(data *DataBlob) (*types.ActiveClusterSelectionPolicy, error)
 
+		// serialize/deserialize workflow completion callbacks, always JSON encoded
+		SerializeCompletionCallbacks(callbacks []*types.CompletionCallbackInfo) (*DataBlob, error)
+		DeserializeCompletionCallbacks(data *DataBlob) ([]*types.CompletionCallbackInfo, error)
+
 		// serialize/deserialize full replication task payload for DLQ storage
 		SerializeReplicationDLQTask(task *types.ReplicationTask, encodingType constants.EncodingType) (*DataBlob, error)
 		DeserializeReplicationDLQTask(data *DataBlob) (*types.ReplicationTask, error)
@@ -367,6 +371,10 @@ func (t *serializerImpl) SerializeActiveClusterSelectionPolicy(policy *types.Act
 	if policy == nil {
 		return nil, nil
 	}
+	if policy.WorkflowIDHash != nil {
+		// the thrift representation has no workflow ID hash yet, so such policies are stored as JSON
+		encodingType = constants.EncodingTypeJSON
+	}
 	return t.serialize(policy, encodingType)
 }
 
@@ -380,6 +388,24 @@ func (t *serializerImpl) DeserializeActiveClusterSelectionPolicy(data *DataBlob)
 	return &policy, err
 }
 
+func (t *serializerImpl) SerializeCompletionCallbacks(callbacks []*types.CompletionCallbackInfo) (*DataBlob, error) {
+	if len(callbacks) == 0 {
+		return nil, nil
+	}
+	// completion callbacks have no thrift representation yet, so they are always stored as JSON
+	return t.serialize(callbacks, constants.EncodingTypeJSON)
+}
+
+func (t *serializerImpl) DeserializeCompletionCallbacks(data *DataBlob) ([]*types.CompletionCallbackInfo, error) {
+	if data == nil || len(data.Data) == 0 {
+		return nil, nil
+	}
+
+	var callbacks []*types.CompletionCallbackInfo
+	err := t.deserialize(data, &callbacks)
+	return callbacks, err
+}
+
 func (t *serializerImpl) SerializeReplicationDLQTask(task *types.ReplicationTask, encodingType constants.EncodingType) (*DataBlob, error) {
 	if task == nil {
 		return nil, nil
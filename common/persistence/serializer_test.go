@@ -319,6 +319,22 @@ func TestSerializers(t *testing.T) {
 	}
 }
 
+func TestSerializeActiveClusterSelectionPolicyWithWorkflowIDHash(t *testing.T) {
+	serializer := NewPayloadSerializer()
+	policy := &types.ActiveClusterSelectionPolicy{
+		ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "region1"},
+		WorkflowIDHash:   &types.WorkflowIDHashPolicy{Scope: "region"},
+	}
+
+	blob, err := serializer.SerializeActiveClusterSelectionPolicy(policy, constants.EncodingTypeThriftRW)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.EncodingTypeJSON, blob.Encoding)
+
+	deserialized, err := serializer.DeserializeActiveClusterSelectionPolicy(blob)
+	assert.NoError(t, err)
+	assert.Equal(t, policy, deserialized)
+}
+
 func TestDataBlob_GetData(t *testing.T) {
 	tests := map[string]struct {
 		in          *DataBlob
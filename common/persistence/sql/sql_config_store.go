@@ -74,3 +74,11 @@ func (m *sqlConfigStore) UpdateConfig(ctx context.Context, value *persistence.In
 	}
 	return nil
 }
+
+func (m *sqlConfigStore) FetchConfigVersions(ctx context.Context, configType persistence.ConfigType, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	entries, err := m.db.SelectConfigVersions(ctx, int(configType), maxVersion, pageSize)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "FetchConfigVersions", "", err)
+	}
+	return entries, nil
+}
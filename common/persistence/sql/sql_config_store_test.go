@@ -175,3 +175,50 @@ func TestUpdateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchConfigVersions(t *testing.T) {
+	entries := []*persistence.InternalConfigStoreEntry{{Version: 2}, {Version: 1}}
+	testCases := []struct {
+		name      string
+		mockSetup func(*sqlplugin.MockDB)
+		want      []*persistence.InternalConfigStoreEntry
+		wantErr   bool
+	}{
+		{
+			name: "Success case",
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().SelectConfigVersions(gomock.Any(), int(persistence.DynamicConfig), int64(2), 10).Return(entries, nil)
+			},
+			want: entries,
+		},
+		{
+			name: "Database error",
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				err := errors.New("db error")
+				mockDB.EXPECT().SelectConfigVersions(gomock.Any(), int(persistence.DynamicConfig), int64(2), 10).Return(nil, err)
+				mockDB.EXPECT().IsNotFoundError(err).Return(false)
+				mockDB.EXPECT().IsTimeoutError(err).Return(true)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockDB := sqlplugin.NewMockDB(ctrl)
+			store, err := NewSQLConfigStore(mockDB, nil, nil)
+			require.NoError(t, err, "Failed to create sql config store")
+
+			tc.mockSetup(mockDB)
+			got, err := store.FetchConfigVersions(context.Background(), persistence.DynamicConfig, 2, 10)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
@@ -1006,6 +1006,15 @@ func buildExecutionRow(
 	parser serialization.Parser,
 ) (row *sqlplugin.ExecutionsRow, err error) {
 
+	// the sqlblobs execution info has no field for the callbacks, reject them instead of dropping them
+	if executionInfo.CompletionCallbacks != nil {
+		return nil, &types.BadRequestError{Message: "completion callbacks are not supported by the SQL persistence store"}
+	}
+	// same for the replication exclusion, dropping it would replicate the workflow after the next load
+	if executionInfo.ReplicationExcluded {
+		return nil, &types.BadRequestError{Message: "replication filters are not supported by the SQL persistence store"}
+	}
+
 	info := serialization.FromInternalWorkflowExecutionInfo(executionInfo)
 
 	info.StartVersion = startVersion
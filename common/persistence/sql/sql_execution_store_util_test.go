@@ -863,6 +863,48 @@ func TestCreateExecution(t *testing.T) {
 				assert.True(t, errors.As(err, &expectedErr), "Expected the error to be WorkflowExecutionAlreadyStartedError")
 			},
 		},
+		{
+			name: "Error case - completion callbacks",
+			workflow: &persistence.InternalWorkflowSnapshot{
+				ExecutionInfo: &persistence.InternalWorkflowExecutionInfo{
+					DomainID:            "8be8a310-7d20-483e-a5d2-48659dc47602",
+					WorkflowID:          "abc",
+					RunID:               "8be8a310-7d20-483e-a5d2-48659dc47603",
+					NextEventID:         9,
+					CompletionCallbacks: &persistence.DataBlob{Data: []byte(`[]`), Encoding: constants.EncodingTypeJSON},
+				},
+				VersionHistories: &persistence.DataBlob{},
+				StartVersion:     1,
+				LastWriteVersion: 2,
+			},
+			mockSetup: func(mockTx *sqlplugin.MockTx, mockParser *serialization.MockParser) {},
+			wantErr:   true,
+			assertErr: func(t *testing.T, err error) {
+				var expectedErr *types.BadRequestError
+				assert.True(t, errors.As(err, &expectedErr), "Expected the error to be BadRequestError")
+			},
+		},
+		{
+			name: "Error case - replication excluded",
+			workflow: &persistence.InternalWorkflowSnapshot{
+				ExecutionInfo: &persistence.InternalWorkflowExecutionInfo{
+					DomainID:            "8be8a310-7d20-483e-a5d2-48659dc47602",
+					WorkflowID:          "abc",
+					RunID:               "8be8a310-7d20-483e-a5d2-48659dc47603",
+					NextEventID:         9,
+					ReplicationExcluded: true,
+				},
+				VersionHistories: &persistence.DataBlob{},
+				StartVersion:     1,
+				LastWriteVersion: 2,
+			},
+			mockSetup: func(mockTx *sqlplugin.MockTx, mockParser *serialization.MockParser) {},
+			wantErr:   true,
+			assertErr: func(t *testing.T, err error) {
+				var expectedErr *types.BadRequestError
+				assert.True(t, errors.As(err, &expectedErr), "Expected the error to be BadRequestError")
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,103 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqldriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	// BuildDSN returns the data source name to connect to the database of cfg
+	BuildDSN func(cfg *config.SQL) (string, error)
+
+	// secretConnector opens each connection with a freshly built DSN, so that the connections opened
+	// after a secret rotation use the new password
+	secretConnector struct {
+		driver   driver.Driver
+		buildDSN func() (string, error)
+	}
+)
+
+// Connect opens a connection pool to the database of cfg and verifies it with a ping, like sqlx.Connect.
+// If the password of cfg is a secret reference, it is resolved again for every new connection of the pool.
+func Connect(driverName string, cfg *config.SQL, buildDSN BuildDSN) (*sqlx.DB, error) {
+	// cfg is copied because CreateDBConnections reuses it for every database
+	cfgCopy := *cfg
+	dsn := func() (string, error) {
+		password, err := config.ResolveSecret(cfgCopy.Password)
+		if err != nil {
+			return "", err
+		}
+		resolved := cfgCopy
+		resolved.Password = password
+		return buildDSN(&resolved)
+	}
+
+	firstDSN, err := dsn()
+	if err != nil {
+		return nil, err
+	}
+	if !config.IsSecretReference(cfg.Password) {
+		return sqlx.Connect(driverName, firstDSN)
+	}
+
+	// sql.Open only looks up the driver registered with the name, it doesn't connect
+	db, err := sql.Open(driverName, firstDSN)
+	if err != nil {
+		return nil, err
+	}
+	drv := db.Driver()
+	if err := db.Close(); err != nil {
+		return nil, err
+	}
+
+	xdb := sqlx.NewDb(sql.OpenDB(&secretConnector{driver: drv, buildDSN: dsn}), driverName)
+	if err := xdb.Ping(); err != nil {
+		xdb.Close()
+		return nil, err
+	}
+	return xdb, nil
+}
+
+func (c *secretConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	dsn, err := c.buildDSN()
+	if err != nil {
+		return nil, err
+	}
+	if driverCtx, ok := c.driver.(driver.DriverContext); ok {
+		connector, err := driverCtx.OpenConnector(dsn)
+		if err != nil {
+			return nil, err
+		}
+		return connector.Connect(ctx)
+	}
+	return c.driver.Open(dsn)
+}
+
+func (c *secretConnector) Driver() driver.Driver {
+	return c.driver
+}
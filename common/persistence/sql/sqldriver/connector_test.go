@@ -0,0 +1,112 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqldriver
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+)
+
+const testDriverName = "sqldriver-test"
+
+type (
+	testDriver struct {
+		sync.Mutex
+		dsns []string
+	}
+
+	testConn struct{}
+)
+
+var _testDriver = &testDriver{}
+
+func init() {
+	sql.Register(testDriverName, _testDriver)
+}
+
+func TestConnect(t *testing.T) {
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("secret1"), 0644))
+	buildDSN := func(cfg *config.SQL) (string, error) {
+		return cfg.User + ":" + cfg.Password, nil
+	}
+
+	db, err := Connect(testDriverName, &config.SQL{User: "cadence", Password: "plain"}, buildDSN)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	assert.Equal(t, []string{"cadence:plain"}, _testDriver.popDSNs())
+
+	cfg := &config.SQL{User: "cadence", Password: "file://" + passwordFile}
+	db, err = Connect(testDriverName, cfg, buildDSN)
+	require.NoError(t, err)
+	defer db.Close()
+	assert.Equal(t, []string{"cadence:secret1"}, _testDriver.popDSNs())
+
+	// the connection is closed instead of returned to the pool, so the next ping opens a new one
+	db.SetMaxIdleConns(0)
+	require.NoError(t, os.WriteFile(passwordFile, []byte("secret2"), 0644))
+	cfg.Password = ""
+	require.NoError(t, db.Ping())
+	assert.Equal(t, []string{"cadence:secret2"}, _testDriver.popDSNs())
+
+	require.NoError(t, os.Remove(passwordFile))
+	assert.Error(t, db.Ping())
+	_, err = Connect(testDriverName, &config.SQL{Password: "file://" + passwordFile}, buildDSN)
+	assert.Error(t, err)
+}
+
+func (d *testDriver) Open(dsn string) (driver.Conn, error) {
+	d.Lock()
+	defer d.Unlock()
+	d.dsns = append(d.dsns, dsn)
+	return testConn{}, nil
+}
+
+func (d *testDriver) popDSNs() []string {
+	d.Lock()
+	defer d.Unlock()
+	dsns := d.dsns
+	d.dsns = nil
+	return dsns
+}
+
+func (testConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (testConn) Close() error {
+	return nil
+}
+
+func (testConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not implemented")
+}
@@ -178,12 +178,10 @@ func getDialOptions(cfg *config.SQL) (cloudsqlconn.DialOption, error) {
 }
 
 func createSingleDBConn(cfg *config.SQL, driverName string) (*sqlx.DB, error) {
-	dsn, err := buildDSN(cfg, driverName)
-	if err != nil {
-		return nil, err
-	}
 	// Can use either mysql or the DriverName, since the DSN also encodes the DriverName
-	db, err := sqlx.Connect(driverName, dsn)
+	db, err := sqldriver.Connect(driverName, cfg, func(cfg *config.SQL) (string, error) {
+		return buildDSN(cfg, driverName)
+	})
 	if err != nil {
 		return nil, err
 	}
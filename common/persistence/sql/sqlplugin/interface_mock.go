@@ -1206,6 +1206,21 @@ func (mr *MocktableCRUDMockRecorder) ReplaceIntoVisibility(ctx, row any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoVisibility", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoVisibility), ctx, row)
 }
 
+// SelectConfigVersions mocks base method.
+func (m *MocktableCRUD) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConfigVersions", ctx, rowType, maxVersion, pageSize)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConfigVersions indicates an expected call of SelectConfigVersions.
+func (mr *MocktableCRUDMockRecorder) SelectConfigVersions(ctx, rowType, maxVersion, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConfigVersions", reflect.TypeOf((*MocktableCRUD)(nil).SelectConfigVersions), ctx, rowType, maxVersion, pageSize)
+}
+
 // SelectFromActiveClusterSelectionPolicy mocks base method.
 func (m *MocktableCRUD) SelectFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (*ActiveClusterSelectionPolicyRow, error) {
 	m.ctrl.T.Helper()
@@ -3174,6 +3189,21 @@ func (mr *MockTxMockRecorder) Rollback() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTx)(nil).Rollback))
 }
 
+// SelectConfigVersions mocks base method.
+func (m *MockTx) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConfigVersions", ctx, rowType, maxVersion, pageSize)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConfigVersions indicates an expected call of SelectConfigVersions.
+func (mr *MockTxMockRecorder) SelectConfigVersions(ctx, rowType, maxVersion, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConfigVersions", reflect.TypeOf((*MockTx)(nil).SelectConfigVersions), ctx, rowType, maxVersion, pageSize)
+}
+
 // SelectFromActiveClusterSelectionPolicy mocks base method.
 func (m *MockTx) SelectFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (*ActiveClusterSelectionPolicyRow, error) {
 	m.ctrl.T.Helper()
@@ -4970,6 +5000,21 @@ func (mr *MockDBMockRecorder) ReplaceIntoVisibility(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoVisibility", reflect.TypeOf((*MockDB)(nil).ReplaceIntoVisibility), ctx, row)
 }
 
+// SelectConfigVersions mocks base method.
+func (m *MockDB) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectConfigVersions", ctx, rowType, maxVersion, pageSize)
+	ret0, _ := ret[0].([]*persistence.InternalConfigStoreEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectConfigVersions indicates an expected call of SelectConfigVersions.
+func (mr *MockDBMockRecorder) SelectConfigVersions(ctx, rowType, maxVersion, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectConfigVersions", reflect.TypeOf((*MockDB)(nil).SelectConfigVersions), ctx, rowType, maxVersion, pageSize)
+}
+
 // SelectFromActiveClusterSelectionPolicy mocks base method.
 func (m *MockDB) SelectFromActiveClusterSelectionPolicy(ctx context.Context, filter *ActiveClusterSelectionPolicyFilter) (*ActiveClusterSelectionPolicyRow, error) {
 	m.ctrl.T.Helper()
@@ -603,6 +603,8 @@ type (
 		Timestamp    time.Time
 		Data         []byte
 		DataEncoding string
+		Author       string
+		Reason       string
 	}
 
 	// DomainAuditLogRow represents a row in domain_audit_log table
@@ -876,6 +878,9 @@ type (
 		InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error
 		// SelectLatestConfig returns the config entry of the row_type with the largest(latest) version value
 		SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error)
+		// SelectConfigVersions returns up to pageSize config entries of the row_type with a version not greater than maxVersion, latest first.
+		// A non-positive maxVersion starts from the latest version
+		SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error)
 
 		// InsertDomainAuditLog inserts a new audit log entry for a domain operation. Returns error if there is any failure
 		InsertIntoDomainAuditLog(ctx context.Context, row *DomainAuditLogRow) (sql.Result, error)
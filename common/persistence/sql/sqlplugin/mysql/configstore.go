@@ -24,6 +24,7 @@ package mysql
 
 import (
 	"context"
+	"math"
 
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
@@ -31,7 +32,7 @@ import (
 )
 
 func (mdb *DB) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	_, err := mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertConfigQuery, row.RowType, -1*row.Version, mdb.converter.ToDateTime(row.Timestamp), row.Values.Data, row.Values.Encoding)
+	_, err := mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertConfigQuery, row.RowType, -1*row.Version, mdb.converter.ToDateTime(row.Timestamp), row.Values.Data, row.Values.Encoding, row.Author, row.Reason)
 	return err
 }
 
@@ -41,14 +42,35 @@ func (mdb *DB) SelectLatestConfig(ctx context.Context, rowType int) (*persistenc
 	if err != nil {
 		return nil, err
 	}
-	row.Version *= -1
+	return mdb.toConfigStoreEntry(&row), nil
+}
+
+func (mdb *DB) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	if maxVersion <= 0 {
+		maxVersion = math.MaxInt64
+	}
+	var rows []sqlplugin.ClusterConfigRow
+	err := mdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, _selectConfigVersionsQuery, rowType, -1*maxVersion, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*persistence.InternalConfigStoreEntry, 0, len(rows))
+	for i := range rows {
+		entries = append(entries, mdb.toConfigStoreEntry(&rows[i]))
+	}
+	return entries, nil
+}
+
+func (mdb *DB) toConfigStoreEntry(row *sqlplugin.ClusterConfigRow) *persistence.InternalConfigStoreEntry {
 	return &persistence.InternalConfigStoreEntry{
 		RowType:   row.RowType,
-		Version:   row.Version,
+		Version:   -1 * row.Version,
 		Timestamp: mdb.converter.FromDateTime(row.Timestamp),
 		Values: &persistence.DataBlob{
 			Data:     row.Data,
 			Encoding: constants.EncodingType(row.DataEncoding),
 		},
-	}, nil
+		Author: row.Author,
+		Reason: row.Reason,
+	}
 }
@@ -23,7 +23,10 @@
 package mysql
 
 const (
-	_selectLatestConfigQuery = "SELECT row_type, version, timestamp, data, data_encoding FROM cluster_config WHERE row_type = ? ORDER BY version LIMIT 1;"
+	_selectLatestConfigQuery = "SELECT row_type, version, timestamp, data, data_encoding, author, reason FROM cluster_config WHERE row_type = ? ORDER BY version LIMIT 1;"
 
-	_insertConfigQuery = "INSERT INTO cluster_config (row_type, version, timestamp, data, data_encoding) VALUES(?, ?, ?, ?, ?)"
+	// versions are stored negated, so version >= -maxVersion selects the versions up to maxVersion, latest first
+	_selectConfigVersionsQuery = "SELECT row_type, version, timestamp, data, data_encoding, author, reason FROM cluster_config WHERE row_type = ? AND version >= ? ORDER BY version LIMIT ?;"
+
+	_insertConfigQuery = "INSERT INTO cluster_config (row_type, version, timestamp, data, data_encoding, author, reason) VALUES(?, ?, ?, ?, ?, ?, ?)"
 )
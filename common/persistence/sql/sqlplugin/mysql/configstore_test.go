@@ -25,6 +25,7 @@ package mysql
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
 	"time"
 
@@ -50,7 +51,7 @@ func TestInsertConfig(t *testing.T) {
 				Values: &persistence.DataBlob{},
 			},
 			mockSetup: func(mockDriver *sqldriver.MockDriver) {
-				mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _insertConfigQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _insertConfigQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 			},
 			expectError: false,
 		},
@@ -60,7 +61,7 @@ func TestInsertConfig(t *testing.T) {
 				Values: &persistence.DataBlob{},
 			},
 			mockSetup: func(mockDriver *sqldriver.MockDriver) {
-				mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _insertConfigQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+				mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _insertConfigQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
 			},
 			expectError: true,
 		},
@@ -106,6 +107,8 @@ func TestSelectLatestConfig(t *testing.T) {
 					Timestamp:    now,
 					Data:         []byte("test data"),
 					DataEncoding: "json",
+					Author:       "alice",
+					Reason:       "raise limits",
 				}
 				md.EXPECT().GetContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _selectLatestConfigQuery, 1).DoAndReturn(
 					func(ctx context.Context, shardID int, r *sqlplugin.ClusterConfigRow, query string, args ...interface{}) error {
@@ -123,6 +126,8 @@ func TestSelectLatestConfig(t *testing.T) {
 					Data:     []byte("test data"),
 					Encoding: constants.EncodingType("json"),
 				},
+				Author: "alice",
+				Reason: "raise limits",
 			},
 		},
 		{
@@ -155,3 +160,73 @@ func TestSelectLatestConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectConfigVersions(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name            string
+		maxVersion      int64
+		expectedVersion int64
+		setupMock       func(*sqldriver.MockDriver, int64)
+		expectError     bool
+		expectedRows    []*persistence.InternalConfigStoreEntry
+	}{
+		{
+			name:            "Success case",
+			maxVersion:      3,
+			expectedVersion: -3,
+			setupMock: func(md *sqldriver.MockDriver, version int64) {
+				md.EXPECT().SelectContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _selectConfigVersionsQuery, 1, version, 2).DoAndReturn(
+					func(ctx context.Context, shardID int, r *[]sqlplugin.ClusterConfigRow, query string, args ...interface{}) error {
+						*r = []sqlplugin.ClusterConfigRow{
+							{RowType: 1, Version: -3, Timestamp: now, Data: []byte("v3"), DataEncoding: "json", Author: "alice", Reason: "rollback"},
+							{RowType: 1, Version: -2, Timestamp: now, Data: []byte("v2"), DataEncoding: "json"},
+						}
+						return nil
+					},
+				)
+			},
+			expectedRows: []*persistence.InternalConfigStoreEntry{
+				{RowType: 1, Version: 3, Timestamp: now, Values: &persistence.DataBlob{Data: []byte("v3"), Encoding: "json"}, Author: "alice", Reason: "rollback"},
+				{RowType: 1, Version: 2, Timestamp: now, Values: &persistence.DataBlob{Data: []byte("v2"), Encoding: "json"}},
+			},
+		},
+		{
+			name:            "Starts from the latest version",
+			maxVersion:      0,
+			expectedVersion: -math.MaxInt64,
+			setupMock: func(md *sqldriver.MockDriver, version int64) {
+				md.EXPECT().SelectContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _selectConfigVersionsQuery, 1, version, 2).Return(nil)
+			},
+			expectedRows: []*persistence.InternalConfigStoreEntry{},
+		},
+		{
+			name:            "Error case",
+			maxVersion:      3,
+			expectedVersion: -3,
+			setupMock: func(md *sqldriver.MockDriver, version int64) {
+				md.EXPECT().SelectContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _selectConfigVersionsQuery, 1, version, 2).Return(errors.New("some error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockDriver := sqldriver.NewMockDriver(ctrl)
+			mdb := &DB{driver: mockDriver, converter: &converter{}}
+
+			tc.setupMock(mockDriver, tc.expectedVersion)
+
+			rows, err := mdb.SelectConfigVersions(context.Background(), 1, tc.maxVersion, 2)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRows, rows)
+			}
+		})
+	}
+}
@@ -104,7 +104,9 @@ func (p *plugin) createSingleDBConn(cfg *config.SQL) (*sqlx.DB, error) {
 		return nil, err
 	}
 
-	db, err := sqlx.Connect(PluginName, buildDSN(cfg))
+	db, err := sqldriver.Connect(PluginName, cfg, func(cfg *config.SQL) (string, error) {
+		return buildDSN(cfg), nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -24,6 +24,7 @@ package postgres
 
 import (
 	"context"
+	"math"
 
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
@@ -31,13 +32,16 @@ import (
 )
 
 const (
-	_selectLatestConfigQuery = "SELECT row_type, version, timestamp, data, data_encoding FROM cluster_config WHERE row_type = $1 ORDER BY version LIMIT 1;"
+	_selectLatestConfigQuery = "SELECT row_type, version, timestamp, data, data_encoding, author, reason FROM cluster_config WHERE row_type = $1 ORDER BY version LIMIT 1;"
 
-	_insertConfigQuery = "INSERT INTO cluster_config (row_type, version, timestamp, data, data_encoding) VALUES($1, $2, $3, $4, $5)"
+	// versions are stored negated, so version >= -maxVersion selects the versions up to maxVersion, latest first
+	_selectConfigVersionsQuery = "SELECT row_type, version, timestamp, data, data_encoding, author, reason FROM cluster_config WHERE row_type = $1 AND version >= $2 ORDER BY version LIMIT $3;"
+
+	_insertConfigQuery = "INSERT INTO cluster_config (row_type, version, timestamp, data, data_encoding, author, reason) VALUES($1, $2, $3, $4, $5, $6, $7)"
 )
 
 func (pdb *db) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	_, err := pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertConfigQuery, row.RowType, -1*row.Version, pdb.converter.ToPostgresDateTime(row.Timestamp), row.Values.Data, row.Values.Encoding)
+	_, err := pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertConfigQuery, row.RowType, -1*row.Version, pdb.converter.ToPostgresDateTime(row.Timestamp), row.Values.Data, row.Values.Encoding, row.Author, row.Reason)
 	return err
 }
 
@@ -47,14 +51,35 @@ func (pdb *db) SelectLatestConfig(ctx context.Context, rowType int) (*persistenc
 	if err != nil {
 		return nil, err
 	}
-	row.Version *= -1
+	return pdb.toConfigStoreEntry(&row), nil
+}
+
+func (pdb *db) SelectConfigVersions(ctx context.Context, rowType int, maxVersion int64, pageSize int) ([]*persistence.InternalConfigStoreEntry, error) {
+	if maxVersion <= 0 {
+		maxVersion = math.MaxInt64
+	}
+	var rows []sqlplugin.ClusterConfigRow
+	err := pdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, _selectConfigVersionsQuery, rowType, -1*maxVersion, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*persistence.InternalConfigStoreEntry, 0, len(rows))
+	for i := range rows {
+		entries = append(entries, pdb.toConfigStoreEntry(&rows[i]))
+	}
+	return entries, nil
+}
+
+func (pdb *db) toConfigStoreEntry(row *sqlplugin.ClusterConfigRow) *persistence.InternalConfigStoreEntry {
 	return &persistence.InternalConfigStoreEntry{
 		RowType:   row.RowType,
-		Version:   row.Version,
+		Version:   -1 * row.Version,
 		Timestamp: pdb.converter.FromPostgresDateTime(row.Timestamp),
 		Values: &persistence.DataBlob{
 			Data:     row.Data,
 			Encoding: constants.EncodingType(row.DataEncoding),
 		},
-	}, nil
+		Author: row.Author,
+		Reason: row.Reason,
+	}
 }
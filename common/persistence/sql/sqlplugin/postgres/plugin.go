@@ -100,7 +100,9 @@ func (d *plugin) createSingleDBConn(cfg *config.SQL) (*sqlx.DB, error) {
 		return nil, fmt.Errorf("invalid connect address, it must be in host:port format, %v, err: %v", cfg.ConnectAddr, err)
 	}
 
-	db, err := sqlx.Connect(PluginName, buildDSN(cfg, host, port, params))
+	db, err := sqldriver.Connect(PluginName, cfg, func(cfg *config.SQL) (string, error) {
+		return buildDSN(cfg, host, port, params), nil
+	})
 	if err != nil {
 		return nil, err
 	}
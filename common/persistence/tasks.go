@@ -211,6 +211,13 @@ type (
 		TaskList string
 	}
 
+	// CompletionCallbackTask identifies a transfer task for notifying the completion callbacks of a closed workflow
+	CompletionCallbackTask struct {
+		WorkflowIdentifier
+		TaskData
+		TaskList string
+	}
+
 	// RecordChildExecutionCompletedTask identifies a task for recording the competion of a child workflow
 	RecordChildExecutionCompletedTask struct {
 		WorkflowIdentifier
@@ -256,6 +263,13 @@ type (
 		TaskList    string
 	}
 
+	// CompletionCallbackRetryTimerTask to retry the completion callbacks of a closed workflow
+	CompletionCallbackRetryTimerTask struct {
+		WorkflowIdentifier
+		TaskData
+		TaskList string
+	}
+
 	// HistoryReplicationTask is the replication task created for shipping history replication events to other clusters
 	HistoryReplicationTask struct {
 		WorkflowIdentifier
@@ -296,10 +310,12 @@ var (
 	_ Task = (*UpsertWorkflowSearchAttributesTask)(nil)
 	_ Task = (*StartChildExecutionTask)(nil)
 	_ Task = (*RecordWorkflowClosedTask)(nil)
+	_ Task = (*CompletionCallbackTask)(nil)
 	_ Task = (*ActivityTimeoutTask)(nil)
 	_ Task = (*UserTimerTask)(nil)
 	_ Task = (*ActivityRetryTimerTask)(nil)
 	_ Task = (*WorkflowBackoffTimerTask)(nil)
+	_ Task = (*CompletionCallbackRetryTimerTask)(nil)
 	_ Task = (*HistoryReplicationTask)(nil)
 	_ Task = (*SyncActivityTask)(nil)
 	_ Task = (*FailoverMarkerTask)(nil)
@@ -1030,6 +1046,56 @@ func (r *WorkflowBackoffTimerTask) ToInternalReplicationTaskInfo() (*types.Repli
 	return nil, fmt.Errorf("workflow backoff timer task is not replication task")
 }
 
+// GetType returns the type of the completion callback retry timer task
+func (r *CompletionCallbackRetryTimerTask) GetTaskType() int {
+	return TaskTypeCompletionCallbackRetryTimer
+}
+
+func (r *CompletionCallbackRetryTimerTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryTimer
+}
+
+func (r *CompletionCallbackRetryTimerTask) GetTaskKey() HistoryTaskKey {
+	return NewHistoryTaskKey(r.VisibilityTimestamp, r.TaskID)
+}
+
+func (r *CompletionCallbackRetryTimerTask) GetTaskList() string {
+	return r.TaskList
+}
+
+func (r *CompletionCallbackRetryTimerTask) GetOriginalTaskList() string {
+	return r.TaskList
+}
+
+func (r *CompletionCallbackRetryTimerTask) GetOriginalTaskListKind() types.TaskListKind {
+	return types.TaskListKindNormal
+}
+
+func (r *CompletionCallbackRetryTimerTask) ByteSize() uint64 {
+	return r.WorkflowIdentifier.ByteSize() + r.TaskData.ByteSize() + uint64(len(r.TaskList))
+}
+
+func (r *CompletionCallbackRetryTimerTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return nil, fmt.Errorf("completion callback retry timer task is not transfer task")
+}
+
+func (r *CompletionCallbackRetryTimerTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return &TimerTaskInfo{
+		TaskType:            TaskTypeCompletionCallbackRetryTimer,
+		DomainID:            r.DomainID,
+		WorkflowID:          r.WorkflowID,
+		RunID:               r.RunID,
+		TaskID:              r.TaskID,
+		VisibilityTimestamp: r.VisibilityTimestamp,
+		Version:             r.Version,
+		TaskList:            r.TaskList,
+	}, nil
+}
+
+func (r *CompletionCallbackRetryTimerTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return nil, fmt.Errorf("completion callback retry timer task is not replication task")
+}
+
 // GetType returns the type of the timeout task.
 func (u *WorkflowTimeoutTask) GetTaskType() int {
 	return TaskTypeWorkflowTimeout
@@ -1415,6 +1481,59 @@ func (u *RecordWorkflowClosedTask) ToInternalReplicationTaskInfo() (*types.Repli
 	return nil, fmt.Errorf("record workflow closed task is not replication task")
 }
 
+// GetType returns the type of the completion callback task
+func (u *CompletionCallbackTask) GetTaskType() int {
+	return TransferTaskTypeCompletionCallback
+}
+
+func (u *CompletionCallbackTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryTransfer
+}
+
+func (u *CompletionCallbackTask) GetTaskKey() HistoryTaskKey {
+	return NewImmediateTaskKey(u.TaskID)
+}
+
+func (u *CompletionCallbackTask) GetTaskList() string {
+	return u.TaskList
+}
+
+func (u *CompletionCallbackTask) GetOriginalTaskList() string {
+	return u.TaskList
+}
+
+func (u *CompletionCallbackTask) GetOriginalTaskListKind() types.TaskListKind {
+	return types.TaskListKindNormal
+}
+
+func (u *CompletionCallbackTask) ByteSize() uint64 {
+	return u.WorkflowIdentifier.ByteSize() + u.TaskData.ByteSize() + uint64(len(u.TaskList))
+}
+
+func (u *CompletionCallbackTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return &TransferTaskInfo{
+		TaskType:            TransferTaskTypeCompletionCallback,
+		DomainID:            u.DomainID,
+		WorkflowID:          u.WorkflowID,
+		RunID:               u.RunID,
+		TaskID:              u.TaskID,
+		VisibilityTimestamp: u.VisibilityTimestamp,
+		Version:             u.Version,
+		TaskList:            u.TaskList,
+		TargetDomainID:      u.DomainID,
+		TargetWorkflowID:    TransferTaskTransferTargetWorkflowID,
+		TargetRunID:         TransferTaskTransferTargetRunID,
+	}, nil
+}
+
+func (u *CompletionCallbackTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return nil, fmt.Errorf("completion callback task is not timer task")
+}
+
+func (u *CompletionCallbackTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return nil, fmt.Errorf("completion callback task is not replication task")
+}
+
 // GetType returns the type of the history replication task
 func (a *HistoryReplicationTask) GetTaskType() int {
 	return ReplicationTaskTypeHistory
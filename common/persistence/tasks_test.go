@@ -44,6 +44,7 @@ func TestTaskCommonMethods(t *testing.T) {
 		&UserTimerTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&ActivityRetryTimerTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&WorkflowBackoffTimerTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
+		&CompletionCallbackRetryTimerTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&WorkflowTimeoutTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&CancelExecutionTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&SignalExecutionTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
@@ -51,6 +52,7 @@ func TestTaskCommonMethods(t *testing.T) {
 		&UpsertWorkflowSearchAttributesTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&StartChildExecutionTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&RecordWorkflowClosedTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
+		&CompletionCallbackTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&HistoryReplicationTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&SyncActivityTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
 		&FailoverMarkerTask{TaskData: TaskData{Version: 1, TaskID: 1, VisibilityTimestamp: timeNow}},
@@ -80,6 +82,8 @@ func TestTaskCommonMethods(t *testing.T) {
 			assert.Equal(t, TaskTypeActivityRetryTimer, ty.GetTaskType())
 		case *WorkflowBackoffTimerTask:
 			assert.Equal(t, TaskTypeWorkflowBackoffTimer, ty.GetTaskType())
+		case *CompletionCallbackRetryTimerTask:
+			assert.Equal(t, TaskTypeCompletionCallbackRetryTimer, ty.GetTaskType())
 		case *WorkflowTimeoutTask:
 			assert.Equal(t, TaskTypeWorkflowTimeout, ty.GetTaskType())
 		case *CancelExecutionTask:
@@ -94,6 +98,8 @@ func TestTaskCommonMethods(t *testing.T) {
 			assert.Equal(t, TransferTaskTypeStartChildExecution, ty.GetTaskType())
 		case *RecordWorkflowClosedTask:
 			assert.Equal(t, TransferTaskTypeRecordWorkflowClosed, ty.GetTaskType())
+		case *CompletionCallbackTask:
+			assert.Equal(t, TransferTaskTypeCompletionCallback, ty.GetTaskType())
 		case *HistoryReplicationTask:
 			assert.Equal(t, ReplicationTaskTypeHistory, ty.GetTaskType())
 		case *SyncActivityTask:
@@ -142,6 +148,7 @@ func TestTransferTaskMapping(t *testing.T) {
 		&UpsertWorkflowSearchAttributesTask{},
 		&StartChildExecutionTask{},
 		&RecordWorkflowClosedTask{},
+		&CompletionCallbackTask{},
 	}
 	for i := 0; i < 1000; i++ {
 		for _, task := range tasks {
@@ -166,6 +173,7 @@ func TestTimerTaskMapping(t *testing.T) {
 		&UserTimerTask{},
 		&ActivityRetryTimerTask{},
 		&WorkflowBackoffTimerTask{},
+		&CompletionCallbackRetryTimerTask{},
 	}
 	for i := 0; i < 1000; i++ {
 		for _, task := range tasks {
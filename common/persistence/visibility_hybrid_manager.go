@@ -53,6 +53,35 @@ const (
 // ResponseComparatorContextKey is for Pinot/ES response comparator. This struct will be passed into ctx as a key.
 type ResponseComparatorContextKey string
 
+type (
+	visibilityWriteStoreKey struct{}
+	visibilityReadStoreKey  struct{}
+)
+
+// ContextWithVisibilityWriteStore returns a context whose visibility writes go to the given stores, e.g. "es" or
+// "db,pinot". It overrides WriteVisibilityStoreName and does not fall back to db.
+func ContextWithVisibilityWriteStore(ctx context.Context, store string) context.Context {
+	return context.WithValue(ctx, visibilityWriteStoreKey{}, store)
+}
+
+// VisibilityWriteStoreFromContext returns the visibility stores writes of the context go to, empty if not set
+func VisibilityWriteStoreFromContext(ctx context.Context) string {
+	store, _ := ctx.Value(visibilityWriteStoreKey{}).(string)
+	return store
+}
+
+// ContextWithVisibilityReadStore returns a context whose visibility reads go to the given store, e.g. "es". It
+// overrides ReadVisibilityStoreName, disables shadow reads and does not fall back to db.
+func ContextWithVisibilityReadStore(ctx context.Context, store string) context.Context {
+	return context.WithValue(ctx, visibilityReadStoreKey{}, store)
+}
+
+// VisibilityReadStoreFromContext returns the visibility store reads of the context go to, empty if not set
+func VisibilityReadStoreFromContext(ctx context.Context) string {
+	store, _ := ctx.Value(visibilityReadStoreKey{}).(string)
+	return store
+}
+
 type OperationType string
 
 var Operation = struct {
@@ -221,7 +250,10 @@ func (v *visibilityHybridManager) chooseVisibilityModeForAdmin() string {
 
 func (v *visibilityHybridManager) chooseVisibilityManagerForWrite(ctx context.Context, visFunc func(string) error) error {
 	var writeMode string
-	if v.writeVisibilityStoreName != nil {
+	storeOverride := VisibilityWriteStoreFromContext(ctx)
+	if storeOverride != "" {
+		writeMode = storeOverride
+	} else if v.writeVisibilityStoreName != nil {
 		writeMode = v.writeVisibilityStoreName()
 	} else {
 		key := VisibilityAdminDeletionKey("visibilityAdminDelete")
@@ -243,6 +275,9 @@ func (v *visibilityHybridManager) chooseVisibilityManagerForWrite(ctx context.Co
 			if err := visFunc(mode); err != nil {
 				errors = append(errors, err.Error())
 			}
+		} else if storeOverride != "" {
+			// an explicitly requested store must not be silently replaced by db
+			errors = append(errors, fmt.Sprintf("visibility store is not available: %s", mode))
 		} else if mode != dbVisStoreName && !strings.Contains(writeMode, dbVisStoreName) {
 			// If requested mode is not available and it's not already "db", fall back to "db"
 			// when write mode already includes db, skip this step since it will perform the write in another loop
@@ -315,7 +350,10 @@ func (v *visibilityHybridManager) ListOpenWorkflowExecutions(
 	ctx context.Context,
 	request *ListWorkflowExecutionsRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListOpenWorkflowExecutions, request, v.logger)
 	}
@@ -327,7 +365,10 @@ func (v *visibilityHybridManager) ListClosedWorkflowExecutions(
 	ctx context.Context,
 	request *ListWorkflowExecutionsRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListClosedWorkflowExecutions, request, v.logger)
 	}
@@ -338,7 +379,10 @@ func (v *visibilityHybridManager) ListOpenWorkflowExecutionsByType(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByTypeRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListOpenWorkflowExecutionsByType, request, v.logger)
 	}
@@ -349,7 +393,10 @@ func (v *visibilityHybridManager) ListClosedWorkflowExecutionsByType(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByTypeRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListClosedWorkflowExecutionsByType, request, v.logger)
 	}
@@ -360,7 +407,10 @@ func (v *visibilityHybridManager) ListOpenWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListOpenWorkflowExecutionsByWorkflowID, request, v.logger)
 	}
@@ -371,7 +421,10 @@ func (v *visibilityHybridManager) ListClosedWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListClosedWorkflowExecutionsByWorkflowID, request, v.logger)
 	}
@@ -382,7 +435,10 @@ func (v *visibilityHybridManager) ListClosedWorkflowExecutionsByStatus(
 	ctx context.Context,
 	request *ListClosedWorkflowExecutionsByStatusRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListClosedWorkflowExecutionsByStatus, request, v.logger)
 	}
@@ -393,7 +449,10 @@ func (v *visibilityHybridManager) GetClosedWorkflowExecution(
 	ctx context.Context,
 	request *GetClosedWorkflowExecutionRequest,
 ) (*GetClosedWorkflowExecutionResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.GetClosedWorkflowExecution, request, v.logger)
 	}
@@ -404,7 +463,10 @@ func (v *visibilityHybridManager) ListWorkflowExecutions(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByQueryRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ListWorkflowExecutions, request, v.logger)
 	}
@@ -415,7 +477,10 @@ func (v *visibilityHybridManager) ScanWorkflowExecutions(
 	ctx context.Context,
 	request *ListWorkflowExecutionsByQueryRequest,
 ) (*ListWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.ScanWorkflowExecutions, request, v.logger)
 	}
@@ -426,14 +491,28 @@ func (v *visibilityHybridManager) CountWorkflowExecutions(
 	ctx context.Context,
 	request *CountWorkflowExecutionsRequest,
 ) (*CountWorkflowExecutionsResponse, error) {
-	manager, shadowMgr := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	manager, shadowMgr, err := v.chooseVisibilityManagerForRead(ctx, request.Domain)
+	if err != nil {
+		return nil, err
+	}
 	if shadowMgr != nil {
 		go shadow(shadowMgr.CountWorkflowExecutions, request, v.logger)
 	}
 	return manager.CountWorkflowExecutions(ctx, request)
 }
 
-func (v *visibilityHybridManager) chooseVisibilityManagerForRead(ctx context.Context, domain string) (VisibilityManager, VisibilityManager) {
+func (v *visibilityHybridManager) chooseVisibilityManagerForRead(ctx context.Context, domain string) (VisibilityManager, VisibilityManager, error) {
+	if storeOverride := VisibilityReadStoreFromContext(ctx); storeOverride != "" {
+		// an explicitly requested store must not be silently replaced by db, and is never shadowed
+		readStore := strings.ToLower(strings.TrimSpace(storeOverride))
+		if v.visibilityMgrs[readStore] == nil {
+			return nil, nil, &types.InternalServiceError{
+				Message: fmt.Sprintf("visibility store is not available: %s", readStore),
+			}
+		}
+		return v.visibilityMgrs[readStore], nil, nil
+	}
+
 	var visibilityMgr, shadowMgr VisibilityManager
 	stores := strings.Split(v.readVisibilityStoreName(domain), ",")
 	for i := range stores {
@@ -452,7 +531,7 @@ func (v *visibilityHybridManager) chooseVisibilityManagerForRead(ctx context.Con
 		shadowMgr = v.visibilityMgrs[stores[1]]
 	}
 
-	return visibilityMgr, shadowMgr
+	return visibilityMgr, shadowMgr, nil
 }
 
 func shadow[ReqT any, ResT any](f func(ctx context.Context, request ReqT) (ResT, error), request ReqT, logger log.Logger) {
@@ -470,6 +470,29 @@ func TestVisibilityHybridRecordWorkflowExecutionClosed(t *testing.T) {
 				mockESVisibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			},
 		},
+		"Case4-1: write store in context overrides the configured stores": {
+			context:                 ContextWithVisibilityWriteStore(context.Background(), pinotStoreName),
+			request:                 request,
+			mockDBVisibilityManager: NewMockVisibilityManager(ctrl),
+			mockDBVisibilityManagerAffordance: func(mockDBVisibilityManager *MockVisibilityManager) {
+				mockDBVisibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockPinotVisibilityManager: NewMockVisibilityManager(ctrl),
+			mockPinotVisibilityManagerAffordance: func(mockPinotVisibilityManager *MockVisibilityManager) {
+				mockPinotVisibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			writeVisibilityStoreName: dynamicproperties.GetStringPropertyFn(dbVisStoreName),
+		},
+		"Case4-2: write store in context is not available, no fall back to db": {
+			context:                 ContextWithVisibilityWriteStore(context.Background(), esStoreName),
+			request:                 request,
+			mockDBVisibilityManager: NewMockVisibilityManager(ctrl),
+			mockDBVisibilityManagerAffordance: func(mockDBVisibilityManager *MockVisibilityManager) {
+				mockDBVisibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).Times(0)
+			},
+			writeVisibilityStoreName: dynamicproperties.GetStringPropertyFn(dbVisStoreName),
+			expectedError:            fmt.Errorf("error"),
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -1787,6 +1810,7 @@ func TestVisibilityHybridGetClosedWorkflowExecution(t *testing.T) {
 		mockPinotVisibilityManagerAffordance func(wg *sync.WaitGroup, mockPinotVisibilityManager *MockVisibilityManager)
 		mockESVisibilityManagerAffordance    func(wg *sync.WaitGroup, mockESVisibilityManager *MockVisibilityManager)
 		readVisibilityStoreName              dynamicproperties.StringPropertyFnWithDomainFilter
+		readStoreOverride                    string
 		wgCount                              int
 		expectedError                        error
 	}{
@@ -1847,6 +1871,32 @@ func TestVisibilityHybridGetClosedWorkflowExecution(t *testing.T) {
 			wgCount:                 1,
 			expectedError:           nil,
 		},
+		"Case3-1: read store in context overrides the configured stores without shadow read": {
+			request:                    request,
+			mockPinotVisibilityManager: NewMockVisibilityManager(ctrl),
+			mockPinotVisibilityManagerAffordance: func(wg *sync.WaitGroup, mockPinotVisibilityManager *MockVisibilityManager) {
+				mockPinotVisibilityManager.EXPECT().GetClosedWorkflowExecution(gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockESVisibilityManager: NewMockVisibilityManager(ctrl),
+			mockESVisibilityManagerAffordance: func(wg *sync.WaitGroup, mockESVisibilityManager *MockVisibilityManager) {
+				mockESVisibilityManager.EXPECT().GetClosedWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			},
+			readVisibilityStoreName: dynamicproperties.GetStringPropertyFnFilteredByDomain(dualStorePinotPrimary),
+			readStoreOverride:       esStoreName,
+			wgCount:                 0,
+			expectedError:           nil,
+		},
+		"Case3-2: read store in context is not available, no fall back to db": {
+			request:                 request,
+			mockDBVisibilityManager: NewMockVisibilityManager(ctrl),
+			mockDBVisibilityManagerAffordance: func(mockDBVisibilityManager *MockVisibilityManager) {
+				mockDBVisibilityManager.EXPECT().GetClosedWorkflowExecution(gomock.Any(), gomock.Any()).Times(0)
+			},
+			readVisibilityStoreName: dynamicproperties.GetStringPropertyFnFilteredByDomain(dbVisStoreName),
+			readStoreOverride:       pinotStoreName,
+			wgCount:                 0,
+			expectedError:           fmt.Errorf("error"),
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -1870,7 +1920,11 @@ func TestVisibilityHybridGetClosedWorkflowExecution(t *testing.T) {
 			}
 			visibilityManager := NewVisibilityHybridManager(visibilityMgrs, test.readVisibilityStoreName, nil, dynamicproperties.GetBoolPropertyFnFilteredByDomain(true), testStoreName, log.NewNoop())
 
-			_, err := visibilityManager.GetClosedWorkflowExecution(context.Background(), test.request)
+			ctx := context.Background()
+			if test.readStoreOverride != "" {
+				ctx = ContextWithVisibilityReadStore(ctx, test.readStoreOverride)
+			}
+			_, err := visibilityManager.GetClosedWorkflowExecution(ctx, test.request)
 			if test.expectedError != nil {
 				assert.Error(t, err)
 			} else {
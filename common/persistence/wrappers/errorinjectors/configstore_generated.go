@@ -15,10 +15,9 @@ import (
 
 // injectorConfigStoreManager implements _sourcePersistence.ConfigStoreManager interface instrumented with error injection.
 type injectorConfigStoreManager struct {
-	wrapped   _sourcePersistence.ConfigStoreManager
-	starttime time.Time
-	errorRate float64
-	logger    log.Logger
+	wrapped  _sourcePersistence.ConfigStoreManager
+	injector *faultInjector
+	logger   log.Logger
 }
 
 // NewConfigStoreManager creates a new instance of ConfigStoreManager with error injection.
@@ -27,12 +26,12 @@ func NewConfigStoreManager(
 	errorRate float64,
 	logger log.Logger,
 	starttime time.Time,
+	opts ...Option,
 ) persistence.ConfigStoreManager {
 	return &injectorConfigStoreManager{
-		wrapped:   wrapped,
-		starttime: starttime,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:  wrapped,
+		injector: newFaultInjector(errorRate, logger, starttime, opts...),
+		logger:   logger,
 	}
 }
 
@@ -42,9 +41,8 @@ func (c *injectorConfigStoreManager) Close() {
 }
 
 func (c *injectorConfigStoreManager) FetchDynamicConfig(ctx context.Context, cfgType _sourcePersistence.ConfigType) (fp1 *_sourcePersistence.FetchDynamicConfigResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ConfigStoreManager.FetchDynamicConfig", c.wrapped, cfgType)
+	if forwardCall {
 		fp1, err = c.wrapped.FetchDynamicConfig(ctx, cfgType)
 	}
 
@@ -56,10 +54,23 @@ func (c *injectorConfigStoreManager) FetchDynamicConfig(ctx context.Context, cfg
 	return
 }
 
+func (c *injectorConfigStoreManager) ListDynamicConfigVersions(ctx context.Context, request *_sourcePersistence.ListDynamicConfigVersionsRequest, cfgType _sourcePersistence.ConfigType) (lp1 *_sourcePersistence.ListDynamicConfigVersionsResponse, err error) {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ConfigStoreManager.ListDynamicConfigVersions", c.wrapped, request, cfgType)
+	if forwardCall {
+		lp1, err = c.wrapped.ListDynamicConfigVersions(ctx, request, cfgType)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "ConfigStoreManager.ListDynamicConfigVersions", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *injectorConfigStoreManager) UpdateDynamicConfig(ctx context.Context, request *_sourcePersistence.UpdateDynamicConfigRequest, cfgType _sourcePersistence.ConfigType) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ConfigStoreManager.UpdateDynamicConfig", c.wrapped, request, cfgType)
+	if forwardCall {
 		err = c.wrapped.UpdateDynamicConfig(ctx, request, cfgType)
 	}
 
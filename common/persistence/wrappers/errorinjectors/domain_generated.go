@@ -15,10 +15,9 @@ import (
 
 // injectorDomainManager implements _sourcePersistence.DomainManager interface instrumented with error injection.
 type injectorDomainManager struct {
-	wrapped   _sourcePersistence.DomainManager
-	starttime time.Time
-	errorRate float64
-	logger    log.Logger
+	wrapped  _sourcePersistence.DomainManager
+	injector *faultInjector
+	logger   log.Logger
 }
 
 // NewDomainManager creates a new instance of DomainManager with error injection.
@@ -27,12 +26,12 @@ func NewDomainManager(
 	errorRate float64,
 	logger log.Logger,
 	starttime time.Time,
+	opts ...Option,
 ) persistence.DomainManager {
 	return &injectorDomainManager{
-		wrapped:   wrapped,
-		starttime: starttime,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:  wrapped,
+		injector: newFaultInjector(errorRate, logger, starttime, opts...),
+		logger:   logger,
 	}
 }
 
@@ -42,9 +41,8 @@ func (c *injectorDomainManager) Close() {
 }
 
 func (c *injectorDomainManager) CreateDomain(ctx context.Context, request *_sourcePersistence.CreateDomainRequest) (cp1 *_sourcePersistence.CreateDomainResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "DomainManager.CreateDomain", c.wrapped, request)
+	if forwardCall {
 		cp1, err = c.wrapped.CreateDomain(ctx, request)
 	}
 
@@ -57,9 +55,8 @@ func (c *injectorDomainManager) CreateDomain(ctx context.Context, request *_sour
 }
 
 func (c *injectorDomainManager) DeleteDomain(ctx context.Context, request *_sourcePersistence.DeleteDomainRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "DomainManager.DeleteDomain", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteDomain(ctx, request)
 	}
 
@@ -72,9 +69,8 @@ func (c *injectorDomainManager) DeleteDomain(ctx context.Context, request *_sour
 }
 
 func (c *injectorDomainManager) DeleteDomainByName(ctx context.Context, request *_sourcePersistence.DeleteDomainByNameRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "DomainManager.DeleteDomainByName", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteDomainByName(ctx, request)
 	}
 
@@ -87,9 +83,8 @@ func (c *injectorDomainManager) DeleteDomainByName(ctx context.Context, request
 }
 
 func (c *injectorDomainManager) GetDomain(ctx context.Context, request *_sourcePersistence.GetDomainRequest) (gp1 *_sourcePersistence.GetDomainResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "DomainManager.GetDomain", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetDomain(ctx, request)
 	}
 
@@ -102,9 +97,8 @@ func (c *injectorDomainManager) GetDomain(ctx context.Context, request *_sourceP
 }
 
 func (c *injectorDomainManager) GetMetadata(ctx context.Context) (gp1 *_sourcePersistence.GetMetadataResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "DomainManager.GetMetadata", c.wrapped)
+	if forwardCall {
 		gp1, err = c.wrapped.GetMetadata(ctx)
 	}
 
@@ -121,9 +115,8 @@ func (c *injectorDomainManager) GetName() (s1 string) {
 }
 
 func (c *injectorDomainManager) ListDomains(ctx context.Context, request *_sourcePersistence.ListDomainsRequest) (lp1 *_sourcePersistence.ListDomainsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "DomainManager.ListDomains", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListDomains(ctx, request)
 	}
 
@@ -136,9 +129,8 @@ func (c *injectorDomainManager) ListDomains(ctx context.Context, request *_sourc
 }
 
 func (c *injectorDomainManager) UpdateDomain(ctx context.Context, request *_sourcePersistence.UpdateDomainRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "DomainManager.UpdateDomain", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.UpdateDomain(ctx, request)
 	}
 
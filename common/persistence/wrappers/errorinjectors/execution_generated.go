@@ -16,10 +16,9 @@ import (
 
 // injectorExecutionManager implements _sourcePersistence.ExecutionManager interface instrumented with error injection.
 type injectorExecutionManager struct {
-	wrapped   _sourcePersistence.ExecutionManager
-	starttime time.Time
-	errorRate float64
-	logger    log.Logger
+	wrapped  _sourcePersistence.ExecutionManager
+	injector *faultInjector
+	logger   log.Logger
 }
 
 // NewExecutionManager creates a new instance of ExecutionManager with error injection.
@@ -28,12 +27,12 @@ func NewExecutionManager(
 	errorRate float64,
 	logger log.Logger,
 	starttime time.Time,
+	opts ...Option,
 ) persistence.ExecutionManager {
 	return &injectorExecutionManager{
-		wrapped:   wrapped,
-		starttime: starttime,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:  wrapped,
+		injector: newFaultInjector(errorRate, logger, starttime, opts...),
+		logger:   logger,
 	}
 }
 
@@ -43,9 +42,8 @@ func (c *injectorExecutionManager) Close() {
 }
 
 func (c *injectorExecutionManager) CompleteHistoryTask(ctx context.Context, request *_sourcePersistence.CompleteHistoryTaskRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.CompleteHistoryTask", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.CompleteHistoryTask(ctx, request)
 	}
 
@@ -58,9 +56,8 @@ func (c *injectorExecutionManager) CompleteHistoryTask(ctx context.Context, requ
 }
 
 func (c *injectorExecutionManager) ConflictResolveWorkflowExecution(ctx context.Context, request *_sourcePersistence.ConflictResolveWorkflowExecutionRequest) (cp1 *_sourcePersistence.ConflictResolveWorkflowExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.ConflictResolveWorkflowExecution", c.wrapped, request)
+	if forwardCall {
 		cp1, err = c.wrapped.ConflictResolveWorkflowExecution(ctx, request)
 	}
 
@@ -73,9 +70,8 @@ func (c *injectorExecutionManager) ConflictResolveWorkflowExecution(ctx context.
 }
 
 func (c *injectorExecutionManager) CreateFailoverMarkerTasks(ctx context.Context, request *_sourcePersistence.CreateFailoverMarkersRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.CreateFailoverMarkerTasks", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.CreateFailoverMarkerTasks(ctx, request)
 	}
 
@@ -88,9 +84,8 @@ func (c *injectorExecutionManager) CreateFailoverMarkerTasks(ctx context.Context
 }
 
 func (c *injectorExecutionManager) CreateHistoryTasks(ctx context.Context, request *_sourcePersistence.CreateHistoryTasksRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.CreateHistoryTasks", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.CreateHistoryTasks(ctx, request)
 	}
 
@@ -103,9 +98,8 @@ func (c *injectorExecutionManager) CreateHistoryTasks(ctx context.Context, reque
 }
 
 func (c *injectorExecutionManager) CreateWorkflowExecution(ctx context.Context, request *_sourcePersistence.CreateWorkflowExecutionRequest) (cp1 *_sourcePersistence.CreateWorkflowExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.CreateWorkflowExecution", c.wrapped, request)
+	if forwardCall {
 		cp1, err = c.wrapped.CreateWorkflowExecution(ctx, request)
 	}
 
@@ -118,9 +112,8 @@ func (c *injectorExecutionManager) CreateWorkflowExecution(ctx context.Context,
 }
 
 func (c *injectorExecutionManager) DeleteActiveClusterSelectionPolicy(ctx context.Context, request *_sourcePersistence.DeleteActiveClusterSelectionPolicyRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.DeleteActiveClusterSelectionPolicy", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteActiveClusterSelectionPolicy(ctx, request)
 	}
 
@@ -133,9 +126,8 @@ func (c *injectorExecutionManager) DeleteActiveClusterSelectionPolicy(ctx contex
 }
 
 func (c *injectorExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Context, request *_sourcePersistence.DeleteCurrentWorkflowExecutionRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.DeleteCurrentWorkflowExecution", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteCurrentWorkflowExecution(ctx, request)
 	}
 
@@ -148,9 +140,8 @@ func (c *injectorExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Co
 }
 
 func (c *injectorExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Context, request *_sourcePersistence.DeleteReplicationTaskFromDLQRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.DeleteReplicationTaskFromDLQ", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteReplicationTaskFromDLQ(ctx, request)
 	}
 
@@ -163,9 +154,8 @@ func (c *injectorExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Cont
 }
 
 func (c *injectorExecutionManager) DeleteWorkflowExecution(ctx context.Context, request *_sourcePersistence.DeleteWorkflowExecutionRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.DeleteWorkflowExecution", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteWorkflowExecution(ctx, request)
 	}
 
@@ -178,9 +168,8 @@ func (c *injectorExecutionManager) DeleteWorkflowExecution(ctx context.Context,
 }
 
 func (c *injectorExecutionManager) FetchWorkflowTimerTasksForCleanup(ctx context.Context, request *_sourcePersistence.FetchWorkflowTimerTasksForCleanupRequest) (ha1 []_sourcePersistence.HistoryTaskKey, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.FetchWorkflowTimerTasksForCleanup", c.wrapped, request)
+	if forwardCall {
 		ha1, err = c.wrapped.FetchWorkflowTimerTasksForCleanup(ctx, request)
 	}
 
@@ -193,9 +182,8 @@ func (c *injectorExecutionManager) FetchWorkflowTimerTasksForCleanup(ctx context
 }
 
 func (c *injectorExecutionManager) GetActiveClusterSelectionPolicy(ctx context.Context, request *_sourcePersistence.GetActiveClusterSelectionPolicyRequest) (ap1 *types.ActiveClusterSelectionPolicy, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.GetActiveClusterSelectionPolicy", c.wrapped, request)
+	if forwardCall {
 		ap1, err = c.wrapped.GetActiveClusterSelectionPolicy(ctx, request)
 	}
 
@@ -208,9 +196,8 @@ func (c *injectorExecutionManager) GetActiveClusterSelectionPolicy(ctx context.C
 }
 
 func (c *injectorExecutionManager) GetCurrentExecution(ctx context.Context, request *_sourcePersistence.GetCurrentExecutionRequest) (gp1 *_sourcePersistence.GetCurrentExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.GetCurrentExecution", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetCurrentExecution(ctx, request)
 	}
 
@@ -223,9 +210,8 @@ func (c *injectorExecutionManager) GetCurrentExecution(ctx context.Context, requ
 }
 
 func (c *injectorExecutionManager) GetHistoryTasks(ctx context.Context, request *_sourcePersistence.GetHistoryTasksRequest) (gp1 *_sourcePersistence.GetHistoryTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.GetHistoryTasks", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetHistoryTasks(ctx, request)
 	}
 
@@ -242,9 +228,8 @@ func (c *injectorExecutionManager) GetName() (s1 string) {
 }
 
 func (c *injectorExecutionManager) GetReplicationDLQSize(ctx context.Context, request *_sourcePersistence.GetReplicationDLQSizeRequest) (gp1 *_sourcePersistence.GetReplicationDLQSizeResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.GetReplicationDLQSize", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetReplicationDLQSize(ctx, request)
 	}
 
@@ -257,9 +242,8 @@ func (c *injectorExecutionManager) GetReplicationDLQSize(ctx context.Context, re
 }
 
 func (c *injectorExecutionManager) GetReplicationTasksFromDLQ(ctx context.Context, request *_sourcePersistence.GetReplicationTasksFromDLQRequest) (gp1 *_sourcePersistence.GetReplicationDLQTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.GetReplicationTasksFromDLQ", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetReplicationTasksFromDLQ(ctx, request)
 	}
 
@@ -272,9 +256,8 @@ func (c *injectorExecutionManager) GetReplicationTasksFromDLQ(ctx context.Contex
 }
 
 func (c *injectorExecutionManager) GetWorkflowExecution(ctx context.Context, request *_sourcePersistence.GetWorkflowExecutionRequest) (gp1 *_sourcePersistence.GetWorkflowExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.GetWorkflowExecution", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetWorkflowExecution(ctx, request)
 	}
 
@@ -287,9 +270,8 @@ func (c *injectorExecutionManager) GetWorkflowExecution(ctx context.Context, req
 }
 
 func (c *injectorExecutionManager) IsWorkflowExecutionExists(ctx context.Context, request *_sourcePersistence.IsWorkflowExecutionExistsRequest) (ip1 *_sourcePersistence.IsWorkflowExecutionExistsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.IsWorkflowExecutionExists", c.wrapped, request)
+	if forwardCall {
 		ip1, err = c.wrapped.IsWorkflowExecutionExists(ctx, request)
 	}
 
@@ -302,9 +284,8 @@ func (c *injectorExecutionManager) IsWorkflowExecutionExists(ctx context.Context
 }
 
 func (c *injectorExecutionManager) ListConcreteExecutions(ctx context.Context, request *_sourcePersistence.ListConcreteExecutionsRequest) (lp1 *_sourcePersistence.ListConcreteExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.ListConcreteExecutions", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListConcreteExecutions(ctx, request)
 	}
 
@@ -317,9 +298,8 @@ func (c *injectorExecutionManager) ListConcreteExecutions(ctx context.Context, r
 }
 
 func (c *injectorExecutionManager) ListCurrentExecutions(ctx context.Context, request *_sourcePersistence.ListCurrentExecutionsRequest) (lp1 *_sourcePersistence.ListCurrentExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.ListCurrentExecutions", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListCurrentExecutions(ctx, request)
 	}
 
@@ -332,9 +312,8 @@ func (c *injectorExecutionManager) ListCurrentExecutions(ctx context.Context, re
 }
 
 func (c *injectorExecutionManager) PutReplicationTaskToDLQ(ctx context.Context, request *_sourcePersistence.PutReplicationTaskToDLQRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.PutReplicationTaskToDLQ", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.PutReplicationTaskToDLQ(ctx, request)
 	}
 
@@ -347,9 +326,8 @@ func (c *injectorExecutionManager) PutReplicationTaskToDLQ(ctx context.Context,
 }
 
 func (c *injectorExecutionManager) RangeCompleteHistoryTask(ctx context.Context, request *_sourcePersistence.RangeCompleteHistoryTaskRequest) (rp1 *_sourcePersistence.RangeCompleteHistoryTaskResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.RangeCompleteHistoryTask", c.wrapped, request)
+	if forwardCall {
 		rp1, err = c.wrapped.RangeCompleteHistoryTask(ctx, request)
 	}
 
@@ -362,9 +340,8 @@ func (c *injectorExecutionManager) RangeCompleteHistoryTask(ctx context.Context,
 }
 
 func (c *injectorExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *_sourcePersistence.RangeDeleteReplicationTaskFromDLQRequest) (rp1 *_sourcePersistence.RangeDeleteReplicationTaskFromDLQResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.RangeDeleteReplicationTaskFromDLQ", c.wrapped, request)
+	if forwardCall {
 		rp1, err = c.wrapped.RangeDeleteReplicationTaskFromDLQ(ctx, request)
 	}
 
@@ -377,9 +354,8 @@ func (c *injectorExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context
 }
 
 func (c *injectorExecutionManager) UpdateWorkflowExecution(ctx context.Context, request *_sourcePersistence.UpdateWorkflowExecutionRequest) (up1 *_sourcePersistence.UpdateWorkflowExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ExecutionManager.UpdateWorkflowExecution", c.wrapped, request)
+	if forwardCall {
 		up1, err = c.wrapped.UpdateWorkflowExecution(ctx, request)
 	}
 
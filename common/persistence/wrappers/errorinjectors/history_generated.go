@@ -15,10 +15,9 @@ import (
 
 // injectorHistoryManager implements _sourcePersistence.HistoryManager interface instrumented with error injection.
 type injectorHistoryManager struct {
-	wrapped   _sourcePersistence.HistoryManager
-	starttime time.Time
-	errorRate float64
-	logger    log.Logger
+	wrapped  _sourcePersistence.HistoryManager
+	injector *faultInjector
+	logger   log.Logger
 }
 
 // NewHistoryManager creates a new instance of HistoryManager with error injection.
@@ -27,19 +26,18 @@ func NewHistoryManager(
 	errorRate float64,
 	logger log.Logger,
 	starttime time.Time,
+	opts ...Option,
 ) persistence.HistoryManager {
 	return &injectorHistoryManager{
-		wrapped:   wrapped,
-		starttime: starttime,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:  wrapped,
+		injector: newFaultInjector(errorRate, logger, starttime, opts...),
+		logger:   logger,
 	}
 }
 
 func (c *injectorHistoryManager) AppendHistoryNodes(ctx context.Context, request *_sourcePersistence.AppendHistoryNodesRequest) (ap1 *_sourcePersistence.AppendHistoryNodesResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "HistoryManager.AppendHistoryNodes", c.wrapped, request)
+	if forwardCall {
 		ap1, err = c.wrapped.AppendHistoryNodes(ctx, request)
 	}
 
@@ -57,9 +55,8 @@ func (c *injectorHistoryManager) Close() {
 }
 
 func (c *injectorHistoryManager) DeleteHistoryBranch(ctx context.Context, request *_sourcePersistence.DeleteHistoryBranchRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "HistoryManager.DeleteHistoryBranch", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteHistoryBranch(ctx, request)
 	}
 
@@ -72,9 +69,8 @@ func (c *injectorHistoryManager) DeleteHistoryBranch(ctx context.Context, reques
 }
 
 func (c *injectorHistoryManager) ForkHistoryBranch(ctx context.Context, request *_sourcePersistence.ForkHistoryBranchRequest) (fp1 *_sourcePersistence.ForkHistoryBranchResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "HistoryManager.ForkHistoryBranch", c.wrapped, request)
+	if forwardCall {
 		fp1, err = c.wrapped.ForkHistoryBranch(ctx, request)
 	}
 
@@ -87,9 +83,8 @@ func (c *injectorHistoryManager) ForkHistoryBranch(ctx context.Context, request
 }
 
 func (c *injectorHistoryManager) GetAllHistoryTreeBranches(ctx context.Context, request *_sourcePersistence.GetAllHistoryTreeBranchesRequest) (gp1 *_sourcePersistence.GetAllHistoryTreeBranchesResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "HistoryManager.GetAllHistoryTreeBranches", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetAllHistoryTreeBranches(ctx, request)
 	}
 
@@ -102,9 +97,8 @@ func (c *injectorHistoryManager) GetAllHistoryTreeBranches(ctx context.Context,
 }
 
 func (c *injectorHistoryManager) GetHistoryTree(ctx context.Context, request *_sourcePersistence.GetHistoryTreeRequest) (gp1 *_sourcePersistence.GetHistoryTreeResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "HistoryManager.GetHistoryTree", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetHistoryTree(ctx, request)
 	}
 
@@ -121,9 +115,8 @@ func (c *injectorHistoryManager) GetName() (s1 string) {
 }
 
 func (c *injectorHistoryManager) ReadHistoryBranch(ctx context.Context, request *_sourcePersistence.ReadHistoryBranchRequest) (rp1 *_sourcePersistence.ReadHistoryBranchResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "HistoryManager.ReadHistoryBranch", c.wrapped, request)
+	if forwardCall {
 		rp1, err = c.wrapped.ReadHistoryBranch(ctx, request)
 	}
 
@@ -136,9 +129,8 @@ func (c *injectorHistoryManager) ReadHistoryBranch(ctx context.Context, request
 }
 
 func (c *injectorHistoryManager) ReadHistoryBranchByBatch(ctx context.Context, request *_sourcePersistence.ReadHistoryBranchRequest) (rp1 *_sourcePersistence.ReadHistoryBranchByBatchResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "HistoryManager.ReadHistoryBranchByBatch", c.wrapped, request)
+	if forwardCall {
 		rp1, err = c.wrapped.ReadHistoryBranchByBatch(ctx, request)
 	}
 
@@ -151,9 +143,8 @@ func (c *injectorHistoryManager) ReadHistoryBranchByBatch(ctx context.Context, r
 }
 
 func (c *injectorHistoryManager) ReadRawHistoryBranch(ctx context.Context, request *_sourcePersistence.ReadHistoryBranchRequest) (rp1 *_sourcePersistence.ReadRawHistoryBranchResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "HistoryManager.ReadRawHistoryBranch", c.wrapped, request)
+	if forwardCall {
 		rp1, err = c.wrapped.ReadRawHistoryBranch(ctx, request)
 	}
 
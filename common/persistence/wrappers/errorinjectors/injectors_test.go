@@ -185,6 +185,7 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 		if expectCalls {
 			mocked.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().FetchDynamicConfig(gomock.Any(), gomock.Any()).Return(&persistence.FetchDynamicConfigResponse{}, expectedErr)
+			mocked.EXPECT().ListDynamicConfigVersions(gomock.Any(), gomock.Any(), gomock.Any()).Return(&persistence.ListDynamicConfigVersionsResponse{}, expectedErr)
 		}
 	case *injectorDomainManager:
 		mocked := persistence.NewMockDomainManager(ctrl)
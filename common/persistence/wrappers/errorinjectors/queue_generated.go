@@ -15,10 +15,9 @@ import (
 
 // injectorQueueManager implements _sourcePersistence.QueueManager interface instrumented with error injection.
 type injectorQueueManager struct {
-	wrapped   _sourcePersistence.QueueManager
-	starttime time.Time
-	errorRate float64
-	logger    log.Logger
+	wrapped  _sourcePersistence.QueueManager
+	injector *faultInjector
+	logger   log.Logger
 }
 
 // NewQueueManager creates a new instance of QueueManager with error injection.
@@ -27,12 +26,12 @@ func NewQueueManager(
 	errorRate float64,
 	logger log.Logger,
 	starttime time.Time,
+	opts ...Option,
 ) persistence.QueueManager {
 	return &injectorQueueManager{
-		wrapped:   wrapped,
-		starttime: starttime,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:  wrapped,
+		injector: newFaultInjector(errorRate, logger, starttime, opts...),
+		logger:   logger,
 	}
 }
 
@@ -42,9 +41,8 @@ func (c *injectorQueueManager) Close() {
 }
 
 func (c *injectorQueueManager) DeleteMessageFromDLQ(ctx context.Context, request *_sourcePersistence.DeleteMessageFromDLQRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.DeleteMessageFromDLQ", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteMessageFromDLQ(ctx, request)
 	}
 
@@ -57,9 +55,8 @@ func (c *injectorQueueManager) DeleteMessageFromDLQ(ctx context.Context, request
 }
 
 func (c *injectorQueueManager) DeleteMessagesBefore(ctx context.Context, request *_sourcePersistence.DeleteMessagesBeforeRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.DeleteMessagesBefore", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteMessagesBefore(ctx, request)
 	}
 
@@ -72,9 +69,8 @@ func (c *injectorQueueManager) DeleteMessagesBefore(ctx context.Context, request
 }
 
 func (c *injectorQueueManager) EnqueueMessage(ctx context.Context, request *_sourcePersistence.EnqueueMessageRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.EnqueueMessage", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.EnqueueMessage(ctx, request)
 	}
 
@@ -87,9 +83,8 @@ func (c *injectorQueueManager) EnqueueMessage(ctx context.Context, request *_sou
 }
 
 func (c *injectorQueueManager) EnqueueMessageToDLQ(ctx context.Context, request *_sourcePersistence.EnqueueMessageToDLQRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.EnqueueMessageToDLQ", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.EnqueueMessageToDLQ(ctx, request)
 	}
 
@@ -102,9 +97,8 @@ func (c *injectorQueueManager) EnqueueMessageToDLQ(ctx context.Context, request
 }
 
 func (c *injectorQueueManager) GetAckLevels(ctx context.Context, request *_sourcePersistence.GetAckLevelsRequest) (gp1 *_sourcePersistence.GetAckLevelsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.GetAckLevels", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetAckLevels(ctx, request)
 	}
 
@@ -117,9 +111,8 @@ func (c *injectorQueueManager) GetAckLevels(ctx context.Context, request *_sourc
 }
 
 func (c *injectorQueueManager) GetDLQAckLevels(ctx context.Context, request *_sourcePersistence.GetDLQAckLevelsRequest) (gp1 *_sourcePersistence.GetDLQAckLevelsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.GetDLQAckLevels", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetDLQAckLevels(ctx, request)
 	}
 
@@ -132,9 +125,8 @@ func (c *injectorQueueManager) GetDLQAckLevels(ctx context.Context, request *_so
 }
 
 func (c *injectorQueueManager) GetDLQSize(ctx context.Context, request *_sourcePersistence.GetDLQSizeRequest) (gp1 *_sourcePersistence.GetDLQSizeResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.GetDLQSize", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetDLQSize(ctx, request)
 	}
 
@@ -147,9 +139,8 @@ func (c *injectorQueueManager) GetDLQSize(ctx context.Context, request *_sourceP
 }
 
 func (c *injectorQueueManager) RangeDeleteMessagesFromDLQ(ctx context.Context, request *_sourcePersistence.RangeDeleteMessagesFromDLQRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.RangeDeleteMessagesFromDLQ", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.RangeDeleteMessagesFromDLQ(ctx, request)
 	}
 
@@ -162,9 +153,8 @@ func (c *injectorQueueManager) RangeDeleteMessagesFromDLQ(ctx context.Context, r
 }
 
 func (c *injectorQueueManager) ReadMessages(ctx context.Context, request *_sourcePersistence.ReadMessagesRequest) (rp1 *_sourcePersistence.ReadMessagesResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.ReadMessages", c.wrapped, request)
+	if forwardCall {
 		rp1, err = c.wrapped.ReadMessages(ctx, request)
 	}
 
@@ -177,9 +167,8 @@ func (c *injectorQueueManager) ReadMessages(ctx context.Context, request *_sourc
 }
 
 func (c *injectorQueueManager) ReadMessagesFromDLQ(ctx context.Context, request *_sourcePersistence.ReadMessagesFromDLQRequest) (rp1 *_sourcePersistence.ReadMessagesFromDLQResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.ReadMessagesFromDLQ", c.wrapped, request)
+	if forwardCall {
 		rp1, err = c.wrapped.ReadMessagesFromDLQ(ctx, request)
 	}
 
@@ -192,9 +181,8 @@ func (c *injectorQueueManager) ReadMessagesFromDLQ(ctx context.Context, request
 }
 
 func (c *injectorQueueManager) UpdateAckLevel(ctx context.Context, request *_sourcePersistence.UpdateAckLevelRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.UpdateAckLevel", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.UpdateAckLevel(ctx, request)
 	}
 
@@ -207,9 +195,8 @@ func (c *injectorQueueManager) UpdateAckLevel(ctx context.Context, request *_sou
 }
 
 func (c *injectorQueueManager) UpdateDLQAckLevel(ctx context.Context, request *_sourcePersistence.UpdateDLQAckLevelRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "QueueManager.UpdateDLQAckLevel", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.UpdateDLQAckLevel(ctx, request)
 	}
 
@@ -0,0 +1,336 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package errorinjectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// Error types which rules can inject
+const (
+	ErrorTypeTimeout            = "timeout"
+	ErrorTypeConditionFailed    = "conditionFailed"
+	ErrorTypeShardOwnershipLost = "shardOwnershipLost"
+	ErrorTypeServiceBusy        = "serviceBusy"
+	ErrorTypeInternal           = "internal"
+)
+
+// rulesRefreshInterval is how often the rules are read from dynamic config
+const rulesRefreshInterval = 10 * time.Second
+
+type (
+	// Rule injects an error and/or latency into the persistence calls it matches. A call matches when every
+	// field which is set matches. Operations, domains and task lists are path.Match patterns, operations are
+	// named "<Manager>.<Method>", e.g. "ExecutionManager.UpdateWorkflowExecution" or "TaskManager.*".
+	// Domains, shards and task lists are read from the DomainName, ShardID, TaskList and TaskListName fields
+	// of the request, and from the shard of the ExecutionManager.
+	Rule struct {
+		Operations []string `json:"operations,omitempty"`
+		Domains    []string `json:"domains,omitempty"`
+		MinShardID *int     `json:"minShardID,omitempty"`
+		MaxShardID *int     `json:"maxShardID,omitempty"`
+		TaskLists  []string `json:"taskLists,omitempty"`
+
+		// Error is one of the ErrorType constants, no error is injected when it is empty
+		Error string `json:"error,omitempty"`
+		// Latency is added before the call, e.g. "200ms"
+		Latency string `json:"latency,omitempty"`
+		// Partial forwards the call to persistence before returning the error, as when a write
+		// succeeds but its response is lost
+		Partial bool `json:"partial,omitempty"`
+		// Rate is the probability for the rule to apply to a matching call, defaults to 1
+		Rate *float64 `json:"rate,omitempty"`
+		// Start and End bound when the rule is active, both are optional
+		Start *time.Time `json:"start,omitempty"`
+		End   *time.Time `json:"end,omitempty"`
+
+		latency time.Duration
+	}
+
+	// Option configures the injectors
+	Option func(*faultInjector)
+
+	faultInjector struct {
+		errorRate  float64
+		starttime  time.Time
+		logger     log.Logger
+		rulesFn    dynamicproperties.ListPropertyFn
+		timeSource clock.TimeSource
+
+		rules       atomic.Pointer[ruleSet]
+		nextRefresh atomic.Int64
+		refreshMu   sync.Mutex
+	}
+
+	// ruleSet is the parsed snapshot of a dynamic config value, swapped as a whole on refresh
+	ruleSet struct {
+		raw   []interface{}
+		rules []*Rule
+	}
+
+	callAttributes struct {
+		domain   string
+		shardID  int
+		hasShard bool
+		taskList string
+	}
+)
+
+// WithRules adds the rules returned by rulesFn on top of the error rate. Rules are evaluated in order and the
+// first matching one decides, even when its rate skips the call; calls which match no rule fall back to the
+// error rate. rulesFn is read every rulesRefreshInterval.
+func WithRules(rulesFn dynamicproperties.ListPropertyFn) Option {
+	return func(f *faultInjector) {
+		f.rulesFn = rulesFn
+	}
+}
+
+// WithTimeSource sets the time source rule windows are evaluated against
+func WithTimeSource(timeSource clock.TimeSource) Option {
+	return func(f *faultInjector) {
+		f.timeSource = timeSource
+	}
+}
+
+func newFaultInjector(errorRate float64, logger log.Logger, starttime time.Time, opts ...Option) *faultInjector {
+	f := &faultInjector{
+		errorRate:  errorRate,
+		starttime:  starttime,
+		logger:     logger,
+		timeSource: clock.NewRealTimeSource(),
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// inject returns whether the call should be forwarded to persistence, and the error to inject into it, if any
+func (f *faultInjector) inject(ctx context.Context, operation string, manager interface{}, args ...interface{}) (bool, error) {
+	if rule, ok := f.match(operation, manager, args); ok {
+		if rule.Rate != nil && rand.Float64() >= *rule.Rate {
+			return true, nil
+		}
+		return rule.apply(ctx, manager, args)
+	}
+	if f.errorRate == 0 {
+		// the injectors are installed whenever rules can be configured, keep the calls which match none cheap
+		return true, nil
+	}
+	fakeErr := generateFakeError(f.errorRate, f.starttime)
+	return shouldForwardCallToPersistence(fakeErr), fakeErr
+}
+
+// match returns the first rule matching the call
+func (f *faultInjector) match(operation string, manager interface{}, args []interface{}) (*Rule, bool) {
+	rules := f.currentRules()
+	if len(rules) == 0 {
+		return nil, false
+	}
+	now := f.timeSource.Now()
+	attributes := getCallAttributes(manager, args)
+	for _, rule := range rules {
+		if rule.matches(now, operation, attributes) {
+			return rule, true
+		}
+	}
+	return nil, false
+}
+
+// currentRules returns the rules of the latest snapshot, refreshing it when rulesRefreshInterval passed.
+// Only one caller refreshes, the others keep using the current snapshot.
+func (f *faultInjector) currentRules() []*Rule {
+	if f.rulesFn == nil {
+		return nil
+	}
+	now := f.timeSource.Now().UnixNano()
+	if now >= f.nextRefresh.Load() && f.refreshMu.TryLock() {
+		f.nextRefresh.Store(now + rulesRefreshInterval.Nanoseconds())
+		f.refreshRules()
+		f.refreshMu.Unlock()
+	}
+	if current := f.rules.Load(); current != nil {
+		return current.rules
+	}
+	return nil
+}
+
+// refreshRules parses the rules again when the dynamic config value changed
+func (f *faultInjector) refreshRules() {
+	raw := f.rulesFn()
+	if current := f.rules.Load(); current != nil && reflect.DeepEqual(raw, current.raw) {
+		return
+	}
+	rules, err := ParseRules(raw)
+	if err != nil {
+		f.logger.Error("invalid persistence fault injection rules, ignoring them", tag.Error(err))
+	}
+	f.rules.Store(&ruleSet{raw: raw, rules: rules})
+}
+
+// ParseRules parses the rules of a dynamic config value
+func ParseRules(raw []interface{}) ([]*Rule, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var rules []*Rule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("decoding rules: %w", err)
+	}
+	for i, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+	}
+	return rules, nil
+}
+
+func (r *Rule) validate() error {
+	switch r.Error {
+	case "", ErrorTypeTimeout, ErrorTypeConditionFailed, ErrorTypeShardOwnershipLost, ErrorTypeServiceBusy, ErrorTypeInternal:
+	default:
+		return fmt.Errorf("unknown error type %q", r.Error)
+	}
+	if r.Latency != "" {
+		latency, err := time.ParseDuration(r.Latency)
+		if err != nil {
+			return fmt.Errorf("invalid latency: %w", err)
+		}
+		r.latency = latency
+	}
+	if r.Error == "" && r.latency == 0 {
+		return fmt.Errorf("rule injects neither an error nor latency")
+	}
+	if r.Rate != nil && (*r.Rate < 0 || *r.Rate > 1) {
+		return fmt.Errorf("rate must be between 0 and 1, got %v", *r.Rate)
+	}
+	for _, patterns := range [][]string{r.Operations, r.Domains, r.TaskLists} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Rule) matches(now time.Time, operation string, attributes callAttributes) bool {
+	if r.Start != nil && now.Before(*r.Start) || r.End != nil && !now.Before(*r.End) {
+		return false
+	}
+	if len(r.Operations) > 0 && !matchAny(r.Operations, operation) {
+		return false
+	}
+	if len(r.Domains) > 0 && !matchAny(r.Domains, attributes.domain) {
+		return false
+	}
+	if len(r.TaskLists) > 0 && !matchAny(r.TaskLists, attributes.taskList) {
+		return false
+	}
+	if r.MinShardID != nil || r.MaxShardID != nil {
+		if !attributes.hasShard ||
+			r.MinShardID != nil && attributes.shardID < *r.MinShardID ||
+			r.MaxShardID != nil && attributes.shardID > *r.MaxShardID {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Rule) apply(ctx context.Context, manager interface{}, args []interface{}) (bool, error) {
+	if r.latency > 0 {
+		timer := time.NewTimer(r.latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return false, ErrFakeTimeout
+		}
+	}
+
+	var fakeErr error
+	switch r.Error {
+	case ErrorTypeTimeout:
+		fakeErr = ErrFakeTimeout
+	case ErrorTypeConditionFailed:
+		fakeErr = ErrFakeConditionFailed
+	case ErrorTypeShardOwnershipLost:
+		fakeErr = &persistence.ShardOwnershipLostError{
+			ShardID: getCallAttributes(manager, args).shardID,
+			Msg:     "Fake Persistence Shard Ownership Lost Error.",
+		}
+	case ErrorTypeServiceBusy:
+		fakeErr = errors.ErrFakeServiceBusy
+	case ErrorTypeInternal:
+		fakeErr = errors.ErrFakeInternalService
+	}
+	return fakeErr == nil || r.Partial, fakeErr
+}
+
+func getCallAttributes(manager interface{}, args []interface{}) callAttributes {
+	var attributes callAttributes
+	if m, ok := manager.(interface{ GetShardID() int }); ok {
+		attributes.shardID, attributes.hasShard = m.GetShardID(), true
+	}
+	for _, arg := range args {
+		v := reflect.Indirect(reflect.ValueOf(arg))
+		if v.Kind() != reflect.Struct {
+			continue
+		}
+		if f := v.FieldByName("DomainName"); f.Kind() == reflect.String {
+			attributes.domain = f.String()
+		}
+		if f := v.FieldByName("ShardID"); f.Kind() == reflect.Int {
+			attributes.shardID, attributes.hasShard = int(f.Int()), true
+		}
+		for _, name := range []string{"TaskList", "TaskListName"} {
+			if f := v.FieldByName(name); f.Kind() == reflect.String {
+				attributes.taskList = f.String()
+			}
+		}
+	}
+	return attributes
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,333 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package errorinjectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func staticRules(rules []interface{}) dynamicproperties.ListPropertyFn {
+	return func(...dynamicproperties.FilterOption) []interface{} { return rules }
+}
+
+func TestParseRules(t *testing.T) {
+	tests := map[string]struct {
+		raw     []interface{}
+		wantErr string
+		want    func(t *testing.T, rules []*Rule)
+	}{
+		"empty": {
+			raw: []interface{}{},
+			want: func(t *testing.T, rules []*Rule) {
+				assert.Empty(t, rules)
+			},
+		},
+		"valid rule": {
+			raw: []interface{}{
+				map[string]interface{}{
+					"operations": []interface{}{"TaskManager.*"},
+					"domains":    []interface{}{"test-*"},
+					"error":      "timeout",
+					"latency":    "150ms",
+					"rate":       0.5,
+				},
+			},
+			want: func(t *testing.T, rules []*Rule) {
+				require.Len(t, rules, 1)
+				assert.Equal(t, []string{"TaskManager.*"}, rules[0].Operations)
+				assert.Equal(t, ErrorTypeTimeout, rules[0].Error)
+				assert.Equal(t, 150*time.Millisecond, rules[0].latency)
+				assert.Equal(t, 0.5, *rules[0].Rate)
+			},
+		},
+		"unknown error": {
+			raw:     []interface{}{map[string]interface{}{"error": "boom"}},
+			wantErr: `rule 0: unknown error type "boom"`,
+		},
+		"invalid latency": {
+			raw:     []interface{}{map[string]interface{}{"latency": "soon"}},
+			wantErr: "rule 0: invalid latency",
+		},
+		"no effect": {
+			raw:     []interface{}{map[string]interface{}{"domains": []interface{}{"test"}}},
+			wantErr: "rule 0: rule injects neither an error nor latency",
+		},
+		"invalid rate": {
+			raw:     []interface{}{map[string]interface{}{"error": "internal", "rate": 2}},
+			wantErr: "rule 0: rate must be between 0 and 1",
+		},
+		"invalid pattern": {
+			raw:     []interface{}{map[string]interface{}{"error": "internal", "operations": []interface{}{"["}}},
+			wantErr: `rule 0: invalid pattern "["`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rules, err := ParseRules(tc.raw)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tc.want(t, rules)
+		})
+	}
+}
+
+func TestRuleMatches(t *testing.T) {
+	now := time.Now()
+	before, after := now.Add(-time.Minute), now.Add(time.Minute)
+	one, three := 1, 3
+
+	tests := map[string]struct {
+		rule       Rule
+		operation  string
+		attributes callAttributes
+		want       bool
+	}{
+		"match all": {
+			rule:      Rule{},
+			operation: "TaskManager.GetTasks",
+			want:      true,
+		},
+		"operation pattern": {
+			rule:      Rule{Operations: []string{"ExecutionManager.*"}},
+			operation: "TaskManager.GetTasks",
+			want:      false,
+		},
+		"domain pattern": {
+			rule:       Rule{Domains: []string{"test-*"}},
+			operation:  "TaskManager.GetTasks",
+			attributes: callAttributes{domain: "test-domain"},
+			want:       true,
+		},
+		"task list": {
+			rule:       Rule{TaskLists: []string{"tl"}},
+			operation:  "TaskManager.GetTasks",
+			attributes: callAttributes{taskList: "other"},
+			want:       false,
+		},
+		"shard in range": {
+			rule:       Rule{MinShardID: &one, MaxShardID: &three},
+			operation:  "ShardManager.GetShard",
+			attributes: callAttributes{shardID: 2, hasShard: true},
+			want:       true,
+		},
+		"shard out of range": {
+			rule:       Rule{MinShardID: &one, MaxShardID: &three},
+			operation:  "ShardManager.GetShard",
+			attributes: callAttributes{shardID: 4, hasShard: true},
+			want:       false,
+		},
+		"no shard": {
+			rule:      Rule{MinShardID: &one},
+			operation: "DomainManager.GetDomain",
+			want:      false,
+		},
+		"inside window": {
+			rule:      Rule{Start: &before, End: &after},
+			operation: "DomainManager.GetDomain",
+			want:      true,
+		},
+		"window not started": {
+			rule:      Rule{Start: &after},
+			operation: "DomainManager.GetDomain",
+			want:      false,
+		},
+		"window ended": {
+			rule:      Rule{End: &before},
+			operation: "DomainManager.GetDomain",
+			want:      false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.rule.matches(now, tc.operation, tc.attributes))
+		})
+	}
+}
+
+func TestGetCallAttributes(t *testing.T) {
+	attributes := getCallAttributes(nil, []interface{}{&persistence.LeaseTaskListRequest{DomainName: "domain", TaskList: "tl"}})
+	assert.Equal(t, callAttributes{domain: "domain", taskList: "tl"}, attributes)
+
+	attributes = getCallAttributes(nil, []interface{}{&persistence.GetShardRequest{ShardID: 5}})
+	assert.Equal(t, callAttributes{shardID: 5, hasShard: true}, attributes)
+
+	attributes = getCallAttributes(nil, []interface{}{(*persistence.GetShardRequest)(nil), "string"})
+	assert.Equal(t, callAttributes{}, attributes)
+}
+
+func TestFaultInjectorRules(t *testing.T) {
+	tests := map[string]struct {
+		rules       []interface{}
+		errorRate   float64
+		operation   string
+		args        []interface{}
+		wantErr     error
+		wantForward bool
+	}{
+		"no rules falls back to error rate": {
+			operation:   "TaskManager.GetTasks",
+			wantForward: true,
+		},
+		"invalid rules are ignored": {
+			rules:       []interface{}{map[string]interface{}{"error": "boom"}},
+			operation:   "TaskManager.GetTasks",
+			wantForward: true,
+		},
+		"not matching rule": {
+			rules:       []interface{}{map[string]interface{}{"operations": []interface{}{"ShardManager.*"}, "error": "timeout"}},
+			operation:   "TaskManager.GetTasks",
+			wantForward: true,
+		},
+		"matching rule": {
+			rules:     []interface{}{map[string]interface{}{"domains": []interface{}{"domain"}, "error": "serviceBusy"}},
+			operation: "TaskManager.LeaseTaskList",
+			args:      []interface{}{&persistence.LeaseTaskListRequest{DomainName: "domain"}},
+			wantErr:   errors.ErrFakeServiceBusy,
+		},
+		"partial failure forwards the call": {
+			rules:       []interface{}{map[string]interface{}{"error": "conditionFailed", "partial": true}},
+			operation:   "ExecutionManager.UpdateWorkflowExecution",
+			wantErr:     ErrFakeConditionFailed,
+			wantForward: true,
+		},
+		"shard ownership lost": {
+			rules:     []interface{}{map[string]interface{}{"error": "shardOwnershipLost"}},
+			operation: "ShardManager.UpdateShard",
+			args:      []interface{}{&persistence.GetShardRequest{ShardID: 7}},
+			wantErr:   &persistence.ShardOwnershipLostError{ShardID: 7, Msg: "Fake Persistence Shard Ownership Lost Error."},
+		},
+		"first matching rule wins": {
+			rules: []interface{}{
+				map[string]interface{}{"operations": []interface{}{"TaskManager.GetTasks"}, "error": "internal"},
+				map[string]interface{}{"error": "timeout"},
+			},
+			operation: "TaskManager.GetTasks",
+			wantErr:   errors.ErrFakeInternalService,
+		},
+		"zero rate never applies": {
+			rules:       []interface{}{map[string]interface{}{"error": "timeout", "rate": 0}},
+			operation:   "TaskManager.GetTasks",
+			wantForward: true,
+		},
+		"skipped rule doesn't fall through to later rules or the error rate": {
+			rules: []interface{}{
+				map[string]interface{}{"operations": []interface{}{"TaskManager.GetTasks"}, "error": "timeout", "rate": 0},
+				map[string]interface{}{"error": "internal"},
+			},
+			errorRate:   1,
+			operation:   "TaskManager.GetTasks",
+			wantForward: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			injector := newFaultInjector(tc.errorRate, log.NewNoop(), time.Now().Add(-time.Minute), WithRules(staticRules(tc.rules)))
+			forward, fakeErr := injector.inject(context.Background(), tc.operation, nil, tc.args...)
+			assert.Equal(t, tc.wantErr, fakeErr)
+			assert.Equal(t, tc.wantForward, forward)
+		})
+	}
+}
+
+func TestFaultInjectorLatency(t *testing.T) {
+	rules := []interface{}{map[string]interface{}{"latency": "50ms"}}
+	injector := newFaultInjector(0, log.NewNoop(), time.Now(), WithRules(staticRules(rules)))
+
+	start := time.Now()
+	forward, fakeErr := injector.inject(context.Background(), "TaskManager.GetTasks", nil)
+	assert.NoError(t, fakeErr)
+	assert.True(t, forward)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	forward, fakeErr = injector.inject(ctx, "TaskManager.GetTasks", nil)
+	assert.Equal(t, ErrFakeTimeout, fakeErr)
+	assert.False(t, forward)
+}
+
+func TestFaultInjectorRuleWindow(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	start := timeSource.Now().Add(time.Minute)
+	rules := []interface{}{map[string]interface{}{"error": "internal", "start": start.Format(time.RFC3339Nano)}}
+	injector := newFaultInjector(0, log.NewNoop(), time.Now(), WithRules(staticRules(rules)), WithTimeSource(timeSource))
+
+	_, fakeErr := injector.inject(context.Background(), "TaskManager.GetTasks", nil)
+	assert.NoError(t, fakeErr)
+
+	timeSource.Advance(time.Minute)
+	_, fakeErr = injector.inject(context.Background(), "TaskManager.GetTasks", nil)
+	assert.Equal(t, errors.ErrFakeInternalService, fakeErr)
+}
+
+func TestFaultInjectorRulesRefresh(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	calls := 0
+	rules := []interface{}{}
+	rulesFn := func(...dynamicproperties.FilterOption) []interface{} {
+		calls++
+		return rules
+	}
+	injector := newFaultInjector(0, log.NewNoop(), time.Now(), WithRules(rulesFn), WithTimeSource(timeSource))
+
+	_, fakeErr := injector.inject(context.Background(), "TaskManager.GetTasks", nil)
+	assert.NoError(t, fakeErr)
+
+	rules = []interface{}{map[string]interface{}{"error": "internal"}}
+	_, fakeErr = injector.inject(context.Background(), "TaskManager.GetTasks", nil)
+	assert.NoError(t, fakeErr, "rules are only read again after the refresh interval")
+	assert.Equal(t, 1, calls)
+
+	timeSource.Advance(rulesRefreshInterval)
+	_, fakeErr = injector.inject(context.Background(), "TaskManager.GetTasks", nil)
+	assert.Equal(t, errors.ErrFakeInternalService, fakeErr)
+	assert.Equal(t, 2, calls)
+}
+
+func TestInjectorWithRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockShardManager(ctrl)
+	rules := []interface{}{map[string]interface{}{"operations": []interface{}{"ShardManager.UpdateShard"}, "error": "timeout", "partial": true}}
+	object := NewShardManager(mocked, 0, log.NewNoop(), time.Now(), WithRules(staticRules(rules)))
+
+	mocked.EXPECT().GetShard(gomock.Any(), gomock.Any()).Return(&persistence.GetShardResponse{}, nil)
+	_, err := object.GetShard(context.Background(), &persistence.GetShardRequest{ShardID: 1})
+	assert.NoError(t, err)
+
+	mocked.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	err = object.UpdateShard(context.Background(), &persistence.UpdateShardRequest{})
+	assert.Equal(t, ErrFakeTimeout, err)
+}
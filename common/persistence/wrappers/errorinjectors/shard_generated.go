@@ -15,10 +15,9 @@ import (
 
 // injectorShardManager implements _sourcePersistence.ShardManager interface instrumented with error injection.
 type injectorShardManager struct {
-	wrapped   _sourcePersistence.ShardManager
-	starttime time.Time
-	errorRate float64
-	logger    log.Logger
+	wrapped  _sourcePersistence.ShardManager
+	injector *faultInjector
+	logger   log.Logger
 }
 
 // NewShardManager creates a new instance of ShardManager with error injection.
@@ -27,12 +26,12 @@ func NewShardManager(
 	errorRate float64,
 	logger log.Logger,
 	starttime time.Time,
+	opts ...Option,
 ) persistence.ShardManager {
 	return &injectorShardManager{
-		wrapped:   wrapped,
-		starttime: starttime,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:  wrapped,
+		injector: newFaultInjector(errorRate, logger, starttime, opts...),
+		logger:   logger,
 	}
 }
 
@@ -42,9 +41,8 @@ func (c *injectorShardManager) Close() {
 }
 
 func (c *injectorShardManager) CreateShard(ctx context.Context, request *_sourcePersistence.CreateShardRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ShardManager.CreateShard", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.CreateShard(ctx, request)
 	}
 
@@ -61,9 +59,8 @@ func (c *injectorShardManager) GetName() (s1 string) {
 }
 
 func (c *injectorShardManager) GetShard(ctx context.Context, request *_sourcePersistence.GetShardRequest) (gp1 *_sourcePersistence.GetShardResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ShardManager.GetShard", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetShard(ctx, request)
 	}
 
@@ -76,9 +73,8 @@ func (c *injectorShardManager) GetShard(ctx context.Context, request *_sourcePer
 }
 
 func (c *injectorShardManager) UpdateShard(ctx context.Context, request *_sourcePersistence.UpdateShardRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "ShardManager.UpdateShard", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.UpdateShard(ctx, request)
 	}
 
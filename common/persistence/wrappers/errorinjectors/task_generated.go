@@ -15,10 +15,9 @@ import (
 
 // injectorTaskManager implements _sourcePersistence.TaskManager interface instrumented with error injection.
 type injectorTaskManager struct {
-	wrapped   _sourcePersistence.TaskManager
-	starttime time.Time
-	errorRate float64
-	logger    log.Logger
+	wrapped  _sourcePersistence.TaskManager
+	injector *faultInjector
+	logger   log.Logger
 }
 
 // NewTaskManager creates a new instance of TaskManager with error injection.
@@ -27,12 +26,12 @@ func NewTaskManager(
 	errorRate float64,
 	logger log.Logger,
 	starttime time.Time,
+	opts ...Option,
 ) persistence.TaskManager {
 	return &injectorTaskManager{
-		wrapped:   wrapped,
-		starttime: starttime,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:  wrapped,
+		injector: newFaultInjector(errorRate, logger, starttime, opts...),
+		logger:   logger,
 	}
 }
 
@@ -42,9 +41,8 @@ func (c *injectorTaskManager) Close() {
 }
 
 func (c *injectorTaskManager) CompleteTask(ctx context.Context, request *_sourcePersistence.CompleteTaskRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.CompleteTask", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.CompleteTask(ctx, request)
 	}
 
@@ -57,9 +55,8 @@ func (c *injectorTaskManager) CompleteTask(ctx context.Context, request *_source
 }
 
 func (c *injectorTaskManager) CompleteTasksLessThan(ctx context.Context, request *_sourcePersistence.CompleteTasksLessThanRequest) (cp1 *_sourcePersistence.CompleteTasksLessThanResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.CompleteTasksLessThan", c.wrapped, request)
+	if forwardCall {
 		cp1, err = c.wrapped.CompleteTasksLessThan(ctx, request)
 	}
 
@@ -72,9 +69,8 @@ func (c *injectorTaskManager) CompleteTasksLessThan(ctx context.Context, request
 }
 
 func (c *injectorTaskManager) CreateTasks(ctx context.Context, request *_sourcePersistence.CreateTasksRequest) (cp1 *_sourcePersistence.CreateTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.CreateTasks", c.wrapped, request)
+	if forwardCall {
 		cp1, err = c.wrapped.CreateTasks(ctx, request)
 	}
 
@@ -87,9 +83,8 @@ func (c *injectorTaskManager) CreateTasks(ctx context.Context, request *_sourceP
 }
 
 func (c *injectorTaskManager) DeleteTaskList(ctx context.Context, request *_sourcePersistence.DeleteTaskListRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.DeleteTaskList", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteTaskList(ctx, request)
 	}
 
@@ -106,9 +101,8 @@ func (c *injectorTaskManager) GetName() (s1 string) {
 }
 
 func (c *injectorTaskManager) GetOrphanTasks(ctx context.Context, request *_sourcePersistence.GetOrphanTasksRequest) (gp1 *_sourcePersistence.GetOrphanTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.GetOrphanTasks", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetOrphanTasks(ctx, request)
 	}
 
@@ -121,9 +115,8 @@ func (c *injectorTaskManager) GetOrphanTasks(ctx context.Context, request *_sour
 }
 
 func (c *injectorTaskManager) GetTaskList(ctx context.Context, request *_sourcePersistence.GetTaskListRequest) (gp1 *_sourcePersistence.GetTaskListResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.GetTaskList", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetTaskList(ctx, request)
 	}
 
@@ -136,9 +129,8 @@ func (c *injectorTaskManager) GetTaskList(ctx context.Context, request *_sourceP
 }
 
 func (c *injectorTaskManager) GetTaskListSize(ctx context.Context, request *_sourcePersistence.GetTaskListSizeRequest) (gp1 *_sourcePersistence.GetTaskListSizeResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.GetTaskListSize", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetTaskListSize(ctx, request)
 	}
 
@@ -151,9 +143,8 @@ func (c *injectorTaskManager) GetTaskListSize(ctx context.Context, request *_sou
 }
 
 func (c *injectorTaskManager) GetTasks(ctx context.Context, request *_sourcePersistence.GetTasksRequest) (gp1 *_sourcePersistence.GetTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.GetTasks", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetTasks(ctx, request)
 	}
 
@@ -166,9 +157,8 @@ func (c *injectorTaskManager) GetTasks(ctx context.Context, request *_sourcePers
 }
 
 func (c *injectorTaskManager) LeaseTaskList(ctx context.Context, request *_sourcePersistence.LeaseTaskListRequest) (lp1 *_sourcePersistence.LeaseTaskListResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.LeaseTaskList", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.LeaseTaskList(ctx, request)
 	}
 
@@ -181,9 +171,8 @@ func (c *injectorTaskManager) LeaseTaskList(ctx context.Context, request *_sourc
 }
 
 func (c *injectorTaskManager) ListTaskList(ctx context.Context, request *_sourcePersistence.ListTaskListRequest) (lp1 *_sourcePersistence.ListTaskListResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.ListTaskList", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListTaskList(ctx, request)
 	}
 
@@ -196,9 +185,8 @@ func (c *injectorTaskManager) ListTaskList(ctx context.Context, request *_source
 }
 
 func (c *injectorTaskManager) UpdateTaskList(ctx context.Context, request *_sourcePersistence.UpdateTaskListRequest) (up1 *_sourcePersistence.UpdateTaskListResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "TaskManager.UpdateTaskList", c.wrapped, request)
+	if forwardCall {
 		up1, err = c.wrapped.UpdateTaskList(ctx, request)
 	}
 
@@ -76,6 +76,8 @@ func generateFakeError(
 var (
 	// ErrFakeTimeout is a fake persistence timeout error.
 	ErrFakeTimeout = &persistence.TimeoutError{Msg: "Fake Persistence Timeout Error."}
+	// ErrFakeConditionFailed is a fake persistence condition failed error, only injected by rules.
+	ErrFakeConditionFailed = &persistence.ConditionFailedError{Msg: "Fake Persistence Condition Failed Error."}
 )
 
 var (
@@ -140,6 +142,8 @@ func configManagerTags(op string) *tag.Tag {
 	switch op {
 	case "ConfigStoreManager.FetchDynamicConfig":
 		return &tag.StoreOperationFetchDynamicConfig
+	case "ConfigStoreManager.ListDynamicConfigVersions":
+		return &tag.StoreOperationListDynamicConfigVersions
 	case "ConfigStoreManager.UpdateDynamicConfig":
 		return &tag.StoreOperationUpdateDynamicConfig
 	}
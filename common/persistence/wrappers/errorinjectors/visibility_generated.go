@@ -15,10 +15,9 @@ import (
 
 // injectorVisibilityManager implements _sourcePersistence.VisibilityManager interface instrumented with error injection.
 type injectorVisibilityManager struct {
-	wrapped   _sourcePersistence.VisibilityManager
-	starttime time.Time
-	errorRate float64
-	logger    log.Logger
+	wrapped  _sourcePersistence.VisibilityManager
+	injector *faultInjector
+	logger   log.Logger
 }
 
 // NewVisibilityManager creates a new instance of VisibilityManager with error injection.
@@ -27,12 +26,12 @@ func NewVisibilityManager(
 	errorRate float64,
 	logger log.Logger,
 	starttime time.Time,
+	opts ...Option,
 ) persistence.VisibilityManager {
 	return &injectorVisibilityManager{
-		wrapped:   wrapped,
-		starttime: starttime,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:  wrapped,
+		injector: newFaultInjector(errorRate, logger, starttime, opts...),
+		logger:   logger,
 	}
 }
 
@@ -42,9 +41,8 @@ func (c *injectorVisibilityManager) Close() {
 }
 
 func (c *injectorVisibilityManager) CountWorkflowExecutions(ctx context.Context, request *_sourcePersistence.CountWorkflowExecutionsRequest) (cp1 *_sourcePersistence.CountWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.CountWorkflowExecutions", c.wrapped, request)
+	if forwardCall {
 		cp1, err = c.wrapped.CountWorkflowExecutions(ctx, request)
 	}
 
@@ -57,9 +55,8 @@ func (c *injectorVisibilityManager) CountWorkflowExecutions(ctx context.Context,
 }
 
 func (c *injectorVisibilityManager) DeleteUninitializedWorkflowExecution(ctx context.Context, request *_sourcePersistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.DeleteUninitializedWorkflowExecution", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteUninitializedWorkflowExecution(ctx, request)
 	}
 
@@ -72,9 +69,8 @@ func (c *injectorVisibilityManager) DeleteUninitializedWorkflowExecution(ctx con
 }
 
 func (c *injectorVisibilityManager) DeleteWorkflowExecution(ctx context.Context, request *_sourcePersistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.DeleteWorkflowExecution", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.DeleteWorkflowExecution(ctx, request)
 	}
 
@@ -87,9 +83,8 @@ func (c *injectorVisibilityManager) DeleteWorkflowExecution(ctx context.Context,
 }
 
 func (c *injectorVisibilityManager) GetClosedWorkflowExecution(ctx context.Context, request *_sourcePersistence.GetClosedWorkflowExecutionRequest) (gp1 *_sourcePersistence.GetClosedWorkflowExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.GetClosedWorkflowExecution", c.wrapped, request)
+	if forwardCall {
 		gp1, err = c.wrapped.GetClosedWorkflowExecution(ctx, request)
 	}
 
@@ -106,9 +101,8 @@ func (c *injectorVisibilityManager) GetName() (s1 string) {
 }
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.ListClosedWorkflowExecutions", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListClosedWorkflowExecutions(ctx, request)
 	}
 
@@ -121,9 +115,8 @@ func (c *injectorVisibilityManager) ListClosedWorkflowExecutions(ctx context.Con
 }
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *_sourcePersistence.ListClosedWorkflowExecutionsByStatusRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.ListClosedWorkflowExecutionsByStatus", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByStatus(ctx, request)
 	}
 
@@ -136,9 +129,8 @@ func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByStatus(ctx con
 }
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByType(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByTypeRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.ListClosedWorkflowExecutionsByType", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByType(ctx, request)
 	}
 
@@ -151,9 +143,8 @@ func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByType(ctx conte
 }
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
 	}
 
@@ -166,9 +157,8 @@ func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(ctx
 }
 
 func (c *injectorVisibilityManager) ListOpenWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.ListOpenWorkflowExecutions", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListOpenWorkflowExecutions(ctx, request)
 	}
 
@@ -181,9 +171,8 @@ func (c *injectorVisibilityManager) ListOpenWorkflowExecutions(ctx context.Conte
 }
 
 func (c *injectorVisibilityManager) ListOpenWorkflowExecutionsByType(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByTypeRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.ListOpenWorkflowExecutionsByType", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListOpenWorkflowExecutionsByType(ctx, request)
 	}
 
@@ -196,9 +185,8 @@ func (c *injectorVisibilityManager) ListOpenWorkflowExecutionsByType(ctx context
 }
 
 func (c *injectorVisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
 	}
 
@@ -211,9 +199,8 @@ func (c *injectorVisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(ctx c
 }
 
 func (c *injectorVisibilityManager) ListWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByQueryRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.ListWorkflowExecutions", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ListWorkflowExecutions(ctx, request)
 	}
 
@@ -226,9 +213,8 @@ func (c *injectorVisibilityManager) ListWorkflowExecutions(ctx context.Context,
 }
 
 func (c *injectorVisibilityManager) RecordWorkflowExecutionClosed(ctx context.Context, request *_sourcePersistence.RecordWorkflowExecutionClosedRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.RecordWorkflowExecutionClosed", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.RecordWorkflowExecutionClosed(ctx, request)
 	}
 
@@ -241,9 +227,8 @@ func (c *injectorVisibilityManager) RecordWorkflowExecutionClosed(ctx context.Co
 }
 
 func (c *injectorVisibilityManager) RecordWorkflowExecutionStarted(ctx context.Context, request *_sourcePersistence.RecordWorkflowExecutionStartedRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.RecordWorkflowExecutionStarted", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.RecordWorkflowExecutionStarted(ctx, request)
 	}
 
@@ -256,9 +241,8 @@ func (c *injectorVisibilityManager) RecordWorkflowExecutionStarted(ctx context.C
 }
 
 func (c *injectorVisibilityManager) RecordWorkflowExecutionUninitialized(ctx context.Context, request *_sourcePersistence.RecordWorkflowExecutionUninitializedRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.RecordWorkflowExecutionUninitialized", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.RecordWorkflowExecutionUninitialized(ctx, request)
 	}
 
@@ -271,9 +255,8 @@ func (c *injectorVisibilityManager) RecordWorkflowExecutionUninitialized(ctx con
 }
 
 func (c *injectorVisibilityManager) ScanWorkflowExecutions(ctx context.Context, request *_sourcePersistence.ListWorkflowExecutionsByQueryRequest) (lp1 *_sourcePersistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.ScanWorkflowExecutions", c.wrapped, request)
+	if forwardCall {
 		lp1, err = c.wrapped.ScanWorkflowExecutions(ctx, request)
 	}
 
@@ -286,9 +269,8 @@ func (c *injectorVisibilityManager) ScanWorkflowExecutions(ctx context.Context,
 }
 
 func (c *injectorVisibilityManager) UpsertWorkflowExecution(ctx context.Context, request *_sourcePersistence.UpsertWorkflowExecutionRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate, c.starttime)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	forwardCall, fakeErr := c.injector.inject(ctx, "VisibilityManager.UpsertWorkflowExecution", c.wrapped, request)
+	if forwardCall {
 		err = c.wrapped.UpsertWorkflowExecution(ctx, request)
 	}
 
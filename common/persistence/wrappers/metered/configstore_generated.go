@@ -56,6 +56,19 @@ func (c *meteredConfigStoreManager) FetchDynamicConfig(ctx context.Context, cfgT
 	return
 }
 
+func (c *meteredConfigStoreManager) ListDynamicConfigVersions(ctx context.Context, request *_sourcePersistence.ListDynamicConfigVersionsRequest, cfgType _sourcePersistence.ConfigType) (lp1 *_sourcePersistence.ListDynamicConfigVersionsResponse, err error) {
+	op := func() error {
+		lp1, err = c.wrapped.ListDynamicConfigVersions(ctx, request, cfgType)
+		c.emptyMetric("ConfigStoreManager.ListDynamicConfigVersions", request, lp1, err)
+		return err
+	}
+
+	retryCount := getRetryCountFromContext(ctx)
+
+	err = c.call(metrics.PersistenceListDynamicConfigVersionsScope, op, append(getCustomMetricTags(request), metrics.IsRetryTag(retryCount > 0))...)
+	return
+}
+
 func (c *meteredConfigStoreManager) UpdateDynamicConfig(ctx context.Context, request *_sourcePersistence.UpdateDynamicConfigRequest, cfgType _sourcePersistence.ConfigType) (err error) {
 	op := func() error {
 		err = c.wrapped.UpdateDynamicConfig(ctx, request, cfgType)
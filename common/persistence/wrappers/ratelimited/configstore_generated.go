@@ -45,6 +45,14 @@ func (c *ratelimitedConfigStoreManager) FetchDynamicConfig(ctx context.Context,
 	return c.wrapped.FetchDynamicConfig(ctx, cfgType)
 }
 
+func (c *ratelimitedConfigStoreManager) ListDynamicConfigVersions(ctx context.Context, request *_sourcePersistence.ListDynamicConfigVersionsRequest, cfgType _sourcePersistence.ConfigType) (lp1 *_sourcePersistence.ListDynamicConfigVersionsResponse, err error) {
+	if !c.callerBypass.AllowLimiter(ctx, c.rateLimiter) {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.ListDynamicConfigVersions(ctx, request, cfgType)
+}
+
 func (c *ratelimitedConfigStoreManager) UpdateDynamicConfig(ctx context.Context, request *_sourcePersistence.UpdateDynamicConfigRequest, cfgType _sourcePersistence.ConfigType) (err error) {
 	if !c.callerBypass.AllowLimiter(ctx, c.rateLimiter) {
 		err = ErrPersistenceLimitExceeded
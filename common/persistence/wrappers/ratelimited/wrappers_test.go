@@ -166,6 +166,7 @@ func builderForPassThrough(t *testing.T, injector any, limiter quotas.Limiter, e
 		if expectCalls {
 			mocked.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().FetchDynamicConfig(gomock.Any(), gomock.Any()).Return(&persistence.FetchDynamicConfigResponse{}, expectedErr)
+			mocked.EXPECT().ListDynamicConfigVersions(gomock.Any(), gomock.Any(), gomock.Any()).Return(&persistence.ListDynamicConfigVersionsResponse{}, expectedErr)
 		}
 	case *ratelimitedDomainManager:
 		mocked := persistence.NewMockDomainManager(ctrl)
@@ -0,0 +1,149 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package invariant
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// EntityConcreteExecution registers an invariant checked against concrete executions
+	EntityConcreteExecution Entity = "concrete_execution"
+	// EntityCurrentExecution registers an invariant checked against current executions
+	EntityCurrentExecution Entity = "current_execution"
+)
+
+type (
+	// Entity is the kind of scanned entity an invariant is checked against
+	Entity string
+
+	// Factory creates a registered invariant
+	Factory func(pr persistence.Retryer, dc cache.DomainCache, logger *zap.Logger) Invariant
+
+	// Registration describes an invariant added on top of the built-in ones.
+	// A registered invariant runs when its collection is enabled for the scanner or fixer,
+	// and its name is enabled in the matching custom invariants dynamic config.
+	Registration struct {
+		Name       Name
+		Collection Collection
+		Entity     Entity
+		Factory    Factory
+	}
+
+	registry struct {
+		sync.RWMutex
+		registrations map[Name]Registration
+	}
+)
+
+// builtinNames cannot be used for registered invariants
+var builtinNames = map[Name]struct{}{
+	HistoryExists:           {},
+	InactiveDomainExists:    {},
+	OpenCurrentExecution:    {},
+	ConcreteExecutionExists: {},
+	StaleWorkflow:           {},
+	TimerInvalid:            {},
+	HistoryInvalid:          {},
+	MismatchedRecords:       {},
+}
+
+var registered = &registry{registrations: make(map[Name]Registration)}
+
+// Register adds an invariant to the ones run by the executions scanner and fixer.
+// It is meant to be called during server startup, before the worker service starts.
+func Register(r Registration) error {
+	if r.Name == "" {
+		return fmt.Errorf("invariant name must not be empty")
+	}
+	if _, ok := builtinNames[r.Name]; ok {
+		return fmt.Errorf("invariant %q is a built-in invariant", r.Name)
+	}
+	if !r.Collection.IsACollection() {
+		return fmt.Errorf("invariant %q has unknown collection %v", r.Name, r.Collection)
+	}
+	if r.Entity != EntityConcreteExecution && r.Entity != EntityCurrentExecution {
+		return fmt.Errorf("invariant %q has unknown entity %q", r.Name, r.Entity)
+	}
+	if r.Factory == nil {
+		return fmt.Errorf("invariant %q has no factory", r.Name)
+	}
+
+	registered.Lock()
+	defer registered.Unlock()
+	if _, ok := registered.registrations[r.Name]; ok {
+		return fmt.Errorf("invariant %q is already registered", r.Name)
+	}
+	registered.registrations[r.Name] = r
+	return nil
+}
+
+// Registered returns the registered invariants checked against entity, sorted by name.
+func Registered(entity Entity) []Registration {
+	registered.RLock()
+	defer registered.RUnlock()
+	var res []Registration
+	for _, r := range registered.registrations {
+		if r.Entity == entity {
+			res = append(res, r)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+// Select returns the registered invariants checked against entity which belong to one of collections
+// and are enabled, sorted by name.
+func Select(entity Entity, collections []Collection, enabled func(Name) bool) []Registration {
+	inCollections := make(map[Collection]struct{}, len(collections))
+	for _, c := range collections {
+		inCollections[c] = struct{}{}
+	}
+	var res []Registration
+	for _, r := range Registered(entity) {
+		if _, ok := inCollections[r.Collection]; ok && enabled(r.Name) {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// RegisterForTest should be used only in tests to register an invariant, the returned func de-registers it
+func RegisterForTest(r Registration) (func(), error) {
+	if err := Register(r); err != nil {
+		return nil, err
+	}
+	return func() {
+		registered.Lock()
+		defer registered.Unlock()
+		delete(registered.registrations, r.Name)
+	}, nil
+}
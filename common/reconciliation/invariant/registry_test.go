@@ -0,0 +1,124 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package invariant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestRegister(t *testing.T) {
+	factory := func(persistence.Retryer, cache.DomainCache, *zap.Logger) Invariant {
+		return NewMockInvariant(gomock.NewController(t))
+	}
+	valid := Registration{
+		Name:       "test_register",
+		Collection: CollectionMutableState,
+		Entity:     EntityConcreteExecution,
+		Factory:    factory,
+	}
+
+	tests := map[string]struct {
+		modify  func(r *Registration)
+		wantErr string
+	}{
+		"valid": {
+			modify: func(r *Registration) {},
+		},
+		"empty name": {
+			modify:  func(r *Registration) { r.Name = "" },
+			wantErr: "invariant name must not be empty",
+		},
+		"builtin name": {
+			modify:  func(r *Registration) { r.Name = HistoryExists },
+			wantErr: `invariant "history_exists" is a built-in invariant`,
+		},
+		"unknown collection": {
+			modify:  func(r *Registration) { r.Collection = Collection(-1) },
+			wantErr: "has unknown collection",
+		},
+		"unknown entity": {
+			modify:  func(r *Registration) { r.Entity = "timer" },
+			wantErr: `invariant "test_register" has unknown entity "timer"`,
+		},
+		"nil factory": {
+			modify:  func(r *Registration) { r.Factory = nil },
+			wantErr: `invariant "test_register" has no factory`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := valid
+			tc.modify(&r)
+			err := Register(r)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			t.Cleanup(func() {
+				registered.Lock()
+				defer registered.Unlock()
+				delete(registered.registrations, r.Name)
+			})
+			assert.EqualError(t, Register(r), `invariant "test_register" is already registered`)
+		})
+	}
+}
+
+func TestRegisteredAndSelect(t *testing.T) {
+	factory := func(persistence.Retryer, cache.DomainCache, *zap.Logger) Invariant {
+		return NewMockInvariant(gomock.NewController(t))
+	}
+	for _, r := range []Registration{
+		{Name: "test_b", Collection: CollectionMutableState, Entity: EntityConcreteExecution, Factory: factory},
+		{Name: "test_a", Collection: CollectionHistory, Entity: EntityConcreteExecution, Factory: factory},
+		{Name: "test_c", Collection: CollectionMutableState, Entity: EntityCurrentExecution, Factory: factory},
+	} {
+		deregister, err := RegisterForTest(r)
+		require.NoError(t, err)
+		t.Cleanup(deregister)
+	}
+
+	names := func(rs []Registration) []Name {
+		var res []Name
+		for _, r := range rs {
+			res = append(res, r.Name)
+		}
+		return res
+	}
+	all := func(Name) bool { return true }
+
+	assert.Equal(t, []Name{"test_a", "test_b"}, names(Registered(EntityConcreteExecution)))
+	assert.Equal(t, []Name{"test_c"}, names(Registered(EntityCurrentExecution)))
+
+	assert.Equal(t, []Name{"test_b"}, names(Select(EntityConcreteExecution, []Collection{CollectionMutableState}, all)))
+	assert.Equal(t, []Name{"test_a", "test_b"}, names(Select(EntityConcreteExecution, []Collection{CollectionHistory, CollectionMutableState}, all)))
+	assert.Equal(t, []Name{"test_a"}, names(Select(EntityConcreteExecution, []Collection{CollectionHistory, CollectionMutableState}, func(n Name) bool { return n == "test_a" })))
+	assert.Empty(t, Select(EntityConcreteExecution, nil, all))
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package replication aggregates the replication status reported by history shards.
+package replication
+
+import (
+	"github.com/uber/cadence/common/types"
+)
+
+// Merge aggregates the status of several shards: task lags and DLQ sizes add up, the lag is the largest one and
+// the oldest pending task is the oldest of all shards. The task lag is truncated if any shard's is. Fetch errors add up and the last one is kept.
+func Merge(statuses ...*types.ReplicationStatus) *types.ReplicationStatus {
+	summary := &types.ReplicationStatus{}
+	fetched := true
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+		summary.TaskLag += status.TaskLag
+		summary.TaskLagTruncated = summary.TaskLagTruncated || status.TaskLagTruncated
+		summary.DLQSize += status.DLQSize
+		if status.LagSeconds > summary.LagSeconds {
+			summary.LagSeconds = status.LagSeconds
+		}
+		if status.OldestPendingTaskTime != 0 &&
+			(summary.OldestPendingTaskTime == 0 || status.OldestPendingTaskTime < summary.OldestPendingTaskTime) {
+			summary.OldestPendingTaskTime = status.OldestPendingTaskTime
+		}
+		summary.Fetcher = mergeFetcher(summary.Fetcher, status.Fetcher)
+		fetched = fetched && status.Fetcher.GetLastFetchTime() != 0
+	}
+	if summary.Fetcher != nil && !fetched {
+		summary.Fetcher.LastFetchTime = 0
+	}
+	return summary
+}
+
+func mergeFetcher(summary, status *types.ReplicationFetcherStatus) *types.ReplicationFetcherStatus {
+	if status == nil {
+		return summary
+	}
+	if summary == nil {
+		copied := *status
+		return &copied
+	}
+	if status.LastFetchTime < summary.LastFetchTime {
+		summary.LastFetchTime = status.LastFetchTime
+	}
+	summary.ErrorCount += status.ErrorCount
+	if status.LastErrorTime > summary.LastErrorTime {
+		summary.LastError = status.LastError
+		summary.LastErrorTime = status.LastErrorTime
+	}
+	return summary
+}
+
+// DLQSizeByShard returns the replication DLQ sizes of a CountDLQMessages response of the target cluster, for
+// the tasks which came from sourceCluster.
+func DLQSizeByShard(resp *types.CountDLQMessagesResponse, sourceCluster string) map[int32]int64 {
+	sizes := make(map[int32]int64)
+	if resp == nil {
+		return sizes
+	}
+	for key, count := range resp.History {
+		if key.SourceCluster == sourceCluster {
+			sizes[key.ShardID] += count
+		}
+	}
+	return sizes
+}
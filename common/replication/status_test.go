@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestMerge(t *testing.T) {
+	tests := map[string]struct {
+		statuses []*types.ReplicationStatus
+		want     *types.ReplicationStatus
+	}{
+		"no shards": {
+			want: &types.ReplicationStatus{},
+		},
+		"lags and fetch errors": {
+			statuses: []*types.ReplicationStatus{
+				{
+					AckedTaskID:           5,
+					MaxTaskID:             7,
+					TaskLag:               2,
+					OldestPendingTaskTime: 300,
+					LagSeconds:            10,
+					DLQSize:               1,
+					Fetcher:               &types.ReplicationFetcherStatus{LastFetchTime: 50, ErrorCount: 1, LastError: "old", LastErrorTime: 20},
+				},
+				nil,
+				{
+					AckedTaskID:           7,
+					MaxTaskID:             10,
+					TaskLag:               3,
+					OldestPendingTaskTime: 100,
+					LagSeconds:            30,
+					Fetcher:               &types.ReplicationFetcherStatus{LastFetchTime: 40, ErrorCount: 2, LastError: "new", LastErrorTime: 30},
+				},
+				{AckedTaskID: 9, MaxTaskID: 9, Fetcher: &types.ReplicationFetcherStatus{LastFetchTime: 60}},
+			},
+			want: &types.ReplicationStatus{
+				TaskLag:               5,
+				OldestPendingTaskTime: 100,
+				LagSeconds:            30,
+				DLQSize:               1,
+				Fetcher:               &types.ReplicationFetcherStatus{LastFetchTime: 40, ErrorCount: 3, LastError: "new", LastErrorTime: 30},
+			},
+		},
+		"truncated domain lag": {
+			statuses: []*types.ReplicationStatus{
+				{TaskLag: 1000, TaskLagTruncated: true},
+				{TaskLag: 2},
+			},
+			want: &types.ReplicationStatus{TaskLag: 1002, TaskLagTruncated: true},
+		},
+		"shard not fetched from": {
+			statuses: []*types.ReplicationStatus{
+				{Fetcher: &types.ReplicationFetcherStatus{LastFetchTime: 50}},
+				{},
+			},
+			want: &types.ReplicationStatus{Fetcher: &types.ReplicationFetcherStatus{}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Merge(tc.statuses...))
+		})
+	}
+}
+
+func TestDLQSizeByShard(t *testing.T) {
+	assert.Empty(t, DLQSizeByShard(nil, "active"))
+	got := DLQSizeByShard(&types.CountDLQMessagesResponse{
+		History: map[types.HistoryDLQCountKey]int64{
+			{ShardID: 1, SourceCluster: "active"}:  3,
+			{ShardID: 1, SourceCluster: "other"}:   5,
+			{ShardID: 2, SourceCluster: "active"}:  1,
+			{ShardID: 3, SourceCluster: "standby"}: 7,
+		},
+		Domain: 9,
+	}, "active")
+	assert.Equal(t, map[int32]int64{1: 3, 2: 1}, got)
+}
@@ -57,6 +57,7 @@ type FactoryImpl struct {
 	maxMessageSize int
 	channel        tchannel.Channel
 	dispatcher     *yarpc.Dispatcher
+	restInbound    *RESTInbound
 	outbounds      *Outbounds
 	logger         log.Logger
 	serviceName    string
@@ -110,16 +111,13 @@ func NewFactory(logger log.Logger, p Params) Factory {
 		logger.Info("Listening for GRPC requests", tag.Address(p.GRPCAddress))
 	}
 	// Create http inbound if configured
+	var restInbound *RESTInbound
 	if p.HTTP != nil {
+		if p.HTTP.REST {
+			restInbound = NewRESTInbound(p.HTTP.Procedures, p.InboundMiddleware.Unary)
+		}
 		interceptor := func(handler nethttp.Handler) nethttp.Handler {
-			return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
-				procedure := r.Header.Get(yarpchttp.ProcedureHeader)
-				if _, found := p.HTTP.Procedures[procedure]; found {
-					handler.ServeHTTP(w, r)
-					return
-				}
-				nethttp.NotFound(w, r)
-			})
+			return newHTTPInterceptor(handler, p.HTTP.Procedures, restInbound)
 		}
 
 		inboundOptions := []yarpchttp.InboundOption{yarpchttp.Interceptor(interceptor)}
@@ -157,6 +155,7 @@ func NewFactory(logger log.Logger, p Params) Factory {
 	return &FactoryImpl{
 		maxMessageSize: p.GRPCMaxMsgSize,
 		dispatcher:     dispatcher,
+		restInbound:    restInbound,
 		channel:        ch.Channel(),
 		outbounds:      outbounds,
 		serviceName:    p.ServiceName,
@@ -171,6 +170,11 @@ func (d *FactoryImpl) GetDispatcher() *yarpc.Dispatcher {
 	return d.dispatcher
 }
 
+// GetRESTInbound returns the REST routes of the HTTP inbound, nil if they are not enabled
+func (d *FactoryImpl) GetRESTInbound() *RESTInbound {
+	return d.restInbound
+}
+
 // GetTChannel GetChannel returns Tchannel Channel used by Ringpop
 func (d *FactoryImpl) GetTChannel() tchannel.Channel {
 	return d.channel
@@ -240,6 +244,23 @@ func (d *FactoryImpl) listenMembershipChanges(svc string, ch chan *membership.Ch
 	}
 }
 
+// newHTTPInterceptor passes the yarpc requests for the allowed procedures to the yarpc handler.
+// Requests without a procedure header are served by the REST routes, if any.
+func newHTTPInterceptor(handler nethttp.Handler, procedures map[string]struct{}, rest *RESTInbound) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		procedure := r.Header.Get(yarpchttp.ProcedureHeader)
+		if _, found := procedures[procedure]; found {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		if procedure == "" && rest != nil && rest.hasRoute(r) {
+			rest.ServeHTTP(w, r)
+			return
+		}
+		nethttp.NotFound(w, r)
+	})
+}
+
 func createDialer(transport *grpc.Transport, tlsConfig *tls.Config) *grpc.Dialer {
 	var dialOptions []grpc.DialOption
 	if tlsConfig != nil {
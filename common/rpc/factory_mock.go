@@ -71,6 +71,20 @@ func (mr *MockFactoryMockRecorder) GetMaxMessageSize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxMessageSize", reflect.TypeOf((*MockFactory)(nil).GetMaxMessageSize))
 }
 
+// GetRESTInbound mocks base method.
+func (m *MockFactory) GetRESTInbound() *RESTInbound {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRESTInbound")
+	ret0, _ := ret[0].(*RESTInbound)
+	return ret0
+}
+
+// GetRESTInbound indicates an expected call of GetRESTInbound.
+func (mr *MockFactoryMockRecorder) GetRESTInbound() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRESTInbound", reflect.TypeOf((*MockFactory)(nil).GetRESTInbound))
+}
+
 // GetTChannel mocks base method.
 func (m *MockFactory) GetTChannel() tchannel.Channel {
 	m.ctrl.T.Helper()
@@ -22,6 +22,8 @@ package rpc
 
 import (
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
@@ -29,6 +31,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
+	yarpchttp "go.uber.org/yarpc/transport/http"
 
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/membership"
@@ -60,6 +63,96 @@ func TestNewFactory(t *testing.T) {
 	assert.NotNil(t, f.GetDispatcher(), "GetDispatcher returned nil")
 	assert.NotNil(t, f.GetTChannel(), "GetTChannel returned nil")
 	assert.Equal(t, grpcMsgSize, f.GetMaxMessageSize(), "GetMaxMessageSize returned wrong value")
+	assert.Nil(t, f.GetRESTInbound(), "GetRESTInbound returned routes while REST is not enabled")
+}
+
+func TestHTTPInterceptor(t *testing.T) {
+	yarpcHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("yarpc"))
+	})
+	procedures := map[string]struct{}{
+		"cadence.api.v1.WorkflowAPI::StartWorkflowExecution": {},
+		"cadence.api.v1.DomainAPI::ListDomains":              {},
+	}
+	rest := NewRESTInbound(procedures, nil)
+	rest.Handle("GET /api/v1/domains", "cadence.api.v1.DomainAPI::ListDomains", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("rest"))
+	}))
+	rest.Handle("POST /api/v1/domains", "cadence.api.v1.DomainAPI::RegisterDomain", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("rest"))
+	}))
+
+	tests := []struct {
+		desc       string
+		rest       *RESTInbound
+		method     string
+		path       string
+		procedure  string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			desc:       "allowed procedure",
+			rest:       rest,
+			method:     http.MethodPost,
+			path:       "/",
+			procedure:  "cadence.api.v1.WorkflowAPI::StartWorkflowExecution",
+			wantStatus: http.StatusOK,
+			wantBody:   "yarpc",
+		},
+		{
+			desc:       "procedure not allowed",
+			rest:       rest,
+			method:     http.MethodPost,
+			path:       "/api/v1/domains",
+			procedure:  "cadence.api.v1.WorkflowAPI::TerminateWorkflowExecution",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			desc:       "rest route",
+			rest:       rest,
+			method:     http.MethodGet,
+			path:       "/api/v1/domains",
+			wantStatus: http.StatusOK,
+			wantBody:   "rest",
+		},
+		{
+			desc:       "rest route of a procedure not allowed",
+			rest:       rest,
+			method:     http.MethodPost,
+			path:       "/api/v1/domains",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			desc:       "unknown rest route",
+			rest:       rest,
+			method:     http.MethodGet,
+			path:       "/api/v1/unknown",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			desc:       "rest not enabled",
+			method:     http.MethodGet,
+			path:       "/api/v1/domains",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, tc.path, nil)
+			if tc.procedure != "" {
+				r.Header.Set(yarpchttp.ProcedureHeader, tc.procedure)
+			}
+			w := httptest.NewRecorder()
+			newHTTPInterceptor(yarpcHandler, procedures, tc.rest).ServeHTTP(w, r)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, w.Body.String())
+			}
+		})
+	}
 }
 
 func TestStartStop(t *testing.T) {
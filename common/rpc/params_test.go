@@ -80,6 +80,12 @@ func TestNewParams(t *testing.T) {
 	params, err = NewParams(serviceName, cfg, dc, logger, metricsCl)
 	assert.NoError(t, err)
 	assert.Equal(t, "127.0.0.1:8800", params.HTTP.Address)
+	assert.False(t, params.HTTP.REST)
+
+	cfg = makeConfig(config.Service{RPC: config.RPC{BindOnLocalHost: true, HTTP: &config.HTTP{Port: 8800, REST: true}}})
+	params, err = NewParams(serviceName, cfg, dc, logger, metricsCl)
+	assert.NoError(t, err)
+	assert.True(t, params.HTTP.REST)
 
 	cfg = makeConfig(config.Service{RPC: config.RPC{BindOnLocalHost: true, HTTP: &config.HTTP{}}})
 	params, err = NewParams(serviceName, cfg, dc, logger, metricsCl)
@@ -0,0 +1,75 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	nethttp "net/http"
+
+	"go.uber.org/yarpc/api/middleware"
+)
+
+// RESTInbound serves REST routes on the HTTP inbound next to the yarpc procedures. Each route exposes a yarpc
+// procedure: it is only served if the procedure is allowed on the HTTP inbound, and its handler has to be called
+// through the same inbound middleware as the yarpc procedures, see InboundMiddleware.
+type RESTInbound struct {
+	mux        *nethttp.ServeMux
+	procedures map[string]struct{}
+	middleware middleware.UnaryInbound
+}
+
+// NewRESTInbound creates a RESTInbound serving the routes of the given procedures
+func NewRESTInbound(procedures map[string]struct{}, inboundMiddleware middleware.UnaryInbound) *RESTInbound {
+	return &RESTInbound{
+		mux:        nethttp.NewServeMux(),
+		procedures: procedures,
+		middleware: inboundMiddleware,
+	}
+}
+
+// Handle serves the route pattern with handler if procedure is allowed on the HTTP inbound, it returns false and
+// ignores the route otherwise
+func (i *RESTInbound) Handle(pattern, procedure string, handler nethttp.Handler) bool {
+	if _, ok := i.procedures[procedure]; !ok {
+		return false
+	}
+	i.mux.Handle(pattern, handler)
+	return true
+}
+
+// HandleDocument serves a route which exposes no procedure, e.g. the description of the other routes
+func (i *RESTInbound) HandleDocument(pattern string, handler nethttp.Handler) {
+	i.mux.Handle(pattern, handler)
+}
+
+// InboundMiddleware returns the unary inbound middleware of the yarpc procedures
+func (i *RESTInbound) InboundMiddleware() middleware.UnaryInbound {
+	return i.middleware
+}
+
+// ServeHTTP serves the request with the route matching it, or responds with 404 Not Found
+func (i *RESTInbound) ServeHTTP(w nethttp.ResponseWriter, r *nethttp.Request) {
+	i.mux.ServeHTTP(w, r)
+}
+
+func (i *RESTInbound) hasRoute(r *nethttp.Request) bool {
+	_, pattern := i.mux.Handler(r)
+	return pattern != ""
+}
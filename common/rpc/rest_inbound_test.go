@@ -0,0 +1,61 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/yarpc"
+)
+
+func TestRESTInbound(t *testing.T) {
+	inboundMiddleware := yarpc.UnaryInboundMiddleware(&CallerInfoMiddleware{})
+	rest := NewRESTInbound(map[string]struct{}{"cadence.api.v1.DomainAPI::ListDomains": {}}, inboundMiddleware)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("rest"))
+	})
+
+	assert.True(t, rest.Handle("GET /api/v1/domains", "cadence.api.v1.DomainAPI::ListDomains", handler))
+	assert.False(t, rest.Handle("POST /api/v1/domains", "cadence.api.v1.DomainAPI::RegisterDomain", handler))
+	rest.HandleDocument("GET /api/v1/openapi.json", handler)
+	assert.Equal(t, inboundMiddleware, rest.InboundMiddleware())
+
+	for _, tc := range []struct {
+		method    string
+		path      string
+		wantRoute bool
+	}{
+		{http.MethodGet, "/api/v1/domains", true},
+		{http.MethodPost, "/api/v1/domains", false},
+		{http.MethodGet, "/api/v1/openapi.json", true},
+	} {
+		r := httptest.NewRequest(tc.method, tc.path, nil)
+		assert.Equal(t, tc.wantRoute, rest.hasRoute(r), "%s %s", tc.method, tc.path)
+		if tc.wantRoute {
+			w := httptest.NewRecorder()
+			rest.ServeHTTP(w, r)
+			assert.Equal(t, "rest", w.Body.String())
+		}
+	}
+}
@@ -32,6 +32,8 @@ import (
 // Factory Creates a dispatcher that knows how to transport requests.
 type Factory interface {
 	GetDispatcher() *yarpc.Dispatcher
+	// GetRESTInbound returns the REST routes of the HTTP inbound, or nil if they are not enabled
+	GetRESTInbound() *RESTInbound
 	GetMaxMessageSize() int
 	Start(PeerLister) error
 	GetTChannel() tchannel.Channel
@@ -34,6 +34,8 @@ type (
 	SyncMap[K comparable, V any] interface {
 		Get(key K) (value V, ok bool)
 		Put(key K, value V) (inserted bool)
+		// Keys returns the keys of the map in no particular order
+		Keys() []K
 	}
 )
 
@@ -59,3 +61,13 @@ func (m *syncmap[K, V]) Put(key K, value V) (inserted bool) {
 	m.data[key] = value
 	return true
 }
+
+func (m *syncmap[K, V]) Keys() []K {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	keys := make([]K, 0, len(m.data))
+	for key := range m.data {
+		keys = append(keys, key)
+	}
+	return keys
+}
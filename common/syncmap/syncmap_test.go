@@ -57,6 +57,7 @@ func TestRace(t *testing.T) {
 				_, _ = m.Get(key)
 				_ = m.Put(key, i)
 			}
+			_ = m.Keys()
 		}()
 	}
 
@@ -92,4 +93,7 @@ func TestBasics(t *testing.T) {
 	val, ok = m.Get(key)
 	assert.True(t, ok, "should still get")
 	assert.Equal(t, orig, val, "should get original value, not replaced")
+
+	m.Put("other", orig)
+	assert.ElementsMatch(t, []string{key, "other"}, m.Keys(), "should get all inserted keys")
 }
@@ -254,11 +254,254 @@ type GetDynamicConfigResponse struct {
 type UpdateDynamicConfigRequest struct {
 	ConfigName   string                `json:"configName,omitempty"`
 	ConfigValues []*DynamicConfigValue `json:"configValues,omitempty"`
+	// Reason is recorded in the config store version created by the update, the author is the caller
+	Reason string `json:"reason,omitempty"`
+}
+
+func (v *UpdateDynamicConfigRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
 }
 
 type RestoreDynamicConfigRequest struct {
 	ConfigName string                 `json:"configName,omitempty"`
 	Filters    []*DynamicConfigFilter `json:"filters,omitempty"`
+	// Reason is recorded in the config store version created by the restore, the author is the caller
+	Reason string `json:"reason,omitempty"`
+}
+
+func (v *RestoreDynamicConfigRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+// ListDynamicConfigVersionsRequest lists the config store versions, latest first
+type ListDynamicConfigVersionsRequest struct {
+	// MaxVersion is the latest version to return, 0 starts from the latest version
+	MaxVersion int64 `json:"maxVersion,omitempty"`
+	PageSize   int32 `json:"pageSize,omitempty"`
+}
+
+func (v *ListDynamicConfigVersionsRequest) GetMaxVersion() (o int64) {
+	if v != nil {
+		return v.MaxVersion
+	}
+	return
+}
+
+func (v *ListDynamicConfigVersionsRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+// ListDynamicConfigVersionsResponse holds the versions without their entries
+type ListDynamicConfigVersionsResponse struct {
+	Versions []*DynamicConfigVersion `json:"versions,omitempty"`
+	// NextMaxVersion is the MaxVersion of the next page, 0 when there are no more versions
+	NextMaxVersion int64 `json:"nextMaxVersion,omitempty"`
+}
+
+func (v *ListDynamicConfigVersionsResponse) GetVersions() (o []*DynamicConfigVersion) {
+	if v != nil {
+		return v.Versions
+	}
+	return
+}
+
+func (v *ListDynamicConfigVersionsResponse) GetNextMaxVersion() (o int64) {
+	if v != nil {
+		return v.NextMaxVersion
+	}
+	return
+}
+
+// DiffDynamicConfigVersionsRequest compares the entries of two config store versions
+type DiffDynamicConfigVersionsRequest struct {
+	FromVersion int64 `json:"fromVersion,omitempty"`
+	// ToVersion 0 compares against the latest version
+	ToVersion int64 `json:"toVersion,omitempty"`
+}
+
+func (v *DiffDynamicConfigVersionsRequest) GetFromVersion() (o int64) {
+	if v != nil {
+		return v.FromVersion
+	}
+	return
+}
+
+func (v *DiffDynamicConfigVersionsRequest) GetToVersion() (o int64) {
+	if v != nil {
+		return v.ToVersion
+	}
+	return
+}
+
+type DiffDynamicConfigVersionsResponse struct {
+	From  *DynamicConfigVersion     `json:"from,omitempty"`
+	To    *DynamicConfigVersion     `json:"to,omitempty"`
+	Diffs []*DynamicConfigEntryDiff `json:"diffs,omitempty"`
+}
+
+func (v *DiffDynamicConfigVersionsResponse) GetFrom() (o *DynamicConfigVersion) {
+	if v != nil {
+		return v.From
+	}
+	return
+}
+
+func (v *DiffDynamicConfigVersionsResponse) GetTo() (o *DynamicConfigVersion) {
+	if v != nil {
+		return v.To
+	}
+	return
+}
+
+func (v *DiffDynamicConfigVersionsResponse) GetDiffs() (o []*DynamicConfigEntryDiff) {
+	if v != nil {
+		return v.Diffs
+	}
+	return
+}
+
+// RollbackDynamicConfigRequest writes the entries of Version as a new config store version
+type RollbackDynamicConfigRequest struct {
+	Version int64  `json:"version,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+func (v *RollbackDynamicConfigRequest) GetVersion() (o int64) {
+	if v != nil {
+		return v.Version
+	}
+	return
+}
+
+func (v *RollbackDynamicConfigRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+type RollbackDynamicConfigResponse struct {
+	// NewVersion is the version created by the rollback
+	NewVersion int64 `json:"newVersion,omitempty"`
+}
+
+func (v *RollbackDynamicConfigResponse) GetNewVersion() (o int64) {
+	if v != nil {
+		return v.NewVersion
+	}
+	return
+}
+
+type ResolveDynamicConfigRequest struct {
+	ConfigName string                 `json:"configName,omitempty"`
+	Filters    []*DynamicConfigFilter `json:"filters,omitempty"`
+}
+
+func (v *ResolveDynamicConfigRequest) GetConfigName() (o string) {
+	if v != nil {
+		return v.ConfigName
+	}
+	return
+}
+
+func (v *ResolveDynamicConfigRequest) GetFilters() (o []*DynamicConfigFilter) {
+	if v != nil {
+		return v.Filters
+	}
+	return
+}
+
+type ResolveDynamicConfigResponse struct {
+	Value *DataBlob `json:"value,omitempty"`
+	// Source is the dynamic config client which supplied the value
+	Source string `json:"source,omitempty"`
+	// MatchedFilters are the constraints of the winning value, empty when an unconstrained value won
+	MatchedFilters []*DynamicConfigFilter `json:"matchedFilters,omitempty"`
+	// IsDefault is set when nothing is configured for the key and its default value is returned
+	IsDefault bool `json:"isDefault,omitempty"`
+	// Candidates are the other configured values of the key, in evaluation order
+	Candidates []*DynamicConfigCandidate `json:"candidates,omitempty"`
+	// Detail is a client specific description of the evaluation
+	Detail string `json:"detail,omitempty"`
+}
+
+func (v *ResolveDynamicConfigResponse) GetValue() (o *DataBlob) {
+	if v != nil {
+		return v.Value
+	}
+	return
+}
+
+func (v *ResolveDynamicConfigResponse) GetSource() (o string) {
+	if v != nil {
+		return v.Source
+	}
+	return
+}
+
+func (v *ResolveDynamicConfigResponse) GetMatchedFilters() (o []*DynamicConfigFilter) {
+	if v != nil {
+		return v.MatchedFilters
+	}
+	return
+}
+
+func (v *ResolveDynamicConfigResponse) GetIsDefault() (o bool) {
+	if v != nil {
+		return v.IsDefault
+	}
+	return
+}
+
+func (v *ResolveDynamicConfigResponse) GetCandidates() (o []*DynamicConfigCandidate) {
+	if v != nil {
+		return v.Candidates
+	}
+	return
+}
+
+func (v *ResolveDynamicConfigResponse) GetDetail() (o string) {
+	if v != nil {
+		return v.Detail
+	}
+	return
+}
+
+type DynamicConfigCandidate struct {
+	Value   *DataBlob              `json:"value,omitempty"`
+	Filters []*DynamicConfigFilter `json:"filters,omitempty"`
+	// Matched is set when the filters of the candidate match the request, whether or not it won
+	Matched bool `json:"matched,omitempty"`
+}
+
+func (v *DynamicConfigCandidate) GetValue() (o *DataBlob) {
+	if v != nil {
+		return v.Value
+	}
+	return
+}
+
+func (v *DynamicConfigCandidate) GetFilters() (o []*DynamicConfigFilter) {
+	if v != nil {
+		return v.Filters
+	}
+	return
+}
+
+func (v *DynamicConfigCandidate) GetMatched() (o bool) {
+	if v != nil {
+		return v.Matched
+	}
+	return
 }
 
 // AdminDeleteWorkflowRequest is an internal type (TBD...)
@@ -501,3 +744,447 @@ type UpdateTaskListPartitionConfigRequest struct {
 }
 
 type UpdateTaskListPartitionConfigResponse struct{}
+
+type DescribeReplicationStatusRequest struct {
+	// TargetCluster is the cluster replicating from the current cluster
+	TargetCluster string `json:"targetCluster,omitempty"`
+	// ShardIDs, when set, restricts the status to those shards. All shards are described otherwise.
+	ShardIDs []int32 `json:"shardIDs,omitempty"`
+	// Domain, when set, restricts the status to the replication tasks of that domain
+	Domain string `json:"domain,omitempty"`
+}
+
+func (v *DescribeReplicationStatusRequest) GetTargetCluster() (o string) {
+	if v != nil {
+		return v.TargetCluster
+	}
+	return
+}
+
+func (v *DescribeReplicationStatusRequest) GetShardIDs() (o []int32) {
+	if v != nil {
+		return v.ShardIDs
+	}
+	return
+}
+
+func (v *DescribeReplicationStatusRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+type DescribeReplicationStatusResponse struct {
+	SourceCluster string `json:"sourceCluster,omitempty"`
+	TargetCluster string `json:"targetCluster,omitempty"`
+	// Summary aggregates the described shards
+	Summary *ReplicationStatus `json:"summary,omitempty"`
+	// Shards holds the status of each described shard, ordered by shard ID
+	Shards []*ShardReplicationStatus `json:"shards,omitempty"`
+}
+
+func (v *DescribeReplicationStatusResponse) GetSourceCluster() (o string) {
+	if v != nil {
+		return v.SourceCluster
+	}
+	return
+}
+
+func (v *DescribeReplicationStatusResponse) GetTargetCluster() (o string) {
+	if v != nil {
+		return v.TargetCluster
+	}
+	return
+}
+
+func (v *DescribeReplicationStatusResponse) GetSummary() (o *ReplicationStatus) {
+	if v != nil {
+		return v.Summary
+	}
+	return
+}
+
+func (v *DescribeReplicationStatusResponse) GetShards() (o []*ShardReplicationStatus) {
+	if v != nil {
+		return v.Shards
+	}
+	return
+}
+
+// ReplicationStatus is how far a target cluster is behind, for a shard or aggregated over shards. Replication
+// tasks of all the domains of a shard are replicated in order, so the status of a shard bounds the status of each
+// of its domains.
+type ReplicationStatus struct {
+	// AckedTaskID is the replication task ID the target cluster acknowledged. It is not set on summaries.
+	AckedTaskID int64 `json:"ackedTaskID,omitempty"`
+	// MaxTaskID is the ID of the last task created on the shard. It is not set on summaries.
+	MaxTaskID int64 `json:"maxTaskID,omitempty"`
+	// TaskLag is MaxTaskID - AckedTaskID, summed up on summaries. Task IDs are shared by all the task queues of a
+	// shard, so it is an upper bound of the replication tasks the target cluster has not acknowledged yet. When
+	// the status is restricted to a domain, it is the number of pending replication tasks of the domain.
+	TaskLag int64 `json:"taskLag,omitempty"`
+	// TaskLagTruncated is set when the pending tasks of a domain were too many to be all read. TaskLag is then a
+	// lower bound and, if none of them belonged to the domain, the lag is the one of the whole shard.
+	TaskLagTruncated bool `json:"taskLagTruncated,omitempty"`
+	// OldestPendingTaskTime is the creation time of the oldest replication task the target cluster has not
+	// acknowledged yet, in unix nanos. It is not set when nothing is pending.
+	OldestPendingTaskTime int64 `json:"oldestPendingTaskTime,omitempty"`
+	// LagSeconds is the age of the oldest pending task, 0 when nothing is pending
+	LagSeconds int64 `json:"lagSeconds,omitempty"`
+	// DLQSize is the number of tasks from the current cluster in the replication DLQ of the target cluster
+	DLQSize int64 `json:"dlqSize,omitempty"`
+	// Fetcher describes the replication fetch requests the target cluster sent to the shard
+	Fetcher *ReplicationFetcherStatus `json:"fetcher,omitempty"`
+}
+
+func (v *ReplicationStatus) GetAckedTaskID() (o int64) {
+	if v != nil {
+		return v.AckedTaskID
+	}
+	return
+}
+
+func (v *ReplicationStatus) GetMaxTaskID() (o int64) {
+	if v != nil {
+		return v.MaxTaskID
+	}
+	return
+}
+
+func (v *ReplicationStatus) GetTaskLag() (o int64) {
+	if v != nil {
+		return v.TaskLag
+	}
+	return
+}
+
+func (v *ReplicationStatus) GetTaskLagTruncated() (o bool) {
+	if v != nil {
+		return v.TaskLagTruncated
+	}
+	return
+}
+
+func (v *ReplicationStatus) GetOldestPendingTaskTime() (o int64) {
+	if v != nil {
+		return v.OldestPendingTaskTime
+	}
+	return
+}
+
+func (v *ReplicationStatus) GetLagSeconds() (o int64) {
+	if v != nil {
+		return v.LagSeconds
+	}
+	return
+}
+
+func (v *ReplicationStatus) GetDLQSize() (o int64) {
+	if v != nil {
+		return v.DLQSize
+	}
+	return
+}
+
+func (v *ReplicationStatus) GetFetcher() (o *ReplicationFetcherStatus) {
+	if v != nil {
+		return v.Fetcher
+	}
+	return
+}
+
+type ShardReplicationStatus struct {
+	ShardID int32              `json:"shardID,omitempty"`
+	Status  *ReplicationStatus `json:"status,omitempty"`
+}
+
+func (v *ShardReplicationStatus) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+func (v *ShardReplicationStatus) GetStatus() (o *ReplicationStatus) {
+	if v != nil {
+		return v.Status
+	}
+	return
+}
+
+// ReplicationFetcherStatus describes the replication fetch requests of a target cluster, as seen by the history
+// hosts of the current cluster
+type ReplicationFetcherStatus struct {
+	// LastFetchTime is when the target cluster last fetched replication tasks, in unix nanos. On summaries it is
+	// the oldest last fetch time of the shards, 0 when one of them was not fetched from yet.
+	LastFetchTime int64 `json:"lastFetchTime,omitempty"`
+	// ErrorCount is the number of failed fetches since the shard was loaded by its history host
+	ErrorCount int64 `json:"errorCount,omitempty"`
+	// LastError is the error of the last failed fetch
+	LastError string `json:"lastError,omitempty"`
+	// LastErrorTime is when the last fetch failed, in unix nanos
+	LastErrorTime int64 `json:"lastErrorTime,omitempty"`
+}
+
+func (v *ReplicationFetcherStatus) GetLastFetchTime() (o int64) {
+	if v != nil {
+		return v.LastFetchTime
+	}
+	return
+}
+
+func (v *ReplicationFetcherStatus) GetErrorCount() (o int64) {
+	if v != nil {
+		return v.ErrorCount
+	}
+	return
+}
+
+func (v *ReplicationFetcherStatus) GetLastError() (o string) {
+	if v != nil {
+		return v.LastError
+	}
+	return
+}
+
+func (v *ReplicationFetcherStatus) GetLastErrorTime() (o int64) {
+	if v != nil {
+		return v.LastErrorTime
+	}
+	return
+}
+
+// ListTaskListBacklogRequest lists the persisted tasks of a task list partition
+type ListTaskListBacklogRequest struct {
+	Domain string `json:"domain,omitempty"`
+	// TaskList is the name of the partition, e.g. /__cadence_sys/my-tasklist/1 for partition 1
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	PageSize     int32         `json:"pageSize,omitempty"`
+	// NextPageToken continues a previous listing, listing starts at the ack level of the task list otherwise
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+func (v *ListTaskListBacklogRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *ListTaskListBacklogRequest) GetTaskList() (o *TaskList) {
+	if v != nil {
+		return v.TaskList
+	}
+	return
+}
+
+func (v *ListTaskListBacklogRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+func (v *ListTaskListBacklogRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+func (v *ListTaskListBacklogRequest) GetNextPageToken() (o []byte) {
+	if v != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+type ListTaskListBacklogResponse struct {
+	// Tasks are ordered by task ID
+	Tasks         []*TaskListBacklogTask `json:"tasks,omitempty"`
+	NextPageToken []byte                 `json:"nextPageToken,omitempty"`
+}
+
+func (v *ListTaskListBacklogResponse) GetTasks() (o []*TaskListBacklogTask) {
+	if v != nil {
+		return v.Tasks
+	}
+	return
+}
+
+func (v *ListTaskListBacklogResponse) GetNextPageToken() (o []byte) {
+	if v != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// TaskListBacklogTask is a task waiting in the backlog of a task list
+type TaskListBacklogTask struct {
+	TaskID     int64  `json:"taskID,omitempty"`
+	WorkflowID string `json:"workflowID,omitempty"`
+	RunID      string `json:"runID,omitempty"`
+	ScheduleID int64  `json:"scheduleID,omitempty"`
+	// CreatedTime is in unix nanoseconds
+	CreatedTime int64 `json:"createdTime,omitempty"`
+	// ExpiryTime is in unix nanoseconds, 0 when the task doesn't expire
+	ExpiryTime int64 `json:"expiryTime,omitempty"`
+}
+
+func (v *TaskListBacklogTask) GetTaskID() (o int64) {
+	if v != nil {
+		return v.TaskID
+	}
+	return
+}
+
+func (v *TaskListBacklogTask) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+func (v *TaskListBacklogTask) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+func (v *TaskListBacklogTask) GetScheduleID() (o int64) {
+	if v != nil {
+		return v.ScheduleID
+	}
+	return
+}
+
+func (v *TaskListBacklogTask) GetCreatedTime() (o int64) {
+	if v != nil {
+		return v.CreatedTime
+	}
+	return
+}
+
+func (v *TaskListBacklogTask) GetExpiryTime() (o int64) {
+	if v != nil {
+		return v.ExpiryTime
+	}
+	return
+}
+
+// DeleteTaskListBacklogTasksRequest deletes the given tasks from a task list partition
+type DeleteTaskListBacklogTasksRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	TaskIDs      []int64       `json:"taskIDs,omitempty"`
+}
+
+func (v *DeleteTaskListBacklogTasksRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *DeleteTaskListBacklogTasksRequest) GetTaskList() (o *TaskList) {
+	if v != nil {
+		return v.TaskList
+	}
+	return
+}
+
+func (v *DeleteTaskListBacklogTasksRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+func (v *DeleteTaskListBacklogTasksRequest) GetTaskIDs() (o []int64) {
+	if v != nil {
+		return v.TaskIDs
+	}
+	return
+}
+
+// DeleteTaskListBacklogTasksResponse lists the deleted tasks. Tasks which were already dispatched to a poller or
+// completed are not deleted.
+type DeleteTaskListBacklogTasksResponse struct {
+	DeletedTaskIDs []int64 `json:"deletedTaskIDs,omitempty"`
+}
+
+func (v *DeleteTaskListBacklogTasksResponse) GetDeletedTaskIDs() (o []int64) {
+	if v != nil {
+		return v.DeletedTaskIDs
+	}
+	return
+}
+
+// MoveTaskListBacklogRequest moves the tasks in [MinTaskID, MaxTaskID] from a task list partition to another
+// task list of the same type
+type MoveTaskListBacklogRequest struct {
+	Domain              string        `json:"domain,omitempty"`
+	TaskList            *TaskList     `json:"taskList,omitempty"`
+	TaskListType        *TaskListType `json:"taskListType,omitempty"`
+	DestinationTaskList *TaskList     `json:"destinationTaskList,omitempty"`
+	MinTaskID           int64         `json:"minTaskID,omitempty"`
+	// MaxTaskID of 0 moves every task from MinTaskID on
+	MaxTaskID int64 `json:"maxTaskID,omitempty"`
+}
+
+func (v *MoveTaskListBacklogRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *MoveTaskListBacklogRequest) GetTaskList() (o *TaskList) {
+	if v != nil {
+		return v.TaskList
+	}
+	return
+}
+
+func (v *MoveTaskListBacklogRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+func (v *MoveTaskListBacklogRequest) GetDestinationTaskList() (o *TaskList) {
+	if v != nil {
+		return v.DestinationTaskList
+	}
+	return
+}
+
+func (v *MoveTaskListBacklogRequest) GetMinTaskID() (o int64) {
+	if v != nil {
+		return v.MinTaskID
+	}
+	return
+}
+
+func (v *MoveTaskListBacklogRequest) GetMaxTaskID() (o int64) {
+	if v != nil {
+		return v.MaxTaskID
+	}
+	return
+}
+
+type MoveTaskListBacklogResponse struct {
+	MovedTasks int64 `json:"movedTasks,omitempty"`
+}
+
+func (v *MoveTaskListBacklogResponse) GetMovedTasks() (o int64) {
+	if v != nil {
+		return v.MovedTasks
+	}
+	return
+}
@@ -0,0 +1,536 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BatchOperationState is the lifecycle state of a batch operation.
+type BatchOperationState int32
+
+const (
+	BatchOperationStateInvalid   BatchOperationState = iota
+	BatchOperationStateRunning                       // Still processing workflows
+	BatchOperationStateCompleted                     // All matching workflows were processed
+	BatchOperationStateFailed                        // The batch job itself failed or timed out
+	BatchOperationStateStopped                       // Stopped by an operator before completion
+)
+
+func (e BatchOperationState) Ptr() *BatchOperationState { return &e }
+
+func (e BatchOperationState) String() string {
+	switch e {
+	case BatchOperationStateInvalid:
+		return "INVALID"
+	case BatchOperationStateRunning:
+		return "RUNNING"
+	case BatchOperationStateCompleted:
+		return "COMPLETED"
+	case BatchOperationStateFailed:
+		return "FAILED"
+	case BatchOperationStateStopped:
+		return "STOPPED"
+	}
+	return fmt.Sprintf("BatchOperationState(%d)", int32(e))
+}
+
+func (e *BatchOperationState) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "INVALID":
+		*e = BatchOperationStateInvalid
+	case "RUNNING":
+		*e = BatchOperationStateRunning
+	case "COMPLETED":
+		*e = BatchOperationStateCompleted
+	case "FAILED":
+		*e = BatchOperationStateFailed
+	case "STOPPED":
+		*e = BatchOperationStateStopped
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "BatchOperationState", err)
+		}
+		*e = BatchOperationState(val)
+	}
+	return nil
+}
+
+func (e BatchOperationState) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// BatchOperationSignalParams carries the signal to send for a signal batch operation.
+type BatchOperationSignalParams struct {
+	SignalName string `json:"signalName,omitempty"`
+	Input      []byte `json:"-"` // Filtering PII
+}
+
+func (v *BatchOperationSignalParams) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+func (v *BatchOperationSignalParams) GetInput() (o []byte) {
+	if v != nil {
+		return v.Input
+	}
+	return
+}
+
+// BatchOperationReplicateParams carries the clusters for a replicate batch operation.
+type BatchOperationReplicateParams struct {
+	SourceCluster string `json:"sourceCluster,omitempty"`
+	TargetCluster string `json:"targetCluster,omitempty"`
+}
+
+func (v *BatchOperationReplicateParams) GetSourceCluster() (o string) {
+	if v != nil {
+		return v.SourceCluster
+	}
+	return
+}
+
+func (v *BatchOperationReplicateParams) GetTargetCluster() (o string) {
+	if v != nil {
+		return v.TargetCluster
+	}
+	return
+}
+
+// BatchOperationFailure is a distinct reason workflows failed to be processed,
+// with the number of workflows that failed with it.
+type BatchOperationFailure struct {
+	Reason string `json:"reason,omitempty"`
+	Count  int64  `json:"count,omitempty"`
+}
+
+func (v *BatchOperationFailure) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *BatchOperationFailure) GetCount() (o int64) {
+	if v != nil {
+		return v.Count
+	}
+	return
+}
+
+// BatchOperationInfo describes a batch operation and its progress.
+type BatchOperationInfo struct {
+	JobID          string                   `json:"jobId,omitempty"`
+	Domain         string                   `json:"domain,omitempty"`
+	BatchType      string                   `json:"batchType,omitempty"`
+	Query          string                   `json:"query,omitempty"`
+	Reason         string                   `json:"reason,omitempty"`
+	Identity       string                   `json:"identity,omitempty"`
+	State          BatchOperationState      `json:"state,omitempty"`
+	StartTime      *int64                   `json:"startTime,omitempty"`
+	CloseTime      *int64                   `json:"closeTime,omitempty"`
+	TotalEstimate  int64                    `json:"totalEstimate,omitempty"`
+	SucceededCount int64                    `json:"succeededCount,omitempty"`
+	FailedCount    int64                    `json:"failedCount,omitempty"`
+	SkippedCount   int64                    `json:"skippedCount,omitempty"`
+	FailureReasons []*BatchOperationFailure `json:"failureReasons,omitempty"`
+}
+
+func (v *BatchOperationInfo) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetBatchType() (o string) {
+	if v != nil {
+		return v.BatchType
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetQuery() (o string) {
+	if v != nil {
+		return v.Query
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetState() (o BatchOperationState) {
+	if v != nil {
+		return v.State
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetStartTime() (o *int64) {
+	if v != nil {
+		return v.StartTime
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetCloseTime() (o *int64) {
+	if v != nil {
+		return v.CloseTime
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetTotalEstimate() (o int64) {
+	if v != nil {
+		return v.TotalEstimate
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetSucceededCount() (o int64) {
+	if v != nil {
+		return v.SucceededCount
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetFailedCount() (o int64) {
+	if v != nil {
+		return v.FailedCount
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetSkippedCount() (o int64) {
+	if v != nil {
+		return v.SkippedCount
+	}
+	return
+}
+
+func (v *BatchOperationInfo) GetFailureReasons() (o []*BatchOperationFailure) {
+	if v != nil {
+		return v.FailureReasons
+	}
+	return
+}
+
+// StartBatchOperationRequest is the request to start a batch operation over
+// the workflows in Domain matching Query.
+type StartBatchOperationRequest struct {
+	Domain            string                         `json:"domain,omitempty"`
+	JobID             string                         `json:"jobId,omitempty"`
+	BatchType         string                         `json:"batchType,omitempty"`
+	Query             string                         `json:"query,omitempty"`
+	Reason            string                         `json:"reason,omitempty"`
+	Identity          string                         `json:"identity,omitempty"`
+	RequestID         string                         `json:"requestId,omitempty"`
+	SignalParams      *BatchOperationSignalParams    `json:"signalParams,omitempty"`
+	ReplicateParams   *BatchOperationReplicateParams `json:"replicateParams,omitempty"`
+	TerminateChildren *bool                          `json:"terminateChildren,omitempty"`
+	CancelChildren    *bool                          `json:"cancelChildren,omitempty"`
+	RPS               int32                          `json:"rps,omitempty"`
+	Concurrency       int32                          `json:"concurrency,omitempty"`
+	// PageSize, AttemptsOnRetryableError, ActivityHeartBeatTimeoutSeconds and MaxActivityRetries
+	// tune the batch activity, the batcher defaults are used when they are not set
+	PageSize                        int32 `json:"pageSize,omitempty"`
+	AttemptsOnRetryableError        int32 `json:"attemptsOnRetryableError,omitempty"`
+	ActivityHeartBeatTimeoutSeconds int32 `json:"activityHeartBeatTimeoutSeconds,omitempty"`
+	MaxActivityRetries              int32 `json:"maxActivityRetries,omitempty"`
+}
+
+func (v *StartBatchOperationRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetBatchType() (o string) {
+	if v != nil {
+		return v.BatchType
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetQuery() (o string) {
+	if v != nil {
+		return v.Query
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetSignalParams() (o *BatchOperationSignalParams) {
+	if v != nil {
+		return v.SignalParams
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetReplicateParams() (o *BatchOperationReplicateParams) {
+	if v != nil {
+		return v.ReplicateParams
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetTerminateChildren() (o *bool) {
+	if v != nil {
+		return v.TerminateChildren
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetCancelChildren() (o *bool) {
+	if v != nil {
+		return v.CancelChildren
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetRPS() (o int32) {
+	if v != nil {
+		return v.RPS
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetConcurrency() (o int32) {
+	if v != nil {
+		return v.Concurrency
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetAttemptsOnRetryableError() (o int32) {
+	if v != nil {
+		return v.AttemptsOnRetryableError
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetActivityHeartBeatTimeoutSeconds() (o int32) {
+	if v != nil {
+		return v.ActivityHeartBeatTimeoutSeconds
+	}
+	return
+}
+
+func (v *StartBatchOperationRequest) GetMaxActivityRetries() (o int32) {
+	if v != nil {
+		return v.MaxActivityRetries
+	}
+	return
+}
+
+// StartBatchOperationResponse is the response for starting a batch operation.
+type StartBatchOperationResponse struct {
+	JobID string `json:"jobId,omitempty"`
+}
+
+func (v *StartBatchOperationResponse) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
+
+// DescribeBatchOperationRequest is the request to describe a batch operation.
+type DescribeBatchOperationRequest struct {
+	Domain string `json:"domain,omitempty"`
+	JobID  string `json:"jobId,omitempty"`
+}
+
+func (v *DescribeBatchOperationRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *DescribeBatchOperationRequest) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
+
+// DescribeBatchOperationResponse is the response for describing a batch operation.
+type DescribeBatchOperationResponse struct {
+	Info *BatchOperationInfo `json:"info,omitempty"`
+}
+
+func (v *DescribeBatchOperationResponse) GetInfo() (o *BatchOperationInfo) {
+	if v != nil {
+		return v.Info
+	}
+	return
+}
+
+// ListBatchOperationsRequest is the request to list the batch operations
+// started against a domain.
+type ListBatchOperationsRequest struct {
+	Domain        string `json:"domain,omitempty"`
+	PageSize      int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+func (v *ListBatchOperationsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *ListBatchOperationsRequest) GetPageSize() (o int32) {
+	if v != nil {
+		return v.PageSize
+	}
+	return
+}
+
+func (v *ListBatchOperationsRequest) GetNextPageToken() (o []byte) {
+	if v != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// ListBatchOperationsResponse is the response for listing batch operations.
+// Progress counters are not populated; use DescribeBatchOperation for them.
+type ListBatchOperationsResponse struct {
+	Operations    []*BatchOperationInfo `json:"operations,omitempty"`
+	NextPageToken []byte                `json:"nextPageToken,omitempty"`
+}
+
+func (v *ListBatchOperationsResponse) GetOperations() (o []*BatchOperationInfo) {
+	if v != nil {
+		return v.Operations
+	}
+	return
+}
+
+func (v *ListBatchOperationsResponse) GetNextPageToken() (o []byte) {
+	if v != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+// StopBatchOperationRequest is the request to stop a running batch operation.
+// Workflows already processed are not reverted.
+type StopBatchOperationRequest struct {
+	Domain   string `json:"domain,omitempty"`
+	JobID    string `json:"jobId,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+func (v *StopBatchOperationRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+func (v *StopBatchOperationRequest) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
+
+func (v *StopBatchOperationRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *StopBatchOperationRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// StopBatchOperationResponse is the response for stopping a batch operation.
+type StopBatchOperationResponse struct{}
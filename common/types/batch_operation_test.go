@@ -0,0 +1,96 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchOperationState_TextRoundTrip(t *testing.T) {
+	for _, state := range []BatchOperationState{
+		BatchOperationStateInvalid,
+		BatchOperationStateRunning,
+		BatchOperationStateCompleted,
+		BatchOperationStateFailed,
+		BatchOperationStateStopped,
+	} {
+		text, err := state.MarshalText()
+		require.NoError(t, err)
+		var got BatchOperationState
+		require.NoError(t, got.UnmarshalText(text))
+		assert.Equal(t, state, got)
+	}
+
+	var state BatchOperationState
+	require.NoError(t, state.UnmarshalText([]byte("running")))
+	assert.Equal(t, BatchOperationStateRunning, state)
+	require.NoError(t, state.UnmarshalText([]byte("42")))
+	assert.Equal(t, "BatchOperationState(42)", state.String())
+	assert.Error(t, state.UnmarshalText([]byte("unknown")))
+}
+
+func TestBatchOperationInfo_NilGetters(t *testing.T) {
+	var v *BatchOperationInfo
+	assert.Equal(t, "", v.GetJobID())
+	assert.Equal(t, "", v.GetDomain())
+	assert.Equal(t, BatchOperationStateInvalid, v.GetState())
+	assert.Nil(t, v.GetStartTime())
+	assert.Nil(t, v.GetCloseTime())
+	assert.Equal(t, int64(0), v.GetSucceededCount())
+	assert.Equal(t, int64(0), v.GetFailedCount())
+	assert.Equal(t, int64(0), v.GetSkippedCount())
+	assert.Nil(t, v.GetFailureReasons())
+}
+
+func TestStartBatchOperationRequest_NilGetters(t *testing.T) {
+	var v *StartBatchOperationRequest
+	assert.Equal(t, "", v.GetDomain())
+	assert.Equal(t, "", v.GetBatchType())
+	assert.Equal(t, "", v.GetQuery())
+	assert.Nil(t, v.GetSignalParams())
+	assert.Nil(t, v.GetReplicateParams())
+	assert.Nil(t, v.GetTerminateChildren())
+	assert.Equal(t, int32(0), v.GetRPS())
+	assert.Equal(t, int32(0), v.GetPageSize())
+	assert.Equal(t, int32(0), v.GetAttemptsOnRetryableError())
+	assert.Equal(t, int32(0), v.GetActivityHeartBeatTimeoutSeconds())
+	assert.Equal(t, int32(0), v.GetMaxActivityRetries())
+}
+
+func TestBatchOperationRequests_Getters(t *testing.T) {
+	describe := &DescribeBatchOperationRequest{Domain: "d", JobID: "j"}
+	assert.Equal(t, "d", describe.GetDomain())
+	assert.Equal(t, "j", describe.GetJobID())
+
+	list := &ListBatchOperationsRequest{Domain: "d", PageSize: 5, NextPageToken: []byte("t")}
+	assert.Equal(t, "d", list.GetDomain())
+	assert.Equal(t, int32(5), list.GetPageSize())
+	assert.Equal(t, []byte("t"), list.GetNextPageToken())
+
+	stop := &StopBatchOperationRequest{Domain: "d", JobID: "j", Reason: "r", Identity: "i"}
+	assert.Equal(t, "d", stop.GetDomain())
+	assert.Equal(t, "j", stop.GetJobID())
+	assert.Equal(t, "r", stop.GetReason())
+	assert.Equal(t, "i", stop.GetIdentity())
+}
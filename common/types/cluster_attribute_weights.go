@@ -0,0 +1,82 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ClusterAttributeWeights are the relative weights, keyed by scope and then by cluster attribute name, with which
+// workflows using a WorkflowIDHashPolicy are spread over the cluster attributes of an active-active domain. It is
+// stored JSON encoded in the domain data, see constants.DomainDataKeyForClusterAttributeWeights.
+// A scope without weights spreads workflows evenly over all of its cluster attributes. Once a scope has weights,
+// cluster attributes without a weight or with a weight of 0 receive no new workflows.
+type ClusterAttributeWeights map[string]map[string]int
+
+// ParseClusterAttributeWeights decodes JSON encoded ClusterAttributeWeights, an empty string is no weights
+func ParseClusterAttributeWeights(encoded string) (ClusterAttributeWeights, error) {
+	if strings.TrimSpace(encoded) == "" {
+		return nil, nil
+	}
+	var weights ClusterAttributeWeights
+	if err := json.Unmarshal([]byte(encoded), &weights); err != nil {
+		return nil, fmt.Errorf("invalid cluster attribute weights: %w", err)
+	}
+	if err := weights.Validate(); err != nil {
+		return nil, err
+	}
+	return weights, nil
+}
+
+// Validate rejects empty names, negative weights and scopes where no cluster attribute can be selected
+func (w ClusterAttributeWeights) Validate() error {
+	for scope, attributes := range w {
+		if scope == "" {
+			return errors.New("invalid cluster attribute weights: empty scope")
+		}
+		total := 0
+		for name, weight := range attributes {
+			if name == "" {
+				return fmt.Errorf("invalid cluster attribute weights: empty cluster attribute name in scope %s", scope)
+			}
+			if weight < 0 {
+				return fmt.Errorf("invalid cluster attribute weights: negative weight %d for %s.%s", weight, scope, name)
+			}
+			total += weight
+		}
+		if total == 0 {
+			return fmt.Errorf("invalid cluster attribute weights: no positive weight in scope %s", scope)
+		}
+	}
+	return nil
+}
+
+// Weight returns the weight of cluster attribute name in scope
+func (w ClusterAttributeWeights) Weight(scope, name string) int {
+	attributes, ok := w[scope]
+	if !ok {
+		return 1
+	}
+	return attributes[name]
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseClusterAttributeWeights(t *testing.T) {
+	tests := []struct {
+		name        string
+		encoded     string
+		expected    ClusterAttributeWeights
+		expectedErr string
+	}{
+		{
+			name:    "empty",
+			encoded: "",
+		},
+		{
+			name:     "valid",
+			encoded:  `{"region":{"us-east":3,"us-west":1,"eu-west":0}}`,
+			expected: ClusterAttributeWeights{"region": {"us-east": 3, "us-west": 1, "eu-west": 0}},
+		},
+		{
+			name:        "not json",
+			encoded:     "region",
+			expectedErr: "invalid cluster attribute weights",
+		},
+		{
+			name:        "empty scope",
+			encoded:     `{"":{"us-east":1}}`,
+			expectedErr: "invalid cluster attribute weights: empty scope",
+		},
+		{
+			name:        "empty cluster attribute name",
+			encoded:     `{"region":{"":1}}`,
+			expectedErr: "invalid cluster attribute weights: empty cluster attribute name in scope region",
+		},
+		{
+			name:        "negative weight",
+			encoded:     `{"region":{"us-east":-1,"us-west":2}}`,
+			expectedErr: "invalid cluster attribute weights: negative weight -1 for region.us-east",
+		},
+		{
+			name:        "no positive weight",
+			encoded:     `{"region":{"us-east":0}}`,
+			expectedErr: "invalid cluster attribute weights: no positive weight in scope region",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weights, err := ParseClusterAttributeWeights(tt.encoded)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, weights)
+		})
+	}
+}
+
+func TestClusterAttributeWeights_Weight(t *testing.T) {
+	weights := ClusterAttributeWeights{"region": {"us-east": 3, "us-west": 0}}
+
+	assert.Equal(t, 3, weights.Weight("region", "us-east"))
+	assert.Equal(t, 0, weights.Weight("region", "us-west"))
+	assert.Equal(t, 0, weights.Weight("region", "eu-west"))
+	assert.Equal(t, 1, weights.Weight("city", "seattle"))
+	assert.Equal(t, 1, ClusterAttributeWeights(nil).Weight("region", "us-east"))
+}
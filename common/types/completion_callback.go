@@ -0,0 +1,162 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// CompletionCallback is an HTTP endpoint which is sent the close event of a workflow execution.
+// Callbacks are kept in the mutable state of the active cluster only: they are not part of the started event
+// and are not replicated, so a workflow which fails over before it closes is closed without notifying them.
+// The public thrift and gRPC APIs have no field for callbacks yet, so they are only registered by
+// StartWorkflowExecution and their delivery state only returned by DescribeWorkflowExecution through the
+// REST gateway of the frontend.
+type CompletionCallback struct {
+	URL    string            `json:"url,omitempty"`
+	Header map[string]string `json:"header,omitempty"`
+}
+
+// GetURL is an internal getter (TBD...)
+func (v *CompletionCallback) GetURL() (o string) {
+	if v != nil {
+		return v.URL
+	}
+	return
+}
+
+// GetHeader is an internal getter (TBD...)
+func (v *CompletionCallback) GetHeader() (o map[string]string) {
+	if v != nil && v.Header != nil {
+		return v.Header
+	}
+	return
+}
+
+// Validate rejects callbacks without an absolute http or https URL
+func (v *CompletionCallback) Validate() error {
+	if v == nil {
+		return errors.New("invalid completion callback: callback is not set")
+	}
+	u, err := url.Parse(v.URL)
+	if err != nil {
+		return fmt.Errorf("invalid completion callback URL %q: %w", v.URL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid completion callback URL %q: must be an absolute http or https URL", v.URL)
+	}
+	return nil
+}
+
+// CompletionCallbackState is the delivery state of a completion callback
+type CompletionCallbackState int32
+
+const (
+	// CompletionCallbackStateScheduled is a callback which has not been delivered yet
+	CompletionCallbackStateScheduled CompletionCallbackState = iota
+	// CompletionCallbackStateSucceeded is a callback which was acknowledged by the endpoint
+	CompletionCallbackStateSucceeded
+	// CompletionCallbackStateFailed is a callback which will not be retried anymore
+	CompletionCallbackStateFailed
+)
+
+func (v CompletionCallbackState) String() string {
+	switch v {
+	case CompletionCallbackStateScheduled:
+		return "SCHEDULED"
+	case CompletionCallbackStateSucceeded:
+		return "SUCCEEDED"
+	case CompletionCallbackStateFailed:
+		return "FAILED"
+	}
+	return "UNKNOWN"
+}
+
+// Ptr is a helper function for getting pointer to CompletionCallbackState
+func (v CompletionCallbackState) Ptr() *CompletionCallbackState {
+	return &v
+}
+
+// CompletionCallbackInfo is a completion callback together with its delivery state
+type CompletionCallbackInfo struct {
+	Callback             *CompletionCallback     `json:"callback,omitempty"`
+	State                CompletionCallbackState `json:"state"`
+	Attempt              int32                   `json:"attempt,omitempty"`
+	LastAttemptTimestamp *int64                  `json:"lastAttemptTimestamp,omitempty"`
+	LastFailure          string                  `json:"lastFailure,omitempty"`
+}
+
+// GetCallback is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetCallback() (o *CompletionCallback) {
+	if v != nil && v.Callback != nil {
+		return v.Callback
+	}
+	return
+}
+
+// GetState is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetState() (o CompletionCallbackState) {
+	if v != nil {
+		return v.State
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetAttempt() (o int32) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetLastAttemptTimestamp is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetLastAttemptTimestamp() (o int64) {
+	if v != nil && v.LastAttemptTimestamp != nil {
+		return *v.LastAttemptTimestamp
+	}
+	return
+}
+
+// GetLastFailure is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetLastFailure() (o string) {
+	if v != nil {
+		return v.LastFailure
+	}
+	return
+}
+
+// NewCompletionCallbackInfos returns the callbacks in scheduled state, nil if there are no callbacks
+func NewCompletionCallbackInfos(callbacks []*CompletionCallback) []*CompletionCallbackInfo {
+	if len(callbacks) == 0 {
+		return nil
+	}
+	infos := make([]*CompletionCallbackInfo, 0, len(callbacks))
+	for _, callback := range callbacks {
+		infos = append(infos, &CompletionCallbackInfo{
+			Callback: callback,
+			State:    CompletionCallbackStateScheduled,
+		})
+	}
+	return infos
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompletionCallback_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		callback    *CompletionCallback
+		expectedErr string
+	}{
+		{
+			name:     "https",
+			callback: &CompletionCallback{URL: "https://example.com/done", Header: map[string]string{"Authorization": "token"}},
+		},
+		{
+			name:     "http with port",
+			callback: &CompletionCallback{URL: "http://localhost:8080/done"},
+		},
+		{
+			name:        "nil",
+			expectedErr: "callback is not set",
+		},
+		{
+			name:        "empty",
+			callback:    &CompletionCallback{},
+			expectedErr: "must be an absolute http or https URL",
+		},
+		{
+			name:        "relative",
+			callback:    &CompletionCallback{URL: "/done"},
+			expectedErr: "must be an absolute http or https URL",
+		},
+		{
+			name:        "unsupported scheme",
+			callback:    &CompletionCallback{URL: "ftp://example.com/done"},
+			expectedErr: "must be an absolute http or https URL",
+		},
+		{
+			name:        "unparsable",
+			callback:    &CompletionCallback{URL: "http://[::1"},
+			expectedErr: "invalid completion callback URL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.callback.Validate()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewCompletionCallbackInfos(t *testing.T) {
+	assert.Nil(t, NewCompletionCallbackInfos(nil))
+
+	callbacks := []*CompletionCallback{{URL: "https://a.example.com"}, {URL: "https://b.example.com"}}
+	assert.Equal(t, []*CompletionCallbackInfo{
+		{Callback: callbacks[0], State: CompletionCallbackStateScheduled},
+		{Callback: callbacks[1], State: CompletionCallbackStateScheduled},
+	}, NewCompletionCallbackInfos(callbacks))
+}
+
+func TestCompletionCallbackState_String(t *testing.T) {
+	assert.Equal(t, "SCHEDULED", CompletionCallbackStateScheduled.String())
+	assert.Equal(t, "SUCCEEDED", CompletionCallbackStateSucceeded.String())
+	assert.Equal(t, "FAILED", CompletionCallbackStateFailed.String())
+	assert.Equal(t, "UNKNOWN", CompletionCallbackState(10).String())
+}
@@ -40,6 +40,80 @@ type DynamicConfigFilter struct {
 	Value *DataBlob `json:"value,omitempty"`
 }
 
+// DynamicConfigVersion is a snapshot of the dynamic config kept by the config store
+type DynamicConfigVersion struct {
+	Version int64 `json:"version,omitempty"`
+	// Timestamp is the unix nano time the version was written
+	Timestamp *int64                `json:"timestamp,omitempty"`
+	Author    string                `json:"author,omitempty"`
+	Reason    string                `json:"reason,omitempty"`
+	Entries   []*DynamicConfigEntry `json:"entries,omitempty"`
+}
+
+func (v *DynamicConfigVersion) GetVersion() (o int64) {
+	if v != nil {
+		return v.Version
+	}
+	return
+}
+
+func (v *DynamicConfigVersion) GetTimestamp() (o *int64) {
+	if v != nil {
+		return v.Timestamp
+	}
+	return
+}
+
+func (v *DynamicConfigVersion) GetAuthor() (o string) {
+	if v != nil {
+		return v.Author
+	}
+	return
+}
+
+func (v *DynamicConfigVersion) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+func (v *DynamicConfigVersion) GetEntries() (o []*DynamicConfigEntry) {
+	if v != nil {
+		return v.Entries
+	}
+	return
+}
+
+// DynamicConfigEntryDiff is the change of a dynamic config key between two versions.
+// FromValues is empty for an added key and ToValues is empty for a removed key.
+type DynamicConfigEntryDiff struct {
+	Name       string                `json:"name,omitempty"`
+	FromValues []*DynamicConfigValue `json:"fromValues,omitempty"`
+	ToValues   []*DynamicConfigValue `json:"toValues,omitempty"`
+}
+
+func (v *DynamicConfigEntryDiff) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+func (v *DynamicConfigEntryDiff) GetFromValues() (o []*DynamicConfigValue) {
+	if v != nil {
+		return v.FromValues
+	}
+	return
+}
+
+func (v *DynamicConfigEntryDiff) GetToValues() (o []*DynamicConfigValue) {
+	if v != nil {
+		return v.ToValues
+	}
+	return
+}
+
 func (dcf *DynamicConfigFilter) Copy() *DynamicConfigFilter {
 	if dcf == nil {
 		return nil
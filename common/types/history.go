@@ -684,6 +684,24 @@ type RecordDecisionTaskStartedResponse struct {
 	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
 	HistorySize               int64                     `json:"historySize,omitempty"`
+	HistoryCount              int64                     `json:"historyCount,omitempty"`
+	ContinueAsNewSuggested    bool                      `json:"continueAsNewSuggested,omitempty"`
+}
+
+// GetHistoryCount is an internal getter of returning the history event count
+func (v *RecordDecisionTaskStartedResponse) GetHistoryCount() (o int64) {
+	if v != nil {
+		return v.HistoryCount
+	}
+	return
+}
+
+// GetContinueAsNewSuggested is an internal getter (TBD...)
+func (v *RecordDecisionTaskStartedResponse) GetContinueAsNewSuggested() (o bool) {
+	if v != nil {
+		return v.ContinueAsNewSuggested
+	}
+	return
 }
 
 // GetPreviousStartedEventID is an internal getter (TBD...)
@@ -1306,6 +1324,52 @@ func (v *GetFailoverInfoResponse) GetPendingShards() (o []int32) {
 	return
 }
 
+// GetReplicationStatusRequest asks history hosts how far a remote cluster is behind on their shards
+type GetReplicationStatusRequest struct {
+	// ClusterName is the remote cluster replicating from the current cluster
+	ClusterName string  `json:"clusterName,omitempty"`
+	ShardIDs    []int32 `json:"shardIDs,omitempty"`
+	// DomainID, when set, restricts the pending tasks to the ones of that domain
+	DomainID string `json:"domainID,omitempty"`
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *GetReplicationStatusRequest) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// GetShardIDs is an internal getter (TBD...)
+func (v *GetReplicationStatusRequest) GetShardIDs() (o []int32) {
+	if v != nil {
+		return v.ShardIDs
+	}
+	return
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *GetReplicationStatusRequest) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetReplicationStatusResponse holds the status of the requested shards, in no particular order
+type GetReplicationStatusResponse struct {
+	Shards []*ShardReplicationStatus `json:"shards,omitempty"`
+}
+
+// GetShards is an internal getter (TBD...)
+func (v *GetReplicationStatusResponse) GetShards() (o []*ShardReplicationStatus) {
+	if v != nil {
+		return v.Shards
+	}
+	return
+}
+
 type RatelimitUpdateRequest struct {
 	Any *Any `json:"any"`
 }
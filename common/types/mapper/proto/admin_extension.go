@@ -0,0 +1,692 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
+	"github.com/uber/cadence/common/types"
+)
+
+// The admin APIs which are not in the public admin IDL yet are served by the AdminExtensionAPI of frontend.v1.
+// Its dynamic config and replication status messages have the shape of their admin.v1 and history.v1
+// counterparts, the AdminExtension prefix tells their mappers apart.
+
+func FromAdminExtensionDynamicConfigFilter(t *types.DynamicConfigFilter) *frontendv1.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DynamicConfigFilter{
+		Name:  t.Name,
+		Value: FromDataBlob(t.Value),
+	}
+}
+
+func ToAdminExtensionDynamicConfigFilter(t *frontendv1.DynamicConfigFilter) *types.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigFilter{
+		Name:  t.Name,
+		Value: ToDataBlob(t.Value),
+	}
+}
+
+func FromAdminExtensionDynamicConfigFilterArray(t []*types.DynamicConfigFilter) []*frontendv1.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.DynamicConfigFilter, len(t))
+	for i := range t {
+		v[i] = FromAdminExtensionDynamicConfigFilter(t[i])
+	}
+	return v
+}
+
+func ToAdminExtensionDynamicConfigFilterArray(t []*frontendv1.DynamicConfigFilter) []*types.DynamicConfigFilter {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigFilter, len(t))
+	for i := range t {
+		v[i] = ToAdminExtensionDynamicConfigFilter(t[i])
+	}
+	return v
+}
+
+func FromAdminExtensionDynamicConfigValue(t *types.DynamicConfigValue) *frontendv1.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DynamicConfigValue{
+		Value:   FromDataBlob(t.Value),
+		Filters: FromAdminExtensionDynamicConfigFilterArray(t.Filters),
+	}
+}
+
+func ToAdminExtensionDynamicConfigValue(t *frontendv1.DynamicConfigValue) *types.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigValue{
+		Value:   ToDataBlob(t.Value),
+		Filters: ToAdminExtensionDynamicConfigFilterArray(t.Filters),
+	}
+}
+
+func FromAdminExtensionDynamicConfigValueArray(t []*types.DynamicConfigValue) []*frontendv1.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.DynamicConfigValue, len(t))
+	for i := range t {
+		v[i] = FromAdminExtensionDynamicConfigValue(t[i])
+	}
+	return v
+}
+
+func ToAdminExtensionDynamicConfigValueArray(t []*frontendv1.DynamicConfigValue) []*types.DynamicConfigValue {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigValue, len(t))
+	for i := range t {
+		v[i] = ToAdminExtensionDynamicConfigValue(t[i])
+	}
+	return v
+}
+
+func FromAdminExtensionDynamicConfigEntry(t *types.DynamicConfigEntry) *frontendv1.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DynamicConfigEntry{
+		Name:   t.Name,
+		Values: FromAdminExtensionDynamicConfigValueArray(t.Values),
+	}
+}
+
+func ToAdminExtensionDynamicConfigEntry(t *frontendv1.DynamicConfigEntry) *types.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigEntry{
+		Name:   t.Name,
+		Values: ToAdminExtensionDynamicConfigValueArray(t.Values),
+	}
+}
+
+func FromAdminExtensionDynamicConfigEntryArray(t []*types.DynamicConfigEntry) []*frontendv1.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.DynamicConfigEntry, len(t))
+	for i := range t {
+		v[i] = FromAdminExtensionDynamicConfigEntry(t[i])
+	}
+	return v
+}
+
+func ToAdminExtensionDynamicConfigEntryArray(t []*frontendv1.DynamicConfigEntry) []*types.DynamicConfigEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigEntry, len(t))
+	for i := range t {
+		v[i] = ToAdminExtensionDynamicConfigEntry(t[i])
+	}
+	return v
+}
+
+func FromDynamicConfigVersion(t *types.DynamicConfigVersion) *frontendv1.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DynamicConfigVersion{
+		Version:   t.Version,
+		Timestamp: unixNanoToTime(t.Timestamp),
+		Author:    t.Author,
+		Reason:    t.Reason,
+		Entries:   FromAdminExtensionDynamicConfigEntryArray(t.Entries),
+	}
+}
+
+func ToDynamicConfigVersion(t *frontendv1.DynamicConfigVersion) *types.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigVersion{
+		Version:   t.Version,
+		Timestamp: timeToUnixNano(t.Timestamp),
+		Author:    t.Author,
+		Reason:    t.Reason,
+		Entries:   ToAdminExtensionDynamicConfigEntryArray(t.Entries),
+	}
+}
+
+func FromDynamicConfigVersionArray(t []*types.DynamicConfigVersion) []*frontendv1.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.DynamicConfigVersion, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigVersion(t[i])
+	}
+	return v
+}
+
+func ToDynamicConfigVersionArray(t []*frontendv1.DynamicConfigVersion) []*types.DynamicConfigVersion {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigVersion, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigVersion(t[i])
+	}
+	return v
+}
+
+func FromDynamicConfigEntryDiff(t *types.DynamicConfigEntryDiff) *frontendv1.DynamicConfigEntryDiff {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DynamicConfigEntryDiff{
+		Name:       t.Name,
+		FromValues: FromAdminExtensionDynamicConfigValueArray(t.FromValues),
+		ToValues:   FromAdminExtensionDynamicConfigValueArray(t.ToValues),
+	}
+}
+
+func ToDynamicConfigEntryDiff(t *frontendv1.DynamicConfigEntryDiff) *types.DynamicConfigEntryDiff {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigEntryDiff{
+		Name:       t.Name,
+		FromValues: ToAdminExtensionDynamicConfigValueArray(t.FromValues),
+		ToValues:   ToAdminExtensionDynamicConfigValueArray(t.ToValues),
+	}
+}
+
+func FromDynamicConfigEntryDiffArray(t []*types.DynamicConfigEntryDiff) []*frontendv1.DynamicConfigEntryDiff {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.DynamicConfigEntryDiff, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigEntryDiff(t[i])
+	}
+	return v
+}
+
+func ToDynamicConfigEntryDiffArray(t []*frontendv1.DynamicConfigEntryDiff) []*types.DynamicConfigEntryDiff {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigEntryDiff, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigEntryDiff(t[i])
+	}
+	return v
+}
+
+func FromAdminListDynamicConfigVersionsRequest(t *types.ListDynamicConfigVersionsRequest) *frontendv1.ListDynamicConfigVersionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ListDynamicConfigVersionsRequest{
+		MaxVersion: t.MaxVersion,
+		PageSize:   t.PageSize,
+	}
+}
+
+func ToAdminListDynamicConfigVersionsRequest(t *frontendv1.ListDynamicConfigVersionsRequest) *types.ListDynamicConfigVersionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDynamicConfigVersionsRequest{
+		MaxVersion: t.MaxVersion,
+		PageSize:   t.PageSize,
+	}
+}
+
+func FromAdminListDynamicConfigVersionsResponse(t *types.ListDynamicConfigVersionsResponse) *frontendv1.ListDynamicConfigVersionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ListDynamicConfigVersionsResponse{
+		Versions:       FromDynamicConfigVersionArray(t.Versions),
+		NextMaxVersion: t.NextMaxVersion,
+	}
+}
+
+func ToAdminListDynamicConfigVersionsResponse(t *frontendv1.ListDynamicConfigVersionsResponse) *types.ListDynamicConfigVersionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListDynamicConfigVersionsResponse{
+		Versions:       ToDynamicConfigVersionArray(t.Versions),
+		NextMaxVersion: t.NextMaxVersion,
+	}
+}
+
+func FromAdminDiffDynamicConfigVersionsRequest(t *types.DiffDynamicConfigVersionsRequest) *frontendv1.DiffDynamicConfigVersionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DiffDynamicConfigVersionsRequest{
+		FromVersion: t.FromVersion,
+		ToVersion:   t.ToVersion,
+	}
+}
+
+func ToAdminDiffDynamicConfigVersionsRequest(t *frontendv1.DiffDynamicConfigVersionsRequest) *types.DiffDynamicConfigVersionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DiffDynamicConfigVersionsRequest{
+		FromVersion: t.FromVersion,
+		ToVersion:   t.ToVersion,
+	}
+}
+
+func FromAdminDiffDynamicConfigVersionsResponse(t *types.DiffDynamicConfigVersionsResponse) *frontendv1.DiffDynamicConfigVersionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DiffDynamicConfigVersionsResponse{
+		From:  FromDynamicConfigVersion(t.From),
+		To:    FromDynamicConfigVersion(t.To),
+		Diffs: FromDynamicConfigEntryDiffArray(t.Diffs),
+	}
+}
+
+func ToAdminDiffDynamicConfigVersionsResponse(t *frontendv1.DiffDynamicConfigVersionsResponse) *types.DiffDynamicConfigVersionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DiffDynamicConfigVersionsResponse{
+		From:  ToDynamicConfigVersion(t.From),
+		To:    ToDynamicConfigVersion(t.To),
+		Diffs: ToDynamicConfigEntryDiffArray(t.Diffs),
+	}
+}
+
+func FromAdminRollbackDynamicConfigRequest(t *types.RollbackDynamicConfigRequest) *frontendv1.RollbackDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.RollbackDynamicConfigRequest{
+		Version: t.Version,
+		Reason:  t.Reason,
+	}
+}
+
+func ToAdminRollbackDynamicConfigRequest(t *frontendv1.RollbackDynamicConfigRequest) *types.RollbackDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RollbackDynamicConfigRequest{
+		Version: t.Version,
+		Reason:  t.Reason,
+	}
+}
+
+func FromAdminRollbackDynamicConfigResponse(t *types.RollbackDynamicConfigResponse) *frontendv1.RollbackDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.RollbackDynamicConfigResponse{
+		NewVersion: t.NewVersion,
+	}
+}
+
+func ToAdminRollbackDynamicConfigResponse(t *frontendv1.RollbackDynamicConfigResponse) *types.RollbackDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RollbackDynamicConfigResponse{
+		NewVersion: t.NewVersion,
+	}
+}
+
+func FromAdminResolveDynamicConfigRequest(t *types.ResolveDynamicConfigRequest) *frontendv1.ResolveDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ResolveDynamicConfigRequest{
+		ConfigName: t.ConfigName,
+		Filters:    FromAdminExtensionDynamicConfigFilterArray(t.Filters),
+	}
+}
+
+func ToAdminResolveDynamicConfigRequest(t *frontendv1.ResolveDynamicConfigRequest) *types.ResolveDynamicConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResolveDynamicConfigRequest{
+		ConfigName: t.ConfigName,
+		Filters:    ToAdminExtensionDynamicConfigFilterArray(t.Filters),
+	}
+}
+
+func FromAdminResolveDynamicConfigResponse(t *types.ResolveDynamicConfigResponse) *frontendv1.ResolveDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ResolveDynamicConfigResponse{
+		Value:          FromDataBlob(t.Value),
+		Source:         t.Source,
+		MatchedFilters: FromAdminExtensionDynamicConfigFilterArray(t.MatchedFilters),
+		IsDefault:      t.IsDefault,
+		Candidates:     FromDynamicConfigCandidateArray(t.Candidates),
+		Detail:         t.Detail,
+	}
+}
+
+func ToAdminResolveDynamicConfigResponse(t *frontendv1.ResolveDynamicConfigResponse) *types.ResolveDynamicConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ResolveDynamicConfigResponse{
+		Value:          ToDataBlob(t.Value),
+		Source:         t.Source,
+		MatchedFilters: ToAdminExtensionDynamicConfigFilterArray(t.MatchedFilters),
+		IsDefault:      t.IsDefault,
+		Candidates:     ToDynamicConfigCandidateArray(t.Candidates),
+		Detail:         t.Detail,
+	}
+}
+
+func FromDynamicConfigCandidate(t *types.DynamicConfigCandidate) *frontendv1.DynamicConfigCandidate {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DynamicConfigCandidate{
+		Value:   FromDataBlob(t.Value),
+		Filters: FromAdminExtensionDynamicConfigFilterArray(t.Filters),
+		Matched: t.Matched,
+	}
+}
+
+func ToDynamicConfigCandidate(t *frontendv1.DynamicConfigCandidate) *types.DynamicConfigCandidate {
+	if t == nil {
+		return nil
+	}
+	return &types.DynamicConfigCandidate{
+		Value:   ToDataBlob(t.Value),
+		Filters: ToAdminExtensionDynamicConfigFilterArray(t.Filters),
+		Matched: t.Matched,
+	}
+}
+
+func FromDynamicConfigCandidateArray(t []*types.DynamicConfigCandidate) []*frontendv1.DynamicConfigCandidate {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.DynamicConfigCandidate, len(t))
+	for i := range t {
+		v[i] = FromDynamicConfigCandidate(t[i])
+	}
+	return v
+}
+
+func ToDynamicConfigCandidateArray(t []*frontendv1.DynamicConfigCandidate) []*types.DynamicConfigCandidate {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DynamicConfigCandidate, len(t))
+	for i := range t {
+		v[i] = ToDynamicConfigCandidate(t[i])
+	}
+	return v
+}
+
+func FromAdminDescribeReplicationStatusRequest(t *types.DescribeReplicationStatusRequest) *frontendv1.DescribeReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DescribeReplicationStatusRequest{
+		TargetCluster: t.TargetCluster,
+		ShardIds:      t.ShardIDs,
+		Domain:        t.Domain,
+	}
+}
+
+func ToAdminDescribeReplicationStatusRequest(t *frontendv1.DescribeReplicationStatusRequest) *types.DescribeReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeReplicationStatusRequest{
+		TargetCluster: t.TargetCluster,
+		ShardIDs:      t.ShardIds,
+		Domain:        t.Domain,
+	}
+}
+
+func FromAdminDescribeReplicationStatusResponse(t *types.DescribeReplicationStatusResponse) *frontendv1.DescribeReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DescribeReplicationStatusResponse{
+		SourceCluster: t.SourceCluster,
+		TargetCluster: t.TargetCluster,
+		Summary:       FromAdminExtensionReplicationStatus(t.Summary),
+		Shards:        FromAdminExtensionShardReplicationStatusArray(t.Shards),
+	}
+}
+
+func ToAdminDescribeReplicationStatusResponse(t *frontendv1.DescribeReplicationStatusResponse) *types.DescribeReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeReplicationStatusResponse{
+		SourceCluster: t.SourceCluster,
+		TargetCluster: t.TargetCluster,
+		Summary:       ToAdminExtensionReplicationStatus(t.Summary),
+		Shards:        ToAdminExtensionShardReplicationStatusArray(t.Shards),
+	}
+}
+
+func FromAdminExtensionShardReplicationStatusArray(t []*types.ShardReplicationStatus) []*frontendv1.ShardReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.ShardReplicationStatus, len(t))
+	for i := range t {
+		v[i] = FromAdminExtensionShardReplicationStatus(t[i])
+	}
+	return v
+}
+
+func ToAdminExtensionShardReplicationStatusArray(t []*frontendv1.ShardReplicationStatus) []*types.ShardReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ShardReplicationStatus, len(t))
+	for i := range t {
+		v[i] = ToAdminExtensionShardReplicationStatus(t[i])
+	}
+	return v
+}
+
+func FromAdminExtensionShardReplicationStatus(t *types.ShardReplicationStatus) *frontendv1.ShardReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ShardReplicationStatus{
+		ShardId: t.ShardID,
+		Status:  FromAdminExtensionReplicationStatus(t.Status),
+	}
+}
+
+func ToAdminExtensionShardReplicationStatus(t *frontendv1.ShardReplicationStatus) *types.ShardReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ShardReplicationStatus{
+		ShardID: t.ShardId,
+		Status:  ToAdminExtensionReplicationStatus(t.Status),
+	}
+}
+
+func FromAdminExtensionReplicationStatus(t *types.ReplicationStatus) *frontendv1.ReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ReplicationStatus{
+		AckedTaskId:           t.AckedTaskID,
+		MaxTaskId:             t.MaxTaskID,
+		TaskLag:               t.TaskLag,
+		TaskLagTruncated:      t.TaskLagTruncated,
+		OldestPendingTaskTime: nonZeroUnixNanoToTime(t.OldestPendingTaskTime),
+		LagSeconds:            t.LagSeconds,
+		DlqSize:               t.DLQSize,
+		Fetcher:               FromAdminExtensionReplicationFetcherStatus(t.Fetcher),
+	}
+}
+
+func ToAdminExtensionReplicationStatus(t *frontendv1.ReplicationStatus) *types.ReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationStatus{
+		AckedTaskID:           t.AckedTaskId,
+		MaxTaskID:             t.MaxTaskId,
+		TaskLag:               t.TaskLag,
+		TaskLagTruncated:      t.TaskLagTruncated,
+		OldestPendingTaskTime: timeToNonZeroUnixNano(t.OldestPendingTaskTime),
+		LagSeconds:            t.LagSeconds,
+		DLQSize:               t.DlqSize,
+		Fetcher:               ToAdminExtensionReplicationFetcherStatus(t.Fetcher),
+	}
+}
+
+func FromAdminExtensionReplicationFetcherStatus(t *types.ReplicationFetcherStatus) *frontendv1.ReplicationFetcherStatus {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ReplicationFetcherStatus{
+		LastFetchTime: nonZeroUnixNanoToTime(t.LastFetchTime),
+		ErrorCount:    t.ErrorCount,
+		LastError:     t.LastError,
+		LastErrorTime: nonZeroUnixNanoToTime(t.LastErrorTime),
+	}
+}
+
+func ToAdminExtensionReplicationFetcherStatus(t *frontendv1.ReplicationFetcherStatus) *types.ReplicationFetcherStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationFetcherStatus{
+		LastFetchTime: timeToNonZeroUnixNano(t.LastFetchTime),
+		ErrorCount:    t.ErrorCount,
+		LastError:     t.LastError,
+		LastErrorTime: timeToNonZeroUnixNano(t.LastErrorTime),
+	}
+}
+
+func FromAdminMoveTaskListBacklogRequest(t *types.MoveTaskListBacklogRequest) *frontendv1.MoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.MoveTaskListBacklogRequest{
+		Domain:              t.Domain,
+		TaskList:            FromTaskList(t.TaskList),
+		TaskListType:        FromTaskListType(t.TaskListType),
+		DestinationTaskList: FromTaskList(t.DestinationTaskList),
+		MinTaskId:           t.MinTaskID,
+		MaxTaskId:           t.MaxTaskID,
+	}
+}
+
+func ToAdminMoveTaskListBacklogRequest(t *frontendv1.MoveTaskListBacklogRequest) *types.MoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListBacklogRequest{
+		Domain:              t.Domain,
+		TaskList:            ToTaskList(t.TaskList),
+		TaskListType:        ToTaskListType(t.TaskListType),
+		DestinationTaskList: ToTaskList(t.DestinationTaskList),
+		MinTaskID:           t.MinTaskId,
+		MaxTaskID:           t.MaxTaskId,
+	}
+}
+
+func FromAdminMoveTaskListBacklogResponse(t *types.MoveTaskListBacklogResponse) *frontendv1.MoveTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.MoveTaskListBacklogResponse{
+		MovedTasks: t.MovedTasks,
+	}
+}
+
+func ToAdminMoveTaskListBacklogResponse(t *frontendv1.MoveTaskListBacklogResponse) *types.MoveTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListBacklogResponse{
+		MovedTasks: t.MovedTasks,
+	}
+}
+
+func FromAdminDeleteTaskListBacklogTasksRequest(t *types.DeleteTaskListBacklogTasksRequest) *frontendv1.DeleteTaskListBacklogTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DeleteTaskListBacklogTasksRequest{
+		Domain:       t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		TaskIds:      t.TaskIDs,
+	}
+}
+
+func ToAdminDeleteTaskListBacklogTasksRequest(t *frontendv1.DeleteTaskListBacklogTasksRequest) *types.DeleteTaskListBacklogTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteTaskListBacklogTasksRequest{
+		Domain:       t.Domain,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		TaskIDs:      t.TaskIds,
+	}
+}
+
+func FromAdminDeleteTaskListBacklogTasksResponse(t *types.DeleteTaskListBacklogTasksResponse) *frontendv1.DeleteTaskListBacklogTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DeleteTaskListBacklogTasksResponse{
+		DeletedTaskIds: t.DeletedTaskIDs,
+	}
+}
+
+func ToAdminDeleteTaskListBacklogTasksResponse(t *frontendv1.DeleteTaskListBacklogTasksResponse) *types.DeleteTaskListBacklogTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DeleteTaskListBacklogTasksResponse{
+		DeletedTaskIDs: t.DeletedTaskIds,
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"testing"
+
+	"github.com/uber/cadence/common/types/mapper/testutils"
+)
+
+func TestAdminListDynamicConfigVersionsRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminListDynamicConfigVersionsRequest, ToAdminListDynamicConfigVersionsRequest)
+}
+
+func TestAdminListDynamicConfigVersionsResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminListDynamicConfigVersionsResponse, ToAdminListDynamicConfigVersionsResponse,
+		testutils.WithCustomFuncs(testutils.EncodingTypeFuzzer),
+	)
+}
+
+func TestAdminDiffDynamicConfigVersionsRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminDiffDynamicConfigVersionsRequest, ToAdminDiffDynamicConfigVersionsRequest)
+}
+
+func TestAdminDiffDynamicConfigVersionsResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminDiffDynamicConfigVersionsResponse, ToAdminDiffDynamicConfigVersionsResponse,
+		testutils.WithCustomFuncs(testutils.EncodingTypeFuzzer),
+	)
+}
+
+func TestAdminRollbackDynamicConfigRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminRollbackDynamicConfigRequest, ToAdminRollbackDynamicConfigRequest)
+}
+
+func TestAdminRollbackDynamicConfigResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminRollbackDynamicConfigResponse, ToAdminRollbackDynamicConfigResponse)
+}
+
+func TestAdminResolveDynamicConfigRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminResolveDynamicConfigRequest, ToAdminResolveDynamicConfigRequest,
+		testutils.WithCustomFuncs(testutils.EncodingTypeFuzzer),
+	)
+}
+
+func TestAdminResolveDynamicConfigResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminResolveDynamicConfigResponse, ToAdminResolveDynamicConfigResponse,
+		testutils.WithCustomFuncs(testutils.EncodingTypeFuzzer),
+	)
+}
+
+func TestAdminDescribeReplicationStatusRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminDescribeReplicationStatusRequest, ToAdminDescribeReplicationStatusRequest)
+}
+
+func TestAdminDescribeReplicationStatusResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminDescribeReplicationStatusResponse, ToAdminDescribeReplicationStatusResponse)
+}
+
+func TestAdminMoveTaskListBacklogRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminMoveTaskListBacklogRequest, ToAdminMoveTaskListBacklogRequest)
+}
+
+func TestAdminMoveTaskListBacklogResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminMoveTaskListBacklogResponse, ToAdminMoveTaskListBacklogResponse)
+}
+
+func TestAdminDeleteTaskListBacklogTasksRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminDeleteTaskListBacklogTasksRequest, ToAdminDeleteTaskListBacklogTasksRequest)
+}
+
+func TestAdminDeleteTaskListBacklogTasksResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromAdminDeleteTaskListBacklogTasksResponse, ToAdminDeleteTaskListBacklogTasksResponse)
+}
@@ -1003,8 +1003,10 @@ func TestAdminResetQueueRequestFuzz(t *testing.T) {
 }
 
 func TestAdminUpdateDynamicConfigRequestFuzz(t *testing.T) {
+	// Reason is not in the IDL yet, the admin clients send it in a header
 	testutils.RunMapperFuzzTest(t, FromAdminUpdateDynamicConfigRequest, ToAdminUpdateDynamicConfigRequest,
 		testutils.WithCustomFuncs(testutils.EncodingTypeFuzzer),
+		testutils.WithExcludedFields("Reason"),
 	)
 }
 
@@ -1185,8 +1187,10 @@ func TestAdminGetWorkflowExecutionRawHistoryV2RequestFuzz(t *testing.T) {
 }
 
 func TestAdminRestoreDynamicConfigRequestFuzz(t *testing.T) {
+	// Reason is not in the IDL yet, the admin clients send it in a header
 	testutils.RunMapperFuzzTest(t, FromAdminRestoreDynamicConfigRequest, ToAdminRestoreDynamicConfigRequest,
 		testutils.WithCustomFuncs(testutils.EncodingTypeFuzzer),
+		testutils.WithExcludedFields("Reason"),
 	)
 }
 
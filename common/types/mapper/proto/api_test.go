@@ -1531,6 +1531,8 @@ func TestListFailoverHistoryResponseMapping(t *testing.T) {
 			if v.ID != nil && *v.ID == "" {
 				v.ID = nil
 			}
+			// Comment is not in the IDL yet, frontend sends it in a response header
+			v.Comment = nil
 		})
 	for i := 0; i < 100; i++ {
 		var response types.ListFailoverHistoryResponse
@@ -1785,7 +1787,10 @@ func TestListDomainsRequestFuzz(t *testing.T) {
 }
 
 func TestPollForActivityTaskResponseFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromPollForActivityTaskResponse, ToPollForActivityTaskResponse)
+	// RecommendedPollerCount is not in the IDL yet, frontend sends it in a response header
+	testutils.RunMapperFuzzTest(t, FromPollForActivityTaskResponse, ToPollForActivityTaskResponse,
+		testutils.WithExcludedFields("RecommendedPollerCount"),
+	)
 }
 
 func TestTaskListMetadataFuzz(t *testing.T) {
@@ -1805,17 +1810,20 @@ func TestListFailoverHistoryResponseFuzz(t *testing.T) {
 func TestDescribeTaskListResponseFuzz(t *testing.T) {
 	// TaskListPartitionConfig has map[int] fields that get truncated to map[int32] in proto
 	// From and To are non-invertable operations, so we rely on testdata to verify the mapping is correct
+	// RecommendedPollerCount is not in the IDL yet, frontend sends it in a response header
 	testutils.RunMapperFuzzTest(t, FromDescribeTaskListResponse, ToDescribeTaskListResponse,
-		testutils.WithExcludedFields("ReadPartitions", "WritePartitions"),
+		testutils.WithExcludedFields("ReadPartitions", "WritePartitions", "RecommendedPollerCount"),
 	)
 }
 
 func TestStartWorkflowExecutionAsyncRequestFuzz(t *testing.T) {
+	// WorkflowIDHash, WorkflowIDConflictPolicy and CompletionCallbacks are not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromStartWorkflowExecutionAsyncRequest, ToStartWorkflowExecutionAsyncRequest,
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
 		),
+		testutils.WithExcludedFields("WorkflowIDHash", "WorkflowIDConflictPolicy", "CompletionCallbacks"),
 	)
 }
 
@@ -1897,7 +1905,8 @@ func TestFailoverDomainResponseFuzz(t *testing.T) {
 			testutils.DomainStatusFuzzer,
 			ArchivalStatusFuzzer,
 		),
-		testutils.WithExcludedFields("EmitMetric", "WorkflowExecutionRetentionPeriodInDays"),
+		// Comment is not in the IDL yet
+		testutils.WithExcludedFields("EmitMetric", "WorkflowExecutionRetentionPeriodInDays", "Comment"),
 	)
 }
 
@@ -1912,6 +1921,8 @@ func TestFailoverEventArrayFuzz(t *testing.T) {
 				if e.ID != nil && *e.ID == "" {
 					e.ID = nil
 				}
+				// Comment is not in the IDL yet, frontend sends it in a response header
+				e.Comment = nil
 			},
 		),
 	)
@@ -1964,11 +1975,13 @@ func TestSignalExternalWorkflowExecutionInitiatedEventAttributesFuzz(t *testing.
 }
 
 func TestStartChildWorkflowExecutionInitiatedEventAttributesFuzz(t *testing.T) {
+	// WorkflowIDHash is not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromStartChildWorkflowExecutionInitiatedEventAttributes, ToStartChildWorkflowExecutionInitiatedEventAttributes,
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
 		),
+		testutils.WithExcludedFields("WorkflowIDHash"),
 	)
 }
 
@@ -2029,6 +2042,7 @@ func TestWorkflowQueryFuzz(t *testing.T) {
 }
 
 func TestHistoryEventFuzz(t *testing.T) {
+	// WorkflowIDHash is not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromHistoryEvent, ToHistoryEvent,
 		testutils.WithCustomFuncs(
 			EventTypeFuzzer,
@@ -2048,6 +2062,7 @@ func TestHistoryEventFuzz(t *testing.T) {
 				}
 			},
 		),
+		testutils.WithExcludedFields("WorkflowIDHash"),
 	)
 }
 
@@ -2127,6 +2142,7 @@ func TestRequestCancelExternalWorkflowExecutionDecisionAttributesFuzz(t *testing
 }
 
 func TestDecisionFuzz(t *testing.T) {
+	// WorkflowIDHash is not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromDecision, ToDecision,
 		testutils.WithCustomFuncs(
 			func(d *types.Decision, c fuzz.Continue) {
@@ -2142,6 +2158,7 @@ func TestDecisionFuzz(t *testing.T) {
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
 		),
+		testutils.WithExcludedFields("WorkflowIDHash"),
 	)
 }
 
@@ -2165,6 +2182,8 @@ func TestChildWorkflowExecutionStartedEventAttributesFuzz(t *testing.T) {
 func TestPollForDecisionTaskResponseFuzz(t *testing.T) {
 	// History.Events: nil vs empty slice (mapper creates empty when nil)
 	// Contains HistoryEvent array which needs comprehensive enum fuzzers
+	// WorkflowIDHash, HistoryCount, ContinueAsNewSuggested and RecommendedPollerCount are not in the IDL yet,
+	// frontend sends all but WorkflowIDHash in response headers
 	testutils.RunMapperFuzzTest(t, FromPollForDecisionTaskResponse, ToPollForDecisionTaskResponse,
 		testutils.WithCustomFuncs(
 			func(h *types.History, c fuzz.Continue) {
@@ -2196,10 +2215,12 @@ func TestPollForDecisionTaskResponseFuzz(t *testing.T) {
 			WorkflowExecutionCloseStatusFuzzer,
 			ParentClosePolicyFuzzer,
 		),
+		testutils.WithExcludedFields("WorkflowIDHash", "HistoryCount", "ContinueAsNewSuggested", "RecommendedPollerCount"),
 	)
 }
 
 func TestDecisionArrayFuzz(t *testing.T) {
+	// WorkflowIDHash is not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromDecisionArray, ToDecisionArray,
 		testutils.WithCustomFuncs(
 			func(d *types.Decision, c fuzz.Continue) {
@@ -2215,6 +2236,7 @@ func TestDecisionArrayFuzz(t *testing.T) {
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
 		),
+		testutils.WithExcludedFields("WorkflowIDHash"),
 	)
 }
 
@@ -2370,11 +2392,13 @@ func TestResetPointsFuzz(t *testing.T) {
 }
 
 func TestSignalWithStartWorkflowExecutionRequestFuzz(t *testing.T) {
+	// WorkflowIDHash and WorkflowIDConflictPolicy are not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromSignalWithStartWorkflowExecutionRequest, ToSignalWithStartWorkflowExecutionRequest,
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 			CronOverlapPolicyFuzzer,
 		),
+		testutils.WithExcludedFields("WorkflowIDHash", "WorkflowIDConflictPolicy"),
 	)
 }
 
@@ -2467,7 +2491,10 @@ func TestStartChildWorkflowExecutionFailedEventAttributesFuzz(t *testing.T) {
 }
 
 func TestTaskListStatusFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromTaskListStatus, ToTaskListStatus)
+	// RecommendedPollerCount is not in the IDL yet, frontend sends it in a response header
+	testutils.RunMapperFuzzTest(t, FromTaskListStatus, ToTaskListStatus,
+		testutils.WithExcludedFields("RecommendedPollerCount"),
+	)
 }
 
 func TestTaskListPartitionMetadataArrayFuzz(t *testing.T) {
@@ -2587,10 +2614,12 @@ func TestActivityLocalDispatchInfoFuzz(t *testing.T) {
 }
 
 func TestStartWorkflowExecutionRequestFuzz(t *testing.T) {
+	// WorkflowIDHash, WorkflowIDConflictPolicy and CompletionCallbacks are not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromStartWorkflowExecutionRequest, ToStartWorkflowExecutionRequest,
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 		),
+		testutils.WithExcludedFields("WorkflowIDHash", "WorkflowIDConflictPolicy", "CompletionCallbacks"),
 	)
 }
 
@@ -2622,6 +2651,8 @@ func TestDescribeTaskListResponseMapFuzz(t *testing.T) {
 				}
 			},
 		),
+		// RecommendedPollerCount is not in the IDL yet, frontend only sends it in a response header for a single task list
+		testutils.WithExcludedFields("RecommendedPollerCount"),
 	)
 }
 
@@ -2672,7 +2703,10 @@ func TestActiveClusterInfoFuzz(t *testing.T) {
 }
 
 func TestActiveClusterSelectionPolicyFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromActiveClusterSelectionPolicy, ToActiveClusterSelectionPolicy)
+	// WorkflowIDHash is not in the IDL yet
+	testutils.RunMapperFuzzTest(t, FromActiveClusterSelectionPolicy, ToActiveClusterSelectionPolicy,
+		testutils.WithExcludedFields("WorkflowIDHash"),
+	)
 }
 
 func TestRespondActivityTaskCanceledRequestFuzz(t *testing.T) {
@@ -2727,8 +2761,10 @@ func TestWorkflowExecutionInfoFuzz(t *testing.T) {
 	// [Intended] ParentDomainID, ParentDomain: converted to empty string instead of nil when ParentExecutionInfo exists but field is empty
 	// [Intended] ParentInitiatedID: converted to 0 instead of nil when ParentExecutionInfo exists but field is 0
 	// [BUG] CronSchedule is not round trip safe with an empty string
+	// ReplicationExcluded is not in the IDL yet, frontend sends it in a response header
+	// WorkflowIDHash is not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromWorkflowExecutionInfo, ToWorkflowExecutionInfo,
-		testutils.WithExcludedFields("UpdateTime", "ParentDomainID", "ParentDomain", "ParentInitiatedID", "CronSchedule"),
+		testutils.WithExcludedFields("UpdateTime", "ParentDomainID", "ParentDomain", "ParentInitiatedID", "CronSchedule", "ReplicationExcluded", "WorkflowIDHash"),
 	)
 }
 
@@ -2741,10 +2777,12 @@ func TestSignalExternalWorkflowExecutionDecisionAttributesFuzz(t *testing.T) {
 }
 
 func TestSignalWithStartWorkflowExecutionAsyncRequestFuzz(t *testing.T) {
+	// WorkflowIDHash and WorkflowIDConflictPolicy are not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromSignalWithStartWorkflowExecutionAsyncRequest, ToSignalWithStartWorkflowExecutionAsyncRequest,
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 		),
+		testutils.WithExcludedFields("WorkflowIDHash", "WorkflowIDConflictPolicy"),
 	)
 }
 
@@ -2804,7 +2842,9 @@ func TestDescribeDomainResponseFuzz(t *testing.T) {
 				}
 			},
 		),
-		testutils.WithExcludedFields("EmitMetric"), // EmitMetric is deprecated and permanently set to true
+		// EmitMetric is deprecated and permanently set to true
+		// OpenWorkflowUsage is not in the IDL yet, frontend sends it in a response header
+		testutils.WithExcludedFields("EmitMetric", "OpenWorkflowUsage"),
 	)
 }
 
@@ -2825,6 +2865,8 @@ func TestPayloadFuzz(t *testing.T) {
 }
 
 func TestRespondDecisionTaskCompletedResponseFuzz(t *testing.T) {
+	// WorkflowIDHash, HistoryCount, ContinueAsNewSuggested and RecommendedPollerCount are not in the IDL yet,
+	// frontend sends all but WorkflowIDHash in response headers
 	testutils.RunMapperFuzzTest(t, FromRespondDecisionTaskCompletedResponse, ToRespondDecisionTaskCompletedResponse,
 		testutils.WithCustomFuncs(
 			func(h *types.History, c fuzz.Continue) {
@@ -2835,6 +2877,7 @@ func TestRespondDecisionTaskCompletedResponseFuzz(t *testing.T) {
 				}
 			},
 		),
+		testutils.WithExcludedFields("WorkflowIDHash", "HistoryCount", "ContinueAsNewSuggested", "RecommendedPollerCount"),
 	)
 }
 
@@ -2845,11 +2888,12 @@ func TestStartTimeFilterFuzz(t *testing.T) {
 func TestWorkflowExecutionContinuedAsNewEventAttributesFuzz(t *testing.T) {
 	// JitterStartSeconds don't roundtrip correctly
 	// [BUG] FailureDetails requires FailureReason to be set
+	// WorkflowIDHash is not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromWorkflowExecutionContinuedAsNewEventAttributes, ToWorkflowExecutionContinuedAsNewEventAttributes,
 		testutils.WithCustomFuncs(
 			ContinueAsNewInitiatorFuzzer,
 		),
-		testutils.WithExcludedFields("JitterStartSeconds", "FailureDetails"),
+		testutils.WithExcludedFields("JitterStartSeconds", "FailureDetails", "WorkflowIDHash"),
 	)
 }
 
@@ -2916,6 +2960,7 @@ func TestWorkflowExecutionStartedEventAttributesFuzz(t *testing.T) {
 	// [BUG] FromFailure only creates a Failure object if reason is non-nil, so details without reason are dropped
 	// JitterStartSeconds don't roundtrip
 	// Empty string fields become nil
+	// WorkflowIDHash is not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromWorkflowExecutionStartedEventAttributes, ToWorkflowExecutionStartedEventAttributes,
 		testutils.WithCustomFuncs(
 			func(e *types.WorkflowExecutionStartedEventAttributes, c fuzz.Continue) {
@@ -2929,7 +2974,7 @@ func TestWorkflowExecutionStartedEventAttributesFuzz(t *testing.T) {
 				}
 			},
 		),
-		testutils.WithExcludedFields("JitterStartSeconds"),
+		testutils.WithExcludedFields("JitterStartSeconds", "WorkflowIDHash"),
 	)
 }
 
@@ -2953,6 +2998,7 @@ func TestClusterFailoverFuzz(t *testing.T) {
 func TestDescribeDomainResponseDomainFuzz(t *testing.T) {
 	// WorkflowExecutionRetentionPeriodInDays: nil→0 conversion
 	// [BUG] DomainInfo, Configuration, ReplicationConfiguration must be non-nil
+	// OpenWorkflowUsage is not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromDescribeDomainResponseDomain, ToDescribeDomainResponseDomain,
 		testutils.WithCustomFuncs(
 			testutils.DomainStatusFuzzer,
@@ -2971,7 +3017,7 @@ func TestDescribeDomainResponseDomainFuzz(t *testing.T) {
 				}
 			},
 		),
-		testutils.WithExcludedFields("WorkflowExecutionRetentionPeriodInDays", "EmitMetric"),
+		testutils.WithExcludedFields("WorkflowExecutionRetentionPeriodInDays", "EmitMetric", "OpenWorkflowUsage"),
 	)
 }
 
@@ -3059,7 +3105,10 @@ func TestPayloadMapFuzz(t *testing.T) {
 }
 
 func TestAutoConfigHintFuzz(t *testing.T) {
-	testutils.RunMapperFuzzTest(t, FromAutoConfigHint, ToAutoConfigHint)
+	// RecommendedPollerCount is not in the IDL yet, frontend sends it in a response header
+	testutils.RunMapperFuzzTest(t, FromAutoConfigHint, ToAutoConfigHint,
+		testutils.WithExcludedFields("RecommendedPollerCount"),
+	)
 }
 
 func TestBadBinariesFuzz(t *testing.T) {
@@ -3071,10 +3120,12 @@ func TestSignalWithStartWorkflowExecutionResponseFuzz(t *testing.T) {
 }
 
 func TestStartChildWorkflowExecutionDecisionAttributesFuzz(t *testing.T) {
+	// WorkflowIDHash is not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromStartChildWorkflowExecutionDecisionAttributes, ToStartChildWorkflowExecutionDecisionAttributes,
 		testutils.WithCustomFuncs(
 			WorkflowIDReusePolicyFuzzer,
 		),
+		testutils.WithExcludedFields("WorkflowIDHash"),
 	)
 }
 
@@ -3100,6 +3151,8 @@ func TestFailoverEventFuzz(t *testing.T) {
 				if e.ID != nil && *e.ID == "" {
 					e.ID = nil
 				}
+				// Comment is not in the IDL yet, frontend sends it in a response header
+				e.Comment = nil
 			},
 		),
 	)
@@ -3108,11 +3161,12 @@ func TestFailoverEventFuzz(t *testing.T) {
 func TestContinueAsNewWorkflowExecutionDecisionAttributesFuzz(t *testing.T) {
 	// [BUG] FromFailure only creates a Failure object if reason is non-nil, so details without reason are dropped
 	// JitterStartSeconds doesn't roundtrip correctly
+	// WorkflowIDHash is not in the IDL yet
 	testutils.RunMapperFuzzTest(t, FromContinueAsNewWorkflowExecutionDecisionAttributes, ToContinueAsNewWorkflowExecutionDecisionAttributes,
 		testutils.WithCustomFuncs(
 			ContinueAsNewInitiatorFuzzer,
 		),
-		testutils.WithExcludedFields("JitterStartSeconds", "FailureDetails"),
+		testutils.WithExcludedFields("JitterStartSeconds", "FailureDetails", "WorkflowIDHash"),
 	)
 }
 
@@ -0,0 +1,399 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// --- Enum mappers ---
+
+func FromBatchOperationState(s types.BatchOperationState) frontendv1.BatchOperationState {
+	switch s {
+	case types.BatchOperationStateRunning:
+		return frontendv1.BatchOperationState_BATCH_OPERATION_STATE_RUNNING
+	case types.BatchOperationStateCompleted:
+		return frontendv1.BatchOperationState_BATCH_OPERATION_STATE_COMPLETED
+	case types.BatchOperationStateFailed:
+		return frontendv1.BatchOperationState_BATCH_OPERATION_STATE_FAILED
+	case types.BatchOperationStateStopped:
+		return frontendv1.BatchOperationState_BATCH_OPERATION_STATE_STOPPED
+	}
+	return frontendv1.BatchOperationState_BATCH_OPERATION_STATE_INVALID
+}
+
+func ToBatchOperationState(s frontendv1.BatchOperationState) types.BatchOperationState {
+	switch s {
+	case frontendv1.BatchOperationState_BATCH_OPERATION_STATE_RUNNING:
+		return types.BatchOperationStateRunning
+	case frontendv1.BatchOperationState_BATCH_OPERATION_STATE_COMPLETED:
+		return types.BatchOperationStateCompleted
+	case frontendv1.BatchOperationState_BATCH_OPERATION_STATE_FAILED:
+		return types.BatchOperationStateFailed
+	case frontendv1.BatchOperationState_BATCH_OPERATION_STATE_STOPPED:
+		return types.BatchOperationStateStopped
+	}
+	return types.BatchOperationStateInvalid
+}
+
+// --- Struct mappers ---
+
+func FromBatchOperationSignalParams(t *types.BatchOperationSignalParams) *frontendv1.BatchOperationSignalParams {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.BatchOperationSignalParams{
+		SignalName: t.SignalName,
+		Input:      t.Input,
+	}
+}
+
+func ToBatchOperationSignalParams(t *frontendv1.BatchOperationSignalParams) *types.BatchOperationSignalParams {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationSignalParams{
+		SignalName: t.SignalName,
+		Input:      t.Input,
+	}
+}
+
+func FromBatchOperationReplicateParams(t *types.BatchOperationReplicateParams) *frontendv1.BatchOperationReplicateParams {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.BatchOperationReplicateParams{
+		SourceCluster: t.SourceCluster,
+		TargetCluster: t.TargetCluster,
+	}
+}
+
+func ToBatchOperationReplicateParams(t *frontendv1.BatchOperationReplicateParams) *types.BatchOperationReplicateParams {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationReplicateParams{
+		SourceCluster: t.SourceCluster,
+		TargetCluster: t.TargetCluster,
+	}
+}
+
+func FromBatchOperationFailure(t *types.BatchOperationFailure) *frontendv1.BatchOperationFailure {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.BatchOperationFailure{
+		Reason: t.Reason,
+		Count:  t.Count,
+	}
+}
+
+func ToBatchOperationFailure(t *frontendv1.BatchOperationFailure) *types.BatchOperationFailure {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationFailure{
+		Reason: t.Reason,
+		Count:  t.Count,
+	}
+}
+
+func FromBatchOperationFailureArray(t []*types.BatchOperationFailure) []*frontendv1.BatchOperationFailure {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.BatchOperationFailure, len(t))
+	for i := range t {
+		v[i] = FromBatchOperationFailure(t[i])
+	}
+	return v
+}
+
+func ToBatchOperationFailureArray(t []*frontendv1.BatchOperationFailure) []*types.BatchOperationFailure {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.BatchOperationFailure, len(t))
+	for i := range t {
+		v[i] = ToBatchOperationFailure(t[i])
+	}
+	return v
+}
+
+func FromBatchOperationInfo(t *types.BatchOperationInfo) *frontendv1.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.BatchOperationInfo{
+		JobId:          t.JobID,
+		Domain:         t.Domain,
+		BatchType:      t.BatchType,
+		Query:          t.Query,
+		Reason:         t.Reason,
+		Identity:       t.Identity,
+		State:          FromBatchOperationState(t.State),
+		StartTime:      unixNanoToTime(t.StartTime),
+		CloseTime:      unixNanoToTime(t.CloseTime),
+		TotalEstimate:  t.TotalEstimate,
+		SucceededCount: t.SucceededCount,
+		FailedCount:    t.FailedCount,
+		SkippedCount:   t.SkippedCount,
+		FailureReasons: FromBatchOperationFailureArray(t.FailureReasons),
+	}
+}
+
+func ToBatchOperationInfo(t *frontendv1.BatchOperationInfo) *types.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.BatchOperationInfo{
+		JobID:          t.JobId,
+		Domain:         t.Domain,
+		BatchType:      t.BatchType,
+		Query:          t.Query,
+		Reason:         t.Reason,
+		Identity:       t.Identity,
+		State:          ToBatchOperationState(t.State),
+		StartTime:      timeToUnixNano(t.StartTime),
+		CloseTime:      timeToUnixNano(t.CloseTime),
+		TotalEstimate:  t.TotalEstimate,
+		SucceededCount: t.SucceededCount,
+		FailedCount:    t.FailedCount,
+		SkippedCount:   t.SkippedCount,
+		FailureReasons: ToBatchOperationFailureArray(t.FailureReasons),
+	}
+}
+
+func FromBatchOperationInfoArray(t []*types.BatchOperationInfo) []*frontendv1.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*frontendv1.BatchOperationInfo, len(t))
+	for i := range t {
+		v[i] = FromBatchOperationInfo(t[i])
+	}
+	return v
+}
+
+func ToBatchOperationInfoArray(t []*frontendv1.BatchOperationInfo) []*types.BatchOperationInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.BatchOperationInfo, len(t))
+	for i := range t {
+		v[i] = ToBatchOperationInfo(t[i])
+	}
+	return v
+}
+
+// --- Request/Response mappers ---
+
+func FromStartBatchOperationRequest(t *types.StartBatchOperationRequest) *frontendv1.StartBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	var activityHeartbeatTimeout *int32
+	if t.ActivityHeartBeatTimeoutSeconds != 0 {
+		activityHeartbeatTimeout = common.Int32Ptr(t.ActivityHeartBeatTimeoutSeconds)
+	}
+	return &frontendv1.StartBatchOperationRequest{
+		Domain:                   t.Domain,
+		JobId:                    t.JobID,
+		BatchType:                t.BatchType,
+		Query:                    t.Query,
+		Reason:                   t.Reason,
+		Identity:                 t.Identity,
+		RequestId:                t.RequestID,
+		SignalParams:             FromBatchOperationSignalParams(t.SignalParams),
+		ReplicateParams:          FromBatchOperationReplicateParams(t.ReplicateParams),
+		TerminateChildren:        fromBoolValue(t.TerminateChildren),
+		CancelChildren:           fromBoolValue(t.CancelChildren),
+		Rps:                      t.RPS,
+		Concurrency:              t.Concurrency,
+		PageSize:                 t.PageSize,
+		AttemptsOnRetryableError: t.AttemptsOnRetryableError,
+		ActivityHeartbeatTimeout: secondsToDuration(activityHeartbeatTimeout),
+		MaxActivityRetries:       t.MaxActivityRetries,
+	}
+}
+
+func ToStartBatchOperationRequest(t *frontendv1.StartBatchOperationRequest) *types.StartBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.StartBatchOperationRequest{
+		Domain:                          t.Domain,
+		JobID:                           t.JobId,
+		BatchType:                       t.BatchType,
+		Query:                           t.Query,
+		Reason:                          t.Reason,
+		Identity:                        t.Identity,
+		RequestID:                       t.RequestId,
+		SignalParams:                    ToBatchOperationSignalParams(t.SignalParams),
+		ReplicateParams:                 ToBatchOperationReplicateParams(t.ReplicateParams),
+		TerminateChildren:               toBoolValue(t.TerminateChildren),
+		CancelChildren:                  toBoolValue(t.CancelChildren),
+		RPS:                             t.Rps,
+		Concurrency:                     t.Concurrency,
+		PageSize:                        t.PageSize,
+		AttemptsOnRetryableError:        t.AttemptsOnRetryableError,
+		ActivityHeartBeatTimeoutSeconds: common.Int32Default(durationToSeconds(t.ActivityHeartbeatTimeout)),
+		MaxActivityRetries:              t.MaxActivityRetries,
+	}
+}
+
+func FromStartBatchOperationResponse(t *types.StartBatchOperationResponse) *frontendv1.StartBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.StartBatchOperationResponse{
+		JobId: t.JobID,
+	}
+}
+
+func ToStartBatchOperationResponse(t *frontendv1.StartBatchOperationResponse) *types.StartBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.StartBatchOperationResponse{
+		JobID: t.JobId,
+	}
+}
+
+func FromDescribeBatchOperationRequest(t *types.DescribeBatchOperationRequest) *frontendv1.DescribeBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DescribeBatchOperationRequest{
+		Domain: t.Domain,
+		JobId:  t.JobID,
+	}
+}
+
+func ToDescribeBatchOperationRequest(t *frontendv1.DescribeBatchOperationRequest) *types.DescribeBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeBatchOperationRequest{
+		Domain: t.Domain,
+		JobID:  t.JobId,
+	}
+}
+
+func FromDescribeBatchOperationResponse(t *types.DescribeBatchOperationResponse) *frontendv1.DescribeBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.DescribeBatchOperationResponse{
+		Info: FromBatchOperationInfo(t.Info),
+	}
+}
+
+func ToDescribeBatchOperationResponse(t *frontendv1.DescribeBatchOperationResponse) *types.DescribeBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeBatchOperationResponse{
+		Info: ToBatchOperationInfo(t.Info),
+	}
+}
+
+func FromListBatchOperationsRequest(t *types.ListBatchOperationsRequest) *frontendv1.ListBatchOperationsRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ListBatchOperationsRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListBatchOperationsRequest(t *frontendv1.ListBatchOperationsRequest) *types.ListBatchOperationsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListBatchOperationsRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromListBatchOperationsResponse(t *types.ListBatchOperationsResponse) *frontendv1.ListBatchOperationsResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.ListBatchOperationsResponse{
+		Operations:    FromBatchOperationInfoArray(t.Operations),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func ToListBatchOperationsResponse(t *frontendv1.ListBatchOperationsResponse) *types.ListBatchOperationsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListBatchOperationsResponse{
+		Operations:    ToBatchOperationInfoArray(t.Operations),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+func FromStopBatchOperationRequest(t *types.StopBatchOperationRequest) *frontendv1.StopBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.StopBatchOperationRequest{
+		Domain:   t.Domain,
+		JobId:    t.JobID,
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
+func ToStopBatchOperationRequest(t *frontendv1.StopBatchOperationRequest) *types.StopBatchOperationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.StopBatchOperationRequest{
+		Domain:   t.Domain,
+		JobID:    t.JobId,
+		Reason:   t.Reason,
+		Identity: t.Identity,
+	}
+}
+
+func FromStopBatchOperationResponse(t *types.StopBatchOperationResponse) *frontendv1.StopBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &frontendv1.StopBatchOperationResponse{}
+}
+
+func ToStopBatchOperationResponse(t *frontendv1.StopBatchOperationResponse) *types.StopBatchOperationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.StopBatchOperationResponse{}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/testutils"
+)
+
+func TestBatchOperationState(t *testing.T) {
+	for _, item := range []types.BatchOperationState{
+		types.BatchOperationStateInvalid,
+		types.BatchOperationStateRunning,
+		types.BatchOperationStateCompleted,
+		types.BatchOperationStateFailed,
+		types.BatchOperationStateStopped,
+	} {
+		assert.Equal(t, item, ToBatchOperationState(FromBatchOperationState(item)))
+	}
+}
+
+func TestStartBatchOperationRequest(t *testing.T) {
+	for _, item := range []*types.StartBatchOperationRequest{nil, {}, {
+		Domain:                          "domain",
+		JobID:                           "job-id",
+		BatchType:                       "signal",
+		Query:                           "WorkflowType = 'wf'",
+		Reason:                          "reason",
+		Identity:                        "identity",
+		RequestID:                       "request-id",
+		SignalParams:                    &types.BatchOperationSignalParams{SignalName: "signal", Input: []byte("input")},
+		TerminateChildren:               common.BoolPtr(true),
+		CancelChildren:                  common.BoolPtr(false),
+		RPS:                             50,
+		Concurrency:                     5,
+		PageSize:                        1000,
+		AttemptsOnRetryableError:        3,
+		ActivityHeartBeatTimeoutSeconds: 10,
+		MaxActivityRetries:              2,
+	}} {
+		assert.Equal(t, item, ToStartBatchOperationRequest(FromStartBatchOperationRequest(item)))
+	}
+}
+
+func TestDescribeBatchOperationResponse(t *testing.T) {
+	for _, item := range []*types.DescribeBatchOperationResponse{nil, {}, {
+		Info: &types.BatchOperationInfo{
+			JobID:          "job-id",
+			Domain:         "domain",
+			BatchType:      "terminate",
+			State:          types.BatchOperationStateFailed,
+			StartTime:      common.Int64Ptr(1000),
+			CloseTime:      common.Int64Ptr(2000),
+			TotalEstimate:  10,
+			SucceededCount: 7,
+			FailedCount:    3,
+			FailureReasons: []*types.BatchOperationFailure{{Reason: "not found", Count: 3}},
+		},
+	}} {
+		assert.Equal(t, item, ToDescribeBatchOperationResponse(FromDescribeBatchOperationResponse(item)))
+	}
+}
+
+func TestStartBatchOperationRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromStartBatchOperationRequest, ToStartBatchOperationRequest)
+}
+
+func TestStartBatchOperationResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromStartBatchOperationResponse, ToStartBatchOperationResponse)
+}
+
+func TestDescribeBatchOperationRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromDescribeBatchOperationRequest, ToDescribeBatchOperationRequest)
+}
+
+func TestDescribeBatchOperationResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromDescribeBatchOperationResponse, ToDescribeBatchOperationResponse,
+		WithBatchOperationEnumFuzzers(),
+	)
+}
+
+func TestListBatchOperationsRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromListBatchOperationsRequest, ToListBatchOperationsRequest)
+}
+
+func TestListBatchOperationsResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromListBatchOperationsResponse, ToListBatchOperationsResponse,
+		WithBatchOperationEnumFuzzers(),
+	)
+}
+
+func TestStopBatchOperationRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromStopBatchOperationRequest, ToStopBatchOperationRequest)
+}
+
+func TestStopBatchOperationResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromStopBatchOperationResponse, ToStopBatchOperationResponse)
+}
+
+// WithBatchOperationEnumFuzzers constrains the batch operation state to its defined values
+func WithBatchOperationEnumFuzzers() testutils.FuzzOption {
+	return testutils.WithCustomFuncs(
+		func(e *types.BatchOperationState, c fuzz.Continue) {
+			*e = types.BatchOperationState(c.Intn(5)) // 0-4: Invalid through Stopped
+		},
+	)
+}
@@ -43,6 +43,20 @@ func toDoubleValue(v *gogo.DoubleValue) *float64 {
 	return common.Float64Ptr(v.Value)
 }
 
+func fromBoolValue(v *bool) *gogo.BoolValue {
+	if v == nil {
+		return nil
+	}
+	return &gogo.BoolValue{Value: *v}
+}
+
+func toBoolValue(v *gogo.BoolValue) *bool {
+	if v == nil {
+		return nil
+	}
+	return common.BoolPtr(v.Value)
+}
+
 func fromInt64Value(v *int64) *gogo.Int64Value {
 	if v == nil {
 		return nil
@@ -79,6 +93,18 @@ func timeToUnixNano(t *gogo.Timestamp) *int64 {
 	return common.Int64Ptr(timestamp.UnixNano())
 }
 
+// nonZeroUnixNanoToTime maps a unix nano time where 0 means unset
+func nonZeroUnixNanoToTime(t int64) *gogo.Timestamp {
+	if t == 0 {
+		return nil
+	}
+	return unixNanoToTime(&t)
+}
+
+func timeToNonZeroUnixNano(t *gogo.Timestamp) int64 {
+	return common.Int64Default(timeToUnixNano(t))
+}
+
 func timeToTimestamp(t *time.Time) *gogo.Timestamp {
 	if t == nil || t.IsZero() {
 		return nil
@@ -198,6 +198,8 @@ func FromHistoryDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecu
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		ReplicationExcluded:    t.WorkflowExecutionInfo.GetReplicationExcluded(),
+		CompletionCallbacks:    FromCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -205,12 +207,18 @@ func ToHistoryDescribeWorkflowExecutionResponse(t *historyv1.DescribeWorkflowExe
 	if t == nil {
 		return nil
 	}
+	workflowExecutionInfo := ToWorkflowExecutionInfo(t.WorkflowExecutionInfo)
+	if workflowExecutionInfo != nil {
+		// the public IDL has no replication exclusion, it travels next to the execution info
+		workflowExecutionInfo.ReplicationExcluded = t.ReplicationExcluded
+	}
 	return &types.DescribeWorkflowExecutionResponse{
 		ExecutionConfiguration: ToWorkflowExecutionConfiguration(t.ExecutionConfiguration),
-		WorkflowExecutionInfo:  ToWorkflowExecutionInfo(t.WorkflowExecutionInfo),
+		WorkflowExecutionInfo:  workflowExecutionInfo,
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		CompletionCallbacks:    ToCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -901,6 +909,8 @@ func FromHistoryRecordDecisionTaskStartedResponse(t *types.RecordDecisionTaskSta
 		StartedTime:               unixNanoToTime(t.StartedTimestamp),
 		Queries:                   FromWorkflowQueryMap(t.Queries),
 		HistorySize:               t.HistorySize,
+		HistoryCount:              t.HistoryCount,
+		ContinueAsNewSuggested:    t.ContinueAsNewSuggested,
 	}
 }
 
@@ -924,6 +934,8 @@ func ToHistoryRecordDecisionTaskStartedResponse(t *historyv1.RecordDecisionTaskS
 		StartedTimestamp:          timeToUnixNano(t.StartedTime),
 		Queries:                   ToWorkflowQueryMap(t.Queries),
 		HistorySize:               t.HistorySize,
+		HistoryCount:              t.HistoryCount,
+		ContinueAsNewSuggested:    t.ContinueAsNewSuggested,
 	}
 }
 
@@ -1279,10 +1291,16 @@ func FromHistorySignalWithStartWorkflowExecutionRequest(t *types.HistorySignalWi
 	if t == nil {
 		return nil
 	}
+	var workflowIDConflictPolicy *types.WorkflowIDConflictPolicy
+	if t.SignalWithStartRequest != nil {
+		workflowIDConflictPolicy = t.SignalWithStartRequest.WorkflowIDConflictPolicy
+	}
 	return &historyv1.SignalWithStartWorkflowExecutionRequest{
-		Request:         FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
-		DomainId:        t.DomainUUID,
-		PartitionConfig: t.PartitionConfig,
+		Request:                  FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
+		DomainId:                 t.DomainUUID,
+		PartitionConfig:          t.PartitionConfig,
+		WorkflowIdHash:           FromWorkflowIDHashPolicy(t.SignalWithStartRequest.GetActiveClusterSelectionPolicy().GetWorkflowIDHash()),
+		WorkflowIdConflictPolicy: FromWorkflowIDConflictPolicy(workflowIDConflictPolicy),
 	}
 }
 
@@ -1290,8 +1308,14 @@ func ToHistorySignalWithStartWorkflowExecutionRequest(t *historyv1.SignalWithSta
 	if t == nil {
 		return nil
 	}
+	signalWithStartRequest := ToSignalWithStartWorkflowExecutionRequest(t.Request)
+	if signalWithStartRequest != nil {
+		// the public IDL has no workflow ID hash and no workflow ID conflict policy, they travel next to the signal with start request
+		signalWithStartRequest.ActiveClusterSelectionPolicy = withWorkflowIDHash(signalWithStartRequest.ActiveClusterSelectionPolicy, ToWorkflowIDHashPolicy(t.WorkflowIdHash))
+		signalWithStartRequest.WorkflowIDConflictPolicy = ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy)
+	}
 	return &types.HistorySignalWithStartWorkflowExecutionRequest{
-		SignalWithStartRequest: ToSignalWithStartWorkflowExecutionRequest(t.Request),
+		SignalWithStartRequest: signalWithStartRequest,
 		DomainUUID:             t.DomainId,
 		PartitionConfig:        t.PartitionConfig,
 	}
@@ -1339,10 +1363,26 @@ func ToHistorySignalWorkflowExecutionRequest(t *historyv1.SignalWorkflowExecutio
 	}
 }
 
+// withWorkflowIDHash adds the workflow ID hash carried next to a start request to its active cluster selection policy
+func withWorkflowIDHash(policy *types.ActiveClusterSelectionPolicy, hash *types.WorkflowIDHashPolicy) *types.ActiveClusterSelectionPolicy {
+	if hash == nil {
+		return policy
+	}
+	if policy == nil {
+		policy = &types.ActiveClusterSelectionPolicy{}
+	}
+	policy.WorkflowIDHash = hash
+	return policy
+}
+
 func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecutionRequest) *historyv1.StartWorkflowExecutionRequest {
 	if t == nil {
 		return nil
 	}
+	var workflowIDConflictPolicy *types.WorkflowIDConflictPolicy
+	if t.StartRequest != nil {
+		workflowIDConflictPolicy = t.StartRequest.WorkflowIDConflictPolicy
+	}
 	return &historyv1.StartWorkflowExecutionRequest{
 		Request:                  FromStartWorkflowExecutionRequest(t.StartRequest),
 		DomainId:                 t.DomainUUID,
@@ -1354,6 +1394,9 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		LastCompletionResult:     FromPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoff: secondsToDuration(t.FirstDecisionTaskBackoffSeconds),
 		PartitionConfig:          t.PartitionConfig,
+		CompletionCallbacks:      FromCompletionCallbackArray(t.StartRequest.GetCompletionCallbacks()),
+		WorkflowIdHash:           FromWorkflowIDHashPolicy(t.StartRequest.GetActiveClusterSelectionPolicy().GetWorkflowIDHash()),
+		WorkflowIdConflictPolicy: FromWorkflowIDConflictPolicy(workflowIDConflictPolicy),
 	}
 }
 
@@ -1361,8 +1404,16 @@ func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionR
 	if t == nil {
 		return nil
 	}
+	startRequest := ToStartWorkflowExecutionRequest(t.Request)
+	if startRequest != nil {
+		// the public IDL has no completion callbacks, no workflow ID hash and no workflow ID conflict policy,
+		// they travel next to the start request
+		startRequest.CompletionCallbacks = ToCompletionCallbackArray(t.CompletionCallbacks)
+		startRequest.ActiveClusterSelectionPolicy = withWorkflowIDHash(startRequest.ActiveClusterSelectionPolicy, ToWorkflowIDHashPolicy(t.WorkflowIdHash))
+		startRequest.WorkflowIDConflictPolicy = ToWorkflowIDConflictPolicy(t.WorkflowIdConflictPolicy)
+	}
 	return &types.HistoryStartWorkflowExecutionRequest{
-		StartRequest:                    ToStartWorkflowExecutionRequest(t.Request),
+		StartRequest:                    startRequest,
 		DomainUUID:                      t.DomainId,
 		ParentExecutionInfo:             ToParentExecutionInfo(t.ParentExecutionInfo),
 		Attempt:                         t.Attempt,
@@ -1623,3 +1674,141 @@ func ToHistoryRatelimitUpdateResponse(t *historyv1.RatelimitUpdateResponse) *typ
 		Any: ToAny(t.Data),
 	}
 }
+
+func FromHistoryGetReplicationStatusRequest(t *types.GetReplicationStatusRequest) *historyv1.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetReplicationStatusRequest{
+		ClusterName: t.ClusterName,
+		ShardIds:    t.ShardIDs,
+		DomainId:    t.DomainID,
+	}
+}
+
+func ToHistoryGetReplicationStatusRequest(t *historyv1.GetReplicationStatusRequest) *types.GetReplicationStatusRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationStatusRequest{
+		ClusterName: t.ClusterName,
+		ShardIDs:    t.ShardIds,
+		DomainID:    t.DomainId,
+	}
+}
+
+func FromHistoryGetReplicationStatusResponse(t *types.GetReplicationStatusResponse) *historyv1.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetReplicationStatusResponse{
+		Shards: FromShardReplicationStatusArray(t.Shards),
+	}
+}
+
+func ToHistoryGetReplicationStatusResponse(t *historyv1.GetReplicationStatusResponse) *types.GetReplicationStatusResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationStatusResponse{
+		Shards: ToShardReplicationStatusArray(t.Shards),
+	}
+}
+
+func FromShardReplicationStatusArray(t []*types.ShardReplicationStatus) []*historyv1.ShardReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.ShardReplicationStatus, len(t))
+	for i := range t {
+		v[i] = FromShardReplicationStatus(t[i])
+	}
+	return v
+}
+
+func ToShardReplicationStatusArray(t []*historyv1.ShardReplicationStatus) []*types.ShardReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ShardReplicationStatus, len(t))
+	for i := range t {
+		v[i] = ToShardReplicationStatus(t[i])
+	}
+	return v
+}
+
+func FromShardReplicationStatus(t *types.ShardReplicationStatus) *historyv1.ShardReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ShardReplicationStatus{
+		ShardId: t.ShardID,
+		Status:  FromReplicationStatus(t.Status),
+	}
+}
+
+func ToShardReplicationStatus(t *historyv1.ShardReplicationStatus) *types.ShardReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ShardReplicationStatus{
+		ShardID: t.ShardId,
+		Status:  ToReplicationStatus(t.Status),
+	}
+}
+
+func FromReplicationStatus(t *types.ReplicationStatus) *historyv1.ReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReplicationStatus{
+		AckedTaskId:           t.AckedTaskID,
+		MaxTaskId:             t.MaxTaskID,
+		TaskLag:               t.TaskLag,
+		TaskLagTruncated:      t.TaskLagTruncated,
+		OldestPendingTaskTime: nonZeroUnixNanoToTime(t.OldestPendingTaskTime),
+		LagSeconds:            t.LagSeconds,
+		DlqSize:               t.DLQSize,
+		Fetcher:               FromReplicationFetcherStatus(t.Fetcher),
+	}
+}
+
+func ToReplicationStatus(t *historyv1.ReplicationStatus) *types.ReplicationStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationStatus{
+		AckedTaskID:           t.AckedTaskId,
+		MaxTaskID:             t.MaxTaskId,
+		TaskLag:               t.TaskLag,
+		TaskLagTruncated:      t.TaskLagTruncated,
+		OldestPendingTaskTime: timeToNonZeroUnixNano(t.OldestPendingTaskTime),
+		LagSeconds:            t.LagSeconds,
+		DLQSize:               t.DlqSize,
+		Fetcher:               ToReplicationFetcherStatus(t.Fetcher),
+	}
+}
+
+func FromReplicationFetcherStatus(t *types.ReplicationFetcherStatus) *historyv1.ReplicationFetcherStatus {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReplicationFetcherStatus{
+		LastFetchTime: nonZeroUnixNanoToTime(t.LastFetchTime),
+		ErrorCount:    t.ErrorCount,
+		LastError:     t.LastError,
+		LastErrorTime: nonZeroUnixNanoToTime(t.LastErrorTime),
+	}
+}
+
+func ToReplicationFetcherStatus(t *historyv1.ReplicationFetcherStatus) *types.ReplicationFetcherStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationFetcherStatus{
+		LastFetchTime: timeToNonZeroUnixNano(t.LastFetchTime),
+		ErrorCount:    t.ErrorCount,
+		LastError:     t.LastError,
+		LastErrorTime: timeToNonZeroUnixNano(t.LastErrorTime),
+	}
+}
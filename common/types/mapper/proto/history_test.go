@@ -28,6 +28,7 @@ import (
 
 	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	sharedv1 "github.com/uber/cadence/.gen/proto/shared/v1"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/testutils"
 	"github.com/uber/cadence/common/types/testdata"
@@ -78,6 +79,36 @@ func TestHistoryDescribeWorkflowExecutionResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryDescribeWorkflowExecutionResponse(FromHistoryDescribeWorkflowExecutionResponse(item)))
 	}
 }
+func TestHistoryDescribeWorkflowExecutionResponseReplicationExcluded(t *testing.T) {
+	info := testdata.WorkflowExecutionInfo
+	info.ReplicationExcluded = true
+	item := testdata.HistoryDescribeWorkflowExecutionResponse
+	item.WorkflowExecutionInfo = &info
+	assert.Equal(t, &item, ToHistoryDescribeWorkflowExecutionResponse(FromHistoryDescribeWorkflowExecutionResponse(&item)))
+}
+func TestHistoryDescribeWorkflowExecutionResponseCompletionCallbacks(t *testing.T) {
+	item := testdata.HistoryDescribeWorkflowExecutionResponse
+	item.CompletionCallbacks = []*types.CompletionCallbackInfo{
+		{
+			Callback: &types.CompletionCallback{URL: "https://example.com/callback", Header: map[string]string{"key": "value"}},
+			State:    types.CompletionCallbackStateScheduled,
+		},
+		{
+			Callback:             &types.CompletionCallback{URL: "https://example.com/succeeded"},
+			State:                types.CompletionCallbackStateSucceeded,
+			Attempt:              2,
+			LastAttemptTimestamp: common.Int64Ptr(testdata.Timestamp1),
+		},
+		{
+			Callback:             &types.CompletionCallback{URL: "https://example.com/failed"},
+			State:                types.CompletionCallbackStateFailed,
+			Attempt:              5,
+			LastAttemptTimestamp: common.Int64Ptr(testdata.Timestamp2),
+			LastFailure:          "503 Service Unavailable",
+		},
+	}
+	assert.Equal(t, &item, ToHistoryDescribeWorkflowExecutionResponse(FromHistoryDescribeWorkflowExecutionResponse(&item)))
+}
 func TestHistoryGetDLQReplicationMessagesRequest(t *testing.T) {
 	for _, item := range []*types.GetDLQReplicationMessagesRequest{nil, {}, &testdata.HistoryGetDLQReplicationMessagesRequest} {
 		assert.Equal(t, item, ToHistoryGetDLQReplicationMessagesRequest(FromHistoryGetDLQReplicationMessagesRequest(item)))
@@ -296,6 +327,31 @@ func TestHistorySignalWithStartWorkflowExecutionRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistorySignalWithStartWorkflowExecutionRequest(FromHistorySignalWithStartWorkflowExecutionRequest(item)))
 	}
 }
+func TestHistorySignalWithStartWorkflowExecutionRequestWorkflowIDHash(t *testing.T) {
+	for _, policy := range []*types.ActiveClusterSelectionPolicy{
+		{WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "region"}},
+		{ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "region1"}, WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "region"}},
+	} {
+		signalWithStartRequest := testdata.SignalWithStartWorkflowExecutionRequest
+		signalWithStartRequest.ActiveClusterSelectionPolicy = policy
+		item := testdata.HistorySignalWithStartWorkflowExecutionRequest
+		item.SignalWithStartRequest = &signalWithStartRequest
+		assert.Equal(t, &item, ToHistorySignalWithStartWorkflowExecutionRequest(FromHistorySignalWithStartWorkflowExecutionRequest(&item)))
+	}
+}
+func TestHistorySignalWithStartWorkflowExecutionRequestWorkflowIDConflictPolicy(t *testing.T) {
+	for _, policy := range []types.WorkflowIDConflictPolicy{
+		types.WorkflowIDConflictPolicyFail,
+		types.WorkflowIDConflictPolicyUseExisting,
+		types.WorkflowIDConflictPolicySignalExisting,
+	} {
+		signalWithStartRequest := testdata.SignalWithStartWorkflowExecutionRequest
+		signalWithStartRequest.WorkflowIDConflictPolicy = policy.Ptr()
+		item := testdata.HistorySignalWithStartWorkflowExecutionRequest
+		item.SignalWithStartRequest = &signalWithStartRequest
+		assert.Equal(t, &item, ToHistorySignalWithStartWorkflowExecutionRequest(FromHistorySignalWithStartWorkflowExecutionRequest(&item)))
+	}
+}
 func TestHistorySignalWithStartWorkflowExecutionResponse(t *testing.T) {
 	for _, item := range []*types.StartWorkflowExecutionResponse{nil, {}, &testdata.HistorySignalWithStartWorkflowExecutionResponse} {
 		assert.Equal(t, item, ToHistorySignalWithStartWorkflowExecutionResponse(FromHistorySignalWithStartWorkflowExecutionResponse(item)))
@@ -311,6 +367,42 @@ func TestHistoryStartWorkflowExecutionRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(item)))
 	}
 }
+func TestHistoryStartWorkflowExecutionRequestCompletionCallbacks(t *testing.T) {
+	startRequest := testdata.StartWorkflowExecutionRequest
+	startRequest.CompletionCallbacks = []*types.CompletionCallback{
+		{URL: "https://example.com/callback", Header: map[string]string{"key": "value"}},
+		{URL: "http://example.com/other"},
+	}
+	item := testdata.HistoryStartWorkflowExecutionRequest
+	item.StartRequest = &startRequest
+	assert.Equal(t, &item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(&item)))
+}
+
+func TestHistoryStartWorkflowExecutionRequestWorkflowIDHash(t *testing.T) {
+	for _, policy := range []*types.ActiveClusterSelectionPolicy{
+		{WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "region"}},
+		{ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "region1"}, WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "region"}},
+	} {
+		startRequest := testdata.StartWorkflowExecutionRequest
+		startRequest.ActiveClusterSelectionPolicy = policy
+		item := testdata.HistoryStartWorkflowExecutionRequest
+		item.StartRequest = &startRequest
+		assert.Equal(t, &item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(&item)))
+	}
+}
+
+func TestHistoryStartWorkflowExecutionRequestWorkflowIDConflictPolicy(t *testing.T) {
+	for _, policy := range []types.WorkflowIDConflictPolicy{
+		types.WorkflowIDConflictPolicyFail,
+		types.WorkflowIDConflictPolicyUseExisting,
+	} {
+		startRequest := testdata.StartWorkflowExecutionRequest
+		startRequest.WorkflowIDConflictPolicy = policy.Ptr()
+		item := testdata.HistoryStartWorkflowExecutionRequest
+		item.StartRequest = &startRequest
+		assert.Equal(t, &item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(&item)))
+	}
+}
 func TestHistoryStartWorkflowExecutionResponse(t *testing.T) {
 	for _, item := range []*types.StartWorkflowExecutionResponse{nil, {}, &testdata.HistoryStartWorkflowExecutionResponse} {
 		assert.Equal(t, item, ToHistoryStartWorkflowExecutionResponse(FromHistoryStartWorkflowExecutionResponse(item)))
@@ -822,3 +914,11 @@ func TestHistoryStartWorkflowExecutionRequestFuzz(t *testing.T) {
 		testutils.WithExcludedFields("StartRequest"),
 	)
 }
+
+func TestHistoryGetReplicationStatusRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryGetReplicationStatusRequest, ToHistoryGetReplicationStatusRequest)
+}
+
+func TestHistoryGetReplicationStatusResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromHistoryGetReplicationStatusResponse, ToHistoryGetReplicationStatusResponse)
+}
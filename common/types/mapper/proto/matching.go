@@ -21,6 +21,8 @@
 package proto
 
 import (
+	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+
 	matchingv1 "github.com/uber/cadence/.gen/proto/matching/v1"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
@@ -217,10 +219,11 @@ func FromMatchingDescribeTaskListResponse(t *types.DescribeTaskListResponse) *ma
 		return nil
 	}
 	return &matchingv1.DescribeTaskListResponse{
-		Pollers:         FromPollerInfoArray(t.Pollers),
-		TaskListStatus:  FromTaskListStatus(t.TaskListStatus),
-		PartitionConfig: FromAPITaskListPartitionConfig(t.PartitionConfig),
-		TaskList:        FromTaskList(t.TaskList),
+		Pollers:                FromPollerInfoArray(t.Pollers),
+		TaskListStatus:         FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig:        FromAPITaskListPartitionConfig(t.PartitionConfig),
+		TaskList:               FromTaskList(t.TaskList),
+		RecommendedPollerCount: t.TaskListStatus.GetRecommendedPollerCount(),
 	}
 }
 
@@ -230,7 +233,7 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 	}
 	return &types.DescribeTaskListResponse{
 		Pollers:         ToPollerInfoArray(t.Pollers),
-		TaskListStatus:  ToTaskListStatus(t.TaskListStatus),
+		TaskListStatus:  toMatchingTaskListStatus(t.TaskListStatus, t.RecommendedPollerCount),
 		PartitionConfig: ToAPITaskListPartitionConfig(t.PartitionConfig),
 		TaskList:        ToTaskList(t.TaskList),
 	}
@@ -458,6 +461,7 @@ func FromMatchingPollForActivityTaskResponse(t *types.MatchingPollForActivityTas
 		PartitionConfig:            FromTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:          FromLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:             FromAutoConfigHint(t.AutoConfigHint),
+		RecommendedPollerCount:     t.AutoConfigHint.GetRecommendedPollerCount(),
 	}
 }
 
@@ -484,7 +488,7 @@ func ToMatchingPollForActivityTaskResponse(t *matchingv1.PollForActivityTaskResp
 		Header:                          ToHeader(t.Header),
 		PartitionConfig:                 ToTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:               ToLoadBalancerHints(t.LoadBalancerHints),
-		AutoConfigHint:                  ToAutoConfigHint(t.AutoConfigHint),
+		AutoConfigHint:                  toMatchingAutoConfigHint(t.AutoConfigHint, t.RecommendedPollerCount),
 	}
 }
 
@@ -540,6 +544,9 @@ func FromMatchingPollForDecisionTaskResponse(t *types.MatchingPollForDecisionTas
 		PartitionConfig:           FromTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:         FromLoadBalancerHints(t.LoadBalancerHints),
 		AutoConfigHint:            FromAutoConfigHint(t.AutoConfigHint),
+		HistoryCount:              t.HistoryCount,
+		ContinueAsNewSuggested:    t.ContinueAsNewSuggested,
+		RecommendedPollerCount:    t.AutoConfigHint.GetRecommendedPollerCount(),
 	}
 }
 
@@ -568,7 +575,9 @@ func ToMatchingPollForDecisionTaskResponse(t *matchingv1.PollForDecisionTaskResp
 		TotalHistoryBytes:         t.TotalHistoryBytes,
 		PartitionConfig:           ToTaskListPartitionConfig(t.PartitionConfig),
 		LoadBalancerHints:         ToLoadBalancerHints(t.LoadBalancerHints),
-		AutoConfigHint:            ToAutoConfigHint(t.AutoConfigHint),
+		AutoConfigHint:            toMatchingAutoConfigHint(t.AutoConfigHint, t.RecommendedPollerCount),
+		HistoryCount:              t.HistoryCount,
+		ContinueAsNewSuggested:    t.ContinueAsNewSuggested,
 	}
 }
 
@@ -718,6 +727,94 @@ func ToMatchingRefreshTaskListPartitionConfigResponse(t *matchingv1.RefreshTaskL
 	return &types.MatchingRefreshTaskListPartitionConfigResponse{}
 }
 
+func FromMatchingMoveTaskListBacklogRequest(t *types.MatchingMoveTaskListBacklogRequest) *matchingv1.MoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.MoveTaskListBacklogRequest{
+		DomainId:            t.DomainUUID,
+		TaskList:            FromTaskList(t.TaskList),
+		TaskListType:        FromTaskListType(t.TaskListType),
+		DestinationTaskList: FromTaskList(t.DestinationTaskList),
+		MinTaskId:           t.MinTaskID,
+		MaxTaskId:           t.MaxTaskID,
+	}
+}
+
+func ToMatchingMoveTaskListBacklogRequest(t *matchingv1.MoveTaskListBacklogRequest) *types.MatchingMoveTaskListBacklogRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:          t.DomainId,
+		TaskList:            ToTaskList(t.TaskList),
+		TaskListType:        ToTaskListType(t.TaskListType),
+		DestinationTaskList: ToTaskList(t.DestinationTaskList),
+		MinTaskID:           t.MinTaskId,
+		MaxTaskID:           t.MaxTaskId,
+	}
+}
+
+func FromMatchingMoveTaskListBacklogResponse(t *types.MoveTaskListBacklogResponse) *matchingv1.MoveTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.MoveTaskListBacklogResponse{
+		MovedTasks: t.MovedTasks,
+	}
+}
+
+func ToMatchingMoveTaskListBacklogResponse(t *matchingv1.MoveTaskListBacklogResponse) *types.MoveTaskListBacklogResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveTaskListBacklogResponse{
+		MovedTasks: t.MovedTasks,
+	}
+}
+
+func FromMatchingDeleteTaskListBacklogTasksRequest(t *types.MatchingDeleteTaskListBacklogTasksRequest) *matchingv1.DeleteTaskListBacklogTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DeleteTaskListBacklogTasksRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		TaskIds:      t.TaskIDs,
+	}
+}
+
+func ToMatchingDeleteTaskListBacklogTasksRequest(t *matchingv1.DeleteTaskListBacklogTasksRequest) *types.MatchingDeleteTaskListBacklogTasksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDeleteTaskListBacklogTasksRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		TaskIDs:      t.TaskIds,
+	}
+}
+
+func FromMatchingDeleteTaskListBacklogTasksResponse(t *types.MatchingDeleteTaskListBacklogTasksResponse) *matchingv1.DeleteTaskListBacklogTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DeleteTaskListBacklogTasksResponse{
+		DeletedTaskIds: t.DeletedTaskIDs,
+	}
+}
+
+func ToMatchingDeleteTaskListBacklogTasksResponse(t *matchingv1.DeleteTaskListBacklogTasksResponse) *types.MatchingDeleteTaskListBacklogTasksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDeleteTaskListBacklogTasksResponse{
+		DeletedTaskIDs: t.DeletedTaskIds,
+	}
+}
+
 func FromLoadBalancerHints(t *types.LoadBalancerHints) *matchingv1.LoadBalancerHints {
 	if t == nil {
 		return nil
@@ -737,3 +834,21 @@ func ToLoadBalancerHints(t *matchingv1.LoadBalancerHints) *types.LoadBalancerHin
 		RatePerSecond: t.RatePerSecond,
 	}
 }
+
+// RecommendedPollerCount is not in the public IDL yet, so matching carries it
+// next to the api.v1 message it belongs to.
+func toMatchingAutoConfigHint(t *apiv1.AutoConfigHint, recommendedPollerCount int32) *types.AutoConfigHint {
+	hint := ToAutoConfigHint(t)
+	if hint != nil {
+		hint.RecommendedPollerCount = recommendedPollerCount
+	}
+	return hint
+}
+
+func toMatchingTaskListStatus(t *apiv1.TaskListStatus, recommendedPollerCount int32) *types.TaskListStatus {
+	status := ToTaskListStatus(t)
+	if status != nil {
+		status.RecommendedPollerCount = recommendedPollerCount
+	}
+	return status
+}
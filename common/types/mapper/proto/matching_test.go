@@ -428,3 +428,19 @@ func TestMatchingRefreshTaskListPartitionConfigRequestFuzz(t *testing.T) {
 func TestMatchingRefreshTaskListPartitionConfigResponseFuzz(t *testing.T) {
 	testutils.RunMapperFuzzTest(t, FromMatchingRefreshTaskListPartitionConfigResponse, ToMatchingRefreshTaskListPartitionConfigResponse)
 }
+
+func TestMatchingMoveTaskListBacklogRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromMatchingMoveTaskListBacklogRequest, ToMatchingMoveTaskListBacklogRequest)
+}
+
+func TestMatchingMoveTaskListBacklogResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromMatchingMoveTaskListBacklogResponse, ToMatchingMoveTaskListBacklogResponse)
+}
+
+func TestMatchingDeleteTaskListBacklogTasksRequestFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromMatchingDeleteTaskListBacklogTasksRequest, ToMatchingDeleteTaskListBacklogTasksRequest)
+}
+
+func TestMatchingDeleteTaskListBacklogTasksResponseFuzz(t *testing.T) {
+	testutils.RunMapperFuzzTest(t, FromMatchingDeleteTaskListBacklogTasksResponse, ToMatchingDeleteTaskListBacklogTasksResponse)
+}
@@ -1846,3 +1846,162 @@ func FromAny(t *types.Any) *sharedv1.Any {
 		Value:     t.Value,
 	}
 }
+
+func FromCompletionCallback(t *types.CompletionCallback) *sharedv1.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.CompletionCallback{
+		Url:    t.URL,
+		Header: t.Header,
+	}
+}
+
+func ToCompletionCallback(t *sharedv1.CompletionCallback) *types.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	return &types.CompletionCallback{
+		URL:    t.Url,
+		Header: t.Header,
+	}
+}
+
+func FromCompletionCallbackArray(t []*types.CompletionCallback) []*sharedv1.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.CompletionCallback, len(t))
+	for i := range t {
+		v[i] = FromCompletionCallback(t[i])
+	}
+	return v
+}
+
+func ToCompletionCallbackArray(t []*sharedv1.CompletionCallback) []*types.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallback, len(t))
+	for i := range t {
+		v[i] = ToCompletionCallback(t[i])
+	}
+	return v
+}
+
+func FromCompletionCallbackState(t types.CompletionCallbackState) sharedv1.CompletionCallbackState {
+	switch t {
+	case types.CompletionCallbackStateScheduled:
+		return sharedv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_SCHEDULED
+	case types.CompletionCallbackStateSucceeded:
+		return sharedv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_SUCCEEDED
+	case types.CompletionCallbackStateFailed:
+		return sharedv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_FAILED
+	}
+	return sharedv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_INVALID
+}
+
+func ToCompletionCallbackState(t sharedv1.CompletionCallbackState) types.CompletionCallbackState {
+	switch t {
+	case sharedv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_SUCCEEDED:
+		return types.CompletionCallbackStateSucceeded
+	case sharedv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_FAILED:
+		return types.CompletionCallbackStateFailed
+	}
+	return types.CompletionCallbackStateScheduled
+}
+
+func FromCompletionCallbackInfo(t *types.CompletionCallbackInfo) *sharedv1.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.CompletionCallbackInfo{
+		Callback:        FromCompletionCallback(t.Callback),
+		State:           FromCompletionCallbackState(t.State),
+		Attempt:         t.Attempt,
+		LastAttemptTime: unixNanoToTime(t.LastAttemptTimestamp),
+		LastFailure:     t.LastFailure,
+	}
+}
+
+func ToCompletionCallbackInfo(t *sharedv1.CompletionCallbackInfo) *types.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.CompletionCallbackInfo{
+		Callback:             ToCompletionCallback(t.Callback),
+		State:                ToCompletionCallbackState(t.State),
+		Attempt:              t.Attempt,
+		LastAttemptTimestamp: timeToUnixNano(t.LastAttemptTime),
+		LastFailure:          t.LastFailure,
+	}
+}
+
+func FromCompletionCallbackInfoArray(t []*types.CompletionCallbackInfo) []*sharedv1.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.CompletionCallbackInfo, len(t))
+	for i := range t {
+		v[i] = FromCompletionCallbackInfo(t[i])
+	}
+	return v
+}
+
+func ToCompletionCallbackInfoArray(t []*sharedv1.CompletionCallbackInfo) []*types.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallbackInfo, len(t))
+	for i := range t {
+		v[i] = ToCompletionCallbackInfo(t[i])
+	}
+	return v
+}
+
+func FromWorkflowIDHashPolicy(t *types.WorkflowIDHashPolicy) *sharedv1.WorkflowIdHashPolicy {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.WorkflowIdHashPolicy{
+		Scope: t.Scope,
+	}
+}
+
+func ToWorkflowIDHashPolicy(t *sharedv1.WorkflowIdHashPolicy) *types.WorkflowIDHashPolicy {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowIDHashPolicy{
+		Scope: t.Scope,
+	}
+}
+
+func FromWorkflowIDConflictPolicy(t *types.WorkflowIDConflictPolicy) sharedv1.WorkflowIdConflictPolicy {
+	if t == nil {
+		return sharedv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID
+	}
+	switch *t {
+	case types.WorkflowIDConflictPolicyFail:
+		return sharedv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL
+	case types.WorkflowIDConflictPolicyUseExisting:
+		return sharedv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING
+	case types.WorkflowIDConflictPolicySignalExisting:
+		return sharedv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_SIGNAL_EXISTING
+	}
+	return sharedv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID
+}
+
+func ToWorkflowIDConflictPolicy(t sharedv1.WorkflowIdConflictPolicy) *types.WorkflowIDConflictPolicy {
+	switch t {
+	case sharedv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_INVALID:
+		return nil
+	case sharedv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_FAIL:
+		return types.WorkflowIDConflictPolicyFail.Ptr()
+	case sharedv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING:
+		return types.WorkflowIDConflictPolicyUseExisting.Ptr()
+	case sharedv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_SIGNAL_EXISTING:
+		return types.WorkflowIDConflictPolicySignalExisting.Ptr()
+	}
+	return nil
+}
@@ -304,6 +304,121 @@ func (v *MatchingGetTaskListsByDomainRequest) GetDomain() (o string) {
 	return
 }
 
+// MatchingMoveTaskListBacklogRequest moves the tasks in [MinTaskID, MaxTaskID] from a task list partition to
+// DestinationTaskList. It is served by the owner of DestinationTaskList, which allocates the new task IDs. The
+// tasks are deleted from the partition through its owner before they are appended to DestinationTaskList.
+type MatchingMoveTaskListBacklogRequest struct {
+	DomainUUID          string        `json:"domainUUID,omitempty"`
+	TaskList            *TaskList     `json:"taskList,omitempty"`
+	TaskListType        *TaskListType `json:"taskListType,omitempty"`
+	DestinationTaskList *TaskList     `json:"destinationTaskList,omitempty"`
+	MinTaskID           int64         `json:"minTaskID,omitempty"`
+	MaxTaskID           int64         `json:"maxTaskID,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetDestinationTaskList is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetDestinationTaskList() (o *TaskList) {
+	if v != nil && v.DestinationTaskList != nil {
+		return v.DestinationTaskList
+	}
+	return
+}
+
+// GetMinTaskID is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetMinTaskID() (o int64) {
+	if v != nil {
+		return v.MinTaskID
+	}
+	return
+}
+
+// GetMaxTaskID is an internal getter (TBD...)
+func (v *MatchingMoveTaskListBacklogRequest) GetMaxTaskID() (o int64) {
+	if v != nil {
+		return v.MaxTaskID
+	}
+	return
+}
+
+// MatchingDeleteTaskListBacklogTasksRequest deletes the given tasks from a task list partition. It is served by
+// the owner of TaskList, which drops the tasks it has already read instead of dispatching them.
+type MatchingDeleteTaskListBacklogTasksRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	TaskIDs      []int64       `json:"taskIDs,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingDeleteTaskListBacklogTasksRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingDeleteTaskListBacklogTasksRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingDeleteTaskListBacklogTasksRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetTaskIDs is an internal getter (TBD...)
+func (v *MatchingDeleteTaskListBacklogTasksRequest) GetTaskIDs() (o []int64) {
+	if v != nil {
+		return v.TaskIDs
+	}
+	return
+}
+
+// MatchingDeleteTaskListBacklogTasksResponse lists the deleted tasks. Tasks which were already dispatched to a
+// poller or completed are not deleted.
+type MatchingDeleteTaskListBacklogTasksResponse struct {
+	DeletedTaskIDs []int64 `json:"deletedTaskIDs,omitempty"`
+}
+
+// GetDeletedTaskIDs is an internal getter (TBD...)
+func (v *MatchingDeleteTaskListBacklogTasksResponse) GetDeletedTaskIDs() (o []int64) {
+	if v != nil {
+		return v.DeletedTaskIDs
+	}
+	return
+}
+
 // MatchingPollForActivityTaskRequest is an internal type (TBD...)
 type MatchingPollForActivityTaskRequest struct {
 	DomainUUID     string                      `json:"domainUUID,omitempty"`
@@ -448,6 +563,8 @@ type MatchingPollForDecisionTaskResponse struct {
 	StartedTimestamp          *int64                    `json:"startedTimestamp,omitempty"`
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
 	TotalHistoryBytes         int64                     `json:"currentHistorySize,omitempty"`
+	HistoryCount              int64                     `json:"historyCount,omitempty"`
+	ContinueAsNewSuggested    bool                      `json:"continueAsNewSuggested,omitempty"`
 	PartitionConfig           *TaskListPartitionConfig
 	LoadBalancerHints         *LoadBalancerHints
 	AutoConfigHint            *AutoConfigHint
@@ -501,6 +618,22 @@ func (v *MatchingPollForDecisionTaskResponse) GetTotalHistoryBytes() (o int64) {
 	return
 }
 
+// GetHistoryCount is an internal getter of returning the history event count
+func (v *MatchingPollForDecisionTaskResponse) GetHistoryCount() (o int64) {
+	if v != nil {
+		return v.HistoryCount
+	}
+	return
+}
+
+// GetContinueAsNewSuggested is an internal getter (TBD...)
+func (v *MatchingPollForDecisionTaskResponse) GetContinueAsNewSuggested() (o bool) {
+	if v != nil {
+		return v.ContinueAsNewSuggested
+	}
+	return
+}
+
 type MatchingPollForActivityTaskResponse struct {
 	TaskToken                       []byte             `json:"taskToken,omitempty"`
 	WorkflowExecution               *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -1781,3 +1781,63 @@ func TestMatchingRefreshTaskListPartitionConfigRequest_GetTaskListType(t *testin
 		})
 	}
 }
+
+func TestMatchingMoveTaskListBacklogRequest_GetTaskListType(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *MatchingMoveTaskListBacklogRequest
+		want TaskListType
+	}{
+		{
+			name: "nil request",
+			req:  nil,
+			want: TaskListTypeDecision,
+		},
+		{
+			name: "empty request",
+			req:  &MatchingMoveTaskListBacklogRequest{},
+			want: TaskListTypeDecision,
+		},
+		{
+			name: "non empty request",
+			req:  &MatchingMoveTaskListBacklogRequest{TaskListType: TaskListTypeActivity.Ptr()},
+			want: TaskListTypeActivity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.req.GetTaskListType()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatchingDeleteTaskListBacklogTasksRequest_GetTaskListType(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *MatchingDeleteTaskListBacklogTasksRequest
+		want TaskListType
+	}{
+		{
+			name: "nil request",
+			req:  nil,
+			want: TaskListTypeDecision,
+		},
+		{
+			name: "empty request",
+			req:  &MatchingDeleteTaskListBacklogTasksRequest{},
+			want: TaskListTypeDecision,
+		},
+		{
+			name: "non empty request",
+			req:  &MatchingDeleteTaskListBacklogTasksRequest{TaskListType: TaskListTypeActivity.Ptr()},
+			want: TaskListTypeActivity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.req.GetTaskListType()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ReplicationFilter excludes workflows of a global domain from cross cluster replication, for example high volume
+// workflows which do not need to survive a failover. It is stored JSON encoded in the domain data, see
+// constants.DomainDataKeyForReplicationFilter, so it is replicated together with the domain.
+//
+// The filter is evaluated once when a workflow starts and the result is persisted with the workflow, later changes
+// to the filter only apply to new workflows. Continue-as-new and reset runs keep the decision of the run they
+// follow. An excluded workflow only exists in the cluster it started in: after a failover the new active cluster
+// has no copy of it, and the old cluster drops its tasks instead of waiting for history which is never replicated.
+// Excluded workflows are only supported by the Cassandra persistence store.
+type ReplicationFilter struct {
+	ExcludedWorkflowTypes []string `json:"excludedWorkflowTypes,omitempty"`
+	ExcludedTaskLists     []string `json:"excludedTaskLists,omitempty"`
+}
+
+// ParseReplicationFilter decodes a JSON encoded ReplicationFilter, an empty string is no filter
+func ParseReplicationFilter(encoded string) (*ReplicationFilter, error) {
+	if strings.TrimSpace(encoded) == "" {
+		return nil, nil
+	}
+	var filter ReplicationFilter
+	if err := json.Unmarshal([]byte(encoded), &filter); err != nil {
+		return nil, fmt.Errorf("invalid replication filter: %w", err)
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return &filter, nil
+}
+
+// Validate rejects empty workflow type and task list names
+func (v *ReplicationFilter) Validate() error {
+	if v == nil {
+		return nil
+	}
+	for _, workflowType := range v.ExcludedWorkflowTypes {
+		if workflowType == "" {
+			return errors.New("invalid replication filter: empty workflow type")
+		}
+	}
+	for _, taskList := range v.ExcludedTaskLists {
+		if taskList == "" {
+			return errors.New("invalid replication filter: empty task list")
+		}
+	}
+	return nil
+}
+
+// Excludes returns true if workflows of workflowType started on taskList are not replicated
+func (v *ReplicationFilter) Excludes(workflowType, taskList string) bool {
+	if v == nil {
+		return false
+	}
+	for _, excluded := range v.ExcludedWorkflowTypes {
+		if excluded == workflowType {
+			return true
+		}
+	}
+	for _, excluded := range v.ExcludedTaskLists {
+		if excluded == taskList {
+			return true
+		}
+	}
+	return false
+}
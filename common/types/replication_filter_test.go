@@ -0,0 +1,81 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReplicationFilter(t *testing.T) {
+	tests := []struct {
+		name        string
+		encoded     string
+		expected    *ReplicationFilter
+		expectedErr string
+	}{
+		{
+			name:    "empty",
+			encoded: "",
+		},
+		{
+			name:     "valid",
+			encoded:  `{"excludedWorkflowTypes":["wf"],"excludedTaskLists":["tl"]}`,
+			expected: &ReplicationFilter{ExcludedWorkflowTypes: []string{"wf"}, ExcludedTaskLists: []string{"tl"}},
+		},
+		{
+			name:        "not json",
+			encoded:     "wf",
+			expectedErr: "invalid replication filter",
+		},
+		{
+			name:        "empty workflow type",
+			encoded:     `{"excludedWorkflowTypes":[""]}`,
+			expectedErr: "invalid replication filter: empty workflow type",
+		},
+		{
+			name:        "empty task list",
+			encoded:     `{"excludedTaskLists":[""]}`,
+			expectedErr: "invalid replication filter: empty task list",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := ParseReplicationFilter(tt.encoded)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, filter)
+		})
+	}
+}
+
+func TestReplicationFilter_Excludes(t *testing.T) {
+	filter := &ReplicationFilter{ExcludedWorkflowTypes: []string{"ephemeral"}, ExcludedTaskLists: []string{"scratch"}}
+
+	assert.True(t, filter.Excludes("ephemeral", "tl"))
+	assert.True(t, filter.Excludes("wf", "scratch"))
+	assert.False(t, filter.Excludes("wf", "tl"))
+	assert.False(t, (*ReplicationFilter)(nil).Excludes("ephemeral", "scratch"))
+}
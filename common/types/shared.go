@@ -1843,6 +1843,7 @@ type DescribeDomainResponse struct {
 	FailoverVersion          int64                           `json:"failoverVersion,omitempty"`
 	IsGlobalDomain           bool                            `json:"isGlobalDomain,omitempty"`
 	FailoverInfo             *FailoverInfo                   `json:"failoverInfo,omitempty"`
+	OpenWorkflowUsage        *OpenWorkflowUsage              `json:"openWorkflowUsage,omitempty"`
 }
 
 func (v *DomainReplicationConfiguration) GetActiveClusters() (o *ActiveClusters) {
@@ -1884,6 +1885,76 @@ func (v *DescribeDomainResponse) GetFailoverInfo() (o *FailoverInfo) {
 	return
 }
 
+// GetOpenWorkflowUsage is an internal getter (TBD...)
+func (v *DescribeDomainResponse) GetOpenWorkflowUsage() (o *OpenWorkflowUsage) {
+	if v != nil {
+		return v.OpenWorkflowUsage
+	}
+	return
+}
+
+// OpenWorkflowUsage is the number of open workflows of a domain compared to its configured limits
+type OpenWorkflowUsage struct {
+	OpenWorkflowCount int64                            `json:"openWorkflowCount,omitempty"`
+	OpenWorkflowLimit int64                            `json:"openWorkflowLimit,omitempty"`
+	WorkflowTypes     []*WorkflowTypeOpenWorkflowUsage `json:"workflowTypes,omitempty"`
+}
+
+// GetOpenWorkflowCount is an internal getter (TBD...)
+func (v *OpenWorkflowUsage) GetOpenWorkflowCount() (o int64) {
+	if v != nil {
+		return v.OpenWorkflowCount
+	}
+	return
+}
+
+// GetOpenWorkflowLimit is an internal getter (TBD...)
+func (v *OpenWorkflowUsage) GetOpenWorkflowLimit() (o int64) {
+	if v != nil {
+		return v.OpenWorkflowLimit
+	}
+	return
+}
+
+// GetWorkflowTypes is an internal getter (TBD...)
+func (v *OpenWorkflowUsage) GetWorkflowTypes() (o []*WorkflowTypeOpenWorkflowUsage) {
+	if v != nil {
+		return v.WorkflowTypes
+	}
+	return
+}
+
+// WorkflowTypeOpenWorkflowUsage is the number of open workflows of a single workflow type compared to its configured limit
+type WorkflowTypeOpenWorkflowUsage struct {
+	WorkflowType      string `json:"workflowType,omitempty"`
+	OpenWorkflowCount int64  `json:"openWorkflowCount,omitempty"`
+	OpenWorkflowLimit int64  `json:"openWorkflowLimit,omitempty"`
+}
+
+// GetWorkflowType is an internal getter (TBD...)
+func (v *WorkflowTypeOpenWorkflowUsage) GetWorkflowType() (o string) {
+	if v != nil {
+		return v.WorkflowType
+	}
+	return
+}
+
+// GetOpenWorkflowCount is an internal getter (TBD...)
+func (v *WorkflowTypeOpenWorkflowUsage) GetOpenWorkflowCount() (o int64) {
+	if v != nil {
+		return v.OpenWorkflowCount
+	}
+	return
+}
+
+// GetOpenWorkflowLimit is an internal getter (TBD...)
+func (v *WorkflowTypeOpenWorkflowUsage) GetOpenWorkflowLimit() (o int64) {
+	if v != nil {
+		return v.OpenWorkflowLimit
+	}
+	return
+}
+
 // FailoverDomainRequest is an internal type (TBD...)
 type FailoverDomainRequest struct {
 	DomainName               string          `json:"domainName,omitempty"`
@@ -2079,6 +2150,7 @@ type FailoverEvent struct {
 	CreatedTime      *int64             `json:"createdTime,omitempty"`
 	FailoverType     *FailoverType      `json:"failoverType,omitempty"`
 	ClusterFailovers []*ClusterFailover `json:"clusterFailovers,omitempty"`
+	Comment          *string            `json:"comment,omitempty"`
 }
 
 // GetID is an internal getter (TBD...)
@@ -2113,6 +2185,14 @@ func (v *FailoverEvent) GetClusterFailovers() (o []*ClusterFailover) {
 	return
 }
 
+// GetComment is an internal getter (TBD...)
+func (v *FailoverEvent) GetComment() (o string) {
+	if v != nil && v.Comment != nil {
+		return *v.Comment
+	}
+	return
+}
+
 // FailoverType is an internal type (TBD...)
 type FailoverType int32
 
@@ -2343,6 +2423,7 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
 	PendingChildren        []*PendingChildExecutionInfo    `json:"pendingChildren,omitempty"`
 	PendingDecision        *PendingDecisionInfo            `json:"pendingDecision,omitempty"`
+	CompletionCallbacks    []*CompletionCallbackInfo       `json:"completionCallbacks,omitempty"`
 }
 
 // GetWorkflowExecutionInfo is an internal getter (TBD...)
@@ -2361,6 +2442,14 @@ func (v *DescribeWorkflowExecutionResponse) GetPendingActivities() (o []*Pending
 	return
 }
 
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResponse) GetCompletionCallbacks() (o []*CompletionCallbackInfo) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
 // DomainAlreadyExistsError is an internal type (TBD...)
 type DomainAlreadyExistsError struct {
 	Message string `json:"message,required"`
@@ -2921,6 +3010,9 @@ func (c *ClusterAttribute) Equals(other *ClusterAttribute) bool {
 
 type ActiveClusterSelectionPolicy struct {
 	ClusterAttribute *ClusterAttribute `json:"clusterAttribute,omitempty" yaml:"clusterAttribute,omitempty"`
+	// WorkflowIDHash selects ClusterAttribute by hashing the workflow ID, it is resolved by the
+	// active cluster manager whenever a new run is started
+	WorkflowIDHash *WorkflowIDHashPolicy `json:"workflowIDHash,omitempty" yaml:"workflowIDHash,omitempty"`
 }
 
 func (p *ActiveClusterSelectionPolicy) GetClusterAttribute() *ClusterAttribute {
@@ -2930,6 +3022,13 @@ func (p *ActiveClusterSelectionPolicy) GetClusterAttribute() *ClusterAttribute {
 	return p.ClusterAttribute
 }
 
+func (p *ActiveClusterSelectionPolicy) GetWorkflowIDHash() *WorkflowIDHashPolicy {
+	if p == nil {
+		return nil
+	}
+	return p.WorkflowIDHash
+}
+
 func (p *ActiveClusterSelectionPolicy) Equals(other *ActiveClusterSelectionPolicy) bool {
 	if p == nil && other == nil {
 		return true
@@ -2938,7 +3037,30 @@ func (p *ActiveClusterSelectionPolicy) Equals(other *ActiveClusterSelectionPolic
 		return false
 	}
 
-	return p.ClusterAttribute.Equals(other.ClusterAttribute)
+	return p.ClusterAttribute.Equals(other.ClusterAttribute) && p.WorkflowIDHash.Equals(other.WorkflowIDHash)
+}
+
+// WorkflowIDHashPolicy spreads workflows over the cluster attributes of Scope by hashing the workflow ID into
+// buckets sized by the domain's ClusterAttributeWeights
+type WorkflowIDHashPolicy struct {
+	Scope string `json:"scope,omitempty" yaml:"scope,omitempty"`
+}
+
+func (p *WorkflowIDHashPolicy) GetScope() string {
+	if p == nil {
+		return ""
+	}
+	return p.Scope
+}
+
+func (p *WorkflowIDHashPolicy) Equals(other *WorkflowIDHashPolicy) bool {
+	if p == nil && other == nil {
+		return true
+	}
+	if p == nil || other == nil {
+		return false
+	}
+	return p.Scope == other.Scope
 }
 
 // DomainStatus is an internal type (TBD...)
@@ -5180,6 +5302,8 @@ type PollForDecisionTaskResponse struct {
 	Queries                   map[string]*WorkflowQuery `json:"queries,omitempty"`
 	NextEventID               int64                     `json:"nextEventId,omitempty"`
 	TotalHistoryBytes         int64                     `json:"historySize,omitempty"`
+	HistoryCount              int64                     `json:"historyCount,omitempty"`
+	ContinueAsNewSuggested    bool                      `json:"continueAsNewSuggested,omitempty"`
 	AutoConfigHint            *AutoConfigHint           `json:"autoConfigHint,omitempty"`
 }
 
@@ -5238,6 +5362,22 @@ func (v *PollForDecisionTaskResponse) GetHistorySize() (o int64) {
 	return
 }
 
+// GetHistoryCount is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetHistoryCount() (o int64) {
+	if v != nil {
+		return v.HistoryCount
+	}
+	return
+}
+
+// GetContinueAsNewSuggested is an internal getter (TBD...)
+func (v *PollForDecisionTaskResponse) GetContinueAsNewSuggested() (o bool) {
+	if v != nil {
+		return v.ContinueAsNewSuggested
+	}
+	return
+}
+
 // PollerInfo is an internal type (TBD...)
 type PollerInfo struct {
 	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
@@ -7058,6 +7198,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	FirstRunAtTimestamp                 *int64                        `json:"firstRunAtTimestamp,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	WorkflowIDConflictPolicy            *WorkflowIDConflictPolicy     `json:"workflowIdConflictPolicy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7132,6 +7273,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIDReusePolicy() (o
 	return
 }
 
+// GetWorkflowIDConflictPolicy is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetWorkflowIDConflictPolicy() (o WorkflowIDConflictPolicy) {
+	if v != nil && v.WorkflowIDConflictPolicy != nil {
+		return *v.WorkflowIDConflictPolicy
+	}
+	return
+}
+
 // GetSignalName is an internal getter (TBD...)
 func (v *SignalWithStartWorkflowExecutionRequest) GetSignalName() (o string) {
 	if v != nil {
@@ -7156,6 +7305,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	return
 }
 
+// GetActiveClusterSelectionPolicy is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetActiveClusterSelectionPolicy() (o *ActiveClusterSelectionPolicy) {
+	if v != nil && v.ActiveClusterSelectionPolicy != nil {
+		return v.ActiveClusterSelectionPolicy
+	}
+	return
+}
+
 // SignalWithStartWorkflowExecutionAsyncRequest is an internal type (TBD...)
 type SignalWithStartWorkflowExecutionAsyncRequest struct {
 	*SignalWithStartWorkflowExecutionRequest
@@ -7534,6 +7691,8 @@ type StartWorkflowExecutionRequest struct {
 	FirstRunAtTimeStamp                 *int64                        `json:"firstRunAtTimeStamp,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	WorkflowIDConflictPolicy            *WorkflowIDConflictPolicy     `json:"workflowIdConflictPolicy,omitempty"`
+	CompletionCallbacks                 []*CompletionCallback         `json:"completionCallbacks,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -7632,6 +7791,30 @@ func (v *StartWorkflowExecutionRequest) GetCronOverlapPolicy() (o CronOverlapPol
 	return
 }
 
+// GetWorkflowIDConflictPolicy is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetWorkflowIDConflictPolicy() (o WorkflowIDConflictPolicy) {
+	if v != nil && v.WorkflowIDConflictPolicy != nil {
+		return *v.WorkflowIDConflictPolicy
+	}
+	return
+}
+
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetCompletionCallbacks() (o []*CompletionCallback) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
+// GetActiveClusterSelectionPolicy is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetActiveClusterSelectionPolicy() (o *ActiveClusterSelectionPolicy) {
+	if v != nil && v.ActiveClusterSelectionPolicy != nil {
+		return v.ActiveClusterSelectionPolicy
+	}
+	return
+}
+
 // StartWorkflowExecutionResponse is an internal type (TBD...)
 type StartWorkflowExecutionResponse struct {
 	RunID string `json:"runId,omitempty"`
@@ -7838,6 +8021,17 @@ type TaskListStatus struct {
 	IsolationGroupMetrics map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
 	NewTasksPerSecond     float64                           `json:"newTasksPerSecond,omitempty"`
 	Empty                 bool                              `json:"empty,omitempty"`
+	// RecommendedPollerCount is the number of concurrent pollers recommended for the task list partition, summed
+	// over all workers
+	RecommendedPollerCount int32 `json:"recommendedPollerCount,omitempty"`
+}
+
+// GetRecommendedPollerCount is an internal getter (TBD...)
+func (v *TaskListStatus) GetRecommendedPollerCount() (o int32) {
+	if v != nil {
+		return v.RecommendedPollerCount
+	}
+	return
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -8762,6 +8956,8 @@ type WorkflowExecutionInfo struct {
 	CronSchedule                 *string                       `json:"cronSchedule,omitempty"`
 	ExecutionStatus              *WorkflowExecutionStatus      `json:"executionStatus,omitempty"`
 	ScheduledExecutionTime       *int64                        `json:"scheduledExecutionTime,omitempty"`
+	// ReplicationExcluded is set when the replication filter of the global domain excluded the workflow when it started
+	ReplicationExcluded bool `json:"replicationExcluded,omitempty"`
 }
 
 // GetExecution is an internal getter (TBD...)
@@ -8860,6 +9056,14 @@ func (v *WorkflowExecutionInfo) GetScheduledExecutionTime() (o int64) {
 	return
 }
 
+// GetReplicationExcluded is an internal getter (TBD...)
+func (v *WorkflowExecutionInfo) GetReplicationExcluded() (o bool) {
+	if v != nil {
+		return v.ReplicationExcluded
+	}
+	return
+}
+
 // WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName string `json:"signalName,omitempty"`
@@ -9817,6 +10021,17 @@ type Any struct {
 type AutoConfigHint struct {
 	EnableAutoConfig   bool  `json:"enableAutoConfig"`
 	PollerWaitTimeInMs int64 `json:"pollerWaitTimeInMs"`
+	// RecommendedPollerCount is the number of concurrent pollers recommended for the task list partition, summed
+	// over all workers
+	RecommendedPollerCount int32 `json:"recommendedPollerCount"`
+}
+
+// GetRecommendedPollerCount is an internal getter (TBD...)
+func (v *AutoConfigHint) GetRecommendedPollerCount() (o int32) {
+	if v != nil {
+		return v.RecommendedPollerCount
+	}
+	return
 }
 
 type CronOverlapPolicy int32
@@ -9840,3 +10055,37 @@ func (v CronOverlapPolicy) String() string {
 func (v CronOverlapPolicy) Ptr() *CronOverlapPolicy {
 	return &v
 }
+
+// WorkflowIDConflictPolicy decides what a start request does when a run with
+// the same workflow ID is still open. WorkflowIDReusePolicy only applies to
+// closed runs.
+type WorkflowIDConflictPolicy int32
+
+const (
+	// WorkflowIDConflictPolicyFail rejects the request with WorkflowExecutionAlreadyStartedError
+	WorkflowIDConflictPolicyFail WorkflowIDConflictPolicy = iota
+	// WorkflowIDConflictPolicyUseExisting returns the open run's ID without starting a new run,
+	// SignalWithStartWorkflowExecution still delivers its signal to the open run
+	WorkflowIDConflictPolicyUseExisting
+	// WorkflowIDConflictPolicySignalExisting delivers the request's signal to the open run. Only
+	// SignalWithStartWorkflowExecution accepts it, StartWorkflowExecution has no signal to deliver and
+	// is rejected with a BadRequestError.
+	WorkflowIDConflictPolicySignalExisting
+)
+
+func (v WorkflowIDConflictPolicy) String() string {
+	switch v {
+	case WorkflowIDConflictPolicyFail:
+		return "FAIL"
+	case WorkflowIDConflictPolicyUseExisting:
+		return "USE_EXISTING"
+	case WorkflowIDConflictPolicySignalExisting:
+		return "SIGNAL_EXISTING"
+	}
+	return "UNKNOWN"
+}
+
+// Ptr is a helper function for getting pointer to WorkflowIDConflictPolicy
+func (v WorkflowIDConflictPolicy) Ptr() *WorkflowIDConflictPolicy {
+	return &v
+}
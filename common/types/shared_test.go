@@ -639,3 +639,80 @@ func TestIsAConfigUpdateRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestActiveClusterSelectionPolicy_Equals(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   *ActiveClusterSelectionPolicy
+		other    *ActiveClusterSelectionPolicy
+		expected bool
+	}{
+		{
+			name:     "both nil should be equal",
+			expected: true,
+		},
+		{
+			name:     "one nil should not be equal",
+			policy:   &ActiveClusterSelectionPolicy{},
+			expected: false,
+		},
+		{
+			name:     "same cluster attribute should be equal",
+			policy:   &ActiveClusterSelectionPolicy{ClusterAttribute: &ClusterAttribute{Scope: "region", Name: "us-east"}},
+			other:    &ActiveClusterSelectionPolicy{ClusterAttribute: &ClusterAttribute{Scope: "region", Name: "us-east"}},
+			expected: true,
+		},
+		{
+			name:     "different cluster attribute should not be equal",
+			policy:   &ActiveClusterSelectionPolicy{ClusterAttribute: &ClusterAttribute{Scope: "region", Name: "us-east"}},
+			other:    &ActiveClusterSelectionPolicy{ClusterAttribute: &ClusterAttribute{Scope: "region", Name: "us-west"}},
+			expected: false,
+		},
+		{
+			name: "workflow ID hash policies resolved to the same cluster attribute should be equal",
+			policy: &ActiveClusterSelectionPolicy{
+				ClusterAttribute: &ClusterAttribute{Scope: "region", Name: "us-east"},
+				WorkflowIDHash:   &WorkflowIDHashPolicy{Scope: "region"},
+			},
+			other: &ActiveClusterSelectionPolicy{
+				ClusterAttribute: &ClusterAttribute{Scope: "region", Name: "us-east"},
+				WorkflowIDHash:   &WorkflowIDHashPolicy{Scope: "region"},
+			},
+			expected: true,
+		},
+		{
+			name: "workflow ID hash policies resolved to different cluster attributes should not be equal",
+			policy: &ActiveClusterSelectionPolicy{
+				ClusterAttribute: &ClusterAttribute{Scope: "region", Name: "us-east"},
+				WorkflowIDHash:   &WorkflowIDHashPolicy{Scope: "region"},
+			},
+			other: &ActiveClusterSelectionPolicy{
+				ClusterAttribute: &ClusterAttribute{Scope: "region", Name: "us-west"},
+				WorkflowIDHash:   &WorkflowIDHashPolicy{Scope: "region"},
+			},
+			expected: false,
+		},
+		{
+			name:     "workflow ID hash policies of different scopes should not be equal",
+			policy:   &ActiveClusterSelectionPolicy{WorkflowIDHash: &WorkflowIDHashPolicy{Scope: "region"}},
+			other:    &ActiveClusterSelectionPolicy{WorkflowIDHash: &WorkflowIDHashPolicy{Scope: "city"}},
+			expected: false,
+		},
+		{
+			name: "workflow ID hash policy should not equal a cluster attribute policy",
+			policy: &ActiveClusterSelectionPolicy{
+				ClusterAttribute: &ClusterAttribute{Scope: "region", Name: "us-east"},
+				WorkflowIDHash:   &WorkflowIDHashPolicy{Scope: "region"},
+			},
+			other:    &ActiveClusterSelectionPolicy{ClusterAttribute: &ClusterAttribute{Scope: "region", Name: "us-east"}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.Equals(tt.other))
+			assert.Equal(t, tt.expected, tt.other.Equals(tt.policy))
+		})
+	}
+}
@@ -466,6 +466,8 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *types.RecordDeci
 		StartedTimestamp:          historyResponse.StartedTimestamp,
 		Queries:                   historyResponse.Queries,
 		TotalHistoryBytes:         historyResponse.HistorySize,
+		HistoryCount:              historyResponse.HistoryCount,
+		ContinueAsNewSuggested:    historyResponse.ContinueAsNewSuggested,
 	}
 	if historyResponse.GetPreviousStartedEventID() != constants.EmptyEventID {
 		matchingResp.PreviousStartedEventID = historyResponse.PreviousStartedEventID
@@ -25,6 +25,7 @@ import (
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	"go.uber.org/yarpc"
 
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
@@ -55,7 +56,11 @@ type MatchingClient interface {
 
 // NewAdminClient creates a client to cadence admin client
 func NewAdminClient(d *yarpc.Dispatcher) AdminClient {
-	return grpc.NewAdminClient(adminv1.NewAdminAPIYARPCClient(d.ClientConfig(testOutboundName(service.Frontend))))
+	config := d.ClientConfig(testOutboundName(service.Frontend))
+	return grpc.NewAdminClient(
+		adminv1.NewAdminAPIYARPCClient(config),
+		frontendv1.NewAdminExtensionAPIYARPCClient(config),
+	)
 }
 
 // NewFrontendClient creates a client to cadence frontend client
@@ -67,6 +72,7 @@ func NewFrontendClient(d *yarpc.Dispatcher) FrontendClient {
 		apiv1.NewWorkerAPIYARPCClient(config),
 		apiv1.NewVisibilityAPIYARPCClient(config),
 		apiv1.NewScheduleAPIYARPCClient(config),
+		frontendv1.NewBatchAPIYARPCClient(config),
 	)
 }
 
@@ -21,26 +21,50 @@
 package host
 
 import (
+	"sync"
+
 	"github.com/dgryski/go-farm"
 
 	"github.com/uber/cadence/common/membership"
 )
 
 type simpleHashring struct {
-	hosts    []membership.HostInfo
 	hashfunc func([]byte) uint32
+
+	mu        sync.RWMutex
+	all       []membership.HostInfo
+	hosts     []membership.HostInfo
+	removed   map[string]struct{}
+	listeners map[string]chan<- *membership.ChangedEvent
 }
 
 // newSimpleHashring returns a service resolver that maintains static mapping
 // between services and host info
 func newSimpleHashring(hosts []membership.HostInfo) *simpleHashring {
 	return &simpleHashring{
-		hosts:    hosts,
-		hashfunc: farm.Fingerprint32,
+		hashfunc:  farm.Fingerprint32,
+		all:       hosts,
+		hosts:     hosts,
+		removed:   make(map[string]struct{}),
+		listeners: make(map[string]chan<- *membership.ChangedEvent),
+	}
+}
+
+// newSimpleHashrings returns a ring per service, to be shared by the resolvers of all the hosts
+func newSimpleHashrings(hosts map[string][]membership.HostInfo) map[string]*simpleHashring {
+	rings := make(map[string]*simpleHashring, len(hosts))
+	for service, hostList := range hosts {
+		rings[service] = newSimpleHashring(hostList)
 	}
+	return rings
 }
 
 func (s *simpleHashring) Lookup(key string) (membership.HostInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.hosts) == 0 {
+		return membership.HostInfo{}, membership.ErrInsufficientHosts
+	}
 	hash := int(s.hashfunc([]byte(key)))
 	idx := hash % len(s.hosts)
 	return s.hosts[idx], nil
@@ -49,6 +73,8 @@ func (s *simpleHashring) Lookup(key string) (membership.HostInfo, error) {
 // LookupN returns up to n hosts for the given key using consecutive slots in
 // the ring, wrapping around if needed.
 func (s *simpleHashring) LookupN(key string, n int) ([]membership.HostInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	if len(s.hosts) == 0 {
 		return nil, membership.ErrInsufficientHosts
 	}
@@ -66,17 +92,67 @@ func (s *simpleHashring) LookupN(key string, n int) ([]membership.HostInfo, erro
 }
 
 func (s *simpleHashring) AddListener(name string, notifyChannel chan<- *membership.ChangedEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners[name] = notifyChannel
 	return nil
 }
 
 func (s *simpleHashring) RemoveListener(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.listeners, name)
 	return nil
 }
 
 func (s *simpleHashring) MemberCount() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return len(s.hosts)
 }
 
 func (s *simpleHashring) Members() []membership.HostInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.hosts
 }
+
+// removeHost takes a host out of the ring, as if it left the cluster, and notifies the listeners
+func (s *simpleHashring) removeHost(host membership.HostInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.removed[host.GetAddress()]; ok {
+		return
+	}
+	s.removed[host.GetAddress()] = struct{}{}
+	s.refresh(&membership.ChangedEvent{HostsRemoved: []string{host.GetAddress()}})
+}
+
+// addHost puts a removed host back in its original slot of the ring and notifies the listeners
+func (s *simpleHashring) addHost(host membership.HostInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.removed[host.GetAddress()]; !ok {
+		return
+	}
+	delete(s.removed, host.GetAddress())
+	s.refresh(&membership.ChangedEvent{HostsAdded: []string{host.GetAddress()}})
+}
+
+func (s *simpleHashring) refresh(event *membership.ChangedEvent) {
+	hosts := make([]membership.HostInfo, 0, len(s.all))
+	for _, host := range s.all {
+		if _, ok := s.removed[host.GetAddress()]; !ok {
+			hosts = append(hosts, host)
+		}
+	}
+	s.hosts = hosts
+
+	for _, ch := range s.listeners {
+		// like ringpop, don't block on listeners which are not keeping up
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
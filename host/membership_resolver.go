@@ -34,13 +34,15 @@ type simpleResolver struct {
 
 // NewSimpleResolver returns a membership resolver interface
 func NewSimpleResolver(serviceName string, hosts map[string][]membership.HostInfo, currentHost membership.HostInfo) membership.Resolver {
-	resolvers := make(map[string]*simpleHashring, len(hosts))
-	for service, hostList := range hosts {
-		resolvers[service] = newSimpleHashring(hostList)
-	}
+	return newSharedResolver(newSimpleHashrings(hosts), currentHost)
+}
+
+// newSharedResolver returns a membership resolver over rings shared with the other hosts, so that
+// hosts removed from or added to a ring are seen by all of them
+func newSharedResolver(rings map[string]*simpleHashring, currentHost membership.HostInfo) membership.Resolver {
 	return &simpleResolver{
 		hostInfo:  currentHost,
-		resolvers: resolvers,
+		resolvers: rings,
 	}
 }
 
@@ -59,11 +61,26 @@ func (s *simpleResolver) WhoAmI() (membership.HostInfo, error) {
 }
 
 func (s *simpleResolver) Subscribe(service string, name string, notifyChannel chan<- *membership.ChangedEvent) error {
-	return nil
+	resolver, ok := s.resolvers[service]
+	if !ok {
+		// services which are not part of the cluster never change
+		return nil
+	}
+	return resolver.AddListener(s.listenerName(name), notifyChannel)
 }
 
 func (s *simpleResolver) Unsubscribe(service string, name string) error {
-	return nil
+	resolver, ok := s.resolvers[service]
+	if !ok {
+		return nil
+	}
+	return resolver.RemoveListener(s.listenerName(name))
+}
+
+// listenerName scopes a listener to the current host, the rings are shared by every host and they
+// all subscribe under the same names
+func (s *simpleResolver) listenerName(name string) string {
+	return s.hostInfo.GetAddress() + "/" + name
 }
 
 func (s *simpleResolver) Lookup(service string, key string) (membership.HostInfo, error) {
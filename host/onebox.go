@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -101,6 +102,13 @@ type (
 		matchingServices []common.Daemon
 		historyServices  []common.Daemon
 
+		// historyLock guards historyServices, which fault events replace while the cluster runs
+		historyLock      sync.Mutex
+		historyStartTime time.Time
+
+		// membershipRings are shared by the membership resolvers of all the hosts
+		membershipRings map[string]*simpleHashring
+
 		adminClient                   adminClient.Client
 		frontendClient                frontendClient.Client
 		historyClient                 historyClient.Client
@@ -185,6 +193,35 @@ type (
 		// This allows pending timer tasks (e.g. workflow timeout timers) to be processed
 		// before the simulation ends. Defaults to 120 seconds.
 		SleepAfterAllWorkflows time.Duration
+
+		// FaultEvents are injected into the history hosts at fixed offsets from their start,
+		// so that every run of a scenario goes through the same sequence of faults.
+		FaultEvents []HistorySimulationFaultEvent
+	}
+
+	// HistorySimulationFaultEventType is the kind of fault a HistorySimulationFaultEvent injects
+	HistorySimulationFaultEventType string
+
+	// HistorySimulationFaultEvent is a fault injected into the history hosts during the simulation
+	HistorySimulationFaultEvent struct {
+		// At is the offset from the start of the history hosts at which the event fires.
+		At time.Duration
+		// Type is one of the HistorySimulationFaultEvent* constants.
+		Type HistorySimulationFaultEventType
+		// HostIndex is the history host stopped by kill-host and started again by restart-host.
+		// A killed host leaves the membership ring, its shards move to the other hosts until it is restarted.
+		// Killing the only history host leaves every shard unavailable.
+		HostIndex int
+		// ShardIDs are the shards unloaded by close-shards. They are reacquired with a new range ID
+		// on the next request, as happens during a shard handoff.
+		ShardIDs []int
+		// Duration is how long stall-persistence lasts.
+		Duration time.Duration
+		// Latency is added to every matching persistence call during stall-persistence. Defaults to 5 seconds.
+		Latency time.Duration
+		// Operations are the persistence operations stalled by stall-persistence, see errorinjectors.Rule.
+		// Defaults to all operations.
+		Operations []string
 	}
 
 	MatchingConfig struct {
@@ -245,6 +282,10 @@ type (
 		AdaptiveScalerUpdateInterval        time.Duration
 		QPSTrackerInterval                  time.Duration
 		TaskIsolationDuration               time.Duration
+
+		// PersistenceFaultInjectionRules are set as system.persistenceFaultInjectionRules for matching,
+		// see errorinjectors.Rule for the fields. Defaults to no rules.
+		PersistenceFaultInjectionRules []map[string]interface{}
 	}
 
 	SimulationPollerConfiguration struct {
@@ -341,6 +382,16 @@ type (
 	}
 )
 
+// Fault events supported by the history simulation
+const (
+	HistorySimulationFaultEventKillHost         HistorySimulationFaultEventType = "kill-host"
+	HistorySimulationFaultEventRestartHost      HistorySimulationFaultEventType = "restart-host"
+	HistorySimulationFaultEventCloseShards      HistorySimulationFaultEventType = "close-shards"
+	HistorySimulationFaultEventStallPersistence HistorySimulationFaultEventType = "stall-persistence"
+
+	defaultStallPersistenceLatency = 5 * time.Second
+)
+
 // NewCadence returns an instance that hosts full cadence in one process
 func NewCadence(params *CadenceParams) Cadence {
 	return &cadenceImpl{
@@ -393,6 +444,7 @@ func (c *cadenceImpl) Start() error {
 	if c.enableWorker() {
 		hosts[service.Worker] = []membership.HostInfo{c.WorkerServiceHost()}
 	}
+	c.membershipRings = newSimpleHashrings(hosts)
 
 	// create cadence-system domain, this must be created before starting
 	// the services - so directly use the metadataManager to create this
@@ -402,23 +454,27 @@ func (c *cadenceImpl) Start() error {
 
 	var startWG sync.WaitGroup
 	startWG.Add(1)
-	go c.startHistory(hosts, &startWG)
+	go c.startHistory(&startWG)
 	startWG.Wait()
 
 	startWG.Add(1)
-	go c.startMatching(hosts, &startWG)
+	go c.startMatching(&startWG)
 	startWG.Wait()
 
 	startWG.Add(1)
-	go c.startFrontend(hosts, &startWG)
+	go c.startFrontend(&startWG)
 	startWG.Wait()
 
 	if c.enableWorker() {
 		startWG.Add(1)
-		go c.startWorker(hosts, &startWG)
+		go c.startWorker(&startWG)
 		startWG.Wait()
 	}
 
+	if faultEvents := c.historyConfig.SimulationConfig.FaultEvents; len(faultEvents) > 0 {
+		go c.runHistoryFaultEvents(faultEvents)
+	}
+
 	return nil
 }
 
@@ -439,9 +495,11 @@ func (c *cadenceImpl) Stop() {
 
 	c.shutdownWG.Add(serviceCount)
 	c.frontendService.Stop()
+	c.historyLock.Lock()
 	for _, historyService := range c.historyServices {
 		historyService.Stop()
 	}
+	c.historyLock.Unlock()
 	for _, matchingService := range c.matchingServices {
 		matchingService.Stop()
 	}
@@ -679,7 +737,7 @@ func setOperationalDefaults(params *resource.Params) {
 	params.PercentageOnboarded = membership.StaticPercentageOnboarded(0)
 }
 
-func (c *cadenceImpl) startFrontend(hosts map[string][]membership.HostInfo, startWG *sync.WaitGroup) {
+func (c *cadenceImpl) startFrontend(startWG *sync.WaitGroup) {
 	params := new(resource.Params)
 	setOperationalDefaults(params)
 	params.ClusterRedirectionPolicy = &config.ClusterRedirectionPolicy{}
@@ -692,7 +750,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]membership.HostInfo, star
 	params.MetricScope = tally.NewTestScope(service.Frontend, make(map[string]string))
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger), metrics.MigrationConfig{} /* default, only used in test setups */)
 	params.RPCFactory = c.newRPCFactory(service.Frontend, c.FrontendHost(), params.MetricsClient)
-	params.MembershipResolver = newMembershipResolver(params.Name, hosts, c.FrontendHost())
+	params.MembershipResolver = c.newMembershipResolver(c.FrontendHost())
 	params.ClusterMetadata = c.clusterMetadata
 	params.MessagingClient = c.messagingClient
 	params.DynamicConfig = newIntegrationConfigClient(c.dynamicClient, c.frontendDynCfgOverrides)
@@ -772,99 +830,222 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]membership.HostInfo, star
 	c.shutdownWG.Done()
 }
 
-func (c *cadenceImpl) startHistory(hosts map[string][]membership.HostInfo, startWG *sync.WaitGroup) {
-	pprofPorts := c.HistoryPProfPorts()
-	historyHosts := c.HistoryHosts()
-	for i, hostport := range historyHosts {
-		params := new(resource.Params)
-		setOperationalDefaults(params)
-		params.Name = service.History
-		params.Logger = c.logger
-		params.ZapLogger = c.zapLogger
-		params.ThrottledLogger = c.logger
-		params.TimeSource = c.timeSource
-		params.PProfInitializer = newPProfInitializerImpl(c.logger, pprofPorts[i])
-		params.MetricScope = tally.NewTestScope(service.History, make(map[string]string))
-		params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger), metrics.MigrationConfig{} /* default, only used in test setups */)
-		params.RPCFactory = c.newRPCFactory(service.History, hostport, params.MetricsClient)
-		params.MembershipResolver = newMembershipResolver(params.Name, hosts, hostport)
-		params.ClusterMetadata = c.clusterMetadata
-		params.MessagingClient = c.messagingClient
-		integrationClient := newIntegrationConfigClient(c.dynamicClient, c.historyDynCfgOverrides)
-		c.overrideHistoryDynamicConfig(integrationClient)
-		params.DynamicConfig = integrationClient
-		params.DynamicCollection = dynamicconfig.NewCollection(
-			params.DynamicConfig,
-			c.logger,
-			dynamicproperties.ClusterNameFilter(c.clusterMetadata.GetCurrentClusterName()),
-		)
-		params.OperationalDynamicConfig = dynamicconfig.NewCollection(
-			params.OperationalConfigStore,
-			c.logger,
-			dynamicproperties.ClusterNameFilter(c.clusterMetadata.GetCurrentClusterName()),
-		)
-		params.PublicClient = newPublicClient(params.RPCFactory.GetDispatcher())
-		params.ArchivalMetadata = c.archiverMetadata
-		params.ArchiverProvider = c.archiverProvider
-		params.ESConfig = c.esConfig
-		params.ESClient = c.esClient
-		params.PinotConfig = c.pinotConfig
-		params.GetIsolationGroups = getFromDynamicConfig(params)
+func (c *cadenceImpl) startHistory(startWG *sync.WaitGroup) {
+	if err := validateHistoryFaultEvents(c.historyConfig.SimulationConfig.FaultEvents, len(c.HistoryHosts())); err != nil {
+		c.logger.Fatal("Invalid history simulation fault events", tag.Error(err))
+	}
 
-		var err error
-		params.PersistenceConfig, err = copyPersistenceConfig(c.persistenceConfig)
-		if err != nil {
-			c.logger.Fatal("Failed to copy persistence config for history", tag.Error(err))
+	c.historyLock.Lock()
+	c.historyStartTime = time.Now()
+	c.historyServices = make([]common.Daemon, len(c.HistoryHosts()))
+	for i := range c.historyServices {
+		historyService := c.newHistoryService(i)
+		// TODO: this is not correct when there are multiple history hosts as later client will overwrite previous ones.
+		// However current interface for getting history client doesn't specify which client it needs and the tests that use this API
+		// depends on the fact that there's only one history host.
+		// Need to change those tests and modify the interface for getting history client.
+		c.historyClient = NewHistoryClient(historyService.GetDispatcher())
+		c.historyServices[i] = historyService
+
+		go historyService.Start()
+	}
+	c.historyLock.Unlock()
+
+	startWG.Done()
+	c.logger.Info(fmt.Sprintf("Started %d history services", len(c.historyServices)))
+
+	<-c.shutdownCh
+	c.shutdownWG.Done()
+}
+
+func (c *cadenceImpl) newHistoryService(index int) resource.Resource {
+	hostport := c.HistoryHosts()[index]
+	params := new(resource.Params)
+	setOperationalDefaults(params)
+	params.Name = service.History
+	params.Logger = c.logger
+	params.ZapLogger = c.zapLogger
+	params.ThrottledLogger = c.logger
+	params.TimeSource = c.timeSource
+	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.HistoryPProfPorts()[index])
+	params.MetricScope = tally.NewTestScope(service.History, make(map[string]string))
+	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger), metrics.MigrationConfig{} /* default, only used in test setups */)
+	params.RPCFactory = c.newRPCFactory(service.History, hostport, params.MetricsClient)
+	params.MembershipResolver = c.newMembershipResolver(hostport)
+	params.ClusterMetadata = c.clusterMetadata
+	params.MessagingClient = c.messagingClient
+	integrationClient := newIntegrationConfigClient(c.dynamicClient, c.historyDynCfgOverrides)
+	c.overrideHistoryDynamicConfig(integrationClient)
+	params.DynamicConfig = integrationClient
+	params.DynamicCollection = dynamicconfig.NewCollection(
+		params.DynamicConfig,
+		c.logger,
+		dynamicproperties.ClusterNameFilter(c.clusterMetadata.GetCurrentClusterName()),
+	)
+	params.OperationalDynamicConfig = dynamicconfig.NewCollection(
+		params.OperationalConfigStore,
+		c.logger,
+		dynamicproperties.ClusterNameFilter(c.clusterMetadata.GetCurrentClusterName()),
+	)
+	params.PublicClient = newPublicClient(params.RPCFactory.GetDispatcher())
+	params.ArchivalMetadata = c.archiverMetadata
+	params.ArchiverProvider = c.archiverProvider
+	params.ESConfig = c.esConfig
+	params.ESClient = c.esClient
+	params.PinotConfig = c.pinotConfig
+	params.GetIsolationGroups = getFromDynamicConfig(params)
+
+	var err error
+	params.PersistenceConfig, err = copyPersistenceConfig(c.persistenceConfig)
+	if err != nil {
+		c.logger.Fatal("Failed to copy persistence config for history", tag.Error(err))
+	}
+
+	if c.pinotConfig != nil {
+		pinotDataStoreName := "pinot-visibility"
+		params.PersistenceConfig.AdvancedVisibilityStore = pinotDataStoreName
+		params.PersistenceConfig.DataStores[pinotDataStoreName] = config.DataStore{
+			Pinot:         c.pinotConfig,
+			ElasticSearch: c.esConfig,
 		}
+		params.DynamicConfig.UpdateValue(dynamicproperties.WriteVisibilityStoreName, constants.VisibilityModePinot)
+	} else if c.esConfig != nil {
+		esDataStoreName := "es-visibility"
+		params.PersistenceConfig.AdvancedVisibilityStore = esDataStoreName
+		params.PersistenceConfig.DataStores[esDataStoreName] = config.DataStore{
+			ElasticSearch: c.esConfig,
+		}
+	}
 
-		if c.pinotConfig != nil {
-			pinotDataStoreName := "pinot-visibility"
-			params.PersistenceConfig.AdvancedVisibilityStore = pinotDataStoreName
-			params.PersistenceConfig.DataStores[pinotDataStoreName] = config.DataStore{
-				Pinot:         c.pinotConfig,
-				ElasticSearch: c.esConfig,
+	historyService, err := history.NewService(params)
+	if err != nil {
+		params.Logger.Fatal("unable to start history service", tag.Error(err))
+	}
+
+	if c.mockAdminClient != nil {
+		clientBean := historyService.GetClientBean()
+		if clientBean != nil {
+			for serviceName, client := range c.mockAdminClient {
+				clientBean.SetRemoteAdminClient(serviceName, client)
 			}
-			params.DynamicConfig.UpdateValue(dynamicproperties.WriteVisibilityStoreName, constants.VisibilityModePinot)
-		} else if c.esConfig != nil {
-			esDataStoreName := "es-visibility"
-			params.PersistenceConfig.AdvancedVisibilityStore = esDataStoreName
-			params.PersistenceConfig.DataStores[esDataStoreName] = config.DataStore{
-				ElasticSearch: c.esConfig,
+		}
+	}
+	return historyService
+}
+
+func validateHistoryFaultEvents(events []HistorySimulationFaultEvent, numHosts int) error {
+	for i, event := range events {
+		switch event.Type {
+		case HistorySimulationFaultEventKillHost, HistorySimulationFaultEventRestartHost:
+			if event.HostIndex < 0 || event.HostIndex >= numHosts {
+				return fmt.Errorf("fault event %d: host index %d is out of range, there are %d history hosts", i, event.HostIndex, numHosts)
+			}
+		case HistorySimulationFaultEventCloseShards:
+			if len(event.ShardIDs) == 0 {
+				return fmt.Errorf("fault event %d: close-shards requires shard IDs", i)
+			}
+		case HistorySimulationFaultEventStallPersistence:
+			if event.Duration <= 0 {
+				return fmt.Errorf("fault event %d: stall-persistence requires a duration", i)
 			}
+		default:
+			return fmt.Errorf("fault event %d: unknown type %q", i, event.Type)
 		}
+	}
+	return nil
+}
 
-		historyService, err := history.NewService(params)
-		if err != nil {
-			params.Logger.Fatal("unable to start history service", tag.Error(err))
+// runHistoryFaultEvents fires the host and shard events at their offsets from the start of the history hosts.
+// Persistence stalls are not fired here, they are installed up front as time bound fault injection rules.
+func (c *cadenceImpl) runHistoryFaultEvents(events []HistorySimulationFaultEvent) {
+	events = append([]HistorySimulationFaultEvent(nil), events...)
+	sort.SliceStable(events, func(i, j int) bool { return events[i].At < events[j].At })
+
+	for _, event := range events {
+		if event.Type == HistorySimulationFaultEventStallPersistence {
+			continue
+		}
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-time.After(time.Until(c.historyStartTime.Add(event.At))):
 		}
 
-		if c.mockAdminClient != nil {
-			clientBean := historyService.GetClientBean()
-			if clientBean != nil {
-				for serviceName, client := range c.mockAdminClient {
-					clientBean.SetRemoteAdminClient(serviceName, client)
+		c.logger.Info("Firing history simulation fault event",
+			tag.Dynamic("fault-event-type", event.Type),
+			tag.Dynamic("fault-event-at", event.At),
+		)
+		switch event.Type {
+		case HistorySimulationFaultEventKillHost:
+			c.historyLock.Lock()
+			c.historyServices[event.HostIndex].Stop()
+			// there is no failure detection in onebox, take the host out of the ring so its shards move
+			c.membershipRings[service.History].removeHost(c.HistoryHosts()[event.HostIndex])
+			c.historyLock.Unlock()
+		case HistorySimulationFaultEventRestartHost:
+			c.restartHistoryHost(event.HostIndex)
+		case HistorySimulationFaultEventCloseShards:
+			for _, shardID := range event.ShardIDs {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				err := c.adminClient.CloseShard(ctx, &types.CloseShardRequest{ShardID: int32(shardID)})
+				cancel()
+				if err != nil {
+					c.logger.Warn("Failed to close shard", tag.ShardID(shardID), tag.Error(err))
 				}
 			}
 		}
+	}
+}
 
-		// TODO: this is not correct when there are multiple history hosts as later client will overwrite previous ones.
-		// However current interface for getting history client doesn't specify which client it needs and the tests that use this API
-		// depends on the fact that there's only one history host.
-		// Need to change those tests and modify the interface for getting history client.
-		c.historyClient = NewHistoryClient(historyService.GetDispatcher())
-		c.historyServices = append(c.historyServices, historyService)
+func (c *cadenceImpl) restartHistoryHost(index int) {
+	c.historyLock.Lock()
+	defer c.historyLock.Unlock()
 
-		go historyService.Start()
+	select {
+	case <-c.shutdownCh:
+		return
+	default:
 	}
 
-	startWG.Done()
-	c.logger.Info(fmt.Sprintf("Started %d history services", len(c.historyServices)))
+	// restarting a host which was not killed behaves like a crash followed by a restart
+	c.historyServices[index].Stop()
+	historyService := c.newHistoryService(index)
+	if index == len(c.historyServices)-1 {
+		c.historyClient = NewHistoryClient(historyService.GetDispatcher())
+	}
+	c.historyServices[index] = historyService
+	c.membershipRings[service.History].addHost(c.HistoryHosts()[index])
+	go historyService.Start()
+}
 
-	<-c.shutdownCh
-	c.shutdownWG.Done()
+// historyPersistenceStallRules turns the stall-persistence events into fault injection rules bound to the
+// window of each stall
+func (c *cadenceImpl) historyPersistenceStallRules() []interface{} {
+	var rules []interface{}
+	for _, event := range c.historyConfig.SimulationConfig.FaultEvents {
+		if event.Type != HistorySimulationFaultEventStallPersistence {
+			continue
+		}
+		latency := event.Latency
+		if latency == 0 {
+			latency = defaultStallPersistenceLatency
+		}
+		operations := event.Operations
+		if len(operations) == 0 {
+			operations = []string{"*"}
+		}
+		start := c.historyStartTime.Add(event.At)
+		rules = append(rules, map[string]interface{}{
+			"operations": operations,
+			"latency":    latency.String(),
+			"start":      start,
+			"end":        start.Add(event.Duration),
+		})
+	}
+	return rules
 }
 
-func (c *cadenceImpl) startMatching(hosts map[string][]membership.HostInfo, startWG *sync.WaitGroup) {
+func (c *cadenceImpl) startMatching(startWG *sync.WaitGroup) {
 	pprofPorts := c.MatchingPProfPorts()
 	promPorts := c.MatchingPrometheusPorts()
 	for i, hostport := range c.MatchingHosts() {
@@ -887,7 +1068,7 @@ func (c *cadenceImpl) startMatching(hosts map[string][]membership.HostInfo, star
 		params.MetricScope = metricsCfg.NewScope(c.logger, service.Matching)
 		params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger), metrics.MigrationConfig{} /* default, only used in test setups */)
 		params.RPCFactory = c.newRPCFactory(service.Matching, hostport, params.MetricsClient)
-		params.MembershipResolver = newMembershipResolver(params.Name, hosts, hostport)
+		params.MembershipResolver = c.newMembershipResolver(hostport)
 		params.ClusterMetadata = c.clusterMetadata
 		params.DynamicConfig = newIntegrationConfigClient(c.dynamicClient, c.matchingDynCfgOverrides)
 		params.DynamicCollection = dynamicconfig.NewCollection(
@@ -956,7 +1137,7 @@ func (c *cadenceImpl) startMatching(hosts map[string][]membership.HostInfo, star
 	c.shutdownWG.Done()
 }
 
-func (c *cadenceImpl) startWorker(hosts map[string][]membership.HostInfo, startWG *sync.WaitGroup) {
+func (c *cadenceImpl) startWorker(startWG *sync.WaitGroup) {
 	defer c.shutdownWG.Done()
 
 	params := new(resource.Params)
@@ -970,7 +1151,7 @@ func (c *cadenceImpl) startWorker(hosts map[string][]membership.HostInfo, startW
 	params.MetricScope = tally.NewTestScope(service.Worker, make(map[string]string))
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, c.logger), metrics.MigrationConfig{} /* default, only used in test setups */)
 	params.RPCFactory = c.newRPCFactory(service.Worker, c.WorkerServiceHost(), params.MetricsClient)
-	params.MembershipResolver = newMembershipResolver(params.Name, hosts, c.WorkerServiceHost())
+	params.MembershipResolver = c.newMembershipResolver(c.WorkerServiceHost())
 	params.ClusterMetadata = c.clusterMetadata
 	params.DynamicConfig = newIntegrationConfigClient(c.dynamicClient, c.workerDynCfgOverrides)
 	params.DynamicCollection = dynamicconfig.NewCollection(
@@ -1214,6 +1395,11 @@ func (c *cadenceImpl) overrideHistoryDynamicConfig(client *dynamicClient) {
 	if c.historyConfig.HistoryCountLimitError != 0 {
 		client.OverrideValue(dynamicproperties.HistoryCountLimitError, c.historyConfig.HistoryCountLimitError)
 	}
+	if stallRules := c.historyPersistenceStallRules(); len(stallRules) > 0 {
+		// stalls are added after the configured rules, which therefore stay static for the whole run
+		rules, _ := client.GetListValue(dynamicproperties.PersistenceFaultInjectionRules, nil)
+		client.OverrideValue(dynamicproperties.PersistenceFaultInjectionRules, append(rules, stallRules...))
+	}
 }
 
 // copyPersistenceConfig makes a deepcopy of persistence config.
@@ -1238,8 +1424,8 @@ func copyPersistenceConfig(pConfig config.Persistence) (config.Persistence, erro
 	return pConfig, nil
 }
 
-func newMembershipResolver(serviceName string, hosts map[string][]membership.HostInfo, currentHost membership.HostInfo) membership.Resolver {
-	return NewSimpleResolver(serviceName, hosts, currentHost)
+func (c *cadenceImpl) newMembershipResolver(currentHost membership.HostInfo) membership.Resolver {
+	return newSharedResolver(c.membershipRings, currentHost)
 }
 
 func newPProfInitializerImpl(logger log.Logger, port int) common.PProfInitializer {
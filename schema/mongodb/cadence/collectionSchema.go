@@ -38,4 +38,6 @@ type ClusterConfigCollectionEntry struct {
 	Data                 []byte `json:"data"`
 	DataEncoding         string `json:"dataencoding"`
 	UnixTimestampSeconds int64  `json:"unixtimestampseconds"`
+	Author               string `json:"author"`
+	Reason               string `json:"reason"`
 }
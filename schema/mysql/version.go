@@ -25,7 +25,7 @@ import "github.com/uber/cadence/schema/common"
 // NOTE: whenever there is a new data base schema update, plz update the following versions
 
 // Version is the MySQL database release version
-const Version = "0.8"
+const Version = "0.9"
 
 // VisibilityVersion is the MySQL visibility database release version
 const VisibilityVersion = "0.8"
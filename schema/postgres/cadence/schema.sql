@@ -271,6 +271,8 @@ CREATE TABLE cluster_config (
   timestamp TIMESTAMP NOT NULL,
   data           BYTEA NOT NULL,
   data_encoding  VARCHAR(16) NOT NULL,
+  author         VARCHAR(255) NOT NULL DEFAULT '',
+  reason         VARCHAR(1024) NOT NULL DEFAULT '',
   PRIMARY KEY (row_type, version)
 );
 
@@ -0,0 +1,2 @@
+ALTER TABLE cluster_config ADD COLUMN author VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE cluster_config ADD COLUMN reason VARCHAR(1024) NOT NULL DEFAULT '';
@@ -297,6 +297,8 @@ CREATE TABLE cluster_config
     timestamp     DATETIME(6) NOT NULL,
     data          MEDIUMBLOB  NOT NULL,
     data_encoding VARCHAR(16) NOT NULL,
+    author        VARCHAR(255) NOT NULL DEFAULT '',
+    reason        VARCHAR(1024) NOT NULL DEFAULT '',
     PRIMARY KEY (row_type, version)
 );
 
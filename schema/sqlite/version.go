@@ -27,7 +27,7 @@ import (
 // NOTE: whenever there is a new data base schema update, plz update the following versions
 
 // Version is the SQLite database release version
-const Version = "0.3"
+const Version = "0.4"
 
 // VisibilityVersion is the SQLite visibility database release version
 const VisibilityVersion = "0.2"
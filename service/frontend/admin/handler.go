@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/asyncworkflow/queueconfigapi"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -43,6 +45,7 @@ import (
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/configstore"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/isolationgroup/isolationgroupapi"
@@ -63,6 +66,7 @@ import (
 const (
 	getDomainReplicationMessageBatchSize = 100
 	defaultLastMessageID                 = int64(-1)
+	defaultDynamicConfigVersionsPageSize = 20
 )
 
 type (
@@ -1598,7 +1602,8 @@ func (adh *adminHandlerImpl) UpdateDynamicConfig(ctx context.Context, request *t
 	if request == nil {
 		return adh.error(validate.ErrRequestNotSet, scope)
 	}
-	return adh.updateDynamicConfigValue(ctx, scope, adh.params.DynamicConfig, request.ConfigName, request.ConfigValues)
+	change := adh.dynamicConfigChange(ctx, request.Reason)
+	return adh.updateDynamicConfigValue(ctx, scope, adh.params.DynamicConfig, request.ConfigName, request.ConfigValues, change)
 }
 
 func (adh *adminHandlerImpl) RestoreDynamicConfig(ctx context.Context, request *types.RestoreDynamicConfigRequest) (retError error) {
@@ -1608,7 +1613,8 @@ func (adh *adminHandlerImpl) RestoreDynamicConfig(ctx context.Context, request *
 	if request == nil {
 		return adh.error(validate.ErrRequestNotSet, scope)
 	}
-	return adh.restoreDynamicConfigValue(ctx, scope, adh.params.DynamicConfig, request.ConfigName, request.Filters)
+	change := adh.dynamicConfigChange(ctx, request.Reason)
+	return adh.restoreDynamicConfigValue(ctx, scope, adh.params.DynamicConfig, request.ConfigName, request.Filters, change)
 }
 
 func (adh *adminHandlerImpl) ListDynamicConfig(ctx context.Context, request *types.ListDynamicConfigRequest) (_ *types.ListDynamicConfigResponse, retError error) {
@@ -1625,6 +1631,159 @@ func (adh *adminHandlerImpl) ListDynamicConfig(ctx context.Context, request *typ
 	return &types.ListDynamicConfigResponse{Entries: entries}, nil
 }
 
+func (adh *adminHandlerImpl) ResolveDynamicConfig(ctx context.Context, request *types.ResolveDynamicConfigRequest) (_ *types.ResolveDynamicConfigResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminResolveDynamicConfigScope)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	keyVal, err := adh.resolveDynamicConfigKey(scope, request.ConfigName)
+	if err != nil {
+		return nil, err
+	}
+	explainer, ok := adh.params.DynamicConfig.(dynamicconfig.Explainer)
+	if !ok {
+		return nil, adh.error(&types.BadRequestError{Message: "dynamic config client does not support resolving values"}, scope)
+	}
+	filters, err := convertFilterListToMap(request.Filters)
+	if err != nil {
+		return nil, adh.error(validate.ErrInvalidFilters, scope)
+	}
+	// services look values up with their cluster name, see dynamicconfigfx
+	if _, ok := filters[dynamicproperties.ClusterName]; !ok {
+		filters[dynamicproperties.ClusterName] = adh.GetClusterMetadata().GetCurrentClusterName()
+	}
+
+	res, err := explainer.ExplainValue(keyVal, filters)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp, err := toResolveDynamicConfigResponse(res)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) ListDynamicConfigVersions(ctx context.Context, request *types.ListDynamicConfigVersionsRequest) (_ *types.ListDynamicConfigVersionsResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListDynamicConfigVersionsScope)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	client, err := adh.versionedConfigClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultDynamicConfigVersionsPageSize
+	}
+	versions, err := client.ListVersions(request.MaxVersion, pageSize)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp := &types.ListDynamicConfigVersionsResponse{}
+	for _, version := range versions {
+		resp.Versions = append(resp.Versions, &types.DynamicConfigVersion{
+			Version:   version.Version,
+			Timestamp: version.Timestamp,
+			Author:    version.Author,
+			Reason:    version.Reason,
+		})
+	}
+	if len(versions) == pageSize && versions[len(versions)-1].Version > 1 {
+		resp.NextMaxVersion = versions[len(versions)-1].Version - 1
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) DiffDynamicConfigVersions(ctx context.Context, request *types.DiffDynamicConfigVersionsRequest) (_ *types.DiffDynamicConfigVersionsResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDiffDynamicConfigVersionsScope)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.FromVersion <= 0 {
+		return nil, adh.error(&types.BadRequestError{Message: "FromVersion must be set."}, scope)
+	}
+	client, err := adh.versionedConfigClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	from, err := client.GetVersion(request.FromVersion)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	to, err := client.GetVersion(request.ToVersion)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.DiffDynamicConfigVersionsResponse{
+		From:  from,
+		To:    to,
+		Diffs: configstore.DiffEntries(from.Entries, to.Entries),
+	}, nil
+}
+
+func (adh *adminHandlerImpl) RollbackDynamicConfig(ctx context.Context, request *types.RollbackDynamicConfigRequest) (_ *types.RollbackDynamicConfigResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRollbackDynamicConfigScope)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.Version <= 0 {
+		return nil, adh.error(&types.BadRequestError{Message: "Version must be set."}, scope)
+	}
+	client, err := adh.versionedConfigClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	change := adh.dynamicConfigChange(ctx, request.Reason)
+	newVersion, err := client.Rollback(request.Version, change)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	adh.GetLogger().Info("dynamic config rolled back",
+		tag.Name(change.Author),
+		tag.Value(request.Version),
+		tag.CurrentVersion(newVersion),
+	)
+	return &types.RollbackDynamicConfigResponse{NewVersion: newVersion}, nil
+}
+
+// versionedConfigClient returns the dynamic config client when it keeps version history, which
+// is only the case for the config store backed client.
+func (adh *adminHandlerImpl) versionedConfigClient(scope metrics.Scope) (configstore.VersionedClient, error) {
+	client, ok := adh.params.DynamicConfig.(configstore.VersionedClient)
+	if !ok {
+		return nil, adh.error(&types.BadRequestError{Message: "dynamic config versions are only available with the config store client"}, scope)
+	}
+	return client, nil
+}
+
+// dynamicConfigChange describes a dynamic config change made by the caller. The author is the caller
+// authenticated by the access controlled handler, or the calling service when no authorizer
+// authenticates callers. The public IDL has no reason on the update and restore requests, the admin clients
+// send it in a header.
+func (adh *adminHandlerImpl) dynamicConfigChange(ctx context.Context, reason string) configstore.ChangeInfo {
+	author := authorization.AuthenticatedActor(ctx)
+	if call := yarpc.CallFromContext(ctx); call != nil {
+		if author == "" {
+			author = call.Caller()
+		}
+		if reason == "" {
+			reason = call.Header(common.DynamicConfigChangeReasonHeaderName)
+		}
+	}
+	return configstore.ChangeInfo{Author: author, Reason: reason}
+}
+
 func (adh *adminHandlerImpl) GetOperationalDynamicConfig(ctx context.Context, request *types.GetOperationalDynamicConfigRequest) (_ *types.GetOperationalDynamicConfigResponse, retError error) {
 	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
 	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetOperationalDynamicConfigScope)
@@ -1655,7 +1814,7 @@ func (adh *adminHandlerImpl) UpdateOperationalDynamicConfig(ctx context.Context,
 		return err
 	}
 	adh.logOperationalConfigChange(ctx, "Update", request.ConfigName, request.ConfigValues)
-	return adh.updateDynamicConfigValue(ctx, scope, client, request.ConfigName, request.ConfigValues)
+	return adh.updateDynamicConfigValue(ctx, scope, client, request.ConfigName, request.ConfigValues, adh.dynamicConfigChange(ctx, ""))
 }
 
 func (adh *adminHandlerImpl) RestoreOperationalDynamicConfig(ctx context.Context, request *types.RestoreOperationalDynamicConfigRequest) (retError error) {
@@ -1670,7 +1829,7 @@ func (adh *adminHandlerImpl) RestoreOperationalDynamicConfig(ctx context.Context
 		return err
 	}
 	adh.logOperationalConfigChange(ctx, "Restore", request.ConfigName, request.Filters)
-	return adh.restoreDynamicConfigValue(ctx, scope, client, request.ConfigName, request.Filters)
+	return adh.restoreDynamicConfigValue(ctx, scope, client, request.ConfigName, request.Filters, adh.dynamicConfigChange(ctx, ""))
 }
 
 func (adh *adminHandlerImpl) ListOperationalDynamicConfig(ctx context.Context, request *types.ListOperationalDynamicConfigRequest) (_ *types.ListOperationalDynamicConfigResponse, retError error) {
@@ -1775,11 +1934,15 @@ func (adh *adminHandlerImpl) updateDynamicConfigValue(
 	client dynamicconfig.Client,
 	configName string,
 	values []*types.DynamicConfigValue,
+	change configstore.ChangeInfo,
 ) error {
 	keyVal, err := adh.resolveDynamicConfigKey(scope, configName)
 	if err != nil {
 		return err
 	}
+	if versioned, ok := client.(configstore.VersionedClient); ok {
+		return versioned.UpdateValueWithChange(keyVal, values, change)
+	}
 	return client.UpdateValue(keyVal, values)
 }
 
@@ -1791,6 +1954,7 @@ func (adh *adminHandlerImpl) restoreDynamicConfigValue(
 	client dynamicconfig.Client,
 	configName string,
 	filters []*types.DynamicConfigFilter,
+	change configstore.ChangeInfo,
 ) error {
 	keyVal, err := adh.resolveDynamicConfigKey(scope, configName)
 	if err != nil {
@@ -1803,6 +1967,9 @@ func (adh *adminHandlerImpl) restoreDynamicConfigValue(
 			return adh.error(validate.ErrInvalidFilters, scope)
 		}
 	}
+	if versioned, ok := client.(configstore.VersionedClient); ok {
+		return versioned.RestoreValueWithChange(keyVal, convFilters, change)
+	}
 	return client.RestoreValue(keyVal, convFilters)
 }
 
@@ -1971,6 +2138,67 @@ func convertFromDataBlob(blob *types.DataBlob) (interface{}, error) {
 	}
 }
 
+func convertToDataBlob(v interface{}) (*types.DataBlob, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DataBlob{
+		EncodingType: types.EncodingTypeJSON.Ptr(),
+		Data:         data,
+	}, nil
+}
+
+// convertConstraintsToFilterList converts client constraints to filters, sorted by name
+func convertConstraintsToFilterList(constraints map[string]interface{}) ([]*types.DynamicConfigFilter, error) {
+	var filters []*types.DynamicConfigFilter
+	for name, v := range constraints {
+		blob, err := convertToDataBlob(v)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, &types.DynamicConfigFilter{Name: name, Value: blob})
+	}
+	sort.Slice(filters, func(i, j int) bool {
+		return filters[i].Name < filters[j].Name
+	})
+	return filters, nil
+}
+
+func toResolveDynamicConfigResponse(res *dynamicconfig.Resolution) (*types.ResolveDynamicConfigResponse, error) {
+	value, err := convertToDataBlob(res.Value)
+	if err != nil {
+		return nil, err
+	}
+	matched, err := convertConstraintsToFilterList(res.Constraints)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.ResolveDynamicConfigResponse{
+		Value:          value,
+		Source:         res.Source,
+		MatchedFilters: matched,
+		IsDefault:      res.Default,
+		Detail:         res.Detail,
+	}
+	for _, c := range res.Candidates {
+		candidateValue, err := convertToDataBlob(c.Value)
+		if err != nil {
+			return nil, err
+		}
+		filters, err := convertConstraintsToFilterList(c.Constraints)
+		if err != nil {
+			return nil, err
+		}
+		resp.Candidates = append(resp.Candidates, &types.DynamicConfigCandidate{
+			Value:   candidateValue,
+			Filters: filters,
+			Matched: c.Matched,
+		})
+	}
+	return resp, nil
+}
+
 func convertFilterListToMap(filters []*types.DynamicConfigFilter) (map[dynamicproperties.Filter]interface{}, error) {
 	newFilters := make(map[dynamicproperties.Filter]interface{})
 
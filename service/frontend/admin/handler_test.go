@@ -35,14 +35,18 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/asyncworkflow/queueconfigapi"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -3095,3 +3099,308 @@ func TestListOperationalDynamicConfig(t *testing.T) {
 		assert.Equal(t, "testGetIntPropertyKey", resp.Entries[0].Name)
 	})
 }
+
+type versionedDynamicConfigClient struct {
+	*dynamicconfig.MockClient
+	*configstore.MockVersionedClient
+}
+
+func newVersionedConfigAdminHandler(t *testing.T, client dynamicconfig.Client) adminHandlerImpl {
+	t.Helper()
+	return adminHandlerImpl{
+		Resource: &resource.Test{
+			Logger:        testlogger.New(t),
+			MetricsClient: metrics.NewNoopMetricsClient(),
+		},
+		params: &resource.Params{
+			DynamicConfig: client,
+		},
+	}
+}
+
+func TestUpdateDynamicConfig_RecordsChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	versioned := configstore.NewMockVersionedClient(ctrl)
+	versioned.EXPECT().
+		UpdateValueWithChange(gomock.Any(), gomock.Any(), configstore.ChangeInfo{Author: "alice", Reason: "incident"}).
+		Return(nil)
+	h := newVersionedConfigAdminHandler(t, versionedDynamicConfigClient{dynamicconfig.NewMockClient(ctrl), versioned})
+
+	err := h.UpdateDynamicConfig(authorization.WithAuthenticatedActor(context.Background(), "alice"), &types.UpdateDynamicConfigRequest{
+		ConfigName: "testGetIntPropertyKey",
+		Reason:     "incident",
+	})
+	assert.NoError(t, err)
+}
+
+func TestDynamicConfigChange(t *testing.T) {
+	inboundCall := func(t *testing.T, headers transport.Headers) context.Context {
+		ctx, call := encoding.NewInboundCall(context.Background())
+		require.NoError(t, call.ReadFromRequest(&transport.Request{Caller: "cadence-cli", Headers: headers}))
+		return ctx
+	}
+	reasonHeader := transport.NewHeaders().With(common.DynamicConfigChangeReasonHeaderName, "from header")
+
+	tests := map[string]struct {
+		ctx      context.Context
+		reason   string
+		expected configstore.ChangeInfo
+	}{
+		"authenticated caller": {
+			ctx:      authorization.WithAuthenticatedActor(inboundCall(t, transport.NewHeaders()), "alice"),
+			reason:   "incident",
+			expected: configstore.ChangeInfo{Author: "alice", Reason: "incident"},
+		},
+		"calling service without authenticated caller": {
+			ctx:      inboundCall(t, transport.NewHeaders()),
+			expected: configstore.ChangeInfo{Author: "cadence-cli"},
+		},
+		"reason from header": {
+			ctx:      inboundCall(t, reasonHeader),
+			expected: configstore.ChangeInfo{Author: "cadence-cli", Reason: "from header"},
+		},
+		"request reason wins over header": {
+			ctx:      inboundCall(t, reasonHeader),
+			reason:   "incident",
+			expected: configstore.ChangeInfo{Author: "cadence-cli", Reason: "incident"},
+		},
+		"not a yarpc call": {
+			ctx:      context.Background(),
+			expected: configstore.ChangeInfo{},
+		},
+	}
+	h := newVersionedConfigAdminHandler(t, nil)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, h.dynamicConfigChange(tc.ctx, tc.reason))
+		})
+	}
+}
+
+func TestListDynamicConfigVersions(t *testing.T) {
+	tests := map[string]struct {
+		input        *types.ListDynamicConfigVersionsRequest
+		unversioned  bool
+		mockSetup    func(mock *configstore.MockVersionedClient)
+		expected     *types.ListDynamicConfigVersionsResponse
+		expectedType error
+	}{
+		"nil request": {
+			input:        nil,
+			expectedType: &types.BadRequestError{},
+		},
+		"unversioned client": {
+			input:        &types.ListDynamicConfigVersionsRequest{},
+			unversioned:  true,
+			expectedType: &types.BadRequestError{},
+		},
+		"full page": {
+			input: &types.ListDynamicConfigVersionsRequest{PageSize: 2},
+			mockSetup: func(mock *configstore.MockVersionedClient) {
+				mock.EXPECT().ListVersions(int64(0), 2).Return([]*types.DynamicConfigVersion{
+					{Version: 5, Author: "alice", Entries: []*types.DynamicConfigEntry{{Name: "foo"}}},
+					{Version: 4},
+				}, nil)
+			},
+			expected: &types.ListDynamicConfigVersionsResponse{
+				Versions:       []*types.DynamicConfigVersion{{Version: 5, Author: "alice"}, {Version: 4}},
+				NextMaxVersion: 3,
+			},
+		},
+		"last page": {
+			input: &types.ListDynamicConfigVersionsRequest{MaxVersion: 3},
+			mockSetup: func(mock *configstore.MockVersionedClient) {
+				mock.EXPECT().ListVersions(int64(3), defaultDynamicConfigVersionsPageSize).Return([]*types.DynamicConfigVersion{{Version: 3}}, nil)
+			},
+			expected: &types.ListDynamicConfigVersionsResponse{
+				Versions: []*types.DynamicConfigVersion{{Version: 3}},
+			},
+		},
+		"store error": {
+			input: &types.ListDynamicConfigVersionsRequest{},
+			mockSetup: func(mock *configstore.MockVersionedClient) {
+				mock.EXPECT().ListVersions(int64(0), defaultDynamicConfigVersionsPageSize).Return(nil, &types.InternalServiceError{Message: "db error"})
+			},
+			expectedType: &types.InternalServiceError{},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			var client dynamicconfig.Client = dynamicconfig.NewMockClient(ctrl)
+			if !td.unversioned {
+				versioned := configstore.NewMockVersionedClient(ctrl)
+				if td.mockSetup != nil {
+					td.mockSetup(versioned)
+				}
+				client = versionedDynamicConfigClient{dynamicconfig.NewMockClient(ctrl), versioned}
+			}
+			h := newVersionedConfigAdminHandler(t, client)
+
+			resp, err := h.ListDynamicConfigVersions(context.Background(), td.input)
+			if td.expectedType != nil {
+				assert.IsType(t, td.expectedType, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, td.expected, resp)
+		})
+	}
+}
+
+func TestDiffDynamicConfigVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	versioned := configstore.NewMockVersionedClient(ctrl)
+	h := newVersionedConfigAdminHandler(t, versionedDynamicConfigClient{dynamicconfig.NewMockClient(ctrl), versioned})
+
+	t.Run("missing from version", func(t *testing.T) {
+		_, err := h.DiffDynamicConfigVersions(context.Background(), &types.DiffDynamicConfigVersionsRequest{})
+		assert.IsType(t, &types.BadRequestError{}, err)
+	})
+	t.Run("missing version", func(t *testing.T) {
+		versioned.EXPECT().GetVersion(int64(9)).Return(nil, &types.EntityNotExistsError{})
+		_, err := h.DiffDynamicConfigVersions(context.Background(), &types.DiffDynamicConfigVersionsRequest{FromVersion: 9})
+		assert.IsType(t, &types.EntityNotExistsError{}, err)
+	})
+	t.Run("diff against latest", func(t *testing.T) {
+		from := &types.DynamicConfigVersion{Version: 1, Entries: []*types.DynamicConfigEntry{{Name: "foo"}}}
+		to := &types.DynamicConfigVersion{Version: 2, Entries: []*types.DynamicConfigEntry{{Name: "bar"}}}
+		versioned.EXPECT().GetVersion(int64(1)).Return(from, nil)
+		versioned.EXPECT().GetVersion(int64(0)).Return(to, nil)
+
+		resp, err := h.DiffDynamicConfigVersions(context.Background(), &types.DiffDynamicConfigVersionsRequest{FromVersion: 1})
+		require.NoError(t, err)
+		assert.Equal(t, from, resp.From)
+		assert.Equal(t, to, resp.To)
+		assert.Equal(t, []*types.DynamicConfigEntryDiff{{Name: "bar"}, {Name: "foo"}}, resp.Diffs)
+	})
+}
+
+func TestRollbackDynamicConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	versioned := configstore.NewMockVersionedClient(ctrl)
+	h := newVersionedConfigAdminHandler(t, versionedDynamicConfigClient{dynamicconfig.NewMockClient(ctrl), versioned})
+
+	t.Run("nil request", func(t *testing.T) {
+		_, err := h.RollbackDynamicConfig(context.Background(), nil)
+		assert.IsType(t, &types.BadRequestError{}, err)
+	})
+	t.Run("missing version", func(t *testing.T) {
+		_, err := h.RollbackDynamicConfig(context.Background(), &types.RollbackDynamicConfigRequest{})
+		assert.IsType(t, &types.BadRequestError{}, err)
+	})
+	t.Run("rolls back", func(t *testing.T) {
+		versioned.EXPECT().Rollback(int64(3), configstore.ChangeInfo{Author: "bob", Reason: "revert"}).Return(int64(8), nil)
+		resp, err := h.RollbackDynamicConfig(authorization.WithAuthenticatedActor(context.Background(), "bob"), &types.RollbackDynamicConfigRequest{
+			Version: 3,
+			Reason:  "revert",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), resp.NewVersion)
+	})
+}
+
+type fakeDynamicConfigExplainer struct {
+	dynamicconfig.Client
+	explain func(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) (*dynamicconfig.Resolution, error)
+}
+
+func (f fakeDynamicConfigExplainer) ExplainValue(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) (*dynamicconfig.Resolution, error) {
+	return f.explain(name, filters)
+}
+
+func TestResolveDynamicConfig(t *testing.T) {
+	jsonBlob := func(data string) *types.DataBlob {
+		return &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(data)}
+	}
+	domainFilter := &types.DynamicConfigFilter{Name: "domainName", Value: jsonBlob(`"samples-domain"`)}
+
+	tests := map[string]struct {
+		input        *types.ResolveDynamicConfigRequest
+		unsupported  bool
+		explain      func(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) (*dynamicconfig.Resolution, error)
+		expected     *types.ResolveDynamicConfigResponse
+		expectedType error
+	}{
+		"nil request": {
+			input:        nil,
+			expectedType: &types.BadRequestError{},
+		},
+		"invalid config name": {
+			input:        &types.ResolveDynamicConfigRequest{ConfigName: "invalid"},
+			expectedType: &types.InternalServiceError{},
+		},
+		"unsupported client": {
+			input:        &types.ResolveDynamicConfigRequest{ConfigName: "testGetIntPropertyKey"},
+			unsupported:  true,
+			expectedType: &types.BadRequestError{},
+		},
+		"explain error": {
+			input: &types.ResolveDynamicConfigRequest{ConfigName: "testGetIntPropertyKey"},
+			explain: func(dynamicproperties.Key, map[dynamicproperties.Filter]interface{}) (*dynamicconfig.Resolution, error) {
+				return nil, errors.New("explain failed")
+			},
+			expectedType: &types.InternalServiceError{},
+		},
+		"resolves with the current cluster": {
+			input: &types.ResolveDynamicConfigRequest{
+				ConfigName: "testGetIntPropertyKey",
+				Filters:    []*types.DynamicConfigFilter{domainFilter},
+			},
+			explain: func(name dynamicproperties.Key, filters map[dynamicproperties.Filter]interface{}) (*dynamicconfig.Resolution, error) {
+				assert.Equal(t, dynamicproperties.TestGetIntPropertyKey, name)
+				assert.Equal(t, map[dynamicproperties.Filter]interface{}{
+					dynamicproperties.DomainName:  "samples-domain",
+					dynamicproperties.ClusterName: cluster.TestCurrentClusterName,
+				}, filters)
+				return &dynamicconfig.Resolution{
+					Source:      dynamicconfig.FileBasedClient,
+					Value:       10,
+					Constraints: map[string]interface{}{"domainName": "samples-domain"},
+					Candidates: []dynamicconfig.Candidate{
+						{Value: 5, Matched: true},
+						{Value: 7, Constraints: map[string]interface{}{"taskListName": "tl"}},
+					},
+				}, nil
+			},
+			expected: &types.ResolveDynamicConfigResponse{
+				Value:          jsonBlob("10"),
+				Source:         dynamicconfig.FileBasedClient,
+				MatchedFilters: []*types.DynamicConfigFilter{domainFilter},
+				Candidates: []*types.DynamicConfigCandidate{
+					{Value: jsonBlob("5"), Matched: true},
+					{Value: jsonBlob("7"), Filters: []*types.DynamicConfigFilter{{Name: "taskListName", Value: jsonBlob(`"tl"`)}}},
+				},
+			},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			var client dynamicconfig.Client = dynamicconfig.NewMockClient(ctrl)
+			if !td.unsupported {
+				client = fakeDynamicConfigExplainer{Client: client, explain: td.explain}
+			}
+			h := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:          testlogger.New(t),
+					MetricsClient:   metrics.NewNoopMetricsClient(),
+					ClusterMetadata: cluster.TestActiveClusterMetadata,
+				},
+				params: &resource.Params{
+					DynamicConfig: client,
+				},
+			}
+
+			resp, err := h.ResolveDynamicConfig(context.Background(), td.input)
+			if td.expectedType != nil {
+				assert.IsType(t, td.expectedType, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, td.expected, resp)
+		})
+	}
+}
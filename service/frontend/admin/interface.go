@@ -20,7 +20,7 @@
 
 //go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination interface_mock.go -self_package github.com/uber/cadence/service/frontend/admin
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/accesscontrolled.tmpl -o ../wrappers/accesscontrolled/admin_generated.go -v handler=Admin
-//go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/admin_generated.go -v handler=Admin -v package=adminv1 -v path=github.com/uber/cadence-idl/go/proto/admin/v1 -v prefix=Admin
+//go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/admin_generated.go -v handler=Admin -v package=adminv1 -v path=github.com/uber/cadence-idl/go/proto/admin/v1 -v internalPackage=frontendv1 -v internalPath=github.com/uber/cadence/.gen/proto/frontend/v1 -v prefix=Admin
 //go:generate gowrap gen -g -p ../../../.gen/go/admin/adminserviceserver -i Interface -t ../../templates/thrift.tmpl -o ../wrappers/thrift/admin_generated.go -v handler=Admin -v prefix=Admin
 
 package admin
@@ -42,6 +42,7 @@ type Handler interface {
 	DescribeShardDistribution(context.Context, *types.DescribeShardDistributionRequest) (*types.DescribeShardDistributionResponse, error)
 	DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest) (*types.DescribeHistoryHostResponse, error)
 	DescribeQueue(context.Context, *types.DescribeQueueRequest) (*types.DescribeQueueResponse, error)
+	DescribeReplicationStatus(context.Context, *types.DescribeReplicationStatusRequest) (*types.DescribeReplicationStatusResponse, error)
 	DescribeWorkflowExecution(context.Context, *types.AdminDescribeWorkflowExecutionRequest) (*types.AdminDescribeWorkflowExecutionResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
 	GetDomainReplicationMessages(context.Context, *types.GetDomainReplicationMessagesRequest) (*types.GetDomainReplicationMessagesResponse, error)
@@ -49,6 +50,8 @@ type Handler interface {
 	GetWorkflowExecutionRawHistoryV2(context.Context, *types.GetWorkflowExecutionRawHistoryV2Request) (*types.GetWorkflowExecutionRawHistoryV2Response, error)
 	CountDLQMessages(context.Context, *types.CountDLQMessagesRequest) (*types.CountDLQMessagesResponse, error)
 	MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
+	MoveTaskListBacklog(context.Context, *types.MoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error)
+	DeleteTaskListBacklogTasks(context.Context, *types.DeleteTaskListBacklogTasksRequest) (*types.DeleteTaskListBacklogTasksResponse, error)
 	PurgeDLQMessages(context.Context, *types.PurgeDLQMessagesRequest) error
 	ReadDLQMessages(context.Context, *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error)
 	ReapplyEvents(context.Context, *types.ReapplyEventsRequest) error
@@ -66,6 +69,10 @@ type Handler interface {
 	UpdateOperationalDynamicConfig(context.Context, *types.UpdateOperationalDynamicConfigRequest) error
 	RestoreOperationalDynamicConfig(context.Context, *types.RestoreOperationalDynamicConfigRequest) error
 	ListOperationalDynamicConfig(context.Context, *types.ListOperationalDynamicConfigRequest) (*types.ListOperationalDynamicConfigResponse, error)
+	ListDynamicConfigVersions(context.Context, *types.ListDynamicConfigVersionsRequest) (*types.ListDynamicConfigVersionsResponse, error)
+	DiffDynamicConfigVersions(context.Context, *types.DiffDynamicConfigVersionsRequest) (*types.DiffDynamicConfigVersionsResponse, error)
+	RollbackDynamicConfig(context.Context, *types.RollbackDynamicConfigRequest) (*types.RollbackDynamicConfigResponse, error)
+	ResolveDynamicConfig(context.Context, *types.ResolveDynamicConfigRequest) (*types.ResolveDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error)
 	MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error)
 	GetGlobalIsolationGroups(ctx context.Context, request *types.GetGlobalIsolationGroupsRequest) (*types.GetGlobalIsolationGroupsResponse, error)
@@ -85,6 +85,21 @@ func (mr *MockHandlerMockRecorder) CountDLQMessages(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDLQMessages", reflect.TypeOf((*MockHandler)(nil).CountDLQMessages), arg0, arg1)
 }
 
+// DeleteTaskListBacklogTasks mocks base method.
+func (m *MockHandler) DeleteTaskListBacklogTasks(arg0 context.Context, arg1 *types.DeleteTaskListBacklogTasksRequest) (*types.DeleteTaskListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskListBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.DeleteTaskListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskListBacklogTasks indicates an expected call of DeleteTaskListBacklogTasks.
+func (mr *MockHandlerMockRecorder) DeleteTaskListBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskListBacklogTasks", reflect.TypeOf((*MockHandler)(nil).DeleteTaskListBacklogTasks), arg0, arg1)
+}
+
 // DeleteWorkflow mocks base method.
 func (m *MockHandler) DeleteWorkflow(arg0 context.Context, arg1 *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +160,21 @@ func (mr *MockHandlerMockRecorder) DescribeQueue(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockHandler)(nil).DescribeQueue), arg0, arg1)
 }
 
+// DescribeReplicationStatus mocks base method.
+func (m *MockHandler) DescribeReplicationStatus(arg0 context.Context, arg1 *types.DescribeReplicationStatusRequest) (*types.DescribeReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationStatus indicates an expected call of DescribeReplicationStatus.
+func (mr *MockHandlerMockRecorder) DescribeReplicationStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationStatus", reflect.TypeOf((*MockHandler)(nil).DescribeReplicationStatus), arg0, arg1)
+}
+
 // DescribeShardDistribution mocks base method.
 func (m *MockHandler) DescribeShardDistribution(arg0 context.Context, arg1 *types.DescribeShardDistributionRequest) (*types.DescribeShardDistributionResponse, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +205,21 @@ func (mr *MockHandlerMockRecorder) DescribeWorkflowExecution(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// DiffDynamicConfigVersions mocks base method.
+func (m *MockHandler) DiffDynamicConfigVersions(arg0 context.Context, arg1 *types.DiffDynamicConfigVersionsRequest) (*types.DiffDynamicConfigVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffDynamicConfigVersions", arg0, arg1)
+	ret0, _ := ret[0].(*types.DiffDynamicConfigVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffDynamicConfigVersions indicates an expected call of DiffDynamicConfigVersions.
+func (mr *MockHandlerMockRecorder) DiffDynamicConfigVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffDynamicConfigVersions", reflect.TypeOf((*MockHandler)(nil).DiffDynamicConfigVersions), arg0, arg1)
+}
+
 // GetCrossClusterTasks mocks base method.
 func (m *MockHandler) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +385,21 @@ func (mr *MockHandlerMockRecorder) ListDynamicConfig(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockHandler)(nil).ListDynamicConfig), arg0, arg1)
 }
 
+// ListDynamicConfigVersions mocks base method.
+func (m *MockHandler) ListDynamicConfigVersions(arg0 context.Context, arg1 *types.ListDynamicConfigVersionsRequest) (*types.ListDynamicConfigVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigVersions", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListDynamicConfigVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigVersions indicates an expected call of ListDynamicConfigVersions.
+func (mr *MockHandlerMockRecorder) ListDynamicConfigVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigVersions", reflect.TypeOf((*MockHandler)(nil).ListDynamicConfigVersions), arg0, arg1)
+}
+
 // ListOperationalDynamicConfig mocks base method.
 func (m *MockHandler) ListOperationalDynamicConfig(arg0 context.Context, arg1 *types.ListOperationalDynamicConfigRequest) (*types.ListOperationalDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -385,6 +445,21 @@ func (mr *MockHandlerMockRecorder) MergeDLQMessages(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHandler)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MoveTaskListBacklog mocks base method.
+func (m *MockHandler) MoveTaskListBacklog(arg0 context.Context, arg1 *types.MoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskListBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*types.MoveTaskListBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskListBacklog indicates an expected call of MoveTaskListBacklog.
+func (mr *MockHandlerMockRecorder) MoveTaskListBacklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskListBacklog", reflect.TypeOf((*MockHandler)(nil).MoveTaskListBacklog), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockHandler) PurgeDLQMessages(arg0 context.Context, arg1 *types.PurgeDLQMessagesRequest) error {
 	m.ctrl.T.Helper()
@@ -484,6 +559,21 @@ func (mr *MockHandlerMockRecorder) ResetQueue(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetQueue", reflect.TypeOf((*MockHandler)(nil).ResetQueue), arg0, arg1)
 }
 
+// ResolveDynamicConfig mocks base method.
+func (m *MockHandler) ResolveDynamicConfig(arg0 context.Context, arg1 *types.ResolveDynamicConfigRequest) (*types.ResolveDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.ResolveDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveDynamicConfig indicates an expected call of ResolveDynamicConfig.
+func (mr *MockHandlerMockRecorder) ResolveDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDynamicConfig", reflect.TypeOf((*MockHandler)(nil).ResolveDynamicConfig), arg0, arg1)
+}
+
 // RespondCrossClusterTasksCompleted mocks base method.
 func (m *MockHandler) RespondCrossClusterTasksCompleted(arg0 context.Context, arg1 *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -527,6 +617,21 @@ func (mr *MockHandlerMockRecorder) RestoreOperationalDynamicConfig(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreOperationalDynamicConfig", reflect.TypeOf((*MockHandler)(nil).RestoreOperationalDynamicConfig), arg0, arg1)
 }
 
+// RollbackDynamicConfig mocks base method.
+func (m *MockHandler) RollbackDynamicConfig(arg0 context.Context, arg1 *types.RollbackDynamicConfigRequest) (*types.RollbackDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.RollbackDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackDynamicConfig indicates an expected call of RollbackDynamicConfig.
+func (mr *MockHandlerMockRecorder) RollbackDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDynamicConfig", reflect.TypeOf((*MockHandler)(nil).RollbackDynamicConfig), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockHandler) Start() {
 	m.ctrl.T.Helper()
@@ -0,0 +1,121 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/replication"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// DescribeReplicationStatus describes how far a remote cluster is behind the current cluster, per shard and in
+// total. The history hosts owning the shards report their replication ack and max levels and the fetch requests
+// they served, the DLQ sizes come from the remote cluster. When a domain is given, the task lag and the oldest pending
+// task only count the tasks of that domain; the DLQ sizes stay per shard.
+func (adh *adminHandlerImpl) DescribeReplicationStatus(
+	ctx context.Context,
+	request *types.DescribeReplicationStatusRequest,
+) (_ *types.DescribeReplicationStatusResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDescribeReplicationStatusScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.TargetCluster == "" {
+		return nil, adh.error(validate.ErrClusterNameNotSet, scope)
+	}
+	currentCluster := adh.GetClusterMetadata().GetCurrentClusterName()
+	if _, ok := adh.GetClusterMetadata().GetRemoteClusterInfo()[request.TargetCluster]; !ok {
+		return nil, adh.error(&types.BadRequestError{Message: fmt.Sprintf("%s is not a remote cluster of %s", request.TargetCluster, currentCluster)}, scope)
+	}
+
+	shardIDs := request.ShardIDs
+	if len(shardIDs) == 0 {
+		for shardID := 0; shardID < adh.numberOfHistoryShards; shardID++ {
+			shardIDs = append(shardIDs, int32(shardID))
+		}
+	}
+	for _, shardID := range shardIDs {
+		if shardID < 0 || int(shardID) >= adh.numberOfHistoryShards {
+			return nil, adh.error(&types.BadRequestError{Message: fmt.Sprintf("invalid shard ID %d", shardID)}, scope)
+		}
+	}
+
+	var domainID string
+	if request.Domain != "" {
+		var err error
+		domainID, err = adh.GetDomainCache().GetDomainID(request.Domain)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	dlqSizes, err := adh.replicationDLQSizes(ctx, request.TargetCluster, currentCluster)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetHistoryClient().GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{
+		ClusterName: request.TargetCluster,
+		ShardIDs:    shardIDs,
+		DomainID:    domainID,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	statuses := make([]*types.ReplicationStatus, 0, len(resp.GetShards()))
+	for _, shard := range resp.GetShards() {
+		if shard.Status == nil {
+			shard.Status = &types.ReplicationStatus{}
+		}
+		shard.Status.DLQSize = dlqSizes[shard.ShardID]
+		statuses = append(statuses, shard.Status)
+	}
+	return &types.DescribeReplicationStatusResponse{
+		SourceCluster: currentCluster,
+		TargetCluster: request.TargetCluster,
+		Summary:       replication.Merge(statuses...),
+		Shards:        resp.GetShards(),
+	}, nil
+}
+
+// replicationDLQSizes counts the tasks of the current cluster in the replication DLQ of the target cluster, by shard.
+// The DLQ is unknown rather than empty when the target cluster can't be reached, so errors are returned.
+func (adh *adminHandlerImpl) replicationDLQSizes(ctx context.Context, targetCluster, currentCluster string) (map[int32]int64, error) {
+	adminClient, err := adh.GetRemoteAdminClient(targetCluster)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adminClient.CountDLQMessages(ctx, &types.CountDLQMessagesRequest{ForceFetch: true})
+	if err != nil {
+		return nil, fmt.Errorf("counting DLQ messages of %s: %w", targetCluster, err)
+	}
+	return replication.DLQSizeByShard(resp, currentCluster), nil
+}
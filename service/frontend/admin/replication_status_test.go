@@ -0,0 +1,148 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/types"
+)
+
+func (s *adminHandlerSuite) TestDescribeReplicationStatus() {
+	ctx := context.Background()
+
+	s.mockResource.RemoteAdminClient.EXPECT().CountDLQMessages(gomock.Any(), &types.CountDLQMessagesRequest{ForceFetch: true}).
+		Return(&types.CountDLQMessagesResponse{History: map[types.HistoryDLQCountKey]int64{
+			{ShardID: 0, SourceCluster: cluster.TestCurrentClusterName}:     4,
+			{ShardID: 0, SourceCluster: cluster.TestAlternativeClusterName}: 9,
+		}}, nil)
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{
+		ClusterName: cluster.TestAlternativeClusterName,
+		ShardIDs:    []int32{0},
+	}).Return(&types.GetReplicationStatusResponse{
+		Shards: []*types.ShardReplicationStatus{
+			{
+				ShardID: 0,
+				Status: &types.ReplicationStatus{
+					AckedTaskID:           10,
+					MaxTaskID:             15,
+					TaskLag:               5,
+					OldestPendingTaskTime: 1000,
+					LagSeconds:            3600,
+					Fetcher:               &types.ReplicationFetcherStatus{LastFetchTime: 2000, ErrorCount: 1, LastError: "fetch failed"},
+				},
+			},
+		},
+	}, nil)
+
+	resp, err := s.handler.DescribeReplicationStatus(ctx, &types.DescribeReplicationStatusRequest{
+		TargetCluster: cluster.TestAlternativeClusterName,
+	})
+	s.NoError(err)
+	s.Equal(cluster.TestCurrentClusterName, resp.SourceCluster)
+	s.Equal(cluster.TestAlternativeClusterName, resp.TargetCluster)
+	s.Len(resp.Shards, 1)
+	shard := resp.Shards[0]
+	s.Equal(int32(0), shard.ShardID)
+	s.Equal(int64(5), shard.Status.TaskLag)
+	s.Equal(int64(4), shard.Status.DLQSize)
+	s.Equal(&types.ReplicationStatus{
+		TaskLag:               5,
+		OldestPendingTaskTime: 1000,
+		LagSeconds:            3600,
+		DLQSize:               4,
+		Fetcher:               &types.ReplicationFetcherStatus{LastFetchTime: 2000, ErrorCount: 1, LastError: "fetch failed"},
+	}, resp.Summary)
+}
+
+func (s *adminHandlerSuite) TestDescribeReplicationStatus_InvalidRequests() {
+	ctx := context.Background()
+
+	_, err := s.handler.DescribeReplicationStatus(ctx, nil)
+	s.Error(err)
+
+	_, err = s.handler.DescribeReplicationStatus(ctx, &types.DescribeReplicationStatusRequest{})
+	s.Error(err)
+
+	_, err = s.handler.DescribeReplicationStatus(ctx, &types.DescribeReplicationStatusRequest{TargetCluster: cluster.TestCurrentClusterName})
+	s.ErrorContains(err, "is not a remote cluster")
+
+	_, err = s.handler.DescribeReplicationStatus(ctx, &types.DescribeReplicationStatusRequest{
+		TargetCluster: cluster.TestAlternativeClusterName,
+		ShardIDs:      []int32{1},
+	})
+	s.ErrorContains(err, "invalid shard ID 1")
+}
+
+func (s *adminHandlerSuite) TestDescribeReplicationStatus_Domain() {
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil)
+	s.mockResource.RemoteAdminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).Return(&types.CountDLQMessagesResponse{}, nil)
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{
+		ClusterName: cluster.TestAlternativeClusterName,
+		ShardIDs:    []int32{0},
+		DomainID:    s.domainID,
+	}).Return(&types.GetReplicationStatusResponse{
+		Shards: []*types.ShardReplicationStatus{
+			{ShardID: 0, Status: &types.ReplicationStatus{TaskLag: 1000, TaskLagTruncated: true}},
+		},
+	}, nil)
+
+	resp, err := s.handler.DescribeReplicationStatus(context.Background(), &types.DescribeReplicationStatusRequest{
+		TargetCluster: cluster.TestAlternativeClusterName,
+		Domain:        s.domainName,
+	})
+	s.NoError(err)
+	s.Equal(int64(1000), resp.Summary.TaskLag)
+	s.True(resp.Summary.TaskLagTruncated)
+}
+
+func (s *adminHandlerSuite) TestDescribeReplicationStatus_UnknownDomain() {
+	s.mockDomainCache.EXPECT().GetDomainID("unknown").Return("", &types.EntityNotExistsError{Message: "domain not found"})
+
+	_, err := s.handler.DescribeReplicationStatus(context.Background(), &types.DescribeReplicationStatusRequest{
+		TargetCluster: cluster.TestAlternativeClusterName,
+		Domain:        "unknown",
+	})
+	s.ErrorContains(err, "domain not found")
+}
+
+func (s *adminHandlerSuite) TestDescribeReplicationStatus_TargetClusterUnreachable() {
+	s.mockResource.RemoteAdminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).Return(nil, errors.New("unreachable"))
+
+	_, err := s.handler.DescribeReplicationStatus(context.Background(), &types.DescribeReplicationStatusRequest{
+		TargetCluster: cluster.TestAlternativeClusterName,
+	})
+	s.ErrorContains(err, "unreachable")
+}
+
+func (s *adminHandlerSuite) TestDescribeReplicationStatus_HistoryError() {
+	s.mockResource.RemoteAdminClient.EXPECT().CountDLQMessages(gomock.Any(), gomock.Any()).Return(&types.CountDLQMessagesResponse{}, nil)
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any()).Return(nil, errors.New("shard not owned"))
+
+	_, err := s.handler.DescribeReplicationStatus(context.Background(), &types.DescribeReplicationStatusRequest{
+		TargetCluster: cluster.TestAlternativeClusterName,
+	})
+	s.ErrorContains(err, "shard not owned")
+}
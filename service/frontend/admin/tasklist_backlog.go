@@ -0,0 +1,205 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admin
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/matching/tasklist"
+)
+
+// defaultTaskListBacklogPageSize is the page size of ListTaskListBacklog when the request doesn't set one
+const defaultTaskListBacklogPageSize = 100
+
+// ListTaskListBacklog pages through the persisted tasks of a task list partition
+func (adh *adminHandlerImpl) ListTaskListBacklog(
+	ctx context.Context,
+	request *types.ListTaskListBacklogRequest,
+) (_ *types.ListTaskListBacklogResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListTaskListBacklogScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	backlog, err := adh.taskListBacklog(request.Domain, request.TaskList, request.TaskListType)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	var readLevel int64
+	if len(request.NextPageToken) > 0 {
+		readLevel, err = strconv.ParseInt(string(request.NextPageToken), 10, 64)
+		if err != nil || readLevel <= 0 {
+			return nil, adh.error(validate.ErrInvalidNextPageToken, scope)
+		}
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultTaskListBacklogPageSize
+	}
+
+	tasks, nextReadLevel, err := backlog.List(readLevel, pageSize)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp := &types.ListTaskListBacklogResponse{}
+	for _, task := range tasks {
+		t := &types.TaskListBacklogTask{
+			TaskID:      task.TaskID,
+			WorkflowID:  task.WorkflowID,
+			RunID:       task.RunID,
+			ScheduleID:  task.ScheduleID,
+			CreatedTime: task.CreatedTime.UnixNano(),
+		}
+		if !task.Expiry.IsZero() {
+			t.ExpiryTime = task.Expiry.UnixNano()
+		}
+		resp.Tasks = append(resp.Tasks, t)
+	}
+	if nextReadLevel != 0 {
+		resp.NextPageToken = []byte(strconv.FormatInt(nextReadLevel, 10))
+	}
+	return resp, nil
+}
+
+// DeleteTaskListBacklogTasks deletes the given tasks from a task list partition.
+// The delete is done by the matching host owning the partition, so the tasks it has already read are not dispatched.
+func (adh *adminHandlerImpl) DeleteTaskListBacklogTasks(
+	ctx context.Context,
+	request *types.DeleteTaskListBacklogTasksRequest,
+) (_ *types.DeleteTaskListBacklogTasksResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDeleteTaskListBacklogTasksScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.Domain == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.TaskList.GetName() == "" {
+		return nil, adh.error(validate.ErrTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(validate.ErrTaskListTypeNotSet, scope)
+	}
+	if len(request.TaskIDs) == 0 {
+		return nil, adh.error(&types.BadRequestError{Message: "Task IDs not set."}, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.Domain)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetMatchingClient().DeleteTaskListBacklogTasks(ctx, &types.MatchingDeleteTaskListBacklogTasksRequest{
+		DomainUUID:   domainID,
+		TaskList:     &types.TaskList{Name: request.TaskList.GetName(), Kind: types.TaskListKindNormal.Ptr()},
+		TaskListType: request.TaskListType,
+		TaskIDs:      request.TaskIDs,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.DeleteTaskListBacklogTasksResponse{DeletedTaskIDs: resp.GetDeletedTaskIDs()}, nil
+}
+
+// MoveTaskListBacklog moves a range of tasks from a task list partition to another task list of the same type.
+// The move is done by the matching host owning the destination, which allocates the new task IDs.
+func (adh *adminHandlerImpl) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MoveTaskListBacklogRequest,
+) (_ *types.MoveTaskListBacklogResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminMoveTaskListBacklogScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.Domain == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.TaskList.GetName() == "" {
+		return nil, adh.error(validate.ErrTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(validate.ErrTaskListTypeNotSet, scope)
+	}
+	if request.DestinationTaskList.GetName() == "" {
+		return nil, adh.error(&types.BadRequestError{Message: "Destination task list not set."}, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.Domain)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	maxTaskID := request.MaxTaskID
+	if maxTaskID == 0 {
+		maxTaskID = math.MaxInt64
+	}
+
+	resp, err := adh.GetMatchingClient().MoveTaskListBacklog(ctx, &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:          domainID,
+		TaskList:            &types.TaskList{Name: request.TaskList.GetName()},
+		TaskListType:        request.TaskListType,
+		DestinationTaskList: &types.TaskList{Name: request.DestinationTaskList.GetName(), Kind: types.TaskListKindNormal.Ptr()},
+		MinTaskID:           request.MinTaskID,
+		MaxTaskID:           maxTaskID,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) taskListBacklog(domain string, taskList *types.TaskList, taskListType *types.TaskListType) (*tasklist.Backlog, error) {
+	if domain == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if taskList.GetName() == "" {
+		return nil, validate.ErrTaskListNotSet
+	}
+	if taskListType == nil {
+		return nil, validate.ErrTaskListTypeNotSet
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(domain)
+	if err != nil {
+		return nil, err
+	}
+	return tasklist.NewBacklog(
+		adh.GetTaskManager(),
+		domainID,
+		domain,
+		taskList.GetName(),
+		int(*taskListType),
+		adh.GetLogger(),
+	), nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package admin
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func (s *adminHandlerSuite) TestListTaskListBacklog() {
+	ctx := context.Background()
+	created := time.Unix(100, 0)
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(2)
+
+	s.mockResource.TaskMgr.On("GetTaskList", mock.Anything, mock.Anything).Return(&persistence.GetTaskListResponse{
+		TaskListInfo: &persistence.TaskListInfo{AckLevel: 10},
+	}, nil).Once()
+	s.mockResource.TaskMgr.On("GetTasks", mock.Anything, mock.MatchedBy(func(req *persistence.GetTasksRequest) bool {
+		return req.ReadLevel == 10 && req.BatchSize == 2 && req.TaskType == persistence.TaskListTypeActivity
+	})).Return(&persistence.GetTasksResponse{Tasks: []*persistence.TaskInfo{
+		{WorkflowID: "wid1", RunID: "rid1", TaskID: 11, ScheduleID: 5, CreatedTime: created},
+		{WorkflowID: "wid2", RunID: "rid2", TaskID: 12, ScheduleID: 7, CreatedTime: created, Expiry: created.Add(time.Minute)},
+	}}, nil).Once()
+	request := &types.ListTaskListBacklogRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		PageSize:     2,
+	}
+	resp, err := s.handler.ListTaskListBacklog(ctx, request)
+	s.NoError(err)
+	s.Equal([]*types.TaskListBacklogTask{
+		{TaskID: 11, WorkflowID: "wid1", RunID: "rid1", ScheduleID: 5, CreatedTime: created.UnixNano()},
+		{TaskID: 12, WorkflowID: "wid2", RunID: "rid2", ScheduleID: 7, CreatedTime: created.UnixNano(), ExpiryTime: created.Add(time.Minute).UnixNano()},
+	}, resp.Tasks)
+	s.Equal([]byte("12"), resp.NextPageToken)
+
+	s.mockResource.TaskMgr.On("GetTasks", mock.Anything, mock.MatchedBy(func(req *persistence.GetTasksRequest) bool {
+		return req.ReadLevel == 12
+	})).Return(&persistence.GetTasksResponse{}, nil).Once()
+	request.NextPageToken = resp.NextPageToken
+	resp, err = s.handler.ListTaskListBacklog(ctx, request)
+	s.NoError(err)
+	s.Empty(resp.Tasks)
+	s.Nil(resp.NextPageToken)
+}
+
+func (s *adminHandlerSuite) TestListTaskListBacklog_InvalidRequest() {
+	ctx := context.Background()
+
+	_, err := s.handler.ListTaskListBacklog(ctx, nil)
+	s.Error(err)
+
+	_, err = s.handler.ListTaskListBacklog(ctx, &types.ListTaskListBacklogRequest{
+		Domain:       s.domainName,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	_, err = s.handler.ListTaskListBacklog(ctx, &types.ListTaskListBacklogRequest{
+		Domain:   s.domainName,
+		TaskList: &types.TaskList{Name: "tl"},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil)
+	_, err = s.handler.ListTaskListBacklog(ctx, &types.ListTaskListBacklogRequest{
+		Domain:        s.domainName,
+		TaskList:      &types.TaskList{Name: "tl"},
+		TaskListType:  types.TaskListTypeActivity.Ptr(),
+		NextPageToken: []byte("not a number"),
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) TestDeleteTaskListBacklogTasks() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil)
+	s.mockResource.MatchingClient.EXPECT().DeleteTaskListBacklogTasks(ctx, &types.MatchingDeleteTaskListBacklogTasksRequest{
+		DomainUUID:   s.domainID,
+		TaskList:     &types.TaskList{Name: "tl", Kind: types.TaskListKindNormal.Ptr()},
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+		TaskIDs:      []int64{3, 4},
+	}).Return(&types.MatchingDeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{4}}, nil)
+
+	resp, err := s.handler.DeleteTaskListBacklogTasks(ctx, &types.DeleteTaskListBacklogTasksRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+		TaskIDs:      []int64{3, 4},
+	})
+	s.NoError(err)
+	s.Equal(&types.DeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{4}}, resp)
+
+	_, err = s.handler.DeleteTaskListBacklogTasks(ctx, &types.DeleteTaskListBacklogTasksRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) TestMoveTaskListBacklog() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(2)
+	s.mockResource.MatchingClient.EXPECT().MoveTaskListBacklog(ctx, &types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:          s.domainID,
+		TaskList:            &types.TaskList{Name: "tl"},
+		TaskListType:        types.TaskListTypeActivity.Ptr(),
+		DestinationTaskList: &types.TaskList{Name: "other-tl", Kind: types.TaskListKindNormal.Ptr()},
+		MinTaskID:           5,
+		MaxTaskID:           20,
+	}).Return(&types.MoveTaskListBacklogResponse{MovedTasks: 1}, nil)
+
+	resp, err := s.handler.MoveTaskListBacklog(ctx, &types.MoveTaskListBacklogRequest{
+		Domain:              s.domainName,
+		TaskList:            &types.TaskList{Name: "tl"},
+		TaskListType:        types.TaskListTypeActivity.Ptr(),
+		DestinationTaskList: &types.TaskList{Name: "other-tl"},
+		MinTaskID:           5,
+		MaxTaskID:           20,
+	})
+	s.NoError(err)
+	s.Equal(&types.MoveTaskListBacklogResponse{MovedTasks: 1}, resp)
+
+	// an unset max task ID moves every task from the min task ID on
+	s.mockResource.MatchingClient.EXPECT().MoveTaskListBacklog(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.MatchingMoveTaskListBacklogRequest, _ ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error) {
+			s.Equal(int64(math.MaxInt64), req.MaxTaskID)
+			return nil, &types.ServiceBusyError{}
+		})
+	_, err = s.handler.MoveTaskListBacklog(ctx, &types.MoveTaskListBacklogRequest{
+		Domain:              s.domainName,
+		TaskList:            &types.TaskList{Name: "tl"},
+		TaskListType:        types.TaskListTypeActivity.Ptr(),
+		DestinationTaskList: &types.TaskList{Name: "other-tl"},
+		MinTaskID:           5,
+	})
+	s.IsType(&types.ServiceBusyError{}, err)
+
+	_, err = s.handler.MoveTaskListBacklog(ctx, &types.MoveTaskListBacklogRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
@@ -26,6 +26,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -95,6 +96,18 @@ func (wh *WorkflowHandler) DescribeDomain(
 		resp.FailoverInfo.CompletedShardCount = failoverResp.GetCompletedShardCount()
 		resp.FailoverInfo.PendingShards = failoverResp.GetPendingShards()
 	}
+
+	usage, err := wh.openWorkflowLimiter.Usage(ctx, resp.GetDomainInfo().GetUUID(), resp.GetDomainInfo().GetName())
+	if err != nil {
+		// despite the error from visibility, return describe domain response
+		wh.GetLogger().Error(
+			fmt.Sprintf("Failed to get open workflow usage for domain %s", resp.GetDomainInfo().GetName()),
+			tag.Error(err),
+		)
+		return resp, nil
+	}
+	resp.OpenWorkflowUsage = usage
+	writeResponseHeaders(ctx, frontend.DescribeDomainResponseHeaders(resp))
 	return resp, nil
 }
 
@@ -306,15 +319,18 @@ func (wh *WorkflowHandler) ListFailoverHistory(ctx context.Context, request *typ
 	failoverEvents := make([]*types.FailoverEvent, 0, len(auditLogsResp.AuditLogs))
 	for _, auditLog := range auditLogsResp.AuditLogs {
 		event := auditLog.ToFailoverEvents()
-		if event != nil && event.ClusterFailovers != nil {
+		// scheduled failover entries don't change the domain but record why a failover ran or didn't
+		if event != nil && (event.ClusterFailovers != nil || auditLog.IdentityType == persistence.DomainAuditIdentityTypeScheduledFailover) {
 			failoverEvents = append(failoverEvents, event)
 		}
 	}
 
-	return &types.ListFailoverHistoryResponse{
+	resp := &types.ListFailoverHistoryResponse{
 		FailoverEvents: failoverEvents,
 		NextPageToken:  auditLogsResp.NextPageToken,
-	}, nil
+	}
+	writeResponseHeaders(ctx, frontend.ListFailoverHistoryResponseHeaders(resp))
+	return resp, nil
 }
 
 func (wh *WorkflowHandler) gracefulFailoverInitiationFailureEmitter(scope metrics.ScopeIdx, domainName string, isGraceful bool) func(reason string) {
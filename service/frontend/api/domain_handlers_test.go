@@ -30,7 +30,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc/yarpctest"
 
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
@@ -155,12 +158,13 @@ func TestRegisterDomain(t *testing.T) {
 func TestDescribeDomain(t *testing.T) {
 	domainName := "domain-name" // Define the domain name pointer to pass in requests
 	testCases := []struct {
-		name          string
-		req           *types.DescribeDomainRequest
-		setupMocks    func(*mockDeps)
-		expectError   bool
-		expectedError string
-		verifyResp    func(t *testing.T, resp *types.DescribeDomainResponse)
+		name            string
+		req             *types.DescribeDomainRequest
+		setupMocks      func(*mockDeps)
+		expectError     bool
+		expectedError   string
+		verifyResp      func(t *testing.T, resp *types.DescribeDomainResponse)
+		expectedHeaders map[string]string
 	}{
 		{
 			name: "success without failover info",
@@ -263,13 +267,65 @@ func TestDescribeDomain(t *testing.T) {
 				assert.Nil(t, resp.FailoverInfo.PendingShards)
 			},
 		},
+		{
+			name: "success with open workflow usage",
+			req: &types.DescribeDomainRequest{
+				Name: &domainName,
+			},
+			setupMocks: func(deps *mockDeps) {
+				assert.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.FrontendOpenWorkflowLimit, 100))
+				deps.mockRequestValidator.EXPECT().ValidateDescribeDomainRequest(gomock.Any(), gomock.Any()).Return(nil)
+				deps.mockDomainHandler.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+					DomainInfo: &types.DomainInfo{
+						Name: "domain-name",
+						UUID: "domain-id",
+					},
+				}, nil)
+				deps.mockVisibilityMgr.On("CountWorkflowExecutions", mock.Anything, &persistence.CountWorkflowExecutionsRequest{
+					DomainUUID: "domain-id",
+					Domain:     "domain-name",
+					Query:      "CloseTime = missing",
+				}).Return(&persistence.CountWorkflowExecutionsResponse{Count: 42}, nil).Once()
+			},
+			expectError: false,
+			verifyResp: func(t *testing.T, resp *types.DescribeDomainResponse) {
+				assert.Equal(t, &types.OpenWorkflowUsage{OpenWorkflowCount: 42, OpenWorkflowLimit: 100}, resp.OpenWorkflowUsage)
+			},
+			expectedHeaders: map[string]string{
+				common.OpenWorkflowUsageHeaderName: `{"openWorkflowCount":42,"openWorkflowLimit":100}`,
+			},
+		},
+		{
+			name: "error from visibility when getting open workflow usage",
+			req: &types.DescribeDomainRequest{
+				Name: &domainName,
+			},
+			setupMocks: func(deps *mockDeps) {
+				assert.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.FrontendOpenWorkflowLimit, 100))
+				deps.mockRequestValidator.EXPECT().ValidateDescribeDomainRequest(gomock.Any(), gomock.Any()).Return(nil)
+				deps.mockDomainHandler.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+					DomainInfo: &types.DomainInfo{
+						Name: "domain-name",
+						UUID: "domain-id",
+					},
+				}, nil)
+				deps.mockVisibilityMgr.On("CountWorkflowExecutions", mock.Anything, mock.Anything).Return(nil, errors.New("visibility error")).Once()
+			},
+			expectError: false,
+			verifyResp: func(t *testing.T, resp *types.DescribeDomainResponse) {
+				assert.Equal(t, "domain-name", resp.DomainInfo.Name)
+				assert.Nil(t, resp.OpenWorkflowUsage)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			wh, deps := setupMocksForWorkflowHandler(t)
 			tc.setupMocks(deps)
-			resp, err := wh.DescribeDomain(context.Background(), tc.req)
+			responseHeaders := map[string]string{}
+			ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{ResponseHeaders: responseHeaders})
+			resp, err := wh.DescribeDomain(ctx, tc.req)
 
 			if tc.expectError {
 				assert.ErrorContains(t, err, tc.expectedError)
@@ -277,6 +333,9 @@ func TestDescribeDomain(t *testing.T) {
 				assert.NoError(t, err)
 				tc.verifyResp(t, resp)
 			}
+			if tc.expectedHeaders != nil {
+				assert.Equal(t, tc.expectedHeaders, responseHeaders)
+			}
 		})
 	}
 }
@@ -425,12 +484,13 @@ func TestListFailoverHistory(t *testing.T) {
 	nextPageToken := []byte("next-page-token")
 
 	testCases := []struct {
-		name          string
-		req           *types.ListFailoverHistoryRequest
-		setupMocks    func(*mockDeps)
-		expectError   bool
-		expectedError string
-		verifyResp    func(t *testing.T, resp *types.ListFailoverHistoryResponse)
+		name            string
+		req             *types.ListFailoverHistoryRequest
+		setupMocks      func(*mockDeps)
+		expectError     bool
+		expectedError   string
+		verifyResp      func(t *testing.T, resp *types.ListFailoverHistoryResponse)
+		expectedHeaders map[string]string
 	}{
 		{
 			name: "success_with_default_page_size",
@@ -512,6 +572,51 @@ func TestListFailoverHistory(t *testing.T) {
 				assert.Nil(t, resp.NextPageToken)
 			},
 		},
+		{
+			name: "success_keeps_scheduled_failover_outcomes",
+			req: &types.ListFailoverHistoryRequest{
+				Filters: &types.ListFailoverHistoryRequestFilters{
+					DomainID: domainID,
+				},
+			},
+			setupMocks: func(deps *mockDeps) {
+				unchanged := &persistence.GetDomainResponse{
+					ReplicationConfig: &persistence.DomainReplicationConfig{
+						ActiveClusterName: "cluster-us-west",
+					},
+					FailoverVersion: 1,
+				}
+				deps.mockResource.DomainAuditMgr.EXPECT().GetDomainAuditLogs(gomock.Any(), gomock.Any()).
+					Return(&persistence.GetDomainAuditLogsResponse{
+						AuditLogs: []*persistence.DomainAuditLog{
+							{
+								EventID:      eventID1,
+								DomainID:     domainID,
+								StateBefore:  unchanged,
+								StateAfter:   unchanged,
+								IdentityType: persistence.DomainAuditIdentityTypeScheduledFailover,
+								Comment:      "scheduled failover cancelled",
+							},
+							{
+								EventID:     "event-id-2",
+								DomainID:    domainID,
+								StateBefore: unchanged,
+								StateAfter:  unchanged,
+							},
+						},
+					}, nil)
+			},
+			expectError: false,
+			verifyResp: func(t *testing.T, resp *types.ListFailoverHistoryResponse) {
+				assert.Len(t, resp.FailoverEvents, 1)
+				assert.Equal(t, eventID1, resp.FailoverEvents[0].GetID())
+				assert.Equal(t, "scheduled failover cancelled", resp.FailoverEvents[0].GetComment())
+				assert.Nil(t, resp.FailoverEvents[0].ClusterFailovers)
+			},
+			expectedHeaders: map[string]string{
+				common.FailoverEventCommentsHeaderName: `{"event-id-1":"scheduled failover cancelled"}`,
+			},
+		},
 		{
 			name: "error_nil_filters",
 			req: &types.ListFailoverHistoryRequest{
@@ -546,7 +651,9 @@ func TestListFailoverHistory(t *testing.T) {
 			wh, deps := setupMocksForWorkflowHandler(t)
 			tc.setupMocks(deps)
 
-			resp, err := wh.ListFailoverHistory(context.Background(), tc.req)
+			responseHeaders := map[string]string{}
+			ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{ResponseHeaders: responseHeaders})
+			resp, err := wh.ListFailoverHistory(ctx, tc.req)
 
 			if tc.expectError {
 				assert.Error(t, err)
@@ -556,6 +663,9 @@ func TestListFailoverHistory(t *testing.T) {
 				assert.NoError(t, err)
 				tc.verifyResp(t, resp)
 			}
+			if tc.expectedHeaders != nil {
+				assert.Equal(t, tc.expectedHeaders, responseHeaders)
+			}
 		})
 	}
 }
@@ -34,12 +34,14 @@ import (
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/completioncallback"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/elasticsearch/validator"
@@ -87,6 +89,7 @@ type (
 		producerManager           ProducerManager
 		thriftrwEncoder           codec.BinaryEncoder
 		requestValidator          RequestValidator
+		openWorkflowLimiter       *openWorkflowLimiter
 	}
 
 	getHistoryContinuationToken struct {
@@ -110,6 +113,10 @@ type (
 
 var (
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
+
+	// async requests are queued with their thrift encoding, which has no workflow ID hash and no workflow ID conflict policy yet
+	errWorkflowIDHashNotSupportedByAsyncRequests           = &types.BadRequestError{Message: "workflow ID hash is not supported by async requests"}
+	errWorkflowIDConflictPolicyNotSupportedByAsyncRequests = &types.BadRequestError{Message: "WorkflowIDConflictPolicy is not supported by async requests"}
 )
 
 // NewWorkflowHandler creates a thrift handler for the cadence service
@@ -150,6 +157,12 @@ func NewWorkflowHandler(
 		),
 		thriftrwEncoder:  codec.NewThriftRWEncoder(),
 		requestValidator: NewRequestValidator(resource.GetLogger(), resource.GetMetricsClient(), config),
+		openWorkflowLimiter: newOpenWorkflowLimiter(
+			resource.GetVisibilityManager(),
+			config,
+			resource.GetTimeSource(),
+			resource.GetLogger(),
+		),
 	}
 }
 
@@ -381,7 +394,7 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, nil
 	}
 
-	return &types.PollForActivityTaskResponse{
+	resp = &types.PollForActivityTaskResponse{
 		TaskToken:                       matchingResp.TaskToken,
 		WorkflowExecution:               matchingResp.WorkflowExecution,
 		ActivityID:                      matchingResp.ActivityID,
@@ -399,7 +412,9 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		WorkflowDomain:                  matchingResp.WorkflowDomain,
 		Header:                          matchingResp.Header,
 		AutoConfigHint:                  matchingResp.AutoConfigHint,
-	}, nil
+	}
+	writeResponseHeaders(ctx, frontend.PollForActivityTaskResponseHeaders(resp))
+	return resp, nil
 }
 
 // PollForDecisionTask - Poll for a decision task.
@@ -531,6 +546,7 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, err
 	}
 
+	writeResponseHeaders(ctx, frontend.PollForDecisionTaskResponseHeaders(resp))
 	return resp, nil
 }
 
@@ -1443,6 +1459,7 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 			return nil, err
 		}
 		completedResp.DecisionTask = newDecisionTask
+		writeResponseHeaders(ctx, frontend.PollForDecisionTaskResponseHeaders(newDecisionTask))
 	}
 
 	return completedResp, nil
@@ -1611,6 +1628,12 @@ func (wh *WorkflowHandler) StartWorkflowExecutionAsync(
 	if err != nil {
 		return nil, err
 	}
+	if startRequest.StartWorkflowExecutionRequest.GetActiveClusterSelectionPolicy().GetWorkflowIDHash() != nil {
+		return nil, errWorkflowIDHashNotSupportedByAsyncRequests
+	}
+	if startRequest.StartWorkflowExecutionRequest.WorkflowIDConflictPolicy != nil {
+		return nil, errWorkflowIDConflictPolicyNotSupportedByAsyncRequests
+	}
 
 	producer, err := wh.producerManager.GetProducerByDomain(startRequest.GetDomain())
 	if err != nil {
@@ -1666,6 +1689,14 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	if err := wh.openWorkflowLimiter.Allow(ctx, scope, domainID, domainName, startRequest.WorkflowType.GetName()); err != nil {
+		// a start for a workflow ID that is already running does not open another workflow:
+		// history returns the running workflow to a retry with the same RequestID or to the
+		// UseExisting conflict policy, and otherwise terminates it or rejects the start
+		if !wh.openWorkflowLimiter.AllowRunningCheck() || !wh.isWorkflowRunning(ctx, domainID, startRequest) {
+			return nil, err
+		}
+	}
 	historyRequest, err := common.CreateHistoryStartWorkflowRequest(
 		domainID, startRequest, time.Now(), wh.getPartitionConfig(ctx, domainName))
 	if err != nil {
@@ -1693,6 +1724,27 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	return resp, nil
 }
 
+func (wh *WorkflowHandler) isWorkflowRunning(
+	ctx context.Context,
+	domainID string,
+	startRequest *types.StartWorkflowExecutionRequest,
+) bool {
+	resp, err := wh.GetHistoryClient().DescribeWorkflowExecution(ctx, &types.HistoryDescribeWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Request: &types.DescribeWorkflowExecutionRequest{
+			Domain: startRequest.GetDomain(),
+			Execution: &types.WorkflowExecution{
+				WorkflowID: startRequest.GetWorkflowID(),
+			},
+		},
+	})
+	if err != nil {
+		return false
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	return info != nil && info.CloseStatus == nil
+}
+
 func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Context, startRequest *types.StartWorkflowExecutionRequest, scope metrics.Scope) error {
 	if startRequest == nil {
 		return validate.ErrRequestNotSet
@@ -1704,6 +1756,9 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 	if startRequest.GetWorkflowID() == "" {
 		return validate.ErrWorkflowIDNotSet
 	}
+	if err := validateWorkflowIDConflictPolicy(startRequest.WorkflowIDConflictPolicy, startRequest.WorkflowIDReusePolicy, false); err != nil {
+		return err
+	}
 	if _, err := uuid.Parse(startRequest.RequestID); err != nil {
 		return &types.BadRequestError{Message: fmt.Sprintf("requestId %q is not a valid UUID", startRequest.RequestID)}
 	}
@@ -1736,6 +1791,22 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 	if err := common.ValidateRetryPolicy(startRequest.RetryPolicy); err != nil {
 		return err
 	}
+	if len(startRequest.CompletionCallbacks) > 0 && !wh.config.EnableCompletionCallbacks(domainName) {
+		return &types.BadRequestError{Message: fmt.Sprintf("completion callbacks are not enabled for domain %v", domainName)}
+	}
+	if len(startRequest.CompletionCallbacks) > 0 && wh.config.IsSQLDefaultStore {
+		// reject them before the start request reaches history, the SQL execution store would fail the workflow creation
+		return &types.BadRequestError{Message: "completion callbacks are not supported by the SQL persistence store"}
+	}
+	allowlist := completioncallback.NewHostAllowlist(wh.config.CompletionCallbackAllowedHosts)
+	for _, callback := range startRequest.CompletionCallbacks {
+		if err := callback.Validate(); err != nil {
+			return &types.BadRequestError{Message: err.Error()}
+		}
+		if err := allowlist.Validate(callback.GetURL()); err != nil {
+			return &types.BadRequestError{Message: err.Error()}
+		}
+	}
 	wh.GetLogger().Debug(
 		"Received StartWorkflowExecution. WorkflowID",
 		tag.WorkflowID(startRequest.GetWorkflowID()))
@@ -2238,6 +2309,12 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecutionAsync(
 	if err != nil {
 		return nil, err
 	}
+	if signalWithStartRequest.SignalWithStartWorkflowExecutionRequest.GetActiveClusterSelectionPolicy().GetWorkflowIDHash() != nil {
+		return nil, errWorkflowIDHashNotSupportedByAsyncRequests
+	}
+	if signalWithStartRequest.SignalWithStartWorkflowExecutionRequest.WorkflowIDConflictPolicy != nil {
+		return nil, errWorkflowIDConflictPolicyNotSupportedByAsyncRequests
+	}
 	producer, err := wh.producerManager.GetProducerByDomain(signalWithStartRequest.GetDomain())
 	if err != nil {
 		return nil, err
@@ -2310,6 +2387,31 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 	return resp, nil
 }
 
+// validateWorkflowIDConflictPolicy checks the policy applied when a run with the same workflow ID is still open.
+// A nil policy keeps the API default: StartWorkflowExecution fails and SignalWithStartWorkflowExecution signals.
+func validateWorkflowIDConflictPolicy(
+	conflictPolicy *types.WorkflowIDConflictPolicy,
+	reusePolicy *types.WorkflowIDReusePolicy,
+	hasSignal bool,
+) error {
+	if conflictPolicy == nil {
+		return nil
+	}
+	switch *conflictPolicy {
+	case types.WorkflowIDConflictPolicyFail, types.WorkflowIDConflictPolicyUseExisting:
+	case types.WorkflowIDConflictPolicySignalExisting:
+		if !hasSignal {
+			return validate.ErrSignalExistingRequiresSignal
+		}
+	default:
+		return validate.ErrInvalidWorkflowIDConflictPolicy
+	}
+	if reusePolicy != nil && *reusePolicy == types.WorkflowIDReusePolicyTerminateIfRunning {
+		return validate.ErrConflictPolicyWithTerminateIfRunning
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx context.Context, signalWithStartRequest *types.SignalWithStartWorkflowExecutionRequest, scope metrics.Scope) error {
 	if signalWithStartRequest == nil {
 		return validate.ErrRequestNotSet
@@ -2322,6 +2424,9 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 	if signalWithStartRequest.GetWorkflowID() == "" {
 		return validate.ErrWorkflowIDNotSet
 	}
+	if err := validateWorkflowIDConflictPolicy(signalWithStartRequest.WorkflowIDConflictPolicy, signalWithStartRequest.WorkflowIDReusePolicy, true); err != nil {
+		return err
+	}
 
 	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
 	if !common.IsValidIDLength(
@@ -2760,6 +2865,7 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(
 		return nil, err
 	}
 
+	writeResponseHeaders(ctx, frontend.DescribeWorkflowExecutionResponseHeaders(response))
 	return response, nil
 }
 
@@ -3033,12 +3139,26 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		Queries:                   matchingResp.Queries,
 		NextEventID:               matchingResp.NextEventID,
 		TotalHistoryBytes:         matchingResp.TotalHistoryBytes,
+		HistoryCount:              matchingResp.HistoryCount,
+		ContinueAsNewSuggested:    matchingResp.ContinueAsNewSuggested,
 		AutoConfigHint:            matchingResp.AutoConfigHint,
 	}
 
 	return resp, nil
 }
 
+// writeResponseHeaders sends the values of a response which are not in the IDL yet, see client/frontend
+func writeResponseHeaders(ctx context.Context, headers map[string]string) {
+	call := yarpc.CallFromContext(ctx)
+	if call == nil {
+		// the handler was not called through yarpc
+		return
+	}
+	for name, value := range headers {
+		_ = call.WriteResponseHeader(name, value)
+	}
+}
+
 func verifyHistoryIsComplete(
 	events []*types.HistoryEvent,
 	expectedFirstEventID int64,
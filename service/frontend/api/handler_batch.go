@@ -0,0 +1,504 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/worker/batcher"
+)
+
+const (
+	defaultListBatchOperationsPageSize = 10
+	batchOperationDecisionTimeout      = 10 // seconds
+
+	// memo and search attribute keys the batch job workflow is tagged with, shared with `cadence workflow batch start`
+	batchMemoReason         = "Reason"
+	batchMemoQuery          = "Query"
+	batchMemoBatchType      = "BatchType"
+	batchSearchAttrDomain   = "CustomDomain"
+	batchSearchAttrOperator = "Operator"
+)
+
+func (wh *WorkflowHandler) StartBatchOperation(
+	ctx context.Context,
+	request *types.StartBatchOperationRequest,
+) (*types.StartBatchOperationResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	params, err := batchParamsFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to serialize batch operation parameters: %v", err)}
+	}
+	memo, err := encodeBatchFields(map[string]string{
+		batchMemoReason:    request.GetReason(),
+		batchMemoQuery:     request.GetQuery(),
+		batchMemoBatchType: request.GetBatchType(),
+	})
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to serialize batch operation memo: %v", err)}
+	}
+	searchAttributes, err := encodeBatchFields(map[string]string{
+		batchSearchAttrDomain:   request.GetDomain(),
+		batchSearchAttrOperator: request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to serialize batch operation search attributes: %v", err)}
+	}
+
+	jobID := request.GetJobID()
+	if jobID == "" {
+		jobID = uuid.New().String()
+	}
+	requestID := request.GetRequestID()
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+	_, err = wh.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              constants.BatcherLocalDomainName,
+		WorkflowID:                          jobID,
+		WorkflowType:                        &types.WorkflowType{Name: batcher.BatchWFV2TypeName},
+		TaskList:                            &types.TaskList{Name: batcher.BatcherTaskListName},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(batcher.InfiniteDuration.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(batchOperationDecisionTimeout),
+		Identity:                            request.GetIdentity(),
+		RequestID:                           requestID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyRejectDuplicate.Ptr(),
+		RetryPolicy:                         batchOperationRetryPolicy(),
+		Memo:                                &types.Memo{Fields: memo},
+		SearchAttributes:                    &types.SearchAttributes{IndexedFields: searchAttributes},
+	})
+	if err != nil {
+		var alreadyStarted *types.WorkflowExecutionAlreadyStartedError
+		if errors.As(err, &alreadyStarted) {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("batch operation %q already exists", jobID)}
+		}
+		return nil, err
+	}
+	return &types.StartBatchOperationResponse{JobID: jobID}, nil
+}
+
+func (wh *WorkflowHandler) DescribeBatchOperation(
+	ctx context.Context,
+	request *types.DescribeBatchOperationRequest,
+) (*types.DescribeBatchOperationResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if request.GetJobID() == "" {
+		return nil, &types.BadRequestError{Message: "JobID is not set on request."}
+	}
+
+	resp, err := wh.describeBatchJob(ctx, request.GetDomain(), request.GetJobID())
+	if err != nil {
+		return nil, err
+	}
+	info := batchOperationInfoFromExecution(resp.GetWorkflowExecutionInfo())
+
+	runID := resp.GetWorkflowExecutionInfo().GetExecution().GetRunID()
+	var progress *batcher.HeartBeatDetails
+	if info.State == types.BatchOperationStateCompleted {
+		// the final progress is the workflow result
+		progress, err = wh.getBatchJobResult(ctx, request.GetJobID(), runID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if progress == nil {
+		// the activity heartbeats its progress after every processed page, stopped runs keep it on their
+		// pending activity
+		progress, err = batchProgressFromPendingActivities(resp.PendingActivities)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if progress == nil && info.State != types.BatchOperationStateRunning {
+		progress, err = wh.getBatchJobLastHeartbeat(ctx, request.GetJobID(), runID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	applyBatchProgress(info, progress)
+	return &types.DescribeBatchOperationResponse{Info: info}, nil
+}
+
+func (wh *WorkflowHandler) ListBatchOperations(
+	ctx context.Context,
+	request *types.ListBatchOperationsRequest,
+) (*types.ListBatchOperationsResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	pageSize := request.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = defaultListBatchOperationsPageSize
+	}
+
+	query, err := batchDomainQuery(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := wh.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+		Domain:        constants.BatcherLocalDomainName,
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+		Query:         query,
+	})
+	if err != nil {
+		return nil, err
+	}
+	operations := make([]*types.BatchOperationInfo, 0, len(resp.GetExecutions()))
+	for _, execution := range resp.GetExecutions() {
+		operations = append(operations, batchOperationInfoFromExecution(execution))
+	}
+	return &types.ListBatchOperationsResponse{
+		Operations:    operations,
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+func (wh *WorkflowHandler) StopBatchOperation(
+	ctx context.Context,
+	request *types.StopBatchOperationRequest,
+) (*types.StopBatchOperationResponse, error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if request.GetJobID() == "" {
+		return nil, &types.BadRequestError{Message: "JobID is not set on request."}
+	}
+	if request.GetReason() == "" {
+		return nil, &types.BadRequestError{Message: "Reason is not set on request."}
+	}
+
+	// make sure the job belongs to the requested domain before stopping it
+	resp, err := wh.describeBatchJob(ctx, request.GetDomain(), request.GetJobID())
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetWorkflowExecutionInfo().CloseStatus != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("batch operation %q is not running", request.GetJobID())}
+	}
+	err = wh.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+		Domain: constants.BatcherLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: request.GetJobID(),
+			RunID:      resp.GetWorkflowExecutionInfo().GetExecution().GetRunID(),
+		},
+		Reason:   request.GetReason(),
+		Identity: request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.StopBatchOperationResponse{}, nil
+}
+
+// describeBatchJob describes the batch job workflow and verifies that it operates on domainName.
+// Jobs of other domains are reported as not existing so that callers can't learn about them.
+func (wh *WorkflowHandler) describeBatchJob(ctx context.Context, domainName, jobID string) (*types.DescribeWorkflowExecutionResponse, error) {
+	resp, err := wh.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    constants.BatcherLocalDomainName,
+		Execution: &types.WorkflowExecution{WorkflowID: jobID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if decodeBatchField(resp.GetWorkflowExecutionInfo().GetSearchAttributes().GetIndexedFields(), batchSearchAttrDomain) != domainName {
+		return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("batch operation %q not found in domain %q", jobID, domainName)}
+	}
+	return resp, nil
+}
+
+func (wh *WorkflowHandler) getBatchJobResult(ctx context.Context, jobID, runID string) (*batcher.HeartBeatDetails, error) {
+	resp, err := wh.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 constants.BatcherLocalDomainName,
+		Execution:              &types.WorkflowExecution{WorkflowID: jobID, RunID: runID},
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range resp.GetHistory().GetEvents() {
+		attributes := event.GetWorkflowExecutionCompletedEventAttributes()
+		if attributes == nil || len(attributes.Result) == 0 {
+			continue
+		}
+		return decodeBatchProgress(attributes.Result)
+	}
+	return nil, nil
+}
+
+// getBatchJobLastHeartbeat returns the progress which the activity of a closed run heartbeated last. A run which
+// failed because its activity timed out has no result and no pending activity, the timeout event keeps the details.
+func (wh *WorkflowHandler) getBatchJobLastHeartbeat(ctx context.Context, jobID, runID string) (*batcher.HeartBeatDetails, error) {
+	request := &types.GetWorkflowExecutionHistoryRequest{
+		Domain:    constants.BatcherLocalDomainName,
+		Execution: &types.WorkflowExecution{WorkflowID: jobID, RunID: runID},
+	}
+	var details []byte
+	for {
+		resp, err := wh.GetWorkflowExecutionHistory(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		if last := lastActivityHeartbeatDetails(resp.GetHistory().GetEvents()); last != nil {
+			details = last
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	return decodeBatchProgress(details)
+}
+
+func lastActivityHeartbeatDetails(events []*types.HistoryEvent) []byte {
+	var details []byte
+	for _, event := range events {
+		if attributes := event.GetActivityTaskTimedOutEventAttributes(); attributes != nil && len(attributes.Details) > 0 {
+			details = attributes.Details
+		}
+	}
+	return details
+}
+
+func batchProgressFromPendingActivities(activities []*types.PendingActivityInfo) (*batcher.HeartBeatDetails, error) {
+	var progress *batcher.HeartBeatDetails
+	for _, activity := range activities {
+		if len(activity.HeartbeatDetails) == 0 {
+			continue
+		}
+		hbd, err := decodeBatchProgress(activity.HeartbeatDetails)
+		if err != nil {
+			return nil, err
+		}
+		progress = hbd
+	}
+	return progress, nil
+}
+
+// decodeBatchProgress returns nil when there is no progress to decode
+func decodeBatchProgress(data []byte) (*batcher.HeartBeatDetails, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var hbd batcher.HeartBeatDetails
+	if err := json.Unmarshal(data, &hbd); err != nil {
+		return nil, &types.InternalServiceError{Message: fmt.Sprintf("failed to decode batch operation progress: %v", err)}
+	}
+	return &hbd, nil
+}
+
+func batchParamsFromRequest(request *types.StartBatchOperationRequest) (batcher.BatchParams, error) {
+	if request.GetDomain() == "" {
+		return batcher.BatchParams{}, validate.ErrDomainNotSet
+	}
+	if request.GetQuery() == "" {
+		return batcher.BatchParams{}, &types.BadRequestError{Message: "Query is not set on request."}
+	}
+	if request.GetReason() == "" {
+		return batcher.BatchParams{}, &types.BadRequestError{Message: "Reason is not set on request."}
+	}
+	params := batcher.BatchParams{
+		DomainName:               request.GetDomain(),
+		Query:                    request.GetQuery(),
+		Reason:                   request.GetReason(),
+		BatchType:                request.GetBatchType(),
+		RPS:                      int(request.GetRPS()),
+		Concurrency:              int(request.GetConcurrency()),
+		PageSize:                 int(request.GetPageSize()),
+		AttemptsOnRetryableError: int(request.GetAttemptsOnRetryableError()),
+		ActivityHeartBeatTimeout: time.Duration(request.GetActivityHeartBeatTimeoutSeconds()) * time.Second,
+		MaxActivityRetries:       int(request.GetMaxActivityRetries()),
+		TerminateParams: batcher.TerminateParams{
+			TerminateChildren: request.GetTerminateChildren(),
+		},
+		CancelParams: batcher.CancelParams{
+			CancelChildren: request.GetCancelChildren(),
+		},
+	}
+	switch request.GetBatchType() {
+	case batcher.BatchTypeTerminate, batcher.BatchTypeCancel, batcher.BatchTypeRefresh:
+	case batcher.BatchTypeSignal:
+		if request.GetSignalParams().GetSignalName() == "" {
+			return batcher.BatchParams{}, &types.BadRequestError{Message: "SignalParams.SignalName is not set on request."}
+		}
+		params.SignalParams = batcher.SignalParams{
+			SignalName: request.GetSignalParams().GetSignalName(),
+			Input:      string(request.GetSignalParams().GetInput()),
+		}
+	case batcher.BatchTypeReplicate:
+		if request.GetReplicateParams().GetSourceCluster() == "" || request.GetReplicateParams().GetTargetCluster() == "" {
+			return batcher.BatchParams{}, &types.BadRequestError{Message: "ReplicateParams.SourceCluster and ReplicateParams.TargetCluster must be set on request."}
+		}
+		params.ReplicateParams = batcher.ReplicateParams{
+			SourceCluster: request.GetReplicateParams().GetSourceCluster(),
+			TargetCluster: request.GetReplicateParams().GetTargetCluster(),
+		}
+	default:
+		return batcher.BatchParams{}, &types.BadRequestError{
+			Message: fmt.Sprintf("BatchType %q is not supported, must be one of %v.", request.GetBatchType(), batcher.AllBatchTypes),
+		}
+	}
+	if params.RPS < 0 || params.Concurrency < 0 || params.PageSize < 0 || params.AttemptsOnRetryableError < 0 ||
+		params.ActivityHeartBeatTimeout < 0 || params.MaxActivityRetries < 0 {
+		return batcher.BatchParams{}, &types.BadRequestError{Message: "RPS, Concurrency and the activity options must not be negative."}
+	}
+	return params, nil
+}
+
+// batchDomainQuery returns the visibility query matching the batch jobs of domainName.
+// Domain names are not restricted to a character set, so names that would break out of the
+// quoted value are rejected rather than escaped, since escaping differs between visibility stores.
+func batchDomainQuery(domainName string) (string, error) {
+	if strings.ContainsAny(domainName, `'\`) {
+		return "", &types.BadRequestError{Message: fmt.Sprintf("Domain %q cannot be used to list batch operations.", domainName)}
+	}
+	return fmt.Sprintf("%s = '%s'", batchSearchAttrDomain, domainName), nil
+}
+
+func batchOperationRetryPolicy() *types.RetryPolicy {
+	policy := batcher.BatchActivityRetryPolicy
+	return &types.RetryPolicy{
+		InitialIntervalInSeconds:    int32(policy.InitialInterval.Seconds()),
+		BackoffCoefficient:          policy.BackoffCoefficient,
+		MaximumIntervalInSeconds:    int32(policy.MaximumInterval.Seconds()),
+		NonRetriableErrorReasons:    policy.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: int32(policy.ExpirationInterval.Seconds()),
+	}
+}
+
+func batchOperationInfoFromExecution(execution *types.WorkflowExecutionInfo) *types.BatchOperationInfo {
+	if execution == nil {
+		return &types.BatchOperationInfo{}
+	}
+	memo := execution.Memo.GetFields()
+	searchAttributes := execution.GetSearchAttributes().GetIndexedFields()
+	return &types.BatchOperationInfo{
+		JobID:     execution.GetExecution().GetWorkflowID(),
+		Domain:    decodeBatchField(searchAttributes, batchSearchAttrDomain),
+		Identity:  decodeBatchField(searchAttributes, batchSearchAttrOperator),
+		Reason:    decodeBatchField(memo, batchMemoReason),
+		Query:     decodeBatchField(memo, batchMemoQuery),
+		BatchType: decodeBatchField(memo, batchMemoBatchType),
+		State:     batchOperationState(execution.CloseStatus),
+		StartTime: execution.StartTime,
+		CloseTime: execution.CloseTime,
+	}
+}
+
+func batchOperationState(closeStatus *types.WorkflowExecutionCloseStatus) types.BatchOperationState {
+	if closeStatus == nil {
+		return types.BatchOperationStateRunning
+	}
+	switch *closeStatus {
+	case types.WorkflowExecutionCloseStatusCompleted:
+		return types.BatchOperationStateCompleted
+	case types.WorkflowExecutionCloseStatusTerminated, types.WorkflowExecutionCloseStatusCanceled:
+		return types.BatchOperationStateStopped
+	default:
+		return types.BatchOperationStateFailed
+	}
+}
+
+func applyBatchProgress(info *types.BatchOperationInfo, progress *batcher.HeartBeatDetails) {
+	if progress == nil {
+		return
+	}
+	info.TotalEstimate = progress.TotalEstimate
+	info.SucceededCount = int64(progress.SuccessCount)
+	info.FailedCount = int64(progress.ErrorCount)
+	info.SkippedCount = int64(progress.SkippedCount)
+	if len(progress.FailureReasons) == 0 {
+		return
+	}
+	info.FailureReasons = make([]*types.BatchOperationFailure, 0, len(progress.FailureReasons))
+	for reason, count := range progress.FailureReasons {
+		info.FailureReasons = append(info.FailureReasons, &types.BatchOperationFailure{Reason: reason, Count: int64(count)})
+	}
+	// most frequent first, ties broken by reason for a stable output
+	sort.Slice(info.FailureReasons, func(i, j int) bool {
+		if info.FailureReasons[i].Count != info.FailureReasons[j].Count {
+			return info.FailureReasons[i].Count > info.FailureReasons[j].Count
+		}
+		return info.FailureReasons[i].Reason < info.FailureReasons[j].Reason
+	})
+}
+
+func encodeBatchFields(fields map[string]string) (map[string][]byte, error) {
+	encoded := make(map[string][]byte, len(fields))
+	for key, value := range fields {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		encoded[key] = data
+	}
+	return encoded, nil
+}
+
+func decodeBatchField(fields map[string][]byte, key string) string {
+	var value string
+	if err := json.Unmarshal(fields[key], &value); err != nil {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,482 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+	"github.com/uber/cadence/service/worker/batcher"
+)
+
+const (
+	testBatcherDomainID = "2b3bc5e2-7a3c-4f9c-9f2d-3d0f0d1c6a11"
+	testBatchJobID      = "batch-job-id"
+)
+
+func TestBatchParamsFromRequest(t *testing.T) {
+	tests := map[string]struct {
+		request *types.StartBatchOperationRequest
+		want    batcher.BatchParams
+		wantErr error
+	}{
+		"missing domain": {
+			request: &types.StartBatchOperationRequest{Query: "q", Reason: "r", BatchType: batcher.BatchTypeTerminate},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"missing query": {
+			request: &types.StartBatchOperationRequest{Domain: testDomain, Reason: "r", BatchType: batcher.BatchTypeTerminate},
+			wantErr: &types.BadRequestError{Message: "Query is not set on request."},
+		},
+		"missing reason": {
+			request: &types.StartBatchOperationRequest{Domain: testDomain, Query: "q", BatchType: batcher.BatchTypeTerminate},
+			wantErr: &types.BadRequestError{Message: "Reason is not set on request."},
+		},
+		"unsupported batch type": {
+			request: &types.StartBatchOperationRequest{Domain: testDomain, Query: "q", Reason: "r", BatchType: "reset"},
+			wantErr: &types.BadRequestError{Message: `BatchType "reset" is not supported, must be one of [terminate cancel signal replicate refresh].`},
+		},
+		"signal without signal name": {
+			request: &types.StartBatchOperationRequest{Domain: testDomain, Query: "q", Reason: "r", BatchType: batcher.BatchTypeSignal},
+			wantErr: &types.BadRequestError{Message: "SignalParams.SignalName is not set on request."},
+		},
+		"replicate without target cluster": {
+			request: &types.StartBatchOperationRequest{
+				Domain: testDomain, Query: "q", Reason: "r", BatchType: batcher.BatchTypeReplicate,
+				ReplicateParams: &types.BatchOperationReplicateParams{SourceCluster: "a"},
+			},
+			wantErr: &types.BadRequestError{Message: "ReplicateParams.SourceCluster and ReplicateParams.TargetCluster must be set on request."},
+		},
+		"negative rps": {
+			request: &types.StartBatchOperationRequest{Domain: testDomain, Query: "q", Reason: "r", BatchType: batcher.BatchTypeCancel, RPS: -1},
+			wantErr: &types.BadRequestError{Message: "RPS, Concurrency and the activity options must not be negative."},
+		},
+		"negative page size": {
+			request: &types.StartBatchOperationRequest{Domain: testDomain, Query: "q", Reason: "r", BatchType: batcher.BatchTypeCancel, PageSize: -1},
+			wantErr: &types.BadRequestError{Message: "RPS, Concurrency and the activity options must not be negative."},
+		},
+		"terminate": {
+			request: &types.StartBatchOperationRequest{
+				Domain: testDomain, Query: "q", Reason: "r", BatchType: batcher.BatchTypeTerminate,
+				TerminateChildren: common.BoolPtr(false), RPS: 10, Concurrency: 2,
+				PageSize: 100, AttemptsOnRetryableError: 3, ActivityHeartBeatTimeoutSeconds: 30, MaxActivityRetries: 4,
+			},
+			want: batcher.BatchParams{
+				DomainName: testDomain, Query: "q", Reason: "r", BatchType: batcher.BatchTypeTerminate,
+				TerminateParams:          batcher.TerminateParams{TerminateChildren: common.BoolPtr(false)},
+				RPS:                      10,
+				Concurrency:              2,
+				PageSize:                 100,
+				AttemptsOnRetryableError: 3,
+				ActivityHeartBeatTimeout: 30 * time.Second,
+				MaxActivityRetries:       4,
+			},
+		},
+		"signal": {
+			request: &types.StartBatchOperationRequest{
+				Domain: testDomain, Query: "q", Reason: "r", BatchType: batcher.BatchTypeSignal,
+				SignalParams: &types.BatchOperationSignalParams{SignalName: "sig", Input: []byte(`"in"`)},
+			},
+			want: batcher.BatchParams{
+				DomainName: testDomain, Query: "q", Reason: "r", BatchType: batcher.BatchTypeSignal,
+				SignalParams: batcher.SignalParams{SignalName: "sig", Input: `"in"`},
+			},
+		},
+		"replicate": {
+			request: &types.StartBatchOperationRequest{
+				Domain: testDomain, Query: "q", Reason: "r", BatchType: batcher.BatchTypeReplicate,
+				ReplicateParams: &types.BatchOperationReplicateParams{SourceCluster: "a", TargetCluster: "b"},
+			},
+			want: batcher.BatchParams{
+				DomainName: testDomain, Query: "q", Reason: "r", BatchType: batcher.BatchTypeReplicate,
+				ReplicateParams: batcher.ReplicateParams{SourceCluster: "a", TargetCluster: "b"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := batchParamsFromRequest(tc.request)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestBatchDomainQuery(t *testing.T) {
+	query, err := batchDomainQuery("test-domain")
+	assert.NoError(t, err)
+	assert.Equal(t, "CustomDomain = 'test-domain'", query)
+
+	for _, domainName := range []string{"x' or CustomDomain != '", `x\`} {
+		_, err := batchDomainQuery(domainName)
+		assert.IsType(t, &types.BadRequestError{}, err, domainName)
+	}
+}
+
+func TestBatchOperationState(t *testing.T) {
+	tests := map[string]struct {
+		closeStatus *types.WorkflowExecutionCloseStatus
+		want        types.BatchOperationState
+	}{
+		"running":    {closeStatus: nil, want: types.BatchOperationStateRunning},
+		"completed":  {closeStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(), want: types.BatchOperationStateCompleted},
+		"terminated": {closeStatus: types.WorkflowExecutionCloseStatusTerminated.Ptr(), want: types.BatchOperationStateStopped},
+		"canceled":   {closeStatus: types.WorkflowExecutionCloseStatusCanceled.Ptr(), want: types.BatchOperationStateStopped},
+		"failed":     {closeStatus: types.WorkflowExecutionCloseStatusFailed.Ptr(), want: types.BatchOperationStateFailed},
+		"timed out":  {closeStatus: types.WorkflowExecutionCloseStatusTimedOut.Ptr(), want: types.BatchOperationStateFailed},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, batchOperationState(tc.closeStatus))
+		})
+	}
+}
+
+func TestApplyBatchProgress(t *testing.T) {
+	info := &types.BatchOperationInfo{}
+	applyBatchProgress(info, nil)
+	assert.Equal(t, &types.BatchOperationInfo{}, info)
+
+	applyBatchProgress(info, &batcher.HeartBeatDetails{
+		TotalEstimate:  10,
+		SuccessCount:   5,
+		ErrorCount:     4,
+		SkippedCount:   1,
+		FailureReasons: map[string]int{"b": 2, "a": 2, "c": 0},
+	})
+	assert.Equal(t, &types.BatchOperationInfo{
+		TotalEstimate:  10,
+		SucceededCount: 5,
+		FailedCount:    4,
+		SkippedCount:   1,
+		FailureReasons: []*types.BatchOperationFailure{
+			{Reason: "a", Count: 2},
+			{Reason: "b", Count: 2},
+			{Reason: "c", Count: 0},
+		},
+	}, info)
+}
+
+func testBatchJobExecution(t *testing.T, domain string, closeStatus *types.WorkflowExecutionCloseStatus) *types.WorkflowExecutionInfo {
+	memo, err := encodeBatchFields(map[string]string{
+		batchMemoReason:    "cleanup",
+		batchMemoQuery:     "WorkflowType = 'wf'",
+		batchMemoBatchType: batcher.BatchTypeTerminate,
+	})
+	require.NoError(t, err)
+	searchAttributes, err := encodeBatchFields(map[string]string{
+		batchSearchAttrDomain:   domain,
+		batchSearchAttrOperator: "operator",
+	})
+	require.NoError(t, err)
+	return &types.WorkflowExecutionInfo{
+		Execution:        &types.WorkflowExecution{WorkflowID: testBatchJobID, RunID: testRunID},
+		StartTime:        common.Int64Ptr(100),
+		CloseStatus:      closeStatus,
+		Memo:             &types.Memo{Fields: memo},
+		SearchAttributes: &types.SearchAttributes{IndexedFields: searchAttributes},
+	}
+}
+
+func TestDescribeBatchOperation(t *testing.T) {
+	progress, err := json.Marshal(batcher.HeartBeatDetails{
+		TotalEstimate:  3,
+		SuccessCount:   1,
+		ErrorCount:     1,
+		SkippedCount:   1,
+		FailureReasons: map[string]int{"boom": 1},
+	})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		request *types.DescribeBatchOperationRequest
+		mockFn  func(*scheduleTestFixture)
+		want    *types.DescribeBatchOperationResponse
+		wantErr error
+	}{
+		"nil request": {
+			request: nil,
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: validate.ErrRequestNotSet,
+		},
+		"empty domain": {
+			request: &types.DescribeBatchOperationRequest{JobID: testBatchJobID},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		"empty job ID": {
+			request: &types.DescribeBatchOperationRequest{Domain: testDomain},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: &types.BadRequestError{Message: "JobID is not set on request."},
+		},
+		"job of another domain": {
+			request: &types.DescribeBatchOperationRequest{Domain: testDomain, JobID: testBatchJobID},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(constants.BatcherLocalDomainName).Return(testBatcherDomainID, nil)
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: testBatchJobExecution(t, "other-domain", nil),
+					}, nil)
+			},
+			wantErr: &types.EntityNotExistsError{Message: `batch operation "batch-job-id" not found in domain "test-domain"`},
+		},
+		"running job": {
+			request: &types.DescribeBatchOperationRequest{Domain: testDomain, JobID: testBatchJobID},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(constants.BatcherLocalDomainName).Return(testBatcherDomainID, nil)
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistoryDescribeWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
+						assert.Equal(t, testBatcherDomainID, req.DomainUUID)
+						assert.Equal(t, testBatchJobID, req.Request.GetExecution().GetWorkflowID())
+						return &types.DescribeWorkflowExecutionResponse{
+							WorkflowExecutionInfo: testBatchJobExecution(t, testDomain, nil),
+							PendingActivities:     []*types.PendingActivityInfo{{HeartbeatDetails: progress}},
+						}, nil
+					})
+			},
+			want: &types.DescribeBatchOperationResponse{
+				Info: &types.BatchOperationInfo{
+					JobID:          testBatchJobID,
+					Domain:         testDomain,
+					BatchType:      batcher.BatchTypeTerminate,
+					Query:          "WorkflowType = 'wf'",
+					Reason:         "cleanup",
+					Identity:       "operator",
+					State:          types.BatchOperationStateRunning,
+					StartTime:      common.Int64Ptr(100),
+					TotalEstimate:  3,
+					SucceededCount: 1,
+					FailedCount:    1,
+					SkippedCount:   1,
+					FailureReasons: []*types.BatchOperationFailure{{Reason: "boom", Count: 1}},
+				},
+			},
+		},
+		"stopped job reports the last heartbeat": {
+			request: &types.DescribeBatchOperationRequest{Domain: testDomain, JobID: testBatchJobID},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(constants.BatcherLocalDomainName).Return(testBatcherDomainID, nil)
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: testBatchJobExecution(t, testDomain, types.WorkflowExecutionCloseStatusTerminated.Ptr()),
+						PendingActivities:     []*types.PendingActivityInfo{{HeartbeatDetails: progress}},
+					}, nil)
+			},
+			want: &types.DescribeBatchOperationResponse{
+				Info: &types.BatchOperationInfo{
+					JobID:          testBatchJobID,
+					Domain:         testDomain,
+					BatchType:      batcher.BatchTypeTerminate,
+					Query:          "WorkflowType = 'wf'",
+					Reason:         "cleanup",
+					Identity:       "operator",
+					State:          types.BatchOperationStateStopped,
+					StartTime:      common.Int64Ptr(100),
+					TotalEstimate:  3,
+					SucceededCount: 1,
+					FailedCount:    1,
+					SkippedCount:   1,
+					FailureReasons: []*types.BatchOperationFailure{{Reason: "boom", Count: 1}},
+				},
+			},
+		},
+		"malformed heartbeat": {
+			request: &types.DescribeBatchOperationRequest{Domain: testDomain, JobID: testBatchJobID},
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(constants.BatcherLocalDomainName).Return(testBatcherDomainID, nil)
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: testBatchJobExecution(t, testDomain, nil),
+						PendingActivities:     []*types.PendingActivityInfo{{HeartbeatDetails: []byte("{")}},
+					}, nil)
+			},
+			wantErr: &types.InternalServiceError{Message: "failed to decode batch operation progress: unexpected end of JSON input"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newScheduleTestFixture(t)
+			defer f.finish()
+			tc.mockFn(f)
+
+			resp, err := f.handler.DescribeBatchOperation(context.Background(), tc.request)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, resp)
+		})
+	}
+}
+
+func TestLastActivityHeartbeatDetails(t *testing.T) {
+	timedOut := func(details string) *types.HistoryEvent {
+		return &types.HistoryEvent{ActivityTaskTimedOutEventAttributes: &types.ActivityTaskTimedOutEventAttributes{Details: []byte(details)}}
+	}
+	events := []*types.HistoryEvent{
+		{ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{}},
+		timedOut(`{"SuccessCount":1}`),
+		timedOut(`{"SuccessCount":2}`),
+		// a timeout before the first heartbeat has no details
+		timedOut(""),
+		{WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{}},
+	}
+	assert.Equal(t, []byte(`{"SuccessCount":2}`), lastActivityHeartbeatDetails(events))
+	assert.Nil(t, lastActivityHeartbeatDetails(events[:1]))
+}
+
+func TestStopBatchOperation(t *testing.T) {
+	validRequest := &types.StopBatchOperationRequest{
+		Domain:   testDomain,
+		JobID:    testBatchJobID,
+		Reason:   "no longer needed",
+		Identity: "operator",
+	}
+	tests := map[string]struct {
+		request *types.StopBatchOperationRequest
+		mockFn  func(*scheduleTestFixture)
+		wantErr error
+	}{
+		"nil request": {
+			request: nil,
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: validate.ErrRequestNotSet,
+		},
+		"empty reason": {
+			request: &types.StopBatchOperationRequest{Domain: testDomain, JobID: testBatchJobID},
+			mockFn:  func(f *scheduleTestFixture) {},
+			wantErr: &types.BadRequestError{Message: "Reason is not set on request."},
+		},
+		"job not found": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(constants.BatcherLocalDomainName).Return(testBatcherDomainID, nil)
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{Message: "not found"})
+			},
+			wantErr: &types.EntityNotExistsError{Message: "not found"},
+		},
+		"job already closed": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(constants.BatcherLocalDomainName).Return(testBatcherDomainID, nil)
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: testBatchJobExecution(t, testDomain, types.WorkflowExecutionCloseStatusCompleted.Ptr()),
+					}, nil)
+			},
+			wantErr: &types.BadRequestError{Message: `batch operation "batch-job-id" is not running`},
+		},
+		"terminate error": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(constants.BatcherLocalDomainName).Return(testBatcherDomainID, nil).Times(2)
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: testBatchJobExecution(t, testDomain, nil),
+					}, nil)
+				f.historyClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(errors.New("terminate failed"))
+			},
+			wantErr: errors.New("terminate failed"),
+		},
+		"success": {
+			request: validRequest,
+			mockFn: func(f *scheduleTestFixture) {
+				f.domainCache.EXPECT().GetDomainID(constants.BatcherLocalDomainName).Return(testBatcherDomainID, nil).Times(2)
+				f.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(&types.DescribeWorkflowExecutionResponse{
+						WorkflowExecutionInfo: testBatchJobExecution(t, testDomain, nil),
+					}, nil)
+				f.historyClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.HistoryTerminateWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+						assert.Equal(t, testBatcherDomainID, req.DomainUUID)
+						assert.Equal(t, &types.TerminateWorkflowExecutionRequest{
+							Domain:            constants.BatcherLocalDomainName,
+							WorkflowExecution: &types.WorkflowExecution{WorkflowID: testBatchJobID, RunID: testRunID},
+							Reason:            "no longer needed",
+							Identity:          "operator",
+						}, req.TerminateRequest)
+						return nil
+					})
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newScheduleTestFixture(t)
+			defer f.finish()
+			tc.mockFn(f)
+
+			resp, err := f.handler.StopBatchOperation(context.Background(), tc.request)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &types.StopBatchOperationResponse{}, resp)
+		})
+	}
+}
+
+func TestBatchOperationHandlers_Validation(t *testing.T) {
+	f := newScheduleTestFixture(t)
+	defer f.finish()
+	ctx := context.Background()
+
+	_, err := f.handler.StartBatchOperation(ctx, nil)
+	assert.Equal(t, validate.ErrRequestNotSet, err)
+	_, err = f.handler.StartBatchOperation(ctx, &types.StartBatchOperationRequest{})
+	assert.Equal(t, validate.ErrDomainNotSet, err)
+	_, err = f.handler.ListBatchOperations(ctx, nil)
+	assert.Equal(t, validate.ErrRequestNotSet, err)
+	_, err = f.handler.ListBatchOperations(ctx, &types.ListBatchOperationsRequest{})
+	assert.Equal(t, validate.ErrDomainNotSet, err)
+	_, err = f.handler.ListBatchOperations(ctx, &types.ListBatchOperationsRequest{Domain: "x' or CustomDomain != '"})
+	assert.IsType(t, &types.BadRequestError{}, err)
+
+	f.handler.Stop()
+	_, err = f.handler.StartBatchOperation(ctx, &types.StartBatchOperationRequest{})
+	assert.Equal(t, validate.ErrShuttingDown, err)
+	_, err = f.handler.DescribeBatchOperation(ctx, &types.DescribeBatchOperationRequest{})
+	assert.Equal(t, validate.ErrShuttingDown, err)
+	_, err = f.handler.ListBatchOperations(ctx, &types.ListBatchOperationsRequest{})
+	assert.Equal(t, validate.ErrShuttingDown, err)
+	_, err = f.handler.StopBatchOperation(ctx, &types.StopBatchOperationRequest{})
+	assert.Equal(t, validate.ErrShuttingDown, err)
+}
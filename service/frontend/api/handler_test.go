@@ -386,9 +386,10 @@ func (s *workflowHandlerSuite) TestPollForActivityTask_IsolationGroupDrained() {
 func (s *workflowHandlerSuite) TestPollForActivityTask() {
 
 	for _, tt := range []struct {
-		name     string
-		response *types.MatchingPollForActivityTaskResponse
-		expected *types.PollForActivityTaskResponse
+		name            string
+		response        *types.MatchingPollForActivityTaskResponse
+		expected        *types.PollForActivityTaskResponse
+		expectedHeaders map[string]string
 	}{
 		{
 			"success",
@@ -412,6 +413,7 @@ func (s *workflowHandlerSuite) TestPollForActivityTask() {
 				Input:          []byte(`{"key": "value"}`),
 				AutoConfigHint: &types.AutoConfigHint{EnableAutoConfig: true, PollerWaitTimeInMs: 1000},
 			},
+			map[string]string{},
 		},
 		{
 			"success with empty polls",
@@ -421,6 +423,17 @@ func (s *workflowHandlerSuite) TestPollForActivityTask() {
 			&types.PollForActivityTaskResponse{
 				AutoConfigHint: &types.AutoConfigHint{EnableAutoConfig: true, PollerWaitTimeInMs: 1000},
 			},
+			map[string]string{},
+		},
+		{
+			"recommended poller count in a response header",
+			&types.MatchingPollForActivityTaskResponse{
+				AutoConfigHint: &types.AutoConfigHint{EnableAutoConfig: true, PollerWaitTimeInMs: 1000, RecommendedPollerCount: 4},
+			},
+			&types.PollForActivityTaskResponse{
+				AutoConfigHint: &types.AutoConfigHint{EnableAutoConfig: true, PollerWaitTimeInMs: 1000, RecommendedPollerCount: 4},
+			},
+			map[string]string{common.RecommendedPollerCountHeaderName: "4"},
 		},
 	} {
 		s.T().Run(tt.name, func(t *testing.T) {
@@ -428,7 +441,8 @@ func (s *workflowHandlerSuite) TestPollForActivityTask() {
 			config.EnableTasklistIsolation = dynamicproperties.GetBoolPropertyFnFilteredByDomain(true)
 			wh := s.getWorkflowHandler(config)
 
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			responseHeaders := map[string]string{}
+			ctx, cancel := context.WithTimeout(yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{ResponseHeaders: responseHeaders}), time.Second*5)
 			defer cancel()
 			isolationGroup := "dca1"
 			ctx = isolationgroup.ContextWithIsolationGroup(ctx, isolationGroup)
@@ -444,6 +458,7 @@ func (s *workflowHandlerSuite) TestPollForActivityTask() {
 			})
 			s.NoError(err)
 			s.Equal(tt.expected, resp)
+			s.Equal(tt.expectedHeaders, responseHeaders)
 		})
 	}
 }
@@ -736,6 +751,116 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_IsolationGroupDrained(
 	s.IsType(err, &types.BadRequestError{})
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_OpenWorkflowLimitReached() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	config.OpenWorkflowLimit = dynamicproperties.GetIntPropertyFilteredByDomain(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	s.mockVisibilityMgr.On("CountWorkflowExecutions", mock.Anything, &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: s.testDomainID,
+		Domain:     s.testDomain,
+		Query:      "CloseTime = missing",
+	}).Return(&persistence.CountWorkflowExecutionsResponse{Count: 10}, nil).Once()
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.HistoryDescribeWorkflowExecutionRequest{
+		DomainUUID: s.testDomainID,
+		Request: &types.DescribeWorkflowExecutionRequest{
+			Domain:    s.testDomain,
+			Execution: &types.WorkflowExecution{WorkflowID: "workflow-id"},
+		},
+	}).Return(nil, &types.EntityNotExistsError{})
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Equal(&types.ServiceBusyError{
+		Message: "Domain test-domain reached its limit of 10 open workflows.",
+		Reason:  constants.OpenWorkflowLimitReason,
+	}, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_OpenWorkflowLimitReached_WorkflowRunning() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	config.OpenWorkflowLimit = dynamicproperties.GetIntPropertyFilteredByDomain(10)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicyUseExisting.Ptr(),
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	s.mockVisibilityMgr.On("CountWorkflowExecutions", mock.Anything, &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: s.testDomainID,
+		Domain:     s.testDomain,
+		Query:      "CloseTime = missing",
+	}).Return(&persistence.CountWorkflowExecutionsResponse{Count: 10}, nil).Once()
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+			Execution: &types.WorkflowExecution{WorkflowID: "workflow-id", RunID: "test-rid"},
+		},
+	}, nil)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.StartWorkflowExecutionResponse{RunID: "test-rid"}, nil)
+	resp, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.NoError(err)
+	s.Equal("test-rid", resp.GetRunID())
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_OpenWorkflowLimitReached_RunningCheckThrottled() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	config.OpenWorkflowLimit = dynamicproperties.GetIntPropertyFilteredByDomain(10)
+	config.OpenWorkflowLimitRunningCheckRPS = dynamicproperties.GetIntPropertyFn(0)
+	wh := s.getWorkflowHandler(config)
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "workflow-id",
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	s.mockVisibilityMgr.On("CountWorkflowExecutions", mock.Anything, &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: s.testDomainID,
+		Domain:     s.testDomain,
+		Query:      "CloseTime = missing",
+	}).Return(&persistence.CountWorkflowExecutionsResponse{Count: 10}, nil).Once()
+	// no history call is expected, the start is rejected without checking whether the workflow is running
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	s.Equal(&types.ServiceBusyError{
+		Message: "Domain test-domain reached its limit of 10 open workflows.",
+		Reason:  constants.OpenWorkflowLimitReason,
+	}, err)
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_LogJitterTime() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
@@ -3017,6 +3142,7 @@ func (s *workflowHandlerSuite) TestRespondDecisionTaskCompleted() {
 		mockFn          func()
 		expectError     bool
 		expectErrorType error
+		expectedHeaders map[string]string
 	}{
 		"shutting down": {
 			input: validRequest,
@@ -3120,13 +3246,48 @@ func (s *workflowHandlerSuite) TestRespondDecisionTaskCompleted() {
 					LastFirstEventID: 1,
 				}, nil).Once()
 			},
+			expectError:     false,
+			expectedHeaders: map[string]string{},
+		},
+		"return new decision task with history count and continue-as-new suggestion": {
+			input: &types.RespondDecisionTaskCompletedRequest{
+				TaskToken:             []byte("token"),
+				Identity:              "identity",
+				Decisions:             make([]*types.Decision, 100),
+				ReturnNewDecisionTask: true,
+			},
+			mockFn: func() {
+				s.mockTokenSerializer.EXPECT().Deserialize(gomock.Any()).Return(&common.TaskToken{DomainID: s.testDomainID}, nil)
+				s.mockTokenSerializer.EXPECT().Serialize(gomock.Any()).Return([]byte("new task token"), nil)
+				s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return(s.testDomain, nil).Times(2)
+				s.mockHistoryClient.EXPECT().RespondDecisionTaskCompleted(gomock.Any(), gomock.Any()).Return(&types.HistoryRespondDecisionTaskCompletedResponse{
+					StartedResponse: &types.RecordDecisionTaskStartedResponse{
+						Attempt:                1,
+						ScheduledEventID:       2,
+						HistoryCount:           12000,
+						ContinueAsNewSuggested: true,
+					},
+				}, nil)
+				s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+					HistoryEvents:    []*types.HistoryEvent{},
+					NextPageToken:    []byte{},
+					Size:             0,
+					LastFirstEventID: 1,
+				}, nil).Once()
+			},
 			expectError: false,
+			expectedHeaders: map[string]string{
+				common.HistoryCountHeaderName:           "12000",
+				common.ContinueAsNewSuggestedHeaderName: "true",
+			},
 		},
 	}
 	for name, input := range testInput {
 		s.Run(name, func() {
 			input.mockFn()
-			_, err := wh.RespondDecisionTaskCompleted(context.Background(), input.input)
+			responseHeaders := map[string]string{}
+			ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{ResponseHeaders: responseHeaders})
+			_, err := wh.RespondDecisionTaskCompleted(ctx, input.input)
 			if input.expectError {
 				s.Error(err)
 				if input.expectErrorType != nil {
@@ -3135,6 +3296,9 @@ func (s *workflowHandlerSuite) TestRespondDecisionTaskCompleted() {
 			} else {
 				s.NoError(err)
 			}
+			if input.expectedHeaders != nil {
+				s.Equal(input.expectedHeaders, responseHeaders)
+			}
 			wh.shuttingDown = int32(0)
 			wh.config.MaxIDLengthWarnLimit = dynamicproperties.GetIntPropertyFn(1000)
 			wh.config.IdentityMaxLength = dynamicproperties.GetIntPropertyFilteredByDomain(1000)
@@ -3375,6 +3539,10 @@ func (s *workflowHandlerSuite) TestRespondQueryTaskCompleted() {
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Remaining() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dynamicproperties.GetBoolPropertyFn(true)
+	config.EnableCompletionCallbacks = func(domain string) bool { return domain == s.testDomain }
+	config.CompletionCallbackAllowedHosts = func(...dynamicproperties.FilterOption) []interface{} {
+		return []interface{}{"example.com"}
+	}
 	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
 	wh.tokenSerializer = s.mockTokenSerializer
 
@@ -3405,6 +3573,92 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Remaining() {
 			ExpectError:     true,
 			ExpectErrorType: validate.ErrShuttingDown,
 		},
+		"signal existing conflict policy": {
+			Request: func() *types.StartWorkflowExecutionRequest {
+				r := *validRequest
+				r.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicySignalExisting.Ptr()
+				return &r
+			}(),
+			MockFn:          func() {},
+			ExpectError:     true,
+			ExpectErrorType: validate.ErrSignalExistingRequiresSignal,
+		},
+		"conflict policy with terminate if running": {
+			Request: func() *types.StartWorkflowExecutionRequest {
+				r := *validRequest
+				r.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyUseExisting.Ptr()
+				r.WorkflowIDReusePolicy = types.WorkflowIDReusePolicyTerminateIfRunning.Ptr()
+				return &r
+			}(),
+			MockFn:          func() {},
+			ExpectError:     true,
+			ExpectErrorType: validate.ErrConflictPolicyWithTerminateIfRunning,
+		},
+		"use existing conflict policy": {
+			Request: func() *types.StartWorkflowExecutionRequest {
+				r := *validRequest
+				r.WorkflowIDConflictPolicy = types.WorkflowIDConflictPolicyUseExisting.Ptr()
+				return &r
+			}(),
+			MockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			ExpectError: false,
+		},
+		"invalid completion callback": {
+			Request: func() *types.StartWorkflowExecutionRequest {
+				r := *validRequest
+				r.CompletionCallbacks = []*types.CompletionCallback{{URL: "ftp://example.com/done"}}
+				return &r
+			}(),
+			MockFn:      func() {},
+			ExpectError: true,
+			ExpectErrorType: &types.BadRequestError{
+				Message: `invalid completion callback URL "ftp://example.com/done": must be an absolute http or https URL`,
+			},
+		},
+		"completion callbacks not enabled": {
+			Request: func() *types.StartWorkflowExecutionRequest {
+				r := *validRequest
+				r.Domain = "other-domain"
+				r.CompletionCallbacks = []*types.CompletionCallback{{URL: "https://example.com/done"}}
+				return &r
+			}(),
+			MockFn:      func() {},
+			ExpectError: true,
+			ExpectErrorType: &types.BadRequestError{
+				Message: "completion callbacks are not enabled for domain other-domain",
+			},
+		},
+		"completion callback host not allowed": {
+			Request: func() *types.StartWorkflowExecutionRequest {
+				r := *validRequest
+				r.CompletionCallbacks = []*types.CompletionCallback{{URL: "http://169.254.169.254/latest/meta-data"}}
+				return &r
+			}(),
+			MockFn:      func() {},
+			ExpectError: true,
+			ExpectErrorType: &types.BadRequestError{
+				Message: `completion callback host "169.254.169.254" is not allowed`,
+			},
+		},
+		"completion callbacks": {
+			Request: func() *types.StartWorkflowExecutionRequest {
+				r := *validRequest
+				r.CompletionCallbacks = []*types.CompletionCallback{
+					{URL: "https://example.com/done", Header: map[string]string{"Authorization": "Bearer token"}},
+				}
+				return &r
+			}(),
+			MockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			ExpectError: false,
+		},
 		"cannot get domain ID": {
 			Request: validRequest,
 			MockFn: func() {
@@ -3451,6 +3705,29 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Remaining() {
 	}
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_CompletionCallbacksOnSQLStore() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.EnableCompletionCallbacks = dynamicproperties.GetBoolPropertyFnFilteredByDomain(true)
+	config.IsSQLDefaultStore = true
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+
+	_, err := wh.StartWorkflowExecution(context.Background(), &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: "wid",
+		RequestID:  testRunID,
+		WorkflowType: &types.WorkflowType{
+			Name: "wType",
+		},
+		TaskList: &types.TaskList{
+			Name: "tasklist",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(100),
+		CompletionCallbacks:                 []*types.CompletionCallback{{URL: "https://example.com/done"}},
+	})
+	s.Equal(&types.BadRequestError{Message: "completion callbacks are not supported by the SQL persistence store"}, err)
+}
+
 func (s *workflowHandlerSuite) TestSignalWorkflowExecution() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dynamicproperties.GetBoolPropertyFn(true)
@@ -3828,6 +4105,54 @@ func TestStartWorkflowExecutionAsync(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:       "Error case - workflow ID hash",
+			setupMocks: func(mockQueue *MockProducerManager) {},
+			request: &types.StartWorkflowExecutionAsyncRequest{
+				StartWorkflowExecutionRequest: &types.StartWorkflowExecutionRequest{
+					Domain:     "test-domain",
+					WorkflowID: "test-workflow-id",
+					WorkflowType: &types.WorkflowType{
+						Name: "test-workflow-type",
+					},
+					TaskList: &types.TaskList{
+						Name: "test-task-list",
+					},
+					Input:                               []byte("test-input"),
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+					Identity:                            "test-identity",
+					RequestID:                           uuid.New(),
+					ActiveClusterSelectionPolicy: &types.ActiveClusterSelectionPolicy{
+						WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "region"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "Error case - workflow ID conflict policy",
+			setupMocks: func(mockQueue *MockProducerManager) {},
+			request: &types.StartWorkflowExecutionAsyncRequest{
+				StartWorkflowExecutionRequest: &types.StartWorkflowExecutionRequest{
+					Domain:     "test-domain",
+					WorkflowID: "test-workflow-id",
+					WorkflowType: &types.WorkflowType{
+						Name: "test-workflow-type",
+					},
+					TaskList: &types.TaskList{
+						Name: "test-task-list",
+					},
+					Input:                               []byte("test-input"),
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+					Identity:                            "test-identity",
+					RequestID:                           uuid.New(),
+					WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicyUseExisting.Ptr(),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Error case - failed to publish message",
 			setupMocks: func(mockQueue *MockProducerManager) {
@@ -3948,6 +4273,56 @@ func TestSignalWithStartWorkflowExecutionAsync(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:       "Error case - workflow ID hash",
+			setupMocks: func(mockQueue *MockProducerManager) {},
+			request: &types.SignalWithStartWorkflowExecutionAsyncRequest{
+				SignalWithStartWorkflowExecutionRequest: &types.SignalWithStartWorkflowExecutionRequest{
+					Domain:     "test-domain",
+					WorkflowID: "test-workflow-id",
+					WorkflowType: &types.WorkflowType{
+						Name: "test-workflow-type",
+					},
+					TaskList: &types.TaskList{
+						Name: "test-task-list",
+					},
+					Input:                               []byte("test-input"),
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+					Identity:                            "test-identity",
+					RequestID:                           uuid.New(),
+					SignalName:                          "test-signal-name",
+					ActiveClusterSelectionPolicy: &types.ActiveClusterSelectionPolicy{
+						WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "region"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "Error case - workflow ID conflict policy",
+			setupMocks: func(mockQueue *MockProducerManager) {},
+			request: &types.SignalWithStartWorkflowExecutionAsyncRequest{
+				SignalWithStartWorkflowExecutionRequest: &types.SignalWithStartWorkflowExecutionRequest{
+					Domain:     "test-domain",
+					WorkflowID: "test-workflow-id",
+					WorkflowType: &types.WorkflowType{
+						Name: "test-workflow-type",
+					},
+					TaskList: &types.TaskList{
+						Name: "test-task-list",
+					},
+					Input:                               []byte("test-input"),
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+					Identity:                            "test-identity",
+					RequestID:                           uuid.New(),
+					SignalName:                          "test-signal-name",
+					WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicyUseExisting.Ptr(),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Error case - failed to publish message",
 			setupMocks: func(mockQueue *MockProducerManager) {
@@ -4454,6 +4829,41 @@ func TestDescribeWorkflowExecution(t *testing.T) {
 	}
 }
 
+func TestDescribeWorkflowExecution_ReplicationExcludedHeader(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+	cfg := frontendcfg.NewConfig(
+		dc.NewCollection(
+			dc.NewInMemoryClient(),
+			mockResource.GetLogger(),
+		),
+		numHistoryShards,
+		false,
+		"hostname",
+		mockResource.GetLogger(),
+	)
+	wh := NewWorkflowHandler(mockResource, cfg, client.NewMockVersionChecker(mockCtrl), nil)
+
+	resp := &types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{ReplicationExcluded: true},
+	}
+	mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil).Times(1)
+	mockResource.HistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(1)
+
+	responseHeaders := make(map[string]string)
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{ResponseHeaders: responseHeaders})
+	describeResponse, err := wh.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: "test-domain",
+		Execution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, resp, describeResponse)
+	assert.Equal(t, map[string]string{common.ReplicationExcludedHeaderName: "true"}, responseHeaders)
+}
+
 func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	config.EnableClientVersionCheck = dynamicproperties.GetBoolPropertyFn(true)
@@ -4513,6 +4923,15 @@ func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution() {
 			mockFn:      func() {},
 			expectError: true,
 		},
+		"invalid conflict policy": {
+			request: &types.SignalWithStartWorkflowExecutionRequest{
+				Domain:                   s.testDomain,
+				WorkflowID:               testWorkflowID,
+				WorkflowIDConflictPolicy: types.WorkflowIDConflictPolicy(-1).Ptr(),
+			},
+			mockFn:      func() {},
+			expectError: true,
+		},
 		"cannot get domain ID": {
 			request: validRequest,
 			mockFn: func() {
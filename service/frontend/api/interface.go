@@ -24,7 +24,7 @@
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/versioncheck.tmpl -o ../wrappers/versioncheck/api_generated.go
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/metered.tmpl -o ../wrappers/metered/api_generated.go -v handler=API
 //go:generate gowrap gen -g -p . -i Handler -t ../templates/ratelimited.tmpl -o ../wrappers/ratelimited/api_generated.go -v handler=API
-//go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/api_generated.go -v handler=API -v package=apiv1 -v path=github.com/uber/cadence-idl/go/proto/api/v1 -v prefix=
+//go:generate gowrap gen -g -p . -i Handler -t ../../templates/grpc.tmpl -o ../wrappers/grpc/api_generated.go -v handler=API -v package=apiv1 -v path=github.com/uber/cadence-idl/go/proto/api/v1 -v internalPackage=frontendv1 -v internalPath=github.com/uber/cadence/.gen/proto/frontend/v1 -v prefix=
 //go:generate gowrap gen -g -p ../../../.gen/go/cadence/workflowserviceserver -i Interface -t ../../templates/thrift.tmpl -o ../wrappers/thrift/api_generated.go -v handler=API -v prefix=
 
 package api
@@ -95,5 +95,10 @@ type (
 		UnpauseSchedule(context.Context, *types.UnpauseScheduleRequest) (*types.UnpauseScheduleResponse, error)
 		BackfillSchedule(context.Context, *types.BackfillScheduleRequest) (*types.BackfillScheduleResponse, error)
 		ListSchedules(context.Context, *types.ListSchedulesRequest) (*types.ListSchedulesResponse, error)
+
+		StartBatchOperation(context.Context, *types.StartBatchOperationRequest) (*types.StartBatchOperationResponse, error)
+		DescribeBatchOperation(context.Context, *types.DescribeBatchOperationRequest) (*types.DescribeBatchOperationResponse, error)
+		ListBatchOperations(context.Context, *types.ListBatchOperationsRequest) (*types.ListBatchOperationsResponse, error)
+		StopBatchOperation(context.Context, *types.StopBatchOperationRequest) (*types.StopBatchOperationResponse, error)
 	}
 )
@@ -130,6 +130,21 @@ func (mr *MockHandlerMockRecorder) DeprecateDomain(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprecateDomain", reflect.TypeOf((*MockHandler)(nil).DeprecateDomain), arg0, arg1)
 }
 
+// DescribeBatchOperation mocks base method.
+func (m *MockHandler) DescribeBatchOperation(arg0 context.Context, arg1 *types.DescribeBatchOperationRequest) (*types.DescribeBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBatchOperation indicates an expected call of DescribeBatchOperation.
+func (mr *MockHandlerMockRecorder) DescribeBatchOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBatchOperation", reflect.TypeOf((*MockHandler)(nil).DescribeBatchOperation), arg0, arg1)
+}
+
 // DescribeDomain mocks base method.
 func (m *MockHandler) DescribeDomain(arg0 context.Context, arg1 *types.DescribeDomainRequest) (*types.DescribeDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +325,21 @@ func (mr *MockHandlerMockRecorder) ListArchivedWorkflowExecutions(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArchivedWorkflowExecutions", reflect.TypeOf((*MockHandler)(nil).ListArchivedWorkflowExecutions), arg0, arg1)
 }
 
+// ListBatchOperations mocks base method.
+func (m *MockHandler) ListBatchOperations(arg0 context.Context, arg1 *types.ListBatchOperationsRequest) (*types.ListBatchOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBatchOperations", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListBatchOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBatchOperations indicates an expected call of ListBatchOperations.
+func (mr *MockHandlerMockRecorder) ListBatchOperations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchOperations", reflect.TypeOf((*MockHandler)(nil).ListBatchOperations), arg0, arg1)
+}
+
 // ListClosedWorkflowExecutions mocks base method.
 func (m *MockHandler) ListClosedWorkflowExecutions(arg0 context.Context, arg1 *types.ListClosedWorkflowExecutionsRequest) (*types.ListClosedWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -778,6 +808,21 @@ func (mr *MockHandlerMockRecorder) SignalWorkflowExecution(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).SignalWorkflowExecution), arg0, arg1)
 }
 
+// StartBatchOperation mocks base method.
+func (m *MockHandler) StartBatchOperation(arg0 context.Context, arg1 *types.StartBatchOperationRequest) (*types.StartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*types.StartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockHandlerMockRecorder) StartBatchOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockHandler)(nil).StartBatchOperation), arg0, arg1)
+}
+
 // StartWorkflowExecution mocks base method.
 func (m *MockHandler) StartWorkflowExecution(arg0 context.Context, arg1 *types.StartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -808,6 +853,21 @@ func (mr *MockHandlerMockRecorder) StartWorkflowExecutionAsync(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorkflowExecutionAsync", reflect.TypeOf((*MockHandler)(nil).StartWorkflowExecutionAsync), arg0, arg1)
 }
 
+// StopBatchOperation mocks base method.
+func (m *MockHandler) StopBatchOperation(arg0 context.Context, arg1 *types.StopBatchOperationRequest) (*types.StopBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*types.StopBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopBatchOperation indicates an expected call of StopBatchOperation.
+func (mr *MockHandlerMockRecorder) StopBatchOperation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopBatchOperation", reflect.TypeOf((*MockHandler)(nil).StopBatchOperation), arg0, arg1)
+}
+
 // TerminateWorkflowExecution mocks base method.
 func (m *MockHandler) TerminateWorkflowExecution(arg0 context.Context, arg1 *types.TerminateWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
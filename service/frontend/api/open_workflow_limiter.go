@@ -0,0 +1,277 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/config"
+)
+
+const openWorkflowQuery = "CloseTime = missing"
+
+// queryStringEscaper escapes a value for a single quoted string of a visibility query. The Elasticsearch and
+// Pinot visibility stores both parse the query with the MySQL dialect, where a backslash also escapes.
+var queryStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+
+type (
+	// openWorkflowLimiter caps the number of concurrently open workflows per domain and per workflow type.
+	// Open workflows are counted through visibility, which aggregates executions across all history shards,
+	// and counts are cached for a short time so that starts do not each issue a visibility query.
+	// Since visibility is updated asynchronously, the limits are soft and can be exceeded by the starts
+	// accepted within the visibility lag and the cache TTL.
+	// Only StartWorkflowExecution requests served by frontend are limited. Workflows started by history,
+	// such as child workflows, cron and retry runs, continue-as-new and resets, are counted but never rejected.
+	openWorkflowLimiter struct {
+		visibilityManager  persistence.VisibilityManager
+		domainLimit        dynamicproperties.IntPropertyFnWithDomainFilter
+		workflowTypeLimits dynamicproperties.MapPropertyFnWithDomainFilter
+		countCacheTTL      dynamicproperties.DurationPropertyFn
+		timeSource         clock.TimeSource
+		logger             log.Logger
+		// runningCheckLimiter bounds the history calls made for rejected starts, see AllowRunningCheck
+		runningCheckLimiter quotas.Limiter
+
+		// countGroup merges the concurrent count queries of a key whose cached count expired
+		countGroup singleflight.Group
+
+		sync.Mutex
+		counts map[openWorkflowCountKey]openWorkflowCount
+	}
+
+	openWorkflowCountKey struct {
+		domainID string
+		// workflowType is empty when counting all open workflows of the domain
+		workflowType string
+	}
+
+	openWorkflowCount struct {
+		count int64
+		// err is cached as well so that a visibility store which cannot count is not queried on every start
+		err      error
+		expireAt time.Time
+	}
+)
+
+func newOpenWorkflowLimiter(
+	visibilityManager persistence.VisibilityManager,
+	config *config.Config,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *openWorkflowLimiter {
+	return &openWorkflowLimiter{
+		visibilityManager:   visibilityManager,
+		domainLimit:         config.OpenWorkflowLimit,
+		workflowTypeLimits:  config.OpenWorkflowLimitPerWorkflowType,
+		countCacheTTL:       config.OpenWorkflowCountCacheTTL,
+		timeSource:          timeSource,
+		logger:              logger,
+		runningCheckLimiter: quotas.NewDynamicRateLimiter(config.OpenWorkflowLimitRunningCheckRPS.AsFloat64()),
+		counts:              make(map[openWorkflowCountKey]openWorkflowCount),
+	}
+}
+
+// Allow returns a ServiceBusyError if the domain or the workflow type already reached its open workflow limit.
+// Its reason is OpenWorkflowLimitReason, which tells clients it apart from the rate limit rejections.
+// Failures to count open workflows do not block the start, they are counted on the given scope instead.
+func (l *openWorkflowLimiter) Allow(
+	ctx context.Context,
+	scope metrics.Scope,
+	domainID string,
+	domainName string,
+	workflowType string,
+) error {
+	if limit := int64(l.domainLimit(domainName)); limit > 0 {
+		count, err := l.getCount(ctx, domainID, domainName, "")
+		if err != nil {
+			scope.IncCounter(metrics.OpenWorkflowLimitSkippedCounter)
+		} else if count >= limit {
+			return &types.ServiceBusyError{
+				Message: fmt.Sprintf("Domain %v reached its limit of %d open workflows.", domainName, limit),
+				Reason:  constants.OpenWorkflowLimitReason,
+			}
+		}
+	}
+
+	if limit, ok := l.getWorkflowTypeLimits(domainName)[workflowType]; ok && limit > 0 {
+		count, err := l.getCount(ctx, domainID, domainName, workflowType)
+		if err != nil {
+			scope.IncCounter(metrics.OpenWorkflowLimitSkippedCounter)
+		} else if count >= limit {
+			return &types.ServiceBusyError{
+				Message: fmt.Sprintf("Workflow type %v in domain %v reached its limit of %d open workflows.", workflowType, domainName, limit),
+				Reason:  constants.OpenWorkflowLimitReason,
+			}
+		}
+	}
+	return nil
+}
+
+// AllowRunningCheck returns whether a start rejected by Allow can be checked against history for an already
+// running workflow ID. The checks are rate limited so that a burst of rejected starts does not turn into
+// a burst of history calls, the starts over the rate stay rejected.
+func (l *openWorkflowLimiter) AllowRunningCheck() bool {
+	return l.runningCheckLimiter.Allow()
+}
+
+// Usage returns the open workflow counts of the domain along with the configured limits,
+// or nil if no open workflow limit is configured for the domain.
+func (l *openWorkflowLimiter) Usage(
+	ctx context.Context,
+	domainID string,
+	domainName string,
+) (*types.OpenWorkflowUsage, error) {
+	domainLimit := int64(l.domainLimit(domainName))
+	workflowTypeLimits := l.getWorkflowTypeLimits(domainName)
+	if domainLimit <= 0 && len(workflowTypeLimits) == 0 {
+		return nil, nil
+	}
+
+	count, err := l.getCount(ctx, domainID, domainName, "")
+	if err != nil {
+		return nil, err
+	}
+	usage := &types.OpenWorkflowUsage{
+		OpenWorkflowCount: count,
+		OpenWorkflowLimit: domainLimit,
+	}
+
+	workflowTypes := make([]string, 0, len(workflowTypeLimits))
+	for workflowType := range workflowTypeLimits {
+		workflowTypes = append(workflowTypes, workflowType)
+	}
+	sort.Strings(workflowTypes)
+	for _, workflowType := range workflowTypes {
+		count, err := l.getCount(ctx, domainID, domainName, workflowType)
+		if err != nil {
+			return nil, err
+		}
+		usage.WorkflowTypes = append(usage.WorkflowTypes, &types.WorkflowTypeOpenWorkflowUsage{
+			WorkflowType:      workflowType,
+			OpenWorkflowCount: count,
+			OpenWorkflowLimit: workflowTypeLimits[workflowType],
+		})
+	}
+	return usage, nil
+}
+
+func (l *openWorkflowLimiter) getCount(
+	ctx context.Context,
+	domainID string,
+	domainName string,
+	workflowType string,
+) (int64, error) {
+	key := openWorkflowCountKey{domainID: domainID, workflowType: workflowType}
+	if cached, ok := l.getCachedCount(key); ok {
+		return cached.count, cached.err
+	}
+
+	result, _, _ := l.countGroup.Do(domainID+"/"+workflowType, func() (interface{}, error) {
+		// the count may have been refreshed since the lookup above by a query which completed in between
+		if cached, ok := l.getCachedCount(key); ok {
+			return cached, nil
+		}
+		return l.countOpenWorkflows(ctx, key, domainName), nil
+	})
+	cached := result.(openWorkflowCount)
+	return cached.count, cached.err
+}
+
+func (l *openWorkflowLimiter) getCachedCount(key openWorkflowCountKey) (openWorkflowCount, bool) {
+	l.Lock()
+	cached, ok := l.counts[key]
+	l.Unlock()
+	return cached, ok && l.timeSource.Now().Before(cached.expireAt)
+}
+
+func (l *openWorkflowLimiter) countOpenWorkflows(
+	ctx context.Context,
+	key openWorkflowCountKey,
+	domainName string,
+) openWorkflowCount {
+	query := openWorkflowQuery
+	if key.workflowType != "" {
+		query = fmt.Sprintf("WorkflowType = '%s' and %s", queryStringEscaper.Replace(key.workflowType), openWorkflowQuery)
+	}
+	resp, err := l.visibilityManager.CountWorkflowExecutions(ctx, &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: key.domainID,
+		Domain:     domainName,
+		Query:      query,
+	})
+	cached := openWorkflowCount{expireAt: l.timeSource.Now().Add(l.countCacheTTL())}
+	if err != nil {
+		l.logger.Warn("Failed to count open workflows, skipping open workflow limit",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowType(key.workflowType),
+			tag.Error(err),
+		)
+		cached.err = err
+		if ctx.Err() != nil {
+			// the caller gave up, which says nothing about the visibility store
+			return cached
+		}
+	} else {
+		cached.count = resp.Count
+	}
+
+	l.Lock()
+	l.counts[key] = cached
+	l.Unlock()
+	return cached
+}
+
+func (l *openWorkflowLimiter) getWorkflowTypeLimits(domainName string) map[string]int64 {
+	limits := make(map[string]int64)
+	for workflowType, value := range l.workflowTypeLimits(domainName) {
+		// dynamic config map values are decoded as int or float64 depending on the config source
+		switch v := value.(type) {
+		case int:
+			limits[workflowType] = int64(v)
+		case int64:
+			limits[workflowType] = v
+		case float64:
+			limits[workflowType] = int64(v)
+		default:
+			l.logger.Warn("Ignoring invalid open workflow limit",
+				tag.WorkflowDomainName(domainName),
+				tag.WorkflowType(workflowType),
+				tag.Value(value),
+			)
+		}
+	}
+	return limits
+}
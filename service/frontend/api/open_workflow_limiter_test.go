@@ -0,0 +1,307 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/service/frontend/config"
+)
+
+func newTestOpenWorkflowLimiter(
+	t *testing.T,
+	visibilityManager persistence.VisibilityManager,
+	timeSource clock.TimeSource,
+	domainLimit int,
+	workflowTypeLimits map[string]interface{},
+) *openWorkflowLimiter {
+	return newOpenWorkflowLimiter(
+		visibilityManager,
+		&config.Config{
+			OpenWorkflowLimit:                dynamicproperties.GetIntPropertyFilteredByDomain(domainLimit),
+			OpenWorkflowLimitPerWorkflowType: func(string) map[string]interface{} { return workflowTypeLimits },
+			OpenWorkflowCountCacheTTL:        dynamicproperties.GetDurationPropertyFn(time.Minute),
+			OpenWorkflowLimitRunningCheckRPS: dynamicproperties.GetIntPropertyFn(1),
+		},
+		timeSource,
+		testlogger.New(t),
+	)
+}
+
+func expectCountOpenWorkflows(visibilityManager *persistence.MockVisibilityManager, query string, count int64, err error) {
+	visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), &persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Domain:     testDomain,
+		Query:      query,
+	}).Return(&persistence.CountWorkflowExecutionsResponse{Count: count}, err).Times(1)
+}
+
+func TestOpenWorkflowLimiter_Allow(t *testing.T) {
+	testCases := []struct {
+		name               string
+		domainLimit        int
+		workflowTypeLimits map[string]interface{}
+		mockSetup          func(*persistence.MockVisibilityManager)
+		wantErr            error
+		wantSkipped        int64
+	}{
+		{
+			name: "no limits configured",
+		},
+		{
+			name:        "below domain limit",
+			domainLimit: 10,
+			mockSetup: func(visibilityManager *persistence.MockVisibilityManager) {
+				expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 9, nil)
+			},
+		},
+		{
+			name:        "domain limit reached",
+			domainLimit: 10,
+			mockSetup: func(visibilityManager *persistence.MockVisibilityManager) {
+				expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 10, nil)
+			},
+			wantErr: &types.ServiceBusyError{
+				Message: "Domain test-domain reached its limit of 10 open workflows.",
+				Reason:  constants.OpenWorkflowLimitReason,
+			},
+		},
+		{
+			name:               "workflow type limit reached",
+			domainLimit:        10,
+			workflowTypeLimits: map[string]interface{}{"wf-type": 5, "other-wf-type": 1},
+			mockSetup: func(visibilityManager *persistence.MockVisibilityManager) {
+				expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 9, nil)
+				expectCountOpenWorkflows(visibilityManager, "WorkflowType = 'wf-type' and CloseTime = missing", 5, nil)
+			},
+			wantErr: &types.ServiceBusyError{
+				Message: "Workflow type wf-type in domain test-domain reached its limit of 5 open workflows.",
+				Reason:  constants.OpenWorkflowLimitReason,
+			},
+		},
+		{
+			name:               "workflow type limit decoded as float",
+			workflowTypeLimits: map[string]interface{}{"wf-type": float64(5)},
+			mockSetup: func(visibilityManager *persistence.MockVisibilityManager) {
+				expectCountOpenWorkflows(visibilityManager, "WorkflowType = 'wf-type' and CloseTime = missing", 4, nil)
+			},
+		},
+		{
+			name:               "other workflow types are not limited",
+			workflowTypeLimits: map[string]interface{}{"other-wf-type": 1},
+		},
+		{
+			name:        "visibility error does not block the start",
+			domainLimit: 10,
+			mockSetup: func(visibilityManager *persistence.MockVisibilityManager) {
+				expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 0, persistence.ErrVisibilityOperationNotSupported)
+			},
+			wantSkipped: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			visibilityManager := persistence.NewMockVisibilityManager(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(visibilityManager)
+			}
+			limiter := newTestOpenWorkflowLimiter(t, visibilityManager, clock.NewMockedTimeSource(), tc.domainLimit, tc.workflowTypeLimits)
+			testScope := tally.NewTestScope("", nil)
+			scope := metrics.NewClient(testScope, metrics.Frontend, metrics.MigrationConfig{}).Scope(metrics.FrontendStartWorkflowExecutionScope)
+
+			err := limiter.Allow(context.Background(), scope, testDomainID, testDomain, "wf-type")
+			assert.Equal(t, tc.wantErr, err)
+			var skipped int64
+			for _, counter := range testScope.Snapshot().Counters() {
+				if counter.Name() == "open_workflow_limit_skipped" {
+					skipped += counter.Value()
+				}
+			}
+			assert.Equal(t, tc.wantSkipped, skipped)
+		})
+	}
+}
+
+func TestOpenWorkflowLimiter_WorkflowTypeIsEscaped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	visibilityManager := persistence.NewMockVisibilityManager(ctrl)
+	workflowType := `it's a \ type`
+	expectCountOpenWorkflows(visibilityManager, `WorkflowType = 'it''s a \\ type' and CloseTime = missing`, 0, nil)
+	limiter := newTestOpenWorkflowLimiter(t, visibilityManager, clock.NewMockedTimeSource(), 0, map[string]interface{}{workflowType: 1})
+
+	assert.NoError(t, limiter.Allow(context.Background(), metrics.NoopScope, testDomainID, testDomain, workflowType))
+}
+
+func TestOpenWorkflowLimiter_ReasonIsReturnedToClients(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	visibilityManager := persistence.NewMockVisibilityManager(ctrl)
+	expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 10, nil)
+	limiter := newTestOpenWorkflowLimiter(t, visibilityManager, clock.NewMockedTimeSource(), 10, nil)
+
+	err := limiter.Allow(context.Background(), metrics.NoopScope, testDomainID, testDomain, "wf-type")
+	for name, roundTrip := range map[string]func(error) error{
+		"proto":  func(err error) error { return proto.ToError(proto.FromError(err)) },
+		"thrift": func(err error) error { return thrift.ToError(thrift.FromError(err)) },
+	} {
+		var serviceBusy *types.ServiceBusyError
+		require.ErrorAs(t, roundTrip(err), &serviceBusy, name)
+		assert.Equal(t, constants.OpenWorkflowLimitReason, serviceBusy.Reason, name)
+	}
+}
+
+func TestOpenWorkflowLimiter_CountIsCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	visibilityManager := persistence.NewMockVisibilityManager(ctrl)
+	timeSource := clock.NewMockedTimeSource()
+	limiter := newTestOpenWorkflowLimiter(t, visibilityManager, timeSource, 10, nil)
+
+	expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 9, nil)
+	require.NoError(t, limiter.Allow(context.Background(), metrics.NoopScope, testDomainID, testDomain, "wf-type"))
+	require.NoError(t, limiter.Allow(context.Background(), metrics.NoopScope, testDomainID, testDomain, "wf-type"))
+
+	timeSource.Advance(time.Minute)
+	expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 10, nil)
+	assert.Error(t, limiter.Allow(context.Background(), metrics.NoopScope, testDomainID, testDomain, "wf-type"))
+}
+
+func TestOpenWorkflowLimiter_CountErrorIsCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	visibilityManager := persistence.NewMockVisibilityManager(ctrl)
+	timeSource := clock.NewMockedTimeSource()
+	limiter := newTestOpenWorkflowLimiter(t, visibilityManager, timeSource, 10, nil)
+
+	expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 0, persistence.ErrVisibilityOperationNotSupported)
+	require.NoError(t, limiter.Allow(context.Background(), metrics.NoopScope, testDomainID, testDomain, "wf-type"))
+	require.NoError(t, limiter.Allow(context.Background(), metrics.NoopScope, testDomainID, testDomain, "wf-type"))
+
+	timeSource.Advance(time.Minute)
+	expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 10, nil)
+	assert.Error(t, limiter.Allow(context.Background(), metrics.NoopScope, testDomainID, testDomain, "wf-type"))
+}
+
+func TestOpenWorkflowLimiter_ConcurrentCountsAreMerged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	visibilityManager := persistence.NewMockVisibilityManager(ctrl)
+	limiter := newTestOpenWorkflowLimiter(t, visibilityManager, clock.NewMockedTimeSource(), 10, nil)
+
+	release := make(chan struct{})
+	visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *persistence.CountWorkflowExecutionsRequest) (*persistence.CountWorkflowExecutionsResponse, error) {
+			<-release
+			return &persistence.CountWorkflowExecutionsResponse{Count: 10}, nil
+		},
+	).Times(1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Error(t, limiter.Allow(context.Background(), metrics.NoopScope, testDomainID, testDomain, "wf-type"))
+		}()
+	}
+	close(release)
+	wg.Wait()
+}
+
+func TestOpenWorkflowLimiter_CanceledCountIsNotCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	visibilityManager := persistence.NewMockVisibilityManager(ctrl)
+	limiter := newTestOpenWorkflowLimiter(t, visibilityManager, clock.NewMockedTimeSource(), 10, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 0, context.Canceled)
+	require.NoError(t, limiter.Allow(ctx, metrics.NoopScope, testDomainID, testDomain, "wf-type"))
+
+	expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 10, nil)
+	assert.Error(t, limiter.Allow(context.Background(), metrics.NoopScope, testDomainID, testDomain, "wf-type"))
+}
+
+func TestOpenWorkflowLimiter_AllowRunningCheck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	limiter := newTestOpenWorkflowLimiter(t, persistence.NewMockVisibilityManager(ctrl), clock.NewMockedTimeSource(), 10, nil)
+
+	assert.True(t, limiter.AllowRunningCheck())
+	assert.False(t, limiter.AllowRunningCheck())
+}
+
+func TestOpenWorkflowLimiter_Usage(t *testing.T) {
+	t.Run("no limits configured", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		limiter := newTestOpenWorkflowLimiter(t, persistence.NewMockVisibilityManager(ctrl), clock.NewMockedTimeSource(), 0, nil)
+
+		usage, err := limiter.Usage(context.Background(), testDomainID, testDomain)
+		assert.NoError(t, err)
+		assert.Nil(t, usage)
+	})
+
+	t.Run("limits configured", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		visibilityManager := persistence.NewMockVisibilityManager(ctrl)
+		limiter := newTestOpenWorkflowLimiter(t, visibilityManager, clock.NewMockedTimeSource(), 100, map[string]interface{}{"b-type": 20, "a-type": 10})
+		expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 42, nil)
+		expectCountOpenWorkflows(visibilityManager, "WorkflowType = 'a-type' and CloseTime = missing", 3, nil)
+		expectCountOpenWorkflows(visibilityManager, "WorkflowType = 'b-type' and CloseTime = missing", 7, nil)
+
+		usage, err := limiter.Usage(context.Background(), testDomainID, testDomain)
+		assert.NoError(t, err)
+		assert.Equal(t, &types.OpenWorkflowUsage{
+			OpenWorkflowCount: 42,
+			OpenWorkflowLimit: 100,
+			WorkflowTypes: []*types.WorkflowTypeOpenWorkflowUsage{
+				{WorkflowType: "a-type", OpenWorkflowCount: 3, OpenWorkflowLimit: 10},
+				{WorkflowType: "b-type", OpenWorkflowCount: 7, OpenWorkflowLimit: 20},
+			},
+		}, usage)
+	})
+
+	t.Run("visibility error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		visibilityManager := persistence.NewMockVisibilityManager(ctrl)
+		limiter := newTestOpenWorkflowLimiter(t, visibilityManager, clock.NewMockedTimeSource(), 100, nil)
+		expectCountOpenWorkflows(visibilityManager, "CloseTime = missing", 0, errors.New("visibility error"))
+
+		usage, err := limiter.Usage(context.Background(), testDomainID, testDomain)
+		assert.Error(t, err)
+		assert.Nil(t, usage)
+	})
+}
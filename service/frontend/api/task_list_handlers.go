@@ -25,6 +25,7 @@ package api
 import (
 	"context"
 
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/frontend/validate"
 )
@@ -53,6 +54,7 @@ func (wh *WorkflowHandler) DescribeTaskList(
 	if err != nil {
 		return nil, err
 	}
+	writeResponseHeaders(ctx, frontend.DescribeTaskListResponseHeaders(response))
 	return response, nil
 }
 
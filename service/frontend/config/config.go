@@ -107,6 +107,8 @@ type Config struct {
 	DomainFailoverRefreshInterval                     dynamicproperties.DurationPropertyFn
 	DomainFailoverRefreshTimerJitterCoefficient       dynamicproperties.FloatPropertyFn
 	EnableActiveClusterSelectionPolicyInStartWorkflow dynamicproperties.BoolPropertyFnWithDomainFilter
+	EnableCompletionCallbacks                         dynamicproperties.BoolPropertyFnWithDomainFilter
+	CompletionCallbackAllowedHosts                    dynamicproperties.ListPropertyFn
 
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicproperties.MapPropertyFn
@@ -122,6 +124,12 @@ type Config struct {
 	// max number of decisions per RespondDecisionTaskCompleted request (unlimited by default)
 	DecisionResultCountLimit dynamicproperties.IntPropertyFnWithDomainFilter
 
+	// max number of concurrently open workflows per domain and per workflow type (unlimited by default)
+	OpenWorkflowLimit                dynamicproperties.IntPropertyFnWithDomainFilter
+	OpenWorkflowLimitPerWorkflowType dynamicproperties.MapPropertyFnWithDomainFilter
+	OpenWorkflowCountCacheTTL        dynamicproperties.DurationPropertyFn
+	OpenWorkflowLimitRunningCheckRPS dynamicproperties.IntPropertyFn
+
 	// Debugging
 
 	// Emit signal related metrics with signal name tag. Be aware of cardinality.
@@ -129,6 +137,10 @@ type Config struct {
 
 	// HostName for machine running the service
 	HostName string
+
+	// IsSQLDefaultStore is set by the service when executions are stored in SQL, which cannot hold completion
+	// callbacks nor the replication exclusion of a workflow
+	IsSQLDefaultStore bool
 }
 
 // NewConfig returns new service config with default values
@@ -191,6 +203,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		DomainFailoverRefreshInterval:                     dc.GetDurationProperty(dynamicproperties.DomainFailoverRefreshInterval),
 		DomainFailoverRefreshTimerJitterCoefficient:       dc.GetFloat64Property(dynamicproperties.DomainFailoverRefreshTimerJitterCoefficient),
 		EnableActiveClusterSelectionPolicyInStartWorkflow: dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableActiveClusterSelectionPolicyInStartWorkflow),
+		EnableCompletionCallbacks:                         dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableCompletionCallbacks),
+		CompletionCallbackAllowedHosts:                    dc.GetListProperty(dynamicproperties.CompletionCallbackAllowedHosts),
 		EnableClientVersionCheck:                          dc.GetBoolProperty(dynamicproperties.EnableClientVersionCheck),
 		EnableQueryAttributeValidation:                    dc.GetBoolProperty(dynamicproperties.EnableQueryAttributeValidation),
 		ValidSearchAttributes:                             dc.GetMapProperty(dynamicproperties.ValidSearchAttributes),
@@ -202,6 +216,10 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		DisallowQuery:                                     dc.GetBoolPropertyFilteredByDomain(dynamicproperties.DisallowQuery),
 		SendRawWorkflowHistory:                            dc.GetBoolPropertyFilteredByDomain(dynamicproperties.SendRawWorkflowHistory),
 		DecisionResultCountLimit:                          dc.GetIntPropertyFilteredByDomain(dynamicproperties.FrontendDecisionResultCountLimit),
+		OpenWorkflowLimit:                                 dc.GetIntPropertyFilteredByDomain(dynamicproperties.FrontendOpenWorkflowLimit),
+		OpenWorkflowLimitPerWorkflowType:                  dc.GetMapPropertyFilteredByDomain(dynamicproperties.FrontendOpenWorkflowLimitPerWorkflowType),
+		OpenWorkflowCountCacheTTL:                         dc.GetDurationProperty(dynamicproperties.FrontendOpenWorkflowCountCacheTTL),
+		OpenWorkflowLimitRunningCheckRPS:                  dc.GetIntProperty(dynamicproperties.FrontendOpenWorkflowLimitRunningCheckRPS),
 		EmitSignalNameMetricsTag:                          dc.GetBoolPropertyFilteredByDomain(dynamicproperties.FrontendEmitSignalNameMetricsTag),
 		Lockdown:                                          dc.GetBoolPropertyFilteredByDomain(dynamicproperties.Lockdown),
 		EnableTasklistIsolation:                           dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableTasklistIsolation),
@@ -46,6 +46,7 @@ func TestNewConfig(t *testing.T) {
 		"NumHistoryShards":                                  {nil, 1001},
 		"IsAdvancedVisConfigExist":                          {nil, true},
 		"HostName":                                          {nil, "hostname"},
+		"IsSQLDefaultStore":                                 {nil, false},
 		"DomainConfig":                                      ignoreField, // Handle this separately since it's also a config object
 		"PersistenceMaxQPS":                                 {dynamicproperties.FrontendPersistenceMaxQPS, 1},
 		"PersistenceGlobalMaxQPS":                           {dynamicproperties.FrontendPersistenceGlobalMaxQPS, 2},
@@ -92,6 +93,8 @@ func TestNewConfig(t *testing.T) {
 		"DomainFailoverRefreshInterval":                     {dynamicproperties.DomainFailoverRefreshInterval, time.Duration(33)},
 		"DomainFailoverRefreshTimerJitterCoefficient":       {dynamicproperties.DomainFailoverRefreshTimerJitterCoefficient, 34.0},
 		"EnableActiveClusterSelectionPolicyInStartWorkflow": {dynamicproperties.EnableActiveClusterSelectionPolicyInStartWorkflow, true},
+		"EnableCompletionCallbacks":                         {dynamicproperties.EnableCompletionCallbacks, true},
+		"CompletionCallbackAllowedHosts":                    {dynamicproperties.CompletionCallbackAllowedHosts, []interface{}{"hooks.example.com"}},
 		"EnableClientVersionCheck":                          {dynamicproperties.EnableClientVersionCheck, true},
 		"EnableQueryAttributeValidation":                    {dynamicproperties.EnableQueryAttributeValidation, false},
 		"ValidSearchAttributes":                             {dynamicproperties.ValidSearchAttributes, map[string]interface{}{"foo": "bar"}},
@@ -102,6 +105,10 @@ func TestNewConfig(t *testing.T) {
 		"DisallowQuery":                                     {dynamicproperties.DisallowQuery, true},
 		"SendRawWorkflowHistory":                            {dynamicproperties.SendRawWorkflowHistory, false},
 		"DecisionResultCountLimit":                          {dynamicproperties.FrontendDecisionResultCountLimit, 39},
+		"OpenWorkflowLimit":                                 {dynamicproperties.FrontendOpenWorkflowLimit, 47},
+		"OpenWorkflowLimitPerWorkflowType":                  {dynamicproperties.FrontendOpenWorkflowLimitPerWorkflowType, map[string]interface{}{"wf": 48}},
+		"OpenWorkflowCountCacheTTL":                         {dynamicproperties.FrontendOpenWorkflowCountCacheTTL, time.Duration(49)},
+		"OpenWorkflowLimitRunningCheckRPS":                  {dynamicproperties.FrontendOpenWorkflowLimitRunningCheckRPS, 50},
 		"EmitSignalNameMetricsTag":                          {dynamicproperties.FrontendEmitSignalNameMetricsTag, true},
 		"Lockdown":                                          {dynamicproperties.Lockdown, false},
 		"EnableTasklistIsolation":                           {dynamicproperties.EnableTasklistIsolation, true},
@@ -126,6 +133,7 @@ func TestNewConfig(t *testing.T) {
 		"FailoverHistoryMaxSize":      {dynamicproperties.FrontendFailoverHistoryMaxSize, 44},
 		"MaxFailoverTimeoutInSeconds": {dynamicproperties.FrontendMaxFailoverTimeoutInSeconds, 45},
 		"EnableDomainAuditLogging":    {dynamicproperties.EnableDomainAuditLogging, true},
+		"IsSQLDefaultStore":           {nil, false},
 	}
 	client := dynamicconfig.NewInMemoryClient()
 	logger := testlogger.New(t)
@@ -184,6 +192,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicproperties.MapPropertyFn:
 			return fn()
+		case dynamicproperties.MapPropertyFnWithDomainFilter:
+			return fn("domain")
 		case dynamicproperties.StringPropertyFn:
 			return fn()
 		case dynamicproperties.StringPropertyWithRatelimitKeyFilter:
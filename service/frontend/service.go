@@ -30,6 +30,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/client"
+	commonConfig "github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log/tag"
@@ -46,6 +47,7 @@ import (
 	"github.com/uber/cadence/service/frontend/wrappers/grpc"
 	"github.com/uber/cadence/service/frontend/wrappers/metered"
 	"github.com/uber/cadence/service/frontend/wrappers/ratelimited"
+	"github.com/uber/cadence/service/frontend/wrappers/rest"
 	"github.com/uber/cadence/service/frontend/wrappers/thrift"
 	"github.com/uber/cadence/service/frontend/wrappers/versioncheck"
 )
@@ -76,6 +78,8 @@ func NewService(
 		params.HostName,
 		params.Logger,
 	)
+	serviceConfig.IsSQLDefaultStore = params.PersistenceConfig.DefaultStoreType() == commonConfig.StoreTypeSQL
+	serviceConfig.DomainConfig.IsSQLDefaultStore = serviceConfig.IsSQLDefaultStore
 
 	serviceResource, err := resource.New(
 		params,
@@ -167,6 +171,11 @@ func (s *Service) Start() {
 	grpcHandler := grpc.NewAPIHandler(handler)
 	grpcHandler.Register(s.GetDispatcher())
 
+	if restInbound := s.params.RPCFactory.GetRESTInbound(); restInbound != nil {
+		restHandler := rest.NewAPIHandler(handler)
+		restHandler.Register(restInbound)
+	}
+
 	s.adminHandler = admin.NewHandler(s, s.params, s.config, dh)
 	s.adminHandler = accesscontrolled.NewAdminHandler(s.adminHandler, s, s.params.Authorizer, s.params.AuthorizationConfig)
 
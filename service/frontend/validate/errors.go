@@ -59,6 +59,11 @@ var (
 	ErrDomainInLockdown                           = &types.BadRequestError{Message: "Domain is not accepting fail overs at this time due to lockdown."}
 	ErrShuttingDown                               = &types.InternalServiceError{Message: "Shutting down"}
 
+	// Err for workflow ID conflict policy
+	ErrInvalidWorkflowIDConflictPolicy      = &types.BadRequestError{Message: "WorkflowIDConflictPolicy is invalid."}
+	ErrSignalExistingRequiresSignal         = &types.BadRequestError{Message: "WorkflowIDConflictPolicy SignalExisting is only supported by SignalWithStartWorkflowExecution."}
+	ErrConflictPolicyWithTerminateIfRunning = &types.BadRequestError{Message: "WorkflowIDConflictPolicy cannot be combined with WorkflowIDReusePolicy TerminateIfRunning."}
+
 	// Err for archival
 	ErrHistoryNotFound = &types.BadRequestError{Message: "Requested workflow history not found, may have passed retention period."}
 
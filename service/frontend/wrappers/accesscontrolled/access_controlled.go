@@ -33,13 +33,17 @@ import (
 
 var errUnauthorized = &types.AccessDeniedError{Message: "Request unauthorized."}
 
-func (a *adminHandler) isAuthorized(ctx context.Context, attr *authorization.Attributes) (bool, error) {
+// isAuthorized returns ctx carrying the authenticated caller, so admin APIs can record who made a change
+func (a *adminHandler) isAuthorized(ctx context.Context, attr *authorization.Attributes) (context.Context, bool, error) {
 	result, err := a.authorizer.Authorize(ctx, attr)
 	if err != nil {
-		return false, err
+		return ctx, false, err
+	}
+	if result.DryRunDenied {
+		a.GetMetricsClient().IncCounter(metrics.AdminAuthorizationScope, metrics.CadenceAuthorizationDryRunDeniedCounter)
 	}
 	isAuth := result.Decision == authorization.DecisionAllow
-	return isAuth, nil
+	return authorization.WithAuthenticatedActor(ctx, result.Actor), isAuth, nil
 }
 
 func (a *apiHandler) isAuthorized(
@@ -59,6 +63,9 @@ func (a *apiHandler) isAuthorized(
 		scope.IncCounter(metrics.CadenceErrAuthorizeFailedCounter)
 		return false, err
 	}
+	if result.DryRunDenied {
+		scope.IncCounter(metrics.CadenceAuthorizationDryRunDeniedCounter)
+	}
 	isAuth := result.Decision == authorization.DecisionAllow
 	if !isAuth {
 		scope.IncCounter(metrics.CadenceErrUnauthorizedCounter)
@@ -121,6 +121,17 @@ func TestIsAuthorized(t *testing.T) {
 			isAuthorized: false,
 			wantErr:      false,
 		},
+		{
+			name: "Dry run case - would be denied",
+			mockSetup: func(authorizer *authorization.MockAuthorizer, scope *mocks.Scope) {
+				authorizer.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(authorization.Result{Decision: authorization.DecisionAllow, DryRunDenied: true}, nil)
+				scope.On("StartTimer", metrics.CadenceAuthorizationLatency).Return(metrics.NewTestStopwatch()).Once()
+				scope.On("ExponentialHistogram", metrics.CadenceAuthorizationLatencyHistogram, mock.AnythingOfType("time.Duration")).Return().Once()
+				scope.On("IncCounter", metrics.CadenceAuthorizationDryRunDeniedCounter).Return().Once()
+			},
+			isAuthorized: true,
+			wantErr:      false,
+		},
 		{
 			name: "Error case - authorization error",
 			mockSetup: func(authorizer *authorization.MockAuthorizer, scope *mocks.Scope) {
@@ -204,3 +215,37 @@ func TestDescribeCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminHandlerPassesAuthenticatedActor(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockAuthorizer := authorization.NewMockAuthorizer(controller)
+	mockAdminHandler := admin.NewMockHandler(controller)
+
+	mockAuthorizer.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(authorization.Result{Decision: authorization.DecisionAllow, Actor: "alice"}, nil)
+	mockAdminHandler.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *types.UpdateDynamicConfigRequest) error {
+			assert.Equal(t, "alice", authorization.AuthenticatedActor(ctx))
+			return nil
+		})
+
+	handler := &adminHandler{authorizer: mockAuthorizer, handler: mockAdminHandler}
+	err := handler.UpdateDynamicConfig(context.Background(), &types.UpdateDynamicConfigRequest{})
+	assert.NoError(t, err)
+}
+
+func TestAdminHandlerCountsDryRunDenied(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockAuthorizer := authorization.NewMockAuthorizer(controller)
+	mockAdminHandler := admin.NewMockHandler(controller)
+	mockMetricsClient := mocks.NewClient(t)
+	mockResource := resource.NewMockResource(controller)
+	mockResource.EXPECT().GetMetricsClient().Return(mockMetricsClient)
+
+	mockAuthorizer.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(authorization.Result{Decision: authorization.DecisionAllow, DryRunDenied: true}, nil)
+	mockAdminHandler.EXPECT().DescribeCluster(gomock.Any()).Return(&types.DescribeClusterResponse{}, nil)
+	mockMetricsClient.EXPECT().IncCounter(metrics.AdminAuthorizationScope, metrics.CadenceAuthorizationDryRunDeniedCounter).Return().Once()
+
+	handler := &adminHandler{authorizer: mockAuthorizer, handler: mockAdminHandler, Resource: mockResource}
+	_, err := handler.DescribeCluster(context.Background())
+	assert.NoError(t, err)
+}
@@ -44,7 +44,7 @@ func (a *adminHandler) AddSearchAttribute(ctx context.Context, ap1 *types.AddSea
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(ap1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -60,7 +60,7 @@ func (a *adminHandler) CloseShard(ctx context.Context, cp1 *types.CloseShardRequ
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(cp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -76,7 +76,7 @@ func (a *adminHandler) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQ
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(cp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -86,13 +86,29 @@ func (a *adminHandler) CountDLQMessages(ctx context.Context, cp1 *types.CountDLQ
 	return a.handler.CountDLQMessages(ctx, cp1)
 }
 
+func (a *adminHandler) DeleteTaskListBacklogTasks(ctx context.Context, dp1 *types.DeleteTaskListBacklogTasksRequest) (dp2 *types.DeleteTaskListBacklogTasksResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DeleteTaskListBacklogTasks",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DeleteTaskListBacklogTasks(ctx, dp1)
+}
+
 func (a *adminHandler) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteWorkflowRequest) (ap2 *types.AdminDeleteWorkflowResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "DeleteWorkflow",
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(ap1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +123,7 @@ func (a *adminHandler) DescribeCluster(ctx context.Context) (dp1 *types.Describe
 		APIName:    "DescribeCluster",
 		Permission: authorization.PermissionRead,
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +139,7 @@ func (a *adminHandler) DescribeHistoryHost(ctx context.Context, dp1 *types.Descr
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(dp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +155,7 @@ func (a *adminHandler) DescribeQueue(ctx context.Context, dp1 *types.DescribeQue
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(dp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -149,13 +165,29 @@ func (a *adminHandler) DescribeQueue(ctx context.Context, dp1 *types.DescribeQue
 	return a.handler.DescribeQueue(ctx, dp1)
 }
 
+func (a *adminHandler) DescribeReplicationStatus(ctx context.Context, dp1 *types.DescribeReplicationStatusRequest) (dp2 *types.DescribeReplicationStatusResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DescribeReplicationStatus",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeReplicationStatus(ctx, dp1)
+}
+
 func (a *adminHandler) DescribeShardDistribution(ctx context.Context, dp1 *types.DescribeShardDistributionRequest) (dp2 *types.DescribeShardDistributionResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "DescribeShardDistribution",
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(dp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +203,7 @@ func (a *adminHandler) DescribeWorkflowExecution(ctx context.Context, ap1 *types
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(ap1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -181,13 +213,29 @@ func (a *adminHandler) DescribeWorkflowExecution(ctx context.Context, ap1 *types
 	return a.handler.DescribeWorkflowExecution(ctx, ap1)
 }
 
+func (a *adminHandler) DiffDynamicConfigVersions(ctx context.Context, dp1 *types.DiffDynamicConfigVersionsRequest) (dp2 *types.DiffDynamicConfigVersionsResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DiffDynamicConfigVersions",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DiffDynamicConfigVersions(ctx, dp1)
+}
+
 func (a *adminHandler) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "GetCrossClusterTasks",
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(gp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +251,7 @@ func (a *adminHandler) GetDLQReplicationMessages(ctx context.Context, gp1 *types
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(gp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +267,7 @@ func (a *adminHandler) GetDomainAsyncWorkflowConfiguraton(ctx context.Context, g
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(gp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +283,7 @@ func (a *adminHandler) GetDomainIsolationGroups(ctx context.Context, request *ty
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(request),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +299,7 @@ func (a *adminHandler) GetDomainReplicationMessages(ctx context.Context, gp1 *ty
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(gp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +315,7 @@ func (a *adminHandler) GetDynamicConfig(ctx context.Context, gp1 *types.GetDynam
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(gp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -283,7 +331,7 @@ func (a *adminHandler) GetGlobalIsolationGroups(ctx context.Context, request *ty
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(request),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -299,7 +347,7 @@ func (a *adminHandler) GetOperationalDynamicConfig(ctx context.Context, gp1 *typ
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(gp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -315,7 +363,7 @@ func (a *adminHandler) GetReplicationMessages(ctx context.Context, gp1 *types.Ge
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(gp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -331,7 +379,7 @@ func (a *adminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, gp1
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(gp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -347,7 +395,7 @@ func (a *adminHandler) ListDynamicConfig(ctx context.Context, lp1 *types.ListDyn
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(lp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -357,13 +405,29 @@ func (a *adminHandler) ListDynamicConfig(ctx context.Context, lp1 *types.ListDyn
 	return a.handler.ListDynamicConfig(ctx, lp1)
 }
 
+func (a *adminHandler) ListDynamicConfigVersions(ctx context.Context, lp1 *types.ListDynamicConfigVersionsRequest) (lp2 *types.ListDynamicConfigVersionsResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ListDynamicConfigVersions",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(lp1),
+	}
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListDynamicConfigVersions(ctx, lp1)
+}
+
 func (a *adminHandler) ListOperationalDynamicConfig(ctx context.Context, lp1 *types.ListOperationalDynamicConfigRequest) (lp2 *types.ListOperationalDynamicConfigResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "ListOperationalDynamicConfig",
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(lp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -379,7 +443,7 @@ func (a *adminHandler) MaintainCorruptWorkflow(ctx context.Context, ap1 *types.A
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(ap1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -395,7 +459,7 @@ func (a *adminHandler) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQ
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(mp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -405,13 +469,29 @@ func (a *adminHandler) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQ
 	return a.handler.MergeDLQMessages(ctx, mp1)
 }
 
+func (a *adminHandler) MoveTaskListBacklog(ctx context.Context, mp1 *types.MoveTaskListBacklogRequest) (mp2 *types.MoveTaskListBacklogResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "MoveTaskListBacklog",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(mp1),
+	}
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.MoveTaskListBacklog(ctx, mp1)
+}
+
 func (a *adminHandler) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "PurgeDLQMessages",
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(pp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -427,7 +507,7 @@ func (a *adminHandler) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMe
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(rp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -443,7 +523,7 @@ func (a *adminHandler) ReapplyEvents(ctx context.Context, rp1 *types.ReapplyEven
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(rp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -459,7 +539,7 @@ func (a *adminHandler) RefreshWorkflowTasks(ctx context.Context, rp1 *types.Refr
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(rp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -475,7 +555,7 @@ func (a *adminHandler) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskRequ
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(rp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -491,7 +571,7 @@ func (a *adminHandler) ResendReplicationTasks(ctx context.Context, rp1 *types.Re
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(rp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -507,7 +587,7 @@ func (a *adminHandler) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequ
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(rp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -517,13 +597,29 @@ func (a *adminHandler) ResetQueue(ctx context.Context, rp1 *types.ResetQueueRequ
 	return a.handler.ResetQueue(ctx, rp1)
 }
 
+func (a *adminHandler) ResolveDynamicConfig(ctx context.Context, rp1 *types.ResolveDynamicConfigRequest) (rp2 *types.ResolveDynamicConfigResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ResolveDynamicConfig",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ResolveDynamicConfig(ctx, rp1)
+}
+
 func (a *adminHandler) RespondCrossClusterTasksCompleted(ctx context.Context, rp1 *types.RespondCrossClusterTasksCompletedRequest) (rp2 *types.RespondCrossClusterTasksCompletedResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "RespondCrossClusterTasksCompleted",
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(rp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -539,7 +635,7 @@ func (a *adminHandler) RestoreDynamicConfig(ctx context.Context, rp1 *types.Rest
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(rp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -555,7 +651,7 @@ func (a *adminHandler) RestoreOperationalDynamicConfig(ctx context.Context, rp1
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(rp1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -565,6 +661,22 @@ func (a *adminHandler) RestoreOperationalDynamicConfig(ctx context.Context, rp1
 	return a.handler.RestoreOperationalDynamicConfig(ctx, rp1)
 }
 
+func (a *adminHandler) RollbackDynamicConfig(ctx context.Context, rp1 *types.RollbackDynamicConfigRequest) (rp2 *types.RollbackDynamicConfigResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "RollbackDynamicConfig",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.RollbackDynamicConfig(ctx, rp1)
+}
+
 func (a *adminHandler) Start() {
 	a.handler.Start()
 }
@@ -579,7 +691,7 @@ func (a *adminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(up1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -595,7 +707,7 @@ func (a *adminHandler) UpdateDomainIsolationGroups(ctx context.Context, request
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(request),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -611,7 +723,7 @@ func (a *adminHandler) UpdateDynamicConfig(ctx context.Context, up1 *types.Updat
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(up1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -627,7 +739,7 @@ func (a *adminHandler) UpdateGlobalIsolationGroups(ctx context.Context, request
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(request),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
@@ -643,7 +755,7 @@ func (a *adminHandler) UpdateOperationalDynamicConfig(ctx context.Context, up1 *
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(up1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
 	}
@@ -659,7 +771,7 @@ func (a *adminHandler) UpdateTaskListPartitionConfig(ctx context.Context, up1 *t
 		Permission:  authorization.PermissionAdmin,
 		RequestBody: authorization.NewFilteredRequestBody(up1),
 	}
-	isAuthorized, err := a.isAuthorized(ctx, attr)
+	ctx, isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
 	}
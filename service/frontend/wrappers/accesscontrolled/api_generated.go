@@ -145,6 +145,24 @@ func (a *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDo
 	return a.handler.DeprecateDomain(ctx, dp1)
 }
 
+func (a *apiHandler) DescribeBatchOperation(ctx context.Context, dp1 *types.DescribeBatchOperationRequest) (dp2 *types.DescribeBatchOperationResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeBatchOperationScope, dp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "DescribeBatchOperation",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+		DomainName:  dp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeBatchOperation(ctx, dp1)
+}
+
 func (a *apiHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendDescribeDomainScope).Tagged(metrics.NonDomainTag())
 	attr := &authorization.Attributes{
@@ -305,6 +323,24 @@ func (a *apiHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *ty
 	return a.handler.ListArchivedWorkflowExecutions(ctx, lp1)
 }
 
+func (a *apiHandler) ListBatchOperations(ctx context.Context, lp1 *types.ListBatchOperationsRequest) (lp2 *types.ListBatchOperationsResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListBatchOperationsScope, lp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ListBatchOperations",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(lp1),
+		DomainName:  lp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListBatchOperations(ctx, lp1)
+}
+
 func (a *apiHandler) ListClosedWorkflowExecutions(ctx context.Context, lp1 *types.ListClosedWorkflowExecutionsRequest) (lp2 *types.ListClosedWorkflowExecutionsResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendListClosedWorkflowExecutionsScope, lp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -690,6 +726,24 @@ func (a *apiHandler) SignalWorkflowExecution(ctx context.Context, sp1 *types.Sig
 	return a.handler.SignalWorkflowExecution(ctx, sp1)
 }
 
+func (a *apiHandler) StartBatchOperation(ctx context.Context, sp1 *types.StartBatchOperationRequest) (sp2 *types.StartBatchOperationResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendStartBatchOperationScope, sp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "StartBatchOperation",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(sp1),
+		DomainName:  sp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.StartBatchOperation(ctx, sp1)
+}
+
 func (a *apiHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendStartWorkflowExecutionScope, sp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -730,6 +784,24 @@ func (a *apiHandler) StartWorkflowExecutionAsync(ctx context.Context, sp1 *types
 	return a.handler.StartWorkflowExecutionAsync(ctx, sp1)
 }
 
+func (a *apiHandler) StopBatchOperation(ctx context.Context, sp1 *types.StopBatchOperationRequest) (sp2 *types.StopBatchOperationResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendStopBatchOperationScope, sp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "StopBatchOperation",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(sp1),
+		DomainName:  sp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.StopBatchOperation(ctx, sp1)
+}
+
 func (a *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.TerminateWorkflowExecutionRequest) (err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendTerminateWorkflowExecutionScope, tp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -456,11 +456,11 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) activeClusterForActi
 		}
 		// if current workflow is still running, use the policy for the current workflow
 		if running {
-			requestedActiveClusterSelectionPolicy = existingActiveClusterSelectionPolicy
+			return policy.activeClusterByClusterAttribute(ctx, domainEntry, existingActiveClusterSelectionPolicy, apiName)
 		}
-		return policy.activeClusterByClusterAttribute(ctx, domainEntry, requestedActiveClusterSelectionPolicy, apiName)
+		return policy.activeClusterForNewWorkflow(ctx, domainEntry, workflowExecution, requestedActiveClusterSelectionPolicy, apiName)
 	} else if apiName == "StartWorkflowExecution" {
-		return policy.activeClusterByClusterAttribute(ctx, domainEntry, requestedActiveClusterSelectionPolicy, apiName)
+		return policy.activeClusterForNewWorkflow(ctx, domainEntry, workflowExecution, requestedActiveClusterSelectionPolicy, apiName)
 	}
 
 	if workflowExecution == nil || workflowExecution.WorkflowID == "" {
@@ -478,6 +478,24 @@ func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) activeClusterForActi
 	return activeClusterInfo.ActiveClusterName
 }
 
+func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) activeClusterForNewWorkflow(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	workflowExecution *types.WorkflowExecution,
+	requestedActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy,
+	apiName string,
+) string {
+	if requestedActiveClusterSelectionPolicy.GetWorkflowIDHash() != nil && workflowExecution != nil {
+		resolvedPolicy, err := policy.activeClusterManager.ResolveActiveClusterSelectionPolicy(ctx, domainEntry.GetInfo().ID, workflowExecution.WorkflowID, requestedActiveClusterSelectionPolicy)
+		if err != nil {
+			policy.logger.Error("Failed to resolve active cluster selection policy, using current cluster", tag.WorkflowDomainName(domainEntry.GetInfo().Name), tag.WorkflowID(workflowExecution.WorkflowID), tag.OperationName(apiName), tag.Error(err))
+			return policy.currentClusterName
+		}
+		requestedActiveClusterSelectionPolicy = resolvedPolicy
+	}
+	return policy.activeClusterByClusterAttribute(ctx, domainEntry, requestedActiveClusterSelectionPolicy, apiName)
+}
+
 func (policy *selectedOrAllAPIsForwardingRedirectionPolicy) activeClusterByClusterAttribute(ctx context.Context, domainEntry *cache.DomainCacheEntry, requestedActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy, apiName string) string {
 	policy.logger.Debug("Active cluster selection policy by cluster attribute", tag.WorkflowDomainName(domainEntry.GetInfo().Name), tag.OperationName(apiName), tag.Dynamic("policy", requestedActiveClusterSelectionPolicy))
 	activeClusterInfo, err := policy.activeClusterManager.GetActiveClusterInfoByClusterAttribute(ctx, domainEntry.GetInfo().ID, requestedActiveClusterSelectionPolicy.GetClusterAttribute())
@@ -615,6 +615,12 @@ func (s *selectedAPIsForwardingRedirectionPolicySuite) TestActiveClusterForActiv
 		},
 	}
 
+	workflowIDHashPlcy := &types.ActiveClusterSelectionPolicy{
+		WorkflowIDHash: &types.WorkflowIDHashPolicy{
+			Scope: "region",
+		},
+	}
+
 	tests := []struct {
 		name                   string
 		apiName                string
@@ -624,6 +630,39 @@ func (s *selectedAPIsForwardingRedirectionPolicySuite) TestActiveClusterForActiv
 		mockFn                 func(activeClusterManager *activecluster.MockManager)
 		want                   string
 	}{
+		{
+			name:                   "new workflow with workflow ID hash policy",
+			apiName:                "StartWorkflowExecution",
+			domainEntry:            domainEntry,
+			actClSelPolicyForNewWF: workflowIDHashPlcy,
+			workflowExecution: &types.WorkflowExecution{
+				WorkflowID: "wf1",
+			},
+			mockFn: func(activeClusterManager *activecluster.MockManager) {
+				activeClusterManager.EXPECT().ResolveActiveClusterSelectionPolicy(gomock.Any(), domainEntry.GetInfo().ID, "wf1", workflowIDHashPlcy).Return(&types.ActiveClusterSelectionPolicy{
+					ClusterAttribute: usWestStickyPlcy.GetClusterAttribute(),
+					WorkflowIDHash:   workflowIDHashPlcy.GetWorkflowIDHash(),
+				}, nil)
+				activeClusterManager.EXPECT().GetActiveClusterInfoByClusterAttribute(gomock.Any(), domainEntry.GetInfo().ID, usWestStickyPlcy.GetClusterAttribute()).Return(&types.ActiveClusterInfo{
+					ActiveClusterName: s.alternativeClusterName,
+					FailoverVersion:   2,
+				}, nil)
+			},
+			want: s.alternativeClusterName,
+		},
+		{
+			name:                   "new workflow with workflow ID hash policy - resolve failed",
+			apiName:                "StartWorkflowExecution",
+			domainEntry:            domainEntry,
+			actClSelPolicyForNewWF: workflowIDHashPlcy,
+			workflowExecution: &types.WorkflowExecution{
+				WorkflowID: "wf1",
+			},
+			mockFn: func(activeClusterManager *activecluster.MockManager) {
+				activeClusterManager.EXPECT().ResolveActiveClusterSelectionPolicy(gomock.Any(), domainEntry.GetInfo().ID, "wf1", workflowIDHashPlcy).Return(nil, errors.New("resolve failed"))
+			},
+			want: s.currentClusterName,
+		},
 		{
 			name:                   "new workflow with policy",
 			apiName:                "StartWorkflowExecution",
@@ -9,6 +9,7 @@ import (
 
 	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
 
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	"github.com/uber/cadence/common/types/mapper/proto"
 	_sourceAdmin "github.com/uber/cadence/service/frontend/admin"
 )
@@ -36,6 +37,11 @@ func (g AdminHandler) CountDLQMessages(ctx context.Context, request *adminv1.Cou
 	return proto.FromAdminCountDLQMessagesResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) DeleteTaskListBacklogTasks(ctx context.Context, request *frontendv1.DeleteTaskListBacklogTasksRequest) (*frontendv1.DeleteTaskListBacklogTasksResponse, error) {
+	response, err := g.h.DeleteTaskListBacklogTasks(ctx, proto.ToAdminDeleteTaskListBacklogTasksRequest(request))
+	return proto.FromAdminDeleteTaskListBacklogTasksResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) DeleteWorkflow(ctx context.Context, request *adminv1.DeleteWorkflowRequest) (*adminv1.DeleteWorkflowResponse, error) {
 	response, err := g.h.DeleteWorkflow(ctx, proto.ToAdminDeleteWorkflowRequest(request))
 	return proto.FromAdminDeleteWorkflowResponse(response), proto.FromError(err)
@@ -56,6 +62,11 @@ func (g AdminHandler) DescribeQueue(ctx context.Context, request *adminv1.Descri
 	return proto.FromAdminDescribeQueueResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) DescribeReplicationStatus(ctx context.Context, request *frontendv1.DescribeReplicationStatusRequest) (*frontendv1.DescribeReplicationStatusResponse, error) {
+	response, err := g.h.DescribeReplicationStatus(ctx, proto.ToAdminDescribeReplicationStatusRequest(request))
+	return proto.FromAdminDescribeReplicationStatusResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) DescribeShardDistribution(ctx context.Context, request *adminv1.DescribeShardDistributionRequest) (*adminv1.DescribeShardDistributionResponse, error) {
 	response, err := g.h.DescribeShardDistribution(ctx, proto.ToAdminDescribeShardDistributionRequest(request))
 	return proto.FromAdminDescribeShardDistributionResponse(response), proto.FromError(err)
@@ -66,6 +77,11 @@ func (g AdminHandler) DescribeWorkflowExecution(ctx context.Context, request *ad
 	return proto.FromAdminDescribeWorkflowExecutionResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) DiffDynamicConfigVersions(ctx context.Context, request *frontendv1.DiffDynamicConfigVersionsRequest) (*frontendv1.DiffDynamicConfigVersionsResponse, error) {
+	response, err := g.h.DiffDynamicConfigVersions(ctx, proto.ToAdminDiffDynamicConfigVersionsRequest(request))
+	return proto.FromAdminDiffDynamicConfigVersionsResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) GetCrossClusterTasks(ctx context.Context, request *adminv1.GetCrossClusterTasksRequest) (*adminv1.GetCrossClusterTasksResponse, error) {
 	response, err := g.h.GetCrossClusterTasks(ctx, proto.ToAdminGetCrossClusterTasksRequest(request))
 	return proto.FromAdminGetCrossClusterTasksResponse(response), proto.FromError(err)
@@ -121,6 +137,11 @@ func (g AdminHandler) ListDynamicConfig(ctx context.Context, request *adminv1.Li
 	return proto.FromAdminListDynamicConfigResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) ListDynamicConfigVersions(ctx context.Context, request *frontendv1.ListDynamicConfigVersionsRequest) (*frontendv1.ListDynamicConfigVersionsResponse, error) {
+	response, err := g.h.ListDynamicConfigVersions(ctx, proto.ToAdminListDynamicConfigVersionsRequest(request))
+	return proto.FromAdminListDynamicConfigVersionsResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) ListOperationalDynamicConfig(ctx context.Context, request *adminv1.ListOperationalDynamicConfigRequest) (*adminv1.ListOperationalDynamicConfigResponse, error) {
 	response, err := g.h.ListOperationalDynamicConfig(ctx, proto.ToAdminListOperationalDynamicConfigRequest(request))
 	return proto.FromAdminListOperationalDynamicConfigResponse(response), proto.FromError(err)
@@ -136,6 +157,11 @@ func (g AdminHandler) MergeDLQMessages(ctx context.Context, request *adminv1.Mer
 	return proto.FromAdminMergeDLQMessagesResponse(response), proto.FromError(err)
 }
 
+func (g AdminHandler) MoveTaskListBacklog(ctx context.Context, request *frontendv1.MoveTaskListBacklogRequest) (*frontendv1.MoveTaskListBacklogResponse, error) {
+	response, err := g.h.MoveTaskListBacklog(ctx, proto.ToAdminMoveTaskListBacklogRequest(request))
+	return proto.FromAdminMoveTaskListBacklogResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) PurgeDLQMessages(ctx context.Context, request *adminv1.PurgeDLQMessagesRequest) (*adminv1.PurgeDLQMessagesResponse, error) {
 	err := g.h.PurgeDLQMessages(ctx, proto.ToAdminPurgeDLQMessagesRequest(request))
 	return &adminv1.PurgeDLQMessagesResponse{}, proto.FromError(err)
@@ -171,6 +197,11 @@ func (g AdminHandler) ResetQueue(ctx context.Context, request *adminv1.ResetQueu
 	return &adminv1.ResetQueueResponse{}, proto.FromError(err)
 }
 
+func (g AdminHandler) ResolveDynamicConfig(ctx context.Context, request *frontendv1.ResolveDynamicConfigRequest) (*frontendv1.ResolveDynamicConfigResponse, error) {
+	response, err := g.h.ResolveDynamicConfig(ctx, proto.ToAdminResolveDynamicConfigRequest(request))
+	return proto.FromAdminResolveDynamicConfigResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) RespondCrossClusterTasksCompleted(ctx context.Context, request *adminv1.RespondCrossClusterTasksCompletedRequest) (*adminv1.RespondCrossClusterTasksCompletedResponse, error) {
 	response, err := g.h.RespondCrossClusterTasksCompleted(ctx, proto.ToAdminRespondCrossClusterTasksCompletedRequest(request))
 	return proto.FromAdminRespondCrossClusterTasksCompletedResponse(response), proto.FromError(err)
@@ -186,6 +217,11 @@ func (g AdminHandler) RestoreOperationalDynamicConfig(ctx context.Context, reque
 	return &adminv1.RestoreOperationalDynamicConfigResponse{}, proto.FromError(err)
 }
 
+func (g AdminHandler) RollbackDynamicConfig(ctx context.Context, request *frontendv1.RollbackDynamicConfigRequest) (*frontendv1.RollbackDynamicConfigResponse, error) {
+	response, err := g.h.RollbackDynamicConfig(ctx, proto.ToAdminRollbackDynamicConfigRequest(request))
+	return proto.FromAdminRollbackDynamicConfigResponse(response), proto.FromError(err)
+}
+
 func (g AdminHandler) UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *adminv1.UpdateDomainAsyncWorkflowConfiguratonRequest) (*adminv1.UpdateDomainAsyncWorkflowConfiguratonResponse, error) {
 	response, err := g.h.UpdateDomainAsyncWorkflowConfiguraton(ctx, proto.ToAdminUpdateDomainAsyncWorkflowConfiguratonRequest(request))
 	return proto.FromAdminUpdateDomainAsyncWorkflowConfiguratonResponse(response), proto.FromError(err)
@@ -9,6 +9,7 @@ import (
 
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	"github.com/uber/cadence/common/types/mapper/proto"
 	_sourceApi "github.com/uber/cadence/service/frontend/api"
 )
@@ -51,6 +52,11 @@ func (g APIHandler) DeprecateDomain(ctx context.Context, request *apiv1.Deprecat
 	return &apiv1.DeprecateDomainResponse{}, proto.FromError(err)
 }
 
+func (g APIHandler) DescribeBatchOperation(ctx context.Context, request *frontendv1.DescribeBatchOperationRequest) (*frontendv1.DescribeBatchOperationResponse, error) {
+	response, err := g.h.DescribeBatchOperation(ctx, proto.ToDescribeBatchOperationRequest(request))
+	return proto.FromDescribeBatchOperationResponse(response), proto.FromError(err)
+}
+
 func (g APIHandler) DescribeDomain(ctx context.Context, request *apiv1.DescribeDomainRequest) (*apiv1.DescribeDomainResponse, error) {
 	response, err := g.h.DescribeDomain(ctx, proto.ToDescribeDomainRequest(request))
 	return proto.FromDescribeDomainResponse(response), proto.FromError(err)
@@ -106,6 +112,11 @@ func (g APIHandler) ListArchivedWorkflowExecutions(ctx context.Context, request
 	return proto.FromListArchivedWorkflowExecutionsResponse(response), proto.FromError(err)
 }
 
+func (g APIHandler) ListBatchOperations(ctx context.Context, request *frontendv1.ListBatchOperationsRequest) (*frontendv1.ListBatchOperationsResponse, error) {
+	response, err := g.h.ListBatchOperations(ctx, proto.ToListBatchOperationsRequest(request))
+	return proto.FromListBatchOperationsResponse(response), proto.FromError(err)
+}
+
 func (g APIHandler) ListClosedWorkflowExecutions(ctx context.Context, request *apiv1.ListClosedWorkflowExecutionsRequest) (*apiv1.ListClosedWorkflowExecutionsResponse, error) {
 	response, err := g.h.ListClosedWorkflowExecutions(ctx, proto.ToListClosedWorkflowExecutionsRequest(request))
 	return proto.FromListClosedWorkflowExecutionsResponse(response), proto.FromError(err)
@@ -251,11 +262,6 @@ func (g APIHandler) ScanWorkflowExecutions(ctx context.Context, request *apiv1.S
 	return proto.FromScanWorkflowExecutionsResponse(response), proto.FromError(err)
 }
 
-func (g APIHandler) SignalWithStartWorkflowExecution(ctx context.Context, request *apiv1.SignalWithStartWorkflowExecutionRequest) (*apiv1.SignalWithStartWorkflowExecutionResponse, error) {
-	response, err := g.h.SignalWithStartWorkflowExecution(ctx, proto.ToSignalWithStartWorkflowExecutionRequest(request))
-	return proto.FromSignalWithStartWorkflowExecutionResponse(response), proto.FromError(err)
-}
-
 func (g APIHandler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, request *apiv1.SignalWithStartWorkflowExecutionAsyncRequest) (*apiv1.SignalWithStartWorkflowExecutionAsyncResponse, error) {
 	response, err := g.h.SignalWithStartWorkflowExecutionAsync(ctx, proto.ToSignalWithStartWorkflowExecutionAsyncRequest(request))
 	return proto.FromSignalWithStartWorkflowExecutionAsyncResponse(response), proto.FromError(err)
@@ -266,9 +272,9 @@ func (g APIHandler) SignalWorkflowExecution(ctx context.Context, request *apiv1.
 	return &apiv1.SignalWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
-func (g APIHandler) StartWorkflowExecution(ctx context.Context, request *apiv1.StartWorkflowExecutionRequest) (*apiv1.StartWorkflowExecutionResponse, error) {
-	response, err := g.h.StartWorkflowExecution(ctx, proto.ToStartWorkflowExecutionRequest(request))
-	return proto.FromStartWorkflowExecutionResponse(response), proto.FromError(err)
+func (g APIHandler) StartBatchOperation(ctx context.Context, request *frontendv1.StartBatchOperationRequest) (*frontendv1.StartBatchOperationResponse, error) {
+	response, err := g.h.StartBatchOperation(ctx, proto.ToStartBatchOperationRequest(request))
+	return proto.FromStartBatchOperationResponse(response), proto.FromError(err)
 }
 
 func (g APIHandler) StartWorkflowExecutionAsync(ctx context.Context, request *apiv1.StartWorkflowExecutionAsyncRequest) (*apiv1.StartWorkflowExecutionAsyncResponse, error) {
@@ -276,6 +282,11 @@ func (g APIHandler) StartWorkflowExecutionAsync(ctx context.Context, request *ap
 	return proto.FromStartWorkflowExecutionAsyncResponse(response), proto.FromError(err)
 }
 
+func (g APIHandler) StopBatchOperation(ctx context.Context, request *frontendv1.StopBatchOperationRequest) (*frontendv1.StopBatchOperationResponse, error) {
+	response, err := g.h.StopBatchOperation(ctx, proto.ToStopBatchOperationRequest(request))
+	return proto.FromStopBatchOperationResponse(response), proto.FromError(err)
+}
+
 func (g APIHandler) TerminateWorkflowExecution(ctx context.Context, request *apiv1.TerminateWorkflowExecutionRequest) (*apiv1.TerminateWorkflowExecutionResponse, error) {
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToTerminateWorkflowExecutionRequest(request))
 	return &apiv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
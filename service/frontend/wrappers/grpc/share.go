@@ -29,11 +29,14 @@ import (
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	"go.uber.org/yarpc"
 
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
 func (g AdminHandler) Register(dispatcher *yarpc.Dispatcher) {
 	dispatcher.Register(adminv1.BuildAdminAPIYARPCProcedures(g))
+	dispatcher.Register(frontendv1.BuildAdminExtensionAPIYARPCProcedures(g))
 }
 
 func (g APIHandler) Register(dispatcher *yarpc.Dispatcher) {
@@ -43,9 +46,31 @@ func (g APIHandler) Register(dispatcher *yarpc.Dispatcher) {
 	dispatcher.Register(apiv1.BuildVisibilityAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildMetaAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildScheduleAPIYARPCProcedures(g))
+	dispatcher.Register(frontendv1.BuildBatchAPIYARPCProcedures(g))
 }
 
 func (g APIHandler) Health(ctx context.Context, request *apiv1.HealthRequest) (*apiv1.HealthResponse, error) {
 	response, err := g.h.Health(ctx)
 	return proto.FromHealthResponse(response), proto.FromError(err)
 }
+
+// StartWorkflowExecution and SignalWithStartWorkflowExecution are written by hand, they copy the values which are
+// not in the IDL yet from the request headers to the request
+
+func (g APIHandler) StartWorkflowExecution(ctx context.Context, request *apiv1.StartWorkflowExecutionRequest) (*apiv1.StartWorkflowExecutionResponse, error) {
+	startRequest := proto.ToStartWorkflowExecutionRequest(request)
+	if err := frontend.ReadStartWorkflowExecutionRequestHeaders(ctx, startRequest); err != nil {
+		return nil, proto.FromError(err)
+	}
+	response, err := g.h.StartWorkflowExecution(ctx, startRequest)
+	return proto.FromStartWorkflowExecutionResponse(response), proto.FromError(err)
+}
+
+func (g APIHandler) SignalWithStartWorkflowExecution(ctx context.Context, request *apiv1.SignalWithStartWorkflowExecutionRequest) (*apiv1.SignalWithStartWorkflowExecutionResponse, error) {
+	signalWithStartRequest := proto.ToSignalWithStartWorkflowExecutionRequest(request)
+	if err := frontend.ReadSignalWithStartWorkflowExecutionRequestHeaders(ctx, signalWithStartRequest); err != nil {
+		return nil, proto.FromError(err)
+	}
+	response, err := g.h.SignalWithStartWorkflowExecution(ctx, signalWithStartRequest)
+	return proto.FromSignalWithStartWorkflowExecutionResponse(response), proto.FromError(err)
+}
@@ -140,6 +140,24 @@ func (h *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDo
 	}
 	return err
 }
+func (h *apiHandler) DescribeBatchOperation(ctx context.Context, dp1 *types.DescribeBatchOperationRequest) (dp2 *types.DescribeBatchOperationResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeBatchOperation")}
+	tags = append(tags, toDescribeBatchOperationRequestTags(dp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendDescribeBatchOperationScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(dp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	dp2, err = h.handler.DescribeBatchOperation(ctx, dp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return dp2, err
+}
+
 func (h *apiHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeDomain")}
@@ -338,6 +356,24 @@ func (h *apiHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *ty
 	}
 	return lp2, err
 }
+func (h *apiHandler) ListBatchOperations(ctx context.Context, lp1 *types.ListBatchOperationsRequest) (lp2 *types.ListBatchOperationsResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("ListBatchOperations")}
+	tags = append(tags, toListBatchOperationsRequestTags(lp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendListBatchOperationsScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	lp2, err = h.handler.ListBatchOperations(ctx, lp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return lp2, err
+}
+
 func (h *apiHandler) ListClosedWorkflowExecutions(ctx context.Context, lp1 *types.ListClosedWorkflowExecutionsRequest) (lp2 *types.ListClosedWorkflowExecutionsResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListClosedWorkflowExecutions")}
@@ -991,6 +1027,24 @@ func (h *apiHandler) SignalWorkflowExecution(ctx context.Context, sp1 *types.Sig
 	}
 	return err
 }
+func (h *apiHandler) StartBatchOperation(ctx context.Context, sp1 *types.StartBatchOperationRequest) (sp2 *types.StartBatchOperationResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("StartBatchOperation")}
+	tags = append(tags, toStartBatchOperationRequestTags(sp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendStartBatchOperationScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(sp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	sp2, err = h.handler.StartBatchOperation(ctx, sp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return sp2, err
+}
+
 func (h *apiHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("StartWorkflowExecution")}
@@ -1047,6 +1101,24 @@ func (h *apiHandler) StartWorkflowExecutionAsync(ctx context.Context, sp1 *types
 	}
 	return sp2, err
 }
+func (h *apiHandler) StopBatchOperation(ctx context.Context, sp1 *types.StopBatchOperationRequest) (sp2 *types.StopBatchOperationResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("StopBatchOperation")}
+	tags = append(tags, toStopBatchOperationRequestTags(sp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendStopBatchOperationScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(sp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	swStart := time.Now()
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer func() { sw.Stop(); scope.ExponentialHistogram(metrics.CadenceLatencyHistogram, time.Since(swStart)) }()
+	logger := h.logger.WithTags(tags...)
+
+	sp2, err = h.handler.StopBatchOperation(ctx, sp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return sp2, err
+}
+
 func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.TerminateWorkflowExecutionRequest) (err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("TerminateWorkflowExecution")}
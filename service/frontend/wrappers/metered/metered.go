@@ -414,3 +414,27 @@ func toListSchedulesRequestTags(req *types.ListSchedulesRequest) []tag.Tag {
 		tag.WorkflowDomainName(req.GetDomain()),
 	}
 }
+
+func toStartBatchOperationRequestTags(req *types.StartBatchOperationRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
+func toDescribeBatchOperationRequestTags(req *types.DescribeBatchOperationRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
+func toListBatchOperationsRequestTags(req *types.ListBatchOperationsRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
+func toStopBatchOperationRequestTags(req *types.StopBatchOperationRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
@@ -125,6 +125,22 @@ func (h *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDo
 	return h.wrapped.DeprecateDomain(ctx, dp1)
 }
 
+func (h *apiHandler) DescribeBatchOperation(ctx context.Context, dp1 *types.DescribeBatchOperationRequest) (dp2 *types.DescribeBatchOperationResponse, err error) {
+	if dp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if dp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: dp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.DescribeBatchOperation(ctx, dp1)
+}
+
 func (h *apiHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	return h.wrapped.DescribeDomain(ctx, dp1)
 }
@@ -269,6 +285,22 @@ func (h *apiHandler) ListArchivedWorkflowExecutions(ctx context.Context, lp1 *ty
 	return h.wrapped.ListArchivedWorkflowExecutions(ctx, lp1)
 }
 
+func (h *apiHandler) ListBatchOperations(ctx context.Context, lp1 *types.ListBatchOperationsRequest) (lp2 *types.ListBatchOperationsResponse, err error) {
+	if lp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if lp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeVisibility, quotas.Info{Domain: lp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.ListBatchOperations(ctx, lp1)
+}
+
 func (h *apiHandler) ListClosedWorkflowExecutions(ctx context.Context, lp1 *types.ListClosedWorkflowExecutionsRequest) (lp2 *types.ListClosedWorkflowExecutionsResponse, err error) {
 	if lp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -817,6 +849,22 @@ func (h *apiHandler) SignalWorkflowExecution(ctx context.Context, sp1 *types.Sig
 	return h.wrapped.SignalWorkflowExecution(ctx, sp1)
 }
 
+func (h *apiHandler) StartBatchOperation(ctx context.Context, sp1 *types.StartBatchOperationRequest) (sp2 *types.StartBatchOperationResponse, err error) {
+	if sp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if sp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: sp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.StartBatchOperation(ctx, sp1)
+}
+
 func (h *apiHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	if sp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -849,6 +897,22 @@ func (h *apiHandler) StartWorkflowExecutionAsync(ctx context.Context, sp1 *types
 	return h.wrapped.StartWorkflowExecutionAsync(ctx, sp1)
 }
 
+func (h *apiHandler) StopBatchOperation(ctx context.Context, sp1 *types.StopBatchOperationRequest) (sp2 *types.StopBatchOperationResponse, err error) {
+	if sp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if sp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if limitErr := h.allowDomain(ctx, ratelimitTypeUser, quotas.Info{Domain: sp1.GetDomain()}); limitErr != nil {
+		err = limitErr
+		return
+	}
+	return h.wrapped.StopBatchOperation(ctx, sp1)
+}
+
 func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.TerminateWorkflowExecutionRequest) (err error) {
 	if tp1 == nil {
 		err = validate.ErrRequestNotSet
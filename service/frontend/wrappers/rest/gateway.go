@@ -0,0 +1,323 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/middleware"
+	"go.uber.org/yarpc/api/transport"
+	yarpchttp "go.uber.org/yarpc/transport/http"
+
+	"github.com/uber/cadence/common/rpc"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/api"
+)
+
+const (
+	// basePath prefixes the paths of all the routes of the gateway
+	basePath = "/api/v1"
+	// defaultTimeout applies to the requests without a Context-TTL-MS header
+	defaultTimeout = 30 * time.Second
+	// maxRequestBodySize matches the default message size limit of the gRPC inbound
+	maxRequestBodySize = 4 * 1024 * 1024
+	// cadenceHeaderPrefix is the prefix of the cadence headers, e.g. cadence-authorization, which are passed to
+	// the handler as they are. Other application headers are passed with the Rpc-Header- prefix, as in yarpc.
+	cadenceHeaderPrefix = "Cadence-"
+
+	// the yarpc services of the procedures exposed by the routes
+	domainAPI     = "uber.cadence.api.v1.DomainAPI"
+	visibilityAPI = "uber.cadence.api.v1.VisibilityAPI"
+	workflowAPI   = "uber.cadence.api.v1.WorkflowAPI"
+)
+
+type (
+	// APIHandler serves the frontend API as resource-oriented REST/JSON routes
+	APIHandler struct {
+		h api.Handler
+	}
+
+	route struct {
+		method string
+		// path is relative to basePath, its parameters have the same syntax in the ServeMux patterns and OpenAPI
+		path        string
+		operationID string
+		procedure   string // the yarpc procedure of the route, it is only served if allowed on the HTTP inbound
+		summary     string
+		query       []queryParam
+		body        reflect.Type
+		response    reflect.Type
+		serve       func(context.Context, *http.Request) (interface{}, error)
+	}
+
+	queryParam struct {
+		name        string
+		kind        string
+		description string
+	}
+
+	// routeHandler adapts a route to a yarpc unary handler, see serve
+	routeHandler func(ctx context.Context) error
+
+	// noBody is the request type of the routes without a body and the response type of the routes which
+	// respond with 204 No Content, their handlers return a nil *noBody
+	noBody struct{}
+
+	errorResponse struct {
+		Message string `json:"message"`
+	}
+)
+
+var (
+	pageSizeParam      = queryParam{"pageSize", "integer", "Maximum number of items in the page"}
+	nextPageTokenParam = queryParam{"nextPageToken", "string", "Token of the page to get, as returned in nextPageToken by the previous page"}
+	runIDParam         = queryParam{"runId", "string", "Run of the workflow, the current run if not set"}
+)
+
+// NewAPIHandler creates a REST gateway to the given frontend handler
+func NewAPIHandler(h api.Handler) APIHandler {
+	return APIHandler{h}
+}
+
+// Register adds the routes of the procedures allowed on the HTTP inbound and their OpenAPI document to the inbound
+func (h APIHandler) Register(inbound *rpc.RESTInbound) {
+	var routes []route
+	for _, rt := range h.routes() {
+		if inbound.Handle(rt.method+" "+basePath+rt.path, rt.procedure, serve(rt, inbound.InboundMiddleware())) {
+			routes = append(routes, rt)
+		}
+	}
+	spec := newOpenAPISpec(routes)
+	inbound.HandleDocument(http.MethodGet+" "+basePath+"/openapi.json", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, spec)
+	}))
+}
+
+func (h APIHandler) routes() []route {
+	return []route{
+		newRoute(http.MethodGet, "/domains", domainAPI, "ListDomains", "List the domains",
+			[]queryParam{pageSizeParam, nextPageTokenParam}, h.listDomains),
+		newRoute(http.MethodPost, "/domains", domainAPI, "RegisterDomain", "Register a domain",
+			nil, h.registerDomain),
+		newRoute(http.MethodGet, "/domains/{domain}", domainAPI, "DescribeDomain", "Describe a domain",
+			nil, h.describeDomain),
+		newRoute(http.MethodGet, "/domains/{domain}/workflows", visibilityAPI, "ListWorkflowExecutions", "List the workflows of a domain",
+			[]queryParam{{"query", "string", "Visibility query the workflows match, all the workflows if not set"}, pageSizeParam, nextPageTokenParam}, h.listWorkflows),
+		newRoute(http.MethodPost, "/domains/{domain}/workflows", workflowAPI, "StartWorkflowExecution", "Start a workflow",
+			nil, h.startWorkflow),
+		newRoute(http.MethodGet, "/domains/{domain}/workflows/{workflowId}", workflowAPI, "DescribeWorkflowExecution", "Describe a workflow",
+			[]queryParam{runIDParam}, h.describeWorkflow),
+		newRoute(http.MethodGet, "/domains/{domain}/workflows/{workflowId}/history", workflowAPI, "GetWorkflowExecutionHistory", "Get the history of a workflow",
+			[]queryParam{
+				runIDParam,
+				pageSizeParam,
+				nextPageTokenParam,
+				{"waitForNewEvent", "boolean", "Wait for the next event if the page has no new event"},
+				{"eventFilter", "string", "ALL_EVENT, the default, or CLOSE_EVENT to only get the close event"},
+			}, h.getHistory),
+		newRoute(http.MethodPost, "/domains/{domain}/workflows/{workflowId}/signal", workflowAPI, "SignalWorkflowExecution", "Signal a workflow",
+			[]queryParam{runIDParam}, h.signalWorkflow),
+		newRoute(http.MethodPost, "/domains/{domain}/workflows/{workflowId}/signal-with-start", workflowAPI, "SignalWithStartWorkflowExecution", "Signal a workflow, starting it if it is not running",
+			nil, h.signalWithStartWorkflow),
+		newRoute(http.MethodPost, "/domains/{domain}/workflows/{workflowId}/query", workflowAPI, "QueryWorkflow", "Query a workflow",
+			[]queryParam{runIDParam}, h.queryWorkflow),
+		newRoute(http.MethodPost, "/domains/{domain}/workflows/{workflowId}/cancel", workflowAPI, "RequestCancelWorkflowExecution", "Request the cancellation of a workflow",
+			[]queryParam{runIDParam}, h.cancelWorkflow),
+		newRoute(http.MethodPost, "/domains/{domain}/workflows/{workflowId}/terminate", workflowAPI, "TerminateWorkflowExecution", "Terminate a workflow",
+			[]queryParam{runIDParam}, h.terminateWorkflow),
+		newRoute(http.MethodGet, "/domains/{domain}/tasklists/{taskList}", workflowAPI, "DescribeTaskList", "Describe the pollers of a task list",
+			[]queryParam{
+				{"type", "string", "Decision, the default, or Activity"},
+				{"includeStatus", "boolean", "Include the status of the task list"},
+			}, h.describeTaskList),
+	}
+}
+
+// newRoute builds a route serving handle, its body and response types are documented in the OpenAPI document
+func newRoute[Req, Resp any](
+	method, path, yarpcService, operationID, summary string,
+	query []queryParam,
+	handle func(context.Context, *http.Request, *Req) (*Resp, error),
+) route {
+	return route{
+		method:      method,
+		path:        path,
+		operationID: operationID,
+		procedure:   yarpcService + "::" + operationID,
+		summary:     summary,
+		query:       query,
+		body:        reflect.TypeOf((*Req)(nil)).Elem(),
+		response:    reflect.TypeOf((*Resp)(nil)).Elem(),
+		serve: func(ctx context.Context, r *http.Request) (interface{}, error) {
+			var request Req
+			if err := decodeBody(r, &request); err != nil {
+				return nil, err
+			}
+			response, err := handle(ctx, r, &request)
+			if err != nil || response == nil {
+				return nil, err
+			}
+			return response, nil
+		},
+	}
+}
+
+// serve calls the route through the inbound middleware of the yarpc procedures, so its requests get the caller
+// info, metrics tags and partition config the yarpc requests get
+func serve(rt route, inboundMiddleware middleware.UnaryInbound) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel, request, err := newCall(r, rt.procedure)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		defer cancel()
+
+		handler := middleware.ApplyUnaryInbound(routeHandler(func(ctx context.Context) error {
+			response, err := rt.serve(ctx, r)
+			if err != nil {
+				return err
+			}
+			if response == nil {
+				w.WriteHeader(http.StatusNoContent)
+				return nil
+			}
+			writeJSON(w, http.StatusOK, response)
+			return nil
+		}), inboundMiddleware)
+		// the route writes its response, there is no yarpc response writer
+		if err := handler.Handle(ctx, request, nil); err != nil {
+			writeError(w, err)
+		}
+	})
+}
+
+func (h routeHandler) Handle(ctx context.Context, _ *transport.Request, _ transport.ResponseWriter) error {
+	return h(ctx)
+}
+
+// newCall builds the context and the request of a yarpc inbound call from the HTTP request, so the middleware and
+// the wrappers of the handler find the caller, auth token and client headers where they find them for the yarpc
+// transports
+func newCall(r *http.Request, procedure string) (context.Context, context.CancelFunc, *transport.Request, error) {
+	timeout := defaultTimeout
+	if ttl := r.Header.Get(yarpchttp.TTLMSHeader); ttl != "" {
+		ms, err := strconv.ParseInt(ttl, 10, 64)
+		if err != nil || ms <= 0 {
+			return nil, nil, nil, &types.BadRequestError{Message: fmt.Sprintf("%s header %q is not a positive integer", yarpchttp.TTLMSHeader, ttl)}
+		}
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+
+	headers := transport.NewHeaders()
+	for key := range r.Header {
+		switch {
+		case strings.HasPrefix(key, yarpchttp.ApplicationHeaderPrefix):
+			headers = headers.With(strings.TrimPrefix(key, yarpchttp.ApplicationHeaderPrefix), r.Header.Get(key))
+		case strings.HasPrefix(key, cadenceHeaderPrefix):
+			headers = headers.With(key, r.Header.Get(key))
+		}
+	}
+
+	request := &transport.Request{
+		Caller:    r.Header.Get(yarpchttp.CallerHeader),
+		Service:   service.Frontend,
+		Transport: "http",
+		Encoding:  "json",
+		Procedure: procedure,
+		Headers:   headers,
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	ctx, call := encoding.NewInboundCall(ctx)
+	if err := call.ReadFromRequest(request); err != nil {
+		cancel()
+		return nil, nil, nil, err
+	}
+	return ctx, cancel, request, nil
+}
+
+func decodeBody(r *http.Request, body interface{}) error {
+	if _, ok := body.(*noBody); ok {
+		return nil
+	}
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxRequestBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(body); err != nil && !errors.Is(err, io.EOF) {
+		return &types.BadRequestError{Message: fmt.Sprintf("invalid request body: %v", err)}
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, errorStatus(err), errorResponse{Message: err.Error()})
+}
+
+// errorStatus maps the errors of the handler to HTTP status codes, like their mapping to gRPC status codes
+func errorStatus(err error) int {
+	switch {
+	case isError[*types.BadRequestError](err),
+		isError[*types.QueryFailedError](err),
+		isError[*types.ClientVersionNotSupportedError](err),
+		isError[*types.FeatureNotEnabledError](err):
+		return http.StatusBadRequest
+	case isError[*types.AccessDeniedError](err):
+		return http.StatusForbidden
+	case isError[*types.EntityNotExistsError](err):
+		return http.StatusNotFound
+	case isError[*types.WorkflowExecutionAlreadyStartedError](err),
+		isError[*types.WorkflowExecutionAlreadyCompletedError](err),
+		isError[*types.CancellationAlreadyRequestedError](err),
+		isError[*types.DomainAlreadyExistsError](err),
+		isError[*types.DomainNotActiveError](err):
+		return http.StatusConflict
+	case isError[*types.ServiceBusyError](err),
+		isError[*types.LimitExceededError](err):
+		return http.StatusTooManyRequests
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func isError[T error](err error) bool {
+	var target T
+	return errors.As(err, &target)
+}
@@ -0,0 +1,425 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/rpc"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/api"
+)
+
+const (
+	testDomain     = "test-domain"
+	testWorkflowID = "test-workflow-id"
+	testRunID      = "test-run-id"
+)
+
+func TestRoutes(t *testing.T) {
+	execution := &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID}
+
+	tests := []struct {
+		desc       string
+		method     string
+		target     string
+		body       string
+		mockFn     func(*api.MockHandler)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			desc:   "list domains",
+			method: http.MethodGet,
+			target: "/api/v1/domains?pageSize=10&nextPageToken=dG9rZW4=",
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().ListDomains(gomock.Any(), &types.ListDomainsRequest{PageSize: 10, NextPageToken: []byte("token")}).
+					Return(&types.ListDomainsResponse{NextPageToken: []byte("next")}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"nextPageToken":"bmV4dA=="}`,
+		},
+		{
+			desc:   "register domain",
+			method: http.MethodPost,
+			target: "/api/v1/domains",
+			body:   `{"name":"test-domain","workflowExecutionRetentionPeriodInDays":3}`,
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().RegisterDomain(gomock.Any(), &types.RegisterDomainRequest{Name: testDomain, WorkflowExecutionRetentionPeriodInDays: 3}).
+					Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			desc:   "describe domain",
+			method: http.MethodGet,
+			target: "/api/v1/domains/test-domain",
+			mockFn: func(h *api.MockHandler) {
+				name := testDomain
+				h.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: &name}).
+					Return(&types.DescribeDomainResponse{DomainInfo: &types.DomainInfo{Name: testDomain}}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"domainInfo":{"name":"test-domain"}}`,
+		},
+		{
+			desc:   "list workflows",
+			method: http.MethodGet,
+			target: "/api/v1/domains/test-domain/workflows?query=WorkflowType%3D%27wf%27&pageSize=5",
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().ListWorkflowExecutions(gomock.Any(), &types.ListWorkflowExecutionsRequest{Domain: testDomain, PageSize: 5, Query: "WorkflowType='wf'"}).
+					Return(&types.ListWorkflowExecutionsResponse{}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{}`,
+		},
+		{
+			desc:   "start workflow",
+			method: http.MethodPost,
+			target: "/api/v1/domains/test-domain/workflows",
+			body: `{
+				"workflowId": "test-workflow-id",
+				"workflowType": {"name": "wf"},
+				"taskList": {"name": "tl"},
+				"executionStartToCloseTimeoutSeconds": 60,
+				"input": {"key": "value"},
+				"memo": {"owner": "team"},
+				"searchAttributes": {"CustomKeywordField": "keyword"}
+			}`,
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().StartWorkflowExecution(gomock.Any(), &types.StartWorkflowExecutionRequest{
+					Domain:                              testDomain,
+					WorkflowID:                          testWorkflowID,
+					WorkflowType:                        &types.WorkflowType{Name: "wf"},
+					TaskList:                            &types.TaskList{Name: "tl"},
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+					Input:                               []byte(`{"key": "value"}`),
+					Memo:                                &types.Memo{Fields: map[string][]byte{"owner": []byte(`"team"`)}},
+					SearchAttributes:                    &types.SearchAttributes{IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)}},
+				}).Return(&types.StartWorkflowExecutionResponse{RunID: testRunID}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"runId":"test-run-id"}`,
+		},
+		{
+			desc:   "describe workflow",
+			method: http.MethodGet,
+			target: "/api/v1/domains/test-domain/workflows/test-workflow-id?runId=test-run-id",
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.DescribeWorkflowExecutionRequest{Domain: testDomain, Execution: execution}).
+					Return(&types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Execution: execution}}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"workflowExecutionInfo":{"execution":{"workflowId":"test-workflow-id","runId":"test-run-id"}}}`,
+		},
+		{
+			desc:   "get history",
+			method: http.MethodGet,
+			target: "/api/v1/domains/test-domain/workflows/test-workflow-id/history?runId=test-run-id&waitForNewEvent=true&eventFilter=close_event",
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &types.GetWorkflowExecutionHistoryRequest{
+					Domain:                 testDomain,
+					Execution:              execution,
+					WaitForNewEvent:        true,
+					HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+				}).Return(&types.GetWorkflowExecutionHistoryResponse{}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{}`,
+		},
+		{
+			desc:   "signal workflow",
+			method: http.MethodPost,
+			target: "/api/v1/domains/test-domain/workflows/test-workflow-id/signal",
+			body:   `{"signalName":"signal","input":[1,2]}`,
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().SignalWorkflowExecution(gomock.Any(), &types.SignalWorkflowExecutionRequest{
+					Domain:            testDomain,
+					WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+					SignalName:        "signal",
+					Input:             []byte(`[1,2]`),
+				}).Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			desc:   "signal with start workflow",
+			method: http.MethodPost,
+			target: "/api/v1/domains/test-domain/workflows/test-workflow-id/signal-with-start",
+			body:   `{"workflowType":{"name":"wf"},"taskList":{"name":"tl"},"signalName":"signal","input":"start","signalInput":"signal"}`,
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), &types.SignalWithStartWorkflowExecutionRequest{
+					Domain:       testDomain,
+					WorkflowID:   testWorkflowID,
+					WorkflowType: &types.WorkflowType{Name: "wf"},
+					TaskList:     &types.TaskList{Name: "tl"},
+					SignalName:   "signal",
+					Input:        []byte(`"start"`),
+					SignalInput:  []byte(`"signal"`),
+				}).Return(&types.StartWorkflowExecutionResponse{RunID: testRunID}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"runId":"test-run-id"}`,
+		},
+		{
+			desc:   "query workflow",
+			method: http.MethodPost,
+			target: "/api/v1/domains/test-domain/workflows/test-workflow-id/query?runId=test-run-id",
+			body:   `{"queryType":"state","queryArgs":{"verbose":true},"queryConsistencyLevel":"STRONG"}`,
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().QueryWorkflow(gomock.Any(), &types.QueryWorkflowRequest{
+					Domain:                testDomain,
+					Execution:             execution,
+					Query:                 &types.WorkflowQuery{QueryType: "state", QueryArgs: []byte(`{"verbose":true}`)},
+					QueryConsistencyLevel: types.QueryConsistencyLevelStrong.Ptr(),
+				}).Return(&types.QueryWorkflowResponse{QueryResult: []byte(`{"state":"running"}`)}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"queryResult":{"state":"running"}}`,
+		},
+		{
+			desc:   "query workflow with a result which is not JSON",
+			method: http.MethodPost,
+			target: "/api/v1/domains/test-domain/workflows/test-workflow-id/query",
+			body:   `{"queryType":"state"}`,
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+					Return(&types.QueryWorkflowResponse{QueryResult: []byte("running")}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"queryResult":"cnVubmluZw=="}`,
+		},
+		{
+			desc:   "cancel workflow without a body",
+			method: http.MethodPost,
+			target: "/api/v1/domains/test-domain/workflows/test-workflow-id/cancel?runId=test-run-id",
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), &types.RequestCancelWorkflowExecutionRequest{
+					Domain:            testDomain,
+					WorkflowExecution: execution,
+				}).Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			desc:   "terminate workflow",
+			method: http.MethodPost,
+			target: "/api/v1/domains/test-domain/workflows/test-workflow-id/terminate",
+			body:   `{"reason":"stuck","details":{"ticket":1},"identity":"script"}`,
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().TerminateWorkflowExecution(gomock.Any(), &types.TerminateWorkflowExecutionRequest{
+					Domain:            testDomain,
+					WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+					Reason:            "stuck",
+					Details:           []byte(`{"ticket":1}`),
+					Identity:          "script",
+				}).Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			desc:   "describe task list",
+			method: http.MethodGet,
+			target: "/api/v1/domains/test-domain/tasklists/tl?type=activity&includeStatus=true",
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().DescribeTaskList(gomock.Any(), &types.DescribeTaskListRequest{
+					Domain:                testDomain,
+					TaskList:              &types.TaskList{Name: "tl", Kind: types.TaskListKindNormal.Ptr()},
+					TaskListType:          types.TaskListTypeActivity.Ptr(),
+					IncludeTaskListStatus: true,
+				}).Return(&types.DescribeTaskListResponse{}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{}`,
+		},
+		{
+			desc:       "invalid query parameter",
+			method:     http.MethodGet,
+			target:     "/api/v1/domains?pageSize=ten",
+			mockFn:     func(h *api.MockHandler) {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"message":"pageSize \"ten\" is not an int32"}`,
+		},
+		{
+			desc:       "unknown body field",
+			method:     http.MethodPost,
+			target:     "/api/v1/domains/test-domain/workflows/test-workflow-id/signal",
+			body:       `{"signal":"signal"}`,
+			mockFn:     func(h *api.MockHandler) {},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"message":"invalid request body: json: unknown field \"signal\""}`,
+		},
+		{
+			desc:   "handler error",
+			method: http.MethodGet,
+			target: "/api/v1/domains/test-domain/workflows/test-workflow-id",
+			mockFn: func(h *api.MockHandler) {
+				h.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+					Return(nil, &types.EntityNotExistsError{Message: "workflow not found"})
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"message":"workflow not found"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			handler := api.NewMockHandler(ctrl)
+			tc.mockFn(handler)
+			mux := newTestInbound(nil)
+			NewAPIHandler(handler).Register(mux)
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body)))
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+			if tc.wantBody == "" {
+				assert.Empty(t, w.Body.String())
+			} else {
+				assert.JSONEq(t, tc.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestCallContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := api.NewMockHandler(ctrl)
+	handler.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *types.DescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
+			call := yarpc.CallFromContext(ctx)
+			require.NotNil(t, call)
+			assert.Equal(t, "test-caller", call.Caller())
+			assert.Equal(t, "uber.cadence.api.v1.WorkflowAPI::DescribeTaskList", call.Procedure())
+			assert.Equal(t, "token", call.Header("cadence-authorization"))
+			assert.Equal(t, "custom", call.Header("x-custom"))
+			assert.Empty(t, call.Header("user-agent"))
+			assert.Equal(t, types.CallerTypeCLI, types.GetCallerInfoFromContext(ctx).GetCallerType())
+
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 500*time.Millisecond)
+			return &types.DescribeTaskListResponse{}, nil
+		})
+	mux := newTestInbound(nil)
+	NewAPIHandler(handler).Register(mux)
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/domains/test-domain/tasklists/tl", nil)
+	r.Header.Set("Rpc-Caller", "test-caller")
+	r.Header.Set("Context-TTL-MS", "1000")
+	r.Header.Set("Cadence-Authorization", "token")
+	r.Header.Set("Cadence-Caller-Type", "cli")
+	r.Header.Set("Rpc-Header-X-Custom", "custom")
+	r.Header.Set("User-Agent", "curl")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestProcedureNotAllowed(t *testing.T) {
+	mux := newTestInbound(map[string]struct{}{"uber.cadence.api.v1.WorkflowAPI::DescribeTaskList": {}})
+	NewAPIHandler(api.NewMockHandler(gomock.NewController(t))).Register(mux)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/domains/test-domain/workflows/test-workflow-id/terminate", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var spec struct {
+		Paths map[string]interface{} `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+	assert.Len(t, spec.Paths, 1)
+	assert.Contains(t, spec.Paths, "/domains/{domain}/tasklists/{taskList}")
+}
+
+func TestMiddlewareError(t *testing.T) {
+	mux := newTestInbound(nil)
+	NewAPIHandler(api.NewMockHandler(gomock.NewController(t))).Register(mux)
+
+	// the partition config of a forwarded request is decoded by the inbound middleware before the handler is called
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/domains/test-domain/tasklists/tl", nil)
+	r.Header.Set("Cadence-Forwarding-Cluster", "cluster0")
+	r.Header.Set("Cadence-Workflow-Partition-Config", "not json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+// newTestInbound returns an inbound allowing the given procedures, all the procedures of the routes if nil, with the
+// inbound middleware of the services
+func newTestInbound(procedures map[string]struct{}) *rpc.RESTInbound {
+	if procedures == nil {
+		procedures = make(map[string]struct{})
+		for _, rt := range NewAPIHandler(nil).routes() {
+			procedures[rt.procedure] = struct{}{}
+		}
+	}
+	return rpc.NewRESTInbound(procedures, yarpc.UnaryInboundMiddleware(
+		&rpc.InboundMetricsMiddleware{},
+		&rpc.CallerInfoMiddleware{},
+		&rpc.ClientPartitionConfigMiddleware{},
+		&rpc.ForwardPartitionConfigMiddleware{},
+	))
+}
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{&types.BadRequestError{}, http.StatusBadRequest},
+		{&types.AccessDeniedError{}, http.StatusForbidden},
+		{&types.EntityNotExistsError{}, http.StatusNotFound},
+		{&types.WorkflowExecutionAlreadyStartedError{}, http.StatusConflict},
+		{&types.DomainAlreadyExistsError{}, http.StatusConflict},
+		{&types.ServiceBusyError{}, http.StatusTooManyRequests},
+		{fmt.Errorf("wrapped: %w", &types.LimitExceededError{}), http.StatusTooManyRequests},
+		{context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{&types.InternalServiceError{}, http.StatusInternalServerError},
+		{errors.New("unknown"), http.StatusInternalServerError},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%T", tc.err), func(t *testing.T) {
+			assert.Equal(t, tc.want, errorStatus(tc.err))
+		})
+	}
+}
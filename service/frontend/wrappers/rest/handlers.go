@@ -0,0 +1,335 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rest
+
+import (
+	"context"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// The payloads of the requests, like the workflow input or signal input, are JSON values which are passed to the
+// workflow as their JSON encoding.
+type (
+	startWorkflowRequest struct {
+		types.StartWorkflowExecutionRequest
+		Input            json.RawMessage            `json:"input,omitempty"`
+		Memo             map[string]json.RawMessage `json:"memo,omitempty"`
+		SearchAttributes map[string]json.RawMessage `json:"searchAttributes,omitempty"`
+	}
+
+	signalWithStartWorkflowRequest struct {
+		types.SignalWithStartWorkflowExecutionRequest
+		Input            json.RawMessage            `json:"input,omitempty"`
+		SignalInput      json.RawMessage            `json:"signalInput,omitempty"`
+		Memo             map[string]json.RawMessage `json:"memo,omitempty"`
+		SearchAttributes map[string]json.RawMessage `json:"searchAttributes,omitempty"`
+	}
+
+	signalWorkflowRequest struct {
+		SignalName string          `json:"signalName,omitempty"`
+		Input      json.RawMessage `json:"input,omitempty"`
+		Identity   string          `json:"identity,omitempty"`
+		RequestID  string          `json:"requestId,omitempty"`
+	}
+
+	queryWorkflowRequest struct {
+		QueryType             string                       `json:"queryType,omitempty"`
+		QueryArgs             json.RawMessage              `json:"queryArgs,omitempty"`
+		QueryRejectCondition  *types.QueryRejectCondition  `json:"queryRejectCondition,omitempty"`
+		QueryConsistencyLevel *types.QueryConsistencyLevel `json:"queryConsistencyLevel,omitempty"`
+	}
+
+	// queryWorkflowResponse has the query result as a JSON value, or as a base64 string if it is not valid JSON
+	queryWorkflowResponse struct {
+		QueryResult   json.RawMessage      `json:"queryResult,omitempty"`
+		QueryRejected *types.QueryRejected `json:"queryRejected,omitempty"`
+	}
+
+	cancelWorkflowRequest struct {
+		Identity  string `json:"identity,omitempty"`
+		RequestID string `json:"requestId,omitempty"`
+		Cause     string `json:"cause,omitempty"`
+	}
+
+	terminateWorkflowRequest struct {
+		Reason   string          `json:"reason,omitempty"`
+		Details  json.RawMessage `json:"details,omitempty"`
+		Identity string          `json:"identity,omitempty"`
+	}
+)
+
+func (h APIHandler) listDomains(ctx context.Context, r *http.Request, _ *noBody) (*types.ListDomainsResponse, error) {
+	pageSize, err := queryInt32(r, pageSizeParam.name)
+	if err != nil {
+		return nil, err
+	}
+	nextPageToken, err := queryPageToken(r)
+	if err != nil {
+		return nil, err
+	}
+	return h.h.ListDomains(ctx, &types.ListDomainsRequest{
+		PageSize:      pageSize,
+		NextPageToken: nextPageToken,
+	})
+}
+
+func (h APIHandler) registerDomain(ctx context.Context, r *http.Request, request *types.RegisterDomainRequest) (*noBody, error) {
+	return nil, h.h.RegisterDomain(ctx, request)
+}
+
+func (h APIHandler) describeDomain(ctx context.Context, r *http.Request, _ *noBody) (*types.DescribeDomainResponse, error) {
+	name := r.PathValue("domain")
+	return h.h.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: &name})
+}
+
+func (h APIHandler) listWorkflows(ctx context.Context, r *http.Request, _ *noBody) (*types.ListWorkflowExecutionsResponse, error) {
+	pageSize, err := queryInt32(r, pageSizeParam.name)
+	if err != nil {
+		return nil, err
+	}
+	nextPageToken, err := queryPageToken(r)
+	if err != nil {
+		return nil, err
+	}
+	return h.h.ListWorkflowExecutions(ctx, &types.ListWorkflowExecutionsRequest{
+		Domain:        r.PathValue("domain"),
+		PageSize:      pageSize,
+		NextPageToken: nextPageToken,
+		Query:         r.URL.Query().Get("query"),
+	})
+}
+
+func (h APIHandler) startWorkflow(ctx context.Context, r *http.Request, request *startWorkflowRequest) (*types.StartWorkflowExecutionResponse, error) {
+	start := request.StartWorkflowExecutionRequest
+	start.Domain = r.PathValue("domain")
+	start.Input = request.Input
+	start.Memo = toMemo(request.Memo)
+	start.SearchAttributes = toSearchAttributes(request.SearchAttributes)
+	return h.h.StartWorkflowExecution(ctx, &start)
+}
+
+func (h APIHandler) describeWorkflow(ctx context.Context, r *http.Request, _ *noBody) (*types.DescribeWorkflowExecutionResponse, error) {
+	return h.h.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    r.PathValue("domain"),
+		Execution: workflowExecution(r),
+	})
+}
+
+func (h APIHandler) getHistory(ctx context.Context, r *http.Request, _ *noBody) (*types.GetWorkflowExecutionHistoryResponse, error) {
+	pageSize, err := queryInt32(r, pageSizeParam.name)
+	if err != nil {
+		return nil, err
+	}
+	nextPageToken, err := queryPageToken(r)
+	if err != nil {
+		return nil, err
+	}
+	waitForNewEvent, err := queryBool(r, "waitForNewEvent")
+	if err != nil {
+		return nil, err
+	}
+	var filter *types.HistoryEventFilterType
+	if r.URL.Query().Has("eventFilter") {
+		filter = new(types.HistoryEventFilterType)
+		if err := queryEnum(r, "eventFilter", filter); err != nil {
+			return nil, err
+		}
+	}
+	return h.h.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 r.PathValue("domain"),
+		Execution:              workflowExecution(r),
+		MaximumPageSize:        pageSize,
+		NextPageToken:          nextPageToken,
+		WaitForNewEvent:        waitForNewEvent,
+		HistoryEventFilterType: filter,
+	})
+}
+
+func (h APIHandler) signalWorkflow(ctx context.Context, r *http.Request, request *signalWorkflowRequest) (*noBody, error) {
+	return nil, h.h.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+		Domain:            r.PathValue("domain"),
+		WorkflowExecution: workflowExecution(r),
+		SignalName:        request.SignalName,
+		Input:             request.Input,
+		Identity:          request.Identity,
+		RequestID:         request.RequestID,
+	})
+}
+
+func (h APIHandler) signalWithStartWorkflow(ctx context.Context, r *http.Request, request *signalWithStartWorkflowRequest) (*types.StartWorkflowExecutionResponse, error) {
+	signalWithStart := request.SignalWithStartWorkflowExecutionRequest
+	signalWithStart.Domain = r.PathValue("domain")
+	signalWithStart.WorkflowID = r.PathValue("workflowId")
+	signalWithStart.Input = request.Input
+	signalWithStart.SignalInput = request.SignalInput
+	signalWithStart.Memo = toMemo(request.Memo)
+	signalWithStart.SearchAttributes = toSearchAttributes(request.SearchAttributes)
+	return h.h.SignalWithStartWorkflowExecution(ctx, &signalWithStart)
+}
+
+func (h APIHandler) queryWorkflow(ctx context.Context, r *http.Request, request *queryWorkflowRequest) (*queryWorkflowResponse, error) {
+	response, err := h.h.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain:    r.PathValue("domain"),
+		Execution: workflowExecution(r),
+		Query: &types.WorkflowQuery{
+			QueryType: request.QueryType,
+			QueryArgs: request.QueryArgs,
+		},
+		QueryRejectCondition:  request.QueryRejectCondition,
+		QueryConsistencyLevel: request.QueryConsistencyLevel,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := json.RawMessage(response.QueryResult)
+	if len(result) > 0 && !json.Valid(result) {
+		if result, err = json.Marshal(response.QueryResult); err != nil {
+			return nil, err
+		}
+	}
+	return &queryWorkflowResponse{
+		QueryResult:   result,
+		QueryRejected: response.QueryRejected,
+	}, nil
+}
+
+func (h APIHandler) cancelWorkflow(ctx context.Context, r *http.Request, request *cancelWorkflowRequest) (*noBody, error) {
+	return nil, h.h.RequestCancelWorkflowExecution(ctx, &types.RequestCancelWorkflowExecutionRequest{
+		Domain:            r.PathValue("domain"),
+		WorkflowExecution: workflowExecution(r),
+		Identity:          request.Identity,
+		RequestID:         request.RequestID,
+		Cause:             request.Cause,
+	})
+}
+
+func (h APIHandler) terminateWorkflow(ctx context.Context, r *http.Request, request *terminateWorkflowRequest) (*noBody, error) {
+	return nil, h.h.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+		Domain:            r.PathValue("domain"),
+		WorkflowExecution: workflowExecution(r),
+		Reason:            request.Reason,
+		Details:           request.Details,
+		Identity:          request.Identity,
+	})
+}
+
+func (h APIHandler) describeTaskList(ctx context.Context, r *http.Request, _ *noBody) (*types.DescribeTaskListResponse, error) {
+	taskListType := types.TaskListTypeDecision
+	if err := queryEnum(r, "type", &taskListType); err != nil {
+		return nil, err
+	}
+	includeStatus, err := queryBool(r, "includeStatus")
+	if err != nil {
+		return nil, err
+	}
+	return h.h.DescribeTaskList(ctx, &types.DescribeTaskListRequest{
+		Domain:                r.PathValue("domain"),
+		TaskList:              &types.TaskList{Name: r.PathValue("taskList"), Kind: types.TaskListKindNormal.Ptr()},
+		TaskListType:          &taskListType,
+		IncludeTaskListStatus: includeStatus,
+	})
+}
+
+func workflowExecution(r *http.Request) *types.WorkflowExecution {
+	return &types.WorkflowExecution{
+		WorkflowID: r.PathValue("workflowId"),
+		RunID:      r.URL.Query().Get(runIDParam.name),
+	}
+}
+
+func toMemo(fields map[string]json.RawMessage) *types.Memo {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &types.Memo{Fields: toPayloads(fields)}
+}
+
+func toSearchAttributes(fields map[string]json.RawMessage) *types.SearchAttributes {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &types.SearchAttributes{IndexedFields: toPayloads(fields)}
+}
+
+func toPayloads(fields map[string]json.RawMessage) map[string][]byte {
+	payloads := make(map[string][]byte, len(fields))
+	for key, value := range fields {
+		payloads[key] = value
+	}
+	return payloads
+}
+
+func queryInt32(r *http.Request, name string) (int32, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, &types.BadRequestError{Message: fmt.Sprintf("%s %q is not an int32", name, value)}
+	}
+	return int32(parsed), nil
+}
+
+func queryBool(r *http.Request, name string) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, &types.BadRequestError{Message: fmt.Sprintf("%s %q is not a bool", name, value)}
+	}
+	return parsed, nil
+}
+
+func queryPageToken(r *http.Request) ([]byte, error) {
+	value := r.URL.Query().Get(nextPageTokenParam.name)
+	if value == "" {
+		return nil, nil
+	}
+	token, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("%s %q is not base64", nextPageTokenParam.name, value)}
+	}
+	return token, nil
+}
+
+// queryEnum parses the named query parameter into value, which is left unchanged if the parameter is not set
+func queryEnum(r *http.Request, name string, value encoding.TextUnmarshaler) error {
+	text := r.URL.Query().Get(name)
+	if text == "" {
+		return nil
+	}
+	if err := value.UnmarshalText([]byte(text)); err != nil {
+		return &types.BadRequestError{Message: fmt.Sprintf("invalid %s: %v", name, err)}
+	}
+	return nil
+}
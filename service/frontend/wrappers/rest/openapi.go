@@ -0,0 +1,256 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rest
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxEnumValues bounds the values listed for an enum, in case its String method does not have the generated format
+const maxEnumValues = 256
+
+var (
+	pathParamPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+	noBodyType          = reflect.TypeOf(noBody{})
+	rawMessageType      = reflect.TypeOf(json.RawMessage{})
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	stringerType        = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	errorResponseSchema = reflect.TypeOf(errorResponse{})
+)
+
+// schemaGenerator generates the OpenAPI schemas of Go types from their json encoding. Structs are added to the
+// components of the document and referenced by name.
+type schemaGenerator struct {
+	schemas map[string]interface{}
+}
+
+// newOpenAPISpec generates the OpenAPI 3 document of the routes. The schemas of the bodies and responses are
+// generated from their types, which are mostly the request and response types of common/types.
+func newOpenAPISpec(routes []route) map[string]interface{} {
+	g := &schemaGenerator{schemas: map[string]interface{}{}}
+	paths := map[string]map[string]interface{}{}
+	for _, rt := range routes {
+		if paths[rt.path] == nil {
+			paths[rt.path] = map[string]interface{}{}
+		}
+		paths[rt.path][strings.ToLower(rt.method)] = g.operation(rt)
+	}
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "Cadence frontend API",
+			"version": "v1",
+		},
+		"servers":    []interface{}{map[string]interface{}{"url": basePath}},
+		"paths":      paths,
+		"components": map[string]interface{}{"schemas": g.schemas},
+	}
+}
+
+func (g *schemaGenerator) operation(rt route) map[string]interface{} {
+	var parameters []interface{}
+	pathParams := map[string]bool{}
+	for _, match := range pathParamPattern.FindAllStringSubmatch(rt.path, -1) {
+		pathParams[match[1]] = true
+		parameters = append(parameters, map[string]interface{}{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+		})
+	}
+	for _, param := range rt.query {
+		parameters = append(parameters, map[string]interface{}{
+			"name":        param.name,
+			"in":          "query",
+			"description": param.description,
+			"schema":      map[string]interface{}{"type": param.kind},
+		})
+	}
+
+	responses := map[string]interface{}{
+		"default": map[string]interface{}{
+			"description": "Error",
+			"content":     jsonContent(g.schema(errorResponseSchema)),
+		},
+	}
+	if rt.response == noBodyType {
+		responses[fmt.Sprint(http.StatusNoContent)] = map[string]interface{}{"description": "No Content"}
+	} else {
+		responses[fmt.Sprint(http.StatusOK)] = map[string]interface{}{
+			"description": "OK",
+			"content":     jsonContent(g.schema(rt.response)),
+		}
+	}
+
+	operation := map[string]interface{}{
+		"operationId": rt.operationID,
+		"summary":     rt.summary,
+		"responses":   responses,
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+	if rt.body != noBodyType {
+		// the body is inlined without the fields set from the path, e.g. the domain
+		properties := map[string]interface{}{}
+		g.properties(rt.body, properties)
+		for name := range pathParams {
+			delete(properties, name)
+		}
+		operation["requestBody"] = map[string]interface{}{
+			"content": jsonContent(map[string]interface{}{"type": "object", "properties": properties}),
+		}
+	}
+	return operation
+}
+
+func (g *schemaGenerator) schema(t reflect.Type) map[string]interface{} {
+	switch {
+	case t == rawMessageType:
+		return map[string]interface{}{"description": "Any JSON value"}
+	case t.Kind() == reflect.Ptr:
+		return g.schema(t.Elem())
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		schema := map[string]interface{}{"type": "string"}
+		if values := enumValues(t); len(values) > 0 {
+			schema["enum"] = values
+		}
+		return schema
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": g.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": g.schema(t.Elem())}
+	case reflect.Struct:
+		name := schemaName(t)
+		if _, ok := g.schemas[name]; !ok {
+			// registered before its properties, so recursive types reference it instead of recursing forever
+			g.schemas[name] = nil
+			properties := map[string]interface{}{}
+			g.properties(t, properties)
+			g.schemas[name] = map[string]interface{}{"type": "object", "properties": properties}
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// properties adds the schemas of the json encoded fields of the struct. Like encoding/json, the fields of
+// embedded structs are promoted unless the outer struct has a field of the same name.
+func (g *schemaGenerator) properties(t reflect.Type, properties map[string]interface{}) {
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				embedded = append(embedded, fieldType)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = g.schema(field.Type)
+	}
+	for _, fieldType := range embedded {
+		promoted := map[string]interface{}{}
+		g.properties(fieldType, promoted)
+		for name, schema := range promoted {
+			if _, ok := properties[name]; !ok {
+				properties[name] = schema
+			}
+		}
+	}
+}
+
+// enumValues lists the names of the values of the generated enums of common/types, whose String method
+// returns e.g. TaskListType(2) for unknown values
+func enumValues(t reflect.Type) []string {
+	if !t.Implements(stringerType) {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+	default:
+		return nil
+	}
+	var values []string
+	for i := int64(0); i < maxEnumValues; i++ {
+		value := reflect.New(t).Elem()
+		value.SetInt(i)
+		name := value.Interface().(fmt.Stringer).String()
+		if strings.HasSuffix(name, fmt.Sprintf("(%d)", i)) {
+			return values
+		}
+		values = append(values, name)
+	}
+	return nil
+}
+
+// schemaName is the name of the type, capitalized for the unexported types of this package
+func schemaName(t reflect.Type) string {
+	first, size := utf8.DecodeRuneInString(t.Name())
+	return string(unicode.ToUpper(first)) + t.Name()[size:]
+}
+
+func jsonContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"application/json": map[string]interface{}{"schema": schema}}
+}
@@ -0,0 +1,100 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/service/frontend/api"
+)
+
+func TestOpenAPISpec(t *testing.T) {
+	mux := newTestInbound(nil)
+	NewAPIHandler(api.NewMockHandler(gomock.NewController(t))).Register(mux)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var spec struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Parameters  []struct {
+				Name string `json:"name"`
+				In   string `json:"in"`
+			} `json:"parameters"`
+			RequestBody struct {
+				Content map[string]struct {
+					Schema struct {
+						Properties map[string]interface{} `json:"properties"`
+					} `json:"schema"`
+				} `json:"content"`
+			} `json:"requestBody"`
+			Responses map[string]interface{} `json:"responses"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+
+	routes := NewAPIHandler(nil).routes()
+	for _, rt := range routes {
+		operation, ok := spec.Paths[rt.path][strings.ToLower(rt.method)]
+		require.True(t, ok, "%s %s is not documented", rt.method, rt.path)
+		assert.Equal(t, rt.operationID, operation.OperationID)
+	}
+
+	start := spec.Paths["/domains/{domain}/workflows"]["post"]
+	assert.Equal(t, "domain", start.Parameters[0].Name)
+	assert.Equal(t, "path", start.Parameters[0].In)
+	startBody := start.RequestBody.Content["application/json"].Schema.Properties
+	assert.Contains(t, startBody, "input", "payloads hidden from the json encoding of common/types are in the body")
+	assert.Contains(t, startBody, "workflowId")
+	assert.NotContains(t, startBody, "domain", "fields set from the path are not in the body")
+	assert.Contains(t, start.Responses, "200")
+	assert.Contains(t, spec.Paths["/domains/{domain}/workflows/{workflowId}/signal"]["post"].Responses, "204")
+
+	taskList := spec.Components.Schemas["DescribeTaskListResponse"]
+	require.NotNil(t, taskList.Properties)
+	assert.Equal(t, "#/components/schemas/PollerInfo", taskList.Properties["pollers"]["items"].(map[string]interface{})["$ref"])
+	assert.Equal(t, []interface{}{"NORMAL", "STICKY", "EPHEMERAL"}, spec.Components.Schemas["TaskList"].Properties["kind"]["enum"])
+	assert.Equal(t, "int64", spec.Components.Schemas["HistoryEvent"].Properties["eventId"]["format"])
+	assert.Equal(t, "byte", spec.Components.Schemas["ActivityTaskScheduledEventAttributes"].Properties["input"]["format"])
+
+	// every referenced schema is in the components
+	refs := strings.Split(w.Body.String(), `"$ref":"#/components/schemas/`)
+	for _, ref := range refs[1:] {
+		name := ref[:strings.Index(ref, `"`)]
+		assert.Contains(t, spec.Components.Schemas, name)
+	}
+}
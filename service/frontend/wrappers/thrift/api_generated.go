@@ -241,11 +241,6 @@ func (g APIHandler) ScanWorkflowExecutions(ctx context.Context, ListRequest *sha
 	return mapper.FromScanWorkflowExecutionsResponse(response), mapper.FromError(err)
 }
 
-func (g APIHandler) SignalWithStartWorkflowExecution(ctx context.Context, SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (sp1 *shared.StartWorkflowExecutionResponse, err error) {
-	response, err := g.h.SignalWithStartWorkflowExecution(ctx, mapper.ToSignalWithStartWorkflowExecutionRequest(SignalWithStartRequest))
-	return mapper.FromSignalWithStartWorkflowExecutionResponse(response), mapper.FromError(err)
-}
-
 func (g APIHandler) SignalWithStartWorkflowExecutionAsync(ctx context.Context, SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionAsyncRequest) (sp1 *shared.SignalWithStartWorkflowExecutionAsyncResponse, err error) {
 	response, err := g.h.SignalWithStartWorkflowExecutionAsync(ctx, mapper.ToSignalWithStartWorkflowExecutionAsyncRequest(SignalWithStartRequest))
 	return mapper.FromSignalWithStartWorkflowExecutionAsyncResponse(response), mapper.FromError(err)
@@ -256,11 +251,6 @@ func (g APIHandler) SignalWorkflowExecution(ctx context.Context, SignalRequest *
 	return mapper.FromError(err)
 }
 
-func (g APIHandler) StartWorkflowExecution(ctx context.Context, StartRequest *shared.StartWorkflowExecutionRequest) (sp1 *shared.StartWorkflowExecutionResponse, err error) {
-	response, err := g.h.StartWorkflowExecution(ctx, mapper.ToStartWorkflowExecutionRequest(StartRequest))
-	return mapper.FromStartWorkflowExecutionResponse(response), mapper.FromError(err)
-}
-
 func (g APIHandler) StartWorkflowExecutionAsync(ctx context.Context, StartRequest *shared.StartWorkflowExecutionAsyncRequest) (sp1 *shared.StartWorkflowExecutionAsyncResponse, err error) {
 	response, err := g.h.StartWorkflowExecutionAsync(ctx, mapper.ToStartWorkflowExecutionAsyncRequest(StartRequest))
 	return mapper.FromStartWorkflowExecutionAsyncResponse(response), mapper.FromError(err)
@@ -27,7 +27,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
 
 	"github.com/uber/cadence/.gen/go/health"
 	"github.com/uber/cadence/.gen/go/shared"
@@ -315,3 +318,37 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 	})
 }
+
+func TestThriftHandlerRequestHeaders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	h := api.NewMockHandler(ctrl)
+	th := NewAPIHandler(h)
+	inboundCall := func(t *testing.T, workflowIDHash string) context.Context {
+		ctx, call := encoding.NewInboundCall(context.Background())
+		headers := transport.NewHeaders().With(common.WorkflowIDHashHeaderName, workflowIDHash)
+		require.NoError(t, call.ReadFromRequest(&transport.Request{Headers: headers}))
+		return ctx
+	}
+	policy := &types.ActiveClusterSelectionPolicy{WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "region"}}
+
+	t.Run("StartWorkflowExecution", func(t *testing.T) {
+		ctx := inboundCall(t, `{"scope":"region"}`)
+		h.EXPECT().StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{ActiveClusterSelectionPolicy: policy}).Return(&types.StartWorkflowExecutionResponse{RunID: "run"}, nil).Times(1)
+		resp, err := th.StartWorkflowExecution(ctx, &shared.StartWorkflowExecutionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, "run", resp.GetRunId())
+	})
+	t.Run("SignalWithStartWorkflowExecution", func(t *testing.T) {
+		ctx := inboundCall(t, `{"scope":"region"}`)
+		h.EXPECT().SignalWithStartWorkflowExecution(ctx, &types.SignalWithStartWorkflowExecutionRequest{ActiveClusterSelectionPolicy: policy}).Return(&types.StartWorkflowExecutionResponse{RunID: "run"}, nil).Times(1)
+		resp, err := th.SignalWithStartWorkflowExecution(ctx, &shared.SignalWithStartWorkflowExecutionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, "run", resp.GetRunId())
+	})
+	t.Run("malformed workflow ID hash", func(t *testing.T) {
+		_, err := th.StartWorkflowExecution(inboundCall(t, "region"), &shared.StartWorkflowExecutionRequest{})
+		var badRequest *shared.BadRequestError
+		assert.ErrorAs(t, err, &badRequest)
+	})
+}
@@ -31,6 +31,8 @@ import (
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
 	"github.com/uber/cadence/.gen/go/health"
 	"github.com/uber/cadence/.gen/go/health/metaserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 	"github.com/uber/cadence/service/frontend/admin"
 	"github.com/uber/cadence/service/frontend/api"
@@ -66,3 +68,24 @@ func (t APIHandler) Health(ctx context.Context) (*health.HealthStatus, error) {
 	response, err := t.h.Health(ctx)
 	return thrift.FromHealthStatus(response), thrift.FromError(err)
 }
+
+// StartWorkflowExecution and SignalWithStartWorkflowExecution are written by hand, they copy the values which are
+// not in the IDL yet from the request headers to the request
+
+func (t APIHandler) StartWorkflowExecution(ctx context.Context, request *shared.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	startRequest := thrift.ToStartWorkflowExecutionRequest(request)
+	if err := frontend.ReadStartWorkflowExecutionRequestHeaders(ctx, startRequest); err != nil {
+		return nil, thrift.FromError(err)
+	}
+	response, err := t.h.StartWorkflowExecution(ctx, startRequest)
+	return thrift.FromStartWorkflowExecutionResponse(response), thrift.FromError(err)
+}
+
+func (t APIHandler) SignalWithStartWorkflowExecution(ctx context.Context, request *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	signalWithStartRequest := thrift.ToSignalWithStartWorkflowExecutionRequest(request)
+	if err := frontend.ReadSignalWithStartWorkflowExecutionRequestHeaders(ctx, signalWithStartRequest); err != nil {
+		return nil, thrift.FromError(err)
+	}
+	response, err := t.h.SignalWithStartWorkflowExecution(ctx, signalWithStartRequest)
+	return thrift.FromSignalWithStartWorkflowExecutionResponse(response), thrift.FromError(err)
+}
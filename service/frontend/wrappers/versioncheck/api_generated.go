@@ -81,6 +81,14 @@ func (h *versionCheckHandler) DeprecateDomain(ctx context.Context, dp1 *types.De
 	return h.frontendHandler.DeprecateDomain(ctx, dp1)
 }
 
+func (h *versionCheckHandler) DescribeBatchOperation(ctx context.Context, dp1 *types.DescribeBatchOperationRequest) (dp2 *types.DescribeBatchOperationResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.DescribeBatchOperation(ctx, dp1)
+}
+
 func (h *versionCheckHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -165,6 +173,14 @@ func (h *versionCheckHandler) ListArchivedWorkflowExecutions(ctx context.Context
 	return h.frontendHandler.ListArchivedWorkflowExecutions(ctx, lp1)
 }
 
+func (h *versionCheckHandler) ListBatchOperations(ctx context.Context, lp1 *types.ListBatchOperationsRequest) (lp2 *types.ListBatchOperationsResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ListBatchOperations(ctx, lp1)
+}
+
 func (h *versionCheckHandler) ListClosedWorkflowExecutions(ctx context.Context, lp1 *types.ListClosedWorkflowExecutionsRequest) (lp2 *types.ListClosedWorkflowExecutionsResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -413,6 +429,14 @@ func (h *versionCheckHandler) SignalWorkflowExecution(ctx context.Context, sp1 *
 	return h.frontendHandler.SignalWorkflowExecution(ctx, sp1)
 }
 
+func (h *versionCheckHandler) StartBatchOperation(ctx context.Context, sp1 *types.StartBatchOperationRequest) (sp2 *types.StartBatchOperationResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.StartBatchOperation(ctx, sp1)
+}
+
 func (h *versionCheckHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -429,6 +453,14 @@ func (h *versionCheckHandler) StartWorkflowExecutionAsync(ctx context.Context, s
 	return h.frontendHandler.StartWorkflowExecutionAsync(ctx, sp1)
 }
 
+func (h *versionCheckHandler) StopBatchOperation(ctx context.Context, sp1 *types.StopBatchOperationRequest) (sp2 *types.StopBatchOperationResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.StopBatchOperation(ctx, sp1)
+}
+
 func (h *versionCheckHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.TerminateWorkflowExecutionRequest) (err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
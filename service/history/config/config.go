@@ -227,6 +227,13 @@ type Config struct {
 	// total number of parentClosePolicy system workflows
 	NumParentClosePolicySystemWorkflows dynamicproperties.IntPropertyFn
 
+	// Completion callback settings
+	EnableCompletionCallbacks                 dynamicproperties.BoolPropertyFnWithDomainFilter
+	CompletionCallbackAllowedHosts            dynamicproperties.ListPropertyFn
+	CompletionCallbackMaxAttempts             dynamicproperties.IntPropertyFnWithDomainFilter
+	CompletionCallbackRequestTimeout          dynamicproperties.DurationPropertyFn
+	CompletionCallbackMaxConcurrentDeliveries dynamicproperties.IntPropertyFn
+
 	// Archival settings
 	NumArchiveSystemWorkflows        dynamicproperties.IntPropertyFn
 	ArchiveRequestRPS                dynamicproperties.IntPropertyFn
@@ -243,6 +250,8 @@ type Config struct {
 	HistorySizeLimitWarn             dynamicproperties.IntPropertyFnWithDomainFilter
 	HistoryCountLimitError           dynamicproperties.IntPropertyFnWithDomainFilter
 	HistoryCountLimitWarn            dynamicproperties.IntPropertyFnWithDomainFilter
+	HistorySizeSuggestContinueAsNew  dynamicproperties.IntPropertyFnWithDomainFilter
+	HistoryCountSuggestContinueAsNew dynamicproperties.IntPropertyFnWithDomainFilter
 	PendingActivitiesCountLimitError dynamicproperties.IntPropertyFnWithDomainFilter
 	PendingActivitiesCountLimitWarn  dynamicproperties.IntPropertyFnWithDomainFilter
 	PendingActivityValidationEnabled dynamicproperties.BoolPropertyFn
@@ -531,6 +540,12 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		ParentClosePolicyThreshold:          dc.GetIntPropertyFilteredByDomain(dynamicproperties.ParentClosePolicyThreshold),
 		ParentClosePolicyBatchSize:          dc.GetIntPropertyFilteredByDomain(dynamicproperties.ParentClosePolicyBatchSize),
 
+		EnableCompletionCallbacks:                 dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableCompletionCallbacks),
+		CompletionCallbackAllowedHosts:            dc.GetListProperty(dynamicproperties.CompletionCallbackAllowedHosts),
+		CompletionCallbackMaxAttempts:             dc.GetIntPropertyFilteredByDomain(dynamicproperties.CompletionCallbackMaxAttempts),
+		CompletionCallbackRequestTimeout:          dc.GetDurationProperty(dynamicproperties.CompletionCallbackRequestTimeout),
+		CompletionCallbackMaxConcurrentDeliveries: dc.GetIntProperty(dynamicproperties.CompletionCallbackMaxConcurrentDeliveries),
+
 		NumArchiveSystemWorkflows:        dc.GetIntProperty(dynamicproperties.NumArchiveSystemWorkflows),
 		ArchiveRequestRPS:                dc.GetIntProperty(dynamicproperties.ArchiveRequestRPS),
 		ArchiveInlineHistoryRPS:          dc.GetIntProperty(dynamicproperties.ArchiveInlineHistoryRPS),
@@ -545,6 +560,8 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		HistorySizeLimitWarn:             dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistorySizeLimitWarn),
 		HistoryCountLimitError:           dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistoryCountLimitError),
 		HistoryCountLimitWarn:            dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistoryCountLimitWarn),
+		HistorySizeSuggestContinueAsNew:  dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistorySizeSuggestContinueAsNew),
+		HistoryCountSuggestContinueAsNew: dc.GetIntPropertyFilteredByDomain(dynamicproperties.HistoryCountSuggestContinueAsNew),
 		PendingActivitiesCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicproperties.PendingActivitiesCountLimitError),
 		PendingActivitiesCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicproperties.PendingActivitiesCountLimitWarn),
 		PendingActivityValidationEnabled: dc.GetBoolProperty(dynamicproperties.EnablePendingActivityValidation),
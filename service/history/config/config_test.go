@@ -187,6 +187,11 @@ func TestNewConfig(t *testing.T) {
 		"ParentClosePolicyThreshold":                           {dynamicproperties.ParentClosePolicyThreshold, 61},
 		"ParentClosePolicyBatchSize":                           {dynamicproperties.ParentClosePolicyBatchSize, 62},
 		"NumParentClosePolicySystemWorkflows":                  {dynamicproperties.NumParentClosePolicySystemWorkflows, 63},
+		"EnableCompletionCallbacks":                            {dynamicproperties.EnableCompletionCallbacks, true},
+		"CompletionCallbackAllowedHosts":                       {dynamicproperties.CompletionCallbackAllowedHosts, []interface{}{"hooks.example.com"}},
+		"CompletionCallbackMaxAttempts":                        {dynamicproperties.CompletionCallbackMaxAttempts, 11},
+		"CompletionCallbackRequestTimeout":                     {dynamicproperties.CompletionCallbackRequestTimeout, time.Second},
+		"CompletionCallbackMaxConcurrentDeliveries":            {dynamicproperties.CompletionCallbackMaxConcurrentDeliveries, 12},
 		"NumArchiveSystemWorkflows":                            {dynamicproperties.NumArchiveSystemWorkflows, 64},
 		"ArchiveRequestRPS":                                    {dynamicproperties.ArchiveRequestRPS, 65},
 		"ArchiveInlineHistoryRPS":                              {dynamicproperties.ArchiveInlineHistoryRPS, 66},
@@ -200,6 +205,8 @@ func TestNewConfig(t *testing.T) {
 		"HistorySizeLimitWarn":                                 {dynamicproperties.HistorySizeLimitWarn, 73},
 		"HistoryCountLimitError":                               {dynamicproperties.HistoryCountLimitError, 74},
 		"HistoryCountLimitWarn":                                {dynamicproperties.HistoryCountLimitWarn, 75},
+		"HistorySizeSuggestContinueAsNew":                      {dynamicproperties.HistorySizeSuggestContinueAsNew, 103},
+		"HistoryCountSuggestContinueAsNew":                     {dynamicproperties.HistoryCountSuggestContinueAsNew, 104},
 		"PendingActivitiesCountLimitError":                     {dynamicproperties.PendingActivitiesCountLimitError, 76},
 		"PendingActivitiesCountLimitWarn":                      {dynamicproperties.PendingActivitiesCountLimitWarn, 77},
 		"PendingActivityValidationEnabled":                     {dynamicproperties.EnablePendingActivityValidation, true},
@@ -366,6 +373,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicproperties.MapPropertyFn:
 			return fn()
+		case dynamicproperties.ListPropertyFn:
+			return fn()
 		case dynamicproperties.StringPropertyFn:
 			return fn()
 		case dynamicproperties.DurationPropertyFnWithDomainIDFilter:
@@ -209,6 +209,21 @@ func (c *workflowSizeChecker) failWorkflowSizeExceedsLimit() (bool, error) {
 	return false, nil
 }
 
+// suggestContinueAsNew returns true once the workflow history crossed one of the
+// per domain soft thresholds, so that workers can continue-as-new before the hard
+// limits enforced by failWorkflowSizeExceedsLimit are hit. A threshold of 0 disables the check.
+func suggestContinueAsNew(
+	historySize int64,
+	historyCount int64,
+	historySizeThreshold int,
+	historyCountThreshold int,
+) bool {
+	if historySizeThreshold > 0 && historySize >= int64(historySizeThreshold) {
+		return true
+	}
+	return historyCountThreshold > 0 && historyCount >= int64(historyCountThreshold)
+}
+
 func (v *attrValidator) validateActivityScheduleAttributes(
 	domainID string,
 	targetDomainID string,
@@ -1022,3 +1022,48 @@ func TestWorkflowSizeChecker_failWorkflowSizeExceedsLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestSuggestContinueAsNew(t *testing.T) {
+	for name, tc := range map[string]struct {
+		historySize           int64
+		historyCount          int64
+		historySizeThreshold  int
+		historyCountThreshold int
+		expected              bool
+	}{
+		"thresholds disabled": {
+			historySize:  1024 * 1024,
+			historyCount: 1024,
+		},
+		"below thresholds": {
+			historySize:           99,
+			historyCount:          9,
+			historySizeThreshold:  100,
+			historyCountThreshold: 10,
+		},
+		"history size threshold reached": {
+			historySize:           100,
+			historyCount:          9,
+			historySizeThreshold:  100,
+			historyCountThreshold: 10,
+			expected:              true,
+		},
+		"history count threshold reached": {
+			historySize:           99,
+			historyCount:          10,
+			historySizeThreshold:  100,
+			historyCountThreshold: 10,
+			expected:              true,
+		},
+		"only history count threshold configured": {
+			historySize:           1024 * 1024,
+			historyCount:          11,
+			historyCountThreshold: 10,
+			expected:              true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, suggestContinueAsNew(tc.historySize, tc.historyCount, tc.historySizeThreshold, tc.historyCountThreshold))
+		})
+	}
+}
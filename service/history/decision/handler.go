@@ -215,7 +215,7 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 			if decision.StartedID != constants.EmptyEventID {
 				// If decision is started as part of the current request scope then return a positive response
 				if decision.RequestID == requestID {
-					resp, err = handler.createRecordDecisionTaskStartedResponse(domainID, mutableState, decision, req.PollRequest.GetIdentity())
+					resp, err = handler.createRecordDecisionTaskStartedResponse(domainEntry.GetInfo().Name, mutableState, decision, req.PollRequest.GetIdentity())
 					if err != nil {
 						return nil, err
 					}
@@ -234,7 +234,7 @@ func (handler *handlerImpl) HandleDecisionTaskStarted(
 				return nil, &types.InternalServiceError{Message: "Unable to add DecisionTaskStarted event to history."}
 			}
 
-			resp, err = handler.createRecordDecisionTaskStartedResponse(domainID, mutableState, decision, req.PollRequest.GetIdentity())
+			resp, err = handler.createRecordDecisionTaskStartedResponse(domainEntry.GetInfo().Name, mutableState, decision, req.PollRequest.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
@@ -634,7 +634,7 @@ Update_History_Loop:
 
 		if request.GetReturnNewDecisionTask() && createNewDecisionTask {
 			decision, _ := msBuilder.GetDecisionInfo(newDecisionTaskScheduledID)
-			resp.StartedResponse, err = handler.createRecordDecisionTaskStartedResponse(domainID, msBuilder, decision, request.GetIdentity())
+			resp.StartedResponse, err = handler.createRecordDecisionTaskStartedResponse(domainName, msBuilder, decision, request.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
@@ -649,7 +649,7 @@ Update_History_Loop:
 }
 
 func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
-	domainID string,
+	domainName string,
 	msBuilder execution.MutableState,
 	decision *execution.DecisionInfo,
 	identity string,
@@ -711,6 +711,13 @@ func (handler *handlerImpl) createRecordDecisionTaskStartedResponse(
 	}
 	response.Queries = queries
 	response.HistorySize = msBuilder.GetHistorySize()
+	response.HistoryCount = response.NextEventID - 1
+	response.ContinueAsNewSuggested = suggestContinueAsNew(
+		response.HistorySize,
+		response.HistoryCount,
+		handler.config.HistorySizeSuggestContinueAsNew(domainName),
+		handler.config.HistoryCountSuggestContinueAsNew(domainName),
+	)
 	return response, nil
 }
 
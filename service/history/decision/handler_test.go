@@ -1212,10 +1212,12 @@ func TestHandleDecisionTaskCompleted(t *testing.T) {
 
 func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 	tests := []struct {
-		name        string
-		expectCalls func()
-		expectedErr error
-		indexes     []string
+		name                             string
+		historyCountSuggestContinueAsNew int
+		expectCalls                      func()
+		expectedErr                      error
+		expectedContinueAsNewSuggested   bool
+		indexes                          []string
 	}{
 		{
 			name: "success",
@@ -1234,6 +1236,22 @@ func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 			expectedErr: nil,
 			indexes:     []string{"test-id", "test-id1"},
 		},
+		{
+			name:                             "success - continue-as-new suggested",
+			historyCountSuggestContinueAsNew: 100,
+			expectCalls: func() {
+				s.mockMutableState.EXPECT().GetWorkflowType().Return(&types.WorkflowType{})
+				s.mockMutableState.EXPECT().GetNextEventID().Return(int64(101))
+				s.mockMutableState.EXPECT().CreateTransientDecisionEvents(gomock.Any(), "test-identity").Return(&types.HistoryEvent{}, &types.HistoryEvent{})
+				s.mockMutableState.EXPECT().GetCurrentBranchToken().Return([]byte{}, nil)
+				registry := query.NewMockRegistry(s.controller)
+				s.mockMutableState.EXPECT().GetQueryRegistry().Return(registry)
+				registry.EXPECT().GetBufferedIDs().Return(nil)
+				s.mockMutableState.EXPECT().GetHistorySize().Return(int64(1024))
+			},
+			expectedErr:                    nil,
+			expectedContinueAsNewSuggested: true,
+		},
 		{
 			name: "failure",
 			expectCalls: func() {
@@ -1256,7 +1274,8 @@ func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 				Attempt:    1,
 			}
 			test.expectCalls()
-			resp, err := s.decisionHandler.createRecordDecisionTaskStartedResponse(constants.TestDomainID, s.mockMutableState, decision, "test-identity")
+			s.decisionHandler.config.HistoryCountSuggestContinueAsNew = dynamicproperties.GetIntPropertyFilteredByDomain(test.historyCountSuggestContinueAsNew)
+			resp, err := s.decisionHandler.createRecordDecisionTaskStartedResponse(constants.TestDomainName, s.mockMutableState, decision, "test-identity")
 			s.Equal(test.expectedErr, err)
 			if err != nil {
 				s.Nil(resp)
@@ -1264,6 +1283,8 @@ func (s *DecisionHandlerSuite) TestCreateRecordDecisionTaskStartedResponse() {
 				s.Equal(&types.HistoryEvent{}, resp.DecisionInfo.ScheduledEvent)
 				s.Equal(&types.HistoryEvent{}, resp.DecisionInfo.StartedEvent)
 				s.Equal([]byte{}, resp.BranchToken)
+				s.Equal(resp.NextEventID-1, resp.HistoryCount)
+				s.Equal(test.expectedContinueAsNewSuggested, resp.ContinueAsNewSuggested)
 				for _, index := range test.indexes {
 					_, ok := resp.Queries[index]
 					s.True(ok)
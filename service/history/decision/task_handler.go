@@ -1050,6 +1050,7 @@ func (handler *taskHandlerImpl) retryCronContinueAsNew(
 	failureDetails []byte,
 	lastCompletionResult []byte,
 ) error {
+	// the started event may have lost the workflow ID hash of the active cluster selection policy, the execution info keeps it
 	continueAsNewAttributes := &types.ContinueAsNewWorkflowExecutionDecisionAttributes{
 		WorkflowType:                        attr.WorkflowType,
 		TaskList:                            attr.TaskList,
@@ -1068,7 +1069,7 @@ func (handler *taskHandlerImpl) retryCronContinueAsNew(
 		SearchAttributes:                    attr.SearchAttributes,
 		JitterStartSeconds:                  attr.JitterStartSeconds,
 		CronOverlapPolicy:                   attr.CronOverlapPolicy,
-		ActiveClusterSelectionPolicy:        attr.ActiveClusterSelectionPolicy,
+		ActiveClusterSelectionPolicy:        handler.mutableState.GetExecutionInfo().ActiveClusterSelectionPolicy,
 	}
 
 	_, newStateBuilder, err := handler.mutableState.AddContinueAsNewEvent(
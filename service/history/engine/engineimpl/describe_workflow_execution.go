@@ -124,6 +124,7 @@ func createDescribeWorkflowExecutionResponse(ctx context.Context, mutableState e
 
 	childExecutions := mutableState.GetPendingChildExecutionInfos()
 	domainEntry := mutableState.GetDomainEntry()
+	workflowExecutionInfo.ReplicationExcluded = executionInfo.ReplicationExcluded
 	for _, childExecution := range childExecutions {
 		pendingChild, err := mapPendingChildExecutionInfo(childExecution, domainEntry, domainCache)
 		if err != nil {
@@ -136,6 +137,8 @@ func createDescribeWorkflowExecutionResponse(ctx context.Context, mutableState e
 		result.PendingDecision = mapPendingDecisionInfo(di)
 	}
 
+	result.CompletionCallbacks = mapCompletionCallbackInfos(executionInfo.CompletionCallbacks)
+
 	return result, nil
 }
 
@@ -194,6 +197,22 @@ func mapWorkflowExecutionInfo(executionInfo *persistence.WorkflowExecutionInfo,
 	return result, nil
 }
 
+// mapCompletionCallbackInfos returns the delivery state of the callbacks without their headers,
+// as those usually carry credentials for the callback endpoint
+func mapCompletionCallbackInfos(infos []*types.CompletionCallbackInfo) []*types.CompletionCallbackInfo {
+	var result []*types.CompletionCallbackInfo
+	for _, info := range infos {
+		result = append(result, &types.CompletionCallbackInfo{
+			Callback:             &types.CompletionCallback{URL: info.GetCallback().GetURL()},
+			State:                info.GetState(),
+			Attempt:              info.GetAttempt(),
+			LastAttemptTimestamp: info.LastAttemptTimestamp,
+			LastFailure:          info.GetLastFailure(),
+		})
+	}
+	return result
+}
+
 func mapPendingActivityInfo(ai *persistence.ActivityInfo, activityScheduledEvent *types.HistoryEvent) *types.PendingActivityInfo {
 	p := &types.PendingActivityInfo{
 		ActivityID: ai.ActivityID,
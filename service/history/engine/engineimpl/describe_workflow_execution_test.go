@@ -286,6 +286,39 @@ func TestCreateDescribeWorkflowExecutionResponse(t *testing.T) {
 				assert.Nil(t, result.PendingDecision)
 			},
 		},
+		{
+			name: "Success - workflow excluded from replication when it started",
+			setupMocks: func(mockMutableState *execution.MockMutableState, mockDomainCache *cache.MockDomainCache) {
+				// the domain filter has been removed since, the workflow stays excluded
+				domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+					&persistence.DomainInfo{ID: "test-domain-id", Name: "test-domain-name"},
+					&persistence.DomainConfig{},
+					&persistence.DomainReplicationConfig{
+						ActiveClusterName: "cluster-a",
+						Clusters:          []*persistence.ClusterReplicationConfig{{ClusterName: "cluster-a"}, {ClusterName: "cluster-b"}},
+					},
+					0,
+				)
+				mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+					DomainID:            "test-domain-id",
+					TaskList:            "test-task-list",
+					WorkflowTypeName:    "test-workflow-type",
+					State:               persistence.WorkflowStateRunning,
+					ReplicationExcluded: true,
+				})
+				mockMutableState.EXPECT().GetStartEvent(gomock.Any()).Return(&types.HistoryEvent{
+					WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+				}, nil)
+				mockMutableState.EXPECT().GetNextEventID().Return(int64(10))
+				mockMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistence.ActivityInfo{})
+				mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistence.ChildExecutionInfo{})
+				mockMutableState.EXPECT().GetDomainEntry().Return(domainEntry)
+				mockMutableState.EXPECT().GetPendingDecision().Return(nil, false)
+			},
+			verifyResult: func(t *testing.T, result *types.DescribeWorkflowExecutionResponse) {
+				assert.True(t, result.WorkflowExecutionInfo.ReplicationExcluded)
+			},
+		},
 		{
 			name: "Error - GetStartEvent fails",
 			setupMocks: func(mockMutableState *execution.MockMutableState, mockDomainCache *cache.MockDomainCache) {
@@ -1142,6 +1175,59 @@ func TestMapPendingDecisionInfo(t *testing.T) {
 	}
 }
 
+func TestMapCompletionCallbackInfos(t *testing.T) {
+	testCases := []struct {
+		name     string
+		infos    []*types.CompletionCallbackInfo
+		expected []*types.CompletionCallbackInfo
+	}{
+		{
+			name:     "Success - no callbacks",
+			infos:    nil,
+			expected: nil,
+		},
+		{
+			name: "Success - headers are not returned",
+			infos: []*types.CompletionCallbackInfo{
+				{
+					Callback: &types.CompletionCallback{
+						URL:    "https://example.com/done",
+						Header: map[string]string{"Authorization": "Bearer secret"},
+					},
+					State:                types.CompletionCallbackStateFailed,
+					Attempt:              3,
+					LastAttemptTimestamp: common.Int64Ptr(1234567890),
+					LastFailure:          "completion callback returned 400 Bad Request",
+				},
+				{
+					Callback: &types.CompletionCallback{URL: "http://localhost:8080/done"},
+					State:    types.CompletionCallbackStateScheduled,
+				},
+			},
+			expected: []*types.CompletionCallbackInfo{
+				{
+					Callback:             &types.CompletionCallback{URL: "https://example.com/done"},
+					State:                types.CompletionCallbackStateFailed,
+					Attempt:              3,
+					LastAttemptTimestamp: common.Int64Ptr(1234567890),
+					LastFailure:          "completion callback returned 400 Bad Request",
+				},
+				{
+					Callback: &types.CompletionCallback{URL: "http://localhost:8080/done"},
+					State:    types.CompletionCallbackStateScheduled,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := mapCompletionCallbackInfos(tc.infos)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
 func TestValidateDescribeWorkflowExecutionRequest(t *testing.T) {
 	testCases := []struct {
 		name        string
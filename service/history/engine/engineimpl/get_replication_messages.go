@@ -77,6 +77,14 @@ func (e *historyEngineImpl) GetReplicationMessages(
 	return replicationMessages, nil
 }
 
+func (e *historyEngineImpl) GetReplicationStatus(
+	ctx context.Context,
+	pollingCluster string,
+	domainID string,
+) (*types.ReplicationStatus, error) {
+	return e.replicationAckManager.GetStatus(ctx, pollingCluster, domainID)
+}
+
 func (e *historyEngineImpl) GetDLQReplicationMessages(
 	ctx context.Context,
 	taskInfos []*types.ReplicationTaskInfo,
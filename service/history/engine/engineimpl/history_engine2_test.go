@@ -1954,6 +1954,60 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 	s.Equal(runID, resp.GetRunID())
 }
 
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal_UseExistingConflictPolicy() {
+	s.testSignalWithStartSignalsRunningWorkflow(types.WorkflowIDConflictPolicyUseExisting)
+}
+
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal_SignalExistingConflictPolicy() {
+	s.testSignalWithStartSignalsRunningWorkflow(types.WorkflowIDConflictPolicySignalExisting)
+}
+
+func (s *engine2Suite) testSignalWithStartSignalsRunningWorkflow(conflictPolicy types.WorkflowIDConflictPolicy) {
+	runID := constants.TestRunID
+	signalName := "my signal name"
+	testActiveClusterInfo := &types.ActiveClusterInfo{
+		ActiveClusterName: s.mockShard.GetClusterMetadata().GetCurrentClusterName(),
+		FailoverVersion:   0,
+	}
+	s.mockShard.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(testActiveClusterInfo, nil).Times(1)
+	sRequest := &types.HistorySignalWithStartWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalWithStartRequest: &types.SignalWithStartWorkflowExecutionRequest{
+			Domain:                   constants.TestDomainID,
+			WorkflowID:               "wId",
+			Identity:                 "testIdentity",
+			SignalName:               signalName,
+			SignalInput:              []byte("signal input"),
+			WorkflowIDConflictPolicy: conflictPolicy.Ptr(),
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.historyEngine.shard,
+		testlogger.New(s.Suite.T()),
+		runID,
+		constants.TestLocalDomainEntry,
+	)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything, mock.Anything).Return(&p.GetCurrentExecutionResponse{RunID: runID}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	var appended []*types.HistoryEvent
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { appended = args.Get(1).(*p.AppendHistoryNodesRequest).Events }).
+		Return(&p.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.Nil(err)
+	s.Equal(runID, resp.GetRunID())
+	// the running workflow is signaled instead of being started again
+	s.NotEmpty(appended)
+	s.Equal(types.EventTypeWorkflowExecutionSignaled, appended[0].GetEventType())
+	s.Equal(signalName, appended[0].WorkflowExecutionSignaledEventAttributes.GetSignalName())
+}
+
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal_DuplicateRequestError() {
 	domainID := constants.TestDomainID
 	workflowID := "wId"
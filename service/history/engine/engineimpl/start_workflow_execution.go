@@ -285,6 +285,14 @@ func (e *historyEngineImpl) startWorkflowHelper(
 			}
 		}
 
+		if shouldUseExistingWorkflow(startRequest, t.State) {
+			// the history branch created above is not used by the running workflow
+			e.handleCreateWorkflowExecutionFailureCleanup(ctx, startRequest, domainEntry, workflowExecution, historyBlob, false, err)
+			return &types.StartWorkflowExecutionResponse{
+				RunID: t.RunID,
+			}, workflowExecution, historyBlob, nil
+		}
+
 		prevRunID = t.RunID
 		if shouldTerminateAndStart(startRequest, t.State) {
 			runningWFCtx, err := workflow.LoadOnce(ctx, e.executionCache, domainID, workflowID, prevRunID)
@@ -524,8 +532,20 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				break
 			}
 
+			// workflow is running, an explicit conflict policy rejects the request or signals the running workflow,
+			// UseExisting and SignalExisting only skip the start
+			if sRequest.WorkflowIDConflictPolicy != nil && sRequest.GetWorkflowIDConflictPolicy() == types.WorkflowIDConflictPolicyFail {
+				msg := "Workflow execution is already running. WorkflowId: %v, RunId: %v."
+				return nil, getWorkflowAlreadyStartedError(
+					msg,
+					mutableState.GetExecutionInfo().CreateRequestID,
+					workflowExecution.GetWorkflowID(),
+					wfContext.GetExecution().RunID,
+				)
+			}
+
 			// workflow is running, if policy is TerminateIfRunning, terminate current run then signalWithStart
-			if sRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning {
+			if sRequest.WorkflowIDConflictPolicy == nil && sRequest.GetWorkflowIDReusePolicy() == types.WorkflowIDReusePolicyTerminateIfRunning {
 				workflowExecution.RunID = uuid.New()
 				runningWFCtx := workflow.NewContext(wfContext, release, mutableState)
 				resp, errTerm := e.terminateAndStartWorkflow(
@@ -676,6 +696,11 @@ func shouldTerminateAndStart(startRequest *types.HistoryStartWorkflowExecutionRe
 		persistence.IsWorkflowRunning(state)
 }
 
+func shouldUseExistingWorkflow(startRequest *types.HistoryStartWorkflowExecutionRequest, state int) bool {
+	return startRequest.StartRequest.GetWorkflowIDConflictPolicy() == types.WorkflowIDConflictPolicyUseExisting &&
+		persistence.IsWorkflowRunning(state)
+}
+
 func (e *historyEngineImpl) validateStartWorkflowExecutionRequest(request *types.StartWorkflowExecutionRequest, metricsScope metrics.ScopeIdx) error {
 	if len(request.GetRequestID()) == 0 {
 		return &types.BadRequestError{Message: "Missing request ID."}
@@ -786,6 +811,9 @@ func (e *historyEngineImpl) terminateAndStartWorkflow(
 		return nil, err
 	}
 	if activeCluster != e.currentClusterName {
+		if err := e.resolveActiveClusterSelectionPolicy(ctx, domainEntry, startRequest); err != nil {
+			return nil, err
+		}
 		if runningMutableState.GetExecutionInfo().ActiveClusterSelectionPolicy.Equals(startRequest.StartRequest.ActiveClusterSelectionPolicy) {
 			return nil, e.newDomainNotActiveError(domainEntry, runningMutableState.GetCurrentVersion())
 		}
@@ -998,12 +1026,38 @@ func (e *historyEngineImpl) newChildContext(
 	return context.WithTimeout(context.Background(), ctxTimeout)
 }
 
+// resolveActiveClusterSelectionPolicy selects the cluster attribute of a workflow ID hash policy, the resolved
+// cluster attribute is recorded in the started event so the run keeps it when the domain's weights change
+func (e *historyEngineImpl) resolveActiveClusterSelectionPolicy(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	startRequest *types.HistoryStartWorkflowExecutionRequest,
+) error {
+	if startRequest.StartRequest.ActiveClusterSelectionPolicy.GetWorkflowIDHash() == nil {
+		return nil
+	}
+	policy, err := e.shard.GetActiveClusterManager().ResolveActiveClusterSelectionPolicy(ctx, domainEntry.GetInfo().ID, startRequest.StartRequest.GetWorkflowID(), startRequest.StartRequest.ActiveClusterSelectionPolicy)
+	if err != nil {
+		var errNotFound *activecluster.ClusterAttributeNotFoundError
+		if !errors.As(err, &errNotFound) {
+			return err
+		}
+		e.logger.Warn("Failed to resolve active cluster selection policy by workflow ID hash", tag.Error(err))
+		return errClusterAttributeNotFound
+	}
+	startRequest.StartRequest.ActiveClusterSelectionPolicy = policy
+	return nil
+}
+
 func (e *historyEngineImpl) createMutableState(
 	ctx context.Context,
 	domainEntry *cache.DomainCacheEntry,
 	runID string,
 	startRequest *types.HistoryStartWorkflowExecutionRequest,
 ) (execution.MutableState, error) {
+	if err := e.resolveActiveClusterSelectionPolicy(ctx, domainEntry, startRequest); err != nil {
+		return nil, err
+	}
 	activeClusterInfo, err := e.shard.GetActiveClusterManager().GetActiveClusterInfoByClusterAttribute(ctx, domainEntry.GetInfo().ID, startRequest.StartRequest.ActiveClusterSelectionPolicy.GetClusterAttribute())
 	if err != nil {
 		var errNotFound *activecluster.ClusterAttributeNotFoundError
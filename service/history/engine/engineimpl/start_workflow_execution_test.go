@@ -386,6 +386,44 @@ func TestStartWorkflowExecution_OrphanedHistoryCleanup(t *testing.T) {
 			expectHistoryCleanup: false, // Cleanup doesn't happen because success is returned
 			wantErr:              false,
 		},
+		{
+			name: "cleanup orphaned history when use existing conflict policy returns the running workflow",
+			request: &types.HistoryStartWorkflowExecutionRequest{
+				DomainUUID: constants.TestDomainID,
+				StartRequest: &types.StartWorkflowExecutionRequest{
+					Domain:                              constants.TestDomainName,
+					WorkflowID:                          "workflow-id",
+					WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+					TaskList:                            &types.TaskList{Name: "default-task-list"},
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+					Identity:                            "workflow-starter",
+					RequestID:                           "request-id",
+					WorkflowIDConflictPolicy:            types.WorkflowIDConflictPolicyUseExisting.Ptr(),
+				},
+			},
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				domainEntry := &cache.DomainCacheEntry{}
+				eft.ShardCtx.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(domainEntry, nil).AnyTimes()
+				eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByClusterAttribute(gomock.Any(), constants.TestDomainID, nil).Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil)
+
+				historyV2Mgr := eft.ShardCtx.Resource.HistoryMgr
+				historyV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.AnythingOfType("*persistence.AppendHistoryNodesRequest")).
+					Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+
+				eft.ShardCtx.Resource.ExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.Anything).
+					Return(nil, &persistence.WorkflowExecutionAlreadyStartedError{
+						StartRequestID: "different-request-id",
+						RunID:          "existing-run-id",
+						State:          persistence.WorkflowStateRunning,
+					}).Once()
+
+				historyV2Mgr.On("DeleteHistoryBranch", mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			enableCleanupFlag:    true,
+			expectHistoryCleanup: true,
+			wantErr:              false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -418,6 +456,64 @@ func TestStartWorkflowExecution_OrphanedHistoryCleanup(t *testing.T) {
 }
 
 func TestSignalWithStartWorkflowExecution(t *testing.T) {
+	// mockRunningWorkflow makes the current run of the test workflow open
+	mockRunningWorkflow := func(eft *testdata.EngineForTest) {
+		domainEntry := &cache.DomainCacheEntry{}
+		eft.ShardCtx.Resource.DomainCache.EXPECT().GetDomainByID(constants.TestDomainID).Return(domainEntry, nil).AnyTimes()
+		getCurrentExecReq := &persistence.GetCurrentExecutionRequest{
+			ShardID:    common.Ptr(0),
+			DomainID:   constants.TestDomainID,
+			WorkflowID: constants.TestWorkflowID,
+			DomainName: constants.TestDomainName,
+		}
+		getCurrentExecResp := &persistence.GetCurrentExecutionResponse{
+			RunID:       constants.TestRunID,
+			State:       persistence.WorkflowStateRunning,
+			CloseStatus: persistence.WorkflowCloseStatusNone,
+		}
+		eft.ShardCtx.Resource.ExecutionMgr.On("GetCurrentExecution", mock.Anything, getCurrentExecReq).Return(getCurrentExecResp, nil).Once()
+		getExecReq := &persistence.GetWorkflowExecutionRequest{
+			ShardID:    common.Ptr(0),
+			DomainID:   constants.TestDomainID,
+			Execution:  types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID},
+			DomainName: constants.TestDomainName,
+			RangeID:    1,
+		}
+		getExecResp := &persistence.GetWorkflowExecutionResponse{
+			State: &persistence.WorkflowMutableState{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					DomainID:        constants.TestDomainID,
+					WorkflowID:      constants.TestWorkflowID,
+					RunID:           constants.TestRunID,
+					CreateRequestID: "existing-request-id",
+				},
+				ExecutionStats: &persistence.ExecutionStats{},
+			},
+			MutableStateStats: &persistence.MutableStateStats{},
+		}
+		eft.ShardCtx.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, getExecReq).Return(getExecResp, nil).Once()
+		eft.ShardCtx.Resource.ActiveClusterMgr.EXPECT().GetActiveClusterInfoByWorkflow(gomock.Any(), constants.TestDomainID, constants.TestWorkflowID, constants.TestRunID).Return(&types.ActiveClusterInfo{ActiveClusterName: cluster.TestCurrentClusterName}, nil).AnyTimes()
+		eft.ShardCtx.Resource.ShardMgr.On("UpdateShard", mock.Anything, mock.Anything).Return(nil)
+	}
+	newRequest := func(conflictPolicy types.WorkflowIDConflictPolicy) *types.HistorySignalWithStartWorkflowExecutionRequest {
+		return &types.HistorySignalWithStartWorkflowExecutionRequest{
+			DomainUUID: constants.TestDomainID,
+			SignalWithStartRequest: &types.SignalWithStartWorkflowExecutionRequest{
+				Domain:                              constants.TestDomainName,
+				WorkflowID:                          constants.TestWorkflowID,
+				WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+				SignalName:                          "signal-name",
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(3600),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+				TaskList:                            &types.TaskList{Name: "default-task-list"},
+				RequestID:                           "request-id-for-start",
+				SignalInput:                         []byte("signal-input"),
+				Identity:                            "tester",
+				WorkflowIDConflictPolicy:            conflictPolicy.Ptr(),
+			},
+		}
+	}
+
 	tests := []struct {
 		name       string
 		setupMocks func(*testing.T, *testdata.EngineForTest)
@@ -560,6 +656,14 @@ func TestSignalWithStartWorkflowExecution(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:    "fail conflict policy rejects the request when the workflow is running",
+			request: newRequest(types.WorkflowIDConflictPolicyFail),
+			setupMocks: func(t *testing.T, eft *testdata.EngineForTest) {
+				mockRunningWorkflow(eft)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -582,9 +686,13 @@ func TestSignalWithStartWorkflowExecution(t *testing.T) {
 }
 
 func TestCreateMutableState(t *testing.T) {
+	workflowIDHashPolicy := &types.ActiveClusterSelectionPolicy{
+		WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "region"},
+	}
 	tests := []struct {
 		name           string
 		domainEntry    *cache.DomainCacheEntry
+		policy         *types.ActiveClusterSelectionPolicy
 		mockFn         func(ac *activecluster.MockManager)
 		wantErr        bool
 		wantVersion    int64
@@ -703,6 +811,35 @@ func TestCreateMutableState(t *testing.T) {
 			wantErr:        true,
 			wantErrMessage: "Cannot start workflow with a cluster attribute that is not found in the domain's metadata.",
 		},
+		{
+			name:        "create mutable state successfully, workflow ID hash policy is resolved to a cluster attribute",
+			domainEntry: getDomainCacheEntry(0, nil),
+			policy:      workflowIDHashPolicy,
+			mockFn: func(ac *activecluster.MockManager) {
+				ac.EXPECT().ResolveActiveClusterSelectionPolicy(gomock.Any(), gomock.Any(), "wid", workflowIDHashPolicy).
+					Return(&types.ActiveClusterSelectionPolicy{
+						ClusterAttribute: &types.ClusterAttribute{Scope: "region", Name: "us-east"},
+						WorkflowIDHash:   workflowIDHashPolicy.WorkflowIDHash,
+					}, nil)
+				ac.EXPECT().GetActiveClusterInfoByClusterAttribute(gomock.Any(), gomock.Any(), &types.ClusterAttribute{Scope: "region", Name: "us-east"}).
+					Return(&types.ActiveClusterInfo{
+						ActiveClusterName: cluster.TestCurrentClusterName,
+						FailoverVersion:   2,
+					}, nil)
+			},
+			wantVersion: 2,
+		},
+		{
+			name:        "failed to create mutable state, workflow ID hash policy has no cluster attribute to select",
+			domainEntry: getDomainCacheEntry(0, nil),
+			policy:      workflowIDHashPolicy,
+			mockFn: func(ac *activecluster.MockManager) {
+				ac.EXPECT().ResolveActiveClusterSelectionPolicy(gomock.Any(), gomock.Any(), "wid", workflowIDHashPolicy).
+					Return(nil, &activecluster.ClusterAttributeNotFoundError{})
+			},
+			wantErr:        true,
+			wantErrMessage: "Cannot start workflow with a cluster attribute that is not found in the domain's metadata.",
+		},
 	}
 
 	for _, tc := range tests {
@@ -721,7 +858,10 @@ func TestCreateMutableState(t *testing.T) {
 				tc.domainEntry,
 				"rid",
 				&types.HistoryStartWorkflowExecutionRequest{
-					StartRequest: &types.StartWorkflowExecutionRequest{},
+					StartRequest: &types.StartWorkflowExecutionRequest{
+						WorkflowID:                   "wid",
+						ActiveClusterSelectionPolicy: tc.policy,
+					},
 				},
 			)
 			if tc.wantErr {
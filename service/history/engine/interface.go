@@ -62,6 +62,7 @@ type (
 		SyncShardStatus(ctx context.Context, request *types.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *types.SyncActivityRequest) error
 		GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID int64) (*types.ReplicationMessages, error)
+		GetReplicationStatus(ctx context.Context, pollingCluster string, domainID string) (*types.ReplicationStatus, error)
 		GetDLQReplicationMessages(ctx context.Context, taskInfos []*types.ReplicationTaskInfo) ([]*types.ReplicationTask, error)
 		QueryWorkflow(ctx context.Context, request *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error)
 		ReapplyEvents(ctx context.Context, domainUUID string, workflowID string, runID string, events []*types.HistoryEvent) error
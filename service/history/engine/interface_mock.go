@@ -164,6 +164,21 @@ func (mr *MockEngineMockRecorder) GetReplicationMessages(ctx, pollingCluster, la
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetReplicationMessages), ctx, pollingCluster, lastReadMessageID)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockEngine) GetReplicationStatus(ctx context.Context, pollingCluster, domainID string) (*types.ReplicationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", ctx, pollingCluster, domainID)
+	ret0, _ := ret[0].(*types.ReplicationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockEngineMockRecorder) GetReplicationStatus(ctx, pollingCluster, domainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockEngine)(nil).GetReplicationStatus), ctx, pollingCluster, domainID)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockEngine) MergeDLQMessages(ctx context.Context, messagesRequest *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
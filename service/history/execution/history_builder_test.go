@@ -30,6 +30,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
 	commonconstants "github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
@@ -497,6 +498,46 @@ func (s *historyBuilderSuite) TestHistoryBuilderWorkflowStartFailures() {
 	s.Equal(commonconstants.EmptyEventID, s.getPreviousDecisionStartedEventID())
 }
 
+func (s *historyBuilderSuite) TestHistoryBuilderWorkflowStartReplicationExcluded() {
+	newDomainEntry := func(filter string) *cache.DomainCacheEntry {
+		return cache.NewGlobalDomainCacheEntryForTest(
+			&persistence.DomainInfo{
+				ID:   s.domainID,
+				Name: s.domainName,
+				Data: map[string]string{commonconstants.DomainDataKeyForReplicationFilter: filter},
+			},
+			&persistence.DomainConfig{Retention: 1},
+			&persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+					{ClusterName: cluster.TestAlternativeClusterName},
+				},
+			},
+			cluster.TestCurrentClusterInitialFailoverVersion,
+		)
+	}
+	tests := map[string]struct {
+		filter   string
+		excluded bool
+	}{
+		"no filter":              {},
+		"workflow type excluded": {filter: `{"excludedWorkflowTypes":["ephemeral"]}`, excluded: true},
+		"task list excluded":     {filter: `{"excludedTaskLists":["scratch"]}`, excluded: true},
+		"other workflow types":   {filter: `{"excludedWorkflowTypes":["other"]}`},
+	}
+
+	for name, td := range tests {
+		s.Run(name, func() {
+			s.msBuilder = NewMutableStateBuilder(s.mockShard, s.logger, newDomainEntry(td.filter))
+			we := types.WorkflowExecution{WorkflowID: "replication-excluded-workflow-id", RunID: uuid.New()}
+
+			s.addWorkflowExecutionStartedEvent(we, "ephemeral", "scratch", nil, 60, 10, "identity", nil)
+			s.Equal(td.excluded, s.msBuilder.GetExecutionInfo().ReplicationExcluded)
+		})
+	}
+}
+
 func (s *historyBuilderSuite) TestHistoryBuilderDecisionScheduledFailures() {
 	id := "historybuilder-decisionscheduled-failures-test-workflow-id"
 	rid := "historybuilder-decisionscheduled-failures-test-run-id"
@@ -1230,6 +1230,15 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 		}
 	}
 
+	if attributes.ActiveClusterSelectionPolicy.GetWorkflowIDHash() != nil {
+		// resolve the workflow ID hash again so that new runs follow changes of the cluster attribute weights
+		policy, err := e.shard.GetActiveClusterManager().ResolveActiveClusterSelectionPolicy(ctx, e.domainEntry.GetInfo().ID, e.executionInfo.WorkflowID, attributes.ActiveClusterSelectionPolicy)
+		if err != nil {
+			return nil, nil, err
+		}
+		attributes.ActiveClusterSelectionPolicy = policy
+	}
+
 	continueAsNewEvent := e.hBuilder.AddContinuedAsNewEvent(decisionCompletedEventID, newRunID, attributes)
 	currentStartEvent, err := e.GetStartEvent(ctx)
 	if err != nil {
@@ -1742,6 +1751,7 @@ func (e *mutableStateBuilder) eventsToReplicationTask(
 
 	if transactionPolicy == TransactionPolicyPassive ||
 		!e.canReplicateEvents() ||
+		e.isReplicationExcluded() ||
 		len(events) == 0 {
 		return emptyTasks, nil
 	}
@@ -1800,7 +1810,8 @@ func (e *mutableStateBuilder) syncActivityToReplicationTask(
 ) []persistence.Task {
 
 	if transactionPolicy == TransactionPolicyPassive ||
-		!e.canReplicateEvents() {
+		!e.canReplicateEvents() ||
+		e.isReplicationExcluded() {
 		return emptyTasks
 	}
 
@@ -1853,6 +1864,13 @@ func (e *mutableStateBuilder) canReplicateEvents() bool {
 	return e.shard.GetConfig().EnableReplicationTaskGeneration(domainID, workflowID)
 }
 
+// isReplicationExcluded returns true if the replication filter of the domain excluded this workflow when it
+// started. Unlike canReplicateEvents it only stops generating replication tasks, the workflow still follows the
+// failover version checks of its global domain.
+func (e *mutableStateBuilder) isReplicationExcluded() bool {
+	return e.executionInfo.ReplicationExcluded
+}
+
 // validateNoEventsAfterWorkflowFinish perform check on history event batch
 // NOTE: do not apply this check on every batch, since transient
 // decision && workflow finish will be broken (the first batch)
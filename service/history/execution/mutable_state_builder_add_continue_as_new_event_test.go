@@ -274,6 +274,8 @@ func TestAddContinueAsNewEvent(t *testing.T) {
 		// history is a substruct of current state, but because they're both
 		// pointing to each other, they're assembled at the test start
 		startingHistory []*types.HistoryEvent
+		// policy of the continue-as-new decision
+		activeClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
 
 		// expectations
 		historyManagerAffordance func(historyManager *persistence.MockHistoryManager)
@@ -328,6 +330,17 @@ func TestAddContinueAsNewEvent(t *testing.T) {
 			expectedReturnedState:   expectedEndingReturnExecutionStateFn(2),
 			expectedReturnedHistory: expectedEndingReturnHistoryStateFn(2),
 		},
+		"a continue-as-new with failure to resolve the workflow ID hash policy - active-active domain": {
+			domainEntry:                  domainEntryActiveActive,
+			startingState:                createStartingExecutionInfo(),
+			startingHistory:              createValidStartingHistory(1),
+			activeClusterSelectionPolicy: &types.ActiveClusterSelectionPolicy{WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "city"}},
+			actClMgrAffordance: func(actClMgr *activecluster.MockManager) {
+				actClMgr.EXPECT().ResolveActiveClusterSelectionPolicy(gomock.Any(), domainID, "helloworld_b4db8bd0-74b7-4250-ade7-ac72a1efb171", gomock.Any()).
+					Return(nil, &activecluster.ClusterAttributeNotFoundError{})
+			},
+			expectedErr: &activecluster.ClusterAttributeNotFoundError{},
+		},
 		"a continue-as-new with failure to get the history event": {
 			domainEntry:     domainEntry,
 			startingState:   createStartingExecutionInfo(),
@@ -427,7 +440,8 @@ func TestAddContinueAsNewEvent(t *testing.T) {
 					TaskList: &types.TaskList{
 						Name: "helloWorldGroup",
 					},
-					Input: []uint8{110, 117, 108, 108, 10},
+					Input:                        []uint8{110, 117, 108, 108, 10},
+					ActiveClusterSelectionPolicy: td.activeClusterSelectionPolicy,
 				})
 
 			if td.expectedErr != nil {
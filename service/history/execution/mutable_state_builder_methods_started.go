@@ -129,6 +129,11 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	); err != nil {
 		return nil, err
 	}
+	// callbacks follow the workflow across continue-as-new and are only notified when the last run closes
+	e.executionInfo.CompletionCallbacks = types.NewCompletionCallbackInfos(getCompletionCallbacks(previousExecutionInfo.CompletionCallbacks))
+	// the replication exclusion is decided once for the whole chain, the new run must not start replicating
+	// events of a workflow whose earlier runs the other clusters never received
+	e.executionInfo.ReplicationExcluded = previousExecutionInfo.ReplicationExcluded
 
 	if err := e.SetHistoryTree(e.GetExecutionInfo().RunID); err != nil {
 		return nil, err
@@ -177,6 +182,10 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 		false); err != nil {
 		return nil, err
 	}
+	// completion callbacks are not part of the started event, they are only kept in the execution record
+	e.executionInfo.CompletionCallbacks = types.NewCompletionCallbackInfos(request.CompletionCallbacks)
+	// the domain replication filter is only evaluated here, later changes to the filter do not affect the workflow
+	e.executionInfo.ReplicationExcluded = e.domainEntry.IsReplicationExcluded(e.executionInfo.WorkflowTypeName, e.executionInfo.TaskList)
 
 	return event, nil
 }
@@ -298,3 +307,11 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	}
 	return nil
 }
+
+func getCompletionCallbacks(infos []*types.CompletionCallbackInfo) []*types.CompletionCallback {
+	var callbacks []*types.CompletionCallback
+	for _, info := range infos {
+		callbacks = append(callbacks, info.GetCallback())
+	}
+	return callbacks
+}
@@ -4093,6 +4093,66 @@ func Test__reorderAndFilterDuplicateEvents(t *testing.T) {
 	}
 }
 
+func TestEventsToReplicationTask_ReplicationFilter(t *testing.T) {
+	tests := map[string]struct {
+		filter        string
+		excluded      bool
+		expectedTasks int
+	}{
+		"no filter": {
+			expectedTasks: 1,
+		},
+		"excluded at start": {
+			filter:   `{"excludedWorkflowTypes":["ephemeral"]}`,
+			excluded: true,
+		},
+		"excluded at start, filter removed since": {
+			excluded: true,
+		},
+		"not excluded at start, filter added since": {
+			filter:        `{"excludedTaskLists":["scratch"]}`,
+			expectedTasks: 1,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			shardContext := shard.NewMockContext(ctrl)
+			shardContext.EXPECT().GetLogger().Return(testlogger.New(t)).AnyTimes()
+			shardContext.EXPECT().GetActiveClusterManager().Return(activecluster.NewMockManager(ctrl)).AnyTimes()
+
+			domainEntry := cache.NewGlobalDomainCacheEntryForTest(
+				&persistence.DomainInfo{
+					ID:   constants.TestDomainID,
+					Name: constants.TestDomainName,
+					Data: map[string]string{commonconstants.DomainDataKeyForReplicationFilter: td.filter},
+				},
+				&persistence.DomainConfig{},
+				&persistence.DomainReplicationConfig{
+					ActiveClusterName: cluster.TestCurrentClusterName,
+					Clusters: []*persistence.ClusterReplicationConfig{
+						{ClusterName: cluster.TestCurrentClusterName},
+						{ClusterName: cluster.TestAlternativeClusterName},
+					},
+				},
+				cluster.TestCurrentClusterInitialFailoverVersion,
+			)
+			ms := createMSBWithMocks(events.NewMockCache(ctrl), shardContext, cache.NewMockDomainCache(ctrl), domainEntry)
+			shardContext.EXPECT().GetConfig().Return(&config.Config{
+				EnableReplicationTaskGeneration: func(string, string) bool { return true },
+			}).AnyTimes()
+			ms.executionInfo.WorkflowTypeName = "ephemeral"
+			ms.executionInfo.TaskList = "scratch"
+			ms.executionInfo.ReplicationExcluded = td.excluded
+
+			tasks, err := ms.eventsToReplicationTask(TransactionPolicyActive, []*types.HistoryEvent{{ID: 1, Version: cluster.TestCurrentClusterInitialFailoverVersion}})
+			require.NoError(t, err)
+			assert.Len(t, tasks, td.expectedTasks)
+		})
+	}
+}
+
 func createMSBWithMocks(mockCache *events.MockCache, shardContext *shardCtx.MockContext, mockDomainCache *cache.MockDomainCache, domainEntry *cache.DomainCacheEntry) *mutableStateBuilder {
 	// the MSB constructor calls a bunch of endpoints on the mocks, so
 	// put them in here as a set of fixed expectations so the actual mocking
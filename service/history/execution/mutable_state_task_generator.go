@@ -158,7 +158,7 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 
 	executionInfo := r.mutableState.GetExecutionInfo()
 	taskList := executionInfo.TaskList
-	r.mutableState.AddTransferTasks(&persistence.CloseExecutionTask{
+	transferTasks := []persistence.Task{&persistence.CloseExecutionTask{
 		WorkflowIdentifier: persistence.WorkflowIdentifier{
 			DomainID:   executionInfo.DomainID,
 			WorkflowID: executionInfo.WorkflowID,
@@ -169,7 +169,23 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 			Version: closeEvent.Version,
 		},
 		TaskList: taskList,
-	})
+	}}
+	// callbacks are carried over to the new run on continue-as-new
+	if len(executionInfo.CompletionCallbacks) > 0 && closeEvent.GetEventType() != types.EventTypeWorkflowExecutionContinuedAsNew {
+		transferTasks = append(transferTasks, &persistence.CompletionCallbackTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID:   executionInfo.DomainID,
+				WorkflowID: executionInfo.WorkflowID,
+				RunID:      executionInfo.RunID,
+			},
+			TaskData: persistence.TaskData{
+				// TaskID and VisibilityTimestamp are set by shard context
+				Version: closeEvent.Version,
+			},
+			TaskList: taskList,
+		})
+	}
+	r.mutableState.AddTransferTasks(transferTasks...)
 
 	retentionInDays := defaultWorkflowRetentionInDays
 	domainEntry, err := r.domainCache.GetDomainByID(executionInfo.DomainID)
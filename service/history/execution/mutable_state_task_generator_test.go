@@ -1632,5 +1632,59 @@ func GenerateWorkflowCloseTasksTestCases(retention time.Duration, closeEvent *ty
 				},
 			},
 		},
+		{
+			// completion callbacks attached
+			setupFn: func(mockMutableState *MockMutableState) {
+				mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: constants.TestWorkflowID,
+					RunID:      constants.TestRunID,
+					TaskList:   "task-list",
+					CompletionCallbacks: types.NewCompletionCallbackInfos([]*types.CompletionCallback{
+						{URL: "https://example.com/done"},
+					}),
+				}).AnyTimes()
+				mockMutableState.EXPECT().HasParentExecution().Return(false).AnyTimes()
+				mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(nil).AnyTimes()
+			},
+			generatedTasks: []persistence.Task{
+				&persistence.CloseExecutionTask{
+					WorkflowIdentifier: persistence.WorkflowIdentifier{
+						DomainID:   constants.TestDomainID,
+						WorkflowID: constants.TestWorkflowID,
+						RunID:      constants.TestRunID,
+					},
+					TaskData: persistence.TaskData{
+						VisibilityTimestamp: now,
+						Version:             version,
+					},
+					TaskList: "task-list",
+				},
+				&persistence.CompletionCallbackTask{
+					WorkflowIdentifier: persistence.WorkflowIdentifier{
+						DomainID:   constants.TestDomainID,
+						WorkflowID: constants.TestWorkflowID,
+						RunID:      constants.TestRunID,
+					},
+					TaskData: persistence.TaskData{
+						VisibilityTimestamp: now,
+						Version:             version,
+					},
+					TaskList: "task-list",
+				},
+				&persistence.DeleteHistoryEventTask{
+					WorkflowIdentifier: persistence.WorkflowIdentifier{
+						DomainID:   constants.TestDomainID,
+						WorkflowID: constants.TestWorkflowID,
+						RunID:      constants.TestRunID,
+					},
+					TaskData: persistence.TaskData{
+						VisibilityTimestamp: time.Unix(0, closeEvent.GetTimestamp()).Add(retention),
+						Version:             version,
+					},
+					TaskList: "task-list",
+				},
+			},
+		},
 	}
 }
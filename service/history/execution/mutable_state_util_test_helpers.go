@@ -150,9 +150,20 @@ func CopyWorkflowExecutionInfo(t *testing.T, sourceInfo *persistence.WorkflowExe
 		ExpirationSeconds:                  sourceInfo.ExpirationSeconds,
 		CronOverlapPolicy:                  sourceInfo.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:       sourceInfo.ActiveClusterSelectionPolicy,
+		CompletionCallbacks:                copyCompletionCallbackInfos(sourceInfo.CompletionCallbacks),
+		ReplicationExcluded:                sourceInfo.ReplicationExcluded,
 	}
 }
 
+func copyCompletionCallbackInfos(infos []*types.CompletionCallbackInfo) []*types.CompletionCallbackInfo {
+	var result []*types.CompletionCallbackInfo
+	for _, info := range infos {
+		infoCopy := *info
+		result = append(result, &infoCopy)
+	}
+	return result
+}
+
 // CopyActivityInfo copies ActivityInfo
 func CopyActivityInfo(t *testing.T, sourceInfo *persistence.ActivityInfo) *persistence.ActivityInfo {
 	details := slices.Clone(sourceInfo.Details)
@@ -1753,6 +1753,50 @@ func cmpReplicationShardMessages(a, b replicationShardMessages) int {
 	return 0
 }
 
+// GetReplicationStatus describes how far a remote cluster is behind on the requested shards
+func (h *handlerImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *types.GetReplicationStatusRequest,
+) (resp *types.GetReplicationStatusResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetReplicationStatusScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+	if request.GetClusterName() == "" {
+		return nil, h.error(constants.ErrClusterNameNotSet, scope, "", "", "")
+	}
+
+	g := &errgroup.Group{}
+	shards := make([]*types.ShardReplicationStatus, len(request.ShardIDs))
+	for i, shardID := range request.ShardIDs {
+		i, shardID := i, shardID
+		g.Go(func() (e error) {
+			defer func() { log.CapturePanic(recover(), h.GetLogger(), &e) }()
+
+			engine, err := h.controller.GetEngineForShard(int(shardID))
+			if err != nil {
+				return err
+			}
+			status, err := engine.GetReplicationStatus(ctx, request.GetClusterName(), request.GetDomainID())
+			if err != nil {
+				return fmt.Errorf("replication status of shard %d: %w", shardID, err)
+			}
+			shards[i] = &types.ShardReplicationStatus{ShardID: shardID, Status: status}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, h.error(err, scope, "", "", "")
+	}
+	return &types.GetReplicationStatusResponse{Shards: shards}, nil
+}
+
 // GetDLQReplicationMessages is called by remote peers to get replicated messages for DLQ merging
 func (h *handlerImpl) GetDLQReplicationMessages(
 	ctx context.Context,
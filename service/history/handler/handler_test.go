@@ -3188,6 +3188,78 @@ func (s *handlerSuite) TestCountDLQMessages() {
 	}
 }
 
+func (s *handlerSuite) TestGetReplicationStatus() {
+	validInput := &types.GetReplicationStatusRequest{
+		ClusterName: "standby",
+		ShardIDs:    []int32{0, 1},
+		DomainID:    "domain-id",
+	}
+	status := &types.ReplicationStatus{AckedTaskID: 10, MaxTaskID: 15, TaskLag: 5}
+
+	testInput := map[string]struct {
+		input         *types.GetReplicationStatusRequest
+		expected      *types.GetReplicationStatusResponse
+		expectedError bool
+		mockFn        func()
+	}{
+		"shutting down": {
+			input:         validInput,
+			expectedError: true,
+			mockFn: func() {
+				s.handler.shuttingDown = int32(1)
+			},
+		},
+		"cluster name not set": {
+			input:         &types.GetReplicationStatusRequest{ShardIDs: []int32{0}},
+			expectedError: true,
+			mockFn:        func() {},
+		},
+		"cannot get engine": {
+			input:         &types.GetReplicationStatusRequest{ClusterName: "standby", ShardIDs: []int32{0}},
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"getReplicationStatus error": {
+			input:         &types.GetReplicationStatusRequest{ClusterName: "standby", ShardIDs: []int32{0}},
+			expectedError: true,
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().GetReplicationStatus(gomock.Any(), "standby", "").Return(nil, errors.New("error")).Times(1)
+			},
+		},
+		"success": {
+			input: validInput,
+			expected: &types.GetReplicationStatusResponse{
+				Shards: []*types.ShardReplicationStatus{
+					{ShardID: 0, Status: status},
+					{ShardID: 1, Status: status},
+				},
+			},
+			mockFn: func() {
+				s.mockShardController.EXPECT().GetEngineForShard(0).Return(s.mockEngine, nil).Times(1)
+				s.mockShardController.EXPECT().GetEngineForShard(1).Return(s.mockEngine, nil).Times(1)
+				s.mockEngine.EXPECT().GetReplicationStatus(gomock.Any(), "standby", "domain-id").Return(status, nil).Times(2)
+			},
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := s.handler.GetReplicationStatus(context.Background(), input.input)
+			s.handler.shuttingDown = int32(0)
+			if input.expectedError {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+				s.Equal(input.expected, resp)
+			}
+		})
+	}
+}
+
 func (s *handlerSuite) TestReadDLQMessages() {
 	validInput := &types.ReadDLQMessagesRequest{
 		ShardID: 1,
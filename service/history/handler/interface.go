@@ -53,6 +53,7 @@ type Handler interface {
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
 	GetMutableState(context.Context, *types.GetMutableStateRequest) (*types.GetMutableStateResponse, error)
 	GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest) (*types.GetReplicationMessagesResponse, error)
+	GetReplicationStatus(context.Context, *types.GetReplicationStatusRequest) (*types.GetReplicationStatusResponse, error)
 	MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
 	NotifyFailoverMarkers(context.Context, *types.NotifyFailoverMarkersRequest) error
 	PollMutableState(context.Context, *types.PollMutableStateRequest) (*types.PollMutableStateResponse, error)
@@ -207,6 +207,21 @@ func (mr *MockHandlerMockRecorder) GetReplicationMessages(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockHandler)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockHandler) GetReplicationStatus(arg0 context.Context, arg1 *types.GetReplicationStatusRequest) (*types.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockHandlerMockRecorder) GetReplicationStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockHandler)(nil).GetReplicationStatus), arg0, arg1)
+}
+
 // Health mocks base method.
 func (m *MockHandler) Health(arg0 context.Context) (*types.HealthStatus, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +191,8 @@ func (t *transferQueueProcessor) Stop() {
 		t.logger.Info("Stopping transfer queue processor non-gracefully")
 		defer t.logger.Info("Transfer queue processor stopped non-gracefully")
 		t.activeQueueProcessor.Stop()
+		// stop active executor after queue processor
+		t.activeTaskExecutor.Stop()
 		for _, standbyQueueProcessor := range t.standbyQueueProcessors {
 			standbyQueueProcessor.Stop()
 		}
@@ -221,6 +223,8 @@ func (t *transferQueueProcessor) Stop() {
 			failoverQueueProcessor.Stop()
 		}
 	}
+
+	t.activeTaskExecutor.Stop()
 }
 
 func (t *transferQueueProcessor) NotifyNewTask(clusterName string, info *hcommon.NotifyTaskInfo) {
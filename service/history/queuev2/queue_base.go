@@ -81,6 +81,7 @@ type (
 		category        persistence.HistoryTaskCategory
 		options         *Options
 		timeSource      clock.TimeSource
+		taskExecutor    task.Executor
 		taskInitializer task.Initializer
 
 		rescheduler           task.Rescheduler
@@ -198,6 +199,7 @@ func newQueueBase(
 		category:            category,
 		options:             options,
 		timeSource:          timeSource,
+		taskExecutor:        taskExecutor,
 		taskInitializer:     taskInitializer,
 		rescheduler:         rescheduler,
 		queueReader:         queueReader,
@@ -235,6 +237,8 @@ func (q *queueBase) Stop() {
 	q.updateQueueStateTimer.Stop()
 	q.virtualQueueManager.Stop()
 	q.rescheduler.Stop()
+	// stop executor after the queue stops dispatching tasks
+	q.taskExecutor.Stop()
 }
 
 func (q *queueBase) Category() persistence.HistoryTaskCategory {
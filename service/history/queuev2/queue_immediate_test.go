@@ -126,6 +126,7 @@ func TestImmediateQueue_LifeCycle(t *testing.T) {
 	mockTimeSource.Advance(options.UpdateAckInterval() * 2)
 
 	// Test Stop
+	mockTaskExecutor.EXPECT().Stop().Times(1)
 	queue.Stop()
 	assert.Equal(t, common.DaemonStatusStopped, atomic.LoadInt32(&queue.status))
 }
@@ -153,9 +153,11 @@ func TestCachedScheduledQueue_StartStop(t *testing.T) {
 	).AnyTimes()
 	mockReader.EXPECT().Start().Times(1)
 	mockReader.EXPECT().Stop().Times(1)
+	mockExecutor := task.NewMockExecutor(ctrl)
+	mockExecutor.EXPECT().Stop().Times(1)
 
 	inner := NewScheduledQueue(mockShard, persistence.HistoryTaskCategoryTimer,
-		task.NewMockProcessor(ctrl), task.NewMockExecutor(ctrl),
+		task.NewMockProcessor(ctrl), mockExecutor,
 		mockShard.GetLogger(), metrics.NoopClient, metrics.NoopScope, mockReader, options).(*scheduledQueue)
 
 	q := newCachedScheduledQueue(inner, mockReader)
@@ -27,6 +27,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/common/clock"
@@ -39,6 +40,13 @@ import (
 	"github.com/uber/cadence/service/history/config"
 )
 
+const (
+	// defaultStatusScanLimit bounds the pending tasks read to describe the status of a single domain
+	defaultStatusScanLimit = 10000
+
+	statusScanPageSize = 1000
+)
+
 type (
 	// TaskAckManager is the ack manager for replication tasks
 	TaskAckManager struct {
@@ -59,6 +67,16 @@ type (
 
 		dynamicTaskBatchSizer DynamicTaskBatchSizer
 		timeSource            clock.TimeSource
+
+		// fetches describes the GetTasks calls of each polling cluster since the shard was loaded
+		fetches *fetchTracker
+
+		statusScanLimit int
+	}
+
+	fetchTracker struct {
+		sync.Mutex
+		clusters map[string]*types.ReplicationFetcherStatus
 	}
 
 	ackLevelStore interface {
@@ -99,12 +117,15 @@ func NewTaskAckManager(
 		maxReplicationMessagesSize: config.MaxResponseSize,
 		replicationMessagesSizeFn:  replicationMessagesSizeFn,
 		dynamicTaskBatchSizer:      dynamicTaskBatchSizer,
+		fetches:                    &fetchTracker{clusters: make(map[string]*types.ReplicationFetcherStatus)},
+		statusScanLimit:            defaultStatusScanLimit,
 	}
 }
 
 func (t *TaskAckManager) GetTasks(ctx context.Context, pollingCluster string, lastReadTaskID int64) (_ *types.ReplicationMessages, err error) {
 	result, err := t.getTasks(ctx, pollingCluster, lastReadTaskID)
 	t.dynamicTaskBatchSizer.analyse(err, result)
+	t.fetches.record(pollingCluster, t.timeSource.Now(), err)
 	if err != nil {
 		return nil, err
 	}
@@ -239,6 +260,81 @@ func (t *TaskAckManager) getTasks(ctx context.Context, pollingCluster string, la
 	}, nil
 }
 
+// GetStatus describes how far the polling cluster is behind on the shard, from its ack level, the max read level
+// of the shard and the oldest replication task it has not acknowledged yet. When domainID is set, the lag is the
+// one of the pending tasks of that domain, see scanDomainStatus.
+func (t *TaskAckManager) GetStatus(ctx context.Context, pollingCluster, domainID string) (*types.ReplicationStatus, error) {
+	ackLevel := t.ackLevels.GetQueueClusterAckLevel(persistence.HistoryTaskCategoryReplication, pollingCluster).GetTaskID()
+	maxReadLevel := t.ackLevels.UpdateIfNeededAndGetQueueMaxReadLevel(persistence.HistoryTaskCategoryReplication, pollingCluster).GetTaskID()
+	status := &types.ReplicationStatus{
+		AckedTaskID: ackLevel,
+		MaxTaskID:   maxReadLevel,
+		Fetcher:     t.fetches.get(pollingCluster),
+	}
+	if domainID != "" {
+		if err := t.scanDomainStatus(ctx, status, domainID); err != nil {
+			return nil, err
+		}
+		return status, nil
+	}
+
+	if maxReadLevel > ackLevel {
+		status.TaskLag = maxReadLevel - ackLevel
+	}
+	tasks, _, err := t.reader.Read(ctx, ackLevel, maxReadLevel, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) > 0 {
+		t.setOldestPendingTask(status, tasks[0])
+	}
+	return status, nil
+}
+
+// scanDomainStatus counts the pending tasks of a domain, reading up to statusScanLimit tasks from the ack level.
+// Tasks are read in order, so the first task of the domain is its oldest pending one. When the scan stops before
+// finding any, the oldest pending task of the shard bounds the lag of the domain.
+func (t *TaskAckManager) scanDomainStatus(ctx context.Context, status *types.ReplicationStatus, domainID string) error {
+	var oldestShardTask persistence.Task
+	readLevel, scanned := status.AckedTaskID, 0
+	for {
+		tasks, hasMore, err := t.reader.Read(ctx, readLevel, status.MaxTaskID, statusScanPageSize)
+		if err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			if oldestShardTask == nil {
+				oldestShardTask = task
+			}
+			if task.GetDomainID() != domainID {
+				continue
+			}
+			if status.TaskLag == 0 {
+				t.setOldestPendingTask(status, task)
+			}
+			status.TaskLag++
+		}
+		scanned += len(tasks)
+		if !hasMore || len(tasks) == 0 {
+			return nil
+		}
+		if scanned >= t.statusScanLimit {
+			status.TaskLagTruncated = true
+			if status.TaskLag == 0 {
+				t.setOldestPendingTask(status, oldestShardTask)
+			}
+			return nil
+		}
+		readLevel = tasks[len(tasks)-1].GetTaskID()
+	}
+}
+
+func (t *TaskAckManager) setOldestPendingTask(status *types.ReplicationStatus, task persistence.Task) {
+	oldest := task.GetVisibilityTimestamp()
+	status.OldestPendingTaskTime = oldest.UnixNano()
+	status.LagSeconds = int64(t.timeSource.Since(oldest) / time.Second)
+}
+
 // ackLevel updates the ack level for the given cluster
 func (t *TaskAckManager) ackLevel(pollingCluster string, lastReadTaskID int64) {
 	if err := t.ackLevels.UpdateQueueClusterAckLevel(persistence.HistoryTaskCategoryReplication, pollingCluster, persistence.NewImmediateTaskKey(lastReadTaskID)); err != nil {
@@ -296,3 +392,32 @@ func (t *TaskAckManager) shrinkMessagesBySize(msgs *types.ReplicationMessages) (
 		msgs.LastRetrievedMessageID = msgs.ReplicationTasks[len(msgs.ReplicationTasks)-1].SourceTaskID
 	}
 }
+
+func (f *fetchTracker) record(pollingCluster string, now time.Time, err error) {
+	f.Lock()
+	defer f.Unlock()
+
+	status, ok := f.clusters[pollingCluster]
+	if !ok {
+		status = &types.ReplicationFetcherStatus{}
+		f.clusters[pollingCluster] = status
+	}
+	status.LastFetchTime = now.UnixNano()
+	if err != nil {
+		status.ErrorCount++
+		status.LastError = err.Error()
+		status.LastErrorTime = now.UnixNano()
+	}
+}
+
+func (f *fetchTracker) get(pollingCluster string) *types.ReplicationFetcherStatus {
+	f.Lock()
+	defer f.Unlock()
+
+	status, ok := f.clusters[pollingCluster]
+	if !ok {
+		return nil
+	}
+	copied := *status
+	return &copied
+}
@@ -26,6 +26,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -398,6 +399,151 @@ func TestTaskAckManager_GetTasks(t *testing.T) {
 	}
 }
 
+func TestTaskAckManager_GetStatus(t *testing.T) {
+	now := time.Unix(1000, 0)
+	pendingTask := &persistence.HistoryReplicationTask{
+		TaskData: persistence.TaskData{TaskID: 11, VisibilityTimestamp: now.Add(-time.Minute)},
+	}
+	domainTask := func(taskID int64, domainID string, age time.Duration) *persistence.HistoryReplicationTask {
+		return &persistence.HistoryReplicationTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{DomainID: domainID},
+			TaskData:           persistence.TaskData{TaskID: taskID, VisibilityTimestamp: now.Add(-age)},
+		}
+	}
+	tests := []struct {
+		name         string
+		readLevel    int64
+		reader       taskReader
+		domainID     string
+		scanLimit    int
+		fetch        bool
+		expectStatus *types.ReplicationStatus
+		expectErr    string
+	}{
+		{
+			name:         "caught up",
+			readLevel:    10,
+			reader:       fakeTaskReader{},
+			expectStatus: &types.ReplicationStatus{AckedTaskID: 10, MaxTaskID: 10},
+		},
+		{
+			name:      "pending tasks",
+			readLevel: 20,
+			reader:    fakeTaskReader{pendingTask},
+			expectStatus: &types.ReplicationStatus{
+				AckedTaskID:           10,
+				MaxTaskID:             20,
+				TaskLag:               10,
+				OldestPendingTaskTime: now.Add(-time.Minute).UnixNano(),
+				LagSeconds:            60,
+			},
+		},
+		{
+			name:      "pending tasks of a domain",
+			readLevel: 20,
+			reader: fakeTaskReader{
+				domainTask(11, "other-domain", 3*time.Minute),
+				domainTask(12, testDomainID, 2*time.Minute),
+				domainTask(13, testDomainID, time.Minute),
+			},
+			domainID: testDomainID,
+			expectStatus: &types.ReplicationStatus{
+				AckedTaskID:           10,
+				MaxTaskID:             20,
+				TaskLag:               2,
+				OldestPendingTaskTime: now.Add(-2 * time.Minute).UnixNano(),
+				LagSeconds:            120,
+			},
+		},
+		{
+			name:      "domain scan stops before finding a task of the domain",
+			readLevel: 20,
+			reader: fakeTaskReader{
+				domainTask(11, "other-domain", 3*time.Minute),
+				domainTask(12, "other-domain", 2*time.Minute),
+				domainTask(21, testDomainID, time.Minute),
+			},
+			domainID:  testDomainID,
+			scanLimit: 1,
+			expectStatus: &types.ReplicationStatus{
+				AckedTaskID:           10,
+				MaxTaskID:             20,
+				TaskLagTruncated:      true,
+				OldestPendingTaskTime: now.Add(-3 * time.Minute).UnixNano(),
+				LagSeconds:            180,
+			},
+		},
+		{
+			name:      "fetches of the polling cluster",
+			readLevel: 10,
+			reader:    fakeTaskReader{},
+			fetch:     true,
+			expectStatus: &types.ReplicationStatus{
+				AckedTaskID: 10,
+				MaxTaskID:   10,
+				Fetcher:     &types.ReplicationFetcherStatus{LastFetchTime: now.UnixNano()},
+			},
+		},
+		{
+			name:      "read error",
+			readLevel: 20,
+			reader:    (fakeTaskReader)(nil),
+			expectErr: "error reading replication tasks",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ackLevels := &fakeAckLevelStore{
+				readLevel: tt.readLevel,
+				remote:    map[string]persistence.HistoryTaskKey{testClusterA: persistence.NewImmediateTaskKey(10)},
+			}
+			ackManager := NewTaskAckManager(
+				testShardID,
+				ackLevels,
+				metrics.NewNoopMetricsClient(),
+				log.NewNoop(),
+				tt.reader,
+				createTestTaskStore(t, fakeDomainCache{testDomainID: testDomain}, fakeTaskHydrator{}),
+				clock.NewMockedTimeSourceAt(now),
+				testConfig,
+				proto.ReplicationMessagesSize,
+				fakeDynamicTaskBatchSizer(10),
+			)
+			if tt.scanLimit > 0 {
+				ackManager.statusScanLimit = tt.scanLimit
+			}
+			if tt.fetch {
+				_, err := ackManager.GetTasks(context.Background(), testClusterA, 10)
+				require.NoError(t, err)
+			}
+
+			status, err := ackManager.GetStatus(context.Background(), testClusterA, tt.domainID)
+			if tt.expectErr != "" {
+				assert.EqualError(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, status)
+		})
+	}
+}
+
+func TestFetchTracker(t *testing.T) {
+	tracker := &fetchTracker{clusters: make(map[string]*types.ReplicationFetcherStatus)}
+	assert.Nil(t, tracker.get(testClusterA))
+
+	tracker.record(testClusterA, time.Unix(1, 0), errors.New("fetch failed"))
+	tracker.record(testClusterA, time.Unix(2, 0), nil)
+	assert.Equal(t, &types.ReplicationFetcherStatus{
+		LastFetchTime: time.Unix(2, 0).UnixNano(),
+		ErrorCount:    1,
+		LastError:     "fetch failed",
+		LastErrorTime: time.Unix(1, 0).UnixNano(),
+	}, tracker.get(testClusterA))
+	assert.Nil(t, tracker.get(testClusterB))
+}
+
 type fakeAckLevelStore struct {
 	remote    map[string]persistence.HistoryTaskKey
 	readLevel int64
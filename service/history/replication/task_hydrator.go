@@ -58,6 +58,7 @@ type (
 		IsWorkflowExecutionRunning() bool
 		GetActivityInfo(int64) (*persistence.ActivityInfo, bool)
 		GetVersionHistories() *persistence.VersionHistories
+		GetExecutionInfo() *persistence.WorkflowExecutionInfo
 	}
 )
 
@@ -99,6 +100,10 @@ func (h TaskHydrator) Hydrate(ctx context.Context, task persistence.Task) (retTa
 		return nil, err
 	}
 
+	if isReplicationExcluded(ms) {
+		return nil, nil
+	}
+
 	switch t := task.(type) {
 	case *persistence.SyncActivityTask:
 		return hydrateSyncActivityTask(t, ms)
@@ -115,6 +120,15 @@ func (h TaskHydrator) Hydrate(ctx context.Context, task persistence.Task) (retTa
 	}
 }
 
+// isReplicationExcluded drops the tasks of a workflow which the replication filter excluded when it started.
+// It reads the exclusion persisted with the workflow instead of the current filter of the domain: a filter changed
+// while the workflow is running would otherwise drop part of its history from the other clusters.
+// Immediate hydration happens in the transaction generating the tasks, which already applied the exclusion.
+func isReplicationExcluded(ms mutableState) bool {
+	info := ms.GetExecutionInfo()
+	return info != nil && info.ReplicationExcluded
+}
+
 func hydrateFailoverMarkerTask(t *persistence.FailoverMarkerTask) *types.ReplicationTask {
 	return &types.ReplicationTask{
 		TaskType:     types.ReplicationTaskTypeFailoverMarker.Ptr(),
@@ -328,3 +342,7 @@ func (ms immediateMutableState) GetActivityInfo(id int64) (*persistence.Activity
 func (ms immediateMutableState) GetVersionHistories() *persistence.VersionHistories {
 	return ms.versionHistories
 }
+func (ms immediateMutableState) GetExecutionInfo() *persistence.WorkflowExecutionInfo {
+	// not needed, the immediate hydrator does not filter
+	return nil
+}
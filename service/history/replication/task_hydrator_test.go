@@ -286,6 +286,51 @@ func TestTaskHydrator_HydrateSyncActivityTask(t *testing.T) {
 	}
 }
 
+func TestTaskHydrator_ReplicationExcluded(t *testing.T) {
+	task := &persistence.SyncActivityTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   testDomainID,
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+		},
+		ScheduledID: testScheduleID,
+	}
+
+	tests := []struct {
+		name       string
+		excluded   bool
+		expectTask bool
+	}{
+		{
+			name:       "workflow is not excluded",
+			expectTask: true,
+		},
+		{
+			name:     "workflow is excluded",
+			excluded: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := TaskHydrator{
+				msProvider: &fakeMutableStateProvider{
+					workflows: map[definition.WorkflowIdentifier]mutableState{
+						testWorkflowIdentifier: &fakeMutableState{
+							isWorkflowExecutionRunning: true,
+							activityInfos:              map[int64]persistence.ActivityInfo{testScheduleID: {ScheduleID: testScheduleID}},
+							executionInfo:              persistence.WorkflowExecutionInfo{ReplicationExcluded: tt.excluded},
+						},
+					},
+				},
+			}
+			result, err := th.Hydrate(context.Background(), task)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectTask, result != nil)
+			assert.True(t, th.msProvider.(*fakeMutableStateProvider).released)
+		})
+	}
+}
+
 func TestTaskHydrator_HydrateHistoryReplicationTask(t *testing.T) {
 	task := &persistence.HistoryReplicationTask{
 		TaskData: persistence.TaskData{
@@ -833,6 +878,7 @@ type fakeMutableState struct {
 	isWorkflowExecutionRunning bool
 	versionHistories           *persistence.VersionHistories
 	activityInfos              map[int64]persistence.ActivityInfo
+	executionInfo              persistence.WorkflowExecutionInfo
 }
 
 func (ms fakeMutableState) IsWorkflowExecutionRunning() bool {
@@ -845,6 +891,9 @@ func (ms fakeMutableState) GetActivityInfo(scheduleID int64) (*persistence.Activ
 func (ms fakeMutableState) GetVersionHistories() *persistence.VersionHistories {
 	return ms.versionHistories
 }
+func (ms fakeMutableState) GetExecutionInfo() *persistence.WorkflowExecutionInfo {
+	return &ms.executionInfo
+}
 
 type historyBlob struct {
 	branch []byte
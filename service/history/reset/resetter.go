@@ -134,6 +134,11 @@ func (r *workflowResetterImpl) ResetWorkflow(
 		return err
 	}
 
+	baseReplicationExcluded, err := r.isBaseReplicationExcluded(ctx, domainID, workflowID, baseRunID, currentMutableState)
+	if err != nil {
+		return err
+	}
+
 	currentWorkflowTerminated := false
 	if currentMutableState.IsWorkflowExecutionRunning() {
 		if err := r.terminateWorkflow(
@@ -170,6 +175,9 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	}
 	defer resetWorkflow.GetReleaseFn()(retError)
 
+	// the reset run forks the history of the base run, it is only replicated if the base run was
+	resetWorkflow.GetMutableState().GetExecutionInfo().ReplicationExcluded = baseReplicationExcluded
+
 	return r.persistToDB(
 		ctx,
 		currentWorkflowTerminated,
@@ -333,6 +341,37 @@ func (r *workflowResetterImpl) persistToDB(
 	)
 }
 
+func (r *workflowResetterImpl) isBaseReplicationExcluded(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	baseRunID string,
+	currentMutableState execution.MutableState,
+) (bool, error) {
+
+	if baseRunID == currentMutableState.GetExecutionInfo().RunID {
+		return currentMutableState.GetExecutionInfo().ReplicationExcluded, nil
+	}
+
+	domainName, err := r.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return false, err
+	}
+	// the flag never changes after the run started, no need to lock the base run
+	resp, err := r.shard.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID: domainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      baseRunID,
+		},
+		DomainName: domainName,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.State.ExecutionInfo.ReplicationExcluded, nil
+}
+
 func (r *workflowResetterImpl) replayResetWorkflow(
 	ctx context.Context,
 	domainID string,
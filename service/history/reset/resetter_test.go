@@ -348,6 +348,36 @@ func (s *workflowResetterSuite) TestGenerateBranchToken() {
 	s.Equal(resetBranchToken, newBranchToken)
 }
 
+func (s *workflowResetterSuite) TestIsBaseReplicationExcluded() {
+	currentMutableState := execution.NewMockMutableState(s.controller)
+	currentMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		RunID:               s.currentRunID,
+		ReplicationExcluded: true,
+	}).AnyTimes()
+
+	excluded, err := s.workflowResetter.isBaseReplicationExcluded(
+		context.Background(), s.domainID, s.workflowID, s.currentRunID, currentMutableState,
+	)
+	s.NoError(err)
+	s.True(excluded)
+
+	domainName := uuid.New()
+	s.mockShard.Resource.DomainCache.EXPECT().GetDomainName(s.domainID).Return(domainName, nil).Times(1)
+	s.mockShard.Resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.GetWorkflowExecutionRequest) bool {
+		return request.Execution.GetRunID() == s.baseRunID && request.DomainName == domainName
+	})).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{RunID: s.baseRunID},
+		},
+	}, nil).Times(1)
+
+	excluded, err = s.workflowResetter.isBaseReplicationExcluded(
+		context.Background(), s.domainID, s.workflowID, s.baseRunID, currentMutableState,
+	)
+	s.NoError(err)
+	s.False(excluded)
+}
+
 func (s *workflowResetterSuite) TestTerminateWorkflow() {
 	decision := &execution.DecisionInfo{
 		Version:    123,
@@ -0,0 +1,189 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/completioncallback"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+)
+
+const (
+	completionCallbackRetryInitialInterval = time.Second
+	completionCallbackRetryMaxInterval     = 10 * time.Minute
+)
+
+var (
+	errCompletionCallbacksDisabled = errors.New("completion callbacks are not enabled for the domain")
+	errCompletionCallbackCutShort  = errors.New("completion callback delivery did not complete")
+)
+
+type (
+	// completionCallbackDispatcher delivers completion callbacks in the background, so the queue workers
+	// never wait for the callback endpoints. It runs at most one delivery per workflow and at most
+	// CompletionCallbackMaxConcurrentDeliveries deliveries per queue of a shard.
+	completionCallbackDispatcher struct {
+		sync.Mutex
+
+		httpClient *http.Client
+		allowlist  completioncallback.HostAllowlist
+		config     *config.Config
+
+		ctx      context.Context
+		cancel   context.CancelFunc
+		wg       sync.WaitGroup
+		inflight map[definition.WorkflowIdentifier]struct{}
+	}
+)
+
+func newCompletionCallbackDispatcher(config *config.Config) *completionCallbackDispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &completionCallbackDispatcher{
+		httpClient: completioncallback.NewHTTPClient(),
+		allowlist:  completioncallback.NewHostAllowlist(config.CompletionCallbackAllowedHosts),
+		config:     config,
+		ctx:        ctx,
+		cancel:     cancel,
+		inflight:   make(map[definition.WorkflowIdentifier]struct{}),
+	}
+}
+
+// reserve marks a delivery of the workflow as in flight. It returns false when the workflow already has
+// one, when the shard has too many of them or after the dispatcher is stopped.
+func (d *completionCallbackDispatcher) reserve(workflow definition.WorkflowIdentifier) bool {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.ctx.Err() != nil {
+		return false
+	}
+	if _, ok := d.inflight[workflow]; ok || len(d.inflight) >= d.config.CompletionCallbackMaxConcurrentDeliveries() {
+		return false
+	}
+	d.inflight[workflow] = struct{}{}
+	return true
+}
+
+// release drops a reservation which is not going to be run
+func (d *completionCallbackDispatcher) release(workflow definition.WorkflowIdentifier) {
+	d.Lock()
+	defer d.Unlock()
+
+	delete(d.inflight, workflow)
+}
+
+// run runs the reserved delivery in the background and drops the reservation once it returns
+func (d *completionCallbackDispatcher) run(
+	workflow definition.WorkflowIdentifier,
+	delivery func(ctx context.Context),
+) {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.ctx.Err() != nil {
+		delete(d.inflight, workflow)
+		return
+	}
+	d.wg.Add(1)
+	go func() {
+		defer func() {
+			d.release(workflow)
+			d.wg.Done()
+		}()
+		delivery(d.ctx)
+	}()
+}
+
+// stop cancels the deliveries in flight and waits for them to return
+func (d *completionCallbackDispatcher) stop() {
+	d.Lock()
+	d.cancel()
+	d.Unlock()
+	d.wg.Wait()
+}
+
+// deliver POSTs the body to the callback, it returns whether a failed delivery should be retried
+func (d *completionCallbackDispatcher) deliver(
+	ctx context.Context,
+	domainName string,
+	callback *types.CompletionCallback,
+	body []byte,
+) (bool, error) {
+
+	// the domain settings and the allowlist may have changed since the workflow was started
+	if !d.config.EnableCompletionCallbacks(domainName) {
+		return false, errCompletionCallbacksDisabled
+	}
+	if err := d.allowlist.Validate(callback.GetURL()); err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.config.CompletionCallbackRequestTimeout())
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, callback.GetURL(), bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	for key, value := range callback.GetHeader() {
+		request.Header.Set(key, value)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := d.httpClient.Do(request)
+	if err != nil {
+		return !errors.Is(err, completioncallback.ErrForbiddenAddress), err
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		return false, nil
+	case response.StatusCode == http.StatusRequestTimeout,
+		response.StatusCode == http.StatusTooManyRequests,
+		response.StatusCode >= 500:
+		return true, fmt.Errorf("completion callback returned %v", response.Status)
+	default:
+		return false, fmt.Errorf("completion callback returned %v", response.Status)
+	}
+}
+
+// completionCallbackDueTime returns when the backoff after the last attempt of the callback ends
+func completionCallbackDueTime(info *types.CompletionCallbackInfo) time.Time {
+	if info.GetAttempt() == 0 {
+		return time.Unix(0, 0)
+	}
+	delay := completionCallbackRetryInitialInterval
+	for attempt := int32(1); attempt < info.GetAttempt() && delay < completionCallbackRetryMaxInterval; attempt++ {
+		delay *= 2
+	}
+	delay = min(delay, completionCallbackRetryMaxInterval)
+	return time.Unix(0, info.GetLastAttemptTimestamp()).Add(delay)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/completioncallback"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+)
+
+func TestCompletionCallbackDispatcher_Reserve(t *testing.T) {
+	dispatcher := newCompletionCallbackDispatcher(&config.Config{
+		CompletionCallbackMaxConcurrentDeliveries: dynamicproperties.GetIntPropertyFn(2),
+		CompletionCallbackAllowedHosts:            func(...dynamicproperties.FilterOption) []interface{} { return nil },
+	})
+	workflow1 := definition.NewWorkflowIdentifier("domain", "workflow1", "run")
+	workflow2 := definition.NewWorkflowIdentifier("domain", "workflow2", "run")
+	workflow3 := definition.NewWorkflowIdentifier("domain", "workflow3", "run")
+
+	release := make(chan struct{})
+	block := func(ctx context.Context) { <-release }
+	assert.True(t, dispatcher.reserve(workflow1))
+	dispatcher.run(workflow1, block)
+	assert.False(t, dispatcher.reserve(workflow1), "one delivery per workflow")
+	assert.True(t, dispatcher.reserve(workflow2))
+	assert.False(t, dispatcher.reserve(workflow3), "max concurrent deliveries")
+
+	dispatcher.release(workflow2)
+	assert.True(t, dispatcher.reserve(workflow3))
+	dispatcher.release(workflow3)
+
+	close(release)
+	dispatcher.wg.Wait()
+	assert.True(t, dispatcher.reserve(workflow1), "the delivery drops its reservation when it returns")
+}
+
+func TestCompletionCallbackDispatcher_Stop(t *testing.T) {
+	dispatcher := newCompletionCallbackDispatcher(&config.Config{
+		CompletionCallbackMaxConcurrentDeliveries: dynamicproperties.GetIntPropertyFn(2),
+		CompletionCallbackAllowedHosts:            func(...dynamicproperties.FilterOption) []interface{} { return nil },
+	})
+	workflow1 := definition.NewWorkflowIdentifier("domain", "workflow1", "run")
+	workflow2 := definition.NewWorkflowIdentifier("domain", "workflow2", "run")
+
+	assert.True(t, dispatcher.reserve(workflow1))
+	dispatcher.run(workflow1, func(ctx context.Context) { <-ctx.Done() })
+	assert.True(t, dispatcher.reserve(workflow2))
+	dispatcher.stop()
+
+	// a reservation made before the dispatcher stopped is dropped instead of run
+	dispatcher.run(workflow2, func(context.Context) { t.Fatal("delivery should not run after stop") })
+	assert.Empty(t, dispatcher.inflight)
+	assert.False(t, dispatcher.reserve(workflow1))
+}
+
+func TestCompletionCallbackDispatcher_Deliver(t *testing.T) {
+	statusCode := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	dispatcher := newCompletionCallbackDispatcher(&config.Config{
+		EnableCompletionCallbacks: func(domain string) bool { return domain == "domain" },
+		CompletionCallbackAllowedHosts: func(...dynamicproperties.FilterOption) []interface{} {
+			return []interface{}{"127.0.0.1"}
+		},
+		CompletionCallbackRequestTimeout:          dynamicproperties.GetDurationPropertyFn(time.Second),
+		CompletionCallbackMaxConcurrentDeliveries: dynamicproperties.GetIntPropertyFn(1),
+	})
+	callback := &types.CompletionCallback{URL: server.URL}
+
+	// the default client refuses to connect to the loopback address of the test server
+	retryable, err := dispatcher.deliver(context.Background(), "domain", callback, nil)
+	assert.ErrorIs(t, err, completioncallback.ErrForbiddenAddress)
+	assert.False(t, retryable)
+
+	dispatcher.httpClient = server.Client()
+	tests := map[string]struct {
+		domain    string
+		url       string
+		status    int
+		retryable bool
+		err       string
+	}{
+		"success":           {domain: "domain", url: server.URL, status: http.StatusOK},
+		"server error":      {domain: "domain", url: server.URL, status: http.StatusBadGateway, retryable: true, err: "completion callback returned 502 Bad Gateway"},
+		"too many requests": {domain: "domain", url: server.URL, status: http.StatusTooManyRequests, retryable: true, err: "completion callback returned 429 Too Many Requests"},
+		"client error":      {domain: "domain", url: server.URL, status: http.StatusNotFound, err: "completion callback returned 404 Not Found"},
+		"not enabled":       {domain: "other", url: server.URL, status: http.StatusOK, err: errCompletionCallbacksDisabled.Error()},
+		"host not allowed":  {domain: "domain", url: "http://169.254.169.254/", status: http.StatusOK, err: `completion callback host "169.254.169.254" is not allowed`},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			statusCode = tc.status
+			retryable, err := dispatcher.deliver(context.Background(), tc.domain, &types.CompletionCallback{URL: tc.url}, nil)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+			assert.Equal(t, tc.retryable, retryable)
+		})
+	}
+}
+
+func TestCompletionCallbackDueTime(t *testing.T) {
+	lastAttempt := time.Unix(1700000000, 0)
+	failed := func(attempt int32) *types.CompletionCallbackInfo {
+		return &types.CompletionCallbackInfo{
+			Attempt:              attempt,
+			LastAttemptTimestamp: common.Int64Ptr(lastAttempt.UnixNano()),
+		}
+	}
+
+	assert.Equal(t, time.Unix(0, 0), completionCallbackDueTime(&types.CompletionCallbackInfo{}))
+	assert.Equal(t, lastAttempt.Add(time.Second), completionCallbackDueTime(failed(1)))
+	assert.Equal(t, lastAttempt.Add(8*time.Second), completionCallbackDueTime(failed(4)))
+	assert.Equal(t, lastAttempt.Add(10*time.Minute), completionCallbackDueTime(failed(100)))
+}
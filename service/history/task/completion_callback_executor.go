@@ -0,0 +1,344 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
+	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/history/shard"
+)
+
+type (
+	// completionCallbackExecutor delivers the completion callbacks of closed workflows. It is shared by the
+	// completion callback transfer task, which makes the first attempt, and the completion callback retry
+	// timer task, which makes the following ones.
+	completionCallbackExecutor struct {
+		shard          shard.Context
+		executionCache execution.Cache
+		logger         log.Logger
+		metricsScope   metrics.Scope
+		config         *config.Config
+		dispatcher     *completionCallbackDispatcher
+	}
+
+	// completionCallbackRequest is the body POSTed to the completion callbacks of a closed workflow
+	completionCallbackRequest struct {
+		Domain     string              `json:"domain"`
+		WorkflowID string              `json:"workflowId"`
+		RunID      string              `json:"runId"`
+		CloseEvent *types.HistoryEvent `json:"closeEvent"`
+	}
+
+	// completionCallbackDelivery is the request body and the callbacks of a workflow which are due for an attempt
+	completionCallbackDelivery struct {
+		domainName string
+		body       []byte
+		callbacks  []indexedCompletionCallback
+	}
+
+	// indexedCompletionCallback is a callback with its index in the execution info
+	indexedCompletionCallback struct {
+		index    int
+		callback *types.CompletionCallback
+	}
+
+	completionCallbackResult struct {
+		index     int
+		err       error
+		retryable bool
+	}
+)
+
+func newCompletionCallbackExecutor(
+	shard shard.Context,
+	executionCache execution.Cache,
+	logger log.Logger,
+	metricsScope metrics.Scope,
+	config *config.Config,
+) *completionCallbackExecutor {
+	return &completionCallbackExecutor{
+		shard:          shard,
+		executionCache: executionCache,
+		logger:         logger,
+		metricsScope:   metricsScope,
+		config:         config,
+		dispatcher:     newCompletionCallbackDispatcher(config),
+	}
+}
+
+// process records an attempt for the callbacks which are due together with a retry timer, then hands them
+// over to the dispatcher. The task is acked right away, the retry timer resumes the delivery when the
+// callbacks are still scheduled after the attempt, including when the shard moved to another host.
+func (e *completionCallbackExecutor) process(
+	ctx context.Context,
+	task persistence.Task,
+) error {
+
+	workflow := definition.NewWorkflowIdentifier(task.GetDomainID(), task.GetWorkflowID(), task.GetRunID())
+	reserved := e.dispatcher.reserve(workflow)
+	delivery, err := e.recordAttempt(ctx, task, reserved)
+	if !reserved {
+		return err
+	}
+	if err != nil || delivery == nil {
+		e.dispatcher.release(workflow)
+		return err
+	}
+	e.dispatcher.run(workflow, func(ctx context.Context) {
+		e.deliver(ctx, task, delivery)
+	})
+	return nil
+}
+
+// stop cancels the deliveries in flight, their callbacks are attempted again once the retry timer fires
+func (e *completionCallbackExecutor) stop() {
+	e.dispatcher.stop()
+}
+
+// recordAttempt counts an attempt for the callbacks which are due and schedules a retry timer for the
+// callbacks which remain scheduled. It returns the callbacks to deliver, or nil when there are none or
+// when the delivery could not be reserved, in which case the timer retries it after a request timeout.
+func (e *completionCallbackExecutor) recordAttempt(
+	ctx context.Context,
+	task persistence.Task,
+	reserved bool,
+) (_ *completionCallbackDelivery, retError error) {
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.GetDomainID(),
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return nil, errWorkflowBusy
+		}
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableState(ctx, wfContext, task, e.metricsScope, e.logger, 0)
+	if err != nil {
+		return nil, err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil, nil
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return nil, err
+	}
+	ok, err := verifyTaskVersion(e.shard, e.logger, task.GetDomainID(), lastWriteVersion, task.GetVersion(), task)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	now := e.shard.GetTimeSource().Now()
+	executionInfo := mutableState.GetExecutionInfo()
+	domainName := mutableState.GetDomainEntry().GetInfo().Name
+	maxAttempts := int32(e.config.CompletionCallbackMaxAttempts(domainName))
+	updated := false
+	var retryTime time.Time
+	retryAt := func(t time.Time) {
+		if retryTime.IsZero() || t.Before(retryTime) {
+			retryTime = t
+		}
+	}
+
+	var due []indexedCompletionCallback
+	for index, info := range executionInfo.CompletionCallbacks {
+		if info.GetState() != types.CompletionCallbackStateScheduled {
+			continue
+		}
+		if info.GetAttempt() >= maxAttempts {
+			// the result of the last attempt was never recorded, e.g. because the shard moved during the delivery
+			info.State = types.CompletionCallbackStateFailed
+			info.LastFailure = errCompletionCallbackCutShort.Error()
+			updated = true
+			continue
+		}
+		if dueTime := completionCallbackDueTime(info); now.Before(dueTime) {
+			retryAt(dueTime)
+			continue
+		}
+		due = append(due, indexedCompletionCallback{
+			index:    index,
+			callback: info.GetCallback(),
+		})
+	}
+
+	var delivery *completionCallbackDelivery
+	switch {
+	case len(due) > 0 && reserved:
+		completionEvent, err := mutableState.GetCompletionEvent(ctx)
+		if err != nil {
+			return nil, err
+		}
+		body, err := json.Marshal(completionCallbackRequest{
+			Domain:     domainName,
+			WorkflowID: task.GetWorkflowID(),
+			RunID:      task.GetRunID(),
+			CloseEvent: completionEvent,
+		})
+		if err != nil {
+			return nil, err
+		}
+		delivery = &completionCallbackDelivery{
+			domainName: domainName,
+			body:       body,
+			callbacks:  due,
+		}
+
+		// the retry fires once the delivery had time to complete and the backoff after it has passed
+		deliveryTimeout := time.Duration(len(due)) * e.config.CompletionCallbackRequestTimeout()
+		for _, callback := range due {
+			info := executionInfo.CompletionCallbacks[callback.index]
+			info.Attempt++
+			info.LastAttemptTimestamp = common.Int64Ptr(now.UnixNano())
+			retryAt(completionCallbackDueTime(info).Add(deliveryTimeout))
+		}
+		updated = true
+	case len(due) > 0:
+		// the workflow already has a delivery in flight or the shard has too many of them
+		retryAt(now.Add(e.config.CompletionCallbackRequestTimeout()))
+	}
+
+	if !retryTime.IsZero() {
+		mutableState.AddTimerTasks(&persistence.CompletionCallbackRetryTimerTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID:   executionInfo.DomainID,
+				WorkflowID: executionInfo.WorkflowID,
+				RunID:      executionInfo.RunID,
+			},
+			TaskData: persistence.TaskData{
+				// TaskID is set by shard
+				VisibilityTimestamp: retryTime,
+				Version:             lastWriteVersion,
+			},
+			TaskList: executionInfo.TaskList,
+		})
+		updated = true
+	}
+	if !updated {
+		return nil, nil
+	}
+	if err := wfContext.UpdateWorkflowExecutionTasks(ctx, now); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// deliver runs on the dispatcher, it calls the endpoints without holding the workflow lock
+// and writes the results back afterwards
+func (e *completionCallbackExecutor) deliver(
+	ctx context.Context,
+	task persistence.Task,
+	delivery *completionCallbackDelivery,
+) {
+
+	results := make([]completionCallbackResult, 0, len(delivery.callbacks))
+	for _, callback := range delivery.callbacks {
+		retryable, err := e.dispatcher.deliver(ctx, delivery.domainName, callback.callback, delivery.body)
+		results = append(results, completionCallbackResult{
+			index:     callback.index,
+			err:       err,
+			retryable: retryable,
+		})
+	}
+	if ctx.Err() != nil {
+		// the shard is closing, the callbacks are attempted again by its next owner
+		return
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, taskDefaultTimeout)
+	defer cancel()
+	if err := e.recordResults(updateCtx, task, results); err != nil {
+		e.logger.Warn("Failed to update completion callbacks",
+			tag.WorkflowDomainID(task.GetDomainID()),
+			tag.WorkflowID(task.GetWorkflowID()),
+			tag.WorkflowRunID(task.GetRunID()),
+			tag.Error(err),
+		)
+	}
+}
+
+// recordResults writes the outcome of an attempt, the callbacks which failed with a retryable error
+// stay scheduled for the retry timer
+func (e *completionCallbackExecutor) recordResults(
+	ctx context.Context,
+	task persistence.Task,
+	results []completionCallbackResult,
+) (retError error) {
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.GetDomainID(),
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableState(ctx, wfContext, task, e.metricsScope, e.logger, 0)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	maxAttempts := int32(e.config.CompletionCallbackMaxAttempts(mutableState.GetDomainEntry().GetInfo().Name))
+	infos := mutableState.GetExecutionInfo().CompletionCallbacks
+	for _, result := range results {
+		if result.index >= len(infos) || infos[result.index].GetState() != types.CompletionCallbackStateScheduled {
+			continue
+		}
+		info := infos[result.index]
+		switch {
+		case result.err == nil:
+			info.State = types.CompletionCallbackStateSucceeded
+			info.LastFailure = ""
+		case !result.retryable || info.Attempt >= maxAttempts:
+			info.State = types.CompletionCallbackStateFailed
+			info.LastFailure = result.err.Error()
+		default:
+			info.LastFailure = result.err.Error()
+		}
+	}
+
+	return wfContext.UpdateWorkflowExecutionTasks(ctx, e.shard.GetTimeSource().Now())
+}
@@ -127,6 +127,11 @@ func GetTransferTaskMetricsScope(
 			return metrics.TransferActiveTaskApplyParentClosePolicyScope
 		}
 		return metrics.TransferStandbyTaskApplyParentClosePolicyScope
+	case persistence.TransferTaskTypeCompletionCallback:
+		if isActive {
+			return metrics.TransferActiveTaskCompletionCallbackScope
+		}
+		return metrics.TransferStandbyTaskCompletionCallbackScope
 	default:
 		if isActive {
 			return metrics.TransferActiveQueueProcessorScope
@@ -176,6 +181,11 @@ func GetTimerTaskMetricScope(
 			return metrics.TimerActiveTaskWorkflowBackoffTimerScope
 		}
 		return metrics.TimerStandbyTaskWorkflowBackoffTimerScope
+	case persistence.TaskTypeCompletionCallbackRetryTimer:
+		if isActive {
+			return metrics.TimerActiveTaskCompletionCallbackRetryTimerScope
+		}
+		return metrics.TimerStandbyTaskCompletionCallbackRetryTimerScope
 	default:
 		if isActive {
 			return metrics.TimerActiveQueueProcessorScope
@@ -228,6 +228,18 @@ func TestGetTransferTaskMetricsScope(t *testing.T) {
 			isActive:      false,
 			expectedScope: metrics.TransferStandbyTaskApplyParentClosePolicyScope,
 		},
+		{
+			name:          "TransferTaskTypeCompletionCallback - active",
+			taskType:      persistence.TransferTaskTypeCompletionCallback,
+			isActive:      true,
+			expectedScope: metrics.TransferActiveTaskCompletionCallbackScope,
+		},
+		{
+			name:          "TransferTaskTypeCompletionCallback - standby",
+			taskType:      persistence.TransferTaskTypeCompletionCallback,
+			isActive:      false,
+			expectedScope: metrics.TransferStandbyTaskCompletionCallbackScope,
+		},
 		{
 			name:          "TransferTaskType not caught - active",
 			taskType:      -100,
@@ -328,6 +340,18 @@ func TestGetTimerTaskMetricScope(t *testing.T) {
 			isActive:      false,
 			expectedScope: metrics.TimerStandbyTaskWorkflowBackoffTimerScope,
 		},
+		{
+			name:          "TimerTaskTypeCompletionCallbackRetryTimer - active",
+			taskType:      persistence.TaskTypeCompletionCallbackRetryTimer,
+			isActive:      true,
+			expectedScope: metrics.TimerActiveTaskCompletionCallbackRetryTimerScope,
+		},
+		{
+			name:          "TimerTaskTypeCompletionCallbackRetryTimer - standby",
+			taskType:      persistence.TaskTypeCompletionCallbackRetryTimer,
+			isActive:      false,
+			expectedScope: metrics.TimerStandbyTaskCompletionCallbackRetryTimerScope,
+		},
 		{
 			name:          "TimerTaskTypeDeleteHistoryEvent - active",
 			taskType:      persistence.TaskTypeDeleteHistoryEvent,
@@ -53,6 +53,8 @@ var (
 type (
 	timerActiveTaskExecutor struct {
 		*timerTaskExecutorBase
+
+		completionCallbacks *completionCallbackExecutor
 	}
 )
 
@@ -74,6 +76,13 @@ func NewTimerActiveTaskExecutor(
 			metricsClient,
 			config,
 		),
+		completionCallbacks: newCompletionCallbackExecutor(
+			shard,
+			executionCache,
+			logger,
+			metricsClient.Scope(metrics.TimerQueueProcessorScope),
+			config,
+		),
 	}
 }
 
@@ -121,6 +130,10 @@ func (t *timerActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowBackoffTimerTask(ctx, timerTask)
+	case *persistence.CompletionCallbackRetryTimerTask:
+		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
+		defer cancel()
+		return executeResponse, t.completionCallbacks.process(ctx, timerTask)
 	case *persistence.DeleteHistoryEventTask:
 		ctx, cancel := context.WithTimeout(t.ctx, time.Duration(t.config.DeleteHistoryEventContextTimeout())*time.Second)
 		defer cancel()
@@ -130,6 +143,11 @@ func (t *timerActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 	}
 }
 
+func (t *timerActiveTaskExecutor) Stop() {
+	t.timerTaskExecutorBase.Stop()
+	t.completionCallbacks.stop()
+}
+
 func (t *timerActiveTaskExecutor) executeUserTimerTimeoutTask(
 	ctx context.Context,
 	task *persistence.UserTimerTask,
@@ -835,6 +853,7 @@ func (t *timerActiveTaskExecutor) executeWorkflowTimeoutTask(
 	}
 
 	startAttributes := startEvent.WorkflowExecutionStartedEventAttributes
+	// the started event may have lost the workflow ID hash of the active cluster selection policy, the execution info keeps it
 	continueAsNewAttributes := &types.ContinueAsNewWorkflowExecutionDecisionAttributes{
 		WorkflowType:                        startAttributes.WorkflowType,
 		TaskList:                            startAttributes.TaskList,
@@ -851,7 +870,7 @@ func (t *timerActiveTaskExecutor) executeWorkflowTimeoutTask(
 		SearchAttributes:                    startAttributes.SearchAttributes,
 		JitterStartSeconds:                  startAttributes.JitterStartSeconds,
 		CronOverlapPolicy:                   startAttributes.CronOverlapPolicy,
-		ActiveClusterSelectionPolicy:        startAttributes.ActiveClusterSelectionPolicy,
+		ActiveClusterSelectionPolicy:        mutableState.GetExecutionInfo().ActiveClusterSelectionPolicy,
 	}
 	newMutableState, err := retryWorkflow(
 		ctx,
@@ -1084,6 +1084,86 @@ func (s *timerActiveTaskExecutorSuite) TestWorkflowBackoffTimer_Noop() {
 	s.NoError(err)
 }
 
+func (s *timerActiveTaskExecutorSuite) TestCompletionCallbackRetryTimer_NotDue() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	// the backoff after the first attempt has not passed yet
+	lastAttempt := s.timeSource.Now()
+	callbacks := types.NewCompletionCallbackInfos([]*types.CompletionCallback{{URL: "http://callback.test"}})
+	callbacks[0].Attempt = 1
+	callbacks[0].LastAttemptTimestamp = common.Int64Ptr(lastAttempt.UnixNano())
+	mutableState.GetExecutionInfo().CompletionCallbacks = callbacks
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	timerTask := s.newCompletionCallbackRetryTimerTask(workflowExecution)
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		callbacks := request.UpdateWorkflowMutation.ExecutionInfo.CompletionCallbacks
+		timers := request.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTimer]
+		return len(callbacks) == 1 &&
+			callbacks[0].Attempt == 1 &&
+			len(timers) == 1 &&
+			!timers[0].GetVisibilityTimestamp().Before(lastAttempt.Add(completionCallbackRetryInitialInterval))
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	_, err = s.timerActiveTaskExecutor.Execute(timerTask)
+	s.NoError(err)
+	s.False(s.timerActiveTaskExecutor.completionCallbacks.dispatcher.isInflight(definition.NewWorkflowIdentifier(
+		s.domainID,
+		workflowExecution.GetWorkflowID(),
+		workflowExecution.GetRunID(),
+	)))
+}
+
+func (s *timerActiveTaskExecutorSuite) TestCompletionCallbackRetryTimer_AttemptCutShort() {
+
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	// the last attempt was recorded but its result was not, e.g. because the shard moved
+	callbacks := types.NewCompletionCallbackInfos([]*types.CompletionCallback{{URL: "http://callback.test"}})
+	callbacks[0].Attempt = int32(s.timerActiveTaskExecutor.config.CompletionCallbackMaxAttempts(s.domain))
+	callbacks[0].LastAttemptTimestamp = common.Int64Ptr(s.timeSource.Now().Add(-time.Hour).UnixNano())
+	mutableState.GetExecutionInfo().CompletionCallbacks = callbacks
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	timerTask := s.newCompletionCallbackRetryTimerTask(workflowExecution)
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		callbacks := request.UpdateWorkflowMutation.ExecutionInfo.CompletionCallbacks
+		return len(callbacks) == 1 &&
+			callbacks[0].State == types.CompletionCallbackStateFailed &&
+			callbacks[0].LastFailure == errCompletionCallbackCutShort.Error() &&
+			len(request.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTimer]) == 0
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	_, err = s.timerActiveTaskExecutor.Execute(timerTask)
+	s.NoError(err)
+}
+
+func (s *timerActiveTaskExecutorSuite) newCompletionCallbackRetryTimerTask(workflowExecution types.WorkflowExecution) Task {
+	return s.newTimerTaskFromInfo(&persistence.CompletionCallbackRetryTimerTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version:             s.version,
+			TaskID:              int64(100),
+			VisibilityTimestamp: s.timeSource.Now(),
+		},
+	})
+}
+
 func (s *timerActiveTaskExecutorSuite) TestActivityRetryTimer_Fire() {
 
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
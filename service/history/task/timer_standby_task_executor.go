@@ -139,6 +139,9 @@ func (t *timerStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error) {
 		ctx, cancel := context.WithTimeout(t.ctx, taskDefaultTimeout)
 		defer cancel()
 		return executeResponse, t.executeWorkflowBackoffTimerTask(ctx, timerTask)
+	case *persistence.CompletionCallbackRetryTimerTask:
+		// completion callbacks are only delivered by the active cluster, same as the completion callback transfer task
+		return executeResponse, nil
 	case *persistence.DeleteHistoryEventTask:
 		// special timeout for delete history event
 		deleteHistoryEventContext, deleteHistoryEventCancel := context.WithTimeout(t.ctx, time.Duration(t.config.DeleteHistoryEventContextTimeout())*time.Second)
@@ -485,6 +488,11 @@ func (t *timerStandbyTaskExecutor) processTimer(
 		return nil
 	}
 
+	if mutableState.GetExecutionInfo().ReplicationExcluded {
+		// see transferStandbyTaskExecutor.processTransfer
+		return nil
+	}
+
 	historyResendInfo, err := actionFn(ctx, wfContext, mutableState)
 	if err != nil {
 		if t.logger.DebugOn() {
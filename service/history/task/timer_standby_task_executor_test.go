@@ -227,6 +227,35 @@ func (s *timerStandbyTaskExecutorSuite) TestProcessUserTimerTimeout_Pending() {
 	s.Equal(s.domainID, s.mockDLQWriter.calls[0].DomainID)
 }
 
+func (s *timerStandbyTaskExecutorSuite) TestProcessUserTimerTimeout_ReplicationExcluded() {
+
+	_, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	mutableState.GetExecutionInfo().ReplicationExcluded = true
+
+	timerID := "timer"
+	timerTimeout := 2 * time.Second
+	event, _ := test.AddTimerStartedEvent(mutableState, decisionCompletionID, timerID, int64(timerTimeout.Seconds()))
+
+	timerSequence := execution.NewTimerSequence(mutableState)
+	mutableState.DeleteTimerTasks()
+	modified, err := timerSequence.CreateNextUserTimer()
+	s.NoError(err)
+	s.True(modified)
+	task := mutableState.GetTimerTasks()[0]
+	timerTask := s.newTimerTaskFromInfo(task)
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	// no history is ever replicated for the workflow, the task is acked instead of holding back the queue
+	s.mockShard.SetCurrentTime(s.clusterName, s.timeSource.Now().Add(s.fetchHistoryDuration))
+	_, err = s.timerStandbyTaskExecutor.Execute(timerTask)
+	s.NoError(err)
+	s.Empty(s.mockDLQWriter.calls)
+}
+
 func (s *timerStandbyTaskExecutorSuite) TestProcessUserTimerTimeout_Pending_ActiveActiveDomain() {
 
 	domainID := constants.TestActiveActiveDomainID
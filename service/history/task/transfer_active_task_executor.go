@@ -76,6 +76,7 @@ type (
 		parentClosePolicyClient parentclosepolicy.Client
 		workflowResetter        reset.WorkflowResetter
 		wfIDCache               workflowcache.WFCache
+		completionCallbacks     *completionCallbackExecutor
 	}
 
 	generatorF = func(taskGenerator execution.MutableStateTaskGenerator) error
@@ -110,6 +111,13 @@ func NewTransferActiveTaskExecutor(
 		),
 		workflowResetter: workflowResetter,
 		wfIDCache:        wfIDCache,
+		completionCallbacks: newCompletionCallbackExecutor(
+			shard,
+			executionCache,
+			logger,
+			shard.GetMetricsClient().Scope(metrics.TransferQueueProcessorScope),
+			config,
+		),
 	}
 }
 
@@ -158,13 +166,16 @@ func (t *transferActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error)
 		return executeResponse, t.processResetWorkflow(ctx, transferTask)
 	case *persistence.UpsertWorkflowSearchAttributesTask:
 		return executeResponse, t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case *persistence.CompletionCallbackTask:
+		return executeResponse, t.completionCallbacks.process(ctx, transferTask)
 	default:
 		return executeResponse, errUnknownTransferTask
 	}
 }
 
-// Empty func for now
-func (t *transferActiveTaskExecutor) Stop() {}
+func (t *transferActiveTaskExecutor) Stop() {
+	t.completionCallbacks.stop()
+}
 
 func (t *transferActiveTaskExecutor) processActivityTask(
 	ctx context.Context,
@@ -25,7 +25,10 @@ import (
 	"encoding/json"
 	"errors"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -46,6 +49,7 @@ import (
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/completioncallback"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/mocks"
@@ -1974,6 +1978,184 @@ func (s *transferActiveTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttribu
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_Success() {
+	s.testProcessCompletionCallback(http.StatusOK, types.CompletionCallbackStateSucceeded, "")
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_RetryableFailure() {
+	// the callback stays scheduled and is attempted again by the retry timer recorded with the attempt
+	s.testProcessCompletionCallback(http.StatusServiceUnavailable, types.CompletionCallbackStateScheduled, "completion callback returned 503 Service Unavailable")
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_NonRetryableFailure() {
+	s.testProcessCompletionCallback(http.StatusBadRequest, types.CompletionCallbackStateFailed, "completion callback returned 400 Bad Request")
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_Redirect() {
+	s.testProcessCompletionCallback(http.StatusFound, types.CompletionCallbackStateFailed, "completion callback returned 302 Found")
+}
+
+func (s *transferActiveTaskExecutorSuite) testProcessCompletionCallback(
+	statusCode int,
+	expectedState types.CompletionCallbackState,
+	expectedFailure string,
+) {
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	var requests int32
+	receivedCh := make(chan completionCallbackRequest, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		s.Equal(http.MethodPost, r.Method)
+		s.Equal("Bearer token", r.Header.Get("Authorization"))
+		var received completionCallbackRequest
+		s.NoError(json.NewDecoder(r.Body).Decode(&received))
+		receivedCh <- received
+		if statusCode == http.StatusFound {
+			w.Header().Set("Location", "http://169.254.169.254/latest/meta-data")
+		}
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+	s.enableCompletionCallbacks(server)
+
+	mutableState.GetExecutionInfo().CompletionCallbacks = types.NewCompletionCallbackInfos([]*types.CompletionCallback{
+		{URL: server.URL, Header: map[string]string{"Authorization": "Bearer token"}},
+	})
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	transferTask := s.newCompletionCallbackTask(workflowExecution)
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	// the attempt is recorded with its retry timer before the endpoint is called
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		callbacks := request.UpdateWorkflowMutation.ExecutionInfo.CompletionCallbacks
+		timers := request.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTimer]
+		return len(callbacks) == 1 &&
+			callbacks[0].State == types.CompletionCallbackStateScheduled &&
+			callbacks[0].Attempt == 1 &&
+			callbacks[0].LastFailure == "" &&
+			len(timers) == 1 &&
+			timers[0].GetTaskType() == persistence.TaskTypeCompletionCallbackRetryTimer
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		callbacks := request.UpdateWorkflowMutation.ExecutionInfo.CompletionCallbacks
+		return len(callbacks) == 1 &&
+			callbacks[0].State == expectedState &&
+			callbacks[0].Attempt == 1 &&
+			callbacks[0].LastFailure == expectedFailure
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	// the task is acked right away and the callbacks are delivered in the background
+	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+	s.NoError(err)
+	s.transferActiveTaskExecutor.completionCallbacks.dispatcher.wg.Wait()
+	s.Equal(int32(1), atomic.LoadInt32(&requests))
+	received := <-receivedCh
+	s.Equal(s.domainName, received.Domain)
+	s.Equal(workflowExecution.GetWorkflowID(), received.WorkflowID)
+	s.Equal(workflowExecution.GetRunID(), received.RunID)
+	s.Equal(event.ID, received.CloseEvent.ID)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_HostNotAllowed() {
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("the endpoint should not be called")
+	}))
+	defer server.Close()
+	s.enableCompletionCallbacks(server)
+
+	// the allowlist may have changed since the workflow was started
+	mutableState.GetExecutionInfo().CompletionCallbacks = types.NewCompletionCallbackInfos([]*types.CompletionCallback{
+		{URL: "http://169.254.169.254/latest/meta-data"},
+	})
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		callbacks := request.UpdateWorkflowMutation.ExecutionInfo.CompletionCallbacks
+		return len(callbacks) == 1 &&
+			callbacks[0].State == types.CompletionCallbackStateScheduled
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		callbacks := request.UpdateWorkflowMutation.ExecutionInfo.CompletionCallbacks
+		return len(callbacks) == 1 &&
+			callbacks[0].State == types.CompletionCallbackStateFailed &&
+			callbacks[0].LastFailure == `completion callback host "169.254.169.254" is not allowed`
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	_, err = s.transferActiveTaskExecutor.Execute(s.newCompletionCallbackTask(workflowExecution))
+	s.NoError(err)
+	s.transferActiveTaskExecutor.completionCallbacks.dispatcher.wg.Wait()
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_DispatcherBusy() {
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("the endpoint should not be called")
+	}))
+	defer server.Close()
+	s.enableCompletionCallbacks(server)
+	s.transferActiveTaskExecutor.config.CompletionCallbackMaxConcurrentDeliveries = dynamicproperties.GetIntPropertyFn(0)
+
+	mutableState.GetExecutionInfo().CompletionCallbacks = types.NewCompletionCallbackInfos([]*types.CompletionCallback{
+		{URL: server.URL},
+	})
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	// no attempt is recorded, only a retry timer
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		callbacks := request.UpdateWorkflowMutation.ExecutionInfo.CompletionCallbacks
+		timers := request.UpdateWorkflowMutation.TasksByCategory[persistence.HistoryTaskCategoryTimer]
+		return len(callbacks) == 1 &&
+			callbacks[0].Attempt == 0 &&
+			len(timers) == 1 &&
+			timers[0].GetTaskType() == persistence.TaskTypeCompletionCallbackRetryTimer
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	_, err = s.transferActiveTaskExecutor.Execute(s.newCompletionCallbackTask(workflowExecution))
+	s.NoError(err)
+	s.transferActiveTaskExecutor.completionCallbacks.dispatcher.wg.Wait()
+}
+
+// enableCompletionCallbacks allows the callbacks to the test server, the test server listens on loopback
+// so the executor uses its client instead of the one rejecting internal addresses
+func (s *transferActiveTaskExecutorSuite) enableCompletionCallbacks(server *httptest.Server) {
+	s.transferActiveTaskExecutor.config.EnableCompletionCallbacks = dynamicproperties.GetBoolPropertyFnFilteredByDomain(true)
+	s.transferActiveTaskExecutor.config.CompletionCallbackAllowedHosts = func(...dynamicproperties.FilterOption) []interface{} {
+		return []interface{}{"127.0.0.1"}
+	}
+	s.transferActiveTaskExecutor.completionCallbacks.dispatcher.allowlist = completioncallback.NewHostAllowlist(s.transferActiveTaskExecutor.config.CompletionCallbackAllowedHosts)
+	client := server.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	s.transferActiveTaskExecutor.completionCallbacks.dispatcher.httpClient = client
+}
+
+func (s *transferActiveTaskExecutorSuite) newCompletionCallbackTask(workflowExecution types.WorkflowExecution) Task {
+	return s.newTransferTaskFromInfo(&persistence.CompletionCallbackTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+	})
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessResetWorkflow_ResetPointNil() {
 	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
 	s.NoError(err)
@@ -135,6 +135,10 @@ func (t *transferStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error
 		return executeResponse, nil
 	case *persistence.UpsertWorkflowSearchAttributesTask:
 		return executeResponse, t.processUpsertWorkflowSearchAttributes(ctx, transferTask)
+	case *persistence.CompletionCallbackTask:
+		// completion callbacks are only delivered by the active cluster, they are not replicated
+		// so a standby cluster has nothing to verify and won't deliver them after a failover
+		return executeResponse, nil
 	default:
 		return executeResponse, errUnknownTransferTask
 	}
@@ -656,6 +660,12 @@ func (t *transferStandbyTaskExecutor) processTransfer(
 		return nil
 	}
 
+	if mutableState.GetExecutionInfo().ReplicationExcluded && mutableState.IsWorkflowExecutionRunning() {
+		// the workflow only exists in this cluster, the active cluster has no history to resend for it and
+		// will never make progress on it. Its tasks are acked so that they don't hold back the standby queue.
+		return nil
+	}
+
 	historyResendInfo, err := actionFn(ctx, wfContext, mutableState)
 	if err != nil {
 		return err
@@ -75,6 +75,11 @@ func (g GRPCHandler) GetReplicationMessages(ctx context.Context, request *histor
 	return proto.FromHistoryGetReplicationMessagesResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) GetReplicationStatus(ctx context.Context, request *historyv1.GetReplicationStatusRequest) (*historyv1.GetReplicationStatusResponse, error) {
+	response, err := g.h.GetReplicationStatus(ctx, proto.ToHistoryGetReplicationStatusRequest(request))
+	return proto.FromHistoryGetReplicationStatusResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) MergeDLQMessages(ctx context.Context, request *historyv1.MergeDLQMessagesRequest) (*historyv1.MergeDLQMessagesResponse, error) {
 	response, err := g.h.MergeDLQMessages(ctx, proto.ToHistoryMergeDLQMessagesRequest(request))
 	return proto.FromHistoryMergeDLQMessagesResponse(response), proto.FromError(err)
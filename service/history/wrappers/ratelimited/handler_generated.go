@@ -109,6 +109,10 @@ func (h *historyHandler) GetReplicationMessages(ctx context.Context, gp1 *types.
 	return h.wrapped.GetReplicationMessages(ctx, gp1)
 }
 
+func (h *historyHandler) GetReplicationStatus(ctx context.Context, gp1 *types.GetReplicationStatusRequest) (gp2 *types.GetReplicationStatusResponse, err error) {
+	return h.wrapped.GetReplicationStatus(ctx, gp1)
+}
+
 func (h *historyHandler) Health(ctx context.Context) (hp1 *types.HealthStatus, err error) {
 	return h.wrapped.Health(ctx)
 }
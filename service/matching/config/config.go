@@ -28,6 +28,9 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 )
 
+// RangeSize is the number of task IDs a task list allocates with each lease
+const RangeSize = 100000
+
 type (
 	// Config represents configuration for cadence-matching service
 	Config struct {
@@ -71,6 +74,11 @@ type (
 		EnablePartitionEmptyCheck                 dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
 		EnableStandbyTaskCompletion               dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
 		EnableClientAutoConfig                    dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
+		PollerHintMinPollerCount                  dynamicproperties.IntPropertyFnWithTaskListInfoFilters
+		PollerHintMaxPollerCount                  dynamicproperties.IntPropertyFnWithTaskListInfoFilters
+		PollerHintMinSyncMatchRate                dynamicproperties.FloatPropertyFnWithTaskListInfoFilters
+		PollerHintScaleUpBacklogAge               dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
+		PollerHintScaleDownPollWaitTime           dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		QPSTrackerInterval                        dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		OverrideTaskListRPS                       dynamicproperties.FloatPropertyFnWithTaskListInfoFilters
 		EnablePartitionIsolationGroupAssignment   dynamicproperties.BoolPropertyFnWithTaskListInfoFilters
@@ -177,6 +185,12 @@ type (
 		// standby task completion configuration
 		EnableStandbyTaskCompletion func() bool
 		EnableClientAutoConfig      func() bool
+		// poller count hint configuration
+		PollerHintMinPollerCount        func() int
+		PollerHintMaxPollerCount        func() int
+		PollerHintMinSyncMatchRate      func() float64
+		PollerHintScaleUpBacklogAge     func() time.Duration
+		PollerHintScaleDownPollWaitTime func() time.Duration
 	}
 )
 
@@ -190,7 +204,7 @@ func NewConfig(dc *dynamicconfig.Collection, operationalDC *dynamicconfig.Collec
 		WorkerRPS:                                  dc.GetIntProperty(dynamicproperties.MatchingWorkerRPS),
 		DomainUserRPS:                              dc.GetIntPropertyFilteredByDomain(dynamicproperties.MatchingDomainUserRPS),
 		DomainWorkerRPS:                            dc.GetIntPropertyFilteredByDomain(dynamicproperties.MatchingDomainWorkerRPS),
-		RangeSize:                                  100000,
+		RangeSize:                                  RangeSize,
 		ReadRangeSize:                              dc.GetIntProperty(dynamicproperties.MatchingReadRangeSize),
 		GetTasksBatchSize:                          dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingGetTasksBatchSize),
 		UpdateAckInterval:                          dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingUpdateAckInterval),
@@ -243,6 +257,11 @@ func NewConfig(dc *dynamicconfig.Collection, operationalDC *dynamicconfig.Collec
 		AllIsolationGroups:                         getIsolationGroups,
 		EnableStandbyTaskCompletion:                dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableStandbyTaskCompletion),
 		EnableClientAutoConfig:                     dc.GetBoolPropertyFilteredByTaskListInfo(dynamicproperties.MatchingEnableClientAutoConfig),
+		PollerHintMinPollerCount:                   dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingPollerHintMinPollerCount),
+		PollerHintMaxPollerCount:                   dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingPollerHintMaxPollerCount),
+		PollerHintMinSyncMatchRate:                 dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicproperties.MatchingPollerHintMinSyncMatchRate),
+		PollerHintScaleUpBacklogAge:                dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingPollerHintScaleUpBacklogAge),
+		PollerHintScaleDownPollWaitTime:            dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingPollerHintScaleDownPollWaitTime),
 		EnableReturnAllTaskListKinds:               dc.GetBoolProperty(dynamicproperties.MatchingEnableReturnAllTaskListKinds),
 		ExcludeShortLivedTaskListsFromShardManager: operationalDC.GetBoolProperty(dynamicproperties.MatchingExcludeShortLivedTaskListsFromShardManager),
 		RecordTaskStartedTimeout:                   dc.GetDurationPropertyFilteredByDomain(dynamicproperties.MatchingRecordTaskStartedTimeout),
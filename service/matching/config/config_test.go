@@ -96,6 +96,11 @@ func TestNewConfig(t *testing.T) {
 		"OverrideTaskListRPS":                       {dynamicproperties.MatchingOverrideTaskListRPS, 1500.0},
 		"EnableStandbyTaskCompletion":               {dynamicproperties.MatchingEnableStandbyTaskCompletion, false},
 		"EnableClientAutoConfig":                    {dynamicproperties.MatchingEnableClientAutoConfig, false},
+		"PollerHintMinPollerCount":                  {dynamicproperties.MatchingPollerHintMinPollerCount, 44},
+		"PollerHintMaxPollerCount":                  {dynamicproperties.MatchingPollerHintMaxPollerCount, 45},
+		"PollerHintMinSyncMatchRate":                {dynamicproperties.MatchingPollerHintMinSyncMatchRate, 0.46},
+		"PollerHintScaleUpBacklogAge":               {dynamicproperties.MatchingPollerHintScaleUpBacklogAge, time.Duration(47)},
+		"PollerHintScaleDownPollWaitTime":           {dynamicproperties.MatchingPollerHintScaleDownPollWaitTime, time.Duration(48)},
 		"TaskIsolationDuration":                     {dynamicproperties.TaskIsolationDuration, time.Duration(35)},
 		"TaskIsolationPollerWindow":                 {dynamicproperties.TaskIsolationPollerWindow, time.Duration(36)},
 		"EnablePartitionIsolationGroupAssignment":   {dynamicproperties.EnablePartitionIsolationGroupAssignment, true},
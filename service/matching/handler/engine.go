@@ -68,6 +68,9 @@ const (
 	// _defaultSDReportTTL is the default TTL for shard status reports from matching executor to shard distributor.
 	// This controls how frequently the executor reports its shard load/status to the distributor.
 	_defaultSDReportTTL = 1 * time.Minute
+
+	// _moveTaskListBacklogBatchSize is the number of tasks MoveTaskListBacklog reads, deletes and appends at a time
+	_moveTaskListBacklogBatchSize = 100
 )
 
 // Implements matching.Engine
@@ -624,8 +627,9 @@ pollLoop:
 					PartitionConfig:   tlMgr.TaskListPartitionConfig(),
 					LoadBalancerHints: tlMgr.LoadBalancerHints(),
 					AutoConfigHint: &types.AutoConfigHint{
-						EnableAutoConfig:   e.config.EnableClientAutoConfig(domainName, taskListName, persistence.TaskListTypeDecision),
-						PollerWaitTimeInMs: time.Since(startT).Milliseconds(),
+						EnableAutoConfig:       e.config.EnableClientAutoConfig(domainName, taskListName, persistence.TaskListTypeDecision),
+						PollerWaitTimeInMs:     time.Since(startT).Milliseconds(),
+						RecommendedPollerCount: tlMgr.RecommendedPollerCount(),
 					},
 				}, nil
 			}
@@ -815,8 +819,9 @@ pollLoop:
 					PartitionConfig:   tlMgr.TaskListPartitionConfig(),
 					LoadBalancerHints: tlMgr.LoadBalancerHints(),
 					AutoConfigHint: &types.AutoConfigHint{
-						EnableAutoConfig:   e.config.EnableClientAutoConfig(domainName, taskListName, persistence.TaskListTypeDecision),
-						PollerWaitTimeInMs: time.Since(startT).Milliseconds(),
+						EnableAutoConfig:       e.config.EnableClientAutoConfig(domainName, taskListName, persistence.TaskListTypeDecision),
+						PollerWaitTimeInMs:     time.Since(startT).Milliseconds(),
+						RecommendedPollerCount: tlMgr.RecommendedPollerCount(),
 					},
 				}, nil
 			}
@@ -1191,6 +1196,91 @@ func (e *matchingEngineImpl) RefreshTaskListPartitionConfig(
 	return &types.MatchingRefreshTaskListPartitionConfigResponse{}, nil
 }
 
+// MoveTaskListBacklog moves tasks from a task list partition to the destination task list, which must be owned
+// by this host. The new task IDs are allocated by the manager of the destination, so its lease is kept. The tasks
+// are deleted through the owner of the source partition, which may be another host, before they are appended.
+func (e *matchingEngineImpl) MoveTaskListBacklog(
+	hCtx *handlerContext,
+	request *types.MatchingMoveTaskListBacklogRequest,
+) (*types.MoveTaskListBacklogResponse, error) {
+	domainID := request.DomainUUID
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	domainName, err := e.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return nil, err
+	}
+	if request.TaskList.GetName() == "" || request.DestinationTaskList.GetName() == "" {
+		return nil, &types.BadRequestError{Message: "Source and destination task lists must be set."}
+	}
+	if request.DestinationTaskList.GetKind() != types.TaskListKindNormal {
+		return nil, &types.BadRequestError{Message: "Tasks can only be moved to a normal tasklist."}
+	}
+	destinationID, err := tasklist.NewIdentifier(domainID, request.DestinationTaskList.GetName(), taskListType)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := e.getOrCreateTaskListManager(hCtx.Context, destinationID, types.TaskListKindNormal)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteTasks := func(ctx context.Context, taskIDs []int64) ([]int64, error) {
+		resp, err := e.matchingClient.DeleteTaskListBacklogTasks(ctx, &types.MatchingDeleteTaskListBacklogTasksRequest{
+			DomainUUID:   domainID,
+			TaskList:     &types.TaskList{Name: request.TaskList.GetName(), Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType: request.TaskListType,
+			TaskIDs:      taskIDs,
+		})
+		return resp.GetDeletedTaskIDs(), err
+	}
+	backlog := tasklist.NewBacklog(e.taskManager, domainID, domainName, request.TaskList.GetName(), taskListType, e.logger)
+	moved, err := backlog.Move(hCtx.Context, request.MinTaskID, request.MaxTaskID, destination, deleteTasks, _moveTaskListBacklogBatchSize)
+	if err != nil {
+		// a retry moves the remaining tasks, the ones already moved are gone from the source task list
+		e.logger.Warn("Failed to move task list backlog",
+			tag.WorkflowTaskListName(request.TaskList.GetName()),
+			tag.Counter(moved),
+			tag.Error(err),
+		)
+		return nil, err
+	}
+	return &types.MoveTaskListBacklogResponse{MovedTasks: int64(moved)}, nil
+}
+
+// DeleteTaskListBacklogTasks deletes tasks of a task list partition owned by this host. The manager of the
+// partition drops the ones it has already read, tasks which were handed to a poller are not deleted.
+func (e *matchingEngineImpl) DeleteTaskListBacklogTasks(
+	hCtx *handlerContext,
+	request *types.MatchingDeleteTaskListBacklogTasksRequest,
+) (*types.MatchingDeleteTaskListBacklogTasksResponse, error) {
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	if request.TaskList.GetName() == "" {
+		return nil, &types.BadRequestError{Message: "Task list must be set."}
+	}
+	if len(request.TaskIDs) == 0 {
+		return nil, &types.BadRequestError{Message: "Task IDs must be set."}
+	}
+	taskListID, err := tasklist.NewIdentifier(request.DomainUUID, request.TaskList.GetName(), taskListType)
+	if err != nil {
+		return nil, err
+	}
+	tlMgr, err := e.getOrCreateTaskListManager(hCtx.Context, taskListID, types.TaskListKindNormal)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := tlMgr.DeleteTasks(request.TaskIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MatchingDeleteTaskListBacklogTasksResponse{DeletedTaskIDs: deleted}, nil
+}
+
 func (e *matchingEngineImpl) getHostInfo(partitionKey string) (string, error) {
 	host, err := e.membershipResolver.Lookup(service.Matching, partitionKey)
 	if err != nil {
@@ -366,8 +366,9 @@ func (s *matchingEngineSuite) PollForDecisionTasksResultTest() {
 			Kind: &tlKind,
 		},
 		AutoConfigHint: &types.AutoConfigHint{
-			EnableAutoConfig:   false,
-			PollerWaitTimeInMs: 0,
+			EnableAutoConfig:       false,
+			PollerWaitTimeInMs:     0,
+			RecommendedPollerCount: 2,
 		},
 	}
 
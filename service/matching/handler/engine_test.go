@@ -37,6 +37,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -1426,6 +1427,227 @@ func TestRefreshTaskListPartitionConfig(t *testing.T) {
 	}
 }
 
+func TestMoveTaskListBacklog(t *testing.T) {
+	request := func() *types.MatchingMoveTaskListBacklogRequest {
+		return &types.MatchingMoveTaskListBacklogRequest{
+			DomainUUID:          "test-domain-id",
+			TaskList:            &types.TaskList{Name: "source-tasklist"},
+			TaskListType:        types.TaskListTypeActivity.Ptr(),
+			DestinationTaskList: &types.TaskList{Name: "test-tasklist"},
+			MinTaskID:           1,
+			MaxTaskID:           10,
+		}
+	}
+	testCases := []struct {
+		name          string
+		req           *types.MatchingMoveTaskListBacklogRequest
+		mockSetup     func(*tasklist.MockManager, *persistence.MockTaskManager, *matching.MockClient, *gomock.Controller, *executorclient.MockExecutor[tasklist.ShardProcessor])
+		want          *types.MoveTaskListBacklogResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  request(),
+			mockSetup: func(mockManager *tasklist.MockManager, taskManager *persistence.MockTaskManager, matchingClient *matching.MockClient, mockCtrl *gomock.Controller, mockExecutor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				mockExecutor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(mockCtrl), nil)
+				tasks := []*persistence.TaskInfo{{DomainID: "test-domain-id", TaskID: 3}, {DomainID: "test-domain-id", TaskID: 7}}
+				taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+						assert.Equal(t, "source-tasklist", req.TaskList)
+						assert.Equal(t, persistence.TaskListTypeActivity, req.TaskType)
+						assert.Equal(t, int64(0), req.ReadLevel)
+						assert.Equal(t, int64(10), *req.MaxReadLevel)
+						return &persistence.GetTasksResponse{Tasks: tasks}, nil
+					})
+				matchingClient.EXPECT().DeleteTaskListBacklogTasks(gomock.Any(), &types.MatchingDeleteTaskListBacklogTasksRequest{
+					DomainUUID:   "test-domain-id",
+					TaskList:     &types.TaskList{Name: "source-tasklist", Kind: types.TaskListKindNormal.Ptr()},
+					TaskListType: types.TaskListTypeActivity.Ptr(),
+					TaskIDs:      []int64{3, 7},
+				}).Return(&types.MatchingDeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{3, 7}}, nil)
+				mockManager.EXPECT().AppendTasks(gomock.Any(), tasks).Return(nil)
+			},
+			want: &types.MoveTaskListBacklogResponse{MovedTasks: 2},
+		},
+		{
+			name: "tasks dispatched by the source are not moved",
+			req:  request(),
+			mockSetup: func(mockManager *tasklist.MockManager, taskManager *persistence.MockTaskManager, matchingClient *matching.MockClient, mockCtrl *gomock.Controller, mockExecutor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				mockExecutor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(mockCtrl), nil)
+				tasks := []*persistence.TaskInfo{{DomainID: "test-domain-id", TaskID: 3}, {DomainID: "test-domain-id", TaskID: 7}}
+				taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: tasks}, nil)
+				matchingClient.EXPECT().DeleteTaskListBacklogTasks(gomock.Any(), gomock.Any()).
+					Return(&types.MatchingDeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{7}}, nil)
+				mockManager.EXPECT().AppendTasks(gomock.Any(), tasks[1:]).Return(nil)
+			},
+			want: &types.MoveTaskListBacklogResponse{MovedTasks: 1},
+		},
+		{
+			name: "source delete error",
+			req:  request(),
+			mockSetup: func(mockManager *tasklist.MockManager, taskManager *persistence.MockTaskManager, matchingClient *matching.MockClient, mockCtrl *gomock.Controller, mockExecutor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				mockExecutor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(mockCtrl), nil)
+				taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: []*persistence.TaskInfo{{TaskID: 3}}}, nil)
+				matchingClient.EXPECT().DeleteTaskListBacklogTasks(gomock.Any(), gomock.Any()).Return(nil, errors.New("delete error"))
+			},
+			expectedError: "delete error",
+		},
+		{
+			name: "destination not set",
+			req: func() *types.MatchingMoveTaskListBacklogRequest {
+				req := request()
+				req.DestinationTaskList = nil
+				return req
+			}(),
+			mockSetup: func(mockManager *tasklist.MockManager, taskManager *persistence.MockTaskManager, matchingClient *matching.MockClient, mockCtrl *gomock.Controller, mockExecutor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+			},
+			expectedError: "Source and destination task lists must be set.",
+		},
+		{
+			name: "sticky destination",
+			req: func() *types.MatchingMoveTaskListBacklogRequest {
+				req := request()
+				req.DestinationTaskList.Kind = types.TaskListKindSticky.Ptr()
+				return req
+			}(),
+			mockSetup: func(mockManager *tasklist.MockManager, taskManager *persistence.MockTaskManager, matchingClient *matching.MockClient, mockCtrl *gomock.Controller, mockExecutor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+			},
+			expectedError: "Tasks can only be moved to a normal tasklist.",
+		},
+		{
+			name: "destination append error",
+			req:  request(),
+			mockSetup: func(mockManager *tasklist.MockManager, taskManager *persistence.MockTaskManager, matchingClient *matching.MockClient, mockCtrl *gomock.Controller, mockExecutor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				mockExecutor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(mockCtrl), nil)
+				taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: []*persistence.TaskInfo{{TaskID: 3}}}, nil)
+				matchingClient.EXPECT().DeleteTaskListBacklogTasks(gomock.Any(), gomock.Any()).
+					Return(&types.MatchingDeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{3}}, nil)
+				mockManager.EXPECT().AppendTasks(gomock.Any(), gomock.Any()).Return(errors.New("append error"))
+			},
+			expectedError: "append error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(mockCtrl)
+			mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test-domain", nil)
+			tasklistID := mustNewIdentifier(t, "test-domain-id", "test-tasklist", persistence.TaskListTypeActivity)
+			mockManager := newMockManagerWithTaskListID(mockCtrl, tasklistID)
+			taskManager := persistence.NewMockTaskManager(mockCtrl)
+			matchingClient := matching.NewMockClient(mockCtrl)
+			mockExecutor := executorclient.NewMockExecutor[tasklist.ShardProcessor](mockCtrl)
+			tc.mockSetup(mockManager, taskManager, matchingClient, mockCtrl, mockExecutor)
+			taskListRegistry := tasklist.NewTaskListRegistry(metrics.NewNoopMetricsClient())
+			pct := membership.NewMockPercentageOnboarded(mockCtrl)
+			pct.EXPECT().Value().Return(100).AnyTimes()
+			engine := &matchingEngineImpl{
+				taskManager:         taskManager,
+				matchingClient:      matchingClient,
+				taskListRegistry:    taskListRegistry,
+				timeSource:          clock.NewRealTimeSource(),
+				domainCache:         mockDomainCache,
+				executor:            mockExecutor,
+				metricsClient:       metrics.NewNoopMetricsClient(),
+				logger:              log.NewNoop(),
+				percentageOnboarded: pct,
+				config: &config.Config{
+					ExcludeShortLivedTaskListsFromShardManager: func(opts ...dynamicproperties.FilterOption) bool { return false },
+				},
+			}
+			taskListRegistry.Register(*tasklistID, mockManager)
+			resp, err := engine.MoveTaskListBacklog(&handlerContext{Context: context.Background()}, tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, resp)
+			}
+		})
+	}
+}
+
+func TestDeleteTaskListBacklogTasks(t *testing.T) {
+	request := func() *types.MatchingDeleteTaskListBacklogTasksRequest {
+		return &types.MatchingDeleteTaskListBacklogTasksRequest{
+			DomainUUID:   "test-domain-id",
+			TaskList:     &types.TaskList{Name: "test-tasklist"},
+			TaskListType: types.TaskListTypeActivity.Ptr(),
+			TaskIDs:      []int64{3, 7},
+		}
+	}
+	testCases := []struct {
+		name          string
+		req           *types.MatchingDeleteTaskListBacklogTasksRequest
+		mockSetup     func(*tasklist.MockManager, *gomock.Controller, *executorclient.MockExecutor[tasklist.ShardProcessor])
+		want          *types.MatchingDeleteTaskListBacklogTasksResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  request(),
+			mockSetup: func(mockManager *tasklist.MockManager, mockCtrl *gomock.Controller, mockExecutor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				mockExecutor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(mockCtrl), nil)
+				mockManager.EXPECT().DeleteTasks([]int64{3, 7}).Return([]int64{7}, nil)
+			},
+			want: &types.MatchingDeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{7}},
+		},
+		{
+			name: "task IDs not set",
+			req: func() *types.MatchingDeleteTaskListBacklogTasksRequest {
+				req := request()
+				req.TaskIDs = nil
+				return req
+			}(),
+			mockSetup: func(mockManager *tasklist.MockManager, mockCtrl *gomock.Controller, mockExecutor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+			},
+			expectedError: "Task IDs must be set.",
+		},
+		{
+			name: "delete error",
+			req:  request(),
+			mockSetup: func(mockManager *tasklist.MockManager, mockCtrl *gomock.Controller, mockExecutor *executorclient.MockExecutor[tasklist.ShardProcessor]) {
+				mockExecutor.EXPECT().GetShardProcess(gomock.Any(), gomock.Any()).Return(tasklist.NewMockShardProcessor(mockCtrl), nil)
+				mockManager.EXPECT().DeleteTasks(gomock.Any()).Return(nil, errors.New("delete error"))
+			},
+			expectedError: "delete error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			tasklistID := mustNewIdentifier(t, "test-domain-id", "test-tasklist", persistence.TaskListTypeActivity)
+			mockManager := newMockManagerWithTaskListID(mockCtrl, tasklistID)
+			mockExecutor := executorclient.NewMockExecutor[tasklist.ShardProcessor](mockCtrl)
+			tc.mockSetup(mockManager, mockCtrl, mockExecutor)
+			taskListRegistry := tasklist.NewTaskListRegistry(metrics.NewNoopMetricsClient())
+			pct := membership.NewMockPercentageOnboarded(mockCtrl)
+			pct.EXPECT().Value().Return(100).AnyTimes()
+			engine := &matchingEngineImpl{
+				taskListRegistry:    taskListRegistry,
+				timeSource:          clock.NewRealTimeSource(),
+				executor:            mockExecutor,
+				metricsClient:       metrics.NewNoopMetricsClient(),
+				logger:              log.NewNoop(),
+				percentageOnboarded: pct,
+				config: &config.Config{
+					ExcludeShortLivedTaskListsFromShardManager: func(opts ...dynamicproperties.FilterOption) bool { return false },
+				},
+			}
+			taskListRegistry.Register(*tasklistID, mockManager)
+			resp, err := engine.DeleteTaskListBacklogTasks(&handlerContext{Context: context.Background()}, tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, resp)
+			}
+		})
+	}
+}
+
 func Test_domainChangeCallback(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockDomainCache := cache.NewMockDomainCache(mockCtrl)
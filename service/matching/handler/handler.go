@@ -505,6 +505,64 @@ func (h *handlerImpl) RefreshTaskListPartitionConfig(
 	return response, hCtx.handleErr(err)
 }
 
+// MoveTaskListBacklog moves tasks from a task list partition to a task list owned by this host
+func (h *handlerImpl) MoveTaskListBacklog(
+	ctx context.Context,
+	request *types.MatchingMoveTaskListBacklogRequest,
+) (resp *types.MoveTaskListBacklogResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.DomainUUID)
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.DestinationTaskList,
+		metrics.MatchingMoveTaskListBacklogScope,
+	)
+
+	sw, swStart := hCtx.startProfiling(&h.startWG)
+	defer func() {
+		sw.Stop()
+		hCtx.scope.ExponentialHistogram(metrics.CadenceLatencyPerTaskListHistogram, time.Since(swStart))
+	}()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.MoveTaskListBacklog(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+// DeleteTaskListBacklogTasks deletes persisted tasks of a task list partition owned by this host
+func (h *handlerImpl) DeleteTaskListBacklogTasks(
+	ctx context.Context,
+	request *types.MatchingDeleteTaskListBacklogTasksRequest,
+) (resp *types.MatchingDeleteTaskListBacklogTasksResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.DomainUUID)
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.TaskList,
+		metrics.MatchingDeleteTaskListBacklogTasksScope,
+	)
+
+	sw, swStart := hCtx.startProfiling(&h.startWG)
+	defer func() {
+		sw.Stop()
+		hCtx.scope.ExponentialHistogram(metrics.CadenceLatencyPerTaskListHistogram, time.Since(swStart))
+	}()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.DeleteTaskListBacklogTasks(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
 func (h *handlerImpl) domainName(id string) string {
 	domainName, err := h.domainCache.GetDomainName(id)
 	if err != nil {
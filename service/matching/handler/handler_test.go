@@ -902,6 +902,125 @@ func (s *handlerSuite) TestUpdateTaskListPartitionConfig() {
 	}
 }
 
+func (s *handlerSuite) TestMoveTaskListBacklog() {
+	request := types.MatchingMoveTaskListBacklogRequest{
+		DomainUUID:          "test-domain-id",
+		TaskList:            &types.TaskList{Name: "test-task-list"},
+		DestinationTaskList: &types.TaskList{Name: "test-destination"},
+		MinTaskID:           1,
+		MaxTaskID:           10,
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.MoveTaskListBacklogResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().MoveTaskListBacklog(gomock.Any(), &request).
+					Return(&types.MoveTaskListBacklogResponse{MovedTasks: 3}, nil).Times(1)
+			},
+			want: &types.MoveTaskListBacklogResponse{MovedTasks: 3},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - MoveTaskListBacklog failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().MoveTaskListBacklog(gomock.Any(), &request).
+					Return(nil, errors.New("move-backlog-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "move-backlog-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.MoveTaskListBacklog(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
+
+func (s *handlerSuite) TestDeleteTaskListBacklogTasks() {
+	request := types.MatchingDeleteTaskListBacklogTasksRequest{
+		DomainUUID:   "test-domain-id",
+		TaskList:     &types.TaskList{Name: "test-task-list"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		TaskIDs:      []int64{1, 2},
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.MatchingDeleteTaskListBacklogTasksResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().DeleteTaskListBacklogTasks(gomock.Any(), &request).
+					Return(&types.MatchingDeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{1}}, nil).Times(1)
+			},
+			want: &types.MatchingDeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{1}},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - DeleteTaskListBacklogTasks failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().DeleteTaskListBacklogTasks(gomock.Any(), &request).
+					Return(nil, errors.New("delete-backlog-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "delete-backlog-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.DeleteTaskListBacklogTasks(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
+
 func partitions(num int) map[int]*types.TaskListPartition {
 	result := make(map[int]*types.TaskListPartition, num)
 	for i := 0; i < num; i++ {
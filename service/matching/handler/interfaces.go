@@ -48,6 +48,8 @@ type (
 		GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
 		UpdateTaskListPartitionConfig(hCtx *handlerContext, request *types.MatchingUpdateTaskListPartitionConfigRequest) (*types.MatchingUpdateTaskListPartitionConfigResponse, error)
 		RefreshTaskListPartitionConfig(hCtx *handlerContext, request *types.MatchingRefreshTaskListPartitionConfigRequest) (*types.MatchingRefreshTaskListPartitionConfigResponse, error)
+		MoveTaskListBacklog(hCtx *handlerContext, request *types.MatchingMoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error)
+		DeleteTaskListBacklogTasks(hCtx *handlerContext, request *types.MatchingDeleteTaskListBacklogTasksRequest) (*types.MatchingDeleteTaskListBacklogTasksResponse, error)
 	}
 
 	// Handler interface for matching service
@@ -67,5 +69,7 @@ type (
 		RespondQueryTaskCompleted(context.Context, *types.MatchingRespondQueryTaskCompletedRequest) error
 		UpdateTaskListPartitionConfig(context.Context, *types.MatchingUpdateTaskListPartitionConfigRequest) (*types.MatchingUpdateTaskListPartitionConfigResponse, error)
 		RefreshTaskListPartitionConfig(context.Context, *types.MatchingRefreshTaskListPartitionConfigRequest) (*types.MatchingRefreshTaskListPartitionConfigResponse, error)
+		MoveTaskListBacklog(context.Context, *types.MatchingMoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error)
+		DeleteTaskListBacklogTasks(context.Context, *types.MatchingDeleteTaskListBacklogTasksRequest) (*types.MatchingDeleteTaskListBacklogTasksResponse, error)
 	}
 )
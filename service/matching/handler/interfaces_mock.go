@@ -86,6 +86,21 @@ func (mr *MockEngineMockRecorder) CancelOutstandingPoll(hCtx, request any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockEngine)(nil).CancelOutstandingPoll), hCtx, request)
 }
 
+// DeleteTaskListBacklogTasks mocks base method.
+func (m *MockEngine) DeleteTaskListBacklogTasks(hCtx *handlerContext, request *types.MatchingDeleteTaskListBacklogTasksRequest) (*types.MatchingDeleteTaskListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskListBacklogTasks", hCtx, request)
+	ret0, _ := ret[0].(*types.MatchingDeleteTaskListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskListBacklogTasks indicates an expected call of DeleteTaskListBacklogTasks.
+func (mr *MockEngineMockRecorder) DeleteTaskListBacklogTasks(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskListBacklogTasks", reflect.TypeOf((*MockEngine)(nil).DeleteTaskListBacklogTasks), hCtx, request)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockEngine) DescribeTaskList(hCtx *handlerContext, request *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +146,21 @@ func (mr *MockEngineMockRecorder) ListTaskListPartitions(hCtx, request any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockEngine)(nil).ListTaskListPartitions), hCtx, request)
 }
 
+// MoveTaskListBacklog mocks base method.
+func (m *MockEngine) MoveTaskListBacklog(hCtx *handlerContext, request *types.MatchingMoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskListBacklog", hCtx, request)
+	ret0, _ := ret[0].(*types.MoveTaskListBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskListBacklog indicates an expected call of MoveTaskListBacklog.
+func (mr *MockEngineMockRecorder) MoveTaskListBacklog(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskListBacklog", reflect.TypeOf((*MockEngine)(nil).MoveTaskListBacklog), hCtx, request)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockEngine) PollForActivityTask(hCtx *handlerContext, request *types.MatchingPollForActivityTaskRequest) (*types.MatchingPollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -312,6 +342,21 @@ func (mr *MockHandlerMockRecorder) CancelOutstandingPoll(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockHandler)(nil).CancelOutstandingPoll), arg0, arg1)
 }
 
+// DeleteTaskListBacklogTasks mocks base method.
+func (m *MockHandler) DeleteTaskListBacklogTasks(arg0 context.Context, arg1 *types.MatchingDeleteTaskListBacklogTasksRequest) (*types.MatchingDeleteTaskListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskListBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*types.MatchingDeleteTaskListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskListBacklogTasks indicates an expected call of DeleteTaskListBacklogTasks.
+func (mr *MockHandlerMockRecorder) DeleteTaskListBacklogTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskListBacklogTasks", reflect.TypeOf((*MockHandler)(nil).DeleteTaskListBacklogTasks), arg0, arg1)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockHandler) DescribeTaskList(arg0 context.Context, arg1 *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -372,6 +417,21 @@ func (mr *MockHandlerMockRecorder) ListTaskListPartitions(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockHandler)(nil).ListTaskListPartitions), arg0, arg1)
 }
 
+// MoveTaskListBacklog mocks base method.
+func (m *MockHandler) MoveTaskListBacklog(arg0 context.Context, arg1 *types.MatchingMoveTaskListBacklogRequest) (*types.MoveTaskListBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskListBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*types.MoveTaskListBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskListBacklog indicates an expected call of MoveTaskListBacklog.
+func (mr *MockHandlerMockRecorder) MoveTaskListBacklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskListBacklog", reflect.TypeOf((*MockHandler)(nil).MoveTaskListBacklog), arg0, arg1)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockHandler) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest) (*types.MatchingPollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,160 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// Backlog reads the persisted tasks of a single task list partition without going through the task list
+// manager that owns it. It is meant for operators inspecting or repairing a stuck backlog.
+//
+// The owner of the partition keeps buffering and dispatching tasks, so tasks are only deleted through it,
+// see Manager.DeleteTasks. Otherwise a deleted task may still be dispatched from the buffer of the owner.
+type Backlog struct {
+	db     *taskListDB
+	logger log.Logger
+}
+
+// NewBacklog returns the backlog of the given task list partition
+func NewBacklog(
+	store persistence.TaskManager,
+	domainID string,
+	domainName string,
+	taskListName string,
+	taskType int,
+	logger log.Logger,
+) *Backlog {
+	logger = logger.WithTags(tag.WorkflowDomainName(domainName), tag.WorkflowTaskListName(taskListName), tag.TaskType(taskType))
+	return &Backlog{
+		db:     newTaskListDB(store, domainID, domainName, taskListName, taskType, persistence.TaskListKindNormal, logger),
+		logger: logger,
+	}
+}
+
+// List returns up to pageSize tasks with an ID greater than readLevel, in task ID order. A readLevel of 0
+// starts at the ack level of the task list, skipping tasks that are completed but not yet deleted.
+// The returned read level continues the listing, it is 0 once there are no more tasks.
+func (b *Backlog) List(readLevel int64, pageSize int) ([]*persistence.TaskInfo, int64, error) {
+	if pageSize <= 0 {
+		return nil, 0, &types.BadRequestError{Message: "page size must be positive"}
+	}
+	if readLevel == 0 {
+		info, err := b.db.GetTaskListInfo(b.db.taskListName)
+		if err != nil {
+			return nil, 0, err
+		}
+		readLevel = info.AckLevel
+	}
+	resp, err := b.db.GetTasks(readLevel, math.MaxInt64, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(resp.Tasks) < pageSize {
+		return resp.Tasks, 0, nil
+	}
+	return resp.Tasks, resp.Tasks[len(resp.Tasks)-1].TaskID, nil
+}
+
+// Move moves the tasks with an ID in [minTaskID, maxTaskID] to the destination task list of the same type,
+// batchSize tasks at a time, and returns the number of moved tasks.
+//
+// The destination must be the manager of the task list owned by this host: it allocates the new task IDs
+// from its own lease, so the move doesn't disturb its ownership. Each batch is deleted from this task list
+// with deleteTasks, which must go through the owner of this task list, and only the tasks it deleted are
+// appended to the destination. Tasks already handed to a poller are skipped, so no task is dispatched from
+// both task lists. The tasks of a batch which fails to be appended are logged, as they are gone from both.
+func (b *Backlog) Move(
+	ctx context.Context,
+	minTaskID int64,
+	maxTaskID int64,
+	destination Manager,
+	deleteTasks func(ctx context.Context, taskIDs []int64) ([]int64, error),
+	batchSize int,
+) (int, error) {
+	dst := destination.TaskListID()
+	if dst.GetDomainID() != b.db.domainID || dst.GetType() != b.db.taskType || dst.GetName() == b.db.taskListName {
+		return 0, &types.BadRequestError{Message: "destination must be a different task list of the same domain and type"}
+	}
+	if minTaskID > maxTaskID {
+		return 0, &types.BadRequestError{Message: fmt.Sprintf("invalid task ID range [%d, %d]", minTaskID, maxTaskID)}
+	}
+	if batchSize <= 0 {
+		return 0, &types.BadRequestError{Message: "batch size must be positive"}
+	}
+
+	moved := 0
+	readLevel := minTaskID - 1
+	for {
+		resp, err := b.db.GetTasks(readLevel, maxTaskID, batchSize)
+		if err != nil {
+			return moved, err
+		}
+		if len(resp.Tasks) == 0 {
+			break
+		}
+		taskIDs := make([]int64, 0, len(resp.Tasks))
+		for _, task := range resp.Tasks {
+			taskIDs = append(taskIDs, task.TaskID)
+		}
+		deletedIDs, err := deleteTasks(ctx, taskIDs)
+		if err != nil {
+			return moved, fmt.Errorf("deleting tasks to move: %w", err)
+		}
+		deleted := make(map[int64]struct{}, len(deletedIDs))
+		for _, taskID := range deletedIDs {
+			deleted[taskID] = struct{}{}
+		}
+		tasks := make([]*persistence.TaskInfo, 0, len(deletedIDs))
+		for _, task := range resp.Tasks {
+			if _, ok := deleted[task.TaskID]; ok {
+				tasks = append(tasks, task)
+			}
+		}
+		if err := destination.AppendTasks(ctx, tasks); err != nil {
+			for _, task := range tasks {
+				b.logger.Error("Task list backlog task may be lost, refresh the tasks of its workflow",
+					tag.TaskID(task.TaskID),
+					tag.WorkflowDomainID(task.DomainID),
+					tag.WorkflowID(task.WorkflowID),
+					tag.WorkflowRunID(task.RunID),
+					tag.WorkflowScheduleID(task.ScheduleID),
+				)
+			}
+			return moved, fmt.Errorf("appending tasks to %s: %w", dst.GetName(), err)
+		}
+		moved += len(tasks)
+
+		readLevel = resp.Tasks[len(resp.Tasks)-1].TaskID
+		if len(resp.Tasks) < batchSize {
+			break
+		}
+	}
+	b.logger.Info("Moved task list backlog tasks", tag.Counter(moved), tag.Value(dst.GetName()))
+	return moved, nil
+}
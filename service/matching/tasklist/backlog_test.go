@@ -0,0 +1,302 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	backlogTestDomainID   = "deadbeef-0000-4567-890a-bcdef0123456"
+	backlogTestDomainName = "test-domain"
+	backlogTestTaskList   = "test-tasklist"
+)
+
+func backlogTestTasks(taskIDs ...int64) []*persistence.TaskInfo {
+	tasks := make([]*persistence.TaskInfo, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		tasks = append(tasks, &persistence.TaskInfo{
+			DomainID:   backlogTestDomainID,
+			WorkflowID: "wid",
+			RunID:      "rid",
+			TaskID:     taskID,
+			ScheduleID: taskID * 10,
+		})
+	}
+	return tasks
+}
+
+func TestBacklogList(t *testing.T) {
+	testCases := []struct {
+		name          string
+		readLevel     int64
+		pageSize      int
+		mockSetup     func(m *persistence.MockTaskManager)
+		wantTaskIDs   []int64
+		wantReadLevel int64
+		wantErr       bool
+	}{
+		{
+			name:     "starts at the ack level",
+			pageSize: 3,
+			mockSetup: func(m *persistence.MockTaskManager) {
+				m.EXPECT().GetTaskList(gomock.Any(), &persistence.GetTaskListRequest{
+					DomainID:   backlogTestDomainID,
+					DomainName: backlogTestDomainName,
+					TaskList:   backlogTestTaskList,
+					TaskType:   persistence.TaskListTypeActivity,
+				}).Return(&persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{AckLevel: 5}}, nil)
+				m.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+						assert.Equal(t, int64(5), req.ReadLevel)
+						assert.Equal(t, 3, req.BatchSize)
+						return &persistence.GetTasksResponse{Tasks: backlogTestTasks(6, 7, 9)}, nil
+					})
+			},
+			wantTaskIDs:   []int64{6, 7, 9},
+			wantReadLevel: 9,
+		},
+		{
+			name:      "last page",
+			readLevel: 9,
+			pageSize:  3,
+			mockSetup: func(m *persistence.MockTaskManager) {
+				m.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+						assert.Equal(t, int64(9), req.ReadLevel)
+						return &persistence.GetTasksResponse{Tasks: backlogTestTasks(12)}, nil
+					})
+			},
+			wantTaskIDs: []int64{12},
+		},
+		{
+			name:     "invalid page size",
+			pageSize: 0,
+			wantErr:  true,
+		},
+		{
+			name:     "get task list error",
+			pageSize: 3,
+			mockSetup: func(m *persistence.MockTaskManager) {
+				m.EXPECT().GetTaskList(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+			wantErr: true,
+		},
+		{
+			name:      "get tasks error",
+			readLevel: 1,
+			pageSize:  3,
+			mockSetup: func(m *persistence.MockTaskManager) {
+				m.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := persistence.NewMockTaskManager(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(store)
+			}
+			backlog := NewBacklog(store, backlogTestDomainID, backlogTestDomainName, backlogTestTaskList, persistence.TaskListTypeActivity, testlogger.New(t))
+
+			tasks, readLevel, err := backlog.List(tc.readLevel, tc.pageSize)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			var taskIDs []int64
+			for _, task := range tasks {
+				taskIDs = append(taskIDs, task.TaskID)
+			}
+			assert.Equal(t, tc.wantTaskIDs, taskIDs)
+			assert.Equal(t, tc.wantReadLevel, readLevel)
+		})
+	}
+}
+
+func TestBacklogMove(t *testing.T) {
+	const destination = "other-tasklist"
+
+	deleteAll := func(_ context.Context, taskIDs []int64) ([]int64, error) {
+		return taskIDs, nil
+	}
+
+	testCases := []struct {
+		name            string
+		minTaskID       int64
+		maxTaskID       int64
+		destination     string
+		destinationType int
+		batchSize       int
+		deleteTasks     func(ctx context.Context, taskIDs []int64) ([]int64, error)
+		mockSetup       func(m *persistence.MockTaskManager, dst *MockManager)
+		wantMoved       int
+		wantErr         bool
+	}{
+		{
+			name:            "moves in batches",
+			minTaskID:       1,
+			maxTaskID:       20,
+			destination:     destination,
+			destinationType: persistence.TaskListTypeActivity,
+			batchSize:       2,
+			deleteTasks:     deleteAll,
+			mockSetup: func(m *persistence.MockTaskManager, dst *MockManager) {
+				gomock.InOrder(
+					m.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, req *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+							assert.Equal(t, backlogTestTaskList, req.TaskList)
+							assert.Equal(t, int64(0), req.ReadLevel)
+							assert.Equal(t, int64(20), *req.MaxReadLevel)
+							return &persistence.GetTasksResponse{Tasks: backlogTestTasks(4, 6)}, nil
+						}),
+					dst.EXPECT().AppendTasks(gomock.Any(), backlogTestTasks(4, 6)).Return(nil),
+					m.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, req *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+							assert.Equal(t, int64(6), req.ReadLevel)
+							return &persistence.GetTasksResponse{Tasks: backlogTestTasks(11)}, nil
+						}),
+					dst.EXPECT().AppendTasks(gomock.Any(), backlogTestTasks(11)).Return(nil),
+				)
+			},
+			wantMoved: 3,
+		},
+		{
+			name:            "tasks which are not deleted are skipped",
+			minTaskID:       1,
+			maxTaskID:       20,
+			destination:     destination,
+			destinationType: persistence.TaskListTypeActivity,
+			batchSize:       3,
+			deleteTasks: func(_ context.Context, taskIDs []int64) ([]int64, error) {
+				assert.Equal(t, []int64{4, 6, 7}, taskIDs)
+				return []int64{4, 7}, nil
+			},
+			mockSetup: func(m *persistence.MockTaskManager, dst *MockManager) {
+				m.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: backlogTestTasks(4, 6, 7)}, nil)
+				dst.EXPECT().AppendTasks(gomock.Any(), backlogTestTasks(4, 7)).Return(nil)
+				m.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{}, nil)
+			},
+			wantMoved: 2,
+		},
+		{
+			name:            "delete error",
+			minTaskID:       1,
+			maxTaskID:       20,
+			destination:     destination,
+			destinationType: persistence.TaskListTypeActivity,
+			batchSize:       2,
+			deleteTasks: func(_ context.Context, taskIDs []int64) ([]int64, error) {
+				return nil, errors.New("some error")
+			},
+			mockSetup: func(m *persistence.MockTaskManager, dst *MockManager) {
+				m.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: backlogTestTasks(4, 6)}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:            "append error",
+			minTaskID:       1,
+			maxTaskID:       20,
+			destination:     destination,
+			destinationType: persistence.TaskListTypeActivity,
+			batchSize:       2,
+			deleteTasks:     deleteAll,
+			mockSetup: func(m *persistence.MockTaskManager, dst *MockManager) {
+				m.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: backlogTestTasks(4)}, nil)
+				dst.EXPECT().AppendTasks(gomock.Any(), gomock.Any()).Return(errShutdown)
+			},
+			wantErr: true,
+		},
+		{
+			name:            "same destination",
+			minTaskID:       1,
+			maxTaskID:       20,
+			destination:     backlogTestTaskList,
+			destinationType: persistence.TaskListTypeActivity,
+			batchSize:       2,
+			wantErr:         true,
+		},
+		{
+			name:            "destination of another type",
+			minTaskID:       1,
+			maxTaskID:       20,
+			destination:     destination,
+			destinationType: persistence.TaskListTypeDecision,
+			batchSize:       2,
+			wantErr:         true,
+		},
+		{
+			name:            "invalid range",
+			minTaskID:       20,
+			maxTaskID:       1,
+			destination:     destination,
+			destinationType: persistence.TaskListTypeActivity,
+			batchSize:       2,
+			wantErr:         true,
+		},
+		{
+			name:            "invalid batch size",
+			minTaskID:       1,
+			maxTaskID:       20,
+			destination:     destination,
+			destinationType: persistence.TaskListTypeActivity,
+			wantErr:         true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := persistence.NewMockTaskManager(ctrl)
+			dst := NewMockManager(ctrl)
+			dstID, err := NewIdentifier(backlogTestDomainID, tc.destination, tc.destinationType)
+			require.NoError(t, err)
+			dst.EXPECT().TaskListID().Return(dstID).AnyTimes()
+			if tc.mockSetup != nil {
+				tc.mockSetup(store, dst)
+			}
+			backlog := NewBacklog(store, backlogTestDomainID, backlogTestDomainName, backlogTestTaskList, persistence.TaskListTypeActivity, testlogger.New(t))
+
+			moved, err := backlog.Move(context.Background(), tc.minTaskID, tc.maxTaskID, dst, tc.deleteTasks, tc.batchSize)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantMoved, moved)
+		})
+	}
+}
@@ -211,6 +211,28 @@ func (db *taskListDB) CompleteTasksLessThan(taskID int64, limit int) (int, error
 	return resp.TasksCompleted, nil
 }
 
+// CompleteTask deletes a single task of this task list
+func (db *taskListDB) CompleteTask(taskID int64) error {
+	err := db.store.CompleteTask(context.Background(), &persistence.CompleteTaskRequest{
+		TaskList: &persistence.TaskListInfo{
+			DomainID: db.domainID,
+			Name:     db.taskListName,
+			TaskType: db.taskType,
+		},
+		TaskID:     taskID,
+		DomainName: db.domainName,
+	})
+	if err != nil {
+		db.logger.Error("Persistent store operation failure",
+			tag.StoreOperationCompleteTask,
+			tag.Error(err),
+			tag.TaskID(taskID),
+			tag.TaskType(db.taskType),
+			tag.WorkflowTaskListName(db.taskListName))
+	}
+	return err
+}
+
 // GetTaskListSize gets the backlog size of a tasklist
 func (db *taskListDB) GetTaskListSize(ackLevel int64) (int64, error) {
 	resp, err := db.store.GetTaskListSize(context.Background(), &persistence.GetTaskListSizeRequest{
@@ -36,6 +36,7 @@ import (
 	smtypes "github.com/cadence-workflow/shard-manager/common/types"
 	"github.com/cadence-workflow/shard-manager/service/sharddistributor/client/executorclient"
 
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -69,6 +70,12 @@ type (
 		// match with a poller. When that fails, task will be written to database and later
 		// asynchronously matched with a poller
 		AddTask(ctx context.Context, params AddTaskParams) (syncMatch bool, err error)
+		// AppendTasks writes the given tasks to the database in order without trying to sync match them,
+		// allocating their task IDs from the lease of this task list. It is used to move tasks from another task list
+		AppendTasks(ctx context.Context, tasks []*persistence.TaskInfo) error
+		// DeleteTasks deletes the given tasks of the backlog, including the ones read but not dispatched yet,
+		// and returns the IDs of the deleted tasks. Tasks which were handed to a poller are not deleted
+		DeleteTasks(taskIDs []int64) ([]int64, error)
 		// GetTask blocks waiting for a task Returns error when context deadline is exceeded
 		// maxDispatchPerSecond is the max rate at which tasks are allowed to be dispatched
 		// from this task list to pollers
@@ -93,6 +100,8 @@ type (
 		LoadBalancerHints() *types.LoadBalancerHints
 		QueriesPerSecond() float64
 		ReleaseBlockedPollers() error
+		// RecommendedPollerCount returns the number of concurrent pollers recommended for this task list partition
+		RecommendedPollerCount() int32
 	}
 
 	TaskMatcher interface {
@@ -18,6 +18,7 @@ import (
 	executorclient "github.com/cadence-workflow/shard-manager/service/sharddistributor/client/executorclient"
 	gomock "go.uber.org/mock/gomock"
 
+	persistence "github.com/uber/cadence/common/persistence"
 	types0 "github.com/uber/cadence/common/types"
 )
 
@@ -167,6 +168,20 @@ func (mr *MockManagerMockRecorder) AddTask(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTask", reflect.TypeOf((*MockManager)(nil).AddTask), ctx, params)
 }
 
+// AppendTasks mocks base method.
+func (m *MockManager) AppendTasks(ctx context.Context, tasks []*persistence.TaskInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendTasks", ctx, tasks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendTasks indicates an expected call of AppendTasks.
+func (mr *MockManagerMockRecorder) AppendTasks(ctx, tasks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendTasks", reflect.TypeOf((*MockManager)(nil).AppendTasks), ctx, tasks)
+}
+
 // CancelPoller mocks base method.
 func (m *MockManager) CancelPoller(pollerID string) {
 	m.ctrl.T.Helper()
@@ -179,6 +194,21 @@ func (mr *MockManagerMockRecorder) CancelPoller(pollerID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPoller", reflect.TypeOf((*MockManager)(nil).CancelPoller), pollerID)
 }
 
+// DeleteTasks mocks base method.
+func (m *MockManager) DeleteTasks(taskIDs []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTasks", taskIDs)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTasks indicates an expected call of DeleteTasks.
+func (mr *MockManagerMockRecorder) DeleteTasks(taskIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTasks", reflect.TypeOf((*MockManager)(nil).DeleteTasks), taskIDs)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockManager) DescribeTaskList(includeTaskListStatus bool) *types0.DescribeTaskListResponse {
 	m.ctrl.T.Helper()
@@ -307,6 +337,20 @@ func (mr *MockManagerMockRecorder) QueriesPerSecond() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueriesPerSecond", reflect.TypeOf((*MockManager)(nil).QueriesPerSecond))
 }
 
+// RecommendedPollerCount mocks base method.
+func (m *MockManager) RecommendedPollerCount() int32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecommendedPollerCount")
+	ret0, _ := ret[0].(int32)
+	return ret0
+}
+
+// RecommendedPollerCount indicates an expected call of RecommendedPollerCount.
+func (mr *MockManagerMockRecorder) RecommendedPollerCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecommendedPollerCount", reflect.TypeOf((*MockManager)(nil).RecommendedPollerCount))
+}
+
 // RefreshTaskListPartitionConfig mocks base method.
 func (m *MockManager) RefreshTaskListPartitionConfig(arg0 context.Context, arg1 *types0.TaskListPartitionConfig) error {
 	m.ctrl.T.Helper()
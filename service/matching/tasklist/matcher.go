@@ -75,6 +75,9 @@ type taskMatcherImpl struct {
 // ErrTasklistThrottled implies a tasklist was throttled
 var ErrTasklistThrottled = errors.New("tasklist limit exceeded")
 
+// errTaskDeleted is returned by MustOffer when a backlog task was deleted before it could be forwarded
+var errTaskDeleted = errors.New("task deleted from the backlog")
+
 // newTaskMatcher returns a task matcher instance. The returned instance can be
 // used by task producers and consumers to find a match. Both sync matches and non-sync
 // matches should use this implementation
@@ -370,12 +373,19 @@ forLoop:
 			tm.scope.ExponentialHistogram(metrics.AsyncMatchLocalPollLatencyPerTaskListHistogram, time.Since(startT))
 			return nil
 		case token := <-tm.fwdrAddReqTokenC():
+			if !task.claim() {
+				token.release()
+				e.EventName = "Task Deleted Before Forwarding"
+				event.Log(e)
+				return errTaskDeleted
+			}
 			e.EventName = "Attempting to Forward Task"
 			event.Log(e)
 			childCtx, cancel := context.WithTimeout(ctx, time.Second*2)
 			err := tm.fwdr.ForwardTask(childCtx, task)
 			token.release()
 			if err != nil {
+				task.release()
 				if errors.Is(err, ErrForwarderSlowDown) {
 					tm.scope.IncCounter(metrics.AsyncMatchForwardTaskThrottleErrorPerTasklist)
 				}
@@ -521,6 +521,21 @@ func (t *MatcherTestSuite) TestMustOfferRemoteRateLimit() {
 	}
 }
 
+func (t *MatcherTestSuite) TestMustOfferDeletedTaskIsNotForwarded() {
+	claimed := false
+	task := newInternalTask(t.newTaskInfo(), func(*persistence.TaskInfo, error) {}, types.TaskSourceDbBacklog, "", false, "")
+	task.Event.claimFunc = func(*persistence.TaskInfo) bool {
+		claimed = true
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
+	defer cancel()
+
+	// the task was deleted while it was buffered, so it is dropped instead of forwarded to the parent
+	t.ErrorIs(t.matcher.MustOffer(ctx, task), errTaskDeleted)
+	t.True(claimed)
+}
+
 func (t *MatcherTestSuite) TestIsolationMustOfferRemoteMatch() {
 	pollSigC := make(chan struct{})
 	forwardPollSigC := make(chan struct{})
@@ -0,0 +1,147 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+const (
+	// pollerAdvisorSmoothing is the weight of a new sample in the moving averages of the poller advisor
+	pollerAdvisorSmoothing = 0.1
+	// pollerScaleUpFactor is how much the recommended poller count grows when tasks wait for pollers
+	pollerScaleUpFactor = 1.5
+)
+
+type (
+	// pollerAdvisor recommends the number of concurrent pollers a task list partition needs, summed over all
+	// workers. It is returned to workers in the AutoConfigHint of poll responses so they can scale their pollers
+	// up during bursts and down when idle.
+	//
+	// More pollers are recommended when the partition has a backlog that is older than a threshold or when too
+	// few tasks are sync matched, as long as polls return quickly. Fewer pollers are recommended when there is
+	// no backlog and polls wait long for a task.
+	pollerAdvisor struct {
+		config     *config.TaskListConfig
+		timeSource clock.TimeSource
+
+		outstandingPolls int64
+
+		sync.Mutex
+		pollers       float64 // moving average of the concurrent polls
+		syncMatchRate float64 // moving average of the share of added tasks that were sync matched
+		pollWaitTime  float64 // moving average of the time a poll waits for a task, in nanoseconds
+		backlogAge    time.Duration
+	}
+
+	pollerStats struct {
+		pollers       float64
+		syncMatchRate float64
+		pollWaitTime  time.Duration
+		backlogAge    time.Duration
+		backlogCount  int64
+	}
+)
+
+func newPollerAdvisor(config *config.TaskListConfig, timeSource clock.TimeSource) *pollerAdvisor {
+	return &pollerAdvisor{
+		config:        config,
+		timeSource:    timeSource,
+		syncMatchRate: 1,
+	}
+}
+
+// StartPoll records a poll waiting for a task
+func (a *pollerAdvisor) StartPoll() {
+	outstanding := atomic.AddInt64(&a.outstandingPolls, 1)
+	a.Lock()
+	defer a.Unlock()
+	a.pollers = movingAverage(a.pollers, float64(outstanding))
+}
+
+// EndPoll records how long a poll waited, whether it got a task or not
+func (a *pollerAdvisor) EndPoll(waitTime time.Duration) {
+	atomic.AddInt64(&a.outstandingPolls, -1)
+	a.Lock()
+	defer a.Unlock()
+	a.pollWaitTime = movingAverage(a.pollWaitTime, float64(waitTime))
+}
+
+// RecordAddTask records whether a task added to the partition was sync matched or written to the backlog
+func (a *pollerAdvisor) RecordAddTask(syncMatched bool) {
+	sample := 0.0
+	if syncMatched {
+		sample = 1
+	}
+	a.Lock()
+	defer a.Unlock()
+	a.syncMatchRate = movingAverage(a.syncMatchRate, sample)
+}
+
+// RecordBacklogTask records the creation time of a task dispatched from the backlog, it approximates the age of
+// the backlog
+func (a *pollerAdvisor) RecordBacklogTask(createdTime time.Time) {
+	a.Lock()
+	defer a.Unlock()
+	a.backlogAge = a.timeSource.Since(createdTime)
+}
+
+// Recommend returns the recommended poller count given the current backlog size
+func (a *pollerAdvisor) Recommend(backlogCount int64) int32 {
+	a.Lock()
+	stats := pollerStats{
+		pollers:       a.pollers,
+		syncMatchRate: a.syncMatchRate,
+		pollWaitTime:  time.Duration(a.pollWaitTime),
+		backlogAge:    a.backlogAge,
+		backlogCount:  backlogCount,
+	}
+	a.Unlock()
+	if backlogCount == 0 {
+		stats.backlogAge = 0
+	}
+	return recommendPollerCount(stats, a.config)
+}
+
+func recommendPollerCount(stats pollerStats, config *config.TaskListConfig) int32 {
+	count := int(math.Ceil(stats.pollers))
+	idle := stats.pollWaitTime >= config.PollerHintScaleDownPollWaitTime()
+	switch {
+	case stats.backlogCount > 0 && !idle &&
+		(stats.backlogAge >= config.PollerHintScaleUpBacklogAge() || stats.syncMatchRate < config.PollerHintMinSyncMatchRate()):
+		count = max(count+1, int(math.Ceil(float64(count)*pollerScaleUpFactor)))
+	case stats.backlogCount == 0 && idle:
+		count /= 2
+	}
+	count = max(count, config.PollerHintMinPollerCount())
+	count = min(count, config.PollerHintMaxPollerCount())
+	return int32(count)
+}
+
+func movingAverage(average, sample float64) float64 {
+	return average + pollerAdvisorSmoothing*(sample-average)
+}
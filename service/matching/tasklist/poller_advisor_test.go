@@ -0,0 +1,148 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasklist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/service/matching/config"
+)
+
+func testPollerAdvisorConfig() *config.TaskListConfig {
+	return &config.TaskListConfig{
+		PollerHintMinPollerCount: func() int {
+			return 2
+		},
+		PollerHintMaxPollerCount: func() int {
+			return 10
+		},
+		PollerHintMinSyncMatchRate: func() float64 {
+			return 0.9
+		},
+		PollerHintScaleUpBacklogAge: func() time.Duration {
+			return time.Second
+		},
+		PollerHintScaleDownPollWaitTime: func() time.Duration {
+			return 10 * time.Second
+		},
+	}
+}
+
+func TestRecommendPollerCount(t *testing.T) {
+	cases := []struct {
+		name     string
+		stats    pollerStats
+		expected int32
+	}{
+		{
+			name:     "no pollers",
+			stats:    pollerStats{syncMatchRate: 1},
+			expected: 2,
+		},
+		{
+			name:     "steady",
+			stats:    pollerStats{pollers: 4, syncMatchRate: 1, pollWaitTime: time.Second},
+			expected: 4,
+		},
+		{
+			name:     "partial pollers are rounded up",
+			stats:    pollerStats{pollers: 3.2, syncMatchRate: 1, pollWaitTime: time.Second},
+			expected: 4,
+		},
+		{
+			name:     "old backlog",
+			stats:    pollerStats{pollers: 4, syncMatchRate: 1, backlogAge: time.Second, backlogCount: 10},
+			expected: 6,
+		},
+		{
+			name:     "low sync match rate",
+			stats:    pollerStats{pollers: 4, syncMatchRate: 0.5, backlogCount: 10},
+			expected: 6,
+		},
+		{
+			name:     "young backlog with high sync match rate",
+			stats:    pollerStats{pollers: 4, syncMatchRate: 0.95, backlogAge: time.Millisecond, backlogCount: 10},
+			expected: 4,
+		},
+		{
+			name:     "backlog with idle polls",
+			stats:    pollerStats{pollers: 4, syncMatchRate: 0.5, pollWaitTime: 10 * time.Second, backlogAge: time.Minute, backlogCount: 10},
+			expected: 4,
+		},
+		{
+			name:     "idle",
+			stats:    pollerStats{pollers: 8, syncMatchRate: 1, pollWaitTime: 10 * time.Second},
+			expected: 4,
+		},
+		{
+			name:     "idle at min",
+			stats:    pollerStats{pollers: 3, syncMatchRate: 1, pollWaitTime: time.Minute},
+			expected: 2,
+		},
+		{
+			name:     "scale up capped at max",
+			stats:    pollerStats{pollers: 8, syncMatchRate: 1, backlogAge: time.Minute, backlogCount: 10},
+			expected: 10,
+		},
+		{
+			name:     "capped at max",
+			stats:    pollerStats{pollers: 20, syncMatchRate: 1},
+			expected: 10,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, recommendPollerCount(tc.stats, testPollerAdvisorConfig()))
+		})
+	}
+}
+
+func TestPollerAdvisor(t *testing.T) {
+	timeSource := clock.NewMockedTimeSource()
+	advisor := newPollerAdvisor(testPollerAdvisorConfig(), timeSource)
+	assert.Equal(t, int32(2), advisor.Recommend(0))
+
+	// keep 4 polls outstanding until the moving average settles
+	for i := 0; i < 4; i++ {
+		advisor.StartPoll()
+	}
+	for i := 0; i < 50; i++ {
+		advisor.EndPoll(time.Millisecond)
+		advisor.StartPoll()
+	}
+	assert.Equal(t, int64(4), advisor.outstandingPolls)
+	assert.Equal(t, int32(4), advisor.Recommend(0))
+
+	advisor.RecordBacklogTask(timeSource.Now().Add(-2 * time.Second))
+	assert.Equal(t, 2*time.Second, advisor.backlogAge)
+	assert.Equal(t, int32(6), advisor.Recommend(10))
+	// the backlog age is stale once the backlog is drained
+	assert.Equal(t, int32(4), advisor.Recommend(0))
+
+	advisor.RecordAddTask(false)
+	assert.InDelta(t, 0.9, advisor.syncMatchRate, 0.0001)
+	advisor.RecordAddTask(true)
+	assert.InDelta(t, 0.91, advisor.syncMatchRate, 0.0001)
+}
@@ -32,6 +32,9 @@ type (
 	genericTaskInfo struct {
 		*persistence.TaskInfo
 		completionFunc func(*persistence.TaskInfo, error)
+		// claimFunc and releaseFunc are set for backlog tasks, see taskReader.claimTask
+		claimFunc   func(*persistence.TaskInfo) bool
+		releaseFunc func(*persistence.TaskInfo)
 	}
 	// queryTaskInfo contains the info for a query task
 	queryTaskInfo struct {
@@ -177,6 +180,22 @@ func (task *InternalTask) PollForActivityResponse() *types.MatchingPollForActivi
 	return nil
 }
 
+// claim is called before the task is handed to a poller or forwarded. It returns false when the task
+// was deleted from the backlog while it was buffered, the task is then completed and must be dropped.
+func (task *InternalTask) claim() bool {
+	if task.Event == nil || task.Event.claimFunc == nil {
+		return true
+	}
+	return task.Event.claimFunc(task.Event.TaskInfo)
+}
+
+// release undoes claim when the task couldn't be handed over, so it can be deleted again
+func (task *InternalTask) release() {
+	if task.Event != nil && task.Event.releaseFunc != nil {
+		task.Event.releaseFunc(task.Event.TaskInfo)
+	}
+}
+
 // finish marks a task as finished. Should be called after a poller picks up a task
 // and marks it as started. If the task is unable to marked as started, then this
 // method should be called with a non-nil error argument.
@@ -146,6 +146,7 @@ type (
 
 		qpsTracker     stats.QPSTrackerGroup
 		adaptiveScaler AdaptiveScaler
+		pollerAdvisor  *pollerAdvisor
 
 		partitionConfigLock sync.RWMutex
 		partitionConfig     *types.TaskListPartitionConfig
@@ -227,6 +228,7 @@ func NewManager(p ManagerParams) (Manager, error) {
 	}
 
 	tlMgr.qpsTracker = stats.NewEmaFixedWindowQPSTracker(p.TimeSource, 0.5, taskListConfig.QPSTrackerInterval(), baseEvent)
+	tlMgr.pollerAdvisor = newPollerAdvisor(taskListConfig, p.TimeSource)
 	if p.TaskList.IsRoot() && p.TaskListKind == types.TaskListKindNormal {
 		adaptiveScalerScope := common.NewPerTaskListScope(domainName, p.TaskList.GetName(), p.TaskListKind, p.MetricsClient, metrics.MatchingAdaptiveScalerScope).
 			Tagged(getTaskListTypeTag(p.TaskList.GetType()))
@@ -604,6 +606,9 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 	isolationGroup, _ := c.getIsolationGroupForTask(ctx, params.TaskInfo)
 	// active task, try sync match first
 	syncMatch, err = c.trySyncMatch(ctx, params, isolationGroup)
+	if !isForwarded {
+		c.pollerAdvisor.RecordAddTask(syncMatch)
+	}
 	if syncMatch {
 		e.EventName = "SyncMatched so not persisted"
 		event.Log(e)
@@ -627,6 +632,51 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 	return syncMatch, nil
 }
 
+// AppendTasks writes the given tasks to the database one at a time, in order, without trying to sync match
+// them. The task IDs of the given tasks are ignored, new ones are allocated from the lease of this task list.
+func (c *taskListManagerImpl) AppendTasks(ctx context.Context, tasks []*persistence.TaskInfo) error {
+	c.startWG.Wait()
+
+	if c.shouldReload() {
+		c.Stop()
+		return errShutdown
+	}
+	for _, task := range tasks {
+		data := *task
+		data.TaskID = 0
+		if _, err := c.taskWriter.appendTask(ctx, &data); err != nil {
+			return err
+		}
+	}
+	c.taskReader.Signal()
+	return nil
+}
+
+// DeleteTasks deletes the given tasks from the database and drops the ones already read in the buffers, so
+// they are not dispatched. Tasks which were completed, handed to a poller or forwarded are not deleted.
+// It returns the IDs of the deleted tasks. When the database fails, the tasks deleted so far are returned,
+// and an error only when there are none.
+func (c *taskListManagerImpl) DeleteTasks(taskIDs []int64) ([]int64, error) {
+	c.startWG.Wait()
+
+	removed := c.taskReader.removeTasks(taskIDs)
+	for i, taskID := range removed {
+		if err := c.db.CompleteTask(taskID); err != nil {
+			c.taskReader.restoreTasks(removed[i:])
+			if i == 0 {
+				return nil, fmt.Errorf("deleting task %d: %w", taskID, err)
+			}
+			c.logger.Warn("Failed to delete task list backlog tasks", tag.TaskID(taskID), tag.Error(err))
+			removed = removed[:i]
+			break
+		}
+	}
+	if len(removed) > 0 {
+		c.logger.Info("Deleted task list backlog tasks", tag.Counter(len(removed)))
+	}
+	return removed, nil
+}
+
 // DispatchTask dispatches a task to a poller on the active side. When there are no pollers to pick
 // up the task or if the rate limit is exceeded, this method will return error. Task
 // *will not* be persisted to db. On the passive side, dispatches the task to the taskCompleter; it will attempt
@@ -690,15 +740,34 @@ func (c *taskListManagerImpl) GetTask(
 	c.liveness.MarkAlive()
 	// TODO: consider return early if QPS and backlog count are both 0,
 	// since there is no task to be returned
+	startT := c.timeSource.Now()
+	c.pollerAdvisor.StartPoll()
 	task, err := c.getTask(ctx, maxDispatchPerSecond)
+	for err == nil && !task.claim() {
+		// the task was deleted from the backlog while it was buffered, keep polling
+		task, err = c.getTask(ctx, maxDispatchPerSecond)
+	}
+	c.pollerAdvisor.EndPoll(c.timeSource.Since(startT))
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get task: %w", err)
 	}
+	if task.source == types.TaskSourceDbBacklog && task.Event != nil {
+		c.pollerAdvisor.RecordBacklogTask(task.Event.CreatedTime)
+	}
 	task.domainName = c.domainName
 	task.BacklogCountHint = c.taskAckManager.GetBacklogCount()
+	if task.AutoConfigHint != nil {
+		task.AutoConfigHint.RecommendedPollerCount = c.RecommendedPollerCount()
+	}
 	return task, nil
 }
 
+// RecommendedPollerCount returns the number of concurrent pollers recommended for this task list partition,
+// summed over all workers
+func (c *taskListManagerImpl) RecommendedPollerCount() int32 {
+	return c.pollerAdvisor.Recommend(c.taskAckManager.GetBacklogCount())
+}
+
 func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond *float64) (*InternalTask, error) {
 	c.emitMisconfiguredPartitionMetrics()
 	// We need to set a shorter timeout than the original ctx; otherwise, by the time ctx deadline is
@@ -796,9 +865,10 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 			StartID: idBlock.start,
 			EndID:   idBlock.end,
 		},
-		IsolationGroupMetrics: isolationGroupMetrics,
-		NewTasksPerSecond:     c.qpsTracker.QPS(),
-		Empty:                 c.taskAckManager.GetAckLevel() == c.taskWriter.GetMaxReadLevel(),
+		IsolationGroupMetrics:  isolationGroupMetrics,
+		NewTasksPerSecond:      c.qpsTracker.QPS(),
+		Empty:                  c.taskAckManager.GetAckLevel() == c.taskWriter.GetMaxReadLevel(),
+		RecommendedPollerCount: c.RecommendedPollerCount(),
 	}
 
 	return response
@@ -1167,6 +1237,21 @@ func newTaskListConfig(id *Identifier, cfg *config.Config, domainName string) *c
 		EnableClientAutoConfig: func() bool {
 			return cfg.EnableClientAutoConfig(domainName, taskListName, taskType)
 		},
+		PollerHintMinPollerCount: func() int {
+			return cfg.PollerHintMinPollerCount(domainName, taskListName, taskType)
+		},
+		PollerHintMaxPollerCount: func() int {
+			return cfg.PollerHintMaxPollerCount(domainName, taskListName, taskType)
+		},
+		PollerHintMinSyncMatchRate: func() float64 {
+			return cfg.PollerHintMinSyncMatchRate(domainName, taskListName, taskType)
+		},
+		PollerHintScaleUpBacklogAge: func() time.Duration {
+			return cfg.PollerHintScaleUpBacklogAge(domainName, taskListName, taskType)
+		},
+		PollerHintScaleDownPollWaitTime: func() time.Duration {
+			return cfg.PollerHintScaleDownPollWaitTime(domainName, taskListName, taskType)
+		},
 	}
 }
 
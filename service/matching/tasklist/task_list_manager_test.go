@@ -358,7 +358,8 @@ func TestDescribeTaskList(t *testing.T) {
 					"datacenterA": {},
 					"datacenterB": {},
 				},
-				Empty: true,
+				Empty:                  true,
+				RecommendedPollerCount: 2,
 			},
 		},
 		{
@@ -383,7 +384,8 @@ func TestDescribeTaskList(t *testing.T) {
 					"datacenterA": {},
 					"datacenterB": {},
 				},
-				Empty: false,
+				Empty:                  false,
+				RecommendedPollerCount: 2,
 			},
 		},
 		{
@@ -418,7 +420,8 @@ func TestDescribeTaskList(t *testing.T) {
 						NewTasksPerSecond: 25.0,
 					},
 				},
-				Empty: true,
+				Empty:                  true,
+				RecommendedPollerCount: 2,
 			},
 		},
 	}
@@ -641,6 +644,72 @@ func TestAddTaskStandby(t *testing.T) {
 	require.False(t, syncMatch)
 }
 
+func TestAppendTasks(t *testing.T) {
+	controller := gomock.NewController(t)
+	tlm := createTestTaskListManager(t, testlogger.New(t), controller)
+	require.NoError(t, tlm.Start(context.Background()))
+	defer tlm.Stop()
+
+	tasks := []*persistence.TaskInfo{
+		{DomainID: "domain", WorkflowID: "wid", RunID: "rid", TaskID: 1000, ScheduleID: 2, CreatedTime: time.Now()},
+		{DomainID: "domain", WorkflowID: "wid", RunID: "rid", TaskID: 2000, ScheduleID: 5, CreatedTime: time.Now()},
+	}
+	require.NoError(t, tlm.AppendTasks(context.Background(), tasks))
+
+	tm := tlm.db.store.(*TestTaskManager)
+	assert.Equal(t, 2, tm.GetCreateTaskCount(tlm.taskListID))
+	// the moved tasks get IDs from the lease of this task list, the given tasks are not modified
+	assert.Equal(t, int64(1000), tasks[0].TaskID)
+	assert.Equal(t, int64(2000), tasks[1].TaskID)
+	block := rangeIDToTaskIDBlock(tlm.db.RangeID(), tlm.config.RangeSize)
+	assert.Equal(t, block.start+1, tlm.taskWriter.GetMaxReadLevel())
+}
+
+func TestDeleteTasks(t *testing.T) {
+	controller := gomock.NewController(t)
+	tlm := createTestTaskListManager(t, testlogger.New(t), controller)
+	tlm.startWG.Done() // the reader is driven by the test instead of being started
+	tlm.taskAckManager.SetAckLevel(10)
+
+	tasks := []*persistence.TaskInfo{
+		{DomainID: "domain", WorkflowID: "wid", RunID: "rid", TaskID: 11, ScheduleID: 2, CreatedTime: time.Now()},
+		{DomainID: "domain", WorkflowID: "wid", RunID: "rid", TaskID: 12, ScheduleID: 5, CreatedTime: time.Now()},
+		{DomainID: "domain", WorkflowID: "wid", RunID: "rid", TaskID: 13, ScheduleID: 8, CreatedTime: time.Now()},
+	}
+	require.True(t, tlm.taskReader.addTasksToBuffer(tasks[:2]))
+	// task 12 is handed to a poller, it can't be deleted anymore
+	require.True(t, tlm.taskReader.claimTask(tasks[1]))
+
+	deleted, err := tlm.DeleteTasks([]int64{5, 11, 12, 13})
+	require.NoError(t, err)
+	// task 5 is below the ack level, so it was completed already
+	assert.Equal(t, []int64{11, 13}, deleted)
+
+	// task 13 is skipped when it is read
+	require.True(t, tlm.taskReader.addTasksToBuffer(tasks[2:]))
+	assert.Equal(t, int64(13), tlm.taskAckManager.GetReadLevel())
+
+	// task 11 is dropped from the buffer instead of being dispatched
+	tlm.taskReader.dispatchTask = func(context.Context, *InternalTask) error {
+		t.Fatal("deleted task was dispatched")
+		return nil
+	}
+	taskInfo := <-tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]
+	require.Equal(t, int64(11), taskInfo.TaskID)
+	breakDispatchLoop, breakRetries := tlm.taskReader.dispatchSingleTaskFromBuffer(taskInfo)
+	assert.False(t, breakDispatchLoop)
+	assert.True(t, breakRetries)
+
+	// a deleted task which reached the matcher is completed instead of being claimed
+	tlm.taskReader.removeTasks([]int64{14})
+	assert.False(t, tlm.taskReader.claimTask(&persistence.TaskInfo{TaskID: 14}))
+
+	tlm.taskReader.completeTask(tasks[1], nil)
+	assert.Equal(t, int64(12), tlm.taskAckManager.GetAckLevel())
+	assert.Empty(t, tlm.taskReader.claimedTasks)
+	assert.Equal(t, map[int64]struct{}{13: {}, 14: {}}, tlm.taskReader.removedTasks)
+}
+
 // return a client side tasklist throttle error from the rate limiter.
 // The expected behaviour is to retry
 func TestRateLimitErrorsFromTasklistDispatch(t *testing.T) {
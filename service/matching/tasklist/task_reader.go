@@ -98,6 +98,13 @@ type (
 		// unusable. The reader only reports it, the manager owns the teardown.
 		fatalCh   chan struct{}
 		fatalOnce sync.Once
+
+		// removedTasks were deleted through the owner of the task list while they were buffered or not
+		// read yet, they are dropped instead of dispatched. claimedTasks were handed to a poller or forwarded
+		// and can't be deleted anymore. Both only keep task IDs above the ack level.
+		removeLock   sync.Mutex
+		removedTasks map[int64]struct{}
+		claimedTasks map[int64]struct{}
 	}
 )
 
@@ -131,6 +138,8 @@ func newTaskReader(tlMgr *taskListManagerImpl, isolationGroups []string) *taskRe
 		cancelFunc:     cancel,
 		notifyC:        make(chan struct{}, 1),
 		fatalCh:        make(chan struct{}),
+		removedTasks:   make(map[int64]struct{}),
+		claimedTasks:   make(map[int64]struct{}),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
 		taskBuffers:              taskBuffers,
@@ -368,6 +377,11 @@ func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
 		tr.taskAckManager.SetReadLevel(task.TaskID)
 		return true
 	}
+	if tr.isTaskRemoved(task.TaskID) {
+		// the task was deleted after it was read from the database, skip it like an expired task
+		tr.taskAckManager.SetReadLevel(task.TaskID)
+		return true
+	}
 	err := tr.taskAckManager.ReadItem(task.TaskID)
 	if err != nil {
 		tr.logger.Fatal("critical bug when adding item to ackManager", tag.Error(err))
@@ -428,9 +442,88 @@ func (tr *taskReader) completeTask(task *persistence.TaskInfo, err error) {
 		tr.Signal()
 	}
 	ackLevel := tr.taskAckManager.AckItem(task.TaskID)
+	tr.pruneTasks(ackLevel)
 	tr.taskGC.Run(ackLevel)
 }
 
+// removeTasks marks the given tasks as deleted so they are dropped instead of dispatched, and returns the
+// ones which can be deleted from the database. Tasks which were completed, handed to a poller or forwarded
+// are left out.
+func (tr *taskReader) removeTasks(taskIDs []int64) []int64 {
+	tr.removeLock.Lock()
+	defer tr.removeLock.Unlock()
+
+	// completed tasks below the ack level may not be deleted from the database yet
+	ackLevel := tr.taskAckManager.GetAckLevel()
+	var removed []int64
+	for _, taskID := range taskIDs {
+		if taskID <= ackLevel {
+			continue
+		}
+		if _, ok := tr.claimedTasks[taskID]; ok {
+			continue
+		}
+		tr.removedTasks[taskID] = struct{}{}
+		removed = append(removed, taskID)
+	}
+	return removed
+}
+
+// restoreTasks undoes removeTasks for the tasks which couldn't be deleted from the database
+func (tr *taskReader) restoreTasks(taskIDs []int64) {
+	tr.removeLock.Lock()
+	defer tr.removeLock.Unlock()
+	for _, taskID := range taskIDs {
+		delete(tr.removedTasks, taskID)
+	}
+}
+
+func (tr *taskReader) isTaskRemoved(taskID int64) bool {
+	tr.removeLock.Lock()
+	defer tr.removeLock.Unlock()
+	_, ok := tr.removedTasks[taskID]
+	return ok
+}
+
+// claimTask records that a backlog task is about to be handed to a poller or forwarded, so it can't be
+// deleted anymore. A task which was deleted while it was buffered is completed instead and false is returned.
+func (tr *taskReader) claimTask(task *persistence.TaskInfo) bool {
+	tr.removeLock.Lock()
+	_, removed := tr.removedTasks[task.TaskID]
+	if !removed {
+		tr.claimedTasks[task.TaskID] = struct{}{}
+	}
+	tr.removeLock.Unlock()
+
+	if removed {
+		tr.completeTask(task, nil)
+	}
+	return !removed
+}
+
+func (tr *taskReader) releaseTask(task *persistence.TaskInfo) {
+	tr.removeLock.Lock()
+	defer tr.removeLock.Unlock()
+	delete(tr.claimedTasks, task.TaskID)
+}
+
+// pruneTasks forgets the removed and claimed tasks at or below the ack level, the ack level keeps them from
+// being deleted or dispatched again
+func (tr *taskReader) pruneTasks(ackLevel int64) {
+	tr.removeLock.Lock()
+	defer tr.removeLock.Unlock()
+	for taskID := range tr.removedTasks {
+		if taskID <= ackLevel {
+			delete(tr.removedTasks, taskID)
+		}
+	}
+	for taskID := range tr.claimedTasks {
+		if taskID <= ackLevel {
+			delete(tr.claimedTasks, taskID)
+		}
+	}
+}
+
 func (tr *taskReader) newDispatchContext(isolationGroup string, isolationDuration time.Duration) (context.Context, context.CancelFunc) {
 	rps := float64(tr.rateLimit())
 	if isolationGroup != "" || rps > 1e-7 { // 1e-7 is a random number chosen to avoid overflow, normally user don't set such a low rps
@@ -491,6 +584,12 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		tr.taskAckManager.AckItem(taskInfo.TaskID)
 		return false, true
 	}
+	if tr.isTaskRemoved(taskInfo.TaskID) {
+		e.EventName = "Task Deleted"
+		event.Log(e)
+		tr.completeTask(taskInfo, nil)
+		return false, true
+	}
 	isolationGroup, isolationDuration := tr.getIsolationGroupForTask(tr.cancelCtx, taskInfo)
 	_, isolationGroupIsKnown := tr.taskBuffers[isolationGroup]
 	if !isolationGroupIsKnown {
@@ -498,6 +597,8 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		isolationDuration = noIsolationTimeout
 	}
 	task := newInternalTask(taskInfo, tr.completeTask, types.TaskSourceDbBacklog, "", false, isolationGroup)
+	task.Event.claimFunc = tr.claimTask
+	task.Event.releaseFunc = tr.releaseTask
 	dispatchCtx, cancel := tr.newDispatchContext(isolationGroup, isolationDuration)
 	asyncMatchStart := time.Now()
 	timerScope := tr.scope.StartTimer(metrics.AsyncMatchLatencyPerTaskList)
@@ -538,6 +639,12 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		return false, false
 	}
 
+	if errors.Is(err, errTaskDeleted) {
+		e.EventName = "Task Deleted"
+		event.Log(e)
+		return false, true
+	}
+
 	if errors.Is(err, ErrTasklistThrottled) {
 		e.EventName = "Dispatch failed because throttled. Will retry dispatch"
 		event.Log(e)
@@ -35,6 +35,11 @@ func (g GRPCHandler) CancelOutstandingPoll(ctx context.Context, request *matchin
 	return &matchingv1.CancelOutstandingPollResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) DeleteTaskListBacklogTasks(ctx context.Context, request *matchingv1.DeleteTaskListBacklogTasksRequest) (*matchingv1.DeleteTaskListBacklogTasksResponse, error) {
+	response, err := g.h.DeleteTaskListBacklogTasks(ctx, proto.ToMatchingDeleteTaskListBacklogTasksRequest(request))
+	return proto.FromMatchingDeleteTaskListBacklogTasksResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) DescribeTaskList(ctx context.Context, request *matchingv1.DescribeTaskListRequest) (*matchingv1.DescribeTaskListResponse, error) {
 	response, err := g.h.DescribeTaskList(ctx, proto.ToMatchingDescribeTaskListRequest(request))
 	return proto.FromMatchingDescribeTaskListResponse(response), proto.FromError(err)
@@ -50,6 +55,11 @@ func (g GRPCHandler) ListTaskListPartitions(ctx context.Context, request *matchi
 	return proto.FromMatchingListTaskListPartitionsResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) MoveTaskListBacklog(ctx context.Context, request *matchingv1.MoveTaskListBacklogRequest) (*matchingv1.MoveTaskListBacklogResponse, error) {
+	response, err := g.h.MoveTaskListBacklog(ctx, proto.ToMatchingMoveTaskListBacklogRequest(request))
+	return proto.FromMatchingMoveTaskListBacklogResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) PollForActivityTask(ctx context.Context, request *matchingv1.PollForActivityTaskRequest) (*matchingv1.PollForActivityTaskResponse, error) {
 	response, err := g.h.PollForActivityTask(ctx, proto.ToMatchingPollForActivityTaskRequest(request))
 	return proto.FromMatchingPollForActivityTaskResponse(response), proto.FromError(err)
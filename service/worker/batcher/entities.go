@@ -23,6 +23,7 @@
 package batcher
 
 import (
+	"errors"
 	"time"
 
 	"github.com/uber/cadence/common/types"
@@ -110,6 +111,13 @@ type HeartBeatDetails struct {
 	SuccessCount int
 	// Number of workflows that give up due to errors.
 	ErrorCount int
+	// Number of workflows that were skipped because they no longer exist
+	// or were already closed when the operation was applied.
+	SkippedCount int
+	// FailureReasons counts the errors that made workflows give up, keyed by
+	// error message. At most MaxFailureReasons distinct messages are kept;
+	// the remaining ones are accumulated under FailureReasonOther.
+	FailureReasons map[string]int
 	// RPS is the rate limit currently in effect for the running activity.
 	// Surfaced in the heartbeat so the UI can display the live, signal-tuned value.
 	RPS int
@@ -119,6 +127,43 @@ type HeartBeatDetails struct {
 	Concurrency int
 }
 
+// recordResult accounts the outcome of processing a single workflow.
+func (hbd *HeartBeatDetails) recordResult(err error) {
+	switch {
+	case err == nil:
+		hbd.SuccessCount++
+	case errors.Is(err, errWorkflowSkipped):
+		hbd.SkippedCount++
+	default:
+		hbd.ErrorCount++
+		reason := err.Error()
+		if len(reason) > maxFailureReasonLength {
+			reason = reason[:maxFailureReasonLength]
+		}
+		hbd.addFailureReason(reason, 1)
+	}
+}
+
+// merge adds the counters of a processed page into the overall progress.
+func (hbd *HeartBeatDetails) merge(page HeartBeatDetails) {
+	hbd.SuccessCount += page.SuccessCount
+	hbd.ErrorCount += page.ErrorCount
+	hbd.SkippedCount += page.SkippedCount
+	for reason, count := range page.FailureReasons {
+		hbd.addFailureReason(reason, count)
+	}
+}
+
+func (hbd *HeartBeatDetails) addFailureReason(reason string, count int) {
+	if hbd.FailureReasons == nil {
+		hbd.FailureReasons = make(map[string]int)
+	}
+	if _, ok := hbd.FailureReasons[reason]; !ok && len(hbd.FailureReasons) >= MaxFailureReasons {
+		reason = FailureReasonOther
+	}
+	hbd.FailureReasons[reason] += count
+}
+
 type taskDetail struct {
 	execution types.WorkflowExecution
 	attempts  int
@@ -0,0 +1,106 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeartBeatDetails_RecordResult(t *testing.T) {
+	longReason := strings.Repeat("x", maxFailureReasonLength+10)
+	tests := map[string]struct {
+		results []error
+		want    HeartBeatDetails
+	}{
+		"success": {
+			results: []error{nil, nil},
+			want:    HeartBeatDetails{SuccessCount: 2},
+		},
+		"skipped": {
+			results: []error{errWorkflowSkipped, nil},
+			want:    HeartBeatDetails{SuccessCount: 1, SkippedCount: 1},
+		},
+		"failures are grouped by reason": {
+			results: []error{errors.New("boom"), errors.New("boom"), errors.New("bang")},
+			want: HeartBeatDetails{
+				ErrorCount:     3,
+				FailureReasons: map[string]int{"boom": 2, "bang": 1},
+			},
+		},
+		"long failure reasons are truncated": {
+			results: []error{errors.New(longReason)},
+			want: HeartBeatDetails{
+				ErrorCount:     1,
+				FailureReasons: map[string]int{longReason[:maxFailureReasonLength]: 1},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var hbd HeartBeatDetails
+			for _, err := range tc.results {
+				hbd.recordResult(err)
+			}
+			assert.Equal(t, tc.want, hbd)
+		})
+	}
+}
+
+func TestHeartBeatDetails_FailureReasonsAreCapped(t *testing.T) {
+	var hbd HeartBeatDetails
+	for i := 0; i < MaxFailureReasons+5; i++ {
+		hbd.recordResult(fmt.Errorf("error %d", i))
+	}
+	hbd.recordResult(errors.New("error 0"))
+
+	assert.Equal(t, MaxFailureReasons+6, hbd.ErrorCount)
+	assert.Len(t, hbd.FailureReasons, MaxFailureReasons+1)
+	assert.Equal(t, 2, hbd.FailureReasons["error 0"])
+	assert.Equal(t, 5, hbd.FailureReasons[FailureReasonOther])
+}
+
+func TestHeartBeatDetails_Merge(t *testing.T) {
+	hbd := HeartBeatDetails{
+		CurrentPage:    1,
+		SuccessCount:   3,
+		ErrorCount:     1,
+		FailureReasons: map[string]int{"boom": 1},
+	}
+	hbd.merge(HeartBeatDetails{
+		SuccessCount:   2,
+		ErrorCount:     2,
+		SkippedCount:   4,
+		FailureReasons: map[string]int{"boom": 1, "bang": 1},
+	})
+
+	assert.Equal(t, HeartBeatDetails{
+		CurrentPage:    1,
+		SuccessCount:   5,
+		ErrorCount:     3,
+		SkippedCount:   4,
+		FailureReasons: map[string]int{"boom": 2, "bang": 1},
+	}, hbd)
+}
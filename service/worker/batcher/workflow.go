@@ -22,6 +22,7 @@ package batcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -72,6 +73,12 @@ const (
 	DefaultActivityHeartBeatTimeout = time.Second * 10
 	// DefaultMaxActivityRetries is the default value for MaxActivityRetries
 	DefaultMaxActivityRetries = 4
+	// MaxFailureReasons is the max number of distinct failure reasons tracked in HeartBeatDetails
+	MaxFailureReasons = 20
+	// FailureReasonOther is the failure reason that failures beyond MaxFailureReasons are accounted under
+	FailureReasonOther = "other"
+
+	maxFailureReasonLength = 256
 )
 
 const (
@@ -90,6 +97,10 @@ const (
 // AllBatchTypes is the batch types we supported
 var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypeRefresh}
 
+// errWorkflowSkipped is reported by a task processor when the target workflow
+// no longer exists or is already closed, so the operation had nothing to do.
+var errWorkflowSkipped = errors.New("workflow does not exist or is already closed")
+
 var (
 	BatchActivityRetryPolicy = cadence.RetryPolicy{
 		InitialInterval:          10 * time.Second,
@@ -200,19 +211,16 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 			}
 		}
 
-		succCount := 0
-		errCount := 0
+		var page HeartBeatDetails
+		processed := 0
 		// wait for counters indicate this batch is done
 	Loop:
 		for {
 			select {
 			case err := <-respCh:
-				if err == nil {
-					succCount++
-				} else {
-					errCount++
-				}
-				if succCount+errCount == batchCount {
+				page.recordResult(err)
+				processed++
+				if processed == batchCount {
 					break Loop
 				}
 			case <-ctx.Done():
@@ -222,8 +230,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 
 		hbd.CurrentPage++
 		hbd.PageToken = resp.NextPageToken
-		hbd.SuccessCount += succCount
-		hbd.ErrorCount += errCount
+		hbd.merge(page)
 		activity.RecordHeartbeat(ctx, hbd)
 
 		if len(hbd.PageToken) == 0 {
@@ -337,7 +344,9 @@ func startTaskProcessor(
 						})
 					})
 			}
-			if err != nil {
+			if errors.Is(err, errWorkflowSkipped) {
+				respCh <- err
+			} else if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
@@ -367,7 +376,7 @@ func processTask(
 	procFn func(string, string) error,
 ) error {
 	wfs := []types.WorkflowExecution{task.execution}
-	for len(wfs) > 0 {
+	for root := true; len(wfs) > 0; root = false {
 		wf := wfs[0]
 		wfs = wfs[1:]
 
@@ -381,6 +390,9 @@ func processTask(
 		if err != nil {
 			// EntityNotExistsError means wf is not running or deleted
 			if _, ok := err.(*types.EntityNotExistsError); ok {
+				if root {
+					return errWorkflowSkipped
+				}
 				continue
 			}
 			return err
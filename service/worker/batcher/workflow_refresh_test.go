@@ -131,7 +131,7 @@ func TestRefreshBatchActivity(t *testing.T) {
 	}
 }
 
-func TestRefreshBatchActivityEntityNotExistsIsSuccess(t *testing.T) {
+func TestRefreshBatchActivityEntityNotExistsIsSkipped(t *testing.T) {
 	tests := []struct {
 		name     string
 		activity interface{}
@@ -190,8 +190,8 @@ func TestRefreshBatchActivityEntityNotExistsIsSuccess(t *testing.T) {
 			if err := value.Get(&result); err != nil {
 				t.Fatalf("Get() error = %v", err)
 			}
-			if result.SuccessCount != 1 || result.ErrorCount != 0 {
-				t.Errorf("progress = %+v, want one successful no-op", result)
+			if result.SkippedCount != 1 || result.SuccessCount != 0 || result.ErrorCount != 0 {
+				t.Errorf("progress = %+v, want one skipped no-op", result)
 			}
 		})
 	}
@@ -217,26 +217,22 @@ func batchActivityV2(ctx context.Context, params BatchParams) (HeartBeatDetails,
 			}
 		}
 
-		succCount := 0
-		errCount := 0
+		var page HeartBeatDetails
+		processed := 0
 	Loop:
 		for {
 			select {
 			case err := <-respCh:
-				if err == nil {
-					succCount++
-				} else {
-					errCount++
-				}
-				if succCount+errCount == batchCount {
+				page.recordResult(err)
+				processed++
+				if processed == batchCount {
 					break Loop
 				}
 			case <-ctx.Done():
 				return hbd, cadence.NewCanceledError(hbd)
 			}
 		}
-		hbd.SuccessCount += succCount
-		hbd.ErrorCount += errCount
+		hbd.merge(page)
 		hbd.CurrentPage++
 		hbd.PageToken = resp.NextPageToken
 		activity.RecordHeartbeat(ctx, hbd)
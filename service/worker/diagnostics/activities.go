@@ -24,20 +24,26 @@ package diagnostics
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/diagnostics/analytics"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
 )
 
 const (
 	linkToTimeoutsRunbook = "https://cadenceworkflow.io/docs/workflow-troubleshooting/timeouts/"
 	linkToFailuresRunbook = "https://cadenceworkflow.io/docs/workflow-troubleshooting/activity-failures/"
 	linkToRetriesRunbook  = "https://cadenceworkflow.io/docs/workflow-troubleshooting/retries"
+	linkToGeneralRunbook  = "https://cadenceworkflow.io/docs/workflow-troubleshooting/"
 	WfDiagnosticsAppName  = "workflow-diagnostics"
 
 	_maxPageSize           = 1000            // current maximum page size for fetching workflow history
@@ -144,18 +150,176 @@ func (w *dw) emitUsageLogs(ctx context.Context, info analytics.WfDiagnosticsUsag
 }
 
 func (w *dw) emit(ctx context.Context, info analytics.WfDiagnosticsUsageData, client messaging.Client) error {
-	producer, err := client.NewProducer(WfDiagnosticsAppName)
+	emitter, err := newEmitter(client)
 	if err != nil {
 		// skip emitting logs if producer cannot be created since it is optional
 		w.logger.Error("producer creation failed, skipping emitting wf-diagnostics usage logs", tag.WorkflowDomainName(info.Domain))
 		return nil
 	}
-	emitter := analytics.NewEmitter(analytics.EmitterParams{
-		Producer: producer,
-	})
 	return emitter.EmitUsageData(ctx, info)
 }
 
+func newEmitter(client messaging.Client) (analytics.DataEmitter, error) {
+	producer, err := client.NewProducer(WfDiagnosticsAppName)
+	if err != nil {
+		return nil, err
+	}
+	return analytics.NewEmitter(analytics.EmitterParams{
+		Producer: producer,
+	}), nil
+}
+
+type sampleExecutionsParams struct {
+	Domain      string
+	WindowStart time.Time
+	WindowEnd   time.Time
+	SampleSize  int
+}
+
+type sampledExecution struct {
+	Execution    *types.WorkflowExecution
+	WorkflowType string
+}
+
+// sampleExecutions lists the executions of the domain which failed or timed out in the window. Both close statuses
+// alternate in the sample so that one of them does not crowd out the other.
+func (w *dw) sampleExecutions(ctx context.Context, info sampleExecutionsParams) ([]sampledExecution, error) {
+	frontendClient := w.clientBean.GetFrontendClient()
+	var byStatus [][]sampledExecution
+	for _, status := range []types.WorkflowExecutionCloseStatus{types.WorkflowExecutionCloseStatusFailed, types.WorkflowExecutionCloseStatusTimedOut} {
+		executions, err := listClosedExecutions(ctx, frontendClient, info, status)
+		if err != nil {
+			return nil, err
+		}
+		byStatus = append(byStatus, executions)
+	}
+
+	result := make([]sampledExecution, 0, info.SampleSize)
+	for i := 0; len(result) < info.SampleSize; i++ {
+		added := false
+		for _, executions := range byStatus {
+			if i < len(executions) && len(result) < info.SampleSize {
+				result = append(result, executions[i])
+				added = true
+			}
+		}
+		if !added {
+			break
+		}
+	}
+	return result, nil
+}
+
+func listClosedExecutions(ctx context.Context, frontendClient frontend.Client, info sampleExecutionsParams, status types.WorkflowExecutionCloseStatus) ([]sampledExecution, error) {
+	var nextPageToken []byte
+	var result []sampledExecution
+	for {
+		// the start time filter of closed executions applies to their close time
+		response, err := frontendClient.ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+			Domain:          info.Domain,
+			MaximumPageSize: int32(min(info.SampleSize, _maxPageSize)),
+			NextPageToken:   nextPageToken,
+			StartTimeFilter: &types.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(info.WindowStart.UnixNano()),
+				LatestTime:   common.Int64Ptr(info.WindowEnd.UnixNano()),
+			},
+			StatusFilter: status.Ptr(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list closed executions: %w", err)
+		}
+
+		for _, execution := range response.GetExecutions() {
+			result = append(result, sampledExecution{
+				Execution:    execution.GetExecution(),
+				WorkflowType: execution.GetType().GetName(),
+			})
+			if len(result) >= info.SampleSize {
+				return result, nil
+			}
+		}
+
+		if len(response.NextPageToken) == 0 {
+			return result, nil
+		}
+		nextPageToken = response.NextPageToken
+	}
+}
+
+type diagnosedIssue struct {
+	InvariantType string
+	Reason        string
+	ActivityType  string
+	RootCauses    []string
+}
+
+// diagnoseExecution identifies the issues of an execution and their root causes
+func (w *dw) diagnoseExecution(ctx context.Context, info identifyIssuesParams) ([]diagnosedIssue, error) {
+	issues, err := w.identifyIssues(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]diagnosedIssue, 0, len(issues))
+	for _, issue := range issues {
+		// issue IDs are only unique per invariant, so root causes are looked up one issue at a time
+		rootCauses, err := w.rootCauseIssues(ctx, rootCauseIssuesParams{
+			Domain: info.Domain,
+			Issues: []invariant.InvariantCheckResult{issue},
+		})
+		if err != nil {
+			return nil, err
+		}
+		activityType, err := issueActivityType(issue)
+		if err != nil {
+			return nil, err
+		}
+		diagnosed := diagnosedIssue{
+			InvariantType: issue.InvariantType,
+			Reason:        issue.Reason,
+			ActivityType:  activityType,
+		}
+		for _, rc := range rootCauses {
+			diagnosed.RootCauses = append(diagnosed.RootCauses, rc.RootCause.String())
+		}
+		result = append(result, diagnosed)
+	}
+	return result, nil
+}
+
+// issueActivityType returns the activity type of the issues of an activity, it is empty for the other issues
+func issueActivityType(issue invariant.InvariantCheckResult) (string, error) {
+	switch issue.InvariantType {
+	case failure.ActivityFailed.String():
+		var metadata failure.FailureIssuesMetadata
+		if err := json.Unmarshal(issue.Metadata, &metadata); err != nil {
+			return "", err
+		}
+		return metadata.ActivityType, nil
+	case timeout.TimeoutTypeActivity.String():
+		var metadata timeout.ActivityTimeoutMetadata
+		if err := json.Unmarshal(issue.Metadata, &metadata); err != nil {
+			return "", err
+		}
+		return metadata.ActivityType, nil
+	}
+	return "", nil
+}
+
+func (w *dw) emitDomainDiagnostics(ctx context.Context, data analytics.DomainDiagnosticsData) error {
+	if w.messagingClient == nil {
+		// skip emitting data if messaging client is not provided since it is optional
+		w.logger.Error("messaging client is not provided, skipping emitting domain diagnostics data", tag.WorkflowDomainName(data.Domain))
+		return nil
+	}
+	emitter, err := newEmitter(w.messagingClient)
+	if err != nil {
+		w.logger.Error("producer creation failed, skipping emitting domain diagnostics data", tag.WorkflowDomainName(data.Domain))
+		return nil
+	}
+	return emitter.EmitDomainDiagnosticsData(ctx, data)
+}
+
 // capNumberOfIssues limits the number of issues to avoid overwhelming the result with too many issues.
 func capNumberOfIssues(issues []invariant.InvariantCheckResult) []invariant.InvariantCheckResult {
 	if len(issues) > _maxIssuesPerInvariant {
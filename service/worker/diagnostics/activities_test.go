@@ -331,3 +331,94 @@ func Test__identifyIssuesWithPaginatedHistory(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedResult, result)
 }
+
+func Test__sampleExecutions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClientBean := client.NewMockBean(ctrl)
+	mockFrontendClient := frontend.NewMockClient(ctrl)
+	mockClientBean.EXPECT().GetFrontendClient().Return(mockFrontendClient).AnyTimes()
+	dwtest := &dw{clientBean: mockClientBean}
+
+	windowEnd := time.Unix(0, testTimeStamp)
+	params := sampleExecutionsParams{
+		Domain:      "test-domain",
+		WindowStart: windowEnd.Add(-time.Hour),
+		WindowEnd:   windowEnd,
+		SampleSize:  3,
+	}
+	executionInfo := func(workflowID string) *types.WorkflowExecutionInfo {
+		return &types.WorkflowExecutionInfo{
+			Execution: &types.WorkflowExecution{WorkflowID: workflowID, RunID: "rid"},
+			Type:      &types.WorkflowType{Name: "wf-type"},
+		}
+	}
+	sampled := func(workflowID string) sampledExecution {
+		return sampledExecution{
+			Execution:    &types.WorkflowExecution{WorkflowID: workflowID, RunID: "rid"},
+			WorkflowType: "wf-type",
+		}
+	}
+	listRequest := func(status types.WorkflowExecutionCloseStatus, pageToken []byte) *types.ListClosedWorkflowExecutionsRequest {
+		return &types.ListClosedWorkflowExecutionsRequest{
+			Domain:          "test-domain",
+			MaximumPageSize: 3,
+			NextPageToken:   pageToken,
+			StartTimeFilter: &types.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(params.WindowStart.UnixNano()),
+				LatestTime:   common.Int64Ptr(params.WindowEnd.UnixNano()),
+			},
+			StatusFilter: status.Ptr(),
+		}
+	}
+	mockFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), listRequest(types.WorkflowExecutionCloseStatusFailed, nil)).
+		Return(&types.ListClosedWorkflowExecutionsResponse{
+			Executions:    []*types.WorkflowExecutionInfo{executionInfo("failed1")},
+			NextPageToken: []byte("token"),
+		}, nil)
+	mockFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), listRequest(types.WorkflowExecutionCloseStatusFailed, []byte("token"))).
+		Return(&types.ListClosedWorkflowExecutionsResponse{
+			Executions: []*types.WorkflowExecutionInfo{executionInfo("failed2"), executionInfo("failed3")},
+		}, nil)
+	mockFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), listRequest(types.WorkflowExecutionCloseStatusTimedOut, nil)).
+		Return(&types.ListClosedWorkflowExecutionsResponse{
+			Executions: []*types.WorkflowExecutionInfo{executionInfo("timedout1")},
+		}, nil)
+
+	result, err := dwtest.sampleExecutions(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, []sampledExecution{sampled("failed1"), sampled("timedout1"), sampled("failed2")}, result)
+}
+
+func Test__diagnoseExecution(t *testing.T) {
+	dwtest := testDiagnosticWorkflow(t, testWorkflowExecutionHistoryResponse())
+	result, err := dwtest.diagnoseExecution(context.Background(), identifyIssuesParams{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: "123",
+			RunID:      "abc",
+		},
+		Domain: "test-domain",
+	})
+	require.NoError(t, err)
+	require.Contains(t, result, diagnosedIssue{
+		InvariantType: failure.ActivityFailed.String(),
+		Reason:        failure.GenericError.String(),
+		ActivityType:  "test-activity",
+		RootCauses:    []string{invariant.RootCauseTypeServiceSideIssue.String()},
+	})
+	for _, issue := range result {
+		if issue.InvariantType != failure.ActivityFailed.String() {
+			require.Empty(t, issue.ActivityType)
+		}
+	}
+}
+
+func Test__emitDomainDiagnostics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClient := messaging.NewMockClient(ctrl)
+	mockProducer := messaging.NewMockProducer(ctrl)
+	mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+	mockClient.EXPECT().NewProducer(WfDiagnosticsAppName).Return(mockProducer, nil)
+	dwtest := &dw{messagingClient: mockClient}
+	err := dwtest.emitDomainDiagnostics(context.Background(), analytics.DomainDiagnosticsData{Domain: "test-domain"})
+	require.NoError(t, err)
+}
@@ -72,3 +72,42 @@ func (et *emitter) EmitUsageData(ctx context.Context, data WfDiagnosticsUsageDat
 	}
 	return et.producer.Publish(ctx, pinotMsg)
 }
+
+// EmitDomainDiagnosticsData publishes a message per root cause count so that the counts of several runs can be
+// summed up by workflow or activity type, a single message without root cause is published if none was found
+func (et *emitter) EmitDomainDiagnosticsData(ctx context.Context, data DomainDiagnosticsData) error {
+	rootCauses := data.RootCauses
+	if len(rootCauses) == 0 {
+		rootCauses = []RootCauseCount{{}}
+	}
+	for _, rc := range rootCauses {
+		msg := make(map[string]interface{})
+		msg[Domain] = data.Domain
+		msg[Environment] = data.Environment
+		msg[DiagnosticsWfID] = data.DiagnosticsWorkflowID
+		msg[DiagnosticsWfRunID] = data.DiagnosticsRunID
+		msg[WindowStart] = data.WindowStart.UTC().UnixMilli()
+		msg[WindowEnd] = data.WindowEnd.UTC().UnixMilli()
+		msg[WorkflowsSampled] = data.WorkflowsSampled
+		msg[WorkflowsWithIssues] = data.WorkflowsWithIssues
+		msg[WorkflowType] = rc.WorkflowType
+		msg[ActivityType] = rc.ActivityType
+		msg[IssueType] = rc.IssueType
+		msg[RootCause] = rc.RootCause
+		msg[Count] = rc.Count
+
+		serializedMsg, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+
+		pinotMsg := &indexer.PinotMessage{
+			WorkflowID: common.StringPtr(data.DiagnosticsWorkflowID),
+			Payload:    serializedMsg,
+		}
+		if err := et.producer.Publish(ctx, pinotMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -24,6 +24,7 @@ package analytics
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -95,3 +96,89 @@ func Test__EmitUsageData(t *testing.T) {
 		})
 	}
 }
+
+func Test__EmitDomainDiagnosticsData(t *testing.T) {
+	testdata := DomainDiagnosticsData{
+		Domain:                "test-domain",
+		Environment:           "test-env",
+		DiagnosticsWorkflowID: "diagnostics-wid",
+		DiagnosticsRunID:      "diagnostics-rid",
+		WindowStart:           time.Now().Add(-7 * 24 * time.Hour),
+		WindowEnd:             time.Now(),
+		WorkflowsSampled:      10,
+		WorkflowsWithIssues:   3,
+		RootCauses: []RootCauseCount{
+			{
+				WorkflowType: "test-workflow",
+				ActivityType: "test-activity",
+				IssueType:    "Activity Failed",
+				RootCause:    "There was an issue in the activity code",
+				Count:        2,
+			},
+			{
+				WorkflowType: "test-workflow",
+				IssueType:    "The Workflow Execution has timed out",
+				Count:        1,
+			},
+		},
+	}
+	mockErr := errors.New("mockErr")
+	tests := map[string]struct {
+		data                   DomainDiagnosticsData
+		producerMockAffordance func(mockProducer *mocks.KafkaProducer)
+		expectedError          error
+	}{
+		"Case1: a message per root cause": {
+			data: testdata,
+			producerMockAffordance: func(mockProducer *mocks.KafkaProducer) {
+				mockProducer.On("Publish", mock.Anything, mock.MatchedBy(func(input *indexer.PinotMessage) bool {
+					require.Equal(t, testdata.DiagnosticsWorkflowID, input.GetWorkflowID())
+					return true
+				})).Return(nil).Twice()
+			},
+			expectedError: nil,
+		},
+		"Case2: a single message without root causes": {
+			data: DomainDiagnosticsData{
+				Domain:                "test-domain",
+				DiagnosticsWorkflowID: "diagnostics-wid",
+				WorkflowsSampled:      10,
+			},
+			producerMockAffordance: func(mockProducer *mocks.KafkaProducer) {
+				mockProducer.On("Publish", mock.Anything, mock.MatchedBy(func(input *indexer.PinotMessage) bool {
+					var msg map[string]interface{}
+					require.NoError(t, json.Unmarshal(input.GetPayload(), &msg))
+					require.Equal(t, float64(10), msg[WorkflowsSampled])
+					require.Equal(t, float64(0), msg[Count])
+					return true
+				})).Return(nil).Once()
+			},
+			expectedError: nil,
+		},
+		"Case3: error case": {
+			data: testdata,
+			producerMockAffordance: func(mockProducer *mocks.KafkaProducer) {
+				mockProducer.On("Publish", mock.Anything, mock.Anything).Return(mockErr).Once()
+			},
+			expectedError: mockErr,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockProducer := &mocks.KafkaProducer{}
+			emitter := NewEmitter(EmitterParams{
+				Producer: mockProducer,
+			})
+			test.producerMockAffordance(mockProducer)
+
+			err := emitter.EmitDomainDiagnosticsData(context.Background(), test.data)
+			if test.expectedError != nil {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			mockProducer.AssertExpectations(t)
+		})
+	}
+}
@@ -41,7 +41,31 @@ type WfDiagnosticsUsageData struct {
 	SatisfactionFeedback  bool
 }
 
+// RootCauseCount is the number of sampled workflows of a workflow type which had an issue with a root cause,
+// ActivityType is only set for issues of an activity
+type RootCauseCount struct {
+	WorkflowType string
+	ActivityType string
+	IssueType    string
+	RootCause    string
+	Count        int
+}
+
+// DomainDiagnosticsData is the result of the diagnostics run on a sample of the workflows of a domain
+type DomainDiagnosticsData struct {
+	Domain                string
+	Environment           string
+	DiagnosticsWorkflowID string
+	DiagnosticsRunID      string
+	WindowStart           time.Time
+	WindowEnd             time.Time
+	WorkflowsSampled      int
+	WorkflowsWithIssues   int
+	RootCauses            []RootCauseCount
+}
+
 // DataEmitter is the interface to emit workflow diagnostics data
 type DataEmitter interface {
 	EmitUsageData(context.Context, WfDiagnosticsUsageData) error
+	EmitDomainDiagnosticsData(context.Context, DomainDiagnosticsData) error
 }
@@ -34,4 +34,13 @@ const (
 	Environment          = "environment"
 	DiagnosticsStartTime = "diagnostics_start_time"
 	DiagnosticsEndTime   = "diagnostics_end_time"
+
+	WorkflowType        = "workflow_type"
+	ActivityType        = "activity_type"
+	RootCause           = "root_cause"
+	Count               = "count"
+	WindowStart         = "window_start"
+	WindowEnd           = "window_end"
+	WorkflowsSampled    = "workflows_sampled"
+	WorkflowsWithIssues = "workflows_with_issues"
 )
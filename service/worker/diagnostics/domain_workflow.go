@@ -0,0 +1,260 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package diagnostics
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/analytics"
+)
+
+const (
+	// DomainDiagnosticsWorkflowTypeName is the name of the workflow diagnosing a sample of the workflows of a domain
+	DomainDiagnosticsWorkflowTypeName = "domain-diagnostics-workflow"
+	// DomainDiagnosticsTaskListName is the task list of the diagnostics worker
+	DomainDiagnosticsTaskListName = tasklist
+	// QueryDomainDiagnosticsReport returns the report of the domain diagnostics, it is partial while the workflow runs
+	QueryDomainDiagnosticsReport = "query-domain-diagnostics-report"
+
+	// DefaultDomainDiagnosticsWindow is how far back the sampled workflows closed
+	DefaultDomainDiagnosticsWindow = 7 * 24 * time.Hour
+	// DefaultDomainDiagnosticsSampleSize is the number of sampled workflows
+	DefaultDomainDiagnosticsSampleSize = 100
+	// MaxDomainDiagnosticsSampleSize bounds the history of the workflow
+	MaxDomainDiagnosticsSampleSize = 1000
+
+	sampleExecutionsActivity      = "sampleExecutions"
+	diagnoseExecutionActivity     = "diagnoseExecution"
+	emitDomainDiagnosticsActivity = "emitDomainDiagnostics"
+
+	_domainDiagnosticsConcurrency = 10 // number of workflows diagnosed in parallel
+	_maxRootCauseExamples         = 3  // number of workflows listed for each root cause
+)
+
+// DomainDiagnosticsWorkflowID returns the ID of the domain diagnostics workflow of a domain
+func DomainDiagnosticsWorkflowID(domain string) string {
+	return fmt.Sprintf("%s-%s", DomainDiagnosticsWorkflowTypeName, domain)
+}
+
+type DomainDiagnosticsWorkflowInput struct {
+	Domain     string
+	Window     time.Duration
+	SampleSize int
+}
+
+// DomainDiagnosticsReport aggregates the root causes of the issues of the sampled workflows
+type DomainDiagnosticsReport struct {
+	Domain              string
+	WindowStart         time.Time
+	WindowEnd           time.Time
+	WorkflowsSampled    int
+	WorkflowsDiagnosed  int
+	WorkflowsWithIssues int
+	RootCauses          []*RootCauseSummary
+	Completed           bool
+}
+
+// RootCauseSummary counts the sampled workflows of a type which had an issue with a root cause. ActivityType is only
+// set for the issues of an activity and RootCause is empty when no root cause was found for the issue.
+type RootCauseSummary struct {
+	WorkflowType string
+	ActivityType string
+	IssueType    string
+	RootCause    string
+	Count        int
+	Examples     []*types.WorkflowExecution
+}
+
+// DomainDiagnosticsWorkflow samples the workflows of a domain which failed or timed out in a window, diagnoses
+// them and aggregates their root causes by workflow and activity type. When it runs on a cron schedule, each run
+// reports on the window which precedes it.
+func (w *dw) DomainDiagnosticsWorkflow(ctx workflow.Context, params DomainDiagnosticsWorkflowInput) (*DomainDiagnosticsReport, error) {
+	if params.Domain == "" {
+		return nil, errors.New("domain is required")
+	}
+	window := params.Window
+	if window <= 0 {
+		window = DefaultDomainDiagnosticsWindow
+	}
+	sampleSize := params.SampleSize
+	if sampleSize <= 0 {
+		sampleSize = DefaultDomainDiagnosticsSampleSize
+	}
+	sampleSize = min(sampleSize, MaxDomainDiagnosticsSampleSize)
+
+	windowEnd := workflow.Now(ctx)
+	report := &DomainDiagnosticsReport{
+		Domain:      params.Domain,
+		WindowStart: windowEnd.Add(-window),
+		WindowEnd:   windowEnd,
+	}
+	err := workflow.SetQueryHandler(ctx, QueryDomainDiagnosticsReport, func() (*DomainDiagnosticsReport, error) {
+		return report, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    2 * _contextTimeout,
+	})
+
+	var executions []sampledExecution
+	err = workflow.ExecuteActivity(activityCtx, sampleExecutionsActivity, sampleExecutionsParams{
+		Domain:      params.Domain,
+		WindowStart: report.WindowStart,
+		WindowEnd:   report.WindowEnd,
+		SampleSize:  sampleSize,
+	}).Get(ctx, &executions)
+	if err != nil {
+		return nil, fmt.Errorf("SampleExecutions: %w", err)
+	}
+	report.WorkflowsSampled = len(executions)
+
+	for start := 0; start < len(executions); start += _domainDiagnosticsConcurrency {
+		batch := executions[start:min(start+_domainDiagnosticsConcurrency, len(executions))]
+		futures := make([]workflow.Future, 0, len(batch))
+		for _, execution := range batch {
+			futures = append(futures, workflow.ExecuteActivity(activityCtx, diagnoseExecutionActivity, identifyIssuesParams{
+				Execution: execution.Execution,
+				Domain:    params.Domain,
+			}))
+		}
+		for i, future := range futures {
+			var issues []diagnosedIssue
+			if err := future.Get(ctx, &issues); err != nil {
+				// the history of a sampled workflow can be deleted by retention before it is diagnosed
+				w.logger.Warn("domain diagnostics skipped a workflow which could not be diagnosed",
+					tag.Error(err),
+					tag.WorkflowDomainName(params.Domain),
+					tag.WorkflowID(batch[i].Execution.GetWorkflowID()),
+					tag.WorkflowRunID(batch[i].Execution.GetRunID()))
+				continue
+			}
+			report.WorkflowsDiagnosed++
+			report.add(batch[i], issues)
+		}
+	}
+	report.sortRootCauses()
+	report.Completed = true
+
+	info := workflow.GetInfo(ctx)
+	emitCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToCloseTimeout: time.Second * 10,
+		ScheduleToStartTimeout: time.Second * 5,
+		StartToCloseTimeout:    time.Second * 5,
+	})
+	err = workflow.ExecuteActivity(emitCtx, emitDomainDiagnosticsActivity, analytics.DomainDiagnosticsData{
+		Domain:                params.Domain,
+		Environment:           w.clusterMetadata.GetCurrentClusterName(),
+		DiagnosticsWorkflowID: info.WorkflowExecution.ID,
+		DiagnosticsRunID:      info.WorkflowExecution.RunID,
+		WindowStart:           report.WindowStart,
+		WindowEnd:             report.WindowEnd,
+		WorkflowsSampled:      report.WorkflowsSampled,
+		WorkflowsWithIssues:   report.WorkflowsWithIssues,
+		RootCauses:            report.rootCauseCounts(),
+	}).Get(ctx, nil)
+	if err != nil {
+		w.logger.Error("domain diagnostics data emission failed",
+			tag.Error(err),
+			tag.WorkflowDomainName(params.Domain),
+			tag.WorkflowID(info.WorkflowExecution.ID),
+			tag.WorkflowRunID(info.WorkflowExecution.RunID))
+	}
+
+	return report, nil
+}
+
+// add counts the root causes of the issues of a workflow, a workflow is counted once per root cause
+func (r *DomainDiagnosticsReport) add(execution sampledExecution, issues []diagnosedIssue) {
+	if len(issues) == 0 {
+		return
+	}
+	r.WorkflowsWithIssues++
+
+	counted := make(map[*RootCauseSummary]bool)
+	for _, issue := range issues {
+		rootCauses := issue.RootCauses
+		if len(rootCauses) == 0 {
+			rootCauses = []string{""}
+		}
+		for _, rootCause := range rootCauses {
+			summary := r.findOrAddRootCause(execution.WorkflowType, issue.ActivityType, issue.InvariantType, rootCause)
+			if counted[summary] {
+				continue
+			}
+			counted[summary] = true
+			summary.Count++
+			if len(summary.Examples) < _maxRootCauseExamples {
+				summary.Examples = append(summary.Examples, execution.Execution)
+			}
+		}
+	}
+}
+
+func (r *DomainDiagnosticsReport) findOrAddRootCause(workflowType, activityType, issueType, rootCause string) *RootCauseSummary {
+	for _, summary := range r.RootCauses {
+		if summary.WorkflowType == workflowType && summary.ActivityType == activityType &&
+			summary.IssueType == issueType && summary.RootCause == rootCause {
+			return summary
+		}
+	}
+	summary := &RootCauseSummary{
+		WorkflowType: workflowType,
+		ActivityType: activityType,
+		IssueType:    issueType,
+		RootCause:    rootCause,
+	}
+	r.RootCauses = append(r.RootCauses, summary)
+	return summary
+}
+
+// sortRootCauses puts the most frequent root causes first
+func (r *DomainDiagnosticsReport) sortRootCauses() {
+	sort.SliceStable(r.RootCauses, func(i, j int) bool {
+		return r.RootCauses[i].Count > r.RootCauses[j].Count
+	})
+}
+
+func (r *DomainDiagnosticsReport) rootCauseCounts() []analytics.RootCauseCount {
+	result := make([]analytics.RootCauseCount, 0, len(r.RootCauses))
+	for _, summary := range r.RootCauses {
+		result = append(result, analytics.RootCauseCount{
+			WorkflowType: summary.WorkflowType,
+			ActivityType: summary.ActivityType,
+			IssueType:    summary.IssueType,
+			RootCause:    summary.RootCause,
+			Count:        summary.Count,
+		})
+	}
+	return result
+}
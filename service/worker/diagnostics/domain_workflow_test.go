@@ -0,0 +1,130 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package diagnostics
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/analytics"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/decision"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
+)
+
+func (s *diagnosticsWorkflowTestSuite) TestDomainDiagnosticsWorkflow() {
+	executions := []sampledExecution{
+		{Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}, WorkflowType: "wf-type"},
+		{Execution: &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"}, WorkflowType: "wf-type"},
+		{Execution: &types.WorkflowExecution{WorkflowID: "wid3", RunID: "rid3"}, WorkflowType: "other-wf-type"},
+	}
+	activityFailure := diagnosedIssue{
+		InvariantType: failure.ActivityFailed.String(),
+		Reason:        failure.GenericError.String(),
+		ActivityType:  "activity-type",
+		RootCauses:    []string{invariant.RootCauseTypeServiceSideIssue.String()},
+	}
+	isExecution := func(workflowID string) interface{} {
+		return mock.MatchedBy(func(params identifyIssuesParams) bool {
+			return params.Execution.GetWorkflowID() == workflowID
+		})
+	}
+	s.workflowEnv.OnActivity(sampleExecutionsActivity, mock.Anything, mock.MatchedBy(func(params sampleExecutionsParams) bool {
+		return params.Domain == "test-domain" && params.SampleSize == DefaultDomainDiagnosticsSampleSize &&
+			params.WindowEnd.Sub(params.WindowStart) == DefaultDomainDiagnosticsWindow
+	})).Return(executions, nil).Once()
+	s.workflowEnv.OnActivity(diagnoseExecutionActivity, mock.Anything, isExecution("wid1")).Return([]diagnosedIssue{activityFailure, activityFailure}, nil).Once()
+	s.workflowEnv.OnActivity(diagnoseExecutionActivity, mock.Anything, isExecution("wid2")).Return([]diagnosedIssue{activityFailure}, nil).Once()
+	s.workflowEnv.OnActivity(diagnoseExecutionActivity, mock.Anything, isExecution("wid3")).Return(nil, errors.New("history not found")).Once()
+	s.workflowEnv.OnActivity(emitDomainDiagnosticsActivity, mock.Anything, mock.MatchedBy(func(data analytics.DomainDiagnosticsData) bool {
+		return data.Domain == "test-domain" && data.WorkflowsSampled == 3 && data.WorkflowsWithIssues == 2 && len(data.RootCauses) == 1
+	})).Return(nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(DomainDiagnosticsWorkflowTypeName, DomainDiagnosticsWorkflowInput{Domain: "test-domain"})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	var report DomainDiagnosticsReport
+	s.NoError(s.workflowEnv.GetWorkflowResult(&report))
+	s.Equal(3, report.WorkflowsSampled)
+	s.Equal(2, report.WorkflowsDiagnosed)
+	s.Equal(2, report.WorkflowsWithIssues)
+	s.True(report.Completed)
+	s.Equal([]*RootCauseSummary{
+		{
+			WorkflowType: "wf-type",
+			ActivityType: "activity-type",
+			IssueType:    failure.ActivityFailed.String(),
+			RootCause:    invariant.RootCauseTypeServiceSideIssue.String(),
+			Count:        2,
+			Examples:     []*types.WorkflowExecution{executions[0].Execution, executions[1].Execution},
+		},
+	}, report.RootCauses)
+}
+
+func (s *diagnosticsWorkflowTestSuite) TestDomainDiagnosticsWorkflow_Error() {
+	s.workflowEnv.ExecuteWorkflow(DomainDiagnosticsWorkflowTypeName, DomainDiagnosticsWorkflowInput{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func TestDomainDiagnosticsReport_add(t *testing.T) {
+	timeoutIssue := diagnosedIssue{
+		InvariantType: timeout.TimeoutTypeExecution.String(),
+		Reason:        "START_TO_CLOSE",
+	}
+	decisionIssue := diagnosedIssue{
+		InvariantType: decision.DecisionTaskFailedRepeatedly.String(),
+		RootCauses: []string{
+			invariant.RootCauseTypeNonDeterministicWorkflow.String(),
+			invariant.RootCauseTypeDecisionWorkerFailure.String(),
+		},
+	}
+	report := &DomainDiagnosticsReport{}
+	for i := 0; i < _maxRootCauseExamples+1; i++ {
+		report.add(sampledExecution{Execution: &types.WorkflowExecution{WorkflowID: "wid"}, WorkflowType: "wf-type"}, []diagnosedIssue{timeoutIssue})
+	}
+	report.add(sampledExecution{Execution: &types.WorkflowExecution{WorkflowID: "other"}, WorkflowType: "wf-type"}, []diagnosedIssue{decisionIssue})
+	report.add(sampledExecution{Execution: &types.WorkflowExecution{WorkflowID: "healthy"}, WorkflowType: "wf-type"}, nil)
+	report.sortRootCauses()
+
+	require.Equal(t, _maxRootCauseExamples+2, report.WorkflowsWithIssues)
+	require.Len(t, report.RootCauses, 3)
+	require.Equal(t, timeout.TimeoutTypeExecution.String(), report.RootCauses[0].IssueType)
+	require.Empty(t, report.RootCauses[0].RootCause)
+	require.Equal(t, _maxRootCauseExamples+1, report.RootCauses[0].Count)
+	require.Len(t, report.RootCauses[0].Examples, _maxRootCauseExamples)
+	require.Equal(t, invariant.RootCauseTypeNonDeterministicWorkflow.String(), report.RootCauses[1].RootCause)
+	require.Equal(t, 1, report.RootCauses[1].Count)
+	require.Equal(t, invariant.RootCauseTypeDecisionWorkerFailure.String(), report.RootCauses[2].RootCause)
+	require.Equal(t, []analytics.RootCauseCount{
+		{WorkflowType: "wf-type", IssueType: timeout.TimeoutTypeExecution.String(), Count: _maxRootCauseExamples + 1},
+		{WorkflowType: "wf-type", IssueType: decisionIssue.InvariantType, RootCause: invariant.RootCauseTypeNonDeterministicWorkflow.String(), Count: 1},
+		{WorkflowType: "wf-type", IssueType: decisionIssue.InvariantType, RootCause: invariant.RootCauseTypeDecisionWorkerFailure.String(), Count: 1},
+	}, report.rootCauseCounts())
+}
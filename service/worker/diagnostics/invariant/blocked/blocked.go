@@ -0,0 +1,229 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package blocked
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+// Blocked is an invariant that will be used to identify workflows blocked on signals or child workflows in the workflow execution history
+type Blocked invariant.Invariant
+
+type blocked struct{}
+
+func NewInvariant() Blocked {
+	return &blocked{}
+}
+
+func (b *blocked) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	issueID := 0
+	state := newExecutionState(events)
+
+	// a workflow waiting on activities, timers or child workflows may consume its signals later
+	if !state.closed && !state.hasPendingWork() {
+		for _, signals := range unconsumedSignals(events) {
+			result = append(result, invariant.InvariantCheckResult{
+				IssueID:       issueID,
+				InvariantType: UnconsumedSignals.String(),
+				Reason:        signals.SignalName,
+				Metadata:      invariant.MarshalData(signals),
+			})
+			issueID++
+		}
+	}
+
+	for _, event := range events {
+		attr := event.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+		if attr == nil {
+			continue
+		}
+		if _, ok := state.startPendingChildren[event.ID]; !ok {
+			continue
+		}
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: ChildWorkflowStartPending.String(),
+			Reason:        attr.GetWorkflowID(),
+			Metadata: invariant.MarshalData(ChildWorkflowStartPendingMetadata{
+				InitiatedEventID: event.ID,
+				Domain:           attr.GetDomain(),
+				WorkflowID:       attr.GetWorkflowID(),
+				WorkflowType:     attr.GetWorkflowType().GetName(),
+				TaskList:         attr.TaskList.GetName(),
+			}),
+		})
+		issueID++
+	}
+	return result, nil
+}
+
+// executionState is the state of the workflow execution rebuilt from its history
+type executionState struct {
+	closed               bool
+	pendingActivities    map[int64]struct{}
+	pendingTimers        map[string]struct{}
+	pendingChildren      map[int64]struct{}
+	startPendingChildren map[int64]struct{}
+}
+
+func newExecutionState(events []*types.HistoryEvent) *executionState {
+	state := &executionState{
+		pendingActivities:    make(map[int64]struct{}),
+		pendingTimers:        make(map[string]struct{}),
+		pendingChildren:      make(map[int64]struct{}),
+		startPendingChildren: make(map[int64]struct{}),
+	}
+	for _, event := range events {
+		switch {
+		case isCloseEvent(event):
+			state.closed = true
+		case event.ActivityTaskScheduledEventAttributes != nil:
+			state.pendingActivities[event.ID] = struct{}{}
+		case event.ActivityTaskCompletedEventAttributes != nil:
+			delete(state.pendingActivities, event.ActivityTaskCompletedEventAttributes.ScheduledEventID)
+		case event.ActivityTaskFailedEventAttributes != nil:
+			delete(state.pendingActivities, event.ActivityTaskFailedEventAttributes.ScheduledEventID)
+		case event.ActivityTaskTimedOutEventAttributes != nil:
+			delete(state.pendingActivities, event.ActivityTaskTimedOutEventAttributes.ScheduledEventID)
+		case event.ActivityTaskCanceledEventAttributes != nil:
+			delete(state.pendingActivities, event.ActivityTaskCanceledEventAttributes.ScheduledEventID)
+		case event.TimerStartedEventAttributes != nil:
+			state.pendingTimers[event.TimerStartedEventAttributes.TimerID] = struct{}{}
+		case event.TimerFiredEventAttributes != nil:
+			delete(state.pendingTimers, event.TimerFiredEventAttributes.TimerID)
+		case event.TimerCanceledEventAttributes != nil:
+			delete(state.pendingTimers, event.TimerCanceledEventAttributes.TimerID)
+		case event.StartChildWorkflowExecutionInitiatedEventAttributes != nil:
+			state.pendingChildren[event.ID] = struct{}{}
+			state.startPendingChildren[event.ID] = struct{}{}
+		case event.ChildWorkflowExecutionStartedEventAttributes != nil:
+			delete(state.startPendingChildren, event.ChildWorkflowExecutionStartedEventAttributes.InitiatedEventID)
+		case event.StartChildWorkflowExecutionFailedEventAttributes != nil:
+			delete(state.startPendingChildren, event.StartChildWorkflowExecutionFailedEventAttributes.InitiatedEventID)
+			delete(state.pendingChildren, event.StartChildWorkflowExecutionFailedEventAttributes.InitiatedEventID)
+		case event.ChildWorkflowExecutionCompletedEventAttributes != nil:
+			delete(state.pendingChildren, event.ChildWorkflowExecutionCompletedEventAttributes.InitiatedEventID)
+		case event.ChildWorkflowExecutionFailedEventAttributes != nil:
+			delete(state.pendingChildren, event.ChildWorkflowExecutionFailedEventAttributes.InitiatedEventID)
+		case event.ChildWorkflowExecutionCanceledEventAttributes != nil:
+			delete(state.pendingChildren, event.ChildWorkflowExecutionCanceledEventAttributes.InitiatedEventID)
+		case event.ChildWorkflowExecutionTimedOutEventAttributes != nil:
+			delete(state.pendingChildren, event.ChildWorkflowExecutionTimedOutEventAttributes.InitiatedEventID)
+		case event.ChildWorkflowExecutionTerminatedEventAttributes != nil:
+			delete(state.pendingChildren, event.ChildWorkflowExecutionTerminatedEventAttributes.InitiatedEventID)
+		}
+	}
+	return state
+}
+
+func (s *executionState) hasPendingWork() bool {
+	return len(s.pendingActivities) > 0 || len(s.pendingTimers) > 0 || len(s.pendingChildren) > 0
+}
+
+// unconsumedSignals returns the signals that were delivered to decision tasks which completed without any decision,
+// since the last decision task that made progress
+func unconsumedSignals(events []*types.HistoryEvent) []*UnconsumedSignalsMetadata {
+	var received, unconsumed []*types.HistoryEvent
+	for i, event := range events {
+		switch {
+		case event.WorkflowExecutionSignaledEventAttributes != nil:
+			received = append(received, event)
+		case event.DecisionTaskCompletedEventAttributes != nil:
+			if i+1 < len(events) && producedByDecision(events[i+1]) {
+				received, unconsumed = nil, nil
+			} else {
+				unconsumed = append(unconsumed, received...)
+				received = nil
+			}
+		}
+	}
+
+	result := make([]*UnconsumedSignalsMetadata, 0)
+	signalsByName := make(map[string]*UnconsumedSignalsMetadata)
+	for _, event := range unconsumed {
+		name := event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName()
+		signals, ok := signalsByName[name]
+		if !ok {
+			signals = &UnconsumedSignalsMetadata{
+				SignalName:         name,
+				FirstSignalEventID: event.ID,
+			}
+			signalsByName[name] = signals
+			result = append(result, signals)
+		}
+		signals.SignalCount++
+		signals.LastSignalEventID = event.ID
+	}
+	return result
+}
+
+// producedByDecision returns true for the events written from the decisions of a completed decision task
+func producedByDecision(event *types.HistoryEvent) bool {
+	return event.ActivityTaskScheduledEventAttributes != nil ||
+		event.ActivityTaskCancelRequestedEventAttributes != nil ||
+		event.RequestCancelActivityTaskFailedEventAttributes != nil ||
+		event.TimerStartedEventAttributes != nil ||
+		event.TimerCanceledEventAttributes != nil ||
+		event.CancelTimerFailedEventAttributes != nil ||
+		event.MarkerRecordedEventAttributes != nil ||
+		event.StartChildWorkflowExecutionInitiatedEventAttributes != nil ||
+		event.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil ||
+		event.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil ||
+		event.UpsertWorkflowSearchAttributesEventAttributes != nil ||
+		isCloseEvent(event)
+}
+
+func isCloseEvent(event *types.HistoryEvent) bool {
+	return event.WorkflowExecutionCompletedEventAttributes != nil ||
+		event.WorkflowExecutionFailedEventAttributes != nil ||
+		event.WorkflowExecutionCanceledEventAttributes != nil ||
+		event.WorkflowExecutionTerminatedEventAttributes != nil ||
+		event.WorkflowExecutionTimedOutEventAttributes != nil ||
+		event.WorkflowExecutionContinuedAsNewEventAttributes != nil
+}
+
+func (b *blocked) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		switch issue.InvariantType {
+		case UnconsumedSignals.String():
+			result = append(result, invariant.InvariantRootCauseResult{
+				IssueID:   issue.IssueID,
+				RootCause: invariant.RootCauseTypeUnconsumedSignals,
+				Metadata:  issue.Metadata,
+			})
+		case ChildWorkflowStartPending.String():
+			result = append(result, invariant.InvariantRootCauseResult{
+				IssueID:   issue.IssueID,
+				RootCause: invariant.RootCauseTypeChildWorkflowStartPending,
+				Metadata:  issue.Metadata,
+			})
+		}
+	}
+	return result, nil
+}
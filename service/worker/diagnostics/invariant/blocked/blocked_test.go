@@ -0,0 +1,209 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package blocked
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	testDomain      = "test-domain"
+	testChildDomain = "test-child-domain"
+	testSignalName  = "test-signal"
+)
+
+func Test__Check(t *testing.T) {
+	signalsMetadataInBytes, err := json.Marshal(UnconsumedSignalsMetadata{
+		SignalName:         testSignalName,
+		SignalCount:        2,
+		FirstSignalEventID: 5,
+		LastSignalEventID:  6,
+	})
+	require.NoError(t, err)
+	childMetadataInBytes, err := json.Marshal(ChildWorkflowStartPendingMetadata{
+		InitiatedEventID: 5,
+		Domain:           testChildDomain,
+		WorkflowID:       "child-wid",
+		WorkflowType:     "child-type",
+		TaskList:         "child-tasklist",
+	})
+	require.NoError(t, err)
+	testCases := []struct {
+		name           string
+		events         []*types.HistoryEvent
+		expectedResult []invariant.InvariantCheckResult
+	}{
+		{
+			name: "signals not consumed",
+			events: concatEvents(
+				startedEvents(),
+				[]*types.HistoryEvent{signaledEvent(5), signaledEvent(6)},
+				decisionEvents(7),
+			),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: UnconsumedSignals.String(),
+					Reason:        testSignalName,
+					Metadata:      signalsMetadataInBytes,
+				},
+			},
+		},
+		{
+			name: "signals consumed",
+			events: concatEvents(
+				startedEvents(),
+				[]*types.HistoryEvent{signaledEvent(5), signaledEvent(6)},
+				decisionEvents(7),
+				[]*types.HistoryEvent{{ID: 10, MarkerRecordedEventAttributes: &types.MarkerRecordedEventAttributes{}}},
+			),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "signals not processed by a decision task yet",
+			events: append(startedEvents(),
+				signaledEvent(5),
+				&types.HistoryEvent{ID: 6, DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{}},
+			),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "workflow waiting on a timer",
+			events: concatEvents(
+				startedEvents(),
+				[]*types.HistoryEvent{
+					{ID: 5, TimerStartedEventAttributes: &types.TimerStartedEventAttributes{TimerID: "timer"}},
+					signaledEvent(6),
+				},
+				decisionEvents(7),
+			),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name: "child workflow start pending",
+			events: append(startedEvents(),
+				&types.HistoryEvent{
+					ID: 5,
+					StartChildWorkflowExecutionInitiatedEventAttributes: &types.StartChildWorkflowExecutionInitiatedEventAttributes{
+						Domain:       testChildDomain,
+						WorkflowID:   "child-wid",
+						WorkflowType: &types.WorkflowType{Name: "child-type"},
+						TaskList:     &types.TaskList{Name: "child-tasklist"},
+					},
+				},
+			),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: ChildWorkflowStartPending.String(),
+					Reason:        "child-wid",
+					Metadata:      childMetadataInBytes,
+				},
+			},
+		},
+		{
+			name: "child workflow started",
+			events: append(startedEvents(),
+				&types.HistoryEvent{
+					ID: 5,
+					StartChildWorkflowExecutionInitiatedEventAttributes: &types.StartChildWorkflowExecutionInitiatedEventAttributes{
+						Domain:     testChildDomain,
+						WorkflowID: "child-wid",
+					},
+				},
+				&types.HistoryEvent{
+					ID: 6,
+					ChildWorkflowExecutionStartedEventAttributes: &types.ChildWorkflowExecutionStartedEventAttributes{InitiatedEventID: 5},
+				},
+			),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := NewInvariant()
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory: &types.GetWorkflowExecutionHistoryResponse{
+					History: &types.History{Events: tc.events},
+				},
+				Domain: testDomain,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	inv := NewInvariant()
+	result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+		Domain: testDomain,
+		Issues: []invariant.InvariantCheckResult{
+			{IssueID: 0, InvariantType: UnconsumedSignals.String(), Metadata: []byte("signals")},
+			{IssueID: 1, InvariantType: ChildWorkflowStartPending.String(), Metadata: []byte("child")},
+			{IssueID: 2, InvariantType: "other"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []invariant.InvariantRootCauseResult{
+		{IssueID: 0, RootCause: invariant.RootCauseTypeUnconsumedSignals, Metadata: []byte("signals")},
+		{IssueID: 1, RootCause: invariant.RootCauseTypeChildWorkflowStartPending, Metadata: []byte("child")},
+	}, result)
+}
+
+func concatEvents(events ...[]*types.HistoryEvent) []*types.HistoryEvent {
+	var result []*types.HistoryEvent
+	for _, e := range events {
+		result = append(result, e...)
+	}
+	return result
+}
+
+// startedEvents returns the events of a workflow whose first decision task completed without any decision
+func startedEvents() []*types.HistoryEvent {
+	return append([]*types.HistoryEvent{
+		{ID: 1, WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{}},
+	}, decisionEvents(2)...)
+}
+
+func decisionEvents(scheduledEventID int64) []*types.HistoryEvent {
+	return []*types.HistoryEvent{
+		{ID: scheduledEventID, DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{}},
+		{ID: scheduledEventID + 1, DecisionTaskStartedEventAttributes: &types.DecisionTaskStartedEventAttributes{ScheduledEventID: scheduledEventID}},
+		{ID: scheduledEventID + 2, DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{ScheduledEventID: scheduledEventID}},
+	}
+}
+
+func signaledEvent(eventID int64) *types.HistoryEvent {
+	return &types.HistoryEvent{
+		ID:                                       eventID,
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{SignalName: testSignalName},
+	}
+}
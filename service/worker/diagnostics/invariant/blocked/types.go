@@ -0,0 +1,56 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package blocked
+
+type BlockedType string
+
+const (
+	UnconsumedSignals         BlockedType = "Workflow is blocked and did not consume the signals it received"
+	ChildWorkflowStartPending BlockedType = "Child workflow is stuck in start pending"
+)
+
+func (b BlockedType) String() string {
+	return string(b)
+}
+
+// UnconsumedSignalsMetadata includes the details of the signals of a name that the workflow did not consume
+type UnconsumedSignalsMetadata struct {
+	SignalName         string
+	SignalCount        int
+	FirstSignalEventID int64
+	LastSignalEventID  int64
+}
+
+// ChildWorkflowStartPendingMetadata includes the details of a child workflow that was initiated but not started
+type ChildWorkflowStartPendingMetadata struct {
+	InitiatedEventID int64
+	Domain           string
+	WorkflowID       string
+	WorkflowType     string
+	TaskList         string
+}
+
+type BlockedIssuesMetadata struct {
+	UnconsumedSignals         *UnconsumedSignalsMetadata
+	ChildWorkflowStartPending *ChildWorkflowStartPendingMetadata
+}
@@ -0,0 +1,197 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package decision
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	// repeatedFailureAttempts is the number of failed attempts after which a decision task is reported as failing repeatedly
+	repeatedFailureAttempts = 3
+	// maxDetailsLength is the maximum length of the failure details kept in the issue metadata
+	maxDetailsLength = 1000
+)
+
+// nonDeterministicEventIDRegex matches the ID of the history event that the worker failed to replay in the failure details
+var nonDeterministicEventIDRegex = regexp.MustCompile(`(?i)event\s*(?:id)?\s*[:=]?\s*(\d+)`)
+
+// Decision is an invariant that will be used to identify decision tasks that keep failing in the workflow execution history
+type Decision invariant.Invariant
+
+type decision struct{}
+
+func NewInvariant() Decision {
+	return &decision{}
+}
+
+func (d *decision) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	issueID := 0
+	var lastFailure *types.HistoryEvent
+	var attempts int64
+	checkRepeatedFailures := func() {
+		if lastFailure == nil || attempts < repeatedFailureAttempts {
+			return
+		}
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: DecisionTaskFailedRepeatedly.String(),
+			Reason:        lastFailure.GetDecisionTaskFailedEventAttributes().GetCause().String(),
+			Metadata:      invariant.MarshalData(failureMetadata(lastFailure, attempts)),
+		})
+		issueID++
+	}
+	for _, event := range events {
+		switch {
+		case event.GetDecisionTaskFailedEventAttributes() != nil:
+			if !isWorkerFailure(event.GetDecisionTaskFailedEventAttributes()) {
+				continue
+			}
+			attempts++
+			lastFailure = event
+			metadata := failureMetadata(event, attempts)
+			if metadata.NonDeterministic {
+				result = append(result, invariant.InvariantCheckResult{
+					IssueID:       issueID,
+					InvariantType: DecisionTaskNonDeterministic.String(),
+					Reason:        event.GetDecisionTaskFailedEventAttributes().GetCause().String(),
+					Metadata:      invariant.MarshalData(metadata),
+				})
+				issueID++
+			}
+		case event.GetDecisionTaskScheduledEventAttributes() != nil:
+			// only the first failure of a decision task is written to the history, the following attempts are
+			// counted by the attempt of the decision task scheduled after them
+			attempts = max(attempts, event.GetDecisionTaskScheduledEventAttributes().GetAttempt())
+		case event.GetDecisionTaskCompletedEventAttributes() != nil:
+			checkRepeatedFailures()
+			lastFailure = nil
+			attempts = 0
+		}
+	}
+	checkRepeatedFailures()
+	return result, nil
+}
+
+// isWorkerFailure returns false for the decision task failures caused by the server, such as resets and failovers
+func isWorkerFailure(attr *types.DecisionTaskFailedEventAttributes) bool {
+	switch attr.GetCause() {
+	case types.DecisionTaskFailedCauseResetStickyTasklist,
+		types.DecisionTaskFailedCauseForceCloseDecision,
+		types.DecisionTaskFailedCauseFailoverCloseDecision,
+		types.DecisionTaskFailedCauseResetWorkflow:
+		return false
+	}
+	return true
+}
+
+func failureMetadata(event *types.HistoryEvent, attempts int64) DecisionFailureMetadata {
+	attr := event.GetDecisionTaskFailedEventAttributes()
+	details := string(attr.GetDetails())
+	if attr.Reason != nil && *attr.Reason != "" {
+		details = *attr.Reason + ": " + details
+	}
+	metadata := DecisionFailureMetadata{
+		ScheduledEventID: attr.ScheduledEventID,
+		FailedEventID:    event.ID,
+		NonDeterministic: isNonDeterministic(details),
+		Attempts:         attempts,
+		Cause:            attr.Cause,
+		Identity:         attr.Identity,
+		BinaryChecksum:   attr.BinaryChecksum,
+		Details:          truncate(details),
+	}
+	if metadata.NonDeterministic {
+		metadata.NonDeterministicEventID = nonDeterministicEventID(details)
+	}
+	return metadata
+}
+
+func isNonDeterministic(details string) bool {
+	details = strings.ToLower(details)
+	return strings.Contains(details, "nondeterministic") || strings.Contains(details, "non-deterministic")
+}
+
+func nonDeterministicEventID(details string) int64 {
+	match := nonDeterministicEventIDRegex.FindStringSubmatch(details)
+	if match == nil {
+		return 0
+	}
+	eventID, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return eventID
+}
+
+func truncate(details string) string {
+	if len(details) > maxDetailsLength {
+		return details[:maxDetailsLength]
+	}
+	return details
+}
+
+func (d *decision) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		switch issue.InvariantType {
+		case DecisionTaskNonDeterministic.String():
+			result = append(result, invariant.InvariantRootCauseResult{
+				IssueID:   issue.IssueID,
+				RootCause: invariant.RootCauseTypeNonDeterministicWorkflow,
+				Metadata:  issue.Metadata,
+			})
+		case DecisionTaskFailedRepeatedly.String():
+			var metadata DecisionFailureMetadata
+			err := json.Unmarshal(issue.Metadata, &metadata)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, invariant.InvariantRootCauseResult{
+				IssueID:   issue.IssueID,
+				RootCause: rootCauseOfFailures(metadata),
+				Metadata:  issue.Metadata,
+			})
+		}
+	}
+	return result, nil
+}
+
+func rootCauseOfFailures(metadata DecisionFailureMetadata) invariant.RootCause {
+	if metadata.NonDeterministic {
+		return invariant.RootCauseTypeNonDeterministicWorkflow
+	}
+	if metadata.Cause == nil || *metadata.Cause == types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure {
+		return invariant.RootCauseTypeDecisionWorkerFailure
+	}
+	return invariant.RootCauseTypeInvalidDecisions
+}
@@ -0,0 +1,237 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package decision
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const (
+	testDomain   = "test-domain"
+	testIdentity = "localhost"
+)
+
+func Test__Check(t *testing.T) {
+	repeatedMetadataInBytes, err := json.Marshal(DecisionFailureMetadata{
+		ScheduledEventID: 2,
+		FailedEventID:    4,
+		Attempts:         4,
+		Cause:            types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+		Identity:         testIdentity,
+		Details:          "panic: boom",
+	})
+	require.NoError(t, err)
+	nonDeterministicMetadataInBytes, err := json.Marshal(DecisionFailureMetadata{
+		ScheduledEventID:        2,
+		FailedEventID:           4,
+		NonDeterministic:        true,
+		NonDeterministicEventID: 7,
+		Attempts:                1,
+		Cause:                   types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+		Identity:                testIdentity,
+		Details:                 "nondeterministic workflow: history eventID: 7 does not match the replay decision",
+	})
+	require.NoError(t, err)
+	testCases := []struct {
+		name           string
+		testData       *types.GetWorkflowExecutionHistoryResponse
+		expectedResult []invariant.InvariantCheckResult
+	}{
+		{
+			name:     "decision task failing repeatedly",
+			testData: decisionFailedHistory("panic: boom", types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure, 4),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: DecisionTaskFailedRepeatedly.String(),
+					Reason:        types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.String(),
+					Metadata:      repeatedMetadataInBytes,
+				},
+			},
+		},
+		{
+			name:           "decision task failed once",
+			testData:       decisionFailedHistory("panic: boom", types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure, 1),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name:           "decision task failed by the server",
+			testData:       decisionFailedHistory("", types.DecisionTaskFailedCauseResetStickyTasklist, 4),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name:     "non-deterministic decision task",
+			testData: decisionFailedHistory("nondeterministic workflow: history eventID: 7 does not match the replay decision", types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure, 1),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: DecisionTaskNonDeterministic.String(),
+					Reason:        types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.String(),
+					Metadata:      nonDeterministicMetadataInBytes,
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := NewInvariant()
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory: tc.testData,
+				Domain:                   testDomain,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	metadataInBytes := func(metadata DecisionFailureMetadata) []byte {
+		data, err := json.Marshal(metadata)
+		require.NoError(t, err)
+		return data
+	}
+	workerFailure := metadataInBytes(DecisionFailureMetadata{Cause: types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr()})
+	invalidDecision := metadataInBytes(DecisionFailureMetadata{Cause: types.DecisionTaskFailedCauseBadScheduleActivityAttributes.Ptr()})
+	nonDeterministic := metadataInBytes(DecisionFailureMetadata{NonDeterministic: true, Cause: types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr()})
+	testCases := []struct {
+		name           string
+		input          []invariant.InvariantCheckResult
+		expectedResult []invariant.InvariantRootCauseResult
+		expectErr      bool
+	}{
+		{
+			name: "non-deterministic workflow",
+			input: []invariant.InvariantCheckResult{
+				{IssueID: 0, InvariantType: DecisionTaskNonDeterministic.String(), Metadata: nonDeterministic},
+				{IssueID: 1, InvariantType: DecisionTaskFailedRepeatedly.String(), Metadata: nonDeterministic},
+			},
+			expectedResult: []invariant.InvariantRootCauseResult{
+				{IssueID: 0, RootCause: invariant.RootCauseTypeNonDeterministicWorkflow, Metadata: nonDeterministic},
+				{IssueID: 1, RootCause: invariant.RootCauseTypeNonDeterministicWorkflow, Metadata: nonDeterministic},
+			},
+		},
+		{
+			name: "worker failure",
+			input: []invariant.InvariantCheckResult{
+				{IssueID: 0, InvariantType: DecisionTaskFailedRepeatedly.String(), Metadata: workerFailure},
+			},
+			expectedResult: []invariant.InvariantRootCauseResult{
+				{IssueID: 0, RootCause: invariant.RootCauseTypeDecisionWorkerFailure, Metadata: workerFailure},
+			},
+		},
+		{
+			name: "invalid decisions",
+			input: []invariant.InvariantCheckResult{
+				{IssueID: 0, InvariantType: DecisionTaskFailedRepeatedly.String(), Metadata: invalidDecision},
+			},
+			expectedResult: []invariant.InvariantRootCauseResult{
+				{IssueID: 0, RootCause: invariant.RootCauseTypeInvalidDecisions, Metadata: invalidDecision},
+			},
+		},
+		{
+			name: "other issues are ignored",
+			input: []invariant.InvariantCheckResult{
+				{IssueID: 0, InvariantType: "other", Metadata: workerFailure},
+			},
+			expectedResult: []invariant.InvariantRootCauseResult{},
+		},
+		{
+			name: "invalid metadata",
+			input: []invariant.InvariantCheckResult{
+				{IssueID: 0, InvariantType: DecisionTaskFailedRepeatedly.String(), Metadata: []byte("{")},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := NewInvariant()
+			result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+				Domain: testDomain,
+				Issues: tc.input,
+			})
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
+
+// decisionFailedHistory returns the history of a workflow whose first decision task failed, followed by a
+// transient decision task of the given attempt, completed when the attempt is 1
+func decisionFailedHistory(details string, cause types.DecisionTaskFailedCause, attempt int64) *types.GetWorkflowExecutionHistoryResponse {
+	events := []*types.HistoryEvent{
+		{
+			ID:                                      1,
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+		},
+		{
+			ID:                                   2,
+			DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{},
+		},
+		{
+			ID:                                 3,
+			DecisionTaskStartedEventAttributes: &types.DecisionTaskStartedEventAttributes{ScheduledEventID: 2},
+		},
+		{
+			ID: 4,
+			DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+				ScheduledEventID: 2,
+				StartedEventID:   3,
+				Cause:            cause.Ptr(),
+				Details:          []byte(details),
+				Identity:         testIdentity,
+			},
+		},
+		{
+			ID:                                   5,
+			DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{Attempt: attempt},
+		},
+		{
+			ID:                                 6,
+			DecisionTaskStartedEventAttributes: &types.DecisionTaskStartedEventAttributes{ScheduledEventID: 5},
+		},
+	}
+	if attempt == 1 {
+		events = append(events, &types.HistoryEvent{
+			ID:                                   7,
+			DecisionTaskCompletedEventAttributes: &types.DecisionTaskCompletedEventAttributes{ScheduledEventID: 5, StartedEventID: 6},
+		})
+	}
+	return &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{
+			Events: events,
+		},
+	}
+}
@@ -0,0 +1,54 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package decision
+
+import "github.com/uber/cadence/common/types"
+
+type DecisionIssueType string
+
+const (
+	DecisionTaskFailedRepeatedly DecisionIssueType = "Decision task has failed repeatedly"
+	DecisionTaskNonDeterministic DecisionIssueType = "Decision task failed because of a non-deterministic workflow"
+)
+
+func (d DecisionIssueType) String() string {
+	return string(d)
+}
+
+// DecisionFailureMetadata includes the details of the last failure of a decision task
+type DecisionFailureMetadata struct {
+	// ScheduledEventID is the ID of the event that scheduled the failing decision task
+	ScheduledEventID int64
+	// FailedEventID is the ID of the DecisionTaskFailed event
+	FailedEventID int64
+	// NonDeterministic is true when the worker failed to replay the workflow history
+	NonDeterministic bool
+	// NonDeterministicEventID is the ID of the history event the workflow failed to replay, 0 when the worker did not report it
+	NonDeterministicEventID int64
+	// Attempts is the number of times the decision task has failed
+	Attempts       int64
+	Cause          *types.DecisionTaskFailedCause
+	Identity       string
+	BinaryChecksum string
+	Details        string
+}
@@ -0,0 +1,129 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package historylimit
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+// HistoryLimit is an invariant that will be used to identify workflow execution histories close to their size limits
+type HistoryLimit invariant.Invariant
+
+type historyLimit struct {
+	serializer             persistence.PayloadSerializer
+	historySizeLimitWarn   dynamicproperties.IntPropertyFnWithDomainFilter
+	historySizeLimitError  dynamicproperties.IntPropertyFnWithDomainFilter
+	historyCountLimitWarn  dynamicproperties.IntPropertyFnWithDomainFilter
+	historyCountLimitError dynamicproperties.IntPropertyFnWithDomainFilter
+}
+
+type Params struct {
+	HistorySizeLimitWarn   dynamicproperties.IntPropertyFnWithDomainFilter
+	HistorySizeLimitError  dynamicproperties.IntPropertyFnWithDomainFilter
+	HistoryCountLimitWarn  dynamicproperties.IntPropertyFnWithDomainFilter
+	HistoryCountLimitError dynamicproperties.IntPropertyFnWithDomainFilter
+}
+
+func NewInvariant(p Params) HistoryLimit {
+	return &historyLimit{
+		serializer:             persistence.NewPayloadSerializer(),
+		historySizeLimitWarn:   p.HistorySizeLimitWarn,
+		historySizeLimitError:  p.HistorySizeLimitError,
+		historyCountLimitWarn:  p.HistoryCountLimitWarn,
+		historyCountLimitError: p.HistoryCountLimitError,
+	}
+}
+
+func (h *historyLimit) Check(ctx context.Context, params invariant.InvariantCheckInput) ([]invariant.InvariantCheckResult, error) {
+	result := make([]invariant.InvariantCheckResult, 0)
+	events := params.WorkflowExecutionHistory.GetHistory().GetEvents()
+	issueID := 0
+
+	// the size of the history is approximated by the size of its events encoded in a single batch
+	blob, err := h.serializer.SerializeBatchEvents(events, constants.EncodingTypeThriftRW)
+	if err != nil {
+		return nil, err
+	}
+	if issue, ok := checkLimit(int64(len(blob.Data)), h.historySizeLimitWarn(params.Domain), h.historySizeLimitError(params.Domain)); ok {
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: HistorySizeLimit.String(),
+			Reason:        issue.reason.String(),
+			Metadata:      invariant.MarshalData(issue.metadata),
+		})
+		issueID++
+	}
+	if issue, ok := checkLimit(int64(len(events)), h.historyCountLimitWarn(params.Domain), h.historyCountLimitError(params.Domain)); ok {
+		result = append(result, invariant.InvariantCheckResult{
+			IssueID:       issueID,
+			InvariantType: HistoryCountLimit.String(),
+			Reason:        issue.reason.String(),
+			Metadata:      invariant.MarshalData(issue.metadata),
+		})
+		issueID++
+	}
+	return result, nil
+}
+
+type limitIssue struct {
+	reason   LimitType
+	metadata HistoryLimitMetadata
+}
+
+func checkLimit(value int64, warnLimit, errorLimit int) (limitIssue, bool) {
+	issue := limitIssue{
+		metadata: HistoryLimitMetadata{
+			Value:      value,
+			WarnLimit:  int64(warnLimit),
+			ErrorLimit: int64(errorLimit),
+		},
+	}
+	switch {
+	case value >= int64(errorLimit):
+		issue.reason = LimitErrorExceeded
+	case value >= int64(warnLimit):
+		issue.reason = LimitWarnExceeded
+	default:
+		return limitIssue{}, false
+	}
+	return issue, true
+}
+
+func (h *historyLimit) RootCause(ctx context.Context, params invariant.InvariantRootCauseInput) ([]invariant.InvariantRootCauseResult, error) {
+	result := make([]invariant.InvariantRootCauseResult, 0)
+	for _, issue := range params.Issues {
+		if issue.InvariantType == HistorySizeLimit.String() || issue.InvariantType == HistoryCountLimit.String() {
+			result = append(result, invariant.InvariantRootCauseResult{
+				IssueID:   issue.IssueID,
+				RootCause: invariant.RootCauseTypeHistoryNearLimit,
+				Metadata:  issue.Metadata,
+			})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,161 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package historylimit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+)
+
+const testDomain = "test-domain"
+
+func Test__Check(t *testing.T) {
+	history := historyWithEvents(10)
+	blob, err := persistence.NewPayloadSerializer().SerializeBatchEvents(history.GetHistory().GetEvents(), constants.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	size := len(blob.Data)
+
+	countWarnMetadata, err := json.Marshal(HistoryLimitMetadata{Value: 10, WarnLimit: 10, ErrorLimit: 20})
+	require.NoError(t, err)
+	countErrorMetadata, err := json.Marshal(HistoryLimitMetadata{Value: 10, WarnLimit: 5, ErrorLimit: 10})
+	require.NoError(t, err)
+	sizeWarnMetadata, err := json.Marshal(HistoryLimitMetadata{Value: int64(size), WarnLimit: int64(size - 1), ErrorLimit: int64(size * 2)})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		params         Params
+		expectedResult []invariant.InvariantCheckResult
+	}{
+		{
+			name:           "history within limits",
+			params:         testParams(size*2, size*4, 100, 200),
+			expectedResult: []invariant.InvariantCheckResult{},
+		},
+		{
+			name:   "event count exceeds warn limit",
+			params: testParams(size*2, size*4, 10, 20),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: HistoryCountLimit.String(),
+					Reason:        LimitWarnExceeded.String(),
+					Metadata:      countWarnMetadata,
+				},
+			},
+		},
+		{
+			name:   "size exceeds warn limit and event count exceeds error limit",
+			params: testParams(size-1, size*2, 5, 10),
+			expectedResult: []invariant.InvariantCheckResult{
+				{
+					IssueID:       0,
+					InvariantType: HistorySizeLimit.String(),
+					Reason:        LimitWarnExceeded.String(),
+					Metadata:      sizeWarnMetadata,
+				},
+				{
+					IssueID:       1,
+					InvariantType: HistoryCountLimit.String(),
+					Reason:        LimitErrorExceeded.String(),
+					Metadata:      countErrorMetadata,
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inv := NewInvariant(tc.params)
+			result, err := inv.Check(context.Background(), invariant.InvariantCheckInput{
+				WorkflowExecutionHistory: history,
+				Domain:                   testDomain,
+			})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func Test__RootCause(t *testing.T) {
+	metadata, err := json.Marshal(HistoryLimitMetadata{Value: 10, WarnLimit: 5, ErrorLimit: 10})
+	require.NoError(t, err)
+	inv := NewInvariant(testParams(0, 0, 0, 0))
+	result, err := inv.RootCause(context.Background(), invariant.InvariantRootCauseInput{
+		Domain: testDomain,
+		Issues: []invariant.InvariantCheckResult{
+			{
+				IssueID:       0,
+				InvariantType: HistoryCountLimit.String(),
+				Reason:        LimitErrorExceeded.String(),
+				Metadata:      metadata,
+			},
+			{
+				IssueID:       1,
+				InvariantType: "some other issue",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []invariant.InvariantRootCauseResult{
+		{
+			IssueID:   0,
+			RootCause: invariant.RootCauseTypeHistoryNearLimit,
+			Metadata:  metadata,
+		},
+	}, result)
+}
+
+func testParams(sizeWarn, sizeError, countWarn, countError int) Params {
+	return Params{
+		HistorySizeLimitWarn:   dynamicproperties.GetIntPropertyFilteredByDomain(sizeWarn),
+		HistorySizeLimitError:  dynamicproperties.GetIntPropertyFilteredByDomain(sizeError),
+		HistoryCountLimitWarn:  dynamicproperties.GetIntPropertyFilteredByDomain(countWarn),
+		HistoryCountLimitError: dynamicproperties.GetIntPropertyFilteredByDomain(countError),
+	}
+}
+
+func historyWithEvents(count int) *types.GetWorkflowExecutionHistoryResponse {
+	events := make([]*types.HistoryEvent, 0, count)
+	for i := 1; i <= count; i++ {
+		events = append(events, &types.HistoryEvent{
+			ID:        int64(i),
+			EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+				SignalName: "signal",
+				Input:      []byte("payload"),
+			},
+		})
+	}
+	return &types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: events},
+	}
+}
@@ -0,0 +1,52 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package historylimit
+
+type HistoryLimitType string
+
+const (
+	HistorySizeLimit  HistoryLimitType = "Workflow history size is close to the limit configured for the domain"
+	HistoryCountLimit HistoryLimitType = "Workflow history event count is close to the limit configured for the domain"
+)
+
+func (h HistoryLimitType) String() string {
+	return string(h)
+}
+
+type LimitType string
+
+const (
+	LimitWarnExceeded  LimitType = "History has exceeded the warning limit"
+	LimitErrorExceeded LimitType = "History has exceeded the error limit, the workflow is terminated once it is reached"
+)
+
+func (l LimitType) String() string {
+	return string(l)
+}
+
+// HistoryLimitMetadata includes the size or event count of the history and the limits configured for the domain
+type HistoryLimitMetadata struct {
+	Value      int64
+	WarnLimit  int64
+	ErrorLimit int64
+}
@@ -66,6 +66,13 @@ const (
 	RootCauseTypeServiceSidePanic                      RootCause = "There is a panic in the activity/workflow that is causing a failure"
 	RootCauseTypeServiceSideCustomError                RootCause = "Customised error returned by the activity/workflow"
 	RootCauseTypeBlobSizeLimit                         RootCause = "Workflow has exceeded the blob size limits configured for the domain"
+	RootCauseTypeStickyWorkerUnavailable               RootCause = "The worker caching the workflow is no longer polling its sticky tasklist"
+	RootCauseTypeNonDeterministicWorkflow              RootCause = "Workflow code is no longer compatible with the workflow history and fails to replay it"
+	RootCauseTypeDecisionWorkerFailure                 RootCause = "Decision tasks keep failing in the worker. Check the failure details and the worker logs"
+	RootCauseTypeInvalidDecisions                      RootCause = "Decision tasks keep failing because the workflow returns decisions that are rejected by the server"
+	RootCauseTypeUnconsumedSignals                     RootCause = "Workflow is waiting but did not consume the signals it received. Check the signal names the workflow listens on"
+	RootCauseTypeChildWorkflowStartPending             RootCause = "Child workflow start has not been processed. Check that the child domain is active in this cluster"
+	RootCauseTypeHistoryNearLimit                      RootCause = "Workflow history is approaching the limits configured for the domain. Use continue-as-new to start a new history"
 )
 
 func (r RootCause) String() string {
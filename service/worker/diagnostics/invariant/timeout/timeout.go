@@ -125,6 +125,15 @@ func (t *timeout) RootCause(ctx context.Context, params invariant.InvariantRootC
 			result = append(result, pollerStatus)
 		}
 
+		if issue.InvariantType == TimeoutTypeDecision.String() && issue.Reason == types.TimeoutTypeScheduleToStart.String() {
+			// schedule to start timeouts of decision tasks only happen on sticky tasklists
+			pollerStatus, err := t.checkTasklist(ctx, issue, params.Domain)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, pollerStatus)
+		}
+
 		if issue.InvariantType == TimeoutTypeActivity.String() {
 			heartbeatStatus, err := checkHeartbeatStatus(issue)
 			if err != nil {
@@ -156,6 +165,14 @@ func (t *timeout) checkTasklist(ctx context.Context, issue invariant.InvariantCh
 		}
 		taskList = metadata.Tasklist
 		tasklistType = shared.TaskListTypeActivity.Ptr()
+	case TimeoutTypeDecision.String():
+		var metadata DecisionTimeoutMetadata
+		err := json.Unmarshal(issue.Metadata, &metadata)
+		if err != nil {
+			return invariant.InvariantRootCauseResult{}, err
+		}
+		taskList = metadata.Tasklist
+		tasklistType = shared.TaskListTypeDecision.Ptr()
 	}
 	if taskList == nil {
 		return invariant.InvariantRootCauseResult{}, fmt.Errorf("tasklist not set")
@@ -178,6 +195,13 @@ func (t *timeout) checkTasklist(ctx context.Context, issue invariant.InvariantCh
 		TaskListName:    taskList.Name,
 		TaskListBacklog: tasklistBacklog,
 	})
+	if len(resp.GetPollers()) == 0 && taskList.GetKind() == types.TaskListKindSticky {
+		return invariant.InvariantRootCauseResult{
+			IssueID:   issue.IssueID,
+			RootCause: invariant.RootCauseTypeStickyWorkerUnavailable,
+			Metadata:  polllersMetadataInBytes,
+		}, nil
+	}
 	if len(resp.GetPollers()) == 0 {
 		return invariant.InvariantRootCauseResult{
 			IssueID:   issue.IssueID,
@@ -45,6 +45,7 @@ func reasonForDecisionTaskTimeouts(event *types.HistoryEvent, allEvents []*types
 	}
 	return reason, DecisionTimeoutMetadata{
 		ConfiguredTimeout: time.Duration(getDecisionTaskConfiguredTimeout(eventScheduledID, allEvents)) * time.Second,
+		Tasklist:          getDecisionTaskTasklist(eventScheduledID, allEvents),
 	}
 }
 
@@ -92,6 +93,7 @@ func getActivityTaskMetadata(e *types.HistoryEvent, events []*types.HistoryEvent
 				return ActivityTimeoutMetadata{}, fmt.Errorf("unknown timeout type")
 			}
 			return ActivityTimeoutMetadata{
+				ActivityType:      attr.GetActivityType().GetName(),
 				TimeoutType:       timeoutType.Ptr(),
 				ConfiguredTimeout: time.Duration(configuredTimeout) * time.Second,
 				TimeElapsed:       timeElapsed,
@@ -114,6 +116,28 @@ func getDecisionTaskConfiguredTimeout(eventScheduledID int64, events []*types.Hi
 	return 0
 }
 
+// getDecisionTaskTasklist returns the tasklist a decision task was scheduled on. Decision tasks scheduled on a
+// tasklist other than the workflow's tasklist were scheduled on the sticky tasklist of a worker.
+func getDecisionTaskTasklist(eventScheduledID int64, events []*types.HistoryEvent) *types.TaskList {
+	for _, event := range events {
+		if event.ID == eventScheduledID {
+			taskList := event.GetDecisionTaskScheduledEventAttributes().GetTaskList()
+			if taskList == nil {
+				return nil
+			}
+			kind := types.TaskListKindNormal
+			if workflowTaskList := getWorkflowExecutionTasklist(events); workflowTaskList != nil && workflowTaskList.GetName() != taskList.GetName() {
+				kind = types.TaskListKindSticky
+			}
+			return &types.TaskList{
+				Name: taskList.GetName(),
+				Kind: kind.Ptr(),
+			}
+		}
+	}
+	return nil
+}
+
 func getChildWorkflowExecutionConfiguredTimeout(e *types.HistoryEvent, events []*types.HistoryEvent) int32 {
 	wfInitiatedID := e.GetChildWorkflowExecutionTimedOutEventAttributes().GetInitiatedEventID()
 	for _, event := range events {
@@ -55,6 +55,7 @@ type ChildWfTimeoutMetadata struct {
 }
 
 type ActivityTimeoutMetadata struct {
+	ActivityType      string
 	TimeoutType       *types.TimeoutType
 	ConfiguredTimeout time.Duration
 	TimeElapsed       time.Duration
@@ -65,6 +66,7 @@ type ActivityTimeoutMetadata struct {
 
 type DecisionTimeoutMetadata struct {
 	ConfiguredTimeout time.Duration
+	Tasklist          *types.TaskList
 }
 
 type PollersMetadata struct {
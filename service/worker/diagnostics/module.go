@@ -98,6 +98,10 @@ func (w *dw) Start() error {
 	newWorker.RegisterActivityWithOptions(w.identifyIssues, activity.RegisterOptions{Name: identifyIssuesActivity})
 	newWorker.RegisterActivityWithOptions(w.rootCauseIssues, activity.RegisterOptions{Name: rootCauseIssuesActivity})
 	newWorker.RegisterActivityWithOptions(w.emitUsageLogs, activity.RegisterOptions{Name: emitUsageLogsActivity})
+	newWorker.RegisterWorkflowWithOptions(w.DomainDiagnosticsWorkflow, workflow.RegisterOptions{Name: DomainDiagnosticsWorkflowTypeName})
+	newWorker.RegisterActivityWithOptions(w.sampleExecutions, activity.RegisterOptions{Name: sampleExecutionsActivity})
+	newWorker.RegisterActivityWithOptions(w.diagnoseExecution, activity.RegisterOptions{Name: diagnoseExecutionActivity})
+	newWorker.RegisterActivityWithOptions(w.emitDomainDiagnostics, activity.RegisterOptions{Name: emitDomainDiagnosticsActivity})
 	w.worker = newWorker
 	return newWorker.Start()
 }
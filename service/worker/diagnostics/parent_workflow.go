@@ -40,6 +40,9 @@ const (
 	issueTypeTimeouts = "Timeout"
 	issueTypeFailures = "Failure"
 	issueTypeRetry    = "Retry"
+	issueTypeDecision = "Decision"
+	issueTypeBlocked  = "Blocked"
+	issueTypeHistory  = "HistoryLimit"
 )
 
 type DiagnosticsStarterWorkflowInput struct {
@@ -126,5 +129,14 @@ func getIssueType(result DiagnosticsWorkflowResult) string {
 	if result.Retries != nil {
 		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeRetry)
 	}
+	if result.Decisions != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeDecision)
+	}
+	if result.Blocked != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeBlocked)
+	}
+	if result.HistoryLimits != nil {
+		issueType = fmt.Sprintf("%s-%s", issueType, issueTypeHistory)
+	}
 	return issueType
 }
@@ -32,7 +32,10 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/blocked"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/decision"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/historylimit"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/retry"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
 )
@@ -52,9 +55,12 @@ type DiagnosticsWorkflowInput struct {
 }
 
 type DiagnosticsWorkflowResult struct {
-	Timeouts *timeoutDiagnostics
-	Failures *failureDiagnostics
-	Retries  *retryDiagnostics
+	Timeouts      *timeoutDiagnostics
+	Failures      *failureDiagnostics
+	Retries       *retryDiagnostics
+	Decisions     *decisionDiagnostics
+	Blocked       *blockedDiagnostics
+	HistoryLimits *historyLimitDiagnostics
 }
 
 type timeoutDiagnostics struct {
@@ -107,6 +113,51 @@ type retryIssuesResult struct {
 	Metadata      retry.RetryMetadata
 }
 
+type decisionDiagnostics struct {
+	Issues    []*decisionIssuesResult
+	RootCause []*rootCauseResult
+	Runbook   string
+}
+
+type decisionIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      decision.DecisionFailureMetadata
+}
+
+type blockedDiagnostics struct {
+	Issues    []*blockedIssuesResult
+	RootCause []*rootCauseResult
+	Runbook   string
+}
+
+type blockedIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      *blocked.BlockedIssuesMetadata
+}
+
+type historyLimitDiagnostics struct {
+	Issues    []*historyLimitIssuesResult
+	RootCause []*rootCauseResult
+	Runbook   string
+}
+
+type historyLimitIssuesResult struct {
+	IssueID       int
+	InvariantType string
+	Reason        string
+	Metadata      historylimit.HistoryLimitMetadata
+}
+
+// rootCauseResult is used by invariants whose root cause carries no metadata beyond the issue
+type rootCauseResult struct {
+	IssueID       int
+	RootCauseType string
+}
+
 func (w *dw) DiagnosticsWorkflow(ctx workflow.Context, params DiagnosticsWorkflowInput) (*DiagnosticsWorkflowResult, error) {
 	scope := w.metricsClient.Scope(metrics.DiagnosticsWorkflowScope, metrics.DomainTag(params.Domain))
 	scope.IncCounter(metrics.DiagnosticsWorkflowStartedCount)
@@ -120,6 +171,9 @@ func (w *dw) DiagnosticsWorkflow(ctx workflow.Context, params DiagnosticsWorkflo
 	var timeoutsResult *timeoutDiagnostics
 	var failureResult *failureDiagnostics
 	var retryResult *retryDiagnostics
+	var decisionResult *decisionDiagnostics
+	var blockedResult *blockedDiagnostics
+	var historyLimitResult *historyLimitDiagnostics
 	var checkResult []invariant.InvariantCheckResult
 	var rootCauseResult []invariant.InvariantRootCauseResult
 
@@ -195,11 +249,53 @@ func (w *dw) DiagnosticsWorkflow(ctx workflow.Context, params DiagnosticsWorkflo
 		}
 	}
 
+	decisionIssues, err := retrieveDecisionIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveDecisionIssues: %w", err)
+	}
+
+	if len(decisionIssues) > 0 {
+		decisionResult = &decisionDiagnostics{
+			Issues:    decisionIssues,
+			RootCause: retrieveRootCause(rootCauseResult, decisionRootCauses),
+			Runbook:   linkToGeneralRunbook,
+		}
+	}
+
+	blockedIssues, err := retrieveBlockedIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveBlockedIssues: %w", err)
+	}
+
+	if len(blockedIssues) > 0 {
+		blockedResult = &blockedDiagnostics{
+			Issues:    blockedIssues,
+			RootCause: retrieveRootCause(rootCauseResult, blockedRootCauses),
+			Runbook:   linkToGeneralRunbook,
+		}
+	}
+
+	historyLimitIssues, err := retrieveHistoryLimitIssues(checkResult)
+	if err != nil {
+		return nil, fmt.Errorf("RetrieveHistoryLimitIssues: %w", err)
+	}
+
+	if len(historyLimitIssues) > 0 {
+		historyLimitResult = &historyLimitDiagnostics{
+			Issues:    historyLimitIssues,
+			RootCause: retrieveRootCause(rootCauseResult, []invariant.RootCause{invariant.RootCauseTypeHistoryNearLimit}),
+			Runbook:   linkToGeneralRunbook,
+		}
+	}
+
 	scope.IncCounter(metrics.DiagnosticsWorkflowSuccess)
 	return &DiagnosticsWorkflowResult{
-		Timeouts: timeoutsResult,
-		Failures: failureResult,
-		Retries:  retryResult,
+		Timeouts:      timeoutsResult,
+		Failures:      failureResult,
+		Retries:       retryResult,
+		Decisions:     decisionResult,
+		Blocked:       blockedResult,
+		HistoryLimits: historyLimitResult,
 	}, nil
 }
 
@@ -370,6 +466,110 @@ func retrieveRetryIssues(issues []invariant.InvariantCheckResult) ([]*retryIssue
 	return result, nil
 }
 
+var (
+	decisionRootCauses = []invariant.RootCause{
+		invariant.RootCauseTypeNonDeterministicWorkflow,
+		invariant.RootCauseTypeDecisionWorkerFailure,
+		invariant.RootCauseTypeInvalidDecisions,
+	}
+	blockedRootCauses = []invariant.RootCause{
+		invariant.RootCauseTypeUnconsumedSignals,
+		invariant.RootCauseTypeChildWorkflowStartPending,
+	}
+)
+
+func retrieveDecisionIssues(issues []invariant.InvariantCheckResult) ([]*decisionIssuesResult, error) {
+	result := make([]*decisionIssuesResult, 0)
+	for _, issue := range issues {
+		if issue.InvariantType == decision.DecisionTaskFailedRepeatedly.String() || issue.InvariantType == decision.DecisionTaskNonDeterministic.String() {
+			var data decision.DecisionFailureMetadata
+			err := json.Unmarshal(issue.Metadata, &data)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &decisionIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata:      data,
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrieveBlockedIssues(issues []invariant.InvariantCheckResult) ([]*blockedIssuesResult, error) {
+	result := make([]*blockedIssuesResult, 0)
+	for _, issue := range issues {
+		switch issue.InvariantType {
+		case blocked.UnconsumedSignals.String():
+			var metadata blocked.UnconsumedSignalsMetadata
+			err := json.Unmarshal(issue.Metadata, &metadata)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &blockedIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata: &blocked.BlockedIssuesMetadata{
+					UnconsumedSignals: &metadata,
+				},
+			})
+		case blocked.ChildWorkflowStartPending.String():
+			var metadata blocked.ChildWorkflowStartPendingMetadata
+			err := json.Unmarshal(issue.Metadata, &metadata)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &blockedIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata: &blocked.BlockedIssuesMetadata{
+					ChildWorkflowStartPending: &metadata,
+				},
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrieveHistoryLimitIssues(issues []invariant.InvariantCheckResult) ([]*historyLimitIssuesResult, error) {
+	result := make([]*historyLimitIssuesResult, 0)
+	for _, issue := range issues {
+		if issue.InvariantType == historylimit.HistorySizeLimit.String() || issue.InvariantType == historylimit.HistoryCountLimit.String() {
+			var data historylimit.HistoryLimitMetadata
+			err := json.Unmarshal(issue.Metadata, &data)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &historyLimitIssuesResult{
+				IssueID:       issue.IssueID,
+				InvariantType: issue.InvariantType,
+				Reason:        issue.Reason,
+				Metadata:      data,
+			})
+		}
+	}
+	return result, nil
+}
+
+func retrieveRootCause(rootCause []invariant.InvariantRootCauseResult, rootCauseTypes []invariant.RootCause) []*rootCauseResult {
+	result := make([]*rootCauseResult, 0)
+	for _, rc := range rootCause {
+		for _, t := range rootCauseTypes {
+			if rc.RootCause == t {
+				result = append(result, &rootCauseResult{
+					IssueID:       rc.IssueID,
+					RootCauseType: rc.RootCause.String(),
+				})
+			}
+		}
+	}
+	return result
+}
+
 func rootCauseHeartBeatRelated(rootCause invariant.RootCause) bool {
 	for _, rc := range []invariant.RootCause{invariant.RootCauseTypeNoHeartBeatTimeoutNoRetryPolicy,
 		invariant.RootCauseTypeHeartBeatingNotEnabledWithRetryPolicy,
@@ -383,7 +583,7 @@ func rootCauseHeartBeatRelated(rootCause invariant.RootCause) bool {
 }
 
 func rootCausePollersRelated(rootCause invariant.RootCause) bool {
-	for _, rc := range []invariant.RootCause{invariant.RootCauseTypePollersStatus, invariant.RootCauseTypeMissingPollers} {
+	for _, rc := range []invariant.RootCause{invariant.RootCauseTypePollersStatus, invariant.RootCauseTypeMissingPollers, invariant.RootCauseTypeStickyWorkerUnavailable} {
 		if rc == rootCause {
 			return true
 		}
@@ -41,7 +41,10 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/blocked"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/decision"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/failure"
+	"github.com/uber/cadence/service/worker/diagnostics/invariant/historylimit"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/retry"
 	"github.com/uber/cadence/service/worker/diagnostics/invariant/timeout"
 )
@@ -79,6 +82,10 @@ func (s *diagnosticsWorkflowTestSuite) SetupTest() {
 	s.workflowEnv.RegisterActivityWithOptions(s.dw.identifyIssues, activity.RegisterOptions{Name: identifyIssuesActivity})
 	s.workflowEnv.RegisterActivityWithOptions(s.dw.rootCauseIssues, activity.RegisterOptions{Name: rootCauseIssuesActivity})
 	s.workflowEnv.RegisterActivityWithOptions(s.dw.emitUsageLogs, activity.RegisterOptions{Name: emitUsageLogsActivity})
+	s.workflowEnv.RegisterWorkflowWithOptions(s.dw.DomainDiagnosticsWorkflow, workflow.RegisterOptions{Name: DomainDiagnosticsWorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(s.dw.sampleExecutions, activity.RegisterOptions{Name: sampleExecutionsActivity})
+	s.workflowEnv.RegisterActivityWithOptions(s.dw.diagnoseExecution, activity.RegisterOptions{Name: diagnoseExecutionActivity})
+	s.workflowEnv.RegisterActivityWithOptions(s.dw.emitDomainDiagnostics, activity.RegisterOptions{Name: emitDomainDiagnosticsActivity})
 }
 
 func (s *diagnosticsWorkflowTestSuite) TearDownTest() {
@@ -482,3 +489,148 @@ func (s *diagnosticsWorkflowTestSuite) Test__retrieveRetryIssues() {
 	s.NoError(err)
 	s.ElementsMatch(retryIssues, result)
 }
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveDecisionIssues() {
+	decisionMetadata := decision.DecisionFailureMetadata{
+		ScheduledEventID: 5,
+		FailedEventID:    7,
+		Attempts:         3,
+		Cause:            types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.Ptr(),
+	}
+	decisionMetadataInBytes, err := json.Marshal(decisionMetadata)
+	s.NoError(err)
+	issues := []invariant.InvariantCheckResult{
+		{
+			IssueID:       0,
+			InvariantType: decision.DecisionTaskFailedRepeatedly.String(),
+			Reason:        types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.String(),
+			Metadata:      decisionMetadataInBytes,
+		},
+		{
+			IssueID:       1,
+			InvariantType: retry.WorkflowRetryIssue.String(),
+		},
+	}
+	decisionIssues := []*decisionIssuesResult{
+		{
+			IssueID:       0,
+			InvariantType: decision.DecisionTaskFailedRepeatedly.String(),
+			Reason:        types.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure.String(),
+			Metadata:      decisionMetadata,
+		},
+	}
+	result, err := retrieveDecisionIssues(issues)
+	s.NoError(err)
+	s.ElementsMatch(decisionIssues, result)
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveBlockedIssues() {
+	signalsMetadata := blocked.UnconsumedSignalsMetadata{
+		SignalName:         "signal",
+		SignalCount:        2,
+		FirstSignalEventID: 5,
+		LastSignalEventID:  9,
+	}
+	signalsMetadataInBytes, err := json.Marshal(signalsMetadata)
+	s.NoError(err)
+	childMetadata := blocked.ChildWorkflowStartPendingMetadata{
+		InitiatedEventID: 6,
+		Domain:           "test-domain",
+		WorkflowID:       "child-wid",
+	}
+	childMetadataInBytes, err := json.Marshal(childMetadata)
+	s.NoError(err)
+	issues := []invariant.InvariantCheckResult{
+		{
+			IssueID:       0,
+			InvariantType: blocked.UnconsumedSignals.String(),
+			Reason:        "signal",
+			Metadata:      signalsMetadataInBytes,
+		},
+		{
+			IssueID:       1,
+			InvariantType: blocked.ChildWorkflowStartPending.String(),
+			Reason:        "child-wid",
+			Metadata:      childMetadataInBytes,
+		},
+	}
+	blockedIssues := []*blockedIssuesResult{
+		{
+			IssueID:       0,
+			InvariantType: blocked.UnconsumedSignals.String(),
+			Reason:        "signal",
+			Metadata: &blocked.BlockedIssuesMetadata{
+				UnconsumedSignals: &signalsMetadata,
+			},
+		},
+		{
+			IssueID:       1,
+			InvariantType: blocked.ChildWorkflowStartPending.String(),
+			Reason:        "child-wid",
+			Metadata: &blocked.BlockedIssuesMetadata{
+				ChildWorkflowStartPending: &childMetadata,
+			},
+		},
+	}
+	result, err := retrieveBlockedIssues(issues)
+	s.NoError(err)
+	s.ElementsMatch(blockedIssues, result)
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveHistoryLimitIssues() {
+	historyLimitMetadata := historylimit.HistoryLimitMetadata{
+		Value:      60,
+		WarnLimit:  50,
+		ErrorLimit: 100,
+	}
+	historyLimitMetadataInBytes, err := json.Marshal(historyLimitMetadata)
+	s.NoError(err)
+	issues := []invariant.InvariantCheckResult{
+		{
+			IssueID:       0,
+			InvariantType: historylimit.HistoryCountLimit.String(),
+			Reason:        historylimit.LimitWarnExceeded.String(),
+			Metadata:      historyLimitMetadataInBytes,
+		},
+	}
+	historyLimitIssues := []*historyLimitIssuesResult{
+		{
+			IssueID:       0,
+			InvariantType: historylimit.HistoryCountLimit.String(),
+			Reason:        historylimit.LimitWarnExceeded.String(),
+			Metadata:      historyLimitMetadata,
+		},
+	}
+	result, err := retrieveHistoryLimitIssues(issues)
+	s.NoError(err)
+	s.ElementsMatch(historyLimitIssues, result)
+}
+
+func (s *diagnosticsWorkflowTestSuite) Test__retrieveRootCause() {
+	rootCause := []invariant.InvariantRootCauseResult{
+		{
+			IssueID:   0,
+			RootCause: invariant.RootCauseTypeNonDeterministicWorkflow,
+		},
+		{
+			IssueID:   1,
+			RootCause: invariant.RootCauseTypeUnconsumedSignals,
+		},
+		{
+			IssueID:   2,
+			RootCause: invariant.RootCauseTypeMissingPollers,
+		},
+	}
+	s.ElementsMatch([]*rootCauseResult{
+		{
+			IssueID:       0,
+			RootCauseType: invariant.RootCauseTypeNonDeterministicWorkflow.String(),
+		},
+	}, retrieveRootCause(rootCause, decisionRootCauses))
+	s.ElementsMatch([]*rootCauseResult{
+		{
+			IssueID:       1,
+			RootCauseType: invariant.RootCauseTypeUnconsumedSignals.String(),
+		},
+	}, retrieveRootCause(rootCause, blockedRootCauses))
+}
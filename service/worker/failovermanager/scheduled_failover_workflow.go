@@ -0,0 +1,443 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failovermanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	guuid "github.com/google/uuid"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/replication"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ScheduledFailoverWorkflowTypeName is the registered workflow type for ScheduledFailoverWorkflow.
+	ScheduledFailoverWorkflowTypeName = "cadence-sys-scheduled-failover-workflow"
+	// ScheduledFailoverWorkflowIDPrefix prefixes the workflow ID of every scheduled failover. It is followed
+	// by the domain name so scheduled failovers can be listed per domain.
+	ScheduledFailoverWorkflowIDPrefix = "cadence-scheduled-failover-"
+	// ScheduledFailoverMemoKey is the memo key holding the ScheduledFailoverParams, so scheduled failovers
+	// can be listed without querying each workflow
+	ScheduledFailoverMemoKey = "ScheduledFailover"
+
+	checkFailoverPreconditionsActivityName     = "cadence-sys-checkFailoverPreconditions-activity"
+	scheduledFailoverActivityName              = "cadence-sys-scheduled-failover-activity"
+	recordScheduledFailoverOutcomeActivityName = "cadence-sys-recordScheduledFailoverOutcome-activity"
+
+	// ScheduledFailoverWaiting is the query state while waiting for the scheduled time
+	ScheduledFailoverWaiting = "waiting"
+	// ScheduledFailoverCheckingPreconditions is the query state while the preconditions are checked
+	ScheduledFailoverCheckingPreconditions = "checking preconditions"
+	// ScheduledFailoverFailingOver is the query state while the domain is failed over
+	ScheduledFailoverFailingOver = "failing over"
+	// ScheduledFailoverDone is the query state once the outcome is known
+	ScheduledFailoverDone = "done"
+
+	// ScheduledFailoverOutcomeSucceeded is the outcome when the domain was failed over
+	ScheduledFailoverOutcomeSucceeded = "succeeded"
+	// ScheduledFailoverOutcomePreconditionFailed is the outcome when a precondition did not hold at the scheduled time
+	ScheduledFailoverOutcomePreconditionFailed = "precondition failed"
+	// ScheduledFailoverOutcomeFailed is the outcome when the failover itself failed
+	ScheduledFailoverOutcomeFailed = "failed"
+	// ScheduledFailoverOutcomeCancelled is the outcome when the scheduled failover was cancelled
+	ScheduledFailoverOutcomeCancelled = "cancelled"
+
+	defaultScheduledFailoverReason = "scheduled failover"
+
+	errMsgScheduledDomainEmpty        = "domainName is empty"
+	errMsgScheduledTargetClusterEmpty = "one of targetCluster or activeClusters must be set"
+	errMsgScheduledNegativeLag        = "maxReplicationLagSeconds must not be negative"
+)
+
+type (
+	// ScheduledFailoverParams is the arg for ScheduledFailoverWorkflow.
+	ScheduledFailoverParams struct {
+		// DomainName is the domain to fail over.
+		DomainName string
+		// TargetCluster, when set, becomes the domain-level active cluster.
+		TargetCluster string
+		// ActiveClusters, when set, moves cluster attributes of an active-active domain.
+		ActiveClusters *types.ActiveClusters
+		// FailoverTimeoutSeconds, when > 0, makes the failover graceful.
+		FailoverTimeoutSeconds int32
+		// Reason is passed on to FailoverDomain and recorded with the outcome.
+		Reason string
+		// ScheduledTime is when the failover runs. A zero or past time runs it right away.
+		ScheduledTime time.Time
+		// Preconditions are checked at the scheduled time; the failover is skipped if any of them does not hold.
+		Preconditions FailoverPreconditions
+	}
+
+	// FailoverPreconditions are the conditions a scheduled failover checks before running.
+	FailoverPreconditions struct {
+		// MaxReplicationLagSeconds, when > 0, requires every target cluster to be at most this many seconds
+		// behind the current cluster. It can only be checked from the domain's active cluster.
+		MaxReplicationLagSeconds int
+		// RequireEmptyDLQ requires the replication DLQs of every target cluster to be empty.
+		RequireEmptyDLQ bool
+		// RequireHealthyTargetCluster requires every target cluster to be reachable with members in each ring.
+		RequireHealthyTargetCluster bool
+	}
+
+	// ScheduledFailoverResult is the result of ScheduledFailoverWorkflow.
+	ScheduledFailoverResult struct {
+		Outcome string
+		// Detail explains the outcome, e.g. which preconditions failed.
+		Detail string
+	}
+
+	// ScheduledFailoverQueryResult is returned by the QueryType query of ScheduledFailoverWorkflow.
+	ScheduledFailoverQueryResult struct {
+		DomainName    string
+		TargetCluster string
+		ScheduledTime time.Time
+		State         string
+		Outcome       string
+		Detail        string
+		Operator      string
+	}
+
+	// RecordScheduledFailoverOutcomeParams is the arg for RecordScheduledFailoverOutcomeActivity.
+	RecordScheduledFailoverOutcomeParams struct {
+		DomainName string
+		Operator   string
+		WorkflowID string
+		Result     ScheduledFailoverResult
+	}
+)
+
+// ScheduledFailoverWorkflowID returns the workflow ID for a scheduled failover of domainName. The suffix keeps
+// several scheduled failovers of the same domain apart.
+func ScheduledFailoverWorkflowID(domainName, suffix string) string {
+	return fmt.Sprintf("%s%s-%s", ScheduledFailoverWorkflowIDPrefix, domainName, suffix)
+}
+
+// ScheduledFailoverWorkflow fails a single domain over at a scheduled time, provided its preconditions hold
+// at that time. It can be cancelled until the failover starts. Every outcome, including cancellation, is
+// recorded in the domain audit log.
+func ScheduledFailoverWorkflow(ctx workflow.Context, params *ScheduledFailoverParams) (*ScheduledFailoverResult, error) {
+	if err := validateScheduledFailoverParams(params); err != nil {
+		return nil, err
+	}
+
+	state := ScheduledFailoverQueryResult{
+		DomainName:    params.DomainName,
+		TargetCluster: params.TargetCluster,
+		ScheduledTime: params.ScheduledTime,
+		State:         ScheduledFailoverWaiting,
+		Operator:      getOperator(ctx),
+	}
+	err := workflow.SetQueryHandler(ctx, QueryType, func() (*ScheduledFailoverQueryResult, error) {
+		return &state, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := runScheduledFailover(ctx, params, func(s string) { state.State = s })
+	state.State = ScheduledFailoverDone
+	state.Outcome = result.Outcome
+	state.Detail = result.Detail
+
+	// the outcome is recorded on a disconnected context so that cancellations are recorded as well
+	recordCtx, cancel := workflow.NewDisconnectedContext(ctx)
+	defer cancel()
+	recordCtx = workflow.WithActivityOptions(recordCtx, getScheduledFailoverActivityOptions())
+	recordParams := &RecordScheduledFailoverOutcomeParams{
+		DomainName: params.DomainName,
+		Operator:   state.Operator,
+		WorkflowID: workflow.GetInfo(ctx).WorkflowExecution.ID,
+		Result:     *result,
+	}
+	if err := workflow.ExecuteActivity(recordCtx, RecordScheduledFailoverOutcomeActivity, recordParams).Get(recordCtx, nil); err != nil {
+		workflow.GetLogger(ctx).Error("failed to record scheduled failover outcome", zap.Error(err))
+	}
+
+	if result.Outcome == ScheduledFailoverOutcomeCancelled {
+		return nil, ctx.Err()
+	}
+	return result, nil
+}
+
+func runScheduledFailover(ctx workflow.Context, params *ScheduledFailoverParams, setState func(string)) *ScheduledFailoverResult {
+	if wait := params.ScheduledTime.Sub(workflow.Now(ctx)); wait > 0 {
+		if err := workflow.Sleep(ctx, wait); err != nil {
+			return &ScheduledFailoverResult{Outcome: ScheduledFailoverOutcomeCancelled, Detail: "cancelled before the scheduled time"}
+		}
+	}
+
+	ao := workflow.WithActivityOptions(ctx, getScheduledFailoverActivityOptions())
+
+	setState(ScheduledFailoverCheckingPreconditions)
+	var violations []string
+	if err := workflow.ExecuteActivity(ao, CheckFailoverPreconditionsActivity, params).Get(ctx, &violations); err != nil {
+		return scheduledFailoverErrorResult(ctx, "failed to check preconditions", err)
+	}
+	if len(violations) > 0 {
+		return &ScheduledFailoverResult{Outcome: ScheduledFailoverOutcomePreconditionFailed, Detail: strings.Join(violations, "; ")}
+	}
+
+	setState(ScheduledFailoverFailingOver)
+	if err := workflow.ExecuteActivity(ao, ScheduledFailoverActivity, params).Get(ctx, nil); err != nil {
+		return scheduledFailoverErrorResult(ctx, "failover failed", err)
+	}
+	return &ScheduledFailoverResult{Outcome: ScheduledFailoverOutcomeSucceeded}
+}
+
+func scheduledFailoverErrorResult(ctx workflow.Context, msg string, err error) *ScheduledFailoverResult {
+	if ctx.Err() != nil {
+		return &ScheduledFailoverResult{Outcome: ScheduledFailoverOutcomeCancelled, Detail: fmt.Sprintf("cancelled: %v", err)}
+	}
+	return &ScheduledFailoverResult{Outcome: ScheduledFailoverOutcomeFailed, Detail: fmt.Sprintf("%s: %v", msg, err)}
+}
+
+// CheckFailoverPreconditionsActivity checks the preconditions of a scheduled failover and returns a
+// description of each one which does not hold.
+func CheckFailoverPreconditionsActivity(ctx context.Context, params *ScheduledFailoverParams) ([]string, error) {
+	manager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	preconditions := params.Preconditions
+
+	var violations []string
+	for _, targetCluster := range scheduledFailoverTargetClusters(params) {
+		if preconditions.RequireHealthyTargetCluster {
+			if err := checkClusterHealth(ctx, manager, targetCluster); err != nil {
+				violations = append(violations, fmt.Sprintf("cluster %s is not healthy: %v", targetCluster, err))
+			}
+		}
+		if preconditions.RequireEmptyDLQ {
+			count, err := countReplicationDLQMessages(ctx, manager, targetCluster)
+			if err != nil {
+				violations = append(violations, fmt.Sprintf("failed to count DLQ messages of cluster %s: %v", targetCluster, err))
+			} else if count > 0 {
+				violations = append(violations, fmt.Sprintf("cluster %s has %d DLQ messages", targetCluster, count))
+			}
+		}
+	}
+
+	if preconditions.MaxReplicationLagSeconds > 0 {
+		lagViolations, err := checkReplicationLag(ctx, manager, params)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, lagViolations...)
+	}
+	return violations, nil
+}
+
+// ScheduledFailoverActivity fails the domain over once the preconditions hold.
+func ScheduledFailoverActivity(ctx context.Context, params *ScheduledFailoverParams) error {
+	reason := params.Reason
+	if reason == "" {
+		reason = defaultScheduledFailoverReason
+	}
+	request := &types.FailoverDomainRequest{
+		DomainName:     params.DomainName,
+		ActiveClusters: params.ActiveClusters,
+		Reason:         common.StringPtr(reason),
+	}
+	if params.TargetCluster != "" {
+		request.DomainActiveClusterName = common.StringPtr(params.TargetCluster)
+	}
+	if params.FailoverTimeoutSeconds > 0 {
+		request.FailoverTimeoutInSeconds = common.Int32Ptr(params.FailoverTimeoutSeconds)
+	}
+	_, err := getClient(ctx).FailoverDomain(ctx, request)
+	return err
+}
+
+// RecordScheduledFailoverOutcomeActivity records the outcome of a scheduled failover in the domain audit log, so
+// it is listed by ListFailoverHistory next to the failover itself. The outcome is only logged when domain audit
+// logging is disabled.
+func RecordScheduledFailoverOutcomeActivity(ctx context.Context, params *RecordScheduledFailoverOutcomeParams) error {
+	manager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	if manager.domainAuditManager == nil || manager.cfg.EnableDomainAuditLogging == nil || !manager.cfg.EnableDomainAuditLogging() {
+		activity.GetLogger(ctx).Warn("domain audit logging is disabled, scheduled failover outcome is not recorded",
+			zap.String("domain", params.DomainName),
+			zap.String("outcome", params.Result.Outcome),
+			zap.String("detail", params.Result.Detail),
+			zap.String("workflowID", params.WorkflowID))
+		return nil
+	}
+
+	domain, err := manager.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: params.DomainName})
+	if err != nil {
+		return err
+	}
+	eventID, err := guuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	comment := fmt.Sprintf("scheduled failover %s", params.Result.Outcome)
+	if params.Result.Detail != "" {
+		comment = fmt.Sprintf("%s: %s", comment, params.Result.Detail)
+	}
+	_, err = manager.domainAuditManager.CreateDomainAuditLog(ctx, &persistence.CreateDomainAuditLogRequest{
+		DomainID:      domain.Info.ID,
+		EventID:       eventID.String(),
+		CreatedTime:   time.Unix(eventID.Time().UnixTime()),
+		StateBefore:   domain,
+		StateAfter:    domain,
+		OperationType: persistence.DomainAuditOperationTypeFailover,
+		Identity:      params.Operator,
+		IdentityType:  persistence.DomainAuditIdentityTypeScheduledFailover,
+		Comment:       fmt.Sprintf("%s (workflow %s)", comment, params.WorkflowID),
+	})
+	return err
+}
+
+// scheduledFailoverTargetClusters returns the sorted clusters the domain is moved onto.
+func scheduledFailoverTargetClusters(params *ScheduledFailoverParams) []string {
+	seen := make(map[string]struct{})
+	if params.TargetCluster != "" {
+		seen[params.TargetCluster] = struct{}{}
+	}
+	for _, scope := range params.ActiveClusters.GetAttributeScopes() {
+		for _, info := range scope.ClusterAttributes {
+			if info.ActiveClusterName != "" {
+				seen[info.ActiveClusterName] = struct{}{}
+			}
+		}
+	}
+	clusters := make([]string, 0, len(seen))
+	for cluster := range seen {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+	return clusters
+}
+
+func checkClusterHealth(ctx context.Context, manager *FailoverManager, cluster string) error {
+	adminClient, err := manager.clientBean.GetRemoteAdminClient(cluster)
+	if err != nil {
+		return err
+	}
+	resp, err := adminClient.DescribeCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if resp.MembershipInfo == nil {
+		return errors.New("no membership info")
+	}
+	for _, ring := range resp.MembershipInfo.Rings {
+		if ring != nil && ring.MemberCount == 0 {
+			return fmt.Errorf("no %s hosts", ring.Role)
+		}
+	}
+	return nil
+}
+
+func countReplicationDLQMessages(ctx context.Context, manager *FailoverManager, cluster string) (int64, error) {
+	adminClient, err := manager.clientBean.GetRemoteAdminClient(cluster)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := adminClient.CountDLQMessages(ctx, &types.CountDLQMessagesRequest{ForceFetch: true})
+	if err != nil {
+		return 0, err
+	}
+	// only the history tasks which came from the current cluster hold up this failover
+	count := resp.Domain
+	for _, c := range replication.DLQSizeByShard(resp, manager.cfg.ClusterMetadata.GetCurrentClusterName()) {
+		count += c
+	}
+	return count, nil
+}
+
+// checkReplicationLag compares the replication lag of every target cluster with MaxReplicationLagSeconds. The lag is
+// the age of the oldest replication task a target cluster has not acknowledged yet, as reported by the history hosts
+// owning the shards, counting only the tasks of the domain. When a shard has too many pending tasks to scan, it falls
+// back to the oldest task of any domain, which bounds the lag of the domain. Replication tasks are only created
+// where the domain is active, so that is the only place the lag can be measured.
+func checkReplicationLag(ctx context.Context, manager *FailoverManager, params *ScheduledFailoverParams) ([]string, error) {
+	resp, err := getClient(ctx).DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(params.DomainName)})
+	if err != nil {
+		return nil, err
+	}
+	currentCluster := manager.cfg.ClusterMetadata.GetCurrentClusterName()
+	if activeCluster := resp.ReplicationConfiguration.GetActiveClusterName(); activeCluster != currentCluster {
+		return []string{fmt.Sprintf("replication lag can only be checked from the active cluster %s, not %s", activeCluster, currentCluster)}, nil
+	}
+
+	shardIDs := make([]int32, 0, manager.cfg.NumHistoryShards)
+	for shardID := 0; shardID < manager.cfg.NumHistoryShards; shardID++ {
+		shardIDs = append(shardIDs, int32(shardID))
+	}
+	maxLag := time.Duration(params.Preconditions.MaxReplicationLagSeconds) * time.Second
+	var violations []string
+	for _, targetCluster := range scheduledFailoverTargetClusters(params) {
+		if targetCluster == currentCluster {
+			continue
+		}
+		activity.RecordHeartbeat(ctx, targetCluster)
+		status, err := manager.clientBean.GetHistoryClient().GetReplicationStatus(ctx, &types.GetReplicationStatusRequest{
+			ClusterName: targetCluster,
+			ShardIDs:    shardIDs,
+			DomainID:    resp.DomainInfo.GetUUID(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		statuses := make([]*types.ReplicationStatus, 0, len(status.GetShards()))
+		for _, shard := range status.GetShards() {
+			statuses = append(statuses, shard.GetStatus())
+		}
+		if lag := time.Duration(replication.Merge(statuses...).LagSeconds) * time.Second; lag > maxLag {
+			violations = append(violations, fmt.Sprintf("cluster %s is %v behind, more than %v", targetCluster, lag, maxLag))
+		}
+	}
+	return violations, nil
+}
+
+func validateScheduledFailoverParams(params *ScheduledFailoverParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if params.DomainName == "" {
+		return errors.New(errMsgScheduledDomainEmpty)
+	}
+	if params.TargetCluster == "" && len(params.ActiveClusters.GetAttributeScopes()) == 0 {
+		return errors.New(errMsgScheduledTargetClusterEmpty)
+	}
+	if params.Preconditions.MaxReplicationLagSeconds < 0 {
+		return errors.New(errMsgScheduledNegativeLag)
+	}
+	return nil
+}
+
+func getScheduledFailoverActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    5 * time.Minute,
+		HeartbeatTimeout:       time.Minute,
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright (c) 2017-2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failovermanager
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+func newScheduledFailoverWorkflowEnv() *testsuite.TestWorkflowEnvironment {
+	ts := &testsuite.WorkflowTestSuite{}
+	env := ts.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(ScheduledFailoverWorkflow, workflow.RegisterOptions{Name: ScheduledFailoverWorkflowTypeName})
+	env.RegisterActivityWithOptions(CheckFailoverPreconditionsActivity, activity.RegisterOptions{Name: checkFailoverPreconditionsActivityName})
+	env.RegisterActivityWithOptions(ScheduledFailoverActivity, activity.RegisterOptions{Name: scheduledFailoverActivityName})
+	env.RegisterActivityWithOptions(RecordScheduledFailoverOutcomeActivity, activity.RegisterOptions{Name: recordScheduledFailoverOutcomeActivityName})
+	return env
+}
+
+func newScheduledFailoverActivityEnv(t *testing.T) (*testsuite.TestActivityEnvironment, *resource.Test) {
+	ts := &testsuite.WorkflowTestSuite{}
+	env := ts.NewTestActivityEnvironment()
+	ctrl := gomock.NewController(t)
+	mockResource := resource.NewTest(t, ctrl, metrics.Worker)
+	mgr := &FailoverManager{
+		cfg: Config{
+			ClusterMetadata:          cluster.TestActiveClusterMetadata,
+			NumHistoryShards:         2,
+			EnableDomainAuditLogging: dynamicproperties.GetBoolPropertyFn(true),
+		},
+		clientBean:         mockResource.ClientBean,
+		domainManager:      mockResource.MetadataMgr,
+		domainAuditManager: mockResource.DomainAuditMgr,
+	}
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), failoverManagerContextKey, mgr),
+	})
+	env.RegisterActivityWithOptions(CheckFailoverPreconditionsActivity, activity.RegisterOptions{Name: checkFailoverPreconditionsActivityName})
+	env.RegisterActivityWithOptions(RecordScheduledFailoverOutcomeActivity, activity.RegisterOptions{Name: recordScheduledFailoverOutcomeActivityName})
+	t.Cleanup(func() { mockResource.Finish(t) })
+	return env, mockResource
+}
+
+func TestValidateScheduledFailoverParams(t *testing.T) {
+	tests := map[string]struct {
+		params  *ScheduledFailoverParams
+		wantErr string
+	}{
+		"nil params":         {params: nil, wantErr: errMsgParamsIsNil},
+		"empty domain":       {params: &ScheduledFailoverParams{TargetCluster: "c1"}, wantErr: errMsgScheduledDomainEmpty},
+		"no target":          {params: &ScheduledFailoverParams{DomainName: "d"}, wantErr: errMsgScheduledTargetClusterEmpty},
+		"negative lag":       {params: &ScheduledFailoverParams{DomainName: "d", TargetCluster: "c1", Preconditions: FailoverPreconditions{MaxReplicationLagSeconds: -1}}, wantErr: errMsgScheduledNegativeLag},
+		"target cluster set": {params: &ScheduledFailoverParams{DomainName: "d", TargetCluster: "c1"}},
+		"active clusters set": {params: &ScheduledFailoverParams{DomainName: "d", ActiveClusters: &types.ActiveClusters{
+			AttributeScopes: map[string]types.ClusterAttributeScope{
+				"region": {ClusterAttributes: map[string]types.ActiveClusterInfo{"us-west": {ActiveClusterName: "c1"}}},
+			},
+		}}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateScheduledFailoverParams(tc.params)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduledFailoverWorkflow_WhenPreconditionsHoldItFailsOverAtTheScheduledTime(t *testing.T) {
+	env := newScheduledFailoverWorkflowEnv()
+	scheduledTime := env.Now().Add(time.Hour)
+
+	var failoverTime time.Time
+	env.OnActivity(checkFailoverPreconditionsActivityName, mock.Anything, mock.Anything).Return([]string(nil), nil)
+	env.OnActivity(scheduledFailoverActivityName, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { failoverTime = env.Now() }).
+		Return(nil)
+	var recorded *RecordScheduledFailoverOutcomeParams
+	env.OnActivity(recordScheduledFailoverOutcomeActivityName, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { recorded = args.Get(1).(*RecordScheduledFailoverOutcomeParams) }).
+		Return(nil)
+
+	env.ExecuteWorkflow(ScheduledFailoverWorkflowTypeName, &ScheduledFailoverParams{
+		DomainName:    "d1",
+		TargetCluster: "cluster1",
+		ScheduledTime: scheduledTime,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result ScheduledFailoverResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, ScheduledFailoverOutcomeSucceeded, result.Outcome)
+	assert.False(t, failoverTime.Before(scheduledTime))
+	require.NotNil(t, recorded)
+	assert.Equal(t, "d1", recorded.DomainName)
+	assert.Equal(t, ScheduledFailoverOutcomeSucceeded, recorded.Result.Outcome)
+}
+
+func TestScheduledFailoverWorkflow_WhenAPreconditionFailsItSkipsTheFailover(t *testing.T) {
+	env := newScheduledFailoverWorkflowEnv()
+
+	env.OnActivity(checkFailoverPreconditionsActivityName, mock.Anything, mock.Anything).
+		Return([]string{"cluster cluster1 has 3 DLQ messages"}, nil)
+	env.OnActivity(recordScheduledFailoverOutcomeActivityName, mock.Anything, mock.Anything).Return(nil)
+
+	env.ExecuteWorkflow(ScheduledFailoverWorkflowTypeName, &ScheduledFailoverParams{
+		DomainName:    "d1",
+		TargetCluster: "cluster1",
+		Preconditions: FailoverPreconditions{RequireEmptyDLQ: true},
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result ScheduledFailoverResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, ScheduledFailoverOutcomePreconditionFailed, result.Outcome)
+	assert.Equal(t, "cluster cluster1 has 3 DLQ messages", result.Detail)
+	env.AssertNotCalled(t, scheduledFailoverActivityName, mock.Anything, mock.Anything)
+}
+
+func TestScheduledFailoverWorkflow_WhenTheFailoverFailsItRecordsTheFailure(t *testing.T) {
+	env := newScheduledFailoverWorkflowEnv()
+
+	env.OnActivity(checkFailoverPreconditionsActivityName, mock.Anything, mock.Anything).Return([]string(nil), nil)
+	env.OnActivity(scheduledFailoverActivityName, mock.Anything, mock.Anything).Return(errors.New("boom"))
+	var recorded *RecordScheduledFailoverOutcomeParams
+	env.OnActivity(recordScheduledFailoverOutcomeActivityName, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { recorded = args.Get(1).(*RecordScheduledFailoverOutcomeParams) }).
+		Return(nil)
+
+	env.ExecuteWorkflow(ScheduledFailoverWorkflowTypeName, &ScheduledFailoverParams{DomainName: "d1", TargetCluster: "cluster1"})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.NotNil(t, recorded)
+	assert.Equal(t, ScheduledFailoverOutcomeFailed, recorded.Result.Outcome)
+	assert.Contains(t, recorded.Result.Detail, "boom")
+}
+
+func TestScheduledFailoverWorkflow_WhenCancelledBeforeTheScheduledTimeItRecordsTheCancellation(t *testing.T) {
+	env := newScheduledFailoverWorkflowEnv()
+
+	var recorded *RecordScheduledFailoverOutcomeParams
+	env.OnActivity(recordScheduledFailoverOutcomeActivityName, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { recorded = args.Get(1).(*RecordScheduledFailoverOutcomeParams) }).
+		Return(nil)
+	env.RegisterDelayedCallback(env.CancelWorkflow, time.Minute)
+
+	env.ExecuteWorkflow(ScheduledFailoverWorkflowTypeName, &ScheduledFailoverParams{
+		DomainName:    "d1",
+		TargetCluster: "cluster1",
+		ScheduledTime: env.Now().Add(time.Hour),
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	assert.Error(t, env.GetWorkflowError())
+	require.NotNil(t, recorded)
+	assert.Equal(t, ScheduledFailoverOutcomeCancelled, recorded.Result.Outcome)
+	env.AssertNotCalled(t, checkFailoverPreconditionsActivityName, mock.Anything, mock.Anything)
+	env.AssertNotCalled(t, scheduledFailoverActivityName, mock.Anything, mock.Anything)
+}
+
+func TestScheduledFailoverWorkflow_WhenQueriedItReturnsTheState(t *testing.T) {
+	env := newScheduledFailoverWorkflowEnv()
+	scheduledTime := env.Now().Add(time.Hour)
+
+	env.OnActivity(checkFailoverPreconditionsActivityName, mock.Anything, mock.Anything).Return([]string(nil), nil)
+	env.OnActivity(scheduledFailoverActivityName, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(recordScheduledFailoverOutcomeActivityName, mock.Anything, mock.Anything).Return(nil)
+	env.RegisterDelayedCallback(func() {
+		encoded, err := env.QueryWorkflow(QueryType)
+		require.NoError(t, err)
+		var state ScheduledFailoverQueryResult
+		require.NoError(t, encoded.Get(&state))
+		assert.Equal(t, ScheduledFailoverWaiting, state.State)
+		assert.Equal(t, "d1", state.DomainName)
+	}, time.Minute)
+
+	env.ExecuteWorkflow(ScheduledFailoverWorkflowTypeName, &ScheduledFailoverParams{
+		DomainName:    "d1",
+		TargetCluster: "cluster1",
+		ScheduledTime: scheduledTime,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	encoded, err := env.QueryWorkflow(QueryType)
+	require.NoError(t, err)
+	var state ScheduledFailoverQueryResult
+	require.NoError(t, encoded.Get(&state))
+	assert.Equal(t, ScheduledFailoverDone, state.State)
+	assert.Equal(t, ScheduledFailoverOutcomeSucceeded, state.Outcome)
+}
+
+func TestCheckFailoverPreconditionsActivity_WhenTargetIsUnhealthyOrHasDLQMessagesItReportsViolations(t *testing.T) {
+	env, mockResource := newScheduledFailoverActivityEnv(t)
+	mockResource.RemoteAdminClient.EXPECT().DescribeCluster(gomock.Any()).Return(&types.DescribeClusterResponse{
+		MembershipInfo: &types.MembershipInfo{Rings: []*types.RingInfo{
+			{Role: "frontend", MemberCount: 2},
+			{Role: "history", MemberCount: 0},
+		}},
+	}, nil)
+	mockResource.RemoteAdminClient.EXPECT().CountDLQMessages(gomock.Any(), &types.CountDLQMessagesRequest{ForceFetch: true}).
+		Return(&types.CountDLQMessagesResponse{
+			History: map[types.HistoryDLQCountKey]int64{{ShardID: 1, SourceCluster: "active"}: 2},
+			Domain:  1,
+		}, nil)
+
+	result, err := env.ExecuteActivity(checkFailoverPreconditionsActivityName, &ScheduledFailoverParams{
+		DomainName:    "d1",
+		TargetCluster: "standby",
+		Preconditions: FailoverPreconditions{RequireEmptyDLQ: true, RequireHealthyTargetCluster: true},
+	})
+	require.NoError(t, err)
+	var violations []string
+	require.NoError(t, result.Get(&violations))
+	assert.Equal(t, []string{
+		"cluster standby is not healthy: no history hosts",
+		"cluster standby has 3 DLQ messages",
+	}, violations)
+}
+
+func TestCheckFailoverPreconditionsActivity_WhenReplicationLagIsTooHighItReportsAViolation(t *testing.T) {
+	env, mockResource := newScheduledFailoverActivityEnv(t)
+	mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		DomainInfo:               &types.DomainInfo{Name: "d1", UUID: "domain-id"},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: cluster.TestCurrentClusterName},
+	}, nil)
+	mockResource.HistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &types.GetReplicationStatusRequest{
+		ClusterName: cluster.TestAlternativeClusterName,
+		ShardIDs:    []int32{0, 1},
+		DomainID:    "domain-id",
+	}).Return(&types.GetReplicationStatusResponse{
+		Shards: []*types.ShardReplicationStatus{
+			{ShardID: 0, Status: &types.ReplicationStatus{AckedTaskID: 10, MaxTaskID: 10}},
+			{ShardID: 1, Status: &types.ReplicationStatus{AckedTaskID: 20, MaxTaskID: 25, TaskLag: 5, LagSeconds: 120}},
+		},
+	}, nil)
+
+	result, err := env.ExecuteActivity(checkFailoverPreconditionsActivityName, &ScheduledFailoverParams{
+		DomainName:    "d1",
+		TargetCluster: cluster.TestAlternativeClusterName,
+		Preconditions: FailoverPreconditions{MaxReplicationLagSeconds: 60},
+	})
+	require.NoError(t, err)
+	var violations []string
+	require.NoError(t, result.Get(&violations))
+	assert.Equal(t, []string{"cluster standby is 2m0s behind, more than 1m0s"}, violations)
+}
+
+func TestCheckFailoverPreconditionsActivity_WhenReplicationLagIsAtTheMaximumItReportsNoViolation(t *testing.T) {
+	env, mockResource := newScheduledFailoverActivityEnv(t)
+	mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		DomainInfo:               &types.DomainInfo{Name: "d1", UUID: "domain-id"},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: cluster.TestCurrentClusterName},
+	}, nil)
+	mockResource.HistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), gomock.Any()).Return(&types.GetReplicationStatusResponse{
+		Shards: []*types.ShardReplicationStatus{
+			{ShardID: 1, Status: &types.ReplicationStatus{AckedTaskID: 20, MaxTaskID: 25, TaskLag: 5, LagSeconds: 60}},
+		},
+	}, nil)
+
+	result, err := env.ExecuteActivity(checkFailoverPreconditionsActivityName, &ScheduledFailoverParams{
+		DomainName:    "d1",
+		TargetCluster: cluster.TestAlternativeClusterName,
+		Preconditions: FailoverPreconditions{MaxReplicationLagSeconds: 60},
+	})
+	require.NoError(t, err)
+	var violations []string
+	require.NoError(t, result.Get(&violations))
+	assert.Empty(t, violations)
+}
+
+func TestCheckFailoverPreconditionsActivity_WhenNotOnTheActiveClusterItCannotCheckLag(t *testing.T) {
+	env, mockResource := newScheduledFailoverActivityEnv(t)
+	mockResource.FrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: cluster.TestAlternativeClusterName},
+	}, nil)
+
+	result, err := env.ExecuteActivity(checkFailoverPreconditionsActivityName, &ScheduledFailoverParams{
+		DomainName:    "d1",
+		TargetCluster: cluster.TestCurrentClusterName,
+		Preconditions: FailoverPreconditions{MaxReplicationLagSeconds: 60},
+	})
+	require.NoError(t, err)
+	var violations []string
+	require.NoError(t, result.Get(&violations))
+	require.Len(t, violations, 1)
+	assert.Contains(t, violations[0], "can only be checked from the active cluster")
+}
+
+func TestRecordScheduledFailoverOutcomeActivity_WhenAuditLoggingIsEnabledItWritesAnAuditEntry(t *testing.T) {
+	env, mockResource := newScheduledFailoverActivityEnv(t)
+	domain := &persistence.GetDomainResponse{Info: &persistence.DomainInfo{ID: "domain-id", Name: "d1"}}
+	mockResource.MetadataMgr.On("GetDomain", mock.Anything, &persistence.GetDomainRequest{Name: "d1"}).Return(domain, nil)
+	mockResource.DomainAuditMgr.EXPECT().CreateDomainAuditLog(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *persistence.CreateDomainAuditLogRequest) (*persistence.CreateDomainAuditLogResponse, error) {
+			assert.Equal(t, "domain-id", req.DomainID)
+			assert.Equal(t, domain, req.StateBefore)
+			assert.Equal(t, domain, req.StateAfter)
+			assert.Equal(t, persistence.DomainAuditOperationTypeFailover, req.OperationType)
+			assert.Equal(t, persistence.DomainAuditIdentityTypeScheduledFailover, req.IdentityType)
+			assert.Equal(t, "operator", req.Identity)
+			assert.Equal(t, "scheduled failover precondition failed: lagging (workflow wid)", req.Comment)
+			return &persistence.CreateDomainAuditLogResponse{EventID: req.EventID}, nil
+		})
+
+	_, err := env.ExecuteActivity(recordScheduledFailoverOutcomeActivityName, &RecordScheduledFailoverOutcomeParams{
+		DomainName: "d1",
+		Operator:   "operator",
+		WorkflowID: "wid",
+		Result:     ScheduledFailoverResult{Outcome: ScheduledFailoverOutcomePreconditionFailed, Detail: "lagging"},
+	})
+	require.NoError(t, err)
+}
+
+func TestRecordScheduledFailoverOutcomeActivity_WhenAuditLoggingIsDisabledItLogsTheOutcome(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	ts := &testsuite.WorkflowTestSuite{}
+	ts.SetLogger(zap.New(core))
+	env := ts.NewTestActivityEnvironment()
+	mgr := &FailoverManager{
+		cfg: Config{
+			EnableDomainAuditLogging: dynamicproperties.GetBoolPropertyFn(false),
+		},
+	}
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), failoverManagerContextKey, mgr),
+	})
+	env.RegisterActivityWithOptions(RecordScheduledFailoverOutcomeActivity, activity.RegisterOptions{Name: recordScheduledFailoverOutcomeActivityName})
+
+	_, err := env.ExecuteActivity(recordScheduledFailoverOutcomeActivityName, &RecordScheduledFailoverOutcomeParams{
+		DomainName: "d1",
+		WorkflowID: "wid",
+		Result:     ScheduledFailoverResult{Outcome: ScheduledFailoverOutcomeCancelled},
+	})
+	require.NoError(t, err)
+	entries := logs.FilterMessageSnippet("scheduled failover outcome is not recorded").AllUntimed()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "d1", entries[0].ContextMap()["domain"])
+	assert.Equal(t, ScheduledFailoverOutcomeCancelled, entries[0].ContextMap()["outcome"])
+	assert.Equal(t, "wid", entries[0].ContextMap()["workflowID"])
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 )
 
 type (
@@ -46,6 +47,10 @@ type (
 		AdminOperationToken dynamicproperties.StringPropertyFn
 		// ClusterMetadata contains the metadata for this cluster
 		ClusterMetadata cluster.Metadata
+		// NumHistoryShards is used by scheduled failovers to measure replication lag
+		NumHistoryShards int
+		// EnableDomainAuditLogging controls whether scheduled failover outcomes are recorded
+		EnableDomainAuditLogging dynamicproperties.BoolPropertyFn
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -62,6 +67,9 @@ type (
 		TallyScope tally.Scope
 		// ClientBean is an instance of client.Bean for a collection of clients
 		ClientBean client.Bean
+		// DomainManager and DomainAuditManager are used to record scheduled failover outcomes
+		DomainManager      persistence.DomainManager
+		DomainAuditManager persistence.DomainAuditManager
 	}
 
 	// FailoverManager of cadence worker service
@@ -73,6 +81,9 @@ type (
 		tallyScope    tally.Scope
 		logger        log.Logger
 		worker        worker.Worker
+
+		domainManager      persistence.DomainManager
+		domainAuditManager persistence.DomainAuditManager
 	}
 )
 
@@ -85,6 +96,9 @@ func New(params *BootstrapParams) *FailoverManager {
 		tallyScope:    params.TallyScope,
 		logger:        params.Logger.WithTags(tag.ComponentBatcher),
 		clientBean:    params.ClientBean,
+
+		domainManager:      params.DomainManager,
+		domainAuditManager: params.DomainAuditManager,
 	}
 }
 
@@ -110,6 +124,11 @@ func (s *FailoverManager) Start() error {
 	failoverWorker.RegisterActivityWithOptions(FailoverActivityV2, activity.RegisterOptions{Name: failoverActivityV2Name})
 	failoverWorker.RegisterActivityWithOptions(GetDomainsForFailoverV2Activity, activity.RegisterOptions{Name: getDomainsForFailoverV2ActivityName})
 	failoverWorker.RegisterActivityWithOptions(GetDomainsForRebalanceV2Activity, activity.RegisterOptions{Name: getDomainsForRebalanceV2ActivityName})
+
+	failoverWorker.RegisterWorkflowWithOptions(ScheduledFailoverWorkflow, workflow.RegisterOptions{Name: ScheduledFailoverWorkflowTypeName})
+	failoverWorker.RegisterActivityWithOptions(CheckFailoverPreconditionsActivity, activity.RegisterOptions{Name: checkFailoverPreconditionsActivityName})
+	failoverWorker.RegisterActivityWithOptions(ScheduledFailoverActivity, activity.RegisterOptions{Name: scheduledFailoverActivityName})
+	failoverWorker.RegisterActivityWithOptions(RecordScheduledFailoverOutcomeActivity, activity.RegisterOptions{Name: recordScheduledFailoverOutcomeActivityName})
 	s.worker = failoverWorker
 	return failoverWorker.Start()
 }
@@ -26,6 +26,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	cclient "go.uber.org/cadence/client"
@@ -123,6 +124,9 @@ func concreteExecutionScannerManager(
 	for _, fn := range ConcreteExecutionType.ToInvariants(collections, zap.NewNop()) {
 		ivs = append(ivs, fn(pr, domainCache))
 	}
+	for _, fn := range ConcreteExecutionType.ToRegisteredInvariants(collections, params.ScannerConfig, zap.NewNop()) {
+		ivs = append(ivs, fn(pr, domainCache))
+	}
 
 	return invariant.NewInvariantManager(ivs)
 }
@@ -150,6 +154,9 @@ func concreteExecutionFixerManager(_ context.Context, pr persistence.Retryer, pa
 	// or if the list came from a previous version of the server which lacked this config.
 	var collections []invariant.Collection
 	for k, v := range params.EnabledInvariants {
+		if strings.HasPrefix(k, customInvariantKeyPrefix) {
+			continue // registered invariants are selected below
+		}
 		if v == strconv.FormatBool(true) {
 			ivc, err := invariant.CollectionString(k)
 			if err != nil {
@@ -163,6 +170,9 @@ func concreteExecutionFixerManager(_ context.Context, pr persistence.Retryer, pa
 	for _, fn := range ConcreteExecutionType.ToInvariants(collections, zap.NewNop()) {
 		ivs = append(ivs, fn(pr, domainCache))
 	}
+	for _, fn := range ConcreteExecutionType.ToRegisteredInvariants(collections, params.EnabledInvariants, zap.NewNop()) {
+		ivs = append(ivs, fn(pr, domainCache))
+	}
 	return invariant.NewInvariantManager(ivs)
 }
 
@@ -179,6 +189,11 @@ func concreteExecutionCustomScannerConfig(ctx shardscanner.ScannerContext) shard
 	if ctx.Config.DynamicCollection.GetBoolProperty(dynamicproperties.ConcreteExecutionsScannerInvariantCollectionStale)() {
 		res[invariant.CollectionStale.String()] = strconv.FormatBool(true)
 	}
+	ConcreteExecutionType.customInvariantsConfig(
+		res,
+		ctx.Config.DynamicCollection.GetMapProperty(dynamicproperties.ConcreteExecutionsScannerCustomInvariants)(),
+		false,
+	)
 
 	return res
 }
@@ -197,6 +212,11 @@ func concreteExecutionCustomFixerConfig(ctx shardscanner.FixerContext) shardscan
 	res[invariant.CollectionStale.String()] = strconv.FormatBool(
 		ctx.Config.DynamicCollection.GetBoolProperty(dynamicproperties.ConcreteExecutionsFixerInvariantCollectionStale)(),
 	)
+	ConcreteExecutionType.customInvariantsConfig(
+		res,
+		ctx.Config.DynamicCollection.GetMapProperty(dynamicproperties.ConcreteExecutionsFixerCustomInvariants)(),
+		true,
+	)
 
 	return res
 }
@@ -29,13 +29,16 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/cadence/testsuite"
 	"go.uber.org/cadence/workflow"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
@@ -451,6 +454,7 @@ func Test_concreteExecutionCustomScannerConfig(t *testing.T) {
 	collection := dynamicconfig.NewCollection(mockClient, log.NewNoop())
 
 	mockClient.EXPECT().GetBoolValue(gomock.Any(), gomock.Any()).Return(true, nil).Times(3)
+	mockClient.EXPECT().GetMapValue(gomock.Any(), gomock.Any()).Return(map[string]any{}, nil).Times(1)
 
 	ctx := shardscanner.ScannerContext{
 		Config: &shardscanner.ScannerConfig{
@@ -473,6 +477,7 @@ func Test_concreteExecutionCustomFixerConfig(t *testing.T) {
 	collection := dynamicconfig.NewCollection(mockClient, log.NewNoop())
 
 	mockClient.EXPECT().GetBoolValue(gomock.Any(), gomock.Any()).Return(true, nil).Times(3)
+	mockClient.EXPECT().GetMapValue(gomock.Any(), gomock.Any()).Return(map[string]any{}, nil).Times(1)
 
 	ctx := shardscanner.FixerContext{
 		Config: &shardscanner.ScannerConfig{
@@ -489,6 +494,74 @@ func Test_concreteExecutionCustomFixerConfig(t *testing.T) {
 	assert.Equal(t, "true", cfg[invariant.CollectionStale.String()])
 }
 
+func Test_concreteExecutionCustomInvariants(t *testing.T) {
+	const (
+		enabledName  invariant.Name = "test_enabled_invariant"
+		disabledName invariant.Name = "test_disabled_invariant"
+	)
+	newInvariant := func(name invariant.Name) invariant.Factory {
+		return func(persistence.Retryer, cache.DomainCache, *zap.Logger) invariant.Invariant {
+			iv := invariant.NewMockInvariant(gomock.NewController(t))
+			iv.EXPECT().Name().Return(name).AnyTimes()
+			return iv
+		}
+	}
+	for _, name := range []invariant.Name{enabledName, disabledName} {
+		deregister, err := invariant.RegisterForTest(invariant.Registration{
+			Name:       name,
+			Collection: invariant.CollectionMutableState,
+			Entity:     invariant.EntityConcreteExecution,
+			Factory:    newInvariant(name),
+		})
+		require.NoError(t, err)
+		t.Cleanup(deregister)
+	}
+
+	mockClient := dynamicconfig.NewMockClient(gomock.NewController(t))
+	collection := dynamicconfig.NewCollection(mockClient, log.NewNoop())
+	mockClient.EXPECT().GetBoolValue(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+	mockClient.EXPECT().GetMapValue(gomock.Any(), gomock.Any()).Return(map[string]any{
+		string(enabledName):  true,
+		string(disabledName): false,
+		"not_registered":     true,
+	}, nil).AnyTimes()
+
+	scannerCfg := concreteExecutionCustomScannerConfig(shardscanner.ScannerContext{
+		Config: &shardscanner.ScannerConfig{DynamicCollection: collection},
+	})
+	assert.Equal(t, "true", scannerCfg["invariant:test_enabled_invariant"])
+	assert.NotContains(t, scannerCfg, "invariant:test_disabled_invariant")
+	assert.NotContains(t, scannerCfg, "invariant:not_registered")
+
+	fixerCfg := concreteExecutionCustomFixerConfig(shardscanner.FixerContext{
+		Config: &shardscanner.ScannerConfig{DynamicCollection: collection},
+	})
+	assert.Equal(t, "true", fixerCfg["invariant:test_enabled_invariant"])
+	assert.Equal(t, "false", fixerCfg["invariant:test_disabled_invariant"])
+
+	fns := ConcreteExecutionType.ToRegisteredInvariants(ParseCollections(scannerCfg), scannerCfg, zap.NewNop())
+	if assert.Len(t, fns, 1) {
+		assert.Equal(t, enabledName, fns[0](nil, nil).Name())
+	}
+	// the invariant is not selected when its collection is disabled
+	fns = ConcreteExecutionType.ToRegisteredInvariants([]invariant.Collection{invariant.CollectionHistory}, scannerCfg, zap.NewNop())
+	assert.Empty(t, fns)
+	// registered invariants don't trip the unknown collection check of the fixer
+	assert.NotPanics(t, func() {
+		concreteExecutionFixerManager(context.Background(), nil, shardscanner.FixShardActivityParams{EnabledInvariants: fixerCfg}, nil)
+	})
+}
+
+func TestParseCustomInvariants(t *testing.T) {
+	got := ParseCustomInvariants(shardscanner.CustomScannerConfig{
+		"invariant:enabled":                  "true",
+		"invariant:disabled":                 "false",
+		"invariant:malformed":                "yes",
+		invariant.CollectionHistory.String(): "true",
+	})
+	assert.Equal(t, map[invariant.Name]bool{"enabled": true}, got)
+}
+
 func TestConcreteExecutionConfig(t *testing.T) {
 	mockClient := dynamicconfig.NewMockClient(gomock.NewController(t))
 
@@ -115,6 +115,9 @@ func currentExecutionScannerManager(
 	for _, fn := range CurrentExecutionType.ToInvariants(collections, zap.NewNop()) {
 		ivs = append(ivs, fn(pr, domainCache))
 	}
+	for _, fn := range CurrentExecutionType.ToRegisteredInvariants(collections, params.ScannerConfig, zap.NewNop()) {
+		ivs = append(ivs, fn(pr, domainCache))
+	}
 	return invariant.NewInvariantManager(ivs)
 }
 
@@ -140,6 +143,11 @@ func currentExecutionCustomScannerConfig(ctx shardscanner.ScannerContext) shards
 	if ctx.Config.DynamicCollection.GetBoolProperty(dynamicproperties.CurrentExecutionsScannerInvariantCollectionMutableState)() {
 		res[invariant.CollectionMutableState.String()] = strconv.FormatBool(true)
 	}
+	CurrentExecutionType.customInvariantsConfig(
+		res,
+		ctx.Config.DynamicCollection.GetMapProperty(dynamicproperties.CurrentExecutionsScannerCustomInvariants)(),
+		false,
+	)
 
 	return res
 }
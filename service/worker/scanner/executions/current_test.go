@@ -334,6 +334,7 @@ func Test_currentExecutionCustomScannerConfig(t *testing.T) {
 	collection := dynamicconfig.NewCollection(mockClient, log.NewNoop())
 
 	mockClient.EXPECT().GetBoolValue(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+	mockClient.EXPECT().GetMapValue(gomock.Any(), gomock.Any()).Return(map[string]any{}, nil).Times(1)
 
 	ctx := shardscanner.ScannerContext{
 		Config: &shardscanner.ScannerConfig{
@@ -27,6 +27,7 @@ package executions
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -46,6 +47,10 @@ const (
 	CurrentExecutionType
 )
 
+// customInvariantKeyPrefix prefixes the CustomScannerConfig keys which enable registered invariants,
+// to tell them apart from collection keys.
+const customInvariantKeyPrefix = "invariant:"
+
 // ScanType is the enum for representing different entity types to scan
 type ScanType int
 
@@ -125,6 +130,55 @@ func (st ScanType) ToInvariants(collections []invariant.Collection, logger *zap.
 	}
 }
 
+// ToRegisteredInvariants returns the registered invariants of the collections which are enabled in params.
+func (st ScanType) ToRegisteredInvariants(collections []invariant.Collection, params shardscanner.CustomScannerConfig, logger *zap.Logger) []InvariantFactory {
+	enabled := ParseCustomInvariants(params)
+	var fns []InvariantFactory
+	for _, r := range invariant.Select(st.toEntity(), collections, func(name invariant.Name) bool { return enabled[name] }) {
+		fns = append(fns, func(pr persistence.Retryer, dc cache.DomainCache) invariant.Invariant {
+			return r.Factory(pr, dc, logger.Named(string(r.Name)))
+		})
+	}
+	return fns
+}
+
+func (st ScanType) toEntity() invariant.Entity {
+	switch st {
+	case ConcreteExecutionType:
+		return invariant.EntityConcreteExecution
+	case CurrentExecutionType:
+		return invariant.EntityCurrentExecution
+	default:
+		panic("unknown scan type")
+	}
+}
+
+// ParseCustomInvariants returns the names of registered invariants enabled in a string based map
+func ParseCustomInvariants(params shardscanner.CustomScannerConfig) map[invariant.Name]bool {
+	enabled := make(map[invariant.Name]bool)
+	for k, v := range params {
+		name, ok := strings.CutPrefix(k, customInvariantKeyPrefix)
+		if !ok {
+			continue
+		}
+		if on, err := strconv.ParseBool(v); on && err == nil {
+			enabled[invariant.Name(name)] = true
+		}
+	}
+	return enabled
+}
+
+// customInvariantsConfig adds the registered invariants of st to res, enabled according to the dynamic config value.
+// When includeDisabled is set, disabled invariants are added with a "false" value, as fixers expect.
+func (st ScanType) customInvariantsConfig(res shardscanner.CustomScannerConfig, enabled map[string]interface{}, includeDisabled bool) {
+	for _, r := range invariant.Registered(st.toEntity()) {
+		on, _ := enabled[string(r.Name)].(bool)
+		if on || includeDisabled {
+			res[customInvariantKeyPrefix+string(r.Name)] = strconv.FormatBool(on)
+		}
+	}
+}
+
 // ParseCollections converts string based map to list of collections
 func ParseCollections(params shardscanner.CustomScannerConfig) []invariant.Collection {
 	var collections []invariant.Collection
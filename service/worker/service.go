@@ -54,6 +54,7 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
 	"github.com/uber/cadence/service/worker/scheduler"
+	"github.com/uber/cadence/service/worker/visibilityrebuild"
 )
 
 type (
@@ -90,6 +91,7 @@ type (
 		EnableParentClosePolicyWorker       dynamicproperties.BoolPropertyFn
 		NumParentClosePolicySystemWorkflows dynamicproperties.IntPropertyFn
 		EnableFailoverManager               dynamicproperties.BoolPropertyFn
+		EnableVisibilityRebuild             dynamicproperties.BoolPropertyFn
 		DomainReplicationMaxRetryDuration   dynamicproperties.DurationPropertyFn
 		EnableESAnalyzer                    dynamicproperties.BoolPropertyFn
 		EnableAsyncWorkflowConsumption      dynamicproperties.BoolPropertyFn
@@ -101,16 +103,29 @@ type (
 // NewService builds a new cadence-worker service
 func NewService(params *resource.Params) (resource.Resource, error) {
 	serviceConfig := NewConfig(params)
+	resourceConfig := &service.Config{
+		PersistenceMaxQPS:        serviceConfig.PersistenceMaxQPS,
+		PersistenceGlobalMaxQPS:  serviceConfig.PersistenceGlobalMaxQPS,
+		ThrottledLoggerMaxRPS:    serviceConfig.ThrottledLogRPS,
+		IsErrorRetryableFunction: common.IsServiceTransientError,
+		// worker service doesn't need visibility config as it never call visibilityManager API,
+		// except for the visibility rebuild and consistency check which read and write records
+	}
+	if serviceConfig.EnableVisibilityRebuild() {
+		dc := params.DynamicCollection
+		resourceConfig.WriteVisibilityStoreName = dc.GetStringProperty(dynamicproperties.WriteVisibilityStoreName)
+		resourceConfig.ReadVisibilityStoreName = dc.GetStringPropertyFilteredByDomain(dynamicproperties.ReadVisibilityStoreName)
+		resourceConfig.ValidSearchAttributes = dc.GetMapProperty(dynamicproperties.ValidSearchAttributes)
+		resourceConfig.EnableReadDBVisibilityFromClosedExecutionV2 = dc.GetBoolProperty(dynamicproperties.EnableReadFromClosedExecutionV2)
+		resourceConfig.DBVisibilityListMaxQPS = dc.GetIntPropertyFilteredByDomain(dynamicproperties.FrontendVisibilityListMaxQPS)
+		resourceConfig.ESVisibilityListMaxQPS = dc.GetIntPropertyFilteredByDomain(dynamicproperties.FrontendESVisibilityListMaxQPS)
+		resourceConfig.ESIndexMaxResultWindow = dc.GetIntProperty(dynamicproperties.FrontendESIndexMaxResultWindow)
+		resourceConfig.PinotOptimizedQueryColumns = dc.GetMapProperty(dynamicproperties.PinotOptimizedQueryColumns)
+	}
 	serviceResource, err := resource.New(
 		params,
 		service.Worker,
-		&service.Config{
-			PersistenceMaxQPS:        serviceConfig.PersistenceMaxQPS,
-			PersistenceGlobalMaxQPS:  serviceConfig.PersistenceGlobalMaxQPS,
-			ThrottledLoggerMaxRPS:    serviceConfig.ThrottledLogRPS,
-			IsErrorRetryableFunction: common.IsServiceTransientError,
-			// worker service doesn't need visibility config as it never call visibilityManager API
-		},
+		resourceConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -161,8 +176,10 @@ func NewConfig(params *resource.Params) *Config {
 			ClusterMetadata:     params.ClusterMetadata,
 		},
 		failoverManagerCfg: &failovermanager.Config{
-			AdminOperationToken: dc.GetStringProperty(dynamicproperties.AdminOperationToken),
-			ClusterMetadata:     params.ClusterMetadata,
+			AdminOperationToken:      dc.GetStringProperty(dynamicproperties.AdminOperationToken),
+			ClusterMetadata:          params.ClusterMetadata,
+			NumHistoryShards:         params.PersistenceConfig.NumHistoryShards,
+			EnableDomainAuditLogging: dc.GetBoolProperty(dynamicproperties.EnableDomainAuditLogging),
 		},
 		ESAnalyzerCfg: &esanalyzer.Config{
 			ESAnalyzerPause:                          dc.GetBoolProperty(dynamicproperties.ESAnalyzerPause),
@@ -187,6 +204,7 @@ func NewConfig(params *resource.Params) *Config {
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicproperties.NumParentClosePolicySystemWorkflows),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicproperties.EnableESAnalyzer),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicproperties.EnableFailoverManager),
+		EnableVisibilityRebuild:             dc.GetBoolProperty(dynamicproperties.EnableVisibilityRebuild),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicproperties.WorkerThrottledLogRPS),
 		PersistenceGlobalMaxQPS:             dc.GetIntProperty(dynamicproperties.WorkerPersistenceGlobalMaxQPS),
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicproperties.WorkerPersistenceMaxQPS),
@@ -258,6 +276,9 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	if s.config.EnableVisibilityRebuild() {
+		s.startVisibilityRebuild()
+	}
 
 	cm := s.startAsyncWorkflowConsumerManager()
 	defer cm.Stop()
@@ -481,6 +502,9 @@ func (s *Service) startFailoverManager() {
 		Logger:        s.GetLogger(),
 		TallyScope:    s.params.MetricScope,
 		ClientBean:    s.GetClientBean(),
+
+		DomainManager:      s.GetDomainManager(),
+		DomainAuditManager: s.GetDomainAuditManager(),
 	}
 	if err := failovermanager.New(params).Start(); err != nil {
 		s.Stop()
@@ -519,6 +543,24 @@ func (s *Service) startDomainDeprecation() {
 	}
 }
 
+func (s *Service) startVisibilityRebuild() {
+	params := visibilityrebuild.Params{
+		NumHistoryShards:  s.params.PersistenceConfig.NumHistoryShards,
+		ServiceClient:     s.params.PublicClient,
+		ExecutionManager:  s.GetExecutionManager(),
+		HistoryManager:    s.GetHistoryManager(),
+		VisibilityManager: s.GetVisibilityManager(),
+		DomainCache:       s.GetDomainCache(),
+		Tally:             s.params.MetricScope,
+		Logger:            s.GetLogger(),
+	}
+
+	if err := visibilityrebuild.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting visibility rebuilder", tag.Error(err))
+	}
+}
+
 func (s *Service) ensureDomainExists(domain string) {
 	_, err := s.GetDomainManager().GetDomain(context.Background(), &persistence.GetDomainRequest{Name: domain})
 	switch err.(type) {
@@ -0,0 +1,284 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityrebuild
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// errMissingCompletionEvent is returned when the completion event of a closed execution cannot be read, e.g. its
+// history was deleted by retention since it was listed
+var errMissingCompletionEvent = errors.New("completion event of the closed execution is missing")
+
+// RebuildShardActivity re-emits the visibility records of the executions of the shard of the resume token,
+// starting from its page token. It heartbeats its progress after every page and resumes from the last
+// heartbeat when retried. The returned counters only cover this shard.
+func (r *visibilityRebuilder) RebuildShardActivity(ctx context.Context, params RebuildParams) (*RebuildProgress, error) {
+	if r.visibilityManager == nil {
+		return nil, cadence.NewCustomError(ErrVisibilityNotWritableNonRetryable)
+	}
+
+	progress := params.Progress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			r.logger.Warn("Failed to read visibility rebuild heartbeat, rebuilding the shard from its start", tag.Error(err))
+			progress = params.Progress
+		}
+	}
+	shardID := progress.ResumeToken.ShardID
+
+	domainIDs, err := r.getDomainIDs(params.Domains)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCtx := ctx
+	if params.TargetStore != "" {
+		writeCtx = persistence.ContextWithVisibilityWriteStore(ctx, params.TargetStore)
+	}
+	limiter := rate.NewLimiter(rate.Limit(params.RPS), params.RPS)
+	pr := persistence.NewPersistenceRetryerWithShardID(r.executionManager, r.historyManager, common.CreatePersistenceRetryPolicy(), shardID)
+
+	for {
+		resp, err := pr.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			PageSize:  params.PageSize,
+			PageToken: progress.ResumeToken.PageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list executions of shard %d: %w", shardID, err)
+		}
+
+		for _, execution := range resp.Executions {
+			progress.Scanned++
+			info := execution.ExecutionInfo
+			if len(domainIDs) > 0 && !domainIDs[info.DomainID] {
+				continue
+			}
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			emitted, err := r.emitVisibilityRecord(writeCtx, pr, info, execution.VersionHistories)
+			if err != nil {
+				return nil, fmt.Errorf("failed to rebuild visibility of workflow %s run %s: %w", info.WorkflowID, info.RunID, err)
+			}
+			if emitted {
+				progress.Emitted++
+			}
+		}
+
+		progress.ResumeToken.PageToken = resp.PageToken
+		activity.RecordHeartbeat(ctx, progress)
+		if len(resp.PageToken) == 0 {
+			return &progress, nil
+		}
+	}
+}
+
+func (r *visibilityRebuilder) getDomainIDs(domains []string) (map[string]bool, error) {
+	domainIDs := make(map[string]bool, len(domains))
+	for _, domain := range domains {
+		domainID, err := r.getDomainID(domain)
+		if err != nil {
+			return nil, err
+		}
+		domainIDs[domainID] = true
+	}
+	return domainIDs, nil
+}
+
+func (r *visibilityRebuilder) getDomainID(domain string) (string, error) {
+	domainID, err := r.domainCache.GetDomainID(domain)
+	if err != nil {
+		var entityNotExistsError *types.EntityNotExistsError
+		if errors.As(err, &entityNotExistsError) {
+			return "", cadence.NewCustomError(ErrDomainDoesNotExistNonRetryable, domain)
+		}
+		return "", err
+	}
+	return domainID, nil
+}
+
+// emitVisibilityRecord writes the visibility record of an execution from its mutable state, the record of a
+// closed execution also carries its close status and the time of its completion event. It returns false for
+// executions which have no record, like zombies and the executions of deleted domains.
+func (r *visibilityRebuilder) emitVisibilityRecord(
+	ctx context.Context,
+	pr persistence.Retryer,
+	info *persistence.WorkflowExecutionInfo,
+	versionHistories *persistence.VersionHistories,
+) (bool, error) {
+	if info.State != persistence.WorkflowStateCreated &&
+		info.State != persistence.WorkflowStateRunning &&
+		info.State != persistence.WorkflowStateCompleted {
+		return false, nil
+	}
+
+	domainEntry, err := r.domainCache.GetDomainByID(info.DomainID)
+	if err != nil {
+		var entityNotExistsError *types.EntityNotExistsError
+		if errors.As(err, &entityNotExistsError) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	execution := types.WorkflowExecution{
+		WorkflowID: info.WorkflowID,
+		RunID:      info.RunID,
+	}
+	executionTimestamp := info.StartTimestamp.UnixNano()
+	if info.ScheduledExecutionTimestamp != 0 {
+		executionTimestamp = info.ScheduledExecutionTimestamp
+	}
+	var memo *types.Memo
+	if info.Memo != nil {
+		memo = &types.Memo{Fields: info.Memo}
+	}
+	clusterAttribute := info.ActiveClusterSelectionPolicy.GetClusterAttribute()
+	numClusters := int16(len(domainEntry.GetReplicationConfig().Clusters))
+
+	if info.State == persistence.WorkflowStateCompleted {
+		closeStatus := persistence.ToInternalWorkflowExecutionCloseStatus(info.CloseStatus)
+		if closeStatus == nil {
+			return false, nil
+		}
+		closeTimestamp, err := getCloseTimestamp(ctx, pr, domainEntry.GetInfo().Name, info, versionHistories)
+		if err != nil {
+			if errors.Is(err, errMissingCompletionEvent) {
+				r.logger.Warn("Closed execution has no completion event, skipping its visibility record",
+					tag.WorkflowID(info.WorkflowID), tag.WorkflowRunID(info.RunID))
+				return false, nil
+			}
+			return false, err
+		}
+		return true, r.visibilityManager.RecordWorkflowExecutionClosed(ctx, &persistence.RecordWorkflowExecutionClosedRequest{
+			DomainUUID:                  info.DomainID,
+			Domain:                      domainEntry.GetInfo().Name,
+			Execution:                   execution,
+			WorkflowTypeName:            info.WorkflowTypeName,
+			StartTimestamp:              info.StartTimestamp.UnixNano(),
+			ExecutionTimestamp:          executionTimestamp,
+			CloseTimestamp:              closeTimestamp,
+			Status:                      *closeStatus,
+			HistoryLength:               info.NextEventID - 1,
+			RetentionSeconds:            int64(domainEntry.GetRetentionDays(info.WorkflowID)) * 24 * 60 * 60,
+			TaskID:                      info.LastEventTaskID,
+			Memo:                        memo,
+			TaskList:                    info.TaskList,
+			IsCron:                      len(info.CronSchedule) > 0,
+			CronSchedule:                info.CronSchedule,
+			NumClusters:                 numClusters,
+			ClusterAttributeScope:       clusterAttribute.GetScope(),
+			ClusterAttributeName:        clusterAttribute.GetName(),
+			UpdateTimestamp:             info.LastUpdatedTimestamp.UnixNano(),
+			SearchAttributes:            info.SearchAttributes,
+			ShardID:                     int16(pr.GetShardID()),
+			ExecutionStatus:             info.ExecutionStatus,
+			ScheduledExecutionTimestamp: info.ScheduledExecutionTimestamp,
+		})
+	}
+
+	return true, r.visibilityManager.RecordWorkflowExecutionStarted(ctx, &persistence.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:                  info.DomainID,
+		Domain:                      domainEntry.GetInfo().Name,
+		Execution:                   execution,
+		WorkflowTypeName:            info.WorkflowTypeName,
+		StartTimestamp:              info.StartTimestamp.UnixNano(),
+		ExecutionTimestamp:          executionTimestamp,
+		WorkflowTimeout:             int64(info.WorkflowTimeout),
+		TaskID:                      info.LastEventTaskID,
+		Memo:                        memo,
+		TaskList:                    info.TaskList,
+		IsCron:                      len(info.CronSchedule) > 0,
+		CronSchedule:                info.CronSchedule,
+		NumClusters:                 numClusters,
+		ClusterAttributeScope:       clusterAttribute.GetScope(),
+		ClusterAttributeName:        clusterAttribute.GetName(),
+		UpdateTimestamp:             info.LastUpdatedTimestamp.UnixNano(),
+		SearchAttributes:            info.SearchAttributes,
+		ShardID:                     int16(pr.GetShardID()),
+		ExecutionStatus:             info.ExecutionStatus,
+		ScheduledExecutionTimestamp: info.ScheduledExecutionTimestamp,
+	})
+}
+
+// getCloseTimestamp returns the time of the completion event of a closed execution, which is the close time
+// history records when the execution closes.
+func getCloseTimestamp(
+	ctx context.Context,
+	pr persistence.Retryer,
+	domainName string,
+	info *persistence.WorkflowExecutionInfo,
+	versionHistories *persistence.VersionHistories,
+) (int64, error) {
+	if info.CompletionEvent != nil {
+		return info.CompletionEvent.GetTimestamp(), nil
+	}
+	if info.CompletionEventBatchID == constants.EmptyEventID {
+		return 0, errMissingCompletionEvent
+	}
+
+	branchToken := info.BranchToken
+	if versionHistories != nil {
+		versionHistory, err := versionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return 0, err
+		}
+		branchToken = versionHistory.GetBranchToken()
+	}
+	// the completion event is the last event of a closed execution
+	completionEventID := info.NextEventID - 1
+	resp, err := pr.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  info.CompletionEventBatchID,
+		MaxEventID:  completionEventID + 1,
+		PageSize:    1,
+		ShardID:     common.IntPtr(pr.GetShardID()),
+		DomainName:  domainName,
+	})
+	if err != nil {
+		var entityNotExistsError *types.EntityNotExistsError
+		if errors.As(err, &entityNotExistsError) {
+			return 0, errMissingCompletionEvent
+		}
+		return 0, err
+	}
+	for _, event := range resp.HistoryEvents {
+		if event.ID == completionEventID {
+			return event.GetTimestamp(), nil
+		}
+	}
+	return 0, errMissingCompletionEvent
+}
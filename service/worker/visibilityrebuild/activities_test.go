@@ -0,0 +1,292 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityrebuild
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID     = "test-domain-id"
+	testDomainName   = "test-domain"
+	otherDomainID    = "other-domain-id"
+	testWorkflowID   = "test-workflow-id"
+	testRunID        = "test-run-id"
+	testTaskList     = "test-task-list"
+	testWorkflowType = "test-workflow-type"
+	testBranchToken  = "test-branch-token"
+)
+
+type activityTestDeps struct {
+	env               *testsuite.TestActivityEnvironment
+	executionManager  *persistence.MockExecutionManager
+	historyManager    *persistence.MockHistoryManager
+	visibilityManager *persistence.MockVisibilityManager
+	domainCache       *cache.MockDomainCache
+}
+
+func setupActivityTest(t *testing.T) *activityTestDeps {
+	ctrl := gomock.NewController(t)
+	deps := &activityTestDeps{
+		executionManager:  persistence.NewMockExecutionManager(ctrl),
+		historyManager:    persistence.NewMockHistoryManager(ctrl),
+		visibilityManager: persistence.NewMockVisibilityManager(ctrl),
+		domainCache:       cache.NewMockDomainCache(ctrl),
+	}
+	rebuilder := &visibilityRebuilder{
+		numHistoryShards:  4,
+		executionManager:  deps.executionManager,
+		historyManager:    deps.historyManager,
+		visibilityManager: deps.visibilityManager,
+		domainCache:       deps.domainCache,
+		tally:             tally.NoopScope,
+		logger:            testlogger.New(t),
+	}
+	ts := &testsuite.WorkflowTestSuite{}
+	deps.env = ts.NewTestActivityEnvironment()
+	deps.env.RegisterActivityWithOptions(rebuilder.RebuildShardActivity, activity.RegisterOptions{Name: rebuildShardActivity})
+	deps.env.RegisterActivityWithOptions(rebuilder.GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivity})
+	deps.env.RegisterActivityWithOptions(rebuilder.CheckDomainConsistencyActivity, activity.RegisterOptions{Name: checkDomainConsistencyActivity})
+	return deps
+}
+
+func testDomainEntry() *cache.DomainCacheEntry {
+	return cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID, Name: testDomainName},
+		&persistence.DomainConfig{Retention: 7},
+		"active",
+	)
+}
+
+func testExecution(domainID string, state, closeStatus int) *persistence.ListConcreteExecutionsEntity {
+	execution := &persistence.ListConcreteExecutionsEntity{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:             domainID,
+			WorkflowID:           testWorkflowID,
+			RunID:                testRunID,
+			WorkflowTypeName:     testWorkflowType,
+			TaskList:             testTaskList,
+			State:                state,
+			CloseStatus:          closeStatus,
+			StartTimestamp:       time.Unix(100, 0),
+			LastUpdatedTimestamp: time.Unix(200, 0),
+			NextEventID:          11,
+			LastEventTaskID:      42,
+			WorkflowTimeout:      60,
+			BranchToken:          []byte(testBranchToken),
+		},
+	}
+	if state == persistence.WorkflowStateCompleted {
+		execution.ExecutionInfo.CompletionEventBatchID = 10
+	}
+	return execution
+}
+
+// expectCompletionEvent sets the completion event read from the history of the test execution
+func expectCompletionEvent(t *testing.T, deps *activityTestDeps, timestamp int64) {
+	deps.historyManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+			assert.Equal(t, []byte(testBranchToken), req.BranchToken)
+			assert.Equal(t, int64(10), req.MinEventID)
+			assert.Equal(t, int64(11), req.MaxEventID)
+			assert.Equal(t, testDomainName, req.DomainName)
+			return &persistence.ReadHistoryBranchResponse{
+				HistoryEvents: []*types.HistoryEvent{{ID: 10, Timestamp: common.Int64Ptr(timestamp)}},
+			}, nil
+		})
+}
+
+func TestRebuildShardActivity(t *testing.T) {
+	tests := map[string]struct {
+		params        RebuildParams
+		setupMocks    func(deps *activityTestDeps)
+		expected      *RebuildProgress
+		expectedError string
+	}{
+		"running execution is recorded as started": {
+			params: RebuildParams{RPS: 10, PageSize: 10, Progress: RebuildProgress{ResumeToken: ResumeToken{ShardID: 1}}},
+			setupMocks: func(deps *activityTestDeps) {
+				deps.executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *persistence.ListConcreteExecutionsRequest) (*persistence.ListConcreteExecutionsResponse, error) {
+						assert.Equal(t, 1, *req.ShardID)
+						assert.Equal(t, 10, req.PageSize)
+						return &persistence.ListConcreteExecutionsResponse{
+							Executions: []*persistence.ListConcreteExecutionsEntity{
+								testExecution(testDomainID, persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone),
+							},
+						}, nil
+					})
+				deps.domainCache.EXPECT().GetDomainByID(testDomainID).Return(testDomainEntry(), nil)
+				deps.visibilityManager.EXPECT().RecordWorkflowExecutionStarted(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *persistence.RecordWorkflowExecutionStartedRequest) error {
+						assert.Equal(t, testDomainName, req.Domain)
+						assert.Equal(t, types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID}, req.Execution)
+						assert.Equal(t, time.Unix(100, 0).UnixNano(), req.ExecutionTimestamp)
+						assert.Equal(t, int64(42), req.TaskID)
+						assert.Equal(t, int16(1), req.ShardID)
+						return nil
+					})
+			},
+			expected: &RebuildProgress{ResumeToken: ResumeToken{ShardID: 1}, Scanned: 1, Emitted: 1},
+		},
+		"closed execution is recorded as closed in the target store": {
+			params: RebuildParams{TargetStore: "pinot", RPS: 10, PageSize: 10},
+			setupMocks: func(deps *activityTestDeps) {
+				deps.executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+					Executions: []*persistence.ListConcreteExecutionsEntity{
+						testExecution(testDomainID, persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusFailed),
+					},
+				}, nil)
+				deps.domainCache.EXPECT().GetDomainByID(testDomainID).Return(testDomainEntry(), nil)
+				expectCompletionEvent(t, deps, time.Unix(150, 0).UnixNano())
+				deps.visibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, req *persistence.RecordWorkflowExecutionClosedRequest) error {
+						assert.Equal(t, "pinot", persistence.VisibilityWriteStoreFromContext(ctx))
+						assert.Equal(t, types.WorkflowExecutionCloseStatusFailed, req.Status)
+						// the close time is the time of the completion event, not of the last update
+						assert.Equal(t, time.Unix(150, 0).UnixNano(), req.CloseTimestamp)
+						assert.Equal(t, int64(10), req.HistoryLength)
+						assert.Equal(t, int64(7*24*60*60), req.RetentionSeconds)
+						return nil
+					})
+			},
+			expected: &RebuildProgress{Scanned: 1, Emitted: 1},
+		},
+		"closed execution without completion event is skipped": {
+			params: RebuildParams{RPS: 10, PageSize: 10},
+			setupMocks: func(deps *activityTestDeps) {
+				deps.executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+					Executions: []*persistence.ListConcreteExecutionsEntity{
+						testExecution(testDomainID, persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted),
+					},
+				}, nil)
+				deps.domainCache.EXPECT().GetDomainByID(testDomainID).Return(testDomainEntry(), nil)
+				deps.historyManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+			expected: &RebuildProgress{Scanned: 1},
+		},
+		"executions are paged and filtered by domain and state": {
+			params: RebuildParams{Domains: []string{testDomainName}, RPS: 10, PageSize: 1},
+			setupMocks: func(deps *activityTestDeps) {
+				deps.domainCache.EXPECT().GetDomainID(testDomainName).Return(testDomainID, nil)
+				gomock.InOrder(
+					deps.executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+						Executions: []*persistence.ListConcreteExecutionsEntity{
+							testExecution(otherDomainID, persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone),
+						},
+						PageToken: []byte("next"),
+					}, nil),
+					deps.executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, req *persistence.ListConcreteExecutionsRequest) (*persistence.ListConcreteExecutionsResponse, error) {
+							assert.Equal(t, []byte("next"), req.PageToken)
+							return &persistence.ListConcreteExecutionsResponse{
+								Executions: []*persistence.ListConcreteExecutionsEntity{
+									testExecution(testDomainID, persistence.WorkflowStateZombie, persistence.WorkflowCloseStatusNone),
+								},
+							}, nil
+						}),
+				)
+			},
+			expected: &RebuildProgress{Scanned: 2},
+		},
+		"executions of deleted domains are skipped": {
+			params: RebuildParams{RPS: 10, PageSize: 10},
+			setupMocks: func(deps *activityTestDeps) {
+				deps.executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+					Executions: []*persistence.ListConcreteExecutionsEntity{
+						testExecution(testDomainID, persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone),
+					},
+				}, nil)
+				deps.domainCache.EXPECT().GetDomainByID(testDomainID).Return(nil, &types.EntityNotExistsError{})
+			},
+			expected: &RebuildProgress{Scanned: 1},
+		},
+		"unknown domain is not retried": {
+			params: RebuildParams{Domains: []string{testDomainName}, RPS: 10, PageSize: 10},
+			setupMocks: func(deps *activityTestDeps) {
+				deps.domainCache.EXPECT().GetDomainID(testDomainName).Return("", &types.EntityNotExistsError{})
+			},
+			expectedError: ErrDomainDoesNotExistNonRetryable,
+		},
+		"visibility write error fails the activity": {
+			params: RebuildParams{RPS: 10, PageSize: 10},
+			setupMocks: func(deps *activityTestDeps) {
+				deps.executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+					Executions: []*persistence.ListConcreteExecutionsEntity{
+						testExecution(testDomainID, persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone),
+					},
+				}, nil)
+				deps.domainCache.EXPECT().GetDomainByID(testDomainID).Return(testDomainEntry(), nil)
+				deps.visibilityManager.EXPECT().RecordWorkflowExecutionStarted(gomock.Any(), gomock.Any()).Return(errors.New("write failed"))
+			},
+			expectedError: "write failed",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			deps := setupActivityTest(t)
+			tc.setupMocks(deps)
+
+			result, err := deps.env.ExecuteActivity(rebuildShardActivity, tc.params)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			var progress RebuildProgress
+			require.NoError(t, result.Get(&progress))
+			assert.Equal(t, tc.expected, &progress)
+		})
+	}
+}
+
+func TestRebuildShardActivity_VisibilityNotConfigured(t *testing.T) {
+	ts := &testsuite.WorkflowTestSuite{}
+	env := ts.NewTestActivityEnvironment()
+	rebuilder := &visibilityRebuilder{logger: testlogger.New(t)}
+	env.RegisterActivityWithOptions(rebuilder.RebuildShardActivity, activity.RegisterOptions{Name: rebuildShardActivity})
+
+	_, err := env.ExecuteActivity(rebuildShardActivity, RebuildParams{RPS: 10, PageSize: 10})
+	var customErr *cadence.CustomError
+	require.True(t, errors.As(err, &customErr))
+	assert.Equal(t, ErrVisibilityNotWritableNonRetryable, customErr.Reason())
+}
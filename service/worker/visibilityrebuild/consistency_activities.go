@@ -0,0 +1,322 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityrebuild
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	consistencyCheckedMetric    = "visibility_consistency_checked"
+	consistencyMissingMetric    = "visibility_consistency_missing"
+	consistencyMismatchedMetric = "visibility_consistency_mismatched"
+	consistencyRepairedMetric   = "visibility_consistency_repaired"
+
+	// lookupPageSize is the page size of the lookups of a workflow ID in the target store
+	lookupPageSize = 10
+)
+
+type listByWorkflowIDFn func(context.Context, *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.ListWorkflowExecutionsResponse, error)
+
+// GetDomainsActivity returns the domains to check, all the domains of the cluster when none is given
+func (r *visibilityRebuilder) GetDomainsActivity(ctx context.Context, domains []string) ([]string, error) {
+	if len(domains) > 0 {
+		if _, err := r.getDomainIDs(domains); err != nil {
+			return nil, err
+		}
+		return domains, nil
+	}
+
+	for _, entry := range r.domainCache.GetAllDomain() {
+		domains = append(domains, entry.GetInfo().Name)
+	}
+	sort.Strings(domains)
+	return domains, nil
+}
+
+// CheckDomainConsistencyActivity checks the records of the target store against a sample of the open and closed
+// executions of a domain in the source store, and rewrites the records which differ when repair is enabled.
+func (r *visibilityRebuilder) CheckDomainConsistencyActivity(ctx context.Context, params domainCheckParams) (*DomainConsistencyReport, error) {
+	if r.visibilityManager == nil {
+		return nil, cadence.NewCustomError(ErrVisibilityNotWritableNonRetryable)
+	}
+	domainID, err := r.getDomainID(params.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceCtx := persistence.ContextWithVisibilityReadStore(ctx, params.Params.SourceStore)
+	targetCtx := persistence.ContextWithVisibilityReadStore(ctx, params.Params.TargetStore)
+	repairCtx := persistence.ContextWithVisibilityWriteStore(ctx, params.Params.TargetStore)
+	// db visibility does not store search attributes
+	compareSearchAttributes := params.Params.SourceStore != constants.VisibilityModeDB &&
+		params.Params.TargetStore != constants.VisibilityModeDB
+	limiter := rate.NewLimiter(rate.Limit(params.Params.RPS), params.Params.RPS)
+
+	report := &DomainConsistencyReport{Domain: params.Domain}
+	listFns := []func(context.Context, *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error){
+		r.visibilityManager.ListClosedWorkflowExecutions,
+		r.visibilityManager.ListOpenWorkflowExecutions,
+	}
+	for _, list := range listFns {
+		request := &persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:   domainID,
+			Domain:       params.Domain,
+			EarliestTime: params.EarliestTime,
+			LatestTime:   params.LatestTime,
+		}
+		for sampled := 0; sampled < params.Params.SampleSize; {
+			request.PageSize = min(DefaultPageSize, params.Params.SampleSize-sampled)
+			resp, err := list(sourceCtx, request)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list executions of domain %s from %s: %w", params.Domain, params.Params.SourceStore, err)
+			}
+
+			for _, source := range resp.Executions {
+				sampled++
+				if err := limiter.Wait(ctx); err != nil {
+					return nil, err
+				}
+				discrepancy, err := r.checkRecord(targetCtx, params, domainID, source, compareSearchAttributes)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read workflow %s run %s from %s: %w",
+						source.GetExecution().GetWorkflowID(), source.GetExecution().GetRunID(), params.Params.TargetStore, err)
+				}
+				report.Checked++
+				if discrepancy == nil {
+					continue
+				}
+
+				if discrepancy.Type == DiscrepancyMissing {
+					report.Missing++
+				} else {
+					report.Mismatched++
+				}
+				if len(report.Discrepancies) < maxReportedDiscrepancies {
+					report.Discrepancies = append(report.Discrepancies, *discrepancy)
+				}
+				if params.Params.Repair {
+					repaired, err := r.repairRecord(repairCtx, domainID, params.Domain, *source.GetExecution())
+					if err != nil {
+						return nil, fmt.Errorf("failed to repair workflow %s run %s: %w", discrepancy.WorkflowID, discrepancy.RunID, err)
+					}
+					if repaired {
+						report.Repaired++
+					}
+				}
+			}
+
+			activity.RecordHeartbeat(ctx, report)
+			if len(resp.NextPageToken) == 0 {
+				break
+			}
+			request.NextPageToken = resp.NextPageToken
+		}
+	}
+
+	r.emitConsistencyMetrics(params.Params, report)
+	return report, nil
+}
+
+// checkRecord returns how the record of the target store differs from the record of the source store, nil when
+// they match. An execution open in the source store may have closed since it was read, so it matches both an
+// open and a closed record of the target store.
+func (r *visibilityRebuilder) checkRecord(
+	ctx context.Context,
+	params domainCheckParams,
+	domainID string,
+	source *types.WorkflowExecutionInfo,
+	compareSearchAttributes bool,
+) (*Discrepancy, error) {
+	execution := source.GetExecution()
+	discrepancy := &Discrepancy{
+		WorkflowID: execution.GetWorkflowID(),
+		RunID:      execution.GetRunID(),
+	}
+	request := &persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:   domainID,
+			Domain:       params.Domain,
+			EarliestTime: params.EarliestTime,
+			LatestTime:   params.LatestTime,
+			PageSize:     lookupPageSize,
+		},
+		WorkflowID: execution.GetWorkflowID(),
+	}
+
+	if source.CloseStatus == nil {
+		target, err := findExecution(ctx, r.visibilityManager.ListOpenWorkflowExecutionsByWorkflowID, request, execution.GetRunID())
+		if err != nil || target != nil {
+			return nil, err
+		}
+	}
+
+	// closed executions are listed by close time, which is after the start time
+	request.EarliestTime = source.GetStartTime()
+	request.LatestTime = time.Now().UnixNano()
+	target, err := findExecution(ctx, r.visibilityManager.ListClosedWorkflowExecutionsByWorkflowID, request, execution.GetRunID())
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case target == nil:
+		discrepancy.Type = DiscrepancyMissing
+	case source.CloseStatus == nil:
+		return nil, nil
+	case target.GetCloseStatus() != source.GetCloseStatus():
+		discrepancy.Type = DiscrepancyStatus
+		discrepancy.Source = source.GetCloseStatus().String()
+		discrepancy.Target = target.GetCloseStatus().String()
+	// stores keep close times at different precisions
+	case time.Duration(target.GetCloseTime()).Milliseconds() != time.Duration(source.GetCloseTime()).Milliseconds():
+		discrepancy.Type = DiscrepancyCloseTime
+		discrepancy.Source = strconv.FormatInt(source.GetCloseTime(), 10)
+		discrepancy.Target = strconv.FormatInt(target.GetCloseTime(), 10)
+	case compareSearchAttributes && !searchAttributesEqual(source.SearchAttributes, target.SearchAttributes):
+		discrepancy.Type = DiscrepancySearchAttributes
+		discrepancy.Source = searchAttributesString(source.SearchAttributes)
+		discrepancy.Target = searchAttributesString(target.SearchAttributes)
+	default:
+		return nil, nil
+	}
+	return discrepancy, nil
+}
+
+// repairRecord rewrites the visibility record of an execution from its mutable state. It returns false when the
+// execution is not in the execution store anymore.
+func (r *visibilityRebuilder) repairRecord(ctx context.Context, domainID, domain string, execution types.WorkflowExecution) (bool, error) {
+	shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowID(), r.numHistoryShards)
+	pr := persistence.NewPersistenceRetryerWithShardID(r.executionManager, r.historyManager, common.CreatePersistenceRetryPolicy(), shardID)
+	resp, err := pr.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID:   domainID,
+		Execution:  execution,
+		DomainName: domain,
+	})
+	if err != nil {
+		var entityNotExistsError *types.EntityNotExistsError
+		if errors.As(err, &entityNotExistsError) {
+			r.logger.Info("Execution to repair the visibility record of does not exist",
+				tag.WorkflowID(execution.GetWorkflowID()), tag.WorkflowRunID(execution.GetRunID()))
+			return false, nil
+		}
+		return false, err
+	}
+	return r.emitVisibilityRecord(ctx, pr, resp.State.ExecutionInfo, resp.State.VersionHistories)
+}
+
+func (r *visibilityRebuilder) emitConsistencyMetrics(params ConsistencyCheckParams, report *DomainConsistencyReport) {
+	scope := r.tally.Tagged(map[string]string{
+		"domain":       report.Domain,
+		"source_store": params.SourceStore,
+		"target_store": params.TargetStore,
+	})
+	scope.Counter(consistencyCheckedMetric).Inc(report.Checked)
+	scope.Counter(consistencyMissingMetric).Inc(report.Missing)
+	scope.Counter(consistencyMismatchedMetric).Inc(report.Mismatched)
+	scope.Counter(consistencyRepairedMetric).Inc(report.Repaired)
+}
+
+func findExecution(
+	ctx context.Context,
+	list listByWorkflowIDFn,
+	request *persistence.ListWorkflowExecutionsByWorkflowIDRequest,
+	runID string,
+) (*types.WorkflowExecutionInfo, error) {
+	request.NextPageToken = nil
+	for {
+		resp, err := list(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range resp.Executions {
+			if execution.GetExecution().GetRunID() == runID {
+				return execution, nil
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func searchAttributesEqual(source, target *types.SearchAttributes) bool {
+	sourceFields, targetFields := source.GetIndexedFields(), target.GetIndexedFields()
+	if len(sourceFields) != len(targetFields) {
+		return false
+	}
+	for key, sourceValue := range sourceFields {
+		targetValue, ok := targetFields[key]
+		if !ok {
+			return false
+		}
+		// stores encode the same value differently, e.g. with or without spaces
+		var decodedSource, decodedTarget interface{}
+		if json.Unmarshal(sourceValue, &decodedSource) != nil || json.Unmarshal(targetValue, &decodedTarget) != nil {
+			if !bytes.Equal(sourceValue, targetValue) {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(decodedSource, decodedTarget) {
+			return false
+		}
+	}
+	return true
+}
+
+func searchAttributesString(searchAttributes *types.SearchAttributes) string {
+	fields := searchAttributes.GetIndexedFields()
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var buf bytes.Buffer
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		fmt.Fprintf(&buf, "%s=%s", key, fields[key])
+	}
+	return buf.String()
+}
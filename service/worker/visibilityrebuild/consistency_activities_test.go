@@ -0,0 +1,315 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityrebuild
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func testVisibilityRecord(closeStatus *types.WorkflowExecutionCloseStatus, closeTime int64, searchAttributes map[string][]byte) *types.WorkflowExecutionInfo {
+	record := &types.WorkflowExecutionInfo{
+		Execution:   &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID},
+		Type:        &types.WorkflowType{Name: testWorkflowType},
+		StartTime:   common.Int64Ptr(time.Unix(100, 0).UnixNano()),
+		CloseStatus: closeStatus,
+	}
+	if closeStatus != nil {
+		record.CloseTime = common.Int64Ptr(closeTime)
+	}
+	if searchAttributes != nil {
+		record.SearchAttributes = &types.SearchAttributes{IndexedFields: searchAttributes}
+	}
+	return record
+}
+
+func expectReadStore(t *testing.T, ctx context.Context, store string) {
+	assert.Equal(t, store, persistence.VisibilityReadStoreFromContext(ctx))
+}
+
+func TestCheckDomainConsistencyActivity(t *testing.T) {
+	closeTime := time.Unix(200, 0).UnixNano()
+	completed := types.WorkflowExecutionCloseStatusCompleted.Ptr()
+	failed := types.WorkflowExecutionCloseStatusFailed.Ptr()
+	params := domainCheckParams{
+		Params: ConsistencyCheckParams{
+			SourceStore: "es",
+			TargetStore: "pinot",
+			SampleSize:  10,
+			RPS:         100,
+		},
+		Domain:       testDomainName,
+		EarliestTime: time.Unix(0, 0).UnixNano(),
+		LatestTime:   time.Unix(1000, 0).UnixNano(),
+	}
+	withRepair := params
+	withRepair.Params.Repair = true
+
+	// expectSource sets the closed and open executions listed from the source store
+	expectSource := func(t *testing.T, deps *activityTestDeps, closed, open []*types.WorkflowExecutionInfo) {
+		deps.domainCache.EXPECT().GetDomainID(testDomainName).Return(testDomainID, nil)
+		deps.visibilityManager.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+				expectReadStore(t, ctx, "es")
+				assert.Equal(t, testDomainID, req.DomainUUID)
+				assert.Equal(t, 10, req.PageSize)
+				return &persistence.ListWorkflowExecutionsResponse{Executions: closed}, nil
+			})
+		deps.visibilityManager.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+				expectReadStore(t, ctx, "es")
+				return &persistence.ListWorkflowExecutionsResponse{Executions: open}, nil
+			})
+	}
+	// expectTargetClosed sets the closed executions of the workflow ID in the target store
+	expectTargetClosed := func(t *testing.T, deps *activityTestDeps, closed ...*types.WorkflowExecutionInfo) {
+		deps.visibilityManager.EXPECT().ListClosedWorkflowExecutionsByWorkflowID(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+				expectReadStore(t, ctx, "pinot")
+				assert.Equal(t, testWorkflowID, req.WorkflowID)
+				return &persistence.ListWorkflowExecutionsResponse{Executions: closed}, nil
+			})
+	}
+
+	tests := map[string]struct {
+		params        domainCheckParams
+		setupMocks    func(t *testing.T, deps *activityTestDeps)
+		expected      *DomainConsistencyReport
+		expectedError string
+	}{
+		"matching records": {
+			params: params,
+			setupMocks: func(t *testing.T, deps *activityTestDeps) {
+				expectSource(t, deps, []*types.WorkflowExecutionInfo{
+					testVisibilityRecord(completed, closeTime, map[string][]byte{"CustomKeywordField": []byte(`"a"`)}),
+				}, nil)
+				// close times are compared at millisecond precision and search attributes by value
+				expectTargetClosed(t, deps, testVisibilityRecord(completed, closeTime+1, map[string][]byte{"CustomKeywordField": []byte(` "a" `)}))
+			},
+			expected: &DomainConsistencyReport{Domain: testDomainName, Checked: 1},
+		},
+		"mismatched status": {
+			params: params,
+			setupMocks: func(t *testing.T, deps *activityTestDeps) {
+				expectSource(t, deps, []*types.WorkflowExecutionInfo{testVisibilityRecord(completed, closeTime, nil)}, nil)
+				expectTargetClosed(t, deps, testVisibilityRecord(failed, closeTime, nil))
+			},
+			expected: &DomainConsistencyReport{
+				Domain:     testDomainName,
+				Checked:    1,
+				Mismatched: 1,
+				Discrepancies: []Discrepancy{
+					{WorkflowID: testWorkflowID, RunID: testRunID, Type: DiscrepancyStatus, Source: "COMPLETED", Target: "FAILED"},
+				},
+			},
+		},
+		"mismatched search attributes": {
+			params: params,
+			setupMocks: func(t *testing.T, deps *activityTestDeps) {
+				expectSource(t, deps, []*types.WorkflowExecutionInfo{
+					testVisibilityRecord(completed, closeTime, map[string][]byte{"CustomKeywordField": []byte(`"a"`)}),
+				}, nil)
+				expectTargetClosed(t, deps, testVisibilityRecord(completed, closeTime, map[string][]byte{"CustomKeywordField": []byte(`"b"`)}))
+			},
+			expected: &DomainConsistencyReport{
+				Domain:     testDomainName,
+				Checked:    1,
+				Mismatched: 1,
+				Discrepancies: []Discrepancy{
+					{WorkflowID: testWorkflowID, RunID: testRunID, Type: DiscrepancySearchAttributes, Source: `CustomKeywordField="a"`, Target: `CustomKeywordField="b"`},
+				},
+			},
+		},
+		"open execution which closed since it was read": {
+			params: params,
+			setupMocks: func(t *testing.T, deps *activityTestDeps) {
+				expectSource(t, deps, nil, []*types.WorkflowExecutionInfo{testVisibilityRecord(nil, 0, nil)})
+				deps.visibilityManager.EXPECT().ListOpenWorkflowExecutionsByWorkflowID(gomock.Any(), gomock.Any()).
+					Return(&persistence.ListWorkflowExecutionsResponse{}, nil)
+				expectTargetClosed(t, deps, testVisibilityRecord(completed, closeTime, nil))
+			},
+			expected: &DomainConsistencyReport{Domain: testDomainName, Checked: 1},
+		},
+		"missing record is repaired from the execution store": {
+			params: withRepair,
+			setupMocks: func(t *testing.T, deps *activityTestDeps) {
+				expectSource(t, deps, []*types.WorkflowExecutionInfo{testVisibilityRecord(completed, closeTime, nil)}, nil)
+				expectTargetClosed(t, deps)
+				deps.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+						assert.Equal(t, testDomainID, req.DomainID)
+						assert.Equal(t, types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID}, req.Execution)
+						return &persistence.GetWorkflowExecutionResponse{
+							State: &persistence.WorkflowMutableState{
+								ExecutionInfo: testExecution(testDomainID, persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted).ExecutionInfo,
+							},
+						}, nil
+					})
+				deps.domainCache.EXPECT().GetDomainByID(testDomainID).Return(testDomainEntry(), nil)
+				expectCompletionEvent(t, deps, closeTime)
+				deps.visibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, req *persistence.RecordWorkflowExecutionClosedRequest) error {
+						assert.Equal(t, "pinot", persistence.VisibilityWriteStoreFromContext(ctx))
+						assert.Equal(t, closeTime, req.CloseTimestamp)
+						return nil
+					})
+			},
+			expected: &DomainConsistencyReport{
+				Domain:   testDomainName,
+				Checked:  1,
+				Missing:  1,
+				Repaired: 1,
+				Discrepancies: []Discrepancy{
+					{WorkflowID: testWorkflowID, RunID: testRunID, Type: DiscrepancyMissing},
+				},
+			},
+		},
+		"missing record of a deleted execution is not repaired": {
+			params: withRepair,
+			setupMocks: func(t *testing.T, deps *activityTestDeps) {
+				expectSource(t, deps, nil, []*types.WorkflowExecutionInfo{testVisibilityRecord(nil, 0, nil)})
+				deps.visibilityManager.EXPECT().ListOpenWorkflowExecutionsByWorkflowID(gomock.Any(), gomock.Any()).
+					Return(&persistence.ListWorkflowExecutionsResponse{}, nil)
+				expectTargetClosed(t, deps)
+				deps.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+			},
+			expected: &DomainConsistencyReport{
+				Domain:  testDomainName,
+				Checked: 1,
+				Missing: 1,
+				Discrepancies: []Discrepancy{
+					{WorkflowID: testWorkflowID, RunID: testRunID, Type: DiscrepancyMissing},
+				},
+			},
+		},
+		"source store read error": {
+			params: params,
+			setupMocks: func(t *testing.T, deps *activityTestDeps) {
+				deps.domainCache.EXPECT().GetDomainID(testDomainName).Return(testDomainID, nil)
+				deps.visibilityManager.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, errors.New("read failed"))
+			},
+			expectedError: "read failed",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			deps := setupActivityTest(t)
+			tc.setupMocks(t, deps)
+
+			result, err := deps.env.ExecuteActivity(checkDomainConsistencyActivity, tc.params)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			var report DomainConsistencyReport
+			require.NoError(t, result.Get(&report))
+			assert.Equal(t, tc.expected, &report)
+		})
+	}
+}
+
+func TestCheckDomainConsistencyActivity_RepairedRecordPasses(t *testing.T) {
+	// the close time history recorded, which is not the last update time of the execution
+	closeTime := time.Unix(150, 123456).UnixNano()
+	completed := types.WorkflowExecutionCloseStatusCompleted.Ptr()
+	params := domainCheckParams{
+		Params: ConsistencyCheckParams{
+			SourceStore: "es",
+			TargetStore: "pinot",
+			SampleSize:  10,
+			RPS:         100,
+			Repair:      true,
+		},
+		Domain:       testDomainName,
+		EarliestTime: time.Unix(0, 0).UnixNano(),
+		LatestTime:   time.Unix(1000, 0).UnixNano(),
+	}
+
+	deps := setupActivityTest(t)
+	target := testVisibilityRecord(completed, time.Unix(200, 0).UnixNano(), nil)
+	deps.domainCache.EXPECT().GetDomainID(testDomainName).Return(testDomainID, nil).Times(2)
+	deps.visibilityManager.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListWorkflowExecutionsResponse{
+			Executions: []*types.WorkflowExecutionInfo{testVisibilityRecord(completed, closeTime, nil)},
+		}, nil).Times(2)
+	deps.visibilityManager.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Times(2)
+	deps.visibilityManager.EXPECT().ListClosedWorkflowExecutionsByWorkflowID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+			return &persistence.ListWorkflowExecutionsResponse{Executions: []*types.WorkflowExecutionInfo{target}}, nil
+		}).Times(2)
+	deps.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: testExecution(testDomainID, persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted).ExecutionInfo,
+		},
+	}, nil)
+	deps.domainCache.EXPECT().GetDomainByID(testDomainID).Return(testDomainEntry(), nil)
+	expectCompletionEvent(t, deps, closeTime)
+	deps.visibilityManager.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *persistence.RecordWorkflowExecutionClosedRequest) error {
+			target = testVisibilityRecord(req.Status.Ptr(), req.CloseTimestamp, nil)
+			return nil
+		})
+
+	result, err := deps.env.ExecuteActivity(checkDomainConsistencyActivity, params)
+	require.NoError(t, err)
+	var report DomainConsistencyReport
+	require.NoError(t, result.Get(&report))
+	assert.Equal(t, int64(1), report.Mismatched)
+	assert.Equal(t, int64(1), report.Repaired)
+
+	// the repaired record matches the source store
+	result, err = deps.env.ExecuteActivity(checkDomainConsistencyActivity, params)
+	require.NoError(t, err)
+	report = DomainConsistencyReport{}
+	require.NoError(t, result.Get(&report))
+	assert.Equal(t, &DomainConsistencyReport{Domain: testDomainName, Checked: 1}, &report)
+}
+
+func TestGetDomainsActivity(t *testing.T) {
+	deps := setupActivityTest(t)
+	deps.domainCache.EXPECT().GetAllDomain().Return(map[string]*cache.DomainCacheEntry{
+		"id-b": cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "id-b", Name: "b"}, &persistence.DomainConfig{}, "active"),
+		"id-a": cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "id-a", Name: "a"}, &persistence.DomainConfig{}, "active"),
+	})
+
+	result, err := deps.env.ExecuteActivity(getDomainsActivity, []string(nil))
+	require.NoError(t, err)
+	var domains []string
+	require.NoError(t, result.Get(&domains))
+	assert.Equal(t, []string{"a", "b"}, domains)
+}
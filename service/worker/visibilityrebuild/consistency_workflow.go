@@ -0,0 +1,123 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityrebuild
+
+import (
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	getDomainsActivity             = "getDomains"
+	checkDomainConsistencyActivity = "checkDomainConsistency"
+)
+
+var (
+	consistencyRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: time.Hour,
+		NonRetriableErrorReasons: []string{
+			ErrVisibilityNotWritableNonRetryable,
+			ErrDomainDoesNotExistNonRetryable,
+		},
+	}
+
+	consistencyActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    time.Hour,
+		HeartbeatTimeout:       2 * time.Minute,
+		RetryPolicy:            &consistencyRetryPolicy,
+	}
+)
+
+type domainCheckParams struct {
+	Params       ConsistencyCheckParams
+	Domain       string
+	EarliestTime int64
+	LatestTime   int64
+}
+
+// VisibilityConsistencyCheckWorkflow samples the open and closed executions of each domain in the source
+// visibility store and compares them with their records in the target store, one domain at a time.
+func (r *visibilityRebuilder) VisibilityConsistencyCheckWorkflow(ctx workflow.Context, params ConsistencyCheckParams) (*ConsistencyReport, error) {
+	logger := workflow.GetLogger(ctx)
+	if params.SourceStore == "" || params.TargetStore == "" || params.SourceStore == params.TargetStore {
+		return nil, cadence.NewCustomError(ErrInvalidStoresNonRetryable)
+	}
+	if params.SampleSize <= 0 {
+		params.SampleSize = DefaultSampleSize
+	}
+	if params.Range <= 0 {
+		params.Range = DefaultCheckRange
+	}
+	if params.SettleDelay <= 0 {
+		params.SettleDelay = DefaultSettleDelay
+	}
+	if params.RPS <= 0 {
+		params.RPS = DefaultRPS
+	}
+
+	report := ConsistencyReport{
+		SourceStore: params.SourceStore,
+		TargetStore: params.TargetStore,
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeReport, func() (ConsistencyReport, error) {
+		return report, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, consistencyActivityOptions)
+	var domains []string
+	if err := workflow.ExecuteActivity(ctx, getDomainsActivity, params.Domains).Get(ctx, &domains); err != nil {
+		return nil, err
+	}
+
+	latestTime := workflow.Now(ctx).Add(-params.SettleDelay)
+	earliestTime := latestTime.Add(-params.Range)
+	for _, domain := range domains {
+		var domainReport DomainConsistencyReport
+		if err := workflow.ExecuteActivity(ctx, checkDomainConsistencyActivity, domainCheckParams{
+			Params:       params,
+			Domain:       domain,
+			EarliestTime: earliestTime.UnixNano(),
+			LatestTime:   latestTime.UnixNano(),
+		}).Get(ctx, &domainReport); err != nil {
+			return nil, err
+		}
+		logger.Info("Checked visibility consistency of domain",
+			zap.String("domain", domain),
+			zap.Int64("checked", domainReport.Checked),
+			zap.Int64("missing", domainReport.Missing),
+			zap.Int64("mismatched", domainReport.Mismatched),
+			zap.Int64("repaired", domainReport.Repaired))
+		report.Domains = append(report.Domains, domainReport)
+	}
+
+	return &report, nil
+}
@@ -0,0 +1,102 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityrebuild
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+type consistencyCheckWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+	rebuilder   *visibilityRebuilder
+}
+
+func TestConsistencyCheckWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(consistencyCheckWorkflowTestSuite))
+}
+
+func (s *consistencyCheckWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.rebuilder = &visibilityRebuilder{}
+	s.workflowEnv.RegisterWorkflowWithOptions(s.rebuilder.VisibilityConsistencyCheckWorkflow, workflow.RegisterOptions{Name: ConsistencyCheckWorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(s.rebuilder.GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivity})
+	s.workflowEnv.RegisterActivityWithOptions(s.rebuilder.CheckDomainConsistencyActivity, activity.RegisterOptions{Name: checkDomainConsistencyActivity})
+}
+
+func (s *consistencyCheckWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *consistencyCheckWorkflowTestSuite) TestWorkflow_Success() {
+	params := ConsistencyCheckParams{SourceStore: "es", TargetStore: "pinot"}
+	s.workflowEnv.OnActivity(getDomainsActivity, mock.Anything, []string(nil)).Return([]string{"d1", "d2"}, nil).Once()
+	for _, domain := range []string{"d1", "d2"} {
+		domain := domain
+		s.workflowEnv.OnActivity(checkDomainConsistencyActivity, mock.Anything, mock.MatchedBy(func(p domainCheckParams) bool {
+			return p.Domain == domain &&
+				p.Params.SampleSize == DefaultSampleSize &&
+				p.Params.RPS == DefaultRPS &&
+				time.Duration(p.LatestTime-p.EarliestTime) == DefaultCheckRange
+		})).Return(&DomainConsistencyReport{Domain: domain, Checked: 10, Missing: 1}, nil).Once()
+	}
+
+	s.workflowEnv.ExecuteWorkflow(ConsistencyCheckWorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	var report ConsistencyReport
+	s.NoError(s.workflowEnv.GetWorkflowResult(&report))
+	s.Equal(ConsistencyReport{
+		SourceStore: "es",
+		TargetStore: "pinot",
+		Domains: []DomainConsistencyReport{
+			{Domain: "d1", Checked: 10, Missing: 1},
+			{Domain: "d2", Checked: 10, Missing: 1},
+		},
+	}, report)
+}
+
+func (s *consistencyCheckWorkflowTestSuite) TestWorkflow_InvalidStores() {
+	s.workflowEnv.ExecuteWorkflow(ConsistencyCheckWorkflowTypeName, ConsistencyCheckParams{SourceStore: "es", TargetStore: "es"})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), ErrInvalidStoresNonRetryable)
+}
+
+func (s *consistencyCheckWorkflowTestSuite) TestWorkflow_ActivityError() {
+	s.workflowEnv.OnActivity(getDomainsActivity, mock.Anything, []string{"d1"}).Return([]string{"d1"}, nil).Once()
+	s.workflowEnv.OnActivity(checkDomainConsistencyActivity, mock.Anything, mock.Anything).
+		Return(nil, errors.New(ErrDomainDoesNotExistNonRetryable)).Once()
+
+	s.workflowEnv.ExecuteWorkflow(ConsistencyCheckWorkflowTypeName, ConsistencyCheckParams{SourceStore: "db", TargetStore: "es", Domains: []string{"d1"}})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), ErrDomainDoesNotExistNonRetryable)
+}
@@ -0,0 +1,160 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityrebuild
+
+import "time"
+
+const (
+	// WorkflowTypeName is the type of the visibility rebuild workflow
+	WorkflowTypeName = "visibility-rebuild-workflow"
+	// TaskListName is the task list of the visibility rebuild workflow
+	TaskListName = "visibility-rebuild-tasklist"
+	// WorkflowID is the ID of the visibility rebuild workflow, only one rebuild runs at a time
+	WorkflowID = "visibility-rebuild"
+	// QueryTypeProgress returns the RebuildProgress of a running rebuild
+	QueryTypeProgress = "progress"
+
+	// ErrVisibilityNotWritableNonRetryable is returned when the worker has no visibility manager to write with
+	ErrVisibilityNotWritableNonRetryable = "visibility is not writable from worker, set worker.enableVisibilityRebuild and restart"
+	// ErrDomainDoesNotExistNonRetryable is returned when a domain of the filter does not exist
+	ErrDomainDoesNotExistNonRetryable = "domain does not exist"
+
+	// DefaultRPS is the default number of visibility records written per second
+	DefaultRPS = 100
+	// DefaultPageSize is the default number of executions read per page
+	DefaultPageSize = 100
+
+	// shardsPerRun is the number of shards rebuilt before the workflow continues as new
+	shardsPerRun = 16
+
+	// ConsistencyCheckWorkflowTypeName is the type of the visibility consistency check workflow
+	ConsistencyCheckWorkflowTypeName = "visibility-consistency-check-workflow"
+	// ConsistencyCheckWorkflowID is the ID of the visibility consistency check workflow
+	ConsistencyCheckWorkflowID = "visibility-consistency-check"
+	// QueryTypeReport returns the ConsistencyReport of a consistency check, it is partial while the check runs
+	QueryTypeReport = "report"
+
+	// ErrInvalidStoresNonRetryable is returned when the stores to compare are missing or the same
+	ErrInvalidStoresNonRetryable = "source and target visibility stores must be set and different"
+
+	// DefaultSampleSize is the default number of open and of closed executions checked per domain
+	DefaultSampleSize = 1000
+	// DefaultCheckRange is the default time range the sampled executions started or closed in
+	DefaultCheckRange = 24 * time.Hour
+	// DefaultSettleDelay is the default delay before an execution is checked, it leaves time to the
+	// asynchronous writes of advanced visibility stores to land
+	DefaultSettleDelay = 5 * time.Minute
+
+	// maxReportedDiscrepancies is the number of discrepancies listed in the report of a domain, all of them are counted
+	maxReportedDiscrepancies = 20
+)
+
+// Discrepancy types of a record of the target store
+const (
+	DiscrepancyMissing          DiscrepancyType = "missing"
+	DiscrepancyStatus           DiscrepancyType = "status"
+	DiscrepancyCloseTime        DiscrepancyType = "close-time"
+	DiscrepancySearchAttributes DiscrepancyType = "search-attributes"
+)
+
+type (
+	// RebuildParams is the input of the visibility rebuild workflow
+	RebuildParams struct {
+		// TargetStore is the visibility store written to, e.g. "es", "pinot" or "db". When empty, records are
+		// written to the stores configured by system.writeVisibilityStoreName.
+		TargetStore string `json:"target_store,omitempty"`
+		// Domains limits the rebuild to the executions of these domains, all domains are rebuilt when empty
+		Domains []string `json:"domains,omitempty"`
+		// RPS is the maximum number of visibility records written per second
+		RPS int `json:"rps,omitempty"`
+		// PageSize is the number of executions read per persistence call
+		PageSize int `json:"page_size,omitempty"`
+		// Progress is where the rebuild starts, carried over when the workflow continues as new or is resumed
+		Progress RebuildProgress `json:"progress"`
+	}
+
+	// RebuildProgress is the position and counters of a rebuild
+	RebuildProgress struct {
+		ResumeToken ResumeToken `json:"resume_token"`
+		// Scanned is the number of executions read
+		Scanned int64 `json:"scanned"`
+		// Emitted is the number of visibility records written
+		Emitted int64 `json:"emitted"`
+	}
+
+	// ResumeToken is the shard being rebuilt and the page to read next within it
+	ResumeToken struct {
+		ShardID   int    `json:"shard_id"`
+		PageToken []byte `json:"page_token,omitempty"`
+	}
+
+	// ConsistencyCheckParams is the input of the visibility consistency check workflow
+	ConsistencyCheckParams struct {
+		// SourceStore is the visibility store taken as the reference, e.g. "db" or "es"
+		SourceStore string `json:"source_store"`
+		// TargetStore is the visibility store checked against the source, e.g. "es" or "pinot"
+		TargetStore string `json:"target_store"`
+		// Domains limits the check to these domains, all domains are checked when empty
+		Domains []string `json:"domains,omitempty"`
+		// SampleSize is the maximum number of open and of closed executions checked per domain
+		SampleSize int `json:"sample_size,omitempty"`
+		// Range is how far back the sampled executions started, for open ones, or closed, for closed ones
+		Range time.Duration `json:"range,omitempty"`
+		// SettleDelay excludes the executions which changed too recently to be in every store yet
+		SettleDelay time.Duration `json:"settle_delay,omitempty"`
+		// RPS is the maximum number of records checked per second
+		RPS int `json:"rps,omitempty"`
+		// Repair rewrites the missing and mismatched records of the target store from the execution store
+		Repair bool `json:"repair,omitempty"`
+	}
+
+	// ConsistencyReport is the result of a visibility consistency check
+	ConsistencyReport struct {
+		SourceStore string                    `json:"source_store"`
+		TargetStore string                    `json:"target_store"`
+		Domains     []DomainConsistencyReport `json:"domains"`
+	}
+
+	// DomainConsistencyReport is the result of the consistency check of a domain
+	DomainConsistencyReport struct {
+		Domain     string `json:"domain"`
+		Checked    int64  `json:"checked"`
+		Missing    int64  `json:"missing"`
+		Mismatched int64  `json:"mismatched"`
+		Repaired   int64  `json:"repaired"`
+		// Discrepancies lists the first discrepancies found
+		Discrepancies []Discrepancy `json:"discrepancies,omitempty"`
+	}
+
+	// DiscrepancyType is what differs between the record of the source and of the target store
+	DiscrepancyType string
+
+	// Discrepancy is a record of the target store which does not match the source store
+	Discrepancy struct {
+		WorkflowID string          `json:"workflow_id"`
+		RunID      string          `json:"run_id"`
+		Type       DiscrepancyType `json:"type"`
+		Source     string          `json:"source,omitempty"`
+		Target     string          `json:"target,omitempty"`
+	}
+)
@@ -0,0 +1,107 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityrebuild
+
+import (
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// VisibilityRebuildWorker runs the visibility rebuild workflow, which re-emits the visibility records of
+	// the executions in the primary execution store, and the consistency check workflow, which compares the
+	// records of two visibility stores
+	VisibilityRebuildWorker interface {
+		Start() error
+		Stop()
+	}
+
+	visibilityRebuilder struct {
+		numHistoryShards  int
+		svcClient         workflowserviceclient.Interface
+		executionManager  persistence.ExecutionManager
+		historyManager    persistence.HistoryManager
+		visibilityManager persistence.VisibilityManager
+		domainCache       cache.DomainCache
+		tally             tally.Scope
+		logger            log.Logger
+		worker            worker.Worker
+	}
+
+	// Params are the dependencies of the visibility rebuild worker
+	Params struct {
+		NumHistoryShards int
+		ServiceClient    workflowserviceclient.Interface
+		ExecutionManager persistence.ExecutionManager
+		HistoryManager   persistence.HistoryManager
+		// VisibilityManager is nil when the worker is not configured to write visibility records
+		VisibilityManager persistence.VisibilityManager
+		DomainCache       cache.DomainCache
+		Tally             tally.Scope
+		Logger            log.Logger
+	}
+)
+
+// New creates a visibility rebuild worker
+func New(params Params) VisibilityRebuildWorker {
+	return &visibilityRebuilder{
+		numHistoryShards:  params.NumHistoryShards,
+		svcClient:         params.ServiceClient,
+		executionManager:  params.ExecutionManager,
+		historyManager:    params.HistoryManager,
+		visibilityManager: params.VisibilityManager,
+		domainCache:       params.DomainCache,
+		tally:             params.Tally,
+		logger:            params.Logger,
+	}
+}
+
+func (r *visibilityRebuilder) Start() error {
+	workerOpts := worker.Options{
+		MetricsScope:                     r.tally,
+		Tracer:                           opentracing.GlobalTracer(),
+		MaxConcurrentActivityTaskPollers: 4,
+		MaxConcurrentDecisionTaskPollers: 4,
+	}
+	newWorker := worker.New(r.svcClient, constants.SystemLocalDomainName, TaskListName, workerOpts)
+	newWorker.RegisterWorkflowWithOptions(r.VisibilityRebuildWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	newWorker.RegisterActivityWithOptions(r.RebuildShardActivity, activity.RegisterOptions{Name: rebuildShardActivity})
+	newWorker.RegisterWorkflowWithOptions(r.VisibilityConsistencyCheckWorkflow, workflow.RegisterOptions{Name: ConsistencyCheckWorkflowTypeName})
+	newWorker.RegisterActivityWithOptions(r.GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivity})
+	newWorker.RegisterActivityWithOptions(r.CheckDomainConsistencyActivity, activity.RegisterOptions{Name: checkDomainConsistencyActivity})
+	r.worker = newWorker
+	return newWorker.Start()
+}
+
+func (r *visibilityRebuilder) Stop() {
+	r.worker.Stop()
+}
@@ -0,0 +1,106 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityrebuild
+
+import (
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	rebuildShardActivity = "rebuildShard"
+)
+
+var (
+	retryPolicy = cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: 24 * time.Hour,
+		NonRetriableErrorReasons: []string{
+			ErrVisibilityNotWritableNonRetryable,
+			ErrDomainDoesNotExistNonRetryable,
+		},
+	}
+
+	// a shard can hold many executions, the activity heartbeats its position after every page
+	// and resumes from it when retried
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    24 * time.Hour,
+		HeartbeatTimeout:       2 * time.Minute,
+		RetryPolicy:            &retryPolicy,
+	}
+)
+
+// VisibilityRebuildWorkflow re-emits the visibility records of the executions of every shard, in shard order,
+// starting from the resume token of its input. It continues as new every shardsPerRun shards to keep its
+// history small.
+func (r *visibilityRebuilder) VisibilityRebuildWorkflow(ctx workflow.Context, params RebuildParams) (*RebuildProgress, error) {
+	logger := workflow.GetLogger(ctx)
+	if params.RPS <= 0 {
+		params.RPS = DefaultRPS
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = DefaultPageSize
+	}
+
+	progress := params.Progress
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (RebuildProgress, error) {
+		return progress, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+	for i := 0; i < shardsPerRun && progress.ResumeToken.ShardID < r.numHistoryShards; i++ {
+		shardParams := params
+		shardParams.Progress = RebuildProgress{ResumeToken: progress.ResumeToken}
+
+		var shardProgress RebuildProgress
+		if err := workflow.ExecuteActivity(ctx, rebuildShardActivity, shardParams).Get(ctx, &shardProgress); err != nil {
+			return nil, err
+		}
+		logger.Info("Rebuilt visibility of shard",
+			zap.Int("shard_id", progress.ResumeToken.ShardID),
+			zap.Int64("scanned", shardProgress.Scanned),
+			zap.Int64("emitted", shardProgress.Emitted))
+
+		progress.Scanned += shardProgress.Scanned
+		progress.Emitted += shardProgress.Emitted
+		progress.ResumeToken = ResumeToken{ShardID: progress.ResumeToken.ShardID + 1}
+	}
+
+	if progress.ResumeToken.ShardID < r.numHistoryShards {
+		params.Progress = progress
+		return nil, workflow.NewContinueAsNewError(ctx, WorkflowTypeName, params)
+	}
+
+	logger.Info("Visibility rebuild completed",
+		zap.Int64("scanned", progress.Scanned),
+		zap.Int64("emitted", progress.Emitted))
+	return &progress, nil
+}
@@ -0,0 +1,122 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibilityrebuild
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+type visibilityRebuildWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+	rebuilder   *visibilityRebuilder
+}
+
+func TestVisibilityRebuildWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(visibilityRebuildWorkflowTestSuite))
+}
+
+func (s *visibilityRebuildWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.rebuilder = &visibilityRebuilder{numHistoryShards: 3}
+	s.workflowEnv.RegisterWorkflowWithOptions(s.rebuilder.VisibilityRebuildWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(s.rebuilder.RebuildShardActivity, activity.RegisterOptions{Name: rebuildShardActivity})
+}
+
+func (s *visibilityRebuildWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func shardParams(params RebuildParams, shardID int, pageToken []byte) RebuildParams {
+	params.Progress = RebuildProgress{ResumeToken: ResumeToken{ShardID: shardID, PageToken: pageToken}}
+	return params
+}
+
+func (s *visibilityRebuildWorkflowTestSuite) TestWorkflow_Success() {
+	params := RebuildParams{
+		TargetStore: "es",
+		Domains:     []string{"test-domain"},
+		RPS:         10,
+		PageSize:    5,
+	}
+	for shardID := 0; shardID < 3; shardID++ {
+		s.workflowEnv.OnActivity(rebuildShardActivity, mock.Anything, shardParams(params, shardID, nil)).
+			Return(&RebuildProgress{Scanned: 10, Emitted: 4}, nil).Once()
+	}
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	var progress RebuildProgress
+	s.NoError(s.workflowEnv.GetWorkflowResult(&progress))
+	s.Equal(RebuildProgress{ResumeToken: ResumeToken{ShardID: 3}, Scanned: 30, Emitted: 12}, progress)
+}
+
+func (s *visibilityRebuildWorkflowTestSuite) TestWorkflow_ResumesFromToken() {
+	params := RebuildParams{
+		RPS:      DefaultRPS,
+		PageSize: DefaultPageSize,
+		Progress: RebuildProgress{
+			ResumeToken: ResumeToken{ShardID: 2, PageToken: []byte("page")},
+			Scanned:     20,
+			Emitted:     20,
+		},
+	}
+	s.workflowEnv.OnActivity(rebuildShardActivity, mock.Anything, shardParams(params, 2, []byte("page"))).
+		Return(&RebuildProgress{Scanned: 5, Emitted: 5}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, RebuildParams{Progress: params.Progress})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	var progress RebuildProgress
+	s.NoError(s.workflowEnv.GetWorkflowResult(&progress))
+	s.Equal(RebuildProgress{ResumeToken: ResumeToken{ShardID: 3}, Scanned: 25, Emitted: 25}, progress)
+}
+
+func (s *visibilityRebuildWorkflowTestSuite) TestWorkflow_ContinuesAsNew() {
+	s.rebuilder.numHistoryShards = shardsPerRun + 1
+	s.workflowEnv.OnActivity(rebuildShardActivity, mock.Anything, mock.Anything).
+		Return(&RebuildProgress{Scanned: 1, Emitted: 1}, nil).Times(shardsPerRun)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, RebuildParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	s.True(errors.As(s.workflowEnv.GetWorkflowError(), &continueAsNewErr))
+}
+
+func (s *visibilityRebuildWorkflowTestSuite) TestWorkflow_ActivityError() {
+	s.workflowEnv.OnActivity(rebuildShardActivity, mock.Anything, mock.Anything).
+		Return(nil, errors.New(ErrVisibilityNotWritableNonRetryable)).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, RebuildParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.ErrorContains(s.workflowEnv.GetWorkflowError(), ErrVisibilityNotWritableNonRetryable)
+}
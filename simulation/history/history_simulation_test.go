@@ -191,4 +191,42 @@ func (s *HistorySimulationSuite) TestHistorySimulation() {
 	}
 
 	time.Sleep(cfg.SleepAfterAllWorkflows)
+
+	for _, we := range runs {
+		s.assertWorkflowInvariants(ctx, cfg, we)
+	}
+}
+
+// assertWorkflowInvariants checks that faults injected during the run did not lose or duplicate work:
+// the workflow has a single run which completed exactly once, and every timer it started has fired.
+func (s *HistorySimulationSuite) assertWorkflowInvariants(ctx context.Context, cfg host.HistorySimulationConfig, we client.WorkflowRun) {
+	describeResp, err := s.Engine.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    s.DomainName,
+		Execution: &types.WorkflowExecution{WorkflowID: we.GetID()},
+	})
+	s.NoError(err)
+	s.Equal(we.GetRunID(), describeResp.WorkflowExecutionInfo.Execution.GetRunID(), "workflow %v has more than one run", we.GetID())
+	s.Equal(types.WorkflowExecutionCloseStatusCompleted.Ptr(), describeResp.WorkflowExecutionInfo.CloseStatus, "workflow %v did not complete", we.GetID())
+
+	eventCounts := make(map[types.EventType]int)
+	var nextPageToken []byte
+	for {
+		historyResp, err := s.Engine.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+			Domain:        s.DomainName,
+			Execution:     &types.WorkflowExecution{WorkflowID: we.GetID(), RunID: we.GetRunID()},
+			NextPageToken: nextPageToken,
+		})
+		s.NoError(err)
+		for _, event := range historyResp.History.GetEvents() {
+			eventCounts[event.GetEventType()]++
+		}
+		nextPageToken = historyResp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	s.Equal(1, eventCounts[types.EventTypeWorkflowExecutionCompleted], "workflow %v completed more than once", we.GetID())
+	s.Equal(cfg.NumWorkflowSleeps, eventCounts[types.EventTypeTimerStarted], "workflow %v started an unexpected number of timers", we.GetID())
+	s.Equal(eventCounts[types.EventTypeTimerStarted], eventCounts[types.EventTypeTimerFired], "workflow %v lost timers", we.GetID())
 }
@@ -133,6 +133,7 @@ func TestMatchingSimulation(t *testing.T) {
 		dynamicproperties.MatchingAdaptiveScalerUpdateInterval:         clusterConfig.MatchingConfig.SimulationConfig.AdaptiveScalerUpdateInterval,
 		dynamicproperties.MatchingQPSTrackerInterval:                   getQPSTrackerInterval(clusterConfig.MatchingConfig.SimulationConfig.QPSTrackerInterval),
 		dynamicproperties.TaskIsolationDuration:                        clusterConfig.MatchingConfig.SimulationConfig.TaskIsolationDuration,
+		dynamicproperties.PersistenceFaultInjectionRules:               getPersistenceFaultInjectionRules(clusterConfig.MatchingConfig.SimulationConfig),
 	}
 
 	ctrl := gomock.NewController(t)
@@ -564,6 +565,14 @@ func getIsolationGroups(c host.MatchingSimulationConfig) []any {
 	return uniqueGroups
 }
 
+func getPersistenceFaultInjectionRules(c host.MatchingSimulationConfig) []interface{} {
+	rules := make([]interface{}, 0, len(c.PersistenceFaultInjectionRules))
+	for _, rule := range c.PersistenceFaultInjectionRules {
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
 func getNumTaskGenerators(c host.SimulationTaskConfiguration) int {
 	if c.NumTaskGenerators == 0 {
 		return 1
@@ -23,13 +23,16 @@ package cli
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/urfave/cli/v2"
 
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/service/worker/diagnostics"
 	"github.com/uber/cadence/service/worker/scanner/executions"
+	"github.com/uber/cadence/service/worker/visibilityrebuild"
 )
 
 func newAdminWorkflowCommands() []*cli.Command {
@@ -282,7 +285,7 @@ func newAdminShardManagementCommands() []*cli.Command {
 					Name: FlagTimerType,
 					Usage: "timer types: 0 - DecisionTimeoutTask, 1 - TaskTypeActivityTimeout, " +
 						"2 - TaskTypeUserTimer, 3 - TaskTypeWorkflowTimeout, 4 - TaskTypeDeleteHistoryEvent, " +
-						"5 - TaskTypeActivityRetryTimer, 6 - TaskTypeWorkflowBackoffTimer",
+						"5 - TaskTypeActivityRetryTimer, 6 - TaskTypeWorkflowBackoffTimer, 7 - TaskTypeCompletionCallbackRetryTimer",
 					Value: cli.NewIntSlice(-1),
 				},
 				&cli.BoolFlag{
@@ -491,6 +494,40 @@ func newAdminDomainCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:  "diagnose",
+			Usage: "Diagnose a sample of the workflows of the domain which failed or timed out and aggregate their root causes",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:  FlagSampleSize,
+					Usage: "Maximum number of workflows diagnosed",
+					Value: diagnostics.DefaultDomainDiagnosticsSampleSize,
+				},
+				&cli.StringFlag{
+					Name:  FlagCheckRange,
+					Usage: "How far back the diagnosed workflows closed, e.g. 168h",
+					Value: diagnostics.DefaultDomainDiagnosticsWindow.String(),
+				},
+				&cli.StringFlag{
+					Name: FlagCronSchedule,
+					Usage: "Optional cron schedule to diagnose the domain periodically, e.g. \"0 0 * * 1\" for a weekly summary. " +
+						"Each run covers the range before it.",
+				},
+			},
+			Action: AdminDiagnoseDomain,
+		},
+		{
+			Name:  "diagnose-report",
+			Usage: "Show the report of the latest diagnostics of the domain",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "Optional domain diagnostics workflow runID, default is latest runID",
+				},
+			},
+			Action: AdminDiagnoseDomainReport,
+		},
 	}
 }
 
@@ -684,6 +721,110 @@ func newAdminElasticSearchCommands() []*cli.Command {
 	}
 }
 
+func newAdminVisibilityCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "rebuild",
+			Usage: "Re-emit the visibility records of the executions in the primary store into a visibility store",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name: FlagTargetVisibilityStore,
+					Usage: "Optional visibility store to write to: db, es, os or pinot. " +
+						"Defaults to the stores of the system.writeVisibilityStoreName dynamic config.",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagDomains,
+					Usage: "Optional domains to rebuild, eg d1,d2..,dn. All domains are rebuilt when not provided.",
+				},
+				&cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of visibility records written per second",
+					Value: visibilityrebuild.DefaultRPS,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of executions read per page",
+					Value: visibilityrebuild.DefaultPageSize,
+				},
+				&cli.StringFlag{
+					Name:  FlagResumeToken,
+					Usage: "Optional resume token printed by the status command, the rebuild starts from its position",
+				},
+			},
+			Action: AdminRebuildVisibility,
+		},
+		{
+			Name:  "status",
+			Usage: "Show the progress of the visibility rebuild",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "Optional visibility rebuild workflow runID, default is latest runID",
+				},
+			},
+			Action: AdminRebuildVisibilityStatus,
+		},
+		{
+			Name:  "check",
+			Usage: "Compare the records of two visibility stores on a sample of the executions of each domain",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSourceVisibilityStore,
+					Usage:    "Visibility store taken as the reference: db, es, os or pinot",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagTargetVisibilityStore,
+					Usage:    "Visibility store checked against the source: db, es, os or pinot",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagDomains,
+					Usage: "Optional domains to check, eg d1,d2..,dn. All domains are checked when not provided.",
+				},
+				&cli.IntFlag{
+					Name:  FlagSampleSize,
+					Usage: "Maximum number of open and of closed executions checked per domain",
+					Value: visibilityrebuild.DefaultSampleSize,
+				},
+				&cli.StringFlag{
+					Name:  FlagCheckRange,
+					Usage: "How far back the checked executions started or closed, e.g. 24h",
+					Value: visibilityrebuild.DefaultCheckRange.String(),
+				},
+				&cli.StringFlag{
+					Name:  FlagSettleDelay,
+					Usage: "Executions which started or closed more recently than this are not checked, e.g. 5m",
+					Value: visibilityrebuild.DefaultSettleDelay.String(),
+				},
+				&cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of records checked per second",
+					Value: visibilityrebuild.DefaultRPS,
+				},
+				&cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Rewrite the missing and mismatched records of the target store from the execution store",
+				},
+			},
+			Action: AdminCheckVisibility,
+		},
+		{
+			Name:  "check-report",
+			Usage: "Show the report of the visibility consistency check",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: []string{"r", "rid"},
+					Usage:   "Optional visibility consistency check workflow runID, default is latest runID",
+				},
+			},
+			Action: AdminCheckVisibilityReport,
+		},
+	}
+}
+
 func newAdminTaskListCommands() []*cli.Command {
 	return []*cli.Command{
 		{
@@ -744,6 +885,94 @@ func newAdminTaskListCommands() []*cli.Command {
 			},
 			Action: AdminUpdateTaskListPartitionConfig,
 		},
+		{
+			Name:        "backlog",
+			Aliases:     []string{"bl"},
+			Usage:       "Inspect and edit the persisted backlog of a tasklist partition",
+			Subcommands: newAdminTaskListBacklogCommands(),
+		},
+	}
+}
+
+func newAdminTaskListBacklogCommands() []*cli.Command {
+	taskListFlags := []cli.Flag{
+		&cli.StringFlag{
+			Name:     FlagTaskList,
+			Aliases:  []string{"tl"},
+			Usage:    "TaskList Name",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    FlagTaskListType,
+			Aliases: []string{"tlt"},
+			Value:   "decision",
+			Usage:   "TaskList type [decision|activity]",
+		},
+		&cli.IntFlag{
+			Name:  FlagPartition,
+			Usage: "Partition of the tasklist, 0 is the root partition",
+		},
+	}
+	// list works on the database directly, delete and move go through the matching hosts owning the task lists
+	dbTaskListFlags := append(getDBFlags(), taskListFlags...)
+	return []*cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the tasks in the backlog of a tasklist partition, starting at its ack level",
+			Flags: append(slices.Clone(dbTaskListFlags),
+				&cli.Int64Flag{
+					Name:  FlagMinTaskID,
+					Usage: "List tasks from this task ID instead of the ack level",
+				},
+				&cli.IntFlag{
+					Name:    FlagPageSize,
+					Aliases: []string{"ps"},
+					Value:   100,
+					Usage:   "Number of tasks to list",
+				},
+				&cli.BoolFlag{
+					Name:    FlagAll,
+					Aliases: []string{"a"},
+					Usage:   "List all tasks instead of a single page",
+				},
+				getFormatFlag(),
+			),
+			Action: AdminListTaskListBacklog,
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete tasks from the backlog of a tasklist partition, tasks already dispatched to a worker are not deleted",
+			Flags: append(slices.Clone(taskListFlags),
+				&cli.StringFlag{
+					Name:     FlagTaskIDs,
+					Usage:    "Comma separated task IDs or inclusive ranges to delete. Example: \"2,5-6,10\"",
+					Required: true,
+				},
+			),
+			Action: AdminDeleteTaskListBacklogTasks,
+		},
+		{
+			Name:  "move",
+			Usage: "Move a range of tasks from the backlog of a tasklist partition to another tasklist of the same type",
+			Flags: append(slices.Clone(taskListFlags),
+				&cli.StringFlag{
+					Name:     FlagDestinationTaskList,
+					Usage:    "Name of the tasklist to move the tasks to",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:     FlagMinTaskID,
+					Usage:    "First task ID of the range to move",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:  FlagMaxTaskID,
+					Usage: "Last task ID of the range to move, all tasks from min_task_id on are moved by default",
+				},
+			),
+			Action: AdminMoveTaskListBacklog,
+		},
 	}
 }
 
@@ -800,6 +1029,25 @@ func newAdminClusterCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage:   "Describe how far a remote cluster is behind in replicating the history shards of this cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTargetCluster,
+					Aliases:  []string{"tc"},
+					Usage:    "Remote cluster to describe the replication status of",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagShards,
+					Usage: "Comma separated shard IDs or inclusive ranges to describe instead of all shards. Example: \"2,5-6,10\"",
+				},
+				getFormatFlag(),
+			},
+			Action: AdminReplicationStatus,
+		},
 		{
 			Name:        "rebalance",
 			Aliases:     []string{"rb"},
@@ -1347,6 +1595,22 @@ func newAdminConfigStoreCommands() []*cli.Command {
 			},
 			Action: AdminGetDynamicConfig,
 		},
+		{
+			Name:  "resolve",
+			Usage: "Explain which Dynamic Config Value a lookup with the given filters returns and why",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagDynamicConfigName,
+					Usage:    "Name of Dynamic Config parameter to resolve",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagDynamicConfigFilter,
+					Usage: fmt.Sprintf(`Optional. ex: --%s '{"domainName":"global-samples-domain", "shardID":1, "isEnabled": true}'`, FlagDynamicConfigFilter),
+				},
+			},
+			Action: AdminResolveDynamicConfig,
+		},
 		{
 			Name:    "update",
 			Aliases: []string{"u"},
@@ -1362,6 +1626,10 @@ func newAdminConfigStoreCommands() []*cli.Command {
 					Usage:    fmt.Sprintf(`Can be specified multiple times for multiple values. ex: --%s '{"Value":true,"Filters":[]}'`, FlagDynamicConfigValue),
 					Required: true,
 				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Optional. Reason recorded with the change in the config store history",
+				},
 			},
 			Action: AdminUpdateDynamicConfig,
 		},
@@ -1379,6 +1647,10 @@ func newAdminConfigStoreCommands() []*cli.Command {
 					Name:  FlagDynamicConfigFilter,
 					Usage: fmt.Sprintf(`Optional. ex: --%s '{"domainName":"global-samples-domain", "shardID":1, "isEnabled": true}'`, FlagDynamicConfigFilter),
 				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Optional. Reason recorded with the change in the config store history",
+				},
 			},
 			Action: AdminRestoreDynamicConfig,
 		},
@@ -1396,6 +1668,57 @@ func newAdminConfigStoreCommands() []*cli.Command {
 			Flags:   []cli.Flag{getFormatFlag()},
 			Action:  AdminListConfigKeys,
 		},
+		{
+			Name:  "history",
+			Usage: "List the versions of the config store, latest first",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:    FlagPageSize,
+					Aliases: []string{"ps"},
+					Value:   20,
+					Usage:   "Number of versions to list",
+				},
+				&cli.BoolFlag{
+					Name:    FlagAll,
+					Aliases: []string{"a"},
+					Usage:   "List all versions instead of a single page",
+				},
+				getFormatFlag(),
+			},
+			Action: AdminDynamicConfigHistory,
+		},
+		{
+			Name:  "diff",
+			Usage: "Show the config values which differ between two versions of the config store",
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:     FlagDynamicConfigFromVersion,
+					Usage:    "Version to compare from",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:  FlagDynamicConfigToVersion,
+					Usage: "Optional. Version to compare to, the latest version when not set",
+				},
+			},
+			Action: AdminDiffDynamicConfig,
+		},
+		{
+			Name:  "rollback",
+			Usage: "Write the values of an earlier config store version as a new version",
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:     FlagDynamicConfigVersion,
+					Usage:    "Version to roll back to",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Optional. Reason recorded with the change in the config store history",
+				},
+			},
+			Action: AdminRollbackDynamicConfig,
+		},
 		{
 			Name:    "operational-get",
 			Aliases: []string{"og"},
@@ -23,6 +23,8 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/pborman/uuid"
@@ -107,6 +109,86 @@ func AdminDescribeCluster(c *cli.Context) error {
 	return nil
 }
 
+// ReplicationStatusRow is a row of the replication-status table, the last row is the summary of all shards
+type ReplicationStatusRow struct {
+	Shard             string `header:"Shard" json:"shard"`
+	AckedTaskID       int64  `header:"Acked Task ID" json:"ackedTaskID"`
+	MaxTaskID         int64  `header:"Max Task ID" json:"maxTaskID"`
+	TaskLag           int64  `header:"Task Lag" json:"taskLag"`
+	OldestPendingTask string `header:"Oldest Pending Task" json:"oldestPendingTask,omitempty"`
+	LagSeconds        int64  `header:"Lag (s)" json:"lagSeconds"`
+	DLQSize           int64  `header:"DLQ Size" json:"dlqSize"`
+	LastFetch         string `header:"Last Fetch" json:"lastFetch,omitempty"`
+	FetchErrors       int64  `header:"Fetch Errors" json:"fetchErrors"`
+	LastFetchError    string `header:"Last Fetch Error" json:"lastFetchError,omitempty" maxLength:"64"`
+}
+
+// AdminReplicationStatus describes how far the target cluster is behind in replicating the history shards of the
+// cluster the CLI talks to
+func AdminReplicationStatus(c *cli.Context) error {
+	targetCluster, err := getRequiredOption(c, FlagTargetCluster)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+	var shardIDs []int32
+	if c.IsSet(FlagShards) {
+		shards, err := parseIntMultiRange(c.String(FlagShards))
+		if err != nil {
+			return commoncli.Problem("Failed to parse shards", err)
+		}
+		for _, shardID := range shards {
+			shardIDs = append(shardIDs, int32(shardID))
+		}
+	}
+
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	resp, err := adminClient.DescribeReplicationStatus(ctx, &types.DescribeReplicationStatusRequest{
+		TargetCluster: targetCluster,
+		ShardIDs:      shardIDs,
+	})
+	if err != nil {
+		return commoncli.Problem("Operation DescribeReplicationStatus failed.", err)
+	}
+
+	var rows []ReplicationStatusRow
+	for _, shard := range resp.GetShards() {
+		rows = append(rows, newReplicationStatusRow(strconv.Itoa(int(shard.GetShardID())), shard.GetStatus()))
+	}
+	rows = append(rows, newReplicationStatusRow("all", resp.GetSummary()))
+	return Render(c, rows, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+func newReplicationStatusRow(shard string, status *types.ReplicationStatus) ReplicationStatusRow {
+	return ReplicationStatusRow{
+		Shard:             shard,
+		AckedTaskID:       status.GetAckedTaskID(),
+		MaxTaskID:         status.GetMaxTaskID(),
+		TaskLag:           status.GetTaskLag(),
+		OldestPendingTask: formatUnixNanos(status.GetOldestPendingTaskTime()),
+		LagSeconds:        status.GetLagSeconds(),
+		DLQSize:           status.GetDLQSize(),
+		LastFetch:         formatUnixNanos(status.GetFetcher().GetLastFetchTime()),
+		FetchErrors:       status.GetFetcher().GetErrorCount(),
+		LastFetchError:    status.GetFetcher().GetLastError(),
+	}
+}
+
+// formatUnixNanos formats an optional timestamp, unset ones are left empty
+func formatUnixNanos(nanos int64) string {
+	if nanos == 0 {
+		return ""
+	}
+	return time.Unix(0, nanos).Format(time.RFC3339)
+}
+
 func AdminRebalanceStart(c *cli.Context) error {
 	client, err := getCadenceClient(c)
 	if err != nil {
@@ -23,10 +23,13 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
 
@@ -206,6 +209,117 @@ func TestAdminDescribeCluster(t *testing.T) {
 	}
 }
 
+func TestAdminReplicationStatus(t *testing.T) {
+	oldest := time.Unix(1700000000, 0)
+	tests := []struct {
+		name         string
+		args         []clitest.CliArgument
+		mockSetup    func(td *cliTestData)
+		expectedErr  string
+		expectedRows []ReplicationStatusRow
+	}{
+		{
+			name: "lag, DLQ and fetcher of each shard",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagTargetCluster, "standby"),
+				clitest.StringArgument(FlagShards, "0-1"),
+				clitest.StringArgument(FlagFormat, formatJSON),
+			},
+			mockSetup: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().DescribeReplicationStatus(gomock.Any(), &types.DescribeReplicationStatusRequest{
+					TargetCluster: "standby",
+					ShardIDs:      []int32{0, 1},
+				}).Return(&types.DescribeReplicationStatusResponse{
+					Shards: []*types.ShardReplicationStatus{
+						{ShardID: 0, Status: &types.ReplicationStatus{AckedTaskID: 10, MaxTaskID: 10}},
+						{ShardID: 1, Status: &types.ReplicationStatus{
+							AckedTaskID:           20,
+							MaxTaskID:             25,
+							TaskLag:               5,
+							OldestPendingTaskTime: oldest.UnixNano(),
+							LagSeconds:            3600,
+							DLQSize:               3,
+							Fetcher:               &types.ReplicationFetcherStatus{LastFetchTime: oldest.UnixNano(), ErrorCount: 2, LastError: "boom"},
+						}},
+					},
+					Summary: &types.ReplicationStatus{
+						TaskLag:               5,
+						OldestPendingTaskTime: oldest.UnixNano(),
+						LagSeconds:            3600,
+						DLQSize:               3,
+					},
+				}, nil)
+			},
+			expectedRows: []ReplicationStatusRow{
+				{Shard: "0", AckedTaskID: 10, MaxTaskID: 10},
+				{
+					Shard:             "1",
+					AckedTaskID:       20,
+					MaxTaskID:         25,
+					TaskLag:           5,
+					OldestPendingTask: formatUnixNanos(oldest.UnixNano()),
+					LagSeconds:        3600,
+					DLQSize:           3,
+					LastFetch:         formatUnixNanos(oldest.UnixNano()),
+					FetchErrors:       2,
+					LastFetchError:    "boom",
+				},
+				{Shard: "all", TaskLag: 5, OldestPendingTask: formatUnixNanos(oldest.UnixNano()), LagSeconds: 3600, DLQSize: 3},
+			},
+		},
+		{
+			name: "all shards by default",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagTargetCluster, "standby"),
+				clitest.StringArgument(FlagFormat, formatJSON),
+			},
+			mockSetup: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().DescribeReplicationStatus(gomock.Any(), &types.DescribeReplicationStatusRequest{
+					TargetCluster: "standby",
+				}).Return(&types.DescribeReplicationStatusResponse{Summary: &types.ReplicationStatus{}}, nil)
+			},
+			expectedRows: []ReplicationStatusRow{{Shard: "all"}},
+		},
+		{
+			name: "invalid shards",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagTargetCluster, "standby"),
+				clitest.StringArgument(FlagShards, "a-b"),
+			},
+			mockSetup:   func(td *cliTestData) {},
+			expectedErr: "Failed to parse shards",
+		},
+		{
+			name: "describe error",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagTargetCluster, "standby"),
+			},
+			mockSetup: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().DescribeReplicationStatus(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("history down"))
+			},
+			expectedErr: "Operation DescribeReplicationStatus failed.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tt.mockSetup(td)
+			cliCtx := clitest.NewCLIContext(t, td.app, tt.args...)
+
+			err := AdminReplicationStatus(cliCtx)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			var rows []ReplicationStatusRow
+			require.NoError(t, json.Unmarshal([]byte(td.consoleOutput()), &rows))
+			assert.Equal(t, tt.expectedRows, rows)
+		})
+	}
+}
+
 func TestAdminRebalanceStart(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli/v2"
@@ -100,6 +101,71 @@ func AdminGetDynamicConfig(c *cli.Context) error {
 	return nil
 }
 
+// AdminResolveDynamicConfig explains which value of the specified dynamic config parameter a lookup with the
+// specified filter returns, together with the other configured values
+func AdminResolveDynamicConfig(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	configName, err := getRequiredOption(c, FlagDynamicConfigName)
+	if err != nil {
+		return commoncli.Problem("Required flag not found", err)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	parsedFilters, err := parseInputFilter(c.String(FlagDynamicConfigFilter))
+	if err != nil {
+		return commoncli.Problem("Failed to parse input filter array", err)
+	}
+
+	resp, err := adminClient.ResolveDynamicConfig(ctx, &types.ResolveDynamicConfigRequest{
+		ConfigName: configName,
+		Filters:    parsedFilters,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to resolve dynamic config value", err)
+	}
+
+	type candidate struct {
+		cliValue
+		Matched bool
+	}
+	type resolution struct {
+		cliValue
+		Source     string
+		IsDefault  bool         `json:"isDefault,omitempty"`
+		Candidates []*candidate `json:"candidates,omitempty"`
+		Detail     string       `json:"detail,omitempty"`
+	}
+
+	value, err := convertToInputValue(&types.DynamicConfigValue{Value: resp.GetValue(), Filters: resp.GetMatchedFilters()})
+	if err != nil {
+		return commoncli.Problem("Cannot parse resolve response", err)
+	}
+	result := &resolution{
+		cliValue:  *value,
+		Source:    resp.GetSource(),
+		IsDefault: resp.GetIsDefault(),
+		Detail:    resp.GetDetail(),
+	}
+	for _, dcCandidate := range resp.GetCandidates() {
+		value, err := convertToInputValue(&types.DynamicConfigValue{Value: dcCandidate.GetValue(), Filters: dcCandidate.GetFilters()})
+		if err != nil {
+			return commoncli.Problem("Cannot parse resolve response", err)
+		}
+		result.Candidates = append(result.Candidates, &candidate{cliValue: *value, Matched: dcCandidate.GetMatched()})
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), result)
+	return nil
+}
+
 // AdminUpdateDynamicConfig updates specified dynamic config parameter with specified values
 func AdminUpdateDynamicConfig(c *cli.Context) error {
 	adminClient, err := getDeps(c).ServerAdminClient(c)
@@ -157,6 +223,7 @@ func AdminUpdateDynamicConfig(c *cli.Context) error {
 	req := &types.UpdateDynamicConfigRequest{
 		ConfigName:   dcName,
 		ConfigValues: parsedValues,
+		Reason:       c.String(FlagReason),
 	}
 
 	err = adminClient.UpdateDynamicConfig(ctx, req)
@@ -194,6 +261,7 @@ func AdminRestoreDynamicConfig(c *cli.Context) error {
 	req := &types.RestoreDynamicConfigRequest{
 		ConfigName: dcName,
 		Filters:    parsedFilters,
+		Reason:     c.String(FlagReason),
 	}
 
 	err = adminClient.RestoreDynamicConfig(ctx, req)
@@ -241,6 +309,132 @@ func AdminListDynamicConfig(c *cli.Context) error {
 	return nil
 }
 
+// AdminDynamicConfigHistory lists the config store versions, latest first
+func AdminDynamicConfigHistory(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	type VersionRow struct {
+		Version   int64     `header:"Version" json:"version"`
+		Timestamp time.Time `header:"Timestamp" json:"timestamp"`
+		Author    string    `header:"Author" json:"author"`
+		Reason    string    `header:"Reason" json:"reason"`
+	}
+
+	var rows []VersionRow
+	req := &types.ListDynamicConfigVersionsRequest{PageSize: int32(c.Int(FlagPageSize))}
+	for {
+		resp, err := adminClient.ListDynamicConfigVersions(ctx, req)
+		if err != nil {
+			return commoncli.Problem("Failed to list dynamic config versions", err)
+		}
+		for _, version := range resp.GetVersions() {
+			row := VersionRow{
+				Version: version.Version,
+				Author:  version.Author,
+				Reason:  version.Reason,
+			}
+			if version.Timestamp != nil {
+				row.Timestamp = time.Unix(0, *version.Timestamp)
+			}
+			rows = append(rows, row)
+		}
+		if resp.GetNextMaxVersion() == 0 || !c.Bool(FlagAll) {
+			break
+		}
+		req.MaxVersion = resp.GetNextMaxVersion()
+	}
+	return Render(c, rows, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+// AdminDiffDynamicConfig shows the config entries which differ between two config store versions
+func AdminDiffDynamicConfig(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := adminClient.DiffDynamicConfigVersions(ctx, &types.DiffDynamicConfigVersionsRequest{
+		FromVersion: c.Int64(FlagDynamicConfigFromVersion),
+		ToVersion:   c.Int64(FlagDynamicConfigToVersion),
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to diff dynamic config versions", err)
+	}
+
+	type diffEntry struct {
+		Name string
+		From []*cliValue `json:"from,omitempty"`
+		To   []*cliValue `json:"to,omitempty"`
+	}
+	toCliValues := func(values []*types.DynamicConfigValue) ([]*cliValue, error) {
+		var converted []*cliValue
+		for _, value := range values {
+			cliValue, err := convertToInputValue(value)
+			if err != nil {
+				return nil, err
+			}
+			converted = append(converted, cliValue)
+		}
+		return converted, nil
+	}
+
+	diffs := make([]*diffEntry, 0, len(resp.Diffs))
+	for _, diff := range resp.Diffs {
+		from, err := toCliValues(diff.FromValues)
+		if err != nil {
+			return commoncli.Problem("Cannot parse diff response", err)
+		}
+		to, err := toCliValues(diff.ToValues)
+		if err != nil {
+			return commoncli.Problem("Cannot parse diff response", err)
+		}
+		diffs = append(diffs, &diffEntry{Name: diff.Name, From: from, To: to})
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Comparing version %d with version %d\n", resp.GetFrom().GetVersion(), resp.GetTo().GetVersion())
+	prettyPrintJSONObject(getDeps(c).Output(), diffs)
+	return nil
+}
+
+// AdminRollbackDynamicConfig writes the entries of an earlier config store version as a new version
+func AdminRollbackDynamicConfig(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	version := c.Int64(FlagDynamicConfigVersion)
+	resp, err := adminClient.RollbackDynamicConfig(ctx, &types.RollbackDynamicConfigRequest{
+		Version: version,
+		Reason:  c.String(FlagReason),
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to roll back dynamic config", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Dynamic config rolled back to version %d as version %d\n", version, resp.GetNewVersion())
+	return nil
+}
+
 // AdminGetOperationalDynamicConfig gets the value of the specified operational dynamic config
 // parameter (cassandra-backed store) matching the specified filter.
 func AdminGetOperationalDynamicConfig(c *cli.Context) error {
@@ -136,6 +136,59 @@ func TestAdminGetDynamicConfig(t *testing.T) {
 	}
 }
 
+func TestAdminResolveDynamicConfig(t *testing.T) {
+	jsonBlob := func(data string) *types.DataBlob {
+		return &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(data)}
+	}
+	tests := []struct {
+		name        string
+		cmdline     string
+		setupMocks  func(td *cliTestData)
+		errContains string // empty if no error is expected
+	}{
+		{
+			name:    "resolves a value",
+			cmdline: `cadence admin config resolve --name testGetIntPropertyKey --filter '{"domainName":"samples-domain"}'`,
+			setupMocks: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().ResolveDynamicConfig(gomock.Any(), &types.ResolveDynamicConfigRequest{
+					ConfigName: "testGetIntPropertyKey",
+					Filters:    []*types.DynamicConfigFilter{{Name: "domainName", Value: jsonBlob(`"samples-domain"`)}},
+				}).Return(&types.ResolveDynamicConfigResponse{
+					Value:          jsonBlob("10"),
+					Source:         "filebased",
+					MatchedFilters: []*types.DynamicConfigFilter{{Name: "domainName", Value: jsonBlob(`"samples-domain"`)}},
+					Candidates: []*types.DynamicConfigCandidate{
+						{Value: jsonBlob("5"), Matched: true},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:    "failed to resolve",
+			cmdline: `cadence admin config resolve --name testGetIntPropertyKey`,
+			setupMocks: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().ResolveDynamicConfig(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			errContains: "Failed to resolve dynamic config value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tt.setupMocks(td)
+
+			err := clitest.RunCommandLine(t, td.app, tt.cmdline)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				assert.Contains(t, td.consoleOutput(), `"Source": "filebased"`)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+		})
+	}
+}
+
 func TestAdminUpdateDynamicConfig(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -159,6 +212,18 @@ func TestAdminUpdateDynamicConfig(t *testing.T) {
 			},
 			errContains: "",
 		},
+		{
+			name:    "calling with a reason",
+			cmdline: `cadence admin config update --name test-dynamic-config-name --value "{}" --reason incident`,
+			setupMocks: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *types.UpdateDynamicConfigRequest, _ ...yarpc.CallOption) error {
+						assert.Equal(t, "incident", request.Reason)
+						return nil
+					})
+			},
+			errContains: "",
+		},
 		{
 			name:    "failed to update dynamic config values",
 			cmdline: `cadence admin config update --name test-dynamic-config-name --value "{}"`,
@@ -383,6 +448,157 @@ func TestAdminListDynamicConfig(t *testing.T) {
 	}
 }
 
+func TestAdminDynamicConfigHistory(t *testing.T) {
+	tests := []struct {
+		name        string
+		cmdline     string
+		setupMocks  func(td *cliTestData)
+		errContains string // empty if no error is expected
+	}{
+		{
+			name:    "lists a single page",
+			cmdline: `cadence admin config history --pagesize 2`,
+			setupMocks: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().ListDynamicConfigVersions(gomock.Any(), &types.ListDynamicConfigVersionsRequest{PageSize: 2}).
+					Return(&types.ListDynamicConfigVersionsResponse{
+						Versions:       []*types.DynamicConfigVersion{{Version: 5, Author: "alice"}, {Version: 4}},
+						NextMaxVersion: 3,
+					}, nil)
+			},
+		},
+		{
+			name:    "lists all pages",
+			cmdline: `cadence admin config history --pagesize 2 --all`,
+			setupMocks: func(td *cliTestData) {
+				gomock.InOrder(
+					td.mockAdminClient.EXPECT().ListDynamicConfigVersions(gomock.Any(), &types.ListDynamicConfigVersionsRequest{PageSize: 2}).
+						Return(&types.ListDynamicConfigVersionsResponse{
+							Versions:       []*types.DynamicConfigVersion{{Version: 3}, {Version: 2}},
+							NextMaxVersion: 1,
+						}, nil),
+					td.mockAdminClient.EXPECT().ListDynamicConfigVersions(gomock.Any(), &types.ListDynamicConfigVersionsRequest{PageSize: 2, MaxVersion: 1}).
+						Return(&types.ListDynamicConfigVersionsResponse{
+							Versions: []*types.DynamicConfigVersion{{Version: 1}},
+						}, nil),
+				)
+			},
+		},
+		{
+			name:    "failed to list versions",
+			cmdline: `cadence admin config history`,
+			setupMocks: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().ListDynamicConfigVersions(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			errContains: "Failed to list dynamic config versions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tt.setupMocks(td)
+
+			err := clitest.RunCommandLine(t, td.app, tt.cmdline)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestAdminDiffDynamicConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		cmdline     string
+		setupMocks  func(td *cliTestData)
+		errContains string // empty if no error is expected
+	}{
+		{
+			name:    "diffs two versions",
+			cmdline: `cadence admin config diff --from_version 2 --to_version 3`,
+			setupMocks: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().DiffDynamicConfigVersions(gomock.Any(), &types.DiffDynamicConfigVersionsRequest{FromVersion: 2, ToVersion: 3}).
+					Return(&types.DiffDynamicConfigVersionsResponse{
+						From: &types.DynamicConfigVersion{Version: 2},
+						To:   &types.DynamicConfigVersion{Version: 3},
+						Diffs: []*types.DynamicConfigEntryDiff{{
+							Name: "testGetBoolPropertyKey",
+							ToValues: []*types.DynamicConfigValue{{
+								Value: &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte("true")},
+							}},
+						}},
+					}, nil)
+			},
+		},
+		{
+			name:    "failed to diff versions",
+			cmdline: `cadence admin config diff --from_version 2`,
+			setupMocks: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().DiffDynamicConfigVersions(gomock.Any(), &types.DiffDynamicConfigVersionsRequest{FromVersion: 2}).Return(nil, assert.AnError)
+			},
+			errContains: "Failed to diff dynamic config versions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tt.setupMocks(td)
+
+			err := clitest.RunCommandLine(t, td.app, tt.cmdline)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				assert.Contains(t, td.consoleOutput(), "Comparing version 2 with version 3")
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestAdminRollbackDynamicConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		cmdline     string
+		setupMocks  func(td *cliTestData)
+		errContains string // empty if no error is expected
+	}{
+		{
+			name:    "rolls back",
+			cmdline: `cadence admin config rollback --version 3 --reason revert`,
+			setupMocks: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().RollbackDynamicConfig(gomock.Any(), &types.RollbackDynamicConfigRequest{Version: 3, Reason: "revert"}).
+					Return(&types.RollbackDynamicConfigResponse{NewVersion: 8}, nil)
+			},
+		},
+		{
+			name:    "failed to roll back",
+			cmdline: `cadence admin config rollback --version 3`,
+			setupMocks: func(td *cliTestData) {
+				td.mockAdminClient.EXPECT().RollbackDynamicConfig(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+			},
+			errContains: "Failed to roll back dynamic config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			tt.setupMocks(td)
+
+			err := clitest.RunCommandLine(t, td.app, tt.cmdline)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				assert.Contains(t, td.consoleOutput(), "rolled back to version 3 as version 8")
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+		})
+	}
+}
+
 func TestAdminListConfigKeys(t *testing.T) {
 	t.Run("list config keys", func(t *testing.T) {
 		td := newCLITestData(t)
@@ -0,0 +1,117 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+const domainDiagnosticsWorkflowTimeoutInSeconds = 24 * 60 * 60
+
+// AdminDiagnoseDomain starts the workflow diagnosing a sample of the workflows of a domain which failed or timed out
+func AdminDiagnoseDomain(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	sampleSize := c.Int(FlagSampleSize)
+	if sampleSize <= 0 || sampleSize > diagnostics.MaxDomainDiagnosticsSampleSize {
+		return commoncli.Problem(fmt.Sprintf("Sample size must be between 1 and %d", diagnostics.MaxDomainDiagnosticsSampleSize), nil)
+	}
+	window, err := time.ParseDuration(c.String(FlagCheckRange))
+	if err != nil || window <= 0 {
+		return commoncli.Problem(fmt.Sprintf("Invalid range: %s", c.String(FlagCheckRange)), err)
+	}
+	input, err := json.Marshal(diagnostics.DomainDiagnosticsWorkflowInput{
+		Domain:     domain,
+		Window:     window,
+		SampleSize: sampleSize,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to encode workflow parameters", err)
+	}
+
+	client, err := getCadenceClient(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	op, err := getOperatorFn()
+	if err != nil {
+		return commoncli.Problem("Error in getting operator: ", err)
+	}
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		constants.MemoKeyForOperator: op,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to serialize memo", err)
+	}
+
+	workflowID := diagnostics.DomainDiagnosticsWorkflowID(domain)
+	resp, err := client.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              constants.SystemLocalDomainName,
+		RequestID:                           uuidFn(),
+		WorkflowID:                          workflowID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: diagnostics.DomainDiagnosticsTaskListName},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(domainDiagnosticsWorkflowTimeoutInSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: diagnostics.DomainDiagnosticsWorkflowTypeName},
+		CronSchedule:                        c.String(FlagCronSchedule),
+		Input:                               input,
+	})
+	if err != nil {
+		return commoncli.Problem(fmt.Sprintf("Failed to start %s", diagnostics.DomainDiagnosticsWorkflowTypeName), err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Domain diagnostics is in progress. Workflow ID: %s, Run ID: %s\n", workflowID, resp.GetRunID())
+	return nil
+}
+
+// AdminDiagnoseDomainReport shows the report of the domain diagnostics workflow, it is partial while the workflow runs
+func AdminDiagnoseDomainReport(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	var report diagnostics.DomainDiagnosticsReport
+	if err := querySystemWorkflow(c, diagnostics.DomainDiagnosticsWorkflowID(domain), diagnostics.QueryDomainDiagnosticsReport, &report); err != nil {
+		return err
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), report)
+	return nil
+}
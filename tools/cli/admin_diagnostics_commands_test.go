@@ -0,0 +1,162 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/diagnostics"
+)
+
+func TestAdminDiagnoseDomain(t *testing.T) {
+	oldUUIDFn := uuidFn
+	uuidFn = func() string { return "test-uuid" }
+	oldGetOperatorFn := getOperatorFn
+	getOperatorFn = func() (string, error) { return "test-user", nil }
+	defer func() {
+		uuidFn = oldUUIDFn
+		getOperatorFn = oldGetOperatorFn
+	}()
+
+	tests := []struct {
+		desc    string
+		args    []string
+		mockFn  func(*testing.T, *frontend.MockClient)
+		wantErr bool
+	}{
+		{
+			desc: "it should start the domain diagnostics workflow with the given parameters",
+			args: []string{"--domain", "test-domain", "admin", "domain", "diagnose", "--sample_size", "50", "--range", "24h", "--cron", "0 0 * * 1"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				wantReq := &types.StartWorkflowExecutionRequest{
+					Domain:                              constants.SystemLocalDomainName,
+					RequestID:                           "test-uuid",
+					WorkflowID:                          diagnostics.DomainDiagnosticsWorkflowID("test-domain"),
+					WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+					TaskList:                            &types.TaskList{Name: diagnostics.DomainDiagnosticsTaskListName},
+					Input:                               []byte(`{"Domain":"test-domain","Window":86400000000000,"SampleSize":50}`),
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(domainDiagnosticsWorkflowTimeoutInSeconds),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+					Memo: mustGetWorkflowMemo(t, map[string]interface{}{
+						constants.MemoKeyForOperator: "test-user",
+					}),
+					WorkflowType: &types.WorkflowType{Name: diagnostics.DomainDiagnosticsWorkflowTypeName},
+					CronSchedule: "0 0 * * 1",
+				}
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, gotReq *types.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						if diff := cmp.Diff(wantReq, gotReq); diff != "" {
+							t.Fatalf("Request mismatch (-want +got):\n%s", diff)
+						}
+						return &types.StartWorkflowExecutionResponse{}, nil
+					}).Times(1)
+			},
+		},
+		{
+			desc: "defaults are used when no flag is provided",
+			args: []string{"--domain", "test-domain", "admin", "domain", "diagnose"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, gotReq *types.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, `{"Domain":"test-domain","Window":604800000000000,"SampleSize":100}`, string(gotReq.Input))
+						assert.Empty(t, gotReq.CronSchedule)
+						return &types.StartWorkflowExecutionResponse{}, nil
+					}).Times(1)
+			},
+		},
+		{
+			desc:    "missing domain",
+			args:    []string{"admin", "domain", "diagnose"},
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid sample size",
+			args:    []string{"--domain", "test-domain", "admin", "domain", "diagnose", "--sample_size", "0"},
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid range",
+			args:    []string{"--domain", "test-domain", "admin", "domain", "diagnose", "--range", "a week"},
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+		{
+			desc: "when StartWorkflowExecution fails it should return error",
+			args: []string{"--domain", "test-domain", "admin", "domain", "diagnose"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{}).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			frontendCl := frontend.NewMockClient(ctrl)
+			tc.mockFn(t, frontendCl)
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: frontendCl,
+			})
+
+			err := app.Run(append([]string{""}, tc.args...))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Got error: %v, wantErr?: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdminDiagnoseDomainReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	frontendCl := frontend.NewMockClient(ctrl)
+	frontendCl.EXPECT().QueryWorkflow(gomock.Any(), &types.QueryWorkflowRequest{
+		Domain: constants.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: diagnostics.DomainDiagnosticsWorkflowID("test-domain"),
+		},
+		Query: &types.WorkflowQuery{QueryType: diagnostics.QueryDomainDiagnosticsReport},
+	}).Return(&types.QueryWorkflowResponse{
+		QueryResult: []byte(`{"Domain":"test-domain","WorkflowsSampled":10,"RootCauses":[{"WorkflowType":"wf-type","Count":4}]}`),
+	}, nil)
+
+	ioHandler := &testIOHandler{}
+	app := NewCliApp(&clientFactoryMock{serverFrontendClient: frontendCl}, WithIOHandler(ioHandler))
+	require.NoError(t, app.Run([]string{"", "--domain", "test-domain", "admin", "domain", "diagnose-report"}))
+
+	assert.Contains(t, ioHandler.outputBytes.String(), `"WorkflowsSampled": 10`)
+	assert.Contains(t, ioHandler.outputBytes.String(), `"WorkflowType": "wf-type"`)
+}
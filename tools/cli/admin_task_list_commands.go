@@ -28,13 +28,17 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	"go.uber.org/multierr"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/matching/tasklist"
 	"github.com/uber/cadence/tools/common/commoncli"
 )
 
@@ -60,6 +64,13 @@ type (
 		ReadPartitions  map[int]*types.TaskListPartition `header:"Read Partitions"`
 		WritePartitions map[int]*types.TaskListPartition `header:"Write Partitions"`
 	}
+	TaskListBacklogRow struct {
+		TaskID      int64     `header:"Task ID" json:"taskID"`
+		WorkflowID  string    `header:"Workflow ID" json:"workflowID"`
+		RunID       string    `header:"Run ID" json:"runID"`
+		ScheduleID  int64     `header:"Schedule ID" json:"scheduleID"`
+		CreatedTime time.Time `header:"Created Time" json:"createdTime"`
+	}
 )
 
 // AdminDescribeTaskList displays poller and status information of task list.
@@ -349,3 +360,202 @@ func getTaskListTypes(c *cli.Context) ([]types.TaskListType, error) {
 	}
 	return taskListTypes, nil
 }
+
+// AdminListTaskListBacklog lists the persisted tasks of a task list partition, starting at its ack level
+func AdminListTaskListBacklog(c *cli.Context) error {
+	backlog, err := newTaskListBacklog(c)
+	if err != nil {
+		return err
+	}
+	var readLevel int64
+	if c.IsSet(FlagMinTaskID) {
+		readLevel = c.Int64(FlagMinTaskID) - 1
+	}
+
+	var rows []TaskListBacklogRow
+	for {
+		tasks, nextReadLevel, err := backlog.List(readLevel, c.Int(FlagPageSize))
+		if err != nil {
+			return commoncli.Problem("Failed to list task list backlog", err)
+		}
+		for _, task := range tasks {
+			rows = append(rows, TaskListBacklogRow{
+				TaskID:      task.TaskID,
+				WorkflowID:  task.WorkflowID,
+				RunID:       task.RunID,
+				ScheduleID:  task.ScheduleID,
+				CreatedTime: task.CreatedTime,
+			})
+		}
+		if nextReadLevel == 0 || !c.Bool(FlagAll) {
+			break
+		}
+		readLevel = nextReadLevel
+	}
+	return Render(c, rows, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+// AdminDeleteTaskListBacklogTasks deletes the given tasks from a task list partition
+func AdminDeleteTaskListBacklogTasks(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	var taskListType types.TaskListType
+	switch strings.ToLower(c.String(FlagTaskListType)) {
+	case "decision":
+		taskListType = types.TaskListTypeDecision
+	case "activity":
+		taskListType = types.TaskListTypeActivity
+	default:
+		return commoncli.Problem("Invalid task list type: valid types are 'activity' or 'decision'", nil)
+	}
+	ids, err := getRequiredOption(c, FlagTaskIDs)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	parsed, err := parseIntMultiRange(ids)
+	if err != nil {
+		return commoncli.Problem("Failed to parse task IDs", err)
+	}
+	taskIDs := make([]int64, 0, len(parsed))
+	for _, taskID := range parsed {
+		taskIDs = append(taskIDs, int64(taskID))
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := adminClient.DeleteTaskListBacklogTasks(ctx, &types.DeleteTaskListBacklogTasksRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: getPartitionTaskListName(taskList, c.Int(FlagPartition))},
+		TaskListType: taskListType.Ptr(),
+		TaskIDs:      taskIDs,
+	})
+	if err != nil {
+		return commoncli.Problem("Operation DeleteTaskListBacklogTasks failed", err)
+	}
+	deleted := make(map[int64]struct{}, len(resp.GetDeletedTaskIDs()))
+	for _, taskID := range resp.GetDeletedTaskIDs() {
+		deleted[taskID] = struct{}{}
+	}
+	var notDeleted []int64
+	for _, taskID := range taskIDs {
+		if _, ok := deleted[taskID]; !ok {
+			notDeleted = append(notDeleted, taskID)
+		}
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Deleted %d tasks\n", len(deleted))
+	if len(notDeleted) > 0 {
+		fmt.Fprintf(getDeps(c).Output(), "Tasks already dispatched or completed, not deleted: %v\n", notDeleted)
+	}
+	return nil
+}
+
+// AdminMoveTaskListBacklog moves a range of tasks from a task list partition to another task list of the same type
+func AdminMoveTaskListBacklog(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	var taskListType types.TaskListType
+	switch strings.ToLower(c.String(FlagTaskListType)) {
+	case "decision":
+		taskListType = types.TaskListTypeDecision
+	case "activity":
+		taskListType = types.TaskListTypeActivity
+	default:
+		return commoncli.Problem("Invalid task list type: valid types are 'activity' or 'decision'", nil)
+	}
+	destination, err := getRequiredOption(c, FlagDestinationTaskList)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	minTaskID, err := getRequiredInt64Option(c, FlagMinTaskID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := adminClient.MoveTaskListBacklog(ctx, &types.MoveTaskListBacklogRequest{
+		Domain:              domain,
+		TaskList:            &types.TaskList{Name: getPartitionTaskListName(taskList, c.Int(FlagPartition))},
+		TaskListType:        taskListType.Ptr(),
+		DestinationTaskList: &types.TaskList{Name: destination},
+		MinTaskID:           minTaskID,
+		MaxTaskID:           c.Int64(FlagMaxTaskID),
+	})
+	if err != nil {
+		return commoncli.Problem("Operation MoveTaskListBacklog failed. Tasks moved before the failure are not moved again on retry", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Moved %d tasks to %s\n", resp.GetMovedTasks(), destination)
+	return nil
+}
+
+func newTaskListBacklog(c *cli.Context) (*tasklist.Backlog, error) {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return nil, commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return nil, commoncli.Problem("Required flag not found: ", err)
+	}
+	var taskListType int
+	switch strings.ToLower(c.String(FlagTaskListType)) {
+	case "decision":
+		taskListType = persistence.TaskListTypeDecision
+	case "activity":
+		taskListType = persistence.TaskListTypeActivity
+	default:
+		return nil, commoncli.Problem("Invalid task list type: valid types are 'activity' or 'decision'", nil)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return nil, commoncli.Problem("Error in creating context: ", err)
+	}
+	domainManager, err := getDeps(c).initializeDomainManager(c)
+	if err != nil {
+		return nil, commoncli.Problem("Error in task list backlog: ", err)
+	}
+	resp, err := domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: domain})
+	if err != nil {
+		return nil, commoncli.Problem("Failed to get domain "+domain, err)
+	}
+	taskManager, err := getDeps(c).initializeTaskManager(c)
+	if err != nil {
+		return nil, commoncli.Problem("Error in task list backlog: ", err)
+	}
+	return tasklist.NewBacklog(
+		taskManager,
+		resp.Info.ID,
+		domain,
+		getPartitionTaskListName(taskList, c.Int(FlagPartition)),
+		taskListType,
+		log.NewNoop(),
+	), nil
+}
@@ -23,18 +23,22 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/tools/cli/clitest"
 )
@@ -836,3 +840,149 @@ func TestAdminUpdateTaskListPartitionConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminTaskListBacklog(t *testing.T) {
+	domainResp := &persistence.GetDomainResponse{Info: &persistence.DomainInfo{ID: testDomainID, Name: testDomain}}
+	created := time.Unix(100, 0)
+
+	tests := []struct {
+		name           string
+		args           []string
+		allowance      func(td *cliTestData, taskManager *persistence.MockTaskManager)
+		expectedOutput string
+		expectedErr    string
+		skipManagers   bool
+	}{
+		{
+			name: "list all pages",
+			args: []string{"list", "--tl", testTaskList, "--tlt", "activity", "--partition", "2", "--pagesize", "1", "--all", "--format", "json"},
+			allowance: func(td *cliTestData, taskManager *persistence.MockTaskManager) {
+				partition := getPartitionTaskListName(testTaskList, 2)
+				taskManager.EXPECT().GetTaskList(gomock.Any(), &persistence.GetTaskListRequest{
+					DomainID:   testDomainID,
+					DomainName: testDomain,
+					TaskList:   partition,
+					TaskType:   persistence.TaskListTypeActivity,
+				}).Return(&persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{AckLevel: 3}}, nil)
+				gomock.InOrder(
+					taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, req *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+							assert.Equal(t, partition, req.TaskList)
+							assert.Equal(t, int64(3), req.ReadLevel)
+							return &persistence.GetTasksResponse{Tasks: []*persistence.TaskInfo{
+								{WorkflowID: "wid1", RunID: "rid1", TaskID: 4, ScheduleID: 2, CreatedTime: created},
+							}}, nil
+						}),
+					taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, req *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+							assert.Equal(t, int64(4), req.ReadLevel)
+							return &persistence.GetTasksResponse{}, nil
+						}),
+				)
+			},
+			expectedOutput: "wid1",
+		},
+		{
+			name: "list from a task ID",
+			args: []string{"list", "--tl", testTaskList, "--min_task_id", "10"},
+			allowance: func(td *cliTestData, taskManager *persistence.MockTaskManager) {
+				taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+						assert.Equal(t, testTaskList, req.TaskList)
+						assert.Equal(t, persistence.TaskListTypeDecision, req.TaskType)
+						assert.Equal(t, int64(9), req.ReadLevel)
+						return &persistence.GetTasksResponse{}, nil
+					})
+			},
+		},
+		{
+			name: "delete",
+			args: []string{"delete", "--tl", testTaskList, "--task_ids", "3,5-6"},
+			allowance: func(td *cliTestData, taskManager *persistence.MockTaskManager) {
+				td.mockAdminClient.EXPECT().DeleteTaskListBacklogTasks(gomock.Any(), &types.DeleteTaskListBacklogTasksRequest{
+					Domain:       testDomain,
+					TaskList:     &types.TaskList{Name: testTaskList},
+					TaskListType: types.TaskListTypeDecision.Ptr(),
+					TaskIDs:      []int64{3, 5, 6},
+				}).Return(&types.DeleteTaskListBacklogTasksResponse{DeletedTaskIDs: []int64{3, 6}}, nil)
+			},
+			expectedOutput: "Deleted 2 tasks\nTasks already dispatched or completed, not deleted: [5]\n",
+			skipManagers:   true,
+		},
+		{
+			name: "delete fails",
+			args: []string{"delete", "--tl", testTaskList, "--task_ids", "3"},
+			allowance: func(td *cliTestData, taskManager *persistence.MockTaskManager) {
+				td.mockAdminClient.EXPECT().DeleteTaskListBacklogTasks(gomock.Any(), gomock.Any()).Return(nil, &types.ServiceBusyError{})
+			},
+			expectedErr:  "Operation DeleteTaskListBacklogTasks failed",
+			skipManagers: true,
+		},
+		{
+			name: "move",
+			args: []string{"move", "--tl", testTaskList, "--tlt", "activity", "--partition", "1", "--destination_tasklist", "other-tl", "--min_task_id", "5", "--max_task_id", "8"},
+			allowance: func(td *cliTestData, taskManager *persistence.MockTaskManager) {
+				td.mockAdminClient.EXPECT().MoveTaskListBacklog(gomock.Any(), &types.MoveTaskListBacklogRequest{
+					Domain:              testDomain,
+					TaskList:            &types.TaskList{Name: getPartitionTaskListName(testTaskList, 1)},
+					TaskListType:        types.TaskListTypeActivity.Ptr(),
+					DestinationTaskList: &types.TaskList{Name: "other-tl"},
+					MinTaskID:           5,
+					MaxTaskID:           8,
+				}).Return(&types.MoveTaskListBacklogResponse{MovedTasks: 1}, nil)
+			},
+			expectedOutput: "Moved 1 tasks to other-tl\n",
+			skipManagers:   true,
+		},
+		{
+			name:         "invalid task list type",
+			args:         []string{"list", "--tl", testTaskList, "--tlt", "sticky"},
+			allowance:    func(td *cliTestData, taskManager *persistence.MockTaskManager) {},
+			expectedErr:  "Invalid task list type",
+			skipManagers: true,
+		},
+		{
+			name:         "invalid task IDs",
+			args:         []string{"delete", "--tl", testTaskList, "--task_ids", "a-b"},
+			allowance:    func(td *cliTestData, taskManager *persistence.MockTaskManager) {},
+			expectedErr:  "Failed to parse task IDs",
+			skipManagers: true,
+		},
+		{
+			name: "move fails",
+			args: []string{"move", "--tl", testTaskList, "--destination_tasklist", "other-tl", "--min_task_id", "5"},
+			allowance: func(td *cliTestData, taskManager *persistence.MockTaskManager) {
+				td.mockAdminClient.EXPECT().MoveTaskListBacklog(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *types.MoveTaskListBacklogRequest, _ ...yarpc.CallOption) (*types.MoveTaskListBacklogResponse, error) {
+						assert.Equal(t, types.TaskListTypeDecision, req.GetTaskListType())
+						assert.Zero(t, req.MaxTaskID)
+						return nil, &types.ServiceBusyError{}
+					})
+			},
+			expectedErr:  "Operation MoveTaskListBacklog failed",
+			skipManagers: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			taskManager := persistence.NewMockTaskManager(td.ctrl)
+			if !tc.skipManagers {
+				domainManager := persistence.NewMockDomainManager(td.ctrl)
+				domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{Name: testDomain}).Return(domainResp, nil)
+				td.mockManagerFactory.EXPECT().initializeDomainManager(gomock.Any()).Return(domainManager, nil)
+				td.mockManagerFactory.EXPECT().initializeTaskManager(gomock.Any()).Return(taskManager, nil)
+			}
+			tc.allowance(td, taskManager)
+
+			err := td.app.Run(append([]string{"", "--do", testDomain, "admin", "tasklist", "backlog"}, tc.args...))
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Contains(t, td.consoleOutput(), tc.expectedOutput)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/visibilityrebuild"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+const (
+	visibilityRebuildWorkflowTimeoutInSeconds = 30 * 24 * 60 * 60
+	visibilityCheckWorkflowTimeoutInSeconds   = 7 * 24 * 60 * 60
+)
+
+// AdminRebuildVisibility starts the visibility rebuild workflow
+func AdminRebuildVisibility(c *cli.Context) error {
+	targetStore := c.String(FlagTargetVisibilityStore)
+	if targetStore != "" && !isVisibilityStore(targetStore) {
+		return commoncli.Problem(fmt.Sprintf("Invalid target visibility store: %s", targetStore), nil)
+	}
+	if c.Int(FlagRPS) <= 0 || c.Int(FlagPageSize) <= 0 {
+		return commoncli.Problem("RPS and page size must be positive", nil)
+	}
+
+	params := visibilityrebuild.RebuildParams{
+		TargetStore: targetStore,
+		Domains:     c.StringSlice(FlagDomains),
+		RPS:         c.Int(FlagRPS),
+		PageSize:    c.Int(FlagPageSize),
+	}
+	if c.IsSet(FlagResumeToken) {
+		resumeToken, err := decodeResumeToken(c.String(FlagResumeToken))
+		if err != nil {
+			return commoncli.Problem("Invalid resume token", err)
+		}
+		params.Progress.ResumeToken = resumeToken
+	}
+
+	runID, err := startVisibilityWorkflow(c, visibilityrebuild.WorkflowTypeName, visibilityrebuild.WorkflowID, visibilityRebuildWorkflowTimeoutInSeconds, params)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Visibility rebuild is in progress. Workflow ID: %s, Run ID: %s\n", visibilityrebuild.WorkflowID, runID)
+	return nil
+}
+
+// AdminRebuildVisibilityStatus shows the progress of the visibility rebuild workflow with the token to resume it from
+func AdminRebuildVisibilityStatus(c *cli.Context) error {
+	var progress visibilityrebuild.RebuildProgress
+	if err := querySystemWorkflow(c, visibilityrebuild.WorkflowID, visibilityrebuild.QueryTypeProgress, &progress); err != nil {
+		return err
+	}
+	resumeToken, err := encodeResumeToken(progress.ResumeToken)
+	if err != nil {
+		return commoncli.Problem("Failed to encode resume token", err)
+	}
+
+	prettyPrintJSONObject(getDeps(c).Output(), struct {
+		ShardID     int
+		Scanned     int64
+		Emitted     int64
+		ResumeToken string
+	}{
+		ShardID:     progress.ResumeToken.ShardID,
+		Scanned:     progress.Scanned,
+		Emitted:     progress.Emitted,
+		ResumeToken: resumeToken,
+	})
+	return nil
+}
+
+// AdminCheckVisibility starts the visibility consistency check workflow
+func AdminCheckVisibility(c *cli.Context) error {
+	sourceStore := c.String(FlagSourceVisibilityStore)
+	targetStore := c.String(FlagTargetVisibilityStore)
+	if !isVisibilityStore(sourceStore) || !isVisibilityStore(targetStore) || sourceStore == targetStore {
+		return commoncli.Problem(fmt.Sprintf("Invalid visibility stores to compare: %s and %s", sourceStore, targetStore), nil)
+	}
+	if c.Int(FlagRPS) <= 0 || c.Int(FlagSampleSize) <= 0 {
+		return commoncli.Problem("RPS and sample size must be positive", nil)
+	}
+	checkRange, err := time.ParseDuration(c.String(FlagCheckRange))
+	if err != nil || checkRange <= 0 {
+		return commoncli.Problem(fmt.Sprintf("Invalid range: %s", c.String(FlagCheckRange)), err)
+	}
+	settleDelay, err := time.ParseDuration(c.String(FlagSettleDelay))
+	if err != nil || settleDelay < 0 {
+		return commoncli.Problem(fmt.Sprintf("Invalid settle delay: %s", c.String(FlagSettleDelay)), err)
+	}
+
+	params := visibilityrebuild.ConsistencyCheckParams{
+		SourceStore: sourceStore,
+		TargetStore: targetStore,
+		Domains:     c.StringSlice(FlagDomains),
+		SampleSize:  c.Int(FlagSampleSize),
+		Range:       checkRange,
+		SettleDelay: settleDelay,
+		RPS:         c.Int(FlagRPS),
+		Repair:      c.Bool(FlagRepair),
+	}
+	runID, err := startVisibilityWorkflow(c, visibilityrebuild.ConsistencyCheckWorkflowTypeName, visibilityrebuild.ConsistencyCheckWorkflowID, visibilityCheckWorkflowTimeoutInSeconds, params)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Visibility consistency check is in progress. Workflow ID: %s, Run ID: %s\n", visibilityrebuild.ConsistencyCheckWorkflowID, runID)
+	return nil
+}
+
+// AdminCheckVisibilityReport shows the report of the visibility consistency check workflow, it is partial while
+// the check runs
+func AdminCheckVisibilityReport(c *cli.Context) error {
+	var report visibilityrebuild.ConsistencyReport
+	if err := querySystemWorkflow(c, visibilityrebuild.ConsistencyCheckWorkflowID, visibilityrebuild.QueryTypeReport, &report); err != nil {
+		return err
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), report)
+	return nil
+}
+
+func isVisibilityStore(store string) bool {
+	switch store {
+	case constants.VisibilityModeDB, constants.VisibilityModeES, constants.VisibilityModeOS, constants.VisibilityModePinot:
+		return true
+	default:
+		return false
+	}
+}
+
+// startVisibilityWorkflow starts a workflow of the visibility rebuild worker and returns its run ID
+func startVisibilityWorkflow(c *cli.Context, workflowType, workflowID string, timeoutInSeconds int32, params interface{}) (string, error) {
+	input, err := json.Marshal(params)
+	if err != nil {
+		return "", commoncli.Problem("Failed to encode workflow parameters", err)
+	}
+
+	client, err := getCadenceClient(c)
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return "", commoncli.Problem("Error in creating context: ", err)
+	}
+	op, err := getOperatorFn()
+	if err != nil {
+		return "", commoncli.Problem("Error in getting operator: ", err)
+	}
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		constants.MemoKeyForOperator: op,
+	})
+	if err != nil {
+		return "", commoncli.Problem("Failed to serialize memo", err)
+	}
+
+	resp, err := client.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              constants.SystemLocalDomainName,
+		RequestID:                           uuidFn(),
+		WorkflowID:                          workflowID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: visibilityrebuild.TaskListName},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(timeoutInSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: workflowType},
+		Input:                               input,
+	})
+	if err != nil {
+		return "", commoncli.Problem(fmt.Sprintf("Failed to start %s", workflowType), err)
+	}
+	return resp.GetRunID(), nil
+}
+
+// querySystemWorkflow queries a workflow of the system domain and decodes the result
+func querySystemWorkflow(c *cli.Context, workflowID, queryType string, result interface{}) error {
+	client, err := getCadenceClient(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := client.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain: constants.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      c.String(FlagRunID),
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: queryType,
+		},
+	})
+	if err != nil {
+		return commoncli.Problem(fmt.Sprintf("Failed to query %s", workflowID), err)
+	}
+	if resp.GetQueryResult() == nil {
+		return commoncli.Problem("QueryResult has no value", nil)
+	}
+	if err := json.Unmarshal(resp.GetQueryResult(), result); err != nil {
+		return commoncli.Problem("Unable to deserialize QueryResult", err)
+	}
+	return nil
+}
+
+func encodeResumeToken(token visibilityrebuild.ResumeToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+func decodeResumeToken(token string) (visibilityrebuild.ResumeToken, error) {
+	var resumeToken visibilityrebuild.ResumeToken
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return resumeToken, err
+	}
+	err = json.Unmarshal(data, &resumeToken)
+	return resumeToken, err
+}
@@ -0,0 +1,236 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/visibilityrebuild"
+)
+
+func TestAdminRebuildVisibility(t *testing.T) {
+	oldUUIDFn := uuidFn
+	uuidFn = func() string { return "test-uuid" }
+	oldGetOperatorFn := getOperatorFn
+	getOperatorFn = func() (string, error) { return "test-user", nil }
+	defer func() {
+		uuidFn = oldUUIDFn
+		getOperatorFn = oldGetOperatorFn
+	}()
+
+	resumeToken, err := encodeResumeToken(visibilityrebuild.ResumeToken{ShardID: 3, PageToken: []byte("page")})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		args    []string
+		mockFn  func(*testing.T, *frontend.MockClient)
+		wantErr bool
+	}{
+		{
+			desc: "it should start the rebuild workflow with the given parameters",
+			args: []string{"--target_store", "pinot", "--domains", "d1,d2", "--rps", "50", "--pagesize", "20", "--resume_token", resumeToken},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				wantReq := &types.StartWorkflowExecutionRequest{
+					Domain:                              constants.SystemLocalDomainName,
+					RequestID:                           "test-uuid",
+					WorkflowID:                          visibilityrebuild.WorkflowID,
+					WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+					TaskList:                            &types.TaskList{Name: visibilityrebuild.TaskListName},
+					Input:                               []byte(`{"target_store":"pinot","domains":["d1","d2"],"rps":50,"page_size":20,"progress":{"resume_token":{"shard_id":3,"page_token":"cGFnZQ=="},"scanned":0,"emitted":0}}`),
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(visibilityRebuildWorkflowTimeoutInSeconds),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+					Memo: mustGetWorkflowMemo(t, map[string]interface{}{
+						constants.MemoKeyForOperator: "test-user",
+					}),
+					WorkflowType: &types.WorkflowType{Name: visibilityrebuild.WorkflowTypeName},
+				}
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, gotReq *types.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						if diff := cmp.Diff(wantReq, gotReq); diff != "" {
+							t.Fatalf("Request mismatch (-want +got):\n%s", diff)
+						}
+						return &types.StartWorkflowExecutionResponse{}, nil
+					}).Times(1)
+			},
+		},
+		{
+			desc: "defaults are used when no flag is provided",
+			args: []string{},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, gotReq *types.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, `{"rps":100,"page_size":100,"progress":{"resume_token":{"shard_id":0},"scanned":0,"emitted":0}}`, string(gotReq.Input))
+						return &types.StartWorkflowExecutionResponse{}, nil
+					}).Times(1)
+			},
+		},
+		{
+			desc:    "invalid target store",
+			args:    []string{"--target_store", "cassandra"},
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid resume token",
+			args:    []string{"--resume_token", "not-base64!"},
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid rps",
+			args:    []string{"--rps", "0"},
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+		{
+			desc: "when StartWorkflowExecution fails it should return error",
+			args: []string{},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{}).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			frontendCl := frontend.NewMockClient(ctrl)
+			tc.mockFn(t, frontendCl)
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: frontendCl,
+			})
+
+			err := app.Run(append([]string{"", "admin", "visibility", "rebuild"}, tc.args...))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Got error: %v, wantErr?: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdminRebuildVisibilityStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	frontendCl := frontend.NewMockClient(ctrl)
+	frontendCl.EXPECT().QueryWorkflow(gomock.Any(), &types.QueryWorkflowRequest{
+		Domain: constants.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: visibilityrebuild.WorkflowID,
+			RunID:      "run-id",
+		},
+		Query: &types.WorkflowQuery{QueryType: visibilityrebuild.QueryTypeProgress},
+	}).Return(&types.QueryWorkflowResponse{
+		QueryResult: []byte(`{"resume_token":{"shard_id":3,"page_token":"cGFnZQ=="},"scanned":10,"emitted":8}`),
+	}, nil)
+
+	ioHandler := &testIOHandler{}
+	app := NewCliApp(&clientFactoryMock{serverFrontendClient: frontendCl}, WithIOHandler(ioHandler))
+	require.NoError(t, app.Run([]string{"", "admin", "visibility", "status", "--run_id", "run-id"}))
+
+	resumeToken, err := encodeResumeToken(visibilityrebuild.ResumeToken{ShardID: 3, PageToken: []byte("page")})
+	require.NoError(t, err)
+	assert.Contains(t, ioHandler.outputBytes.String(), `"Emitted": 8`)
+	assert.Contains(t, ioHandler.outputBytes.String(), resumeToken)
+}
+
+func TestAdminCheckVisibility(t *testing.T) {
+	tests := []struct {
+		desc    string
+		args    []string
+		mockFn  func(*testing.T, *frontend.MockClient)
+		wantErr bool
+	}{
+		{
+			desc: "it should start the consistency check workflow with the given parameters",
+			args: []string{"--source_store", "es", "--target_store", "pinot", "--domains", "d1", "--sample_size", "50", "--range", "2h", "--settle_delay", "1m", "--rps", "10", "--repair"},
+			mockFn: func(t *testing.T, m *frontend.MockClient) {
+				m.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, gotReq *types.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, visibilityrebuild.ConsistencyCheckWorkflowID, gotReq.WorkflowID)
+						assert.Equal(t, visibilityrebuild.ConsistencyCheckWorkflowTypeName, gotReq.WorkflowType.Name)
+						assert.Equal(t, visibilityrebuild.TaskListName, gotReq.TaskList.Name)
+						assert.Equal(t, `{"source_store":"es","target_store":"pinot","domains":["d1"],"sample_size":50,"range":7200000000000,"settle_delay":60000000000,"rps":10,"repair":true}`, string(gotReq.Input))
+						return &types.StartWorkflowExecutionResponse{}, nil
+					}).Times(1)
+			},
+		},
+		{
+			desc:    "same source and target store",
+			args:    []string{"--source_store", "es", "--target_store", "es"},
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+		{
+			desc:    "invalid range",
+			args:    []string{"--source_store", "db", "--target_store", "es", "--range", "a day"},
+			mockFn:  func(t *testing.T, m *frontend.MockClient) {},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			frontendCl := frontend.NewMockClient(ctrl)
+			tc.mockFn(t, frontendCl)
+			app := NewCliApp(&clientFactoryMock{
+				serverFrontendClient: frontendCl,
+			})
+
+			err := app.Run(append([]string{"", "admin", "visibility", "check"}, tc.args...))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Got error: %v, wantErr?: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdminCheckVisibilityReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	frontendCl := frontend.NewMockClient(ctrl)
+	frontendCl.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *types.QueryWorkflowRequest, opts ...yarpc.CallOption) (*types.QueryWorkflowResponse, error) {
+			assert.Equal(t, visibilityrebuild.ConsistencyCheckWorkflowID, req.Execution.WorkflowID)
+			assert.Equal(t, visibilityrebuild.QueryTypeReport, req.Query.QueryType)
+			return &types.QueryWorkflowResponse{
+				QueryResult: []byte(`{"source_store":"es","target_store":"pinot","domains":[{"domain":"d1","checked":10,"missing":2}]}`),
+			}, nil
+		})
+
+	ioHandler := &testIOHandler{}
+	app := NewCliApp(&clientFactoryMock{serverFrontendClient: frontendCl}, WithIOHandler(ioHandler))
+	require.NoError(t, app.Run([]string{"", "admin", "visibility", "check-report"}))
+	assert.Contains(t, ioHandler.outputBytes.String(), `"missing": 2`)
+}
@@ -192,6 +192,12 @@ func NewCliApp(cf ClientFactory, opts ...CLIAppOptions) *cli.App {
 					Usage:       "Run admin operation on ElasticSearch",
 					Subcommands: newAdminElasticSearchCommands(),
 				},
+				{
+					Name:        "visibility",
+					Aliases:     []string{"vis"},
+					Usage:       "Run admin operation on visibility",
+					Subcommands: newAdminVisibilityCommands(),
+				},
 				{
 					Name:        "tasklist",
 					Aliases:     []string{"tl"},
@@ -156,6 +156,7 @@ type ManagerFactory interface {
 	initializeHistoryManager(c *cli.Context) (persistence.HistoryManager, error)
 	initializeShardManager(c *cli.Context) (persistence.ShardManager, error)
 	initializeDomainManager(c *cli.Context) (persistence.DomainManager, error)
+	initializeTaskManager(c *cli.Context) (persistence.TaskManager, error)
 	initPersistenceFactory(c *cli.Context) (client.Factory, error)
 	initializeInvariantManager(ivs []invariant.Invariant) (invariant.Manager, error)
 }
@@ -212,6 +213,18 @@ func (f *defaultManagerFactory) initializeDomainManager(c *cli.Context) (persist
 	return domainManager, nil
 }
 
+func (f *defaultManagerFactory) initializeTaskManager(c *cli.Context) (persistence.TaskManager, error) {
+	factory, err := f.getPersistenceFactory(c)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get persistence factory: %w", err)
+	}
+	taskManager, err := factory.NewTaskManager()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to initialize task manager: %w", err)
+	}
+	return taskManager, nil
+}
+
 func (f *defaultManagerFactory) getPersistenceFactory(c *cli.Context) (client.Factory, error) {
 	var err error
 	if f.persistenceFactory == nil {
@@ -156,7 +156,7 @@ func newDomainCommands() []*cli.Command {
 		{
 			Name:    "failover",
 			Aliases: []string{"fo"},
-			Usage:   "Failover workflow domain to target active cluster",
+			Usage:   "Failover workflow domain to target active cluster, now or at a scheduled time once preconditions hold",
 			Flags:   failoverDomainFlags,
 			Action: func(c *cli.Context) error {
 				err := checkNoAdditionalArgsPassed(c)
@@ -167,6 +167,38 @@ func newDomainCommands() []*cli.Command {
 					return dc.FailoverDomain(c)
 				})
 			},
+			Subcommands: []*cli.Command{
+				{
+					Name:    "list-scheduled",
+					Aliases: []string{"ls"},
+					Usage:   "List pending scheduled failovers, of the given domain if --domain is set",
+					Flags:   listScheduledFailoversFlags,
+					Action: func(c *cli.Context) error {
+						err := checkNoAdditionalArgsPassed(c)
+						if err != nil {
+							return err
+						}
+						return withDomainClient(c, false, func(dc *domainCLIImpl) error {
+							return dc.ListScheduledFailovers(c)
+						})
+					},
+				},
+				{
+					Name:    "cancel-scheduled",
+					Aliases: []string{"cs"},
+					Usage:   "Cancel a pending scheduled failover",
+					Flags:   cancelScheduledFailoverFlags,
+					Action: func(c *cli.Context) error {
+						err := checkNoAdditionalArgsPassed(c)
+						if err != nil {
+							return err
+						}
+						return withDomainClient(c, false, func(dc *domainCLIImpl) error {
+							return dc.CancelScheduledFailover(c)
+						})
+					},
+				},
+			},
 		},
 		{
 			Name:    "list-failover-history",
@@ -44,6 +44,7 @@ import (
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/domaindeprecation"
+	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/tools/common/commoncli"
 	"github.com/uber/cadence/tools/common/flag"
 )
@@ -544,6 +545,10 @@ func (d *domainCLIImpl) FailoverDomain(c *cli.Context) error {
 		failoverRequest.FailoverTimeoutInSeconds = common.Int32Ptr(int32(c.Int(FlagFailoverTimeout)))
 	}
 
+	if isScheduledFailover(c) {
+		return d.scheduleFailover(ctx, c, failoverRequest)
+	}
+
 	_, err = d.failoverDomain(ctx, failoverRequest)
 	if err != nil {
 		if _, ok := err.(*types.EntityNotExistsError); ok {
@@ -555,6 +560,209 @@ func (d *domainCLIImpl) FailoverDomain(c *cli.Context) error {
 	return nil
 }
 
+// ScheduledFailoverRow is a pending scheduled failover
+type ScheduledFailoverRow struct {
+	WorkflowID    string    `header:"Workflow ID"`
+	Domain        string    `header:"Domain"`
+	TargetCluster string    `header:"Target Cluster"`
+	ScheduledTime time.Time `header:"Scheduled Time"`
+	Preconditions string    `header:"Preconditions"`
+	Operator      string    `header:"Operator"`
+}
+
+func isScheduledFailover(c *cli.Context) bool {
+	return c.IsSet(FlagScheduledTime) || c.IsSet(FlagMaxReplicationLag) || c.Bool(FlagRequireEmptyDLQ) || c.Bool(FlagRequireHealthyTarget)
+}
+
+// parseScheduledTime accepts either an absolute time or a duration from now
+func parseScheduledTime(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return now, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(d), nil
+	}
+	return time.Time{}, fmt.Errorf("cannot parse scheduled time '%s', use RFC3339 format '2006-01-02T15:04:05Z' or a duration such as '90m'", value)
+}
+
+// scheduleFailover starts a scheduled failover workflow for the request instead of failing the domain over now
+func (d *domainCLIImpl) scheduleFailover(ctx context.Context, c *cli.Context, request *types.FailoverDomainRequest) error {
+	now := time.Now()
+	scheduledTime, err := parseScheduledTime(c.String(FlagScheduledTime), now)
+	if err != nil {
+		return commoncli.Problem("Invalid scheduled time.", err)
+	}
+	if scheduledTime.Before(now) {
+		return commoncli.Problem(fmt.Sprintf("Scheduled time %s is in the past.", scheduledTime.Format(time.RFC3339)), nil)
+	}
+	if c.Int(FlagMaxReplicationLag) < 0 {
+		return commoncli.Problem("Max replication lag must not be negative.", nil)
+	}
+
+	params := failovermanager.ScheduledFailoverParams{
+		DomainName:             request.DomainName,
+		TargetCluster:          request.GetDomainActiveClusterName(),
+		ActiveClusters:         request.ActiveClusters,
+		FailoverTimeoutSeconds: request.GetFailoverTimeoutInSeconds(),
+		Reason:                 request.GetReason(),
+		ScheduledTime:          scheduledTime,
+		Preconditions: failovermanager.FailoverPreconditions{
+			MaxReplicationLagSeconds:    c.Int(FlagMaxReplicationLag),
+			RequireEmptyDLQ:             c.Bool(FlagRequireEmptyDLQ),
+			RequireHealthyTargetCluster: c.Bool(FlagRequireHealthyTarget),
+		},
+	}
+	input, err := json.Marshal(params)
+	if err != nil {
+		return commoncli.Problem("Failed to serialize params for scheduled failover workflow", err)
+	}
+	op, err := getOperator()
+	if err != nil {
+		return commoncli.Problem("Failed to get operator", err)
+	}
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		constants.MemoKeyForOperator:             op,
+		failovermanager.ScheduledFailoverMemoKey: params,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to serialize memo", err)
+	}
+
+	// leave the workflow a day past the scheduled time to check preconditions and fail over
+	timeout := scheduledTime.Sub(now) + 24*time.Hour
+	startRequest := &types.StartWorkflowExecutionRequest{
+		Domain:                              constants.SystemLocalDomainName,
+		WorkflowID:                          failovermanager.ScheduledFailoverWorkflowID(request.DomainName, uuid.New()),
+		RequestID:                           uuid.New(),
+		Identity:                            getCliIdentity(),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(timeout.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(defaultDecisionTimeoutInSeconds)),
+		Input:                               input,
+		TaskList: &types.TaskList{
+			Name: failovermanager.TaskListName,
+		},
+		Memo: memo,
+		WorkflowType: &types.WorkflowType{
+			Name: failovermanager.ScheduledFailoverWorkflowTypeName,
+		},
+	}
+	resp, err := d.frontendClient.StartWorkflowExecution(ctx, startRequest)
+	if err != nil {
+		return commoncli.Problem("Failed to start scheduled failover workflow", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Failover of domain %s scheduled for %s. Workflow ID: %s, Run ID: %s\n",
+		request.DomainName, scheduledTime.Format(time.RFC3339), startRequest.WorkflowID, resp.GetRunID())
+	return nil
+}
+
+// ListScheduledFailovers lists the scheduled failovers which have not run yet
+func (d *domainCLIImpl) ListScheduledFailovers(c *cli.Context) error {
+	domainName := c.String(FlagDomain)
+	var rows []ScheduledFailoverRow
+	var nextPageToken []byte
+	for {
+		ctx, cancel, err := newContext(c)
+		if err != nil {
+			return commoncli.Problem("Error in creating context: ", err)
+		}
+		resp, err := d.frontendClient.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+			Domain:          constants.SystemLocalDomainName,
+			MaximumPageSize: 100,
+			NextPageToken:   nextPageToken,
+			StartTimeFilter: &types.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+			},
+			TypeFilter: &types.WorkflowTypeFilter{Name: failovermanager.ScheduledFailoverWorkflowTypeName},
+		})
+		cancel()
+		if err != nil {
+			return commoncli.Problem("Failed to list scheduled failovers.", err)
+		}
+		for _, execution := range resp.GetExecutions() {
+			row, err := scheduledFailoverRow(execution)
+			if err != nil {
+				return commoncli.Problem("Failed to decode scheduled failover.", err)
+			}
+			if domainName == "" || row.Domain == domainName {
+				rows = append(rows, row)
+			}
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	return Render(c, rows, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+func scheduledFailoverRow(execution *types.WorkflowExecutionInfo) (ScheduledFailoverRow, error) {
+	row := ScheduledFailoverRow{WorkflowID: execution.GetExecution().GetWorkflowID()}
+	fields := execution.Memo.GetFields()
+	if data, ok := fields[constants.MemoKeyForOperator]; ok {
+		if err := json.Unmarshal(data, &row.Operator); err != nil {
+			return row, err
+		}
+	}
+	var params failovermanager.ScheduledFailoverParams
+	if data, ok := fields[failovermanager.ScheduledFailoverMemoKey]; ok {
+		if err := json.Unmarshal(data, &params); err != nil {
+			return row, err
+		}
+	}
+	row.Domain = params.DomainName
+	row.TargetCluster = params.TargetCluster
+	if row.TargetCluster == "" && params.ActiveClusters != nil {
+		row.TargetCluster = "cluster attributes"
+	}
+	row.ScheduledTime = params.ScheduledTime
+
+	var preconditions []string
+	if params.Preconditions.MaxReplicationLagSeconds > 0 {
+		preconditions = append(preconditions, fmt.Sprintf("lag <= %ds", params.Preconditions.MaxReplicationLagSeconds))
+	}
+	if params.Preconditions.RequireEmptyDLQ {
+		preconditions = append(preconditions, "empty DLQ")
+	}
+	if params.Preconditions.RequireHealthyTargetCluster {
+		preconditions = append(preconditions, "healthy target")
+	}
+	row.Preconditions = strings.Join(preconditions, ", ")
+	return row, nil
+}
+
+// CancelScheduledFailover cancels a scheduled failover. The cancellation is recorded in the failover history.
+func (d *domainCLIImpl) CancelScheduledFailover(c *cli.Context) error {
+	workflowID, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	if !strings.HasPrefix(workflowID, failovermanager.ScheduledFailoverWorkflowIDPrefix) {
+		return commoncli.Problem(fmt.Sprintf("%s is not a scheduled failover workflow ID.", workflowID), nil)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+	err = d.frontendClient.RequestCancelWorkflowExecution(ctx, &types.RequestCancelWorkflowExecutionRequest{
+		Domain:            constants.SystemLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: workflowID},
+		Identity:          getCliIdentity(),
+		RequestID:         uuid.New(),
+		Cause:             c.String(FlagReason),
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to cancel scheduled failover.", err)
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Scheduled failover %s cancelled.\n", workflowID)
+	return nil
+}
+
 // FailoverDomains is used for managed failover all domains with domain data IsManagedByCadence=true
 func (d *domainCLIImpl) FailoverDomains(c *cli.Context) error {
 	// ask user for confirmation
@@ -660,6 +868,8 @@ VisibilityArchivalURI: {{.}}{{end}}
 {{table .}}{{end}}
 {{with .FailoverInfo}}Graceful failover info:
 {{table .}}{{end}}
+{{with .OpenWorkflowUsage}}Open workflow usage:
+{{table .}}{{end}}
 {{if .IsActiveActiveDomain}}ActiveClustersByClusterAttribute: {{.ClusterAttributeCount}} cluster attribute(s), omitted here as the list can be very large. To see them use --format json.
 {{end}}`
 
@@ -734,6 +944,12 @@ type FailoverHistoryRow struct {
 	Attribute   string    `header:"Cluster Attribute"`
 }
 
+type OpenWorkflowUsageRow struct {
+	WorkflowType      string `header:"Workflow Type"`
+	OpenWorkflowCount int64  `header:"Open Workflows"`
+	OpenWorkflowLimit int64  `header:"Limit"`
+}
+
 type DomainRow struct {
 	Name                     string `header:"Name"`
 	UUID                     string `header:"UUID"`
@@ -752,6 +968,7 @@ type DomainRow struct {
 	VisibilityArchivalURI    string               `header:"Visibility Archival URI"`
 	BadBinaries              []BadBinaryRow
 	FailoverInfo             *FailoverInfoRow
+	OpenWorkflowUsage        []OpenWorkflowUsageRow
 	LongRunningWorkFlowNum   *int
 	IsActiveActiveDomain     bool
 	// ActiveClusters can be very large for active-active domains, so it is only
@@ -814,6 +1031,7 @@ func newDomainRow(domain *types.DescribeDomainResponse) DomainRow {
 		VisibilityArchivalURI:    domain.Configuration.GetVisibilityArchivalURI(),
 		BadBinaries:              newBadBinaryRows(domain.Configuration.BadBinaries),
 		FailoverInfo:             newFailoverInfoRow(domain.FailoverInfo),
+		OpenWorkflowUsage:        newOpenWorkflowUsageRows(domain.OpenWorkflowUsage),
 		IsActiveActiveDomain:     domain.ReplicationConfiguration.IsActiveActive(),
 		ActiveClusters:           domain.ReplicationConfiguration.GetActiveClusters(),
 	}
@@ -832,6 +1050,26 @@ func newFailoverInfoRow(info *types.FailoverInfo) *FailoverInfoRow {
 	}
 }
 
+// newOpenWorkflowUsageRows lists the domain first, a limit of 0 means unlimited
+func newOpenWorkflowUsageRows(usage *types.OpenWorkflowUsage) []OpenWorkflowUsageRow {
+	if usage == nil {
+		return nil
+	}
+	rows := []OpenWorkflowUsageRow{{
+		WorkflowType:      "(all)",
+		OpenWorkflowCount: usage.GetOpenWorkflowCount(),
+		OpenWorkflowLimit: usage.GetOpenWorkflowLimit(),
+	}}
+	for _, workflowType := range usage.GetWorkflowTypes() {
+		rows = append(rows, OpenWorkflowUsageRow{
+			WorkflowType:      workflowType.GetWorkflowType(),
+			OpenWorkflowCount: workflowType.GetOpenWorkflowCount(),
+			OpenWorkflowLimit: workflowType.GetOpenWorkflowLimit(),
+		})
+	}
+	return rows
+}
+
 func newBadBinaryRows(bb *types.BadBinaries) []BadBinaryRow {
 	if bb == nil {
 		return nil
@@ -871,8 +1109,12 @@ func renderFailoverHistoryTableToWriter(writer interface{ Write([]byte) (int, er
 		clusterFailovers := event.GetClusterFailovers()
 
 		if len(clusterFailovers) == 0 {
-			// If no cluster failovers, show event info only
-			table.Append([]string{eventID, createdTime, "-", "-", "-"})
+			// If no cluster failovers, show event info only, e.g. why a scheduled failover didn't run
+			failover := "-"
+			if comment := event.GetComment(); comment != "" {
+				failover = comment
+			}
+			table.Append([]string{eventID, createdTime, failover, "-", "-"})
 			continue
 		}
 
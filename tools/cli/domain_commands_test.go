@@ -23,16 +23,22 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/tools/cli/clitest"
 )
 
@@ -904,6 +910,21 @@ func TestRenderFailoverHistoryTable(t *testing.T) {
 				"2023-11-14", // Just check date, not full timestamp with timezone
 			},
 		},
+		"scheduled failover outcome without cluster failovers": {
+			response: &types.ListFailoverHistoryResponse{
+				FailoverEvents: []*types.FailoverEvent{
+					{
+						ID:          common.StringPtr("event-10"),
+						CreatedTime: common.Int64Ptr(1700000000000000000),
+						Comment:     common.StringPtr("scheduled failover cancelled (workflow wid)"),
+					},
+				},
+			},
+			expectedOutput: []string{
+				"event-10",
+				"scheduled failover cancelled (workflow wid)",
+			},
+		},
 		"failover event with nil FromCluster and ToCluster": {
 			response: &types.ListFailoverHistoryResponse{
 				FailoverEvents: []*types.FailoverEvent{
@@ -1066,3 +1087,155 @@ func TestDescribeDomain_ActiveActiveOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestDescribeDomain_OpenWorkflowUsageOutput(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	serverFrontendClient := frontend.NewMockClient(ctrl)
+	ioHandler := &testIOHandler{}
+	app := NewCliApp(
+		&clientFactoryMock{serverFrontendClient: serverFrontendClient},
+		WithIOHandler(ioHandler),
+	)
+	serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{
+			Name: "test-domain",
+			UUID: "test-uuid",
+		},
+		Configuration:            &types.DomainConfiguration{},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{},
+		OpenWorkflowUsage: &types.OpenWorkflowUsage{
+			OpenWorkflowCount: 42,
+			OpenWorkflowLimit: 100,
+			WorkflowTypes: []*types.WorkflowTypeOpenWorkflowUsage{
+				{WorkflowType: "wf-type", OpenWorkflowCount: 3, OpenWorkflowLimit: 10},
+			},
+		},
+	}, nil)
+
+	err := clitest.RunCommandLine(t, app, "cadence --do test-domain domain describe")
+	assert.NoError(t, err)
+
+	out := ioHandler.outputBytes.String()
+	assert.Contains(t, out, "Open workflow usage:")
+	assert.Regexp(t, `\(all\)\s*\|\s*42\s*\|\s*100`, out)
+	assert.Regexp(t, `wf-type\s*\|\s*3\s*\|\s*10`, out)
+}
+
+func TestParseScheduledTime(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := map[string]struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		"empty means now": {value: "", want: now},
+		"RFC3339":         {value: "2024-01-03T00:00:00Z", want: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)},
+		"duration":        {value: "90m", want: now.Add(90 * time.Minute)},
+		"negative":        {value: "-1h", wantErr: true},
+		"garbage":         {value: "tomorrow", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseScheduledTime(tc.value, now)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tc.want.Equal(got), "want %v, got %v", tc.want, got)
+		})
+	}
+}
+
+func TestFailoverDomain_WhenScheduledItStartsTheScheduledFailoverWorkflow(t *testing.T) {
+	td := newCLITestData(t)
+	td.mockFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			assert.Equal(t, constants.SystemLocalDomainName, req.Domain)
+			assert.Equal(t, failovermanager.ScheduledFailoverWorkflowTypeName, req.WorkflowType.GetName())
+			assert.Equal(t, failovermanager.TaskListName, req.TaskList.GetName())
+			assert.True(t, strings.HasPrefix(req.WorkflowID, failovermanager.ScheduledFailoverWorkflowIDPrefix+"test-domain-"))
+
+			var params failovermanager.ScheduledFailoverParams
+			assert.NoError(t, json.Unmarshal(req.Input, &params))
+			assert.Equal(t, "test-domain", params.DomainName)
+			assert.Equal(t, "cluster1", params.TargetCluster)
+			assert.Equal(t, failovermanager.FailoverPreconditions{
+				MaxReplicationLagSeconds:    30,
+				RequireEmptyDLQ:             true,
+				RequireHealthyTargetCluster: true,
+			}, params.Preconditions)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), params.ScheduledTime, time.Minute)
+			assert.Contains(t, req.Memo.GetFields(), failovermanager.ScheduledFailoverMemoKey)
+			return &types.StartWorkflowExecutionResponse{RunID: "run-id"}, nil
+		})
+
+	err := clitest.RunCommandLine(t, td.app,
+		"cadence --do test-domain domain failover --ac cluster1 --scheduled_time 1h --max_replication_lag_seconds 30 --require_empty_dlq --require_healthy_target")
+	assert.NoError(t, err)
+	assert.Contains(t, td.consoleOutput(), "Failover of domain test-domain scheduled for")
+	assert.Contains(t, td.consoleOutput(), "Run ID: run-id")
+}
+
+func TestFailoverDomain_WhenScheduledTimeIsInThePastItFails(t *testing.T) {
+	td := newCLITestData(t)
+	err := clitest.RunCommandLine(t, td.app, "cadence --do test-domain domain failover --ac cluster1 --scheduled_time 2000-01-01T00:00:00Z")
+	assert.ErrorContains(t, err, "is in the past")
+}
+
+func TestListScheduledFailovers(t *testing.T) {
+	newExecution := func(workflowID string, params failovermanager.ScheduledFailoverParams) *types.WorkflowExecutionInfo {
+		memo, err := getWorkflowMemo(map[string]interface{}{
+			constants.MemoKeyForOperator:             "operator",
+			failovermanager.ScheduledFailoverMemoKey: params,
+		})
+		assert.NoError(t, err)
+		return &types.WorkflowExecutionInfo{
+			Execution: &types.WorkflowExecution{WorkflowID: workflowID},
+			Memo:      memo,
+		}
+	}
+
+	td := newCLITestData(t)
+	td.mockFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *types.ListOpenWorkflowExecutionsRequest, _ ...yarpc.CallOption) (*types.ListOpenWorkflowExecutionsResponse, error) {
+			assert.Equal(t, constants.SystemLocalDomainName, req.Domain)
+			assert.Equal(t, failovermanager.ScheduledFailoverWorkflowTypeName, req.TypeFilter.GetName())
+			return &types.ListOpenWorkflowExecutionsResponse{
+				Executions: []*types.WorkflowExecutionInfo{
+					newExecution("wid-1", failovermanager.ScheduledFailoverParams{
+						DomainName:    "test-domain",
+						TargetCluster: "cluster1",
+						Preconditions: failovermanager.FailoverPreconditions{RequireEmptyDLQ: true},
+					}),
+					newExecution("wid-2", failovermanager.ScheduledFailoverParams{DomainName: "other-domain", TargetCluster: "cluster1"}),
+				},
+			}, nil
+		})
+
+	err := clitest.RunCommandLine(t, td.app, "cadence --do test-domain domain failover list-scheduled")
+	assert.NoError(t, err)
+	assert.Contains(t, td.consoleOutput(), "wid-1")
+	assert.Contains(t, td.consoleOutput(), "empty DLQ")
+	assert.NotContains(t, td.consoleOutput(), "wid-2")
+}
+
+func TestCancelScheduledFailover(t *testing.T) {
+	workflowID := failovermanager.ScheduledFailoverWorkflowID("test-domain", "id")
+
+	td := newCLITestData(t)
+	td.mockFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *types.RequestCancelWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			assert.Equal(t, constants.SystemLocalDomainName, req.Domain)
+			assert.Equal(t, workflowID, req.WorkflowExecution.GetWorkflowID())
+			assert.Equal(t, "maintenance moved", req.Cause)
+			return nil
+		})
+
+	err := clitest.RunCommandLine(t, td.app, "cadence --do test-domain domain failover cancel-scheduled --wid "+workflowID+" --reason 'maintenance moved'")
+	assert.NoError(t, err)
+	assert.Contains(t, td.consoleOutput(), "Scheduled failover "+workflowID+" cancelled.")
+
+	err = clitest.RunCommandLine(t, td.app, "cadence --do test-domain domain failover cancel-scheduled --wid some-other-workflow")
+	assert.ErrorContains(t, err, "is not a scheduled failover workflow ID")
+}
@@ -303,6 +303,42 @@ var (
 			Aliases: []string{"fts"},
 			Usage:   "[Optional] Graceful failover timeout in seconds. When set, the incoming active cluster waits up to this duration for pending replication tasks to drain before taking over",
 		},
+		&cli.StringFlag{
+			Name:    FlagScheduledTime,
+			Aliases: []string{"at"},
+			Usage:   "[Optional] Schedule the failover instead of running it now. Either a time in RFC3339 format, e.g. '2006-01-02T15:04:05Z', or a duration from now, e.g. '90m'",
+		},
+		&cli.IntFlag{
+			Name:    FlagMaxReplicationLag,
+			Aliases: []string{"mrl"},
+			Usage:   "[Optional] Only fail over if the target cluster is at most this many seconds behind. Must be run against the domain's active cluster",
+		},
+		&cli.BoolFlag{
+			Name:  FlagRequireEmptyDLQ,
+			Usage: "[Optional] Only fail over if the target cluster has no replication DLQ messages",
+		},
+		&cli.BoolFlag{
+			Name:  FlagRequireHealthyTarget,
+			Usage: "[Optional] Only fail over if every service of the target cluster has hosts",
+		},
+	}
+
+	listScheduledFailoversFlags = []cli.Flag{
+		getFormatFlag(),
+	}
+
+	cancelScheduledFailoverFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:     FlagWorkflowID,
+			Aliases:  []string{"wid"},
+			Usage:    "Workflow ID of the scheduled failover, as shown by list-scheduled",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    FlagReason,
+			Aliases: []string{"re"},
+			Usage:   "Reason for cancelling the scheduled failover",
+		},
 	}
 
 	listFailoverHistoryFlags = []cli.Flag{
@@ -45,6 +45,7 @@ import (
 
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	frontendv1 "github.com/uber/cadence/.gen/proto/frontend/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
@@ -132,6 +133,7 @@ func (b *clientFactory) ServerFrontendClient(c *cli.Context) (frontend.Client, e
 			apiv1.NewWorkerAPIYARPCClient(clientConfig),
 			apiv1.NewVisibilityAPIYARPCClient(clientConfig),
 			apiv1.NewScheduleAPIYARPCClient(clientConfig),
+			frontendv1.NewBatchAPIYARPCClient(clientConfig),
 		), nil
 	}
 	return thrift.NewFrontendClient(serverFrontend.New(clientConfig)), nil
@@ -145,7 +147,10 @@ func (b *clientFactory) ServerAdminClient(c *cli.Context) (admin.Client, error)
 	}
 	clientConfig := b.dispatcher.ClientConfig(cadenceFrontendService)
 	if c.String(FlagTransport) == grpcTransport {
-		return grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig)), nil
+		return grpcClient.NewAdminClient(
+			adminv1.NewAdminAPIYARPCClient(clientConfig),
+			frontendv1.NewAdminExtensionAPIYARPCClient(clientConfig),
+		), nil
 	}
 	return thrift.NewAdminClient(serverAdmin.New(clientConfig)), nil
 }
@@ -164,6 +169,7 @@ func (b *clientFactory) ServerFrontendClientForMigration(c *cli.Context) (fronte
 			apiv1.NewWorkerAPIYARPCClient(clientConfig),
 			apiv1.NewVisibilityAPIYARPCClient(clientConfig),
 			apiv1.NewScheduleAPIYARPCClient(clientConfig),
+			frontendv1.NewBatchAPIYARPCClient(clientConfig),
 		), nil
 	}
 	return thrift.NewFrontendClient(serverFrontend.New(clientConfig)), nil
@@ -177,7 +183,10 @@ func (b *clientFactory) ServerAdminClientForMigration(c *cli.Context) (admin.Cli
 	}
 	clientConfig := b.dispatcherMigration.ClientConfig(cadenceFrontendService)
 	if c.String(FlagTransport) == grpcTransport {
-		return grpcClient.NewAdminClient(adminv1.NewAdminAPIYARPCClient(clientConfig)), nil
+		return grpcClient.NewAdminClient(
+			adminv1.NewAdminAPIYARPCClient(clientConfig),
+			frontendv1.NewAdminExtensionAPIYARPCClient(clientConfig),
+		), nil
 	}
 	return thrift.NewAdminClient(serverAdmin.New(clientConfig)), nil
 }
@@ -176,6 +176,10 @@ const (
 	FlagBatchType                      = "batch_type"
 	FlagSignalName                     = "signal_name"
 	FlagTaskID                         = "task_id"
+	FlagTaskIDs                        = "task_ids"
+	FlagMinTaskID                      = "min_task_id"
+	FlagMaxTaskID                      = "max_task_id"
+	FlagDestinationTaskList            = "destination_tasklist"
 	FlagTaskType                       = "task_type"
 	FlagTaskVisibilityTimestamp        = "task_timestamp"
 	FlagQueueType                      = "queue_type"
@@ -204,6 +208,10 @@ const (
 	FlagSkipHistoryChecks              = "skip_history_checks"
 	FlagFailoverType                   = "failover_type"
 	FlagFailoverTimeout                = "failover_timeout_seconds"
+	FlagScheduledTime                  = "scheduled_time"
+	FlagMaxReplicationLag              = "max_replication_lag_seconds"
+	FlagRequireEmptyDLQ                = "require_empty_dlq"
+	FlagRequireHealthyTarget           = "require_healthy_target"
 	FlagActivityHeartBeatTimeout       = "heart_beat_timeout_seconds"
 	FlagFailoverWaitTime               = "failover_wait_time_second"
 	FlagFailoverBatchSize              = "failover_batch_size"
@@ -234,6 +242,9 @@ const (
 	FlagDynamicConfigName              = "name"
 	FlagDynamicConfigFilter            = "filter"
 	FlagDynamicConfigValue             = "value"
+	FlagDynamicConfigVersion           = "version"
+	FlagDynamicConfigFromVersion       = "from_version"
+	FlagDynamicConfigToVersion         = "to_version"
 	FlagTransport                      = "transport"
 	FlagFormat                         = "format"
 	FlagJSON                           = "json"
@@ -242,10 +253,20 @@ const (
 	FlagSearchAttribute                = "search_attr"
 	FlagNumReadPartitions              = "num_read_partitions"
 	FlagNumWritePartitions             = "num_write_partitions"
+	FlagPartition                      = "partition"
 	FlagCronOverlapPolicy              = "cron_overlap_policy"
 	FlagClusterAttributeScope          = "cluster_attribute_scope"
 	FlagClusterAttributeName           = "cluster_attribute_name"
 	FlagClusterAttributesJSON          = "cluster_attributes_json"
+	FlagWorkflowIDHash                 = "workflow_id_hash"
+	FlagDomains                        = "domains"
+	FlagTargetVisibilityStore          = "target_store"
+	FlagResumeToken                    = "resume_token"
+	FlagSourceVisibilityStore          = "source_store"
+	FlagSampleSize                     = "sample_size"
+	FlagCheckRange                     = "range"
+	FlagSettleDelay                    = "settle_delay"
+	FlagRepair                         = "repair"
 	// FlagBatchV1 forces the deprecated v1 batch workflow as a fallback.
 	// TODO: remove together with the v1 batch workflow once it is fully deprecated.
 	FlagBatchV1 = "v1"
@@ -478,6 +499,10 @@ func getFlagsForStart() []cli.Flag {
 			Usage:   "Optional cluster attribute name, paired with a cluster attribute scope, to specify how to select the active cluster. This specifies which attribute to tie the workflow to, for example, if the scope is 'region' and the name is 'Lisbon' or 'San Francisco'",
 			Aliases: []string{"caname"},
 		},
+		&cli.BoolFlag{
+			Name:  FlagWorkflowIDHash,
+			Usage: "Optional, instead of a cluster attribute name, select the cluster attribute of the cluster attribute scope by hashing the workflow ID into the cluster attribute weights of the domain",
+		},
 	}
 }
 
@@ -133,3 +133,18 @@ func (mr *MockManagerFactoryMockRecorder) initializeShardManager(c any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "initializeShardManager", reflect.TypeOf((*MockManagerFactory)(nil).initializeShardManager), c)
 }
+
+// initializeTaskManager mocks base method.
+func (m *MockManagerFactory) initializeTaskManager(c *cli.Context) (persistence.TaskManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "initializeTaskManager", c)
+	ret0, _ := ret[0].(persistence.TaskManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// initializeTaskManager indicates an expected call of initializeTaskManager.
+func (mr *MockManagerFactoryMockRecorder) initializeTaskManager(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "initializeTaskManager", reflect.TypeOf((*MockManagerFactory)(nil).initializeTaskManager), c)
+}
@@ -22,6 +22,7 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -31,6 +32,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/urfave/cli/v2"
 
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/types"
@@ -40,6 +42,10 @@ import (
 
 // TerminateBatchJob stops abatch job
 func TerminateBatchJob(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
 	jobID, err := getRequiredOption(c, FlagJobID)
 	if err != nil {
 		return commoncli.Problem("Required flag not found: ", err)
@@ -58,14 +64,11 @@ func TerminateBatchJob(c *cli.Context) error {
 		return commoncli.Problem("Error in creating context:", err)
 	}
 
-	err = svcClient.TerminateWorkflowExecution(
+	_, err = svcClient.StopBatchOperation(
 		tcCtx,
-		&types.TerminateWorkflowExecutionRequest{
-			Domain: constants.BatcherLocalDomainName,
-			WorkflowExecution: &types.WorkflowExecution{
-				WorkflowID: jobID,
-				RunID:      "",
-			},
+		&types.StopBatchOperationRequest{
+			Domain:   domain,
+			JobID:    jobID,
 			Reason:   reason,
 			Identity: getCliIdentity(),
 		},
@@ -82,6 +85,10 @@ func TerminateBatchJob(c *cli.Context) error {
 
 // DescribeBatchJob describe the status of the batch job
 func DescribeBatchJob(c *cli.Context) error {
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
 	jobID, err := getRequiredOption(c, FlagJobID)
 	if err != nil {
 		return commoncli.Problem("Required flag not found: ", err)
@@ -96,40 +103,17 @@ func DescribeBatchJob(c *cli.Context) error {
 		return commoncli.Problem("Error in creating context:", err)
 	}
 
-	wf, err := svcClient.DescribeWorkflowExecution(
+	resp, err := svcClient.DescribeBatchOperation(
 		tcCtx,
-		&types.DescribeWorkflowExecutionRequest{
-			Domain: constants.BatcherLocalDomainName,
-			Execution: &types.WorkflowExecution{
-				WorkflowID: jobID,
-				RunID:      "",
-			},
+		&types.DescribeBatchOperationRequest{
+			Domain: domain,
+			JobID:  jobID,
 		},
 	)
 	if err != nil {
 		return commoncli.Problem("Failed to describe batch job", err)
 	}
-
-	output := map[string]interface{}{}
-	if wf.WorkflowExecutionInfo.CloseStatus != nil {
-		if wf.WorkflowExecutionInfo.GetCloseStatus() != types.WorkflowExecutionCloseStatusCompleted {
-			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetCloseStatus().String()
-		} else {
-			output["msg"] = "batch job is finished successfully"
-		}
-	} else {
-		output["msg"] = "batch job is running"
-		if len(wf.PendingActivities) > 0 {
-			hbdBinary := wf.PendingActivities[0].HeartbeatDetails
-			hbd := batcher.HeartBeatDetails{}
-			err := json.Unmarshal(hbdBinary, &hbd)
-			if err != nil {
-				return commoncli.Problem("Failed to describe batch job", err)
-			}
-			output["progress"] = hbd
-		}
-	}
-	prettyPrintJSONObject(getDeps(c).Output(), output)
+	prettyPrintJSONObject(getDeps(c).Output(), resp.GetInfo())
 	return nil
 }
 
@@ -151,31 +135,28 @@ func ListBatchJobs(c *cli.Context) error {
 		return commoncli.Problem("Error in creating context:", err)
 	}
 
-	resp, err := svcClient.ListWorkflowExecutions(
+	resp, err := svcClient.ListBatchOperations(
 		tcCtx,
-		&types.ListWorkflowExecutionsRequest{
-			Domain:   constants.BatcherLocalDomainName,
+		&types.ListBatchOperationsRequest{
+			Domain:   domain,
 			PageSize: int32(pageSize),
-			Query:    fmt.Sprintf("CustomDomain = '%v'", domain),
 		},
 	)
 	if err != nil {
 		return commoncli.Problem("Failed to list batch jobs", err)
 	}
-	output := make([]interface{}, 0, len(resp.Executions))
-	for _, wf := range resp.Executions {
+	output := make([]interface{}, 0, len(resp.GetOperations()))
+	for _, info := range resp.GetOperations() {
 		job := map[string]string{
-			"jobID":     wf.Execution.GetWorkflowID(),
-			"startTime": timestampToString(wf.GetStartTime(), false),
-			"reason":    string(wf.Memo.Fields["Reason"]),
-			"operator":  string(wf.SearchAttributes.IndexedFields["Operator"]),
+			"jobID":     info.GetJobID(),
+			"startTime": timestampToString(common.Int64Default(info.GetStartTime()), false),
+			"reason":    info.GetReason(),
+			"operator":  info.GetIdentity(),
+			"batchType": info.GetBatchType(),
+			"status":    info.GetState().String(),
 		}
-
-		if wf.CloseStatus != nil {
-			job["status"] = wf.CloseStatus.String()
-			job["closeTime"] = timestampToString(wf.GetCloseTime(), false)
-		} else {
-			job["status"] = "RUNNING"
+		if info.GetState() != types.BatchOperationStateRunning {
+			job["closeTime"] = timestampToString(common.Int64Default(info.GetCloseTime()), false)
 		}
 
 		output = append(output, job)
@@ -279,48 +260,90 @@ func StartBatchJob(c *cli.Context) error {
 		return commoncli.Problem("Error in creating context:", err)
 	}
 
-	params := batcher.BatchParams{
-		DomainName: domain,
-		Query:      query,
-		Reason:     reason,
-		BatchType:  batchType,
-		SignalParams: batcher.SignalParams{
+	// TODO: remove this fallback once the v1 batch workflow is fully deprecated.
+	if c.Bool(FlagBatchV1) {
+		params := batcher.BatchParams{
+			DomainName: domain,
+			Query:      query,
+			Reason:     reason,
+			BatchType:  batchType,
+			SignalParams: batcher.SignalParams{
+				SignalName: sigName,
+				Input:      sigVal,
+			},
+			ReplicateParams: batcher.ReplicateParams{
+				SourceCluster: sourceCluster,
+				TargetCluster: targetCluster,
+			},
+			RPS:                      rps,
+			Concurrency:              concurrency,
+			PageSize:                 pageSize,
+			AttemptsOnRetryableError: retryAttempt,
+			ActivityHeartBeatTimeout: heartBeatTimeout,
+			MaxActivityRetries:       maxActivityRetries,
+		}
+		return startBatchJobV1(tcCtx, c, svcClient, params, operator)
+	}
+
+	request := &types.StartBatchOperationRequest{
+		Domain:                          domain,
+		BatchType:                       batchType,
+		Query:                           query,
+		Reason:                          reason,
+		Identity:                        operator,
+		RequestID:                       uuid.New(),
+		RPS:                             int32(rps),
+		Concurrency:                     int32(concurrency),
+		PageSize:                        int32(pageSize),
+		AttemptsOnRetryableError:        int32(retryAttempt),
+		ActivityHeartBeatTimeoutSeconds: int32(heartBeatTimeout / time.Second),
+		MaxActivityRetries:              int32(maxActivityRetries),
+	}
+	switch batchType {
+	case batcher.BatchTypeSignal:
+		request.SignalParams = &types.BatchOperationSignalParams{
 			SignalName: sigName,
-			Input:      sigVal,
-		},
-		ReplicateParams: batcher.ReplicateParams{
+			Input:      []byte(sigVal),
+		}
+	case batcher.BatchTypeReplicate:
+		request.ReplicateParams = &types.BatchOperationReplicateParams{
 			SourceCluster: sourceCluster,
 			TargetCluster: targetCluster,
-		},
-		RPS:                      rps,
-		Concurrency:              concurrency,
-		PageSize:                 pageSize,
-		AttemptsOnRetryableError: retryAttempt,
-		ActivityHeartBeatTimeout: heartBeatTimeout,
-		MaxActivityRetries:       maxActivityRetries,
+		}
+	}
+	startResp, err := svcClient.StartBatchOperation(tcCtx, request)
+	if err != nil {
+		return commoncli.Problem("Failed to start batch job", err)
 	}
+	output := map[string]interface{}{
+		"msg":   "batch job is started",
+		"jobID": startResp.GetJobID(),
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), output)
+	return nil
+}
+
+// startBatchJobV1 starts the deprecated v1 batch workflow directly, since the batch operation API only starts v2
+func startBatchJobV1(tcCtx context.Context, c *cli.Context, svcClient frontend.Client, params batcher.BatchParams, operator string) error {
 	input, err := json.Marshal(params)
 	if err != nil {
 		return commoncli.Problem("Failed to encode batch job parameters", err)
 	}
 	memo, err := getWorkflowMemo(map[string]interface{}{
-		"Reason": reason,
+		"Reason":    params.Reason,
+		"Query":     params.Query,
+		"BatchType": params.BatchType,
 	})
 	if err != nil {
 		return commoncli.Problem("Failed to encode batch job memo", err)
 	}
 	searchAttributes, err := serializeSearchAttributes(map[string]interface{}{
-		"CustomDomain": domain,
+		"CustomDomain": params.DomainName,
 		"Operator":     operator,
 	})
 	if err != nil {
 		return commoncli.Problem("Failed to encode batch job search attributes", err)
 	}
-	wfTypeName := batcher.BatchWFV2TypeName
-	// TODO: remove this fallback once the v1 batch workflow is fully deprecated.
-	if c.Bool(FlagBatchV1) {
-		wfTypeName = batcher.BatchWFTypeName
-	}
 
 	workflowID := uuid.NewRandom().String()
 	request := &types.StartWorkflowExecutionRequest{
@@ -333,7 +356,7 @@ func StartBatchJob(c *cli.Context) error {
 		Memo:                                memo,
 		SearchAttributes:                    searchAttributes,
 		RetryPolicy:                         copyRetryPolicyFromWorkflow(),
-		WorkflowType:                        &types.WorkflowType{Name: wfTypeName},
+		WorkflowType:                        &types.WorkflowType{Name: batcher.BatchWFTypeName},
 		Input:                               input,
 	}
 	_, err = svcClient.StartWorkflowExecution(tcCtx, request)
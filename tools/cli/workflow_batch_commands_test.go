@@ -21,6 +21,7 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -29,6 +30,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
@@ -36,6 +38,8 @@ import (
 	"github.com/uber/cadence/service/worker/batcher"
 )
 
+const testBatchJobID = "6f0d8d5e-2c57-4d0a-9d8e-1b7f0e3c2a41"
+
 func TestStartBatchJob(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -50,9 +54,13 @@ func TestStartBatchJob(t *testing.T) {
 				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
 					Count: 100,
 				}, nil)
-				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.StartWorkflowExecutionResponse{
-					RunID: "run-id-example",
-				}, nil)
+				mockClient.EXPECT().StartBatchOperation(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.StartBatchOperationRequest, _ ...yarpc.CallOption) (*types.StartBatchOperationResponse, error) {
+						assert.Equal(t, "test-domain", request.Domain)
+						assert.Equal(t, batcher.BatchTypeSignal, request.BatchType)
+						assert.Equal(t, &types.BatchOperationSignalParams{SignalName: "test-signal", Input: []byte("test-input")}, request.SignalParams)
+						return &types.StartBatchOperationResponse{JobID: testBatchJobID}, nil
+					})
 			},
 			flags: map[string]interface{}{
 				FlagDomain:     "test-domain",
@@ -72,15 +80,38 @@ func TestStartBatchJob(t *testing.T) {
 				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
 					Count: 100,
 				}, nil)
-				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.StartWorkflowExecutionResponse{
-					RunID: "run-id-example",
+				mockClient.EXPECT().StartBatchOperation(gomock.Any(), gomock.Any()).Return(&types.StartBatchOperationResponse{
+					JobID: testBatchJobID,
+				}, nil)
+			},
+			flags: map[string]interface{}{
+				FlagDomain:    "test-domain",
+				FlagListQuery: "workflowType='batch'",
+				FlagReason:    "Testing batch refresh job",
+				FlagBatchType: batcher.BatchTypeRefresh,
+				FlagYes:       true,
+			},
+			expectedError:  "",
+			expectedOutput: "batch job is started",
+		},
+		{
+			name: "Valid Start V1 Batch Job",
+			setup: func(mockClient *frontend.MockClient) {
+				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
+					Count: 100,
 				}, nil)
+				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.StartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+						assert.Equal(t, batcher.BatchWFTypeName, request.WorkflowType.GetName())
+						return &types.StartWorkflowExecutionResponse{RunID: "run-id-example"}, nil
+					})
 			},
 			flags: map[string]interface{}{
 				FlagDomain:    "test-domain",
 				FlagListQuery: "workflowType='batch'",
 				FlagReason:    "Testing batch refresh job",
 				FlagBatchType: batcher.BatchTypeRefresh,
+				FlagBatchV1:   true,
 				FlagYes:       true,
 			},
 			expectedError:  "",
@@ -167,12 +198,12 @@ func TestStartBatchJob(t *testing.T) {
 			expectedError: "Failed to count impacting workflows for starting a batch job: count error",
 		},
 		{
-			name: "Start Workflow Execution Failure",
+			name: "Start Batch Operation Failure",
 			setup: func(mockClient *frontend.MockClient) {
 				mockClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{
 					Count: 100,
 				}, nil)
-				mockClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, errors.New("start error"))
+				mockClient.EXPECT().StartBatchOperation(gomock.Any(), gomock.Any()).Return(nil, errors.New("start error"))
 			},
 			flags: map[string]interface{}{
 				FlagDomain:     "test-domain",
@@ -239,19 +270,36 @@ func TestTerminateBatchJob(t *testing.T) {
 		{
 			name: "Valid Termination",
 			setup: func(mockClient *frontend.MockClient) {
-				mockClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+				mockClient.EXPECT().StopBatchOperation(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, request *types.StopBatchOperationRequest, _ ...yarpc.CallOption) (*types.StopBatchOperationResponse, error) {
+						assert.Equal(t, "test-domain", request.Domain)
+						assert.Equal(t, "example-workflow-1", request.JobID)
+						assert.Equal(t, "Testing termination", request.Reason)
+						return &types.StopBatchOperationResponse{}, nil
+					})
 			},
 			flags: map[string]interface{}{
+				FlagDomain: "test-domain",
 				FlagJobID:  "example-workflow-1",
 				FlagReason: "Testing termination",
 			},
 			expectedError:  "",
 			expectedOutput: map[string]interface{}{"msg": "batch job is terminated"},
 		},
+		{
+			name:  "Missing Domain",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagJobID:  "example-workflow-1",
+				FlagReason: "Testing termination",
+			},
+			expectedError: "Required flag not found: : option domain is required",
+		},
 		{
 			name:  "Missing JobID",
 			setup: func(mockClient *frontend.MockClient) {},
 			flags: map[string]interface{}{
+				FlagDomain: "test-domain",
 				FlagReason: "Testing termination",
 			},
 			expectedError: "Required flag not found: : option job_id is required",
@@ -260,16 +308,18 @@ func TestTerminateBatchJob(t *testing.T) {
 			name:  "Missing Reason",
 			setup: func(mockClient *frontend.MockClient) {},
 			flags: map[string]interface{}{
-				FlagJobID: "example-workflow-1",
+				FlagDomain: "test-domain",
+				FlagJobID:  "example-workflow-1",
 			},
 			expectedError: "Required flag not found: : option reason is required",
 		},
 		{
 			name: "Terminate Failure",
 			setup: func(mockClient *frontend.MockClient) {
-				mockClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(errors.New("termination error"))
+				mockClient.EXPECT().StopBatchOperation(gomock.Any(), gomock.Any()).Return(nil, errors.New("termination error"))
 			},
 			flags: map[string]interface{}{
+				FlagDomain: "test-domain",
 				FlagJobID:  "example-workflow-1",
 				FlagReason: "Testing termination",
 			},
@@ -326,75 +376,63 @@ func TestDescribeBatchJob(t *testing.T) {
 		{
 			name: "Valid Batch Job",
 			setup: func(mockClient *frontend.MockClient) {
-				mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
-					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
-						CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
-						Execution: &types.WorkflowExecution{
-							WorkflowID: "example-workflow-1",
-						},
-						StartTime: common.Int64Ptr(1697018400),
-					},
-					PendingActivities: []*types.PendingActivityInfo{
-						{
-							HeartbeatDetails: json.RawMessage(`{"PageToken": null, "CurrentPage": 50, "TotalEstimate": 100, "SuccessCount": 0, "ErrorCount": 0}`),
-						},
+				mockClient.EXPECT().DescribeBatchOperation(gomock.Any(), &types.DescribeBatchOperationRequest{
+					Domain: "test-domain",
+					JobID:  "example-workflow-1",
+				}).Return(&types.DescribeBatchOperationResponse{
+					Info: &types.BatchOperationInfo{
+						JobID:          "example-workflow-1",
+						Domain:         "test-domain",
+						BatchType:      batcher.BatchTypeTerminate,
+						State:          types.BatchOperationStateRunning,
+						TotalEstimate:  100,
+						SucceededCount: 30,
+						FailedCount:    2,
+						FailureReasons: []*types.BatchOperationFailure{{Reason: "timeout", Count: 2}},
 					},
 				}, nil)
 			},
 			flags: map[string]interface{}{
-				FlagJobID: "example-workflow-1",
+				FlagDomain: "test-domain",
+				FlagJobID:  "example-workflow-1",
 			},
 			expectedError: "",
 			expectedOutput: map[string]interface{}{
-				"msg": "batch job is finished successfully",
+				"jobId":          "example-workflow-1",
+				"domain":         "test-domain",
+				"batchType":      batcher.BatchTypeTerminate,
+				"state":          "RUNNING",
+				"totalEstimate":  float64(100),
+				"succeededCount": float64(30),
+				"failedCount":    float64(2),
+				"failureReasons": []interface{}{map[string]interface{}{"reason": "timeout", "count": float64(2)}},
 			},
 		},
 		{
-			name: "Batch Job Running",
+			name: "Error when describing job",
 			setup: func(mockClient *frontend.MockClient) {
-				mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
-					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
-						CloseStatus: nil,
-						Execution: &types.WorkflowExecution{
-							WorkflowID: "example-workflow-2",
-						},
-					},
-					PendingActivities: []*types.PendingActivityInfo{
-						{
-							HeartbeatDetails: json.RawMessage(`{"PageToken": null, "CurrentPage": 30, "TotalEstimate": 100, "SuccessCount": 10, "ErrorCount": 0}`),
-						},
-					},
-				}, nil)
+				mockClient.EXPECT().DescribeBatchOperation(gomock.Any(), gomock.Any()).Return(nil, errors.New("service error"))
 			},
 			flags: map[string]interface{}{
-				FlagJobID: "example-workflow-2",
-			},
-			expectedError: "",
-			expectedOutput: map[string]interface{}{
-				"msg": "batch job is running",
-				"progress": batcher.HeartBeatDetails{
-					PageToken:     nil,
-					CurrentPage:   30,
-					TotalEstimate: 100,
-					SuccessCount:  10,
-					ErrorCount:    0,
-				},
+				FlagDomain: "test-domain",
+				FlagJobID:  "error-job",
 			},
+			expectedError: "Failed to describe batch job: service error",
 		},
 		{
-			name: "Error when describing job",
-			setup: func(mockClient *frontend.MockClient) {
-				mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, errors.New("service error"))
-			},
+			name:  "Missing Domain",
+			setup: func(mockClient *frontend.MockClient) {},
 			flags: map[string]interface{}{
-				FlagJobID: "error-job",
+				FlagJobID: "example-workflow-1",
 			},
-			expectedError: "Failed to describe batch job: service error",
+			expectedError: "Required flag not found: : option domain is required",
 		},
 		{
-			name:          "Missing Job ID",
-			setup:         func(mockClient *frontend.MockClient) {},
-			flags:         map[string]interface{}{},
+			name:  "Missing Job ID",
+			setup: func(mockClient *frontend.MockClient) {},
+			flags: map[string]interface{}{
+				FlagDomain: "test-domain",
+			},
 			expectedError: "Required flag not found: : option job_id is required",
 		},
 	}
@@ -431,14 +469,6 @@ func TestDescribeBatchJob(t *testing.T) {
 				var actualOutput map[string]interface{}
 				err = json.Unmarshal(ioHandler.outputBytes.Bytes(), &actualOutput)
 				assert.NoError(t, err)
-				if progressRaw, exists := actualOutput["progress"]; exists {
-					var progress batcher.HeartBeatDetails
-					progressBytes, err := json.Marshal(progressRaw)
-					if err == nil {
-						json.Unmarshal(progressBytes, &progress)
-						actualOutput["progress"] = progress
-					}
-				}
 				assert.Equal(t, tt.expectedOutput, actualOutput)
 			}
 		})
@@ -456,24 +486,26 @@ func TestListBatchJobs(t *testing.T) {
 		{
 			name: "Valid Batch Job",
 			setup: func(mockClient *frontend.MockClient) {
-				mockClient.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListWorkflowExecutionsResponse{
-					Executions: []*types.WorkflowExecutionInfo{
+				mockClient.EXPECT().ListBatchOperations(gomock.Any(), &types.ListBatchOperationsRequest{
+					Domain:   "test-domain",
+					PageSize: 100,
+				}).Return(&types.ListBatchOperationsResponse{
+					Operations: []*types.BatchOperationInfo{
 						{
-							Execution: &types.WorkflowExecution{
-								WorkflowID: "example-workflow-1",
-							},
+							JobID:     "example-workflow-1",
 							StartTime: common.Int64Ptr(1697018400),
-							Memo: &types.Memo{
-								Fields: map[string][]byte{
-									"Reason": []byte("Testing reason"),
-								},
-							},
-							SearchAttributes: &types.SearchAttributes{
-								IndexedFields: map[string][]byte{
-									"Operator": []byte("test-operator"),
-								},
-							},
-							CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+							Reason:    "Testing reason",
+							Identity:  "test-operator",
+							BatchType: batcher.BatchTypeTerminate,
+							State:     types.BatchOperationStateCompleted,
+						},
+						{
+							JobID:     "example-workflow-2",
+							StartTime: common.Int64Ptr(1697018400),
+							Reason:    "Testing reason",
+							Identity:  "test-operator",
+							BatchType: batcher.BatchTypeSignal,
+							State:     types.BatchOperationStateRunning,
 						},
 					},
 					NextPageToken: []byte("test-next-token"),
@@ -490,9 +522,18 @@ func TestListBatchJobs(t *testing.T) {
 					"startTime": "1970-01-01T00:00:01Z",
 					"reason":    "Testing reason",
 					"operator":  "test-operator",
+					"batchType": batcher.BatchTypeTerminate,
 					"status":    "COMPLETED",
 					"closeTime": "1970-01-01T00:00:00Z",
 				},
+				{
+					"jobID":     "example-workflow-2",
+					"startTime": "1970-01-01T00:00:01Z",
+					"reason":    "Testing reason",
+					"operator":  "test-operator",
+					"batchType": batcher.BatchTypeSignal,
+					"status":    "RUNNING",
+				},
 			},
 		},
 		{
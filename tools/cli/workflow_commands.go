@@ -453,7 +453,7 @@ func constructStartWorkflowRequest(c *cli.Context) (*types.StartWorkflowExecutio
 	if err != nil {
 		return nil, commoncli.Problem("Error in starting wf request: ", err)
 	}
-	activeClusterSelectionPolicy, err := parseClusterAttributes(c.String(FlagClusterAttributeScope), c.String(FlagClusterAttributeName))
+	activeClusterSelectionPolicy, err := parseClusterAttributes(c.String(FlagClusterAttributeScope), c.String(FlagClusterAttributeName), c.Bool(FlagWorkflowIDHash))
 	if err != nil {
 		return nil, commoncli.Problem("Error parsing cluster attributes: ", err)
 	}
@@ -1205,6 +1205,7 @@ type workflowExecutionInfo struct {
 	PartitionConfig              map[string]string
 	CronOverlapPolicy            *types.CronOverlapPolicy
 	ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
+	ReplicationExcluded          bool `json:",omitempty"`
 }
 
 // pendingActivityInfo has same fields as types.PendingActivityInfo, but different field type for better display
@@ -1257,6 +1258,7 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 		PartitionConfig:              info.PartitionConfig,
 		CronOverlapPolicy:            info.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy: info.ActiveClusterSelectionPolicy,
+		ReplicationExcluded:          info.ReplicationExcluded,
 	}
 
 	var pendingActs []*pendingActivityInfo
@@ -2827,7 +2829,15 @@ func mapQueryRejectConditionFromFlag(flag string) (types.QueryRejectCondition, e
 	return rejectCondition, nil
 }
 
-func parseClusterAttributes(clusterAttributeScope string, clusterAttributeName string) (*types.ActiveClusterSelectionPolicy, error) {
+func parseClusterAttributes(clusterAttributeScope string, clusterAttributeName string, workflowIDHash bool) (*types.ActiveClusterSelectionPolicy, error) {
+	if workflowIDHash {
+		if clusterAttributeScope == "" || clusterAttributeName != "" {
+			return nil, fmt.Errorf("invalid workflow ID hash, it needs a cluster attribute scope and no cluster attribute name. got %q.%q", clusterAttributeScope, clusterAttributeName)
+		}
+		return &types.ActiveClusterSelectionPolicy{
+			WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: clusterAttributeScope},
+		}, nil
+	}
 	if clusterAttributeScope == "" && clusterAttributeName == "" {
 		// default case, these values are optional so most workflows will not use them
 		return nil, nil
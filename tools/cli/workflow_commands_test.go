@@ -483,6 +483,22 @@ func Test_ConvertDescribeWorkflowExecutionResponse(t *testing.T) {
 		assert.Equal(t, int64(100), resp.WorkflowExecutionInfo.HistoryLength)
 	})
 
+	t.Run("replication excluded", func(t *testing.T) {
+		mockResp := &types.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-wf-id",
+					RunID:      "test-run-id",
+				},
+				ReplicationExcluded: true,
+			},
+		}
+
+		resp, err := convertDescribeWorkflowExecutionResponse(mockResp, serverFrontendClient, nil)
+		assert.NoError(t, err)
+		assert.True(t, resp.WorkflowExecutionInfo.ReplicationExcluded)
+	})
+
 	t.Run("with pending activities and conversion of timestamps", func(t *testing.T) {
 		now := time.Now().UnixNano()
 		mockResp := &types.DescribeWorkflowExecutionResponse{
@@ -3298,6 +3314,7 @@ func TestParseClusterAttributes(t *testing.T) {
 		name                   string
 		clusterAttributeScope  string
 		clusterAttributeName   string
+		workflowIDHash         bool
 		expectedPolicy         *types.ActiveClusterSelectionPolicy
 		expectError            bool
 		expectedErrorSubstring string
@@ -3337,11 +3354,33 @@ func TestParseClusterAttributes(t *testing.T) {
 			expectError:            true,
 			expectedErrorSubstring: "invalid cluster attribute",
 		},
+		{
+			name:                  "workflow ID hash with scope - should return hash policy",
+			clusterAttributeScope: "test-scope",
+			workflowIDHash:        true,
+			expectedPolicy: &types.ActiveClusterSelectionPolicy{
+				WorkflowIDHash: &types.WorkflowIDHashPolicy{Scope: "test-scope"},
+			},
+		},
+		{
+			name:                   "workflow ID hash without scope - should error",
+			workflowIDHash:         true,
+			expectError:            true,
+			expectedErrorSubstring: "invalid workflow ID hash",
+		},
+		{
+			name:                   "workflow ID hash with name - should error",
+			clusterAttributeScope:  "test-scope",
+			clusterAttributeName:   "test-name",
+			workflowIDHash:         true,
+			expectError:            true,
+			expectedErrorSubstring: "invalid workflow ID hash",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result, err := parseClusterAttributes(tc.clusterAttributeScope, tc.clusterAttributeName)
+			result, err := parseClusterAttributes(tc.clusterAttributeScope, tc.clusterAttributeName, tc.workflowIDHash)
 
 			if tc.expectError {
 				assert.Error(t, err)